@@ -0,0 +1,24 @@
+package guardian
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/abac"
+)
+
+// ABACConfig configures the [ConditionEngine] created by [NewConditionEngine].
+type ABACConfig = abac.Config
+
+// ConditionEngine compiles and evaluates the CEL expressions of [core.Condition].
+type ConditionEngine = abac.Engine
+
+// NewConditionEngine creates a [ConditionEngine] which the RBAC and ReBAC stores evaluate conditions of grants with.
+func NewConditionEngine(config ABACConfig) (*ConditionEngine, error) {
+	return abac.NewEngine(config)
+}
+
+// NewConditionStore creates a postgres backed [core.ConditionStore] which validates expressions with engine.
+func NewConditionStore(pool *pgxpool.Pool, engine *ConditionEngine) core.ConditionStore {
+	return abac.NewStore(pool, engine)
+}
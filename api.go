@@ -0,0 +1,175 @@
+package guardian
+
+import (
+	"net/http"
+
+	"connectrpc.com/connect"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/core/proto/guardian/v1/guardianv1connect"
+	"github.com/gophero/guardian/internal/api"
+)
+
+// AuthConfig configures the sign in rules of [NewAuthServiceHandler].
+type AuthConfig = api.AuthConfig
+
+// NewAuthServiceHandler creates the [guardianv1connect.AuthServiceHandler] and returns the path on which to mount it
+// along with its [http.Handler].
+func NewAuthServiceHandler(
+	config AuthConfig,
+	users core.UserStore,
+	passwords core.PasswordStore,
+	policy core.PasswordPolicy,
+	sessions core.SessionStore,
+	refreshTokens core.RefreshTokenStore,
+	accessTokens core.AccessTokenIssuer,
+	totp core.TOTPStore,
+	recoveryCodes core.RecoveryCodeStore,
+	challenges core.MFAChallengeStore,
+	passkeys core.PasskeyStore,
+	passwordless core.PasswordlessStore,
+	verifications core.EmailVerificationStore,
+	passwordResets core.PasswordResetStore,
+	mailer core.Mailer,
+	mailQueue *MailQueue,
+	audit core.AuditLog,
+	opts ...connect.HandlerOption,
+) (string, http.Handler) {
+	return guardianv1connect.NewAuthServiceHandler(
+		api.NewAuthService(
+			config, users, passwords, policy, sessions, refreshTokens, accessTokens, totp, recoveryCodes, challenges,
+			passkeys, passwordless, verifications, passwordResets, mailer, mailQueue, audit,
+		),
+		opts...,
+	)
+}
+
+// NewUserServiceHandler creates the [guardianv1connect.UserServiceHandler] and returns the path on which to mount it
+// along with its [http.Handler].
+func NewUserServiceHandler(
+	users core.UserStore,
+	profiles core.UserProfileStore,
+	sessions core.SessionStore,
+	refreshTokens core.RefreshTokenStore,
+	accessTokens core.AccessTokenIssuer,
+	verifications core.EmailVerificationStore,
+	mailer core.Mailer,
+	rbac core.RBACStore,
+	opts ...connect.HandlerOption,
+) (string, http.Handler) {
+	return guardianv1connect.NewUserServiceHandler(
+		api.NewUserService(users, profiles, sessions, refreshTokens, accessTokens, verifications, mailer, rbac),
+		opts...,
+	)
+}
+
+// NewMFAServiceHandler creates the [guardianv1connect.MFAServiceHandler] and returns the path on which to mount it
+// along with its [http.Handler].
+func NewMFAServiceHandler(
+	users core.UserStore,
+	totp core.TOTPStore,
+	recoveryCodes core.RecoveryCodeStore,
+	audit core.AuditLog,
+	sessions core.SessionStore,
+	accessTokens core.AccessTokenIssuer,
+	opts ...connect.HandlerOption,
+) (string, http.Handler) {
+	return guardianv1connect.NewMFAServiceHandler(
+		api.NewMFAService(users, totp, recoveryCodes, audit, sessions, accessTokens),
+		opts...,
+	)
+}
+
+// NewPasskeyServiceHandler creates the [guardianv1connect.PasskeyServiceHandler] and returns the path on which to mount
+// it along with its [http.Handler].
+func NewPasskeyServiceHandler(
+	users core.UserStore,
+	passkeys core.PasskeyStore,
+	audit core.AuditLog,
+	sessions core.SessionStore,
+	accessTokens core.AccessTokenIssuer,
+	opts ...connect.HandlerOption,
+) (string, http.Handler) {
+	return guardianv1connect.NewPasskeyServiceHandler(
+		api.NewPasskeyService(users, passkeys, audit, sessions, accessTokens),
+		opts...,
+	)
+}
+
+// NewAuthzServiceHandler creates the [guardianv1connect.AuthzServiceHandler] and returns the path on which to mount it
+// along with its [http.Handler].
+func NewAuthzServiceHandler(
+	rbac core.RBACStore,
+	conditions core.ConditionStore,
+	decisions core.DecisionLog,
+	sessions core.SessionStore,
+	accessTokens core.AccessTokenIssuer,
+	opts ...connect.HandlerOption,
+) (string, http.Handler) {
+	return guardianv1connect.NewAuthzServiceHandler(api.NewAuthzService(rbac, conditions, decisions, sessions, accessTokens), opts...)
+}
+
+// NewRelationServiceHandler creates the [guardianv1connect.RelationServiceHandler] and returns the path on which to
+// mount it along with its [http.Handler].
+func NewRelationServiceHandler(
+	rebac core.ReBACStore,
+	rbac core.RBACStore,
+	decisions core.DecisionLog,
+	sessions core.SessionStore,
+	accessTokens core.AccessTokenIssuer,
+	opts ...connect.HandlerOption,
+) (string, http.Handler) {
+	return guardianv1connect.NewRelationServiceHandler(api.NewRelationService(rebac, rbac, decisions, sessions, accessTokens), opts...)
+}
+
+// NewOrganizationServiceHandler creates the [guardianv1connect.OrganizationServiceHandler] and returns the path on
+// which to mount it along with its [http.Handler].
+func NewOrganizationServiceHandler(
+	orgs core.OrganizationStore,
+	users core.UserStore,
+	mailer core.Mailer,
+	sessions core.SessionStore,
+	accessTokens core.AccessTokenIssuer,
+	opts ...connect.HandlerOption,
+) (string, http.Handler) {
+	return guardianv1connect.NewOrganizationServiceHandler(api.NewOrganizationService(orgs, users, mailer, sessions, accessTokens), opts...)
+}
+
+// NewAPIKeyServiceHandler creates the [guardianv1connect.APIKeyServiceHandler] and returns the path on which to mount
+// it along with its [http.Handler].
+func NewAPIKeyServiceHandler(
+	keys core.APIKeyStore,
+	orgs core.OrganizationStore,
+	rbac core.RBACStore,
+	sessions core.SessionStore,
+	accessTokens core.AccessTokenIssuer,
+	opts ...connect.HandlerOption,
+) (string, http.Handler) {
+	return guardianv1connect.NewAPIKeyServiceHandler(api.NewAPIKeyService(keys, orgs, rbac, sessions, accessTokens), opts...)
+}
+
+// NewOAuthServiceHandler creates the [guardianv1connect.OAuthServiceHandler] and returns the path on which to mount
+// it along with its [http.Handler].
+func NewOAuthServiceHandler(
+	clients core.OAuthClientStore,
+	authorizations core.OAuthAuthorizationStore,
+	devices core.OAuthDeviceAuthorizationStore,
+	rbac core.RBACStore,
+	sessions core.SessionStore,
+	accessTokens core.AccessTokenIssuer,
+	opts ...connect.HandlerOption,
+) (string, http.Handler) {
+	return guardianv1connect.NewOAuthServiceHandler(api.NewOAuthService(clients, authorizations, devices, rbac, sessions, accessTokens), opts...)
+}
+
+// NewServiceAccountServiceHandler creates the [guardianv1connect.ServiceAccountServiceHandler] and returns the path on
+// which to mount it along with its [http.Handler].
+func NewServiceAccountServiceHandler(
+	accounts core.ServiceAccountStore,
+	rbac core.RBACStore,
+	sessions core.SessionStore,
+	accessTokens core.AccessTokenIssuer,
+	opts ...connect.HandlerOption,
+) (string, http.Handler) {
+	return guardianv1connect.NewServiceAccountServiceHandler(api.NewServiceAccountService(accounts, rbac, sessions, accessTokens), opts...)
+}
@@ -0,0 +1,51 @@
+package guardian
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/apikey"
+)
+
+// APIKeyConfig configures the [APIKeyStore] created by [NewAPIKeyStore].
+type APIKeyConfig = apikey.Config
+
+// APIKeyStore is a [core.APIKeyStore] which exports prometheus metrics about verified keys.
+type APIKeyStore interface {
+	core.APIKeyStore
+	prometheus.Collector
+
+	// FlushUsage writes the last used times of keys verified since the last flush in a single batch and returns the
+	// number of updated keys. It should be called every configured usage flush interval and on shutdown.
+	FlushUsage(ctx context.Context) (int64, error)
+	// DeleteExpired deletes expired keys and returns the number of deleted keys.
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// NewAPIKeyStore creates a postgres backed [APIKeyStore].
+func NewAPIKeyStore(pool *pgxpool.Pool, config APIKeyConfig) (APIKeyStore, error) {
+	return apikey.NewStore(pool, config)
+}
+
+// NewAPIKeyClient creates a [core.APIKeyVerifier] which verifies keys with the APIKeyService of the guardian at
+// baseURL, for services which do not access its database.
+func NewAPIKeyClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) core.APIKeyVerifier {
+	return apikey.NewClient(httpClient, baseURL, opts...)
+}
+
+// NewAPIKeyMiddleware creates a middleware which authenticates requests by the API key in the `Authorization: Bearer`
+// header and requires it to have all scopes. The key of a request is available through [APIKeyFromContext].
+func NewAPIKeyMiddleware(verifier core.APIKeyVerifier, scopes ...string) func(http.Handler) http.Handler {
+	return apikey.Middleware(verifier, scopes...)
+}
+
+// APIKeyFromContext returns the API key the request of ctx was authenticated with by a middleware of
+// [NewAPIKeyMiddleware].
+func APIKeyFromContext(ctx context.Context) (core.APIKey, bool) {
+	return apikey.FromContext(ctx)
+}
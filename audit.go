@@ -0,0 +1,13 @@
+package guardian
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/audit"
+)
+
+// NewAuditLog creates a postgres backed [core.AuditLog].
+func NewAuditLog(pool *pgxpool.Pool) core.AuditLog {
+	return audit.NewLog(pool)
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/grafana/dskit/services"
+
+	"github.com/gophero/guardian/pkg/bedrock/log"
+)
+
+// cleanupInterval is the interval between runs of background cleanup tasks.
+const cleanupInterval = time.Hour
+
+// newCleanupService creates a [services.Service] which runs fn every [cleanupInterval]. Failures are logged and retried
+// on the next run instead of stopping the service.
+func newCleanupService(name string, fn func(ctx context.Context) (int64, error)) services.Service {
+	return services.NewTimerService(cleanupInterval, nil, func(ctx context.Context) error {
+		n, err := fn(ctx)
+		if err != nil {
+			log.Err(err).Ctx(ctx).Str("task", name).Msg("cleanup failed")
+			return nil
+		}
+
+		log.Debug().Ctx(ctx).Str("task", name).Int64("deleted", n).Msg("cleanup finished")
+		return nil
+	}, nil)
+}
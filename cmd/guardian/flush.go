@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/grafana/dskit/services"
+
+	"github.com/gophero/guardian/pkg/bedrock/log"
+)
+
+// flushTimeout bounds the final flush on shutdown, when the context of the service is already canceled.
+const flushTimeout = 10 * time.Second
+
+// newFlushService creates a [services.Service] which runs fn every interval and once more when stopped, so buffered
+// writes are not lost on shutdown. Failures are logged and retried on the next run instead of stopping the service.
+func newFlushService(name string, interval time.Duration, fn func(ctx context.Context) (int64, error)) services.Service {
+	flush := func(ctx context.Context) {
+		n, err := fn(ctx)
+		if err != nil {
+			log.Err(err).Ctx(ctx).Str("task", name).Msg("flush failed")
+			return
+		}
+
+		log.Debug().Ctx(ctx).Str("task", name).Int64("written", n).Msg("flush finished")
+	}
+
+	iter := func(ctx context.Context) error {
+		flush(ctx)
+		return nil
+	}
+
+	stop := func(_ error) error {
+		ctx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+		defer cancel()
+
+		flush(ctx)
+		return nil
+	}
+
+	return services.NewTimerService(interval, nil, iter, stop)
+}
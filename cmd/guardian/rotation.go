@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/grafana/dskit/services"
+
+	"github.com/gophero/guardian"
+	"github.com/gophero/guardian/pkg/bedrock/log"
+)
+
+// rotationInterval is the interval between checks whether the signing key is due for rotation.
+const rotationInterval = time.Minute
+
+// newKeyRotationService creates a [services.Service] which ensures a signing key exists on start and rotates it once
+// due. Failures after start are logged and retried on the next run instead of stopping the service.
+func newKeyRotationService(keys *guardian.KeyRing) services.Service {
+	start := func(ctx context.Context) error {
+		if err := keys.RotateIfDue(ctx); err != nil {
+			return fmt.Errorf("main: rotate signing key: %w", err)
+		}
+
+		return nil
+	}
+
+	iter := func(ctx context.Context) error {
+		if err := keys.RotateIfDue(ctx); err != nil {
+			log.Err(err).Ctx(ctx).Msg("signing key rotation failed")
+		}
+
+		return nil
+	}
+
+	return services.NewTimerService(rotationInterval, start, iter, nil)
+}
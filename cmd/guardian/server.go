@@ -3,17 +3,20 @@ package main
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"runtime"
 	"time"
 
 	"github.com/grafana/dskit/services"
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/gophero/guardian"
 	"github.com/gophero/guardian/internal/db"
 	"github.com/gophero/guardian/pkg/bedrock/buildinfo"
 	"github.com/gophero/guardian/pkg/bedrock/infra/postgres"
 	"github.com/gophero/guardian/pkg/bedrock/log"
 	"github.com/gophero/guardian/pkg/bedrock/server"
+	"github.com/gophero/guardian/pkg/bedrock/server/middleware"
 	"github.com/gophero/guardian/pkg/bedrock/tracing"
 )
 
@@ -23,6 +26,47 @@ type ServerCmd struct {
 
 	Tracing tracing.Config `prefix:"tracing." envprefix:"TRACING_" embed:""`
 
+	Password struct {
+		Hasher guardian.PasswordHasherConfig `prefix:"hasher." envprefix:"HASHER_" embed:""`
+		Policy guardian.PasswordPolicyConfig `prefix:"policy." envprefix:"POLICY_" embed:""`
+	} `prefix:"password." envprefix:"PASSWORD_" embed:""`
+
+	Session guardian.SessionConfig `prefix:"session." envprefix:"SESSION_" embed:""`
+	Token   guardian.TokenConfig   `prefix:"token." envprefix:"TOKEN_" embed:""`
+
+	RefreshToken guardian.RefreshTokenConfig `prefix:"refresh_token." envprefix:"REFRESH_TOKEN_" embed:""`
+
+	Encryption guardian.EncryptionConfig `prefix:"encryption." envprefix:"ENCRYPTION_" embed:""`
+	MFA        guardian.MFAConfig        `prefix:"mfa." envprefix:"MFA_" embed:""`
+	Passkey    guardian.PasskeyConfig    `prefix:"passkey." envprefix:"PASSKEY_" embed:""`
+
+	Mail              guardian.MailConfig              `prefix:"mail." envprefix:"MAIL_" embed:""`
+	MailQueue         guardian.MailQueueConfig         `prefix:"mail_queue." envprefix:"MAIL_QUEUE_" embed:""`
+	Passwordless      guardian.PasswordlessConfig      `prefix:"passwordless." envprefix:"PASSWORDLESS_" embed:""`
+	EmailVerification guardian.EmailVerificationConfig `prefix:"email_verification." envprefix:"EMAIL_VERIFICATION_" embed:""`
+	PasswordReset     guardian.PasswordResetConfig     `prefix:"password_reset." envprefix:"PASSWORD_RESET_" embed:""`
+
+	Auth  guardian.AuthConfig  `prefix:"auth." envprefix:"AUTH_" embed:""`
+	RBAC  guardian.RBACConfig  `prefix:"rbac." envprefix:"RBAC_" embed:""`
+	ReBAC guardian.ReBACConfig `prefix:"rebac." envprefix:"REBAC_" embed:""`
+	ABAC  guardian.ABACConfig  `prefix:"abac." envprefix:"ABAC_" embed:""`
+
+	DecisionLog guardian.DecisionLogConfig `prefix:"decision_log." envprefix:"DECISION_LOG_" embed:""`
+
+	Organization guardian.OrganizationConfig `prefix:"organization." envprefix:"ORGANIZATION_" embed:""`
+	APIKey       guardian.APIKeyConfig       `prefix:"api_key." envprefix:"API_KEY_" embed:""`
+
+	ServiceAccount guardian.ServiceAccountConfig `prefix:"service_account." envprefix:"SERVICE_ACCOUNT_" embed:""`
+
+	API struct {
+		Server server.Config `prefix:"server." envprefix:"SERVER_" embed:""`
+	} `prefix:"api." envprefix:"API_" embed:""`
+
+	OAuth struct {
+		guardian.OAuthConfig `embed:""`
+		Server               server.Config `prefix:"server." envprefix:"SERVER_" embed:""`
+	} `prefix:"oauth." envprefix:"OAUTH_" embed:""`
+
 	Metrics struct {
 		Enabled bool          `help:"Enable prometheus metrics server." name:"enabled" env:"ENABLED" default:"true"`
 		Server  server.Config `prefix:"server." envprefix:"SERVER_" embed:""`
@@ -40,6 +84,9 @@ func (cmd *ServerCmd) Run(ctx context.Context, buildInfo buildinfo.BuildInfo) er
 	// Log build information.
 	buildInfo.Log(log.Logger)
 
+	// Make the global logger available to library code logging through the context.
+	ctx = log.Logger.WithContext(ctx)
+
 	// Setup tracing.
 	tm, err := tracing.New(cmd.Tracing, buildInfo)
 	if err != nil {
@@ -65,11 +112,237 @@ func (cmd *ServerCmd) Run(ctx context.Context, buildInfo buildinfo.BuildInfo) er
 		return err
 	}
 
-	prometheus.MustRegister(postgres.NewCollector(pgPool, "primary"))
+	// Setup stores.
+	auditLog := guardian.NewAuditLog(pgPool)
+
+	userStore := guardian.NewUserStore(pgPool)
+	userProfileStore := guardian.NewUserProfileStore(pgPool)
+
+	passwordHasher, err := guardian.NewPasswordHasher(cmd.Password.Hasher)
+	if err != nil {
+		return fmt.Errorf("main: new password hasher: %w", err)
+	}
+
+	passwordPolicy, err := guardian.NewPasswordPolicy(cmd.Password.Policy)
+	if err != nil {
+		return fmt.Errorf("main: new password policy: %w", err)
+	}
+
+	passwordStore, err := guardian.NewPasswordStore(pgPool, passwordHasher, passwordPolicy)
+	if err != nil {
+		return fmt.Errorf("main: new password store: %w", err)
+	}
+
+	sessionStore, err := guardian.NewSessionStore(pgPool, cmd.Session)
+	if err != nil {
+		return fmt.Errorf("main: new session store: %w", err)
+	}
+
+	cipher, err := guardian.NewCipher(cmd.Encryption)
+	if err != nil {
+		return fmt.Errorf("main: new cipher: %w", err)
+	}
+
+	keyRing, err := guardian.NewKeyRing(pgPool, cmd.Token, cipher)
+	if err != nil {
+		return fmt.Errorf("main: new key ring: %w", err)
+	}
+
+	accessTokenIssuer := guardian.NewAccessTokenIssuer(keyRing)
+	idTokenIssuer := guardian.NewIDTokenIssuer(keyRing)
+
+	refreshTokenStore, err := guardian.NewRefreshTokenStore(pgPool, cmd.RefreshToken, auditLog)
+	if err != nil {
+		return fmt.Errorf("main: new refresh token store: %w", err)
+	}
+
+	totpStore, err := guardian.NewTOTPStore(pgPool, cmd.MFA, cipher)
+	if err != nil {
+		return fmt.Errorf("main: new totp store: %w", err)
+	}
+
+	recoveryCodeStore, err := guardian.NewRecoveryCodeStore(pgPool, cmd.MFA)
+	if err != nil {
+		return fmt.Errorf("main: new recovery code store: %w", err)
+	}
+
+	mfaChallengeStore, err := guardian.NewMFAChallengeStore(pgPool, cmd.MFA)
+	if err != nil {
+		return fmt.Errorf("main: new mfa challenge store: %w", err)
+	}
+
+	passkeyStore, err := guardian.NewPasskeyStore(pgPool, cmd.Passkey)
+	if err != nil {
+		return fmt.Errorf("main: new passkey store: %w", err)
+	}
+
+	passwordlessStore, err := guardian.NewPasswordlessStore(pgPool, cmd.Passwordless)
+	if err != nil {
+		return fmt.Errorf("main: new passwordless store: %w", err)
+	}
+
+	emailVerificationStore, err := guardian.NewEmailVerificationStore(pgPool, cmd.EmailVerification)
+	if err != nil {
+		return fmt.Errorf("main: new email verification store: %w", err)
+	}
+
+	passwordResetStore, err := guardian.NewPasswordResetStore(pgPool, cmd.PasswordReset)
+	if err != nil {
+		return fmt.Errorf("main: new password reset store: %w", err)
+	}
+
+	conditionEngine, err := guardian.NewConditionEngine(cmd.ABAC)
+	if err != nil {
+		return fmt.Errorf("main: new condition engine: %w", err)
+	}
+
+	conditionStore := guardian.NewConditionStore(pgPool, conditionEngine)
+
+	rbacStore, err := guardian.NewRBACStore(pgPool, cmd.RBAC, conditionEngine)
+	if err != nil {
+		return fmt.Errorf("main: new rbac store: %w", err)
+	}
+
+	rebacStore, err := guardian.NewReBACStore(pgPool, cmd.ReBAC, conditionEngine)
+	if err != nil {
+		return fmt.Errorf("main: new rebac store: %w", err)
+	}
+
+	decisionLog, err := guardian.NewDecisionLog(pgPool, cmd.DecisionLog, log.Logger)
+	if err != nil {
+		return fmt.Errorf("main: new decision log: %w", err)
+	}
+
+	organizationStore, err := guardian.NewOrganizationStore(pgPool, cmd.Organization)
+	if err != nil {
+		return fmt.Errorf("main: new organization store: %w", err)
+	}
+
+	apiKeyStore, err := guardian.NewAPIKeyStore(pgPool, cmd.APIKey)
+	if err != nil {
+		return fmt.Errorf("main: new api key store: %w", err)
+	}
+
+	serviceAccountStore, err := guardian.NewServiceAccountStore(pgPool, cmd.ServiceAccount)
+	if err != nil {
+		return fmt.Errorf("main: new service account store: %w", err)
+	}
+
+	oauthClientStore := guardian.NewOAuthClientStore(pgPool)
+
+	oauthAuthorizationStore, err := guardian.NewOAuthAuthorizationStore(pgPool, cmd.OAuth.OAuthConfig, refreshTokenStore)
+	if err != nil {
+		return fmt.Errorf("main: new oauth authorization store: %w", err)
+	}
+
+	oauthDeviceAuthorizationStore, err := guardian.NewOAuthDeviceAuthorizationStore(pgPool, cmd.OAuth.OAuthConfig)
+	if err != nil {
+		return fmt.Errorf("main: new oauth device authorization store: %w", err)
+	}
+
+	oauthRevocationStore := guardian.NewOAuthRevocationStore(pgPool)
+
+	oauthHandler, err := guardian.NewOAuthHandler(
+		cmd.OAuth.OAuthConfig, oauthClientStore, serviceAccountStore, oauthAuthorizationStore, oauthDeviceAuthorizationStore,
+		userStore, userProfileStore, sessionStore, refreshTokenStore, oauthRevocationStore, accessTokenIssuer, idTokenIssuer,
+	)
+	if err != nil {
+		return fmt.Errorf("main: new oauth handler: %w", err)
+	}
+
+	mailer, err := guardian.NewSMTPMailer(cmd.Mail)
+	if err != nil {
+		return fmt.Errorf("main: new smtp mailer: %w", err)
+	}
+	defer mailer.Close()
+
+	mailQueue, err := guardian.NewMailQueue(cmd.MailQueue)
+	if err != nil {
+		return fmt.Errorf("main: new mail queue: %w", err)
+	}
+
+	apiMetrics := middleware.NewMetrics("api")
+	oauthMetrics := middleware.NewMetrics("oauth")
+
+	prometheus.MustRegister(
+		postgres.NewCollector(pgPool, "primary"), sessionStore, refreshTokenStore, passwordResetStore, apiKeyStore,
+		oauthHandler, apiMetrics, oauthMetrics,
+	)
 
 	// Setup services.
 	svc := make([]services.Service, 0)
 
+	svc = append(svc,
+		newKeyRotationService(keyRing),
+		newCleanupService("sessions", sessionStore.DeleteExpired),
+		newCleanupService("refresh_tokens", refreshTokenStore.DeleteExpired),
+		newCleanupService("signing_keys", keyRing.DeleteExpired),
+		newCleanupService("totp_enrollments", totpStore.DeleteExpired),
+		newCleanupService("mfa_challenges", mfaChallengeStore.DeleteExpired),
+		newCleanupService("webauthn_challenges", passkeyStore.DeleteExpired),
+		newCleanupService("passwordless_challenges", passwordlessStore.DeleteExpired),
+		newCleanupService("email_verifications", emailVerificationStore.DeleteExpired),
+		newCleanupService("password_resets", passwordResetStore.DeleteExpired),
+		newCleanupService("authz_decisions", decisionLog.DeleteExpired),
+		newCleanupService("organization_invitations", organizationStore.DeleteExpired),
+		newCleanupService("api_keys", apiKeyStore.DeleteExpired),
+		newCleanupService("service_account_assertions", serviceAccountStore.DeleteExpired),
+		newCleanupService("oauth_authorizations", oauthAuthorizationStore.DeleteExpired),
+		newCleanupService("oauth_device_authorizations", oauthDeviceAuthorizationStore.DeleteExpired),
+		newCleanupService("oauth_revoked_access_tokens", oauthRevocationStore.DeleteExpired),
+		newFlushService("api_key_usage", cmd.APIKey.UsageFlushInterval, apiKeyStore.FlushUsage),
+		// Sends the emails still queued on shutdown.
+		services.NewBasicService(nil, mailQueue.Run, nil),
+	)
+
+	jwksHandler := guardian.NewJWKSHandler(keyRing)
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /.well-known/jwks.json", jwksHandler)
+	mux.Handle(guardian.NewAuthServiceHandler(
+		cmd.Auth, userStore, passwordStore, passwordPolicy, sessionStore, refreshTokenStore, accessTokenIssuer,
+		totpStore, recoveryCodeStore, mfaChallengeStore, passkeyStore, passwordlessStore, emailVerificationStore,
+		passwordResetStore, mailer, mailQueue, auditLog,
+	))
+	mux.Handle(guardian.NewUserServiceHandler(
+		userStore, userProfileStore, sessionStore, refreshTokenStore, accessTokenIssuer, emailVerificationStore, mailer, rbacStore,
+	))
+	mux.Handle(guardian.NewMFAServiceHandler(userStore, totpStore, recoveryCodeStore, auditLog, sessionStore, accessTokenIssuer))
+	mux.Handle(guardian.NewPasskeyServiceHandler(userStore, passkeyStore, auditLog, sessionStore, accessTokenIssuer))
+	mux.Handle(guardian.NewAuthzServiceHandler(rbacStore, conditionStore, decisionLog, sessionStore, accessTokenIssuer))
+	mux.Handle(guardian.NewRelationServiceHandler(rebacStore, rbacStore, decisionLog, sessionStore, accessTokenIssuer))
+	mux.Handle(guardian.NewOrganizationServiceHandler(organizationStore, userStore, mailer, sessionStore, accessTokenIssuer))
+	mux.Handle(guardian.NewAPIKeyServiceHandler(apiKeyStore, organizationStore, rbacStore, sessionStore, accessTokenIssuer))
+	mux.Handle(guardian.NewServiceAccountServiceHandler(serviceAccountStore, rbacStore, sessionStore, accessTokenIssuer))
+	mux.Handle(guardian.NewOAuthServiceHandler(oauthClientStore, oauthAuthorizationStore, oauthDeviceAuthorizationStore, rbacStore, sessionStore, accessTokenIssuer))
+
+	apiServer, err := server.NewAPIServer(cmd.API.Server, mux,
+		middleware.Tracing("api"),
+		apiMetrics.Middleware(),
+		middleware.Logging(),
+		middleware.Recovery(),
+	)
+	if err != nil {
+		return fmt.Errorf("main: new api server: %w", err)
+	}
+
+	// The JWKS is published at the issuer as well, where OpenID Connect clients discover it.
+	oauthMux := http.NewServeMux()
+	oauthMux.Handle("GET /.well-known/jwks.json", jwksHandler)
+	oauthMux.Handle("/", oauthHandler)
+
+	oauthServer, err := server.NewOAuthServer(cmd.OAuth.Server, oauthMux,
+		middleware.Tracing("oauth"),
+		oauthMetrics.Middleware(),
+		middleware.Logging(),
+		middleware.Recovery(),
+	)
+	if err != nil {
+		return fmt.Errorf("main: new oauth server: %w", err)
+	}
+
+	svc = append(svc, apiServer, oauthServer)
+
 	if cmd.Metrics.Enabled {
 		s, err := server.NewMetricsServer(cmd.Metrics.Server)
 		if err != nil {
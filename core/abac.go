@@ -0,0 +1,58 @@
+package core
+
+import (
+	"context"
+	"net/netip"
+	"time"
+)
+
+// Condition is a named CEL expression which role assignments and relation tuples can require to hold. Expressions
+// evaluate to a bool and can use the variables of an [AttributeContext]:
+//
+//	time      timestamp            AttributeContext.Time
+//	ip        string               AttributeContext.IP, empty if unknown
+//	user      map(string, dyn)     AttributeContext.User
+//	resource  map(string, dyn)     AttributeContext.Resource
+//
+// In addition to the standard library, `ip.inCidr('10.0.0.0/8')` reports whether ip is in the network.
+type Condition struct {
+	Name        string
+	Expression  string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type CreateConditionParams struct {
+	Name        string
+	Expression  string
+	Description string
+}
+
+// UpdateConditionParams holds fields to update. Nil fields are left unchanged.
+type UpdateConditionParams struct {
+	Expression  *string
+	Description *string
+}
+
+// AttributeContext holds the attributes of a check which conditions are evaluated against.
+type AttributeContext struct {
+	Time     time.Time  // The time of the request. The zero time is replaced with the time of the evaluation.
+	IP       netip.Addr // The address of the client the check is made for, the zero value if unknown.
+	User     map[string]any
+	Resource map[string]any
+}
+
+// ConditionStore manages the conditions which role assignments and relation tuples can require.
+type ConditionStore interface {
+	// CreateCondition returns [ErrInvalidArgument] if the expression does not compile or does not evaluate to a bool,
+	// and [ErrAlreadyExists] if a condition with the name exists.
+	CreateCondition(ctx context.Context, params CreateConditionParams) (Condition, error)
+	GetCondition(ctx context.Context, name string) (Condition, error)
+	ListConditions(ctx context.Context) ([]Condition, error)
+	// UpdateCondition validates a new expression like [ConditionStore.CreateCondition]. The new expression applies to
+	// all grants requiring the condition.
+	UpdateCondition(ctx context.Context, name string, params UpdateConditionParams) (Condition, error)
+	// DeleteCondition returns [ErrInvalidArgument] if role assignments or relation tuples require the condition.
+	DeleteCondition(ctx context.Context, name string) error
+}
@@ -0,0 +1,85 @@
+package core
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKeyOwnerType is the kind of principal an [APIKey] acts on behalf of.
+type APIKeyOwnerType string
+
+const (
+	APIKeyOwnerUser           APIKeyOwnerType = "user"
+	APIKeyOwnerOrganization   APIKeyOwnerType = "organization"
+	APIKeyOwnerServiceAccount APIKeyOwnerType = "service_account"
+)
+
+// APIKeyOwner is the principal an [APIKey] acts on behalf of.
+type APIKeyOwner struct {
+	Type APIKeyOwnerType
+	ID   uuid.UUID
+}
+
+// APIKey is a long-lived credential of scripts and CI jobs. Keys are presented as `gdn_<prefix>_<secret>`, where
+// Prefix, including the `gdn_` part, identifies the key and may be shown to users. Only a hash of the whole key is
+// stored.
+type APIKey struct {
+	ID     uuid.UUID
+	Name   string
+	Prefix string
+	Owner  APIKeyOwner
+	// Scopes are the permissions granted to the key, sorted by name.
+	Scopes     []string
+	CreatedAt  time.Time
+	ExpiresAt  *time.Time // Nil if the key does not expire.
+	LastUsedAt *time.Time // Nil if the key was never used. Uses are recorded in batches, so it lags behind.
+	RotatedAt  *time.Time
+	// PreviousExpiresAt is the time until which the secret replaced by the last rotation stays valid, nil if it is
+	// not valid anymore.
+	PreviousExpiresAt *time.Time
+}
+
+// HasScopes reports whether all scopes are granted to the key.
+func (k APIKey) HasScopes(scopes ...string) bool {
+	for _, s := range scopes {
+		if !slices.Contains(k.Scopes, s) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type CreateAPIKeyParams struct {
+	Name      string
+	Owner     APIKeyOwner
+	Scopes    []string
+	ExpiresAt *time.Time // Nil if the key does not expire.
+}
+
+// APIKeyVerifier verifies presented API keys.
+type APIKeyVerifier interface {
+	// Verify returns the key of token. It returns [ErrInvalidCredentials] if the key is malformed, unknown or expired,
+	// or if it is owned by a user who is deleted or not active.
+	Verify(ctx context.Context, token string) (APIKey, error)
+}
+
+// APIKeyStore manages API keys. Only hashes of keys are stored.
+type APIKeyStore interface {
+	APIKeyVerifier
+
+	// Create creates a key and returns it with the token to present. The token cannot be retrieved later.
+	Create(ctx context.Context, params CreateAPIKeyParams) (APIKey, string, error)
+	Get(ctx context.Context, id uuid.UUID) (APIKey, error)
+	// List lists the keys of owner ordered by id, including expired ones.
+	List(ctx context.Context, owner APIKeyOwner) ([]APIKey, error)
+	// Rotate replaces the secret of the key and returns the key with its new token. The prefix is kept. The previous
+	// token stays valid for overlap, so clients can switch without downtime, and a zero overlap invalidates it
+	// immediately. A nil overlap uses the configured default.
+	Rotate(ctx context.Context, id uuid.UUID, overlap *time.Duration) (APIKey, string, error)
+	// Revoke deletes the key, invalidating its tokens immediately.
+	Revoke(ctx context.Context, id uuid.UUID) error
+}
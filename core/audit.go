@@ -0,0 +1,41 @@
+package core
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/google/uuid"
+)
+
+// AuditEventType identifies the kind of an [AuditEvent].
+type AuditEventType string
+
+const (
+	AuditRefreshTokenReused       AuditEventType = "refresh_token.reused"
+	AuditTOTPEnabled              AuditEventType = "mfa.totp_enabled"
+	AuditTOTPDisabled             AuditEventType = "mfa.totp_disabled"
+	AuditRecoveryCodeUsed         AuditEventType = "mfa.recovery_code_used"
+	AuditRecoveryCodesRegenerated AuditEventType = "mfa.recovery_codes_regenerated"
+	AuditPasskeyRegistered        AuditEventType = "passkey.registered"
+	AuditPasskeyDeleted           AuditEventType = "passkey.deleted"
+	AuditEmailVerified            AuditEventType = "user.email_verified"
+	AuditEmailChanged             AuditEventType = "user.email_changed"
+	AuditPasswordResetRequested   AuditEventType = "password.reset_requested"
+	AuditPasswordReset            AuditEventType = "password.reset"
+	AuditPasswordResetFailed      AuditEventType = "password.reset_failed"
+)
+
+// AuditEvent is a security relevant event.
+type AuditEvent struct {
+	Type      AuditEventType
+	UserID    uuid.UUID // [uuid.Nil] if the event is not related to a user.
+	IPAddress netip.Addr
+	UserAgent string
+	Metadata  map[string]string
+}
+
+// AuditLog records security relevant events.
+type AuditLog interface {
+	// Record records event.
+	Record(ctx context.Context, event AuditEvent) error
+}
@@ -0,0 +1,69 @@
+package core
+
+import "context"
+
+// Decision is the result of an authorization check.
+type Decision struct {
+	Allowed bool
+	// Policy describes the grant which allowed the check, e.g. `role:editor@<org id>` for a role assignment or the
+	// relation tuple which contains the subject. It is empty if the check was denied.
+	Policy string
+	// Conditions which held for the policy to apply, empty for unconditional grants.
+	Conditions []string
+}
+
+// ExplainKind is the kind of an [ExplainNode].
+type ExplainKind string
+
+const (
+	ExplainPermission   ExplainKind = "permission"   // A checked permission, the root of role-based explanations.
+	ExplainAssignment   ExplainKind = "assignment"   // A role assignment of the subject, named like a policy.
+	ExplainRole         ExplainKind = "role"         // A role reached through an assignment or by inheritance.
+	ExplainRelation     ExplainKind = "relation"     // A checked relation of an object like `document:readme#viewer`.
+	ExplainUnion        ExplainKind = "union"        // Allowed if any child is.
+	ExplainIntersection ExplainKind = "intersection" // Allowed if all children are.
+	ExplainExclusion    ExplainKind = "exclusion"    // Allowed if the first child is and the second is not.
+	ExplainTuple        ExplainKind = "tuple"        // A relation tuple which was looked up or followed.
+	ExplainCondition    ExplainKind = "condition"    // A condition which was evaluated, with its expression as detail.
+)
+
+// ExplainNode is a node of the evaluation tree of an explained check. Children are in the order they were evaluated.
+type ExplainNode struct {
+	Kind     ExplainKind
+	Name     string
+	Allowed  bool
+	Detail   string // Additional information like the expression of a condition or why evaluation stopped.
+	Children []ExplainNode
+}
+
+// Explanation is a decision along with the evaluation which led to it.
+type Explanation struct {
+	Decision Decision
+	Tree     ExplainNode
+}
+
+// DecisionType identifies the kind of check of a [DecisionRecord].
+type DecisionType string
+
+const (
+	DecisionPermission DecisionType = "permission"
+	DecisionRelation   DecisionType = "relation"
+)
+
+// DecisionRecord is an authorization decision to record.
+type DecisionRecord struct {
+	Type DecisionType
+	// Subject is the checked user id for permission checks or the subject of relation checks.
+	Subject string
+	// Action is the permission or relation checked.
+	Action string
+	// Resource is the scope of permission checks, empty for the global scope, or the object of relation checks.
+	Resource string
+	Decision Decision
+}
+
+// DecisionLog records authorization decisions, e.g. to find out why a check was denied after the fact.
+// Implementations may only record a sample of decisions.
+type DecisionLog interface {
+	Record(ctx context.Context, record DecisionRecord) error
+}
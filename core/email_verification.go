@@ -0,0 +1,41 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmailVerificationPurpose tells what completing an [EmailVerification] does.
+type EmailVerificationPurpose string
+
+const (
+	// EmailVerificationVerify verifies the current email of the user.
+	EmailVerificationVerify EmailVerificationPurpose = "verify"
+	// EmailVerificationChange changes the email of the user to the verified one.
+	EmailVerificationChange EmailVerificationPurpose = "change"
+)
+
+// EmailVerification is a pending proof that a user controls an email address. It is completed with a token sent to
+// that address.
+type EmailVerification struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Purpose   EmailVerificationPurpose
+	Email     string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// EmailVerificationStore manages single-use [EmailVerification]s. Only hashes of tokens are stored. Creating a
+// verification replaces pending verifications of the same purpose of the user.
+type EmailVerificationStore interface {
+	// Create creates a verification of email for the user and returns it with the link to send to email. The link
+	// embeds the token which completes the verification. It returns [ErrRateLimited] if a verification of the same
+	// purpose was created for the user recently.
+	Create(ctx context.Context, userID uuid.UUID, email string, purpose EmailVerificationPurpose) (EmailVerification, string, error)
+	// Complete completes the verification of token. It returns [ErrInvalidToken] if the verification does not exist,
+	// expired or was completed.
+	Complete(ctx context.Context, token string) (EmailVerification, error)
+}
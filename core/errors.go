@@ -0,0 +1,23 @@
+package core
+
+import "errors"
+
+var (
+	// ErrNotFound is returned when a requested resource does not exist.
+	ErrNotFound = errors.New("core: not found")
+
+	// ErrAlreadyExists is returned when a resource conflicts with an existing one.
+	ErrAlreadyExists = errors.New("core: already exists")
+
+	// ErrInvalidArgument is returned when a given argument fails validation.
+	ErrInvalidArgument = errors.New("core: invalid argument")
+
+	// ErrInvalidCredentials is returned when presented credentials do not match.
+	ErrInvalidCredentials = errors.New("core: invalid credentials")
+
+	// ErrInvalidToken is returned when a presented token is malformed, expired, revoked or not trusted.
+	ErrInvalidToken = errors.New("core: invalid token")
+
+	// ErrRateLimited is returned when an action was repeated too often in a short time.
+	ErrRateLimited = errors.New("core: rate limited")
+)
@@ -0,0 +1,17 @@
+package core
+
+import "context"
+
+// Email is a message to a single recipient. It has a plain text body and optionally an alternative HTML body.
+type Email struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Mailer delivers emails.
+type Mailer interface {
+	// Send delivers email. It returns [ErrInvalidArgument] if the recipient address is malformed.
+	Send(ctx context.Context, email Email) error
+}
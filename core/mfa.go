@@ -0,0 +1,73 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MFAFactor is a kind of second factor a user can answer an [MFAChallenge] with.
+type MFAFactor string
+
+const (
+	MFAFactorTOTP         MFAFactor = "totp"
+	MFAFactorRecoveryCode MFAFactor = "recovery_code"
+)
+
+// TOTPEnrollment is a pending TOTP factor. It has to be confirmed with a code before it is used.
+type TOTPEnrollment struct {
+	Secret string // Base32 encoded secret for manual entry.
+	URI    string // The otpauth:// URI of the secret.
+	QRCode []byte // PNG encoded QR code of URI.
+}
+
+// TOTPStore manages RFC 6238 time-based one-time password factors of users. Secrets are stored encrypted.
+type TOTPStore interface {
+	// Enroll starts enrollment of a factor for the user, replacing a pending one. account labels the factor in
+	// authenticator apps. It returns [ErrAlreadyExists] if the user has a confirmed factor.
+	Enroll(ctx context.Context, userID uuid.UUID, account string) (TOTPEnrollment, error)
+	// Confirm confirms the pending factor of the user with code. It returns [ErrNotFound] if there is no pending
+	// factor and [ErrInvalidCredentials] if code does not match.
+	Confirm(ctx context.Context, userID uuid.UUID, code string) error
+	// Verify returns [ErrInvalidCredentials] if code does not match the confirmed factor of the user or it was already
+	// used.
+	Verify(ctx context.Context, userID uuid.UUID, code string) error
+	// Enabled reports whether the user has a confirmed factor.
+	Enabled(ctx context.Context, userID uuid.UUID) (bool, error)
+	Delete(ctx context.Context, userID uuid.UUID) error
+}
+
+// RecoveryCodeStore manages single-use recovery codes which stand in for a lost second factor. Only hashes of codes
+// are stored.
+type RecoveryCodeStore interface {
+	// Generate replaces the recovery codes of the user with new ones and returns them.
+	Generate(ctx context.Context, userID uuid.UUID) ([]string, error)
+	// Use consumes code. It returns [ErrInvalidCredentials] if code does not match an unused code of the user.
+	Use(ctx context.Context, userID uuid.UUID, code string) error
+	// Remaining returns the number of unused codes of the user.
+	Remaining(ctx context.Context, userID uuid.UUID) (int, error)
+	Delete(ctx context.Context, userID uuid.UUID) error
+}
+
+// MFAChallenge is a sign in which passed the first factor and waits for a second one.
+type MFAChallenge struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Device    string
+	Attempts  int
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// MFAChallengeStore manages pending [MFAChallenge]s identified by opaque tokens. Only hashes of tokens are stored.
+type MFAChallengeStore interface {
+	// Create creates a challenge for the user and returns it with its token.
+	Create(ctx context.Context, userID uuid.UUID, device string) (MFAChallenge, string, error)
+	// Attempt counts an attempt to answer the pending challenge of token and returns the challenge. Attempts are
+	// counted before the answer is verified, so concurrent attempts cannot exceed the limit. It returns
+	// [ErrInvalidToken] if the challenge does not exist, expired, was completed or ran out of attempts.
+	Attempt(ctx context.Context, token string) (MFAChallenge, error)
+	// Complete completes the challenge. It returns [ErrInvalidToken] if it was completed already.
+	Complete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,285 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OAuthClientType is the client type of RFC 6749, section 2.1.
+type OAuthClientType string
+
+const (
+	// OAuthClientPublic clients cannot keep a secret, like single page and native apps. They are authenticated by
+	// PKCE only.
+	OAuthClientPublic OAuthClientType = "public"
+	// OAuthClientConfidential clients authenticate with a secret at the token endpoint.
+	OAuthClientConfidential OAuthClientType = "confidential"
+)
+
+// Grant types an [OAuthClient] may be allowed to use.
+const (
+	OAuthGrantAuthorizationCode = "authorization_code"
+	OAuthGrantRefreshToken      = "refresh_token"
+	// OAuthGrantDeviceCode is the device authorization grant of RFC 8628 for devices without a browser or with limited
+	// input, like command line tools and TVs.
+	OAuthGrantDeviceCode = "urn:ietf:params:oauth:grant-type:device_code"
+)
+
+// OAuthGrantClientCredentials is the grant type service accounts obtain access tokens with. It is not available to
+// registered clients, which act on behalf of users.
+const OAuthGrantClientCredentials = "client_credentials"
+
+// Scopes of OpenID Connect Core 1.0, section 5.4. Requesting [ScopeOpenID] makes an authorization request an OpenID
+// Connect request, the others select the claims returned by the userinfo endpoint.
+const (
+	ScopeOpenID  = "openid"
+	ScopeProfile = "profile"
+	ScopeEmail   = "email"
+	ScopePhone   = "phone"
+	ScopeAddress = "address"
+)
+
+// Values of the prompt parameter of OpenID Connect Core 1.0, section 3.1.2.1.
+const (
+	PromptNone          = "none"
+	PromptLogin         = "login"
+	PromptConsent       = "consent"
+	PromptSelectAccount = "select_account"
+)
+
+// OAuthClient is an application registered to obtain tokens on behalf of users.
+type OAuthClient struct {
+	ID   uuid.UUID
+	Name string
+	Type OAuthClientType
+	// RedirectURIs are the allowed redirect URIs, compared by exact string match.
+	RedirectURIs []string
+	GrantTypes   []string
+	// Scopes are the scopes the client may request, sorted by name.
+	Scopes []string
+	// Audiences are the audiences of the resource servers the client acts as, sorted by name. The client may
+	// introspect access tokens issued for any of them.
+	Audiences []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// AllowsGrant reports whether the client may use the grant type.
+func (c OAuthClient) AllowsGrant(grantType string) bool {
+	return slices.Contains(c.GrantTypes, grantType)
+}
+
+// AllowsRedirectURI reports whether uri is registered as redirect URI of the client.
+func (c OAuthClient) AllowsRedirectURI(uri string) bool {
+	return slices.Contains(c.RedirectURIs, uri)
+}
+
+// AllowsScopes reports whether the client may request all scopes.
+func (c OAuthClient) AllowsScopes(scopes ...string) bool {
+	for _, s := range scopes {
+		if !slices.Contains(c.Scopes, s) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AllowsIntrospection reports whether the client may learn about the access token. Clients may introspect tokens
+// issued to them and tokens intended for one of their audiences.
+func (c OAuthClient) AllowsIntrospection(claims AccessTokenClaims) bool {
+	if claims.ClientID == c.ID.String() {
+		return true
+	}
+
+	for _, aud := range claims.Audience {
+		if slices.Contains(c.Audiences, aud) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type CreateOAuthClientParams struct {
+	Name         string
+	Type         OAuthClientType
+	RedirectURIs []string
+	GrantTypes   []string
+	Scopes       []string
+	Audiences    []string
+}
+
+type UpdateOAuthClientParams struct {
+	ID           uuid.UUID
+	Name         string
+	RedirectURIs []string
+	GrantTypes   []string
+	Scopes       []string
+	Audiences    []string
+}
+
+// OAuthClientStore manages registered OAuth clients. Only hashes of client secrets are stored.
+type OAuthClientStore interface {
+	// Create registers a client and returns it with its secret, which is empty for public clients. The secret cannot
+	// be retrieved later.
+	Create(ctx context.Context, params CreateOAuthClientParams) (OAuthClient, string, error)
+	Get(ctx context.Context, id uuid.UUID) (OAuthClient, error)
+	// List lists all clients ordered by id.
+	List(ctx context.Context) ([]OAuthClient, error)
+	// Update replaces the settings of the client. The type of a client cannot be changed.
+	Update(ctx context.Context, params UpdateOAuthClientParams) (OAuthClient, error)
+	// Delete deletes the client along with its pending authorizations.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// Authenticate returns the confidential client of id if secret is its secret. It returns [ErrInvalidCredentials]
+	// otherwise, including for public clients.
+	Authenticate(ctx context.Context, id uuid.UUID, secret string) (OAuthClient, error)
+}
+
+// OAuthAuthorizationRequest is a validated request of the authorization endpoint waiting for the user to sign in and
+// consent.
+type OAuthAuthorizationRequest struct {
+	ID          uuid.UUID
+	ClientID    uuid.UUID
+	RedirectURI string
+	Scope       []string
+	State       string
+	// CodeChallenge is the S256 PKCE code challenge the code verifier of the token request has to match.
+	CodeChallenge string
+	// Nonce is passed on to the ID token issued for the request.
+	Nonce string
+	// Prompt lists how the client asked the login page to interact with the user, sorted by name.
+	Prompt []string
+	// MaxAge is the maximum time since the user last authenticated, nil if the client did not limit it.
+	MaxAge    *time.Duration
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// HasPrompt reports whether the client requested the prompt value.
+func (r OAuthAuthorizationRequest) HasPrompt(prompt string) bool {
+	return slices.Contains(r.Prompt, prompt)
+}
+
+// RequiresLogin reports whether a user who authenticated at authTime has to authenticate again at now before the
+// request can be approved, because the client asked for prompt=login or authTime is older than MaxAge.
+func (r OAuthAuthorizationRequest) RequiresLogin(authTime, now time.Time) bool {
+	if r.HasPrompt(PromptLogin) && authTime.Before(r.CreatedAt) {
+		return true
+	}
+
+	return r.MaxAge != nil && now.Sub(authTime) > *r.MaxAge
+}
+
+type CreateOAuthAuthorizationRequestParams struct {
+	ClientID      uuid.UUID
+	RedirectURI   string
+	Scope         []string
+	State         string
+	CodeChallenge string
+	Nonce         string
+	Prompt        []string
+	MaxAge        *time.Duration
+}
+
+// OAuthAuthorizationCode is a short-lived single use code which the client exchanges for tokens.
+type OAuthAuthorizationCode struct {
+	ID            uuid.UUID
+	ClientID      uuid.UUID
+	UserID        uuid.UUID
+	SessionID     uuid.UUID // [uuid.Nil] if the code is not bound to a session.
+	RedirectURI   string
+	Scope         []string
+	CodeChallenge string
+	Nonce         string
+	ExpiresAt     time.Time
+}
+
+// OAuthAuthorizationStore manages pending authorization requests and the authorization codes issued for them. Only
+// hashes of codes are stored.
+type OAuthAuthorizationStore interface {
+	// CreateRequest stores a validated authorization request until the user answers it.
+	CreateRequest(ctx context.Context, params CreateOAuthAuthorizationRequestParams) (OAuthAuthorizationRequest, error)
+	// GetRequest returns a pending request. It returns [ErrNotFound] if the request does not exist or expired.
+	GetRequest(ctx context.Context, id uuid.UUID) (OAuthAuthorizationRequest, error)
+	// Approve answers the request on behalf of the user and returns it along with the authorization code issued for
+	// it. The code is bound to the session, which may be [uuid.Nil]. Each request can be answered once.
+	Approve(ctx context.Context, requestID, userID, sessionID uuid.UUID) (OAuthAuthorizationRequest, string, error)
+	// Deny answers the request with a refusal and returns it.
+	Deny(ctx context.Context, requestID uuid.UUID) (OAuthAuthorizationRequest, error)
+	// Exchange redeems the code issued to the client. It returns [ErrInvalidToken] if the code does not exist,
+	// expired, was issued to another client or was already redeemed. Redeeming a code again also revokes the refresh
+	// token family bound to it by [OAuthAuthorizationStore.BindRefreshTokenFamily].
+	Exchange(ctx context.Context, clientID uuid.UUID, code string) (OAuthAuthorizationCode, error)
+	// BindRefreshTokenFamily records the refresh token family issued for the code.
+	BindRefreshTokenFamily(ctx context.Context, codeID, familyID uuid.UUID) error
+}
+
+// OAuthDeviceAuthorizationStatus is the state of an [OAuthDeviceAuthorization].
+type OAuthDeviceAuthorizationStatus string
+
+const (
+	OAuthDeviceAuthorizationPending  OAuthDeviceAuthorizationStatus = "pending"
+	OAuthDeviceAuthorizationApproved OAuthDeviceAuthorizationStatus = "approved"
+	OAuthDeviceAuthorizationDenied   OAuthDeviceAuthorizationStatus = "denied"
+)
+
+// Errors of polling an [OAuthDeviceAuthorization], RFC 8628, section 3.5.
+var (
+	// ErrAuthorizationPending is returned while the user has not answered the authorization.
+	ErrAuthorizationPending = errors.New("core: authorization pending")
+	// ErrSlowDown is returned if the device polls faster than its interval, which is then increased by 5 seconds.
+	ErrSlowDown = errors.New("core: slow down")
+	// ErrAuthorizationDenied is returned if the user refused the authorization.
+	ErrAuthorizationDenied = errors.New("core: authorization denied")
+	// ErrAuthorizationExpired is returned if the authorization expired before the device redeemed it.
+	ErrAuthorizationExpired = errors.New("core: authorization expired")
+)
+
+// OAuthDeviceAuthorization is a request of the device authorization grant. The device shows the user code and polls
+// the token endpoint with its device code, while the user enters the user code on the verification page of another
+// device and consents.
+type OAuthDeviceAuthorization struct {
+	ID       uuid.UUID
+	ClientID uuid.UUID
+	// UserCode is the code the user enters, in upper case without separator.
+	UserCode string
+	Scope    []string
+	Status   OAuthDeviceAuthorizationStatus
+	// UserID and SessionID are set once the user approved the authorization, SessionID may stay [uuid.Nil].
+	UserID    uuid.UUID
+	SessionID uuid.UUID
+	// Interval is the minimum time between two polls of the device.
+	Interval  time.Duration
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+type CreateOAuthDeviceAuthorizationParams struct {
+	ClientID uuid.UUID
+	Scope    []string
+}
+
+// OAuthDeviceAuthorizationStore manages the requests of the device authorization grant. Only hashes of device codes
+// are stored. User codes are short enough to be typed, so callers answering them should be rate limited.
+type OAuthDeviceAuthorizationStore interface {
+	// Create starts an authorization and returns it along with its device code, which cannot be retrieved later.
+	Create(ctx context.Context, params CreateOAuthDeviceAuthorizationParams) (OAuthDeviceAuthorization, string, error)
+	// Get returns the pending authorization of the user code, ignoring case and separators. It returns [ErrNotFound]
+	// if it does not exist, expired or was answered already.
+	Get(ctx context.Context, userCode string) (OAuthDeviceAuthorization, error)
+	// Approve answers the pending authorization of the user code on behalf of the user and returns it. The tokens of
+	// the device are bound to the session, which may be [uuid.Nil]. Each authorization can be answered once.
+	Approve(ctx context.Context, userCode string, userID, sessionID uuid.UUID) (OAuthDeviceAuthorization, error)
+	// Deny answers the pending authorization of the user code with a refusal and returns it.
+	Deny(ctx context.Context, userCode string) (OAuthDeviceAuthorization, error)
+	// Poll returns the approved authorization of the device code issued to the client, which can be redeemed once. It
+	// returns [ErrAuthorizationPending] or [ErrSlowDown] while it is pending, [ErrAuthorizationDenied] once if it was
+	// denied and [ErrAuthorizationExpired] if it expired. It returns [ErrInvalidToken] if the device code does not
+	// exist, was issued to another client or was redeemed already.
+	Poll(ctx context.Context, clientID uuid.UUID, deviceCode string) (OAuthDeviceAuthorization, error)
+}
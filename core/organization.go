@@ -0,0 +1,122 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Organization is a tenant which users are members of. Its slug identifies it in URLs and is unique ignoring case.
+type Organization struct {
+	ID        uuid.UUID
+	Slug      string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type CreateOrganizationParams struct {
+	Slug string
+	Name string
+}
+
+// UpdateOrganizationParams holds fields to update. Nil fields are left unchanged.
+type UpdateOrganizationParams struct {
+	Slug *string
+	Name *string
+}
+
+// OrganizationRole is the role of a member in an [Organization].
+type OrganizationRole string
+
+const (
+	// OrganizationRoleOwner manages the organization, including its owners and deleting it.
+	OrganizationRoleOwner OrganizationRole = "owner"
+	// OrganizationRoleAdmin manages the organization, its members and invitations except owners.
+	OrganizationRoleAdmin OrganizationRole = "admin"
+	// OrganizationRoleMember can view the organization and its members.
+	OrganizationRoleMember OrganizationRole = "member"
+)
+
+// OrganizationMembership makes a user a member of an organization with a role.
+type OrganizationMembership struct {
+	OrgID     uuid.UUID
+	UserID    uuid.UUID
+	Role      OrganizationRole
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ListMembershipsParams struct {
+	OrgID  uuid.UUID
+	Cursor string // Opaque cursor returned as [MembershipPage.NextCursor].
+	Limit  int
+}
+
+// MembershipPage is a single page of memberships ordered by user id. NextCursor is empty on the last page.
+type MembershipPage struct {
+	Memberships []OrganizationMembership
+	NextCursor  string
+}
+
+// OrganizationInvitation invites the owner of an email address to join an organization with a role. It is answered
+// with a token sent to that address.
+type OrganizationInvitation struct {
+	ID        uuid.UUID
+	OrgID     uuid.UUID
+	Email     string
+	Role      OrganizationRole
+	InviterID uuid.UUID // The user who invited, [uuid.Nil] if the user was deleted.
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+type InviteParams struct {
+	OrgID     uuid.UUID
+	Email     string
+	Role      OrganizationRole
+	InviterID uuid.UUID
+}
+
+// OrganizationStore manages organizations, their memberships and invitations. Every organization keeps at least one
+// owner: methods which would remove the last owner return [ErrInvalidArgument]. Only hashes of invitation tokens are
+// stored.
+type OrganizationStore interface {
+	// Create creates an organization with owner as its first owner. It returns [ErrAlreadyExists] if the slug is
+	// taken.
+	Create(ctx context.Context, params CreateOrganizationParams, owner uuid.UUID) (Organization, OrganizationMembership, error)
+	Get(ctx context.Context, id uuid.UUID) (Organization, error)
+	GetBySlug(ctx context.Context, slug string) (Organization, error)
+	// ListByUser lists the organizations the user is a member of, ordered by id.
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]Organization, error)
+	// Update returns [ErrAlreadyExists] if a new slug is taken.
+	Update(ctx context.Context, id uuid.UUID, params UpdateOrganizationParams) (Organization, error)
+	// Delete deletes the organization along with its memberships and invitations.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	GetMembership(ctx context.Context, orgID, userID uuid.UUID) (OrganizationMembership, error)
+	ListMemberships(ctx context.Context, params ListMembershipsParams) (MembershipPage, error)
+	UpdateMembershipRole(ctx context.Context, orgID, userID uuid.UUID, role OrganizationRole) (OrganizationMembership, error)
+	RemoveMembership(ctx context.Context, orgID, userID uuid.UUID) error
+	// TransferOwnership promotes the member to to an owner and demotes the owner from to an admin atomically. It
+	// returns [ErrInvalidArgument] if from is not an owner and [ErrNotFound] if to is not a member.
+	TransferOwnership(ctx context.Context, orgID, from, to uuid.UUID) error
+
+	// Invite creates an invitation and returns it with the link to send to its email. The link embeds the token which
+	// answers the invitation. Inviting an email again replaces its pending invitation.
+	Invite(ctx context.Context, params InviteParams) (OrganizationInvitation, string, error)
+	// GetInvitation returns the pending invitation of token. It returns [ErrInvalidToken] if the invitation does not
+	// exist, expired or was answered.
+	GetInvitation(ctx context.Context, token string) (OrganizationInvitation, error)
+	// ListInvitations lists the pending invitations of the organization ordered by id.
+	ListInvitations(ctx context.Context, orgID uuid.UUID) ([]OrganizationInvitation, error)
+	RevokeInvitation(ctx context.Context, orgID, id uuid.UUID) error
+	// AcceptInvitation makes the user a member with the role of the invitation of token. It returns
+	// [ErrInvalidToken] if there is no pending invitation of token for email and [ErrAlreadyExists] if the user is a
+	// member already.
+	AcceptInvitation(ctx context.Context, token string, userID uuid.UUID, email string) (OrganizationMembership, error)
+	// DeclineInvitation deletes the invitation of token. It returns [ErrInvalidToken] like
+	// [OrganizationStore.AcceptInvitation].
+	DeclineInvitation(ctx context.Context, token, email string) error
+}
@@ -0,0 +1,54 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Passkey is a WebAuthn credential a user signs in with.
+type Passkey struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	CredentialID []byte
+	Name         string
+	// AttestationFormat is the attestation statement format the passkey was registered with, "none" or "packed".
+	AttestationFormat string
+	AAGUID            uuid.UUID // Identifies the authenticator model. Zero for "none" attestation.
+	SignCount         uint32
+	Transports        []string
+	BackupEligible    bool // Whether the passkey can be synced to other devices.
+	BackupState       bool // Whether the passkey is currently synced to other devices.
+	CreatedAt         time.Time
+	LastUsedAt        *time.Time
+}
+
+// PasskeyCeremony is a pending WebAuthn registration or authentication ceremony.
+type PasskeyCeremony struct {
+	ID uuid.UUID
+	// Options is the JSON encoded argument of navigator.credentials.create() or navigator.credentials.get().
+	Options   []byte
+	ExpiresAt time.Time
+}
+
+// PasskeyStore manages passkeys of users and runs the WebAuthn ceremonies to register and authenticate them. Each
+// ceremony can be finished only once.
+type PasskeyStore interface {
+	// BeginRegistration starts registration of a discoverable passkey for the user.
+	BeginRegistration(ctx context.Context, user User) (PasskeyCeremony, error)
+	// FinishRegistration verifies response, the JSON encoded PublicKeyCredential created by the authenticator, and
+	// stores the passkey as name. It returns [ErrInvalidToken] if the ceremony does not exist, expired or belongs to
+	// another user, [ErrInvalidArgument] if response is malformed and [ErrInvalidCredentials] if it fails verification.
+	FinishRegistration(ctx context.Context, userID, ceremonyID uuid.UUID, response []byte, name string) (Passkey, error)
+	// BeginLogin starts authentication with a passkey of the user. If userID is [uuid.Nil] or the user has no passkeys,
+	// any discoverable passkey is accepted.
+	BeginLogin(ctx context.Context, userID uuid.UUID) (PasskeyCeremony, error)
+	// FinishLogin verifies response, the JSON encoded PublicKeyCredential asserted by the authenticator, and returns
+	// the passkey used. It returns [ErrInvalidToken] if the ceremony does not exist or expired, [ErrInvalidArgument] if
+	// response is malformed and [ErrInvalidCredentials] if it fails verification or the sign count indicates a cloned
+	// authenticator.
+	FinishLogin(ctx context.Context, ceremonyID uuid.UUID, response []byte) (Passkey, error)
+	List(ctx context.Context, userID uuid.UUID) ([]Passkey, error)
+	Delete(ctx context.Context, userID, id uuid.UUID) error
+}
@@ -0,0 +1,84 @@
+package core
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// PasswordHasher hashes and verifies passwords.
+type PasswordHasher interface {
+	// Hash hashes password with the preferred algorithm and parameters.
+	Hash(password string) (string, error)
+	// Verify reports whether password matches encoded. needsRehash reports whether encoded was produced with an
+	// algorithm or parameters other than the preferred ones and should be replaced by a fresh [PasswordHasher.Hash].
+	Verify(password string, encoded string) (ok bool, needsRehash bool, err error)
+}
+
+// PasswordStore manages password credentials of users.
+type PasswordStore interface {
+	// Set sets or replaces the password of the user after validating it against the [PasswordPolicy] and the
+	// password history of the user.
+	Set(ctx context.Context, userID uuid.UUID, password string) error
+	// Check validates password like Set without storing it, so it can be rejected before irreversible steps like
+	// using up a password reset.
+	Check(ctx context.Context, userID uuid.UUID, password string) error
+	// Verify returns [ErrInvalidCredentials] if password does not match the password of the user. Hashes with
+	// outdated parameters are transparently upgraded on success.
+	Verify(ctx context.Context, userID uuid.UUID, password string) error
+	Delete(ctx context.Context, userID uuid.UUID) error
+}
+
+// PasswordViolationCode is a machine-readable code of a violated password policy rule.
+type PasswordViolationCode string
+
+const (
+	PasswordTooShort          PasswordViolationCode = "password_too_short"
+	PasswordTooLong           PasswordViolationCode = "password_too_long"
+	PasswordMissingUppercase  PasswordViolationCode = "password_missing_uppercase"
+	PasswordMissingLowercase  PasswordViolationCode = "password_missing_lowercase"
+	PasswordMissingDigit      PasswordViolationCode = "password_missing_digit"
+	PasswordMissingSymbol     PasswordViolationCode = "password_missing_symbol"
+	PasswordRepeatedChars     PasswordViolationCode = "password_repeated_characters"
+	PasswordSequentialChars   PasswordViolationCode = "password_sequential_characters"
+	PasswordContainsUserInfo  PasswordViolationCode = "password_contains_user_info"
+	PasswordReused            PasswordViolationCode = "password_reused"
+	PasswordBreached          PasswordViolationCode = "password_breached"
+	PasswordInvalidCharacters PasswordViolationCode = "password_invalid_characters"
+)
+
+// PasswordViolation describes a single violated password policy rule.
+type PasswordViolation struct {
+	Code    PasswordViolationCode
+	Message string
+}
+
+// PasswordPolicyError is returned when a password violates one or more rules of the password policy.
+//
+// It matches [ErrInvalidArgument] with [errors.Is].
+type PasswordPolicyError struct {
+	Violations []PasswordViolation
+}
+
+// Error implements [error].
+func (e *PasswordPolicyError) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		msgs = append(msgs, v.Message)
+	}
+	return "core: password policy violated: " + strings.Join(msgs, "; ")
+}
+
+// Unwrap returns [ErrInvalidArgument].
+func (e *PasswordPolicyError) Unwrap() error {
+	return ErrInvalidArgument
+}
+
+// PasswordPolicy validates passwords against the configured rules.
+type PasswordPolicy interface {
+	// Validate returns a [*PasswordPolicyError] listing every rule violated by password. The user is used for
+	// context-specific rules and may be partially filled, e.g. before the user is created. Password history is
+	// checked by [PasswordStore.Set] and [PasswordStore.Check] instead.
+	Validate(ctx context.Context, password string, user User) error
+}
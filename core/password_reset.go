@@ -0,0 +1,30 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PasswordReset is a pending reset of the password of a user. It is completed with a token sent to the email address
+// of the user.
+type PasswordReset struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// PasswordResetStore manages single-use [PasswordReset]s. Only hashes of tokens are stored. Creating a reset replaces
+// pending resets of the user.
+type PasswordResetStore interface {
+	// Create creates a reset for the user and returns it with the link to send to the user. The link embeds the token
+	// which completes the reset. It returns [ErrRateLimited] if a reset was created for the user recently.
+	Create(ctx context.Context, userID uuid.UUID) (PasswordReset, string, error)
+	// Get returns the pending reset of token. It returns [ErrInvalidToken] if the reset does not exist, expired or was
+	// used.
+	Get(ctx context.Context, token string) (PasswordReset, error)
+	// Complete marks the reset used. It returns [ErrInvalidToken] if the reset expired or was used in the meantime.
+	Complete(ctx context.Context, id uuid.UUID) error
+}
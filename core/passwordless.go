@@ -0,0 +1,51 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PasswordlessMethod is a way to sign in without a password by proving control of the email address of a user.
+type PasswordlessMethod string
+
+const (
+	PasswordlessMagicLink PasswordlessMethod = "magic_link"
+	PasswordlessEmailOTP  PasswordlessMethod = "email_otp"
+)
+
+// PasswordlessChallenge is a pending passwordless sign in. It is completed with a secret sent to the email address of
+// the user.
+type PasswordlessChallenge struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Method    PasswordlessMethod
+	Device    string
+	Attempts  int
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// PasswordlessStore manages single-use [PasswordlessChallenge]s. Only hashes of tokens and codes are stored. Creating
+// a challenge replaces pending challenges of the same method of the user.
+type PasswordlessStore interface {
+	// CreateMagicLink creates a magic link challenge for the user and returns it with the link to send to the user.
+	// The link embeds the token which completes the challenge. It returns [ErrRateLimited] if a magic link was
+	// created for the user recently.
+	CreateMagicLink(ctx context.Context, userID uuid.UUID, device string) (PasswordlessChallenge, string, error)
+	// NewEmailOTP returns a one-time code challenge for the user with its token, which identifies the challenge, and
+	// the 6 digit code to send to the user. The challenge is not stored, so its token can be answered before it is
+	// known whether the user exists. It can be completed once it was stored with CreateEmailOTP.
+	NewEmailOTP(userID uuid.UUID, device string) (PasswordlessChallenge, string, string)
+	// CreateEmailOTP stores the challenge returned by NewEmailOTP along with its token and code. It returns
+	// [ErrRateLimited] if a one-time code was created for the user recently.
+	CreateEmailOTP(ctx context.Context, c PasswordlessChallenge, token, code string) error
+	// VerifyMagicLink completes the magic link challenge of token. It returns [ErrInvalidToken] if the challenge does
+	// not exist, expired or was completed.
+	VerifyMagicLink(ctx context.Context, token string) (PasswordlessChallenge, error)
+	// VerifyEmailOTP completes the one-time code challenge of token if code matches. Every attempt counts towards the
+	// attempt limit. It returns [ErrInvalidCredentials] if code does not match and [ErrInvalidToken] if the challenge
+	// does not exist, expired, was completed or ran out of attempts.
+	VerifyEmailOTP(ctx context.Context, token, code string) (PasswordlessChallenge, error)
+}
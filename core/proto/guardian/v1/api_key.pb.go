@@ -0,0 +1,1353 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: guardian/v1/api_key.proto
+
+package guardianv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// APIKeyOwnerType is the kind of principal an API key acts on behalf of.
+type APIKeyOwnerType int32
+
+const (
+	APIKeyOwnerType_API_KEY_OWNER_TYPE_UNSPECIFIED     APIKeyOwnerType = 0
+	APIKeyOwnerType_API_KEY_OWNER_TYPE_USER            APIKeyOwnerType = 1
+	APIKeyOwnerType_API_KEY_OWNER_TYPE_ORGANIZATION    APIKeyOwnerType = 2
+	APIKeyOwnerType_API_KEY_OWNER_TYPE_SERVICE_ACCOUNT APIKeyOwnerType = 3
+)
+
+// Enum value maps for APIKeyOwnerType.
+var (
+	APIKeyOwnerType_name = map[int32]string{
+		0: "API_KEY_OWNER_TYPE_UNSPECIFIED",
+		1: "API_KEY_OWNER_TYPE_USER",
+		2: "API_KEY_OWNER_TYPE_ORGANIZATION",
+		3: "API_KEY_OWNER_TYPE_SERVICE_ACCOUNT",
+	}
+	APIKeyOwnerType_value = map[string]int32{
+		"API_KEY_OWNER_TYPE_UNSPECIFIED":     0,
+		"API_KEY_OWNER_TYPE_USER":            1,
+		"API_KEY_OWNER_TYPE_ORGANIZATION":    2,
+		"API_KEY_OWNER_TYPE_SERVICE_ACCOUNT": 3,
+	}
+)
+
+func (x APIKeyOwnerType) Enum() *APIKeyOwnerType {
+	p := new(APIKeyOwnerType)
+	*p = x
+	return p
+}
+
+func (x APIKeyOwnerType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (APIKeyOwnerType) Descriptor() protoreflect.EnumDescriptor {
+	return file_guardian_v1_api_key_proto_enumTypes[0].Descriptor()
+}
+
+func (APIKeyOwnerType) Type() protoreflect.EnumType {
+	return &file_guardian_v1_api_key_proto_enumTypes[0]
+}
+
+func (x APIKeyOwnerType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// APIKey is a long-lived credential presented as `gdn_<prefix>_<secret>`.
+type APIKey struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id                string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Name              string                 `protobuf:"bytes,2,opt,name=name,proto3"`
+	xxx_hidden_Prefix            string                 `protobuf:"bytes,3,opt,name=prefix,proto3"`
+	xxx_hidden_OwnerType         APIKeyOwnerType        `protobuf:"varint,4,opt,name=owner_type,json=ownerType,proto3,enum=guardian.v1.APIKeyOwnerType"`
+	xxx_hidden_OwnerId           string                 `protobuf:"bytes,5,opt,name=owner_id,json=ownerId,proto3"`
+	xxx_hidden_Scopes            []string               `protobuf:"bytes,6,rep,name=scopes,proto3"`
+	xxx_hidden_CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3"`
+	xxx_hidden_ExpiresAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3"`
+	xxx_hidden_LastUsedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_used_at,json=lastUsedAt,proto3"`
+	xxx_hidden_RotatedAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=rotated_at,json=rotatedAt,proto3"`
+	xxx_hidden_PreviousExpiresAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=previous_expires_at,json=previousExpiresAt,proto3"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	mi := &file_guardian_v1_api_key_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKey) ProtoMessage() {}
+
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_api_key_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *APIKey) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *APIKey) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *APIKey) GetPrefix() string {
+	if x != nil {
+		return x.xxx_hidden_Prefix
+	}
+	return ""
+}
+
+func (x *APIKey) GetOwnerType() APIKeyOwnerType {
+	if x != nil {
+		return x.xxx_hidden_OwnerType
+	}
+	return APIKeyOwnerType_API_KEY_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *APIKey) GetOwnerId() string {
+	if x != nil {
+		return x.xxx_hidden_OwnerId
+	}
+	return ""
+}
+
+func (x *APIKey) GetScopes() []string {
+	if x != nil {
+		return x.xxx_hidden_Scopes
+	}
+	return nil
+}
+
+func (x *APIKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ExpiresAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_LastUsedAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetRotatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_RotatedAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetPreviousExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_PreviousExpiresAt
+	}
+	return nil
+}
+
+func (x *APIKey) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *APIKey) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *APIKey) SetPrefix(v string) {
+	x.xxx_hidden_Prefix = v
+}
+
+func (x *APIKey) SetOwnerType(v APIKeyOwnerType) {
+	x.xxx_hidden_OwnerType = v
+}
+
+func (x *APIKey) SetOwnerId(v string) {
+	x.xxx_hidden_OwnerId = v
+}
+
+func (x *APIKey) SetScopes(v []string) {
+	x.xxx_hidden_Scopes = v
+}
+
+func (x *APIKey) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *APIKey) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ExpiresAt = v
+}
+
+func (x *APIKey) SetLastUsedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_LastUsedAt = v
+}
+
+func (x *APIKey) SetRotatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_RotatedAt = v
+}
+
+func (x *APIKey) SetPreviousExpiresAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_PreviousExpiresAt = v
+}
+
+func (x *APIKey) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *APIKey) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExpiresAt != nil
+}
+
+func (x *APIKey) HasLastUsedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_LastUsedAt != nil
+}
+
+func (x *APIKey) HasRotatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_RotatedAt != nil
+}
+
+func (x *APIKey) HasPreviousExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_PreviousExpiresAt != nil
+}
+
+func (x *APIKey) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *APIKey) ClearExpiresAt() {
+	x.xxx_hidden_ExpiresAt = nil
+}
+
+func (x *APIKey) ClearLastUsedAt() {
+	x.xxx_hidden_LastUsedAt = nil
+}
+
+func (x *APIKey) ClearRotatedAt() {
+	x.xxx_hidden_RotatedAt = nil
+}
+
+func (x *APIKey) ClearPreviousExpiresAt() {
+	x.xxx_hidden_PreviousExpiresAt = nil
+}
+
+type APIKey_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id   string
+	Name string
+	// Identifies the key, including its `gdn_` part. It may be shown to users.
+	Prefix    string
+	OwnerType APIKeyOwnerType
+	OwnerId   string
+	// Permissions granted to the key, sorted by name.
+	Scopes    []string
+	CreatedAt *timestamppb.Timestamp
+	// Unset if the key does not expire.
+	ExpiresAt *timestamppb.Timestamp
+	// Unset if the key was never used. Uses are recorded in batches, so it lags behind.
+	LastUsedAt *timestamppb.Timestamp
+	RotatedAt  *timestamppb.Timestamp
+	// The time until which the token replaced by the last rotation stays valid, unset if it is not valid anymore.
+	PreviousExpiresAt *timestamppb.Timestamp
+}
+
+func (b0 APIKey_builder) Build() *APIKey {
+	m0 := &APIKey{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_Prefix = b.Prefix
+	x.xxx_hidden_OwnerType = b.OwnerType
+	x.xxx_hidden_OwnerId = b.OwnerId
+	x.xxx_hidden_Scopes = b.Scopes
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_ExpiresAt = b.ExpiresAt
+	x.xxx_hidden_LastUsedAt = b.LastUsedAt
+	x.xxx_hidden_RotatedAt = b.RotatedAt
+	x.xxx_hidden_PreviousExpiresAt = b.PreviousExpiresAt
+	return m0
+}
+
+type CreateAPIKeyRequest struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name      string                 `protobuf:"bytes,1,opt,name=name,proto3"`
+	xxx_hidden_OwnerType APIKeyOwnerType        `protobuf:"varint,2,opt,name=owner_type,json=ownerType,proto3,enum=guardian.v1.APIKeyOwnerType"`
+	xxx_hidden_OwnerId   string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3"`
+	xxx_hidden_Scopes    []string               `protobuf:"bytes,4,rep,name=scopes,proto3"`
+	xxx_hidden_ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	mi := &file_guardian_v1_api_key_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_api_key_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateAPIKeyRequest) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetOwnerType() APIKeyOwnerType {
+	if x != nil {
+		return x.xxx_hidden_OwnerType
+	}
+	return APIKeyOwnerType_API_KEY_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *CreateAPIKeyRequest) GetOwnerId() string {
+	if x != nil {
+		return x.xxx_hidden_OwnerId
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.xxx_hidden_Scopes
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyRequest) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *CreateAPIKeyRequest) SetOwnerType(v APIKeyOwnerType) {
+	x.xxx_hidden_OwnerType = v
+}
+
+func (x *CreateAPIKeyRequest) SetOwnerId(v string) {
+	x.xxx_hidden_OwnerId = v
+}
+
+func (x *CreateAPIKeyRequest) SetScopes(v []string) {
+	x.xxx_hidden_Scopes = v
+}
+
+func (x *CreateAPIKeyRequest) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ExpiresAt = v
+}
+
+func (x *CreateAPIKeyRequest) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExpiresAt != nil
+}
+
+func (x *CreateAPIKeyRequest) ClearExpiresAt() {
+	x.xxx_hidden_ExpiresAt = nil
+}
+
+type CreateAPIKeyRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name string
+	// Defaults to API_KEY_OWNER_TYPE_USER.
+	OwnerType APIKeyOwnerType
+	// Defaults to the caller for keys of users.
+	OwnerId string
+	Scopes  []string
+	// Unset if the key does not expire.
+	ExpiresAt *timestamppb.Timestamp
+}
+
+func (b0 CreateAPIKeyRequest_builder) Build() *CreateAPIKeyRequest {
+	m0 := &CreateAPIKeyRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_OwnerType = b.OwnerType
+	x.xxx_hidden_OwnerId = b.OwnerId
+	x.xxx_hidden_Scopes = b.Scopes
+	x.xxx_hidden_ExpiresAt = b.ExpiresAt
+	return m0
+}
+
+type CreateAPIKeyResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ApiKey *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3"`
+	xxx_hidden_Token  string                 `protobuf:"bytes,2,opt,name=token,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyResponse) Reset() {
+	*x = CreateAPIKeyResponse{}
+	mi := &file_guardian_v1_api_key_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_api_key_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.xxx_hidden_ApiKey
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyResponse) GetToken() string {
+	if x != nil {
+		return x.xxx_hidden_Token
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyResponse) SetApiKey(v *APIKey) {
+	x.xxx_hidden_ApiKey = v
+}
+
+func (x *CreateAPIKeyResponse) SetToken(v string) {
+	x.xxx_hidden_Token = v
+}
+
+func (x *CreateAPIKeyResponse) HasApiKey() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ApiKey != nil
+}
+
+func (x *CreateAPIKeyResponse) ClearApiKey() {
+	x.xxx_hidden_ApiKey = nil
+}
+
+type CreateAPIKeyResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ApiKey *APIKey
+	Token  string
+}
+
+func (b0 CreateAPIKeyResponse_builder) Build() *CreateAPIKeyResponse {
+	m0 := &CreateAPIKeyResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ApiKey = b.ApiKey
+	x.xxx_hidden_Token = b.Token
+	return m0
+}
+
+type GetAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAPIKeyRequest) Reset() {
+	*x = GetAPIKeyRequest{}
+	mi := &file_guardian_v1_api_key_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAPIKeyRequest) ProtoMessage() {}
+
+func (x *GetAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_api_key_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetAPIKeyRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *GetAPIKeyRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type GetAPIKeyRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 GetAPIKeyRequest_builder) Build() *GetAPIKeyRequest {
+	m0 := &GetAPIKeyRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type GetAPIKeyResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ApiKey *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetAPIKeyResponse) Reset() {
+	*x = GetAPIKeyResponse{}
+	mi := &file_guardian_v1_api_key_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAPIKeyResponse) ProtoMessage() {}
+
+func (x *GetAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_api_key_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.xxx_hidden_ApiKey
+	}
+	return nil
+}
+
+func (x *GetAPIKeyResponse) SetApiKey(v *APIKey) {
+	x.xxx_hidden_ApiKey = v
+}
+
+func (x *GetAPIKeyResponse) HasApiKey() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ApiKey != nil
+}
+
+func (x *GetAPIKeyResponse) ClearApiKey() {
+	x.xxx_hidden_ApiKey = nil
+}
+
+type GetAPIKeyResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ApiKey *APIKey
+}
+
+func (b0 GetAPIKeyResponse_builder) Build() *GetAPIKeyResponse {
+	m0 := &GetAPIKeyResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ApiKey = b.ApiKey
+	return m0
+}
+
+type ListAPIKeysRequest struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OwnerType APIKeyOwnerType        `protobuf:"varint,1,opt,name=owner_type,json=ownerType,proto3,enum=guardian.v1.APIKeyOwnerType"`
+	xxx_hidden_OwnerId   string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysRequest) Reset() {
+	*x = ListAPIKeysRequest{}
+	mi := &file_guardian_v1_api_key_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysRequest) ProtoMessage() {}
+
+func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_api_key_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListAPIKeysRequest) GetOwnerType() APIKeyOwnerType {
+	if x != nil {
+		return x.xxx_hidden_OwnerType
+	}
+	return APIKeyOwnerType_API_KEY_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *ListAPIKeysRequest) GetOwnerId() string {
+	if x != nil {
+		return x.xxx_hidden_OwnerId
+	}
+	return ""
+}
+
+func (x *ListAPIKeysRequest) SetOwnerType(v APIKeyOwnerType) {
+	x.xxx_hidden_OwnerType = v
+}
+
+func (x *ListAPIKeysRequest) SetOwnerId(v string) {
+	x.xxx_hidden_OwnerId = v
+}
+
+type ListAPIKeysRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Defaults to API_KEY_OWNER_TYPE_USER.
+	OwnerType APIKeyOwnerType
+	// Defaults to the caller for keys of users.
+	OwnerId string
+}
+
+func (b0 ListAPIKeysRequest_builder) Build() *ListAPIKeysRequest {
+	m0 := &ListAPIKeysRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_OwnerType = b.OwnerType
+	x.xxx_hidden_OwnerId = b.OwnerId
+	return m0
+}
+
+type ListAPIKeysResponse struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ApiKeys *[]*APIKey             `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysResponse) Reset() {
+	*x = ListAPIKeysResponse{}
+	mi := &file_guardian_v1_api_key_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysResponse) ProtoMessage() {}
+
+func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_api_key_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListAPIKeysResponse) GetApiKeys() []*APIKey {
+	if x != nil {
+		if x.xxx_hidden_ApiKeys != nil {
+			return *x.xxx_hidden_ApiKeys
+		}
+	}
+	return nil
+}
+
+func (x *ListAPIKeysResponse) SetApiKeys(v []*APIKey) {
+	x.xxx_hidden_ApiKeys = &v
+}
+
+type ListAPIKeysResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ApiKeys []*APIKey
+}
+
+func (b0 ListAPIKeysResponse_builder) Build() *ListAPIKeysResponse {
+	m0 := &ListAPIKeysResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ApiKeys = &b.ApiKeys
+	return m0
+}
+
+type RotateAPIKeyRequest struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id      string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Overlap *durationpb.Duration   `protobuf:"bytes,2,opt,name=overlap,proto3"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RotateAPIKeyRequest) Reset() {
+	*x = RotateAPIKeyRequest{}
+	mi := &file_guardian_v1_api_key_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAPIKeyRequest) ProtoMessage() {}
+
+func (x *RotateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_api_key_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RotateAPIKeyRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *RotateAPIKeyRequest) GetOverlap() *durationpb.Duration {
+	if x != nil {
+		return x.xxx_hidden_Overlap
+	}
+	return nil
+}
+
+func (x *RotateAPIKeyRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *RotateAPIKeyRequest) SetOverlap(v *durationpb.Duration) {
+	x.xxx_hidden_Overlap = v
+}
+
+func (x *RotateAPIKeyRequest) HasOverlap() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Overlap != nil
+}
+
+func (x *RotateAPIKeyRequest) ClearOverlap() {
+	x.xxx_hidden_Overlap = nil
+}
+
+type RotateAPIKeyRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+	// Duration for which the previous token stays valid. Zero invalidates it immediately, unset uses the configured
+	// default.
+	Overlap *durationpb.Duration
+}
+
+func (b0 RotateAPIKeyRequest_builder) Build() *RotateAPIKeyRequest {
+	m0 := &RotateAPIKeyRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_Overlap = b.Overlap
+	return m0
+}
+
+type RotateAPIKeyResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ApiKey *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3"`
+	xxx_hidden_Token  string                 `protobuf:"bytes,2,opt,name=token,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RotateAPIKeyResponse) Reset() {
+	*x = RotateAPIKeyResponse{}
+	mi := &file_guardian_v1_api_key_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAPIKeyResponse) ProtoMessage() {}
+
+func (x *RotateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_api_key_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RotateAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.xxx_hidden_ApiKey
+	}
+	return nil
+}
+
+func (x *RotateAPIKeyResponse) GetToken() string {
+	if x != nil {
+		return x.xxx_hidden_Token
+	}
+	return ""
+}
+
+func (x *RotateAPIKeyResponse) SetApiKey(v *APIKey) {
+	x.xxx_hidden_ApiKey = v
+}
+
+func (x *RotateAPIKeyResponse) SetToken(v string) {
+	x.xxx_hidden_Token = v
+}
+
+func (x *RotateAPIKeyResponse) HasApiKey() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ApiKey != nil
+}
+
+func (x *RotateAPIKeyResponse) ClearApiKey() {
+	x.xxx_hidden_ApiKey = nil
+}
+
+type RotateAPIKeyResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ApiKey *APIKey
+	Token  string
+}
+
+func (b0 RotateAPIKeyResponse_builder) Build() *RotateAPIKeyResponse {
+	m0 := &RotateAPIKeyResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ApiKey = b.ApiKey
+	x.xxx_hidden_Token = b.Token
+	return m0
+}
+
+type RevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	mi := &file_guardian_v1_api_key_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_api_key_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RevokeAPIKeyRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *RevokeAPIKeyRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type RevokeAPIKeyRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 RevokeAPIKeyRequest_builder) Build() *RevokeAPIKeyRequest {
+	m0 := &RevokeAPIKeyRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type RevokeAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyResponse) Reset() {
+	*x = RevokeAPIKeyResponse{}
+	mi := &file_guardian_v1_api_key_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyResponse) ProtoMessage() {}
+
+func (x *RevokeAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_api_key_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RevokeAPIKeyResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RevokeAPIKeyResponse_builder) Build() *RevokeAPIKeyResponse {
+	m0 := &RevokeAPIKeyResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type VerifyAPIKeyRequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Token  string                 `protobuf:"bytes,1,opt,name=token,proto3"`
+	xxx_hidden_Scopes []string               `protobuf:"bytes,2,rep,name=scopes,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *VerifyAPIKeyRequest) Reset() {
+	*x = VerifyAPIKeyRequest{}
+	mi := &file_guardian_v1_api_key_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAPIKeyRequest) ProtoMessage() {}
+
+func (x *VerifyAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_api_key_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VerifyAPIKeyRequest) GetToken() string {
+	if x != nil {
+		return x.xxx_hidden_Token
+	}
+	return ""
+}
+
+func (x *VerifyAPIKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.xxx_hidden_Scopes
+	}
+	return nil
+}
+
+func (x *VerifyAPIKeyRequest) SetToken(v string) {
+	x.xxx_hidden_Token = v
+}
+
+func (x *VerifyAPIKeyRequest) SetScopes(v []string) {
+	x.xxx_hidden_Scopes = v
+}
+
+type VerifyAPIKeyRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Token string
+	// Scopes the key is required to have.
+	Scopes []string
+}
+
+func (b0 VerifyAPIKeyRequest_builder) Build() *VerifyAPIKeyRequest {
+	m0 := &VerifyAPIKeyRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Token = b.Token
+	x.xxx_hidden_Scopes = b.Scopes
+	return m0
+}
+
+type VerifyAPIKeyResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ApiKey *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *VerifyAPIKeyResponse) Reset() {
+	*x = VerifyAPIKeyResponse{}
+	mi := &file_guardian_v1_api_key_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAPIKeyResponse) ProtoMessage() {}
+
+func (x *VerifyAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_api_key_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VerifyAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.xxx_hidden_ApiKey
+	}
+	return nil
+}
+
+func (x *VerifyAPIKeyResponse) SetApiKey(v *APIKey) {
+	x.xxx_hidden_ApiKey = v
+}
+
+func (x *VerifyAPIKeyResponse) HasApiKey() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ApiKey != nil
+}
+
+func (x *VerifyAPIKeyResponse) ClearApiKey() {
+	x.xxx_hidden_ApiKey = nil
+}
+
+type VerifyAPIKeyResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ApiKey *APIKey
+}
+
+func (b0 VerifyAPIKeyResponse_builder) Build() *VerifyAPIKeyResponse {
+	m0 := &VerifyAPIKeyResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ApiKey = b.ApiKey
+	return m0
+}
+
+var File_guardian_v1_api_key_proto protoreflect.FileDescriptor
+
+const file_guardian_v1_api_key_proto_rawDesc = "" +
+	"\n" +
+	"\x19guardian/v1/api_key.proto\x12\vguardian.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xef\x03\n" +
+	"\x06APIKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12;\n" +
+	"\n" +
+	"owner_type\x18\x04 \x01(\x0e2\x1c.guardian.v1.APIKeyOwnerTypeR\townerType\x12\x19\n" +
+	"\bowner_id\x18\x05 \x01(\tR\aownerId\x12\x16\n" +
+	"\x06scopes\x18\x06 \x03(\tR\x06scopes\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
+	"\flast_used_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x129\n" +
+	"\n" +
+	"rotated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\trotatedAt\x12J\n" +
+	"\x13previous_expires_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x11previousExpiresAt\"\xd4\x01\n" +
+	"\x13CreateAPIKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12;\n" +
+	"\n" +
+	"owner_type\x18\x02 \x01(\x0e2\x1c.guardian.v1.APIKeyOwnerTypeR\townerType\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"Z\n" +
+	"\x14CreateAPIKeyResponse\x12,\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x13.guardian.v1.APIKeyR\x06apiKey\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\"\n" +
+	"\x10GetAPIKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"A\n" +
+	"\x11GetAPIKeyResponse\x12,\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x13.guardian.v1.APIKeyR\x06apiKey\"l\n" +
+	"\x12ListAPIKeysRequest\x12;\n" +
+	"\n" +
+	"owner_type\x18\x01 \x01(\x0e2\x1c.guardian.v1.APIKeyOwnerTypeR\townerType\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\"E\n" +
+	"\x13ListAPIKeysResponse\x12.\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\x13.guardian.v1.APIKeyR\aapiKeys\"Z\n" +
+	"\x13RotateAPIKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x123\n" +
+	"\aoverlap\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\aoverlap\"Z\n" +
+	"\x14RotateAPIKeyResponse\x12,\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x13.guardian.v1.APIKeyR\x06apiKey\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"%\n" +
+	"\x13RevokeAPIKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x16\n" +
+	"\x14RevokeAPIKeyResponse\"C\n" +
+	"\x13VerifyAPIKeyRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x16\n" +
+	"\x06scopes\x18\x02 \x03(\tR\x06scopes\"D\n" +
+	"\x14VerifyAPIKeyResponse\x12,\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x13.guardian.v1.APIKeyR\x06apiKey*\x9f\x01\n" +
+	"\x0fAPIKeyOwnerType\x12\"\n" +
+	"\x1eAPI_KEY_OWNER_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17API_KEY_OWNER_TYPE_USER\x10\x01\x12#\n" +
+	"\x1fAPI_KEY_OWNER_TYPE_ORGANIZATION\x10\x02\x12&\n" +
+	"\"API_KEY_OWNER_TYPE_SERVICE_ACCOUNT\x10\x032\x81\x04\n" +
+	"\rAPIKeyService\x12S\n" +
+	"\fCreateAPIKey\x12 .guardian.v1.CreateAPIKeyRequest\x1a!.guardian.v1.CreateAPIKeyResponse\x12J\n" +
+	"\tGetAPIKey\x12\x1d.guardian.v1.GetAPIKeyRequest\x1a\x1e.guardian.v1.GetAPIKeyResponse\x12P\n" +
+	"\vListAPIKeys\x12\x1f.guardian.v1.ListAPIKeysRequest\x1a .guardian.v1.ListAPIKeysResponse\x12S\n" +
+	"\fRotateAPIKey\x12 .guardian.v1.RotateAPIKeyRequest\x1a!.guardian.v1.RotateAPIKeyResponse\x12S\n" +
+	"\fRevokeAPIKey\x12 .guardian.v1.RevokeAPIKeyRequest\x1a!.guardian.v1.RevokeAPIKeyResponse\x12S\n" +
+	"\fVerifyAPIKey\x12 .guardian.v1.VerifyAPIKeyRequest\x1a!.guardian.v1.VerifyAPIKeyResponseB\xab\x01\n" +
+	"\x0fcom.guardian.v1B\fApi_keyProtoP\x01Z=github.com/gophero/guardian/core/proto/guardian/v1;guardianv1\xa2\x02\x03GVX\xaa\x02\vGuardian.V1\xca\x02\vGuardian\\V1\xe2\x02\x17Guardian\\V1\\GPBMetadata\xea\x02\fGuardian::V1b\x06proto3"
+
+var file_guardian_v1_api_key_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_guardian_v1_api_key_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_guardian_v1_api_key_proto_goTypes = []any{
+	(APIKeyOwnerType)(0),          // 0: guardian.v1.APIKeyOwnerType
+	(*APIKey)(nil),                // 1: guardian.v1.APIKey
+	(*CreateAPIKeyRequest)(nil),   // 2: guardian.v1.CreateAPIKeyRequest
+	(*CreateAPIKeyResponse)(nil),  // 3: guardian.v1.CreateAPIKeyResponse
+	(*GetAPIKeyRequest)(nil),      // 4: guardian.v1.GetAPIKeyRequest
+	(*GetAPIKeyResponse)(nil),     // 5: guardian.v1.GetAPIKeyResponse
+	(*ListAPIKeysRequest)(nil),    // 6: guardian.v1.ListAPIKeysRequest
+	(*ListAPIKeysResponse)(nil),   // 7: guardian.v1.ListAPIKeysResponse
+	(*RotateAPIKeyRequest)(nil),   // 8: guardian.v1.RotateAPIKeyRequest
+	(*RotateAPIKeyResponse)(nil),  // 9: guardian.v1.RotateAPIKeyResponse
+	(*RevokeAPIKeyRequest)(nil),   // 10: guardian.v1.RevokeAPIKeyRequest
+	(*RevokeAPIKeyResponse)(nil),  // 11: guardian.v1.RevokeAPIKeyResponse
+	(*VerifyAPIKeyRequest)(nil),   // 12: guardian.v1.VerifyAPIKeyRequest
+	(*VerifyAPIKeyResponse)(nil),  // 13: guardian.v1.VerifyAPIKeyResponse
+	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 15: google.protobuf.Duration
+}
+var file_guardian_v1_api_key_proto_depIdxs = []int32{
+	0,  // 0: guardian.v1.APIKey.owner_type:type_name -> guardian.v1.APIKeyOwnerType
+	14, // 1: guardian.v1.APIKey.created_at:type_name -> google.protobuf.Timestamp
+	14, // 2: guardian.v1.APIKey.expires_at:type_name -> google.protobuf.Timestamp
+	14, // 3: guardian.v1.APIKey.last_used_at:type_name -> google.protobuf.Timestamp
+	14, // 4: guardian.v1.APIKey.rotated_at:type_name -> google.protobuf.Timestamp
+	14, // 5: guardian.v1.APIKey.previous_expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 6: guardian.v1.CreateAPIKeyRequest.owner_type:type_name -> guardian.v1.APIKeyOwnerType
+	14, // 7: guardian.v1.CreateAPIKeyRequest.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 8: guardian.v1.CreateAPIKeyResponse.api_key:type_name -> guardian.v1.APIKey
+	1,  // 9: guardian.v1.GetAPIKeyResponse.api_key:type_name -> guardian.v1.APIKey
+	0,  // 10: guardian.v1.ListAPIKeysRequest.owner_type:type_name -> guardian.v1.APIKeyOwnerType
+	1,  // 11: guardian.v1.ListAPIKeysResponse.api_keys:type_name -> guardian.v1.APIKey
+	15, // 12: guardian.v1.RotateAPIKeyRequest.overlap:type_name -> google.protobuf.Duration
+	1,  // 13: guardian.v1.RotateAPIKeyResponse.api_key:type_name -> guardian.v1.APIKey
+	1,  // 14: guardian.v1.VerifyAPIKeyResponse.api_key:type_name -> guardian.v1.APIKey
+	2,  // 15: guardian.v1.APIKeyService.CreateAPIKey:input_type -> guardian.v1.CreateAPIKeyRequest
+	4,  // 16: guardian.v1.APIKeyService.GetAPIKey:input_type -> guardian.v1.GetAPIKeyRequest
+	6,  // 17: guardian.v1.APIKeyService.ListAPIKeys:input_type -> guardian.v1.ListAPIKeysRequest
+	8,  // 18: guardian.v1.APIKeyService.RotateAPIKey:input_type -> guardian.v1.RotateAPIKeyRequest
+	10, // 19: guardian.v1.APIKeyService.RevokeAPIKey:input_type -> guardian.v1.RevokeAPIKeyRequest
+	12, // 20: guardian.v1.APIKeyService.VerifyAPIKey:input_type -> guardian.v1.VerifyAPIKeyRequest
+	3,  // 21: guardian.v1.APIKeyService.CreateAPIKey:output_type -> guardian.v1.CreateAPIKeyResponse
+	5,  // 22: guardian.v1.APIKeyService.GetAPIKey:output_type -> guardian.v1.GetAPIKeyResponse
+	7,  // 23: guardian.v1.APIKeyService.ListAPIKeys:output_type -> guardian.v1.ListAPIKeysResponse
+	9,  // 24: guardian.v1.APIKeyService.RotateAPIKey:output_type -> guardian.v1.RotateAPIKeyResponse
+	11, // 25: guardian.v1.APIKeyService.RevokeAPIKey:output_type -> guardian.v1.RevokeAPIKeyResponse
+	13, // 26: guardian.v1.APIKeyService.VerifyAPIKey:output_type -> guardian.v1.VerifyAPIKeyResponse
+	21, // [21:27] is the sub-list for method output_type
+	15, // [15:21] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_guardian_v1_api_key_proto_init() }
+func file_guardian_v1_api_key_proto_init() {
+	if File_guardian_v1_api_key_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guardian_v1_api_key_proto_rawDesc), len(file_guardian_v1_api_key_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_guardian_v1_api_key_proto_goTypes,
+		DependencyIndexes: file_guardian_v1_api_key_proto_depIdxs,
+		EnumInfos:         file_guardian_v1_api_key_proto_enumTypes,
+		MessageInfos:      file_guardian_v1_api_key_proto_msgTypes,
+	}.Build()
+	File_guardian_v1_api_key_proto = out.File
+	file_guardian_v1_api_key_proto_goTypes = nil
+	file_guardian_v1_api_key_proto_depIdxs = nil
+}
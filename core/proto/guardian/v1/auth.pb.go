@@ -0,0 +1,3511 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: guardian/v1/auth.proto
+
+package guardianv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MFAFactor is a kind of second factor.
+type MFAFactor int32
+
+const (
+	MFAFactor_MFA_FACTOR_UNSPECIFIED   MFAFactor = 0
+	MFAFactor_MFA_FACTOR_TOTP          MFAFactor = 1
+	MFAFactor_MFA_FACTOR_RECOVERY_CODE MFAFactor = 2
+)
+
+// Enum value maps for MFAFactor.
+var (
+	MFAFactor_name = map[int32]string{
+		0: "MFA_FACTOR_UNSPECIFIED",
+		1: "MFA_FACTOR_TOTP",
+		2: "MFA_FACTOR_RECOVERY_CODE",
+	}
+	MFAFactor_value = map[string]int32{
+		"MFA_FACTOR_UNSPECIFIED":   0,
+		"MFA_FACTOR_TOTP":          1,
+		"MFA_FACTOR_RECOVERY_CODE": 2,
+	}
+)
+
+func (x MFAFactor) Enum() *MFAFactor {
+	p := new(MFAFactor)
+	*p = x
+	return p
+}
+
+func (x MFAFactor) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MFAFactor) Descriptor() protoreflect.EnumDescriptor {
+	return file_guardian_v1_auth_proto_enumTypes[0].Descriptor()
+}
+
+func (MFAFactor) Type() protoreflect.EnumType {
+	return &file_guardian_v1_auth_proto_enumTypes[0]
+}
+
+func (x MFAFactor) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Session is a signed in device of a user.
+type Session struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_UserId      string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3"`
+	xxx_hidden_UserAgent   string                 `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3"`
+	xxx_hidden_IpAddress   string                 `protobuf:"bytes,4,opt,name=ip_address,json=ipAddress,proto3"`
+	xxx_hidden_Device      string                 `protobuf:"bytes,5,opt,name=device,proto3"`
+	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3"`
+	xxx_hidden_LastSeenAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_seen_at,json=lastSeenAt,proto3"`
+	xxx_hidden_ExpiresAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3"`
+	xxx_hidden_AuthMethods []string               `protobuf:"bytes,9,rep,name=auth_methods,json=authMethods,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Session) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *Session) GetUserId() string {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.xxx_hidden_UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetIpAddress() string {
+	if x != nil {
+		return x.xxx_hidden_IpAddress
+	}
+	return ""
+}
+
+func (x *Session) GetDevice() string {
+	if x != nil {
+		return x.xxx_hidden_Device
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *Session) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_LastSeenAt
+	}
+	return nil
+}
+
+func (x *Session) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ExpiresAt
+	}
+	return nil
+}
+
+func (x *Session) GetAuthMethods() []string {
+	if x != nil {
+		return x.xxx_hidden_AuthMethods
+	}
+	return nil
+}
+
+func (x *Session) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *Session) SetUserId(v string) {
+	x.xxx_hidden_UserId = v
+}
+
+func (x *Session) SetUserAgent(v string) {
+	x.xxx_hidden_UserAgent = v
+}
+
+func (x *Session) SetIpAddress(v string) {
+	x.xxx_hidden_IpAddress = v
+}
+
+func (x *Session) SetDevice(v string) {
+	x.xxx_hidden_Device = v
+}
+
+func (x *Session) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *Session) SetLastSeenAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_LastSeenAt = v
+}
+
+func (x *Session) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ExpiresAt = v
+}
+
+func (x *Session) SetAuthMethods(v []string) {
+	x.xxx_hidden_AuthMethods = v
+}
+
+func (x *Session) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *Session) HasLastSeenAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_LastSeenAt != nil
+}
+
+func (x *Session) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExpiresAt != nil
+}
+
+func (x *Session) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *Session) ClearLastSeenAt() {
+	x.xxx_hidden_LastSeenAt = nil
+}
+
+func (x *Session) ClearExpiresAt() {
+	x.xxx_hidden_ExpiresAt = nil
+}
+
+type Session_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id         string
+	UserId     string
+	UserAgent  string
+	IpAddress  string
+	Device     string
+	CreatedAt  *timestamppb.Timestamp
+	LastSeenAt *timestamppb.Timestamp
+	ExpiresAt  *timestamppb.Timestamp
+	// Authentication methods of RFC 8176 the user signed in with: `pwd`, `otp`, `hwk` and `mfa`.
+	AuthMethods []string
+}
+
+func (b0 Session_builder) Build() *Session {
+	m0 := &Session{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_UserId = b.UserId
+	x.xxx_hidden_UserAgent = b.UserAgent
+	x.xxx_hidden_IpAddress = b.IpAddress
+	x.xxx_hidden_Device = b.Device
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_LastSeenAt = b.LastSeenAt
+	x.xxx_hidden_ExpiresAt = b.ExpiresAt
+	x.xxx_hidden_AuthMethods = b.AuthMethods
+	return m0
+}
+
+// Tokens are the credentials of a session.
+type Tokens struct {
+	state                            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_AccessToken           string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3"`
+	xxx_hidden_AccessTokenExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=access_token_expires_at,json=accessTokenExpiresAt,proto3"`
+	xxx_hidden_RefreshToken          string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3"`
+	xxx_hidden_RefreshTokenExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=refresh_token_expires_at,json=refreshTokenExpiresAt,proto3"`
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *Tokens) Reset() {
+	*x = Tokens{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tokens) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tokens) ProtoMessage() {}
+
+func (x *Tokens) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Tokens) GetAccessToken() string {
+	if x != nil {
+		return x.xxx_hidden_AccessToken
+	}
+	return ""
+}
+
+func (x *Tokens) GetAccessTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_AccessTokenExpiresAt
+	}
+	return nil
+}
+
+func (x *Tokens) GetRefreshToken() string {
+	if x != nil {
+		return x.xxx_hidden_RefreshToken
+	}
+	return ""
+}
+
+func (x *Tokens) GetRefreshTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_RefreshTokenExpiresAt
+	}
+	return nil
+}
+
+func (x *Tokens) SetAccessToken(v string) {
+	x.xxx_hidden_AccessToken = v
+}
+
+func (x *Tokens) SetAccessTokenExpiresAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_AccessTokenExpiresAt = v
+}
+
+func (x *Tokens) SetRefreshToken(v string) {
+	x.xxx_hidden_RefreshToken = v
+}
+
+func (x *Tokens) SetRefreshTokenExpiresAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_RefreshTokenExpiresAt = v
+}
+
+func (x *Tokens) HasAccessTokenExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_AccessTokenExpiresAt != nil
+}
+
+func (x *Tokens) HasRefreshTokenExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_RefreshTokenExpiresAt != nil
+}
+
+func (x *Tokens) ClearAccessTokenExpiresAt() {
+	x.xxx_hidden_AccessTokenExpiresAt = nil
+}
+
+func (x *Tokens) ClearRefreshTokenExpiresAt() {
+	x.xxx_hidden_RefreshTokenExpiresAt = nil
+}
+
+type Tokens_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	AccessToken           string
+	AccessTokenExpiresAt  *timestamppb.Timestamp
+	RefreshToken          string
+	RefreshTokenExpiresAt *timestamppb.Timestamp
+}
+
+func (b0 Tokens_builder) Build() *Tokens {
+	m0 := &Tokens{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_AccessToken = b.AccessToken
+	x.xxx_hidden_AccessTokenExpiresAt = b.AccessTokenExpiresAt
+	x.xxx_hidden_RefreshToken = b.RefreshToken
+	x.xxx_hidden_RefreshTokenExpiresAt = b.RefreshTokenExpiresAt
+	return m0
+}
+
+// MFAChallenge is a sign in waiting for a second factor.
+type MFAChallenge struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Token     string                 `protobuf:"bytes,1,opt,name=token,proto3"`
+	xxx_hidden_Factors   []MFAFactor            `protobuf:"varint,2,rep,packed,name=factors,proto3,enum=guardian.v1.MFAFactor"`
+	xxx_hidden_ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *MFAChallenge) Reset() {
+	*x = MFAChallenge{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MFAChallenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MFAChallenge) ProtoMessage() {}
+
+func (x *MFAChallenge) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *MFAChallenge) GetToken() string {
+	if x != nil {
+		return x.xxx_hidden_Token
+	}
+	return ""
+}
+
+func (x *MFAChallenge) GetFactors() []MFAFactor {
+	if x != nil {
+		return x.xxx_hidden_Factors
+	}
+	return nil
+}
+
+func (x *MFAChallenge) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ExpiresAt
+	}
+	return nil
+}
+
+func (x *MFAChallenge) SetToken(v string) {
+	x.xxx_hidden_Token = v
+}
+
+func (x *MFAChallenge) SetFactors(v []MFAFactor) {
+	x.xxx_hidden_Factors = v
+}
+
+func (x *MFAChallenge) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ExpiresAt = v
+}
+
+func (x *MFAChallenge) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExpiresAt != nil
+}
+
+func (x *MFAChallenge) ClearExpiresAt() {
+	x.xxx_hidden_ExpiresAt = nil
+}
+
+type MFAChallenge_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Token string
+	// Factors the challenge can be answered with.
+	Factors   []MFAFactor
+	ExpiresAt *timestamppb.Timestamp
+}
+
+func (b0 MFAChallenge_builder) Build() *MFAChallenge {
+	m0 := &MFAChallenge{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Token = b.Token
+	x.xxx_hidden_Factors = b.Factors
+	x.xxx_hidden_ExpiresAt = b.ExpiresAt
+	return m0
+}
+
+// PasswordPolicyError is attached as error detail when a password violates the password policy.
+type PasswordPolicyError struct {
+	state                 protoimpl.MessageState            `protogen:"opaque.v1"`
+	xxx_hidden_Violations *[]*PasswordPolicyError_Violation `protobuf:"bytes,1,rep,name=violations,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *PasswordPolicyError) Reset() {
+	*x = PasswordPolicyError{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordPolicyError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordPolicyError) ProtoMessage() {}
+
+func (x *PasswordPolicyError) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PasswordPolicyError) GetViolations() []*PasswordPolicyError_Violation {
+	if x != nil {
+		if x.xxx_hidden_Violations != nil {
+			return *x.xxx_hidden_Violations
+		}
+	}
+	return nil
+}
+
+func (x *PasswordPolicyError) SetViolations(v []*PasswordPolicyError_Violation) {
+	x.xxx_hidden_Violations = &v
+}
+
+type PasswordPolicyError_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Violations []*PasswordPolicyError_Violation
+}
+
+func (b0 PasswordPolicyError_builder) Build() *PasswordPolicyError {
+	m0 := &PasswordPolicyError{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Violations = &b.Violations
+	return m0
+}
+
+type SignUpRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Email    string                 `protobuf:"bytes,1,opt,name=email,proto3"`
+	xxx_hidden_Username string                 `protobuf:"bytes,2,opt,name=username,proto3"`
+	xxx_hidden_Password string                 `protobuf:"bytes,3,opt,name=password,proto3"`
+	xxx_hidden_Device   string                 `protobuf:"bytes,4,opt,name=device,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SignUpRequest) Reset() {
+	*x = SignUpRequest{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignUpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUpRequest) ProtoMessage() {}
+
+func (x *SignUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SignUpRequest) GetEmail() string {
+	if x != nil {
+		return x.xxx_hidden_Email
+	}
+	return ""
+}
+
+func (x *SignUpRequest) GetUsername() string {
+	if x != nil {
+		return x.xxx_hidden_Username
+	}
+	return ""
+}
+
+func (x *SignUpRequest) GetPassword() string {
+	if x != nil {
+		return x.xxx_hidden_Password
+	}
+	return ""
+}
+
+func (x *SignUpRequest) GetDevice() string {
+	if x != nil {
+		return x.xxx_hidden_Device
+	}
+	return ""
+}
+
+func (x *SignUpRequest) SetEmail(v string) {
+	x.xxx_hidden_Email = v
+}
+
+func (x *SignUpRequest) SetUsername(v string) {
+	x.xxx_hidden_Username = v
+}
+
+func (x *SignUpRequest) SetPassword(v string) {
+	x.xxx_hidden_Password = v
+}
+
+func (x *SignUpRequest) SetDevice(v string) {
+	x.xxx_hidden_Device = v
+}
+
+type SignUpRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Email    string
+	Username string
+	Password string
+	// Human readable name of the device signing up.
+	Device string
+}
+
+func (b0 SignUpRequest_builder) Build() *SignUpRequest {
+	m0 := &SignUpRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Email = b.Email
+	x.xxx_hidden_Username = b.Username
+	x.xxx_hidden_Password = b.Password
+	x.xxx_hidden_Device = b.Device
+	return m0
+}
+
+type SignUpResponse struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_User    *User                  `protobuf:"bytes,1,opt,name=user,proto3"`
+	xxx_hidden_Session *Session               `protobuf:"bytes,2,opt,name=session,proto3"`
+	xxx_hidden_Tokens  *Tokens                `protobuf:"bytes,3,opt,name=tokens,proto3"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SignUpResponse) Reset() {
+	*x = SignUpResponse{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignUpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUpResponse) ProtoMessage() {}
+
+func (x *SignUpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SignUpResponse) GetUser() *User {
+	if x != nil {
+		return x.xxx_hidden_User
+	}
+	return nil
+}
+
+func (x *SignUpResponse) GetSession() *Session {
+	if x != nil {
+		return x.xxx_hidden_Session
+	}
+	return nil
+}
+
+func (x *SignUpResponse) GetTokens() *Tokens {
+	if x != nil {
+		return x.xxx_hidden_Tokens
+	}
+	return nil
+}
+
+func (x *SignUpResponse) SetUser(v *User) {
+	x.xxx_hidden_User = v
+}
+
+func (x *SignUpResponse) SetSession(v *Session) {
+	x.xxx_hidden_Session = v
+}
+
+func (x *SignUpResponse) SetTokens(v *Tokens) {
+	x.xxx_hidden_Tokens = v
+}
+
+func (x *SignUpResponse) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_User != nil
+}
+
+func (x *SignUpResponse) HasSession() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Session != nil
+}
+
+func (x *SignUpResponse) HasTokens() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Tokens != nil
+}
+
+func (x *SignUpResponse) ClearUser() {
+	x.xxx_hidden_User = nil
+}
+
+func (x *SignUpResponse) ClearSession() {
+	x.xxx_hidden_Session = nil
+}
+
+func (x *SignUpResponse) ClearTokens() {
+	x.xxx_hidden_Tokens = nil
+}
+
+type SignUpResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	User *User
+	// Not set if verified email addresses are required.
+	Session *Session
+	// Not set if verified email addresses are required.
+	Tokens *Tokens
+}
+
+func (b0 SignUpResponse_builder) Build() *SignUpResponse {
+	m0 := &SignUpResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_User = b.User
+	x.xxx_hidden_Session = b.Session
+	x.xxx_hidden_Tokens = b.Tokens
+	return m0
+}
+
+type SignInRequest struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Identifier string                 `protobuf:"bytes,1,opt,name=identifier,proto3"`
+	xxx_hidden_Password   string                 `protobuf:"bytes,2,opt,name=password,proto3"`
+	xxx_hidden_Device     string                 `protobuf:"bytes,3,opt,name=device,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SignInRequest) Reset() {
+	*x = SignInRequest{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignInRequest) ProtoMessage() {}
+
+func (x *SignInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SignInRequest) GetIdentifier() string {
+	if x != nil {
+		return x.xxx_hidden_Identifier
+	}
+	return ""
+}
+
+func (x *SignInRequest) GetPassword() string {
+	if x != nil {
+		return x.xxx_hidden_Password
+	}
+	return ""
+}
+
+func (x *SignInRequest) GetDevice() string {
+	if x != nil {
+		return x.xxx_hidden_Device
+	}
+	return ""
+}
+
+func (x *SignInRequest) SetIdentifier(v string) {
+	x.xxx_hidden_Identifier = v
+}
+
+func (x *SignInRequest) SetPassword(v string) {
+	x.xxx_hidden_Password = v
+}
+
+func (x *SignInRequest) SetDevice(v string) {
+	x.xxx_hidden_Device = v
+}
+
+type SignInRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Email or username.
+	Identifier string
+	Password   string
+	// Human readable name of the device signing in.
+	Device string
+}
+
+func (b0 SignInRequest_builder) Build() *SignInRequest {
+	m0 := &SignInRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Identifier = b.Identifier
+	x.xxx_hidden_Password = b.Password
+	x.xxx_hidden_Device = b.Device
+	return m0
+}
+
+type SignInResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_User         *User                  `protobuf:"bytes,1,opt,name=user,proto3"`
+	xxx_hidden_Session      *Session               `protobuf:"bytes,2,opt,name=session,proto3"`
+	xxx_hidden_Tokens       *Tokens                `protobuf:"bytes,3,opt,name=tokens,proto3"`
+	xxx_hidden_MfaChallenge *MFAChallenge          `protobuf:"bytes,4,opt,name=mfa_challenge,json=mfaChallenge,proto3"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *SignInResponse) Reset() {
+	*x = SignInResponse{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignInResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignInResponse) ProtoMessage() {}
+
+func (x *SignInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SignInResponse) GetUser() *User {
+	if x != nil {
+		return x.xxx_hidden_User
+	}
+	return nil
+}
+
+func (x *SignInResponse) GetSession() *Session {
+	if x != nil {
+		return x.xxx_hidden_Session
+	}
+	return nil
+}
+
+func (x *SignInResponse) GetTokens() *Tokens {
+	if x != nil {
+		return x.xxx_hidden_Tokens
+	}
+	return nil
+}
+
+func (x *SignInResponse) GetMfaChallenge() *MFAChallenge {
+	if x != nil {
+		return x.xxx_hidden_MfaChallenge
+	}
+	return nil
+}
+
+func (x *SignInResponse) SetUser(v *User) {
+	x.xxx_hidden_User = v
+}
+
+func (x *SignInResponse) SetSession(v *Session) {
+	x.xxx_hidden_Session = v
+}
+
+func (x *SignInResponse) SetTokens(v *Tokens) {
+	x.xxx_hidden_Tokens = v
+}
+
+func (x *SignInResponse) SetMfaChallenge(v *MFAChallenge) {
+	x.xxx_hidden_MfaChallenge = v
+}
+
+func (x *SignInResponse) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_User != nil
+}
+
+func (x *SignInResponse) HasSession() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Session != nil
+}
+
+func (x *SignInResponse) HasTokens() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Tokens != nil
+}
+
+func (x *SignInResponse) HasMfaChallenge() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MfaChallenge != nil
+}
+
+func (x *SignInResponse) ClearUser() {
+	x.xxx_hidden_User = nil
+}
+
+func (x *SignInResponse) ClearSession() {
+	x.xxx_hidden_Session = nil
+}
+
+func (x *SignInResponse) ClearTokens() {
+	x.xxx_hidden_Tokens = nil
+}
+
+func (x *SignInResponse) ClearMfaChallenge() {
+	x.xxx_hidden_MfaChallenge = nil
+}
+
+type SignInResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	User    *User
+	Session *Session
+	Tokens  *Tokens
+	// Set instead of user, session and tokens if the user enrolled a second factor.
+	MfaChallenge *MFAChallenge
+}
+
+func (b0 SignInResponse_builder) Build() *SignInResponse {
+	m0 := &SignInResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_User = b.User
+	x.xxx_hidden_Session = b.Session
+	x.xxx_hidden_Tokens = b.Tokens
+	x.xxx_hidden_MfaChallenge = b.MfaChallenge
+	return m0
+}
+
+type VerifyMFARequest struct {
+	state                     protoimpl.MessageState  `protogen:"opaque.v1"`
+	xxx_hidden_ChallengeToken string                  `protobuf:"bytes,1,opt,name=challenge_token,json=challengeToken,proto3"`
+	xxx_hidden_Code           isVerifyMFARequest_Code `protobuf_oneof:"code"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *VerifyMFARequest) Reset() {
+	*x = VerifyMFARequest{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMFARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMFARequest) ProtoMessage() {}
+
+func (x *VerifyMFARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VerifyMFARequest) GetChallengeToken() string {
+	if x != nil {
+		return x.xxx_hidden_ChallengeToken
+	}
+	return ""
+}
+
+func (x *VerifyMFARequest) GetTotpCode() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Code.(*verifyMFARequest_TotpCode); ok {
+			return x.TotpCode
+		}
+	}
+	return ""
+}
+
+func (x *VerifyMFARequest) GetRecoveryCode() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Code.(*verifyMFARequest_RecoveryCode); ok {
+			return x.RecoveryCode
+		}
+	}
+	return ""
+}
+
+func (x *VerifyMFARequest) SetChallengeToken(v string) {
+	x.xxx_hidden_ChallengeToken = v
+}
+
+func (x *VerifyMFARequest) SetTotpCode(v string) {
+	x.xxx_hidden_Code = &verifyMFARequest_TotpCode{v}
+}
+
+func (x *VerifyMFARequest) SetRecoveryCode(v string) {
+	x.xxx_hidden_Code = &verifyMFARequest_RecoveryCode{v}
+}
+
+func (x *VerifyMFARequest) HasCode() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Code != nil
+}
+
+func (x *VerifyMFARequest) HasTotpCode() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Code.(*verifyMFARequest_TotpCode)
+	return ok
+}
+
+func (x *VerifyMFARequest) HasRecoveryCode() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Code.(*verifyMFARequest_RecoveryCode)
+	return ok
+}
+
+func (x *VerifyMFARequest) ClearCode() {
+	x.xxx_hidden_Code = nil
+}
+
+func (x *VerifyMFARequest) ClearTotpCode() {
+	if _, ok := x.xxx_hidden_Code.(*verifyMFARequest_TotpCode); ok {
+		x.xxx_hidden_Code = nil
+	}
+}
+
+func (x *VerifyMFARequest) ClearRecoveryCode() {
+	if _, ok := x.xxx_hidden_Code.(*verifyMFARequest_RecoveryCode); ok {
+		x.xxx_hidden_Code = nil
+	}
+}
+
+const VerifyMFARequest_Code_not_set_case case_VerifyMFARequest_Code = 0
+const VerifyMFARequest_TotpCode_case case_VerifyMFARequest_Code = 2
+const VerifyMFARequest_RecoveryCode_case case_VerifyMFARequest_Code = 3
+
+func (x *VerifyMFARequest) WhichCode() case_VerifyMFARequest_Code {
+	if x == nil {
+		return VerifyMFARequest_Code_not_set_case
+	}
+	switch x.xxx_hidden_Code.(type) {
+	case *verifyMFARequest_TotpCode:
+		return VerifyMFARequest_TotpCode_case
+	case *verifyMFARequest_RecoveryCode:
+		return VerifyMFARequest_RecoveryCode_case
+	default:
+		return VerifyMFARequest_Code_not_set_case
+	}
+}
+
+type VerifyMFARequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ChallengeToken string
+	// Fields of oneof xxx_hidden_Code:
+	TotpCode     *string
+	RecoveryCode *string
+	// -- end of xxx_hidden_Code
+}
+
+func (b0 VerifyMFARequest_builder) Build() *VerifyMFARequest {
+	m0 := &VerifyMFARequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ChallengeToken = b.ChallengeToken
+	if b.TotpCode != nil {
+		x.xxx_hidden_Code = &verifyMFARequest_TotpCode{*b.TotpCode}
+	}
+	if b.RecoveryCode != nil {
+		x.xxx_hidden_Code = &verifyMFARequest_RecoveryCode{*b.RecoveryCode}
+	}
+	return m0
+}
+
+type case_VerifyMFARequest_Code protoreflect.FieldNumber
+
+func (x case_VerifyMFARequest_Code) String() string {
+	md := file_guardian_v1_auth_proto_msgTypes[8].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isVerifyMFARequest_Code interface {
+	isVerifyMFARequest_Code()
+}
+
+type verifyMFARequest_TotpCode struct {
+	TotpCode string `protobuf:"bytes,2,opt,name=totp_code,json=totpCode,proto3,oneof"`
+}
+
+type verifyMFARequest_RecoveryCode struct {
+	RecoveryCode string `protobuf:"bytes,3,opt,name=recovery_code,json=recoveryCode,proto3,oneof"`
+}
+
+func (*verifyMFARequest_TotpCode) isVerifyMFARequest_Code() {}
+
+func (*verifyMFARequest_RecoveryCode) isVerifyMFARequest_Code() {}
+
+type VerifyMFAResponse struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_User    *User                  `protobuf:"bytes,1,opt,name=user,proto3"`
+	xxx_hidden_Session *Session               `protobuf:"bytes,2,opt,name=session,proto3"`
+	xxx_hidden_Tokens  *Tokens                `protobuf:"bytes,3,opt,name=tokens,proto3"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *VerifyMFAResponse) Reset() {
+	*x = VerifyMFAResponse{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMFAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMFAResponse) ProtoMessage() {}
+
+func (x *VerifyMFAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VerifyMFAResponse) GetUser() *User {
+	if x != nil {
+		return x.xxx_hidden_User
+	}
+	return nil
+}
+
+func (x *VerifyMFAResponse) GetSession() *Session {
+	if x != nil {
+		return x.xxx_hidden_Session
+	}
+	return nil
+}
+
+func (x *VerifyMFAResponse) GetTokens() *Tokens {
+	if x != nil {
+		return x.xxx_hidden_Tokens
+	}
+	return nil
+}
+
+func (x *VerifyMFAResponse) SetUser(v *User) {
+	x.xxx_hidden_User = v
+}
+
+func (x *VerifyMFAResponse) SetSession(v *Session) {
+	x.xxx_hidden_Session = v
+}
+
+func (x *VerifyMFAResponse) SetTokens(v *Tokens) {
+	x.xxx_hidden_Tokens = v
+}
+
+func (x *VerifyMFAResponse) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_User != nil
+}
+
+func (x *VerifyMFAResponse) HasSession() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Session != nil
+}
+
+func (x *VerifyMFAResponse) HasTokens() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Tokens != nil
+}
+
+func (x *VerifyMFAResponse) ClearUser() {
+	x.xxx_hidden_User = nil
+}
+
+func (x *VerifyMFAResponse) ClearSession() {
+	x.xxx_hidden_Session = nil
+}
+
+func (x *VerifyMFAResponse) ClearTokens() {
+	x.xxx_hidden_Tokens = nil
+}
+
+type VerifyMFAResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	User    *User
+	Session *Session
+	Tokens  *Tokens
+}
+
+func (b0 VerifyMFAResponse_builder) Build() *VerifyMFAResponse {
+	m0 := &VerifyMFAResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_User = b.User
+	x.xxx_hidden_Session = b.Session
+	x.xxx_hidden_Tokens = b.Tokens
+	return m0
+}
+
+type BeginPasskeySignInRequest struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Identifier string                 `protobuf:"bytes,1,opt,name=identifier,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *BeginPasskeySignInRequest) Reset() {
+	*x = BeginPasskeySignInRequest{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeySignInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeySignInRequest) ProtoMessage() {}
+
+func (x *BeginPasskeySignInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BeginPasskeySignInRequest) GetIdentifier() string {
+	if x != nil {
+		return x.xxx_hidden_Identifier
+	}
+	return ""
+}
+
+func (x *BeginPasskeySignInRequest) SetIdentifier(v string) {
+	x.xxx_hidden_Identifier = v
+}
+
+type BeginPasskeySignInRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Email or username. Optional.
+	Identifier string
+}
+
+func (b0 BeginPasskeySignInRequest_builder) Build() *BeginPasskeySignInRequest {
+	m0 := &BeginPasskeySignInRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Identifier = b.Identifier
+	return m0
+}
+
+type BeginPasskeySignInResponse struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_CeremonyId string                 `protobuf:"bytes,1,opt,name=ceremony_id,json=ceremonyId,proto3"`
+	xxx_hidden_Options    string                 `protobuf:"bytes,2,opt,name=options,proto3"`
+	xxx_hidden_ExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *BeginPasskeySignInResponse) Reset() {
+	*x = BeginPasskeySignInResponse{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeySignInResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeySignInResponse) ProtoMessage() {}
+
+func (x *BeginPasskeySignInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BeginPasskeySignInResponse) GetCeremonyId() string {
+	if x != nil {
+		return x.xxx_hidden_CeremonyId
+	}
+	return ""
+}
+
+func (x *BeginPasskeySignInResponse) GetOptions() string {
+	if x != nil {
+		return x.xxx_hidden_Options
+	}
+	return ""
+}
+
+func (x *BeginPasskeySignInResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ExpiresAt
+	}
+	return nil
+}
+
+func (x *BeginPasskeySignInResponse) SetCeremonyId(v string) {
+	x.xxx_hidden_CeremonyId = v
+}
+
+func (x *BeginPasskeySignInResponse) SetOptions(v string) {
+	x.xxx_hidden_Options = v
+}
+
+func (x *BeginPasskeySignInResponse) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ExpiresAt = v
+}
+
+func (x *BeginPasskeySignInResponse) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExpiresAt != nil
+}
+
+func (x *BeginPasskeySignInResponse) ClearExpiresAt() {
+	x.xxx_hidden_ExpiresAt = nil
+}
+
+type BeginPasskeySignInResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	CeremonyId string
+	// JSON encoded options for navigator.credentials.get().
+	Options   string
+	ExpiresAt *timestamppb.Timestamp
+}
+
+func (b0 BeginPasskeySignInResponse_builder) Build() *BeginPasskeySignInResponse {
+	m0 := &BeginPasskeySignInResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_CeremonyId = b.CeremonyId
+	x.xxx_hidden_Options = b.Options
+	x.xxx_hidden_ExpiresAt = b.ExpiresAt
+	return m0
+}
+
+type FinishPasskeySignInRequest struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_CeremonyId string                 `protobuf:"bytes,1,opt,name=ceremony_id,json=ceremonyId,proto3"`
+	xxx_hidden_Credential string                 `protobuf:"bytes,2,opt,name=credential,proto3"`
+	xxx_hidden_Device     string                 `protobuf:"bytes,3,opt,name=device,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *FinishPasskeySignInRequest) Reset() {
+	*x = FinishPasskeySignInRequest{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishPasskeySignInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishPasskeySignInRequest) ProtoMessage() {}
+
+func (x *FinishPasskeySignInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FinishPasskeySignInRequest) GetCeremonyId() string {
+	if x != nil {
+		return x.xxx_hidden_CeremonyId
+	}
+	return ""
+}
+
+func (x *FinishPasskeySignInRequest) GetCredential() string {
+	if x != nil {
+		return x.xxx_hidden_Credential
+	}
+	return ""
+}
+
+func (x *FinishPasskeySignInRequest) GetDevice() string {
+	if x != nil {
+		return x.xxx_hidden_Device
+	}
+	return ""
+}
+
+func (x *FinishPasskeySignInRequest) SetCeremonyId(v string) {
+	x.xxx_hidden_CeremonyId = v
+}
+
+func (x *FinishPasskeySignInRequest) SetCredential(v string) {
+	x.xxx_hidden_Credential = v
+}
+
+func (x *FinishPasskeySignInRequest) SetDevice(v string) {
+	x.xxx_hidden_Device = v
+}
+
+type FinishPasskeySignInRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	CeremonyId string
+	// JSON encoded PublicKeyCredential returned by navigator.credentials.get().
+	Credential string
+	// Human readable name of the device signing in.
+	Device string
+}
+
+func (b0 FinishPasskeySignInRequest_builder) Build() *FinishPasskeySignInRequest {
+	m0 := &FinishPasskeySignInRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_CeremonyId = b.CeremonyId
+	x.xxx_hidden_Credential = b.Credential
+	x.xxx_hidden_Device = b.Device
+	return m0
+}
+
+type FinishPasskeySignInResponse struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_User    *User                  `protobuf:"bytes,1,opt,name=user,proto3"`
+	xxx_hidden_Session *Session               `protobuf:"bytes,2,opt,name=session,proto3"`
+	xxx_hidden_Tokens  *Tokens                `protobuf:"bytes,3,opt,name=tokens,proto3"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *FinishPasskeySignInResponse) Reset() {
+	*x = FinishPasskeySignInResponse{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishPasskeySignInResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishPasskeySignInResponse) ProtoMessage() {}
+
+func (x *FinishPasskeySignInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FinishPasskeySignInResponse) GetUser() *User {
+	if x != nil {
+		return x.xxx_hidden_User
+	}
+	return nil
+}
+
+func (x *FinishPasskeySignInResponse) GetSession() *Session {
+	if x != nil {
+		return x.xxx_hidden_Session
+	}
+	return nil
+}
+
+func (x *FinishPasskeySignInResponse) GetTokens() *Tokens {
+	if x != nil {
+		return x.xxx_hidden_Tokens
+	}
+	return nil
+}
+
+func (x *FinishPasskeySignInResponse) SetUser(v *User) {
+	x.xxx_hidden_User = v
+}
+
+func (x *FinishPasskeySignInResponse) SetSession(v *Session) {
+	x.xxx_hidden_Session = v
+}
+
+func (x *FinishPasskeySignInResponse) SetTokens(v *Tokens) {
+	x.xxx_hidden_Tokens = v
+}
+
+func (x *FinishPasskeySignInResponse) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_User != nil
+}
+
+func (x *FinishPasskeySignInResponse) HasSession() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Session != nil
+}
+
+func (x *FinishPasskeySignInResponse) HasTokens() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Tokens != nil
+}
+
+func (x *FinishPasskeySignInResponse) ClearUser() {
+	x.xxx_hidden_User = nil
+}
+
+func (x *FinishPasskeySignInResponse) ClearSession() {
+	x.xxx_hidden_Session = nil
+}
+
+func (x *FinishPasskeySignInResponse) ClearTokens() {
+	x.xxx_hidden_Tokens = nil
+}
+
+type FinishPasskeySignInResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	User    *User
+	Session *Session
+	Tokens  *Tokens
+}
+
+func (b0 FinishPasskeySignInResponse_builder) Build() *FinishPasskeySignInResponse {
+	m0 := &FinishPasskeySignInResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_User = b.User
+	x.xxx_hidden_Session = b.Session
+	x.xxx_hidden_Tokens = b.Tokens
+	return m0
+}
+
+type SendMagicLinkRequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Email  string                 `protobuf:"bytes,1,opt,name=email,proto3"`
+	xxx_hidden_Device string                 `protobuf:"bytes,2,opt,name=device,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SendMagicLinkRequest) Reset() {
+	*x = SendMagicLinkRequest{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMagicLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMagicLinkRequest) ProtoMessage() {}
+
+func (x *SendMagicLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SendMagicLinkRequest) GetEmail() string {
+	if x != nil {
+		return x.xxx_hidden_Email
+	}
+	return ""
+}
+
+func (x *SendMagicLinkRequest) GetDevice() string {
+	if x != nil {
+		return x.xxx_hidden_Device
+	}
+	return ""
+}
+
+func (x *SendMagicLinkRequest) SetEmail(v string) {
+	x.xxx_hidden_Email = v
+}
+
+func (x *SendMagicLinkRequest) SetDevice(v string) {
+	x.xxx_hidden_Device = v
+}
+
+type SendMagicLinkRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Email string
+	// Human readable name of the device signing in.
+	Device string
+}
+
+func (b0 SendMagicLinkRequest_builder) Build() *SendMagicLinkRequest {
+	m0 := &SendMagicLinkRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Email = b.Email
+	x.xxx_hidden_Device = b.Device
+	return m0
+}
+
+type SendMagicLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMagicLinkResponse) Reset() {
+	*x = SendMagicLinkResponse{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMagicLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMagicLinkResponse) ProtoMessage() {}
+
+func (x *SendMagicLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type SendMagicLinkResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 SendMagicLinkResponse_builder) Build() *SendMagicLinkResponse {
+	m0 := &SendMagicLinkResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type VerifyMagicLinkRequest struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Token string                 `protobuf:"bytes,1,opt,name=token,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *VerifyMagicLinkRequest) Reset() {
+	*x = VerifyMagicLinkRequest{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMagicLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMagicLinkRequest) ProtoMessage() {}
+
+func (x *VerifyMagicLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VerifyMagicLinkRequest) GetToken() string {
+	if x != nil {
+		return x.xxx_hidden_Token
+	}
+	return ""
+}
+
+func (x *VerifyMagicLinkRequest) SetToken(v string) {
+	x.xxx_hidden_Token = v
+}
+
+type VerifyMagicLinkRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Token string
+}
+
+func (b0 VerifyMagicLinkRequest_builder) Build() *VerifyMagicLinkRequest {
+	m0 := &VerifyMagicLinkRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Token = b.Token
+	return m0
+}
+
+type VerifyMagicLinkResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_User         *User                  `protobuf:"bytes,1,opt,name=user,proto3"`
+	xxx_hidden_Session      *Session               `protobuf:"bytes,2,opt,name=session,proto3"`
+	xxx_hidden_Tokens       *Tokens                `protobuf:"bytes,3,opt,name=tokens,proto3"`
+	xxx_hidden_MfaChallenge *MFAChallenge          `protobuf:"bytes,4,opt,name=mfa_challenge,json=mfaChallenge,proto3"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *VerifyMagicLinkResponse) Reset() {
+	*x = VerifyMagicLinkResponse{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMagicLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMagicLinkResponse) ProtoMessage() {}
+
+func (x *VerifyMagicLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VerifyMagicLinkResponse) GetUser() *User {
+	if x != nil {
+		return x.xxx_hidden_User
+	}
+	return nil
+}
+
+func (x *VerifyMagicLinkResponse) GetSession() *Session {
+	if x != nil {
+		return x.xxx_hidden_Session
+	}
+	return nil
+}
+
+func (x *VerifyMagicLinkResponse) GetTokens() *Tokens {
+	if x != nil {
+		return x.xxx_hidden_Tokens
+	}
+	return nil
+}
+
+func (x *VerifyMagicLinkResponse) GetMfaChallenge() *MFAChallenge {
+	if x != nil {
+		return x.xxx_hidden_MfaChallenge
+	}
+	return nil
+}
+
+func (x *VerifyMagicLinkResponse) SetUser(v *User) {
+	x.xxx_hidden_User = v
+}
+
+func (x *VerifyMagicLinkResponse) SetSession(v *Session) {
+	x.xxx_hidden_Session = v
+}
+
+func (x *VerifyMagicLinkResponse) SetTokens(v *Tokens) {
+	x.xxx_hidden_Tokens = v
+}
+
+func (x *VerifyMagicLinkResponse) SetMfaChallenge(v *MFAChallenge) {
+	x.xxx_hidden_MfaChallenge = v
+}
+
+func (x *VerifyMagicLinkResponse) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_User != nil
+}
+
+func (x *VerifyMagicLinkResponse) HasSession() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Session != nil
+}
+
+func (x *VerifyMagicLinkResponse) HasTokens() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Tokens != nil
+}
+
+func (x *VerifyMagicLinkResponse) HasMfaChallenge() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MfaChallenge != nil
+}
+
+func (x *VerifyMagicLinkResponse) ClearUser() {
+	x.xxx_hidden_User = nil
+}
+
+func (x *VerifyMagicLinkResponse) ClearSession() {
+	x.xxx_hidden_Session = nil
+}
+
+func (x *VerifyMagicLinkResponse) ClearTokens() {
+	x.xxx_hidden_Tokens = nil
+}
+
+func (x *VerifyMagicLinkResponse) ClearMfaChallenge() {
+	x.xxx_hidden_MfaChallenge = nil
+}
+
+type VerifyMagicLinkResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	User    *User
+	Session *Session
+	Tokens  *Tokens
+	// Set instead of user, session and tokens if the user enrolled a second factor.
+	MfaChallenge *MFAChallenge
+}
+
+func (b0 VerifyMagicLinkResponse_builder) Build() *VerifyMagicLinkResponse {
+	m0 := &VerifyMagicLinkResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_User = b.User
+	x.xxx_hidden_Session = b.Session
+	x.xxx_hidden_Tokens = b.Tokens
+	x.xxx_hidden_MfaChallenge = b.MfaChallenge
+	return m0
+}
+
+type SendEmailOTPRequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Email  string                 `protobuf:"bytes,1,opt,name=email,proto3"`
+	xxx_hidden_Device string                 `protobuf:"bytes,2,opt,name=device,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SendEmailOTPRequest) Reset() {
+	*x = SendEmailOTPRequest{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEmailOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEmailOTPRequest) ProtoMessage() {}
+
+func (x *SendEmailOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SendEmailOTPRequest) GetEmail() string {
+	if x != nil {
+		return x.xxx_hidden_Email
+	}
+	return ""
+}
+
+func (x *SendEmailOTPRequest) GetDevice() string {
+	if x != nil {
+		return x.xxx_hidden_Device
+	}
+	return ""
+}
+
+func (x *SendEmailOTPRequest) SetEmail(v string) {
+	x.xxx_hidden_Email = v
+}
+
+func (x *SendEmailOTPRequest) SetDevice(v string) {
+	x.xxx_hidden_Device = v
+}
+
+type SendEmailOTPRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Email string
+	// Human readable name of the device signing in.
+	Device string
+}
+
+func (b0 SendEmailOTPRequest_builder) Build() *SendEmailOTPRequest {
+	m0 := &SendEmailOTPRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Email = b.Email
+	x.xxx_hidden_Device = b.Device
+	return m0
+}
+
+type SendEmailOTPResponse struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ChallengeToken string                 `protobuf:"bytes,1,opt,name=challenge_token,json=challengeToken,proto3"`
+	xxx_hidden_ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *SendEmailOTPResponse) Reset() {
+	*x = SendEmailOTPResponse{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEmailOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEmailOTPResponse) ProtoMessage() {}
+
+func (x *SendEmailOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SendEmailOTPResponse) GetChallengeToken() string {
+	if x != nil {
+		return x.xxx_hidden_ChallengeToken
+	}
+	return ""
+}
+
+func (x *SendEmailOTPResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ExpiresAt
+	}
+	return nil
+}
+
+func (x *SendEmailOTPResponse) SetChallengeToken(v string) {
+	x.xxx_hidden_ChallengeToken = v
+}
+
+func (x *SendEmailOTPResponse) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ExpiresAt = v
+}
+
+func (x *SendEmailOTPResponse) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExpiresAt != nil
+}
+
+func (x *SendEmailOTPResponse) ClearExpiresAt() {
+	x.xxx_hidden_ExpiresAt = nil
+}
+
+type SendEmailOTPResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ChallengeToken string
+	ExpiresAt      *timestamppb.Timestamp
+}
+
+func (b0 SendEmailOTPResponse_builder) Build() *SendEmailOTPResponse {
+	m0 := &SendEmailOTPResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ChallengeToken = b.ChallengeToken
+	x.xxx_hidden_ExpiresAt = b.ExpiresAt
+	return m0
+}
+
+type VerifyEmailOTPRequest struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ChallengeToken string                 `protobuf:"bytes,1,opt,name=challenge_token,json=challengeToken,proto3"`
+	xxx_hidden_Code           string                 `protobuf:"bytes,2,opt,name=code,proto3"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *VerifyEmailOTPRequest) Reset() {
+	*x = VerifyEmailOTPRequest{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailOTPRequest) ProtoMessage() {}
+
+func (x *VerifyEmailOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VerifyEmailOTPRequest) GetChallengeToken() string {
+	if x != nil {
+		return x.xxx_hidden_ChallengeToken
+	}
+	return ""
+}
+
+func (x *VerifyEmailOTPRequest) GetCode() string {
+	if x != nil {
+		return x.xxx_hidden_Code
+	}
+	return ""
+}
+
+func (x *VerifyEmailOTPRequest) SetChallengeToken(v string) {
+	x.xxx_hidden_ChallengeToken = v
+}
+
+func (x *VerifyEmailOTPRequest) SetCode(v string) {
+	x.xxx_hidden_Code = v
+}
+
+type VerifyEmailOTPRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ChallengeToken string
+	Code           string
+}
+
+func (b0 VerifyEmailOTPRequest_builder) Build() *VerifyEmailOTPRequest {
+	m0 := &VerifyEmailOTPRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ChallengeToken = b.ChallengeToken
+	x.xxx_hidden_Code = b.Code
+	return m0
+}
+
+type VerifyEmailOTPResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_User         *User                  `protobuf:"bytes,1,opt,name=user,proto3"`
+	xxx_hidden_Session      *Session               `protobuf:"bytes,2,opt,name=session,proto3"`
+	xxx_hidden_Tokens       *Tokens                `protobuf:"bytes,3,opt,name=tokens,proto3"`
+	xxx_hidden_MfaChallenge *MFAChallenge          `protobuf:"bytes,4,opt,name=mfa_challenge,json=mfaChallenge,proto3"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *VerifyEmailOTPResponse) Reset() {
+	*x = VerifyEmailOTPResponse{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailOTPResponse) ProtoMessage() {}
+
+func (x *VerifyEmailOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VerifyEmailOTPResponse) GetUser() *User {
+	if x != nil {
+		return x.xxx_hidden_User
+	}
+	return nil
+}
+
+func (x *VerifyEmailOTPResponse) GetSession() *Session {
+	if x != nil {
+		return x.xxx_hidden_Session
+	}
+	return nil
+}
+
+func (x *VerifyEmailOTPResponse) GetTokens() *Tokens {
+	if x != nil {
+		return x.xxx_hidden_Tokens
+	}
+	return nil
+}
+
+func (x *VerifyEmailOTPResponse) GetMfaChallenge() *MFAChallenge {
+	if x != nil {
+		return x.xxx_hidden_MfaChallenge
+	}
+	return nil
+}
+
+func (x *VerifyEmailOTPResponse) SetUser(v *User) {
+	x.xxx_hidden_User = v
+}
+
+func (x *VerifyEmailOTPResponse) SetSession(v *Session) {
+	x.xxx_hidden_Session = v
+}
+
+func (x *VerifyEmailOTPResponse) SetTokens(v *Tokens) {
+	x.xxx_hidden_Tokens = v
+}
+
+func (x *VerifyEmailOTPResponse) SetMfaChallenge(v *MFAChallenge) {
+	x.xxx_hidden_MfaChallenge = v
+}
+
+func (x *VerifyEmailOTPResponse) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_User != nil
+}
+
+func (x *VerifyEmailOTPResponse) HasSession() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Session != nil
+}
+
+func (x *VerifyEmailOTPResponse) HasTokens() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Tokens != nil
+}
+
+func (x *VerifyEmailOTPResponse) HasMfaChallenge() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MfaChallenge != nil
+}
+
+func (x *VerifyEmailOTPResponse) ClearUser() {
+	x.xxx_hidden_User = nil
+}
+
+func (x *VerifyEmailOTPResponse) ClearSession() {
+	x.xxx_hidden_Session = nil
+}
+
+func (x *VerifyEmailOTPResponse) ClearTokens() {
+	x.xxx_hidden_Tokens = nil
+}
+
+func (x *VerifyEmailOTPResponse) ClearMfaChallenge() {
+	x.xxx_hidden_MfaChallenge = nil
+}
+
+type VerifyEmailOTPResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	User    *User
+	Session *Session
+	Tokens  *Tokens
+	// Set instead of user, session and tokens if the user enrolled a second factor.
+	MfaChallenge *MFAChallenge
+}
+
+func (b0 VerifyEmailOTPResponse_builder) Build() *VerifyEmailOTPResponse {
+	m0 := &VerifyEmailOTPResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_User = b.User
+	x.xxx_hidden_Session = b.Session
+	x.xxx_hidden_Tokens = b.Tokens
+	x.xxx_hidden_MfaChallenge = b.MfaChallenge
+	return m0
+}
+
+type SendEmailVerificationRequest struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Email string                 `protobuf:"bytes,1,opt,name=email,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SendEmailVerificationRequest) Reset() {
+	*x = SendEmailVerificationRequest{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEmailVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEmailVerificationRequest) ProtoMessage() {}
+
+func (x *SendEmailVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SendEmailVerificationRequest) GetEmail() string {
+	if x != nil {
+		return x.xxx_hidden_Email
+	}
+	return ""
+}
+
+func (x *SendEmailVerificationRequest) SetEmail(v string) {
+	x.xxx_hidden_Email = v
+}
+
+type SendEmailVerificationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Email string
+}
+
+func (b0 SendEmailVerificationRequest_builder) Build() *SendEmailVerificationRequest {
+	m0 := &SendEmailVerificationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Email = b.Email
+	return m0
+}
+
+type SendEmailVerificationResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendEmailVerificationResponse) Reset() {
+	*x = SendEmailVerificationResponse{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEmailVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEmailVerificationResponse) ProtoMessage() {}
+
+func (x *SendEmailVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type SendEmailVerificationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 SendEmailVerificationResponse_builder) Build() *SendEmailVerificationResponse {
+	m0 := &SendEmailVerificationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type VerifyEmailRequest struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Token string                 `protobuf:"bytes,1,opt,name=token,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailRequest) ProtoMessage() {}
+
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VerifyEmailRequest) GetToken() string {
+	if x != nil {
+		return x.xxx_hidden_Token
+	}
+	return ""
+}
+
+func (x *VerifyEmailRequest) SetToken(v string) {
+	x.xxx_hidden_Token = v
+}
+
+type VerifyEmailRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Token string
+}
+
+func (b0 VerifyEmailRequest_builder) Build() *VerifyEmailRequest {
+	m0 := &VerifyEmailRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Token = b.Token
+	return m0
+}
+
+type VerifyEmailResponse struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_User *User                  `protobuf:"bytes,1,opt,name=user,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VerifyEmailResponse) Reset() {
+	*x = VerifyEmailResponse{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailResponse) ProtoMessage() {}
+
+func (x *VerifyEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VerifyEmailResponse) GetUser() *User {
+	if x != nil {
+		return x.xxx_hidden_User
+	}
+	return nil
+}
+
+func (x *VerifyEmailResponse) SetUser(v *User) {
+	x.xxx_hidden_User = v
+}
+
+func (x *VerifyEmailResponse) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_User != nil
+}
+
+func (x *VerifyEmailResponse) ClearUser() {
+	x.xxx_hidden_User = nil
+}
+
+type VerifyEmailResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	User *User
+}
+
+func (b0 VerifyEmailResponse_builder) Build() *VerifyEmailResponse {
+	m0 := &VerifyEmailResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_User = b.User
+	return m0
+}
+
+type RequestPasswordResetRequest struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Email string                 `protobuf:"bytes,1,opt,name=email,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.xxx_hidden_Email
+	}
+	return ""
+}
+
+func (x *RequestPasswordResetRequest) SetEmail(v string) {
+	x.xxx_hidden_Email = v
+}
+
+type RequestPasswordResetRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Email string
+}
+
+func (b0 RequestPasswordResetRequest_builder) Build() *RequestPasswordResetRequest {
+	m0 := &RequestPasswordResetRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Email = b.Email
+	return m0
+}
+
+type RequestPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RequestPasswordResetResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RequestPasswordResetResponse_builder) Build() *RequestPasswordResetResponse {
+	m0 := &RequestPasswordResetResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type ResetPasswordRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Token    string                 `protobuf:"bytes,1,opt,name=token,proto3"`
+	xxx_hidden_Password string                 `protobuf:"bytes,2,opt,name=password,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		return x.xxx_hidden_Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.xxx_hidden_Password
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) SetToken(v string) {
+	x.xxx_hidden_Token = v
+}
+
+func (x *ResetPasswordRequest) SetPassword(v string) {
+	x.xxx_hidden_Password = v
+}
+
+type ResetPasswordRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Token    string
+	Password string
+}
+
+func (b0 ResetPasswordRequest_builder) Build() *ResetPasswordRequest {
+	m0 := &ResetPasswordRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Token = b.Token
+	x.xxx_hidden_Password = b.Password
+	return m0
+}
+
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResponse) ProtoMessage() {}
+
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type ResetPasswordResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 ResetPasswordResponse_builder) Build() *ResetPasswordResponse {
+	m0 := &ResetPasswordResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type SignOutRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignOutRequest) Reset() {
+	*x = SignOutRequest{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignOutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignOutRequest) ProtoMessage() {}
+
+func (x *SignOutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type SignOutRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 SignOutRequest_builder) Build() *SignOutRequest {
+	m0 := &SignOutRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type SignOutResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignOutResponse) Reset() {
+	*x = SignOutResponse{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignOutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignOutResponse) ProtoMessage() {}
+
+func (x *SignOutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type SignOutResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 SignOutResponse_builder) Build() *SignOutResponse {
+	m0 := &SignOutResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type RefreshRequest struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_RefreshToken string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *RefreshRequest) Reset() {
+	*x = RefreshRequest{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshRequest) ProtoMessage() {}
+
+func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RefreshRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.xxx_hidden_RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshRequest) SetRefreshToken(v string) {
+	x.xxx_hidden_RefreshToken = v
+}
+
+type RefreshRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	RefreshToken string
+}
+
+func (b0 RefreshRequest_builder) Build() *RefreshRequest {
+	m0 := &RefreshRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_RefreshToken = b.RefreshToken
+	return m0
+}
+
+type RefreshResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Tokens *Tokens                `protobuf:"bytes,1,opt,name=tokens,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RefreshResponse) Reset() {
+	*x = RefreshResponse{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshResponse) ProtoMessage() {}
+
+func (x *RefreshResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RefreshResponse) GetTokens() *Tokens {
+	if x != nil {
+		return x.xxx_hidden_Tokens
+	}
+	return nil
+}
+
+func (x *RefreshResponse) SetTokens(v *Tokens) {
+	x.xxx_hidden_Tokens = v
+}
+
+func (x *RefreshResponse) HasTokens() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Tokens != nil
+}
+
+func (x *RefreshResponse) ClearTokens() {
+	x.xxx_hidden_Tokens = nil
+}
+
+type RefreshResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Tokens *Tokens
+}
+
+func (b0 RefreshResponse_builder) Build() *RefreshResponse {
+	m0 := &RefreshResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Tokens = b.Tokens
+	return m0
+}
+
+type GetSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionRequest) Reset() {
+	*x = GetSessionRequest{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionRequest) ProtoMessage() {}
+
+func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type GetSessionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 GetSessionRequest_builder) Build() *GetSessionRequest {
+	m0 := &GetSessionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GetSessionResponse struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_User    *User                  `protobuf:"bytes,1,opt,name=user,proto3"`
+	xxx_hidden_Session *Session               `protobuf:"bytes,2,opt,name=session,proto3"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetSessionResponse) Reset() {
+	*x = GetSessionResponse{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionResponse) ProtoMessage() {}
+
+func (x *GetSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetSessionResponse) GetUser() *User {
+	if x != nil {
+		return x.xxx_hidden_User
+	}
+	return nil
+}
+
+func (x *GetSessionResponse) GetSession() *Session {
+	if x != nil {
+		return x.xxx_hidden_Session
+	}
+	return nil
+}
+
+func (x *GetSessionResponse) SetUser(v *User) {
+	x.xxx_hidden_User = v
+}
+
+func (x *GetSessionResponse) SetSession(v *Session) {
+	x.xxx_hidden_Session = v
+}
+
+func (x *GetSessionResponse) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_User != nil
+}
+
+func (x *GetSessionResponse) HasSession() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Session != nil
+}
+
+func (x *GetSessionResponse) ClearUser() {
+	x.xxx_hidden_User = nil
+}
+
+func (x *GetSessionResponse) ClearSession() {
+	x.xxx_hidden_Session = nil
+}
+
+type GetSessionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	User    *User
+	Session *Session
+}
+
+func (b0 GetSessionResponse_builder) Build() *GetSessionResponse {
+	m0 := &GetSessionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_User = b.User
+	x.xxx_hidden_Session = b.Session
+	return m0
+}
+
+type PasswordPolicyError_Violation struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Code    string                 `protobuf:"bytes,1,opt,name=code,proto3"`
+	xxx_hidden_Message string                 `protobuf:"bytes,2,opt,name=message,proto3"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PasswordPolicyError_Violation) Reset() {
+	*x = PasswordPolicyError_Violation{}
+	mi := &file_guardian_v1_auth_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordPolicyError_Violation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordPolicyError_Violation) ProtoMessage() {}
+
+func (x *PasswordPolicyError_Violation) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_auth_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PasswordPolicyError_Violation) GetCode() string {
+	if x != nil {
+		return x.xxx_hidden_Code
+	}
+	return ""
+}
+
+func (x *PasswordPolicyError_Violation) GetMessage() string {
+	if x != nil {
+		return x.xxx_hidden_Message
+	}
+	return ""
+}
+
+func (x *PasswordPolicyError_Violation) SetCode(v string) {
+	x.xxx_hidden_Code = v
+}
+
+func (x *PasswordPolicyError_Violation) SetMessage(v string) {
+	x.xxx_hidden_Message = v
+}
+
+type PasswordPolicyError_Violation_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Machine-readable code such as `password_too_short`.
+	Code    string
+	Message string
+}
+
+func (b0 PasswordPolicyError_Violation_builder) Build() *PasswordPolicyError_Violation {
+	m0 := &PasswordPolicyError_Violation{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Code = b.Code
+	x.xxx_hidden_Message = b.Message
+	return m0
+}
+
+var File_guardian_v1_auth_proto protoreflect.FileDescriptor
+
+const file_guardian_v1_auth_proto_rawDesc = "" +
+	"\n" +
+	"\x16guardian/v1/auth.proto\x12\vguardian.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16guardian/v1/user.proto\"\xdf\x02\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x04 \x01(\tR\tipAddress\x12\x16\n" +
+	"\x06device\x18\x05 \x01(\tR\x06device\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_seen_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12!\n" +
+	"\fauth_methods\x18\t \x03(\tR\vauthMethods\"\xf8\x01\n" +
+	"\x06Tokens\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12Q\n" +
+	"\x17access_token_expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x14accessTokenExpiresAt\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x12S\n" +
+	"\x18refresh_token_expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x15refreshTokenExpiresAt\"\x91\x01\n" +
+	"\fMFAChallenge\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x120\n" +
+	"\afactors\x18\x02 \x03(\x0e2\x16.guardian.v1.MFAFactorR\afactors\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x9c\x01\n" +
+	"\x13PasswordPolicyError\x12J\n" +
+	"\n" +
+	"violations\x18\x01 \x03(\v2*.guardian.v1.PasswordPolicyError.ViolationR\n" +
+	"violations\x1a9\n" +
+	"\tViolation\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"u\n" +
+	"\rSignUpRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x16\n" +
+	"\x06device\x18\x04 \x01(\tR\x06device\"\x94\x01\n" +
+	"\x0eSignUpResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.guardian.v1.UserR\x04user\x12.\n" +
+	"\asession\x18\x02 \x01(\v2\x14.guardian.v1.SessionR\asession\x12+\n" +
+	"\x06tokens\x18\x03 \x01(\v2\x13.guardian.v1.TokensR\x06tokens\"c\n" +
+	"\rSignInRequest\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x01 \x01(\tR\n" +
+	"identifier\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x16\n" +
+	"\x06device\x18\x03 \x01(\tR\x06device\"\xd4\x01\n" +
+	"\x0eSignInResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.guardian.v1.UserR\x04user\x12.\n" +
+	"\asession\x18\x02 \x01(\v2\x14.guardian.v1.SessionR\asession\x12+\n" +
+	"\x06tokens\x18\x03 \x01(\v2\x13.guardian.v1.TokensR\x06tokens\x12>\n" +
+	"\rmfa_challenge\x18\x04 \x01(\v2\x19.guardian.v1.MFAChallengeR\fmfaChallenge\"\x89\x01\n" +
+	"\x10VerifyMFARequest\x12'\n" +
+	"\x0fchallenge_token\x18\x01 \x01(\tR\x0echallengeToken\x12\x1d\n" +
+	"\ttotp_code\x18\x02 \x01(\tH\x00R\btotpCode\x12%\n" +
+	"\rrecovery_code\x18\x03 \x01(\tH\x00R\frecoveryCodeB\x06\n" +
+	"\x04code\"\x97\x01\n" +
+	"\x11VerifyMFAResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.guardian.v1.UserR\x04user\x12.\n" +
+	"\asession\x18\x02 \x01(\v2\x14.guardian.v1.SessionR\asession\x12+\n" +
+	"\x06tokens\x18\x03 \x01(\v2\x13.guardian.v1.TokensR\x06tokens\";\n" +
+	"\x19BeginPasskeySignInRequest\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x01 \x01(\tR\n" +
+	"identifier\"\x92\x01\n" +
+	"\x1aBeginPasskeySignInResponse\x12\x1f\n" +
+	"\vceremony_id\x18\x01 \x01(\tR\n" +
+	"ceremonyId\x12\x18\n" +
+	"\aoptions\x18\x02 \x01(\tR\aoptions\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"u\n" +
+	"\x1aFinishPasskeySignInRequest\x12\x1f\n" +
+	"\vceremony_id\x18\x01 \x01(\tR\n" +
+	"ceremonyId\x12\x1e\n" +
+	"\n" +
+	"credential\x18\x02 \x01(\tR\n" +
+	"credential\x12\x16\n" +
+	"\x06device\x18\x03 \x01(\tR\x06device\"\xa1\x01\n" +
+	"\x1bFinishPasskeySignInResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.guardian.v1.UserR\x04user\x12.\n" +
+	"\asession\x18\x02 \x01(\v2\x14.guardian.v1.SessionR\asession\x12+\n" +
+	"\x06tokens\x18\x03 \x01(\v2\x13.guardian.v1.TokensR\x06tokens\"D\n" +
+	"\x14SendMagicLinkRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x16\n" +
+	"\x06device\x18\x02 \x01(\tR\x06device\"\x17\n" +
+	"\x15SendMagicLinkResponse\".\n" +
+	"\x16VerifyMagicLinkRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xdd\x01\n" +
+	"\x17VerifyMagicLinkResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.guardian.v1.UserR\x04user\x12.\n" +
+	"\asession\x18\x02 \x01(\v2\x14.guardian.v1.SessionR\asession\x12+\n" +
+	"\x06tokens\x18\x03 \x01(\v2\x13.guardian.v1.TokensR\x06tokens\x12>\n" +
+	"\rmfa_challenge\x18\x04 \x01(\v2\x19.guardian.v1.MFAChallengeR\fmfaChallenge\"C\n" +
+	"\x13SendEmailOTPRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x16\n" +
+	"\x06device\x18\x02 \x01(\tR\x06device\"z\n" +
+	"\x14SendEmailOTPResponse\x12'\n" +
+	"\x0fchallenge_token\x18\x01 \x01(\tR\x0echallengeToken\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"T\n" +
+	"\x15VerifyEmailOTPRequest\x12'\n" +
+	"\x0fchallenge_token\x18\x01 \x01(\tR\x0echallengeToken\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\xdc\x01\n" +
+	"\x16VerifyEmailOTPResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.guardian.v1.UserR\x04user\x12.\n" +
+	"\asession\x18\x02 \x01(\v2\x14.guardian.v1.SessionR\asession\x12+\n" +
+	"\x06tokens\x18\x03 \x01(\v2\x13.guardian.v1.TokensR\x06tokens\x12>\n" +
+	"\rmfa_challenge\x18\x04 \x01(\v2\x19.guardian.v1.MFAChallengeR\fmfaChallenge\"4\n" +
+	"\x1cSendEmailVerificationRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"\x1f\n" +
+	"\x1dSendEmailVerificationResponse\"*\n" +
+	"\x12VerifyEmailRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"<\n" +
+	"\x13VerifyEmailResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.guardian.v1.UserR\x04user\"3\n" +
+	"\x1bRequestPasswordResetRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"\x1e\n" +
+	"\x1cRequestPasswordResetResponse\"H\n" +
+	"\x14ResetPasswordRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x17\n" +
+	"\x15ResetPasswordResponse\"\x10\n" +
+	"\x0eSignOutRequest\"\x11\n" +
+	"\x0fSignOutResponse\"5\n" +
+	"\x0eRefreshRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\">\n" +
+	"\x0fRefreshResponse\x12+\n" +
+	"\x06tokens\x18\x01 \x01(\v2\x13.guardian.v1.TokensR\x06tokens\"\x13\n" +
+	"\x11GetSessionRequest\"k\n" +
+	"\x12GetSessionResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.guardian.v1.UserR\x04user\x12.\n" +
+	"\asession\x18\x02 \x01(\v2\x14.guardian.v1.SessionR\asession*Z\n" +
+	"\tMFAFactor\x12\x1a\n" +
+	"\x16MFA_FACTOR_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fMFA_FACTOR_TOTP\x10\x01\x12\x1c\n" +
+	"\x18MFA_FACTOR_RECOVERY_CODE\x10\x022\xf8\n" +
+	"\n" +
+	"\vAuthService\x12A\n" +
+	"\x06SignUp\x12\x1a.guardian.v1.SignUpRequest\x1a\x1b.guardian.v1.SignUpResponse\x12A\n" +
+	"\x06SignIn\x12\x1a.guardian.v1.SignInRequest\x1a\x1b.guardian.v1.SignInResponse\x12J\n" +
+	"\tVerifyMFA\x12\x1d.guardian.v1.VerifyMFARequest\x1a\x1e.guardian.v1.VerifyMFAResponse\x12e\n" +
+	"\x12BeginPasskeySignIn\x12&.guardian.v1.BeginPasskeySignInRequest\x1a'.guardian.v1.BeginPasskeySignInResponse\x12h\n" +
+	"\x13FinishPasskeySignIn\x12'.guardian.v1.FinishPasskeySignInRequest\x1a(.guardian.v1.FinishPasskeySignInResponse\x12V\n" +
+	"\rSendMagicLink\x12!.guardian.v1.SendMagicLinkRequest\x1a\".guardian.v1.SendMagicLinkResponse\x12\\\n" +
+	"\x0fVerifyMagicLink\x12#.guardian.v1.VerifyMagicLinkRequest\x1a$.guardian.v1.VerifyMagicLinkResponse\x12S\n" +
+	"\fSendEmailOTP\x12 .guardian.v1.SendEmailOTPRequest\x1a!.guardian.v1.SendEmailOTPResponse\x12Y\n" +
+	"\x0eVerifyEmailOTP\x12\".guardian.v1.VerifyEmailOTPRequest\x1a#.guardian.v1.VerifyEmailOTPResponse\x12n\n" +
+	"\x15SendEmailVerification\x12).guardian.v1.SendEmailVerificationRequest\x1a*.guardian.v1.SendEmailVerificationResponse\x12P\n" +
+	"\vVerifyEmail\x12\x1f.guardian.v1.VerifyEmailRequest\x1a .guardian.v1.VerifyEmailResponse\x12k\n" +
+	"\x14RequestPasswordReset\x12(.guardian.v1.RequestPasswordResetRequest\x1a).guardian.v1.RequestPasswordResetResponse\x12V\n" +
+	"\rResetPassword\x12!.guardian.v1.ResetPasswordRequest\x1a\".guardian.v1.ResetPasswordResponse\x12D\n" +
+	"\aSignOut\x12\x1b.guardian.v1.SignOutRequest\x1a\x1c.guardian.v1.SignOutResponse\x12D\n" +
+	"\aRefresh\x12\x1b.guardian.v1.RefreshRequest\x1a\x1c.guardian.v1.RefreshResponse\x12M\n" +
+	"\n" +
+	"GetSession\x12\x1e.guardian.v1.GetSessionRequest\x1a\x1f.guardian.v1.GetSessionResponseB\xa8\x01\n" +
+	"\x0fcom.guardian.v1B\tAuthProtoP\x01Z=github.com/gophero/guardian/core/proto/guardian/v1;guardianv1\xa2\x02\x03GVX\xaa\x02\vGuardian.V1\xca\x02\vGuardian\\V1\xe2\x02\x17Guardian\\V1\\GPBMetadata\xea\x02\fGuardian::V1b\x06proto3"
+
+var file_guardian_v1_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_guardian_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_guardian_v1_auth_proto_goTypes = []any{
+	(MFAFactor)(0),                        // 0: guardian.v1.MFAFactor
+	(*Session)(nil),                       // 1: guardian.v1.Session
+	(*Tokens)(nil),                        // 2: guardian.v1.Tokens
+	(*MFAChallenge)(nil),                  // 3: guardian.v1.MFAChallenge
+	(*PasswordPolicyError)(nil),           // 4: guardian.v1.PasswordPolicyError
+	(*SignUpRequest)(nil),                 // 5: guardian.v1.SignUpRequest
+	(*SignUpResponse)(nil),                // 6: guardian.v1.SignUpResponse
+	(*SignInRequest)(nil),                 // 7: guardian.v1.SignInRequest
+	(*SignInResponse)(nil),                // 8: guardian.v1.SignInResponse
+	(*VerifyMFARequest)(nil),              // 9: guardian.v1.VerifyMFARequest
+	(*VerifyMFAResponse)(nil),             // 10: guardian.v1.VerifyMFAResponse
+	(*BeginPasskeySignInRequest)(nil),     // 11: guardian.v1.BeginPasskeySignInRequest
+	(*BeginPasskeySignInResponse)(nil),    // 12: guardian.v1.BeginPasskeySignInResponse
+	(*FinishPasskeySignInRequest)(nil),    // 13: guardian.v1.FinishPasskeySignInRequest
+	(*FinishPasskeySignInResponse)(nil),   // 14: guardian.v1.FinishPasskeySignInResponse
+	(*SendMagicLinkRequest)(nil),          // 15: guardian.v1.SendMagicLinkRequest
+	(*SendMagicLinkResponse)(nil),         // 16: guardian.v1.SendMagicLinkResponse
+	(*VerifyMagicLinkRequest)(nil),        // 17: guardian.v1.VerifyMagicLinkRequest
+	(*VerifyMagicLinkResponse)(nil),       // 18: guardian.v1.VerifyMagicLinkResponse
+	(*SendEmailOTPRequest)(nil),           // 19: guardian.v1.SendEmailOTPRequest
+	(*SendEmailOTPResponse)(nil),          // 20: guardian.v1.SendEmailOTPResponse
+	(*VerifyEmailOTPRequest)(nil),         // 21: guardian.v1.VerifyEmailOTPRequest
+	(*VerifyEmailOTPResponse)(nil),        // 22: guardian.v1.VerifyEmailOTPResponse
+	(*SendEmailVerificationRequest)(nil),  // 23: guardian.v1.SendEmailVerificationRequest
+	(*SendEmailVerificationResponse)(nil), // 24: guardian.v1.SendEmailVerificationResponse
+	(*VerifyEmailRequest)(nil),            // 25: guardian.v1.VerifyEmailRequest
+	(*VerifyEmailResponse)(nil),           // 26: guardian.v1.VerifyEmailResponse
+	(*RequestPasswordResetRequest)(nil),   // 27: guardian.v1.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),  // 28: guardian.v1.RequestPasswordResetResponse
+	(*ResetPasswordRequest)(nil),          // 29: guardian.v1.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),         // 30: guardian.v1.ResetPasswordResponse
+	(*SignOutRequest)(nil),                // 31: guardian.v1.SignOutRequest
+	(*SignOutResponse)(nil),               // 32: guardian.v1.SignOutResponse
+	(*RefreshRequest)(nil),                // 33: guardian.v1.RefreshRequest
+	(*RefreshResponse)(nil),               // 34: guardian.v1.RefreshResponse
+	(*GetSessionRequest)(nil),             // 35: guardian.v1.GetSessionRequest
+	(*GetSessionResponse)(nil),            // 36: guardian.v1.GetSessionResponse
+	(*PasswordPolicyError_Violation)(nil), // 37: guardian.v1.PasswordPolicyError.Violation
+	(*timestamppb.Timestamp)(nil),         // 38: google.protobuf.Timestamp
+	(*User)(nil),                          // 39: guardian.v1.User
+}
+var file_guardian_v1_auth_proto_depIdxs = []int32{
+	38, // 0: guardian.v1.Session.created_at:type_name -> google.protobuf.Timestamp
+	38, // 1: guardian.v1.Session.last_seen_at:type_name -> google.protobuf.Timestamp
+	38, // 2: guardian.v1.Session.expires_at:type_name -> google.protobuf.Timestamp
+	38, // 3: guardian.v1.Tokens.access_token_expires_at:type_name -> google.protobuf.Timestamp
+	38, // 4: guardian.v1.Tokens.refresh_token_expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: guardian.v1.MFAChallenge.factors:type_name -> guardian.v1.MFAFactor
+	38, // 6: guardian.v1.MFAChallenge.expires_at:type_name -> google.protobuf.Timestamp
+	37, // 7: guardian.v1.PasswordPolicyError.violations:type_name -> guardian.v1.PasswordPolicyError.Violation
+	39, // 8: guardian.v1.SignUpResponse.user:type_name -> guardian.v1.User
+	1,  // 9: guardian.v1.SignUpResponse.session:type_name -> guardian.v1.Session
+	2,  // 10: guardian.v1.SignUpResponse.tokens:type_name -> guardian.v1.Tokens
+	39, // 11: guardian.v1.SignInResponse.user:type_name -> guardian.v1.User
+	1,  // 12: guardian.v1.SignInResponse.session:type_name -> guardian.v1.Session
+	2,  // 13: guardian.v1.SignInResponse.tokens:type_name -> guardian.v1.Tokens
+	3,  // 14: guardian.v1.SignInResponse.mfa_challenge:type_name -> guardian.v1.MFAChallenge
+	39, // 15: guardian.v1.VerifyMFAResponse.user:type_name -> guardian.v1.User
+	1,  // 16: guardian.v1.VerifyMFAResponse.session:type_name -> guardian.v1.Session
+	2,  // 17: guardian.v1.VerifyMFAResponse.tokens:type_name -> guardian.v1.Tokens
+	38, // 18: guardian.v1.BeginPasskeySignInResponse.expires_at:type_name -> google.protobuf.Timestamp
+	39, // 19: guardian.v1.FinishPasskeySignInResponse.user:type_name -> guardian.v1.User
+	1,  // 20: guardian.v1.FinishPasskeySignInResponse.session:type_name -> guardian.v1.Session
+	2,  // 21: guardian.v1.FinishPasskeySignInResponse.tokens:type_name -> guardian.v1.Tokens
+	39, // 22: guardian.v1.VerifyMagicLinkResponse.user:type_name -> guardian.v1.User
+	1,  // 23: guardian.v1.VerifyMagicLinkResponse.session:type_name -> guardian.v1.Session
+	2,  // 24: guardian.v1.VerifyMagicLinkResponse.tokens:type_name -> guardian.v1.Tokens
+	3,  // 25: guardian.v1.VerifyMagicLinkResponse.mfa_challenge:type_name -> guardian.v1.MFAChallenge
+	38, // 26: guardian.v1.SendEmailOTPResponse.expires_at:type_name -> google.protobuf.Timestamp
+	39, // 27: guardian.v1.VerifyEmailOTPResponse.user:type_name -> guardian.v1.User
+	1,  // 28: guardian.v1.VerifyEmailOTPResponse.session:type_name -> guardian.v1.Session
+	2,  // 29: guardian.v1.VerifyEmailOTPResponse.tokens:type_name -> guardian.v1.Tokens
+	3,  // 30: guardian.v1.VerifyEmailOTPResponse.mfa_challenge:type_name -> guardian.v1.MFAChallenge
+	39, // 31: guardian.v1.VerifyEmailResponse.user:type_name -> guardian.v1.User
+	2,  // 32: guardian.v1.RefreshResponse.tokens:type_name -> guardian.v1.Tokens
+	39, // 33: guardian.v1.GetSessionResponse.user:type_name -> guardian.v1.User
+	1,  // 34: guardian.v1.GetSessionResponse.session:type_name -> guardian.v1.Session
+	5,  // 35: guardian.v1.AuthService.SignUp:input_type -> guardian.v1.SignUpRequest
+	7,  // 36: guardian.v1.AuthService.SignIn:input_type -> guardian.v1.SignInRequest
+	9,  // 37: guardian.v1.AuthService.VerifyMFA:input_type -> guardian.v1.VerifyMFARequest
+	11, // 38: guardian.v1.AuthService.BeginPasskeySignIn:input_type -> guardian.v1.BeginPasskeySignInRequest
+	13, // 39: guardian.v1.AuthService.FinishPasskeySignIn:input_type -> guardian.v1.FinishPasskeySignInRequest
+	15, // 40: guardian.v1.AuthService.SendMagicLink:input_type -> guardian.v1.SendMagicLinkRequest
+	17, // 41: guardian.v1.AuthService.VerifyMagicLink:input_type -> guardian.v1.VerifyMagicLinkRequest
+	19, // 42: guardian.v1.AuthService.SendEmailOTP:input_type -> guardian.v1.SendEmailOTPRequest
+	21, // 43: guardian.v1.AuthService.VerifyEmailOTP:input_type -> guardian.v1.VerifyEmailOTPRequest
+	23, // 44: guardian.v1.AuthService.SendEmailVerification:input_type -> guardian.v1.SendEmailVerificationRequest
+	25, // 45: guardian.v1.AuthService.VerifyEmail:input_type -> guardian.v1.VerifyEmailRequest
+	27, // 46: guardian.v1.AuthService.RequestPasswordReset:input_type -> guardian.v1.RequestPasswordResetRequest
+	29, // 47: guardian.v1.AuthService.ResetPassword:input_type -> guardian.v1.ResetPasswordRequest
+	31, // 48: guardian.v1.AuthService.SignOut:input_type -> guardian.v1.SignOutRequest
+	33, // 49: guardian.v1.AuthService.Refresh:input_type -> guardian.v1.RefreshRequest
+	35, // 50: guardian.v1.AuthService.GetSession:input_type -> guardian.v1.GetSessionRequest
+	6,  // 51: guardian.v1.AuthService.SignUp:output_type -> guardian.v1.SignUpResponse
+	8,  // 52: guardian.v1.AuthService.SignIn:output_type -> guardian.v1.SignInResponse
+	10, // 53: guardian.v1.AuthService.VerifyMFA:output_type -> guardian.v1.VerifyMFAResponse
+	12, // 54: guardian.v1.AuthService.BeginPasskeySignIn:output_type -> guardian.v1.BeginPasskeySignInResponse
+	14, // 55: guardian.v1.AuthService.FinishPasskeySignIn:output_type -> guardian.v1.FinishPasskeySignInResponse
+	16, // 56: guardian.v1.AuthService.SendMagicLink:output_type -> guardian.v1.SendMagicLinkResponse
+	18, // 57: guardian.v1.AuthService.VerifyMagicLink:output_type -> guardian.v1.VerifyMagicLinkResponse
+	20, // 58: guardian.v1.AuthService.SendEmailOTP:output_type -> guardian.v1.SendEmailOTPResponse
+	22, // 59: guardian.v1.AuthService.VerifyEmailOTP:output_type -> guardian.v1.VerifyEmailOTPResponse
+	24, // 60: guardian.v1.AuthService.SendEmailVerification:output_type -> guardian.v1.SendEmailVerificationResponse
+	26, // 61: guardian.v1.AuthService.VerifyEmail:output_type -> guardian.v1.VerifyEmailResponse
+	28, // 62: guardian.v1.AuthService.RequestPasswordReset:output_type -> guardian.v1.RequestPasswordResetResponse
+	30, // 63: guardian.v1.AuthService.ResetPassword:output_type -> guardian.v1.ResetPasswordResponse
+	32, // 64: guardian.v1.AuthService.SignOut:output_type -> guardian.v1.SignOutResponse
+	34, // 65: guardian.v1.AuthService.Refresh:output_type -> guardian.v1.RefreshResponse
+	36, // 66: guardian.v1.AuthService.GetSession:output_type -> guardian.v1.GetSessionResponse
+	51, // [51:67] is the sub-list for method output_type
+	35, // [35:51] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
+}
+
+func init() { file_guardian_v1_auth_proto_init() }
+func file_guardian_v1_auth_proto_init() {
+	if File_guardian_v1_auth_proto != nil {
+		return
+	}
+	file_guardian_v1_user_proto_init()
+	file_guardian_v1_auth_proto_msgTypes[8].OneofWrappers = []any{
+		(*verifyMFARequest_TotpCode)(nil),
+		(*verifyMFARequest_RecoveryCode)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guardian_v1_auth_proto_rawDesc), len(file_guardian_v1_auth_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   37,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_guardian_v1_auth_proto_goTypes,
+		DependencyIndexes: file_guardian_v1_auth_proto_depIdxs,
+		EnumInfos:         file_guardian_v1_auth_proto_enumTypes,
+		MessageInfos:      file_guardian_v1_auth_proto_msgTypes,
+	}.Build()
+	File_guardian_v1_auth_proto = out.File
+	file_guardian_v1_auth_proto_goTypes = nil
+	file_guardian_v1_auth_proto_depIdxs = nil
+}
@@ -0,0 +1,4270 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: guardian/v1/authz.proto
+
+package guardianv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ExplainNodeKind is the kind of an ExplainNode.
+type ExplainNodeKind int32
+
+const (
+	ExplainNodeKind_EXPLAIN_NODE_KIND_UNSPECIFIED ExplainNodeKind = 0
+	// A checked permission, the root of role-based explanations.
+	ExplainNodeKind_EXPLAIN_NODE_KIND_PERMISSION ExplainNodeKind = 1
+	// A role assignment of the subject, named like a policy.
+	ExplainNodeKind_EXPLAIN_NODE_KIND_ASSIGNMENT ExplainNodeKind = 2
+	// A role reached through an assignment or by inheritance.
+	ExplainNodeKind_EXPLAIN_NODE_KIND_ROLE ExplainNodeKind = 3
+	// A checked relation of an object like `document:readme#viewer`.
+	ExplainNodeKind_EXPLAIN_NODE_KIND_RELATION ExplainNodeKind = 4
+	// Allowed if any child is.
+	ExplainNodeKind_EXPLAIN_NODE_KIND_UNION ExplainNodeKind = 5
+	// Allowed if all children are.
+	ExplainNodeKind_EXPLAIN_NODE_KIND_INTERSECTION ExplainNodeKind = 6
+	// Allowed if the first child is and the second is not.
+	ExplainNodeKind_EXPLAIN_NODE_KIND_EXCLUSION ExplainNodeKind = 7
+	// A relation tuple which was looked up or followed.
+	ExplainNodeKind_EXPLAIN_NODE_KIND_TUPLE ExplainNodeKind = 8
+	// A condition which was evaluated, with its expression as detail.
+	ExplainNodeKind_EXPLAIN_NODE_KIND_CONDITION ExplainNodeKind = 9
+)
+
+// Enum value maps for ExplainNodeKind.
+var (
+	ExplainNodeKind_name = map[int32]string{
+		0: "EXPLAIN_NODE_KIND_UNSPECIFIED",
+		1: "EXPLAIN_NODE_KIND_PERMISSION",
+		2: "EXPLAIN_NODE_KIND_ASSIGNMENT",
+		3: "EXPLAIN_NODE_KIND_ROLE",
+		4: "EXPLAIN_NODE_KIND_RELATION",
+		5: "EXPLAIN_NODE_KIND_UNION",
+		6: "EXPLAIN_NODE_KIND_INTERSECTION",
+		7: "EXPLAIN_NODE_KIND_EXCLUSION",
+		8: "EXPLAIN_NODE_KIND_TUPLE",
+		9: "EXPLAIN_NODE_KIND_CONDITION",
+	}
+	ExplainNodeKind_value = map[string]int32{
+		"EXPLAIN_NODE_KIND_UNSPECIFIED":  0,
+		"EXPLAIN_NODE_KIND_PERMISSION":   1,
+		"EXPLAIN_NODE_KIND_ASSIGNMENT":   2,
+		"EXPLAIN_NODE_KIND_ROLE":         3,
+		"EXPLAIN_NODE_KIND_RELATION":     4,
+		"EXPLAIN_NODE_KIND_UNION":        5,
+		"EXPLAIN_NODE_KIND_INTERSECTION": 6,
+		"EXPLAIN_NODE_KIND_EXCLUSION":    7,
+		"EXPLAIN_NODE_KIND_TUPLE":        8,
+		"EXPLAIN_NODE_KIND_CONDITION":    9,
+	}
+)
+
+func (x ExplainNodeKind) Enum() *ExplainNodeKind {
+	p := new(ExplainNodeKind)
+	*p = x
+	return p
+}
+
+func (x ExplainNodeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExplainNodeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_guardian_v1_authz_proto_enumTypes[0].Descriptor()
+}
+
+func (ExplainNodeKind) Type() protoreflect.EnumType {
+	return &file_guardian_v1_authz_proto_enumTypes[0]
+}
+
+func (x ExplainNodeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Permission is a named action which can be granted to roles.
+type Permission struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        string                 `protobuf:"bytes,1,opt,name=name,proto3"`
+	xxx_hidden_Description string                 `protobuf:"bytes,2,opt,name=description,proto3"`
+	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Permission) Reset() {
+	*x = Permission{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Permission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Permission) ProtoMessage() {}
+
+func (x *Permission) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Permission) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *Permission) GetDescription() string {
+	if x != nil {
+		return x.xxx_hidden_Description
+	}
+	return ""
+}
+
+func (x *Permission) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *Permission) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *Permission) SetDescription(v string) {
+	x.xxx_hidden_Description = v
+}
+
+func (x *Permission) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *Permission) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *Permission) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+type Permission_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name        string
+	Description string
+	CreatedAt   *timestamppb.Timestamp
+}
+
+func (b0 Permission_builder) Build() *Permission {
+	m0 := &Permission{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_Description = b.Description
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	return m0
+}
+
+// Role is a named set of permissions. A role has all permissions of its parents.
+type Role struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Name        string                 `protobuf:"bytes,2,opt,name=name,proto3"`
+	xxx_hidden_Description string                 `protobuf:"bytes,3,opt,name=description,proto3"`
+	xxx_hidden_Permissions []string               `protobuf:"bytes,4,rep,name=permissions,proto3"`
+	xxx_hidden_ParentIds   []string               `protobuf:"bytes,5,rep,name=parent_ids,json=parentIds,proto3"`
+	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3"`
+	xxx_hidden_UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Role) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *Role) GetDescription() string {
+	if x != nil {
+		return x.xxx_hidden_Description
+	}
+	return ""
+}
+
+func (x *Role) GetPermissions() []string {
+	if x != nil {
+		return x.xxx_hidden_Permissions
+	}
+	return nil
+}
+
+func (x *Role) GetParentIds() []string {
+	if x != nil {
+		return x.xxx_hidden_ParentIds
+	}
+	return nil
+}
+
+func (x *Role) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *Role) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
+	}
+	return nil
+}
+
+func (x *Role) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *Role) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *Role) SetDescription(v string) {
+	x.xxx_hidden_Description = v
+}
+
+func (x *Role) SetPermissions(v []string) {
+	x.xxx_hidden_Permissions = v
+}
+
+func (x *Role) SetParentIds(v []string) {
+	x.xxx_hidden_ParentIds = v
+}
+
+func (x *Role) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *Role) SetUpdatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *Role) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *Role) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *Role) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *Role) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+type Role_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id          string
+	Name        string
+	Description string
+	// Permissions granted directly to the role.
+	Permissions []string
+	// Ids of the roles the role inherits from directly.
+	ParentIds []string
+	CreatedAt *timestamppb.Timestamp
+	UpdatedAt *timestamppb.Timestamp
+}
+
+func (b0 Role_builder) Build() *Role {
+	m0 := &Role{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_Description = b.Description
+	x.xxx_hidden_Permissions = b.Permissions
+	x.xxx_hidden_ParentIds = b.ParentIds
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	return m0
+}
+
+// RoleAssignment grants the permissions of a role to a user or a service account in a scope.
+type RoleAssignment struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id               string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3"`
+	xxx_hidden_RoleId           string                 `protobuf:"bytes,3,opt,name=role_id,json=roleId,proto3"`
+	xxx_hidden_Scope            string                 `protobuf:"bytes,4,opt,name=scope,proto3"`
+	xxx_hidden_CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3"`
+	xxx_hidden_Condition        string                 `protobuf:"bytes,6,opt,name=condition,proto3"`
+	xxx_hidden_ServiceAccountId string                 `protobuf:"bytes,7,opt,name=service_account_id,json=serviceAccountId,proto3"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *RoleAssignment) Reset() {
+	*x = RoleAssignment{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleAssignment) ProtoMessage() {}
+
+func (x *RoleAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RoleAssignment) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *RoleAssignment) GetUserId() string {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return ""
+}
+
+func (x *RoleAssignment) GetRoleId() string {
+	if x != nil {
+		return x.xxx_hidden_RoleId
+	}
+	return ""
+}
+
+func (x *RoleAssignment) GetScope() string {
+	if x != nil {
+		return x.xxx_hidden_Scope
+	}
+	return ""
+}
+
+func (x *RoleAssignment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *RoleAssignment) GetCondition() string {
+	if x != nil {
+		return x.xxx_hidden_Condition
+	}
+	return ""
+}
+
+func (x *RoleAssignment) GetServiceAccountId() string {
+	if x != nil {
+		return x.xxx_hidden_ServiceAccountId
+	}
+	return ""
+}
+
+func (x *RoleAssignment) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *RoleAssignment) SetUserId(v string) {
+	x.xxx_hidden_UserId = v
+}
+
+func (x *RoleAssignment) SetRoleId(v string) {
+	x.xxx_hidden_RoleId = v
+}
+
+func (x *RoleAssignment) SetScope(v string) {
+	x.xxx_hidden_Scope = v
+}
+
+func (x *RoleAssignment) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *RoleAssignment) SetCondition(v string) {
+	x.xxx_hidden_Condition = v
+}
+
+func (x *RoleAssignment) SetServiceAccountId(v string) {
+	x.xxx_hidden_ServiceAccountId = v
+}
+
+func (x *RoleAssignment) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *RoleAssignment) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+type RoleAssignment_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+	// User the role is assigned to, empty for assignments to service accounts.
+	UserId string
+	RoleId string
+	// Organization the assignment is scoped to, empty for global assignments.
+	Scope     string
+	CreatedAt *timestamppb.Timestamp
+	// Name of the condition the assignment requires, empty for unconditional assignments.
+	Condition string
+	// Service account the role is assigned to, empty for assignments to users.
+	ServiceAccountId string
+}
+
+func (b0 RoleAssignment_builder) Build() *RoleAssignment {
+	m0 := &RoleAssignment{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_UserId = b.UserId
+	x.xxx_hidden_RoleId = b.RoleId
+	x.xxx_hidden_Scope = b.Scope
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_Condition = b.Condition
+	x.xxx_hidden_ServiceAccountId = b.ServiceAccountId
+	return m0
+}
+
+// Condition is a named CEL expression which grants can require to hold. Expressions evaluate to a bool and can use
+// the variables `time` (timestamp), `ip` (string, empty if unknown), `user` and `resource` (maps of the attributes
+// in AttributeContext). `ip.inCidr('10.0.0.0/8')` reports whether ip is in a network.
+type Condition struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        string                 `protobuf:"bytes,1,opt,name=name,proto3"`
+	xxx_hidden_Expression  string                 `protobuf:"bytes,2,opt,name=expression,proto3"`
+	xxx_hidden_Description string                 `protobuf:"bytes,3,opt,name=description,proto3"`
+	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3"`
+	xxx_hidden_UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Condition) Reset() {
+	*x = Condition{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Condition) ProtoMessage() {}
+
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Condition) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *Condition) GetExpression() string {
+	if x != nil {
+		return x.xxx_hidden_Expression
+	}
+	return ""
+}
+
+func (x *Condition) GetDescription() string {
+	if x != nil {
+		return x.xxx_hidden_Description
+	}
+	return ""
+}
+
+func (x *Condition) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *Condition) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
+	}
+	return nil
+}
+
+func (x *Condition) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *Condition) SetExpression(v string) {
+	x.xxx_hidden_Expression = v
+}
+
+func (x *Condition) SetDescription(v string) {
+	x.xxx_hidden_Description = v
+}
+
+func (x *Condition) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *Condition) SetUpdatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *Condition) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *Condition) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *Condition) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *Condition) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+type Condition_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name        string
+	Expression  string
+	Description string
+	CreatedAt   *timestamppb.Timestamp
+	UpdatedAt   *timestamppb.Timestamp
+}
+
+func (b0 Condition_builder) Build() *Condition {
+	m0 := &Condition{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_Expression = b.Expression
+	x.xxx_hidden_Description = b.Description
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	return m0
+}
+
+// AttributeContext holds the attributes conditions are evaluated against.
+type AttributeContext struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Time     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3"`
+	xxx_hidden_Ip       string                 `protobuf:"bytes,2,opt,name=ip,proto3"`
+	xxx_hidden_User     *structpb.Struct       `protobuf:"bytes,3,opt,name=user,proto3"`
+	xxx_hidden_Resource *structpb.Struct       `protobuf:"bytes,4,opt,name=resource,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AttributeContext) Reset() {
+	*x = AttributeContext{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeContext) ProtoMessage() {}
+
+func (x *AttributeContext) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AttributeContext) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Time
+	}
+	return nil
+}
+
+func (x *AttributeContext) GetIp() string {
+	if x != nil {
+		return x.xxx_hidden_Ip
+	}
+	return ""
+}
+
+func (x *AttributeContext) GetUser() *structpb.Struct {
+	if x != nil {
+		return x.xxx_hidden_User
+	}
+	return nil
+}
+
+func (x *AttributeContext) GetResource() *structpb.Struct {
+	if x != nil {
+		return x.xxx_hidden_Resource
+	}
+	return nil
+}
+
+func (x *AttributeContext) SetTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Time = v
+}
+
+func (x *AttributeContext) SetIp(v string) {
+	x.xxx_hidden_Ip = v
+}
+
+func (x *AttributeContext) SetUser(v *structpb.Struct) {
+	x.xxx_hidden_User = v
+}
+
+func (x *AttributeContext) SetResource(v *structpb.Struct) {
+	x.xxx_hidden_Resource = v
+}
+
+func (x *AttributeContext) HasTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Time != nil
+}
+
+func (x *AttributeContext) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_User != nil
+}
+
+func (x *AttributeContext) HasResource() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Resource != nil
+}
+
+func (x *AttributeContext) ClearTime() {
+	x.xxx_hidden_Time = nil
+}
+
+func (x *AttributeContext) ClearUser() {
+	x.xxx_hidden_User = nil
+}
+
+func (x *AttributeContext) ClearResource() {
+	x.xxx_hidden_Resource = nil
+}
+
+type AttributeContext_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Time of the request, the time the check is received if unset.
+	Time *timestamppb.Timestamp
+	// Address of the client the check is made for. Defaults to the address of the caller when it checks itself.
+	Ip       string
+	User     *structpb.Struct
+	Resource *structpb.Struct
+}
+
+func (b0 AttributeContext_builder) Build() *AttributeContext {
+	m0 := &AttributeContext{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Time = b.Time
+	x.xxx_hidden_Ip = b.Ip
+	x.xxx_hidden_User = b.User
+	x.xxx_hidden_Resource = b.Resource
+	return m0
+}
+
+// ExplainNode is a node of the evaluation tree of an explained check. Children are in the order they were evaluated.
+type ExplainNode struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Kind     ExplainNodeKind        `protobuf:"varint,1,opt,name=kind,proto3,enum=guardian.v1.ExplainNodeKind"`
+	xxx_hidden_Name     string                 `protobuf:"bytes,2,opt,name=name,proto3"`
+	xxx_hidden_Allowed  bool                   `protobuf:"varint,3,opt,name=allowed,proto3"`
+	xxx_hidden_Detail   string                 `protobuf:"bytes,4,opt,name=detail,proto3"`
+	xxx_hidden_Children *[]*ExplainNode        `protobuf:"bytes,5,rep,name=children,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ExplainNode) Reset() {
+	*x = ExplainNode{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainNode) ProtoMessage() {}
+
+func (x *ExplainNode) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ExplainNode) GetKind() ExplainNodeKind {
+	if x != nil {
+		return x.xxx_hidden_Kind
+	}
+	return ExplainNodeKind_EXPLAIN_NODE_KIND_UNSPECIFIED
+}
+
+func (x *ExplainNode) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *ExplainNode) GetAllowed() bool {
+	if x != nil {
+		return x.xxx_hidden_Allowed
+	}
+	return false
+}
+
+func (x *ExplainNode) GetDetail() string {
+	if x != nil {
+		return x.xxx_hidden_Detail
+	}
+	return ""
+}
+
+func (x *ExplainNode) GetChildren() []*ExplainNode {
+	if x != nil {
+		if x.xxx_hidden_Children != nil {
+			return *x.xxx_hidden_Children
+		}
+	}
+	return nil
+}
+
+func (x *ExplainNode) SetKind(v ExplainNodeKind) {
+	x.xxx_hidden_Kind = v
+}
+
+func (x *ExplainNode) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *ExplainNode) SetAllowed(v bool) {
+	x.xxx_hidden_Allowed = v
+}
+
+func (x *ExplainNode) SetDetail(v string) {
+	x.xxx_hidden_Detail = v
+}
+
+func (x *ExplainNode) SetChildren(v []*ExplainNode) {
+	x.xxx_hidden_Children = &v
+}
+
+type ExplainNode_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Kind    ExplainNodeKind
+	Name    string
+	Allowed bool
+	// Additional information like the expression of a condition or why evaluation stopped.
+	Detail   string
+	Children []*ExplainNode
+}
+
+func (b0 ExplainNode_builder) Build() *ExplainNode {
+	m0 := &ExplainNode{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Kind = b.Kind
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_Allowed = b.Allowed
+	x.xxx_hidden_Detail = b.Detail
+	x.xxx_hidden_Children = &b.Children
+	return m0
+}
+
+type CreatePermissionRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        string                 `protobuf:"bytes,1,opt,name=name,proto3"`
+	xxx_hidden_Description string                 `protobuf:"bytes,2,opt,name=description,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreatePermissionRequest) Reset() {
+	*x = CreatePermissionRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePermissionRequest) ProtoMessage() {}
+
+func (x *CreatePermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreatePermissionRequest) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *CreatePermissionRequest) GetDescription() string {
+	if x != nil {
+		return x.xxx_hidden_Description
+	}
+	return ""
+}
+
+func (x *CreatePermissionRequest) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *CreatePermissionRequest) SetDescription(v string) {
+	x.xxx_hidden_Description = v
+}
+
+type CreatePermissionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name        string
+	Description string
+}
+
+func (b0 CreatePermissionRequest_builder) Build() *CreatePermissionRequest {
+	m0 := &CreatePermissionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_Description = b.Description
+	return m0
+}
+
+type CreatePermissionResponse struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Permission *Permission            `protobuf:"bytes,1,opt,name=permission,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CreatePermissionResponse) Reset() {
+	*x = CreatePermissionResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePermissionResponse) ProtoMessage() {}
+
+func (x *CreatePermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreatePermissionResponse) GetPermission() *Permission {
+	if x != nil {
+		return x.xxx_hidden_Permission
+	}
+	return nil
+}
+
+func (x *CreatePermissionResponse) SetPermission(v *Permission) {
+	x.xxx_hidden_Permission = v
+}
+
+func (x *CreatePermissionResponse) HasPermission() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Permission != nil
+}
+
+func (x *CreatePermissionResponse) ClearPermission() {
+	x.xxx_hidden_Permission = nil
+}
+
+type CreatePermissionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Permission *Permission
+}
+
+func (b0 CreatePermissionResponse_builder) Build() *CreatePermissionResponse {
+	m0 := &CreatePermissionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Permission = b.Permission
+	return m0
+}
+
+type ListPermissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPermissionsRequest) Reset() {
+	*x = ListPermissionsRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPermissionsRequest) ProtoMessage() {}
+
+func (x *ListPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type ListPermissionsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 ListPermissionsRequest_builder) Build() *ListPermissionsRequest {
+	m0 := &ListPermissionsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type ListPermissionsResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Permissions *[]*Permission         `protobuf:"bytes,1,rep,name=permissions,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ListPermissionsResponse) Reset() {
+	*x = ListPermissionsResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPermissionsResponse) ProtoMessage() {}
+
+func (x *ListPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListPermissionsResponse) GetPermissions() []*Permission {
+	if x != nil {
+		if x.xxx_hidden_Permissions != nil {
+			return *x.xxx_hidden_Permissions
+		}
+	}
+	return nil
+}
+
+func (x *ListPermissionsResponse) SetPermissions(v []*Permission) {
+	x.xxx_hidden_Permissions = &v
+}
+
+type ListPermissionsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Permissions []*Permission
+}
+
+func (b0 ListPermissionsResponse_builder) Build() *ListPermissionsResponse {
+	m0 := &ListPermissionsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Permissions = &b.Permissions
+	return m0
+}
+
+type DeletePermissionRequest struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name string                 `protobuf:"bytes,1,opt,name=name,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeletePermissionRequest) Reset() {
+	*x = DeletePermissionRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePermissionRequest) ProtoMessage() {}
+
+func (x *DeletePermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeletePermissionRequest) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *DeletePermissionRequest) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+type DeletePermissionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name string
+}
+
+func (b0 DeletePermissionRequest_builder) Build() *DeletePermissionRequest {
+	m0 := &DeletePermissionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Name = b.Name
+	return m0
+}
+
+type DeletePermissionResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePermissionResponse) Reset() {
+	*x = DeletePermissionResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePermissionResponse) ProtoMessage() {}
+
+func (x *DeletePermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeletePermissionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeletePermissionResponse_builder) Build() *DeletePermissionResponse {
+	m0 := &DeletePermissionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type CreateRoleRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        string                 `protobuf:"bytes,1,opt,name=name,proto3"`
+	xxx_hidden_Description string                 `protobuf:"bytes,2,opt,name=description,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateRoleRequest) Reset() {
+	*x = CreateRoleRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleRequest) ProtoMessage() {}
+
+func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateRoleRequest) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *CreateRoleRequest) GetDescription() string {
+	if x != nil {
+		return x.xxx_hidden_Description
+	}
+	return ""
+}
+
+func (x *CreateRoleRequest) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *CreateRoleRequest) SetDescription(v string) {
+	x.xxx_hidden_Description = v
+}
+
+type CreateRoleRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name        string
+	Description string
+}
+
+func (b0 CreateRoleRequest_builder) Build() *CreateRoleRequest {
+	m0 := &CreateRoleRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_Description = b.Description
+	return m0
+}
+
+type CreateRoleResponse struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Role *Role                  `protobuf:"bytes,1,opt,name=role,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateRoleResponse) Reset() {
+	*x = CreateRoleResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleResponse) ProtoMessage() {}
+
+func (x *CreateRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateRoleResponse) GetRole() *Role {
+	if x != nil {
+		return x.xxx_hidden_Role
+	}
+	return nil
+}
+
+func (x *CreateRoleResponse) SetRole(v *Role) {
+	x.xxx_hidden_Role = v
+}
+
+func (x *CreateRoleResponse) HasRole() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Role != nil
+}
+
+func (x *CreateRoleResponse) ClearRole() {
+	x.xxx_hidden_Role = nil
+}
+
+type CreateRoleResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Role *Role
+}
+
+func (b0 CreateRoleResponse_builder) Build() *CreateRoleResponse {
+	m0 := &CreateRoleResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Role = b.Role
+	return m0
+}
+
+type GetRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoleRequest) Reset() {
+	*x = GetRoleRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoleRequest) ProtoMessage() {}
+
+func (x *GetRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetRoleRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *GetRoleRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type GetRoleRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 GetRoleRequest_builder) Build() *GetRoleRequest {
+	m0 := &GetRoleRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type GetRoleResponse struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Role *Role                  `protobuf:"bytes,1,opt,name=role,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetRoleResponse) Reset() {
+	*x = GetRoleResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoleResponse) ProtoMessage() {}
+
+func (x *GetRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetRoleResponse) GetRole() *Role {
+	if x != nil {
+		return x.xxx_hidden_Role
+	}
+	return nil
+}
+
+func (x *GetRoleResponse) SetRole(v *Role) {
+	x.xxx_hidden_Role = v
+}
+
+func (x *GetRoleResponse) HasRole() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Role != nil
+}
+
+func (x *GetRoleResponse) ClearRole() {
+	x.xxx_hidden_Role = nil
+}
+
+type GetRoleResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Role *Role
+}
+
+func (b0 GetRoleResponse_builder) Build() *GetRoleResponse {
+	m0 := &GetRoleResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Role = b.Role
+	return m0
+}
+
+type ListRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesRequest) Reset() {
+	*x = ListRolesRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesRequest) ProtoMessage() {}
+
+func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type ListRolesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 ListRolesRequest_builder) Build() *ListRolesRequest {
+	m0 := &ListRolesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type ListRolesResponse struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Roles *[]*Role               `protobuf:"bytes,1,rep,name=roles,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListRolesResponse) Reset() {
+	*x = ListRolesResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesResponse) ProtoMessage() {}
+
+func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListRolesResponse) GetRoles() []*Role {
+	if x != nil {
+		if x.xxx_hidden_Roles != nil {
+			return *x.xxx_hidden_Roles
+		}
+	}
+	return nil
+}
+
+func (x *ListRolesResponse) SetRoles(v []*Role) {
+	x.xxx_hidden_Roles = &v
+}
+
+type ListRolesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Roles []*Role
+}
+
+func (b0 ListRolesResponse_builder) Build() *ListRolesResponse {
+	m0 := &ListRolesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Roles = &b.Roles
+	return m0
+}
+
+type UpdateRoleRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof"`
+	xxx_hidden_Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateRoleRequest) Reset() {
+	*x = UpdateRoleRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleRequest) ProtoMessage() {}
+
+func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateRoleRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetDescription() string {
+	if x != nil {
+		if x.xxx_hidden_Description != nil {
+			return *x.xxx_hidden_Description
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *UpdateRoleRequest) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *UpdateRoleRequest) SetDescription(v string) {
+	x.xxx_hidden_Description = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *UpdateRoleRequest) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *UpdateRoleRequest) HasDescription() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *UpdateRoleRequest) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *UpdateRoleRequest) ClearDescription() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Description = nil
+}
+
+type UpdateRoleRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id          string
+	Name        *string
+	Description *string
+}
+
+func (b0 UpdateRoleRequest_builder) Build() *UpdateRoleRequest {
+	m0 := &UpdateRoleRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Description != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Description = b.Description
+	}
+	return m0
+}
+
+type UpdateRoleResponse struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Role *Role                  `protobuf:"bytes,1,opt,name=role,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateRoleResponse) Reset() {
+	*x = UpdateRoleResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleResponse) ProtoMessage() {}
+
+func (x *UpdateRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateRoleResponse) GetRole() *Role {
+	if x != nil {
+		return x.xxx_hidden_Role
+	}
+	return nil
+}
+
+func (x *UpdateRoleResponse) SetRole(v *Role) {
+	x.xxx_hidden_Role = v
+}
+
+func (x *UpdateRoleResponse) HasRole() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Role != nil
+}
+
+func (x *UpdateRoleResponse) ClearRole() {
+	x.xxx_hidden_Role = nil
+}
+
+type UpdateRoleResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Role *Role
+}
+
+func (b0 UpdateRoleResponse_builder) Build() *UpdateRoleResponse {
+	m0 := &UpdateRoleResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Role = b.Role
+	return m0
+}
+
+type DeleteRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleRequest) Reset() {
+	*x = DeleteRoleRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleRequest) ProtoMessage() {}
+
+func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteRoleRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *DeleteRoleRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type DeleteRoleRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 DeleteRoleRequest_builder) Build() *DeleteRoleRequest {
+	m0 := &DeleteRoleRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type DeleteRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleResponse) Reset() {
+	*x = DeleteRoleResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleResponse) ProtoMessage() {}
+
+func (x *DeleteRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeleteRoleResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeleteRoleResponse_builder) Build() *DeleteRoleResponse {
+	m0 := &DeleteRoleResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GrantPermissionRequest struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_RoleId     string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3"`
+	xxx_hidden_Permission string                 `protobuf:"bytes,2,opt,name=permission,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GrantPermissionRequest) Reset() {
+	*x = GrantPermissionRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantPermissionRequest) ProtoMessage() {}
+
+func (x *GrantPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GrantPermissionRequest) GetRoleId() string {
+	if x != nil {
+		return x.xxx_hidden_RoleId
+	}
+	return ""
+}
+
+func (x *GrantPermissionRequest) GetPermission() string {
+	if x != nil {
+		return x.xxx_hidden_Permission
+	}
+	return ""
+}
+
+func (x *GrantPermissionRequest) SetRoleId(v string) {
+	x.xxx_hidden_RoleId = v
+}
+
+func (x *GrantPermissionRequest) SetPermission(v string) {
+	x.xxx_hidden_Permission = v
+}
+
+type GrantPermissionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	RoleId     string
+	Permission string
+}
+
+func (b0 GrantPermissionRequest_builder) Build() *GrantPermissionRequest {
+	m0 := &GrantPermissionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_RoleId = b.RoleId
+	x.xxx_hidden_Permission = b.Permission
+	return m0
+}
+
+type GrantPermissionResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantPermissionResponse) Reset() {
+	*x = GrantPermissionResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantPermissionResponse) ProtoMessage() {}
+
+func (x *GrantPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type GrantPermissionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 GrantPermissionResponse_builder) Build() *GrantPermissionResponse {
+	m0 := &GrantPermissionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type RevokePermissionRequest struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_RoleId     string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3"`
+	xxx_hidden_Permission string                 `protobuf:"bytes,2,opt,name=permission,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RevokePermissionRequest) Reset() {
+	*x = RevokePermissionRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokePermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokePermissionRequest) ProtoMessage() {}
+
+func (x *RevokePermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RevokePermissionRequest) GetRoleId() string {
+	if x != nil {
+		return x.xxx_hidden_RoleId
+	}
+	return ""
+}
+
+func (x *RevokePermissionRequest) GetPermission() string {
+	if x != nil {
+		return x.xxx_hidden_Permission
+	}
+	return ""
+}
+
+func (x *RevokePermissionRequest) SetRoleId(v string) {
+	x.xxx_hidden_RoleId = v
+}
+
+func (x *RevokePermissionRequest) SetPermission(v string) {
+	x.xxx_hidden_Permission = v
+}
+
+type RevokePermissionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	RoleId     string
+	Permission string
+}
+
+func (b0 RevokePermissionRequest_builder) Build() *RevokePermissionRequest {
+	m0 := &RevokePermissionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_RoleId = b.RoleId
+	x.xxx_hidden_Permission = b.Permission
+	return m0
+}
+
+type RevokePermissionResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokePermissionResponse) Reset() {
+	*x = RevokePermissionResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokePermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokePermissionResponse) ProtoMessage() {}
+
+func (x *RevokePermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RevokePermissionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RevokePermissionResponse_builder) Build() *RevokePermissionResponse {
+	m0 := &RevokePermissionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type AddRoleParentRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_RoleId   string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3"`
+	xxx_hidden_ParentId string                 `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AddRoleParentRequest) Reset() {
+	*x = AddRoleParentRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRoleParentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRoleParentRequest) ProtoMessage() {}
+
+func (x *AddRoleParentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AddRoleParentRequest) GetRoleId() string {
+	if x != nil {
+		return x.xxx_hidden_RoleId
+	}
+	return ""
+}
+
+func (x *AddRoleParentRequest) GetParentId() string {
+	if x != nil {
+		return x.xxx_hidden_ParentId
+	}
+	return ""
+}
+
+func (x *AddRoleParentRequest) SetRoleId(v string) {
+	x.xxx_hidden_RoleId = v
+}
+
+func (x *AddRoleParentRequest) SetParentId(v string) {
+	x.xxx_hidden_ParentId = v
+}
+
+type AddRoleParentRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	RoleId   string
+	ParentId string
+}
+
+func (b0 AddRoleParentRequest_builder) Build() *AddRoleParentRequest {
+	m0 := &AddRoleParentRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_RoleId = b.RoleId
+	x.xxx_hidden_ParentId = b.ParentId
+	return m0
+}
+
+type AddRoleParentResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRoleParentResponse) Reset() {
+	*x = AddRoleParentResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRoleParentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRoleParentResponse) ProtoMessage() {}
+
+func (x *AddRoleParentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type AddRoleParentResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 AddRoleParentResponse_builder) Build() *AddRoleParentResponse {
+	m0 := &AddRoleParentResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type RemoveRoleParentRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_RoleId   string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3"`
+	xxx_hidden_ParentId string                 `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RemoveRoleParentRequest) Reset() {
+	*x = RemoveRoleParentRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRoleParentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRoleParentRequest) ProtoMessage() {}
+
+func (x *RemoveRoleParentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RemoveRoleParentRequest) GetRoleId() string {
+	if x != nil {
+		return x.xxx_hidden_RoleId
+	}
+	return ""
+}
+
+func (x *RemoveRoleParentRequest) GetParentId() string {
+	if x != nil {
+		return x.xxx_hidden_ParentId
+	}
+	return ""
+}
+
+func (x *RemoveRoleParentRequest) SetRoleId(v string) {
+	x.xxx_hidden_RoleId = v
+}
+
+func (x *RemoveRoleParentRequest) SetParentId(v string) {
+	x.xxx_hidden_ParentId = v
+}
+
+type RemoveRoleParentRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	RoleId   string
+	ParentId string
+}
+
+func (b0 RemoveRoleParentRequest_builder) Build() *RemoveRoleParentRequest {
+	m0 := &RemoveRoleParentRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_RoleId = b.RoleId
+	x.xxx_hidden_ParentId = b.ParentId
+	return m0
+}
+
+type RemoveRoleParentResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveRoleParentResponse) Reset() {
+	*x = RemoveRoleParentResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRoleParentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRoleParentResponse) ProtoMessage() {}
+
+func (x *RemoveRoleParentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RemoveRoleParentResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RemoveRoleParentResponse_builder) Build() *RemoveRoleParentResponse {
+	m0 := &RemoveRoleParentResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type CreateConditionRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        string                 `protobuf:"bytes,1,opt,name=name,proto3"`
+	xxx_hidden_Expression  string                 `protobuf:"bytes,2,opt,name=expression,proto3"`
+	xxx_hidden_Description string                 `protobuf:"bytes,3,opt,name=description,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateConditionRequest) Reset() {
+	*x = CreateConditionRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateConditionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConditionRequest) ProtoMessage() {}
+
+func (x *CreateConditionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateConditionRequest) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *CreateConditionRequest) GetExpression() string {
+	if x != nil {
+		return x.xxx_hidden_Expression
+	}
+	return ""
+}
+
+func (x *CreateConditionRequest) GetDescription() string {
+	if x != nil {
+		return x.xxx_hidden_Description
+	}
+	return ""
+}
+
+func (x *CreateConditionRequest) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *CreateConditionRequest) SetExpression(v string) {
+	x.xxx_hidden_Expression = v
+}
+
+func (x *CreateConditionRequest) SetDescription(v string) {
+	x.xxx_hidden_Description = v
+}
+
+type CreateConditionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name        string
+	Expression  string
+	Description string
+}
+
+func (b0 CreateConditionRequest_builder) Build() *CreateConditionRequest {
+	m0 := &CreateConditionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_Expression = b.Expression
+	x.xxx_hidden_Description = b.Description
+	return m0
+}
+
+type CreateConditionResponse struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Condition *Condition             `protobuf:"bytes,1,opt,name=condition,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateConditionResponse) Reset() {
+	*x = CreateConditionResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateConditionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConditionResponse) ProtoMessage() {}
+
+func (x *CreateConditionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateConditionResponse) GetCondition() *Condition {
+	if x != nil {
+		return x.xxx_hidden_Condition
+	}
+	return nil
+}
+
+func (x *CreateConditionResponse) SetCondition(v *Condition) {
+	x.xxx_hidden_Condition = v
+}
+
+func (x *CreateConditionResponse) HasCondition() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Condition != nil
+}
+
+func (x *CreateConditionResponse) ClearCondition() {
+	x.xxx_hidden_Condition = nil
+}
+
+type CreateConditionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Condition *Condition
+}
+
+func (b0 CreateConditionResponse_builder) Build() *CreateConditionResponse {
+	m0 := &CreateConditionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Condition = b.Condition
+	return m0
+}
+
+type GetConditionRequest struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name string                 `protobuf:"bytes,1,opt,name=name,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetConditionRequest) Reset() {
+	*x = GetConditionRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConditionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConditionRequest) ProtoMessage() {}
+
+func (x *GetConditionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetConditionRequest) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *GetConditionRequest) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+type GetConditionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name string
+}
+
+func (b0 GetConditionRequest_builder) Build() *GetConditionRequest {
+	m0 := &GetConditionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Name = b.Name
+	return m0
+}
+
+type GetConditionResponse struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Condition *Condition             `protobuf:"bytes,1,opt,name=condition,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetConditionResponse) Reset() {
+	*x = GetConditionResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConditionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConditionResponse) ProtoMessage() {}
+
+func (x *GetConditionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetConditionResponse) GetCondition() *Condition {
+	if x != nil {
+		return x.xxx_hidden_Condition
+	}
+	return nil
+}
+
+func (x *GetConditionResponse) SetCondition(v *Condition) {
+	x.xxx_hidden_Condition = v
+}
+
+func (x *GetConditionResponse) HasCondition() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Condition != nil
+}
+
+func (x *GetConditionResponse) ClearCondition() {
+	x.xxx_hidden_Condition = nil
+}
+
+type GetConditionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Condition *Condition
+}
+
+func (b0 GetConditionResponse_builder) Build() *GetConditionResponse {
+	m0 := &GetConditionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Condition = b.Condition
+	return m0
+}
+
+type ListConditionsRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConditionsRequest) Reset() {
+	*x = ListConditionsRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConditionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConditionsRequest) ProtoMessage() {}
+
+func (x *ListConditionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type ListConditionsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 ListConditionsRequest_builder) Build() *ListConditionsRequest {
+	m0 := &ListConditionsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type ListConditionsResponse struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Conditions *[]*Condition          `protobuf:"bytes,1,rep,name=conditions,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ListConditionsResponse) Reset() {
+	*x = ListConditionsResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConditionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConditionsResponse) ProtoMessage() {}
+
+func (x *ListConditionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListConditionsResponse) GetConditions() []*Condition {
+	if x != nil {
+		if x.xxx_hidden_Conditions != nil {
+			return *x.xxx_hidden_Conditions
+		}
+	}
+	return nil
+}
+
+func (x *ListConditionsResponse) SetConditions(v []*Condition) {
+	x.xxx_hidden_Conditions = &v
+}
+
+type ListConditionsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Conditions []*Condition
+}
+
+func (b0 ListConditionsResponse_builder) Build() *ListConditionsResponse {
+	m0 := &ListConditionsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Conditions = &b.Conditions
+	return m0
+}
+
+type UpdateConditionRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        string                 `protobuf:"bytes,1,opt,name=name,proto3"`
+	xxx_hidden_Expression  *string                `protobuf:"bytes,2,opt,name=expression,proto3,oneof"`
+	xxx_hidden_Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateConditionRequest) Reset() {
+	*x = UpdateConditionRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateConditionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateConditionRequest) ProtoMessage() {}
+
+func (x *UpdateConditionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateConditionRequest) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *UpdateConditionRequest) GetExpression() string {
+	if x != nil {
+		if x.xxx_hidden_Expression != nil {
+			return *x.xxx_hidden_Expression
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UpdateConditionRequest) GetDescription() string {
+	if x != nil {
+		if x.xxx_hidden_Description != nil {
+			return *x.xxx_hidden_Description
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UpdateConditionRequest) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *UpdateConditionRequest) SetExpression(v string) {
+	x.xxx_hidden_Expression = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *UpdateConditionRequest) SetDescription(v string) {
+	x.xxx_hidden_Description = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *UpdateConditionRequest) HasExpression() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *UpdateConditionRequest) HasDescription() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *UpdateConditionRequest) ClearExpression() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Expression = nil
+}
+
+func (x *UpdateConditionRequest) ClearDescription() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Description = nil
+}
+
+type UpdateConditionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name        string
+	Expression  *string
+	Description *string
+}
+
+func (b0 UpdateConditionRequest_builder) Build() *UpdateConditionRequest {
+	m0 := &UpdateConditionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Name = b.Name
+	if b.Expression != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Expression = b.Expression
+	}
+	if b.Description != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Description = b.Description
+	}
+	return m0
+}
+
+type UpdateConditionResponse struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Condition *Condition             `protobuf:"bytes,1,opt,name=condition,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateConditionResponse) Reset() {
+	*x = UpdateConditionResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateConditionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateConditionResponse) ProtoMessage() {}
+
+func (x *UpdateConditionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateConditionResponse) GetCondition() *Condition {
+	if x != nil {
+		return x.xxx_hidden_Condition
+	}
+	return nil
+}
+
+func (x *UpdateConditionResponse) SetCondition(v *Condition) {
+	x.xxx_hidden_Condition = v
+}
+
+func (x *UpdateConditionResponse) HasCondition() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Condition != nil
+}
+
+func (x *UpdateConditionResponse) ClearCondition() {
+	x.xxx_hidden_Condition = nil
+}
+
+type UpdateConditionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Condition *Condition
+}
+
+func (b0 UpdateConditionResponse_builder) Build() *UpdateConditionResponse {
+	m0 := &UpdateConditionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Condition = b.Condition
+	return m0
+}
+
+type DeleteConditionRequest struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name string                 `protobuf:"bytes,1,opt,name=name,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteConditionRequest) Reset() {
+	*x = DeleteConditionRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConditionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConditionRequest) ProtoMessage() {}
+
+func (x *DeleteConditionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteConditionRequest) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *DeleteConditionRequest) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+type DeleteConditionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name string
+}
+
+func (b0 DeleteConditionRequest_builder) Build() *DeleteConditionRequest {
+	m0 := &DeleteConditionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Name = b.Name
+	return m0
+}
+
+type DeleteConditionResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteConditionResponse) Reset() {
+	*x = DeleteConditionResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConditionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConditionResponse) ProtoMessage() {}
+
+func (x *DeleteConditionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeleteConditionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeleteConditionResponse_builder) Build() *DeleteConditionResponse {
+	m0 := &DeleteConditionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+// Exactly one of user_id and service_account_id is required.
+type AssignRoleRequest struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3"`
+	xxx_hidden_RoleId           string                 `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3"`
+	xxx_hidden_Scope            string                 `protobuf:"bytes,3,opt,name=scope,proto3"`
+	xxx_hidden_Condition        string                 `protobuf:"bytes,4,opt,name=condition,proto3"`
+	xxx_hidden_ServiceAccountId string                 `protobuf:"bytes,5,opt,name=service_account_id,json=serviceAccountId,proto3"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *AssignRoleRequest) Reset() {
+	*x = AssignRoleRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRoleRequest) ProtoMessage() {}
+
+func (x *AssignRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AssignRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return ""
+}
+
+func (x *AssignRoleRequest) GetRoleId() string {
+	if x != nil {
+		return x.xxx_hidden_RoleId
+	}
+	return ""
+}
+
+func (x *AssignRoleRequest) GetScope() string {
+	if x != nil {
+		return x.xxx_hidden_Scope
+	}
+	return ""
+}
+
+func (x *AssignRoleRequest) GetCondition() string {
+	if x != nil {
+		return x.xxx_hidden_Condition
+	}
+	return ""
+}
+
+func (x *AssignRoleRequest) GetServiceAccountId() string {
+	if x != nil {
+		return x.xxx_hidden_ServiceAccountId
+	}
+	return ""
+}
+
+func (x *AssignRoleRequest) SetUserId(v string) {
+	x.xxx_hidden_UserId = v
+}
+
+func (x *AssignRoleRequest) SetRoleId(v string) {
+	x.xxx_hidden_RoleId = v
+}
+
+func (x *AssignRoleRequest) SetScope(v string) {
+	x.xxx_hidden_Scope = v
+}
+
+func (x *AssignRoleRequest) SetCondition(v string) {
+	x.xxx_hidden_Condition = v
+}
+
+func (x *AssignRoleRequest) SetServiceAccountId(v string) {
+	x.xxx_hidden_ServiceAccountId = v
+}
+
+type AssignRoleRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserId string
+	RoleId string
+	Scope  string
+	// Name of a condition the assignment requires, unconditional if empty.
+	Condition        string
+	ServiceAccountId string
+}
+
+func (b0 AssignRoleRequest_builder) Build() *AssignRoleRequest {
+	m0 := &AssignRoleRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_UserId = b.UserId
+	x.xxx_hidden_RoleId = b.RoleId
+	x.xxx_hidden_Scope = b.Scope
+	x.xxx_hidden_Condition = b.Condition
+	x.xxx_hidden_ServiceAccountId = b.ServiceAccountId
+	return m0
+}
+
+type AssignRoleResponse struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Assignment *RoleAssignment        `protobuf:"bytes,1,opt,name=assignment,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AssignRoleResponse) Reset() {
+	*x = AssignRoleResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRoleResponse) ProtoMessage() {}
+
+func (x *AssignRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AssignRoleResponse) GetAssignment() *RoleAssignment {
+	if x != nil {
+		return x.xxx_hidden_Assignment
+	}
+	return nil
+}
+
+func (x *AssignRoleResponse) SetAssignment(v *RoleAssignment) {
+	x.xxx_hidden_Assignment = v
+}
+
+func (x *AssignRoleResponse) HasAssignment() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Assignment != nil
+}
+
+func (x *AssignRoleResponse) ClearAssignment() {
+	x.xxx_hidden_Assignment = nil
+}
+
+type AssignRoleResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Assignment *RoleAssignment
+}
+
+func (b0 AssignRoleResponse_builder) Build() *AssignRoleResponse {
+	m0 := &AssignRoleResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Assignment = b.Assignment
+	return m0
+}
+
+// Exactly one of user_id and service_account_id is required.
+type UnassignRoleRequest struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3"`
+	xxx_hidden_RoleId           string                 `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3"`
+	xxx_hidden_Scope            string                 `protobuf:"bytes,3,opt,name=scope,proto3"`
+	xxx_hidden_ServiceAccountId string                 `protobuf:"bytes,4,opt,name=service_account_id,json=serviceAccountId,proto3"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *UnassignRoleRequest) Reset() {
+	*x = UnassignRoleRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignRoleRequest) ProtoMessage() {}
+
+func (x *UnassignRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UnassignRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return ""
+}
+
+func (x *UnassignRoleRequest) GetRoleId() string {
+	if x != nil {
+		return x.xxx_hidden_RoleId
+	}
+	return ""
+}
+
+func (x *UnassignRoleRequest) GetScope() string {
+	if x != nil {
+		return x.xxx_hidden_Scope
+	}
+	return ""
+}
+
+func (x *UnassignRoleRequest) GetServiceAccountId() string {
+	if x != nil {
+		return x.xxx_hidden_ServiceAccountId
+	}
+	return ""
+}
+
+func (x *UnassignRoleRequest) SetUserId(v string) {
+	x.xxx_hidden_UserId = v
+}
+
+func (x *UnassignRoleRequest) SetRoleId(v string) {
+	x.xxx_hidden_RoleId = v
+}
+
+func (x *UnassignRoleRequest) SetScope(v string) {
+	x.xxx_hidden_Scope = v
+}
+
+func (x *UnassignRoleRequest) SetServiceAccountId(v string) {
+	x.xxx_hidden_ServiceAccountId = v
+}
+
+type UnassignRoleRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserId           string
+	RoleId           string
+	Scope            string
+	ServiceAccountId string
+}
+
+func (b0 UnassignRoleRequest_builder) Build() *UnassignRoleRequest {
+	m0 := &UnassignRoleRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_UserId = b.UserId
+	x.xxx_hidden_RoleId = b.RoleId
+	x.xxx_hidden_Scope = b.Scope
+	x.xxx_hidden_ServiceAccountId = b.ServiceAccountId
+	return m0
+}
+
+type UnassignRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnassignRoleResponse) Reset() {
+	*x = UnassignRoleResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignRoleResponse) ProtoMessage() {}
+
+func (x *UnassignRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type UnassignRoleResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 UnassignRoleResponse_builder) Build() *UnassignRoleResponse {
+	m0 := &UnassignRoleResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+// Exactly one of user_id and service_account_id is required.
+type ListRoleAssignmentsRequest struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3"`
+	xxx_hidden_ServiceAccountId string                 `protobuf:"bytes,2,opt,name=service_account_id,json=serviceAccountId,proto3"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *ListRoleAssignmentsRequest) Reset() {
+	*x = ListRoleAssignmentsRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoleAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoleAssignmentsRequest) ProtoMessage() {}
+
+func (x *ListRoleAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListRoleAssignmentsRequest) GetUserId() string {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return ""
+}
+
+func (x *ListRoleAssignmentsRequest) GetServiceAccountId() string {
+	if x != nil {
+		return x.xxx_hidden_ServiceAccountId
+	}
+	return ""
+}
+
+func (x *ListRoleAssignmentsRequest) SetUserId(v string) {
+	x.xxx_hidden_UserId = v
+}
+
+func (x *ListRoleAssignmentsRequest) SetServiceAccountId(v string) {
+	x.xxx_hidden_ServiceAccountId = v
+}
+
+type ListRoleAssignmentsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserId           string
+	ServiceAccountId string
+}
+
+func (b0 ListRoleAssignmentsRequest_builder) Build() *ListRoleAssignmentsRequest {
+	m0 := &ListRoleAssignmentsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_UserId = b.UserId
+	x.xxx_hidden_ServiceAccountId = b.ServiceAccountId
+	return m0
+}
+
+type ListRoleAssignmentsResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Assignments *[]*RoleAssignment     `protobuf:"bytes,1,rep,name=assignments,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ListRoleAssignmentsResponse) Reset() {
+	*x = ListRoleAssignmentsResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoleAssignmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoleAssignmentsResponse) ProtoMessage() {}
+
+func (x *ListRoleAssignmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListRoleAssignmentsResponse) GetAssignments() []*RoleAssignment {
+	if x != nil {
+		if x.xxx_hidden_Assignments != nil {
+			return *x.xxx_hidden_Assignments
+		}
+	}
+	return nil
+}
+
+func (x *ListRoleAssignmentsResponse) SetAssignments(v []*RoleAssignment) {
+	x.xxx_hidden_Assignments = &v
+}
+
+type ListRoleAssignmentsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Assignments []*RoleAssignment
+}
+
+func (b0 ListRoleAssignmentsResponse_builder) Build() *ListRoleAssignmentsResponse {
+	m0 := &ListRoleAssignmentsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Assignments = &b.Assignments
+	return m0
+}
+
+type CheckRequest struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Subject    string                 `protobuf:"bytes,1,opt,name=subject,proto3"`
+	xxx_hidden_Permission string                 `protobuf:"bytes,2,opt,name=permission,proto3"`
+	xxx_hidden_Scope      string                 `protobuf:"bytes,3,opt,name=scope,proto3"`
+	xxx_hidden_Context    *AttributeContext      `protobuf:"bytes,4,opt,name=context,proto3"`
+	xxx_hidden_Explain    bool                   `protobuf:"varint,5,opt,name=explain,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CheckRequest) Reset() {
+	*x = CheckRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRequest) ProtoMessage() {}
+
+func (x *CheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CheckRequest) GetSubject() string {
+	if x != nil {
+		return x.xxx_hidden_Subject
+	}
+	return ""
+}
+
+func (x *CheckRequest) GetPermission() string {
+	if x != nil {
+		return x.xxx_hidden_Permission
+	}
+	return ""
+}
+
+func (x *CheckRequest) GetScope() string {
+	if x != nil {
+		return x.xxx_hidden_Scope
+	}
+	return ""
+}
+
+func (x *CheckRequest) GetContext() *AttributeContext {
+	if x != nil {
+		return x.xxx_hidden_Context
+	}
+	return nil
+}
+
+func (x *CheckRequest) GetExplain() bool {
+	if x != nil {
+		return x.xxx_hidden_Explain
+	}
+	return false
+}
+
+func (x *CheckRequest) SetSubject(v string) {
+	x.xxx_hidden_Subject = v
+}
+
+func (x *CheckRequest) SetPermission(v string) {
+	x.xxx_hidden_Permission = v
+}
+
+func (x *CheckRequest) SetScope(v string) {
+	x.xxx_hidden_Scope = v
+}
+
+func (x *CheckRequest) SetContext(v *AttributeContext) {
+	x.xxx_hidden_Context = v
+}
+
+func (x *CheckRequest) SetExplain(v bool) {
+	x.xxx_hidden_Explain = v
+}
+
+func (x *CheckRequest) HasContext() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Context != nil
+}
+
+func (x *CheckRequest) ClearContext() {
+	x.xxx_hidden_Context = nil
+}
+
+type CheckRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// User or service account to check, the caller if empty.
+	Subject    string
+	Permission string
+	Scope      string
+	Context    *AttributeContext
+	// Returns the evaluation tree of the check. Explained checks bypass caches.
+	Explain bool
+}
+
+func (b0 CheckRequest_builder) Build() *CheckRequest {
+	m0 := &CheckRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Subject = b.Subject
+	x.xxx_hidden_Permission = b.Permission
+	x.xxx_hidden_Scope = b.Scope
+	x.xxx_hidden_Context = b.Context
+	x.xxx_hidden_Explain = b.Explain
+	return m0
+}
+
+type CheckResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Allowed     bool                   `protobuf:"varint,1,opt,name=allowed,proto3"`
+	xxx_hidden_Policy      string                 `protobuf:"bytes,2,opt,name=policy,proto3"`
+	xxx_hidden_Conditions  []string               `protobuf:"bytes,3,rep,name=conditions,proto3"`
+	xxx_hidden_Explanation *ExplainNode           `protobuf:"bytes,4,opt,name=explanation,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CheckResponse) Reset() {
+	*x = CheckResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckResponse) ProtoMessage() {}
+
+func (x *CheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CheckResponse) GetAllowed() bool {
+	if x != nil {
+		return x.xxx_hidden_Allowed
+	}
+	return false
+}
+
+func (x *CheckResponse) GetPolicy() string {
+	if x != nil {
+		return x.xxx_hidden_Policy
+	}
+	return ""
+}
+
+func (x *CheckResponse) GetConditions() []string {
+	if x != nil {
+		return x.xxx_hidden_Conditions
+	}
+	return nil
+}
+
+func (x *CheckResponse) GetExplanation() *ExplainNode {
+	if x != nil {
+		return x.xxx_hidden_Explanation
+	}
+	return nil
+}
+
+func (x *CheckResponse) SetAllowed(v bool) {
+	x.xxx_hidden_Allowed = v
+}
+
+func (x *CheckResponse) SetPolicy(v string) {
+	x.xxx_hidden_Policy = v
+}
+
+func (x *CheckResponse) SetConditions(v []string) {
+	x.xxx_hidden_Conditions = v
+}
+
+func (x *CheckResponse) SetExplanation(v *ExplainNode) {
+	x.xxx_hidden_Explanation = v
+}
+
+func (x *CheckResponse) HasExplanation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Explanation != nil
+}
+
+func (x *CheckResponse) ClearExplanation() {
+	x.xxx_hidden_Explanation = nil
+}
+
+type CheckResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Allowed bool
+	// The grant which allowed the check like `role:editor@<scope>`, empty if denied.
+	Policy string
+	// Conditions which held for the policy to apply.
+	Conditions []string
+	// The evaluation tree if the check was explained.
+	Explanation *ExplainNode
+}
+
+func (b0 CheckResponse_builder) Build() *CheckResponse {
+	m0 := &CheckResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Allowed = b.Allowed
+	x.xxx_hidden_Policy = b.Policy
+	x.xxx_hidden_Conditions = b.Conditions
+	x.xxx_hidden_Explanation = b.Explanation
+	return m0
+}
+
+type ListEffectivePermissionsRequest struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Subject string                 `protobuf:"bytes,1,opt,name=subject,proto3"`
+	xxx_hidden_Scope   string                 `protobuf:"bytes,2,opt,name=scope,proto3"`
+	xxx_hidden_Context *AttributeContext      `protobuf:"bytes,3,opt,name=context,proto3"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListEffectivePermissionsRequest) Reset() {
+	*x = ListEffectivePermissionsRequest{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEffectivePermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEffectivePermissionsRequest) ProtoMessage() {}
+
+func (x *ListEffectivePermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListEffectivePermissionsRequest) GetSubject() string {
+	if x != nil {
+		return x.xxx_hidden_Subject
+	}
+	return ""
+}
+
+func (x *ListEffectivePermissionsRequest) GetScope() string {
+	if x != nil {
+		return x.xxx_hidden_Scope
+	}
+	return ""
+}
+
+func (x *ListEffectivePermissionsRequest) GetContext() *AttributeContext {
+	if x != nil {
+		return x.xxx_hidden_Context
+	}
+	return nil
+}
+
+func (x *ListEffectivePermissionsRequest) SetSubject(v string) {
+	x.xxx_hidden_Subject = v
+}
+
+func (x *ListEffectivePermissionsRequest) SetScope(v string) {
+	x.xxx_hidden_Scope = v
+}
+
+func (x *ListEffectivePermissionsRequest) SetContext(v *AttributeContext) {
+	x.xxx_hidden_Context = v
+}
+
+func (x *ListEffectivePermissionsRequest) HasContext() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Context != nil
+}
+
+func (x *ListEffectivePermissionsRequest) ClearContext() {
+	x.xxx_hidden_Context = nil
+}
+
+type ListEffectivePermissionsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// User or service account to list the permissions of, the caller if empty.
+	Subject string
+	Scope   string
+	Context *AttributeContext
+}
+
+func (b0 ListEffectivePermissionsRequest_builder) Build() *ListEffectivePermissionsRequest {
+	m0 := &ListEffectivePermissionsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Subject = b.Subject
+	x.xxx_hidden_Scope = b.Scope
+	x.xxx_hidden_Context = b.Context
+	return m0
+}
+
+type ListEffectivePermissionsResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Permissions []string               `protobuf:"bytes,1,rep,name=permissions,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ListEffectivePermissionsResponse) Reset() {
+	*x = ListEffectivePermissionsResponse{}
+	mi := &file_guardian_v1_authz_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEffectivePermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEffectivePermissionsResponse) ProtoMessage() {}
+
+func (x *ListEffectivePermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_authz_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListEffectivePermissionsResponse) GetPermissions() []string {
+	if x != nil {
+		return x.xxx_hidden_Permissions
+	}
+	return nil
+}
+
+func (x *ListEffectivePermissionsResponse) SetPermissions(v []string) {
+	x.xxx_hidden_Permissions = v
+}
+
+type ListEffectivePermissionsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Permissions []string
+}
+
+func (b0 ListEffectivePermissionsResponse_builder) Build() *ListEffectivePermissionsResponse {
+	m0 := &ListEffectivePermissionsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Permissions = b.Permissions
+	return m0
+}
+
+var File_guardian_v1_authz_proto protoreflect.FileDescriptor
+
+const file_guardian_v1_authz_proto_rawDesc = "" +
+	"\n" +
+	"\x17guardian/v1/authz.proto\x12\vguardian.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"}\n" +
+	"\n" +
+	"Permission\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x83\x02\n" +
+	"\x04Role\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12 \n" +
+	"\vpermissions\x18\x04 \x03(\tR\vpermissions\x12\x1d\n" +
+	"\n" +
+	"parent_ids\x18\x05 \x03(\tR\tparentIds\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xef\x01\n" +
+	"\x0eRoleAssignment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\arole_id\x18\x03 \x01(\tR\x06roleId\x12\x14\n" +
+	"\x05scope\x18\x04 \x01(\tR\x05scope\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1c\n" +
+	"\tcondition\x18\x06 \x01(\tR\tcondition\x12,\n" +
+	"\x12service_account_id\x18\a \x01(\tR\x10serviceAccountId\"\xd7\x01\n" +
+	"\tCondition\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x02 \x01(\tR\n" +
+	"expression\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb4\x01\n" +
+	"\x10AttributeContext\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x0e\n" +
+	"\x02ip\x18\x02 \x01(\tR\x02ip\x12+\n" +
+	"\x04user\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x04user\x123\n" +
+	"\bresource\x18\x04 \x01(\v2\x17.google.protobuf.StructR\bresource\"\xbb\x01\n" +
+	"\vExplainNode\x120\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1c.guardian.v1.ExplainNodeKindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aallowed\x18\x03 \x01(\bR\aallowed\x12\x16\n" +
+	"\x06detail\x18\x04 \x01(\tR\x06detail\x124\n" +
+	"\bchildren\x18\x05 \x03(\v2\x18.guardian.v1.ExplainNodeR\bchildren\"O\n" +
+	"\x17CreatePermissionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"S\n" +
+	"\x18CreatePermissionResponse\x127\n" +
+	"\n" +
+	"permission\x18\x01 \x01(\v2\x17.guardian.v1.PermissionR\n" +
+	"permission\"\x18\n" +
+	"\x16ListPermissionsRequest\"T\n" +
+	"\x17ListPermissionsResponse\x129\n" +
+	"\vpermissions\x18\x01 \x03(\v2\x17.guardian.v1.PermissionR\vpermissions\"-\n" +
+	"\x17DeletePermissionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x1a\n" +
+	"\x18DeletePermissionResponse\"I\n" +
+	"\x11CreateRoleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\";\n" +
+	"\x12CreateRoleResponse\x12%\n" +
+	"\x04role\x18\x01 \x01(\v2\x11.guardian.v1.RoleR\x04role\" \n" +
+	"\x0eGetRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"8\n" +
+	"\x0fGetRoleResponse\x12%\n" +
+	"\x04role\x18\x01 \x01(\v2\x11.guardian.v1.RoleR\x04role\"\x12\n" +
+	"\x10ListRolesRequest\"<\n" +
+	"\x11ListRolesResponse\x12'\n" +
+	"\x05roles\x18\x01 \x03(\v2\x11.guardian.v1.RoleR\x05roles\"|\n" +
+	"\x11UpdateRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_description\";\n" +
+	"\x12UpdateRoleResponse\x12%\n" +
+	"\x04role\x18\x01 \x01(\v2\x11.guardian.v1.RoleR\x04role\"#\n" +
+	"\x11DeleteRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x12DeleteRoleResponse\"Q\n" +
+	"\x16GrantPermissionRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x02 \x01(\tR\n" +
+	"permission\"\x19\n" +
+	"\x17GrantPermissionResponse\"R\n" +
+	"\x17RevokePermissionRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x02 \x01(\tR\n" +
+	"permission\"\x1a\n" +
+	"\x18RevokePermissionResponse\"L\n" +
+	"\x14AddRoleParentRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\"\x17\n" +
+	"\x15AddRoleParentResponse\"O\n" +
+	"\x17RemoveRoleParentRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\"\x1a\n" +
+	"\x18RemoveRoleParentResponse\"n\n" +
+	"\x16CreateConditionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x02 \x01(\tR\n" +
+	"expression\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"O\n" +
+	"\x17CreateConditionResponse\x124\n" +
+	"\tcondition\x18\x01 \x01(\v2\x16.guardian.v1.ConditionR\tcondition\")\n" +
+	"\x13GetConditionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"L\n" +
+	"\x14GetConditionResponse\x124\n" +
+	"\tcondition\x18\x01 \x01(\v2\x16.guardian.v1.ConditionR\tcondition\"\x17\n" +
+	"\x15ListConditionsRequest\"P\n" +
+	"\x16ListConditionsResponse\x126\n" +
+	"\n" +
+	"conditions\x18\x01 \x03(\v2\x16.guardian.v1.ConditionR\n" +
+	"conditions\"\x97\x01\n" +
+	"\x16UpdateConditionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\n" +
+	"expression\x18\x02 \x01(\tH\x00R\n" +
+	"expression\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01B\r\n" +
+	"\v_expressionB\x0e\n" +
+	"\f_description\"O\n" +
+	"\x17UpdateConditionResponse\x124\n" +
+	"\tcondition\x18\x01 \x01(\v2\x16.guardian.v1.ConditionR\tcondition\",\n" +
+	"\x16DeleteConditionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x19\n" +
+	"\x17DeleteConditionResponse\"\xa7\x01\n" +
+	"\x11AssignRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\arole_id\x18\x02 \x01(\tR\x06roleId\x12\x14\n" +
+	"\x05scope\x18\x03 \x01(\tR\x05scope\x12\x1c\n" +
+	"\tcondition\x18\x04 \x01(\tR\tcondition\x12,\n" +
+	"\x12service_account_id\x18\x05 \x01(\tR\x10serviceAccountId\"Q\n" +
+	"\x12AssignRoleResponse\x12;\n" +
+	"\n" +
+	"assignment\x18\x01 \x01(\v2\x1b.guardian.v1.RoleAssignmentR\n" +
+	"assignment\"\x8b\x01\n" +
+	"\x13UnassignRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\arole_id\x18\x02 \x01(\tR\x06roleId\x12\x14\n" +
+	"\x05scope\x18\x03 \x01(\tR\x05scope\x12,\n" +
+	"\x12service_account_id\x18\x04 \x01(\tR\x10serviceAccountId\"\x16\n" +
+	"\x14UnassignRoleResponse\"c\n" +
+	"\x1aListRoleAssignmentsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12,\n" +
+	"\x12service_account_id\x18\x02 \x01(\tR\x10serviceAccountId\"\\\n" +
+	"\x1bListRoleAssignmentsResponse\x12=\n" +
+	"\vassignments\x18\x01 \x03(\v2\x1b.guardian.v1.RoleAssignmentR\vassignments\"\xb1\x01\n" +
+	"\fCheckRequest\x12\x18\n" +
+	"\asubject\x18\x01 \x01(\tR\asubject\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x02 \x01(\tR\n" +
+	"permission\x12\x14\n" +
+	"\x05scope\x18\x03 \x01(\tR\x05scope\x127\n" +
+	"\acontext\x18\x04 \x01(\v2\x1d.guardian.v1.AttributeContextR\acontext\x12\x18\n" +
+	"\aexplain\x18\x05 \x01(\bR\aexplain\"\x9d\x01\n" +
+	"\rCheckResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x16\n" +
+	"\x06policy\x18\x02 \x01(\tR\x06policy\x12\x1e\n" +
+	"\n" +
+	"conditions\x18\x03 \x03(\tR\n" +
+	"conditions\x12:\n" +
+	"\vexplanation\x18\x04 \x01(\v2\x18.guardian.v1.ExplainNodeR\vexplanation\"\x8a\x01\n" +
+	"\x1fListEffectivePermissionsRequest\x12\x18\n" +
+	"\asubject\x18\x01 \x01(\tR\asubject\x12\x14\n" +
+	"\x05scope\x18\x02 \x01(\tR\x05scope\x127\n" +
+	"\acontext\x18\x03 \x01(\v2\x1d.guardian.v1.AttributeContextR\acontext\"D\n" +
+	" ListEffectivePermissionsResponse\x12 \n" +
+	"\vpermissions\x18\x01 \x03(\tR\vpermissions*\xd4\x02\n" +
+	"\x0fExplainNodeKind\x12!\n" +
+	"\x1dEXPLAIN_NODE_KIND_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cEXPLAIN_NODE_KIND_PERMISSION\x10\x01\x12 \n" +
+	"\x1cEXPLAIN_NODE_KIND_ASSIGNMENT\x10\x02\x12\x1a\n" +
+	"\x16EXPLAIN_NODE_KIND_ROLE\x10\x03\x12\x1e\n" +
+	"\x1aEXPLAIN_NODE_KIND_RELATION\x10\x04\x12\x1b\n" +
+	"\x17EXPLAIN_NODE_KIND_UNION\x10\x05\x12\"\n" +
+	"\x1eEXPLAIN_NODE_KIND_INTERSECTION\x10\x06\x12\x1f\n" +
+	"\x1bEXPLAIN_NODE_KIND_EXCLUSION\x10\a\x12\x1b\n" +
+	"\x17EXPLAIN_NODE_KIND_TUPLE\x10\b\x12\x1f\n" +
+	"\x1bEXPLAIN_NODE_KIND_CONDITION\x10\t2\xb6\x0f\n" +
+	"\fAuthzService\x12_\n" +
+	"\x10CreatePermission\x12$.guardian.v1.CreatePermissionRequest\x1a%.guardian.v1.CreatePermissionResponse\x12\\\n" +
+	"\x0fListPermissions\x12#.guardian.v1.ListPermissionsRequest\x1a$.guardian.v1.ListPermissionsResponse\x12_\n" +
+	"\x10DeletePermission\x12$.guardian.v1.DeletePermissionRequest\x1a%.guardian.v1.DeletePermissionResponse\x12M\n" +
+	"\n" +
+	"CreateRole\x12\x1e.guardian.v1.CreateRoleRequest\x1a\x1f.guardian.v1.CreateRoleResponse\x12D\n" +
+	"\aGetRole\x12\x1b.guardian.v1.GetRoleRequest\x1a\x1c.guardian.v1.GetRoleResponse\x12J\n" +
+	"\tListRoles\x12\x1d.guardian.v1.ListRolesRequest\x1a\x1e.guardian.v1.ListRolesResponse\x12M\n" +
+	"\n" +
+	"UpdateRole\x12\x1e.guardian.v1.UpdateRoleRequest\x1a\x1f.guardian.v1.UpdateRoleResponse\x12M\n" +
+	"\n" +
+	"DeleteRole\x12\x1e.guardian.v1.DeleteRoleRequest\x1a\x1f.guardian.v1.DeleteRoleResponse\x12\\\n" +
+	"\x0fGrantPermission\x12#.guardian.v1.GrantPermissionRequest\x1a$.guardian.v1.GrantPermissionResponse\x12_\n" +
+	"\x10RevokePermission\x12$.guardian.v1.RevokePermissionRequest\x1a%.guardian.v1.RevokePermissionResponse\x12V\n" +
+	"\rAddRoleParent\x12!.guardian.v1.AddRoleParentRequest\x1a\".guardian.v1.AddRoleParentResponse\x12_\n" +
+	"\x10RemoveRoleParent\x12$.guardian.v1.RemoveRoleParentRequest\x1a%.guardian.v1.RemoveRoleParentResponse\x12\\\n" +
+	"\x0fCreateCondition\x12#.guardian.v1.CreateConditionRequest\x1a$.guardian.v1.CreateConditionResponse\x12S\n" +
+	"\fGetCondition\x12 .guardian.v1.GetConditionRequest\x1a!.guardian.v1.GetConditionResponse\x12Y\n" +
+	"\x0eListConditions\x12\".guardian.v1.ListConditionsRequest\x1a#.guardian.v1.ListConditionsResponse\x12\\\n" +
+	"\x0fUpdateCondition\x12#.guardian.v1.UpdateConditionRequest\x1a$.guardian.v1.UpdateConditionResponse\x12\\\n" +
+	"\x0fDeleteCondition\x12#.guardian.v1.DeleteConditionRequest\x1a$.guardian.v1.DeleteConditionResponse\x12M\n" +
+	"\n" +
+	"AssignRole\x12\x1e.guardian.v1.AssignRoleRequest\x1a\x1f.guardian.v1.AssignRoleResponse\x12S\n" +
+	"\fUnassignRole\x12 .guardian.v1.UnassignRoleRequest\x1a!.guardian.v1.UnassignRoleResponse\x12h\n" +
+	"\x13ListRoleAssignments\x12'.guardian.v1.ListRoleAssignmentsRequest\x1a(.guardian.v1.ListRoleAssignmentsResponse\x12>\n" +
+	"\x05Check\x12\x19.guardian.v1.CheckRequest\x1a\x1a.guardian.v1.CheckResponse\x12w\n" +
+	"\x18ListEffectivePermissions\x12,.guardian.v1.ListEffectivePermissionsRequest\x1a-.guardian.v1.ListEffectivePermissionsResponseB\xa9\x01\n" +
+	"\x0fcom.guardian.v1B\n" +
+	"AuthzProtoP\x01Z=github.com/gophero/guardian/core/proto/guardian/v1;guardianv1\xa2\x02\x03GVX\xaa\x02\vGuardian.V1\xca\x02\vGuardian\\V1\xe2\x02\x17Guardian\\V1\\GPBMetadata\xea\x02\fGuardian::V1b\x06proto3"
+
+var file_guardian_v1_authz_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_guardian_v1_authz_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_guardian_v1_authz_proto_goTypes = []any{
+	(ExplainNodeKind)(0),                     // 0: guardian.v1.ExplainNodeKind
+	(*Permission)(nil),                       // 1: guardian.v1.Permission
+	(*Role)(nil),                             // 2: guardian.v1.Role
+	(*RoleAssignment)(nil),                   // 3: guardian.v1.RoleAssignment
+	(*Condition)(nil),                        // 4: guardian.v1.Condition
+	(*AttributeContext)(nil),                 // 5: guardian.v1.AttributeContext
+	(*ExplainNode)(nil),                      // 6: guardian.v1.ExplainNode
+	(*CreatePermissionRequest)(nil),          // 7: guardian.v1.CreatePermissionRequest
+	(*CreatePermissionResponse)(nil),         // 8: guardian.v1.CreatePermissionResponse
+	(*ListPermissionsRequest)(nil),           // 9: guardian.v1.ListPermissionsRequest
+	(*ListPermissionsResponse)(nil),          // 10: guardian.v1.ListPermissionsResponse
+	(*DeletePermissionRequest)(nil),          // 11: guardian.v1.DeletePermissionRequest
+	(*DeletePermissionResponse)(nil),         // 12: guardian.v1.DeletePermissionResponse
+	(*CreateRoleRequest)(nil),                // 13: guardian.v1.CreateRoleRequest
+	(*CreateRoleResponse)(nil),               // 14: guardian.v1.CreateRoleResponse
+	(*GetRoleRequest)(nil),                   // 15: guardian.v1.GetRoleRequest
+	(*GetRoleResponse)(nil),                  // 16: guardian.v1.GetRoleResponse
+	(*ListRolesRequest)(nil),                 // 17: guardian.v1.ListRolesRequest
+	(*ListRolesResponse)(nil),                // 18: guardian.v1.ListRolesResponse
+	(*UpdateRoleRequest)(nil),                // 19: guardian.v1.UpdateRoleRequest
+	(*UpdateRoleResponse)(nil),               // 20: guardian.v1.UpdateRoleResponse
+	(*DeleteRoleRequest)(nil),                // 21: guardian.v1.DeleteRoleRequest
+	(*DeleteRoleResponse)(nil),               // 22: guardian.v1.DeleteRoleResponse
+	(*GrantPermissionRequest)(nil),           // 23: guardian.v1.GrantPermissionRequest
+	(*GrantPermissionResponse)(nil),          // 24: guardian.v1.GrantPermissionResponse
+	(*RevokePermissionRequest)(nil),          // 25: guardian.v1.RevokePermissionRequest
+	(*RevokePermissionResponse)(nil),         // 26: guardian.v1.RevokePermissionResponse
+	(*AddRoleParentRequest)(nil),             // 27: guardian.v1.AddRoleParentRequest
+	(*AddRoleParentResponse)(nil),            // 28: guardian.v1.AddRoleParentResponse
+	(*RemoveRoleParentRequest)(nil),          // 29: guardian.v1.RemoveRoleParentRequest
+	(*RemoveRoleParentResponse)(nil),         // 30: guardian.v1.RemoveRoleParentResponse
+	(*CreateConditionRequest)(nil),           // 31: guardian.v1.CreateConditionRequest
+	(*CreateConditionResponse)(nil),          // 32: guardian.v1.CreateConditionResponse
+	(*GetConditionRequest)(nil),              // 33: guardian.v1.GetConditionRequest
+	(*GetConditionResponse)(nil),             // 34: guardian.v1.GetConditionResponse
+	(*ListConditionsRequest)(nil),            // 35: guardian.v1.ListConditionsRequest
+	(*ListConditionsResponse)(nil),           // 36: guardian.v1.ListConditionsResponse
+	(*UpdateConditionRequest)(nil),           // 37: guardian.v1.UpdateConditionRequest
+	(*UpdateConditionResponse)(nil),          // 38: guardian.v1.UpdateConditionResponse
+	(*DeleteConditionRequest)(nil),           // 39: guardian.v1.DeleteConditionRequest
+	(*DeleteConditionResponse)(nil),          // 40: guardian.v1.DeleteConditionResponse
+	(*AssignRoleRequest)(nil),                // 41: guardian.v1.AssignRoleRequest
+	(*AssignRoleResponse)(nil),               // 42: guardian.v1.AssignRoleResponse
+	(*UnassignRoleRequest)(nil),              // 43: guardian.v1.UnassignRoleRequest
+	(*UnassignRoleResponse)(nil),             // 44: guardian.v1.UnassignRoleResponse
+	(*ListRoleAssignmentsRequest)(nil),       // 45: guardian.v1.ListRoleAssignmentsRequest
+	(*ListRoleAssignmentsResponse)(nil),      // 46: guardian.v1.ListRoleAssignmentsResponse
+	(*CheckRequest)(nil),                     // 47: guardian.v1.CheckRequest
+	(*CheckResponse)(nil),                    // 48: guardian.v1.CheckResponse
+	(*ListEffectivePermissionsRequest)(nil),  // 49: guardian.v1.ListEffectivePermissionsRequest
+	(*ListEffectivePermissionsResponse)(nil), // 50: guardian.v1.ListEffectivePermissionsResponse
+	(*timestamppb.Timestamp)(nil),            // 51: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                  // 52: google.protobuf.Struct
+}
+var file_guardian_v1_authz_proto_depIdxs = []int32{
+	51, // 0: guardian.v1.Permission.created_at:type_name -> google.protobuf.Timestamp
+	51, // 1: guardian.v1.Role.created_at:type_name -> google.protobuf.Timestamp
+	51, // 2: guardian.v1.Role.updated_at:type_name -> google.protobuf.Timestamp
+	51, // 3: guardian.v1.RoleAssignment.created_at:type_name -> google.protobuf.Timestamp
+	51, // 4: guardian.v1.Condition.created_at:type_name -> google.protobuf.Timestamp
+	51, // 5: guardian.v1.Condition.updated_at:type_name -> google.protobuf.Timestamp
+	51, // 6: guardian.v1.AttributeContext.time:type_name -> google.protobuf.Timestamp
+	52, // 7: guardian.v1.AttributeContext.user:type_name -> google.protobuf.Struct
+	52, // 8: guardian.v1.AttributeContext.resource:type_name -> google.protobuf.Struct
+	0,  // 9: guardian.v1.ExplainNode.kind:type_name -> guardian.v1.ExplainNodeKind
+	6,  // 10: guardian.v1.ExplainNode.children:type_name -> guardian.v1.ExplainNode
+	1,  // 11: guardian.v1.CreatePermissionResponse.permission:type_name -> guardian.v1.Permission
+	1,  // 12: guardian.v1.ListPermissionsResponse.permissions:type_name -> guardian.v1.Permission
+	2,  // 13: guardian.v1.CreateRoleResponse.role:type_name -> guardian.v1.Role
+	2,  // 14: guardian.v1.GetRoleResponse.role:type_name -> guardian.v1.Role
+	2,  // 15: guardian.v1.ListRolesResponse.roles:type_name -> guardian.v1.Role
+	2,  // 16: guardian.v1.UpdateRoleResponse.role:type_name -> guardian.v1.Role
+	4,  // 17: guardian.v1.CreateConditionResponse.condition:type_name -> guardian.v1.Condition
+	4,  // 18: guardian.v1.GetConditionResponse.condition:type_name -> guardian.v1.Condition
+	4,  // 19: guardian.v1.ListConditionsResponse.conditions:type_name -> guardian.v1.Condition
+	4,  // 20: guardian.v1.UpdateConditionResponse.condition:type_name -> guardian.v1.Condition
+	3,  // 21: guardian.v1.AssignRoleResponse.assignment:type_name -> guardian.v1.RoleAssignment
+	3,  // 22: guardian.v1.ListRoleAssignmentsResponse.assignments:type_name -> guardian.v1.RoleAssignment
+	5,  // 23: guardian.v1.CheckRequest.context:type_name -> guardian.v1.AttributeContext
+	6,  // 24: guardian.v1.CheckResponse.explanation:type_name -> guardian.v1.ExplainNode
+	5,  // 25: guardian.v1.ListEffectivePermissionsRequest.context:type_name -> guardian.v1.AttributeContext
+	7,  // 26: guardian.v1.AuthzService.CreatePermission:input_type -> guardian.v1.CreatePermissionRequest
+	9,  // 27: guardian.v1.AuthzService.ListPermissions:input_type -> guardian.v1.ListPermissionsRequest
+	11, // 28: guardian.v1.AuthzService.DeletePermission:input_type -> guardian.v1.DeletePermissionRequest
+	13, // 29: guardian.v1.AuthzService.CreateRole:input_type -> guardian.v1.CreateRoleRequest
+	15, // 30: guardian.v1.AuthzService.GetRole:input_type -> guardian.v1.GetRoleRequest
+	17, // 31: guardian.v1.AuthzService.ListRoles:input_type -> guardian.v1.ListRolesRequest
+	19, // 32: guardian.v1.AuthzService.UpdateRole:input_type -> guardian.v1.UpdateRoleRequest
+	21, // 33: guardian.v1.AuthzService.DeleteRole:input_type -> guardian.v1.DeleteRoleRequest
+	23, // 34: guardian.v1.AuthzService.GrantPermission:input_type -> guardian.v1.GrantPermissionRequest
+	25, // 35: guardian.v1.AuthzService.RevokePermission:input_type -> guardian.v1.RevokePermissionRequest
+	27, // 36: guardian.v1.AuthzService.AddRoleParent:input_type -> guardian.v1.AddRoleParentRequest
+	29, // 37: guardian.v1.AuthzService.RemoveRoleParent:input_type -> guardian.v1.RemoveRoleParentRequest
+	31, // 38: guardian.v1.AuthzService.CreateCondition:input_type -> guardian.v1.CreateConditionRequest
+	33, // 39: guardian.v1.AuthzService.GetCondition:input_type -> guardian.v1.GetConditionRequest
+	35, // 40: guardian.v1.AuthzService.ListConditions:input_type -> guardian.v1.ListConditionsRequest
+	37, // 41: guardian.v1.AuthzService.UpdateCondition:input_type -> guardian.v1.UpdateConditionRequest
+	39, // 42: guardian.v1.AuthzService.DeleteCondition:input_type -> guardian.v1.DeleteConditionRequest
+	41, // 43: guardian.v1.AuthzService.AssignRole:input_type -> guardian.v1.AssignRoleRequest
+	43, // 44: guardian.v1.AuthzService.UnassignRole:input_type -> guardian.v1.UnassignRoleRequest
+	45, // 45: guardian.v1.AuthzService.ListRoleAssignments:input_type -> guardian.v1.ListRoleAssignmentsRequest
+	47, // 46: guardian.v1.AuthzService.Check:input_type -> guardian.v1.CheckRequest
+	49, // 47: guardian.v1.AuthzService.ListEffectivePermissions:input_type -> guardian.v1.ListEffectivePermissionsRequest
+	8,  // 48: guardian.v1.AuthzService.CreatePermission:output_type -> guardian.v1.CreatePermissionResponse
+	10, // 49: guardian.v1.AuthzService.ListPermissions:output_type -> guardian.v1.ListPermissionsResponse
+	12, // 50: guardian.v1.AuthzService.DeletePermission:output_type -> guardian.v1.DeletePermissionResponse
+	14, // 51: guardian.v1.AuthzService.CreateRole:output_type -> guardian.v1.CreateRoleResponse
+	16, // 52: guardian.v1.AuthzService.GetRole:output_type -> guardian.v1.GetRoleResponse
+	18, // 53: guardian.v1.AuthzService.ListRoles:output_type -> guardian.v1.ListRolesResponse
+	20, // 54: guardian.v1.AuthzService.UpdateRole:output_type -> guardian.v1.UpdateRoleResponse
+	22, // 55: guardian.v1.AuthzService.DeleteRole:output_type -> guardian.v1.DeleteRoleResponse
+	24, // 56: guardian.v1.AuthzService.GrantPermission:output_type -> guardian.v1.GrantPermissionResponse
+	26, // 57: guardian.v1.AuthzService.RevokePermission:output_type -> guardian.v1.RevokePermissionResponse
+	28, // 58: guardian.v1.AuthzService.AddRoleParent:output_type -> guardian.v1.AddRoleParentResponse
+	30, // 59: guardian.v1.AuthzService.RemoveRoleParent:output_type -> guardian.v1.RemoveRoleParentResponse
+	32, // 60: guardian.v1.AuthzService.CreateCondition:output_type -> guardian.v1.CreateConditionResponse
+	34, // 61: guardian.v1.AuthzService.GetCondition:output_type -> guardian.v1.GetConditionResponse
+	36, // 62: guardian.v1.AuthzService.ListConditions:output_type -> guardian.v1.ListConditionsResponse
+	38, // 63: guardian.v1.AuthzService.UpdateCondition:output_type -> guardian.v1.UpdateConditionResponse
+	40, // 64: guardian.v1.AuthzService.DeleteCondition:output_type -> guardian.v1.DeleteConditionResponse
+	42, // 65: guardian.v1.AuthzService.AssignRole:output_type -> guardian.v1.AssignRoleResponse
+	44, // 66: guardian.v1.AuthzService.UnassignRole:output_type -> guardian.v1.UnassignRoleResponse
+	46, // 67: guardian.v1.AuthzService.ListRoleAssignments:output_type -> guardian.v1.ListRoleAssignmentsResponse
+	48, // 68: guardian.v1.AuthzService.Check:output_type -> guardian.v1.CheckResponse
+	50, // 69: guardian.v1.AuthzService.ListEffectivePermissions:output_type -> guardian.v1.ListEffectivePermissionsResponse
+	48, // [48:70] is the sub-list for method output_type
+	26, // [26:48] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
+}
+
+func init() { file_guardian_v1_authz_proto_init() }
+func file_guardian_v1_authz_proto_init() {
+	if File_guardian_v1_authz_proto != nil {
+		return
+	}
+	file_guardian_v1_authz_proto_msgTypes[18].OneofWrappers = []any{}
+	file_guardian_v1_authz_proto_msgTypes[36].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guardian_v1_authz_proto_rawDesc), len(file_guardian_v1_authz_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   50,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_guardian_v1_authz_proto_goTypes,
+		DependencyIndexes: file_guardian_v1_authz_proto_depIdxs,
+		EnumInfos:         file_guardian_v1_authz_proto_enumTypes,
+		MessageInfos:      file_guardian_v1_authz_proto_msgTypes,
+	}.Build()
+	File_guardian_v1_authz_proto = out.File
+	file_guardian_v1_authz_proto_goTypes = nil
+	file_guardian_v1_authz_proto_depIdxs = nil
+}
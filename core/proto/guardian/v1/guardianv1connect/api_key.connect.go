@@ -0,0 +1,275 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: guardian/v1/api_key.proto
+
+package guardianv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/gophero/guardian/core/proto/guardian/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// APIKeyServiceName is the fully-qualified name of the APIKeyService service.
+	APIKeyServiceName = "guardian.v1.APIKeyService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// APIKeyServiceCreateAPIKeyProcedure is the fully-qualified name of the APIKeyService's
+	// CreateAPIKey RPC.
+	APIKeyServiceCreateAPIKeyProcedure = "/guardian.v1.APIKeyService/CreateAPIKey"
+	// APIKeyServiceGetAPIKeyProcedure is the fully-qualified name of the APIKeyService's GetAPIKey RPC.
+	APIKeyServiceGetAPIKeyProcedure = "/guardian.v1.APIKeyService/GetAPIKey"
+	// APIKeyServiceListAPIKeysProcedure is the fully-qualified name of the APIKeyService's ListAPIKeys
+	// RPC.
+	APIKeyServiceListAPIKeysProcedure = "/guardian.v1.APIKeyService/ListAPIKeys"
+	// APIKeyServiceRotateAPIKeyProcedure is the fully-qualified name of the APIKeyService's
+	// RotateAPIKey RPC.
+	APIKeyServiceRotateAPIKeyProcedure = "/guardian.v1.APIKeyService/RotateAPIKey"
+	// APIKeyServiceRevokeAPIKeyProcedure is the fully-qualified name of the APIKeyService's
+	// RevokeAPIKey RPC.
+	APIKeyServiceRevokeAPIKeyProcedure = "/guardian.v1.APIKeyService/RevokeAPIKey"
+	// APIKeyServiceVerifyAPIKeyProcedure is the fully-qualified name of the APIKeyService's
+	// VerifyAPIKey RPC.
+	APIKeyServiceVerifyAPIKeyProcedure = "/guardian.v1.APIKeyService/VerifyAPIKey"
+)
+
+// APIKeyServiceClient is a client for the guardian.v1.APIKeyService service.
+type APIKeyServiceClient interface {
+	// CreateAPIKey creates a key and returns its token, which cannot be retrieved later. The scopes of the key have to
+	// be permissions the caller has itself, in the organization for keys of organizations. Fails with
+	// PERMISSION_DENIED otherwise.
+	CreateAPIKey(context.Context, *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error)
+	// GetAPIKey returns a key by id.
+	GetAPIKey(context.Context, *connect.Request[v1.GetAPIKeyRequest]) (*connect.Response[v1.GetAPIKeyResponse], error)
+	// ListAPIKeys lists the keys of an owner ordered by id, including expired ones.
+	ListAPIKeys(context.Context, *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error)
+	// RotateAPIKey replaces the secret of a key and returns its new token. The previous token stays valid for the
+	// overlap, so clients can switch without downtime.
+	RotateAPIKey(context.Context, *connect.Request[v1.RotateAPIKeyRequest]) (*connect.Response[v1.RotateAPIKeyResponse], error)
+	// RevokeAPIKey deletes a key, invalidating its tokens immediately.
+	RevokeAPIKey(context.Context, *connect.Request[v1.RevokeAPIKeyRequest]) (*connect.Response[v1.RevokeAPIKeyResponse], error)
+	// VerifyAPIKey returns the key of a token for services authenticating its bearer. It needs no access token, as the
+	// token is the credential. Fails with UNAUTHENTICATED if the token is invalid or expired and with
+	// PERMISSION_DENIED if the key lacks any of the required scopes.
+	VerifyAPIKey(context.Context, *connect.Request[v1.VerifyAPIKeyRequest]) (*connect.Response[v1.VerifyAPIKeyResponse], error)
+}
+
+// NewAPIKeyServiceClient constructs a client for the guardian.v1.APIKeyService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAPIKeyServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) APIKeyServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	aPIKeyServiceMethods := v1.File_guardian_v1_api_key_proto.Services().ByName("APIKeyService").Methods()
+	return &aPIKeyServiceClient{
+		createAPIKey: connect.NewClient[v1.CreateAPIKeyRequest, v1.CreateAPIKeyResponse](
+			httpClient,
+			baseURL+APIKeyServiceCreateAPIKeyProcedure,
+			connect.WithSchema(aPIKeyServiceMethods.ByName("CreateAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		getAPIKey: connect.NewClient[v1.GetAPIKeyRequest, v1.GetAPIKeyResponse](
+			httpClient,
+			baseURL+APIKeyServiceGetAPIKeyProcedure,
+			connect.WithSchema(aPIKeyServiceMethods.ByName("GetAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		listAPIKeys: connect.NewClient[v1.ListAPIKeysRequest, v1.ListAPIKeysResponse](
+			httpClient,
+			baseURL+APIKeyServiceListAPIKeysProcedure,
+			connect.WithSchema(aPIKeyServiceMethods.ByName("ListAPIKeys")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateAPIKey: connect.NewClient[v1.RotateAPIKeyRequest, v1.RotateAPIKeyResponse](
+			httpClient,
+			baseURL+APIKeyServiceRotateAPIKeyProcedure,
+			connect.WithSchema(aPIKeyServiceMethods.ByName("RotateAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAPIKey: connect.NewClient[v1.RevokeAPIKeyRequest, v1.RevokeAPIKeyResponse](
+			httpClient,
+			baseURL+APIKeyServiceRevokeAPIKeyProcedure,
+			connect.WithSchema(aPIKeyServiceMethods.ByName("RevokeAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyAPIKey: connect.NewClient[v1.VerifyAPIKeyRequest, v1.VerifyAPIKeyResponse](
+			httpClient,
+			baseURL+APIKeyServiceVerifyAPIKeyProcedure,
+			connect.WithSchema(aPIKeyServiceMethods.ByName("VerifyAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// aPIKeyServiceClient implements APIKeyServiceClient.
+type aPIKeyServiceClient struct {
+	createAPIKey *connect.Client[v1.CreateAPIKeyRequest, v1.CreateAPIKeyResponse]
+	getAPIKey    *connect.Client[v1.GetAPIKeyRequest, v1.GetAPIKeyResponse]
+	listAPIKeys  *connect.Client[v1.ListAPIKeysRequest, v1.ListAPIKeysResponse]
+	rotateAPIKey *connect.Client[v1.RotateAPIKeyRequest, v1.RotateAPIKeyResponse]
+	revokeAPIKey *connect.Client[v1.RevokeAPIKeyRequest, v1.RevokeAPIKeyResponse]
+	verifyAPIKey *connect.Client[v1.VerifyAPIKeyRequest, v1.VerifyAPIKeyResponse]
+}
+
+// CreateAPIKey calls guardian.v1.APIKeyService.CreateAPIKey.
+func (c *aPIKeyServiceClient) CreateAPIKey(ctx context.Context, req *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error) {
+	return c.createAPIKey.CallUnary(ctx, req)
+}
+
+// GetAPIKey calls guardian.v1.APIKeyService.GetAPIKey.
+func (c *aPIKeyServiceClient) GetAPIKey(ctx context.Context, req *connect.Request[v1.GetAPIKeyRequest]) (*connect.Response[v1.GetAPIKeyResponse], error) {
+	return c.getAPIKey.CallUnary(ctx, req)
+}
+
+// ListAPIKeys calls guardian.v1.APIKeyService.ListAPIKeys.
+func (c *aPIKeyServiceClient) ListAPIKeys(ctx context.Context, req *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error) {
+	return c.listAPIKeys.CallUnary(ctx, req)
+}
+
+// RotateAPIKey calls guardian.v1.APIKeyService.RotateAPIKey.
+func (c *aPIKeyServiceClient) RotateAPIKey(ctx context.Context, req *connect.Request[v1.RotateAPIKeyRequest]) (*connect.Response[v1.RotateAPIKeyResponse], error) {
+	return c.rotateAPIKey.CallUnary(ctx, req)
+}
+
+// RevokeAPIKey calls guardian.v1.APIKeyService.RevokeAPIKey.
+func (c *aPIKeyServiceClient) RevokeAPIKey(ctx context.Context, req *connect.Request[v1.RevokeAPIKeyRequest]) (*connect.Response[v1.RevokeAPIKeyResponse], error) {
+	return c.revokeAPIKey.CallUnary(ctx, req)
+}
+
+// VerifyAPIKey calls guardian.v1.APIKeyService.VerifyAPIKey.
+func (c *aPIKeyServiceClient) VerifyAPIKey(ctx context.Context, req *connect.Request[v1.VerifyAPIKeyRequest]) (*connect.Response[v1.VerifyAPIKeyResponse], error) {
+	return c.verifyAPIKey.CallUnary(ctx, req)
+}
+
+// APIKeyServiceHandler is an implementation of the guardian.v1.APIKeyService service.
+type APIKeyServiceHandler interface {
+	// CreateAPIKey creates a key and returns its token, which cannot be retrieved later. The scopes of the key have to
+	// be permissions the caller has itself, in the organization for keys of organizations. Fails with
+	// PERMISSION_DENIED otherwise.
+	CreateAPIKey(context.Context, *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error)
+	// GetAPIKey returns a key by id.
+	GetAPIKey(context.Context, *connect.Request[v1.GetAPIKeyRequest]) (*connect.Response[v1.GetAPIKeyResponse], error)
+	// ListAPIKeys lists the keys of an owner ordered by id, including expired ones.
+	ListAPIKeys(context.Context, *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error)
+	// RotateAPIKey replaces the secret of a key and returns its new token. The previous token stays valid for the
+	// overlap, so clients can switch without downtime.
+	RotateAPIKey(context.Context, *connect.Request[v1.RotateAPIKeyRequest]) (*connect.Response[v1.RotateAPIKeyResponse], error)
+	// RevokeAPIKey deletes a key, invalidating its tokens immediately.
+	RevokeAPIKey(context.Context, *connect.Request[v1.RevokeAPIKeyRequest]) (*connect.Response[v1.RevokeAPIKeyResponse], error)
+	// VerifyAPIKey returns the key of a token for services authenticating its bearer. It needs no access token, as the
+	// token is the credential. Fails with UNAUTHENTICATED if the token is invalid or expired and with
+	// PERMISSION_DENIED if the key lacks any of the required scopes.
+	VerifyAPIKey(context.Context, *connect.Request[v1.VerifyAPIKeyRequest]) (*connect.Response[v1.VerifyAPIKeyResponse], error)
+}
+
+// NewAPIKeyServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAPIKeyServiceHandler(svc APIKeyServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	aPIKeyServiceMethods := v1.File_guardian_v1_api_key_proto.Services().ByName("APIKeyService").Methods()
+	aPIKeyServiceCreateAPIKeyHandler := connect.NewUnaryHandler(
+		APIKeyServiceCreateAPIKeyProcedure,
+		svc.CreateAPIKey,
+		connect.WithSchema(aPIKeyServiceMethods.ByName("CreateAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIKeyServiceGetAPIKeyHandler := connect.NewUnaryHandler(
+		APIKeyServiceGetAPIKeyProcedure,
+		svc.GetAPIKey,
+		connect.WithSchema(aPIKeyServiceMethods.ByName("GetAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIKeyServiceListAPIKeysHandler := connect.NewUnaryHandler(
+		APIKeyServiceListAPIKeysProcedure,
+		svc.ListAPIKeys,
+		connect.WithSchema(aPIKeyServiceMethods.ByName("ListAPIKeys")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIKeyServiceRotateAPIKeyHandler := connect.NewUnaryHandler(
+		APIKeyServiceRotateAPIKeyProcedure,
+		svc.RotateAPIKey,
+		connect.WithSchema(aPIKeyServiceMethods.ByName("RotateAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIKeyServiceRevokeAPIKeyHandler := connect.NewUnaryHandler(
+		APIKeyServiceRevokeAPIKeyProcedure,
+		svc.RevokeAPIKey,
+		connect.WithSchema(aPIKeyServiceMethods.ByName("RevokeAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIKeyServiceVerifyAPIKeyHandler := connect.NewUnaryHandler(
+		APIKeyServiceVerifyAPIKeyProcedure,
+		svc.VerifyAPIKey,
+		connect.WithSchema(aPIKeyServiceMethods.ByName("VerifyAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/guardian.v1.APIKeyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case APIKeyServiceCreateAPIKeyProcedure:
+			aPIKeyServiceCreateAPIKeyHandler.ServeHTTP(w, r)
+		case APIKeyServiceGetAPIKeyProcedure:
+			aPIKeyServiceGetAPIKeyHandler.ServeHTTP(w, r)
+		case APIKeyServiceListAPIKeysProcedure:
+			aPIKeyServiceListAPIKeysHandler.ServeHTTP(w, r)
+		case APIKeyServiceRotateAPIKeyProcedure:
+			aPIKeyServiceRotateAPIKeyHandler.ServeHTTP(w, r)
+		case APIKeyServiceRevokeAPIKeyProcedure:
+			aPIKeyServiceRevokeAPIKeyHandler.ServeHTTP(w, r)
+		case APIKeyServiceVerifyAPIKeyProcedure:
+			aPIKeyServiceVerifyAPIKeyHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAPIKeyServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAPIKeyServiceHandler struct{}
+
+func (UnimplementedAPIKeyServiceHandler) CreateAPIKey(context.Context, *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.APIKeyService.CreateAPIKey is not implemented"))
+}
+
+func (UnimplementedAPIKeyServiceHandler) GetAPIKey(context.Context, *connect.Request[v1.GetAPIKeyRequest]) (*connect.Response[v1.GetAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.APIKeyService.GetAPIKey is not implemented"))
+}
+
+func (UnimplementedAPIKeyServiceHandler) ListAPIKeys(context.Context, *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.APIKeyService.ListAPIKeys is not implemented"))
+}
+
+func (UnimplementedAPIKeyServiceHandler) RotateAPIKey(context.Context, *connect.Request[v1.RotateAPIKeyRequest]) (*connect.Response[v1.RotateAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.APIKeyService.RotateAPIKey is not implemented"))
+}
+
+func (UnimplementedAPIKeyServiceHandler) RevokeAPIKey(context.Context, *connect.Request[v1.RevokeAPIKeyRequest]) (*connect.Response[v1.RevokeAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.APIKeyService.RevokeAPIKey is not implemented"))
+}
+
+func (UnimplementedAPIKeyServiceHandler) VerifyAPIKey(context.Context, *connect.Request[v1.VerifyAPIKeyRequest]) (*connect.Response[v1.VerifyAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.APIKeyService.VerifyAPIKey is not implemented"))
+}
@@ -0,0 +1,595 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: guardian/v1/auth.proto
+
+package guardianv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/gophero/guardian/core/proto/guardian/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AuthServiceName is the fully-qualified name of the AuthService service.
+	AuthServiceName = "guardian.v1.AuthService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AuthServiceSignUpProcedure is the fully-qualified name of the AuthService's SignUp RPC.
+	AuthServiceSignUpProcedure = "/guardian.v1.AuthService/SignUp"
+	// AuthServiceSignInProcedure is the fully-qualified name of the AuthService's SignIn RPC.
+	AuthServiceSignInProcedure = "/guardian.v1.AuthService/SignIn"
+	// AuthServiceVerifyMFAProcedure is the fully-qualified name of the AuthService's VerifyMFA RPC.
+	AuthServiceVerifyMFAProcedure = "/guardian.v1.AuthService/VerifyMFA"
+	// AuthServiceBeginPasskeySignInProcedure is the fully-qualified name of the AuthService's
+	// BeginPasskeySignIn RPC.
+	AuthServiceBeginPasskeySignInProcedure = "/guardian.v1.AuthService/BeginPasskeySignIn"
+	// AuthServiceFinishPasskeySignInProcedure is the fully-qualified name of the AuthService's
+	// FinishPasskeySignIn RPC.
+	AuthServiceFinishPasskeySignInProcedure = "/guardian.v1.AuthService/FinishPasskeySignIn"
+	// AuthServiceSendMagicLinkProcedure is the fully-qualified name of the AuthService's SendMagicLink
+	// RPC.
+	AuthServiceSendMagicLinkProcedure = "/guardian.v1.AuthService/SendMagicLink"
+	// AuthServiceVerifyMagicLinkProcedure is the fully-qualified name of the AuthService's
+	// VerifyMagicLink RPC.
+	AuthServiceVerifyMagicLinkProcedure = "/guardian.v1.AuthService/VerifyMagicLink"
+	// AuthServiceSendEmailOTPProcedure is the fully-qualified name of the AuthService's SendEmailOTP
+	// RPC.
+	AuthServiceSendEmailOTPProcedure = "/guardian.v1.AuthService/SendEmailOTP"
+	// AuthServiceVerifyEmailOTPProcedure is the fully-qualified name of the AuthService's
+	// VerifyEmailOTP RPC.
+	AuthServiceVerifyEmailOTPProcedure = "/guardian.v1.AuthService/VerifyEmailOTP"
+	// AuthServiceSendEmailVerificationProcedure is the fully-qualified name of the AuthService's
+	// SendEmailVerification RPC.
+	AuthServiceSendEmailVerificationProcedure = "/guardian.v1.AuthService/SendEmailVerification"
+	// AuthServiceVerifyEmailProcedure is the fully-qualified name of the AuthService's VerifyEmail RPC.
+	AuthServiceVerifyEmailProcedure = "/guardian.v1.AuthService/VerifyEmail"
+	// AuthServiceRequestPasswordResetProcedure is the fully-qualified name of the AuthService's
+	// RequestPasswordReset RPC.
+	AuthServiceRequestPasswordResetProcedure = "/guardian.v1.AuthService/RequestPasswordReset"
+	// AuthServiceResetPasswordProcedure is the fully-qualified name of the AuthService's ResetPassword
+	// RPC.
+	AuthServiceResetPasswordProcedure = "/guardian.v1.AuthService/ResetPassword"
+	// AuthServiceSignOutProcedure is the fully-qualified name of the AuthService's SignOut RPC.
+	AuthServiceSignOutProcedure = "/guardian.v1.AuthService/SignOut"
+	// AuthServiceRefreshProcedure is the fully-qualified name of the AuthService's Refresh RPC.
+	AuthServiceRefreshProcedure = "/guardian.v1.AuthService/Refresh"
+	// AuthServiceGetSessionProcedure is the fully-qualified name of the AuthService's GetSession RPC.
+	AuthServiceGetSessionProcedure = "/guardian.v1.AuthService/GetSession"
+)
+
+// AuthServiceClient is a client for the guardian.v1.AuthService service.
+type AuthServiceClient interface {
+	// SignUp creates a user with a password, emails a link to verify its email address and signs it in. If verified
+	// email addresses are required, the user is returned without a session instead.
+	SignUp(context.Context, *connect.Request[v1.SignUpRequest]) (*connect.Response[v1.SignUpResponse], error)
+	// SignIn signs in a user by email or username and password. If the user enrolled a second factor, an MFA challenge
+	// is returned instead of a session, which has to be answered with VerifyMFA.
+	SignIn(context.Context, *connect.Request[v1.SignInRequest]) (*connect.Response[v1.SignInResponse], error)
+	// VerifyMFA completes a sign in by answering its MFA challenge with a second factor.
+	VerifyMFA(context.Context, *connect.Request[v1.VerifyMFARequest]) (*connect.Response[v1.VerifyMFAResponse], error)
+	// BeginPasskeySignIn starts a WebAuthn authentication ceremony. Without identifier, or if the user has no passkeys,
+	// any discoverable passkey is accepted.
+	BeginPasskeySignIn(context.Context, *connect.Request[v1.BeginPasskeySignInRequest]) (*connect.Response[v1.BeginPasskeySignInResponse], error)
+	// FinishPasskeySignIn signs in the owner of the passkey which answered the ceremony. A passkey is not followed by an
+	// MFA challenge.
+	FinishPasskeySignIn(context.Context, *connect.Request[v1.FinishPasskeySignInRequest]) (*connect.Response[v1.FinishPasskeySignInResponse], error)
+	// SendMagicLink emails a single-use sign in link to the user. It succeeds whether or not the user exists, so it does
+	// not reveal which email addresses are registered.
+	SendMagicLink(context.Context, *connect.Request[v1.SendMagicLinkRequest]) (*connect.Response[v1.SendMagicLinkResponse], error)
+	// VerifyMagicLink signs in with the token of a magic link. If the user enrolled a second factor, an MFA challenge is
+	// returned instead of a session.
+	VerifyMagicLink(context.Context, *connect.Request[v1.VerifyMagicLinkRequest]) (*connect.Response[v1.VerifyMagicLinkResponse], error)
+	// SendEmailOTP emails a 6 digit one-time code to the user and returns the challenge to answer with it. A challenge is
+	// returned whether or not the user exists.
+	SendEmailOTP(context.Context, *connect.Request[v1.SendEmailOTPRequest]) (*connect.Response[v1.SendEmailOTPResponse], error)
+	// VerifyEmailOTP signs in by answering an email challenge with its code. A challenge only accepts a limited number
+	// of attempts. If the user enrolled a second factor, an MFA challenge is returned instead of a session.
+	VerifyEmailOTP(context.Context, *connect.Request[v1.VerifyEmailOTPRequest]) (*connect.Response[v1.VerifyEmailOTPResponse], error)
+	// SendEmailVerification emails a new verification link to the user if its email address is not verified yet.
+	// Repeated requests within a short time are ignored. It succeeds whether or not the user exists, so it does not
+	// reveal which email addresses are registered.
+	SendEmailVerification(context.Context, *connect.Request[v1.SendEmailVerificationRequest]) (*connect.Response[v1.SendEmailVerificationResponse], error)
+	// VerifyEmail completes a verification link sent by SignUp, SendEmailVerification or UserService.RequestEmailChange.
+	// A confirmed email change is notified to the previous email address.
+	VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error)
+	// RequestPasswordReset emails a single-use link to reset the password of the user. It succeeds whether or not the
+	// user exists, so it does not reveal which email addresses are registered.
+	RequestPasswordReset(context.Context, *connect.Request[v1.RequestPasswordResetRequest]) (*connect.Response[v1.RequestPasswordResetResponse], error)
+	// ResetPassword sets a new password with the token of a password reset link and revokes all sessions of the user.
+	// The token is kept if the password violates the password policy, so another password can be tried.
+	ResetPassword(context.Context, *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error)
+	// SignOut revokes the session of the caller and all of its refresh tokens.
+	SignOut(context.Context, *connect.Request[v1.SignOutRequest]) (*connect.Response[v1.SignOutResponse], error)
+	// Refresh exchanges a refresh token for a new access and refresh token. Each refresh token can only be used once.
+	Refresh(context.Context, *connect.Request[v1.RefreshRequest]) (*connect.Response[v1.RefreshResponse], error)
+	// GetSession returns the session and user of the caller.
+	GetSession(context.Context, *connect.Request[v1.GetSessionRequest]) (*connect.Response[v1.GetSessionResponse], error)
+}
+
+// NewAuthServiceClient constructs a client for the guardian.v1.AuthService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AuthServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	authServiceMethods := v1.File_guardian_v1_auth_proto.Services().ByName("AuthService").Methods()
+	return &authServiceClient{
+		signUp: connect.NewClient[v1.SignUpRequest, v1.SignUpResponse](
+			httpClient,
+			baseURL+AuthServiceSignUpProcedure,
+			connect.WithSchema(authServiceMethods.ByName("SignUp")),
+			connect.WithClientOptions(opts...),
+		),
+		signIn: connect.NewClient[v1.SignInRequest, v1.SignInResponse](
+			httpClient,
+			baseURL+AuthServiceSignInProcedure,
+			connect.WithSchema(authServiceMethods.ByName("SignIn")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyMFA: connect.NewClient[v1.VerifyMFARequest, v1.VerifyMFAResponse](
+			httpClient,
+			baseURL+AuthServiceVerifyMFAProcedure,
+			connect.WithSchema(authServiceMethods.ByName("VerifyMFA")),
+			connect.WithClientOptions(opts...),
+		),
+		beginPasskeySignIn: connect.NewClient[v1.BeginPasskeySignInRequest, v1.BeginPasskeySignInResponse](
+			httpClient,
+			baseURL+AuthServiceBeginPasskeySignInProcedure,
+			connect.WithSchema(authServiceMethods.ByName("BeginPasskeySignIn")),
+			connect.WithClientOptions(opts...),
+		),
+		finishPasskeySignIn: connect.NewClient[v1.FinishPasskeySignInRequest, v1.FinishPasskeySignInResponse](
+			httpClient,
+			baseURL+AuthServiceFinishPasskeySignInProcedure,
+			connect.WithSchema(authServiceMethods.ByName("FinishPasskeySignIn")),
+			connect.WithClientOptions(opts...),
+		),
+		sendMagicLink: connect.NewClient[v1.SendMagicLinkRequest, v1.SendMagicLinkResponse](
+			httpClient,
+			baseURL+AuthServiceSendMagicLinkProcedure,
+			connect.WithSchema(authServiceMethods.ByName("SendMagicLink")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyMagicLink: connect.NewClient[v1.VerifyMagicLinkRequest, v1.VerifyMagicLinkResponse](
+			httpClient,
+			baseURL+AuthServiceVerifyMagicLinkProcedure,
+			connect.WithSchema(authServiceMethods.ByName("VerifyMagicLink")),
+			connect.WithClientOptions(opts...),
+		),
+		sendEmailOTP: connect.NewClient[v1.SendEmailOTPRequest, v1.SendEmailOTPResponse](
+			httpClient,
+			baseURL+AuthServiceSendEmailOTPProcedure,
+			connect.WithSchema(authServiceMethods.ByName("SendEmailOTP")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyEmailOTP: connect.NewClient[v1.VerifyEmailOTPRequest, v1.VerifyEmailOTPResponse](
+			httpClient,
+			baseURL+AuthServiceVerifyEmailOTPProcedure,
+			connect.WithSchema(authServiceMethods.ByName("VerifyEmailOTP")),
+			connect.WithClientOptions(opts...),
+		),
+		sendEmailVerification: connect.NewClient[v1.SendEmailVerificationRequest, v1.SendEmailVerificationResponse](
+			httpClient,
+			baseURL+AuthServiceSendEmailVerificationProcedure,
+			connect.WithSchema(authServiceMethods.ByName("SendEmailVerification")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyEmail: connect.NewClient[v1.VerifyEmailRequest, v1.VerifyEmailResponse](
+			httpClient,
+			baseURL+AuthServiceVerifyEmailProcedure,
+			connect.WithSchema(authServiceMethods.ByName("VerifyEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		requestPasswordReset: connect.NewClient[v1.RequestPasswordResetRequest, v1.RequestPasswordResetResponse](
+			httpClient,
+			baseURL+AuthServiceRequestPasswordResetProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RequestPasswordReset")),
+			connect.WithClientOptions(opts...),
+		),
+		resetPassword: connect.NewClient[v1.ResetPasswordRequest, v1.ResetPasswordResponse](
+			httpClient,
+			baseURL+AuthServiceResetPasswordProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ResetPassword")),
+			connect.WithClientOptions(opts...),
+		),
+		signOut: connect.NewClient[v1.SignOutRequest, v1.SignOutResponse](
+			httpClient,
+			baseURL+AuthServiceSignOutProcedure,
+			connect.WithSchema(authServiceMethods.ByName("SignOut")),
+			connect.WithClientOptions(opts...),
+		),
+		refresh: connect.NewClient[v1.RefreshRequest, v1.RefreshResponse](
+			httpClient,
+			baseURL+AuthServiceRefreshProcedure,
+			connect.WithSchema(authServiceMethods.ByName("Refresh")),
+			connect.WithClientOptions(opts...),
+		),
+		getSession: connect.NewClient[v1.GetSessionRequest, v1.GetSessionResponse](
+			httpClient,
+			baseURL+AuthServiceGetSessionProcedure,
+			connect.WithSchema(authServiceMethods.ByName("GetSession")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// authServiceClient implements AuthServiceClient.
+type authServiceClient struct {
+	signUp                *connect.Client[v1.SignUpRequest, v1.SignUpResponse]
+	signIn                *connect.Client[v1.SignInRequest, v1.SignInResponse]
+	verifyMFA             *connect.Client[v1.VerifyMFARequest, v1.VerifyMFAResponse]
+	beginPasskeySignIn    *connect.Client[v1.BeginPasskeySignInRequest, v1.BeginPasskeySignInResponse]
+	finishPasskeySignIn   *connect.Client[v1.FinishPasskeySignInRequest, v1.FinishPasskeySignInResponse]
+	sendMagicLink         *connect.Client[v1.SendMagicLinkRequest, v1.SendMagicLinkResponse]
+	verifyMagicLink       *connect.Client[v1.VerifyMagicLinkRequest, v1.VerifyMagicLinkResponse]
+	sendEmailOTP          *connect.Client[v1.SendEmailOTPRequest, v1.SendEmailOTPResponse]
+	verifyEmailOTP        *connect.Client[v1.VerifyEmailOTPRequest, v1.VerifyEmailOTPResponse]
+	sendEmailVerification *connect.Client[v1.SendEmailVerificationRequest, v1.SendEmailVerificationResponse]
+	verifyEmail           *connect.Client[v1.VerifyEmailRequest, v1.VerifyEmailResponse]
+	requestPasswordReset  *connect.Client[v1.RequestPasswordResetRequest, v1.RequestPasswordResetResponse]
+	resetPassword         *connect.Client[v1.ResetPasswordRequest, v1.ResetPasswordResponse]
+	signOut               *connect.Client[v1.SignOutRequest, v1.SignOutResponse]
+	refresh               *connect.Client[v1.RefreshRequest, v1.RefreshResponse]
+	getSession            *connect.Client[v1.GetSessionRequest, v1.GetSessionResponse]
+}
+
+// SignUp calls guardian.v1.AuthService.SignUp.
+func (c *authServiceClient) SignUp(ctx context.Context, req *connect.Request[v1.SignUpRequest]) (*connect.Response[v1.SignUpResponse], error) {
+	return c.signUp.CallUnary(ctx, req)
+}
+
+// SignIn calls guardian.v1.AuthService.SignIn.
+func (c *authServiceClient) SignIn(ctx context.Context, req *connect.Request[v1.SignInRequest]) (*connect.Response[v1.SignInResponse], error) {
+	return c.signIn.CallUnary(ctx, req)
+}
+
+// VerifyMFA calls guardian.v1.AuthService.VerifyMFA.
+func (c *authServiceClient) VerifyMFA(ctx context.Context, req *connect.Request[v1.VerifyMFARequest]) (*connect.Response[v1.VerifyMFAResponse], error) {
+	return c.verifyMFA.CallUnary(ctx, req)
+}
+
+// BeginPasskeySignIn calls guardian.v1.AuthService.BeginPasskeySignIn.
+func (c *authServiceClient) BeginPasskeySignIn(ctx context.Context, req *connect.Request[v1.BeginPasskeySignInRequest]) (*connect.Response[v1.BeginPasskeySignInResponse], error) {
+	return c.beginPasskeySignIn.CallUnary(ctx, req)
+}
+
+// FinishPasskeySignIn calls guardian.v1.AuthService.FinishPasskeySignIn.
+func (c *authServiceClient) FinishPasskeySignIn(ctx context.Context, req *connect.Request[v1.FinishPasskeySignInRequest]) (*connect.Response[v1.FinishPasskeySignInResponse], error) {
+	return c.finishPasskeySignIn.CallUnary(ctx, req)
+}
+
+// SendMagicLink calls guardian.v1.AuthService.SendMagicLink.
+func (c *authServiceClient) SendMagicLink(ctx context.Context, req *connect.Request[v1.SendMagicLinkRequest]) (*connect.Response[v1.SendMagicLinkResponse], error) {
+	return c.sendMagicLink.CallUnary(ctx, req)
+}
+
+// VerifyMagicLink calls guardian.v1.AuthService.VerifyMagicLink.
+func (c *authServiceClient) VerifyMagicLink(ctx context.Context, req *connect.Request[v1.VerifyMagicLinkRequest]) (*connect.Response[v1.VerifyMagicLinkResponse], error) {
+	return c.verifyMagicLink.CallUnary(ctx, req)
+}
+
+// SendEmailOTP calls guardian.v1.AuthService.SendEmailOTP.
+func (c *authServiceClient) SendEmailOTP(ctx context.Context, req *connect.Request[v1.SendEmailOTPRequest]) (*connect.Response[v1.SendEmailOTPResponse], error) {
+	return c.sendEmailOTP.CallUnary(ctx, req)
+}
+
+// VerifyEmailOTP calls guardian.v1.AuthService.VerifyEmailOTP.
+func (c *authServiceClient) VerifyEmailOTP(ctx context.Context, req *connect.Request[v1.VerifyEmailOTPRequest]) (*connect.Response[v1.VerifyEmailOTPResponse], error) {
+	return c.verifyEmailOTP.CallUnary(ctx, req)
+}
+
+// SendEmailVerification calls guardian.v1.AuthService.SendEmailVerification.
+func (c *authServiceClient) SendEmailVerification(ctx context.Context, req *connect.Request[v1.SendEmailVerificationRequest]) (*connect.Response[v1.SendEmailVerificationResponse], error) {
+	return c.sendEmailVerification.CallUnary(ctx, req)
+}
+
+// VerifyEmail calls guardian.v1.AuthService.VerifyEmail.
+func (c *authServiceClient) VerifyEmail(ctx context.Context, req *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error) {
+	return c.verifyEmail.CallUnary(ctx, req)
+}
+
+// RequestPasswordReset calls guardian.v1.AuthService.RequestPasswordReset.
+func (c *authServiceClient) RequestPasswordReset(ctx context.Context, req *connect.Request[v1.RequestPasswordResetRequest]) (*connect.Response[v1.RequestPasswordResetResponse], error) {
+	return c.requestPasswordReset.CallUnary(ctx, req)
+}
+
+// ResetPassword calls guardian.v1.AuthService.ResetPassword.
+func (c *authServiceClient) ResetPassword(ctx context.Context, req *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error) {
+	return c.resetPassword.CallUnary(ctx, req)
+}
+
+// SignOut calls guardian.v1.AuthService.SignOut.
+func (c *authServiceClient) SignOut(ctx context.Context, req *connect.Request[v1.SignOutRequest]) (*connect.Response[v1.SignOutResponse], error) {
+	return c.signOut.CallUnary(ctx, req)
+}
+
+// Refresh calls guardian.v1.AuthService.Refresh.
+func (c *authServiceClient) Refresh(ctx context.Context, req *connect.Request[v1.RefreshRequest]) (*connect.Response[v1.RefreshResponse], error) {
+	return c.refresh.CallUnary(ctx, req)
+}
+
+// GetSession calls guardian.v1.AuthService.GetSession.
+func (c *authServiceClient) GetSession(ctx context.Context, req *connect.Request[v1.GetSessionRequest]) (*connect.Response[v1.GetSessionResponse], error) {
+	return c.getSession.CallUnary(ctx, req)
+}
+
+// AuthServiceHandler is an implementation of the guardian.v1.AuthService service.
+type AuthServiceHandler interface {
+	// SignUp creates a user with a password, emails a link to verify its email address and signs it in. If verified
+	// email addresses are required, the user is returned without a session instead.
+	SignUp(context.Context, *connect.Request[v1.SignUpRequest]) (*connect.Response[v1.SignUpResponse], error)
+	// SignIn signs in a user by email or username and password. If the user enrolled a second factor, an MFA challenge
+	// is returned instead of a session, which has to be answered with VerifyMFA.
+	SignIn(context.Context, *connect.Request[v1.SignInRequest]) (*connect.Response[v1.SignInResponse], error)
+	// VerifyMFA completes a sign in by answering its MFA challenge with a second factor.
+	VerifyMFA(context.Context, *connect.Request[v1.VerifyMFARequest]) (*connect.Response[v1.VerifyMFAResponse], error)
+	// BeginPasskeySignIn starts a WebAuthn authentication ceremony. Without identifier, or if the user has no passkeys,
+	// any discoverable passkey is accepted.
+	BeginPasskeySignIn(context.Context, *connect.Request[v1.BeginPasskeySignInRequest]) (*connect.Response[v1.BeginPasskeySignInResponse], error)
+	// FinishPasskeySignIn signs in the owner of the passkey which answered the ceremony. A passkey is not followed by an
+	// MFA challenge.
+	FinishPasskeySignIn(context.Context, *connect.Request[v1.FinishPasskeySignInRequest]) (*connect.Response[v1.FinishPasskeySignInResponse], error)
+	// SendMagicLink emails a single-use sign in link to the user. It succeeds whether or not the user exists, so it does
+	// not reveal which email addresses are registered.
+	SendMagicLink(context.Context, *connect.Request[v1.SendMagicLinkRequest]) (*connect.Response[v1.SendMagicLinkResponse], error)
+	// VerifyMagicLink signs in with the token of a magic link. If the user enrolled a second factor, an MFA challenge is
+	// returned instead of a session.
+	VerifyMagicLink(context.Context, *connect.Request[v1.VerifyMagicLinkRequest]) (*connect.Response[v1.VerifyMagicLinkResponse], error)
+	// SendEmailOTP emails a 6 digit one-time code to the user and returns the challenge to answer with it. A challenge is
+	// returned whether or not the user exists.
+	SendEmailOTP(context.Context, *connect.Request[v1.SendEmailOTPRequest]) (*connect.Response[v1.SendEmailOTPResponse], error)
+	// VerifyEmailOTP signs in by answering an email challenge with its code. A challenge only accepts a limited number
+	// of attempts. If the user enrolled a second factor, an MFA challenge is returned instead of a session.
+	VerifyEmailOTP(context.Context, *connect.Request[v1.VerifyEmailOTPRequest]) (*connect.Response[v1.VerifyEmailOTPResponse], error)
+	// SendEmailVerification emails a new verification link to the user if its email address is not verified yet.
+	// Repeated requests within a short time are ignored. It succeeds whether or not the user exists, so it does not
+	// reveal which email addresses are registered.
+	SendEmailVerification(context.Context, *connect.Request[v1.SendEmailVerificationRequest]) (*connect.Response[v1.SendEmailVerificationResponse], error)
+	// VerifyEmail completes a verification link sent by SignUp, SendEmailVerification or UserService.RequestEmailChange.
+	// A confirmed email change is notified to the previous email address.
+	VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error)
+	// RequestPasswordReset emails a single-use link to reset the password of the user. It succeeds whether or not the
+	// user exists, so it does not reveal which email addresses are registered.
+	RequestPasswordReset(context.Context, *connect.Request[v1.RequestPasswordResetRequest]) (*connect.Response[v1.RequestPasswordResetResponse], error)
+	// ResetPassword sets a new password with the token of a password reset link and revokes all sessions of the user.
+	// The token is kept if the password violates the password policy, so another password can be tried.
+	ResetPassword(context.Context, *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error)
+	// SignOut revokes the session of the caller and all of its refresh tokens.
+	SignOut(context.Context, *connect.Request[v1.SignOutRequest]) (*connect.Response[v1.SignOutResponse], error)
+	// Refresh exchanges a refresh token for a new access and refresh token. Each refresh token can only be used once.
+	Refresh(context.Context, *connect.Request[v1.RefreshRequest]) (*connect.Response[v1.RefreshResponse], error)
+	// GetSession returns the session and user of the caller.
+	GetSession(context.Context, *connect.Request[v1.GetSessionRequest]) (*connect.Response[v1.GetSessionResponse], error)
+}
+
+// NewAuthServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	authServiceMethods := v1.File_guardian_v1_auth_proto.Services().ByName("AuthService").Methods()
+	authServiceSignUpHandler := connect.NewUnaryHandler(
+		AuthServiceSignUpProcedure,
+		svc.SignUp,
+		connect.WithSchema(authServiceMethods.ByName("SignUp")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceSignInHandler := connect.NewUnaryHandler(
+		AuthServiceSignInProcedure,
+		svc.SignIn,
+		connect.WithSchema(authServiceMethods.ByName("SignIn")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceVerifyMFAHandler := connect.NewUnaryHandler(
+		AuthServiceVerifyMFAProcedure,
+		svc.VerifyMFA,
+		connect.WithSchema(authServiceMethods.ByName("VerifyMFA")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceBeginPasskeySignInHandler := connect.NewUnaryHandler(
+		AuthServiceBeginPasskeySignInProcedure,
+		svc.BeginPasskeySignIn,
+		connect.WithSchema(authServiceMethods.ByName("BeginPasskeySignIn")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceFinishPasskeySignInHandler := connect.NewUnaryHandler(
+		AuthServiceFinishPasskeySignInProcedure,
+		svc.FinishPasskeySignIn,
+		connect.WithSchema(authServiceMethods.ByName("FinishPasskeySignIn")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceSendMagicLinkHandler := connect.NewUnaryHandler(
+		AuthServiceSendMagicLinkProcedure,
+		svc.SendMagicLink,
+		connect.WithSchema(authServiceMethods.ByName("SendMagicLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceVerifyMagicLinkHandler := connect.NewUnaryHandler(
+		AuthServiceVerifyMagicLinkProcedure,
+		svc.VerifyMagicLink,
+		connect.WithSchema(authServiceMethods.ByName("VerifyMagicLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceSendEmailOTPHandler := connect.NewUnaryHandler(
+		AuthServiceSendEmailOTPProcedure,
+		svc.SendEmailOTP,
+		connect.WithSchema(authServiceMethods.ByName("SendEmailOTP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceVerifyEmailOTPHandler := connect.NewUnaryHandler(
+		AuthServiceVerifyEmailOTPProcedure,
+		svc.VerifyEmailOTP,
+		connect.WithSchema(authServiceMethods.ByName("VerifyEmailOTP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceSendEmailVerificationHandler := connect.NewUnaryHandler(
+		AuthServiceSendEmailVerificationProcedure,
+		svc.SendEmailVerification,
+		connect.WithSchema(authServiceMethods.ByName("SendEmailVerification")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceVerifyEmailHandler := connect.NewUnaryHandler(
+		AuthServiceVerifyEmailProcedure,
+		svc.VerifyEmail,
+		connect.WithSchema(authServiceMethods.ByName("VerifyEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRequestPasswordResetHandler := connect.NewUnaryHandler(
+		AuthServiceRequestPasswordResetProcedure,
+		svc.RequestPasswordReset,
+		connect.WithSchema(authServiceMethods.ByName("RequestPasswordReset")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceResetPasswordHandler := connect.NewUnaryHandler(
+		AuthServiceResetPasswordProcedure,
+		svc.ResetPassword,
+		connect.WithSchema(authServiceMethods.ByName("ResetPassword")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceSignOutHandler := connect.NewUnaryHandler(
+		AuthServiceSignOutProcedure,
+		svc.SignOut,
+		connect.WithSchema(authServiceMethods.ByName("SignOut")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRefreshHandler := connect.NewUnaryHandler(
+		AuthServiceRefreshProcedure,
+		svc.Refresh,
+		connect.WithSchema(authServiceMethods.ByName("Refresh")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceGetSessionHandler := connect.NewUnaryHandler(
+		AuthServiceGetSessionProcedure,
+		svc.GetSession,
+		connect.WithSchema(authServiceMethods.ByName("GetSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/guardian.v1.AuthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AuthServiceSignUpProcedure:
+			authServiceSignUpHandler.ServeHTTP(w, r)
+		case AuthServiceSignInProcedure:
+			authServiceSignInHandler.ServeHTTP(w, r)
+		case AuthServiceVerifyMFAProcedure:
+			authServiceVerifyMFAHandler.ServeHTTP(w, r)
+		case AuthServiceBeginPasskeySignInProcedure:
+			authServiceBeginPasskeySignInHandler.ServeHTTP(w, r)
+		case AuthServiceFinishPasskeySignInProcedure:
+			authServiceFinishPasskeySignInHandler.ServeHTTP(w, r)
+		case AuthServiceSendMagicLinkProcedure:
+			authServiceSendMagicLinkHandler.ServeHTTP(w, r)
+		case AuthServiceVerifyMagicLinkProcedure:
+			authServiceVerifyMagicLinkHandler.ServeHTTP(w, r)
+		case AuthServiceSendEmailOTPProcedure:
+			authServiceSendEmailOTPHandler.ServeHTTP(w, r)
+		case AuthServiceVerifyEmailOTPProcedure:
+			authServiceVerifyEmailOTPHandler.ServeHTTP(w, r)
+		case AuthServiceSendEmailVerificationProcedure:
+			authServiceSendEmailVerificationHandler.ServeHTTP(w, r)
+		case AuthServiceVerifyEmailProcedure:
+			authServiceVerifyEmailHandler.ServeHTTP(w, r)
+		case AuthServiceRequestPasswordResetProcedure:
+			authServiceRequestPasswordResetHandler.ServeHTTP(w, r)
+		case AuthServiceResetPasswordProcedure:
+			authServiceResetPasswordHandler.ServeHTTP(w, r)
+		case AuthServiceSignOutProcedure:
+			authServiceSignOutHandler.ServeHTTP(w, r)
+		case AuthServiceRefreshProcedure:
+			authServiceRefreshHandler.ServeHTTP(w, r)
+		case AuthServiceGetSessionProcedure:
+			authServiceGetSessionHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAuthServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAuthServiceHandler struct{}
+
+func (UnimplementedAuthServiceHandler) SignUp(context.Context, *connect.Request[v1.SignUpRequest]) (*connect.Response[v1.SignUpResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthService.SignUp is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) SignIn(context.Context, *connect.Request[v1.SignInRequest]) (*connect.Response[v1.SignInResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthService.SignIn is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) VerifyMFA(context.Context, *connect.Request[v1.VerifyMFARequest]) (*connect.Response[v1.VerifyMFAResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthService.VerifyMFA is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) BeginPasskeySignIn(context.Context, *connect.Request[v1.BeginPasskeySignInRequest]) (*connect.Response[v1.BeginPasskeySignInResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthService.BeginPasskeySignIn is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) FinishPasskeySignIn(context.Context, *connect.Request[v1.FinishPasskeySignInRequest]) (*connect.Response[v1.FinishPasskeySignInResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthService.FinishPasskeySignIn is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) SendMagicLink(context.Context, *connect.Request[v1.SendMagicLinkRequest]) (*connect.Response[v1.SendMagicLinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthService.SendMagicLink is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) VerifyMagicLink(context.Context, *connect.Request[v1.VerifyMagicLinkRequest]) (*connect.Response[v1.VerifyMagicLinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthService.VerifyMagicLink is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) SendEmailOTP(context.Context, *connect.Request[v1.SendEmailOTPRequest]) (*connect.Response[v1.SendEmailOTPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthService.SendEmailOTP is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) VerifyEmailOTP(context.Context, *connect.Request[v1.VerifyEmailOTPRequest]) (*connect.Response[v1.VerifyEmailOTPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthService.VerifyEmailOTP is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) SendEmailVerification(context.Context, *connect.Request[v1.SendEmailVerificationRequest]) (*connect.Response[v1.SendEmailVerificationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthService.SendEmailVerification is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthService.VerifyEmail is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RequestPasswordReset(context.Context, *connect.Request[v1.RequestPasswordResetRequest]) (*connect.Response[v1.RequestPasswordResetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthService.RequestPasswordReset is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ResetPassword(context.Context, *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthService.ResetPassword is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) SignOut(context.Context, *connect.Request[v1.SignOutRequest]) (*connect.Response[v1.SignOutResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthService.SignOut is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) Refresh(context.Context, *connect.Request[v1.RefreshRequest]) (*connect.Response[v1.RefreshResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthService.Refresh is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) GetSession(context.Context, *connect.Request[v1.GetSessionRequest]) (*connect.Response[v1.GetSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthService.GetSession is not implemented"))
+}
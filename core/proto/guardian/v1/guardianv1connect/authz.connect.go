@@ -0,0 +1,767 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: guardian/v1/authz.proto
+
+package guardianv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/gophero/guardian/core/proto/guardian/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AuthzServiceName is the fully-qualified name of the AuthzService service.
+	AuthzServiceName = "guardian.v1.AuthzService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AuthzServiceCreatePermissionProcedure is the fully-qualified name of the AuthzService's
+	// CreatePermission RPC.
+	AuthzServiceCreatePermissionProcedure = "/guardian.v1.AuthzService/CreatePermission"
+	// AuthzServiceListPermissionsProcedure is the fully-qualified name of the AuthzService's
+	// ListPermissions RPC.
+	AuthzServiceListPermissionsProcedure = "/guardian.v1.AuthzService/ListPermissions"
+	// AuthzServiceDeletePermissionProcedure is the fully-qualified name of the AuthzService's
+	// DeletePermission RPC.
+	AuthzServiceDeletePermissionProcedure = "/guardian.v1.AuthzService/DeletePermission"
+	// AuthzServiceCreateRoleProcedure is the fully-qualified name of the AuthzService's CreateRole RPC.
+	AuthzServiceCreateRoleProcedure = "/guardian.v1.AuthzService/CreateRole"
+	// AuthzServiceGetRoleProcedure is the fully-qualified name of the AuthzService's GetRole RPC.
+	AuthzServiceGetRoleProcedure = "/guardian.v1.AuthzService/GetRole"
+	// AuthzServiceListRolesProcedure is the fully-qualified name of the AuthzService's ListRoles RPC.
+	AuthzServiceListRolesProcedure = "/guardian.v1.AuthzService/ListRoles"
+	// AuthzServiceUpdateRoleProcedure is the fully-qualified name of the AuthzService's UpdateRole RPC.
+	AuthzServiceUpdateRoleProcedure = "/guardian.v1.AuthzService/UpdateRole"
+	// AuthzServiceDeleteRoleProcedure is the fully-qualified name of the AuthzService's DeleteRole RPC.
+	AuthzServiceDeleteRoleProcedure = "/guardian.v1.AuthzService/DeleteRole"
+	// AuthzServiceGrantPermissionProcedure is the fully-qualified name of the AuthzService's
+	// GrantPermission RPC.
+	AuthzServiceGrantPermissionProcedure = "/guardian.v1.AuthzService/GrantPermission"
+	// AuthzServiceRevokePermissionProcedure is the fully-qualified name of the AuthzService's
+	// RevokePermission RPC.
+	AuthzServiceRevokePermissionProcedure = "/guardian.v1.AuthzService/RevokePermission"
+	// AuthzServiceAddRoleParentProcedure is the fully-qualified name of the AuthzService's
+	// AddRoleParent RPC.
+	AuthzServiceAddRoleParentProcedure = "/guardian.v1.AuthzService/AddRoleParent"
+	// AuthzServiceRemoveRoleParentProcedure is the fully-qualified name of the AuthzService's
+	// RemoveRoleParent RPC.
+	AuthzServiceRemoveRoleParentProcedure = "/guardian.v1.AuthzService/RemoveRoleParent"
+	// AuthzServiceCreateConditionProcedure is the fully-qualified name of the AuthzService's
+	// CreateCondition RPC.
+	AuthzServiceCreateConditionProcedure = "/guardian.v1.AuthzService/CreateCondition"
+	// AuthzServiceGetConditionProcedure is the fully-qualified name of the AuthzService's GetCondition
+	// RPC.
+	AuthzServiceGetConditionProcedure = "/guardian.v1.AuthzService/GetCondition"
+	// AuthzServiceListConditionsProcedure is the fully-qualified name of the AuthzService's
+	// ListConditions RPC.
+	AuthzServiceListConditionsProcedure = "/guardian.v1.AuthzService/ListConditions"
+	// AuthzServiceUpdateConditionProcedure is the fully-qualified name of the AuthzService's
+	// UpdateCondition RPC.
+	AuthzServiceUpdateConditionProcedure = "/guardian.v1.AuthzService/UpdateCondition"
+	// AuthzServiceDeleteConditionProcedure is the fully-qualified name of the AuthzService's
+	// DeleteCondition RPC.
+	AuthzServiceDeleteConditionProcedure = "/guardian.v1.AuthzService/DeleteCondition"
+	// AuthzServiceAssignRoleProcedure is the fully-qualified name of the AuthzService's AssignRole RPC.
+	AuthzServiceAssignRoleProcedure = "/guardian.v1.AuthzService/AssignRole"
+	// AuthzServiceUnassignRoleProcedure is the fully-qualified name of the AuthzService's UnassignRole
+	// RPC.
+	AuthzServiceUnassignRoleProcedure = "/guardian.v1.AuthzService/UnassignRole"
+	// AuthzServiceListRoleAssignmentsProcedure is the fully-qualified name of the AuthzService's
+	// ListRoleAssignments RPC.
+	AuthzServiceListRoleAssignmentsProcedure = "/guardian.v1.AuthzService/ListRoleAssignments"
+	// AuthzServiceCheckProcedure is the fully-qualified name of the AuthzService's Check RPC.
+	AuthzServiceCheckProcedure = "/guardian.v1.AuthzService/Check"
+	// AuthzServiceListEffectivePermissionsProcedure is the fully-qualified name of the AuthzService's
+	// ListEffectivePermissions RPC.
+	AuthzServiceListEffectivePermissionsProcedure = "/guardian.v1.AuthzService/ListEffectivePermissions"
+)
+
+// AuthzServiceClient is a client for the guardian.v1.AuthzService service.
+type AuthzServiceClient interface {
+	// CreatePermission creates a permission. Names are lowercase dot separated segments like `documents.read`.
+	CreatePermission(context.Context, *connect.Request[v1.CreatePermissionRequest]) (*connect.Response[v1.CreatePermissionResponse], error)
+	// ListPermissions lists all permissions ordered by name.
+	ListPermissions(context.Context, *connect.Request[v1.ListPermissionsRequest]) (*connect.Response[v1.ListPermissionsResponse], error)
+	// DeletePermission deletes a permission and revokes it from all roles.
+	DeletePermission(context.Context, *connect.Request[v1.DeletePermissionRequest]) (*connect.Response[v1.DeletePermissionResponse], error)
+	// CreateRole creates a role without permissions.
+	CreateRole(context.Context, *connect.Request[v1.CreateRoleRequest]) (*connect.Response[v1.CreateRoleResponse], error)
+	// GetRole returns a role by id.
+	GetRole(context.Context, *connect.Request[v1.GetRoleRequest]) (*connect.Response[v1.GetRoleResponse], error)
+	// ListRoles lists all roles ordered by name.
+	ListRoles(context.Context, *connect.Request[v1.ListRolesRequest]) (*connect.Response[v1.ListRolesResponse], error)
+	// UpdateRole updates the fields of a role which are set in the request.
+	UpdateRole(context.Context, *connect.Request[v1.UpdateRoleRequest]) (*connect.Response[v1.UpdateRoleResponse], error)
+	// DeleteRole deletes a role along with its assignments.
+	DeleteRole(context.Context, *connect.Request[v1.DeleteRoleRequest]) (*connect.Response[v1.DeleteRoleResponse], error)
+	// GrantPermission grants a permission to a role.
+	GrantPermission(context.Context, *connect.Request[v1.GrantPermissionRequest]) (*connect.Response[v1.GrantPermissionResponse], error)
+	// RevokePermission revokes a permission from a role.
+	RevokePermission(context.Context, *connect.Request[v1.RevokePermissionRequest]) (*connect.Response[v1.RevokePermissionResponse], error)
+	// AddRoleParent lets a role inherit all permissions of a parent role. Fails with INVALID_ARGUMENT if the parent
+	// inherits from the role, as role inheritance must not contain cycles.
+	AddRoleParent(context.Context, *connect.Request[v1.AddRoleParentRequest]) (*connect.Response[v1.AddRoleParentResponse], error)
+	// RemoveRoleParent removes a parent of a role.
+	RemoveRoleParent(context.Context, *connect.Request[v1.RemoveRoleParentRequest]) (*connect.Response[v1.RemoveRoleParentResponse], error)
+	// CreateCondition creates a condition. Fails with INVALID_ARGUMENT if the expression does not compile or does not
+	// evaluate to a bool.
+	CreateCondition(context.Context, *connect.Request[v1.CreateConditionRequest]) (*connect.Response[v1.CreateConditionResponse], error)
+	// GetCondition returns a condition by name.
+	GetCondition(context.Context, *connect.Request[v1.GetConditionRequest]) (*connect.Response[v1.GetConditionResponse], error)
+	// ListConditions lists all conditions ordered by name.
+	ListConditions(context.Context, *connect.Request[v1.ListConditionsRequest]) (*connect.Response[v1.ListConditionsResponse], error)
+	// UpdateCondition updates the fields of a condition which are set in the request. A new expression applies to all
+	// grants requiring the condition.
+	UpdateCondition(context.Context, *connect.Request[v1.UpdateConditionRequest]) (*connect.Response[v1.UpdateConditionResponse], error)
+	// DeleteCondition deletes a condition. Fails with INVALID_ARGUMENT while grants require it.
+	DeleteCondition(context.Context, *connect.Request[v1.DeleteConditionRequest]) (*connect.Response[v1.DeleteConditionResponse], error)
+	// AssignRole assigns a role to a user or a service account in a scope.
+	AssignRole(context.Context, *connect.Request[v1.AssignRoleRequest]) (*connect.Response[v1.AssignRoleResponse], error)
+	// UnassignRole removes a role assignment.
+	UnassignRole(context.Context, *connect.Request[v1.UnassignRoleRequest]) (*connect.Response[v1.UnassignRoleResponse], error)
+	// ListRoleAssignments lists the role assignments of a user or a service account in all scopes.
+	ListRoleAssignments(context.Context, *connect.Request[v1.ListRoleAssignmentsRequest]) (*connect.Response[v1.ListRoleAssignmentsResponse], error)
+	// Check reports whether a user has a permission in a scope and which role assignment granted it. Callers can check
+	// themselves, checking other users requires the `guardian.authz.check` permission in the scope. Explaining a check
+	// requires the `guardian.authz.check` permission even for the caller.
+	Check(context.Context, *connect.Request[v1.CheckRequest]) (*connect.Response[v1.CheckResponse], error)
+	// ListEffectivePermissions lists all permissions of a user in a scope, including inherited ones. It is authorized
+	// like Check.
+	ListEffectivePermissions(context.Context, *connect.Request[v1.ListEffectivePermissionsRequest]) (*connect.Response[v1.ListEffectivePermissionsResponse], error)
+}
+
+// NewAuthzServiceClient constructs a client for the guardian.v1.AuthzService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAuthzServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AuthzServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	authzServiceMethods := v1.File_guardian_v1_authz_proto.Services().ByName("AuthzService").Methods()
+	return &authzServiceClient{
+		createPermission: connect.NewClient[v1.CreatePermissionRequest, v1.CreatePermissionResponse](
+			httpClient,
+			baseURL+AuthzServiceCreatePermissionProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("CreatePermission")),
+			connect.WithClientOptions(opts...),
+		),
+		listPermissions: connect.NewClient[v1.ListPermissionsRequest, v1.ListPermissionsResponse](
+			httpClient,
+			baseURL+AuthzServiceListPermissionsProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("ListPermissions")),
+			connect.WithClientOptions(opts...),
+		),
+		deletePermission: connect.NewClient[v1.DeletePermissionRequest, v1.DeletePermissionResponse](
+			httpClient,
+			baseURL+AuthzServiceDeletePermissionProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("DeletePermission")),
+			connect.WithClientOptions(opts...),
+		),
+		createRole: connect.NewClient[v1.CreateRoleRequest, v1.CreateRoleResponse](
+			httpClient,
+			baseURL+AuthzServiceCreateRoleProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("CreateRole")),
+			connect.WithClientOptions(opts...),
+		),
+		getRole: connect.NewClient[v1.GetRoleRequest, v1.GetRoleResponse](
+			httpClient,
+			baseURL+AuthzServiceGetRoleProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("GetRole")),
+			connect.WithClientOptions(opts...),
+		),
+		listRoles: connect.NewClient[v1.ListRolesRequest, v1.ListRolesResponse](
+			httpClient,
+			baseURL+AuthzServiceListRolesProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("ListRoles")),
+			connect.WithClientOptions(opts...),
+		),
+		updateRole: connect.NewClient[v1.UpdateRoleRequest, v1.UpdateRoleResponse](
+			httpClient,
+			baseURL+AuthzServiceUpdateRoleProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("UpdateRole")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteRole: connect.NewClient[v1.DeleteRoleRequest, v1.DeleteRoleResponse](
+			httpClient,
+			baseURL+AuthzServiceDeleteRoleProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("DeleteRole")),
+			connect.WithClientOptions(opts...),
+		),
+		grantPermission: connect.NewClient[v1.GrantPermissionRequest, v1.GrantPermissionResponse](
+			httpClient,
+			baseURL+AuthzServiceGrantPermissionProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("GrantPermission")),
+			connect.WithClientOptions(opts...),
+		),
+		revokePermission: connect.NewClient[v1.RevokePermissionRequest, v1.RevokePermissionResponse](
+			httpClient,
+			baseURL+AuthzServiceRevokePermissionProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("RevokePermission")),
+			connect.WithClientOptions(opts...),
+		),
+		addRoleParent: connect.NewClient[v1.AddRoleParentRequest, v1.AddRoleParentResponse](
+			httpClient,
+			baseURL+AuthzServiceAddRoleParentProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("AddRoleParent")),
+			connect.WithClientOptions(opts...),
+		),
+		removeRoleParent: connect.NewClient[v1.RemoveRoleParentRequest, v1.RemoveRoleParentResponse](
+			httpClient,
+			baseURL+AuthzServiceRemoveRoleParentProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("RemoveRoleParent")),
+			connect.WithClientOptions(opts...),
+		),
+		createCondition: connect.NewClient[v1.CreateConditionRequest, v1.CreateConditionResponse](
+			httpClient,
+			baseURL+AuthzServiceCreateConditionProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("CreateCondition")),
+			connect.WithClientOptions(opts...),
+		),
+		getCondition: connect.NewClient[v1.GetConditionRequest, v1.GetConditionResponse](
+			httpClient,
+			baseURL+AuthzServiceGetConditionProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("GetCondition")),
+			connect.WithClientOptions(opts...),
+		),
+		listConditions: connect.NewClient[v1.ListConditionsRequest, v1.ListConditionsResponse](
+			httpClient,
+			baseURL+AuthzServiceListConditionsProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("ListConditions")),
+			connect.WithClientOptions(opts...),
+		),
+		updateCondition: connect.NewClient[v1.UpdateConditionRequest, v1.UpdateConditionResponse](
+			httpClient,
+			baseURL+AuthzServiceUpdateConditionProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("UpdateCondition")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteCondition: connect.NewClient[v1.DeleteConditionRequest, v1.DeleteConditionResponse](
+			httpClient,
+			baseURL+AuthzServiceDeleteConditionProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("DeleteCondition")),
+			connect.WithClientOptions(opts...),
+		),
+		assignRole: connect.NewClient[v1.AssignRoleRequest, v1.AssignRoleResponse](
+			httpClient,
+			baseURL+AuthzServiceAssignRoleProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("AssignRole")),
+			connect.WithClientOptions(opts...),
+		),
+		unassignRole: connect.NewClient[v1.UnassignRoleRequest, v1.UnassignRoleResponse](
+			httpClient,
+			baseURL+AuthzServiceUnassignRoleProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("UnassignRole")),
+			connect.WithClientOptions(opts...),
+		),
+		listRoleAssignments: connect.NewClient[v1.ListRoleAssignmentsRequest, v1.ListRoleAssignmentsResponse](
+			httpClient,
+			baseURL+AuthzServiceListRoleAssignmentsProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("ListRoleAssignments")),
+			connect.WithClientOptions(opts...),
+		),
+		check: connect.NewClient[v1.CheckRequest, v1.CheckResponse](
+			httpClient,
+			baseURL+AuthzServiceCheckProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("Check")),
+			connect.WithClientOptions(opts...),
+		),
+		listEffectivePermissions: connect.NewClient[v1.ListEffectivePermissionsRequest, v1.ListEffectivePermissionsResponse](
+			httpClient,
+			baseURL+AuthzServiceListEffectivePermissionsProcedure,
+			connect.WithSchema(authzServiceMethods.ByName("ListEffectivePermissions")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// authzServiceClient implements AuthzServiceClient.
+type authzServiceClient struct {
+	createPermission         *connect.Client[v1.CreatePermissionRequest, v1.CreatePermissionResponse]
+	listPermissions          *connect.Client[v1.ListPermissionsRequest, v1.ListPermissionsResponse]
+	deletePermission         *connect.Client[v1.DeletePermissionRequest, v1.DeletePermissionResponse]
+	createRole               *connect.Client[v1.CreateRoleRequest, v1.CreateRoleResponse]
+	getRole                  *connect.Client[v1.GetRoleRequest, v1.GetRoleResponse]
+	listRoles                *connect.Client[v1.ListRolesRequest, v1.ListRolesResponse]
+	updateRole               *connect.Client[v1.UpdateRoleRequest, v1.UpdateRoleResponse]
+	deleteRole               *connect.Client[v1.DeleteRoleRequest, v1.DeleteRoleResponse]
+	grantPermission          *connect.Client[v1.GrantPermissionRequest, v1.GrantPermissionResponse]
+	revokePermission         *connect.Client[v1.RevokePermissionRequest, v1.RevokePermissionResponse]
+	addRoleParent            *connect.Client[v1.AddRoleParentRequest, v1.AddRoleParentResponse]
+	removeRoleParent         *connect.Client[v1.RemoveRoleParentRequest, v1.RemoveRoleParentResponse]
+	createCondition          *connect.Client[v1.CreateConditionRequest, v1.CreateConditionResponse]
+	getCondition             *connect.Client[v1.GetConditionRequest, v1.GetConditionResponse]
+	listConditions           *connect.Client[v1.ListConditionsRequest, v1.ListConditionsResponse]
+	updateCondition          *connect.Client[v1.UpdateConditionRequest, v1.UpdateConditionResponse]
+	deleteCondition          *connect.Client[v1.DeleteConditionRequest, v1.DeleteConditionResponse]
+	assignRole               *connect.Client[v1.AssignRoleRequest, v1.AssignRoleResponse]
+	unassignRole             *connect.Client[v1.UnassignRoleRequest, v1.UnassignRoleResponse]
+	listRoleAssignments      *connect.Client[v1.ListRoleAssignmentsRequest, v1.ListRoleAssignmentsResponse]
+	check                    *connect.Client[v1.CheckRequest, v1.CheckResponse]
+	listEffectivePermissions *connect.Client[v1.ListEffectivePermissionsRequest, v1.ListEffectivePermissionsResponse]
+}
+
+// CreatePermission calls guardian.v1.AuthzService.CreatePermission.
+func (c *authzServiceClient) CreatePermission(ctx context.Context, req *connect.Request[v1.CreatePermissionRequest]) (*connect.Response[v1.CreatePermissionResponse], error) {
+	return c.createPermission.CallUnary(ctx, req)
+}
+
+// ListPermissions calls guardian.v1.AuthzService.ListPermissions.
+func (c *authzServiceClient) ListPermissions(ctx context.Context, req *connect.Request[v1.ListPermissionsRequest]) (*connect.Response[v1.ListPermissionsResponse], error) {
+	return c.listPermissions.CallUnary(ctx, req)
+}
+
+// DeletePermission calls guardian.v1.AuthzService.DeletePermission.
+func (c *authzServiceClient) DeletePermission(ctx context.Context, req *connect.Request[v1.DeletePermissionRequest]) (*connect.Response[v1.DeletePermissionResponse], error) {
+	return c.deletePermission.CallUnary(ctx, req)
+}
+
+// CreateRole calls guardian.v1.AuthzService.CreateRole.
+func (c *authzServiceClient) CreateRole(ctx context.Context, req *connect.Request[v1.CreateRoleRequest]) (*connect.Response[v1.CreateRoleResponse], error) {
+	return c.createRole.CallUnary(ctx, req)
+}
+
+// GetRole calls guardian.v1.AuthzService.GetRole.
+func (c *authzServiceClient) GetRole(ctx context.Context, req *connect.Request[v1.GetRoleRequest]) (*connect.Response[v1.GetRoleResponse], error) {
+	return c.getRole.CallUnary(ctx, req)
+}
+
+// ListRoles calls guardian.v1.AuthzService.ListRoles.
+func (c *authzServiceClient) ListRoles(ctx context.Context, req *connect.Request[v1.ListRolesRequest]) (*connect.Response[v1.ListRolesResponse], error) {
+	return c.listRoles.CallUnary(ctx, req)
+}
+
+// UpdateRole calls guardian.v1.AuthzService.UpdateRole.
+func (c *authzServiceClient) UpdateRole(ctx context.Context, req *connect.Request[v1.UpdateRoleRequest]) (*connect.Response[v1.UpdateRoleResponse], error) {
+	return c.updateRole.CallUnary(ctx, req)
+}
+
+// DeleteRole calls guardian.v1.AuthzService.DeleteRole.
+func (c *authzServiceClient) DeleteRole(ctx context.Context, req *connect.Request[v1.DeleteRoleRequest]) (*connect.Response[v1.DeleteRoleResponse], error) {
+	return c.deleteRole.CallUnary(ctx, req)
+}
+
+// GrantPermission calls guardian.v1.AuthzService.GrantPermission.
+func (c *authzServiceClient) GrantPermission(ctx context.Context, req *connect.Request[v1.GrantPermissionRequest]) (*connect.Response[v1.GrantPermissionResponse], error) {
+	return c.grantPermission.CallUnary(ctx, req)
+}
+
+// RevokePermission calls guardian.v1.AuthzService.RevokePermission.
+func (c *authzServiceClient) RevokePermission(ctx context.Context, req *connect.Request[v1.RevokePermissionRequest]) (*connect.Response[v1.RevokePermissionResponse], error) {
+	return c.revokePermission.CallUnary(ctx, req)
+}
+
+// AddRoleParent calls guardian.v1.AuthzService.AddRoleParent.
+func (c *authzServiceClient) AddRoleParent(ctx context.Context, req *connect.Request[v1.AddRoleParentRequest]) (*connect.Response[v1.AddRoleParentResponse], error) {
+	return c.addRoleParent.CallUnary(ctx, req)
+}
+
+// RemoveRoleParent calls guardian.v1.AuthzService.RemoveRoleParent.
+func (c *authzServiceClient) RemoveRoleParent(ctx context.Context, req *connect.Request[v1.RemoveRoleParentRequest]) (*connect.Response[v1.RemoveRoleParentResponse], error) {
+	return c.removeRoleParent.CallUnary(ctx, req)
+}
+
+// CreateCondition calls guardian.v1.AuthzService.CreateCondition.
+func (c *authzServiceClient) CreateCondition(ctx context.Context, req *connect.Request[v1.CreateConditionRequest]) (*connect.Response[v1.CreateConditionResponse], error) {
+	return c.createCondition.CallUnary(ctx, req)
+}
+
+// GetCondition calls guardian.v1.AuthzService.GetCondition.
+func (c *authzServiceClient) GetCondition(ctx context.Context, req *connect.Request[v1.GetConditionRequest]) (*connect.Response[v1.GetConditionResponse], error) {
+	return c.getCondition.CallUnary(ctx, req)
+}
+
+// ListConditions calls guardian.v1.AuthzService.ListConditions.
+func (c *authzServiceClient) ListConditions(ctx context.Context, req *connect.Request[v1.ListConditionsRequest]) (*connect.Response[v1.ListConditionsResponse], error) {
+	return c.listConditions.CallUnary(ctx, req)
+}
+
+// UpdateCondition calls guardian.v1.AuthzService.UpdateCondition.
+func (c *authzServiceClient) UpdateCondition(ctx context.Context, req *connect.Request[v1.UpdateConditionRequest]) (*connect.Response[v1.UpdateConditionResponse], error) {
+	return c.updateCondition.CallUnary(ctx, req)
+}
+
+// DeleteCondition calls guardian.v1.AuthzService.DeleteCondition.
+func (c *authzServiceClient) DeleteCondition(ctx context.Context, req *connect.Request[v1.DeleteConditionRequest]) (*connect.Response[v1.DeleteConditionResponse], error) {
+	return c.deleteCondition.CallUnary(ctx, req)
+}
+
+// AssignRole calls guardian.v1.AuthzService.AssignRole.
+func (c *authzServiceClient) AssignRole(ctx context.Context, req *connect.Request[v1.AssignRoleRequest]) (*connect.Response[v1.AssignRoleResponse], error) {
+	return c.assignRole.CallUnary(ctx, req)
+}
+
+// UnassignRole calls guardian.v1.AuthzService.UnassignRole.
+func (c *authzServiceClient) UnassignRole(ctx context.Context, req *connect.Request[v1.UnassignRoleRequest]) (*connect.Response[v1.UnassignRoleResponse], error) {
+	return c.unassignRole.CallUnary(ctx, req)
+}
+
+// ListRoleAssignments calls guardian.v1.AuthzService.ListRoleAssignments.
+func (c *authzServiceClient) ListRoleAssignments(ctx context.Context, req *connect.Request[v1.ListRoleAssignmentsRequest]) (*connect.Response[v1.ListRoleAssignmentsResponse], error) {
+	return c.listRoleAssignments.CallUnary(ctx, req)
+}
+
+// Check calls guardian.v1.AuthzService.Check.
+func (c *authzServiceClient) Check(ctx context.Context, req *connect.Request[v1.CheckRequest]) (*connect.Response[v1.CheckResponse], error) {
+	return c.check.CallUnary(ctx, req)
+}
+
+// ListEffectivePermissions calls guardian.v1.AuthzService.ListEffectivePermissions.
+func (c *authzServiceClient) ListEffectivePermissions(ctx context.Context, req *connect.Request[v1.ListEffectivePermissionsRequest]) (*connect.Response[v1.ListEffectivePermissionsResponse], error) {
+	return c.listEffectivePermissions.CallUnary(ctx, req)
+}
+
+// AuthzServiceHandler is an implementation of the guardian.v1.AuthzService service.
+type AuthzServiceHandler interface {
+	// CreatePermission creates a permission. Names are lowercase dot separated segments like `documents.read`.
+	CreatePermission(context.Context, *connect.Request[v1.CreatePermissionRequest]) (*connect.Response[v1.CreatePermissionResponse], error)
+	// ListPermissions lists all permissions ordered by name.
+	ListPermissions(context.Context, *connect.Request[v1.ListPermissionsRequest]) (*connect.Response[v1.ListPermissionsResponse], error)
+	// DeletePermission deletes a permission and revokes it from all roles.
+	DeletePermission(context.Context, *connect.Request[v1.DeletePermissionRequest]) (*connect.Response[v1.DeletePermissionResponse], error)
+	// CreateRole creates a role without permissions.
+	CreateRole(context.Context, *connect.Request[v1.CreateRoleRequest]) (*connect.Response[v1.CreateRoleResponse], error)
+	// GetRole returns a role by id.
+	GetRole(context.Context, *connect.Request[v1.GetRoleRequest]) (*connect.Response[v1.GetRoleResponse], error)
+	// ListRoles lists all roles ordered by name.
+	ListRoles(context.Context, *connect.Request[v1.ListRolesRequest]) (*connect.Response[v1.ListRolesResponse], error)
+	// UpdateRole updates the fields of a role which are set in the request.
+	UpdateRole(context.Context, *connect.Request[v1.UpdateRoleRequest]) (*connect.Response[v1.UpdateRoleResponse], error)
+	// DeleteRole deletes a role along with its assignments.
+	DeleteRole(context.Context, *connect.Request[v1.DeleteRoleRequest]) (*connect.Response[v1.DeleteRoleResponse], error)
+	// GrantPermission grants a permission to a role.
+	GrantPermission(context.Context, *connect.Request[v1.GrantPermissionRequest]) (*connect.Response[v1.GrantPermissionResponse], error)
+	// RevokePermission revokes a permission from a role.
+	RevokePermission(context.Context, *connect.Request[v1.RevokePermissionRequest]) (*connect.Response[v1.RevokePermissionResponse], error)
+	// AddRoleParent lets a role inherit all permissions of a parent role. Fails with INVALID_ARGUMENT if the parent
+	// inherits from the role, as role inheritance must not contain cycles.
+	AddRoleParent(context.Context, *connect.Request[v1.AddRoleParentRequest]) (*connect.Response[v1.AddRoleParentResponse], error)
+	// RemoveRoleParent removes a parent of a role.
+	RemoveRoleParent(context.Context, *connect.Request[v1.RemoveRoleParentRequest]) (*connect.Response[v1.RemoveRoleParentResponse], error)
+	// CreateCondition creates a condition. Fails with INVALID_ARGUMENT if the expression does not compile or does not
+	// evaluate to a bool.
+	CreateCondition(context.Context, *connect.Request[v1.CreateConditionRequest]) (*connect.Response[v1.CreateConditionResponse], error)
+	// GetCondition returns a condition by name.
+	GetCondition(context.Context, *connect.Request[v1.GetConditionRequest]) (*connect.Response[v1.GetConditionResponse], error)
+	// ListConditions lists all conditions ordered by name.
+	ListConditions(context.Context, *connect.Request[v1.ListConditionsRequest]) (*connect.Response[v1.ListConditionsResponse], error)
+	// UpdateCondition updates the fields of a condition which are set in the request. A new expression applies to all
+	// grants requiring the condition.
+	UpdateCondition(context.Context, *connect.Request[v1.UpdateConditionRequest]) (*connect.Response[v1.UpdateConditionResponse], error)
+	// DeleteCondition deletes a condition. Fails with INVALID_ARGUMENT while grants require it.
+	DeleteCondition(context.Context, *connect.Request[v1.DeleteConditionRequest]) (*connect.Response[v1.DeleteConditionResponse], error)
+	// AssignRole assigns a role to a user or a service account in a scope.
+	AssignRole(context.Context, *connect.Request[v1.AssignRoleRequest]) (*connect.Response[v1.AssignRoleResponse], error)
+	// UnassignRole removes a role assignment.
+	UnassignRole(context.Context, *connect.Request[v1.UnassignRoleRequest]) (*connect.Response[v1.UnassignRoleResponse], error)
+	// ListRoleAssignments lists the role assignments of a user or a service account in all scopes.
+	ListRoleAssignments(context.Context, *connect.Request[v1.ListRoleAssignmentsRequest]) (*connect.Response[v1.ListRoleAssignmentsResponse], error)
+	// Check reports whether a user has a permission in a scope and which role assignment granted it. Callers can check
+	// themselves, checking other users requires the `guardian.authz.check` permission in the scope. Explaining a check
+	// requires the `guardian.authz.check` permission even for the caller.
+	Check(context.Context, *connect.Request[v1.CheckRequest]) (*connect.Response[v1.CheckResponse], error)
+	// ListEffectivePermissions lists all permissions of a user in a scope, including inherited ones. It is authorized
+	// like Check.
+	ListEffectivePermissions(context.Context, *connect.Request[v1.ListEffectivePermissionsRequest]) (*connect.Response[v1.ListEffectivePermissionsResponse], error)
+}
+
+// NewAuthzServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAuthzServiceHandler(svc AuthzServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	authzServiceMethods := v1.File_guardian_v1_authz_proto.Services().ByName("AuthzService").Methods()
+	authzServiceCreatePermissionHandler := connect.NewUnaryHandler(
+		AuthzServiceCreatePermissionProcedure,
+		svc.CreatePermission,
+		connect.WithSchema(authzServiceMethods.ByName("CreatePermission")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authzServiceListPermissionsHandler := connect.NewUnaryHandler(
+		AuthzServiceListPermissionsProcedure,
+		svc.ListPermissions,
+		connect.WithSchema(authzServiceMethods.ByName("ListPermissions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authzServiceDeletePermissionHandler := connect.NewUnaryHandler(
+		AuthzServiceDeletePermissionProcedure,
+		svc.DeletePermission,
+		connect.WithSchema(authzServiceMethods.ByName("DeletePermission")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authzServiceCreateRoleHandler := connect.NewUnaryHandler(
+		AuthzServiceCreateRoleProcedure,
+		svc.CreateRole,
+		connect.WithSchema(authzServiceMethods.ByName("CreateRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authzServiceGetRoleHandler := connect.NewUnaryHandler(
+		AuthzServiceGetRoleProcedure,
+		svc.GetRole,
+		connect.WithSchema(authzServiceMethods.ByName("GetRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authzServiceListRolesHandler := connect.NewUnaryHandler(
+		AuthzServiceListRolesProcedure,
+		svc.ListRoles,
+		connect.WithSchema(authzServiceMethods.ByName("ListRoles")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authzServiceUpdateRoleHandler := connect.NewUnaryHandler(
+		AuthzServiceUpdateRoleProcedure,
+		svc.UpdateRole,
+		connect.WithSchema(authzServiceMethods.ByName("UpdateRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authzServiceDeleteRoleHandler := connect.NewUnaryHandler(
+		AuthzServiceDeleteRoleProcedure,
+		svc.DeleteRole,
+		connect.WithSchema(authzServiceMethods.ByName("DeleteRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authzServiceGrantPermissionHandler := connect.NewUnaryHandler(
+		AuthzServiceGrantPermissionProcedure,
+		svc.GrantPermission,
+		connect.WithSchema(authzServiceMethods.ByName("GrantPermission")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authzServiceRevokePermissionHandler := connect.NewUnaryHandler(
+		AuthzServiceRevokePermissionProcedure,
+		svc.RevokePermission,
+		connect.WithSchema(authzServiceMethods.ByName("RevokePermission")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authzServiceAddRoleParentHandler := connect.NewUnaryHandler(
+		AuthzServiceAddRoleParentProcedure,
+		svc.AddRoleParent,
+		connect.WithSchema(authzServiceMethods.ByName("AddRoleParent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authzServiceRemoveRoleParentHandler := connect.NewUnaryHandler(
+		AuthzServiceRemoveRoleParentProcedure,
+		svc.RemoveRoleParent,
+		connect.WithSchema(authzServiceMethods.ByName("RemoveRoleParent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authzServiceCreateConditionHandler := connect.NewUnaryHandler(
+		AuthzServiceCreateConditionProcedure,
+		svc.CreateCondition,
+		connect.WithSchema(authzServiceMethods.ByName("CreateCondition")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authzServiceGetConditionHandler := connect.NewUnaryHandler(
+		AuthzServiceGetConditionProcedure,
+		svc.GetCondition,
+		connect.WithSchema(authzServiceMethods.ByName("GetCondition")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authzServiceListConditionsHandler := connect.NewUnaryHandler(
+		AuthzServiceListConditionsProcedure,
+		svc.ListConditions,
+		connect.WithSchema(authzServiceMethods.ByName("ListConditions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authzServiceUpdateConditionHandler := connect.NewUnaryHandler(
+		AuthzServiceUpdateConditionProcedure,
+		svc.UpdateCondition,
+		connect.WithSchema(authzServiceMethods.ByName("UpdateCondition")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authzServiceDeleteConditionHandler := connect.NewUnaryHandler(
+		AuthzServiceDeleteConditionProcedure,
+		svc.DeleteCondition,
+		connect.WithSchema(authzServiceMethods.ByName("DeleteCondition")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authzServiceAssignRoleHandler := connect.NewUnaryHandler(
+		AuthzServiceAssignRoleProcedure,
+		svc.AssignRole,
+		connect.WithSchema(authzServiceMethods.ByName("AssignRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authzServiceUnassignRoleHandler := connect.NewUnaryHandler(
+		AuthzServiceUnassignRoleProcedure,
+		svc.UnassignRole,
+		connect.WithSchema(authzServiceMethods.ByName("UnassignRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authzServiceListRoleAssignmentsHandler := connect.NewUnaryHandler(
+		AuthzServiceListRoleAssignmentsProcedure,
+		svc.ListRoleAssignments,
+		connect.WithSchema(authzServiceMethods.ByName("ListRoleAssignments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authzServiceCheckHandler := connect.NewUnaryHandler(
+		AuthzServiceCheckProcedure,
+		svc.Check,
+		connect.WithSchema(authzServiceMethods.ByName("Check")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authzServiceListEffectivePermissionsHandler := connect.NewUnaryHandler(
+		AuthzServiceListEffectivePermissionsProcedure,
+		svc.ListEffectivePermissions,
+		connect.WithSchema(authzServiceMethods.ByName("ListEffectivePermissions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/guardian.v1.AuthzService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AuthzServiceCreatePermissionProcedure:
+			authzServiceCreatePermissionHandler.ServeHTTP(w, r)
+		case AuthzServiceListPermissionsProcedure:
+			authzServiceListPermissionsHandler.ServeHTTP(w, r)
+		case AuthzServiceDeletePermissionProcedure:
+			authzServiceDeletePermissionHandler.ServeHTTP(w, r)
+		case AuthzServiceCreateRoleProcedure:
+			authzServiceCreateRoleHandler.ServeHTTP(w, r)
+		case AuthzServiceGetRoleProcedure:
+			authzServiceGetRoleHandler.ServeHTTP(w, r)
+		case AuthzServiceListRolesProcedure:
+			authzServiceListRolesHandler.ServeHTTP(w, r)
+		case AuthzServiceUpdateRoleProcedure:
+			authzServiceUpdateRoleHandler.ServeHTTP(w, r)
+		case AuthzServiceDeleteRoleProcedure:
+			authzServiceDeleteRoleHandler.ServeHTTP(w, r)
+		case AuthzServiceGrantPermissionProcedure:
+			authzServiceGrantPermissionHandler.ServeHTTP(w, r)
+		case AuthzServiceRevokePermissionProcedure:
+			authzServiceRevokePermissionHandler.ServeHTTP(w, r)
+		case AuthzServiceAddRoleParentProcedure:
+			authzServiceAddRoleParentHandler.ServeHTTP(w, r)
+		case AuthzServiceRemoveRoleParentProcedure:
+			authzServiceRemoveRoleParentHandler.ServeHTTP(w, r)
+		case AuthzServiceCreateConditionProcedure:
+			authzServiceCreateConditionHandler.ServeHTTP(w, r)
+		case AuthzServiceGetConditionProcedure:
+			authzServiceGetConditionHandler.ServeHTTP(w, r)
+		case AuthzServiceListConditionsProcedure:
+			authzServiceListConditionsHandler.ServeHTTP(w, r)
+		case AuthzServiceUpdateConditionProcedure:
+			authzServiceUpdateConditionHandler.ServeHTTP(w, r)
+		case AuthzServiceDeleteConditionProcedure:
+			authzServiceDeleteConditionHandler.ServeHTTP(w, r)
+		case AuthzServiceAssignRoleProcedure:
+			authzServiceAssignRoleHandler.ServeHTTP(w, r)
+		case AuthzServiceUnassignRoleProcedure:
+			authzServiceUnassignRoleHandler.ServeHTTP(w, r)
+		case AuthzServiceListRoleAssignmentsProcedure:
+			authzServiceListRoleAssignmentsHandler.ServeHTTP(w, r)
+		case AuthzServiceCheckProcedure:
+			authzServiceCheckHandler.ServeHTTP(w, r)
+		case AuthzServiceListEffectivePermissionsProcedure:
+			authzServiceListEffectivePermissionsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAuthzServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAuthzServiceHandler struct{}
+
+func (UnimplementedAuthzServiceHandler) CreatePermission(context.Context, *connect.Request[v1.CreatePermissionRequest]) (*connect.Response[v1.CreatePermissionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.CreatePermission is not implemented"))
+}
+
+func (UnimplementedAuthzServiceHandler) ListPermissions(context.Context, *connect.Request[v1.ListPermissionsRequest]) (*connect.Response[v1.ListPermissionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.ListPermissions is not implemented"))
+}
+
+func (UnimplementedAuthzServiceHandler) DeletePermission(context.Context, *connect.Request[v1.DeletePermissionRequest]) (*connect.Response[v1.DeletePermissionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.DeletePermission is not implemented"))
+}
+
+func (UnimplementedAuthzServiceHandler) CreateRole(context.Context, *connect.Request[v1.CreateRoleRequest]) (*connect.Response[v1.CreateRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.CreateRole is not implemented"))
+}
+
+func (UnimplementedAuthzServiceHandler) GetRole(context.Context, *connect.Request[v1.GetRoleRequest]) (*connect.Response[v1.GetRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.GetRole is not implemented"))
+}
+
+func (UnimplementedAuthzServiceHandler) ListRoles(context.Context, *connect.Request[v1.ListRolesRequest]) (*connect.Response[v1.ListRolesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.ListRoles is not implemented"))
+}
+
+func (UnimplementedAuthzServiceHandler) UpdateRole(context.Context, *connect.Request[v1.UpdateRoleRequest]) (*connect.Response[v1.UpdateRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.UpdateRole is not implemented"))
+}
+
+func (UnimplementedAuthzServiceHandler) DeleteRole(context.Context, *connect.Request[v1.DeleteRoleRequest]) (*connect.Response[v1.DeleteRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.DeleteRole is not implemented"))
+}
+
+func (UnimplementedAuthzServiceHandler) GrantPermission(context.Context, *connect.Request[v1.GrantPermissionRequest]) (*connect.Response[v1.GrantPermissionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.GrantPermission is not implemented"))
+}
+
+func (UnimplementedAuthzServiceHandler) RevokePermission(context.Context, *connect.Request[v1.RevokePermissionRequest]) (*connect.Response[v1.RevokePermissionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.RevokePermission is not implemented"))
+}
+
+func (UnimplementedAuthzServiceHandler) AddRoleParent(context.Context, *connect.Request[v1.AddRoleParentRequest]) (*connect.Response[v1.AddRoleParentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.AddRoleParent is not implemented"))
+}
+
+func (UnimplementedAuthzServiceHandler) RemoveRoleParent(context.Context, *connect.Request[v1.RemoveRoleParentRequest]) (*connect.Response[v1.RemoveRoleParentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.RemoveRoleParent is not implemented"))
+}
+
+func (UnimplementedAuthzServiceHandler) CreateCondition(context.Context, *connect.Request[v1.CreateConditionRequest]) (*connect.Response[v1.CreateConditionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.CreateCondition is not implemented"))
+}
+
+func (UnimplementedAuthzServiceHandler) GetCondition(context.Context, *connect.Request[v1.GetConditionRequest]) (*connect.Response[v1.GetConditionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.GetCondition is not implemented"))
+}
+
+func (UnimplementedAuthzServiceHandler) ListConditions(context.Context, *connect.Request[v1.ListConditionsRequest]) (*connect.Response[v1.ListConditionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.ListConditions is not implemented"))
+}
+
+func (UnimplementedAuthzServiceHandler) UpdateCondition(context.Context, *connect.Request[v1.UpdateConditionRequest]) (*connect.Response[v1.UpdateConditionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.UpdateCondition is not implemented"))
+}
+
+func (UnimplementedAuthzServiceHandler) DeleteCondition(context.Context, *connect.Request[v1.DeleteConditionRequest]) (*connect.Response[v1.DeleteConditionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.DeleteCondition is not implemented"))
+}
+
+func (UnimplementedAuthzServiceHandler) AssignRole(context.Context, *connect.Request[v1.AssignRoleRequest]) (*connect.Response[v1.AssignRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.AssignRole is not implemented"))
+}
+
+func (UnimplementedAuthzServiceHandler) UnassignRole(context.Context, *connect.Request[v1.UnassignRoleRequest]) (*connect.Response[v1.UnassignRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.UnassignRole is not implemented"))
+}
+
+func (UnimplementedAuthzServiceHandler) ListRoleAssignments(context.Context, *connect.Request[v1.ListRoleAssignmentsRequest]) (*connect.Response[v1.ListRoleAssignmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.ListRoleAssignments is not implemented"))
+}
+
+func (UnimplementedAuthzServiceHandler) Check(context.Context, *connect.Request[v1.CheckRequest]) (*connect.Response[v1.CheckResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.Check is not implemented"))
+}
+
+func (UnimplementedAuthzServiceHandler) ListEffectivePermissions(context.Context, *connect.Request[v1.ListEffectivePermissionsRequest]) (*connect.Response[v1.ListEffectivePermissionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.AuthzService.ListEffectivePermissions is not implemented"))
+}
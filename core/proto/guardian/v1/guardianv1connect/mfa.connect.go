@@ -0,0 +1,233 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: guardian/v1/mfa.proto
+
+package guardianv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/gophero/guardian/core/proto/guardian/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// MFAServiceName is the fully-qualified name of the MFAService service.
+	MFAServiceName = "guardian.v1.MFAService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// MFAServiceGetMFAStatusProcedure is the fully-qualified name of the MFAService's GetMFAStatus RPC.
+	MFAServiceGetMFAStatusProcedure = "/guardian.v1.MFAService/GetMFAStatus"
+	// MFAServiceEnrollTOTPProcedure is the fully-qualified name of the MFAService's EnrollTOTP RPC.
+	MFAServiceEnrollTOTPProcedure = "/guardian.v1.MFAService/EnrollTOTP"
+	// MFAServiceConfirmTOTPProcedure is the fully-qualified name of the MFAService's ConfirmTOTP RPC.
+	MFAServiceConfirmTOTPProcedure = "/guardian.v1.MFAService/ConfirmTOTP"
+	// MFAServiceDisableTOTPProcedure is the fully-qualified name of the MFAService's DisableTOTP RPC.
+	MFAServiceDisableTOTPProcedure = "/guardian.v1.MFAService/DisableTOTP"
+	// MFAServiceRegenerateRecoveryCodesProcedure is the fully-qualified name of the MFAService's
+	// RegenerateRecoveryCodes RPC.
+	MFAServiceRegenerateRecoveryCodesProcedure = "/guardian.v1.MFAService/RegenerateRecoveryCodes"
+)
+
+// MFAServiceClient is a client for the guardian.v1.MFAService service.
+type MFAServiceClient interface {
+	// GetMFAStatus returns the second factors enrolled by the caller.
+	GetMFAStatus(context.Context, *connect.Request[v1.GetMFAStatusRequest]) (*connect.Response[v1.GetMFAStatusResponse], error)
+	// EnrollTOTP starts enrollment of a TOTP factor, replacing a pending enrollment. The factor is not used until it is
+	// confirmed with ConfirmTOTP.
+	EnrollTOTP(context.Context, *connect.Request[v1.EnrollTOTPRequest]) (*connect.Response[v1.EnrollTOTPResponse], error)
+	// ConfirmTOTP confirms the pending TOTP factor with a code of it and returns new recovery codes.
+	ConfirmTOTP(context.Context, *connect.Request[v1.ConfirmTOTPRequest]) (*connect.Response[v1.ConfirmTOTPResponse], error)
+	// DisableTOTP removes the TOTP factor and all recovery codes.
+	DisableTOTP(context.Context, *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error)
+	// RegenerateRecoveryCodes replaces all recovery codes with new ones.
+	RegenerateRecoveryCodes(context.Context, *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error)
+}
+
+// NewMFAServiceClient constructs a client for the guardian.v1.MFAService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewMFAServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) MFAServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	mFAServiceMethods := v1.File_guardian_v1_mfa_proto.Services().ByName("MFAService").Methods()
+	return &mFAServiceClient{
+		getMFAStatus: connect.NewClient[v1.GetMFAStatusRequest, v1.GetMFAStatusResponse](
+			httpClient,
+			baseURL+MFAServiceGetMFAStatusProcedure,
+			connect.WithSchema(mFAServiceMethods.ByName("GetMFAStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		enrollTOTP: connect.NewClient[v1.EnrollTOTPRequest, v1.EnrollTOTPResponse](
+			httpClient,
+			baseURL+MFAServiceEnrollTOTPProcedure,
+			connect.WithSchema(mFAServiceMethods.ByName("EnrollTOTP")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmTOTP: connect.NewClient[v1.ConfirmTOTPRequest, v1.ConfirmTOTPResponse](
+			httpClient,
+			baseURL+MFAServiceConfirmTOTPProcedure,
+			connect.WithSchema(mFAServiceMethods.ByName("ConfirmTOTP")),
+			connect.WithClientOptions(opts...),
+		),
+		disableTOTP: connect.NewClient[v1.DisableTOTPRequest, v1.DisableTOTPResponse](
+			httpClient,
+			baseURL+MFAServiceDisableTOTPProcedure,
+			connect.WithSchema(mFAServiceMethods.ByName("DisableTOTP")),
+			connect.WithClientOptions(opts...),
+		),
+		regenerateRecoveryCodes: connect.NewClient[v1.RegenerateRecoveryCodesRequest, v1.RegenerateRecoveryCodesResponse](
+			httpClient,
+			baseURL+MFAServiceRegenerateRecoveryCodesProcedure,
+			connect.WithSchema(mFAServiceMethods.ByName("RegenerateRecoveryCodes")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// mFAServiceClient implements MFAServiceClient.
+type mFAServiceClient struct {
+	getMFAStatus            *connect.Client[v1.GetMFAStatusRequest, v1.GetMFAStatusResponse]
+	enrollTOTP              *connect.Client[v1.EnrollTOTPRequest, v1.EnrollTOTPResponse]
+	confirmTOTP             *connect.Client[v1.ConfirmTOTPRequest, v1.ConfirmTOTPResponse]
+	disableTOTP             *connect.Client[v1.DisableTOTPRequest, v1.DisableTOTPResponse]
+	regenerateRecoveryCodes *connect.Client[v1.RegenerateRecoveryCodesRequest, v1.RegenerateRecoveryCodesResponse]
+}
+
+// GetMFAStatus calls guardian.v1.MFAService.GetMFAStatus.
+func (c *mFAServiceClient) GetMFAStatus(ctx context.Context, req *connect.Request[v1.GetMFAStatusRequest]) (*connect.Response[v1.GetMFAStatusResponse], error) {
+	return c.getMFAStatus.CallUnary(ctx, req)
+}
+
+// EnrollTOTP calls guardian.v1.MFAService.EnrollTOTP.
+func (c *mFAServiceClient) EnrollTOTP(ctx context.Context, req *connect.Request[v1.EnrollTOTPRequest]) (*connect.Response[v1.EnrollTOTPResponse], error) {
+	return c.enrollTOTP.CallUnary(ctx, req)
+}
+
+// ConfirmTOTP calls guardian.v1.MFAService.ConfirmTOTP.
+func (c *mFAServiceClient) ConfirmTOTP(ctx context.Context, req *connect.Request[v1.ConfirmTOTPRequest]) (*connect.Response[v1.ConfirmTOTPResponse], error) {
+	return c.confirmTOTP.CallUnary(ctx, req)
+}
+
+// DisableTOTP calls guardian.v1.MFAService.DisableTOTP.
+func (c *mFAServiceClient) DisableTOTP(ctx context.Context, req *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error) {
+	return c.disableTOTP.CallUnary(ctx, req)
+}
+
+// RegenerateRecoveryCodes calls guardian.v1.MFAService.RegenerateRecoveryCodes.
+func (c *mFAServiceClient) RegenerateRecoveryCodes(ctx context.Context, req *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error) {
+	return c.regenerateRecoveryCodes.CallUnary(ctx, req)
+}
+
+// MFAServiceHandler is an implementation of the guardian.v1.MFAService service.
+type MFAServiceHandler interface {
+	// GetMFAStatus returns the second factors enrolled by the caller.
+	GetMFAStatus(context.Context, *connect.Request[v1.GetMFAStatusRequest]) (*connect.Response[v1.GetMFAStatusResponse], error)
+	// EnrollTOTP starts enrollment of a TOTP factor, replacing a pending enrollment. The factor is not used until it is
+	// confirmed with ConfirmTOTP.
+	EnrollTOTP(context.Context, *connect.Request[v1.EnrollTOTPRequest]) (*connect.Response[v1.EnrollTOTPResponse], error)
+	// ConfirmTOTP confirms the pending TOTP factor with a code of it and returns new recovery codes.
+	ConfirmTOTP(context.Context, *connect.Request[v1.ConfirmTOTPRequest]) (*connect.Response[v1.ConfirmTOTPResponse], error)
+	// DisableTOTP removes the TOTP factor and all recovery codes.
+	DisableTOTP(context.Context, *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error)
+	// RegenerateRecoveryCodes replaces all recovery codes with new ones.
+	RegenerateRecoveryCodes(context.Context, *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error)
+}
+
+// NewMFAServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewMFAServiceHandler(svc MFAServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	mFAServiceMethods := v1.File_guardian_v1_mfa_proto.Services().ByName("MFAService").Methods()
+	mFAServiceGetMFAStatusHandler := connect.NewUnaryHandler(
+		MFAServiceGetMFAStatusProcedure,
+		svc.GetMFAStatus,
+		connect.WithSchema(mFAServiceMethods.ByName("GetMFAStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mFAServiceEnrollTOTPHandler := connect.NewUnaryHandler(
+		MFAServiceEnrollTOTPProcedure,
+		svc.EnrollTOTP,
+		connect.WithSchema(mFAServiceMethods.ByName("EnrollTOTP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mFAServiceConfirmTOTPHandler := connect.NewUnaryHandler(
+		MFAServiceConfirmTOTPProcedure,
+		svc.ConfirmTOTP,
+		connect.WithSchema(mFAServiceMethods.ByName("ConfirmTOTP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mFAServiceDisableTOTPHandler := connect.NewUnaryHandler(
+		MFAServiceDisableTOTPProcedure,
+		svc.DisableTOTP,
+		connect.WithSchema(mFAServiceMethods.ByName("DisableTOTP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mFAServiceRegenerateRecoveryCodesHandler := connect.NewUnaryHandler(
+		MFAServiceRegenerateRecoveryCodesProcedure,
+		svc.RegenerateRecoveryCodes,
+		connect.WithSchema(mFAServiceMethods.ByName("RegenerateRecoveryCodes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/guardian.v1.MFAService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case MFAServiceGetMFAStatusProcedure:
+			mFAServiceGetMFAStatusHandler.ServeHTTP(w, r)
+		case MFAServiceEnrollTOTPProcedure:
+			mFAServiceEnrollTOTPHandler.ServeHTTP(w, r)
+		case MFAServiceConfirmTOTPProcedure:
+			mFAServiceConfirmTOTPHandler.ServeHTTP(w, r)
+		case MFAServiceDisableTOTPProcedure:
+			mFAServiceDisableTOTPHandler.ServeHTTP(w, r)
+		case MFAServiceRegenerateRecoveryCodesProcedure:
+			mFAServiceRegenerateRecoveryCodesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedMFAServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedMFAServiceHandler struct{}
+
+func (UnimplementedMFAServiceHandler) GetMFAStatus(context.Context, *connect.Request[v1.GetMFAStatusRequest]) (*connect.Response[v1.GetMFAStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.MFAService.GetMFAStatus is not implemented"))
+}
+
+func (UnimplementedMFAServiceHandler) EnrollTOTP(context.Context, *connect.Request[v1.EnrollTOTPRequest]) (*connect.Response[v1.EnrollTOTPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.MFAService.EnrollTOTP is not implemented"))
+}
+
+func (UnimplementedMFAServiceHandler) ConfirmTOTP(context.Context, *connect.Request[v1.ConfirmTOTPRequest]) (*connect.Response[v1.ConfirmTOTPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.MFAService.ConfirmTOTP is not implemented"))
+}
+
+func (UnimplementedMFAServiceHandler) DisableTOTP(context.Context, *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.MFAService.DisableTOTP is not implemented"))
+}
+
+func (UnimplementedMFAServiceHandler) RegenerateRecoveryCodes(context.Context, *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.MFAService.RegenerateRecoveryCodes is not implemented"))
+}
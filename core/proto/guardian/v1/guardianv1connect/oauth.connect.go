@@ -0,0 +1,447 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: guardian/v1/oauth.proto
+
+package guardianv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/gophero/guardian/core/proto/guardian/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// OAuthServiceName is the fully-qualified name of the OAuthService service.
+	OAuthServiceName = "guardian.v1.OAuthService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// OAuthServiceCreateOAuthClientProcedure is the fully-qualified name of the OAuthService's
+	// CreateOAuthClient RPC.
+	OAuthServiceCreateOAuthClientProcedure = "/guardian.v1.OAuthService/CreateOAuthClient"
+	// OAuthServiceGetOAuthClientProcedure is the fully-qualified name of the OAuthService's
+	// GetOAuthClient RPC.
+	OAuthServiceGetOAuthClientProcedure = "/guardian.v1.OAuthService/GetOAuthClient"
+	// OAuthServiceListOAuthClientsProcedure is the fully-qualified name of the OAuthService's
+	// ListOAuthClients RPC.
+	OAuthServiceListOAuthClientsProcedure = "/guardian.v1.OAuthService/ListOAuthClients"
+	// OAuthServiceUpdateOAuthClientProcedure is the fully-qualified name of the OAuthService's
+	// UpdateOAuthClient RPC.
+	OAuthServiceUpdateOAuthClientProcedure = "/guardian.v1.OAuthService/UpdateOAuthClient"
+	// OAuthServiceDeleteOAuthClientProcedure is the fully-qualified name of the OAuthService's
+	// DeleteOAuthClient RPC.
+	OAuthServiceDeleteOAuthClientProcedure = "/guardian.v1.OAuthService/DeleteOAuthClient"
+	// OAuthServiceGetAuthorizationProcedure is the fully-qualified name of the OAuthService's
+	// GetAuthorization RPC.
+	OAuthServiceGetAuthorizationProcedure = "/guardian.v1.OAuthService/GetAuthorization"
+	// OAuthServiceApproveAuthorizationProcedure is the fully-qualified name of the OAuthService's
+	// ApproveAuthorization RPC.
+	OAuthServiceApproveAuthorizationProcedure = "/guardian.v1.OAuthService/ApproveAuthorization"
+	// OAuthServiceDenyAuthorizationProcedure is the fully-qualified name of the OAuthService's
+	// DenyAuthorization RPC.
+	OAuthServiceDenyAuthorizationProcedure = "/guardian.v1.OAuthService/DenyAuthorization"
+	// OAuthServiceGetDeviceAuthorizationProcedure is the fully-qualified name of the OAuthService's
+	// GetDeviceAuthorization RPC.
+	OAuthServiceGetDeviceAuthorizationProcedure = "/guardian.v1.OAuthService/GetDeviceAuthorization"
+	// OAuthServiceApproveDeviceAuthorizationProcedure is the fully-qualified name of the OAuthService's
+	// ApproveDeviceAuthorization RPC.
+	OAuthServiceApproveDeviceAuthorizationProcedure = "/guardian.v1.OAuthService/ApproveDeviceAuthorization"
+	// OAuthServiceDenyDeviceAuthorizationProcedure is the fully-qualified name of the OAuthService's
+	// DenyDeviceAuthorization RPC.
+	OAuthServiceDenyDeviceAuthorizationProcedure = "/guardian.v1.OAuthService/DenyDeviceAuthorization"
+)
+
+// OAuthServiceClient is a client for the guardian.v1.OAuthService service.
+type OAuthServiceClient interface {
+	// CreateOAuthClient registers a client and returns its secret, which is empty for public clients and cannot be
+	// retrieved later.
+	CreateOAuthClient(context.Context, *connect.Request[v1.CreateOAuthClientRequest]) (*connect.Response[v1.CreateOAuthClientResponse], error)
+	// GetOAuthClient returns a client by id.
+	GetOAuthClient(context.Context, *connect.Request[v1.GetOAuthClientRequest]) (*connect.Response[v1.GetOAuthClientResponse], error)
+	// ListOAuthClients lists all clients ordered by id.
+	ListOAuthClients(context.Context, *connect.Request[v1.ListOAuthClientsRequest]) (*connect.Response[v1.ListOAuthClientsResponse], error)
+	// UpdateOAuthClient replaces the settings of a client. The type of a client cannot be changed.
+	UpdateOAuthClient(context.Context, *connect.Request[v1.UpdateOAuthClientRequest]) (*connect.Response[v1.UpdateOAuthClientResponse], error)
+	// DeleteOAuthClient deletes a client along with its pending authorizations. Tokens issued to it stay valid until
+	// they expire.
+	DeleteOAuthClient(context.Context, *connect.Request[v1.DeleteOAuthClientRequest]) (*connect.Response[v1.DeleteOAuthClientResponse], error)
+	// GetAuthorization returns a pending authorization request from the `request_id` query parameter of the login page,
+	// so the login page can ask the caller for consent. Fails with NOT_FOUND if the request does not exist, expired or
+	// was answered already.
+	GetAuthorization(context.Context, *connect.Request[v1.GetAuthorizationRequest]) (*connect.Response[v1.GetAuthorizationResponse], error)
+	// ApproveAuthorization issues an authorization code to the client on behalf of the caller and returns the URL to
+	// redirect the user agent to. It is answered like GetAuthorization. Fails with FAILED_PRECONDITION if the caller has
+	// to sign in again because of the prompt or max_age of the request.
+	ApproveAuthorization(context.Context, *connect.Request[v1.ApproveAuthorizationRequest]) (*connect.Response[v1.ApproveAuthorizationResponse], error)
+	// DenyAuthorization refuses an authorization request and returns the URL to redirect the user agent to. It is
+	// answered like GetAuthorization. Requests with prompt `none` are denied with an OpenID Connect error if they cannot
+	// be approved without interacting with the user.
+	DenyAuthorization(context.Context, *connect.Request[v1.DenyAuthorizationRequest]) (*connect.Response[v1.DenyAuthorizationResponse], error)
+	// GetDeviceAuthorization returns a pending device authorization from the user code the caller entered on the
+	// verification page, or from its `user_code` query parameter, so the page can ask the caller for consent. Case and
+	// dashes of the user code are ignored. Fails with NOT_FOUND if the authorization does not exist, expired or was
+	// answered already.
+	GetDeviceAuthorization(context.Context, *connect.Request[v1.GetDeviceAuthorizationRequest]) (*connect.Response[v1.GetDeviceAuthorizationResponse], error)
+	// ApproveDeviceAuthorization lets the device obtain tokens on behalf of the caller, bound to the session of the
+	// caller. It is answered like GetDeviceAuthorization.
+	ApproveDeviceAuthorization(context.Context, *connect.Request[v1.ApproveDeviceAuthorizationRequest]) (*connect.Response[v1.ApproveDeviceAuthorizationResponse], error)
+	// DenyDeviceAuthorization refuses a device authorization, the device is sent an `access_denied` error. It is
+	// answered like GetDeviceAuthorization.
+	DenyDeviceAuthorization(context.Context, *connect.Request[v1.DenyDeviceAuthorizationRequest]) (*connect.Response[v1.DenyDeviceAuthorizationResponse], error)
+}
+
+// NewOAuthServiceClient constructs a client for the guardian.v1.OAuthService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewOAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) OAuthServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	oAuthServiceMethods := v1.File_guardian_v1_oauth_proto.Services().ByName("OAuthService").Methods()
+	return &oAuthServiceClient{
+		createOAuthClient: connect.NewClient[v1.CreateOAuthClientRequest, v1.CreateOAuthClientResponse](
+			httpClient,
+			baseURL+OAuthServiceCreateOAuthClientProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("CreateOAuthClient")),
+			connect.WithClientOptions(opts...),
+		),
+		getOAuthClient: connect.NewClient[v1.GetOAuthClientRequest, v1.GetOAuthClientResponse](
+			httpClient,
+			baseURL+OAuthServiceGetOAuthClientProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("GetOAuthClient")),
+			connect.WithClientOptions(opts...),
+		),
+		listOAuthClients: connect.NewClient[v1.ListOAuthClientsRequest, v1.ListOAuthClientsResponse](
+			httpClient,
+			baseURL+OAuthServiceListOAuthClientsProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("ListOAuthClients")),
+			connect.WithClientOptions(opts...),
+		),
+		updateOAuthClient: connect.NewClient[v1.UpdateOAuthClientRequest, v1.UpdateOAuthClientResponse](
+			httpClient,
+			baseURL+OAuthServiceUpdateOAuthClientProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("UpdateOAuthClient")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteOAuthClient: connect.NewClient[v1.DeleteOAuthClientRequest, v1.DeleteOAuthClientResponse](
+			httpClient,
+			baseURL+OAuthServiceDeleteOAuthClientProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("DeleteOAuthClient")),
+			connect.WithClientOptions(opts...),
+		),
+		getAuthorization: connect.NewClient[v1.GetAuthorizationRequest, v1.GetAuthorizationResponse](
+			httpClient,
+			baseURL+OAuthServiceGetAuthorizationProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("GetAuthorization")),
+			connect.WithClientOptions(opts...),
+		),
+		approveAuthorization: connect.NewClient[v1.ApproveAuthorizationRequest, v1.ApproveAuthorizationResponse](
+			httpClient,
+			baseURL+OAuthServiceApproveAuthorizationProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("ApproveAuthorization")),
+			connect.WithClientOptions(opts...),
+		),
+		denyAuthorization: connect.NewClient[v1.DenyAuthorizationRequest, v1.DenyAuthorizationResponse](
+			httpClient,
+			baseURL+OAuthServiceDenyAuthorizationProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("DenyAuthorization")),
+			connect.WithClientOptions(opts...),
+		),
+		getDeviceAuthorization: connect.NewClient[v1.GetDeviceAuthorizationRequest, v1.GetDeviceAuthorizationResponse](
+			httpClient,
+			baseURL+OAuthServiceGetDeviceAuthorizationProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("GetDeviceAuthorization")),
+			connect.WithClientOptions(opts...),
+		),
+		approveDeviceAuthorization: connect.NewClient[v1.ApproveDeviceAuthorizationRequest, v1.ApproveDeviceAuthorizationResponse](
+			httpClient,
+			baseURL+OAuthServiceApproveDeviceAuthorizationProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("ApproveDeviceAuthorization")),
+			connect.WithClientOptions(opts...),
+		),
+		denyDeviceAuthorization: connect.NewClient[v1.DenyDeviceAuthorizationRequest, v1.DenyDeviceAuthorizationResponse](
+			httpClient,
+			baseURL+OAuthServiceDenyDeviceAuthorizationProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("DenyDeviceAuthorization")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// oAuthServiceClient implements OAuthServiceClient.
+type oAuthServiceClient struct {
+	createOAuthClient          *connect.Client[v1.CreateOAuthClientRequest, v1.CreateOAuthClientResponse]
+	getOAuthClient             *connect.Client[v1.GetOAuthClientRequest, v1.GetOAuthClientResponse]
+	listOAuthClients           *connect.Client[v1.ListOAuthClientsRequest, v1.ListOAuthClientsResponse]
+	updateOAuthClient          *connect.Client[v1.UpdateOAuthClientRequest, v1.UpdateOAuthClientResponse]
+	deleteOAuthClient          *connect.Client[v1.DeleteOAuthClientRequest, v1.DeleteOAuthClientResponse]
+	getAuthorization           *connect.Client[v1.GetAuthorizationRequest, v1.GetAuthorizationResponse]
+	approveAuthorization       *connect.Client[v1.ApproveAuthorizationRequest, v1.ApproveAuthorizationResponse]
+	denyAuthorization          *connect.Client[v1.DenyAuthorizationRequest, v1.DenyAuthorizationResponse]
+	getDeviceAuthorization     *connect.Client[v1.GetDeviceAuthorizationRequest, v1.GetDeviceAuthorizationResponse]
+	approveDeviceAuthorization *connect.Client[v1.ApproveDeviceAuthorizationRequest, v1.ApproveDeviceAuthorizationResponse]
+	denyDeviceAuthorization    *connect.Client[v1.DenyDeviceAuthorizationRequest, v1.DenyDeviceAuthorizationResponse]
+}
+
+// CreateOAuthClient calls guardian.v1.OAuthService.CreateOAuthClient.
+func (c *oAuthServiceClient) CreateOAuthClient(ctx context.Context, req *connect.Request[v1.CreateOAuthClientRequest]) (*connect.Response[v1.CreateOAuthClientResponse], error) {
+	return c.createOAuthClient.CallUnary(ctx, req)
+}
+
+// GetOAuthClient calls guardian.v1.OAuthService.GetOAuthClient.
+func (c *oAuthServiceClient) GetOAuthClient(ctx context.Context, req *connect.Request[v1.GetOAuthClientRequest]) (*connect.Response[v1.GetOAuthClientResponse], error) {
+	return c.getOAuthClient.CallUnary(ctx, req)
+}
+
+// ListOAuthClients calls guardian.v1.OAuthService.ListOAuthClients.
+func (c *oAuthServiceClient) ListOAuthClients(ctx context.Context, req *connect.Request[v1.ListOAuthClientsRequest]) (*connect.Response[v1.ListOAuthClientsResponse], error) {
+	return c.listOAuthClients.CallUnary(ctx, req)
+}
+
+// UpdateOAuthClient calls guardian.v1.OAuthService.UpdateOAuthClient.
+func (c *oAuthServiceClient) UpdateOAuthClient(ctx context.Context, req *connect.Request[v1.UpdateOAuthClientRequest]) (*connect.Response[v1.UpdateOAuthClientResponse], error) {
+	return c.updateOAuthClient.CallUnary(ctx, req)
+}
+
+// DeleteOAuthClient calls guardian.v1.OAuthService.DeleteOAuthClient.
+func (c *oAuthServiceClient) DeleteOAuthClient(ctx context.Context, req *connect.Request[v1.DeleteOAuthClientRequest]) (*connect.Response[v1.DeleteOAuthClientResponse], error) {
+	return c.deleteOAuthClient.CallUnary(ctx, req)
+}
+
+// GetAuthorization calls guardian.v1.OAuthService.GetAuthorization.
+func (c *oAuthServiceClient) GetAuthorization(ctx context.Context, req *connect.Request[v1.GetAuthorizationRequest]) (*connect.Response[v1.GetAuthorizationResponse], error) {
+	return c.getAuthorization.CallUnary(ctx, req)
+}
+
+// ApproveAuthorization calls guardian.v1.OAuthService.ApproveAuthorization.
+func (c *oAuthServiceClient) ApproveAuthorization(ctx context.Context, req *connect.Request[v1.ApproveAuthorizationRequest]) (*connect.Response[v1.ApproveAuthorizationResponse], error) {
+	return c.approveAuthorization.CallUnary(ctx, req)
+}
+
+// DenyAuthorization calls guardian.v1.OAuthService.DenyAuthorization.
+func (c *oAuthServiceClient) DenyAuthorization(ctx context.Context, req *connect.Request[v1.DenyAuthorizationRequest]) (*connect.Response[v1.DenyAuthorizationResponse], error) {
+	return c.denyAuthorization.CallUnary(ctx, req)
+}
+
+// GetDeviceAuthorization calls guardian.v1.OAuthService.GetDeviceAuthorization.
+func (c *oAuthServiceClient) GetDeviceAuthorization(ctx context.Context, req *connect.Request[v1.GetDeviceAuthorizationRequest]) (*connect.Response[v1.GetDeviceAuthorizationResponse], error) {
+	return c.getDeviceAuthorization.CallUnary(ctx, req)
+}
+
+// ApproveDeviceAuthorization calls guardian.v1.OAuthService.ApproveDeviceAuthorization.
+func (c *oAuthServiceClient) ApproveDeviceAuthorization(ctx context.Context, req *connect.Request[v1.ApproveDeviceAuthorizationRequest]) (*connect.Response[v1.ApproveDeviceAuthorizationResponse], error) {
+	return c.approveDeviceAuthorization.CallUnary(ctx, req)
+}
+
+// DenyDeviceAuthorization calls guardian.v1.OAuthService.DenyDeviceAuthorization.
+func (c *oAuthServiceClient) DenyDeviceAuthorization(ctx context.Context, req *connect.Request[v1.DenyDeviceAuthorizationRequest]) (*connect.Response[v1.DenyDeviceAuthorizationResponse], error) {
+	return c.denyDeviceAuthorization.CallUnary(ctx, req)
+}
+
+// OAuthServiceHandler is an implementation of the guardian.v1.OAuthService service.
+type OAuthServiceHandler interface {
+	// CreateOAuthClient registers a client and returns its secret, which is empty for public clients and cannot be
+	// retrieved later.
+	CreateOAuthClient(context.Context, *connect.Request[v1.CreateOAuthClientRequest]) (*connect.Response[v1.CreateOAuthClientResponse], error)
+	// GetOAuthClient returns a client by id.
+	GetOAuthClient(context.Context, *connect.Request[v1.GetOAuthClientRequest]) (*connect.Response[v1.GetOAuthClientResponse], error)
+	// ListOAuthClients lists all clients ordered by id.
+	ListOAuthClients(context.Context, *connect.Request[v1.ListOAuthClientsRequest]) (*connect.Response[v1.ListOAuthClientsResponse], error)
+	// UpdateOAuthClient replaces the settings of a client. The type of a client cannot be changed.
+	UpdateOAuthClient(context.Context, *connect.Request[v1.UpdateOAuthClientRequest]) (*connect.Response[v1.UpdateOAuthClientResponse], error)
+	// DeleteOAuthClient deletes a client along with its pending authorizations. Tokens issued to it stay valid until
+	// they expire.
+	DeleteOAuthClient(context.Context, *connect.Request[v1.DeleteOAuthClientRequest]) (*connect.Response[v1.DeleteOAuthClientResponse], error)
+	// GetAuthorization returns a pending authorization request from the `request_id` query parameter of the login page,
+	// so the login page can ask the caller for consent. Fails with NOT_FOUND if the request does not exist, expired or
+	// was answered already.
+	GetAuthorization(context.Context, *connect.Request[v1.GetAuthorizationRequest]) (*connect.Response[v1.GetAuthorizationResponse], error)
+	// ApproveAuthorization issues an authorization code to the client on behalf of the caller and returns the URL to
+	// redirect the user agent to. It is answered like GetAuthorization. Fails with FAILED_PRECONDITION if the caller has
+	// to sign in again because of the prompt or max_age of the request.
+	ApproveAuthorization(context.Context, *connect.Request[v1.ApproveAuthorizationRequest]) (*connect.Response[v1.ApproveAuthorizationResponse], error)
+	// DenyAuthorization refuses an authorization request and returns the URL to redirect the user agent to. It is
+	// answered like GetAuthorization. Requests with prompt `none` are denied with an OpenID Connect error if they cannot
+	// be approved without interacting with the user.
+	DenyAuthorization(context.Context, *connect.Request[v1.DenyAuthorizationRequest]) (*connect.Response[v1.DenyAuthorizationResponse], error)
+	// GetDeviceAuthorization returns a pending device authorization from the user code the caller entered on the
+	// verification page, or from its `user_code` query parameter, so the page can ask the caller for consent. Case and
+	// dashes of the user code are ignored. Fails with NOT_FOUND if the authorization does not exist, expired or was
+	// answered already.
+	GetDeviceAuthorization(context.Context, *connect.Request[v1.GetDeviceAuthorizationRequest]) (*connect.Response[v1.GetDeviceAuthorizationResponse], error)
+	// ApproveDeviceAuthorization lets the device obtain tokens on behalf of the caller, bound to the session of the
+	// caller. It is answered like GetDeviceAuthorization.
+	ApproveDeviceAuthorization(context.Context, *connect.Request[v1.ApproveDeviceAuthorizationRequest]) (*connect.Response[v1.ApproveDeviceAuthorizationResponse], error)
+	// DenyDeviceAuthorization refuses a device authorization, the device is sent an `access_denied` error. It is
+	// answered like GetDeviceAuthorization.
+	DenyDeviceAuthorization(context.Context, *connect.Request[v1.DenyDeviceAuthorizationRequest]) (*connect.Response[v1.DenyDeviceAuthorizationResponse], error)
+}
+
+// NewOAuthServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewOAuthServiceHandler(svc OAuthServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	oAuthServiceMethods := v1.File_guardian_v1_oauth_proto.Services().ByName("OAuthService").Methods()
+	oAuthServiceCreateOAuthClientHandler := connect.NewUnaryHandler(
+		OAuthServiceCreateOAuthClientProcedure,
+		svc.CreateOAuthClient,
+		connect.WithSchema(oAuthServiceMethods.ByName("CreateOAuthClient")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthServiceGetOAuthClientHandler := connect.NewUnaryHandler(
+		OAuthServiceGetOAuthClientProcedure,
+		svc.GetOAuthClient,
+		connect.WithSchema(oAuthServiceMethods.ByName("GetOAuthClient")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthServiceListOAuthClientsHandler := connect.NewUnaryHandler(
+		OAuthServiceListOAuthClientsProcedure,
+		svc.ListOAuthClients,
+		connect.WithSchema(oAuthServiceMethods.ByName("ListOAuthClients")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthServiceUpdateOAuthClientHandler := connect.NewUnaryHandler(
+		OAuthServiceUpdateOAuthClientProcedure,
+		svc.UpdateOAuthClient,
+		connect.WithSchema(oAuthServiceMethods.ByName("UpdateOAuthClient")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthServiceDeleteOAuthClientHandler := connect.NewUnaryHandler(
+		OAuthServiceDeleteOAuthClientProcedure,
+		svc.DeleteOAuthClient,
+		connect.WithSchema(oAuthServiceMethods.ByName("DeleteOAuthClient")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthServiceGetAuthorizationHandler := connect.NewUnaryHandler(
+		OAuthServiceGetAuthorizationProcedure,
+		svc.GetAuthorization,
+		connect.WithSchema(oAuthServiceMethods.ByName("GetAuthorization")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthServiceApproveAuthorizationHandler := connect.NewUnaryHandler(
+		OAuthServiceApproveAuthorizationProcedure,
+		svc.ApproveAuthorization,
+		connect.WithSchema(oAuthServiceMethods.ByName("ApproveAuthorization")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthServiceDenyAuthorizationHandler := connect.NewUnaryHandler(
+		OAuthServiceDenyAuthorizationProcedure,
+		svc.DenyAuthorization,
+		connect.WithSchema(oAuthServiceMethods.ByName("DenyAuthorization")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthServiceGetDeviceAuthorizationHandler := connect.NewUnaryHandler(
+		OAuthServiceGetDeviceAuthorizationProcedure,
+		svc.GetDeviceAuthorization,
+		connect.WithSchema(oAuthServiceMethods.ByName("GetDeviceAuthorization")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthServiceApproveDeviceAuthorizationHandler := connect.NewUnaryHandler(
+		OAuthServiceApproveDeviceAuthorizationProcedure,
+		svc.ApproveDeviceAuthorization,
+		connect.WithSchema(oAuthServiceMethods.ByName("ApproveDeviceAuthorization")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthServiceDenyDeviceAuthorizationHandler := connect.NewUnaryHandler(
+		OAuthServiceDenyDeviceAuthorizationProcedure,
+		svc.DenyDeviceAuthorization,
+		connect.WithSchema(oAuthServiceMethods.ByName("DenyDeviceAuthorization")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/guardian.v1.OAuthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case OAuthServiceCreateOAuthClientProcedure:
+			oAuthServiceCreateOAuthClientHandler.ServeHTTP(w, r)
+		case OAuthServiceGetOAuthClientProcedure:
+			oAuthServiceGetOAuthClientHandler.ServeHTTP(w, r)
+		case OAuthServiceListOAuthClientsProcedure:
+			oAuthServiceListOAuthClientsHandler.ServeHTTP(w, r)
+		case OAuthServiceUpdateOAuthClientProcedure:
+			oAuthServiceUpdateOAuthClientHandler.ServeHTTP(w, r)
+		case OAuthServiceDeleteOAuthClientProcedure:
+			oAuthServiceDeleteOAuthClientHandler.ServeHTTP(w, r)
+		case OAuthServiceGetAuthorizationProcedure:
+			oAuthServiceGetAuthorizationHandler.ServeHTTP(w, r)
+		case OAuthServiceApproveAuthorizationProcedure:
+			oAuthServiceApproveAuthorizationHandler.ServeHTTP(w, r)
+		case OAuthServiceDenyAuthorizationProcedure:
+			oAuthServiceDenyAuthorizationHandler.ServeHTTP(w, r)
+		case OAuthServiceGetDeviceAuthorizationProcedure:
+			oAuthServiceGetDeviceAuthorizationHandler.ServeHTTP(w, r)
+		case OAuthServiceApproveDeviceAuthorizationProcedure:
+			oAuthServiceApproveDeviceAuthorizationHandler.ServeHTTP(w, r)
+		case OAuthServiceDenyDeviceAuthorizationProcedure:
+			oAuthServiceDenyDeviceAuthorizationHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedOAuthServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedOAuthServiceHandler struct{}
+
+func (UnimplementedOAuthServiceHandler) CreateOAuthClient(context.Context, *connect.Request[v1.CreateOAuthClientRequest]) (*connect.Response[v1.CreateOAuthClientResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.OAuthService.CreateOAuthClient is not implemented"))
+}
+
+func (UnimplementedOAuthServiceHandler) GetOAuthClient(context.Context, *connect.Request[v1.GetOAuthClientRequest]) (*connect.Response[v1.GetOAuthClientResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.OAuthService.GetOAuthClient is not implemented"))
+}
+
+func (UnimplementedOAuthServiceHandler) ListOAuthClients(context.Context, *connect.Request[v1.ListOAuthClientsRequest]) (*connect.Response[v1.ListOAuthClientsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.OAuthService.ListOAuthClients is not implemented"))
+}
+
+func (UnimplementedOAuthServiceHandler) UpdateOAuthClient(context.Context, *connect.Request[v1.UpdateOAuthClientRequest]) (*connect.Response[v1.UpdateOAuthClientResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.OAuthService.UpdateOAuthClient is not implemented"))
+}
+
+func (UnimplementedOAuthServiceHandler) DeleteOAuthClient(context.Context, *connect.Request[v1.DeleteOAuthClientRequest]) (*connect.Response[v1.DeleteOAuthClientResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.OAuthService.DeleteOAuthClient is not implemented"))
+}
+
+func (UnimplementedOAuthServiceHandler) GetAuthorization(context.Context, *connect.Request[v1.GetAuthorizationRequest]) (*connect.Response[v1.GetAuthorizationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.OAuthService.GetAuthorization is not implemented"))
+}
+
+func (UnimplementedOAuthServiceHandler) ApproveAuthorization(context.Context, *connect.Request[v1.ApproveAuthorizationRequest]) (*connect.Response[v1.ApproveAuthorizationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.OAuthService.ApproveAuthorization is not implemented"))
+}
+
+func (UnimplementedOAuthServiceHandler) DenyAuthorization(context.Context, *connect.Request[v1.DenyAuthorizationRequest]) (*connect.Response[v1.DenyAuthorizationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.OAuthService.DenyAuthorization is not implemented"))
+}
+
+func (UnimplementedOAuthServiceHandler) GetDeviceAuthorization(context.Context, *connect.Request[v1.GetDeviceAuthorizationRequest]) (*connect.Response[v1.GetDeviceAuthorizationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.OAuthService.GetDeviceAuthorization is not implemented"))
+}
+
+func (UnimplementedOAuthServiceHandler) ApproveDeviceAuthorization(context.Context, *connect.Request[v1.ApproveDeviceAuthorizationRequest]) (*connect.Response[v1.ApproveDeviceAuthorizationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.OAuthService.ApproveDeviceAuthorization is not implemented"))
+}
+
+func (UnimplementedOAuthServiceHandler) DenyDeviceAuthorization(context.Context, *connect.Request[v1.DenyDeviceAuthorizationRequest]) (*connect.Response[v1.DenyDeviceAuthorizationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.OAuthService.DenyDeviceAuthorization is not implemented"))
+}
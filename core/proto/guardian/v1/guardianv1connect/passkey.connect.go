@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: guardian/v1/passkey.proto
+
+package guardianv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/gophero/guardian/core/proto/guardian/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PasskeyServiceName is the fully-qualified name of the PasskeyService service.
+	PasskeyServiceName = "guardian.v1.PasskeyService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PasskeyServiceBeginPasskeyRegistrationProcedure is the fully-qualified name of the
+	// PasskeyService's BeginPasskeyRegistration RPC.
+	PasskeyServiceBeginPasskeyRegistrationProcedure = "/guardian.v1.PasskeyService/BeginPasskeyRegistration"
+	// PasskeyServiceFinishPasskeyRegistrationProcedure is the fully-qualified name of the
+	// PasskeyService's FinishPasskeyRegistration RPC.
+	PasskeyServiceFinishPasskeyRegistrationProcedure = "/guardian.v1.PasskeyService/FinishPasskeyRegistration"
+	// PasskeyServiceListPasskeysProcedure is the fully-qualified name of the PasskeyService's
+	// ListPasskeys RPC.
+	PasskeyServiceListPasskeysProcedure = "/guardian.v1.PasskeyService/ListPasskeys"
+	// PasskeyServiceDeletePasskeyProcedure is the fully-qualified name of the PasskeyService's
+	// DeletePasskey RPC.
+	PasskeyServiceDeletePasskeyProcedure = "/guardian.v1.PasskeyService/DeletePasskey"
+)
+
+// PasskeyServiceClient is a client for the guardian.v1.PasskeyService service.
+type PasskeyServiceClient interface {
+	// BeginPasskeyRegistration starts a WebAuthn registration ceremony for a discoverable passkey.
+	BeginPasskeyRegistration(context.Context, *connect.Request[v1.BeginPasskeyRegistrationRequest]) (*connect.Response[v1.BeginPasskeyRegistrationResponse], error)
+	// FinishPasskeyRegistration verifies the credential created by the authenticator and stores it as passkey. Only
+	// "none" and "packed" attestation statements are accepted.
+	FinishPasskeyRegistration(context.Context, *connect.Request[v1.FinishPasskeyRegistrationRequest]) (*connect.Response[v1.FinishPasskeyRegistrationResponse], error)
+	// ListPasskeys returns all passkeys of the caller.
+	ListPasskeys(context.Context, *connect.Request[v1.ListPasskeysRequest]) (*connect.Response[v1.ListPasskeysResponse], error)
+	// DeletePasskey deletes a passkey of the caller.
+	DeletePasskey(context.Context, *connect.Request[v1.DeletePasskeyRequest]) (*connect.Response[v1.DeletePasskeyResponse], error)
+}
+
+// NewPasskeyServiceClient constructs a client for the guardian.v1.PasskeyService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPasskeyServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PasskeyServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	passkeyServiceMethods := v1.File_guardian_v1_passkey_proto.Services().ByName("PasskeyService").Methods()
+	return &passkeyServiceClient{
+		beginPasskeyRegistration: connect.NewClient[v1.BeginPasskeyRegistrationRequest, v1.BeginPasskeyRegistrationResponse](
+			httpClient,
+			baseURL+PasskeyServiceBeginPasskeyRegistrationProcedure,
+			connect.WithSchema(passkeyServiceMethods.ByName("BeginPasskeyRegistration")),
+			connect.WithClientOptions(opts...),
+		),
+		finishPasskeyRegistration: connect.NewClient[v1.FinishPasskeyRegistrationRequest, v1.FinishPasskeyRegistrationResponse](
+			httpClient,
+			baseURL+PasskeyServiceFinishPasskeyRegistrationProcedure,
+			connect.WithSchema(passkeyServiceMethods.ByName("FinishPasskeyRegistration")),
+			connect.WithClientOptions(opts...),
+		),
+		listPasskeys: connect.NewClient[v1.ListPasskeysRequest, v1.ListPasskeysResponse](
+			httpClient,
+			baseURL+PasskeyServiceListPasskeysProcedure,
+			connect.WithSchema(passkeyServiceMethods.ByName("ListPasskeys")),
+			connect.WithClientOptions(opts...),
+		),
+		deletePasskey: connect.NewClient[v1.DeletePasskeyRequest, v1.DeletePasskeyResponse](
+			httpClient,
+			baseURL+PasskeyServiceDeletePasskeyProcedure,
+			connect.WithSchema(passkeyServiceMethods.ByName("DeletePasskey")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// passkeyServiceClient implements PasskeyServiceClient.
+type passkeyServiceClient struct {
+	beginPasskeyRegistration  *connect.Client[v1.BeginPasskeyRegistrationRequest, v1.BeginPasskeyRegistrationResponse]
+	finishPasskeyRegistration *connect.Client[v1.FinishPasskeyRegistrationRequest, v1.FinishPasskeyRegistrationResponse]
+	listPasskeys              *connect.Client[v1.ListPasskeysRequest, v1.ListPasskeysResponse]
+	deletePasskey             *connect.Client[v1.DeletePasskeyRequest, v1.DeletePasskeyResponse]
+}
+
+// BeginPasskeyRegistration calls guardian.v1.PasskeyService.BeginPasskeyRegistration.
+func (c *passkeyServiceClient) BeginPasskeyRegistration(ctx context.Context, req *connect.Request[v1.BeginPasskeyRegistrationRequest]) (*connect.Response[v1.BeginPasskeyRegistrationResponse], error) {
+	return c.beginPasskeyRegistration.CallUnary(ctx, req)
+}
+
+// FinishPasskeyRegistration calls guardian.v1.PasskeyService.FinishPasskeyRegistration.
+func (c *passkeyServiceClient) FinishPasskeyRegistration(ctx context.Context, req *connect.Request[v1.FinishPasskeyRegistrationRequest]) (*connect.Response[v1.FinishPasskeyRegistrationResponse], error) {
+	return c.finishPasskeyRegistration.CallUnary(ctx, req)
+}
+
+// ListPasskeys calls guardian.v1.PasskeyService.ListPasskeys.
+func (c *passkeyServiceClient) ListPasskeys(ctx context.Context, req *connect.Request[v1.ListPasskeysRequest]) (*connect.Response[v1.ListPasskeysResponse], error) {
+	return c.listPasskeys.CallUnary(ctx, req)
+}
+
+// DeletePasskey calls guardian.v1.PasskeyService.DeletePasskey.
+func (c *passkeyServiceClient) DeletePasskey(ctx context.Context, req *connect.Request[v1.DeletePasskeyRequest]) (*connect.Response[v1.DeletePasskeyResponse], error) {
+	return c.deletePasskey.CallUnary(ctx, req)
+}
+
+// PasskeyServiceHandler is an implementation of the guardian.v1.PasskeyService service.
+type PasskeyServiceHandler interface {
+	// BeginPasskeyRegistration starts a WebAuthn registration ceremony for a discoverable passkey.
+	BeginPasskeyRegistration(context.Context, *connect.Request[v1.BeginPasskeyRegistrationRequest]) (*connect.Response[v1.BeginPasskeyRegistrationResponse], error)
+	// FinishPasskeyRegistration verifies the credential created by the authenticator and stores it as passkey. Only
+	// "none" and "packed" attestation statements are accepted.
+	FinishPasskeyRegistration(context.Context, *connect.Request[v1.FinishPasskeyRegistrationRequest]) (*connect.Response[v1.FinishPasskeyRegistrationResponse], error)
+	// ListPasskeys returns all passkeys of the caller.
+	ListPasskeys(context.Context, *connect.Request[v1.ListPasskeysRequest]) (*connect.Response[v1.ListPasskeysResponse], error)
+	// DeletePasskey deletes a passkey of the caller.
+	DeletePasskey(context.Context, *connect.Request[v1.DeletePasskeyRequest]) (*connect.Response[v1.DeletePasskeyResponse], error)
+}
+
+// NewPasskeyServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPasskeyServiceHandler(svc PasskeyServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	passkeyServiceMethods := v1.File_guardian_v1_passkey_proto.Services().ByName("PasskeyService").Methods()
+	passkeyServiceBeginPasskeyRegistrationHandler := connect.NewUnaryHandler(
+		PasskeyServiceBeginPasskeyRegistrationProcedure,
+		svc.BeginPasskeyRegistration,
+		connect.WithSchema(passkeyServiceMethods.ByName("BeginPasskeyRegistration")),
+		connect.WithHandlerOptions(opts...),
+	)
+	passkeyServiceFinishPasskeyRegistrationHandler := connect.NewUnaryHandler(
+		PasskeyServiceFinishPasskeyRegistrationProcedure,
+		svc.FinishPasskeyRegistration,
+		connect.WithSchema(passkeyServiceMethods.ByName("FinishPasskeyRegistration")),
+		connect.WithHandlerOptions(opts...),
+	)
+	passkeyServiceListPasskeysHandler := connect.NewUnaryHandler(
+		PasskeyServiceListPasskeysProcedure,
+		svc.ListPasskeys,
+		connect.WithSchema(passkeyServiceMethods.ByName("ListPasskeys")),
+		connect.WithHandlerOptions(opts...),
+	)
+	passkeyServiceDeletePasskeyHandler := connect.NewUnaryHandler(
+		PasskeyServiceDeletePasskeyProcedure,
+		svc.DeletePasskey,
+		connect.WithSchema(passkeyServiceMethods.ByName("DeletePasskey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/guardian.v1.PasskeyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PasskeyServiceBeginPasskeyRegistrationProcedure:
+			passkeyServiceBeginPasskeyRegistrationHandler.ServeHTTP(w, r)
+		case PasskeyServiceFinishPasskeyRegistrationProcedure:
+			passkeyServiceFinishPasskeyRegistrationHandler.ServeHTTP(w, r)
+		case PasskeyServiceListPasskeysProcedure:
+			passkeyServiceListPasskeysHandler.ServeHTTP(w, r)
+		case PasskeyServiceDeletePasskeyProcedure:
+			passkeyServiceDeletePasskeyHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPasskeyServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPasskeyServiceHandler struct{}
+
+func (UnimplementedPasskeyServiceHandler) BeginPasskeyRegistration(context.Context, *connect.Request[v1.BeginPasskeyRegistrationRequest]) (*connect.Response[v1.BeginPasskeyRegistrationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.PasskeyService.BeginPasskeyRegistration is not implemented"))
+}
+
+func (UnimplementedPasskeyServiceHandler) FinishPasskeyRegistration(context.Context, *connect.Request[v1.FinishPasskeyRegistrationRequest]) (*connect.Response[v1.FinishPasskeyRegistrationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.PasskeyService.FinishPasskeyRegistration is not implemented"))
+}
+
+func (UnimplementedPasskeyServiceHandler) ListPasskeys(context.Context, *connect.Request[v1.ListPasskeysRequest]) (*connect.Response[v1.ListPasskeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.PasskeyService.ListPasskeys is not implemented"))
+}
+
+func (UnimplementedPasskeyServiceHandler) DeletePasskey(context.Context, *connect.Request[v1.DeletePasskeyRequest]) (*connect.Response[v1.DeletePasskeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.PasskeyService.DeletePasskey is not implemented"))
+}
@@ -0,0 +1,332 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: guardian/v1/relation.proto
+
+package guardianv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/gophero/guardian/core/proto/guardian/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// RelationServiceName is the fully-qualified name of the RelationService service.
+	RelationServiceName = "guardian.v1.RelationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// RelationServiceWriteSchemaProcedure is the fully-qualified name of the RelationService's
+	// WriteSchema RPC.
+	RelationServiceWriteSchemaProcedure = "/guardian.v1.RelationService/WriteSchema"
+	// RelationServiceReadSchemaProcedure is the fully-qualified name of the RelationService's
+	// ReadSchema RPC.
+	RelationServiceReadSchemaProcedure = "/guardian.v1.RelationService/ReadSchema"
+	// RelationServiceWriteTuplesProcedure is the fully-qualified name of the RelationService's
+	// WriteTuples RPC.
+	RelationServiceWriteTuplesProcedure = "/guardian.v1.RelationService/WriteTuples"
+	// RelationServiceReadTuplesProcedure is the fully-qualified name of the RelationService's
+	// ReadTuples RPC.
+	RelationServiceReadTuplesProcedure = "/guardian.v1.RelationService/ReadTuples"
+	// RelationServiceCheckRelationProcedure is the fully-qualified name of the RelationService's
+	// CheckRelation RPC.
+	RelationServiceCheckRelationProcedure = "/guardian.v1.RelationService/CheckRelation"
+	// RelationServiceExpandRelationProcedure is the fully-qualified name of the RelationService's
+	// ExpandRelation RPC.
+	RelationServiceExpandRelationProcedure = "/guardian.v1.RelationService/ExpandRelation"
+	// RelationServiceListObjectsProcedure is the fully-qualified name of the RelationService's
+	// ListObjects RPC.
+	RelationServiceListObjectsProcedure = "/guardian.v1.RelationService/ListObjects"
+	// RelationServiceListSubjectsProcedure is the fully-qualified name of the RelationService's
+	// ListSubjects RPC.
+	RelationServiceListSubjectsProcedure = "/guardian.v1.RelationService/ListSubjects"
+)
+
+// RelationServiceClient is a client for the guardian.v1.RelationService service.
+type RelationServiceClient interface {
+	// WriteSchema replaces the schema. Fails with INVALID_ARGUMENT if the schema is invalid or does not allow tuples
+	// which are stored.
+	WriteSchema(context.Context, *connect.Request[v1.WriteSchemaRequest]) (*connect.Response[v1.WriteSchemaResponse], error)
+	// ReadSchema returns the current schema.
+	ReadSchema(context.Context, *connect.Request[v1.ReadSchemaRequest]) (*connect.Response[v1.ReadSchemaResponse], error)
+	// WriteTuples atomically deletes and writes tuples. Writing existing tuples replaces their condition and deleting
+	// missing tuples does nothing. Fails with NOT_FOUND if a condition does not exist.
+	WriteTuples(context.Context, *connect.Request[v1.WriteTuplesRequest]) (*connect.Response[v1.WriteTuplesResponse], error)
+	// ReadTuples lists the tuples of a namespace matching a filter ordered by their creation.
+	ReadTuples(context.Context, *connect.Request[v1.ReadTuplesRequest]) (*connect.Response[v1.ReadTuplesResponse], error)
+	// CheckRelation reports whether a subject has a relation to an object, and explains the evaluation if requested.
+	CheckRelation(context.Context, *connect.Request[v1.CheckRelationRequest]) (*connect.Response[v1.CheckRelationResponse], error)
+	// ExpandRelation returns the tree of subjects having a relation to an object.
+	ExpandRelation(context.Context, *connect.Request[v1.ExpandRelationRequest]) (*connect.Response[v1.ExpandRelationResponse], error)
+	// ListObjects lists the objects of a namespace a subject has a relation to, ordered by id.
+	ListObjects(context.Context, *connect.Request[v1.ListObjectsRequest]) (*connect.Response[v1.ListObjectsResponse], error)
+	// ListSubjects lists the objects of a namespace which have a relation to an object, ordered by id.
+	ListSubjects(context.Context, *connect.Request[v1.ListSubjectsRequest]) (*connect.Response[v1.ListSubjectsResponse], error)
+}
+
+// NewRelationServiceClient constructs a client for the guardian.v1.RelationService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRelationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RelationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	relationServiceMethods := v1.File_guardian_v1_relation_proto.Services().ByName("RelationService").Methods()
+	return &relationServiceClient{
+		writeSchema: connect.NewClient[v1.WriteSchemaRequest, v1.WriteSchemaResponse](
+			httpClient,
+			baseURL+RelationServiceWriteSchemaProcedure,
+			connect.WithSchema(relationServiceMethods.ByName("WriteSchema")),
+			connect.WithClientOptions(opts...),
+		),
+		readSchema: connect.NewClient[v1.ReadSchemaRequest, v1.ReadSchemaResponse](
+			httpClient,
+			baseURL+RelationServiceReadSchemaProcedure,
+			connect.WithSchema(relationServiceMethods.ByName("ReadSchema")),
+			connect.WithClientOptions(opts...),
+		),
+		writeTuples: connect.NewClient[v1.WriteTuplesRequest, v1.WriteTuplesResponse](
+			httpClient,
+			baseURL+RelationServiceWriteTuplesProcedure,
+			connect.WithSchema(relationServiceMethods.ByName("WriteTuples")),
+			connect.WithClientOptions(opts...),
+		),
+		readTuples: connect.NewClient[v1.ReadTuplesRequest, v1.ReadTuplesResponse](
+			httpClient,
+			baseURL+RelationServiceReadTuplesProcedure,
+			connect.WithSchema(relationServiceMethods.ByName("ReadTuples")),
+			connect.WithClientOptions(opts...),
+		),
+		checkRelation: connect.NewClient[v1.CheckRelationRequest, v1.CheckRelationResponse](
+			httpClient,
+			baseURL+RelationServiceCheckRelationProcedure,
+			connect.WithSchema(relationServiceMethods.ByName("CheckRelation")),
+			connect.WithClientOptions(opts...),
+		),
+		expandRelation: connect.NewClient[v1.ExpandRelationRequest, v1.ExpandRelationResponse](
+			httpClient,
+			baseURL+RelationServiceExpandRelationProcedure,
+			connect.WithSchema(relationServiceMethods.ByName("ExpandRelation")),
+			connect.WithClientOptions(opts...),
+		),
+		listObjects: connect.NewClient[v1.ListObjectsRequest, v1.ListObjectsResponse](
+			httpClient,
+			baseURL+RelationServiceListObjectsProcedure,
+			connect.WithSchema(relationServiceMethods.ByName("ListObjects")),
+			connect.WithClientOptions(opts...),
+		),
+		listSubjects: connect.NewClient[v1.ListSubjectsRequest, v1.ListSubjectsResponse](
+			httpClient,
+			baseURL+RelationServiceListSubjectsProcedure,
+			connect.WithSchema(relationServiceMethods.ByName("ListSubjects")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// relationServiceClient implements RelationServiceClient.
+type relationServiceClient struct {
+	writeSchema    *connect.Client[v1.WriteSchemaRequest, v1.WriteSchemaResponse]
+	readSchema     *connect.Client[v1.ReadSchemaRequest, v1.ReadSchemaResponse]
+	writeTuples    *connect.Client[v1.WriteTuplesRequest, v1.WriteTuplesResponse]
+	readTuples     *connect.Client[v1.ReadTuplesRequest, v1.ReadTuplesResponse]
+	checkRelation  *connect.Client[v1.CheckRelationRequest, v1.CheckRelationResponse]
+	expandRelation *connect.Client[v1.ExpandRelationRequest, v1.ExpandRelationResponse]
+	listObjects    *connect.Client[v1.ListObjectsRequest, v1.ListObjectsResponse]
+	listSubjects   *connect.Client[v1.ListSubjectsRequest, v1.ListSubjectsResponse]
+}
+
+// WriteSchema calls guardian.v1.RelationService.WriteSchema.
+func (c *relationServiceClient) WriteSchema(ctx context.Context, req *connect.Request[v1.WriteSchemaRequest]) (*connect.Response[v1.WriteSchemaResponse], error) {
+	return c.writeSchema.CallUnary(ctx, req)
+}
+
+// ReadSchema calls guardian.v1.RelationService.ReadSchema.
+func (c *relationServiceClient) ReadSchema(ctx context.Context, req *connect.Request[v1.ReadSchemaRequest]) (*connect.Response[v1.ReadSchemaResponse], error) {
+	return c.readSchema.CallUnary(ctx, req)
+}
+
+// WriteTuples calls guardian.v1.RelationService.WriteTuples.
+func (c *relationServiceClient) WriteTuples(ctx context.Context, req *connect.Request[v1.WriteTuplesRequest]) (*connect.Response[v1.WriteTuplesResponse], error) {
+	return c.writeTuples.CallUnary(ctx, req)
+}
+
+// ReadTuples calls guardian.v1.RelationService.ReadTuples.
+func (c *relationServiceClient) ReadTuples(ctx context.Context, req *connect.Request[v1.ReadTuplesRequest]) (*connect.Response[v1.ReadTuplesResponse], error) {
+	return c.readTuples.CallUnary(ctx, req)
+}
+
+// CheckRelation calls guardian.v1.RelationService.CheckRelation.
+func (c *relationServiceClient) CheckRelation(ctx context.Context, req *connect.Request[v1.CheckRelationRequest]) (*connect.Response[v1.CheckRelationResponse], error) {
+	return c.checkRelation.CallUnary(ctx, req)
+}
+
+// ExpandRelation calls guardian.v1.RelationService.ExpandRelation.
+func (c *relationServiceClient) ExpandRelation(ctx context.Context, req *connect.Request[v1.ExpandRelationRequest]) (*connect.Response[v1.ExpandRelationResponse], error) {
+	return c.expandRelation.CallUnary(ctx, req)
+}
+
+// ListObjects calls guardian.v1.RelationService.ListObjects.
+func (c *relationServiceClient) ListObjects(ctx context.Context, req *connect.Request[v1.ListObjectsRequest]) (*connect.Response[v1.ListObjectsResponse], error) {
+	return c.listObjects.CallUnary(ctx, req)
+}
+
+// ListSubjects calls guardian.v1.RelationService.ListSubjects.
+func (c *relationServiceClient) ListSubjects(ctx context.Context, req *connect.Request[v1.ListSubjectsRequest]) (*connect.Response[v1.ListSubjectsResponse], error) {
+	return c.listSubjects.CallUnary(ctx, req)
+}
+
+// RelationServiceHandler is an implementation of the guardian.v1.RelationService service.
+type RelationServiceHandler interface {
+	// WriteSchema replaces the schema. Fails with INVALID_ARGUMENT if the schema is invalid or does not allow tuples
+	// which are stored.
+	WriteSchema(context.Context, *connect.Request[v1.WriteSchemaRequest]) (*connect.Response[v1.WriteSchemaResponse], error)
+	// ReadSchema returns the current schema.
+	ReadSchema(context.Context, *connect.Request[v1.ReadSchemaRequest]) (*connect.Response[v1.ReadSchemaResponse], error)
+	// WriteTuples atomically deletes and writes tuples. Writing existing tuples replaces their condition and deleting
+	// missing tuples does nothing. Fails with NOT_FOUND if a condition does not exist.
+	WriteTuples(context.Context, *connect.Request[v1.WriteTuplesRequest]) (*connect.Response[v1.WriteTuplesResponse], error)
+	// ReadTuples lists the tuples of a namespace matching a filter ordered by their creation.
+	ReadTuples(context.Context, *connect.Request[v1.ReadTuplesRequest]) (*connect.Response[v1.ReadTuplesResponse], error)
+	// CheckRelation reports whether a subject has a relation to an object, and explains the evaluation if requested.
+	CheckRelation(context.Context, *connect.Request[v1.CheckRelationRequest]) (*connect.Response[v1.CheckRelationResponse], error)
+	// ExpandRelation returns the tree of subjects having a relation to an object.
+	ExpandRelation(context.Context, *connect.Request[v1.ExpandRelationRequest]) (*connect.Response[v1.ExpandRelationResponse], error)
+	// ListObjects lists the objects of a namespace a subject has a relation to, ordered by id.
+	ListObjects(context.Context, *connect.Request[v1.ListObjectsRequest]) (*connect.Response[v1.ListObjectsResponse], error)
+	// ListSubjects lists the objects of a namespace which have a relation to an object, ordered by id.
+	ListSubjects(context.Context, *connect.Request[v1.ListSubjectsRequest]) (*connect.Response[v1.ListSubjectsResponse], error)
+}
+
+// NewRelationServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRelationServiceHandler(svc RelationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	relationServiceMethods := v1.File_guardian_v1_relation_proto.Services().ByName("RelationService").Methods()
+	relationServiceWriteSchemaHandler := connect.NewUnaryHandler(
+		RelationServiceWriteSchemaProcedure,
+		svc.WriteSchema,
+		connect.WithSchema(relationServiceMethods.ByName("WriteSchema")),
+		connect.WithHandlerOptions(opts...),
+	)
+	relationServiceReadSchemaHandler := connect.NewUnaryHandler(
+		RelationServiceReadSchemaProcedure,
+		svc.ReadSchema,
+		connect.WithSchema(relationServiceMethods.ByName("ReadSchema")),
+		connect.WithHandlerOptions(opts...),
+	)
+	relationServiceWriteTuplesHandler := connect.NewUnaryHandler(
+		RelationServiceWriteTuplesProcedure,
+		svc.WriteTuples,
+		connect.WithSchema(relationServiceMethods.ByName("WriteTuples")),
+		connect.WithHandlerOptions(opts...),
+	)
+	relationServiceReadTuplesHandler := connect.NewUnaryHandler(
+		RelationServiceReadTuplesProcedure,
+		svc.ReadTuples,
+		connect.WithSchema(relationServiceMethods.ByName("ReadTuples")),
+		connect.WithHandlerOptions(opts...),
+	)
+	relationServiceCheckRelationHandler := connect.NewUnaryHandler(
+		RelationServiceCheckRelationProcedure,
+		svc.CheckRelation,
+		connect.WithSchema(relationServiceMethods.ByName("CheckRelation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	relationServiceExpandRelationHandler := connect.NewUnaryHandler(
+		RelationServiceExpandRelationProcedure,
+		svc.ExpandRelation,
+		connect.WithSchema(relationServiceMethods.ByName("ExpandRelation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	relationServiceListObjectsHandler := connect.NewUnaryHandler(
+		RelationServiceListObjectsProcedure,
+		svc.ListObjects,
+		connect.WithSchema(relationServiceMethods.ByName("ListObjects")),
+		connect.WithHandlerOptions(opts...),
+	)
+	relationServiceListSubjectsHandler := connect.NewUnaryHandler(
+		RelationServiceListSubjectsProcedure,
+		svc.ListSubjects,
+		connect.WithSchema(relationServiceMethods.ByName("ListSubjects")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/guardian.v1.RelationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RelationServiceWriteSchemaProcedure:
+			relationServiceWriteSchemaHandler.ServeHTTP(w, r)
+		case RelationServiceReadSchemaProcedure:
+			relationServiceReadSchemaHandler.ServeHTTP(w, r)
+		case RelationServiceWriteTuplesProcedure:
+			relationServiceWriteTuplesHandler.ServeHTTP(w, r)
+		case RelationServiceReadTuplesProcedure:
+			relationServiceReadTuplesHandler.ServeHTTP(w, r)
+		case RelationServiceCheckRelationProcedure:
+			relationServiceCheckRelationHandler.ServeHTTP(w, r)
+		case RelationServiceExpandRelationProcedure:
+			relationServiceExpandRelationHandler.ServeHTTP(w, r)
+		case RelationServiceListObjectsProcedure:
+			relationServiceListObjectsHandler.ServeHTTP(w, r)
+		case RelationServiceListSubjectsProcedure:
+			relationServiceListSubjectsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRelationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRelationServiceHandler struct{}
+
+func (UnimplementedRelationServiceHandler) WriteSchema(context.Context, *connect.Request[v1.WriteSchemaRequest]) (*connect.Response[v1.WriteSchemaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.RelationService.WriteSchema is not implemented"))
+}
+
+func (UnimplementedRelationServiceHandler) ReadSchema(context.Context, *connect.Request[v1.ReadSchemaRequest]) (*connect.Response[v1.ReadSchemaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.RelationService.ReadSchema is not implemented"))
+}
+
+func (UnimplementedRelationServiceHandler) WriteTuples(context.Context, *connect.Request[v1.WriteTuplesRequest]) (*connect.Response[v1.WriteTuplesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.RelationService.WriteTuples is not implemented"))
+}
+
+func (UnimplementedRelationServiceHandler) ReadTuples(context.Context, *connect.Request[v1.ReadTuplesRequest]) (*connect.Response[v1.ReadTuplesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.RelationService.ReadTuples is not implemented"))
+}
+
+func (UnimplementedRelationServiceHandler) CheckRelation(context.Context, *connect.Request[v1.CheckRelationRequest]) (*connect.Response[v1.CheckRelationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.RelationService.CheckRelation is not implemented"))
+}
+
+func (UnimplementedRelationServiceHandler) ExpandRelation(context.Context, *connect.Request[v1.ExpandRelationRequest]) (*connect.Response[v1.ExpandRelationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.RelationService.ExpandRelation is not implemented"))
+}
+
+func (UnimplementedRelationServiceHandler) ListObjects(context.Context, *connect.Request[v1.ListObjectsRequest]) (*connect.Response[v1.ListObjectsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.RelationService.ListObjects is not implemented"))
+}
+
+func (UnimplementedRelationServiceHandler) ListSubjects(context.Context, *connect.Request[v1.ListSubjectsRequest]) (*connect.Response[v1.ListSubjectsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.RelationService.ListSubjects is not implemented"))
+}
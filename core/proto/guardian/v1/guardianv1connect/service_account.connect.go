@@ -0,0 +1,366 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: guardian/v1/service_account.proto
+
+package guardianv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/gophero/guardian/core/proto/guardian/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ServiceAccountServiceName is the fully-qualified name of the ServiceAccountService service.
+	ServiceAccountServiceName = "guardian.v1.ServiceAccountService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ServiceAccountServiceCreateServiceAccountProcedure is the fully-qualified name of the
+	// ServiceAccountService's CreateServiceAccount RPC.
+	ServiceAccountServiceCreateServiceAccountProcedure = "/guardian.v1.ServiceAccountService/CreateServiceAccount"
+	// ServiceAccountServiceGetServiceAccountProcedure is the fully-qualified name of the
+	// ServiceAccountService's GetServiceAccount RPC.
+	ServiceAccountServiceGetServiceAccountProcedure = "/guardian.v1.ServiceAccountService/GetServiceAccount"
+	// ServiceAccountServiceListServiceAccountsProcedure is the fully-qualified name of the
+	// ServiceAccountService's ListServiceAccounts RPC.
+	ServiceAccountServiceListServiceAccountsProcedure = "/guardian.v1.ServiceAccountService/ListServiceAccounts"
+	// ServiceAccountServiceUpdateServiceAccountProcedure is the fully-qualified name of the
+	// ServiceAccountService's UpdateServiceAccount RPC.
+	ServiceAccountServiceUpdateServiceAccountProcedure = "/guardian.v1.ServiceAccountService/UpdateServiceAccount"
+	// ServiceAccountServiceDeleteServiceAccountProcedure is the fully-qualified name of the
+	// ServiceAccountService's DeleteServiceAccount RPC.
+	ServiceAccountServiceDeleteServiceAccountProcedure = "/guardian.v1.ServiceAccountService/DeleteServiceAccount"
+	// ServiceAccountServiceRotateServiceAccountSecretProcedure is the fully-qualified name of the
+	// ServiceAccountService's RotateServiceAccountSecret RPC.
+	ServiceAccountServiceRotateServiceAccountSecretProcedure = "/guardian.v1.ServiceAccountService/RotateServiceAccountSecret"
+	// ServiceAccountServiceAddServiceAccountKeyProcedure is the fully-qualified name of the
+	// ServiceAccountService's AddServiceAccountKey RPC.
+	ServiceAccountServiceAddServiceAccountKeyProcedure = "/guardian.v1.ServiceAccountService/AddServiceAccountKey"
+	// ServiceAccountServiceListServiceAccountKeysProcedure is the fully-qualified name of the
+	// ServiceAccountService's ListServiceAccountKeys RPC.
+	ServiceAccountServiceListServiceAccountKeysProcedure = "/guardian.v1.ServiceAccountService/ListServiceAccountKeys"
+	// ServiceAccountServiceDeleteServiceAccountKeyProcedure is the fully-qualified name of the
+	// ServiceAccountService's DeleteServiceAccountKey RPC.
+	ServiceAccountServiceDeleteServiceAccountKeyProcedure = "/guardian.v1.ServiceAccountService/DeleteServiceAccountKey"
+)
+
+// ServiceAccountServiceClient is a client for the guardian.v1.ServiceAccountService service.
+type ServiceAccountServiceClient interface {
+	// CreateServiceAccount creates an account and returns its secret, which cannot be retrieved later.
+	CreateServiceAccount(context.Context, *connect.Request[v1.CreateServiceAccountRequest]) (*connect.Response[v1.CreateServiceAccountResponse], error)
+	// GetServiceAccount returns an account by id.
+	GetServiceAccount(context.Context, *connect.Request[v1.GetServiceAccountRequest]) (*connect.Response[v1.GetServiceAccountResponse], error)
+	// ListServiceAccounts lists all accounts ordered by id.
+	ListServiceAccounts(context.Context, *connect.Request[v1.ListServiceAccountsRequest]) (*connect.Response[v1.ListServiceAccountsResponse], error)
+	// UpdateServiceAccount replaces the settings of an account.
+	UpdateServiceAccount(context.Context, *connect.Request[v1.UpdateServiceAccountRequest]) (*connect.Response[v1.UpdateServiceAccountResponse], error)
+	// DeleteServiceAccount deletes an account along with its keys, API keys and role assignments. Access tokens issued
+	// to it stay valid until they expire.
+	DeleteServiceAccount(context.Context, *connect.Request[v1.DeleteServiceAccountRequest]) (*connect.Response[v1.DeleteServiceAccountResponse], error)
+	// RotateServiceAccountSecret replaces the secret of an account and returns the new secret. The previous secret stays
+	// valid for the overlap, so jobs can switch without downtime.
+	RotateServiceAccountSecret(context.Context, *connect.Request[v1.RotateServiceAccountSecretRequest]) (*connect.Response[v1.RotateServiceAccountSecretResponse], error)
+	// AddServiceAccountKey adds a public key verifying the client assertions of an account. Fails with ALREADY_EXISTS
+	// if the account has a key with the key id.
+	AddServiceAccountKey(context.Context, *connect.Request[v1.AddServiceAccountKeyRequest]) (*connect.Response[v1.AddServiceAccountKeyResponse], error)
+	// ListServiceAccountKeys lists the keys of an account ordered by id.
+	ListServiceAccountKeys(context.Context, *connect.Request[v1.ListServiceAccountKeysRequest]) (*connect.Response[v1.ListServiceAccountKeysResponse], error)
+	// DeleteServiceAccountKey deletes a key of an account, rejecting assertions signed with it immediately.
+	DeleteServiceAccountKey(context.Context, *connect.Request[v1.DeleteServiceAccountKeyRequest]) (*connect.Response[v1.DeleteServiceAccountKeyResponse], error)
+}
+
+// NewServiceAccountServiceClient constructs a client for the guardian.v1.ServiceAccountService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewServiceAccountServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceAccountServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	serviceAccountServiceMethods := v1.File_guardian_v1_service_account_proto.Services().ByName("ServiceAccountService").Methods()
+	return &serviceAccountServiceClient{
+		createServiceAccount: connect.NewClient[v1.CreateServiceAccountRequest, v1.CreateServiceAccountResponse](
+			httpClient,
+			baseURL+ServiceAccountServiceCreateServiceAccountProcedure,
+			connect.WithSchema(serviceAccountServiceMethods.ByName("CreateServiceAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		getServiceAccount: connect.NewClient[v1.GetServiceAccountRequest, v1.GetServiceAccountResponse](
+			httpClient,
+			baseURL+ServiceAccountServiceGetServiceAccountProcedure,
+			connect.WithSchema(serviceAccountServiceMethods.ByName("GetServiceAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		listServiceAccounts: connect.NewClient[v1.ListServiceAccountsRequest, v1.ListServiceAccountsResponse](
+			httpClient,
+			baseURL+ServiceAccountServiceListServiceAccountsProcedure,
+			connect.WithSchema(serviceAccountServiceMethods.ByName("ListServiceAccounts")),
+			connect.WithClientOptions(opts...),
+		),
+		updateServiceAccount: connect.NewClient[v1.UpdateServiceAccountRequest, v1.UpdateServiceAccountResponse](
+			httpClient,
+			baseURL+ServiceAccountServiceUpdateServiceAccountProcedure,
+			connect.WithSchema(serviceAccountServiceMethods.ByName("UpdateServiceAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteServiceAccount: connect.NewClient[v1.DeleteServiceAccountRequest, v1.DeleteServiceAccountResponse](
+			httpClient,
+			baseURL+ServiceAccountServiceDeleteServiceAccountProcedure,
+			connect.WithSchema(serviceAccountServiceMethods.ByName("DeleteServiceAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateServiceAccountSecret: connect.NewClient[v1.RotateServiceAccountSecretRequest, v1.RotateServiceAccountSecretResponse](
+			httpClient,
+			baseURL+ServiceAccountServiceRotateServiceAccountSecretProcedure,
+			connect.WithSchema(serviceAccountServiceMethods.ByName("RotateServiceAccountSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		addServiceAccountKey: connect.NewClient[v1.AddServiceAccountKeyRequest, v1.AddServiceAccountKeyResponse](
+			httpClient,
+			baseURL+ServiceAccountServiceAddServiceAccountKeyProcedure,
+			connect.WithSchema(serviceAccountServiceMethods.ByName("AddServiceAccountKey")),
+			connect.WithClientOptions(opts...),
+		),
+		listServiceAccountKeys: connect.NewClient[v1.ListServiceAccountKeysRequest, v1.ListServiceAccountKeysResponse](
+			httpClient,
+			baseURL+ServiceAccountServiceListServiceAccountKeysProcedure,
+			connect.WithSchema(serviceAccountServiceMethods.ByName("ListServiceAccountKeys")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteServiceAccountKey: connect.NewClient[v1.DeleteServiceAccountKeyRequest, v1.DeleteServiceAccountKeyResponse](
+			httpClient,
+			baseURL+ServiceAccountServiceDeleteServiceAccountKeyProcedure,
+			connect.WithSchema(serviceAccountServiceMethods.ByName("DeleteServiceAccountKey")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// serviceAccountServiceClient implements ServiceAccountServiceClient.
+type serviceAccountServiceClient struct {
+	createServiceAccount       *connect.Client[v1.CreateServiceAccountRequest, v1.CreateServiceAccountResponse]
+	getServiceAccount          *connect.Client[v1.GetServiceAccountRequest, v1.GetServiceAccountResponse]
+	listServiceAccounts        *connect.Client[v1.ListServiceAccountsRequest, v1.ListServiceAccountsResponse]
+	updateServiceAccount       *connect.Client[v1.UpdateServiceAccountRequest, v1.UpdateServiceAccountResponse]
+	deleteServiceAccount       *connect.Client[v1.DeleteServiceAccountRequest, v1.DeleteServiceAccountResponse]
+	rotateServiceAccountSecret *connect.Client[v1.RotateServiceAccountSecretRequest, v1.RotateServiceAccountSecretResponse]
+	addServiceAccountKey       *connect.Client[v1.AddServiceAccountKeyRequest, v1.AddServiceAccountKeyResponse]
+	listServiceAccountKeys     *connect.Client[v1.ListServiceAccountKeysRequest, v1.ListServiceAccountKeysResponse]
+	deleteServiceAccountKey    *connect.Client[v1.DeleteServiceAccountKeyRequest, v1.DeleteServiceAccountKeyResponse]
+}
+
+// CreateServiceAccount calls guardian.v1.ServiceAccountService.CreateServiceAccount.
+func (c *serviceAccountServiceClient) CreateServiceAccount(ctx context.Context, req *connect.Request[v1.CreateServiceAccountRequest]) (*connect.Response[v1.CreateServiceAccountResponse], error) {
+	return c.createServiceAccount.CallUnary(ctx, req)
+}
+
+// GetServiceAccount calls guardian.v1.ServiceAccountService.GetServiceAccount.
+func (c *serviceAccountServiceClient) GetServiceAccount(ctx context.Context, req *connect.Request[v1.GetServiceAccountRequest]) (*connect.Response[v1.GetServiceAccountResponse], error) {
+	return c.getServiceAccount.CallUnary(ctx, req)
+}
+
+// ListServiceAccounts calls guardian.v1.ServiceAccountService.ListServiceAccounts.
+func (c *serviceAccountServiceClient) ListServiceAccounts(ctx context.Context, req *connect.Request[v1.ListServiceAccountsRequest]) (*connect.Response[v1.ListServiceAccountsResponse], error) {
+	return c.listServiceAccounts.CallUnary(ctx, req)
+}
+
+// UpdateServiceAccount calls guardian.v1.ServiceAccountService.UpdateServiceAccount.
+func (c *serviceAccountServiceClient) UpdateServiceAccount(ctx context.Context, req *connect.Request[v1.UpdateServiceAccountRequest]) (*connect.Response[v1.UpdateServiceAccountResponse], error) {
+	return c.updateServiceAccount.CallUnary(ctx, req)
+}
+
+// DeleteServiceAccount calls guardian.v1.ServiceAccountService.DeleteServiceAccount.
+func (c *serviceAccountServiceClient) DeleteServiceAccount(ctx context.Context, req *connect.Request[v1.DeleteServiceAccountRequest]) (*connect.Response[v1.DeleteServiceAccountResponse], error) {
+	return c.deleteServiceAccount.CallUnary(ctx, req)
+}
+
+// RotateServiceAccountSecret calls guardian.v1.ServiceAccountService.RotateServiceAccountSecret.
+func (c *serviceAccountServiceClient) RotateServiceAccountSecret(ctx context.Context, req *connect.Request[v1.RotateServiceAccountSecretRequest]) (*connect.Response[v1.RotateServiceAccountSecretResponse], error) {
+	return c.rotateServiceAccountSecret.CallUnary(ctx, req)
+}
+
+// AddServiceAccountKey calls guardian.v1.ServiceAccountService.AddServiceAccountKey.
+func (c *serviceAccountServiceClient) AddServiceAccountKey(ctx context.Context, req *connect.Request[v1.AddServiceAccountKeyRequest]) (*connect.Response[v1.AddServiceAccountKeyResponse], error) {
+	return c.addServiceAccountKey.CallUnary(ctx, req)
+}
+
+// ListServiceAccountKeys calls guardian.v1.ServiceAccountService.ListServiceAccountKeys.
+func (c *serviceAccountServiceClient) ListServiceAccountKeys(ctx context.Context, req *connect.Request[v1.ListServiceAccountKeysRequest]) (*connect.Response[v1.ListServiceAccountKeysResponse], error) {
+	return c.listServiceAccountKeys.CallUnary(ctx, req)
+}
+
+// DeleteServiceAccountKey calls guardian.v1.ServiceAccountService.DeleteServiceAccountKey.
+func (c *serviceAccountServiceClient) DeleteServiceAccountKey(ctx context.Context, req *connect.Request[v1.DeleteServiceAccountKeyRequest]) (*connect.Response[v1.DeleteServiceAccountKeyResponse], error) {
+	return c.deleteServiceAccountKey.CallUnary(ctx, req)
+}
+
+// ServiceAccountServiceHandler is an implementation of the guardian.v1.ServiceAccountService
+// service.
+type ServiceAccountServiceHandler interface {
+	// CreateServiceAccount creates an account and returns its secret, which cannot be retrieved later.
+	CreateServiceAccount(context.Context, *connect.Request[v1.CreateServiceAccountRequest]) (*connect.Response[v1.CreateServiceAccountResponse], error)
+	// GetServiceAccount returns an account by id.
+	GetServiceAccount(context.Context, *connect.Request[v1.GetServiceAccountRequest]) (*connect.Response[v1.GetServiceAccountResponse], error)
+	// ListServiceAccounts lists all accounts ordered by id.
+	ListServiceAccounts(context.Context, *connect.Request[v1.ListServiceAccountsRequest]) (*connect.Response[v1.ListServiceAccountsResponse], error)
+	// UpdateServiceAccount replaces the settings of an account.
+	UpdateServiceAccount(context.Context, *connect.Request[v1.UpdateServiceAccountRequest]) (*connect.Response[v1.UpdateServiceAccountResponse], error)
+	// DeleteServiceAccount deletes an account along with its keys, API keys and role assignments. Access tokens issued
+	// to it stay valid until they expire.
+	DeleteServiceAccount(context.Context, *connect.Request[v1.DeleteServiceAccountRequest]) (*connect.Response[v1.DeleteServiceAccountResponse], error)
+	// RotateServiceAccountSecret replaces the secret of an account and returns the new secret. The previous secret stays
+	// valid for the overlap, so jobs can switch without downtime.
+	RotateServiceAccountSecret(context.Context, *connect.Request[v1.RotateServiceAccountSecretRequest]) (*connect.Response[v1.RotateServiceAccountSecretResponse], error)
+	// AddServiceAccountKey adds a public key verifying the client assertions of an account. Fails with ALREADY_EXISTS
+	// if the account has a key with the key id.
+	AddServiceAccountKey(context.Context, *connect.Request[v1.AddServiceAccountKeyRequest]) (*connect.Response[v1.AddServiceAccountKeyResponse], error)
+	// ListServiceAccountKeys lists the keys of an account ordered by id.
+	ListServiceAccountKeys(context.Context, *connect.Request[v1.ListServiceAccountKeysRequest]) (*connect.Response[v1.ListServiceAccountKeysResponse], error)
+	// DeleteServiceAccountKey deletes a key of an account, rejecting assertions signed with it immediately.
+	DeleteServiceAccountKey(context.Context, *connect.Request[v1.DeleteServiceAccountKeyRequest]) (*connect.Response[v1.DeleteServiceAccountKeyResponse], error)
+}
+
+// NewServiceAccountServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewServiceAccountServiceHandler(svc ServiceAccountServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceAccountServiceMethods := v1.File_guardian_v1_service_account_proto.Services().ByName("ServiceAccountService").Methods()
+	serviceAccountServiceCreateServiceAccountHandler := connect.NewUnaryHandler(
+		ServiceAccountServiceCreateServiceAccountProcedure,
+		svc.CreateServiceAccount,
+		connect.WithSchema(serviceAccountServiceMethods.ByName("CreateServiceAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceAccountServiceGetServiceAccountHandler := connect.NewUnaryHandler(
+		ServiceAccountServiceGetServiceAccountProcedure,
+		svc.GetServiceAccount,
+		connect.WithSchema(serviceAccountServiceMethods.ByName("GetServiceAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceAccountServiceListServiceAccountsHandler := connect.NewUnaryHandler(
+		ServiceAccountServiceListServiceAccountsProcedure,
+		svc.ListServiceAccounts,
+		connect.WithSchema(serviceAccountServiceMethods.ByName("ListServiceAccounts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceAccountServiceUpdateServiceAccountHandler := connect.NewUnaryHandler(
+		ServiceAccountServiceUpdateServiceAccountProcedure,
+		svc.UpdateServiceAccount,
+		connect.WithSchema(serviceAccountServiceMethods.ByName("UpdateServiceAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceAccountServiceDeleteServiceAccountHandler := connect.NewUnaryHandler(
+		ServiceAccountServiceDeleteServiceAccountProcedure,
+		svc.DeleteServiceAccount,
+		connect.WithSchema(serviceAccountServiceMethods.ByName("DeleteServiceAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceAccountServiceRotateServiceAccountSecretHandler := connect.NewUnaryHandler(
+		ServiceAccountServiceRotateServiceAccountSecretProcedure,
+		svc.RotateServiceAccountSecret,
+		connect.WithSchema(serviceAccountServiceMethods.ByName("RotateServiceAccountSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceAccountServiceAddServiceAccountKeyHandler := connect.NewUnaryHandler(
+		ServiceAccountServiceAddServiceAccountKeyProcedure,
+		svc.AddServiceAccountKey,
+		connect.WithSchema(serviceAccountServiceMethods.ByName("AddServiceAccountKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceAccountServiceListServiceAccountKeysHandler := connect.NewUnaryHandler(
+		ServiceAccountServiceListServiceAccountKeysProcedure,
+		svc.ListServiceAccountKeys,
+		connect.WithSchema(serviceAccountServiceMethods.ByName("ListServiceAccountKeys")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceAccountServiceDeleteServiceAccountKeyHandler := connect.NewUnaryHandler(
+		ServiceAccountServiceDeleteServiceAccountKeyProcedure,
+		svc.DeleteServiceAccountKey,
+		connect.WithSchema(serviceAccountServiceMethods.ByName("DeleteServiceAccountKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/guardian.v1.ServiceAccountService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ServiceAccountServiceCreateServiceAccountProcedure:
+			serviceAccountServiceCreateServiceAccountHandler.ServeHTTP(w, r)
+		case ServiceAccountServiceGetServiceAccountProcedure:
+			serviceAccountServiceGetServiceAccountHandler.ServeHTTP(w, r)
+		case ServiceAccountServiceListServiceAccountsProcedure:
+			serviceAccountServiceListServiceAccountsHandler.ServeHTTP(w, r)
+		case ServiceAccountServiceUpdateServiceAccountProcedure:
+			serviceAccountServiceUpdateServiceAccountHandler.ServeHTTP(w, r)
+		case ServiceAccountServiceDeleteServiceAccountProcedure:
+			serviceAccountServiceDeleteServiceAccountHandler.ServeHTTP(w, r)
+		case ServiceAccountServiceRotateServiceAccountSecretProcedure:
+			serviceAccountServiceRotateServiceAccountSecretHandler.ServeHTTP(w, r)
+		case ServiceAccountServiceAddServiceAccountKeyProcedure:
+			serviceAccountServiceAddServiceAccountKeyHandler.ServeHTTP(w, r)
+		case ServiceAccountServiceListServiceAccountKeysProcedure:
+			serviceAccountServiceListServiceAccountKeysHandler.ServeHTTP(w, r)
+		case ServiceAccountServiceDeleteServiceAccountKeyProcedure:
+			serviceAccountServiceDeleteServiceAccountKeyHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedServiceAccountServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedServiceAccountServiceHandler struct{}
+
+func (UnimplementedServiceAccountServiceHandler) CreateServiceAccount(context.Context, *connect.Request[v1.CreateServiceAccountRequest]) (*connect.Response[v1.CreateServiceAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.ServiceAccountService.CreateServiceAccount is not implemented"))
+}
+
+func (UnimplementedServiceAccountServiceHandler) GetServiceAccount(context.Context, *connect.Request[v1.GetServiceAccountRequest]) (*connect.Response[v1.GetServiceAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.ServiceAccountService.GetServiceAccount is not implemented"))
+}
+
+func (UnimplementedServiceAccountServiceHandler) ListServiceAccounts(context.Context, *connect.Request[v1.ListServiceAccountsRequest]) (*connect.Response[v1.ListServiceAccountsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.ServiceAccountService.ListServiceAccounts is not implemented"))
+}
+
+func (UnimplementedServiceAccountServiceHandler) UpdateServiceAccount(context.Context, *connect.Request[v1.UpdateServiceAccountRequest]) (*connect.Response[v1.UpdateServiceAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.ServiceAccountService.UpdateServiceAccount is not implemented"))
+}
+
+func (UnimplementedServiceAccountServiceHandler) DeleteServiceAccount(context.Context, *connect.Request[v1.DeleteServiceAccountRequest]) (*connect.Response[v1.DeleteServiceAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.ServiceAccountService.DeleteServiceAccount is not implemented"))
+}
+
+func (UnimplementedServiceAccountServiceHandler) RotateServiceAccountSecret(context.Context, *connect.Request[v1.RotateServiceAccountSecretRequest]) (*connect.Response[v1.RotateServiceAccountSecretResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.ServiceAccountService.RotateServiceAccountSecret is not implemented"))
+}
+
+func (UnimplementedServiceAccountServiceHandler) AddServiceAccountKey(context.Context, *connect.Request[v1.AddServiceAccountKeyRequest]) (*connect.Response[v1.AddServiceAccountKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.ServiceAccountService.AddServiceAccountKey is not implemented"))
+}
+
+func (UnimplementedServiceAccountServiceHandler) ListServiceAccountKeys(context.Context, *connect.Request[v1.ListServiceAccountKeysRequest]) (*connect.Response[v1.ListServiceAccountKeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.ServiceAccountService.ListServiceAccountKeys is not implemented"))
+}
+
+func (UnimplementedServiceAccountServiceHandler) DeleteServiceAccountKey(context.Context, *connect.Request[v1.DeleteServiceAccountKeyRequest]) (*connect.Response[v1.DeleteServiceAccountKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("guardian.v1.ServiceAccountService.DeleteServiceAccountKey is not implemented"))
+}
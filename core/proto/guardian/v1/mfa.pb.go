@@ -0,0 +1,913 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: guardian/v1/mfa.proto
+
+package guardianv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetMFAStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMFAStatusRequest) Reset() {
+	*x = GetMFAStatusRequest{}
+	mi := &file_guardian_v1_mfa_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMFAStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMFAStatusRequest) ProtoMessage() {}
+
+func (x *GetMFAStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_mfa_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type GetMFAStatusRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 GetMFAStatusRequest_builder) Build() *GetMFAStatusRequest {
+	m0 := &GetMFAStatusRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GetMFAStatusResponse struct {
+	state                             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_TotpEnabled            bool                   `protobuf:"varint,1,opt,name=totp_enabled,json=totpEnabled,proto3"`
+	xxx_hidden_RecoveryCodesRemaining int32                  `protobuf:"varint,2,opt,name=recovery_codes_remaining,json=recoveryCodesRemaining,proto3"`
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *GetMFAStatusResponse) Reset() {
+	*x = GetMFAStatusResponse{}
+	mi := &file_guardian_v1_mfa_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMFAStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMFAStatusResponse) ProtoMessage() {}
+
+func (x *GetMFAStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_mfa_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetMFAStatusResponse) GetTotpEnabled() bool {
+	if x != nil {
+		return x.xxx_hidden_TotpEnabled
+	}
+	return false
+}
+
+func (x *GetMFAStatusResponse) GetRecoveryCodesRemaining() int32 {
+	if x != nil {
+		return x.xxx_hidden_RecoveryCodesRemaining
+	}
+	return 0
+}
+
+func (x *GetMFAStatusResponse) SetTotpEnabled(v bool) {
+	x.xxx_hidden_TotpEnabled = v
+}
+
+func (x *GetMFAStatusResponse) SetRecoveryCodesRemaining(v int32) {
+	x.xxx_hidden_RecoveryCodesRemaining = v
+}
+
+type GetMFAStatusResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	TotpEnabled            bool
+	RecoveryCodesRemaining int32
+}
+
+func (b0 GetMFAStatusResponse_builder) Build() *GetMFAStatusResponse {
+	m0 := &GetMFAStatusResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_TotpEnabled = b.TotpEnabled
+	x.xxx_hidden_RecoveryCodesRemaining = b.RecoveryCodesRemaining
+	return m0
+}
+
+type EnrollTOTPRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTOTPRequest) Reset() {
+	*x = EnrollTOTPRequest{}
+	mi := &file_guardian_v1_mfa_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPRequest) ProtoMessage() {}
+
+func (x *EnrollTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_mfa_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type EnrollTOTPRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 EnrollTOTPRequest_builder) Build() *EnrollTOTPRequest {
+	m0 := &EnrollTOTPRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type EnrollTOTPResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Secret string                 `protobuf:"bytes,1,opt,name=secret,proto3"`
+	xxx_hidden_Uri    string                 `protobuf:"bytes,2,opt,name=uri,proto3"`
+	xxx_hidden_QrCode []byte                 `protobuf:"bytes,3,opt,name=qr_code,json=qrCode,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EnrollTOTPResponse) Reset() {
+	*x = EnrollTOTPResponse{}
+	mi := &file_guardian_v1_mfa_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPResponse) ProtoMessage() {}
+
+func (x *EnrollTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_mfa_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *EnrollTOTPResponse) GetSecret() string {
+	if x != nil {
+		return x.xxx_hidden_Secret
+	}
+	return ""
+}
+
+func (x *EnrollTOTPResponse) GetUri() string {
+	if x != nil {
+		return x.xxx_hidden_Uri
+	}
+	return ""
+}
+
+func (x *EnrollTOTPResponse) GetQrCode() []byte {
+	if x != nil {
+		return x.xxx_hidden_QrCode
+	}
+	return nil
+}
+
+func (x *EnrollTOTPResponse) SetSecret(v string) {
+	x.xxx_hidden_Secret = v
+}
+
+func (x *EnrollTOTPResponse) SetUri(v string) {
+	x.xxx_hidden_Uri = v
+}
+
+func (x *EnrollTOTPResponse) SetQrCode(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_QrCode = v
+}
+
+type EnrollTOTPResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Base32 encoded secret for manual entry.
+	Secret string
+	// The otpauth:// URI of the secret.
+	Uri string
+	// PNG encoded QR code of uri.
+	QrCode []byte
+}
+
+func (b0 EnrollTOTPResponse_builder) Build() *EnrollTOTPResponse {
+	m0 := &EnrollTOTPResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Secret = b.Secret
+	x.xxx_hidden_Uri = b.Uri
+	x.xxx_hidden_QrCode = b.QrCode
+	return m0
+}
+
+type ConfirmTOTPRequest struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Code string                 `protobuf:"bytes,1,opt,name=code,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPRequest) Reset() {
+	*x = ConfirmTOTPRequest{}
+	mi := &file_guardian_v1_mfa_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPRequest) ProtoMessage() {}
+
+func (x *ConfirmTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_mfa_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ConfirmTOTPRequest) GetCode() string {
+	if x != nil {
+		return x.xxx_hidden_Code
+	}
+	return ""
+}
+
+func (x *ConfirmTOTPRequest) SetCode(v string) {
+	x.xxx_hidden_Code = v
+}
+
+type ConfirmTOTPRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Code string
+}
+
+func (b0 ConfirmTOTPRequest_builder) Build() *ConfirmTOTPRequest {
+	m0 := &ConfirmTOTPRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Code = b.Code
+	return m0
+}
+
+type ConfirmTOTPResponse struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPResponse) Reset() {
+	*x = ConfirmTOTPResponse{}
+	mi := &file_guardian_v1_mfa_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPResponse) ProtoMessage() {}
+
+func (x *ConfirmTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_mfa_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ConfirmTOTPResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.xxx_hidden_RecoveryCodes
+	}
+	return nil
+}
+
+func (x *ConfirmTOTPResponse) SetRecoveryCodes(v []string) {
+	x.xxx_hidden_RecoveryCodes = v
+}
+
+type ConfirmTOTPResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Shown only once, only hashes are stored.
+	RecoveryCodes []string
+}
+
+func (b0 ConfirmTOTPResponse_builder) Build() *ConfirmTOTPResponse {
+	m0 := &ConfirmTOTPResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_RecoveryCodes = b.RecoveryCodes
+	return m0
+}
+
+type DisableTOTPRequest struct {
+	state           protoimpl.MessageState    `protogen:"opaque.v1"`
+	xxx_hidden_Code isDisableTOTPRequest_Code `protobuf_oneof:"code"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DisableTOTPRequest) Reset() {
+	*x = DisableTOTPRequest{}
+	mi := &file_guardian_v1_mfa_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTOTPRequest) ProtoMessage() {}
+
+func (x *DisableTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_mfa_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DisableTOTPRequest) GetTotpCode() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Code.(*disableTOTPRequest_TotpCode); ok {
+			return x.TotpCode
+		}
+	}
+	return ""
+}
+
+func (x *DisableTOTPRequest) GetRecoveryCode() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Code.(*disableTOTPRequest_RecoveryCode); ok {
+			return x.RecoveryCode
+		}
+	}
+	return ""
+}
+
+func (x *DisableTOTPRequest) SetTotpCode(v string) {
+	x.xxx_hidden_Code = &disableTOTPRequest_TotpCode{v}
+}
+
+func (x *DisableTOTPRequest) SetRecoveryCode(v string) {
+	x.xxx_hidden_Code = &disableTOTPRequest_RecoveryCode{v}
+}
+
+func (x *DisableTOTPRequest) HasCode() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Code != nil
+}
+
+func (x *DisableTOTPRequest) HasTotpCode() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Code.(*disableTOTPRequest_TotpCode)
+	return ok
+}
+
+func (x *DisableTOTPRequest) HasRecoveryCode() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Code.(*disableTOTPRequest_RecoveryCode)
+	return ok
+}
+
+func (x *DisableTOTPRequest) ClearCode() {
+	x.xxx_hidden_Code = nil
+}
+
+func (x *DisableTOTPRequest) ClearTotpCode() {
+	if _, ok := x.xxx_hidden_Code.(*disableTOTPRequest_TotpCode); ok {
+		x.xxx_hidden_Code = nil
+	}
+}
+
+func (x *DisableTOTPRequest) ClearRecoveryCode() {
+	if _, ok := x.xxx_hidden_Code.(*disableTOTPRequest_RecoveryCode); ok {
+		x.xxx_hidden_Code = nil
+	}
+}
+
+const DisableTOTPRequest_Code_not_set_case case_DisableTOTPRequest_Code = 0
+const DisableTOTPRequest_TotpCode_case case_DisableTOTPRequest_Code = 1
+const DisableTOTPRequest_RecoveryCode_case case_DisableTOTPRequest_Code = 2
+
+func (x *DisableTOTPRequest) WhichCode() case_DisableTOTPRequest_Code {
+	if x == nil {
+		return DisableTOTPRequest_Code_not_set_case
+	}
+	switch x.xxx_hidden_Code.(type) {
+	case *disableTOTPRequest_TotpCode:
+		return DisableTOTPRequest_TotpCode_case
+	case *disableTOTPRequest_RecoveryCode:
+		return DisableTOTPRequest_RecoveryCode_case
+	default:
+		return DisableTOTPRequest_Code_not_set_case
+	}
+}
+
+type DisableTOTPRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Fields of oneof xxx_hidden_Code:
+	TotpCode     *string
+	RecoveryCode *string
+	// -- end of xxx_hidden_Code
+}
+
+func (b0 DisableTOTPRequest_builder) Build() *DisableTOTPRequest {
+	m0 := &DisableTOTPRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.TotpCode != nil {
+		x.xxx_hidden_Code = &disableTOTPRequest_TotpCode{*b.TotpCode}
+	}
+	if b.RecoveryCode != nil {
+		x.xxx_hidden_Code = &disableTOTPRequest_RecoveryCode{*b.RecoveryCode}
+	}
+	return m0
+}
+
+type case_DisableTOTPRequest_Code protoreflect.FieldNumber
+
+func (x case_DisableTOTPRequest_Code) String() string {
+	md := file_guardian_v1_mfa_proto_msgTypes[6].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isDisableTOTPRequest_Code interface {
+	isDisableTOTPRequest_Code()
+}
+
+type disableTOTPRequest_TotpCode struct {
+	TotpCode string `protobuf:"bytes,1,opt,name=totp_code,json=totpCode,proto3,oneof"`
+}
+
+type disableTOTPRequest_RecoveryCode struct {
+	RecoveryCode string `protobuf:"bytes,2,opt,name=recovery_code,json=recoveryCode,proto3,oneof"`
+}
+
+func (*disableTOTPRequest_TotpCode) isDisableTOTPRequest_Code() {}
+
+func (*disableTOTPRequest_RecoveryCode) isDisableTOTPRequest_Code() {}
+
+type DisableTOTPResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTOTPResponse) Reset() {
+	*x = DisableTOTPResponse{}
+	mi := &file_guardian_v1_mfa_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTOTPResponse) ProtoMessage() {}
+
+func (x *DisableTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_mfa_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DisableTOTPResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DisableTOTPResponse_builder) Build() *DisableTOTPResponse {
+	m0 := &DisableTOTPResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type RegenerateRecoveryCodesRequest struct {
+	state           protoimpl.MessageState                `protogen:"opaque.v1"`
+	xxx_hidden_Code isRegenerateRecoveryCodesRequest_Code `protobuf_oneof:"code"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RegenerateRecoveryCodesRequest) Reset() {
+	*x = RegenerateRecoveryCodesRequest{}
+	mi := &file_guardian_v1_mfa_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateRecoveryCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateRecoveryCodesRequest) ProtoMessage() {}
+
+func (x *RegenerateRecoveryCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_mfa_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RegenerateRecoveryCodesRequest) GetTotpCode() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Code.(*regenerateRecoveryCodesRequest_TotpCode); ok {
+			return x.TotpCode
+		}
+	}
+	return ""
+}
+
+func (x *RegenerateRecoveryCodesRequest) GetRecoveryCode() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Code.(*regenerateRecoveryCodesRequest_RecoveryCode); ok {
+			return x.RecoveryCode
+		}
+	}
+	return ""
+}
+
+func (x *RegenerateRecoveryCodesRequest) SetTotpCode(v string) {
+	x.xxx_hidden_Code = &regenerateRecoveryCodesRequest_TotpCode{v}
+}
+
+func (x *RegenerateRecoveryCodesRequest) SetRecoveryCode(v string) {
+	x.xxx_hidden_Code = &regenerateRecoveryCodesRequest_RecoveryCode{v}
+}
+
+func (x *RegenerateRecoveryCodesRequest) HasCode() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Code != nil
+}
+
+func (x *RegenerateRecoveryCodesRequest) HasTotpCode() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Code.(*regenerateRecoveryCodesRequest_TotpCode)
+	return ok
+}
+
+func (x *RegenerateRecoveryCodesRequest) HasRecoveryCode() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Code.(*regenerateRecoveryCodesRequest_RecoveryCode)
+	return ok
+}
+
+func (x *RegenerateRecoveryCodesRequest) ClearCode() {
+	x.xxx_hidden_Code = nil
+}
+
+func (x *RegenerateRecoveryCodesRequest) ClearTotpCode() {
+	if _, ok := x.xxx_hidden_Code.(*regenerateRecoveryCodesRequest_TotpCode); ok {
+		x.xxx_hidden_Code = nil
+	}
+}
+
+func (x *RegenerateRecoveryCodesRequest) ClearRecoveryCode() {
+	if _, ok := x.xxx_hidden_Code.(*regenerateRecoveryCodesRequest_RecoveryCode); ok {
+		x.xxx_hidden_Code = nil
+	}
+}
+
+const RegenerateRecoveryCodesRequest_Code_not_set_case case_RegenerateRecoveryCodesRequest_Code = 0
+const RegenerateRecoveryCodesRequest_TotpCode_case case_RegenerateRecoveryCodesRequest_Code = 1
+const RegenerateRecoveryCodesRequest_RecoveryCode_case case_RegenerateRecoveryCodesRequest_Code = 2
+
+func (x *RegenerateRecoveryCodesRequest) WhichCode() case_RegenerateRecoveryCodesRequest_Code {
+	if x == nil {
+		return RegenerateRecoveryCodesRequest_Code_not_set_case
+	}
+	switch x.xxx_hidden_Code.(type) {
+	case *regenerateRecoveryCodesRequest_TotpCode:
+		return RegenerateRecoveryCodesRequest_TotpCode_case
+	case *regenerateRecoveryCodesRequest_RecoveryCode:
+		return RegenerateRecoveryCodesRequest_RecoveryCode_case
+	default:
+		return RegenerateRecoveryCodesRequest_Code_not_set_case
+	}
+}
+
+type RegenerateRecoveryCodesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Fields of oneof xxx_hidden_Code:
+	TotpCode     *string
+	RecoveryCode *string
+	// -- end of xxx_hidden_Code
+}
+
+func (b0 RegenerateRecoveryCodesRequest_builder) Build() *RegenerateRecoveryCodesRequest {
+	m0 := &RegenerateRecoveryCodesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.TotpCode != nil {
+		x.xxx_hidden_Code = &regenerateRecoveryCodesRequest_TotpCode{*b.TotpCode}
+	}
+	if b.RecoveryCode != nil {
+		x.xxx_hidden_Code = &regenerateRecoveryCodesRequest_RecoveryCode{*b.RecoveryCode}
+	}
+	return m0
+}
+
+type case_RegenerateRecoveryCodesRequest_Code protoreflect.FieldNumber
+
+func (x case_RegenerateRecoveryCodesRequest_Code) String() string {
+	md := file_guardian_v1_mfa_proto_msgTypes[8].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isRegenerateRecoveryCodesRequest_Code interface {
+	isRegenerateRecoveryCodesRequest_Code()
+}
+
+type regenerateRecoveryCodesRequest_TotpCode struct {
+	TotpCode string `protobuf:"bytes,1,opt,name=totp_code,json=totpCode,proto3,oneof"`
+}
+
+type regenerateRecoveryCodesRequest_RecoveryCode struct {
+	RecoveryCode string `protobuf:"bytes,2,opt,name=recovery_code,json=recoveryCode,proto3,oneof"`
+}
+
+func (*regenerateRecoveryCodesRequest_TotpCode) isRegenerateRecoveryCodesRequest_Code() {}
+
+func (*regenerateRecoveryCodesRequest_RecoveryCode) isRegenerateRecoveryCodesRequest_Code() {}
+
+type RegenerateRecoveryCodesResponse struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *RegenerateRecoveryCodesResponse) Reset() {
+	*x = RegenerateRecoveryCodesResponse{}
+	mi := &file_guardian_v1_mfa_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateRecoveryCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateRecoveryCodesResponse) ProtoMessage() {}
+
+func (x *RegenerateRecoveryCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_mfa_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RegenerateRecoveryCodesResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.xxx_hidden_RecoveryCodes
+	}
+	return nil
+}
+
+func (x *RegenerateRecoveryCodesResponse) SetRecoveryCodes(v []string) {
+	x.xxx_hidden_RecoveryCodes = v
+}
+
+type RegenerateRecoveryCodesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Shown only once, only hashes are stored.
+	RecoveryCodes []string
+}
+
+func (b0 RegenerateRecoveryCodesResponse_builder) Build() *RegenerateRecoveryCodesResponse {
+	m0 := &RegenerateRecoveryCodesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_RecoveryCodes = b.RecoveryCodes
+	return m0
+}
+
+var File_guardian_v1_mfa_proto protoreflect.FileDescriptor
+
+const file_guardian_v1_mfa_proto_rawDesc = "" +
+	"\n" +
+	"\x15guardian/v1/mfa.proto\x12\vguardian.v1\"\x15\n" +
+	"\x13GetMFAStatusRequest\"s\n" +
+	"\x14GetMFAStatusResponse\x12!\n" +
+	"\ftotp_enabled\x18\x01 \x01(\bR\vtotpEnabled\x128\n" +
+	"\x18recovery_codes_remaining\x18\x02 \x01(\x05R\x16recoveryCodesRemaining\"\x13\n" +
+	"\x11EnrollTOTPRequest\"W\n" +
+	"\x12EnrollTOTPResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x10\n" +
+	"\x03uri\x18\x02 \x01(\tR\x03uri\x12\x17\n" +
+	"\aqr_code\x18\x03 \x01(\fR\x06qrCode\"(\n" +
+	"\x12ConfirmTOTPRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"<\n" +
+	"\x13ConfirmTOTPResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"b\n" +
+	"\x12DisableTOTPRequest\x12\x1d\n" +
+	"\ttotp_code\x18\x01 \x01(\tH\x00R\btotpCode\x12%\n" +
+	"\rrecovery_code\x18\x02 \x01(\tH\x00R\frecoveryCodeB\x06\n" +
+	"\x04code\"\x15\n" +
+	"\x13DisableTOTPResponse\"n\n" +
+	"\x1eRegenerateRecoveryCodesRequest\x12\x1d\n" +
+	"\ttotp_code\x18\x01 \x01(\tH\x00R\btotpCode\x12%\n" +
+	"\rrecovery_code\x18\x02 \x01(\tH\x00R\frecoveryCodeB\x06\n" +
+	"\x04code\"H\n" +
+	"\x1fRegenerateRecoveryCodesResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes2\xca\x03\n" +
+	"\n" +
+	"MFAService\x12S\n" +
+	"\fGetMFAStatus\x12 .guardian.v1.GetMFAStatusRequest\x1a!.guardian.v1.GetMFAStatusResponse\x12M\n" +
+	"\n" +
+	"EnrollTOTP\x12\x1e.guardian.v1.EnrollTOTPRequest\x1a\x1f.guardian.v1.EnrollTOTPResponse\x12P\n" +
+	"\vConfirmTOTP\x12\x1f.guardian.v1.ConfirmTOTPRequest\x1a .guardian.v1.ConfirmTOTPResponse\x12P\n" +
+	"\vDisableTOTP\x12\x1f.guardian.v1.DisableTOTPRequest\x1a .guardian.v1.DisableTOTPResponse\x12t\n" +
+	"\x17RegenerateRecoveryCodes\x12+.guardian.v1.RegenerateRecoveryCodesRequest\x1a,.guardian.v1.RegenerateRecoveryCodesResponseB\xa7\x01\n" +
+	"\x0fcom.guardian.v1B\bMfaProtoP\x01Z=github.com/gophero/guardian/core/proto/guardian/v1;guardianv1\xa2\x02\x03GVX\xaa\x02\vGuardian.V1\xca\x02\vGuardian\\V1\xe2\x02\x17Guardian\\V1\\GPBMetadata\xea\x02\fGuardian::V1b\x06proto3"
+
+var file_guardian_v1_mfa_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_guardian_v1_mfa_proto_goTypes = []any{
+	(*GetMFAStatusRequest)(nil),             // 0: guardian.v1.GetMFAStatusRequest
+	(*GetMFAStatusResponse)(nil),            // 1: guardian.v1.GetMFAStatusResponse
+	(*EnrollTOTPRequest)(nil),               // 2: guardian.v1.EnrollTOTPRequest
+	(*EnrollTOTPResponse)(nil),              // 3: guardian.v1.EnrollTOTPResponse
+	(*ConfirmTOTPRequest)(nil),              // 4: guardian.v1.ConfirmTOTPRequest
+	(*ConfirmTOTPResponse)(nil),             // 5: guardian.v1.ConfirmTOTPResponse
+	(*DisableTOTPRequest)(nil),              // 6: guardian.v1.DisableTOTPRequest
+	(*DisableTOTPResponse)(nil),             // 7: guardian.v1.DisableTOTPResponse
+	(*RegenerateRecoveryCodesRequest)(nil),  // 8: guardian.v1.RegenerateRecoveryCodesRequest
+	(*RegenerateRecoveryCodesResponse)(nil), // 9: guardian.v1.RegenerateRecoveryCodesResponse
+}
+var file_guardian_v1_mfa_proto_depIdxs = []int32{
+	0, // 0: guardian.v1.MFAService.GetMFAStatus:input_type -> guardian.v1.GetMFAStatusRequest
+	2, // 1: guardian.v1.MFAService.EnrollTOTP:input_type -> guardian.v1.EnrollTOTPRequest
+	4, // 2: guardian.v1.MFAService.ConfirmTOTP:input_type -> guardian.v1.ConfirmTOTPRequest
+	6, // 3: guardian.v1.MFAService.DisableTOTP:input_type -> guardian.v1.DisableTOTPRequest
+	8, // 4: guardian.v1.MFAService.RegenerateRecoveryCodes:input_type -> guardian.v1.RegenerateRecoveryCodesRequest
+	1, // 5: guardian.v1.MFAService.GetMFAStatus:output_type -> guardian.v1.GetMFAStatusResponse
+	3, // 6: guardian.v1.MFAService.EnrollTOTP:output_type -> guardian.v1.EnrollTOTPResponse
+	5, // 7: guardian.v1.MFAService.ConfirmTOTP:output_type -> guardian.v1.ConfirmTOTPResponse
+	7, // 8: guardian.v1.MFAService.DisableTOTP:output_type -> guardian.v1.DisableTOTPResponse
+	9, // 9: guardian.v1.MFAService.RegenerateRecoveryCodes:output_type -> guardian.v1.RegenerateRecoveryCodesResponse
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_guardian_v1_mfa_proto_init() }
+func file_guardian_v1_mfa_proto_init() {
+	if File_guardian_v1_mfa_proto != nil {
+		return
+	}
+	file_guardian_v1_mfa_proto_msgTypes[6].OneofWrappers = []any{
+		(*disableTOTPRequest_TotpCode)(nil),
+		(*disableTOTPRequest_RecoveryCode)(nil),
+	}
+	file_guardian_v1_mfa_proto_msgTypes[8].OneofWrappers = []any{
+		(*regenerateRecoveryCodesRequest_TotpCode)(nil),
+		(*regenerateRecoveryCodesRequest_RecoveryCode)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guardian_v1_mfa_proto_rawDesc), len(file_guardian_v1_mfa_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_guardian_v1_mfa_proto_goTypes,
+		DependencyIndexes: file_guardian_v1_mfa_proto_depIdxs,
+		MessageInfos:      file_guardian_v1_mfa_proto_msgTypes,
+	}.Build()
+	File_guardian_v1_mfa_proto = out.File
+	file_guardian_v1_mfa_proto_goTypes = nil
+	file_guardian_v1_mfa_proto_depIdxs = nil
+}
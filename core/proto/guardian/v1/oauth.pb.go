@@ -0,0 +1,2216 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: guardian/v1/oauth.proto
+
+package guardianv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OAuthClientType int32
+
+const (
+	OAuthClientType_O_AUTH_CLIENT_TYPE_UNSPECIFIED OAuthClientType = 0
+	// Clients which cannot keep a secret, like single page and native apps. They are authenticated by PKCE only.
+	OAuthClientType_O_AUTH_CLIENT_TYPE_PUBLIC OAuthClientType = 1
+	// Clients which authenticate with their secret at the token endpoint.
+	OAuthClientType_O_AUTH_CLIENT_TYPE_CONFIDENTIAL OAuthClientType = 2
+)
+
+// Enum value maps for OAuthClientType.
+var (
+	OAuthClientType_name = map[int32]string{
+		0: "O_AUTH_CLIENT_TYPE_UNSPECIFIED",
+		1: "O_AUTH_CLIENT_TYPE_PUBLIC",
+		2: "O_AUTH_CLIENT_TYPE_CONFIDENTIAL",
+	}
+	OAuthClientType_value = map[string]int32{
+		"O_AUTH_CLIENT_TYPE_UNSPECIFIED":  0,
+		"O_AUTH_CLIENT_TYPE_PUBLIC":       1,
+		"O_AUTH_CLIENT_TYPE_CONFIDENTIAL": 2,
+	}
+)
+
+func (x OAuthClientType) Enum() *OAuthClientType {
+	p := new(OAuthClientType)
+	*p = x
+	return p
+}
+
+func (x OAuthClientType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OAuthClientType) Descriptor() protoreflect.EnumDescriptor {
+	return file_guardian_v1_oauth_proto_enumTypes[0].Descriptor()
+}
+
+func (OAuthClientType) Type() protoreflect.EnumType {
+	return &file_guardian_v1_oauth_proto_enumTypes[0]
+}
+
+func (x OAuthClientType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// OAuthClient is an application registered to obtain tokens on behalf of users.
+type OAuthClient struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id           string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Name         string                 `protobuf:"bytes,2,opt,name=name,proto3"`
+	xxx_hidden_Type         OAuthClientType        `protobuf:"varint,3,opt,name=type,proto3,enum=guardian.v1.OAuthClientType"`
+	xxx_hidden_RedirectUris []string               `protobuf:"bytes,4,rep,name=redirect_uris,json=redirectUris,proto3"`
+	xxx_hidden_GrantTypes   []string               `protobuf:"bytes,5,rep,name=grant_types,json=grantTypes,proto3"`
+	xxx_hidden_Scopes       []string               `protobuf:"bytes,6,rep,name=scopes,proto3"`
+	xxx_hidden_CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3"`
+	xxx_hidden_UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3"`
+	xxx_hidden_Audiences    []string               `protobuf:"bytes,9,rep,name=audiences,proto3"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *OAuthClient) Reset() {
+	*x = OAuthClient{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthClient) ProtoMessage() {}
+
+func (x *OAuthClient) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *OAuthClient) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *OAuthClient) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *OAuthClient) GetType() OAuthClientType {
+	if x != nil {
+		return x.xxx_hidden_Type
+	}
+	return OAuthClientType_O_AUTH_CLIENT_TYPE_UNSPECIFIED
+}
+
+func (x *OAuthClient) GetRedirectUris() []string {
+	if x != nil {
+		return x.xxx_hidden_RedirectUris
+	}
+	return nil
+}
+
+func (x *OAuthClient) GetGrantTypes() []string {
+	if x != nil {
+		return x.xxx_hidden_GrantTypes
+	}
+	return nil
+}
+
+func (x *OAuthClient) GetScopes() []string {
+	if x != nil {
+		return x.xxx_hidden_Scopes
+	}
+	return nil
+}
+
+func (x *OAuthClient) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *OAuthClient) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
+	}
+	return nil
+}
+
+func (x *OAuthClient) GetAudiences() []string {
+	if x != nil {
+		return x.xxx_hidden_Audiences
+	}
+	return nil
+}
+
+func (x *OAuthClient) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *OAuthClient) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *OAuthClient) SetType(v OAuthClientType) {
+	x.xxx_hidden_Type = v
+}
+
+func (x *OAuthClient) SetRedirectUris(v []string) {
+	x.xxx_hidden_RedirectUris = v
+}
+
+func (x *OAuthClient) SetGrantTypes(v []string) {
+	x.xxx_hidden_GrantTypes = v
+}
+
+func (x *OAuthClient) SetScopes(v []string) {
+	x.xxx_hidden_Scopes = v
+}
+
+func (x *OAuthClient) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *OAuthClient) SetUpdatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *OAuthClient) SetAudiences(v []string) {
+	x.xxx_hidden_Audiences = v
+}
+
+func (x *OAuthClient) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *OAuthClient) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *OAuthClient) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *OAuthClient) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+type OAuthClient_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The client_id of the client.
+	Id   string
+	Name string
+	Type OAuthClientType
+	// Redirect URIs the client may use, compared by exact string match. Plain http is only allowed on loopback
+	// interfaces and custom schemes have to be reverse domain names.
+	RedirectUris []string
+	// Grant types the client may use, `authorization_code`, `refresh_token` and
+	// `urn:ietf:params:oauth:grant-type:device_code`. Clients of the authorization code grant need a redirect URI.
+	GrantTypes []string
+	// Scopes the client may request, sorted by name.
+	Scopes    []string
+	CreatedAt *timestamppb.Timestamp
+	UpdatedAt *timestamppb.Timestamp
+	// Audiences of the resource servers the client acts as, sorted by name. The client may introspect access tokens
+	// issued for any of them.
+	Audiences []string
+}
+
+func (b0 OAuthClient_builder) Build() *OAuthClient {
+	m0 := &OAuthClient{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_Type = b.Type
+	x.xxx_hidden_RedirectUris = b.RedirectUris
+	x.xxx_hidden_GrantTypes = b.GrantTypes
+	x.xxx_hidden_Scopes = b.Scopes
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	x.xxx_hidden_Audiences = b.Audiences
+	return m0
+}
+
+// PendingAuthorization is an authorization request waiting for the user to consent.
+type PendingAuthorization struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_ClientId    string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3"`
+	xxx_hidden_ClientName  string                 `protobuf:"bytes,3,opt,name=client_name,json=clientName,proto3"`
+	xxx_hidden_RedirectUri string                 `protobuf:"bytes,4,opt,name=redirect_uri,json=redirectUri,proto3"`
+	xxx_hidden_Scopes      []string               `protobuf:"bytes,5,rep,name=scopes,proto3"`
+	xxx_hidden_ExpiresAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3"`
+	xxx_hidden_Prompt      []string               `protobuf:"bytes,7,rep,name=prompt,proto3"`
+	xxx_hidden_MaxAge      *durationpb.Duration   `protobuf:"bytes,8,opt,name=max_age,json=maxAge,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *PendingAuthorization) Reset() {
+	*x = PendingAuthorization{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingAuthorization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingAuthorization) ProtoMessage() {}
+
+func (x *PendingAuthorization) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PendingAuthorization) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *PendingAuthorization) GetClientId() string {
+	if x != nil {
+		return x.xxx_hidden_ClientId
+	}
+	return ""
+}
+
+func (x *PendingAuthorization) GetClientName() string {
+	if x != nil {
+		return x.xxx_hidden_ClientName
+	}
+	return ""
+}
+
+func (x *PendingAuthorization) GetRedirectUri() string {
+	if x != nil {
+		return x.xxx_hidden_RedirectUri
+	}
+	return ""
+}
+
+func (x *PendingAuthorization) GetScopes() []string {
+	if x != nil {
+		return x.xxx_hidden_Scopes
+	}
+	return nil
+}
+
+func (x *PendingAuthorization) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ExpiresAt
+	}
+	return nil
+}
+
+func (x *PendingAuthorization) GetPrompt() []string {
+	if x != nil {
+		return x.xxx_hidden_Prompt
+	}
+	return nil
+}
+
+func (x *PendingAuthorization) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.xxx_hidden_MaxAge
+	}
+	return nil
+}
+
+func (x *PendingAuthorization) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *PendingAuthorization) SetClientId(v string) {
+	x.xxx_hidden_ClientId = v
+}
+
+func (x *PendingAuthorization) SetClientName(v string) {
+	x.xxx_hidden_ClientName = v
+}
+
+func (x *PendingAuthorization) SetRedirectUri(v string) {
+	x.xxx_hidden_RedirectUri = v
+}
+
+func (x *PendingAuthorization) SetScopes(v []string) {
+	x.xxx_hidden_Scopes = v
+}
+
+func (x *PendingAuthorization) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ExpiresAt = v
+}
+
+func (x *PendingAuthorization) SetPrompt(v []string) {
+	x.xxx_hidden_Prompt = v
+}
+
+func (x *PendingAuthorization) SetMaxAge(v *durationpb.Duration) {
+	x.xxx_hidden_MaxAge = v
+}
+
+func (x *PendingAuthorization) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExpiresAt != nil
+}
+
+func (x *PendingAuthorization) HasMaxAge() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MaxAge != nil
+}
+
+func (x *PendingAuthorization) ClearExpiresAt() {
+	x.xxx_hidden_ExpiresAt = nil
+}
+
+func (x *PendingAuthorization) ClearMaxAge() {
+	x.xxx_hidden_MaxAge = nil
+}
+
+type PendingAuthorization_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id          string
+	ClientId    string
+	ClientName  string
+	RedirectUri string
+	// Scopes requested by the client, sorted by name.
+	Scopes    []string
+	ExpiresAt *timestamppb.Timestamp
+	// OpenID Connect prompt values of the request, sorted by name. With `none` the request has to be answered without
+	// showing anything to the user, with `login` the user has to sign in again.
+	Prompt []string
+	// Maximum time since the user last signed in, unset if the client did not limit it.
+	MaxAge *durationpb.Duration
+}
+
+func (b0 PendingAuthorization_builder) Build() *PendingAuthorization {
+	m0 := &PendingAuthorization{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_ClientId = b.ClientId
+	x.xxx_hidden_ClientName = b.ClientName
+	x.xxx_hidden_RedirectUri = b.RedirectUri
+	x.xxx_hidden_Scopes = b.Scopes
+	x.xxx_hidden_ExpiresAt = b.ExpiresAt
+	x.xxx_hidden_Prompt = b.Prompt
+	x.xxx_hidden_MaxAge = b.MaxAge
+	return m0
+}
+
+// PendingDeviceAuthorization is a device authorization waiting for the user to consent.
+type PendingDeviceAuthorization struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UserCode   string                 `protobuf:"bytes,1,opt,name=user_code,json=userCode,proto3"`
+	xxx_hidden_ClientId   string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3"`
+	xxx_hidden_ClientName string                 `protobuf:"bytes,3,opt,name=client_name,json=clientName,proto3"`
+	xxx_hidden_Scopes     []string               `protobuf:"bytes,4,rep,name=scopes,proto3"`
+	xxx_hidden_ExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *PendingDeviceAuthorization) Reset() {
+	*x = PendingDeviceAuthorization{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingDeviceAuthorization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingDeviceAuthorization) ProtoMessage() {}
+
+func (x *PendingDeviceAuthorization) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PendingDeviceAuthorization) GetUserCode() string {
+	if x != nil {
+		return x.xxx_hidden_UserCode
+	}
+	return ""
+}
+
+func (x *PendingDeviceAuthorization) GetClientId() string {
+	if x != nil {
+		return x.xxx_hidden_ClientId
+	}
+	return ""
+}
+
+func (x *PendingDeviceAuthorization) GetClientName() string {
+	if x != nil {
+		return x.xxx_hidden_ClientName
+	}
+	return ""
+}
+
+func (x *PendingDeviceAuthorization) GetScopes() []string {
+	if x != nil {
+		return x.xxx_hidden_Scopes
+	}
+	return nil
+}
+
+func (x *PendingDeviceAuthorization) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ExpiresAt
+	}
+	return nil
+}
+
+func (x *PendingDeviceAuthorization) SetUserCode(v string) {
+	x.xxx_hidden_UserCode = v
+}
+
+func (x *PendingDeviceAuthorization) SetClientId(v string) {
+	x.xxx_hidden_ClientId = v
+}
+
+func (x *PendingDeviceAuthorization) SetClientName(v string) {
+	x.xxx_hidden_ClientName = v
+}
+
+func (x *PendingDeviceAuthorization) SetScopes(v []string) {
+	x.xxx_hidden_Scopes = v
+}
+
+func (x *PendingDeviceAuthorization) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ExpiresAt = v
+}
+
+func (x *PendingDeviceAuthorization) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExpiresAt != nil
+}
+
+func (x *PendingDeviceAuthorization) ClearExpiresAt() {
+	x.xxx_hidden_ExpiresAt = nil
+}
+
+type PendingDeviceAuthorization_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The user code formatted for display, like `BCDF-GHJK`.
+	UserCode   string
+	ClientId   string
+	ClientName string
+	// Scopes requested by the client, sorted by name.
+	Scopes    []string
+	ExpiresAt *timestamppb.Timestamp
+}
+
+func (b0 PendingDeviceAuthorization_builder) Build() *PendingDeviceAuthorization {
+	m0 := &PendingDeviceAuthorization{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_UserCode = b.UserCode
+	x.xxx_hidden_ClientId = b.ClientId
+	x.xxx_hidden_ClientName = b.ClientName
+	x.xxx_hidden_Scopes = b.Scopes
+	x.xxx_hidden_ExpiresAt = b.ExpiresAt
+	return m0
+}
+
+type CreateOAuthClientRequest struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name         string                 `protobuf:"bytes,1,opt,name=name,proto3"`
+	xxx_hidden_Type         OAuthClientType        `protobuf:"varint,2,opt,name=type,proto3,enum=guardian.v1.OAuthClientType"`
+	xxx_hidden_RedirectUris []string               `protobuf:"bytes,3,rep,name=redirect_uris,json=redirectUris,proto3"`
+	xxx_hidden_GrantTypes   []string               `protobuf:"bytes,4,rep,name=grant_types,json=grantTypes,proto3"`
+	xxx_hidden_Scopes       []string               `protobuf:"bytes,5,rep,name=scopes,proto3"`
+	xxx_hidden_Audiences    []string               `protobuf:"bytes,6,rep,name=audiences,proto3"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *CreateOAuthClientRequest) Reset() {
+	*x = CreateOAuthClientRequest{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOAuthClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOAuthClientRequest) ProtoMessage() {}
+
+func (x *CreateOAuthClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateOAuthClientRequest) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *CreateOAuthClientRequest) GetType() OAuthClientType {
+	if x != nil {
+		return x.xxx_hidden_Type
+	}
+	return OAuthClientType_O_AUTH_CLIENT_TYPE_UNSPECIFIED
+}
+
+func (x *CreateOAuthClientRequest) GetRedirectUris() []string {
+	if x != nil {
+		return x.xxx_hidden_RedirectUris
+	}
+	return nil
+}
+
+func (x *CreateOAuthClientRequest) GetGrantTypes() []string {
+	if x != nil {
+		return x.xxx_hidden_GrantTypes
+	}
+	return nil
+}
+
+func (x *CreateOAuthClientRequest) GetScopes() []string {
+	if x != nil {
+		return x.xxx_hidden_Scopes
+	}
+	return nil
+}
+
+func (x *CreateOAuthClientRequest) GetAudiences() []string {
+	if x != nil {
+		return x.xxx_hidden_Audiences
+	}
+	return nil
+}
+
+func (x *CreateOAuthClientRequest) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *CreateOAuthClientRequest) SetType(v OAuthClientType) {
+	x.xxx_hidden_Type = v
+}
+
+func (x *CreateOAuthClientRequest) SetRedirectUris(v []string) {
+	x.xxx_hidden_RedirectUris = v
+}
+
+func (x *CreateOAuthClientRequest) SetGrantTypes(v []string) {
+	x.xxx_hidden_GrantTypes = v
+}
+
+func (x *CreateOAuthClientRequest) SetScopes(v []string) {
+	x.xxx_hidden_Scopes = v
+}
+
+func (x *CreateOAuthClientRequest) SetAudiences(v []string) {
+	x.xxx_hidden_Audiences = v
+}
+
+type CreateOAuthClientRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name         string
+	Type         OAuthClientType
+	RedirectUris []string
+	GrantTypes   []string
+	Scopes       []string
+	Audiences    []string
+}
+
+func (b0 CreateOAuthClientRequest_builder) Build() *CreateOAuthClientRequest {
+	m0 := &CreateOAuthClientRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_Type = b.Type
+	x.xxx_hidden_RedirectUris = b.RedirectUris
+	x.xxx_hidden_GrantTypes = b.GrantTypes
+	x.xxx_hidden_Scopes = b.Scopes
+	x.xxx_hidden_Audiences = b.Audiences
+	return m0
+}
+
+type CreateOAuthClientResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Client       *OAuthClient           `protobuf:"bytes,1,opt,name=client,proto3"`
+	xxx_hidden_ClientSecret string                 `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *CreateOAuthClientResponse) Reset() {
+	*x = CreateOAuthClientResponse{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOAuthClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOAuthClientResponse) ProtoMessage() {}
+
+func (x *CreateOAuthClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateOAuthClientResponse) GetClient() *OAuthClient {
+	if x != nil {
+		return x.xxx_hidden_Client
+	}
+	return nil
+}
+
+func (x *CreateOAuthClientResponse) GetClientSecret() string {
+	if x != nil {
+		return x.xxx_hidden_ClientSecret
+	}
+	return ""
+}
+
+func (x *CreateOAuthClientResponse) SetClient(v *OAuthClient) {
+	x.xxx_hidden_Client = v
+}
+
+func (x *CreateOAuthClientResponse) SetClientSecret(v string) {
+	x.xxx_hidden_ClientSecret = v
+}
+
+func (x *CreateOAuthClientResponse) HasClient() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Client != nil
+}
+
+func (x *CreateOAuthClientResponse) ClearClient() {
+	x.xxx_hidden_Client = nil
+}
+
+type CreateOAuthClientResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Client *OAuthClient
+	// Empty for public clients.
+	ClientSecret string
+}
+
+func (b0 CreateOAuthClientResponse_builder) Build() *CreateOAuthClientResponse {
+	m0 := &CreateOAuthClientResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Client = b.Client
+	x.xxx_hidden_ClientSecret = b.ClientSecret
+	return m0
+}
+
+type GetOAuthClientRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOAuthClientRequest) Reset() {
+	*x = GetOAuthClientRequest{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOAuthClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOAuthClientRequest) ProtoMessage() {}
+
+func (x *GetOAuthClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetOAuthClientRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *GetOAuthClientRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type GetOAuthClientRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 GetOAuthClientRequest_builder) Build() *GetOAuthClientRequest {
+	m0 := &GetOAuthClientRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type GetOAuthClientResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Client *OAuthClient           `protobuf:"bytes,1,opt,name=client,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetOAuthClientResponse) Reset() {
+	*x = GetOAuthClientResponse{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOAuthClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOAuthClientResponse) ProtoMessage() {}
+
+func (x *GetOAuthClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetOAuthClientResponse) GetClient() *OAuthClient {
+	if x != nil {
+		return x.xxx_hidden_Client
+	}
+	return nil
+}
+
+func (x *GetOAuthClientResponse) SetClient(v *OAuthClient) {
+	x.xxx_hidden_Client = v
+}
+
+func (x *GetOAuthClientResponse) HasClient() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Client != nil
+}
+
+func (x *GetOAuthClientResponse) ClearClient() {
+	x.xxx_hidden_Client = nil
+}
+
+type GetOAuthClientResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Client *OAuthClient
+}
+
+func (b0 GetOAuthClientResponse_builder) Build() *GetOAuthClientResponse {
+	m0 := &GetOAuthClientResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Client = b.Client
+	return m0
+}
+
+type ListOAuthClientsRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOAuthClientsRequest) Reset() {
+	*x = ListOAuthClientsRequest{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOAuthClientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOAuthClientsRequest) ProtoMessage() {}
+
+func (x *ListOAuthClientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type ListOAuthClientsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 ListOAuthClientsRequest_builder) Build() *ListOAuthClientsRequest {
+	m0 := &ListOAuthClientsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type ListOAuthClientsResponse struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Clients *[]*OAuthClient        `protobuf:"bytes,1,rep,name=clients,proto3"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListOAuthClientsResponse) Reset() {
+	*x = ListOAuthClientsResponse{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOAuthClientsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOAuthClientsResponse) ProtoMessage() {}
+
+func (x *ListOAuthClientsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListOAuthClientsResponse) GetClients() []*OAuthClient {
+	if x != nil {
+		if x.xxx_hidden_Clients != nil {
+			return *x.xxx_hidden_Clients
+		}
+	}
+	return nil
+}
+
+func (x *ListOAuthClientsResponse) SetClients(v []*OAuthClient) {
+	x.xxx_hidden_Clients = &v
+}
+
+type ListOAuthClientsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Clients []*OAuthClient
+}
+
+func (b0 ListOAuthClientsResponse_builder) Build() *ListOAuthClientsResponse {
+	m0 := &ListOAuthClientsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Clients = &b.Clients
+	return m0
+}
+
+type UpdateOAuthClientRequest struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id           string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Name         string                 `protobuf:"bytes,2,opt,name=name,proto3"`
+	xxx_hidden_RedirectUris []string               `protobuf:"bytes,3,rep,name=redirect_uris,json=redirectUris,proto3"`
+	xxx_hidden_GrantTypes   []string               `protobuf:"bytes,4,rep,name=grant_types,json=grantTypes,proto3"`
+	xxx_hidden_Scopes       []string               `protobuf:"bytes,5,rep,name=scopes,proto3"`
+	xxx_hidden_Audiences    []string               `protobuf:"bytes,6,rep,name=audiences,proto3"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *UpdateOAuthClientRequest) Reset() {
+	*x = UpdateOAuthClientRequest{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOAuthClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOAuthClientRequest) ProtoMessage() {}
+
+func (x *UpdateOAuthClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateOAuthClientRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *UpdateOAuthClientRequest) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *UpdateOAuthClientRequest) GetRedirectUris() []string {
+	if x != nil {
+		return x.xxx_hidden_RedirectUris
+	}
+	return nil
+}
+
+func (x *UpdateOAuthClientRequest) GetGrantTypes() []string {
+	if x != nil {
+		return x.xxx_hidden_GrantTypes
+	}
+	return nil
+}
+
+func (x *UpdateOAuthClientRequest) GetScopes() []string {
+	if x != nil {
+		return x.xxx_hidden_Scopes
+	}
+	return nil
+}
+
+func (x *UpdateOAuthClientRequest) GetAudiences() []string {
+	if x != nil {
+		return x.xxx_hidden_Audiences
+	}
+	return nil
+}
+
+func (x *UpdateOAuthClientRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *UpdateOAuthClientRequest) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *UpdateOAuthClientRequest) SetRedirectUris(v []string) {
+	x.xxx_hidden_RedirectUris = v
+}
+
+func (x *UpdateOAuthClientRequest) SetGrantTypes(v []string) {
+	x.xxx_hidden_GrantTypes = v
+}
+
+func (x *UpdateOAuthClientRequest) SetScopes(v []string) {
+	x.xxx_hidden_Scopes = v
+}
+
+func (x *UpdateOAuthClientRequest) SetAudiences(v []string) {
+	x.xxx_hidden_Audiences = v
+}
+
+type UpdateOAuthClientRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id           string
+	Name         string
+	RedirectUris []string
+	GrantTypes   []string
+	Scopes       []string
+	Audiences    []string
+}
+
+func (b0 UpdateOAuthClientRequest_builder) Build() *UpdateOAuthClientRequest {
+	m0 := &UpdateOAuthClientRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_RedirectUris = b.RedirectUris
+	x.xxx_hidden_GrantTypes = b.GrantTypes
+	x.xxx_hidden_Scopes = b.Scopes
+	x.xxx_hidden_Audiences = b.Audiences
+	return m0
+}
+
+type UpdateOAuthClientResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Client *OAuthClient           `protobuf:"bytes,1,opt,name=client,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateOAuthClientResponse) Reset() {
+	*x = UpdateOAuthClientResponse{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOAuthClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOAuthClientResponse) ProtoMessage() {}
+
+func (x *UpdateOAuthClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateOAuthClientResponse) GetClient() *OAuthClient {
+	if x != nil {
+		return x.xxx_hidden_Client
+	}
+	return nil
+}
+
+func (x *UpdateOAuthClientResponse) SetClient(v *OAuthClient) {
+	x.xxx_hidden_Client = v
+}
+
+func (x *UpdateOAuthClientResponse) HasClient() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Client != nil
+}
+
+func (x *UpdateOAuthClientResponse) ClearClient() {
+	x.xxx_hidden_Client = nil
+}
+
+type UpdateOAuthClientResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Client *OAuthClient
+}
+
+func (b0 UpdateOAuthClientResponse_builder) Build() *UpdateOAuthClientResponse {
+	m0 := &UpdateOAuthClientResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Client = b.Client
+	return m0
+}
+
+type DeleteOAuthClientRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOAuthClientRequest) Reset() {
+	*x = DeleteOAuthClientRequest{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOAuthClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOAuthClientRequest) ProtoMessage() {}
+
+func (x *DeleteOAuthClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteOAuthClientRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *DeleteOAuthClientRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type DeleteOAuthClientRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 DeleteOAuthClientRequest_builder) Build() *DeleteOAuthClientRequest {
+	m0 := &DeleteOAuthClientRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type DeleteOAuthClientResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOAuthClientResponse) Reset() {
+	*x = DeleteOAuthClientResponse{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOAuthClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOAuthClientResponse) ProtoMessage() {}
+
+func (x *DeleteOAuthClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeleteOAuthClientResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeleteOAuthClientResponse_builder) Build() *DeleteOAuthClientResponse {
+	m0 := &DeleteOAuthClientResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GetAuthorizationRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuthorizationRequest) Reset() {
+	*x = GetAuthorizationRequest{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthorizationRequest) ProtoMessage() {}
+
+func (x *GetAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetAuthorizationRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *GetAuthorizationRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type GetAuthorizationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 GetAuthorizationRequest_builder) Build() *GetAuthorizationRequest {
+	m0 := &GetAuthorizationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type GetAuthorizationResponse struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Authorization *PendingAuthorization  `protobuf:"bytes,1,opt,name=authorization,proto3"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetAuthorizationResponse) Reset() {
+	*x = GetAuthorizationResponse{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuthorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthorizationResponse) ProtoMessage() {}
+
+func (x *GetAuthorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetAuthorizationResponse) GetAuthorization() *PendingAuthorization {
+	if x != nil {
+		return x.xxx_hidden_Authorization
+	}
+	return nil
+}
+
+func (x *GetAuthorizationResponse) SetAuthorization(v *PendingAuthorization) {
+	x.xxx_hidden_Authorization = v
+}
+
+func (x *GetAuthorizationResponse) HasAuthorization() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Authorization != nil
+}
+
+func (x *GetAuthorizationResponse) ClearAuthorization() {
+	x.xxx_hidden_Authorization = nil
+}
+
+type GetAuthorizationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Authorization *PendingAuthorization
+}
+
+func (b0 GetAuthorizationResponse_builder) Build() *GetAuthorizationResponse {
+	m0 := &GetAuthorizationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Authorization = b.Authorization
+	return m0
+}
+
+type ApproveAuthorizationRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveAuthorizationRequest) Reset() {
+	*x = ApproveAuthorizationRequest{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveAuthorizationRequest) ProtoMessage() {}
+
+func (x *ApproveAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ApproveAuthorizationRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *ApproveAuthorizationRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type ApproveAuthorizationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 ApproveAuthorizationRequest_builder) Build() *ApproveAuthorizationRequest {
+	m0 := &ApproveAuthorizationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type ApproveAuthorizationResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_RedirectUrl string                 `protobuf:"bytes,1,opt,name=redirect_url,json=redirectUrl,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ApproveAuthorizationResponse) Reset() {
+	*x = ApproveAuthorizationResponse{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveAuthorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveAuthorizationResponse) ProtoMessage() {}
+
+func (x *ApproveAuthorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ApproveAuthorizationResponse) GetRedirectUrl() string {
+	if x != nil {
+		return x.xxx_hidden_RedirectUrl
+	}
+	return ""
+}
+
+func (x *ApproveAuthorizationResponse) SetRedirectUrl(v string) {
+	x.xxx_hidden_RedirectUrl = v
+}
+
+type ApproveAuthorizationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The redirect URI of the client with the authorization code and state.
+	RedirectUrl string
+}
+
+func (b0 ApproveAuthorizationResponse_builder) Build() *ApproveAuthorizationResponse {
+	m0 := &ApproveAuthorizationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_RedirectUrl = b.RedirectUrl
+	return m0
+}
+
+type DenyAuthorizationRequest struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id    string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Error string                 `protobuf:"bytes,2,opt,name=error,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DenyAuthorizationRequest) Reset() {
+	*x = DenyAuthorizationRequest{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DenyAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenyAuthorizationRequest) ProtoMessage() {}
+
+func (x *DenyAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DenyAuthorizationRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *DenyAuthorizationRequest) GetError() string {
+	if x != nil {
+		return x.xxx_hidden_Error
+	}
+	return ""
+}
+
+func (x *DenyAuthorizationRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *DenyAuthorizationRequest) SetError(v string) {
+	x.xxx_hidden_Error = v
+}
+
+type DenyAuthorizationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+	// Error sent to the client, `access_denied` if empty. Requests which cannot be answered without interacting with the
+	// user are denied with `login_required`, `consent_required`, `interaction_required` or `account_selection_required`.
+	Error string
+}
+
+func (b0 DenyAuthorizationRequest_builder) Build() *DenyAuthorizationRequest {
+	m0 := &DenyAuthorizationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_Error = b.Error
+	return m0
+}
+
+type DenyAuthorizationResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_RedirectUrl string                 `protobuf:"bytes,1,opt,name=redirect_url,json=redirectUrl,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DenyAuthorizationResponse) Reset() {
+	*x = DenyAuthorizationResponse{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DenyAuthorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenyAuthorizationResponse) ProtoMessage() {}
+
+func (x *DenyAuthorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DenyAuthorizationResponse) GetRedirectUrl() string {
+	if x != nil {
+		return x.xxx_hidden_RedirectUrl
+	}
+	return ""
+}
+
+func (x *DenyAuthorizationResponse) SetRedirectUrl(v string) {
+	x.xxx_hidden_RedirectUrl = v
+}
+
+type DenyAuthorizationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The redirect URI of the client with an `access_denied` error and state.
+	RedirectUrl string
+}
+
+func (b0 DenyAuthorizationResponse_builder) Build() *DenyAuthorizationResponse {
+	m0 := &DenyAuthorizationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_RedirectUrl = b.RedirectUrl
+	return m0
+}
+
+type GetDeviceAuthorizationRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UserCode string                 `protobuf:"bytes,1,opt,name=user_code,json=userCode,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetDeviceAuthorizationRequest) Reset() {
+	*x = GetDeviceAuthorizationRequest{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeviceAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceAuthorizationRequest) ProtoMessage() {}
+
+func (x *GetDeviceAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetDeviceAuthorizationRequest) GetUserCode() string {
+	if x != nil {
+		return x.xxx_hidden_UserCode
+	}
+	return ""
+}
+
+func (x *GetDeviceAuthorizationRequest) SetUserCode(v string) {
+	x.xxx_hidden_UserCode = v
+}
+
+type GetDeviceAuthorizationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserCode string
+}
+
+func (b0 GetDeviceAuthorizationRequest_builder) Build() *GetDeviceAuthorizationRequest {
+	m0 := &GetDeviceAuthorizationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_UserCode = b.UserCode
+	return m0
+}
+
+type GetDeviceAuthorizationResponse struct {
+	state                    protoimpl.MessageState      `protogen:"opaque.v1"`
+	xxx_hidden_Authorization *PendingDeviceAuthorization `protobuf:"bytes,1,opt,name=authorization,proto3"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetDeviceAuthorizationResponse) Reset() {
+	*x = GetDeviceAuthorizationResponse{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeviceAuthorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceAuthorizationResponse) ProtoMessage() {}
+
+func (x *GetDeviceAuthorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetDeviceAuthorizationResponse) GetAuthorization() *PendingDeviceAuthorization {
+	if x != nil {
+		return x.xxx_hidden_Authorization
+	}
+	return nil
+}
+
+func (x *GetDeviceAuthorizationResponse) SetAuthorization(v *PendingDeviceAuthorization) {
+	x.xxx_hidden_Authorization = v
+}
+
+func (x *GetDeviceAuthorizationResponse) HasAuthorization() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Authorization != nil
+}
+
+func (x *GetDeviceAuthorizationResponse) ClearAuthorization() {
+	x.xxx_hidden_Authorization = nil
+}
+
+type GetDeviceAuthorizationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Authorization *PendingDeviceAuthorization
+}
+
+func (b0 GetDeviceAuthorizationResponse_builder) Build() *GetDeviceAuthorizationResponse {
+	m0 := &GetDeviceAuthorizationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Authorization = b.Authorization
+	return m0
+}
+
+type ApproveDeviceAuthorizationRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UserCode string                 `protobuf:"bytes,1,opt,name=user_code,json=userCode,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ApproveDeviceAuthorizationRequest) Reset() {
+	*x = ApproveDeviceAuthorizationRequest{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveDeviceAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveDeviceAuthorizationRequest) ProtoMessage() {}
+
+func (x *ApproveDeviceAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ApproveDeviceAuthorizationRequest) GetUserCode() string {
+	if x != nil {
+		return x.xxx_hidden_UserCode
+	}
+	return ""
+}
+
+func (x *ApproveDeviceAuthorizationRequest) SetUserCode(v string) {
+	x.xxx_hidden_UserCode = v
+}
+
+type ApproveDeviceAuthorizationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserCode string
+}
+
+func (b0 ApproveDeviceAuthorizationRequest_builder) Build() *ApproveDeviceAuthorizationRequest {
+	m0 := &ApproveDeviceAuthorizationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_UserCode = b.UserCode
+	return m0
+}
+
+type ApproveDeviceAuthorizationResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveDeviceAuthorizationResponse) Reset() {
+	*x = ApproveDeviceAuthorizationResponse{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveDeviceAuthorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveDeviceAuthorizationResponse) ProtoMessage() {}
+
+func (x *ApproveDeviceAuthorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type ApproveDeviceAuthorizationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 ApproveDeviceAuthorizationResponse_builder) Build() *ApproveDeviceAuthorizationResponse {
+	m0 := &ApproveDeviceAuthorizationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type DenyDeviceAuthorizationRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UserCode string                 `protobuf:"bytes,1,opt,name=user_code,json=userCode,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DenyDeviceAuthorizationRequest) Reset() {
+	*x = DenyDeviceAuthorizationRequest{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DenyDeviceAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenyDeviceAuthorizationRequest) ProtoMessage() {}
+
+func (x *DenyDeviceAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DenyDeviceAuthorizationRequest) GetUserCode() string {
+	if x != nil {
+		return x.xxx_hidden_UserCode
+	}
+	return ""
+}
+
+func (x *DenyDeviceAuthorizationRequest) SetUserCode(v string) {
+	x.xxx_hidden_UserCode = v
+}
+
+type DenyDeviceAuthorizationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserCode string
+}
+
+func (b0 DenyDeviceAuthorizationRequest_builder) Build() *DenyDeviceAuthorizationRequest {
+	m0 := &DenyDeviceAuthorizationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_UserCode = b.UserCode
+	return m0
+}
+
+type DenyDeviceAuthorizationResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DenyDeviceAuthorizationResponse) Reset() {
+	*x = DenyDeviceAuthorizationResponse{}
+	mi := &file_guardian_v1_oauth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DenyDeviceAuthorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenyDeviceAuthorizationResponse) ProtoMessage() {}
+
+func (x *DenyDeviceAuthorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_oauth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DenyDeviceAuthorizationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DenyDeviceAuthorizationResponse_builder) Build() *DenyDeviceAuthorizationResponse {
+	m0 := &DenyDeviceAuthorizationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+var File_guardian_v1_oauth_proto protoreflect.FileDescriptor
+
+const file_guardian_v1_oauth_proto_rawDesc = "" +
+	"\n" +
+	"\x17guardian/v1/oauth.proto\x12\vguardian.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd5\x02\n" +
+	"\vOAuthClient\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x120\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1c.guardian.v1.OAuthClientTypeR\x04type\x12#\n" +
+	"\rredirect_uris\x18\x04 \x03(\tR\fredirectUris\x12\x1f\n" +
+	"\vgrant_types\x18\x05 \x03(\tR\n" +
+	"grantTypes\x12\x16\n" +
+	"\x06scopes\x18\x06 \x03(\tR\x06scopes\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1c\n" +
+	"\taudiences\x18\t \x03(\tR\taudiences\"\xa6\x02\n" +
+	"\x14PendingAuthorization\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x1f\n" +
+	"\vclient_name\x18\x03 \x01(\tR\n" +
+	"clientName\x12!\n" +
+	"\fredirect_uri\x18\x04 \x01(\tR\vredirectUri\x12\x16\n" +
+	"\x06scopes\x18\x05 \x03(\tR\x06scopes\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x16\n" +
+	"\x06prompt\x18\a \x03(\tR\x06prompt\x122\n" +
+	"\amax_age\x18\b \x01(\v2\x19.google.protobuf.DurationR\x06maxAge\"\xca\x01\n" +
+	"\x1aPendingDeviceAuthorization\x12\x1b\n" +
+	"\tuser_code\x18\x01 \x01(\tR\buserCode\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x1f\n" +
+	"\vclient_name\x18\x03 \x01(\tR\n" +
+	"clientName\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xdc\x01\n" +
+	"\x18CreateOAuthClientRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x120\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1c.guardian.v1.OAuthClientTypeR\x04type\x12#\n" +
+	"\rredirect_uris\x18\x03 \x03(\tR\fredirectUris\x12\x1f\n" +
+	"\vgrant_types\x18\x04 \x03(\tR\n" +
+	"grantTypes\x12\x16\n" +
+	"\x06scopes\x18\x05 \x03(\tR\x06scopes\x12\x1c\n" +
+	"\taudiences\x18\x06 \x03(\tR\taudiences\"r\n" +
+	"\x19CreateOAuthClientResponse\x120\n" +
+	"\x06client\x18\x01 \x01(\v2\x18.guardian.v1.OAuthClientR\x06client\x12#\n" +
+	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\"'\n" +
+	"\x15GetOAuthClientRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"J\n" +
+	"\x16GetOAuthClientResponse\x120\n" +
+	"\x06client\x18\x01 \x01(\v2\x18.guardian.v1.OAuthClientR\x06client\"\x19\n" +
+	"\x17ListOAuthClientsRequest\"N\n" +
+	"\x18ListOAuthClientsResponse\x122\n" +
+	"\aclients\x18\x01 \x03(\v2\x18.guardian.v1.OAuthClientR\aclients\"\xba\x01\n" +
+	"\x18UpdateOAuthClientRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rredirect_uris\x18\x03 \x03(\tR\fredirectUris\x12\x1f\n" +
+	"\vgrant_types\x18\x04 \x03(\tR\n" +
+	"grantTypes\x12\x16\n" +
+	"\x06scopes\x18\x05 \x03(\tR\x06scopes\x12\x1c\n" +
+	"\taudiences\x18\x06 \x03(\tR\taudiences\"M\n" +
+	"\x19UpdateOAuthClientResponse\x120\n" +
+	"\x06client\x18\x01 \x01(\v2\x18.guardian.v1.OAuthClientR\x06client\"*\n" +
+	"\x18DeleteOAuthClientRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1b\n" +
+	"\x19DeleteOAuthClientResponse\")\n" +
+	"\x17GetAuthorizationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"c\n" +
+	"\x18GetAuthorizationResponse\x12G\n" +
+	"\rauthorization\x18\x01 \x01(\v2!.guardian.v1.PendingAuthorizationR\rauthorization\"-\n" +
+	"\x1bApproveAuthorizationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"A\n" +
+	"\x1cApproveAuthorizationResponse\x12!\n" +
+	"\fredirect_url\x18\x01 \x01(\tR\vredirectUrl\"@\n" +
+	"\x18DenyAuthorizationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\">\n" +
+	"\x19DenyAuthorizationResponse\x12!\n" +
+	"\fredirect_url\x18\x01 \x01(\tR\vredirectUrl\"<\n" +
+	"\x1dGetDeviceAuthorizationRequest\x12\x1b\n" +
+	"\tuser_code\x18\x01 \x01(\tR\buserCode\"o\n" +
+	"\x1eGetDeviceAuthorizationResponse\x12M\n" +
+	"\rauthorization\x18\x01 \x01(\v2'.guardian.v1.PendingDeviceAuthorizationR\rauthorization\"@\n" +
+	"!ApproveDeviceAuthorizationRequest\x12\x1b\n" +
+	"\tuser_code\x18\x01 \x01(\tR\buserCode\"$\n" +
+	"\"ApproveDeviceAuthorizationResponse\"=\n" +
+	"\x1eDenyDeviceAuthorizationRequest\x12\x1b\n" +
+	"\tuser_code\x18\x01 \x01(\tR\buserCode\"!\n" +
+	"\x1fDenyDeviceAuthorizationResponse*y\n" +
+	"\x0fOAuthClientType\x12\"\n" +
+	"\x1eO_AUTH_CLIENT_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19O_AUTH_CLIENT_TYPE_PUBLIC\x10\x01\x12#\n" +
+	"\x1fO_AUTH_CLIENT_TYPE_CONFIDENTIAL\x10\x022\x90\t\n" +
+	"\fOAuthService\x12b\n" +
+	"\x11CreateOAuthClient\x12%.guardian.v1.CreateOAuthClientRequest\x1a&.guardian.v1.CreateOAuthClientResponse\x12Y\n" +
+	"\x0eGetOAuthClient\x12\".guardian.v1.GetOAuthClientRequest\x1a#.guardian.v1.GetOAuthClientResponse\x12_\n" +
+	"\x10ListOAuthClients\x12$.guardian.v1.ListOAuthClientsRequest\x1a%.guardian.v1.ListOAuthClientsResponse\x12b\n" +
+	"\x11UpdateOAuthClient\x12%.guardian.v1.UpdateOAuthClientRequest\x1a&.guardian.v1.UpdateOAuthClientResponse\x12b\n" +
+	"\x11DeleteOAuthClient\x12%.guardian.v1.DeleteOAuthClientRequest\x1a&.guardian.v1.DeleteOAuthClientResponse\x12_\n" +
+	"\x10GetAuthorization\x12$.guardian.v1.GetAuthorizationRequest\x1a%.guardian.v1.GetAuthorizationResponse\x12k\n" +
+	"\x14ApproveAuthorization\x12(.guardian.v1.ApproveAuthorizationRequest\x1a).guardian.v1.ApproveAuthorizationResponse\x12b\n" +
+	"\x11DenyAuthorization\x12%.guardian.v1.DenyAuthorizationRequest\x1a&.guardian.v1.DenyAuthorizationResponse\x12q\n" +
+	"\x16GetDeviceAuthorization\x12*.guardian.v1.GetDeviceAuthorizationRequest\x1a+.guardian.v1.GetDeviceAuthorizationResponse\x12}\n" +
+	"\x1aApproveDeviceAuthorization\x12..guardian.v1.ApproveDeviceAuthorizationRequest\x1a/.guardian.v1.ApproveDeviceAuthorizationResponse\x12t\n" +
+	"\x17DenyDeviceAuthorization\x12+.guardian.v1.DenyDeviceAuthorizationRequest\x1a,.guardian.v1.DenyDeviceAuthorizationResponseB\xa9\x01\n" +
+	"\x0fcom.guardian.v1B\n" +
+	"OauthProtoP\x01Z=github.com/gophero/guardian/core/proto/guardian/v1;guardianv1\xa2\x02\x03GVX\xaa\x02\vGuardian.V1\xca\x02\vGuardian\\V1\xe2\x02\x17Guardian\\V1\\GPBMetadata\xea\x02\fGuardian::V1b\x06proto3"
+
+var file_guardian_v1_oauth_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_guardian_v1_oauth_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_guardian_v1_oauth_proto_goTypes = []any{
+	(OAuthClientType)(0),                       // 0: guardian.v1.OAuthClientType
+	(*OAuthClient)(nil),                        // 1: guardian.v1.OAuthClient
+	(*PendingAuthorization)(nil),               // 2: guardian.v1.PendingAuthorization
+	(*PendingDeviceAuthorization)(nil),         // 3: guardian.v1.PendingDeviceAuthorization
+	(*CreateOAuthClientRequest)(nil),           // 4: guardian.v1.CreateOAuthClientRequest
+	(*CreateOAuthClientResponse)(nil),          // 5: guardian.v1.CreateOAuthClientResponse
+	(*GetOAuthClientRequest)(nil),              // 6: guardian.v1.GetOAuthClientRequest
+	(*GetOAuthClientResponse)(nil),             // 7: guardian.v1.GetOAuthClientResponse
+	(*ListOAuthClientsRequest)(nil),            // 8: guardian.v1.ListOAuthClientsRequest
+	(*ListOAuthClientsResponse)(nil),           // 9: guardian.v1.ListOAuthClientsResponse
+	(*UpdateOAuthClientRequest)(nil),           // 10: guardian.v1.UpdateOAuthClientRequest
+	(*UpdateOAuthClientResponse)(nil),          // 11: guardian.v1.UpdateOAuthClientResponse
+	(*DeleteOAuthClientRequest)(nil),           // 12: guardian.v1.DeleteOAuthClientRequest
+	(*DeleteOAuthClientResponse)(nil),          // 13: guardian.v1.DeleteOAuthClientResponse
+	(*GetAuthorizationRequest)(nil),            // 14: guardian.v1.GetAuthorizationRequest
+	(*GetAuthorizationResponse)(nil),           // 15: guardian.v1.GetAuthorizationResponse
+	(*ApproveAuthorizationRequest)(nil),        // 16: guardian.v1.ApproveAuthorizationRequest
+	(*ApproveAuthorizationResponse)(nil),       // 17: guardian.v1.ApproveAuthorizationResponse
+	(*DenyAuthorizationRequest)(nil),           // 18: guardian.v1.DenyAuthorizationRequest
+	(*DenyAuthorizationResponse)(nil),          // 19: guardian.v1.DenyAuthorizationResponse
+	(*GetDeviceAuthorizationRequest)(nil),      // 20: guardian.v1.GetDeviceAuthorizationRequest
+	(*GetDeviceAuthorizationResponse)(nil),     // 21: guardian.v1.GetDeviceAuthorizationResponse
+	(*ApproveDeviceAuthorizationRequest)(nil),  // 22: guardian.v1.ApproveDeviceAuthorizationRequest
+	(*ApproveDeviceAuthorizationResponse)(nil), // 23: guardian.v1.ApproveDeviceAuthorizationResponse
+	(*DenyDeviceAuthorizationRequest)(nil),     // 24: guardian.v1.DenyDeviceAuthorizationRequest
+	(*DenyDeviceAuthorizationResponse)(nil),    // 25: guardian.v1.DenyDeviceAuthorizationResponse
+	(*timestamppb.Timestamp)(nil),              // 26: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                // 27: google.protobuf.Duration
+}
+var file_guardian_v1_oauth_proto_depIdxs = []int32{
+	0,  // 0: guardian.v1.OAuthClient.type:type_name -> guardian.v1.OAuthClientType
+	26, // 1: guardian.v1.OAuthClient.created_at:type_name -> google.protobuf.Timestamp
+	26, // 2: guardian.v1.OAuthClient.updated_at:type_name -> google.protobuf.Timestamp
+	26, // 3: guardian.v1.PendingAuthorization.expires_at:type_name -> google.protobuf.Timestamp
+	27, // 4: guardian.v1.PendingAuthorization.max_age:type_name -> google.protobuf.Duration
+	26, // 5: guardian.v1.PendingDeviceAuthorization.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 6: guardian.v1.CreateOAuthClientRequest.type:type_name -> guardian.v1.OAuthClientType
+	1,  // 7: guardian.v1.CreateOAuthClientResponse.client:type_name -> guardian.v1.OAuthClient
+	1,  // 8: guardian.v1.GetOAuthClientResponse.client:type_name -> guardian.v1.OAuthClient
+	1,  // 9: guardian.v1.ListOAuthClientsResponse.clients:type_name -> guardian.v1.OAuthClient
+	1,  // 10: guardian.v1.UpdateOAuthClientResponse.client:type_name -> guardian.v1.OAuthClient
+	2,  // 11: guardian.v1.GetAuthorizationResponse.authorization:type_name -> guardian.v1.PendingAuthorization
+	3,  // 12: guardian.v1.GetDeviceAuthorizationResponse.authorization:type_name -> guardian.v1.PendingDeviceAuthorization
+	4,  // 13: guardian.v1.OAuthService.CreateOAuthClient:input_type -> guardian.v1.CreateOAuthClientRequest
+	6,  // 14: guardian.v1.OAuthService.GetOAuthClient:input_type -> guardian.v1.GetOAuthClientRequest
+	8,  // 15: guardian.v1.OAuthService.ListOAuthClients:input_type -> guardian.v1.ListOAuthClientsRequest
+	10, // 16: guardian.v1.OAuthService.UpdateOAuthClient:input_type -> guardian.v1.UpdateOAuthClientRequest
+	12, // 17: guardian.v1.OAuthService.DeleteOAuthClient:input_type -> guardian.v1.DeleteOAuthClientRequest
+	14, // 18: guardian.v1.OAuthService.GetAuthorization:input_type -> guardian.v1.GetAuthorizationRequest
+	16, // 19: guardian.v1.OAuthService.ApproveAuthorization:input_type -> guardian.v1.ApproveAuthorizationRequest
+	18, // 20: guardian.v1.OAuthService.DenyAuthorization:input_type -> guardian.v1.DenyAuthorizationRequest
+	20, // 21: guardian.v1.OAuthService.GetDeviceAuthorization:input_type -> guardian.v1.GetDeviceAuthorizationRequest
+	22, // 22: guardian.v1.OAuthService.ApproveDeviceAuthorization:input_type -> guardian.v1.ApproveDeviceAuthorizationRequest
+	24, // 23: guardian.v1.OAuthService.DenyDeviceAuthorization:input_type -> guardian.v1.DenyDeviceAuthorizationRequest
+	5,  // 24: guardian.v1.OAuthService.CreateOAuthClient:output_type -> guardian.v1.CreateOAuthClientResponse
+	7,  // 25: guardian.v1.OAuthService.GetOAuthClient:output_type -> guardian.v1.GetOAuthClientResponse
+	9,  // 26: guardian.v1.OAuthService.ListOAuthClients:output_type -> guardian.v1.ListOAuthClientsResponse
+	11, // 27: guardian.v1.OAuthService.UpdateOAuthClient:output_type -> guardian.v1.UpdateOAuthClientResponse
+	13, // 28: guardian.v1.OAuthService.DeleteOAuthClient:output_type -> guardian.v1.DeleteOAuthClientResponse
+	15, // 29: guardian.v1.OAuthService.GetAuthorization:output_type -> guardian.v1.GetAuthorizationResponse
+	17, // 30: guardian.v1.OAuthService.ApproveAuthorization:output_type -> guardian.v1.ApproveAuthorizationResponse
+	19, // 31: guardian.v1.OAuthService.DenyAuthorization:output_type -> guardian.v1.DenyAuthorizationResponse
+	21, // 32: guardian.v1.OAuthService.GetDeviceAuthorization:output_type -> guardian.v1.GetDeviceAuthorizationResponse
+	23, // 33: guardian.v1.OAuthService.ApproveDeviceAuthorization:output_type -> guardian.v1.ApproveDeviceAuthorizationResponse
+	25, // 34: guardian.v1.OAuthService.DenyDeviceAuthorization:output_type -> guardian.v1.DenyDeviceAuthorizationResponse
+	24, // [24:35] is the sub-list for method output_type
+	13, // [13:24] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_guardian_v1_oauth_proto_init() }
+func file_guardian_v1_oauth_proto_init() {
+	if File_guardian_v1_oauth_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guardian_v1_oauth_proto_rawDesc), len(file_guardian_v1_oauth_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   25,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_guardian_v1_oauth_proto_goTypes,
+		DependencyIndexes: file_guardian_v1_oauth_proto_depIdxs,
+		EnumInfos:         file_guardian_v1_oauth_proto_enumTypes,
+		MessageInfos:      file_guardian_v1_oauth_proto_msgTypes,
+	}.Build()
+	File_guardian_v1_oauth_proto = out.File
+	file_guardian_v1_oauth_proto_goTypes = nil
+	file_guardian_v1_oauth_proto_depIdxs = nil
+}
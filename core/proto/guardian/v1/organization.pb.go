@@ -0,0 +1,2742 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: guardian/v1/organization.proto
+
+package guardianv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// OrganizationRole is the role of a member in an organization.
+type OrganizationRole int32
+
+const (
+	OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED OrganizationRole = 0
+	OrganizationRole_ORGANIZATION_ROLE_OWNER       OrganizationRole = 1
+	OrganizationRole_ORGANIZATION_ROLE_ADMIN       OrganizationRole = 2
+	OrganizationRole_ORGANIZATION_ROLE_MEMBER      OrganizationRole = 3
+)
+
+// Enum value maps for OrganizationRole.
+var (
+	OrganizationRole_name = map[int32]string{
+		0: "ORGANIZATION_ROLE_UNSPECIFIED",
+		1: "ORGANIZATION_ROLE_OWNER",
+		2: "ORGANIZATION_ROLE_ADMIN",
+		3: "ORGANIZATION_ROLE_MEMBER",
+	}
+	OrganizationRole_value = map[string]int32{
+		"ORGANIZATION_ROLE_UNSPECIFIED": 0,
+		"ORGANIZATION_ROLE_OWNER":       1,
+		"ORGANIZATION_ROLE_ADMIN":       2,
+		"ORGANIZATION_ROLE_MEMBER":      3,
+	}
+)
+
+func (x OrganizationRole) Enum() *OrganizationRole {
+	p := new(OrganizationRole)
+	*p = x
+	return p
+}
+
+func (x OrganizationRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrganizationRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_guardian_v1_organization_proto_enumTypes[0].Descriptor()
+}
+
+func (OrganizationRole) Type() protoreflect.EnumType {
+	return &file_guardian_v1_organization_proto_enumTypes[0]
+}
+
+func (x OrganizationRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Organization is a tenant which users are members of.
+type Organization struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id        string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Slug      string                 `protobuf:"bytes,2,opt,name=slug,proto3"`
+	xxx_hidden_Name      string                 `protobuf:"bytes,3,opt,name=name,proto3"`
+	xxx_hidden_CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3"`
+	xxx_hidden_UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Organization) Reset() {
+	*x = Organization{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Organization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organization) ProtoMessage() {}
+
+func (x *Organization) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Organization) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *Organization) GetSlug() string {
+	if x != nil {
+		return x.xxx_hidden_Slug
+	}
+	return ""
+}
+
+func (x *Organization) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *Organization) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *Organization) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
+	}
+	return nil
+}
+
+func (x *Organization) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *Organization) SetSlug(v string) {
+	x.xxx_hidden_Slug = v
+}
+
+func (x *Organization) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *Organization) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *Organization) SetUpdatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *Organization) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *Organization) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *Organization) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *Organization) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+type Organization_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+	// Identifies the organization in URLs. Slugs are hyphen separated alphanumeric segments of up to 63 characters and
+	// unique ignoring case.
+	Slug      string
+	Name      string
+	CreatedAt *timestamppb.Timestamp
+	UpdatedAt *timestamppb.Timestamp
+}
+
+func (b0 Organization_builder) Build() *Organization {
+	m0 := &Organization{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_Slug = b.Slug
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	return m0
+}
+
+// OrganizationMembership makes a user a member of an organization.
+type OrganizationMembership struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OrgId     string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3"`
+	xxx_hidden_UserId    string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3"`
+	xxx_hidden_Role      OrganizationRole       `protobuf:"varint,3,opt,name=role,proto3,enum=guardian.v1.OrganizationRole"`
+	xxx_hidden_CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3"`
+	xxx_hidden_UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *OrganizationMembership) Reset() {
+	*x = OrganizationMembership{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationMembership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationMembership) ProtoMessage() {}
+
+func (x *OrganizationMembership) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *OrganizationMembership) GetOrgId() string {
+	if x != nil {
+		return x.xxx_hidden_OrgId
+	}
+	return ""
+}
+
+func (x *OrganizationMembership) GetUserId() string {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return ""
+}
+
+func (x *OrganizationMembership) GetRole() OrganizationRole {
+	if x != nil {
+		return x.xxx_hidden_Role
+	}
+	return OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED
+}
+
+func (x *OrganizationMembership) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *OrganizationMembership) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
+	}
+	return nil
+}
+
+func (x *OrganizationMembership) SetOrgId(v string) {
+	x.xxx_hidden_OrgId = v
+}
+
+func (x *OrganizationMembership) SetUserId(v string) {
+	x.xxx_hidden_UserId = v
+}
+
+func (x *OrganizationMembership) SetRole(v OrganizationRole) {
+	x.xxx_hidden_Role = v
+}
+
+func (x *OrganizationMembership) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *OrganizationMembership) SetUpdatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *OrganizationMembership) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *OrganizationMembership) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *OrganizationMembership) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *OrganizationMembership) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+type OrganizationMembership_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OrgId     string
+	UserId    string
+	Role      OrganizationRole
+	CreatedAt *timestamppb.Timestamp
+	UpdatedAt *timestamppb.Timestamp
+}
+
+func (b0 OrganizationMembership_builder) Build() *OrganizationMembership {
+	m0 := &OrganizationMembership{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_OrgId = b.OrgId
+	x.xxx_hidden_UserId = b.UserId
+	x.xxx_hidden_Role = b.Role
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	return m0
+}
+
+// OrganizationInvitation invites the owner of an email address to join an organization.
+type OrganizationInvitation struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id        string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_OrgId     string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3"`
+	xxx_hidden_Email     string                 `protobuf:"bytes,3,opt,name=email,proto3"`
+	xxx_hidden_Role      OrganizationRole       `protobuf:"varint,4,opt,name=role,proto3,enum=guardian.v1.OrganizationRole"`
+	xxx_hidden_InviterId string                 `protobuf:"bytes,5,opt,name=inviter_id,json=inviterId,proto3"`
+	xxx_hidden_CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3"`
+	xxx_hidden_ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *OrganizationInvitation) Reset() {
+	*x = OrganizationInvitation{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationInvitation) ProtoMessage() {}
+
+func (x *OrganizationInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *OrganizationInvitation) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *OrganizationInvitation) GetOrgId() string {
+	if x != nil {
+		return x.xxx_hidden_OrgId
+	}
+	return ""
+}
+
+func (x *OrganizationInvitation) GetEmail() string {
+	if x != nil {
+		return x.xxx_hidden_Email
+	}
+	return ""
+}
+
+func (x *OrganizationInvitation) GetRole() OrganizationRole {
+	if x != nil {
+		return x.xxx_hidden_Role
+	}
+	return OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED
+}
+
+func (x *OrganizationInvitation) GetInviterId() string {
+	if x != nil {
+		return x.xxx_hidden_InviterId
+	}
+	return ""
+}
+
+func (x *OrganizationInvitation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *OrganizationInvitation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ExpiresAt
+	}
+	return nil
+}
+
+func (x *OrganizationInvitation) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *OrganizationInvitation) SetOrgId(v string) {
+	x.xxx_hidden_OrgId = v
+}
+
+func (x *OrganizationInvitation) SetEmail(v string) {
+	x.xxx_hidden_Email = v
+}
+
+func (x *OrganizationInvitation) SetRole(v OrganizationRole) {
+	x.xxx_hidden_Role = v
+}
+
+func (x *OrganizationInvitation) SetInviterId(v string) {
+	x.xxx_hidden_InviterId = v
+}
+
+func (x *OrganizationInvitation) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *OrganizationInvitation) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ExpiresAt = v
+}
+
+func (x *OrganizationInvitation) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *OrganizationInvitation) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExpiresAt != nil
+}
+
+func (x *OrganizationInvitation) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *OrganizationInvitation) ClearExpiresAt() {
+	x.xxx_hidden_ExpiresAt = nil
+}
+
+type OrganizationInvitation_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    string
+	OrgId string
+	Email string
+	Role  OrganizationRole
+	// The user who invited, empty if the user was deleted.
+	InviterId string
+	CreatedAt *timestamppb.Timestamp
+	ExpiresAt *timestamppb.Timestamp
+}
+
+func (b0 OrganizationInvitation_builder) Build() *OrganizationInvitation {
+	m0 := &OrganizationInvitation{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_OrgId = b.OrgId
+	x.xxx_hidden_Email = b.Email
+	x.xxx_hidden_Role = b.Role
+	x.xxx_hidden_InviterId = b.InviterId
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_ExpiresAt = b.ExpiresAt
+	return m0
+}
+
+type CreateOrganizationRequest struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Slug string                 `protobuf:"bytes,1,opt,name=slug,proto3"`
+	xxx_hidden_Name string                 `protobuf:"bytes,2,opt,name=name,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationRequest) Reset() {
+	*x = CreateOrganizationRequest{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationRequest) ProtoMessage() {}
+
+func (x *CreateOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateOrganizationRequest) GetSlug() string {
+	if x != nil {
+		return x.xxx_hidden_Slug
+	}
+	return ""
+}
+
+func (x *CreateOrganizationRequest) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *CreateOrganizationRequest) SetSlug(v string) {
+	x.xxx_hidden_Slug = v
+}
+
+func (x *CreateOrganizationRequest) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+type CreateOrganizationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Slug string
+	Name string
+}
+
+func (b0 CreateOrganizationRequest_builder) Build() *CreateOrganizationRequest {
+	m0 := &CreateOrganizationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Slug = b.Slug
+	x.xxx_hidden_Name = b.Name
+	return m0
+}
+
+type CreateOrganizationResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Organization *Organization          `protobuf:"bytes,1,opt,name=organization,proto3"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationResponse) Reset() {
+	*x = CreateOrganizationResponse{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationResponse) ProtoMessage() {}
+
+func (x *CreateOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.xxx_hidden_Organization
+	}
+	return nil
+}
+
+func (x *CreateOrganizationResponse) SetOrganization(v *Organization) {
+	x.xxx_hidden_Organization = v
+}
+
+func (x *CreateOrganizationResponse) HasOrganization() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Organization != nil
+}
+
+func (x *CreateOrganizationResponse) ClearOrganization() {
+	x.xxx_hidden_Organization = nil
+}
+
+type CreateOrganizationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Organization *Organization
+}
+
+func (b0 CreateOrganizationResponse_builder) Build() *CreateOrganizationResponse {
+	m0 := &CreateOrganizationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Organization = b.Organization
+	return m0
+}
+
+type GetOrganizationRequest struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id   string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Slug string                 `protobuf:"bytes,2,opt,name=slug,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetOrganizationRequest) Reset() {
+	*x = GetOrganizationRequest{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationRequest) ProtoMessage() {}
+
+func (x *GetOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetOrganizationRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *GetOrganizationRequest) GetSlug() string {
+	if x != nil {
+		return x.xxx_hidden_Slug
+	}
+	return ""
+}
+
+func (x *GetOrganizationRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *GetOrganizationRequest) SetSlug(v string) {
+	x.xxx_hidden_Slug = v
+}
+
+type GetOrganizationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Either id or slug has to be set.
+	Id   string
+	Slug string
+}
+
+func (b0 GetOrganizationRequest_builder) Build() *GetOrganizationRequest {
+	m0 := &GetOrganizationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_Slug = b.Slug
+	return m0
+}
+
+type GetOrganizationResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Organization *Organization          `protobuf:"bytes,1,opt,name=organization,proto3"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetOrganizationResponse) Reset() {
+	*x = GetOrganizationResponse{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationResponse) ProtoMessage() {}
+
+func (x *GetOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.xxx_hidden_Organization
+	}
+	return nil
+}
+
+func (x *GetOrganizationResponse) SetOrganization(v *Organization) {
+	x.xxx_hidden_Organization = v
+}
+
+func (x *GetOrganizationResponse) HasOrganization() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Organization != nil
+}
+
+func (x *GetOrganizationResponse) ClearOrganization() {
+	x.xxx_hidden_Organization = nil
+}
+
+type GetOrganizationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Organization *Organization
+}
+
+func (b0 GetOrganizationResponse_builder) Build() *GetOrganizationResponse {
+	m0 := &GetOrganizationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Organization = b.Organization
+	return m0
+}
+
+type ListOrganizationsRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationsRequest) Reset() {
+	*x = ListOrganizationsRequest{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsRequest) ProtoMessage() {}
+
+func (x *ListOrganizationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type ListOrganizationsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 ListOrganizationsRequest_builder) Build() *ListOrganizationsRequest {
+	m0 := &ListOrganizationsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type ListOrganizationsResponse struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Organizations *[]*Organization       `protobuf:"bytes,1,rep,name=organizations,proto3"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ListOrganizationsResponse) Reset() {
+	*x = ListOrganizationsResponse{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsResponse) ProtoMessage() {}
+
+func (x *ListOrganizationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListOrganizationsResponse) GetOrganizations() []*Organization {
+	if x != nil {
+		if x.xxx_hidden_Organizations != nil {
+			return *x.xxx_hidden_Organizations
+		}
+	}
+	return nil
+}
+
+func (x *ListOrganizationsResponse) SetOrganizations(v []*Organization) {
+	x.xxx_hidden_Organizations = &v
+}
+
+type ListOrganizationsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Organizations []*Organization
+}
+
+func (b0 ListOrganizationsResponse_builder) Build() *ListOrganizationsResponse {
+	m0 := &ListOrganizationsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Organizations = &b.Organizations
+	return m0
+}
+
+type UpdateOrganizationRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Slug        *string                `protobuf:"bytes,2,opt,name=slug,proto3,oneof"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizationRequest) Reset() {
+	*x = UpdateOrganizationRequest{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationRequest) ProtoMessage() {}
+
+func (x *UpdateOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateOrganizationRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationRequest) GetSlug() string {
+	if x != nil {
+		if x.xxx_hidden_Slug != nil {
+			return *x.xxx_hidden_Slug
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationRequest) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *UpdateOrganizationRequest) SetSlug(v string) {
+	x.xxx_hidden_Slug = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *UpdateOrganizationRequest) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *UpdateOrganizationRequest) HasSlug() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *UpdateOrganizationRequest) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *UpdateOrganizationRequest) ClearSlug() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Slug = nil
+}
+
+func (x *UpdateOrganizationRequest) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Name = nil
+}
+
+type UpdateOrganizationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id   string
+	Slug *string
+	Name *string
+}
+
+func (b0 UpdateOrganizationRequest_builder) Build() *UpdateOrganizationRequest {
+	m0 := &UpdateOrganizationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	if b.Slug != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Slug = b.Slug
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Name = b.Name
+	}
+	return m0
+}
+
+type UpdateOrganizationResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Organization *Organization          `protobuf:"bytes,1,opt,name=organization,proto3"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizationResponse) Reset() {
+	*x = UpdateOrganizationResponse{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationResponse) ProtoMessage() {}
+
+func (x *UpdateOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.xxx_hidden_Organization
+	}
+	return nil
+}
+
+func (x *UpdateOrganizationResponse) SetOrganization(v *Organization) {
+	x.xxx_hidden_Organization = v
+}
+
+func (x *UpdateOrganizationResponse) HasOrganization() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Organization != nil
+}
+
+func (x *UpdateOrganizationResponse) ClearOrganization() {
+	x.xxx_hidden_Organization = nil
+}
+
+type UpdateOrganizationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Organization *Organization
+}
+
+func (b0 UpdateOrganizationResponse_builder) Build() *UpdateOrganizationResponse {
+	m0 := &UpdateOrganizationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Organization = b.Organization
+	return m0
+}
+
+type DeleteOrganizationRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrganizationRequest) Reset() {
+	*x = DeleteOrganizationRequest{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrganizationRequest) ProtoMessage() {}
+
+func (x *DeleteOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteOrganizationRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *DeleteOrganizationRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type DeleteOrganizationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 DeleteOrganizationRequest_builder) Build() *DeleteOrganizationRequest {
+	m0 := &DeleteOrganizationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type DeleteOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrganizationResponse) Reset() {
+	*x = DeleteOrganizationResponse{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrganizationResponse) ProtoMessage() {}
+
+func (x *DeleteOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeleteOrganizationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeleteOrganizationResponse_builder) Build() *DeleteOrganizationResponse {
+	m0 := &DeleteOrganizationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type ListMembersRequest struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OrgId     string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3"`
+	xxx_hidden_PageSize  int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3"`
+	xxx_hidden_PageToken string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListMembersRequest) Reset() {
+	*x = ListMembersRequest{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembersRequest) ProtoMessage() {}
+
+func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListMembersRequest) GetOrgId() string {
+	if x != nil {
+		return x.xxx_hidden_OrgId
+	}
+	return ""
+}
+
+func (x *ListMembersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.xxx_hidden_PageSize
+	}
+	return 0
+}
+
+func (x *ListMembersRequest) GetPageToken() string {
+	if x != nil {
+		return x.xxx_hidden_PageToken
+	}
+	return ""
+}
+
+func (x *ListMembersRequest) SetOrgId(v string) {
+	x.xxx_hidden_OrgId = v
+}
+
+func (x *ListMembersRequest) SetPageSize(v int32) {
+	x.xxx_hidden_PageSize = v
+}
+
+func (x *ListMembersRequest) SetPageToken(v string) {
+	x.xxx_hidden_PageToken = v
+}
+
+type ListMembersRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OrgId    string
+	PageSize int32
+	// The next_page_token of the previous response.
+	PageToken string
+}
+
+func (b0 ListMembersRequest_builder) Build() *ListMembersRequest {
+	m0 := &ListMembersRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_OrgId = b.OrgId
+	x.xxx_hidden_PageSize = b.PageSize
+	x.xxx_hidden_PageToken = b.PageToken
+	return m0
+}
+
+type ListMembersResponse struct {
+	state                    protoimpl.MessageState     `protogen:"opaque.v1"`
+	xxx_hidden_Memberships   *[]*OrganizationMembership `protobuf:"bytes,1,rep,name=memberships,proto3"`
+	xxx_hidden_NextPageToken string                     `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ListMembersResponse) Reset() {
+	*x = ListMembersResponse{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembersResponse) ProtoMessage() {}
+
+func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListMembersResponse) GetMemberships() []*OrganizationMembership {
+	if x != nil {
+		if x.xxx_hidden_Memberships != nil {
+			return *x.xxx_hidden_Memberships
+		}
+	}
+	return nil
+}
+
+func (x *ListMembersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.xxx_hidden_NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMembersResponse) SetMemberships(v []*OrganizationMembership) {
+	x.xxx_hidden_Memberships = &v
+}
+
+func (x *ListMembersResponse) SetNextPageToken(v string) {
+	x.xxx_hidden_NextPageToken = v
+}
+
+type ListMembersResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Memberships   []*OrganizationMembership
+	NextPageToken string
+}
+
+func (b0 ListMembersResponse_builder) Build() *ListMembersResponse {
+	m0 := &ListMembersResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Memberships = &b.Memberships
+	x.xxx_hidden_NextPageToken = b.NextPageToken
+	return m0
+}
+
+type UpdateMemberRoleRequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OrgId  string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3"`
+	xxx_hidden_UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3"`
+	xxx_hidden_Role   OrganizationRole       `protobuf:"varint,3,opt,name=role,proto3,enum=guardian.v1.OrganizationRole"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateMemberRoleRequest) Reset() {
+	*x = UpdateMemberRoleRequest{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemberRoleRequest) ProtoMessage() {}
+
+func (x *UpdateMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateMemberRoleRequest) GetOrgId() string {
+	if x != nil {
+		return x.xxx_hidden_OrgId
+	}
+	return ""
+}
+
+func (x *UpdateMemberRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return ""
+}
+
+func (x *UpdateMemberRoleRequest) GetRole() OrganizationRole {
+	if x != nil {
+		return x.xxx_hidden_Role
+	}
+	return OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED
+}
+
+func (x *UpdateMemberRoleRequest) SetOrgId(v string) {
+	x.xxx_hidden_OrgId = v
+}
+
+func (x *UpdateMemberRoleRequest) SetUserId(v string) {
+	x.xxx_hidden_UserId = v
+}
+
+func (x *UpdateMemberRoleRequest) SetRole(v OrganizationRole) {
+	x.xxx_hidden_Role = v
+}
+
+type UpdateMemberRoleRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OrgId  string
+	UserId string
+	Role   OrganizationRole
+}
+
+func (b0 UpdateMemberRoleRequest_builder) Build() *UpdateMemberRoleRequest {
+	m0 := &UpdateMemberRoleRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_OrgId = b.OrgId
+	x.xxx_hidden_UserId = b.UserId
+	x.xxx_hidden_Role = b.Role
+	return m0
+}
+
+type UpdateMemberRoleResponse struct {
+	state                 protoimpl.MessageState  `protogen:"opaque.v1"`
+	xxx_hidden_Membership *OrganizationMembership `protobuf:"bytes,1,opt,name=membership,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateMemberRoleResponse) Reset() {
+	*x = UpdateMemberRoleResponse{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemberRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemberRoleResponse) ProtoMessage() {}
+
+func (x *UpdateMemberRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateMemberRoleResponse) GetMembership() *OrganizationMembership {
+	if x != nil {
+		return x.xxx_hidden_Membership
+	}
+	return nil
+}
+
+func (x *UpdateMemberRoleResponse) SetMembership(v *OrganizationMembership) {
+	x.xxx_hidden_Membership = v
+}
+
+func (x *UpdateMemberRoleResponse) HasMembership() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Membership != nil
+}
+
+func (x *UpdateMemberRoleResponse) ClearMembership() {
+	x.xxx_hidden_Membership = nil
+}
+
+type UpdateMemberRoleResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Membership *OrganizationMembership
+}
+
+func (b0 UpdateMemberRoleResponse_builder) Build() *UpdateMemberRoleResponse {
+	m0 := &UpdateMemberRoleResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Membership = b.Membership
+	return m0
+}
+
+type RemoveMemberRequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OrgId  string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3"`
+	xxx_hidden_UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RemoveMemberRequest) Reset() {
+	*x = RemoveMemberRequest{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemberRequest) ProtoMessage() {}
+
+func (x *RemoveMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RemoveMemberRequest) GetOrgId() string {
+	if x != nil {
+		return x.xxx_hidden_OrgId
+	}
+	return ""
+}
+
+func (x *RemoveMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return ""
+}
+
+func (x *RemoveMemberRequest) SetOrgId(v string) {
+	x.xxx_hidden_OrgId = v
+}
+
+func (x *RemoveMemberRequest) SetUserId(v string) {
+	x.xxx_hidden_UserId = v
+}
+
+type RemoveMemberRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OrgId  string
+	UserId string
+}
+
+func (b0 RemoveMemberRequest_builder) Build() *RemoveMemberRequest {
+	m0 := &RemoveMemberRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_OrgId = b.OrgId
+	x.xxx_hidden_UserId = b.UserId
+	return m0
+}
+
+type RemoveMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMemberResponse) Reset() {
+	*x = RemoveMemberResponse{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemberResponse) ProtoMessage() {}
+
+func (x *RemoveMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RemoveMemberResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RemoveMemberResponse_builder) Build() *RemoveMemberResponse {
+	m0 := &RemoveMemberResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type TransferOwnershipRequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OrgId  string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3"`
+	xxx_hidden_UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipRequest) Reset() {
+	*x = TransferOwnershipRequest{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TransferOwnershipRequest) GetOrgId() string {
+	if x != nil {
+		return x.xxx_hidden_OrgId
+	}
+	return ""
+}
+
+func (x *TransferOwnershipRequest) GetUserId() string {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return ""
+}
+
+func (x *TransferOwnershipRequest) SetOrgId(v string) {
+	x.xxx_hidden_OrgId = v
+}
+
+func (x *TransferOwnershipRequest) SetUserId(v string) {
+	x.xxx_hidden_UserId = v
+}
+
+type TransferOwnershipRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OrgId string
+	// The member who becomes an owner.
+	UserId string
+}
+
+func (b0 TransferOwnershipRequest_builder) Build() *TransferOwnershipRequest {
+	m0 := &TransferOwnershipRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_OrgId = b.OrgId
+	x.xxx_hidden_UserId = b.UserId
+	return m0
+}
+
+type TransferOwnershipResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipResponse) Reset() {
+	*x = TransferOwnershipResponse{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipResponse) ProtoMessage() {}
+
+func (x *TransferOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type TransferOwnershipResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 TransferOwnershipResponse_builder) Build() *TransferOwnershipResponse {
+	m0 := &TransferOwnershipResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type InviteMemberRequest struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OrgId string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3"`
+	xxx_hidden_Email string                 `protobuf:"bytes,2,opt,name=email,proto3"`
+	xxx_hidden_Role  OrganizationRole       `protobuf:"varint,3,opt,name=role,proto3,enum=guardian.v1.OrganizationRole"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InviteMemberRequest) Reset() {
+	*x = InviteMemberRequest{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteMemberRequest) ProtoMessage() {}
+
+func (x *InviteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InviteMemberRequest) GetOrgId() string {
+	if x != nil {
+		return x.xxx_hidden_OrgId
+	}
+	return ""
+}
+
+func (x *InviteMemberRequest) GetEmail() string {
+	if x != nil {
+		return x.xxx_hidden_Email
+	}
+	return ""
+}
+
+func (x *InviteMemberRequest) GetRole() OrganizationRole {
+	if x != nil {
+		return x.xxx_hidden_Role
+	}
+	return OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED
+}
+
+func (x *InviteMemberRequest) SetOrgId(v string) {
+	x.xxx_hidden_OrgId = v
+}
+
+func (x *InviteMemberRequest) SetEmail(v string) {
+	x.xxx_hidden_Email = v
+}
+
+func (x *InviteMemberRequest) SetRole(v OrganizationRole) {
+	x.xxx_hidden_Role = v
+}
+
+type InviteMemberRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OrgId string
+	Email string
+	// Defaults to ORGANIZATION_ROLE_MEMBER.
+	Role OrganizationRole
+}
+
+func (b0 InviteMemberRequest_builder) Build() *InviteMemberRequest {
+	m0 := &InviteMemberRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_OrgId = b.OrgId
+	x.xxx_hidden_Email = b.Email
+	x.xxx_hidden_Role = b.Role
+	return m0
+}
+
+type InviteMemberResponse struct {
+	state                 protoimpl.MessageState  `protogen:"opaque.v1"`
+	xxx_hidden_Invitation *OrganizationInvitation `protobuf:"bytes,1,opt,name=invitation,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *InviteMemberResponse) Reset() {
+	*x = InviteMemberResponse{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteMemberResponse) ProtoMessage() {}
+
+func (x *InviteMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InviteMemberResponse) GetInvitation() *OrganizationInvitation {
+	if x != nil {
+		return x.xxx_hidden_Invitation
+	}
+	return nil
+}
+
+func (x *InviteMemberResponse) SetInvitation(v *OrganizationInvitation) {
+	x.xxx_hidden_Invitation = v
+}
+
+func (x *InviteMemberResponse) HasInvitation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Invitation != nil
+}
+
+func (x *InviteMemberResponse) ClearInvitation() {
+	x.xxx_hidden_Invitation = nil
+}
+
+type InviteMemberResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Invitation *OrganizationInvitation
+}
+
+func (b0 InviteMemberResponse_builder) Build() *InviteMemberResponse {
+	m0 := &InviteMemberResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Invitation = b.Invitation
+	return m0
+}
+
+type ListInvitationsRequest struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OrgId string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListInvitationsRequest) Reset() {
+	*x = ListInvitationsRequest{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsRequest) ProtoMessage() {}
+
+func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListInvitationsRequest) GetOrgId() string {
+	if x != nil {
+		return x.xxx_hidden_OrgId
+	}
+	return ""
+}
+
+func (x *ListInvitationsRequest) SetOrgId(v string) {
+	x.xxx_hidden_OrgId = v
+}
+
+type ListInvitationsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OrgId string
+}
+
+func (b0 ListInvitationsRequest_builder) Build() *ListInvitationsRequest {
+	m0 := &ListInvitationsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_OrgId = b.OrgId
+	return m0
+}
+
+type ListInvitationsResponse struct {
+	state                  protoimpl.MessageState     `protogen:"opaque.v1"`
+	xxx_hidden_Invitations *[]*OrganizationInvitation `protobuf:"bytes,1,rep,name=invitations,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ListInvitationsResponse) Reset() {
+	*x = ListInvitationsResponse{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsResponse) ProtoMessage() {}
+
+func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListInvitationsResponse) GetInvitations() []*OrganizationInvitation {
+	if x != nil {
+		if x.xxx_hidden_Invitations != nil {
+			return *x.xxx_hidden_Invitations
+		}
+	}
+	return nil
+}
+
+func (x *ListInvitationsResponse) SetInvitations(v []*OrganizationInvitation) {
+	x.xxx_hidden_Invitations = &v
+}
+
+type ListInvitationsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Invitations []*OrganizationInvitation
+}
+
+func (b0 ListInvitationsResponse_builder) Build() *ListInvitationsResponse {
+	m0 := &ListInvitationsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Invitations = &b.Invitations
+	return m0
+}
+
+type RevokeInvitationRequest struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OrgId        string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3"`
+	xxx_hidden_InvitationId string                 `protobuf:"bytes,2,opt,name=invitation_id,json=invitationId,proto3"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationRequest) Reset() {
+	*x = RevokeInvitationRequest{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RevokeInvitationRequest) GetOrgId() string {
+	if x != nil {
+		return x.xxx_hidden_OrgId
+	}
+	return ""
+}
+
+func (x *RevokeInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.xxx_hidden_InvitationId
+	}
+	return ""
+}
+
+func (x *RevokeInvitationRequest) SetOrgId(v string) {
+	x.xxx_hidden_OrgId = v
+}
+
+func (x *RevokeInvitationRequest) SetInvitationId(v string) {
+	x.xxx_hidden_InvitationId = v
+}
+
+type RevokeInvitationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OrgId        string
+	InvitationId string
+}
+
+func (b0 RevokeInvitationRequest_builder) Build() *RevokeInvitationRequest {
+	m0 := &RevokeInvitationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_OrgId = b.OrgId
+	x.xxx_hidden_InvitationId = b.InvitationId
+	return m0
+}
+
+type RevokeInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationResponse) Reset() {
+	*x = RevokeInvitationResponse{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationResponse) ProtoMessage() {}
+
+func (x *RevokeInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RevokeInvitationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RevokeInvitationResponse_builder) Build() *RevokeInvitationResponse {
+	m0 := &RevokeInvitationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GetInvitationRequest struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Token string                 `protobuf:"bytes,1,opt,name=token,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetInvitationRequest) Reset() {
+	*x = GetInvitationRequest{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitationRequest) ProtoMessage() {}
+
+func (x *GetInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.xxx_hidden_Token
+	}
+	return ""
+}
+
+func (x *GetInvitationRequest) SetToken(v string) {
+	x.xxx_hidden_Token = v
+}
+
+type GetInvitationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Token string
+}
+
+func (b0 GetInvitationRequest_builder) Build() *GetInvitationRequest {
+	m0 := &GetInvitationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Token = b.Token
+	return m0
+}
+
+type GetInvitationResponse struct {
+	state                   protoimpl.MessageState  `protogen:"opaque.v1"`
+	xxx_hidden_Invitation   *OrganizationInvitation `protobuf:"bytes,1,opt,name=invitation,proto3"`
+	xxx_hidden_Organization *Organization           `protobuf:"bytes,2,opt,name=organization,proto3"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetInvitationResponse) Reset() {
+	*x = GetInvitationResponse{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitationResponse) ProtoMessage() {}
+
+func (x *GetInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetInvitationResponse) GetInvitation() *OrganizationInvitation {
+	if x != nil {
+		return x.xxx_hidden_Invitation
+	}
+	return nil
+}
+
+func (x *GetInvitationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.xxx_hidden_Organization
+	}
+	return nil
+}
+
+func (x *GetInvitationResponse) SetInvitation(v *OrganizationInvitation) {
+	x.xxx_hidden_Invitation = v
+}
+
+func (x *GetInvitationResponse) SetOrganization(v *Organization) {
+	x.xxx_hidden_Organization = v
+}
+
+func (x *GetInvitationResponse) HasInvitation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Invitation != nil
+}
+
+func (x *GetInvitationResponse) HasOrganization() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Organization != nil
+}
+
+func (x *GetInvitationResponse) ClearInvitation() {
+	x.xxx_hidden_Invitation = nil
+}
+
+func (x *GetInvitationResponse) ClearOrganization() {
+	x.xxx_hidden_Organization = nil
+}
+
+type GetInvitationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Invitation   *OrganizationInvitation
+	Organization *Organization
+}
+
+func (b0 GetInvitationResponse_builder) Build() *GetInvitationResponse {
+	m0 := &GetInvitationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Invitation = b.Invitation
+	x.xxx_hidden_Organization = b.Organization
+	return m0
+}
+
+type AcceptInvitationRequest struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Token string                 `protobuf:"bytes,1,opt,name=token,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationRequest) Reset() {
+	*x = AcceptInvitationRequest{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AcceptInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.xxx_hidden_Token
+	}
+	return ""
+}
+
+func (x *AcceptInvitationRequest) SetToken(v string) {
+	x.xxx_hidden_Token = v
+}
+
+type AcceptInvitationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Token string
+}
+
+func (b0 AcceptInvitationRequest_builder) Build() *AcceptInvitationRequest {
+	m0 := &AcceptInvitationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Token = b.Token
+	return m0
+}
+
+type AcceptInvitationResponse struct {
+	state                 protoimpl.MessageState  `protogen:"opaque.v1"`
+	xxx_hidden_Membership *OrganizationMembership `protobuf:"bytes,1,opt,name=membership,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationResponse) Reset() {
+	*x = AcceptInvitationResponse{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationResponse) ProtoMessage() {}
+
+func (x *AcceptInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AcceptInvitationResponse) GetMembership() *OrganizationMembership {
+	if x != nil {
+		return x.xxx_hidden_Membership
+	}
+	return nil
+}
+
+func (x *AcceptInvitationResponse) SetMembership(v *OrganizationMembership) {
+	x.xxx_hidden_Membership = v
+}
+
+func (x *AcceptInvitationResponse) HasMembership() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Membership != nil
+}
+
+func (x *AcceptInvitationResponse) ClearMembership() {
+	x.xxx_hidden_Membership = nil
+}
+
+type AcceptInvitationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Membership *OrganizationMembership
+}
+
+func (b0 AcceptInvitationResponse_builder) Build() *AcceptInvitationResponse {
+	m0 := &AcceptInvitationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Membership = b.Membership
+	return m0
+}
+
+type DeclineInvitationRequest struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Token string                 `protobuf:"bytes,1,opt,name=token,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeclineInvitationRequest) Reset() {
+	*x = DeclineInvitationRequest{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineInvitationRequest) ProtoMessage() {}
+
+func (x *DeclineInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeclineInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.xxx_hidden_Token
+	}
+	return ""
+}
+
+func (x *DeclineInvitationRequest) SetToken(v string) {
+	x.xxx_hidden_Token = v
+}
+
+type DeclineInvitationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Token string
+}
+
+func (b0 DeclineInvitationRequest_builder) Build() *DeclineInvitationRequest {
+	m0 := &DeclineInvitationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Token = b.Token
+	return m0
+}
+
+type DeclineInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineInvitationResponse) Reset() {
+	*x = DeclineInvitationResponse{}
+	mi := &file_guardian_v1_organization_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineInvitationResponse) ProtoMessage() {}
+
+func (x *DeclineInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_organization_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeclineInvitationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeclineInvitationResponse_builder) Build() *DeclineInvitationResponse {
+	m0 := &DeclineInvitationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+var File_guardian_v1_organization_proto protoreflect.FileDescriptor
+
+const file_guardian_v1_organization_proto_rawDesc = "" +
+	"\n" +
+	"\x1eguardian/v1/organization.proto\x12\vguardian.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbc\x01\n" +
+	"\fOrganization\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf1\x01\n" +
+	"\x16OrganizationMembership\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x121\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x1d.guardian.v1.OrganizationRoleR\x04role\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9d\x02\n" +
+	"\x16OrganizationInvitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\tR\x05orgId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x121\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x1d.guardian.v1.OrganizationRoleR\x04role\x12\x1d\n" +
+	"\n" +
+	"inviter_id\x18\x05 \x01(\tR\tinviterId\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"C\n" +
+	"\x19CreateOrganizationRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"[\n" +
+	"\x1aCreateOrganizationResponse\x12=\n" +
+	"\forganization\x18\x01 \x01(\v2\x19.guardian.v1.OrganizationR\forganization\"<\n" +
+	"\x16GetOrganizationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\"X\n" +
+	"\x17GetOrganizationResponse\x12=\n" +
+	"\forganization\x18\x01 \x01(\v2\x19.guardian.v1.OrganizationR\forganization\"\x1a\n" +
+	"\x18ListOrganizationsRequest\"\\\n" +
+	"\x19ListOrganizationsResponse\x12?\n" +
+	"\rorganizations\x18\x01 \x03(\v2\x19.guardian.v1.OrganizationR\rorganizations\"o\n" +
+	"\x19UpdateOrganizationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04slug\x18\x02 \x01(\tH\x00R\x04slug\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x01R\x04name\x88\x01\x01B\a\n" +
+	"\x05_slugB\a\n" +
+	"\x05_name\"[\n" +
+	"\x1aUpdateOrganizationResponse\x12=\n" +
+	"\forganization\x18\x01 \x01(\v2\x19.guardian.v1.OrganizationR\forganization\"+\n" +
+	"\x19DeleteOrganizationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1c\n" +
+	"\x1aDeleteOrganizationResponse\"g\n" +
+	"\x12ListMembersRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x84\x01\n" +
+	"\x13ListMembersResponse\x12E\n" +
+	"\vmemberships\x18\x01 \x03(\v2#.guardian.v1.OrganizationMembershipR\vmemberships\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"|\n" +
+	"\x17UpdateMemberRoleRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x121\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x1d.guardian.v1.OrganizationRoleR\x04role\"_\n" +
+	"\x18UpdateMemberRoleResponse\x12C\n" +
+	"\n" +
+	"membership\x18\x01 \x01(\v2#.guardian.v1.OrganizationMembershipR\n" +
+	"membership\"E\n" +
+	"\x13RemoveMemberRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x16\n" +
+	"\x14RemoveMemberResponse\"J\n" +
+	"\x18TransferOwnershipRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x1b\n" +
+	"\x19TransferOwnershipResponse\"u\n" +
+	"\x13InviteMemberRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x121\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x1d.guardian.v1.OrganizationRoleR\x04role\"[\n" +
+	"\x14InviteMemberResponse\x12C\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2#.guardian.v1.OrganizationInvitationR\n" +
+	"invitation\"/\n" +
+	"\x16ListInvitationsRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"`\n" +
+	"\x17ListInvitationsResponse\x12E\n" +
+	"\vinvitations\x18\x01 \x03(\v2#.guardian.v1.OrganizationInvitationR\vinvitations\"U\n" +
+	"\x17RevokeInvitationRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12#\n" +
+	"\rinvitation_id\x18\x02 \x01(\tR\finvitationId\"\x1a\n" +
+	"\x18RevokeInvitationResponse\",\n" +
+	"\x14GetInvitationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x9b\x01\n" +
+	"\x15GetInvitationResponse\x12C\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2#.guardian.v1.OrganizationInvitationR\n" +
+	"invitation\x12=\n" +
+	"\forganization\x18\x02 \x01(\v2\x19.guardian.v1.OrganizationR\forganization\"/\n" +
+	"\x17AcceptInvitationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"_\n" +
+	"\x18AcceptInvitationResponse\x12C\n" +
+	"\n" +
+	"membership\x18\x01 \x01(\v2#.guardian.v1.OrganizationMembershipR\n" +
+	"membership\"0\n" +
+	"\x18DeclineInvitationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x1b\n" +
+	"\x19DeclineInvitationResponse*\x8d\x01\n" +
+	"\x10OrganizationRole\x12!\n" +
+	"\x1dORGANIZATION_ROLE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17ORGANIZATION_ROLE_OWNER\x10\x01\x12\x1b\n" +
+	"\x17ORGANIZATION_ROLE_ADMIN\x10\x02\x12\x1c\n" +
+	"\x18ORGANIZATION_ROLE_MEMBER\x10\x032\xa9\v\n" +
+	"\x13OrganizationService\x12e\n" +
+	"\x12CreateOrganization\x12&.guardian.v1.CreateOrganizationRequest\x1a'.guardian.v1.CreateOrganizationResponse\x12\\\n" +
+	"\x0fGetOrganization\x12#.guardian.v1.GetOrganizationRequest\x1a$.guardian.v1.GetOrganizationResponse\x12b\n" +
+	"\x11ListOrganizations\x12%.guardian.v1.ListOrganizationsRequest\x1a&.guardian.v1.ListOrganizationsResponse\x12e\n" +
+	"\x12UpdateOrganization\x12&.guardian.v1.UpdateOrganizationRequest\x1a'.guardian.v1.UpdateOrganizationResponse\x12e\n" +
+	"\x12DeleteOrganization\x12&.guardian.v1.DeleteOrganizationRequest\x1a'.guardian.v1.DeleteOrganizationResponse\x12P\n" +
+	"\vListMembers\x12\x1f.guardian.v1.ListMembersRequest\x1a .guardian.v1.ListMembersResponse\x12_\n" +
+	"\x10UpdateMemberRole\x12$.guardian.v1.UpdateMemberRoleRequest\x1a%.guardian.v1.UpdateMemberRoleResponse\x12S\n" +
+	"\fRemoveMember\x12 .guardian.v1.RemoveMemberRequest\x1a!.guardian.v1.RemoveMemberResponse\x12b\n" +
+	"\x11TransferOwnership\x12%.guardian.v1.TransferOwnershipRequest\x1a&.guardian.v1.TransferOwnershipResponse\x12S\n" +
+	"\fInviteMember\x12 .guardian.v1.InviteMemberRequest\x1a!.guardian.v1.InviteMemberResponse\x12\\\n" +
+	"\x0fListInvitations\x12#.guardian.v1.ListInvitationsRequest\x1a$.guardian.v1.ListInvitationsResponse\x12_\n" +
+	"\x10RevokeInvitation\x12$.guardian.v1.RevokeInvitationRequest\x1a%.guardian.v1.RevokeInvitationResponse\x12V\n" +
+	"\rGetInvitation\x12!.guardian.v1.GetInvitationRequest\x1a\".guardian.v1.GetInvitationResponse\x12_\n" +
+	"\x10AcceptInvitation\x12$.guardian.v1.AcceptInvitationRequest\x1a%.guardian.v1.AcceptInvitationResponse\x12b\n" +
+	"\x11DeclineInvitation\x12%.guardian.v1.DeclineInvitationRequest\x1a&.guardian.v1.DeclineInvitationResponseB\xb0\x01\n" +
+	"\x0fcom.guardian.v1B\x11OrganizationProtoP\x01Z=github.com/gophero/guardian/core/proto/guardian/v1;guardianv1\xa2\x02\x03GVX\xaa\x02\vGuardian.V1\xca\x02\vGuardian\\V1\xe2\x02\x17Guardian\\V1\\GPBMetadata\xea\x02\fGuardian::V1b\x06proto3"
+
+var file_guardian_v1_organization_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_guardian_v1_organization_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_guardian_v1_organization_proto_goTypes = []any{
+	(OrganizationRole)(0),              // 0: guardian.v1.OrganizationRole
+	(*Organization)(nil),               // 1: guardian.v1.Organization
+	(*OrganizationMembership)(nil),     // 2: guardian.v1.OrganizationMembership
+	(*OrganizationInvitation)(nil),     // 3: guardian.v1.OrganizationInvitation
+	(*CreateOrganizationRequest)(nil),  // 4: guardian.v1.CreateOrganizationRequest
+	(*CreateOrganizationResponse)(nil), // 5: guardian.v1.CreateOrganizationResponse
+	(*GetOrganizationRequest)(nil),     // 6: guardian.v1.GetOrganizationRequest
+	(*GetOrganizationResponse)(nil),    // 7: guardian.v1.GetOrganizationResponse
+	(*ListOrganizationsRequest)(nil),   // 8: guardian.v1.ListOrganizationsRequest
+	(*ListOrganizationsResponse)(nil),  // 9: guardian.v1.ListOrganizationsResponse
+	(*UpdateOrganizationRequest)(nil),  // 10: guardian.v1.UpdateOrganizationRequest
+	(*UpdateOrganizationResponse)(nil), // 11: guardian.v1.UpdateOrganizationResponse
+	(*DeleteOrganizationRequest)(nil),  // 12: guardian.v1.DeleteOrganizationRequest
+	(*DeleteOrganizationResponse)(nil), // 13: guardian.v1.DeleteOrganizationResponse
+	(*ListMembersRequest)(nil),         // 14: guardian.v1.ListMembersRequest
+	(*ListMembersResponse)(nil),        // 15: guardian.v1.ListMembersResponse
+	(*UpdateMemberRoleRequest)(nil),    // 16: guardian.v1.UpdateMemberRoleRequest
+	(*UpdateMemberRoleResponse)(nil),   // 17: guardian.v1.UpdateMemberRoleResponse
+	(*RemoveMemberRequest)(nil),        // 18: guardian.v1.RemoveMemberRequest
+	(*RemoveMemberResponse)(nil),       // 19: guardian.v1.RemoveMemberResponse
+	(*TransferOwnershipRequest)(nil),   // 20: guardian.v1.TransferOwnershipRequest
+	(*TransferOwnershipResponse)(nil),  // 21: guardian.v1.TransferOwnershipResponse
+	(*InviteMemberRequest)(nil),        // 22: guardian.v1.InviteMemberRequest
+	(*InviteMemberResponse)(nil),       // 23: guardian.v1.InviteMemberResponse
+	(*ListInvitationsRequest)(nil),     // 24: guardian.v1.ListInvitationsRequest
+	(*ListInvitationsResponse)(nil),    // 25: guardian.v1.ListInvitationsResponse
+	(*RevokeInvitationRequest)(nil),    // 26: guardian.v1.RevokeInvitationRequest
+	(*RevokeInvitationResponse)(nil),   // 27: guardian.v1.RevokeInvitationResponse
+	(*GetInvitationRequest)(nil),       // 28: guardian.v1.GetInvitationRequest
+	(*GetInvitationResponse)(nil),      // 29: guardian.v1.GetInvitationResponse
+	(*AcceptInvitationRequest)(nil),    // 30: guardian.v1.AcceptInvitationRequest
+	(*AcceptInvitationResponse)(nil),   // 31: guardian.v1.AcceptInvitationResponse
+	(*DeclineInvitationRequest)(nil),   // 32: guardian.v1.DeclineInvitationRequest
+	(*DeclineInvitationResponse)(nil),  // 33: guardian.v1.DeclineInvitationResponse
+	(*timestamppb.Timestamp)(nil),      // 34: google.protobuf.Timestamp
+}
+var file_guardian_v1_organization_proto_depIdxs = []int32{
+	34, // 0: guardian.v1.Organization.created_at:type_name -> google.protobuf.Timestamp
+	34, // 1: guardian.v1.Organization.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: guardian.v1.OrganizationMembership.role:type_name -> guardian.v1.OrganizationRole
+	34, // 3: guardian.v1.OrganizationMembership.created_at:type_name -> google.protobuf.Timestamp
+	34, // 4: guardian.v1.OrganizationMembership.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: guardian.v1.OrganizationInvitation.role:type_name -> guardian.v1.OrganizationRole
+	34, // 6: guardian.v1.OrganizationInvitation.created_at:type_name -> google.protobuf.Timestamp
+	34, // 7: guardian.v1.OrganizationInvitation.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 8: guardian.v1.CreateOrganizationResponse.organization:type_name -> guardian.v1.Organization
+	1,  // 9: guardian.v1.GetOrganizationResponse.organization:type_name -> guardian.v1.Organization
+	1,  // 10: guardian.v1.ListOrganizationsResponse.organizations:type_name -> guardian.v1.Organization
+	1,  // 11: guardian.v1.UpdateOrganizationResponse.organization:type_name -> guardian.v1.Organization
+	2,  // 12: guardian.v1.ListMembersResponse.memberships:type_name -> guardian.v1.OrganizationMembership
+	0,  // 13: guardian.v1.UpdateMemberRoleRequest.role:type_name -> guardian.v1.OrganizationRole
+	2,  // 14: guardian.v1.UpdateMemberRoleResponse.membership:type_name -> guardian.v1.OrganizationMembership
+	0,  // 15: guardian.v1.InviteMemberRequest.role:type_name -> guardian.v1.OrganizationRole
+	3,  // 16: guardian.v1.InviteMemberResponse.invitation:type_name -> guardian.v1.OrganizationInvitation
+	3,  // 17: guardian.v1.ListInvitationsResponse.invitations:type_name -> guardian.v1.OrganizationInvitation
+	3,  // 18: guardian.v1.GetInvitationResponse.invitation:type_name -> guardian.v1.OrganizationInvitation
+	1,  // 19: guardian.v1.GetInvitationResponse.organization:type_name -> guardian.v1.Organization
+	2,  // 20: guardian.v1.AcceptInvitationResponse.membership:type_name -> guardian.v1.OrganizationMembership
+	4,  // 21: guardian.v1.OrganizationService.CreateOrganization:input_type -> guardian.v1.CreateOrganizationRequest
+	6,  // 22: guardian.v1.OrganizationService.GetOrganization:input_type -> guardian.v1.GetOrganizationRequest
+	8,  // 23: guardian.v1.OrganizationService.ListOrganizations:input_type -> guardian.v1.ListOrganizationsRequest
+	10, // 24: guardian.v1.OrganizationService.UpdateOrganization:input_type -> guardian.v1.UpdateOrganizationRequest
+	12, // 25: guardian.v1.OrganizationService.DeleteOrganization:input_type -> guardian.v1.DeleteOrganizationRequest
+	14, // 26: guardian.v1.OrganizationService.ListMembers:input_type -> guardian.v1.ListMembersRequest
+	16, // 27: guardian.v1.OrganizationService.UpdateMemberRole:input_type -> guardian.v1.UpdateMemberRoleRequest
+	18, // 28: guardian.v1.OrganizationService.RemoveMember:input_type -> guardian.v1.RemoveMemberRequest
+	20, // 29: guardian.v1.OrganizationService.TransferOwnership:input_type -> guardian.v1.TransferOwnershipRequest
+	22, // 30: guardian.v1.OrganizationService.InviteMember:input_type -> guardian.v1.InviteMemberRequest
+	24, // 31: guardian.v1.OrganizationService.ListInvitations:input_type -> guardian.v1.ListInvitationsRequest
+	26, // 32: guardian.v1.OrganizationService.RevokeInvitation:input_type -> guardian.v1.RevokeInvitationRequest
+	28, // 33: guardian.v1.OrganizationService.GetInvitation:input_type -> guardian.v1.GetInvitationRequest
+	30, // 34: guardian.v1.OrganizationService.AcceptInvitation:input_type -> guardian.v1.AcceptInvitationRequest
+	32, // 35: guardian.v1.OrganizationService.DeclineInvitation:input_type -> guardian.v1.DeclineInvitationRequest
+	5,  // 36: guardian.v1.OrganizationService.CreateOrganization:output_type -> guardian.v1.CreateOrganizationResponse
+	7,  // 37: guardian.v1.OrganizationService.GetOrganization:output_type -> guardian.v1.GetOrganizationResponse
+	9,  // 38: guardian.v1.OrganizationService.ListOrganizations:output_type -> guardian.v1.ListOrganizationsResponse
+	11, // 39: guardian.v1.OrganizationService.UpdateOrganization:output_type -> guardian.v1.UpdateOrganizationResponse
+	13, // 40: guardian.v1.OrganizationService.DeleteOrganization:output_type -> guardian.v1.DeleteOrganizationResponse
+	15, // 41: guardian.v1.OrganizationService.ListMembers:output_type -> guardian.v1.ListMembersResponse
+	17, // 42: guardian.v1.OrganizationService.UpdateMemberRole:output_type -> guardian.v1.UpdateMemberRoleResponse
+	19, // 43: guardian.v1.OrganizationService.RemoveMember:output_type -> guardian.v1.RemoveMemberResponse
+	21, // 44: guardian.v1.OrganizationService.TransferOwnership:output_type -> guardian.v1.TransferOwnershipResponse
+	23, // 45: guardian.v1.OrganizationService.InviteMember:output_type -> guardian.v1.InviteMemberResponse
+	25, // 46: guardian.v1.OrganizationService.ListInvitations:output_type -> guardian.v1.ListInvitationsResponse
+	27, // 47: guardian.v1.OrganizationService.RevokeInvitation:output_type -> guardian.v1.RevokeInvitationResponse
+	29, // 48: guardian.v1.OrganizationService.GetInvitation:output_type -> guardian.v1.GetInvitationResponse
+	31, // 49: guardian.v1.OrganizationService.AcceptInvitation:output_type -> guardian.v1.AcceptInvitationResponse
+	33, // 50: guardian.v1.OrganizationService.DeclineInvitation:output_type -> guardian.v1.DeclineInvitationResponse
+	36, // [36:51] is the sub-list for method output_type
+	21, // [21:36] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_guardian_v1_organization_proto_init() }
+func file_guardian_v1_organization_proto_init() {
+	if File_guardian_v1_organization_proto != nil {
+		return
+	}
+	file_guardian_v1_organization_proto_msgTypes[9].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guardian_v1_organization_proto_rawDesc), len(file_guardian_v1_organization_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   33,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_guardian_v1_organization_proto_goTypes,
+		DependencyIndexes: file_guardian_v1_organization_proto_depIdxs,
+		EnumInfos:         file_guardian_v1_organization_proto_enumTypes,
+		MessageInfos:      file_guardian_v1_organization_proto_msgTypes,
+	}.Build()
+	File_guardian_v1_organization_proto = out.File
+	file_guardian_v1_organization_proto_goTypes = nil
+	file_guardian_v1_organization_proto_depIdxs = nil
+}
@@ -0,0 +1,805 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: guardian/v1/passkey.proto
+
+package guardianv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Passkey is a WebAuthn credential of a user.
+type Passkey struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id                string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Name              string                 `protobuf:"bytes,2,opt,name=name,proto3"`
+	xxx_hidden_AttestationFormat string                 `protobuf:"bytes,3,opt,name=attestation_format,json=attestationFormat,proto3"`
+	xxx_hidden_Aaguid            string                 `protobuf:"bytes,4,opt,name=aaguid,proto3"`
+	xxx_hidden_Transports        []string               `protobuf:"bytes,5,rep,name=transports,proto3"`
+	xxx_hidden_BackedUp          bool                   `protobuf:"varint,6,opt,name=backed_up,json=backedUp,proto3"`
+	xxx_hidden_CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3"`
+	xxx_hidden_LastUsedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_used_at,json=lastUsedAt,proto3"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *Passkey) Reset() {
+	*x = Passkey{}
+	mi := &file_guardian_v1_passkey_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Passkey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Passkey) ProtoMessage() {}
+
+func (x *Passkey) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_passkey_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Passkey) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *Passkey) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *Passkey) GetAttestationFormat() string {
+	if x != nil {
+		return x.xxx_hidden_AttestationFormat
+	}
+	return ""
+}
+
+func (x *Passkey) GetAaguid() string {
+	if x != nil {
+		return x.xxx_hidden_Aaguid
+	}
+	return ""
+}
+
+func (x *Passkey) GetTransports() []string {
+	if x != nil {
+		return x.xxx_hidden_Transports
+	}
+	return nil
+}
+
+func (x *Passkey) GetBackedUp() bool {
+	if x != nil {
+		return x.xxx_hidden_BackedUp
+	}
+	return false
+}
+
+func (x *Passkey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *Passkey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_LastUsedAt
+	}
+	return nil
+}
+
+func (x *Passkey) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *Passkey) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *Passkey) SetAttestationFormat(v string) {
+	x.xxx_hidden_AttestationFormat = v
+}
+
+func (x *Passkey) SetAaguid(v string) {
+	x.xxx_hidden_Aaguid = v
+}
+
+func (x *Passkey) SetTransports(v []string) {
+	x.xxx_hidden_Transports = v
+}
+
+func (x *Passkey) SetBackedUp(v bool) {
+	x.xxx_hidden_BackedUp = v
+}
+
+func (x *Passkey) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *Passkey) SetLastUsedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_LastUsedAt = v
+}
+
+func (x *Passkey) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *Passkey) HasLastUsedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_LastUsedAt != nil
+}
+
+func (x *Passkey) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *Passkey) ClearLastUsedAt() {
+	x.xxx_hidden_LastUsedAt = nil
+}
+
+type Passkey_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id   string
+	Name string
+	// Attestation statement format the passkey was registered with.
+	AttestationFormat string
+	// Identifies the authenticator model. All zeros for "none" attestation.
+	Aaguid     string
+	Transports []string
+	// Whether the passkey is synced to other devices.
+	BackedUp   bool
+	CreatedAt  *timestamppb.Timestamp
+	LastUsedAt *timestamppb.Timestamp
+}
+
+func (b0 Passkey_builder) Build() *Passkey {
+	m0 := &Passkey{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_AttestationFormat = b.AttestationFormat
+	x.xxx_hidden_Aaguid = b.Aaguid
+	x.xxx_hidden_Transports = b.Transports
+	x.xxx_hidden_BackedUp = b.BackedUp
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_LastUsedAt = b.LastUsedAt
+	return m0
+}
+
+type BeginPasskeyRegistrationRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginPasskeyRegistrationRequest) Reset() {
+	*x = BeginPasskeyRegistrationRequest{}
+	mi := &file_guardian_v1_passkey_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeyRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeyRegistrationRequest) ProtoMessage() {}
+
+func (x *BeginPasskeyRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_passkey_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type BeginPasskeyRegistrationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 BeginPasskeyRegistrationRequest_builder) Build() *BeginPasskeyRegistrationRequest {
+	m0 := &BeginPasskeyRegistrationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type BeginPasskeyRegistrationResponse struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_CeremonyId string                 `protobuf:"bytes,1,opt,name=ceremony_id,json=ceremonyId,proto3"`
+	xxx_hidden_Options    string                 `protobuf:"bytes,2,opt,name=options,proto3"`
+	xxx_hidden_ExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *BeginPasskeyRegistrationResponse) Reset() {
+	*x = BeginPasskeyRegistrationResponse{}
+	mi := &file_guardian_v1_passkey_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeyRegistrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeyRegistrationResponse) ProtoMessage() {}
+
+func (x *BeginPasskeyRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_passkey_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BeginPasskeyRegistrationResponse) GetCeremonyId() string {
+	if x != nil {
+		return x.xxx_hidden_CeremonyId
+	}
+	return ""
+}
+
+func (x *BeginPasskeyRegistrationResponse) GetOptions() string {
+	if x != nil {
+		return x.xxx_hidden_Options
+	}
+	return ""
+}
+
+func (x *BeginPasskeyRegistrationResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ExpiresAt
+	}
+	return nil
+}
+
+func (x *BeginPasskeyRegistrationResponse) SetCeremonyId(v string) {
+	x.xxx_hidden_CeremonyId = v
+}
+
+func (x *BeginPasskeyRegistrationResponse) SetOptions(v string) {
+	x.xxx_hidden_Options = v
+}
+
+func (x *BeginPasskeyRegistrationResponse) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ExpiresAt = v
+}
+
+func (x *BeginPasskeyRegistrationResponse) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExpiresAt != nil
+}
+
+func (x *BeginPasskeyRegistrationResponse) ClearExpiresAt() {
+	x.xxx_hidden_ExpiresAt = nil
+}
+
+type BeginPasskeyRegistrationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	CeremonyId string
+	// JSON encoded options for navigator.credentials.create().
+	Options   string
+	ExpiresAt *timestamppb.Timestamp
+}
+
+func (b0 BeginPasskeyRegistrationResponse_builder) Build() *BeginPasskeyRegistrationResponse {
+	m0 := &BeginPasskeyRegistrationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_CeremonyId = b.CeremonyId
+	x.xxx_hidden_Options = b.Options
+	x.xxx_hidden_ExpiresAt = b.ExpiresAt
+	return m0
+}
+
+type FinishPasskeyRegistrationRequest struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_CeremonyId string                 `protobuf:"bytes,1,opt,name=ceremony_id,json=ceremonyId,proto3"`
+	xxx_hidden_Credential string                 `protobuf:"bytes,2,opt,name=credential,proto3"`
+	xxx_hidden_Name       string                 `protobuf:"bytes,3,opt,name=name,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *FinishPasskeyRegistrationRequest) Reset() {
+	*x = FinishPasskeyRegistrationRequest{}
+	mi := &file_guardian_v1_passkey_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishPasskeyRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishPasskeyRegistrationRequest) ProtoMessage() {}
+
+func (x *FinishPasskeyRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_passkey_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetCeremonyId() string {
+	if x != nil {
+		return x.xxx_hidden_CeremonyId
+	}
+	return ""
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetCredential() string {
+	if x != nil {
+		return x.xxx_hidden_Credential
+	}
+	return ""
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *FinishPasskeyRegistrationRequest) SetCeremonyId(v string) {
+	x.xxx_hidden_CeremonyId = v
+}
+
+func (x *FinishPasskeyRegistrationRequest) SetCredential(v string) {
+	x.xxx_hidden_Credential = v
+}
+
+func (x *FinishPasskeyRegistrationRequest) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+type FinishPasskeyRegistrationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	CeremonyId string
+	// JSON encoded PublicKeyCredential returned by navigator.credentials.create().
+	Credential string
+	// Human readable name of the passkey.
+	Name string
+}
+
+func (b0 FinishPasskeyRegistrationRequest_builder) Build() *FinishPasskeyRegistrationRequest {
+	m0 := &FinishPasskeyRegistrationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_CeremonyId = b.CeremonyId
+	x.xxx_hidden_Credential = b.Credential
+	x.xxx_hidden_Name = b.Name
+	return m0
+}
+
+type FinishPasskeyRegistrationResponse struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Passkey *Passkey               `protobuf:"bytes,1,opt,name=passkey,proto3"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *FinishPasskeyRegistrationResponse) Reset() {
+	*x = FinishPasskeyRegistrationResponse{}
+	mi := &file_guardian_v1_passkey_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishPasskeyRegistrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishPasskeyRegistrationResponse) ProtoMessage() {}
+
+func (x *FinishPasskeyRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_passkey_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FinishPasskeyRegistrationResponse) GetPasskey() *Passkey {
+	if x != nil {
+		return x.xxx_hidden_Passkey
+	}
+	return nil
+}
+
+func (x *FinishPasskeyRegistrationResponse) SetPasskey(v *Passkey) {
+	x.xxx_hidden_Passkey = v
+}
+
+func (x *FinishPasskeyRegistrationResponse) HasPasskey() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Passkey != nil
+}
+
+func (x *FinishPasskeyRegistrationResponse) ClearPasskey() {
+	x.xxx_hidden_Passkey = nil
+}
+
+type FinishPasskeyRegistrationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Passkey *Passkey
+}
+
+func (b0 FinishPasskeyRegistrationResponse_builder) Build() *FinishPasskeyRegistrationResponse {
+	m0 := &FinishPasskeyRegistrationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Passkey = b.Passkey
+	return m0
+}
+
+type ListPasskeysRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPasskeysRequest) Reset() {
+	*x = ListPasskeysRequest{}
+	mi := &file_guardian_v1_passkey_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPasskeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPasskeysRequest) ProtoMessage() {}
+
+func (x *ListPasskeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_passkey_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type ListPasskeysRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 ListPasskeysRequest_builder) Build() *ListPasskeysRequest {
+	m0 := &ListPasskeysRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type ListPasskeysResponse struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Passkeys *[]*Passkey            `protobuf:"bytes,1,rep,name=passkeys,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListPasskeysResponse) Reset() {
+	*x = ListPasskeysResponse{}
+	mi := &file_guardian_v1_passkey_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPasskeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPasskeysResponse) ProtoMessage() {}
+
+func (x *ListPasskeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_passkey_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListPasskeysResponse) GetPasskeys() []*Passkey {
+	if x != nil {
+		if x.xxx_hidden_Passkeys != nil {
+			return *x.xxx_hidden_Passkeys
+		}
+	}
+	return nil
+}
+
+func (x *ListPasskeysResponse) SetPasskeys(v []*Passkey) {
+	x.xxx_hidden_Passkeys = &v
+}
+
+type ListPasskeysResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Passkeys []*Passkey
+}
+
+func (b0 ListPasskeysResponse_builder) Build() *ListPasskeysResponse {
+	m0 := &ListPasskeysResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Passkeys = &b.Passkeys
+	return m0
+}
+
+type DeletePasskeyRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePasskeyRequest) Reset() {
+	*x = DeletePasskeyRequest{}
+	mi := &file_guardian_v1_passkey_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePasskeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePasskeyRequest) ProtoMessage() {}
+
+func (x *DeletePasskeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_passkey_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeletePasskeyRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *DeletePasskeyRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type DeletePasskeyRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 DeletePasskeyRequest_builder) Build() *DeletePasskeyRequest {
+	m0 := &DeletePasskeyRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type DeletePasskeyResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePasskeyResponse) Reset() {
+	*x = DeletePasskeyResponse{}
+	mi := &file_guardian_v1_passkey_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePasskeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePasskeyResponse) ProtoMessage() {}
+
+func (x *DeletePasskeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_passkey_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeletePasskeyResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeletePasskeyResponse_builder) Build() *DeletePasskeyResponse {
+	m0 := &DeletePasskeyResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+var File_guardian_v1_passkey_proto protoreflect.FileDescriptor
+
+const file_guardian_v1_passkey_proto_rawDesc = "" +
+	"\n" +
+	"\x19guardian/v1/passkey.proto\x12\vguardian.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaa\x02\n" +
+	"\aPasskey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12-\n" +
+	"\x12attestation_format\x18\x03 \x01(\tR\x11attestationFormat\x12\x16\n" +
+	"\x06aaguid\x18\x04 \x01(\tR\x06aaguid\x12\x1e\n" +
+	"\n" +
+	"transports\x18\x05 \x03(\tR\n" +
+	"transports\x12\x1b\n" +
+	"\tbacked_up\x18\x06 \x01(\bR\bbackedUp\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"!\n" +
+	"\x1fBeginPasskeyRegistrationRequest\"\x98\x01\n" +
+	" BeginPasskeyRegistrationResponse\x12\x1f\n" +
+	"\vceremony_id\x18\x01 \x01(\tR\n" +
+	"ceremonyId\x12\x18\n" +
+	"\aoptions\x18\x02 \x01(\tR\aoptions\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"w\n" +
+	" FinishPasskeyRegistrationRequest\x12\x1f\n" +
+	"\vceremony_id\x18\x01 \x01(\tR\n" +
+	"ceremonyId\x12\x1e\n" +
+	"\n" +
+	"credential\x18\x02 \x01(\tR\n" +
+	"credential\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"S\n" +
+	"!FinishPasskeyRegistrationResponse\x12.\n" +
+	"\apasskey\x18\x01 \x01(\v2\x14.guardian.v1.PasskeyR\apasskey\"\x15\n" +
+	"\x13ListPasskeysRequest\"H\n" +
+	"\x14ListPasskeysResponse\x120\n" +
+	"\bpasskeys\x18\x01 \x03(\v2\x14.guardian.v1.PasskeyR\bpasskeys\"&\n" +
+	"\x14DeletePasskeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
+	"\x15DeletePasskeyResponse2\xb2\x03\n" +
+	"\x0ePasskeyService\x12w\n" +
+	"\x18BeginPasskeyRegistration\x12,.guardian.v1.BeginPasskeyRegistrationRequest\x1a-.guardian.v1.BeginPasskeyRegistrationResponse\x12z\n" +
+	"\x19FinishPasskeyRegistration\x12-.guardian.v1.FinishPasskeyRegistrationRequest\x1a..guardian.v1.FinishPasskeyRegistrationResponse\x12S\n" +
+	"\fListPasskeys\x12 .guardian.v1.ListPasskeysRequest\x1a!.guardian.v1.ListPasskeysResponse\x12V\n" +
+	"\rDeletePasskey\x12!.guardian.v1.DeletePasskeyRequest\x1a\".guardian.v1.DeletePasskeyResponseB\xab\x01\n" +
+	"\x0fcom.guardian.v1B\fPasskeyProtoP\x01Z=github.com/gophero/guardian/core/proto/guardian/v1;guardianv1\xa2\x02\x03GVX\xaa\x02\vGuardian.V1\xca\x02\vGuardian\\V1\xe2\x02\x17Guardian\\V1\\GPBMetadata\xea\x02\fGuardian::V1b\x06proto3"
+
+var file_guardian_v1_passkey_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_guardian_v1_passkey_proto_goTypes = []any{
+	(*Passkey)(nil),                           // 0: guardian.v1.Passkey
+	(*BeginPasskeyRegistrationRequest)(nil),   // 1: guardian.v1.BeginPasskeyRegistrationRequest
+	(*BeginPasskeyRegistrationResponse)(nil),  // 2: guardian.v1.BeginPasskeyRegistrationResponse
+	(*FinishPasskeyRegistrationRequest)(nil),  // 3: guardian.v1.FinishPasskeyRegistrationRequest
+	(*FinishPasskeyRegistrationResponse)(nil), // 4: guardian.v1.FinishPasskeyRegistrationResponse
+	(*ListPasskeysRequest)(nil),               // 5: guardian.v1.ListPasskeysRequest
+	(*ListPasskeysResponse)(nil),              // 6: guardian.v1.ListPasskeysResponse
+	(*DeletePasskeyRequest)(nil),              // 7: guardian.v1.DeletePasskeyRequest
+	(*DeletePasskeyResponse)(nil),             // 8: guardian.v1.DeletePasskeyResponse
+	(*timestamppb.Timestamp)(nil),             // 9: google.protobuf.Timestamp
+}
+var file_guardian_v1_passkey_proto_depIdxs = []int32{
+	9, // 0: guardian.v1.Passkey.created_at:type_name -> google.protobuf.Timestamp
+	9, // 1: guardian.v1.Passkey.last_used_at:type_name -> google.protobuf.Timestamp
+	9, // 2: guardian.v1.BeginPasskeyRegistrationResponse.expires_at:type_name -> google.protobuf.Timestamp
+	0, // 3: guardian.v1.FinishPasskeyRegistrationResponse.passkey:type_name -> guardian.v1.Passkey
+	0, // 4: guardian.v1.ListPasskeysResponse.passkeys:type_name -> guardian.v1.Passkey
+	1, // 5: guardian.v1.PasskeyService.BeginPasskeyRegistration:input_type -> guardian.v1.BeginPasskeyRegistrationRequest
+	3, // 6: guardian.v1.PasskeyService.FinishPasskeyRegistration:input_type -> guardian.v1.FinishPasskeyRegistrationRequest
+	5, // 7: guardian.v1.PasskeyService.ListPasskeys:input_type -> guardian.v1.ListPasskeysRequest
+	7, // 8: guardian.v1.PasskeyService.DeletePasskey:input_type -> guardian.v1.DeletePasskeyRequest
+	2, // 9: guardian.v1.PasskeyService.BeginPasskeyRegistration:output_type -> guardian.v1.BeginPasskeyRegistrationResponse
+	4, // 10: guardian.v1.PasskeyService.FinishPasskeyRegistration:output_type -> guardian.v1.FinishPasskeyRegistrationResponse
+	6, // 11: guardian.v1.PasskeyService.ListPasskeys:output_type -> guardian.v1.ListPasskeysResponse
+	8, // 12: guardian.v1.PasskeyService.DeletePasskey:output_type -> guardian.v1.DeletePasskeyResponse
+	9, // [9:13] is the sub-list for method output_type
+	5, // [5:9] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_guardian_v1_passkey_proto_init() }
+func file_guardian_v1_passkey_proto_init() {
+	if File_guardian_v1_passkey_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guardian_v1_passkey_proto_rawDesc), len(file_guardian_v1_passkey_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_guardian_v1_passkey_proto_goTypes,
+		DependencyIndexes: file_guardian_v1_passkey_proto_depIdxs,
+		MessageInfos:      file_guardian_v1_passkey_proto_msgTypes,
+	}.Build()
+	File_guardian_v1_passkey_proto = out.File
+	file_guardian_v1_passkey_proto_goTypes = nil
+	file_guardian_v1_passkey_proto_depIdxs = nil
+}
@@ -0,0 +1,2298 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: guardian/v1/relation.proto
+
+package guardianv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UsersetOperation is the kind of a UsersetTree node.
+type UsersetOperation int32
+
+const (
+	UsersetOperation_USERSET_OPERATION_UNSPECIFIED UsersetOperation = 0
+	// Leaves hold the subjects of tuples, which may be usersets that are not expanded further.
+	UsersetOperation_USERSET_OPERATION_LEAF         UsersetOperation = 1
+	UsersetOperation_USERSET_OPERATION_UNION        UsersetOperation = 2
+	UsersetOperation_USERSET_OPERATION_INTERSECTION UsersetOperation = 3
+	// The second child is subtracted from the first.
+	UsersetOperation_USERSET_OPERATION_EXCLUSION UsersetOperation = 4
+)
+
+// Enum value maps for UsersetOperation.
+var (
+	UsersetOperation_name = map[int32]string{
+		0: "USERSET_OPERATION_UNSPECIFIED",
+		1: "USERSET_OPERATION_LEAF",
+		2: "USERSET_OPERATION_UNION",
+		3: "USERSET_OPERATION_INTERSECTION",
+		4: "USERSET_OPERATION_EXCLUSION",
+	}
+	UsersetOperation_value = map[string]int32{
+		"USERSET_OPERATION_UNSPECIFIED":  0,
+		"USERSET_OPERATION_LEAF":         1,
+		"USERSET_OPERATION_UNION":        2,
+		"USERSET_OPERATION_INTERSECTION": 3,
+		"USERSET_OPERATION_EXCLUSION":    4,
+	}
+)
+
+func (x UsersetOperation) Enum() *UsersetOperation {
+	p := new(UsersetOperation)
+	*p = x
+	return p
+}
+
+func (x UsersetOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UsersetOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_guardian_v1_relation_proto_enumTypes[0].Descriptor()
+}
+
+func (UsersetOperation) Type() protoreflect.EnumType {
+	return &file_guardian_v1_relation_proto_enumTypes[0]
+}
+
+func (x UsersetOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// ObjectRef identifies an object like `document:readme`.
+type ObjectRef struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3"`
+	xxx_hidden_Id        string                 `protobuf:"bytes,2,opt,name=id,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ObjectRef) Reset() {
+	*x = ObjectRef{}
+	mi := &file_guardian_v1_relation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectRef) ProtoMessage() {}
+
+func (x *ObjectRef) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_relation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ObjectRef) GetNamespace() string {
+	if x != nil {
+		return x.xxx_hidden_Namespace
+	}
+	return ""
+}
+
+func (x *ObjectRef) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *ObjectRef) SetNamespace(v string) {
+	x.xxx_hidden_Namespace = v
+}
+
+func (x *ObjectRef) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type ObjectRef_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Namespace string
+	Id        string
+}
+
+func (b0 ObjectRef_builder) Build() *ObjectRef {
+	m0 := &ObjectRef{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Namespace = b.Namespace
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+// SubjectRef is an object or, with a relation, the set of subjects having the relation to the object.
+type SubjectRef struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3"`
+	xxx_hidden_Id        string                 `protobuf:"bytes,2,opt,name=id,proto3"`
+	xxx_hidden_Relation  string                 `protobuf:"bytes,3,opt,name=relation,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SubjectRef) Reset() {
+	*x = SubjectRef{}
+	mi := &file_guardian_v1_relation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubjectRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubjectRef) ProtoMessage() {}
+
+func (x *SubjectRef) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_relation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SubjectRef) GetNamespace() string {
+	if x != nil {
+		return x.xxx_hidden_Namespace
+	}
+	return ""
+}
+
+func (x *SubjectRef) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *SubjectRef) GetRelation() string {
+	if x != nil {
+		return x.xxx_hidden_Relation
+	}
+	return ""
+}
+
+func (x *SubjectRef) SetNamespace(v string) {
+	x.xxx_hidden_Namespace = v
+}
+
+func (x *SubjectRef) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *SubjectRef) SetRelation(v string) {
+	x.xxx_hidden_Relation = v
+}
+
+type SubjectRef_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Namespace string
+	Id        string
+	Relation  string
+}
+
+func (b0 SubjectRef_builder) Build() *SubjectRef {
+	m0 := &SubjectRef{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Namespace = b.Namespace
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_Relation = b.Relation
+	return m0
+}
+
+// RelationTuple relates an object to a subject.
+type RelationTuple struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Object    *ObjectRef             `protobuf:"bytes,1,opt,name=object,proto3"`
+	xxx_hidden_Relation  string                 `protobuf:"bytes,2,opt,name=relation,proto3"`
+	xxx_hidden_Subject   *SubjectRef            `protobuf:"bytes,3,opt,name=subject,proto3"`
+	xxx_hidden_Condition string                 `protobuf:"bytes,4,opt,name=condition,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RelationTuple) Reset() {
+	*x = RelationTuple{}
+	mi := &file_guardian_v1_relation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationTuple) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationTuple) ProtoMessage() {}
+
+func (x *RelationTuple) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_relation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RelationTuple) GetObject() *ObjectRef {
+	if x != nil {
+		return x.xxx_hidden_Object
+	}
+	return nil
+}
+
+func (x *RelationTuple) GetRelation() string {
+	if x != nil {
+		return x.xxx_hidden_Relation
+	}
+	return ""
+}
+
+func (x *RelationTuple) GetSubject() *SubjectRef {
+	if x != nil {
+		return x.xxx_hidden_Subject
+	}
+	return nil
+}
+
+func (x *RelationTuple) GetCondition() string {
+	if x != nil {
+		return x.xxx_hidden_Condition
+	}
+	return ""
+}
+
+func (x *RelationTuple) SetObject(v *ObjectRef) {
+	x.xxx_hidden_Object = v
+}
+
+func (x *RelationTuple) SetRelation(v string) {
+	x.xxx_hidden_Relation = v
+}
+
+func (x *RelationTuple) SetSubject(v *SubjectRef) {
+	x.xxx_hidden_Subject = v
+}
+
+func (x *RelationTuple) SetCondition(v string) {
+	x.xxx_hidden_Condition = v
+}
+
+func (x *RelationTuple) HasObject() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Object != nil
+}
+
+func (x *RelationTuple) HasSubject() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Subject != nil
+}
+
+func (x *RelationTuple) ClearObject() {
+	x.xxx_hidden_Object = nil
+}
+
+func (x *RelationTuple) ClearSubject() {
+	x.xxx_hidden_Subject = nil
+}
+
+type RelationTuple_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Object   *ObjectRef
+	Relation string
+	Subject  *SubjectRef
+	// Name of a condition the tuple requires, unconditional if empty. It is not part of the identity of a tuple.
+	Condition string
+}
+
+func (b0 RelationTuple_builder) Build() *RelationTuple {
+	m0 := &RelationTuple{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Object = b.Object
+	x.xxx_hidden_Relation = b.Relation
+	x.xxx_hidden_Subject = b.Subject
+	x.xxx_hidden_Condition = b.Condition
+	return m0
+}
+
+// UsersetTree is the expansion of the subjects having a relation to an object.
+type UsersetTree struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Operation UsersetOperation       `protobuf:"varint,1,opt,name=operation,proto3,enum=guardian.v1.UsersetOperation"`
+	xxx_hidden_Object    *ObjectRef             `protobuf:"bytes,2,opt,name=object,proto3"`
+	xxx_hidden_Relation  string                 `protobuf:"bytes,3,opt,name=relation,proto3"`
+	xxx_hidden_Subjects  *[]*SubjectRef         `protobuf:"bytes,4,rep,name=subjects,proto3"`
+	xxx_hidden_Children  *[]*UsersetTree        `protobuf:"bytes,5,rep,name=children,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UsersetTree) Reset() {
+	*x = UsersetTree{}
+	mi := &file_guardian_v1_relation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsersetTree) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsersetTree) ProtoMessage() {}
+
+func (x *UsersetTree) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_relation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UsersetTree) GetOperation() UsersetOperation {
+	if x != nil {
+		return x.xxx_hidden_Operation
+	}
+	return UsersetOperation_USERSET_OPERATION_UNSPECIFIED
+}
+
+func (x *UsersetTree) GetObject() *ObjectRef {
+	if x != nil {
+		return x.xxx_hidden_Object
+	}
+	return nil
+}
+
+func (x *UsersetTree) GetRelation() string {
+	if x != nil {
+		return x.xxx_hidden_Relation
+	}
+	return ""
+}
+
+func (x *UsersetTree) GetSubjects() []*SubjectRef {
+	if x != nil {
+		if x.xxx_hidden_Subjects != nil {
+			return *x.xxx_hidden_Subjects
+		}
+	}
+	return nil
+}
+
+func (x *UsersetTree) GetChildren() []*UsersetTree {
+	if x != nil {
+		if x.xxx_hidden_Children != nil {
+			return *x.xxx_hidden_Children
+		}
+	}
+	return nil
+}
+
+func (x *UsersetTree) SetOperation(v UsersetOperation) {
+	x.xxx_hidden_Operation = v
+}
+
+func (x *UsersetTree) SetObject(v *ObjectRef) {
+	x.xxx_hidden_Object = v
+}
+
+func (x *UsersetTree) SetRelation(v string) {
+	x.xxx_hidden_Relation = v
+}
+
+func (x *UsersetTree) SetSubjects(v []*SubjectRef) {
+	x.xxx_hidden_Subjects = &v
+}
+
+func (x *UsersetTree) SetChildren(v []*UsersetTree) {
+	x.xxx_hidden_Children = &v
+}
+
+func (x *UsersetTree) HasObject() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Object != nil
+}
+
+func (x *UsersetTree) ClearObject() {
+	x.xxx_hidden_Object = nil
+}
+
+type UsersetTree_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Operation UsersetOperation
+	Object    *ObjectRef
+	Relation  string
+	Subjects  []*SubjectRef
+	Children  []*UsersetTree
+}
+
+func (b0 UsersetTree_builder) Build() *UsersetTree {
+	m0 := &UsersetTree{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Operation = b.Operation
+	x.xxx_hidden_Object = b.Object
+	x.xxx_hidden_Relation = b.Relation
+	x.xxx_hidden_Subjects = &b.Subjects
+	x.xxx_hidden_Children = &b.Children
+	return m0
+}
+
+type WriteSchemaRequest struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Definition string                 `protobuf:"bytes,1,opt,name=definition,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *WriteSchemaRequest) Reset() {
+	*x = WriteSchemaRequest{}
+	mi := &file_guardian_v1_relation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteSchemaRequest) ProtoMessage() {}
+
+func (x *WriteSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_relation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *WriteSchemaRequest) GetDefinition() string {
+	if x != nil {
+		return x.xxx_hidden_Definition
+	}
+	return ""
+}
+
+func (x *WriteSchemaRequest) SetDefinition(v string) {
+	x.xxx_hidden_Definition = v
+}
+
+type WriteSchemaRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Definition string
+}
+
+func (b0 WriteSchemaRequest_builder) Build() *WriteSchemaRequest {
+	m0 := &WriteSchemaRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Definition = b.Definition
+	return m0
+}
+
+type WriteSchemaResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Zookie string                 `protobuf:"bytes,1,opt,name=zookie,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WriteSchemaResponse) Reset() {
+	*x = WriteSchemaResponse{}
+	mi := &file_guardian_v1_relation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteSchemaResponse) ProtoMessage() {}
+
+func (x *WriteSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_relation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *WriteSchemaResponse) GetZookie() string {
+	if x != nil {
+		return x.xxx_hidden_Zookie
+	}
+	return ""
+}
+
+func (x *WriteSchemaResponse) SetZookie(v string) {
+	x.xxx_hidden_Zookie = v
+}
+
+type WriteSchemaResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Zookie string
+}
+
+func (b0 WriteSchemaResponse_builder) Build() *WriteSchemaResponse {
+	m0 := &WriteSchemaResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Zookie = b.Zookie
+	return m0
+}
+
+type ReadSchemaRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadSchemaRequest) Reset() {
+	*x = ReadSchemaRequest{}
+	mi := &file_guardian_v1_relation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadSchemaRequest) ProtoMessage() {}
+
+func (x *ReadSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_relation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type ReadSchemaRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 ReadSchemaRequest_builder) Build() *ReadSchemaRequest {
+	m0 := &ReadSchemaRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type ReadSchemaResponse struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Definition string                 `protobuf:"bytes,1,opt,name=definition,proto3"`
+	xxx_hidden_Zookie     string                 `protobuf:"bytes,2,opt,name=zookie,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ReadSchemaResponse) Reset() {
+	*x = ReadSchemaResponse{}
+	mi := &file_guardian_v1_relation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadSchemaResponse) ProtoMessage() {}
+
+func (x *ReadSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_relation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReadSchemaResponse) GetDefinition() string {
+	if x != nil {
+		return x.xxx_hidden_Definition
+	}
+	return ""
+}
+
+func (x *ReadSchemaResponse) GetZookie() string {
+	if x != nil {
+		return x.xxx_hidden_Zookie
+	}
+	return ""
+}
+
+func (x *ReadSchemaResponse) SetDefinition(v string) {
+	x.xxx_hidden_Definition = v
+}
+
+func (x *ReadSchemaResponse) SetZookie(v string) {
+	x.xxx_hidden_Zookie = v
+}
+
+type ReadSchemaResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Definition string
+	Zookie     string
+}
+
+func (b0 ReadSchemaResponse_builder) Build() *ReadSchemaResponse {
+	m0 := &ReadSchemaResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Definition = b.Definition
+	x.xxx_hidden_Zookie = b.Zookie
+	return m0
+}
+
+type WriteTuplesRequest struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Writes  *[]*RelationTuple      `protobuf:"bytes,1,rep,name=writes,proto3"`
+	xxx_hidden_Deletes *[]*RelationTuple      `protobuf:"bytes,2,rep,name=deletes,proto3"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *WriteTuplesRequest) Reset() {
+	*x = WriteTuplesRequest{}
+	mi := &file_guardian_v1_relation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteTuplesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteTuplesRequest) ProtoMessage() {}
+
+func (x *WriteTuplesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_relation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *WriteTuplesRequest) GetWrites() []*RelationTuple {
+	if x != nil {
+		if x.xxx_hidden_Writes != nil {
+			return *x.xxx_hidden_Writes
+		}
+	}
+	return nil
+}
+
+func (x *WriteTuplesRequest) GetDeletes() []*RelationTuple {
+	if x != nil {
+		if x.xxx_hidden_Deletes != nil {
+			return *x.xxx_hidden_Deletes
+		}
+	}
+	return nil
+}
+
+func (x *WriteTuplesRequest) SetWrites(v []*RelationTuple) {
+	x.xxx_hidden_Writes = &v
+}
+
+func (x *WriteTuplesRequest) SetDeletes(v []*RelationTuple) {
+	x.xxx_hidden_Deletes = &v
+}
+
+type WriteTuplesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Writes  []*RelationTuple
+	Deletes []*RelationTuple
+}
+
+func (b0 WriteTuplesRequest_builder) Build() *WriteTuplesRequest {
+	m0 := &WriteTuplesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Writes = &b.Writes
+	x.xxx_hidden_Deletes = &b.Deletes
+	return m0
+}
+
+type WriteTuplesResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Zookie string                 `protobuf:"bytes,1,opt,name=zookie,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WriteTuplesResponse) Reset() {
+	*x = WriteTuplesResponse{}
+	mi := &file_guardian_v1_relation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteTuplesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteTuplesResponse) ProtoMessage() {}
+
+func (x *WriteTuplesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_relation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *WriteTuplesResponse) GetZookie() string {
+	if x != nil {
+		return x.xxx_hidden_Zookie
+	}
+	return ""
+}
+
+func (x *WriteTuplesResponse) SetZookie(v string) {
+	x.xxx_hidden_Zookie = v
+}
+
+type WriteTuplesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Zookie string
+}
+
+func (b0 WriteTuplesResponse_builder) Build() *WriteTuplesResponse {
+	m0 := &WriteTuplesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Zookie = b.Zookie
+	return m0
+}
+
+type ReadTuplesRequest struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3"`
+	xxx_hidden_ObjectId  string                 `protobuf:"bytes,2,opt,name=object_id,json=objectId,proto3"`
+	xxx_hidden_Relation  string                 `protobuf:"bytes,3,opt,name=relation,proto3"`
+	xxx_hidden_Subject   *SubjectRef            `protobuf:"bytes,4,opt,name=subject,proto3"`
+	xxx_hidden_Zookie    string                 `protobuf:"bytes,5,opt,name=zookie,proto3"`
+	xxx_hidden_PageSize  int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3"`
+	xxx_hidden_PageToken string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ReadTuplesRequest) Reset() {
+	*x = ReadTuplesRequest{}
+	mi := &file_guardian_v1_relation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadTuplesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadTuplesRequest) ProtoMessage() {}
+
+func (x *ReadTuplesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_relation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReadTuplesRequest) GetNamespace() string {
+	if x != nil {
+		return x.xxx_hidden_Namespace
+	}
+	return ""
+}
+
+func (x *ReadTuplesRequest) GetObjectId() string {
+	if x != nil {
+		return x.xxx_hidden_ObjectId
+	}
+	return ""
+}
+
+func (x *ReadTuplesRequest) GetRelation() string {
+	if x != nil {
+		return x.xxx_hidden_Relation
+	}
+	return ""
+}
+
+func (x *ReadTuplesRequest) GetSubject() *SubjectRef {
+	if x != nil {
+		return x.xxx_hidden_Subject
+	}
+	return nil
+}
+
+func (x *ReadTuplesRequest) GetZookie() string {
+	if x != nil {
+		return x.xxx_hidden_Zookie
+	}
+	return ""
+}
+
+func (x *ReadTuplesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.xxx_hidden_PageSize
+	}
+	return 0
+}
+
+func (x *ReadTuplesRequest) GetPageToken() string {
+	if x != nil {
+		return x.xxx_hidden_PageToken
+	}
+	return ""
+}
+
+func (x *ReadTuplesRequest) SetNamespace(v string) {
+	x.xxx_hidden_Namespace = v
+}
+
+func (x *ReadTuplesRequest) SetObjectId(v string) {
+	x.xxx_hidden_ObjectId = v
+}
+
+func (x *ReadTuplesRequest) SetRelation(v string) {
+	x.xxx_hidden_Relation = v
+}
+
+func (x *ReadTuplesRequest) SetSubject(v *SubjectRef) {
+	x.xxx_hidden_Subject = v
+}
+
+func (x *ReadTuplesRequest) SetZookie(v string) {
+	x.xxx_hidden_Zookie = v
+}
+
+func (x *ReadTuplesRequest) SetPageSize(v int32) {
+	x.xxx_hidden_PageSize = v
+}
+
+func (x *ReadTuplesRequest) SetPageToken(v string) {
+	x.xxx_hidden_PageToken = v
+}
+
+func (x *ReadTuplesRequest) HasSubject() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Subject != nil
+}
+
+func (x *ReadTuplesRequest) ClearSubject() {
+	x.xxx_hidden_Subject = nil
+}
+
+type ReadTuplesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Namespace string
+	// Filters by object id if not empty.
+	ObjectId string
+	// Filters by relation if not empty.
+	Relation string
+	// Filters by subject if set, including an empty subject relation.
+	Subject  *SubjectRef
+	Zookie   string
+	PageSize int32
+	// The next_page_token of the previous response.
+	PageToken string
+}
+
+func (b0 ReadTuplesRequest_builder) Build() *ReadTuplesRequest {
+	m0 := &ReadTuplesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Namespace = b.Namespace
+	x.xxx_hidden_ObjectId = b.ObjectId
+	x.xxx_hidden_Relation = b.Relation
+	x.xxx_hidden_Subject = b.Subject
+	x.xxx_hidden_Zookie = b.Zookie
+	x.xxx_hidden_PageSize = b.PageSize
+	x.xxx_hidden_PageToken = b.PageToken
+	return m0
+}
+
+type ReadTuplesResponse struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Tuples        *[]*RelationTuple      `protobuf:"bytes,1,rep,name=tuples,proto3"`
+	xxx_hidden_NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3"`
+	xxx_hidden_Zookie        string                 `protobuf:"bytes,3,opt,name=zookie,proto3"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ReadTuplesResponse) Reset() {
+	*x = ReadTuplesResponse{}
+	mi := &file_guardian_v1_relation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadTuplesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadTuplesResponse) ProtoMessage() {}
+
+func (x *ReadTuplesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_relation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReadTuplesResponse) GetTuples() []*RelationTuple {
+	if x != nil {
+		if x.xxx_hidden_Tuples != nil {
+			return *x.xxx_hidden_Tuples
+		}
+	}
+	return nil
+}
+
+func (x *ReadTuplesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.xxx_hidden_NextPageToken
+	}
+	return ""
+}
+
+func (x *ReadTuplesResponse) GetZookie() string {
+	if x != nil {
+		return x.xxx_hidden_Zookie
+	}
+	return ""
+}
+
+func (x *ReadTuplesResponse) SetTuples(v []*RelationTuple) {
+	x.xxx_hidden_Tuples = &v
+}
+
+func (x *ReadTuplesResponse) SetNextPageToken(v string) {
+	x.xxx_hidden_NextPageToken = v
+}
+
+func (x *ReadTuplesResponse) SetZookie(v string) {
+	x.xxx_hidden_Zookie = v
+}
+
+type ReadTuplesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Tuples        []*RelationTuple
+	NextPageToken string
+	Zookie        string
+}
+
+func (b0 ReadTuplesResponse_builder) Build() *ReadTuplesResponse {
+	m0 := &ReadTuplesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Tuples = &b.Tuples
+	x.xxx_hidden_NextPageToken = b.NextPageToken
+	x.xxx_hidden_Zookie = b.Zookie
+	return m0
+}
+
+type CheckRelationRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Object   *ObjectRef             `protobuf:"bytes,1,opt,name=object,proto3"`
+	xxx_hidden_Relation string                 `protobuf:"bytes,2,opt,name=relation,proto3"`
+	xxx_hidden_Subject  *SubjectRef            `protobuf:"bytes,3,opt,name=subject,proto3"`
+	xxx_hidden_Zookie   string                 `protobuf:"bytes,4,opt,name=zookie,proto3"`
+	xxx_hidden_Context  *AttributeContext      `protobuf:"bytes,5,opt,name=context,proto3"`
+	xxx_hidden_Explain  bool                   `protobuf:"varint,6,opt,name=explain,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CheckRelationRequest) Reset() {
+	*x = CheckRelationRequest{}
+	mi := &file_guardian_v1_relation_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckRelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRelationRequest) ProtoMessage() {}
+
+func (x *CheckRelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_relation_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CheckRelationRequest) GetObject() *ObjectRef {
+	if x != nil {
+		return x.xxx_hidden_Object
+	}
+	return nil
+}
+
+func (x *CheckRelationRequest) GetRelation() string {
+	if x != nil {
+		return x.xxx_hidden_Relation
+	}
+	return ""
+}
+
+func (x *CheckRelationRequest) GetSubject() *SubjectRef {
+	if x != nil {
+		return x.xxx_hidden_Subject
+	}
+	return nil
+}
+
+func (x *CheckRelationRequest) GetZookie() string {
+	if x != nil {
+		return x.xxx_hidden_Zookie
+	}
+	return ""
+}
+
+func (x *CheckRelationRequest) GetContext() *AttributeContext {
+	if x != nil {
+		return x.xxx_hidden_Context
+	}
+	return nil
+}
+
+func (x *CheckRelationRequest) GetExplain() bool {
+	if x != nil {
+		return x.xxx_hidden_Explain
+	}
+	return false
+}
+
+func (x *CheckRelationRequest) SetObject(v *ObjectRef) {
+	x.xxx_hidden_Object = v
+}
+
+func (x *CheckRelationRequest) SetRelation(v string) {
+	x.xxx_hidden_Relation = v
+}
+
+func (x *CheckRelationRequest) SetSubject(v *SubjectRef) {
+	x.xxx_hidden_Subject = v
+}
+
+func (x *CheckRelationRequest) SetZookie(v string) {
+	x.xxx_hidden_Zookie = v
+}
+
+func (x *CheckRelationRequest) SetContext(v *AttributeContext) {
+	x.xxx_hidden_Context = v
+}
+
+func (x *CheckRelationRequest) SetExplain(v bool) {
+	x.xxx_hidden_Explain = v
+}
+
+func (x *CheckRelationRequest) HasObject() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Object != nil
+}
+
+func (x *CheckRelationRequest) HasSubject() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Subject != nil
+}
+
+func (x *CheckRelationRequest) HasContext() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Context != nil
+}
+
+func (x *CheckRelationRequest) ClearObject() {
+	x.xxx_hidden_Object = nil
+}
+
+func (x *CheckRelationRequest) ClearSubject() {
+	x.xxx_hidden_Subject = nil
+}
+
+func (x *CheckRelationRequest) ClearContext() {
+	x.xxx_hidden_Context = nil
+}
+
+type CheckRelationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Object   *ObjectRef
+	Relation string
+	Subject  *SubjectRef
+	Zookie   string
+	Context  *AttributeContext
+	// Returns the evaluation tree of the check. Relations evaluated before in the same check are not explained again.
+	Explain bool
+}
+
+func (b0 CheckRelationRequest_builder) Build() *CheckRelationRequest {
+	m0 := &CheckRelationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Object = b.Object
+	x.xxx_hidden_Relation = b.Relation
+	x.xxx_hidden_Subject = b.Subject
+	x.xxx_hidden_Zookie = b.Zookie
+	x.xxx_hidden_Context = b.Context
+	x.xxx_hidden_Explain = b.Explain
+	return m0
+}
+
+type CheckRelationResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Allowed     bool                   `protobuf:"varint,1,opt,name=allowed,proto3"`
+	xxx_hidden_Zookie      string                 `protobuf:"bytes,2,opt,name=zookie,proto3"`
+	xxx_hidden_Policy      string                 `protobuf:"bytes,3,opt,name=policy,proto3"`
+	xxx_hidden_Conditions  []string               `protobuf:"bytes,4,rep,name=conditions,proto3"`
+	xxx_hidden_Explanation *ExplainNode           `protobuf:"bytes,5,opt,name=explanation,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CheckRelationResponse) Reset() {
+	*x = CheckRelationResponse{}
+	mi := &file_guardian_v1_relation_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckRelationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRelationResponse) ProtoMessage() {}
+
+func (x *CheckRelationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_relation_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CheckRelationResponse) GetAllowed() bool {
+	if x != nil {
+		return x.xxx_hidden_Allowed
+	}
+	return false
+}
+
+func (x *CheckRelationResponse) GetZookie() string {
+	if x != nil {
+		return x.xxx_hidden_Zookie
+	}
+	return ""
+}
+
+func (x *CheckRelationResponse) GetPolicy() string {
+	if x != nil {
+		return x.xxx_hidden_Policy
+	}
+	return ""
+}
+
+func (x *CheckRelationResponse) GetConditions() []string {
+	if x != nil {
+		return x.xxx_hidden_Conditions
+	}
+	return nil
+}
+
+func (x *CheckRelationResponse) GetExplanation() *ExplainNode {
+	if x != nil {
+		return x.xxx_hidden_Explanation
+	}
+	return nil
+}
+
+func (x *CheckRelationResponse) SetAllowed(v bool) {
+	x.xxx_hidden_Allowed = v
+}
+
+func (x *CheckRelationResponse) SetZookie(v string) {
+	x.xxx_hidden_Zookie = v
+}
+
+func (x *CheckRelationResponse) SetPolicy(v string) {
+	x.xxx_hidden_Policy = v
+}
+
+func (x *CheckRelationResponse) SetConditions(v []string) {
+	x.xxx_hidden_Conditions = v
+}
+
+func (x *CheckRelationResponse) SetExplanation(v *ExplainNode) {
+	x.xxx_hidden_Explanation = v
+}
+
+func (x *CheckRelationResponse) HasExplanation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Explanation != nil
+}
+
+func (x *CheckRelationResponse) ClearExplanation() {
+	x.xxx_hidden_Explanation = nil
+}
+
+type CheckRelationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Allowed bool
+	Zookie  string
+	// The tuple which contains the subject, empty if denied.
+	Policy string
+	// Conditions of tuples which held for the subject to have the relation.
+	Conditions []string
+	// The evaluation tree if the check was explained.
+	Explanation *ExplainNode
+}
+
+func (b0 CheckRelationResponse_builder) Build() *CheckRelationResponse {
+	m0 := &CheckRelationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Allowed = b.Allowed
+	x.xxx_hidden_Zookie = b.Zookie
+	x.xxx_hidden_Policy = b.Policy
+	x.xxx_hidden_Conditions = b.Conditions
+	x.xxx_hidden_Explanation = b.Explanation
+	return m0
+}
+
+type ExpandRelationRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Object   *ObjectRef             `protobuf:"bytes,1,opt,name=object,proto3"`
+	xxx_hidden_Relation string                 `protobuf:"bytes,2,opt,name=relation,proto3"`
+	xxx_hidden_Zookie   string                 `protobuf:"bytes,3,opt,name=zookie,proto3"`
+	xxx_hidden_Context  *AttributeContext      `protobuf:"bytes,4,opt,name=context,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ExpandRelationRequest) Reset() {
+	*x = ExpandRelationRequest{}
+	mi := &file_guardian_v1_relation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpandRelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpandRelationRequest) ProtoMessage() {}
+
+func (x *ExpandRelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_relation_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ExpandRelationRequest) GetObject() *ObjectRef {
+	if x != nil {
+		return x.xxx_hidden_Object
+	}
+	return nil
+}
+
+func (x *ExpandRelationRequest) GetRelation() string {
+	if x != nil {
+		return x.xxx_hidden_Relation
+	}
+	return ""
+}
+
+func (x *ExpandRelationRequest) GetZookie() string {
+	if x != nil {
+		return x.xxx_hidden_Zookie
+	}
+	return ""
+}
+
+func (x *ExpandRelationRequest) GetContext() *AttributeContext {
+	if x != nil {
+		return x.xxx_hidden_Context
+	}
+	return nil
+}
+
+func (x *ExpandRelationRequest) SetObject(v *ObjectRef) {
+	x.xxx_hidden_Object = v
+}
+
+func (x *ExpandRelationRequest) SetRelation(v string) {
+	x.xxx_hidden_Relation = v
+}
+
+func (x *ExpandRelationRequest) SetZookie(v string) {
+	x.xxx_hidden_Zookie = v
+}
+
+func (x *ExpandRelationRequest) SetContext(v *AttributeContext) {
+	x.xxx_hidden_Context = v
+}
+
+func (x *ExpandRelationRequest) HasObject() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Object != nil
+}
+
+func (x *ExpandRelationRequest) HasContext() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Context != nil
+}
+
+func (x *ExpandRelationRequest) ClearObject() {
+	x.xxx_hidden_Object = nil
+}
+
+func (x *ExpandRelationRequest) ClearContext() {
+	x.xxx_hidden_Context = nil
+}
+
+type ExpandRelationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Object   *ObjectRef
+	Relation string
+	Zookie   string
+	Context  *AttributeContext
+}
+
+func (b0 ExpandRelationRequest_builder) Build() *ExpandRelationRequest {
+	m0 := &ExpandRelationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Object = b.Object
+	x.xxx_hidden_Relation = b.Relation
+	x.xxx_hidden_Zookie = b.Zookie
+	x.xxx_hidden_Context = b.Context
+	return m0
+}
+
+type ExpandRelationResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Tree   *UsersetTree           `protobuf:"bytes,1,opt,name=tree,proto3"`
+	xxx_hidden_Zookie string                 `protobuf:"bytes,2,opt,name=zookie,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ExpandRelationResponse) Reset() {
+	*x = ExpandRelationResponse{}
+	mi := &file_guardian_v1_relation_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpandRelationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpandRelationResponse) ProtoMessage() {}
+
+func (x *ExpandRelationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_relation_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ExpandRelationResponse) GetTree() *UsersetTree {
+	if x != nil {
+		return x.xxx_hidden_Tree
+	}
+	return nil
+}
+
+func (x *ExpandRelationResponse) GetZookie() string {
+	if x != nil {
+		return x.xxx_hidden_Zookie
+	}
+	return ""
+}
+
+func (x *ExpandRelationResponse) SetTree(v *UsersetTree) {
+	x.xxx_hidden_Tree = v
+}
+
+func (x *ExpandRelationResponse) SetZookie(v string) {
+	x.xxx_hidden_Zookie = v
+}
+
+func (x *ExpandRelationResponse) HasTree() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Tree != nil
+}
+
+func (x *ExpandRelationResponse) ClearTree() {
+	x.xxx_hidden_Tree = nil
+}
+
+type ExpandRelationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Tree   *UsersetTree
+	Zookie string
+}
+
+func (b0 ExpandRelationResponse_builder) Build() *ExpandRelationResponse {
+	m0 := &ExpandRelationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Tree = b.Tree
+	x.xxx_hidden_Zookie = b.Zookie
+	return m0
+}
+
+type ListObjectsRequest struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3"`
+	xxx_hidden_Relation  string                 `protobuf:"bytes,2,opt,name=relation,proto3"`
+	xxx_hidden_Subject   *SubjectRef            `protobuf:"bytes,3,opt,name=subject,proto3"`
+	xxx_hidden_Zookie    string                 `protobuf:"bytes,4,opt,name=zookie,proto3"`
+	xxx_hidden_PageSize  int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3"`
+	xxx_hidden_PageToken string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3"`
+	xxx_hidden_Context   *AttributeContext      `protobuf:"bytes,7,opt,name=context,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListObjectsRequest) Reset() {
+	*x = ListObjectsRequest{}
+	mi := &file_guardian_v1_relation_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectsRequest) ProtoMessage() {}
+
+func (x *ListObjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_relation_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListObjectsRequest) GetNamespace() string {
+	if x != nil {
+		return x.xxx_hidden_Namespace
+	}
+	return ""
+}
+
+func (x *ListObjectsRequest) GetRelation() string {
+	if x != nil {
+		return x.xxx_hidden_Relation
+	}
+	return ""
+}
+
+func (x *ListObjectsRequest) GetSubject() *SubjectRef {
+	if x != nil {
+		return x.xxx_hidden_Subject
+	}
+	return nil
+}
+
+func (x *ListObjectsRequest) GetZookie() string {
+	if x != nil {
+		return x.xxx_hidden_Zookie
+	}
+	return ""
+}
+
+func (x *ListObjectsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.xxx_hidden_PageSize
+	}
+	return 0
+}
+
+func (x *ListObjectsRequest) GetPageToken() string {
+	if x != nil {
+		return x.xxx_hidden_PageToken
+	}
+	return ""
+}
+
+func (x *ListObjectsRequest) GetContext() *AttributeContext {
+	if x != nil {
+		return x.xxx_hidden_Context
+	}
+	return nil
+}
+
+func (x *ListObjectsRequest) SetNamespace(v string) {
+	x.xxx_hidden_Namespace = v
+}
+
+func (x *ListObjectsRequest) SetRelation(v string) {
+	x.xxx_hidden_Relation = v
+}
+
+func (x *ListObjectsRequest) SetSubject(v *SubjectRef) {
+	x.xxx_hidden_Subject = v
+}
+
+func (x *ListObjectsRequest) SetZookie(v string) {
+	x.xxx_hidden_Zookie = v
+}
+
+func (x *ListObjectsRequest) SetPageSize(v int32) {
+	x.xxx_hidden_PageSize = v
+}
+
+func (x *ListObjectsRequest) SetPageToken(v string) {
+	x.xxx_hidden_PageToken = v
+}
+
+func (x *ListObjectsRequest) SetContext(v *AttributeContext) {
+	x.xxx_hidden_Context = v
+}
+
+func (x *ListObjectsRequest) HasSubject() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Subject != nil
+}
+
+func (x *ListObjectsRequest) HasContext() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Context != nil
+}
+
+func (x *ListObjectsRequest) ClearSubject() {
+	x.xxx_hidden_Subject = nil
+}
+
+func (x *ListObjectsRequest) ClearContext() {
+	x.xxx_hidden_Context = nil
+}
+
+type ListObjectsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Namespace string
+	Relation  string
+	Subject   *SubjectRef
+	Zookie    string
+	PageSize  int32
+	// The next_page_token of the previous response.
+	PageToken string
+	Context   *AttributeContext
+}
+
+func (b0 ListObjectsRequest_builder) Build() *ListObjectsRequest {
+	m0 := &ListObjectsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Namespace = b.Namespace
+	x.xxx_hidden_Relation = b.Relation
+	x.xxx_hidden_Subject = b.Subject
+	x.xxx_hidden_Zookie = b.Zookie
+	x.xxx_hidden_PageSize = b.PageSize
+	x.xxx_hidden_PageToken = b.PageToken
+	x.xxx_hidden_Context = b.Context
+	return m0
+}
+
+type ListObjectsResponse struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ObjectIds     []string               `protobuf:"bytes,1,rep,name=object_ids,json=objectIds,proto3"`
+	xxx_hidden_NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3"`
+	xxx_hidden_Zookie        string                 `protobuf:"bytes,3,opt,name=zookie,proto3"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ListObjectsResponse) Reset() {
+	*x = ListObjectsResponse{}
+	mi := &file_guardian_v1_relation_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectsResponse) ProtoMessage() {}
+
+func (x *ListObjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_relation_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListObjectsResponse) GetObjectIds() []string {
+	if x != nil {
+		return x.xxx_hidden_ObjectIds
+	}
+	return nil
+}
+
+func (x *ListObjectsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.xxx_hidden_NextPageToken
+	}
+	return ""
+}
+
+func (x *ListObjectsResponse) GetZookie() string {
+	if x != nil {
+		return x.xxx_hidden_Zookie
+	}
+	return ""
+}
+
+func (x *ListObjectsResponse) SetObjectIds(v []string) {
+	x.xxx_hidden_ObjectIds = v
+}
+
+func (x *ListObjectsResponse) SetNextPageToken(v string) {
+	x.xxx_hidden_NextPageToken = v
+}
+
+func (x *ListObjectsResponse) SetZookie(v string) {
+	x.xxx_hidden_Zookie = v
+}
+
+type ListObjectsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ObjectIds     []string
+	NextPageToken string
+	Zookie        string
+}
+
+func (b0 ListObjectsResponse_builder) Build() *ListObjectsResponse {
+	m0 := &ListObjectsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ObjectIds = b.ObjectIds
+	x.xxx_hidden_NextPageToken = b.NextPageToken
+	x.xxx_hidden_Zookie = b.Zookie
+	return m0
+}
+
+type ListSubjectsRequest struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Object           *ObjectRef             `protobuf:"bytes,1,opt,name=object,proto3"`
+	xxx_hidden_Relation         string                 `protobuf:"bytes,2,opt,name=relation,proto3"`
+	xxx_hidden_SubjectNamespace string                 `protobuf:"bytes,3,opt,name=subject_namespace,json=subjectNamespace,proto3"`
+	xxx_hidden_Zookie           string                 `protobuf:"bytes,4,opt,name=zookie,proto3"`
+	xxx_hidden_PageSize         int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3"`
+	xxx_hidden_PageToken        string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3"`
+	xxx_hidden_Context          *AttributeContext      `protobuf:"bytes,7,opt,name=context,proto3"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *ListSubjectsRequest) Reset() {
+	*x = ListSubjectsRequest{}
+	mi := &file_guardian_v1_relation_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubjectsRequest) ProtoMessage() {}
+
+func (x *ListSubjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_relation_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListSubjectsRequest) GetObject() *ObjectRef {
+	if x != nil {
+		return x.xxx_hidden_Object
+	}
+	return nil
+}
+
+func (x *ListSubjectsRequest) GetRelation() string {
+	if x != nil {
+		return x.xxx_hidden_Relation
+	}
+	return ""
+}
+
+func (x *ListSubjectsRequest) GetSubjectNamespace() string {
+	if x != nil {
+		return x.xxx_hidden_SubjectNamespace
+	}
+	return ""
+}
+
+func (x *ListSubjectsRequest) GetZookie() string {
+	if x != nil {
+		return x.xxx_hidden_Zookie
+	}
+	return ""
+}
+
+func (x *ListSubjectsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.xxx_hidden_PageSize
+	}
+	return 0
+}
+
+func (x *ListSubjectsRequest) GetPageToken() string {
+	if x != nil {
+		return x.xxx_hidden_PageToken
+	}
+	return ""
+}
+
+func (x *ListSubjectsRequest) GetContext() *AttributeContext {
+	if x != nil {
+		return x.xxx_hidden_Context
+	}
+	return nil
+}
+
+func (x *ListSubjectsRequest) SetObject(v *ObjectRef) {
+	x.xxx_hidden_Object = v
+}
+
+func (x *ListSubjectsRequest) SetRelation(v string) {
+	x.xxx_hidden_Relation = v
+}
+
+func (x *ListSubjectsRequest) SetSubjectNamespace(v string) {
+	x.xxx_hidden_SubjectNamespace = v
+}
+
+func (x *ListSubjectsRequest) SetZookie(v string) {
+	x.xxx_hidden_Zookie = v
+}
+
+func (x *ListSubjectsRequest) SetPageSize(v int32) {
+	x.xxx_hidden_PageSize = v
+}
+
+func (x *ListSubjectsRequest) SetPageToken(v string) {
+	x.xxx_hidden_PageToken = v
+}
+
+func (x *ListSubjectsRequest) SetContext(v *AttributeContext) {
+	x.xxx_hidden_Context = v
+}
+
+func (x *ListSubjectsRequest) HasObject() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Object != nil
+}
+
+func (x *ListSubjectsRequest) HasContext() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Context != nil
+}
+
+func (x *ListSubjectsRequest) ClearObject() {
+	x.xxx_hidden_Object = nil
+}
+
+func (x *ListSubjectsRequest) ClearContext() {
+	x.xxx_hidden_Context = nil
+}
+
+type ListSubjectsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Object           *ObjectRef
+	Relation         string
+	SubjectNamespace string
+	Zookie           string
+	PageSize         int32
+	// The next_page_token of the previous response.
+	PageToken string
+	Context   *AttributeContext
+}
+
+func (b0 ListSubjectsRequest_builder) Build() *ListSubjectsRequest {
+	m0 := &ListSubjectsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Object = b.Object
+	x.xxx_hidden_Relation = b.Relation
+	x.xxx_hidden_SubjectNamespace = b.SubjectNamespace
+	x.xxx_hidden_Zookie = b.Zookie
+	x.xxx_hidden_PageSize = b.PageSize
+	x.xxx_hidden_PageToken = b.PageToken
+	x.xxx_hidden_Context = b.Context
+	return m0
+}
+
+type ListSubjectsResponse struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_SubjectIds    []string               `protobuf:"bytes,1,rep,name=subject_ids,json=subjectIds,proto3"`
+	xxx_hidden_NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3"`
+	xxx_hidden_Zookie        string                 `protobuf:"bytes,3,opt,name=zookie,proto3"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ListSubjectsResponse) Reset() {
+	*x = ListSubjectsResponse{}
+	mi := &file_guardian_v1_relation_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubjectsResponse) ProtoMessage() {}
+
+func (x *ListSubjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_relation_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListSubjectsResponse) GetSubjectIds() []string {
+	if x != nil {
+		return x.xxx_hidden_SubjectIds
+	}
+	return nil
+}
+
+func (x *ListSubjectsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.xxx_hidden_NextPageToken
+	}
+	return ""
+}
+
+func (x *ListSubjectsResponse) GetZookie() string {
+	if x != nil {
+		return x.xxx_hidden_Zookie
+	}
+	return ""
+}
+
+func (x *ListSubjectsResponse) SetSubjectIds(v []string) {
+	x.xxx_hidden_SubjectIds = v
+}
+
+func (x *ListSubjectsResponse) SetNextPageToken(v string) {
+	x.xxx_hidden_NextPageToken = v
+}
+
+func (x *ListSubjectsResponse) SetZookie(v string) {
+	x.xxx_hidden_Zookie = v
+}
+
+type ListSubjectsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	SubjectIds    []string
+	NextPageToken string
+	Zookie        string
+}
+
+func (b0 ListSubjectsResponse_builder) Build() *ListSubjectsResponse {
+	m0 := &ListSubjectsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_SubjectIds = b.SubjectIds
+	x.xxx_hidden_NextPageToken = b.NextPageToken
+	x.xxx_hidden_Zookie = b.Zookie
+	return m0
+}
+
+var File_guardian_v1_relation_proto protoreflect.FileDescriptor
+
+const file_guardian_v1_relation_proto_rawDesc = "" +
+	"\n" +
+	"\x1aguardian/v1/relation.proto\x12\vguardian.v1\x1a\x17guardian/v1/authz.proto\"9\n" +
+	"\tObjectRef\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"V\n" +
+	"\n" +
+	"SubjectRef\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1a\n" +
+	"\brelation\x18\x03 \x01(\tR\brelation\"\xac\x01\n" +
+	"\rRelationTuple\x12.\n" +
+	"\x06object\x18\x01 \x01(\v2\x16.guardian.v1.ObjectRefR\x06object\x12\x1a\n" +
+	"\brelation\x18\x02 \x01(\tR\brelation\x121\n" +
+	"\asubject\x18\x03 \x01(\v2\x17.guardian.v1.SubjectRefR\asubject\x12\x1c\n" +
+	"\tcondition\x18\x04 \x01(\tR\tcondition\"\x81\x02\n" +
+	"\vUsersetTree\x12;\n" +
+	"\toperation\x18\x01 \x01(\x0e2\x1d.guardian.v1.UsersetOperationR\toperation\x12.\n" +
+	"\x06object\x18\x02 \x01(\v2\x16.guardian.v1.ObjectRefR\x06object\x12\x1a\n" +
+	"\brelation\x18\x03 \x01(\tR\brelation\x123\n" +
+	"\bsubjects\x18\x04 \x03(\v2\x17.guardian.v1.SubjectRefR\bsubjects\x124\n" +
+	"\bchildren\x18\x05 \x03(\v2\x18.guardian.v1.UsersetTreeR\bchildren\"4\n" +
+	"\x12WriteSchemaRequest\x12\x1e\n" +
+	"\n" +
+	"definition\x18\x01 \x01(\tR\n" +
+	"definition\"-\n" +
+	"\x13WriteSchemaResponse\x12\x16\n" +
+	"\x06zookie\x18\x01 \x01(\tR\x06zookie\"\x13\n" +
+	"\x11ReadSchemaRequest\"L\n" +
+	"\x12ReadSchemaResponse\x12\x1e\n" +
+	"\n" +
+	"definition\x18\x01 \x01(\tR\n" +
+	"definition\x12\x16\n" +
+	"\x06zookie\x18\x02 \x01(\tR\x06zookie\"~\n" +
+	"\x12WriteTuplesRequest\x122\n" +
+	"\x06writes\x18\x01 \x03(\v2\x1a.guardian.v1.RelationTupleR\x06writes\x124\n" +
+	"\adeletes\x18\x02 \x03(\v2\x1a.guardian.v1.RelationTupleR\adeletes\"-\n" +
+	"\x13WriteTuplesResponse\x12\x16\n" +
+	"\x06zookie\x18\x01 \x01(\tR\x06zookie\"\xf1\x01\n" +
+	"\x11ReadTuplesRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1b\n" +
+	"\tobject_id\x18\x02 \x01(\tR\bobjectId\x12\x1a\n" +
+	"\brelation\x18\x03 \x01(\tR\brelation\x121\n" +
+	"\asubject\x18\x04 \x01(\v2\x17.guardian.v1.SubjectRefR\asubject\x12\x16\n" +
+	"\x06zookie\x18\x05 \x01(\tR\x06zookie\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\"\x88\x01\n" +
+	"\x12ReadTuplesResponse\x122\n" +
+	"\x06tuples\x18\x01 \x03(\v2\x1a.guardian.v1.RelationTupleR\x06tuples\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x16\n" +
+	"\x06zookie\x18\x03 \x01(\tR\x06zookie\"\x80\x02\n" +
+	"\x14CheckRelationRequest\x12.\n" +
+	"\x06object\x18\x01 \x01(\v2\x16.guardian.v1.ObjectRefR\x06object\x12\x1a\n" +
+	"\brelation\x18\x02 \x01(\tR\brelation\x121\n" +
+	"\asubject\x18\x03 \x01(\v2\x17.guardian.v1.SubjectRefR\asubject\x12\x16\n" +
+	"\x06zookie\x18\x04 \x01(\tR\x06zookie\x127\n" +
+	"\acontext\x18\x05 \x01(\v2\x1d.guardian.v1.AttributeContextR\acontext\x12\x18\n" +
+	"\aexplain\x18\x06 \x01(\bR\aexplain\"\xbd\x01\n" +
+	"\x15CheckRelationResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x16\n" +
+	"\x06zookie\x18\x02 \x01(\tR\x06zookie\x12\x16\n" +
+	"\x06policy\x18\x03 \x01(\tR\x06policy\x12\x1e\n" +
+	"\n" +
+	"conditions\x18\x04 \x03(\tR\n" +
+	"conditions\x12:\n" +
+	"\vexplanation\x18\x05 \x01(\v2\x18.guardian.v1.ExplainNodeR\vexplanation\"\xb4\x01\n" +
+	"\x15ExpandRelationRequest\x12.\n" +
+	"\x06object\x18\x01 \x01(\v2\x16.guardian.v1.ObjectRefR\x06object\x12\x1a\n" +
+	"\brelation\x18\x02 \x01(\tR\brelation\x12\x16\n" +
+	"\x06zookie\x18\x03 \x01(\tR\x06zookie\x127\n" +
+	"\acontext\x18\x04 \x01(\v2\x1d.guardian.v1.AttributeContextR\acontext\"^\n" +
+	"\x16ExpandRelationResponse\x12,\n" +
+	"\x04tree\x18\x01 \x01(\v2\x18.guardian.v1.UsersetTreeR\x04tree\x12\x16\n" +
+	"\x06zookie\x18\x02 \x01(\tR\x06zookie\"\x8e\x02\n" +
+	"\x12ListObjectsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1a\n" +
+	"\brelation\x18\x02 \x01(\tR\brelation\x121\n" +
+	"\asubject\x18\x03 \x01(\v2\x17.guardian.v1.SubjectRefR\asubject\x12\x16\n" +
+	"\x06zookie\x18\x04 \x01(\tR\x06zookie\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x127\n" +
+	"\acontext\x18\a \x01(\v2\x1d.guardian.v1.AttributeContextR\acontext\"t\n" +
+	"\x13ListObjectsResponse\x12\x1d\n" +
+	"\n" +
+	"object_ids\x18\x01 \x03(\tR\tobjectIds\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x16\n" +
+	"\x06zookie\x18\x03 \x01(\tR\x06zookie\"\x9b\x02\n" +
+	"\x13ListSubjectsRequest\x12.\n" +
+	"\x06object\x18\x01 \x01(\v2\x16.guardian.v1.ObjectRefR\x06object\x12\x1a\n" +
+	"\brelation\x18\x02 \x01(\tR\brelation\x12+\n" +
+	"\x11subject_namespace\x18\x03 \x01(\tR\x10subjectNamespace\x12\x16\n" +
+	"\x06zookie\x18\x04 \x01(\tR\x06zookie\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x127\n" +
+	"\acontext\x18\a \x01(\v2\x1d.guardian.v1.AttributeContextR\acontext\"w\n" +
+	"\x14ListSubjectsResponse\x12\x1f\n" +
+	"\vsubject_ids\x18\x01 \x03(\tR\n" +
+	"subjectIds\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x16\n" +
+	"\x06zookie\x18\x03 \x01(\tR\x06zookie*\xb3\x01\n" +
+	"\x10UsersetOperation\x12!\n" +
+	"\x1dUSERSET_OPERATION_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16USERSET_OPERATION_LEAF\x10\x01\x12\x1b\n" +
+	"\x17USERSET_OPERATION_UNION\x10\x02\x12\"\n" +
+	"\x1eUSERSET_OPERATION_INTERSECTION\x10\x03\x12\x1f\n" +
+	"\x1bUSERSET_OPERATION_EXCLUSION\x10\x042\xad\x05\n" +
+	"\x0fRelationService\x12P\n" +
+	"\vWriteSchema\x12\x1f.guardian.v1.WriteSchemaRequest\x1a .guardian.v1.WriteSchemaResponse\x12M\n" +
+	"\n" +
+	"ReadSchema\x12\x1e.guardian.v1.ReadSchemaRequest\x1a\x1f.guardian.v1.ReadSchemaResponse\x12P\n" +
+	"\vWriteTuples\x12\x1f.guardian.v1.WriteTuplesRequest\x1a .guardian.v1.WriteTuplesResponse\x12M\n" +
+	"\n" +
+	"ReadTuples\x12\x1e.guardian.v1.ReadTuplesRequest\x1a\x1f.guardian.v1.ReadTuplesResponse\x12V\n" +
+	"\rCheckRelation\x12!.guardian.v1.CheckRelationRequest\x1a\".guardian.v1.CheckRelationResponse\x12Y\n" +
+	"\x0eExpandRelation\x12\".guardian.v1.ExpandRelationRequest\x1a#.guardian.v1.ExpandRelationResponse\x12P\n" +
+	"\vListObjects\x12\x1f.guardian.v1.ListObjectsRequest\x1a .guardian.v1.ListObjectsResponse\x12S\n" +
+	"\fListSubjects\x12 .guardian.v1.ListSubjectsRequest\x1a!.guardian.v1.ListSubjectsResponseB\xac\x01\n" +
+	"\x0fcom.guardian.v1B\rRelationProtoP\x01Z=github.com/gophero/guardian/core/proto/guardian/v1;guardianv1\xa2\x02\x03GVX\xaa\x02\vGuardian.V1\xca\x02\vGuardian\\V1\xe2\x02\x17Guardian\\V1\\GPBMetadata\xea\x02\fGuardian::V1b\x06proto3"
+
+var file_guardian_v1_relation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_guardian_v1_relation_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_guardian_v1_relation_proto_goTypes = []any{
+	(UsersetOperation)(0),          // 0: guardian.v1.UsersetOperation
+	(*ObjectRef)(nil),              // 1: guardian.v1.ObjectRef
+	(*SubjectRef)(nil),             // 2: guardian.v1.SubjectRef
+	(*RelationTuple)(nil),          // 3: guardian.v1.RelationTuple
+	(*UsersetTree)(nil),            // 4: guardian.v1.UsersetTree
+	(*WriteSchemaRequest)(nil),     // 5: guardian.v1.WriteSchemaRequest
+	(*WriteSchemaResponse)(nil),    // 6: guardian.v1.WriteSchemaResponse
+	(*ReadSchemaRequest)(nil),      // 7: guardian.v1.ReadSchemaRequest
+	(*ReadSchemaResponse)(nil),     // 8: guardian.v1.ReadSchemaResponse
+	(*WriteTuplesRequest)(nil),     // 9: guardian.v1.WriteTuplesRequest
+	(*WriteTuplesResponse)(nil),    // 10: guardian.v1.WriteTuplesResponse
+	(*ReadTuplesRequest)(nil),      // 11: guardian.v1.ReadTuplesRequest
+	(*ReadTuplesResponse)(nil),     // 12: guardian.v1.ReadTuplesResponse
+	(*CheckRelationRequest)(nil),   // 13: guardian.v1.CheckRelationRequest
+	(*CheckRelationResponse)(nil),  // 14: guardian.v1.CheckRelationResponse
+	(*ExpandRelationRequest)(nil),  // 15: guardian.v1.ExpandRelationRequest
+	(*ExpandRelationResponse)(nil), // 16: guardian.v1.ExpandRelationResponse
+	(*ListObjectsRequest)(nil),     // 17: guardian.v1.ListObjectsRequest
+	(*ListObjectsResponse)(nil),    // 18: guardian.v1.ListObjectsResponse
+	(*ListSubjectsRequest)(nil),    // 19: guardian.v1.ListSubjectsRequest
+	(*ListSubjectsResponse)(nil),   // 20: guardian.v1.ListSubjectsResponse
+	(*AttributeContext)(nil),       // 21: guardian.v1.AttributeContext
+	(*ExplainNode)(nil),            // 22: guardian.v1.ExplainNode
+}
+var file_guardian_v1_relation_proto_depIdxs = []int32{
+	1,  // 0: guardian.v1.RelationTuple.object:type_name -> guardian.v1.ObjectRef
+	2,  // 1: guardian.v1.RelationTuple.subject:type_name -> guardian.v1.SubjectRef
+	0,  // 2: guardian.v1.UsersetTree.operation:type_name -> guardian.v1.UsersetOperation
+	1,  // 3: guardian.v1.UsersetTree.object:type_name -> guardian.v1.ObjectRef
+	2,  // 4: guardian.v1.UsersetTree.subjects:type_name -> guardian.v1.SubjectRef
+	4,  // 5: guardian.v1.UsersetTree.children:type_name -> guardian.v1.UsersetTree
+	3,  // 6: guardian.v1.WriteTuplesRequest.writes:type_name -> guardian.v1.RelationTuple
+	3,  // 7: guardian.v1.WriteTuplesRequest.deletes:type_name -> guardian.v1.RelationTuple
+	2,  // 8: guardian.v1.ReadTuplesRequest.subject:type_name -> guardian.v1.SubjectRef
+	3,  // 9: guardian.v1.ReadTuplesResponse.tuples:type_name -> guardian.v1.RelationTuple
+	1,  // 10: guardian.v1.CheckRelationRequest.object:type_name -> guardian.v1.ObjectRef
+	2,  // 11: guardian.v1.CheckRelationRequest.subject:type_name -> guardian.v1.SubjectRef
+	21, // 12: guardian.v1.CheckRelationRequest.context:type_name -> guardian.v1.AttributeContext
+	22, // 13: guardian.v1.CheckRelationResponse.explanation:type_name -> guardian.v1.ExplainNode
+	1,  // 14: guardian.v1.ExpandRelationRequest.object:type_name -> guardian.v1.ObjectRef
+	21, // 15: guardian.v1.ExpandRelationRequest.context:type_name -> guardian.v1.AttributeContext
+	4,  // 16: guardian.v1.ExpandRelationResponse.tree:type_name -> guardian.v1.UsersetTree
+	2,  // 17: guardian.v1.ListObjectsRequest.subject:type_name -> guardian.v1.SubjectRef
+	21, // 18: guardian.v1.ListObjectsRequest.context:type_name -> guardian.v1.AttributeContext
+	1,  // 19: guardian.v1.ListSubjectsRequest.object:type_name -> guardian.v1.ObjectRef
+	21, // 20: guardian.v1.ListSubjectsRequest.context:type_name -> guardian.v1.AttributeContext
+	5,  // 21: guardian.v1.RelationService.WriteSchema:input_type -> guardian.v1.WriteSchemaRequest
+	7,  // 22: guardian.v1.RelationService.ReadSchema:input_type -> guardian.v1.ReadSchemaRequest
+	9,  // 23: guardian.v1.RelationService.WriteTuples:input_type -> guardian.v1.WriteTuplesRequest
+	11, // 24: guardian.v1.RelationService.ReadTuples:input_type -> guardian.v1.ReadTuplesRequest
+	13, // 25: guardian.v1.RelationService.CheckRelation:input_type -> guardian.v1.CheckRelationRequest
+	15, // 26: guardian.v1.RelationService.ExpandRelation:input_type -> guardian.v1.ExpandRelationRequest
+	17, // 27: guardian.v1.RelationService.ListObjects:input_type -> guardian.v1.ListObjectsRequest
+	19, // 28: guardian.v1.RelationService.ListSubjects:input_type -> guardian.v1.ListSubjectsRequest
+	6,  // 29: guardian.v1.RelationService.WriteSchema:output_type -> guardian.v1.WriteSchemaResponse
+	8,  // 30: guardian.v1.RelationService.ReadSchema:output_type -> guardian.v1.ReadSchemaResponse
+	10, // 31: guardian.v1.RelationService.WriteTuples:output_type -> guardian.v1.WriteTuplesResponse
+	12, // 32: guardian.v1.RelationService.ReadTuples:output_type -> guardian.v1.ReadTuplesResponse
+	14, // 33: guardian.v1.RelationService.CheckRelation:output_type -> guardian.v1.CheckRelationResponse
+	16, // 34: guardian.v1.RelationService.ExpandRelation:output_type -> guardian.v1.ExpandRelationResponse
+	18, // 35: guardian.v1.RelationService.ListObjects:output_type -> guardian.v1.ListObjectsResponse
+	20, // 36: guardian.v1.RelationService.ListSubjects:output_type -> guardian.v1.ListSubjectsResponse
+	29, // [29:37] is the sub-list for method output_type
+	21, // [21:29] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_guardian_v1_relation_proto_init() }
+func file_guardian_v1_relation_proto_init() {
+	if File_guardian_v1_relation_proto != nil {
+		return
+	}
+	file_guardian_v1_authz_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guardian_v1_relation_proto_rawDesc), len(file_guardian_v1_relation_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_guardian_v1_relation_proto_goTypes,
+		DependencyIndexes: file_guardian_v1_relation_proto_depIdxs,
+		EnumInfos:         file_guardian_v1_relation_proto_enumTypes,
+		MessageInfos:      file_guardian_v1_relation_proto_msgTypes,
+	}.Build()
+	File_guardian_v1_relation_proto = out.File
+	file_guardian_v1_relation_proto_goTypes = nil
+	file_guardian_v1_relation_proto_depIdxs = nil
+}
@@ -0,0 +1,1717 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: guardian/v1/service_account.proto
+
+package guardianv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ServiceAccount is a non-human principal, like a backend job.
+type ServiceAccount struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id                string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Name              string                 `protobuf:"bytes,2,opt,name=name,proto3"`
+	xxx_hidden_Description       string                 `protobuf:"bytes,3,opt,name=description,proto3"`
+	xxx_hidden_Scopes            []string               `protobuf:"bytes,4,rep,name=scopes,proto3"`
+	xxx_hidden_CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3"`
+	xxx_hidden_UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3"`
+	xxx_hidden_RotatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=rotated_at,json=rotatedAt,proto3"`
+	xxx_hidden_PreviousExpiresAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=previous_expires_at,json=previousExpiresAt,proto3"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ServiceAccount) Reset() {
+	*x = ServiceAccount{}
+	mi := &file_guardian_v1_service_account_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceAccount) ProtoMessage() {}
+
+func (x *ServiceAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_service_account_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ServiceAccount) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetDescription() string {
+	if x != nil {
+		return x.xxx_hidden_Description
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetScopes() []string {
+	if x != nil {
+		return x.xxx_hidden_Scopes
+	}
+	return nil
+}
+
+func (x *ServiceAccount) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *ServiceAccount) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
+	}
+	return nil
+}
+
+func (x *ServiceAccount) GetRotatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_RotatedAt
+	}
+	return nil
+}
+
+func (x *ServiceAccount) GetPreviousExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_PreviousExpiresAt
+	}
+	return nil
+}
+
+func (x *ServiceAccount) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *ServiceAccount) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *ServiceAccount) SetDescription(v string) {
+	x.xxx_hidden_Description = v
+}
+
+func (x *ServiceAccount) SetScopes(v []string) {
+	x.xxx_hidden_Scopes = v
+}
+
+func (x *ServiceAccount) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *ServiceAccount) SetUpdatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *ServiceAccount) SetRotatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_RotatedAt = v
+}
+
+func (x *ServiceAccount) SetPreviousExpiresAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_PreviousExpiresAt = v
+}
+
+func (x *ServiceAccount) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *ServiceAccount) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *ServiceAccount) HasRotatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_RotatedAt != nil
+}
+
+func (x *ServiceAccount) HasPreviousExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_PreviousExpiresAt != nil
+}
+
+func (x *ServiceAccount) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *ServiceAccount) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+func (x *ServiceAccount) ClearRotatedAt() {
+	x.xxx_hidden_RotatedAt = nil
+}
+
+func (x *ServiceAccount) ClearPreviousExpiresAt() {
+	x.xxx_hidden_PreviousExpiresAt = nil
+}
+
+type ServiceAccount_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The client_id of the account.
+	Id          string
+	Name        string
+	Description string
+	// Scopes the account may request, sorted by name.
+	Scopes    []string
+	CreatedAt *timestamppb.Timestamp
+	UpdatedAt *timestamppb.Timestamp
+	RotatedAt *timestamppb.Timestamp
+	// Time until which the secret replaced by the last rotation stays valid, unset if it is not valid anymore.
+	PreviousExpiresAt *timestamppb.Timestamp
+}
+
+func (b0 ServiceAccount_builder) Build() *ServiceAccount {
+	m0 := &ServiceAccount{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_Description = b.Description
+	x.xxx_hidden_Scopes = b.Scopes
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	x.xxx_hidden_RotatedAt = b.RotatedAt
+	x.xxx_hidden_PreviousExpiresAt = b.PreviousExpiresAt
+	return m0
+}
+
+// ServiceAccountKey is a public key verifying the client assertions of a service account.
+type ServiceAccountKey struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id               string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_ServiceAccountId string                 `protobuf:"bytes,2,opt,name=service_account_id,json=serviceAccountId,proto3"`
+	xxx_hidden_KeyId            string                 `protobuf:"bytes,3,opt,name=key_id,json=keyId,proto3"`
+	xxx_hidden_Algorithm        string                 `protobuf:"bytes,4,opt,name=algorithm,proto3"`
+	xxx_hidden_CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *ServiceAccountKey) Reset() {
+	*x = ServiceAccountKey{}
+	mi := &file_guardian_v1_service_account_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceAccountKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceAccountKey) ProtoMessage() {}
+
+func (x *ServiceAccountKey) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_service_account_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ServiceAccountKey) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *ServiceAccountKey) GetServiceAccountId() string {
+	if x != nil {
+		return x.xxx_hidden_ServiceAccountId
+	}
+	return ""
+}
+
+func (x *ServiceAccountKey) GetKeyId() string {
+	if x != nil {
+		return x.xxx_hidden_KeyId
+	}
+	return ""
+}
+
+func (x *ServiceAccountKey) GetAlgorithm() string {
+	if x != nil {
+		return x.xxx_hidden_Algorithm
+	}
+	return ""
+}
+
+func (x *ServiceAccountKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *ServiceAccountKey) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *ServiceAccountKey) SetServiceAccountId(v string) {
+	x.xxx_hidden_ServiceAccountId = v
+}
+
+func (x *ServiceAccountKey) SetKeyId(v string) {
+	x.xxx_hidden_KeyId = v
+}
+
+func (x *ServiceAccountKey) SetAlgorithm(v string) {
+	x.xxx_hidden_Algorithm = v
+}
+
+func (x *ServiceAccountKey) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *ServiceAccountKey) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *ServiceAccountKey) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+type ServiceAccountKey_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id               string
+	ServiceAccountId string
+	// The `kid` header of assertions signed with the key.
+	KeyId string
+	// The JWS algorithm of the key, `EdDSA`, `ES256` or `RS256`.
+	Algorithm string
+	CreatedAt *timestamppb.Timestamp
+}
+
+func (b0 ServiceAccountKey_builder) Build() *ServiceAccountKey {
+	m0 := &ServiceAccountKey{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_ServiceAccountId = b.ServiceAccountId
+	x.xxx_hidden_KeyId = b.KeyId
+	x.xxx_hidden_Algorithm = b.Algorithm
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	return m0
+}
+
+type CreateServiceAccountRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        string                 `protobuf:"bytes,1,opt,name=name,proto3"`
+	xxx_hidden_Description string                 `protobuf:"bytes,2,opt,name=description,proto3"`
+	xxx_hidden_Scopes      []string               `protobuf:"bytes,3,rep,name=scopes,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateServiceAccountRequest) Reset() {
+	*x = CreateServiceAccountRequest{}
+	mi := &file_guardian_v1_service_account_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceAccountRequest) ProtoMessage() {}
+
+func (x *CreateServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_service_account_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateServiceAccountRequest) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountRequest) GetDescription() string {
+	if x != nil {
+		return x.xxx_hidden_Description
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountRequest) GetScopes() []string {
+	if x != nil {
+		return x.xxx_hidden_Scopes
+	}
+	return nil
+}
+
+func (x *CreateServiceAccountRequest) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *CreateServiceAccountRequest) SetDescription(v string) {
+	x.xxx_hidden_Description = v
+}
+
+func (x *CreateServiceAccountRequest) SetScopes(v []string) {
+	x.xxx_hidden_Scopes = v
+}
+
+type CreateServiceAccountRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name        string
+	Description string
+	Scopes      []string
+}
+
+func (b0 CreateServiceAccountRequest_builder) Build() *CreateServiceAccountRequest {
+	m0 := &CreateServiceAccountRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_Description = b.Description
+	x.xxx_hidden_Scopes = b.Scopes
+	return m0
+}
+
+type CreateServiceAccountResponse struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ServiceAccount *ServiceAccount        `protobuf:"bytes,1,opt,name=service_account,json=serviceAccount,proto3"`
+	xxx_hidden_ClientSecret   string                 `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CreateServiceAccountResponse) Reset() {
+	*x = CreateServiceAccountResponse{}
+	mi := &file_guardian_v1_service_account_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceAccountResponse) ProtoMessage() {}
+
+func (x *CreateServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_service_account_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateServiceAccountResponse) GetServiceAccount() *ServiceAccount {
+	if x != nil {
+		return x.xxx_hidden_ServiceAccount
+	}
+	return nil
+}
+
+func (x *CreateServiceAccountResponse) GetClientSecret() string {
+	if x != nil {
+		return x.xxx_hidden_ClientSecret
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountResponse) SetServiceAccount(v *ServiceAccount) {
+	x.xxx_hidden_ServiceAccount = v
+}
+
+func (x *CreateServiceAccountResponse) SetClientSecret(v string) {
+	x.xxx_hidden_ClientSecret = v
+}
+
+func (x *CreateServiceAccountResponse) HasServiceAccount() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ServiceAccount != nil
+}
+
+func (x *CreateServiceAccountResponse) ClearServiceAccount() {
+	x.xxx_hidden_ServiceAccount = nil
+}
+
+type CreateServiceAccountResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ServiceAccount *ServiceAccount
+	ClientSecret   string
+}
+
+func (b0 CreateServiceAccountResponse_builder) Build() *CreateServiceAccountResponse {
+	m0 := &CreateServiceAccountResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ServiceAccount = b.ServiceAccount
+	x.xxx_hidden_ClientSecret = b.ClientSecret
+	return m0
+}
+
+type GetServiceAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceAccountRequest) Reset() {
+	*x = GetServiceAccountRequest{}
+	mi := &file_guardian_v1_service_account_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceAccountRequest) ProtoMessage() {}
+
+func (x *GetServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_service_account_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetServiceAccountRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *GetServiceAccountRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type GetServiceAccountRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 GetServiceAccountRequest_builder) Build() *GetServiceAccountRequest {
+	m0 := &GetServiceAccountRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type GetServiceAccountResponse struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ServiceAccount *ServiceAccount        `protobuf:"bytes,1,opt,name=service_account,json=serviceAccount,proto3"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *GetServiceAccountResponse) Reset() {
+	*x = GetServiceAccountResponse{}
+	mi := &file_guardian_v1_service_account_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceAccountResponse) ProtoMessage() {}
+
+func (x *GetServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_service_account_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetServiceAccountResponse) GetServiceAccount() *ServiceAccount {
+	if x != nil {
+		return x.xxx_hidden_ServiceAccount
+	}
+	return nil
+}
+
+func (x *GetServiceAccountResponse) SetServiceAccount(v *ServiceAccount) {
+	x.xxx_hidden_ServiceAccount = v
+}
+
+func (x *GetServiceAccountResponse) HasServiceAccount() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ServiceAccount != nil
+}
+
+func (x *GetServiceAccountResponse) ClearServiceAccount() {
+	x.xxx_hidden_ServiceAccount = nil
+}
+
+type GetServiceAccountResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ServiceAccount *ServiceAccount
+}
+
+func (b0 GetServiceAccountResponse_builder) Build() *GetServiceAccountResponse {
+	m0 := &GetServiceAccountResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ServiceAccount = b.ServiceAccount
+	return m0
+}
+
+type ListServiceAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServiceAccountsRequest) Reset() {
+	*x = ListServiceAccountsRequest{}
+	mi := &file_guardian_v1_service_account_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceAccountsRequest) ProtoMessage() {}
+
+func (x *ListServiceAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_service_account_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type ListServiceAccountsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 ListServiceAccountsRequest_builder) Build() *ListServiceAccountsRequest {
+	m0 := &ListServiceAccountsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type ListServiceAccountsResponse struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ServiceAccounts *[]*ServiceAccount     `protobuf:"bytes,1,rep,name=service_accounts,json=serviceAccounts,proto3"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *ListServiceAccountsResponse) Reset() {
+	*x = ListServiceAccountsResponse{}
+	mi := &file_guardian_v1_service_account_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceAccountsResponse) ProtoMessage() {}
+
+func (x *ListServiceAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_service_account_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListServiceAccountsResponse) GetServiceAccounts() []*ServiceAccount {
+	if x != nil {
+		if x.xxx_hidden_ServiceAccounts != nil {
+			return *x.xxx_hidden_ServiceAccounts
+		}
+	}
+	return nil
+}
+
+func (x *ListServiceAccountsResponse) SetServiceAccounts(v []*ServiceAccount) {
+	x.xxx_hidden_ServiceAccounts = &v
+}
+
+type ListServiceAccountsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ServiceAccounts []*ServiceAccount
+}
+
+func (b0 ListServiceAccountsResponse_builder) Build() *ListServiceAccountsResponse {
+	m0 := &ListServiceAccountsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ServiceAccounts = &b.ServiceAccounts
+	return m0
+}
+
+type UpdateServiceAccountRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Name        string                 `protobuf:"bytes,2,opt,name=name,proto3"`
+	xxx_hidden_Description string                 `protobuf:"bytes,3,opt,name=description,proto3"`
+	xxx_hidden_Scopes      []string               `protobuf:"bytes,4,rep,name=scopes,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateServiceAccountRequest) Reset() {
+	*x = UpdateServiceAccountRequest{}
+	mi := &file_guardian_v1_service_account_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServiceAccountRequest) ProtoMessage() {}
+
+func (x *UpdateServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_service_account_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateServiceAccountRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *UpdateServiceAccountRequest) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *UpdateServiceAccountRequest) GetDescription() string {
+	if x != nil {
+		return x.xxx_hidden_Description
+	}
+	return ""
+}
+
+func (x *UpdateServiceAccountRequest) GetScopes() []string {
+	if x != nil {
+		return x.xxx_hidden_Scopes
+	}
+	return nil
+}
+
+func (x *UpdateServiceAccountRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *UpdateServiceAccountRequest) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *UpdateServiceAccountRequest) SetDescription(v string) {
+	x.xxx_hidden_Description = v
+}
+
+func (x *UpdateServiceAccountRequest) SetScopes(v []string) {
+	x.xxx_hidden_Scopes = v
+}
+
+type UpdateServiceAccountRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id          string
+	Name        string
+	Description string
+	Scopes      []string
+}
+
+func (b0 UpdateServiceAccountRequest_builder) Build() *UpdateServiceAccountRequest {
+	m0 := &UpdateServiceAccountRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_Description = b.Description
+	x.xxx_hidden_Scopes = b.Scopes
+	return m0
+}
+
+type UpdateServiceAccountResponse struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ServiceAccount *ServiceAccount        `protobuf:"bytes,1,opt,name=service_account,json=serviceAccount,proto3"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *UpdateServiceAccountResponse) Reset() {
+	*x = UpdateServiceAccountResponse{}
+	mi := &file_guardian_v1_service_account_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServiceAccountResponse) ProtoMessage() {}
+
+func (x *UpdateServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_service_account_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateServiceAccountResponse) GetServiceAccount() *ServiceAccount {
+	if x != nil {
+		return x.xxx_hidden_ServiceAccount
+	}
+	return nil
+}
+
+func (x *UpdateServiceAccountResponse) SetServiceAccount(v *ServiceAccount) {
+	x.xxx_hidden_ServiceAccount = v
+}
+
+func (x *UpdateServiceAccountResponse) HasServiceAccount() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ServiceAccount != nil
+}
+
+func (x *UpdateServiceAccountResponse) ClearServiceAccount() {
+	x.xxx_hidden_ServiceAccount = nil
+}
+
+type UpdateServiceAccountResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ServiceAccount *ServiceAccount
+}
+
+func (b0 UpdateServiceAccountResponse_builder) Build() *UpdateServiceAccountResponse {
+	m0 := &UpdateServiceAccountResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ServiceAccount = b.ServiceAccount
+	return m0
+}
+
+type DeleteServiceAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteServiceAccountRequest) Reset() {
+	*x = DeleteServiceAccountRequest{}
+	mi := &file_guardian_v1_service_account_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceAccountRequest) ProtoMessage() {}
+
+func (x *DeleteServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_service_account_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteServiceAccountRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *DeleteServiceAccountRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type DeleteServiceAccountRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 DeleteServiceAccountRequest_builder) Build() *DeleteServiceAccountRequest {
+	m0 := &DeleteServiceAccountRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type DeleteServiceAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteServiceAccountResponse) Reset() {
+	*x = DeleteServiceAccountResponse{}
+	mi := &file_guardian_v1_service_account_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceAccountResponse) ProtoMessage() {}
+
+func (x *DeleteServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_service_account_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeleteServiceAccountResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeleteServiceAccountResponse_builder) Build() *DeleteServiceAccountResponse {
+	m0 := &DeleteServiceAccountResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type RotateServiceAccountSecretRequest struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id      string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Overlap *durationpb.Duration   `protobuf:"bytes,2,opt,name=overlap,proto3"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RotateServiceAccountSecretRequest) Reset() {
+	*x = RotateServiceAccountSecretRequest{}
+	mi := &file_guardian_v1_service_account_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateServiceAccountSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateServiceAccountSecretRequest) ProtoMessage() {}
+
+func (x *RotateServiceAccountSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_service_account_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RotateServiceAccountSecretRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *RotateServiceAccountSecretRequest) GetOverlap() *durationpb.Duration {
+	if x != nil {
+		return x.xxx_hidden_Overlap
+	}
+	return nil
+}
+
+func (x *RotateServiceAccountSecretRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *RotateServiceAccountSecretRequest) SetOverlap(v *durationpb.Duration) {
+	x.xxx_hidden_Overlap = v
+}
+
+func (x *RotateServiceAccountSecretRequest) HasOverlap() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Overlap != nil
+}
+
+func (x *RotateServiceAccountSecretRequest) ClearOverlap() {
+	x.xxx_hidden_Overlap = nil
+}
+
+type RotateServiceAccountSecretRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+	// Duration for which the previous secret stays valid. Zero invalidates it immediately, unset uses the configured
+	// default.
+	Overlap *durationpb.Duration
+}
+
+func (b0 RotateServiceAccountSecretRequest_builder) Build() *RotateServiceAccountSecretRequest {
+	m0 := &RotateServiceAccountSecretRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_Overlap = b.Overlap
+	return m0
+}
+
+type RotateServiceAccountSecretResponse struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ServiceAccount *ServiceAccount        `protobuf:"bytes,1,opt,name=service_account,json=serviceAccount,proto3"`
+	xxx_hidden_ClientSecret   string                 `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *RotateServiceAccountSecretResponse) Reset() {
+	*x = RotateServiceAccountSecretResponse{}
+	mi := &file_guardian_v1_service_account_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateServiceAccountSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateServiceAccountSecretResponse) ProtoMessage() {}
+
+func (x *RotateServiceAccountSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_service_account_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RotateServiceAccountSecretResponse) GetServiceAccount() *ServiceAccount {
+	if x != nil {
+		return x.xxx_hidden_ServiceAccount
+	}
+	return nil
+}
+
+func (x *RotateServiceAccountSecretResponse) GetClientSecret() string {
+	if x != nil {
+		return x.xxx_hidden_ClientSecret
+	}
+	return ""
+}
+
+func (x *RotateServiceAccountSecretResponse) SetServiceAccount(v *ServiceAccount) {
+	x.xxx_hidden_ServiceAccount = v
+}
+
+func (x *RotateServiceAccountSecretResponse) SetClientSecret(v string) {
+	x.xxx_hidden_ClientSecret = v
+}
+
+func (x *RotateServiceAccountSecretResponse) HasServiceAccount() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ServiceAccount != nil
+}
+
+func (x *RotateServiceAccountSecretResponse) ClearServiceAccount() {
+	x.xxx_hidden_ServiceAccount = nil
+}
+
+type RotateServiceAccountSecretResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ServiceAccount *ServiceAccount
+	ClientSecret   string
+}
+
+func (b0 RotateServiceAccountSecretResponse_builder) Build() *RotateServiceAccountSecretResponse {
+	m0 := &RotateServiceAccountSecretResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ServiceAccount = b.ServiceAccount
+	x.xxx_hidden_ClientSecret = b.ClientSecret
+	return m0
+}
+
+type AddServiceAccountKeyRequest struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ServiceAccountId string                 `protobuf:"bytes,1,opt,name=service_account_id,json=serviceAccountId,proto3"`
+	xxx_hidden_Jwk              string                 `protobuf:"bytes,2,opt,name=jwk,proto3"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *AddServiceAccountKeyRequest) Reset() {
+	*x = AddServiceAccountKeyRequest{}
+	mi := &file_guardian_v1_service_account_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddServiceAccountKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddServiceAccountKeyRequest) ProtoMessage() {}
+
+func (x *AddServiceAccountKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_service_account_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AddServiceAccountKeyRequest) GetServiceAccountId() string {
+	if x != nil {
+		return x.xxx_hidden_ServiceAccountId
+	}
+	return ""
+}
+
+func (x *AddServiceAccountKeyRequest) GetJwk() string {
+	if x != nil {
+		return x.xxx_hidden_Jwk
+	}
+	return ""
+}
+
+func (x *AddServiceAccountKeyRequest) SetServiceAccountId(v string) {
+	x.xxx_hidden_ServiceAccountId = v
+}
+
+func (x *AddServiceAccountKeyRequest) SetJwk(v string) {
+	x.xxx_hidden_Jwk = v
+}
+
+type AddServiceAccountKeyRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ServiceAccountId string
+	// The public key as JSON Web Key. The key id defaults to the RFC 7638 thumbprint of the key.
+	Jwk string
+}
+
+func (b0 AddServiceAccountKeyRequest_builder) Build() *AddServiceAccountKeyRequest {
+	m0 := &AddServiceAccountKeyRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ServiceAccountId = b.ServiceAccountId
+	x.xxx_hidden_Jwk = b.Jwk
+	return m0
+}
+
+type AddServiceAccountKeyResponse struct {
+	state          protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Key *ServiceAccountKey     `protobuf:"bytes,1,opt,name=key,proto3"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddServiceAccountKeyResponse) Reset() {
+	*x = AddServiceAccountKeyResponse{}
+	mi := &file_guardian_v1_service_account_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddServiceAccountKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddServiceAccountKeyResponse) ProtoMessage() {}
+
+func (x *AddServiceAccountKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_service_account_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AddServiceAccountKeyResponse) GetKey() *ServiceAccountKey {
+	if x != nil {
+		return x.xxx_hidden_Key
+	}
+	return nil
+}
+
+func (x *AddServiceAccountKeyResponse) SetKey(v *ServiceAccountKey) {
+	x.xxx_hidden_Key = v
+}
+
+func (x *AddServiceAccountKeyResponse) HasKey() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Key != nil
+}
+
+func (x *AddServiceAccountKeyResponse) ClearKey() {
+	x.xxx_hidden_Key = nil
+}
+
+type AddServiceAccountKeyResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Key *ServiceAccountKey
+}
+
+func (b0 AddServiceAccountKeyResponse_builder) Build() *AddServiceAccountKeyResponse {
+	m0 := &AddServiceAccountKeyResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Key = b.Key
+	return m0
+}
+
+type ListServiceAccountKeysRequest struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ServiceAccountId string                 `protobuf:"bytes,1,opt,name=service_account_id,json=serviceAccountId,proto3"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *ListServiceAccountKeysRequest) Reset() {
+	*x = ListServiceAccountKeysRequest{}
+	mi := &file_guardian_v1_service_account_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceAccountKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceAccountKeysRequest) ProtoMessage() {}
+
+func (x *ListServiceAccountKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_service_account_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListServiceAccountKeysRequest) GetServiceAccountId() string {
+	if x != nil {
+		return x.xxx_hidden_ServiceAccountId
+	}
+	return ""
+}
+
+func (x *ListServiceAccountKeysRequest) SetServiceAccountId(v string) {
+	x.xxx_hidden_ServiceAccountId = v
+}
+
+type ListServiceAccountKeysRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ServiceAccountId string
+}
+
+func (b0 ListServiceAccountKeysRequest_builder) Build() *ListServiceAccountKeysRequest {
+	m0 := &ListServiceAccountKeysRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ServiceAccountId = b.ServiceAccountId
+	return m0
+}
+
+type ListServiceAccountKeysResponse struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Keys *[]*ServiceAccountKey  `protobuf:"bytes,1,rep,name=keys,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListServiceAccountKeysResponse) Reset() {
+	*x = ListServiceAccountKeysResponse{}
+	mi := &file_guardian_v1_service_account_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceAccountKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceAccountKeysResponse) ProtoMessage() {}
+
+func (x *ListServiceAccountKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_service_account_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListServiceAccountKeysResponse) GetKeys() []*ServiceAccountKey {
+	if x != nil {
+		if x.xxx_hidden_Keys != nil {
+			return *x.xxx_hidden_Keys
+		}
+	}
+	return nil
+}
+
+func (x *ListServiceAccountKeysResponse) SetKeys(v []*ServiceAccountKey) {
+	x.xxx_hidden_Keys = &v
+}
+
+type ListServiceAccountKeysResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Keys []*ServiceAccountKey
+}
+
+func (b0 ListServiceAccountKeysResponse_builder) Build() *ListServiceAccountKeysResponse {
+	m0 := &ListServiceAccountKeysResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Keys = &b.Keys
+	return m0
+}
+
+type DeleteServiceAccountKeyRequest struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ServiceAccountId string                 `protobuf:"bytes,1,opt,name=service_account_id,json=serviceAccountId,proto3"`
+	xxx_hidden_Id               string                 `protobuf:"bytes,2,opt,name=id,proto3"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *DeleteServiceAccountKeyRequest) Reset() {
+	*x = DeleteServiceAccountKeyRequest{}
+	mi := &file_guardian_v1_service_account_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServiceAccountKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceAccountKeyRequest) ProtoMessage() {}
+
+func (x *DeleteServiceAccountKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_service_account_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteServiceAccountKeyRequest) GetServiceAccountId() string {
+	if x != nil {
+		return x.xxx_hidden_ServiceAccountId
+	}
+	return ""
+}
+
+func (x *DeleteServiceAccountKeyRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *DeleteServiceAccountKeyRequest) SetServiceAccountId(v string) {
+	x.xxx_hidden_ServiceAccountId = v
+}
+
+func (x *DeleteServiceAccountKeyRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type DeleteServiceAccountKeyRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ServiceAccountId string
+	Id               string
+}
+
+func (b0 DeleteServiceAccountKeyRequest_builder) Build() *DeleteServiceAccountKeyRequest {
+	m0 := &DeleteServiceAccountKeyRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ServiceAccountId = b.ServiceAccountId
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type DeleteServiceAccountKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteServiceAccountKeyResponse) Reset() {
+	*x = DeleteServiceAccountKeyResponse{}
+	mi := &file_guardian_v1_service_account_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServiceAccountKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceAccountKeyResponse) ProtoMessage() {}
+
+func (x *DeleteServiceAccountKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_service_account_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeleteServiceAccountKeyResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeleteServiceAccountKeyResponse_builder) Build() *DeleteServiceAccountKeyResponse {
+	m0 := &DeleteServiceAccountKeyResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+var File_guardian_v1_service_account_proto protoreflect.FileDescriptor
+
+const file_guardian_v1_service_account_proto_rawDesc = "" +
+	"\n" +
+	"!guardian/v1/service_account.proto\x12\vguardian.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xeb\x02\n" +
+	"\x0eServiceAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"rotated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\trotatedAt\x12J\n" +
+	"\x13previous_expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x11previousExpiresAt\"\xc1\x01\n" +
+	"\x11ServiceAccountKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
+	"\x12service_account_id\x18\x02 \x01(\tR\x10serviceAccountId\x12\x15\n" +
+	"\x06key_id\x18\x03 \x01(\tR\x05keyId\x12\x1c\n" +
+	"\talgorithm\x18\x04 \x01(\tR\talgorithm\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"k\n" +
+	"\x1bCreateServiceAccountRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\"\x89\x01\n" +
+	"\x1cCreateServiceAccountResponse\x12D\n" +
+	"\x0fservice_account\x18\x01 \x01(\v2\x1b.guardian.v1.ServiceAccountR\x0eserviceAccount\x12#\n" +
+	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\"*\n" +
+	"\x18GetServiceAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"a\n" +
+	"\x19GetServiceAccountResponse\x12D\n" +
+	"\x0fservice_account\x18\x01 \x01(\v2\x1b.guardian.v1.ServiceAccountR\x0eserviceAccount\"\x1c\n" +
+	"\x1aListServiceAccountsRequest\"e\n" +
+	"\x1bListServiceAccountsResponse\x12F\n" +
+	"\x10service_accounts\x18\x01 \x03(\v2\x1b.guardian.v1.ServiceAccountR\x0fserviceAccounts\"{\n" +
+	"\x1bUpdateServiceAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\"d\n" +
+	"\x1cUpdateServiceAccountResponse\x12D\n" +
+	"\x0fservice_account\x18\x01 \x01(\v2\x1b.guardian.v1.ServiceAccountR\x0eserviceAccount\"-\n" +
+	"\x1bDeleteServiceAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1e\n" +
+	"\x1cDeleteServiceAccountResponse\"h\n" +
+	"!RotateServiceAccountSecretRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x123\n" +
+	"\aoverlap\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\aoverlap\"\x8f\x01\n" +
+	"\"RotateServiceAccountSecretResponse\x12D\n" +
+	"\x0fservice_account\x18\x01 \x01(\v2\x1b.guardian.v1.ServiceAccountR\x0eserviceAccount\x12#\n" +
+	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\"]\n" +
+	"\x1bAddServiceAccountKeyRequest\x12,\n" +
+	"\x12service_account_id\x18\x01 \x01(\tR\x10serviceAccountId\x12\x10\n" +
+	"\x03jwk\x18\x02 \x01(\tR\x03jwk\"P\n" +
+	"\x1cAddServiceAccountKeyResponse\x120\n" +
+	"\x03key\x18\x01 \x01(\v2\x1e.guardian.v1.ServiceAccountKeyR\x03key\"M\n" +
+	"\x1dListServiceAccountKeysRequest\x12,\n" +
+	"\x12service_account_id\x18\x01 \x01(\tR\x10serviceAccountId\"T\n" +
+	"\x1eListServiceAccountKeysResponse\x122\n" +
+	"\x04keys\x18\x01 \x03(\v2\x1e.guardian.v1.ServiceAccountKeyR\x04keys\"^\n" +
+	"\x1eDeleteServiceAccountKeyRequest\x12,\n" +
+	"\x12service_account_id\x18\x01 \x01(\tR\x10serviceAccountId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"!\n" +
+	"\x1fDeleteServiceAccountKeyResponse2\x81\b\n" +
+	"\x15ServiceAccountService\x12k\n" +
+	"\x14CreateServiceAccount\x12(.guardian.v1.CreateServiceAccountRequest\x1a).guardian.v1.CreateServiceAccountResponse\x12b\n" +
+	"\x11GetServiceAccount\x12%.guardian.v1.GetServiceAccountRequest\x1a&.guardian.v1.GetServiceAccountResponse\x12h\n" +
+	"\x13ListServiceAccounts\x12'.guardian.v1.ListServiceAccountsRequest\x1a(.guardian.v1.ListServiceAccountsResponse\x12k\n" +
+	"\x14UpdateServiceAccount\x12(.guardian.v1.UpdateServiceAccountRequest\x1a).guardian.v1.UpdateServiceAccountResponse\x12k\n" +
+	"\x14DeleteServiceAccount\x12(.guardian.v1.DeleteServiceAccountRequest\x1a).guardian.v1.DeleteServiceAccountResponse\x12}\n" +
+	"\x1aRotateServiceAccountSecret\x12..guardian.v1.RotateServiceAccountSecretRequest\x1a/.guardian.v1.RotateServiceAccountSecretResponse\x12k\n" +
+	"\x14AddServiceAccountKey\x12(.guardian.v1.AddServiceAccountKeyRequest\x1a).guardian.v1.AddServiceAccountKeyResponse\x12q\n" +
+	"\x16ListServiceAccountKeys\x12*.guardian.v1.ListServiceAccountKeysRequest\x1a+.guardian.v1.ListServiceAccountKeysResponse\x12t\n" +
+	"\x17DeleteServiceAccountKey\x12+.guardian.v1.DeleteServiceAccountKeyRequest\x1a,.guardian.v1.DeleteServiceAccountKeyResponseB\xb3\x01\n" +
+	"\x0fcom.guardian.v1B\x14Service_accountProtoP\x01Z=github.com/gophero/guardian/core/proto/guardian/v1;guardianv1\xa2\x02\x03GVX\xaa\x02\vGuardian.V1\xca\x02\vGuardian\\V1\xe2\x02\x17Guardian\\V1\\GPBMetadata\xea\x02\fGuardian::V1b\x06proto3"
+
+var file_guardian_v1_service_account_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_guardian_v1_service_account_proto_goTypes = []any{
+	(*ServiceAccount)(nil),                     // 0: guardian.v1.ServiceAccount
+	(*ServiceAccountKey)(nil),                  // 1: guardian.v1.ServiceAccountKey
+	(*CreateServiceAccountRequest)(nil),        // 2: guardian.v1.CreateServiceAccountRequest
+	(*CreateServiceAccountResponse)(nil),       // 3: guardian.v1.CreateServiceAccountResponse
+	(*GetServiceAccountRequest)(nil),           // 4: guardian.v1.GetServiceAccountRequest
+	(*GetServiceAccountResponse)(nil),          // 5: guardian.v1.GetServiceAccountResponse
+	(*ListServiceAccountsRequest)(nil),         // 6: guardian.v1.ListServiceAccountsRequest
+	(*ListServiceAccountsResponse)(nil),        // 7: guardian.v1.ListServiceAccountsResponse
+	(*UpdateServiceAccountRequest)(nil),        // 8: guardian.v1.UpdateServiceAccountRequest
+	(*UpdateServiceAccountResponse)(nil),       // 9: guardian.v1.UpdateServiceAccountResponse
+	(*DeleteServiceAccountRequest)(nil),        // 10: guardian.v1.DeleteServiceAccountRequest
+	(*DeleteServiceAccountResponse)(nil),       // 11: guardian.v1.DeleteServiceAccountResponse
+	(*RotateServiceAccountSecretRequest)(nil),  // 12: guardian.v1.RotateServiceAccountSecretRequest
+	(*RotateServiceAccountSecretResponse)(nil), // 13: guardian.v1.RotateServiceAccountSecretResponse
+	(*AddServiceAccountKeyRequest)(nil),        // 14: guardian.v1.AddServiceAccountKeyRequest
+	(*AddServiceAccountKeyResponse)(nil),       // 15: guardian.v1.AddServiceAccountKeyResponse
+	(*ListServiceAccountKeysRequest)(nil),      // 16: guardian.v1.ListServiceAccountKeysRequest
+	(*ListServiceAccountKeysResponse)(nil),     // 17: guardian.v1.ListServiceAccountKeysResponse
+	(*DeleteServiceAccountKeyRequest)(nil),     // 18: guardian.v1.DeleteServiceAccountKeyRequest
+	(*DeleteServiceAccountKeyResponse)(nil),    // 19: guardian.v1.DeleteServiceAccountKeyResponse
+	(*timestamppb.Timestamp)(nil),              // 20: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                // 21: google.protobuf.Duration
+}
+var file_guardian_v1_service_account_proto_depIdxs = []int32{
+	20, // 0: guardian.v1.ServiceAccount.created_at:type_name -> google.protobuf.Timestamp
+	20, // 1: guardian.v1.ServiceAccount.updated_at:type_name -> google.protobuf.Timestamp
+	20, // 2: guardian.v1.ServiceAccount.rotated_at:type_name -> google.protobuf.Timestamp
+	20, // 3: guardian.v1.ServiceAccount.previous_expires_at:type_name -> google.protobuf.Timestamp
+	20, // 4: guardian.v1.ServiceAccountKey.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: guardian.v1.CreateServiceAccountResponse.service_account:type_name -> guardian.v1.ServiceAccount
+	0,  // 6: guardian.v1.GetServiceAccountResponse.service_account:type_name -> guardian.v1.ServiceAccount
+	0,  // 7: guardian.v1.ListServiceAccountsResponse.service_accounts:type_name -> guardian.v1.ServiceAccount
+	0,  // 8: guardian.v1.UpdateServiceAccountResponse.service_account:type_name -> guardian.v1.ServiceAccount
+	21, // 9: guardian.v1.RotateServiceAccountSecretRequest.overlap:type_name -> google.protobuf.Duration
+	0,  // 10: guardian.v1.RotateServiceAccountSecretResponse.service_account:type_name -> guardian.v1.ServiceAccount
+	1,  // 11: guardian.v1.AddServiceAccountKeyResponse.key:type_name -> guardian.v1.ServiceAccountKey
+	1,  // 12: guardian.v1.ListServiceAccountKeysResponse.keys:type_name -> guardian.v1.ServiceAccountKey
+	2,  // 13: guardian.v1.ServiceAccountService.CreateServiceAccount:input_type -> guardian.v1.CreateServiceAccountRequest
+	4,  // 14: guardian.v1.ServiceAccountService.GetServiceAccount:input_type -> guardian.v1.GetServiceAccountRequest
+	6,  // 15: guardian.v1.ServiceAccountService.ListServiceAccounts:input_type -> guardian.v1.ListServiceAccountsRequest
+	8,  // 16: guardian.v1.ServiceAccountService.UpdateServiceAccount:input_type -> guardian.v1.UpdateServiceAccountRequest
+	10, // 17: guardian.v1.ServiceAccountService.DeleteServiceAccount:input_type -> guardian.v1.DeleteServiceAccountRequest
+	12, // 18: guardian.v1.ServiceAccountService.RotateServiceAccountSecret:input_type -> guardian.v1.RotateServiceAccountSecretRequest
+	14, // 19: guardian.v1.ServiceAccountService.AddServiceAccountKey:input_type -> guardian.v1.AddServiceAccountKeyRequest
+	16, // 20: guardian.v1.ServiceAccountService.ListServiceAccountKeys:input_type -> guardian.v1.ListServiceAccountKeysRequest
+	18, // 21: guardian.v1.ServiceAccountService.DeleteServiceAccountKey:input_type -> guardian.v1.DeleteServiceAccountKeyRequest
+	3,  // 22: guardian.v1.ServiceAccountService.CreateServiceAccount:output_type -> guardian.v1.CreateServiceAccountResponse
+	5,  // 23: guardian.v1.ServiceAccountService.GetServiceAccount:output_type -> guardian.v1.GetServiceAccountResponse
+	7,  // 24: guardian.v1.ServiceAccountService.ListServiceAccounts:output_type -> guardian.v1.ListServiceAccountsResponse
+	9,  // 25: guardian.v1.ServiceAccountService.UpdateServiceAccount:output_type -> guardian.v1.UpdateServiceAccountResponse
+	11, // 26: guardian.v1.ServiceAccountService.DeleteServiceAccount:output_type -> guardian.v1.DeleteServiceAccountResponse
+	13, // 27: guardian.v1.ServiceAccountService.RotateServiceAccountSecret:output_type -> guardian.v1.RotateServiceAccountSecretResponse
+	15, // 28: guardian.v1.ServiceAccountService.AddServiceAccountKey:output_type -> guardian.v1.AddServiceAccountKeyResponse
+	17, // 29: guardian.v1.ServiceAccountService.ListServiceAccountKeys:output_type -> guardian.v1.ListServiceAccountKeysResponse
+	19, // 30: guardian.v1.ServiceAccountService.DeleteServiceAccountKey:output_type -> guardian.v1.DeleteServiceAccountKeyResponse
+	22, // [22:31] is the sub-list for method output_type
+	13, // [13:22] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_guardian_v1_service_account_proto_init() }
+func file_guardian_v1_service_account_proto_init() {
+	if File_guardian_v1_service_account_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guardian_v1_service_account_proto_rawDesc), len(file_guardian_v1_service_account_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_guardian_v1_service_account_proto_goTypes,
+		DependencyIndexes: file_guardian_v1_service_account_proto_depIdxs,
+		MessageInfos:      file_guardian_v1_service_account_proto_msgTypes,
+	}.Build()
+	File_guardian_v1_service_account_proto = out.File
+	file_guardian_v1_service_account_proto_goTypes = nil
+	file_guardian_v1_service_account_proto_depIdxs = nil
+}
@@ -0,0 +1,1799 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: guardian/v1/user.proto
+
+package guardianv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UserStatus is the lifecycle state of a user.
+type UserStatus int32
+
+const (
+	UserStatus_USER_STATUS_UNSPECIFIED UserStatus = 0
+	UserStatus_USER_STATUS_ACTIVE      UserStatus = 1
+	UserStatus_USER_STATUS_SUSPENDED   UserStatus = 2
+	UserStatus_USER_STATUS_DISABLED    UserStatus = 3
+)
+
+// Enum value maps for UserStatus.
+var (
+	UserStatus_name = map[int32]string{
+		0: "USER_STATUS_UNSPECIFIED",
+		1: "USER_STATUS_ACTIVE",
+		2: "USER_STATUS_SUSPENDED",
+		3: "USER_STATUS_DISABLED",
+	}
+	UserStatus_value = map[string]int32{
+		"USER_STATUS_UNSPECIFIED": 0,
+		"USER_STATUS_ACTIVE":      1,
+		"USER_STATUS_SUSPENDED":   2,
+		"USER_STATUS_DISABLED":    3,
+	}
+)
+
+func (x UserStatus) Enum() *UserStatus {
+	p := new(UserStatus)
+	*p = x
+	return p
+}
+
+func (x UserStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_guardian_v1_user_proto_enumTypes[0].Descriptor()
+}
+
+func (UserStatus) Type() protoreflect.EnumType {
+	return &file_guardian_v1_user_proto_enumTypes[0]
+}
+
+func (x UserStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// User is an account managed by guardian.
+type User struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id              string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Email           string                 `protobuf:"bytes,2,opt,name=email,proto3"`
+	xxx_hidden_Username        string                 `protobuf:"bytes,3,opt,name=username,proto3"`
+	xxx_hidden_Status          UserStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=guardian.v1.UserStatus"`
+	xxx_hidden_CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3"`
+	xxx_hidden_UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3"`
+	xxx_hidden_EmailVerifiedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=email_verified_at,json=emailVerifiedAt,proto3"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_guardian_v1_user_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_user_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.xxx_hidden_Email
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.xxx_hidden_Username
+	}
+	return ""
+}
+
+func (x *User) GetStatus() UserStatus {
+	if x != nil {
+		return x.xxx_hidden_Status
+	}
+	return UserStatus_USER_STATUS_UNSPECIFIED
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
+	}
+	return nil
+}
+
+func (x *User) GetEmailVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_EmailVerifiedAt
+	}
+	return nil
+}
+
+func (x *User) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *User) SetEmail(v string) {
+	x.xxx_hidden_Email = v
+}
+
+func (x *User) SetUsername(v string) {
+	x.xxx_hidden_Username = v
+}
+
+func (x *User) SetStatus(v UserStatus) {
+	x.xxx_hidden_Status = v
+}
+
+func (x *User) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *User) SetUpdatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *User) SetEmailVerifiedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_EmailVerifiedAt = v
+}
+
+func (x *User) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *User) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *User) HasEmailVerifiedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_EmailVerifiedAt != nil
+}
+
+func (x *User) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *User) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+func (x *User) ClearEmailVerifiedAt() {
+	x.xxx_hidden_EmailVerifiedAt = nil
+}
+
+type User_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id        string
+	Email     string
+	Username  string
+	Status    UserStatus
+	CreatedAt *timestamppb.Timestamp
+	UpdatedAt *timestamppb.Timestamp
+	// Not set until the user verified its email address.
+	EmailVerifiedAt *timestamppb.Timestamp
+}
+
+func (b0 User_builder) Build() *User {
+	m0 := &User{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_Email = b.Email
+	x.xxx_hidden_Username = b.Username
+	x.xxx_hidden_Status = b.Status
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	x.xxx_hidden_EmailVerifiedAt = b.EmailVerifiedAt
+	return m0
+}
+
+// UserProfile holds the standard claims of OpenID Connect about a user. Empty fields are unknown.
+type UserProfile struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        string                 `protobuf:"bytes,1,opt,name=name,proto3"`
+	xxx_hidden_GivenName   string                 `protobuf:"bytes,2,opt,name=given_name,json=givenName,proto3"`
+	xxx_hidden_FamilyName  string                 `protobuf:"bytes,3,opt,name=family_name,json=familyName,proto3"`
+	xxx_hidden_Nickname    string                 `protobuf:"bytes,4,opt,name=nickname,proto3"`
+	xxx_hidden_Picture     string                 `protobuf:"bytes,5,opt,name=picture,proto3"`
+	xxx_hidden_Website     string                 `protobuf:"bytes,6,opt,name=website,proto3"`
+	xxx_hidden_Birthdate   string                 `protobuf:"bytes,7,opt,name=birthdate,proto3"`
+	xxx_hidden_Zoneinfo    string                 `protobuf:"bytes,8,opt,name=zoneinfo,proto3"`
+	xxx_hidden_Locale      string                 `protobuf:"bytes,9,opt,name=locale,proto3"`
+	xxx_hidden_PhoneNumber string                 `protobuf:"bytes,10,opt,name=phone_number,json=phoneNumber,proto3"`
+	xxx_hidden_Address     *Address               `protobuf:"bytes,11,opt,name=address,proto3"`
+	xxx_hidden_UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
+	mi := &file_guardian_v1_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfile) ProtoMessage() {}
+
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UserProfile) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *UserProfile) GetGivenName() string {
+	if x != nil {
+		return x.xxx_hidden_GivenName
+	}
+	return ""
+}
+
+func (x *UserProfile) GetFamilyName() string {
+	if x != nil {
+		return x.xxx_hidden_FamilyName
+	}
+	return ""
+}
+
+func (x *UserProfile) GetNickname() string {
+	if x != nil {
+		return x.xxx_hidden_Nickname
+	}
+	return ""
+}
+
+func (x *UserProfile) GetPicture() string {
+	if x != nil {
+		return x.xxx_hidden_Picture
+	}
+	return ""
+}
+
+func (x *UserProfile) GetWebsite() string {
+	if x != nil {
+		return x.xxx_hidden_Website
+	}
+	return ""
+}
+
+func (x *UserProfile) GetBirthdate() string {
+	if x != nil {
+		return x.xxx_hidden_Birthdate
+	}
+	return ""
+}
+
+func (x *UserProfile) GetZoneinfo() string {
+	if x != nil {
+		return x.xxx_hidden_Zoneinfo
+	}
+	return ""
+}
+
+func (x *UserProfile) GetLocale() string {
+	if x != nil {
+		return x.xxx_hidden_Locale
+	}
+	return ""
+}
+
+func (x *UserProfile) GetPhoneNumber() string {
+	if x != nil {
+		return x.xxx_hidden_PhoneNumber
+	}
+	return ""
+}
+
+func (x *UserProfile) GetAddress() *Address {
+	if x != nil {
+		return x.xxx_hidden_Address
+	}
+	return nil
+}
+
+func (x *UserProfile) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
+	}
+	return nil
+}
+
+func (x *UserProfile) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *UserProfile) SetGivenName(v string) {
+	x.xxx_hidden_GivenName = v
+}
+
+func (x *UserProfile) SetFamilyName(v string) {
+	x.xxx_hidden_FamilyName = v
+}
+
+func (x *UserProfile) SetNickname(v string) {
+	x.xxx_hidden_Nickname = v
+}
+
+func (x *UserProfile) SetPicture(v string) {
+	x.xxx_hidden_Picture = v
+}
+
+func (x *UserProfile) SetWebsite(v string) {
+	x.xxx_hidden_Website = v
+}
+
+func (x *UserProfile) SetBirthdate(v string) {
+	x.xxx_hidden_Birthdate = v
+}
+
+func (x *UserProfile) SetZoneinfo(v string) {
+	x.xxx_hidden_Zoneinfo = v
+}
+
+func (x *UserProfile) SetLocale(v string) {
+	x.xxx_hidden_Locale = v
+}
+
+func (x *UserProfile) SetPhoneNumber(v string) {
+	x.xxx_hidden_PhoneNumber = v
+}
+
+func (x *UserProfile) SetAddress(v *Address) {
+	x.xxx_hidden_Address = v
+}
+
+func (x *UserProfile) SetUpdatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *UserProfile) HasAddress() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Address != nil
+}
+
+func (x *UserProfile) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *UserProfile) ClearAddress() {
+	x.xxx_hidden_Address = nil
+}
+
+func (x *UserProfile) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+type UserProfile_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name       string
+	GivenName  string
+	FamilyName string
+	Nickname   string
+	// URL of a profile picture.
+	Picture string
+	// URL of a web page or blog.
+	Website string
+	// Formatted as YYYY-MM-DD, YYYY if only the year is known or 0000-MM-DD if the year is withheld.
+	Birthdate string
+	// IANA time zone like `Europe/Paris`.
+	Zoneinfo string
+	// BCP 47 language tag like `en-US`.
+	Locale string
+	// E.164 phone number like `+14155550100`.
+	PhoneNumber string
+	Address     *Address
+	// Not set if the profile was never updated.
+	UpdatedAt *timestamppb.Timestamp
+}
+
+func (b0 UserProfile_builder) Build() *UserProfile {
+	m0 := &UserProfile{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_GivenName = b.GivenName
+	x.xxx_hidden_FamilyName = b.FamilyName
+	x.xxx_hidden_Nickname = b.Nickname
+	x.xxx_hidden_Picture = b.Picture
+	x.xxx_hidden_Website = b.Website
+	x.xxx_hidden_Birthdate = b.Birthdate
+	x.xxx_hidden_Zoneinfo = b.Zoneinfo
+	x.xxx_hidden_Locale = b.Locale
+	x.xxx_hidden_PhoneNumber = b.PhoneNumber
+	x.xxx_hidden_Address = b.Address
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	return m0
+}
+
+// Address is a postal address.
+type Address struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_StreetAddress string                 `protobuf:"bytes,1,opt,name=street_address,json=streetAddress,proto3"`
+	xxx_hidden_Locality      string                 `protobuf:"bytes,2,opt,name=locality,proto3"`
+	xxx_hidden_Region        string                 `protobuf:"bytes,3,opt,name=region,proto3"`
+	xxx_hidden_PostalCode    string                 `protobuf:"bytes,4,opt,name=postal_code,json=postalCode,proto3"`
+	xxx_hidden_Country       string                 `protobuf:"bytes,5,opt,name=country,proto3"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_guardian_v1_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Address) GetStreetAddress() string {
+	if x != nil {
+		return x.xxx_hidden_StreetAddress
+	}
+	return ""
+}
+
+func (x *Address) GetLocality() string {
+	if x != nil {
+		return x.xxx_hidden_Locality
+	}
+	return ""
+}
+
+func (x *Address) GetRegion() string {
+	if x != nil {
+		return x.xxx_hidden_Region
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.xxx_hidden_PostalCode
+	}
+	return ""
+}
+
+func (x *Address) GetCountry() string {
+	if x != nil {
+		return x.xxx_hidden_Country
+	}
+	return ""
+}
+
+func (x *Address) SetStreetAddress(v string) {
+	x.xxx_hidden_StreetAddress = v
+}
+
+func (x *Address) SetLocality(v string) {
+	x.xxx_hidden_Locality = v
+}
+
+func (x *Address) SetRegion(v string) {
+	x.xxx_hidden_Region = v
+}
+
+func (x *Address) SetPostalCode(v string) {
+	x.xxx_hidden_PostalCode = v
+}
+
+func (x *Address) SetCountry(v string) {
+	x.xxx_hidden_Country = v
+}
+
+type Address_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// May span multiple lines.
+	StreetAddress string
+	Locality      string
+	Region        string
+	PostalCode    string
+	Country       string
+}
+
+func (b0 Address_builder) Build() *Address {
+	m0 := &Address{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_StreetAddress = b.StreetAddress
+	x.xxx_hidden_Locality = b.Locality
+	x.xxx_hidden_Region = b.Region
+	x.xxx_hidden_PostalCode = b.PostalCode
+	x.xxx_hidden_Country = b.Country
+	return m0
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_guardian_v1_user_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_user_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetUserRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *GetUserRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type GetUserRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 GetUserRequest_builder) Build() *GetUserRequest {
+	m0 := &GetUserRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type GetUserResponse struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_User *User                  `protobuf:"bytes,1,opt,name=user,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_guardian_v1_user_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_user_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetUserResponse) GetUser() *User {
+	if x != nil {
+		return x.xxx_hidden_User
+	}
+	return nil
+}
+
+func (x *GetUserResponse) SetUser(v *User) {
+	x.xxx_hidden_User = v
+}
+
+func (x *GetUserResponse) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_User != nil
+}
+
+func (x *GetUserResponse) ClearUser() {
+	x.xxx_hidden_User = nil
+}
+
+type GetUserResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	User *User
+}
+
+func (b0 GetUserResponse_builder) Build() *GetUserResponse {
+	m0 := &GetUserResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_User = b.User
+	return m0
+}
+
+type UpdateUserRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Email       *string                `protobuf:"bytes,2,opt,name=email,proto3,oneof"`
+	xxx_hidden_Username    *string                `protobuf:"bytes,3,opt,name=username,proto3,oneof"`
+	xxx_hidden_Status      UserStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=guardian.v1.UserStatus,oneof"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_guardian_v1_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateUserRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetEmail() string {
+	if x != nil {
+		if x.xxx_hidden_Email != nil {
+			return *x.xxx_hidden_Email
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetUsername() string {
+	if x != nil {
+		if x.xxx_hidden_Username != nil {
+			return *x.xxx_hidden_Username
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetStatus() UserStatus {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 3) {
+			return x.xxx_hidden_Status
+		}
+	}
+	return UserStatus_USER_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateUserRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *UpdateUserRequest) SetEmail(v string) {
+	x.xxx_hidden_Email = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *UpdateUserRequest) SetUsername(v string) {
+	x.xxx_hidden_Username = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *UpdateUserRequest) SetStatus(v UserStatus) {
+	x.xxx_hidden_Status = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *UpdateUserRequest) HasEmail() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *UpdateUserRequest) HasUsername() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *UpdateUserRequest) HasStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *UpdateUserRequest) ClearEmail() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Email = nil
+}
+
+func (x *UpdateUserRequest) ClearUsername() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Username = nil
+}
+
+func (x *UpdateUserRequest) ClearStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Status = UserStatus_USER_STATUS_UNSPECIFIED
+}
+
+type UpdateUserRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id       string
+	Email    *string
+	Username *string
+	Status   *UserStatus
+}
+
+func (b0 UpdateUserRequest_builder) Build() *UpdateUserRequest {
+	m0 := &UpdateUserRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	if b.Email != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Email = b.Email
+	}
+	if b.Username != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Username = b.Username
+	}
+	if b.Status != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Status = *b.Status
+	}
+	return m0
+}
+
+type UpdateUserResponse struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_User *User                  `protobuf:"bytes,1,opt,name=user,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateUserResponse) Reset() {
+	*x = UpdateUserResponse{}
+	mi := &file_guardian_v1_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserResponse) ProtoMessage() {}
+
+func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.xxx_hidden_User
+	}
+	return nil
+}
+
+func (x *UpdateUserResponse) SetUser(v *User) {
+	x.xxx_hidden_User = v
+}
+
+func (x *UpdateUserResponse) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_User != nil
+}
+
+func (x *UpdateUserResponse) ClearUser() {
+	x.xxx_hidden_User = nil
+}
+
+type UpdateUserResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	User *User
+}
+
+func (b0 UpdateUserResponse_builder) Build() *UpdateUserResponse {
+	m0 := &UpdateUserResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_User = b.User
+	return m0
+}
+
+type RequestEmailChangeRequest struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id    string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Email string                 `protobuf:"bytes,2,opt,name=email,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RequestEmailChangeRequest) Reset() {
+	*x = RequestEmailChangeRequest{}
+	mi := &file_guardian_v1_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailChangeRequest) ProtoMessage() {}
+
+func (x *RequestEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RequestEmailChangeRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *RequestEmailChangeRequest) GetEmail() string {
+	if x != nil {
+		return x.xxx_hidden_Email
+	}
+	return ""
+}
+
+func (x *RequestEmailChangeRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *RequestEmailChangeRequest) SetEmail(v string) {
+	x.xxx_hidden_Email = v
+}
+
+type RequestEmailChangeRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+	// The new email address.
+	Email string
+}
+
+func (b0 RequestEmailChangeRequest_builder) Build() *RequestEmailChangeRequest {
+	m0 := &RequestEmailChangeRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_Email = b.Email
+	return m0
+}
+
+type RequestEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmailChangeResponse) Reset() {
+	*x = RequestEmailChangeResponse{}
+	mi := &file_guardian_v1_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailChangeResponse) ProtoMessage() {}
+
+func (x *RequestEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RequestEmailChangeResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RequestEmailChangeResponse_builder) Build() *RequestEmailChangeResponse {
+	m0 := &RequestEmailChangeResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type ListUsersRequest struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Status    UserStatus             `protobuf:"varint,1,opt,name=status,proto3,enum=guardian.v1.UserStatus"`
+	xxx_hidden_Query     string                 `protobuf:"bytes,2,opt,name=query,proto3"`
+	xxx_hidden_PageSize  int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3"`
+	xxx_hidden_PageToken string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_guardian_v1_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListUsersRequest) GetStatus() UserStatus {
+	if x != nil {
+		return x.xxx_hidden_Status
+	}
+	return UserStatus_USER_STATUS_UNSPECIFIED
+}
+
+func (x *ListUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.xxx_hidden_Query
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.xxx_hidden_PageSize
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.xxx_hidden_PageToken
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) SetStatus(v UserStatus) {
+	x.xxx_hidden_Status = v
+}
+
+func (x *ListUsersRequest) SetQuery(v string) {
+	x.xxx_hidden_Query = v
+}
+
+func (x *ListUsersRequest) SetPageSize(v int32) {
+	x.xxx_hidden_PageSize = v
+}
+
+func (x *ListUsersRequest) SetPageToken(v string) {
+	x.xxx_hidden_PageToken = v
+}
+
+type ListUsersRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Only return users with this status. Cannot be combined with query.
+	Status UserStatus
+	// Only return users whose email or username contains query, ignoring case.
+	Query    string
+	PageSize int32
+	// The next_page_token of the previous response.
+	PageToken string
+}
+
+func (b0 ListUsersRequest_builder) Build() *ListUsersRequest {
+	m0 := &ListUsersRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Status = b.Status
+	x.xxx_hidden_Query = b.Query
+	x.xxx_hidden_PageSize = b.PageSize
+	x.xxx_hidden_PageToken = b.PageToken
+	return m0
+}
+
+type ListUsersResponse struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Users         *[]*User               `protobuf:"bytes,1,rep,name=users,proto3"`
+	xxx_hidden_NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_guardian_v1_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListUsersResponse) GetUsers() []*User {
+	if x != nil {
+		if x.xxx_hidden_Users != nil {
+			return *x.xxx_hidden_Users
+		}
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.xxx_hidden_NextPageToken
+	}
+	return ""
+}
+
+func (x *ListUsersResponse) SetUsers(v []*User) {
+	x.xxx_hidden_Users = &v
+}
+
+func (x *ListUsersResponse) SetNextPageToken(v string) {
+	x.xxx_hidden_NextPageToken = v
+}
+
+type ListUsersResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Users []*User
+	// Empty on the last page.
+	NextPageToken string
+}
+
+func (b0 ListUsersResponse_builder) Build() *ListUsersResponse {
+	m0 := &ListUsersResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Users = &b.Users
+	x.xxx_hidden_NextPageToken = b.NextPageToken
+	return m0
+}
+
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_guardian_v1_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteUserRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *DeleteUserRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type DeleteUserRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 DeleteUserRequest_builder) Build() *DeleteUserRequest {
+	m0 := &DeleteUserRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type DeleteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
+	mi := &file_guardian_v1_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserResponse) ProtoMessage() {}
+
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeleteUserResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeleteUserResponse_builder) Build() *DeleteUserResponse {
+	m0 := &DeleteUserResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GetUserProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserProfileRequest) Reset() {
+	*x = GetUserProfileRequest{}
+	mi := &file_guardian_v1_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProfileRequest) ProtoMessage() {}
+
+func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetUserProfileRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *GetUserProfileRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type GetUserProfileRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 GetUserProfileRequest_builder) Build() *GetUserProfileRequest {
+	m0 := &GetUserProfileRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type GetUserProfileResponse struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Profile *UserProfile           `protobuf:"bytes,1,opt,name=profile,proto3"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetUserProfileResponse) Reset() {
+	*x = GetUserProfileResponse{}
+	mi := &file_guardian_v1_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProfileResponse) ProtoMessage() {}
+
+func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetUserProfileResponse) GetProfile() *UserProfile {
+	if x != nil {
+		return x.xxx_hidden_Profile
+	}
+	return nil
+}
+
+func (x *GetUserProfileResponse) SetProfile(v *UserProfile) {
+	x.xxx_hidden_Profile = v
+}
+
+func (x *GetUserProfileResponse) HasProfile() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Profile != nil
+}
+
+func (x *GetUserProfileResponse) ClearProfile() {
+	x.xxx_hidden_Profile = nil
+}
+
+type GetUserProfileResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Profile *UserProfile
+}
+
+func (b0 GetUserProfileResponse_builder) Build() *GetUserProfileResponse {
+	m0 := &GetUserProfileResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Profile = b.Profile
+	return m0
+}
+
+type UpdateUserProfileRequest struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id      string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Profile *UserProfile           `protobuf:"bytes,2,opt,name=profile,proto3"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateUserProfileRequest) Reset() {
+	*x = UpdateUserProfileRequest{}
+	mi := &file_guardian_v1_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserProfileRequest) ProtoMessage() {}
+
+func (x *UpdateUserProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateUserProfileRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *UpdateUserProfileRequest) GetProfile() *UserProfile {
+	if x != nil {
+		return x.xxx_hidden_Profile
+	}
+	return nil
+}
+
+func (x *UpdateUserProfileRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *UpdateUserProfileRequest) SetProfile(v *UserProfile) {
+	x.xxx_hidden_Profile = v
+}
+
+func (x *UpdateUserProfileRequest) HasProfile() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Profile != nil
+}
+
+func (x *UpdateUserProfileRequest) ClearProfile() {
+	x.xxx_hidden_Profile = nil
+}
+
+type UpdateUserProfileRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id      string
+	Profile *UserProfile
+}
+
+func (b0 UpdateUserProfileRequest_builder) Build() *UpdateUserProfileRequest {
+	m0 := &UpdateUserProfileRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_Profile = b.Profile
+	return m0
+}
+
+type UpdateUserProfileResponse struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Profile *UserProfile           `protobuf:"bytes,1,opt,name=profile,proto3"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateUserProfileResponse) Reset() {
+	*x = UpdateUserProfileResponse{}
+	mi := &file_guardian_v1_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserProfileResponse) ProtoMessage() {}
+
+func (x *UpdateUserProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_v1_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateUserProfileResponse) GetProfile() *UserProfile {
+	if x != nil {
+		return x.xxx_hidden_Profile
+	}
+	return nil
+}
+
+func (x *UpdateUserProfileResponse) SetProfile(v *UserProfile) {
+	x.xxx_hidden_Profile = v
+}
+
+func (x *UpdateUserProfileResponse) HasProfile() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Profile != nil
+}
+
+func (x *UpdateUserProfileResponse) ClearProfile() {
+	x.xxx_hidden_Profile = nil
+}
+
+type UpdateUserProfileResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Profile *UserProfile
+}
+
+func (b0 UpdateUserProfileResponse_builder) Build() *UpdateUserProfileResponse {
+	m0 := &UpdateUserProfileResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Profile = b.Profile
+	return m0
+}
+
+var File_guardian_v1_user_proto protoreflect.FileDescriptor
+
+const file_guardian_v1_user_proto_rawDesc = "" +
+	"\n" +
+	"\x16guardian/v1/user.proto\x12\vguardian.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb7\x02\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12/\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x17.guardian.v1.UserStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12F\n" +
+	"\x11email_verified_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0femailVerifiedAt\"\x91\x03\n" +
+	"\vUserProfile\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"given_name\x18\x02 \x01(\tR\tgivenName\x12\x1f\n" +
+	"\vfamily_name\x18\x03 \x01(\tR\n" +
+	"familyName\x12\x1a\n" +
+	"\bnickname\x18\x04 \x01(\tR\bnickname\x12\x18\n" +
+	"\apicture\x18\x05 \x01(\tR\apicture\x12\x18\n" +
+	"\awebsite\x18\x06 \x01(\tR\awebsite\x12\x1c\n" +
+	"\tbirthdate\x18\a \x01(\tR\tbirthdate\x12\x1a\n" +
+	"\bzoneinfo\x18\b \x01(\tR\bzoneinfo\x12\x16\n" +
+	"\x06locale\x18\t \x01(\tR\x06locale\x12!\n" +
+	"\fphone_number\x18\n" +
+	" \x01(\tR\vphoneNumber\x12.\n" +
+	"\aaddress\x18\v \x01(\v2\x14.guardian.v1.AddressR\aaddress\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9f\x01\n" +
+	"\aAddress\x12%\n" +
+	"\x0estreet_address\x18\x01 \x01(\tR\rstreetAddress\x12\x1a\n" +
+	"\blocality\x18\x02 \x01(\tR\blocality\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12\x1f\n" +
+	"\vpostal_code\x18\x04 \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\x05 \x01(\tR\acountry\" \n" +
+	"\x0eGetUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"8\n" +
+	"\x0fGetUserResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.guardian.v1.UserR\x04user\"\xb7\x01\n" +
+	"\x11UpdateUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\x05email\x18\x02 \x01(\tH\x00R\x05email\x88\x01\x01\x12\x1f\n" +
+	"\busername\x18\x03 \x01(\tH\x01R\busername\x88\x01\x01\x124\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x17.guardian.v1.UserStatusH\x02R\x06status\x88\x01\x01B\b\n" +
+	"\x06_emailB\v\n" +
+	"\t_usernameB\t\n" +
+	"\a_status\";\n" +
+	"\x12UpdateUserResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.guardian.v1.UserR\x04user\"A\n" +
+	"\x19RequestEmailChangeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\"\x1c\n" +
+	"\x1aRequestEmailChangeResponse\"\x95\x01\n" +
+	"\x10ListUsersRequest\x12/\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x17.guardian.v1.UserStatusR\x06status\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"d\n" +
+	"\x11ListUsersResponse\x12'\n" +
+	"\x05users\x18\x01 \x03(\v2\x11.guardian.v1.UserR\x05users\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"#\n" +
+	"\x11DeleteUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x12DeleteUserResponse\"'\n" +
+	"\x15GetUserProfileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"L\n" +
+	"\x16GetUserProfileResponse\x122\n" +
+	"\aprofile\x18\x01 \x01(\v2\x18.guardian.v1.UserProfileR\aprofile\"^\n" +
+	"\x18UpdateUserProfileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x122\n" +
+	"\aprofile\x18\x02 \x01(\v2\x18.guardian.v1.UserProfileR\aprofile\"O\n" +
+	"\x19UpdateUserProfileResponse\x122\n" +
+	"\aprofile\x18\x01 \x01(\v2\x18.guardian.v1.UserProfileR\aprofile*v\n" +
+	"\n" +
+	"UserStatus\x12\x1b\n" +
+	"\x17USER_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12USER_STATUS_ACTIVE\x10\x01\x12\x19\n" +
+	"\x15USER_STATUS_SUSPENDED\x10\x02\x12\x18\n" +
+	"\x14USER_STATUS_DISABLED\x10\x032\xe3\x04\n" +
+	"\vUserService\x12D\n" +
+	"\aGetUser\x12\x1b.guardian.v1.GetUserRequest\x1a\x1c.guardian.v1.GetUserResponse\x12M\n" +
+	"\n" +
+	"UpdateUser\x12\x1e.guardian.v1.UpdateUserRequest\x1a\x1f.guardian.v1.UpdateUserResponse\x12e\n" +
+	"\x12RequestEmailChange\x12&.guardian.v1.RequestEmailChangeRequest\x1a'.guardian.v1.RequestEmailChangeResponse\x12J\n" +
+	"\tListUsers\x12\x1d.guardian.v1.ListUsersRequest\x1a\x1e.guardian.v1.ListUsersResponse\x12M\n" +
+	"\n" +
+	"DeleteUser\x12\x1e.guardian.v1.DeleteUserRequest\x1a\x1f.guardian.v1.DeleteUserResponse\x12Y\n" +
+	"\x0eGetUserProfile\x12\".guardian.v1.GetUserProfileRequest\x1a#.guardian.v1.GetUserProfileResponse\x12b\n" +
+	"\x11UpdateUserProfile\x12%.guardian.v1.UpdateUserProfileRequest\x1a&.guardian.v1.UpdateUserProfileResponseB\xa8\x01\n" +
+	"\x0fcom.guardian.v1B\tUserProtoP\x01Z=github.com/gophero/guardian/core/proto/guardian/v1;guardianv1\xa2\x02\x03GVX\xaa\x02\vGuardian.V1\xca\x02\vGuardian\\V1\xe2\x02\x17Guardian\\V1\\GPBMetadata\xea\x02\fGuardian::V1b\x06proto3"
+
+var file_guardian_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_guardian_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_guardian_v1_user_proto_goTypes = []any{
+	(UserStatus)(0),                    // 0: guardian.v1.UserStatus
+	(*User)(nil),                       // 1: guardian.v1.User
+	(*UserProfile)(nil),                // 2: guardian.v1.UserProfile
+	(*Address)(nil),                    // 3: guardian.v1.Address
+	(*GetUserRequest)(nil),             // 4: guardian.v1.GetUserRequest
+	(*GetUserResponse)(nil),            // 5: guardian.v1.GetUserResponse
+	(*UpdateUserRequest)(nil),          // 6: guardian.v1.UpdateUserRequest
+	(*UpdateUserResponse)(nil),         // 7: guardian.v1.UpdateUserResponse
+	(*RequestEmailChangeRequest)(nil),  // 8: guardian.v1.RequestEmailChangeRequest
+	(*RequestEmailChangeResponse)(nil), // 9: guardian.v1.RequestEmailChangeResponse
+	(*ListUsersRequest)(nil),           // 10: guardian.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),          // 11: guardian.v1.ListUsersResponse
+	(*DeleteUserRequest)(nil),          // 12: guardian.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),         // 13: guardian.v1.DeleteUserResponse
+	(*GetUserProfileRequest)(nil),      // 14: guardian.v1.GetUserProfileRequest
+	(*GetUserProfileResponse)(nil),     // 15: guardian.v1.GetUserProfileResponse
+	(*UpdateUserProfileRequest)(nil),   // 16: guardian.v1.UpdateUserProfileRequest
+	(*UpdateUserProfileResponse)(nil),  // 17: guardian.v1.UpdateUserProfileResponse
+	(*timestamppb.Timestamp)(nil),      // 18: google.protobuf.Timestamp
+}
+var file_guardian_v1_user_proto_depIdxs = []int32{
+	0,  // 0: guardian.v1.User.status:type_name -> guardian.v1.UserStatus
+	18, // 1: guardian.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	18, // 2: guardian.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	18, // 3: guardian.v1.User.email_verified_at:type_name -> google.protobuf.Timestamp
+	3,  // 4: guardian.v1.UserProfile.address:type_name -> guardian.v1.Address
+	18, // 5: guardian.v1.UserProfile.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: guardian.v1.GetUserResponse.user:type_name -> guardian.v1.User
+	0,  // 7: guardian.v1.UpdateUserRequest.status:type_name -> guardian.v1.UserStatus
+	1,  // 8: guardian.v1.UpdateUserResponse.user:type_name -> guardian.v1.User
+	0,  // 9: guardian.v1.ListUsersRequest.status:type_name -> guardian.v1.UserStatus
+	1,  // 10: guardian.v1.ListUsersResponse.users:type_name -> guardian.v1.User
+	2,  // 11: guardian.v1.GetUserProfileResponse.profile:type_name -> guardian.v1.UserProfile
+	2,  // 12: guardian.v1.UpdateUserProfileRequest.profile:type_name -> guardian.v1.UserProfile
+	2,  // 13: guardian.v1.UpdateUserProfileResponse.profile:type_name -> guardian.v1.UserProfile
+	4,  // 14: guardian.v1.UserService.GetUser:input_type -> guardian.v1.GetUserRequest
+	6,  // 15: guardian.v1.UserService.UpdateUser:input_type -> guardian.v1.UpdateUserRequest
+	8,  // 16: guardian.v1.UserService.RequestEmailChange:input_type -> guardian.v1.RequestEmailChangeRequest
+	10, // 17: guardian.v1.UserService.ListUsers:input_type -> guardian.v1.ListUsersRequest
+	12, // 18: guardian.v1.UserService.DeleteUser:input_type -> guardian.v1.DeleteUserRequest
+	14, // 19: guardian.v1.UserService.GetUserProfile:input_type -> guardian.v1.GetUserProfileRequest
+	16, // 20: guardian.v1.UserService.UpdateUserProfile:input_type -> guardian.v1.UpdateUserProfileRequest
+	5,  // 21: guardian.v1.UserService.GetUser:output_type -> guardian.v1.GetUserResponse
+	7,  // 22: guardian.v1.UserService.UpdateUser:output_type -> guardian.v1.UpdateUserResponse
+	9,  // 23: guardian.v1.UserService.RequestEmailChange:output_type -> guardian.v1.RequestEmailChangeResponse
+	11, // 24: guardian.v1.UserService.ListUsers:output_type -> guardian.v1.ListUsersResponse
+	13, // 25: guardian.v1.UserService.DeleteUser:output_type -> guardian.v1.DeleteUserResponse
+	15, // 26: guardian.v1.UserService.GetUserProfile:output_type -> guardian.v1.GetUserProfileResponse
+	17, // 27: guardian.v1.UserService.UpdateUserProfile:output_type -> guardian.v1.UpdateUserProfileResponse
+	21, // [21:28] is the sub-list for method output_type
+	14, // [14:21] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_guardian_v1_user_proto_init() }
+func file_guardian_v1_user_proto_init() {
+	if File_guardian_v1_user_proto != nil {
+		return
+	}
+	file_guardian_v1_user_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guardian_v1_user_proto_rawDesc), len(file_guardian_v1_user_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_guardian_v1_user_proto_goTypes,
+		DependencyIndexes: file_guardian_v1_user_proto_depIdxs,
+		EnumInfos:         file_guardian_v1_user_proto_enumTypes,
+		MessageInfos:      file_guardian_v1_user_proto_msgTypes,
+	}.Build()
+	File_guardian_v1_user_proto = out.File
+	file_guardian_v1_user_proto_goTypes = nil
+	file_guardian_v1_user_proto_depIdxs = nil
+}
@@ -0,0 +1,112 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Permission is a named action which can be granted to roles, e.g. `documents.read`.
+type Permission struct {
+	Name        string
+	Description string
+	CreatedAt   time.Time
+}
+
+// Role is a named set of permissions. A role inherits all permissions of its parents, which form a directed acyclic
+// graph.
+type Role struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	Permissions []string    // Permissions granted directly to the role, sorted by name.
+	ParentIDs   []uuid.UUID // Roles the role inherits from directly.
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type CreateRoleParams struct {
+	Name        string
+	Description string
+}
+
+// UpdateRoleParams holds fields to update. Nil fields are left unchanged.
+type UpdateRoleParams struct {
+	Name        *string
+	Description *string
+}
+
+// RoleAssignment grants the permissions of a role to a user or a [ServiceAccount]. Exactly one of UserID and
+// ServiceAccountID is set, the other is [uuid.Nil]. OrgID scopes the assignment to an organization. It is [uuid.Nil]
+// for global assignments, which apply in every organization. Assignments with a condition only grant permissions
+// while the [Condition] of that name holds.
+type RoleAssignment struct {
+	ID               uuid.UUID
+	UserID           uuid.UUID
+	ServiceAccountID uuid.UUID
+	RoleID           uuid.UUID
+	OrgID            uuid.UUID
+	Condition        string
+	CreatedAt        time.Time
+}
+
+// RBACStore manages role-based access control and checks the effective permissions of subjects, which are users or
+// service accounts identified by their id. Scopes are organization ids, or [uuid.Nil] for the global scope. A subject
+// has a permission in an organization if it is granted to a role, or an ancestor of a role, assigned to the subject
+// globally or in that organization.
+type RBACStore interface {
+	// CreatePermission returns [ErrAlreadyExists] if a permission with the name exists.
+	CreatePermission(ctx context.Context, name, description string) (Permission, error)
+	ListPermissions(ctx context.Context) ([]Permission, error)
+	// DeletePermission deletes the permission and revokes it from all roles.
+	DeletePermission(ctx context.Context, name string) error
+
+	// CreateRole returns [ErrAlreadyExists] if a role with the name exists.
+	CreateRole(ctx context.Context, params CreateRoleParams) (Role, error)
+	GetRole(ctx context.Context, id uuid.UUID) (Role, error)
+	ListRoles(ctx context.Context) ([]Role, error)
+	UpdateRole(ctx context.Context, id uuid.UUID, params UpdateRoleParams) (Role, error)
+	// DeleteRole deletes the role, its assignments and its place in the inheritance graph.
+	DeleteRole(ctx context.Context, id uuid.UUID) error
+
+	// GrantPermission grants the permission to the role. Granting a granted permission does nothing.
+	GrantPermission(ctx context.Context, roleID uuid.UUID, permission string) error
+	RevokePermission(ctx context.Context, roleID uuid.UUID, permission string) error
+	// AddParent lets the role inherit the permissions of parent. It returns [ErrInvalidArgument] if parent already
+	// inherits from the role, as the inheritance graph would no longer be acyclic.
+	AddParent(ctx context.Context, roleID, parentID uuid.UUID) error
+	RemoveParent(ctx context.Context, roleID, parentID uuid.UUID) error
+
+	// Assign assigns the role to subject in scope, requiring the named condition unless it is empty. It returns
+	// [ErrNotFound] if subject is neither a user nor a service account and [ErrAlreadyExists] if the role is
+	// assigned already.
+	Assign(ctx context.Context, subject, roleID, scope uuid.UUID, condition string) (RoleAssignment, error)
+	Unassign(ctx context.Context, subject, roleID, scope uuid.UUID) error
+	// ListAssignments lists the role assignments of subject in all scopes.
+	ListAssignments(ctx context.Context, subject uuid.UUID) ([]RoleAssignment, error)
+
+	// Check decides whether subject has permission in scope, evaluating the conditions of assignments against attrs.
+	Check(ctx context.Context, subject uuid.UUID, permission string, scope uuid.UUID, attrs AttributeContext) (Decision, error)
+	// Explain decides like [RBACStore.Check] and explains the decision with the assignments of subject, the roles they
+	// inherit from and the conditions evaluated. It bypasses caches.
+	Explain(ctx context.Context, subject uuid.UUID, permission string, scope uuid.UUID, attrs AttributeContext) (Explanation, error)
+	// EffectivePermissions lists all permissions of subject in scope under attrs, sorted by name.
+	EffectivePermissions(ctx context.Context, subject, scope uuid.UUID, attrs AttributeContext) ([]string, error)
+}
+
+// Permissions guardian itself checks, which exist in every installation.
+const (
+	// PermissionAuthzManage allows managing permissions, roles and role assignments.
+	PermissionAuthzManage = "guardian.authz.manage"
+	// PermissionAuthzCheck allows checking the permissions of other users.
+	PermissionAuthzCheck = "guardian.authz.check"
+	// PermissionAPIKeysManage allows managing the API keys of service accounts.
+	PermissionAPIKeysManage = "guardian.api_keys.manage"
+	// PermissionOAuthClientsManage allows registering and managing OAuth clients.
+	PermissionOAuthClientsManage = "guardian.oauth_clients.manage"
+	// PermissionServiceAccountsManage allows managing service accounts and their credentials.
+	PermissionServiceAccountsManage = "guardian.service_accounts.manage"
+	// PermissionUsersManage allows listing users and managing all users, including their status and email.
+	PermissionUsersManage = "guardian.users.manage"
+)
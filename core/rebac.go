@@ -0,0 +1,181 @@
+package core
+
+import "context"
+
+// ObjectRef identifies an object like `document:readme`.
+type ObjectRef struct {
+	Namespace string
+	ID        string
+}
+
+// String formats the object as `namespace:id`.
+func (o ObjectRef) String() string {
+	return o.Namespace + ":" + o.ID
+}
+
+// SubjectRef is the subject of a relation tuple. It is an object like `user:alice`, or with Relation the set of
+// subjects having that relation to the object, like `team:eng#member`.
+type SubjectRef struct {
+	Namespace string
+	ID        string
+	Relation  string
+}
+
+// Object returns the object of the subject without its relation.
+func (s SubjectRef) Object() ObjectRef {
+	return ObjectRef{Namespace: s.Namespace, ID: s.ID}
+}
+
+// String formats the subject as `namespace:id` or `namespace:id#relation`.
+func (s SubjectRef) String() string {
+	if s.Relation == "" {
+		return s.Object().String()
+	}
+
+	return s.Object().String() + "#" + s.Relation
+}
+
+// RelationTuple relates an object to a subject, like `document:readme#viewer@team:eng#member`. Tuples with a
+// condition only apply while the [Condition] of that name holds. The condition is not part of the identity of a tuple.
+type RelationTuple struct {
+	Object    ObjectRef
+	Relation  string
+	Subject   SubjectRef
+	Condition string
+}
+
+// String formats the tuple as `namespace:id#relation@subject`, without its condition.
+func (t RelationTuple) String() string {
+	return t.Object.String() + "#" + t.Relation + "@" + t.Subject.String()
+}
+
+// Zookie is an opaque consistency token of a revision of relation tuples. Reads given a zookie are evaluated at a
+// revision at least as fresh as it, so they observe all writes the zookie was returned for. An empty zookie reads
+// the latest revision.
+type Zookie string
+
+// TupleFilter selects relation tuples of a namespace. Empty fields match any value.
+type TupleFilter struct {
+	Namespace string
+	ObjectID  string
+	Relation  string
+	Subject   *SubjectRef // Matches subjects exactly, including an empty relation.
+}
+
+type ReadTuplesParams struct {
+	Filter TupleFilter
+	Zookie Zookie
+	Cursor string // Opaque cursor returned as [TuplePage.NextCursor].
+	Limit  int
+}
+
+// TuplePage is a single page of relation tuples. NextCursor is empty on the last page.
+type TuplePage struct {
+	Tuples     []RelationTuple
+	NextCursor string
+	Zookie     Zookie
+}
+
+type CheckParams struct {
+	Object   ObjectRef
+	Relation string
+	Subject  SubjectRef
+	Zookie   Zookie
+	Context  AttributeContext // Attributes the conditions of tuples are evaluated against.
+}
+
+type ExpandParams struct {
+	Object   ObjectRef
+	Relation string
+	Zookie   Zookie
+	Context  AttributeContext // Attributes the conditions of tuples are evaluated against.
+}
+
+// UsersetOperation is the kind of a [UsersetTree] node.
+type UsersetOperation string
+
+const (
+	UsersetLeaf         UsersetOperation = "leaf"
+	UsersetUnion        UsersetOperation = "union"
+	UsersetIntersection UsersetOperation = "intersection"
+	UsersetExclusion    UsersetOperation = "exclusion"
+)
+
+// UsersetTree is the expansion of the subjects having a relation to an object. Leaves hold the subjects of tuples,
+// which may be usersets that are not expanded further. The other nodes combine their children, where the second
+// child of an exclusion is subtracted from the first.
+type UsersetTree struct {
+	Operation UsersetOperation
+	Object    ObjectRef
+	Relation  string
+	Subjects  []SubjectRef
+	Children  []UsersetTree
+}
+
+type ListObjectsParams struct {
+	Namespace string
+	Relation  string
+	Subject   SubjectRef
+	Zookie    Zookie
+	Context   AttributeContext // Attributes the conditions of tuples are evaluated against.
+	Cursor    string           // Opaque cursor returned as [ObjectPage.NextCursor].
+	Limit     int
+}
+
+// ObjectPage is a single page of object ids. NextCursor is empty on the last page. As the work per page is bounded,
+// pages before the last one can hold fewer ids than requested, even none.
+type ObjectPage struct {
+	ObjectIDs  []string
+	NextCursor string
+	Zookie     Zookie
+}
+
+type ListSubjectsParams struct {
+	Object           ObjectRef
+	Relation         string
+	SubjectNamespace string
+	Zookie           Zookie
+	Context          AttributeContext // Attributes the conditions of tuples are evaluated against.
+	Cursor           string           // Opaque cursor returned as [SubjectPage.NextCursor].
+	Limit            int
+}
+
+// SubjectPage is a single page of subject ids. NextCursor is empty on the last page. As the work per page is bounded,
+// pages before the last one can hold fewer ids than requested, even none.
+type SubjectPage struct {
+	SubjectIDs []string
+	NextCursor string
+	Zookie     Zookie
+}
+
+// ReBACStore stores relation tuples and evaluates relationship-based access control on them. The schema defines the
+// relations of each namespace and how relations are computed from other relations.
+//
+// Tuples with a condition are ignored by evaluations while the condition does not hold for the attributes of the
+// request. Methods return [ErrInvalidArgument] for malformed tuples, relations the schema does not define and zookies
+// which were not returned by the store.
+type ReBACStore interface {
+	// WriteSchema replaces the schema. It returns [ErrInvalidArgument] if the definition is invalid or does not allow
+	// stored tuples anymore.
+	WriteSchema(ctx context.Context, definition string) (Zookie, error)
+	// ReadSchema returns the definition of the current schema or [ErrNotFound] if none was written yet.
+	ReadSchema(ctx context.Context) (string, Zookie, error)
+
+	// WriteTuples atomically deletes and writes tuples. Writing existing tuples replaces their condition and deleting
+	// missing tuples does nothing. It returns [ErrNotFound] if a condition does not exist.
+	WriteTuples(ctx context.Context, writes, deletes []RelationTuple) (Zookie, error)
+	ReadTuples(ctx context.Context, params ReadTuplesParams) (TuplePage, error)
+
+	// Check decides whether the subject has the relation to the object. The policy of an allowing decision is the
+	// tuple which contains the subject.
+	Check(ctx context.Context, params CheckParams) (Decision, Zookie, error)
+	// Explain decides like [ReBACStore.Check] and explains the decision with the relations, tuples and conditions
+	// evaluated. Relations which were already evaluated for the request are not explained again.
+	Explain(ctx context.Context, params CheckParams) (Explanation, Zookie, error)
+	// Expand returns the tree of subjects having the relation to the object.
+	Expand(ctx context.Context, params ExpandParams) (UsersetTree, Zookie, error)
+	// ListObjects lists the ids of objects of the namespace the subject has the relation to.
+	ListObjects(ctx context.Context, params ListObjectsParams) (ObjectPage, error)
+	// ListSubjects lists the ids of objects of the subject namespace which have the relation to the object.
+	ListSubjects(ctx context.Context, params ListSubjectsParams) (SubjectPage, error)
+}
@@ -0,0 +1,62 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RefreshTokenRevokeReason describes why a refresh token family was revoked.
+type RefreshTokenRevokeReason string
+
+const (
+	RefreshTokenRevokeSignOut         RefreshTokenRevokeReason = "sign_out"
+	RefreshTokenRevokeReused          RefreshTokenRevokeReason = "reused"
+	RefreshTokenRevokeUser            RefreshTokenRevokeReason = "user"
+	RefreshTokenRevokePasswordChanged RefreshTokenRevokeReason = "password_changed"
+	RefreshTokenRevokeSecurity        RefreshTokenRevokeReason = "security"
+	RefreshTokenRevokeCodeReused      RefreshTokenRevokeReason = "code_reused"
+	RefreshTokenRevokeClient          RefreshTokenRevokeReason = "client"
+)
+
+// RefreshToken is a single use token which can be exchanged for a new access and refresh token. Every refresh token
+// belongs to a family started by [RefreshTokenStore.Issue] and each rotation adds a new token to the family.
+type RefreshToken struct {
+	ID        uuid.UUID
+	FamilyID  uuid.UUID
+	UserID    uuid.UUID
+	SessionID uuid.UUID // [uuid.Nil] if the family is not bound to a session.
+	ClientID  string
+	Scope     []string
+	ExpiresAt time.Time
+}
+
+// IssueRefreshTokenParams holds the grants of a new refresh token family.
+type IssueRefreshTokenParams struct {
+	UserID    uuid.UUID
+	SessionID uuid.UUID // Optional session to bind the family to. The family is deleted with the session.
+	ClientID  string
+	Scope     []string
+}
+
+// RefreshTokenStore manages rotating refresh tokens. Only hashes of tokens are stored.
+type RefreshTokenStore interface {
+	// Issue starts a new token family and returns its first token.
+	Issue(ctx context.Context, params IssueRefreshTokenParams) (RefreshToken, string, error)
+	// Rotate exchanges token for a new token of the same family. Presenting a token which was already rotated revokes
+	// the whole family and records an [AuditRefreshTokenReused] event. It returns [ErrInvalidToken] if token does not
+	// exist, expired, was already used or its family was revoked.
+	Rotate(ctx context.Context, token string, meta SessionMetadata) (RefreshToken, string, error)
+	// Get returns the refresh token without using it. It returns [ErrInvalidToken] under the same conditions as
+	// Rotate, but does not revoke the family of reused tokens.
+	Get(ctx context.Context, token string) (RefreshToken, error)
+	// FamilyActive reports whether the family exists and neither expired nor was revoked.
+	FamilyActive(ctx context.Context, familyID uuid.UUID) (bool, error)
+	// RevokeFamily revokes all tokens of the family.
+	RevokeFamily(ctx context.Context, familyID uuid.UUID, reason RefreshTokenRevokeReason) error
+	// RevokeSession revokes all token families bound to the session and returns the number of revoked families.
+	RevokeSession(ctx context.Context, sessionID uuid.UUID, reason RefreshTokenRevokeReason) (int64, error)
+	// RevokeUser revokes all token families of the user and returns the number of revoked families.
+	RevokeUser(ctx context.Context, userID uuid.UUID, reason RefreshTokenRevokeReason) (int64, error)
+}
@@ -0,0 +1,84 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ServiceAccount is a non-human principal, like a backend job, which is assigned roles like users are. Service accounts
+// obtain access tokens with the OAuth client credentials grant, using their id as client id and authenticating with
+// their secret or with a JWT signed by one of their keys. Only a hash of the secret is stored.
+type ServiceAccount struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	// Scopes are the scopes the account may request, sorted by name.
+	Scopes    []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	RotatedAt *time.Time
+	// PreviousExpiresAt is the time until which the secret replaced by the last rotation stays valid, nil if it is
+	// not valid anymore.
+	PreviousExpiresAt *time.Time
+}
+
+type CreateServiceAccountParams struct {
+	Name        string
+	Description string
+	Scopes      []string
+}
+
+type UpdateServiceAccountParams struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	Scopes      []string
+}
+
+// ServiceAccountKey is a public key verifying the JWT client assertions of a service account, as described by
+// RFC 7523, section 2.2.
+type ServiceAccountKey struct {
+	ID               uuid.UUID
+	ServiceAccountID uuid.UUID
+	// KeyID is the kid header of assertions signed with the key.
+	KeyID     string
+	Algorithm string
+	CreatedAt time.Time
+}
+
+// ServiceAccountStore manages service accounts and their credentials.
+type ServiceAccountStore interface {
+	// Create creates an account and returns it with its secret. The secret cannot be retrieved later. It returns
+	// [ErrAlreadyExists] if an account with the name exists.
+	Create(ctx context.Context, params CreateServiceAccountParams) (ServiceAccount, string, error)
+	Get(ctx context.Context, id uuid.UUID) (ServiceAccount, error)
+	// List lists all accounts ordered by id.
+	List(ctx context.Context) ([]ServiceAccount, error)
+	// Update replaces the settings of the account.
+	Update(ctx context.Context, params UpdateServiceAccountParams) (ServiceAccount, error)
+	// Delete deletes the account along with its keys, API keys and role assignments.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// RotateSecret replaces the secret of the account and returns the account with its new secret. The previous
+	// secret stays valid for overlap, so clients can switch without downtime, and a zero overlap invalidates it
+	// immediately. A nil overlap uses the configured default.
+	RotateSecret(ctx context.Context, id uuid.UUID, overlap *time.Duration) (ServiceAccount, string, error)
+
+	// AddKey adds the public JWK to the keys of the account. The key ID defaults to the RFC 7638 thumbprint of the
+	// key. It returns [ErrInvalidArgument] if the key is malformed or not a supported public signing key, and
+	// [ErrAlreadyExists] if the account has a key with the key ID.
+	AddKey(ctx context.Context, id uuid.UUID, jwk []byte) (ServiceAccountKey, error)
+	// ListKeys lists the keys of the account ordered by id.
+	ListKeys(ctx context.Context, id uuid.UUID) ([]ServiceAccountKey, error)
+	DeleteKey(ctx context.Context, id, keyID uuid.UUID) error
+
+	// Authenticate returns the account of id if secret is its current secret or its previous secret within the
+	// overlap of the last rotation. It returns [ErrInvalidCredentials] otherwise.
+	Authenticate(ctx context.Context, id uuid.UUID, secret string) (ServiceAccount, error)
+	// AuthenticateAssertion returns the account which signed the JWT client assertion. The issuer and subject of the
+	// assertion are the account id, and its audience has to contain one of audiences. Each assertion is accepted once.
+	// It returns [ErrInvalidCredentials] if the assertion is invalid, expired, replayed or not signed by a key of the
+	// account.
+	AuthenticateAssertion(ctx context.Context, assertion string, audiences []string) (ServiceAccount, error)
+}
@@ -0,0 +1,69 @@
+package core
+
+import (
+	"context"
+	"net/netip"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SessionRevokeReason describes why a [Session] was revoked.
+type SessionRevokeReason string
+
+const (
+	SessionRevokeSignOut         SessionRevokeReason = "sign_out"
+	SessionRevokeUser            SessionRevokeReason = "user"
+	SessionRevokeAll             SessionRevokeReason = "revoke_all"
+	SessionRevokePasswordChanged SessionRevokeReason = "password_changed"
+	SessionRevokeSecurity        SessionRevokeReason = "security"
+)
+
+// Authentication methods of RFC 8176 a [Session] can be created with.
+const (
+	AuthMethodPassword    = "pwd"
+	AuthMethodOTP         = "otp" // TOTP, recovery and emailed codes and magic links.
+	AuthMethodHardwareKey = "hwk" // Passkeys.
+	AuthMethodMultiFactor = "mfa"
+)
+
+// Session is a server-side session of a user.
+type Session struct {
+	ID            uuid.UUID
+	UserID        uuid.UUID
+	UserAgent     string
+	IPAddress     netip.Addr // Zero value if unknown.
+	Device        string
+	CreatedAt     time.Time
+	LastSeenAt    time.Time
+	IdleExpiresAt time.Time // The session expires if it is not used before this time.
+	ExpiresAt     time.Time // The session expires at this time regardless of use.
+	RevokedAt     *time.Time
+	RevokedReason SessionRevokeReason
+	AuthMethods   []string // Authentication methods the user signed in with.
+}
+
+// SessionMetadata describes the client creating a [Session].
+type SessionMetadata struct {
+	UserAgent string
+	IPAddress netip.Addr
+	Device    string
+	// AuthMethods are the authentication methods the user signed in with, only recorded when creating sessions.
+	AuthMethods []string
+}
+
+// SessionStore manages server-side sessions identified by opaque tokens. Only hashes of tokens are stored.
+type SessionStore interface {
+	// Create creates a new session for the user and returns it with its token.
+	Create(ctx context.Context, userID uuid.UUID, meta SessionMetadata) (Session, string, error)
+	// Authenticate returns the active session of token and extends its idle expiration. It returns
+	// [ErrInvalidCredentials] if the session does not exist, expired or was revoked.
+	Authenticate(ctx context.Context, token string) (Session, error)
+	Get(ctx context.Context, id uuid.UUID) (Session, error)
+	// List returns active sessions of the user, newest first.
+	List(ctx context.Context, userID uuid.UUID) ([]Session, error)
+	Revoke(ctx context.Context, id uuid.UUID, reason SessionRevokeReason) error
+	// RevokeAll revokes all active sessions of the user except the session with id except, which may be
+	// [uuid.Nil]. It returns the number of revoked sessions.
+	RevokeAll(ctx context.Context, userID uuid.UUID, except uuid.UUID, reason SessionRevokeReason) (int64, error)
+}
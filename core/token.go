@@ -0,0 +1,78 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccessTokenParams holds the subject and grants of an access token to issue.
+type AccessTokenParams struct {
+	Subject   string    // User ID or any other principal identifier.
+	SessionID uuid.UUID // Optional session the token is bound to.
+	ClientID  string    // Optional OAuth client the token is issued to.
+	Scope     []string
+	Audience  []string  // Overrides the configured default audience when not empty.
+	FamilyID  uuid.UUID // Optional refresh token family issued along with the token, revoked with it.
+}
+
+// AccessTokenClaims are the claims of an issued access token.
+type AccessTokenClaims struct {
+	ID        string
+	Issuer    string
+	Subject   string
+	Audience  []string
+	IssuedAt  time.Time
+	NotBefore time.Time
+	ExpiresAt time.Time
+	SessionID uuid.UUID // [uuid.Nil] if the token is not bound to a session.
+	ClientID  string
+	Scope     []string
+	FamilyID  uuid.UUID // [uuid.Nil] if no refresh token was issued along with the token.
+}
+
+// AccessTokenIssuer issues and verifies short-lived signed access tokens.
+type AccessTokenIssuer interface {
+	// Issue issues a signed access token.
+	Issue(ctx context.Context, params AccessTokenParams) (string, AccessTokenClaims, error)
+	// Verify verifies the signature and claims of token. It returns [ErrInvalidToken] if token is malformed, expired
+	// or not signed by a known key.
+	Verify(ctx context.Context, token string) (AccessTokenClaims, error)
+}
+
+// AccessTokenRevocationStore keeps the identifiers of revoked access tokens until the tokens expire, as signed tokens
+// cannot be invalidated otherwise.
+type AccessTokenRevocationStore interface {
+	// Revoke revokes the access token with the claims.
+	Revoke(ctx context.Context, claims AccessTokenClaims) error
+	// Revoked reports whether the access token with the id was revoked.
+	Revoked(ctx context.Context, id string) (bool, error)
+}
+
+// Authentication context class references placed in the acr claim of ID tokens.
+const (
+	ACRSingleFactor = "urn:guardian:acr:sfa"
+	ACRMultiFactor  = "urn:guardian:acr:mfa" // The session was created with [AuthMethodMultiFactor].
+)
+
+// IDTokenParams holds the claims of an OpenID Connect ID token to issue.
+type IDTokenParams struct {
+	Subject   string
+	ClientID  string    // The client the token is issued to, placed in the aud and azp claims.
+	SessionID uuid.UUID // Optional session the user authenticated in.
+	Nonce     string    // Optional nonce of the authorization request.
+	AuthTime  time.Time // Optional time the user authenticated.
+	ACR       string    // Optional authentication context class reference.
+	AMR       []string  // Optional authentication methods of RFC 8176.
+}
+
+// IDTokenIssuer issues signed OpenID Connect ID tokens, which clients verify with the published JWK set.
+type IDTokenIssuer interface {
+	// Issue issues a signed ID token.
+	Issue(ctx context.Context, params IDTokenParams) (string, error)
+	// Issuer returns the issuer identifier placed in the iss claim.
+	Issuer() string
+	// Algorithm returns the JWS algorithm new tokens are signed with.
+	Algorithm() string
+}
@@ -0,0 +1,79 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserStatus represents the lifecycle state of a [User].
+type UserStatus string
+
+const (
+	UserStatusActive    UserStatus = "active"
+	UserStatusSuspended UserStatus = "suspended"
+	UserStatusDisabled  UserStatus = "disabled"
+)
+
+// User represents an account managed by guardian.
+type User struct {
+	ID              uuid.UUID
+	Email           string
+	EmailVerifiedAt *time.Time // Nil until the user proved control of Email.
+	Username        string
+	Status          UserStatus
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       *time.Time
+}
+
+type CreateUserParams struct {
+	Email    string
+	Username string
+	Status   UserStatus // Defaults to [UserStatusActive] when empty.
+}
+
+// UpdateUserParams holds fields to update. Nil fields are left unchanged. Changing the email resets
+// [User.EmailVerifiedAt].
+type UpdateUserParams struct {
+	Email    *string
+	Username *string
+	Status   *UserStatus
+}
+
+type ListUsersParams struct {
+	Status UserStatus // Filter by status. Empty means all statuses.
+	Cursor string     // Opaque cursor returned as [UserPage.NextCursor].
+	Limit  int
+}
+
+type SearchUsersParams struct {
+	Query  string // Matched as a case-insensitive substring of email or username.
+	Cursor string // Opaque cursor returned as [UserPage.NextCursor].
+	Limit  int
+}
+
+// UserPage is a single page of users. NextCursor is empty on the last page.
+type UserPage struct {
+	Users      []User
+	NextCursor string
+}
+
+// UserStore manages users.
+type UserStore interface {
+	Create(ctx context.Context, params CreateUserParams) (User, error)
+	Get(ctx context.Context, id uuid.UUID) (User, error)
+	GetByEmail(ctx context.Context, email string) (User, error)
+	GetByUsername(ctx context.Context, username string) (User, error)
+	Update(ctx context.Context, id uuid.UUID, params UpdateUserParams) (User, error)
+	// MarkEmailVerified marks the email of the user verified. It returns [ErrNotFound] if the email of the user is no
+	// longer email.
+	MarkEmailVerified(ctx context.Context, id uuid.UUID, email string) (User, error)
+	// ChangeEmail sets a new email which the user already verified.
+	ChangeEmail(ctx context.Context, id uuid.UUID, email string) (User, error)
+	// Delete soft deletes the user. Deleted users are not returned by any other method.
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, params ListUsersParams) (UserPage, error)
+	Search(ctx context.Context, params SearchUsersParams) (UserPage, error)
+}
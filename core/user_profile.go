@@ -0,0 +1,49 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserProfile holds the standard claims of OpenID Connect about a user beyond the email and username of [User]. Empty
+// fields are unknown.
+type UserProfile struct {
+	UserID     uuid.UUID
+	Name       string
+	GivenName  string
+	FamilyName string
+	Nickname   string
+	Picture    string // URL of a profile picture.
+	Website    string // URL of a web page or blog.
+	// Birthdate is formatted as YYYY-MM-DD, YYYY if only the year is known or 0000-MM-DD if the year is withheld.
+	Birthdate   string
+	Zoneinfo    string // IANA time zone like Europe/Paris.
+	Locale      string // BCP 47 language tag like en-US.
+	PhoneNumber string // E.164 phone number like +14155550100.
+	Address     UserAddress
+	UpdatedAt   time.Time // Zero if the profile was never updated.
+}
+
+// UserAddress is the postal address of a [UserProfile].
+type UserAddress struct {
+	StreetAddress string // May span multiple lines.
+	Locality      string
+	Region        string
+	PostalCode    string
+	Country       string
+}
+
+// IsZero reports whether no field of the address is known.
+func (a UserAddress) IsZero() bool {
+	return a == UserAddress{}
+}
+
+// UserProfileStore manages profiles of users.
+type UserProfileStore interface {
+	// Get returns the profile of the user, an empty profile if it was never updated.
+	Get(ctx context.Context, userID uuid.UUID) (UserProfile, error)
+	// Update replaces the profile of [UserProfile.UserID]. It returns [ErrNotFound] if the user does not exist.
+	Update(ctx context.Context, profile UserProfile) (UserProfile, error)
+}
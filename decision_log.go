@@ -0,0 +1,29 @@
+package guardian
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/decisionlog"
+)
+
+// DecisionLogConfig configures the [DecisionLog] created by [NewDecisionLog].
+type DecisionLogConfig = decisionlog.Config
+
+// DecisionLog is a [core.DecisionLog] which records sampled decisions to postgres or a logger.
+type DecisionLog interface {
+	core.DecisionLog
+
+	// DeleteExpired deletes decisions recorded to postgres longer than the configured retention ago and returns the
+	// number of deleted decisions.
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// NewDecisionLog creates a [DecisionLog] recording to the configured sink. Decisions logged to logger carry the trace
+// ids of the context they are recorded with.
+func NewDecisionLog(pool *pgxpool.Pool, config DecisionLogConfig, logger zerolog.Logger) (DecisionLog, error) {
+	return decisionlog.NewLog(pool, config, logger)
+}
@@ -0,0 +1,16 @@
+package guardian
+
+import (
+	"github.com/gophero/guardian/internal/encryption"
+)
+
+// EncryptionConfig configures the [Cipher] created by [NewCipher].
+type EncryptionConfig = encryption.Config
+
+// Cipher encrypts secrets which have to be stored recoverably, such as TOTP secrets and signing keys.
+type Cipher = encryption.Cipher
+
+// NewCipher creates an AES-256-GCM [Cipher] with rotatable keys.
+func NewCipher(config EncryptionConfig) (*Cipher, error) {
+	return encryption.NewCipher(config)
+}
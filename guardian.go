@@ -0,0 +1,15 @@
+// Package guardian is a library for Authentication, Authorization and User Management.
+//
+// It exposes constructors for the postgres backed stores and services implementing the interfaces defined in
+// [github.com/gophero/guardian/core].
+package guardian
+
+import (
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/pkg/migration"
+)
+
+// RunMigrations applies all pending guardian database migrations.
+func RunMigrations(f migration.Factory) error {
+	return db.RunMigrations(f)
+}
@@ -0,0 +1,25 @@
+package abac
+
+import "errors"
+
+type Config struct {
+	CostLimit           uint64 `help:"Maximum cost of evaluating a condition. Conditions exceeding it do not hold." name:"cost_limit" env:"COST_LIMIT" default:"10000"`
+	MaxExpressionLength int    `help:"Maximum length of condition expressions." name:"max_expression_length" env:"MAX_EXPRESSION_LENGTH" default:"4096"`
+	CacheSize           int    `help:"Maximum number of cached compiled conditions." name:"cache_size" env:"CACHE_SIZE" default:"1000"`
+}
+
+func (c Config) validate() error {
+	if c.CostLimit == 0 {
+		return errors.New("abac: CostLimit cannot be zero")
+	}
+
+	if c.MaxExpressionLength <= 0 {
+		return errors.New("abac: MaxExpressionLength cannot be zero or negative")
+	}
+
+	if c.CacheSize <= 0 {
+		return errors.New("abac: CacheSize cannot be zero or negative")
+	}
+
+	return nil
+}
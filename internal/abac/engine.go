@@ -0,0 +1,183 @@
+// Package abac implements attribute-based conditions on grants, which are CEL expressions evaluated against the
+// attributes of a check.
+package abac
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/ext"
+	"github.com/rs/zerolog"
+
+	"github.com/gophero/guardian/core"
+)
+
+// Engine compiles and evaluates condition expressions. Compiled programs are cached by expression, so updated
+// conditions never evaluate outdated programs.
+type Engine struct {
+	config Config
+	env    *cel.Env
+	now    func() time.Time
+
+	mu       sync.Mutex
+	programs map[string]cel.Program
+}
+
+// NewEngine constructs new [Engine].
+func NewEngine(config Config) (*Engine, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	env, err := cel.NewEnv(
+		cel.Variable("time", cel.TimestampType),
+		cel.Variable("ip", cel.StringType),
+		cel.Variable("user", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("resource", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Function("inCidr", cel.MemberOverload("string_in_cidr_string",
+			[]*cel.Type{cel.StringType, cel.StringType}, cel.BoolType, cel.BinaryBinding(inCIDR))),
+		cel.CrossTypeNumericComparisons(true),
+		ext.Strings(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("abac: new cel env: %w", err)
+	}
+
+	return &Engine{config: config, env: env, now: time.Now, programs: map[string]cel.Program{}}, nil
+}
+
+// Compile compiles and type checks expression. It returns [core.ErrInvalidArgument] if the expression is invalid or
+// does not evaluate to a bool.
+func (e *Engine) Compile(expression string) error {
+	_, err := e.program(expression)
+	return err
+}
+
+func (e *Engine) program(expression string) (cel.Program, error) {
+	e.mu.Lock()
+	prg, ok := e.programs[expression]
+	e.mu.Unlock()
+
+	if ok {
+		return prg, nil
+	}
+
+	if len(expression) > e.config.MaxExpressionLength {
+		return nil, fmt.Errorf("abac: expression is longer than %d characters: %w", e.config.MaxExpressionLength, core.ErrInvalidArgument)
+	}
+
+	ast, iss := e.env.Compile(expression)
+	if iss.Err() != nil {
+		return nil, fmt.Errorf("abac: compile expression: %s: %w", iss.Err(), core.ErrInvalidArgument)
+	}
+
+	if !ast.OutputType().IsExactType(cel.BoolType) {
+		return nil, fmt.Errorf("abac: expression evaluates to %s instead of bool: %w", ast.OutputType(), core.ErrInvalidArgument)
+	}
+
+	prg, err := e.env.Program(ast,
+		cel.CostLimit(e.config.CostLimit),
+		cel.InterruptCheckFrequency(100),
+		cel.EvalOptions(cel.OptOptimize),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("abac: program expression: %s: %w", err, core.ErrInvalidArgument)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	// Start over rather than tracking usage, conditions are cheap to recompile.
+	if len(e.programs) >= e.config.CacheSize {
+		clear(e.programs)
+	}
+	e.programs[expression] = prg
+
+	return prg, nil
+}
+
+// Evaluate reports whether expression holds for attrs. Errors, such as missing attributes or exceeding the cost
+// limit, are returned along with false.
+func (e *Engine) Evaluate(ctx context.Context, expression string, attrs core.AttributeContext) (bool, error) {
+	prg, err := e.program(expression)
+	if err != nil {
+		return false, err
+	}
+
+	t := attrs.Time
+	if t.IsZero() {
+		t = e.now()
+	}
+
+	ip := ""
+	if attrs.IP.IsValid() {
+		ip = attrs.IP.String()
+	}
+
+	out, _, err := prg.ContextEval(ctx, map[string]any{
+		"time":     t,
+		"ip":       ip,
+		"user":     orEmpty(attrs.User),
+		"resource": orEmpty(attrs.Resource),
+	})
+	if err != nil {
+		return false, fmt.Errorf("abac: evaluate expression: %w", err)
+	}
+
+	ok, isBool := out.Value().(bool)
+	if !isBool {
+		return false, fmt.Errorf("abac: expression evaluated to %s instead of bool", out.Type())
+	}
+
+	return ok, nil
+}
+
+// Holds reports whether the named condition holds for attrs. Conditions which fail to evaluate do not hold, so errors
+// deny access rather than grant it. They are logged, as they usually mean the caller did not pass an attribute.
+func (e *Engine) Holds(ctx context.Context, name, expression string, attrs core.AttributeContext) bool {
+	return e.Explain(ctx, name, expression, attrs).Allowed
+}
+
+// Explain evaluates the named condition like [Engine.Holds] and describes the evaluation as a node of an explanation.
+// The detail of the node is the expression, followed by the error if it failed to evaluate.
+func (e *Engine) Explain(ctx context.Context, name, expression string, attrs core.AttributeContext) core.ExplainNode {
+	node := core.ExplainNode{Kind: core.ExplainCondition, Name: name, Detail: expression}
+
+	ok, err := e.Evaluate(ctx, expression, attrs)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Str("condition", name).Msg("condition failed to evaluate")
+		node.Detail += " (" + err.Error() + ")"
+		return node
+	}
+
+	node.Allowed = ok
+	return node
+}
+
+func orEmpty(m map[string]any) map[string]any {
+	if m == nil {
+		return map[string]any{}
+	}
+	return m
+}
+
+// inCIDR implements `ip.inCidr(network)`. Invalid addresses are in no network.
+func inCIDR(lhs, rhs ref.Val) ref.Val {
+	prefix, err := netip.ParsePrefix(string(rhs.(types.String)))
+	if err != nil {
+		return types.NewErr("invalid network %q", rhs)
+	}
+
+	addr, err := netip.ParseAddr(string(lhs.(types.String)))
+	if err != nil {
+		return types.False
+	}
+
+	return types.Bool(prefix.Contains(addr.Unmap()))
+}
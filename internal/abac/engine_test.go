@@ -0,0 +1,100 @@
+package abac
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+)
+
+func newTestEngine(t *testing.T) *Engine {
+	t.Helper()
+
+	e, err := NewEngine(Config{CostLimit: 1000, MaxExpressionLength: 256, CacheSize: 2})
+	require.NoError(t, err)
+
+	return e
+}
+
+func TestEngineCompile(t *testing.T) {
+	e := newTestEngine(t)
+
+	for _, expression := range []string{
+		`true`,
+		`ip.inCidr("10.0.0.0/8")`,
+		`resource.owner == user.id && time.getHours("UTC") < 18`,
+		`"admins" in user.groups`,
+	} {
+		require.NoError(t, e.Compile(expression), expression)
+	}
+
+	for _, expression := range []string{
+		``,
+		`resource.owner ==`,
+		`unknown == 1`,
+		`time.getHours()`,
+		`"not a bool"`,
+		`ip.inCidr(1)`,
+		`"` + string(make([]byte, 300)) + `"`,
+	} {
+		require.ErrorIs(t, e.Compile(expression), core.ErrInvalidArgument, expression)
+	}
+}
+
+func TestEngineEvaluate(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEngine(t)
+
+	attrs := core.AttributeContext{
+		Time:     time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC),
+		IP:       netip.MustParseAddr("10.1.2.3"),
+		User:     map[string]any{"id": "bob", "level": 3.0, "groups": []any{"admins"}},
+		Resource: map[string]any{"owner": "bob"},
+	}
+
+	for expression, want := range map[string]bool{
+		`ip.inCidr("10.0.0.0/8")`:                           true,
+		`ip.inCidr("192.168.0.0/16")`:                       false,
+		`resource.owner == user.id`:                         true,
+		`user.level >= 3`:                                   true,
+		`"admins" in user.groups`:                           true,
+		`time.getHours("UTC") >= 9 && time.getHours() < 18`: true,
+		`time < timestamp("2026-01-01T00:00:00Z")`:          false,
+	} {
+		got, err := e.Evaluate(ctx, expression, attrs)
+		require.NoError(t, err, expression)
+		require.Equal(t, want, got, expression)
+	}
+
+	// Missing attributes fail to evaluate and do not hold.
+	_, err := e.Evaluate(ctx, `resource.missing == "x"`, attrs)
+	require.Error(t, err)
+	require.False(t, e.Holds(ctx, "missing", `resource.missing == "x"`, attrs))
+
+	// Unknown addresses are in no network.
+	got, err := e.Evaluate(ctx, `ip.inCidr("0.0.0.0/0")`, core.AttributeContext{})
+	require.NoError(t, err)
+	require.False(t, got)
+
+	_, err = e.Evaluate(ctx, `ip.inCidr("invalid")`, attrs)
+	require.Error(t, err)
+}
+
+func TestEngineCostLimit(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEngine(t)
+
+	items := make([]any, 200)
+	for i := range items {
+		items[i] = float64(i)
+	}
+
+	attrs := core.AttributeContext{Resource: map[string]any{"items": items}}
+
+	_, err := e.Evaluate(ctx, `resource.items.all(a, resource.items.exists(b, a == b))`, attrs)
+	require.ErrorContains(t, err, "cost limit")
+}
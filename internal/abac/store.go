@@ -0,0 +1,141 @@
+package abac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+var conditionNameRe = regexp.MustCompile(`^[a-z][a-z0-9_.-]{0,63}$`)
+
+// Store is a postgres backed [core.ConditionStore]. Expressions are compiled before they are stored, which also warms
+// the cache of the engine.
+type Store struct {
+	q      *queries.Queries
+	engine *Engine
+}
+
+var _ core.ConditionStore = (*Store)(nil)
+
+// NewStore constructs new [Store].
+func NewStore(pool *pgxpool.Pool, engine *Engine) *Store {
+	return &Store{q: queries.New(pool), engine: engine}
+}
+
+// CreateCondition implements [core.ConditionStore].
+func (s *Store) CreateCondition(ctx context.Context, params core.CreateConditionParams) (core.Condition, error) {
+	if !conditionNameRe.MatchString(params.Name) {
+		return core.Condition{}, fmt.Errorf("abac: invalid condition name `%s`: %w", params.Name, core.ErrInvalidArgument)
+	}
+
+	if err := s.engine.Compile(params.Expression); err != nil {
+		return core.Condition{}, err
+	}
+
+	c, err := s.q.CreateCondition(ctx, queries.CreateConditionParams{
+		Name:        params.Name,
+		Expression:  params.Expression,
+		Description: params.Description,
+	})
+	if err != nil {
+		return core.Condition{}, fmt.Errorf("abac: create condition: %w", mapError(err))
+	}
+
+	return toCondition(c), nil
+}
+
+// GetCondition implements [core.ConditionStore].
+func (s *Store) GetCondition(ctx context.Context, name string) (core.Condition, error) {
+	c, err := s.q.GetCondition(ctx, name)
+	if err != nil {
+		return core.Condition{}, fmt.Errorf("abac: get condition: %w", mapError(err))
+	}
+
+	return toCondition(c), nil
+}
+
+// ListConditions implements [core.ConditionStore].
+func (s *Store) ListConditions(ctx context.Context) ([]core.Condition, error) {
+	cs, err := s.q.ListConditions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("abac: list conditions: %w", err)
+	}
+
+	conditions := make([]core.Condition, 0, len(cs))
+	for _, c := range cs {
+		conditions = append(conditions, toCondition(c))
+	}
+
+	return conditions, nil
+}
+
+// UpdateCondition implements [core.ConditionStore].
+func (s *Store) UpdateCondition(ctx context.Context, name string, params core.UpdateConditionParams) (core.Condition, error) {
+	arg := queries.UpdateConditionParams{Name: name}
+
+	if params.Expression != nil {
+		if err := s.engine.Compile(*params.Expression); err != nil {
+			return core.Condition{}, err
+		}
+		arg.Expression = pgtype.Text{String: *params.Expression, Valid: true}
+	}
+
+	if params.Description != nil {
+		arg.Description = pgtype.Text{String: *params.Description, Valid: true}
+	}
+
+	c, err := s.q.UpdateCondition(ctx, arg)
+	if err != nil {
+		return core.Condition{}, fmt.Errorf("abac: update condition: %w", mapError(err))
+	}
+
+	return toCondition(c), nil
+}
+
+// DeleteCondition implements [core.ConditionStore].
+func (s *Store) DeleteCondition(ctx context.Context, name string) error {
+	n, err := s.q.DeleteCondition(ctx, name)
+	if db.IsForeignKeyViolation(err) {
+		return fmt.Errorf("abac: delete condition: condition `%s` is in use: %w", name, core.ErrInvalidArgument)
+	}
+
+	if err != nil {
+		return fmt.Errorf("abac: delete condition: %w", err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("abac: delete condition: %w", core.ErrNotFound)
+	}
+
+	return nil
+}
+
+func toCondition(c queries.Condition) core.Condition {
+	return core.Condition{
+		Name:        c.Name,
+		Expression:  c.Expression,
+		Description: c.Description,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
+
+func mapError(err error) error {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return core.ErrNotFound
+	case db.IsUniqueViolation(err):
+		return core.ErrAlreadyExists
+	default:
+		return err
+	}
+}
@@ -0,0 +1,261 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+	"github.com/gophero/guardian/core/proto/guardian/v1/guardianv1connect"
+)
+
+// APIKeyService implements [guardianv1connect.APIKeyServiceHandler].
+type APIKeyService struct {
+	keys core.APIKeyStore
+	orgs core.OrganizationStore
+	rbac core.RBACStore
+	auth *authenticator
+}
+
+var _ guardianv1connect.APIKeyServiceHandler = (*APIKeyService)(nil)
+
+// NewAPIKeyService constructs new [APIKeyService]. Callers are authorized by the owner of the keys they manage.
+func NewAPIKeyService(
+	keys core.APIKeyStore,
+	orgs core.OrganizationStore,
+	rbac core.RBACStore,
+	sessions core.SessionStore,
+	tokens core.AccessTokenIssuer,
+) *APIKeyService {
+	return &APIKeyService{
+		keys: keys,
+		orgs: orgs,
+		rbac: rbac,
+		auth: &authenticator{tokens: tokens, sessions: sessions, now: time.Now},
+	}
+}
+
+// requireOwner returns a [connect.CodePermissionDenied] error unless the caller may manage the keys of owner. Users
+// manage their own keys, organization admins the keys of their organization and callers with the
+// [core.PermissionAPIKeysManage] permission the keys of service accounts.
+func (s *APIKeyService) requireOwner(ctx context.Context, req connect.AnyRequest, p principal, owner core.APIKeyOwner) error {
+	switch owner.Type {
+	case core.APIKeyOwnerUser:
+		if owner.ID != p.UserID {
+			return connect.NewError(connect.CodePermissionDenied, errors.New("api: keys of other users cannot be managed"))
+		}
+	case core.APIKeyOwnerOrganization:
+		m, err := s.orgs.GetMembership(ctx, owner.ID, p.UserID)
+		if errors.Is(err, core.ErrNotFound) || err == nil && organizationRanks[m.Role] < organizationRanks[core.OrganizationRoleAdmin] {
+			return roleRequired(core.OrganizationRoleAdmin)
+		}
+
+		if err != nil {
+			return toConnectError(ctx, err)
+		}
+	case core.APIKeyOwnerServiceAccount:
+		return requirePermission(ctx, s.rbac, p, core.PermissionAPIKeysManage, uuid.Nil, callerAttributes(req, s.auth.now()))
+	default:
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("api: invalid owner type"))
+	}
+
+	return nil
+}
+
+// key authenticates the caller and returns the key of id if the caller may manage it. Keys the caller may not manage
+// are reported as not found, so they are not revealed.
+func (s *APIKeyService) key(ctx context.Context, req connect.AnyRequest, id string) (core.APIKey, error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return core.APIKey{}, err
+	}
+
+	keyID, err := parseID("id", id)
+	if err != nil {
+		return core.APIKey{}, err
+	}
+
+	key, err := s.keys.Get(ctx, keyID)
+	if err != nil {
+		return core.APIKey{}, toConnectError(ctx, err)
+	}
+
+	var connectErr *connect.Error
+	if err := s.requireOwner(ctx, req, p, key.Owner); errors.As(err, &connectErr) && connectErr.Code() == connect.CodePermissionDenied {
+		return core.APIKey{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("api: get api key: %w", core.ErrNotFound))
+	} else if err != nil {
+		return core.APIKey{}, err
+	}
+
+	return key, nil
+}
+
+// CreateAPIKey implements [guardianv1connect.APIKeyServiceHandler].
+func (s *APIKeyService) CreateAPIKey(ctx context.Context, req *connect.Request[guardianv1.CreateAPIKeyRequest]) (*connect.Response[guardianv1.CreateAPIKeyResponse], error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := parseAPIKeyOwner(p, req.Msg.GetOwnerType(), req.Msg.GetOwnerId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requireOwner(ctx, req, p, owner); err != nil {
+		return nil, err
+	}
+
+	// Keys cannot grant more than the caller has, in the organization for keys of organizations.
+	scope := uuid.Nil
+	if owner.Type == core.APIKeyOwnerOrganization {
+		scope = owner.ID
+	}
+
+	granted, err := s.rbac.EffectivePermissions(ctx, p.UserID, scope, callerAttributes(req, s.auth.now()))
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	for _, sc := range req.Msg.GetScopes() {
+		if !slices.Contains(granted, sc) {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("api: missing permission `%s` to grant", sc))
+		}
+	}
+
+	var expiresAt *time.Time
+	if req.Msg.HasExpiresAt() {
+		t := req.Msg.GetExpiresAt().AsTime()
+		expiresAt = &t
+	}
+
+	key, token, err := s.keys.Create(ctx, core.CreateAPIKeyParams{
+		Name:      req.Msg.GetName(),
+		Owner:     owner,
+		Scopes:    req.Msg.GetScopes(),
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.CreateAPIKeyResponse_builder{ApiKey: toAPIKey(key), Token: token}.Build()), nil
+}
+
+// GetAPIKey implements [guardianv1connect.APIKeyServiceHandler].
+func (s *APIKeyService) GetAPIKey(ctx context.Context, req *connect.Request[guardianv1.GetAPIKeyRequest]) (*connect.Response[guardianv1.GetAPIKeyResponse], error) {
+	key, err := s.key(ctx, req, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(guardianv1.GetAPIKeyResponse_builder{ApiKey: toAPIKey(key)}.Build()), nil
+}
+
+// ListAPIKeys implements [guardianv1connect.APIKeyServiceHandler].
+func (s *APIKeyService) ListAPIKeys(ctx context.Context, req *connect.Request[guardianv1.ListAPIKeysRequest]) (*connect.Response[guardianv1.ListAPIKeysResponse], error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := parseAPIKeyOwner(p, req.Msg.GetOwnerType(), req.Msg.GetOwnerId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requireOwner(ctx, req, p, owner); err != nil {
+		return nil, err
+	}
+
+	keys, err := s.keys.List(ctx, owner)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	res := make([]*guardianv1.APIKey, 0, len(keys))
+	for _, k := range keys {
+		res = append(res, toAPIKey(k))
+	}
+
+	return connect.NewResponse(guardianv1.ListAPIKeysResponse_builder{ApiKeys: res}.Build()), nil
+}
+
+// RotateAPIKey implements [guardianv1connect.APIKeyServiceHandler].
+func (s *APIKeyService) RotateAPIKey(ctx context.Context, req *connect.Request[guardianv1.RotateAPIKeyRequest]) (*connect.Response[guardianv1.RotateAPIKeyResponse], error) {
+	key, err := s.key(ctx, req, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	var overlap *time.Duration
+	if req.Msg.HasOverlap() {
+		if err := req.Msg.GetOverlap().CheckValid(); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("api: invalid overlap: %w", err))
+		}
+
+		d := req.Msg.GetOverlap().AsDuration()
+		overlap = &d
+	}
+
+	key, token, err := s.keys.Rotate(ctx, key.ID, overlap)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.RotateAPIKeyResponse_builder{ApiKey: toAPIKey(key), Token: token}.Build()), nil
+}
+
+// RevokeAPIKey implements [guardianv1connect.APIKeyServiceHandler].
+func (s *APIKeyService) RevokeAPIKey(ctx context.Context, req *connect.Request[guardianv1.RevokeAPIKeyRequest]) (*connect.Response[guardianv1.RevokeAPIKeyResponse], error) {
+	key, err := s.key(ctx, req, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.keys.Revoke(ctx, key.ID); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.RevokeAPIKeyResponse{}), nil
+}
+
+// VerifyAPIKey implements [guardianv1connect.APIKeyServiceHandler].
+func (s *APIKeyService) VerifyAPIKey(ctx context.Context, req *connect.Request[guardianv1.VerifyAPIKeyRequest]) (*connect.Response[guardianv1.VerifyAPIKeyResponse], error) {
+	key, err := s.keys.Verify(ctx, req.Msg.GetToken())
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	if !key.HasScopes(req.Msg.GetScopes()...) {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("api: api key lacks required scopes"))
+	}
+
+	return connect.NewResponse(guardianv1.VerifyAPIKeyResponse_builder{ApiKey: toAPIKey(key)}.Build()), nil
+}
+
+// parseAPIKeyOwner defaults to the caller for keys of users.
+func parseAPIKeyOwner(p principal, t guardianv1.APIKeyOwnerType, id string) (core.APIKeyOwner, error) {
+	owner := core.APIKeyOwner{Type: fromAPIKeyOwnerType(t)}
+	if owner.Type == "" {
+		return core.APIKeyOwner{}, connect.NewError(connect.CodeInvalidArgument, errors.New("api: invalid owner type"))
+	}
+
+	if id == "" && owner.Type == core.APIKeyOwnerUser {
+		owner.ID = p.UserID
+		return owner, nil
+	}
+
+	var err error
+	if owner.ID, err = parseID("owner_id", id); err != nil {
+		return core.APIKeyOwner{}, err
+	}
+
+	return owner, nil
+}
@@ -0,0 +1,210 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+)
+
+func TestAPIKeyService(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClients(t)
+
+	owner := signUp(t, c, "ada@example.com", "ada")
+	ownerID := owner.GetUser().GetId()
+	ownerToken := owner.GetTokens().GetAccessToken()
+	member := signUp(t, c, "bob@example.com", "bob")
+	memberToken := member.GetTokens().GetAccessToken()
+
+	// The owner may read and write documents, the member nothing.
+	for _, p := range []string{"documents.read", "documents.write", core.PermissionAPIKeysManage} {
+		_, err := c.rbac.CreatePermission(ctx, p, "")
+		require.NoError(t, err)
+	}
+
+	role, err := c.rbac.CreateRole(ctx, core.CreateRoleParams{Name: "editor"})
+	require.NoError(t, err)
+	require.NoError(t, c.rbac.GrantPermission(ctx, role.ID, "documents.read"))
+	require.NoError(t, c.rbac.GrantPermission(ctx, role.ID, "documents.write"))
+
+	_, err = c.rbac.Assign(ctx, uuid.MustParse(ownerID), role.ID, uuid.Nil, "")
+	require.NoError(t, err)
+
+	created, err := c.orgs.CreateOrganization(ctx, withBearer(guardianv1.CreateOrganizationRequest_builder{
+		Slug: "acme",
+		Name: "Acme",
+	}.Build(), ownerToken))
+	require.NoError(t, err)
+	org := created.Msg.GetOrganization()
+
+	// create creates a key of the caller with scopes and returns it with its token.
+	create := func(t *testing.T, scopes ...string) (*guardianv1.APIKey, string) {
+		t.Helper()
+
+		res, err := c.apiKeys.CreateAPIKey(ctx, withBearer(guardianv1.CreateAPIKeyRequest_builder{
+			Name:   "ci",
+			Scopes: scopes,
+		}.Build(), ownerToken))
+		require.NoError(t, err)
+
+		return res.Msg.GetApiKey(), res.Msg.GetToken()
+	}
+
+	verify := func(token string, scopes ...string) (*guardianv1.APIKey, error) {
+		res, err := c.apiKeys.VerifyAPIKey(ctx, connect.NewRequest(guardianv1.VerifyAPIKeyRequest_builder{
+			Token:  token,
+			Scopes: scopes,
+		}.Build()))
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Msg.GetApiKey(), nil
+	}
+
+	t.Run("creates keys", func(t *testing.T) {
+		_, err := c.apiKeys.CreateAPIKey(ctx, connect.NewRequest(guardianv1.CreateAPIKeyRequest_builder{Name: "ci"}.Build()))
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		// Keys cannot grant permissions the caller does not have.
+		_, err = c.apiKeys.CreateAPIKey(ctx, withBearer(guardianv1.CreateAPIKeyRequest_builder{
+			Name:   "ci",
+			Scopes: []string{"documents.read"},
+		}.Build(), memberToken))
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		key, token := create(t, "documents.read")
+		require.Equal(t, guardianv1.APIKeyOwnerType_API_KEY_OWNER_TYPE_USER, key.GetOwnerType())
+		require.Equal(t, ownerID, key.GetOwnerId())
+		require.Equal(t, []string{"documents.read"}, key.GetScopes())
+		require.Contains(t, token, key.GetPrefix())
+
+		list, err := c.apiKeys.ListAPIKeys(ctx, withBearer(&guardianv1.ListAPIKeysRequest{}, ownerToken))
+		require.NoError(t, err)
+		require.NotEmpty(t, list.Msg.GetApiKeys())
+	})
+
+	t.Run("verifies keys", func(t *testing.T) {
+		key, token := create(t, "documents.read")
+
+		got, err := verify(token, "documents.read")
+		require.NoError(t, err)
+		require.Equal(t, key.GetId(), got.GetId())
+		require.True(t, got.HasLastUsedAt())
+
+		_, err = verify(token, "documents.write")
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		_, err = verify(token + "x")
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		// Verification needs no access token, but keys are no access tokens either.
+		_, err = c.apiKeys.ListAPIKeys(ctx, withBearer(&guardianv1.ListAPIKeysRequest{}, token))
+		requireCode(t, connect.CodeUnauthenticated, err)
+	})
+
+	t.Run("hides keys of other owners", func(t *testing.T) {
+		key, _ := create(t)
+
+		_, err := c.apiKeys.GetAPIKey(ctx, withBearer(guardianv1.GetAPIKeyRequest_builder{Id: key.GetId()}.Build(), memberToken))
+		requireCode(t, connect.CodeNotFound, err)
+
+		_, err = c.apiKeys.RevokeAPIKey(ctx, withBearer(guardianv1.RevokeAPIKeyRequest_builder{Id: key.GetId()}.Build(), memberToken))
+		requireCode(t, connect.CodeNotFound, err)
+
+		_, err = c.apiKeys.ListAPIKeys(ctx, withBearer(guardianv1.ListAPIKeysRequest_builder{OwnerId: ownerID}.Build(), memberToken))
+		requireCode(t, connect.CodePermissionDenied, err)
+	})
+
+	t.Run("requires organization admins", func(t *testing.T) {
+		req := guardianv1.CreateAPIKeyRequest_builder{
+			Name:      "deploy",
+			OwnerType: guardianv1.APIKeyOwnerType_API_KEY_OWNER_TYPE_ORGANIZATION,
+			OwnerId:   org.GetId(),
+			Scopes:    []string{"documents.write"},
+		}.Build()
+
+		_, err := c.apiKeys.CreateAPIKey(ctx, withBearer(req, memberToken))
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		res, err := c.apiKeys.CreateAPIKey(ctx, withBearer(req, ownerToken))
+		require.NoError(t, err)
+		require.Equal(t, org.GetId(), res.Msg.GetApiKey().GetOwnerId())
+
+		list, err := c.apiKeys.ListAPIKeys(ctx, withBearer(guardianv1.ListAPIKeysRequest_builder{
+			OwnerType: guardianv1.APIKeyOwnerType_API_KEY_OWNER_TYPE_ORGANIZATION,
+			OwnerId:   org.GetId(),
+		}.Build(), ownerToken))
+		require.NoError(t, err)
+		require.Len(t, list.Msg.GetApiKeys(), 1)
+	})
+
+	t.Run("requires permission for service accounts", func(t *testing.T) {
+		req := guardianv1.CreateAPIKeyRequest_builder{
+			Name:      "worker",
+			OwnerType: guardianv1.APIKeyOwnerType_API_KEY_OWNER_TYPE_SERVICE_ACCOUNT,
+			OwnerId:   uuid.NewString(),
+		}.Build()
+
+		_, err := c.apiKeys.CreateAPIKey(ctx, withBearer(req, ownerToken))
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		require.NoError(t, c.rbac.GrantPermission(ctx, role.ID, core.PermissionAPIKeysManage))
+
+		_, err = c.apiKeys.CreateAPIKey(ctx, withBearer(req, ownerToken))
+		require.NoError(t, err)
+	})
+
+	t.Run("rotates keys with overlap", func(t *testing.T) {
+		key, before := create(t)
+
+		res, err := c.apiKeys.RotateAPIKey(ctx, withBearer(guardianv1.RotateAPIKeyRequest_builder{
+			Id:      key.GetId(),
+			Overlap: durationpb.New(time.Hour),
+		}.Build(), ownerToken))
+		require.NoError(t, err)
+		require.Equal(t, key.GetPrefix(), res.Msg.GetApiKey().GetPrefix())
+		require.True(t, res.Msg.GetApiKey().HasPreviousExpiresAt())
+
+		rotated := res.Msg.GetToken()
+		require.NotEqual(t, before, rotated)
+
+		_, err = verify(before)
+		require.NoError(t, err)
+		_, err = verify(rotated)
+		require.NoError(t, err)
+
+		// Without overlap, the replaced token is invalid immediately.
+		res, err = c.apiKeys.RotateAPIKey(ctx, withBearer(guardianv1.RotateAPIKeyRequest_builder{
+			Id:      key.GetId(),
+			Overlap: durationpb.New(0),
+		}.Build(), ownerToken))
+		require.NoError(t, err)
+
+		_, err = verify(rotated)
+		requireCode(t, connect.CodeUnauthenticated, err)
+		_, err = verify(res.Msg.GetToken())
+		require.NoError(t, err)
+	})
+
+	t.Run("revokes keys", func(t *testing.T) {
+		key, token := create(t)
+
+		_, err := c.apiKeys.RevokeAPIKey(ctx, withBearer(guardianv1.RevokeAPIKeyRequest_builder{Id: key.GetId()}.Build(), ownerToken))
+		require.NoError(t, err)
+
+		_, err = verify(token)
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		_, err = c.apiKeys.GetAPIKey(ctx, withBearer(guardianv1.GetAPIKeyRequest_builder{Id: key.GetId()}.Build(), ownerToken))
+		requireCode(t, connect.CodeNotFound, err)
+	})
+}
@@ -0,0 +1,30 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/gophero/guardian/core"
+)
+
+// record records an audit event. Failures are logged only as the change it describes already happened.
+func record(ctx context.Context, audit core.AuditLog, typ core.AuditEventType, userID uuid.UUID, meta core.SessionMetadata) {
+	if err := audit.Record(ctx, core.AuditEvent{
+		Type:      typ,
+		UserID:    userID,
+		IPAddress: meta.IPAddress,
+		UserAgent: meta.UserAgent,
+	}); err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("type", string(typ)).Stringer("user_id", userID).Msg("failed to record audit event")
+	}
+}
+
+// recordDecision records an authorization decision. Failures are logged only so that checks do not depend on the
+// decision log.
+func recordDecision(ctx context.Context, decisions core.DecisionLog, r core.DecisionRecord) {
+	if err := decisions.Record(ctx, r); err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("type", string(r.Type)).Str("action", r.Action).Msg("failed to record authorization decision")
+	}
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/netip"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/gophero/guardian/core"
+)
+
+// authenticator authenticates callers by the access token in the `Authorization: Bearer` header. Tokens are only
+// accepted while the session they are bound to is active, so signing out takes effect immediately.
+type authenticator struct {
+	tokens   core.AccessTokenIssuer
+	sessions core.SessionStore
+	now      func() time.Time
+}
+
+// principal is an authenticated caller.
+type principal struct {
+	UserID  uuid.UUID
+	Session core.Session
+	Claims  core.AccessTokenClaims
+}
+
+func (a *authenticator) authenticate(ctx context.Context, h http.Header) (principal, error) {
+	token, ok := bearerToken(h)
+	if !ok {
+		return principal{}, connect.NewError(connect.CodeUnauthenticated, errors.New("api: missing bearer token"))
+	}
+
+	claims, err := a.tokens.Verify(ctx, token)
+	if err != nil {
+		return principal{}, toConnectError(ctx, err)
+	}
+
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil || claims.SessionID == uuid.Nil {
+		return principal{}, connect.NewError(connect.CodeUnauthenticated, errors.New("api: token is not issued for a user session"))
+	}
+
+	// Tokens of OAuth clients are limited to their scopes and must not act as the user on the API.
+	if claims.ClientID != "" {
+		return principal{}, connect.NewError(connect.CodeUnauthenticated, errors.New("api: token is issued to an oauth client"))
+	}
+
+	sess, err := a.sessions.Get(ctx, claims.SessionID)
+	if errors.Is(err, core.ErrNotFound) || err == nil && (sess.UserID != userID || !active(sess, a.now())) {
+		return principal{}, connect.NewError(connect.CodeUnauthenticated, errors.New("api: session is not active"))
+	}
+
+	if err != nil {
+		return principal{}, toConnectError(ctx, err)
+	}
+
+	return principal{UserID: userID, Session: sess, Claims: claims}, nil
+}
+
+func bearerToken(h http.Header) (string, bool) {
+	scheme, token, ok := strings.Cut(h.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+
+	return token, true
+}
+
+func active(sess core.Session, now time.Time) bool {
+	return sess.RevokedAt == nil && now.Before(sess.ExpiresAt) && now.Before(sess.IdleExpiresAt)
+}
+
+// clientMetadata describes the client of req.
+func clientMetadata(req connect.AnyRequest, device string) core.SessionMetadata {
+	return core.SessionMetadata{UserAgent: req.Header().Get("User-Agent"), Device: device, IPAddress: clientAddr(req)}
+}
+
+// clientAddr returns the address of the client of req, the zero value if unknown.
+func clientAddr(req connect.AnyRequest) netip.Addr {
+	addrPort, err := netip.ParseAddrPort(req.Peer().Addr)
+	if err != nil {
+		return netip.Addr{}
+	}
+
+	return addrPort.Addr().Unmap()
+}
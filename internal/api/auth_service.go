@@ -0,0 +1,873 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+	"github.com/gophero/guardian/core/proto/guardian/v1/guardianv1connect"
+	"github.com/gophero/guardian/internal/mail"
+)
+
+// AuthService implements [guardianv1connect.AuthServiceHandler].
+type AuthService struct {
+	config       AuthConfig
+	users        core.UserStore
+	passwords    core.PasswordStore
+	policy       core.PasswordPolicy
+	sessions     core.SessionStore
+	refresh      core.RefreshTokenStore
+	tokens       core.AccessTokenIssuer
+	challenges   core.MFAChallengeStore
+	passkeys     core.PasskeyStore
+	passwordless core.PasswordlessStore
+	resets       core.PasswordResetStore
+	mailer       core.Mailer
+	queue        *mail.Queue
+	audit        core.AuditLog
+	verifier     *emailVerifier
+	factors      *secondFactors
+	auth         *authenticator
+}
+
+var _ guardianv1connect.AuthServiceHandler = (*AuthService)(nil)
+
+// NewAuthService constructs new [AuthService].
+func NewAuthService(
+	config AuthConfig,
+	users core.UserStore,
+	passwords core.PasswordStore,
+	policy core.PasswordPolicy,
+	sessions core.SessionStore,
+	refresh core.RefreshTokenStore,
+	tokens core.AccessTokenIssuer,
+	totp core.TOTPStore,
+	recovery core.RecoveryCodeStore,
+	challenges core.MFAChallengeStore,
+	passkeys core.PasskeyStore,
+	passwordless core.PasswordlessStore,
+	verifications core.EmailVerificationStore,
+	resets core.PasswordResetStore,
+	mailer core.Mailer,
+	queue *mail.Queue,
+	audit core.AuditLog,
+) *AuthService {
+	return &AuthService{
+		config:       config,
+		users:        users,
+		passwords:    passwords,
+		policy:       policy,
+		sessions:     sessions,
+		refresh:      refresh,
+		tokens:       tokens,
+		challenges:   challenges,
+		passkeys:     passkeys,
+		passwordless: passwordless,
+		resets:       resets,
+		mailer:       mailer,
+		queue:        queue,
+		audit:        audit,
+		verifier:     &emailVerifier{verifications: verifications, mailer: mailer},
+		factors:      &secondFactors{totp: totp, recovery: recovery, audit: audit},
+		auth:         &authenticator{tokens: tokens, sessions: sessions, now: time.Now},
+	}
+}
+
+// SignUp implements [guardianv1connect.AuthServiceHandler].
+func (s *AuthService) SignUp(ctx context.Context, req *connect.Request[guardianv1.SignUpRequest]) (*connect.Response[guardianv1.SignUpResponse], error) {
+	msg := req.Msg
+
+	// Validate the password before creating the user so a rejected password does not leave a user behind.
+	if err := s.policy.Validate(ctx, msg.GetPassword(), core.User{Email: msg.GetEmail(), Username: msg.GetUsername()}); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	user, err := s.users.Create(ctx, core.CreateUserParams{Email: msg.GetEmail(), Username: msg.GetUsername()})
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	if err := s.passwords.Set(ctx, user.ID, msg.GetPassword()); err != nil {
+		if delErr := s.users.Delete(ctx, user.ID); delErr != nil {
+			zerolog.Ctx(ctx).Err(delErr).Stringer("user_id", user.ID).Msg("failed to delete user after failed sign up")
+		}
+		return nil, toConnectError(ctx, err)
+	}
+
+	// The user can request another verification email, so failing to send it does not fail the sign up.
+	s.queue.Enqueue(ctx, func(ctx context.Context) { s.sendEmailVerification(ctx, user) })
+
+	if s.config.RequireVerifiedEmail {
+		return connect.NewResponse(guardianv1.SignUpResponse_builder{User: toUser(user)}.Build()), nil
+	}
+
+	meta := clientMetadata(req, msg.GetDevice())
+	meta.AuthMethods = []string{core.AuthMethodPassword}
+
+	sess, tokens, err := s.startSession(ctx, user.ID, meta)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.SignUpResponse_builder{
+		User:    toUser(user),
+		Session: toSession(sess),
+		Tokens:  tokens,
+	}.Build()), nil
+}
+
+// SignIn implements [guardianv1connect.AuthServiceHandler].
+func (s *AuthService) SignIn(ctx context.Context, req *connect.Request[guardianv1.SignInRequest]) (*connect.Response[guardianv1.SignInResponse], error) {
+	msg := req.Msg
+
+	user, err := s.verifyPassword(ctx, msg.GetIdentifier(), msg.GetPassword())
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	meta := clientMetadata(req, msg.GetDevice())
+	meta.AuthMethods = []string{core.AuthMethodPassword}
+
+	res, err := s.passFirstFactor(ctx, user, meta)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.SignInResponse_builder{
+		User:         res.user,
+		Session:      res.session,
+		Tokens:       res.tokens,
+		MfaChallenge: res.challenge,
+	}.Build()), nil
+}
+
+// firstFactorResult is the outcome of a sign in which passed the first factor. Either user, session and tokens or
+// challenge are set.
+type firstFactorResult struct {
+	user      *guardianv1.User
+	session   *guardianv1.Session
+	tokens    *guardianv1.Tokens
+	challenge *guardianv1.MFAChallenge
+}
+
+// passFirstFactor continues the sign in of a user who passed the first factor. It creates an MFA challenge if the user
+// enrolled a second factor and starts a session otherwise.
+func (s *AuthService) passFirstFactor(ctx context.Context, user core.User, meta core.SessionMetadata) (firstFactorResult, error) {
+	if err := s.canSignIn(user); err != nil {
+		return firstFactorResult{}, err
+	}
+
+	mfa, err := s.factors.totp.Enabled(ctx, user.ID)
+	if err != nil {
+		return firstFactorResult{}, err
+	}
+
+	if mfa {
+		challenge, err := s.challenge(ctx, user.ID, meta.Device)
+		if err != nil {
+			return firstFactorResult{}, err
+		}
+
+		return firstFactorResult{challenge: challenge}, nil
+	}
+
+	sess, tokens, err := s.startSession(ctx, user.ID, meta)
+	if err != nil {
+		return firstFactorResult{}, err
+	}
+
+	return firstFactorResult{user: toUser(user), session: toSession(sess), tokens: tokens}, nil
+}
+
+// canSignIn returns a [connect.CodePermissionDenied] error if the user is not active and a
+// [connect.CodeFailedPrecondition] error if it has to verify its email address first.
+func (s *AuthService) canSignIn(user core.User) error {
+	if user.Status != core.UserStatusActive {
+		return connect.NewError(connect.CodePermissionDenied, errors.New("api: user is not active"))
+	}
+
+	if s.config.RequireVerifiedEmail && user.EmailVerifiedAt == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("api: email is not verified"))
+	}
+
+	return nil
+}
+
+// challenge creates an MFA challenge for the user who passed the first factor.
+func (s *AuthService) challenge(ctx context.Context, userID uuid.UUID, device string) (*guardianv1.MFAChallenge, error) {
+	factors, err := s.factors.factors(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	c, token, err := s.challenges.Create(ctx, userID, device)
+	if err != nil {
+		return nil, err
+	}
+
+	return guardianv1.MFAChallenge_builder{
+		Token:     token,
+		Factors:   factors,
+		ExpiresAt: timestamppb.New(c.ExpiresAt),
+	}.Build(), nil
+}
+
+// VerifyMFA implements [guardianv1connect.AuthServiceHandler].
+func (s *AuthService) VerifyMFA(ctx context.Context, req *connect.Request[guardianv1.VerifyMFARequest]) (*connect.Response[guardianv1.VerifyMFAResponse], error) {
+	msg := req.Msg
+
+	// The attempt is counted before the answer is verified, so concurrent guesses cannot exceed the attempt limit.
+	c, err := s.challenges.Attempt(ctx, msg.GetChallengeToken())
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	meta := clientMetadata(req, c.Device)
+	meta.AuthMethods = []string{core.AuthMethodMultiFactor, core.AuthMethodOTP}
+
+	if err := s.factors.verify(ctx, c.UserID, msg, meta); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	// Completing fails if the challenge was answered concurrently, so it yields a single session.
+	if err := s.challenges.Complete(ctx, c.ID); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	user, err := s.users.Get(ctx, c.UserID)
+	if errors.Is(err, core.ErrNotFound) {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("api: user is not active"))
+	}
+
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	if err := s.canSignIn(user); err != nil {
+		return nil, err
+	}
+
+	sess, tokens, err := s.startSession(ctx, user.ID, meta)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.VerifyMFAResponse_builder{
+		User:    toUser(user),
+		Session: toSession(sess),
+		Tokens:  tokens,
+	}.Build()), nil
+}
+
+// BeginPasskeySignIn implements [guardianv1connect.AuthServiceHandler].
+func (s *AuthService) BeginPasskeySignIn(ctx context.Context, req *connect.Request[guardianv1.BeginPasskeySignInRequest]) (*connect.Response[guardianv1.BeginPasskeySignInResponse], error) {
+	// Unknown users get a discoverable ceremony like users without passkeys, so the response does not reveal them.
+	var userID uuid.UUID
+
+	if identifier := req.Msg.GetIdentifier(); identifier != "" {
+		user, err := s.findUser(ctx, identifier)
+		if err != nil && !errors.Is(err, core.ErrNotFound) {
+			return nil, toConnectError(ctx, err)
+		}
+
+		userID = user.ID
+	}
+
+	c, err := s.passkeys.BeginLogin(ctx, userID)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.BeginPasskeySignInResponse_builder{
+		CeremonyId: c.ID.String(),
+		Options:    string(c.Options),
+		ExpiresAt:  timestamppb.New(c.ExpiresAt),
+	}.Build()), nil
+}
+
+// FinishPasskeySignIn implements [guardianv1connect.AuthServiceHandler].
+func (s *AuthService) FinishPasskeySignIn(ctx context.Context, req *connect.Request[guardianv1.FinishPasskeySignInRequest]) (*connect.Response[guardianv1.FinishPasskeySignInResponse], error) {
+	msg := req.Msg
+
+	ceremonyID, err := uuid.Parse(msg.GetCeremonyId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("api: ceremony_id is not a valid uuid"))
+	}
+
+	passkey, err := s.passkeys.FinishLogin(ctx, ceremonyID, []byte(msg.GetCredential()))
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	user, err := s.users.Get(ctx, passkey.UserID)
+	if errors.Is(err, core.ErrNotFound) {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("api: user is not active"))
+	}
+
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	if err := s.canSignIn(user); err != nil {
+		return nil, err
+	}
+
+	meta := clientMetadata(req, msg.GetDevice())
+	meta.AuthMethods = []string{core.AuthMethodHardwareKey}
+
+	sess, tokens, err := s.startSession(ctx, user.ID, meta)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.FinishPasskeySignInResponse_builder{
+		User:    toUser(user),
+		Session: toSession(sess),
+		Tokens:  tokens,
+	}.Build()), nil
+}
+
+// SendMagicLink implements [guardianv1connect.AuthServiceHandler].
+func (s *AuthService) SendMagicLink(ctx context.Context, req *connect.Request[guardianv1.SendMagicLinkRequest]) (*connect.Response[guardianv1.SendMagicLinkResponse], error) {
+	msg := req.Msg
+
+	user, ok, err := s.findEmailRecipient(ctx, msg.GetEmail())
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	// The link is sent after responding, so neither the response nor its timing reveals the user.
+	if ok {
+		device := msg.GetDevice()
+		s.queue.Enqueue(ctx, func(ctx context.Context) { s.sendMagicLink(ctx, user, device) })
+	}
+
+	return connect.NewResponse(&guardianv1.SendMagicLinkResponse{}), nil
+}
+
+// sendMagicLink creates a magic link for the user and emails it to the user. Failures are only logged, as the request
+// was answered already. Requests repeated within the resend interval send nothing.
+func (s *AuthService) sendMagicLink(ctx context.Context, user core.User, device string) {
+	c, link, err := s.passwordless.CreateMagicLink(ctx, user.ID, device)
+	if errors.Is(err, core.ErrRateLimited) {
+		zerolog.Ctx(ctx).Debug().Stringer("user_id", user.ID).Msg("magic link throttled")
+		return
+	}
+
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Stringer("user_id", user.ID).Msg("failed to create magic link")
+		return
+	}
+
+	email, err := mail.MagicLink(user.Email, mail.MagicLinkData{
+		Username:  user.Username,
+		Link:      link,
+		ExpiresIn: c.ExpiresAt.Sub(c.CreatedAt),
+	})
+	if err == nil {
+		err = s.mailer.Send(ctx, email)
+	}
+
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Stringer("user_id", user.ID).Msg("failed to send magic link")
+	}
+}
+
+// VerifyMagicLink implements [guardianv1connect.AuthServiceHandler].
+func (s *AuthService) VerifyMagicLink(ctx context.Context, req *connect.Request[guardianv1.VerifyMagicLinkRequest]) (*connect.Response[guardianv1.VerifyMagicLinkResponse], error) {
+	c, err := s.passwordless.VerifyMagicLink(ctx, req.Msg.GetToken())
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	meta := clientMetadata(req, c.Device)
+	meta.AuthMethods = []string{core.AuthMethodOTP}
+
+	res, err := s.passPasswordless(ctx, c, meta)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.VerifyMagicLinkResponse_builder{
+		User:         res.user,
+		Session:      res.session,
+		Tokens:       res.tokens,
+		MfaChallenge: res.challenge,
+	}.Build()), nil
+}
+
+// SendEmailOTP implements [guardianv1connect.AuthServiceHandler].
+func (s *AuthService) SendEmailOTP(ctx context.Context, req *connect.Request[guardianv1.SendEmailOTPRequest]) (*connect.Response[guardianv1.SendEmailOTPResponse], error) {
+	msg := req.Msg
+
+	user, ok, err := s.findEmailRecipient(ctx, msg.GetEmail())
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	// Every request is answered with a challenge, which is only stored and sent after responding if the user exists.
+	// So neither the response nor its timing reveals the user.
+	c, token, code := s.passwordless.NewEmailOTP(user.ID, msg.GetDevice())
+
+	if ok {
+		s.queue.Enqueue(ctx, func(ctx context.Context) { s.sendEmailOTP(ctx, user, c, token, code) })
+	}
+
+	return connect.NewResponse(guardianv1.SendEmailOTPResponse_builder{
+		ChallengeToken: token,
+		ExpiresAt:      timestamppb.New(c.ExpiresAt),
+	}.Build()), nil
+}
+
+// sendEmailOTP stores the one-time code challenge and emails its code to the user. Failures are only logged, as the
+// request was answered already. Requests repeated within the resend interval send nothing.
+func (s *AuthService) sendEmailOTP(ctx context.Context, user core.User, c core.PasswordlessChallenge, token, code string) {
+	err := s.passwordless.CreateEmailOTP(ctx, c, token, code)
+	if errors.Is(err, core.ErrRateLimited) {
+		zerolog.Ctx(ctx).Debug().Stringer("user_id", user.ID).Msg("email one-time code throttled")
+		return
+	}
+
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Stringer("user_id", user.ID).Msg("failed to create email one-time code")
+		return
+	}
+
+	email, err := mail.EmailOTP(user.Email, mail.EmailOTPData{
+		Username:  user.Username,
+		Code:      code,
+		ExpiresIn: c.ExpiresAt.Sub(c.CreatedAt),
+	})
+	if err == nil {
+		err = s.mailer.Send(ctx, email)
+	}
+
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Stringer("user_id", user.ID).Msg("failed to send email one-time code")
+	}
+}
+
+// VerifyEmailOTP implements [guardianv1connect.AuthServiceHandler].
+func (s *AuthService) VerifyEmailOTP(ctx context.Context, req *connect.Request[guardianv1.VerifyEmailOTPRequest]) (*connect.Response[guardianv1.VerifyEmailOTPResponse], error) {
+	msg := req.Msg
+
+	c, err := s.passwordless.VerifyEmailOTP(ctx, msg.GetChallengeToken(), msg.GetCode())
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	meta := clientMetadata(req, c.Device)
+	meta.AuthMethods = []string{core.AuthMethodOTP}
+
+	res, err := s.passPasswordless(ctx, c, meta)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.VerifyEmailOTPResponse_builder{
+		User:         res.user,
+		Session:      res.session,
+		Tokens:       res.tokens,
+		MfaChallenge: res.challenge,
+	}.Build()), nil
+}
+
+// findEmailRecipient returns the active user with email. It reports false if there is none, which callers must not
+// reveal.
+func (s *AuthService) findEmailRecipient(ctx context.Context, email string) (core.User, bool, error) {
+	if email == "" {
+		return core.User{}, false, connect.NewError(connect.CodeInvalidArgument, errors.New("api: email cannot be empty"))
+	}
+
+	user, err := s.users.GetByEmail(ctx, email)
+	if errors.Is(err, core.ErrNotFound) || err == nil && user.Status != core.UserStatusActive {
+		return core.User{}, false, nil
+	}
+
+	if err != nil {
+		return core.User{}, false, err
+	}
+
+	return user, true, nil
+}
+
+// passPasswordless continues the sign in of the user who completed the passwordless challenge c.
+func (s *AuthService) passPasswordless(ctx context.Context, c core.PasswordlessChallenge, meta core.SessionMetadata) (firstFactorResult, error) {
+	user, err := s.users.Get(ctx, c.UserID)
+	if errors.Is(err, core.ErrNotFound) {
+		return firstFactorResult{}, connect.NewError(connect.CodePermissionDenied, errors.New("api: user is not active"))
+	}
+
+	if err != nil {
+		return firstFactorResult{}, err
+	}
+
+	// The user proved control of its email address by completing the challenge.
+	if user.EmailVerifiedAt == nil {
+		user, err = s.markEmailVerified(ctx, user, user.Email, meta)
+		if err != nil {
+			return firstFactorResult{}, err
+		}
+	}
+
+	return s.passFirstFactor(ctx, user, meta)
+}
+
+// markEmailVerified marks email verified if it is still the email of the user. Otherwise user is returned unchanged.
+func (s *AuthService) markEmailVerified(ctx context.Context, user core.User, email string, meta core.SessionMetadata) (core.User, error) {
+	verified, err := s.users.MarkEmailVerified(ctx, user.ID, email)
+	if errors.Is(err, core.ErrNotFound) {
+		return user, nil
+	}
+
+	if err != nil {
+		return core.User{}, err
+	}
+
+	if user.EmailVerifiedAt == nil {
+		record(ctx, s.audit, core.AuditEmailVerified, user.ID, meta)
+	}
+
+	return verified, nil
+}
+
+// SendEmailVerification implements [guardianv1connect.AuthServiceHandler].
+func (s *AuthService) SendEmailVerification(ctx context.Context, req *connect.Request[guardianv1.SendEmailVerificationRequest]) (*connect.Response[guardianv1.SendEmailVerificationResponse], error) {
+	user, ok, err := s.findEmailRecipient(ctx, req.Msg.GetEmail())
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	// The verification is sent after responding, so neither the response nor its timing reveals the user.
+	if ok && user.EmailVerifiedAt == nil {
+		s.queue.Enqueue(ctx, func(ctx context.Context) { s.sendEmailVerification(ctx, user) })
+	}
+
+	return connect.NewResponse(&guardianv1.SendEmailVerificationResponse{}), nil
+}
+
+// sendEmailVerification emails a link verifying the email address of the user. Failures are only logged, as the
+// request was answered already. Requests repeated within the resend interval send nothing.
+func (s *AuthService) sendEmailVerification(ctx context.Context, user core.User) {
+	err := s.verifier.send(ctx, user, user.Email, core.EmailVerificationVerify)
+	if errors.Is(err, core.ErrRateLimited) {
+		zerolog.Ctx(ctx).Debug().Stringer("user_id", user.ID).Msg("email verification throttled")
+		return
+	}
+
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Stringer("user_id", user.ID).Msg("failed to send email verification")
+	}
+}
+
+// VerifyEmail implements [guardianv1connect.AuthServiceHandler].
+func (s *AuthService) VerifyEmail(ctx context.Context, req *connect.Request[guardianv1.VerifyEmailRequest]) (*connect.Response[guardianv1.VerifyEmailResponse], error) {
+	v, err := s.verifier.verifications.Complete(ctx, req.Msg.GetToken())
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	user, err := s.users.Get(ctx, v.UserID)
+	if errors.Is(err, core.ErrNotFound) {
+		return nil, toConnectError(ctx, core.ErrInvalidToken)
+	}
+
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	meta := clientMetadata(req, "")
+
+	switch v.Purpose {
+	case core.EmailVerificationChange:
+		user, err = s.changeEmail(ctx, user, v.Email, meta)
+	default:
+		if !strings.EqualFold(user.Email, v.Email) {
+			// The email changed since the link was sent.
+			return nil, toConnectError(ctx, core.ErrInvalidToken)
+		}
+
+		user, err = s.markEmailVerified(ctx, user, v.Email, meta)
+	}
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.VerifyEmailResponse_builder{User: toUser(user)}.Build()), nil
+}
+
+// changeEmail changes the email of the user to the verified email and notifies the previous email address.
+func (s *AuthService) changeEmail(ctx context.Context, user core.User, email string, meta core.SessionMetadata) (core.User, error) {
+	changed, err := s.users.ChangeEmail(ctx, user.ID, email)
+	if err != nil {
+		return core.User{}, err
+	}
+
+	record(ctx, s.audit, core.AuditEmailChanged, user.ID, meta)
+
+	// The change already happened, so failing to notify does not fail it.
+	notice, err := mail.EmailChanged(user.Email, mail.EmailChangedData{Username: user.Username, Email: changed.Email})
+	if err == nil {
+		err = s.mailer.Send(ctx, notice)
+	}
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Stringer("user_id", user.ID).Msg("failed to send email changed notice")
+	}
+
+	return changed, nil
+}
+
+// findUser returns the user identified by email or username.
+func (s *AuthService) findUser(ctx context.Context, identifier string) (core.User, error) {
+	if strings.Contains(identifier, "@") {
+		return s.users.GetByEmail(ctx, identifier)
+	}
+
+	return s.users.GetByUsername(ctx, identifier)
+}
+
+// verifyPassword returns the user identified by email or username if password matches. The password is verified even
+// if the user does not exist so response times do not reveal which users exist.
+func (s *AuthService) verifyPassword(ctx context.Context, identifier, password string) (core.User, error) {
+	user, err := s.findUser(ctx, identifier)
+	if errors.Is(err, core.ErrNotFound) {
+		//nolint:errcheck // Only spends the time of a verification, the user does not exist anyway.
+		s.passwords.Verify(ctx, uuid.Nil, password)
+		return core.User{}, core.ErrInvalidCredentials
+	}
+
+	if err != nil {
+		return core.User{}, err
+	}
+
+	if err := s.passwords.Verify(ctx, user.ID, password); err != nil {
+		return core.User{}, err
+	}
+
+	return user, nil
+}
+
+// startSession creates a session for the user and issues its first access and refresh token.
+func (s *AuthService) startSession(ctx context.Context, userID uuid.UUID, meta core.SessionMetadata) (core.Session, *guardianv1.Tokens, error) {
+	sess, _, err := s.sessions.Create(ctx, userID, meta)
+	if err != nil {
+		return core.Session{}, nil, err
+	}
+
+	rt, refreshToken, err := s.refresh.Issue(ctx, core.IssueRefreshTokenParams{UserID: userID, SessionID: sess.ID})
+	if err != nil {
+		return core.Session{}, nil, err
+	}
+
+	accessToken, claims, err := s.tokens.Issue(ctx, core.AccessTokenParams{Subject: userID.String(), SessionID: sess.ID, FamilyID: rt.FamilyID})
+	if err != nil {
+		return core.Session{}, nil, err
+	}
+
+	return sess, toTokens(accessToken, claims.ExpiresAt, refreshToken, rt.ExpiresAt), nil
+}
+
+// RequestPasswordReset implements [guardianv1connect.AuthServiceHandler].
+func (s *AuthService) RequestPasswordReset(ctx context.Context, req *connect.Request[guardianv1.RequestPasswordResetRequest]) (*connect.Response[guardianv1.RequestPasswordResetResponse], error) {
+	user, ok, err := s.findEmailRecipient(ctx, req.Msg.GetEmail())
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	// The reset is sent after responding, so neither the response nor its timing reveals the user.
+	if ok {
+		meta := clientMetadata(req, "")
+		s.queue.Enqueue(ctx, func(ctx context.Context) { s.sendPasswordReset(ctx, user, meta) })
+	}
+
+	return connect.NewResponse(&guardianv1.RequestPasswordResetResponse{}), nil
+}
+
+// sendPasswordReset creates a password reset for the user and emails its link to the user. Failures are only logged,
+// as the request was answered already. Requests repeated within the resend interval send nothing.
+func (s *AuthService) sendPasswordReset(ctx context.Context, user core.User, meta core.SessionMetadata) {
+	r, link, err := s.resets.Create(ctx, user.ID)
+	if errors.Is(err, core.ErrRateLimited) {
+		zerolog.Ctx(ctx).Debug().Stringer("user_id", user.ID).Msg("password reset throttled")
+		return
+	}
+
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Stringer("user_id", user.ID).Msg("failed to create password reset")
+		return
+	}
+
+	record(ctx, s.audit, core.AuditPasswordResetRequested, user.ID, meta)
+
+	email, err := mail.PasswordReset(user.Email, mail.PasswordResetData{
+		Username:  user.Username,
+		Link:      link,
+		ExpiresIn: r.ExpiresAt.Sub(r.CreatedAt),
+	})
+	if err == nil {
+		err = s.mailer.Send(ctx, email)
+	}
+
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Stringer("user_id", user.ID).Msg("failed to send password reset")
+	}
+}
+
+// ResetPassword implements [guardianv1connect.AuthServiceHandler].
+func (s *AuthService) ResetPassword(ctx context.Context, req *connect.Request[guardianv1.ResetPasswordRequest]) (*connect.Response[guardianv1.ResetPasswordResponse], error) {
+	msg := req.Msg
+	meta := clientMetadata(req, "")
+
+	r, err := s.resets.Get(ctx, msg.GetToken())
+	if errors.Is(err, core.ErrInvalidToken) {
+		record(ctx, s.audit, core.AuditPasswordResetFailed, uuid.Nil, meta)
+	}
+
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	user, err := s.users.Get(ctx, r.UserID)
+	if errors.Is(err, core.ErrNotFound) {
+		return nil, toConnectError(ctx, core.ErrInvalidToken)
+	}
+
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	// Check the password, including the password history, before using up the token, so a rejected password can be
+	// corrected.
+	if err := s.passwords.Check(ctx, user.ID, msg.GetPassword()); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	// Completing fails if the token was used concurrently, so it changes the password only once.
+	if err := s.resets.Complete(ctx, r.ID); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	if err := s.passwords.Set(ctx, user.ID, msg.GetPassword()); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	record(ctx, s.audit, core.AuditPasswordReset, user.ID, meta)
+
+	if _, err := s.sessions.RevokeAll(ctx, user.ID, uuid.Nil, core.SessionRevokePasswordChanged); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	if _, err := s.refresh.RevokeUser(ctx, user.ID, core.RefreshTokenRevokePasswordChanged); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	// The user proved control of its email address by receiving the token.
+	if user.EmailVerifiedAt == nil {
+		if _, err := s.markEmailVerified(ctx, user, user.Email, meta); err != nil {
+			return nil, toConnectError(ctx, err)
+		}
+	}
+
+	return connect.NewResponse(&guardianv1.ResetPasswordResponse{}), nil
+}
+
+// SignOut implements [guardianv1connect.AuthServiceHandler].
+func (s *AuthService) SignOut(ctx context.Context, req *connect.Request[guardianv1.SignOutRequest]) (*connect.Response[guardianv1.SignOutResponse], error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sessions.Revoke(ctx, p.Session.ID, core.SessionRevokeSignOut); err != nil && !errors.Is(err, core.ErrNotFound) {
+		return nil, toConnectError(ctx, err)
+	}
+
+	if _, err := s.refresh.RevokeSession(ctx, p.Session.ID, core.RefreshTokenRevokeSignOut); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.SignOutResponse{}), nil
+}
+
+// Refresh implements [guardianv1connect.AuthServiceHandler].
+func (s *AuthService) Refresh(ctx context.Context, req *connect.Request[guardianv1.RefreshRequest]) (*connect.Response[guardianv1.RefreshResponse], error) {
+	rt, refreshToken, err := s.refresh.Rotate(ctx, req.Msg.GetRefreshToken(), clientMetadata(req, ""))
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	if err := s.checkRefreshable(ctx, rt); err != nil {
+		if revokeErr := s.refresh.RevokeFamily(ctx, rt.FamilyID, core.RefreshTokenRevokeSecurity); revokeErr != nil {
+			zerolog.Ctx(ctx).Err(revokeErr).Stringer("family_id", rt.FamilyID).Msg("failed to revoke refresh token family")
+		}
+		return nil, toConnectError(ctx, err)
+	}
+
+	accessToken, claims, err := s.tokens.Issue(ctx, core.AccessTokenParams{
+		Subject:   rt.UserID.String(),
+		SessionID: rt.SessionID,
+		ClientID:  rt.ClientID,
+		Scope:     rt.Scope,
+		FamilyID:  rt.FamilyID,
+	})
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.RefreshResponse_builder{
+		Tokens: toTokens(accessToken, claims.ExpiresAt, refreshToken, rt.ExpiresAt),
+	}.Build()), nil
+}
+
+// checkRefreshable returns [core.ErrInvalidToken] if the user of rt is no longer active or its session ended.
+func (s *AuthService) checkRefreshable(ctx context.Context, rt core.RefreshToken) error {
+	user, err := s.users.Get(ctx, rt.UserID)
+	if errors.Is(err, core.ErrNotFound) || err == nil && user.Status != core.UserStatusActive {
+		return core.ErrInvalidToken
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if rt.SessionID == uuid.Nil {
+		return nil
+	}
+
+	sess, err := s.sessions.Get(ctx, rt.SessionID)
+	if errors.Is(err, core.ErrNotFound) || err == nil && !active(sess, s.auth.now()) {
+		return core.ErrInvalidToken
+	}
+
+	return err
+}
+
+// GetSession implements [guardianv1connect.AuthServiceHandler].
+func (s *AuthService) GetSession(ctx context.Context, req *connect.Request[guardianv1.GetSessionRequest]) (*connect.Response[guardianv1.GetSessionResponse], error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.users.Get(ctx, p.UserID)
+	if errors.Is(err, core.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("api: user does not exist"))
+	}
+
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.GetSessionResponse_builder{
+		User:    toUser(user),
+		Session: toSession(p.Session),
+	}.Build()), nil
+}
@@ -0,0 +1,878 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+	"github.com/gophero/guardian/core/proto/guardian/v1/guardianv1connect"
+	"github.com/gophero/guardian/internal/mail"
+)
+
+type testClients struct {
+	auth     guardianv1connect.AuthServiceClient
+	users    guardianv1connect.UserServiceClient
+	mfa      guardianv1connect.MFAServiceClient
+	passkeys guardianv1connect.PasskeyServiceClient
+	authz    guardianv1connect.AuthzServiceClient
+	relation guardianv1connect.RelationServiceClient
+	orgs     guardianv1connect.OrganizationServiceClient
+	apiKeys  guardianv1connect.APIKeyServiceClient
+	oauth    guardianv1connect.OAuthServiceClient
+	accounts guardianv1connect.ServiceAccountServiceClient
+
+	totp          fakeTOTPStore
+	passwordless  fakePasswordlessStore
+	verifications fakeEmailVerificationStore
+	resets        fakePasswordResetStore
+	rbac          fakeRBACStore
+	mailer        fakeMailer
+	audit         fakeAuditLog
+	decisions     fakeDecisionLog
+	tokens        fakeAccessTokenIssuer
+	requests      fakeOAuthAuthorizationStore
+	devices       fakeOAuthDeviceAuthorizationStore
+}
+
+func newTestClients(t *testing.T) testClients {
+	t.Helper()
+
+	return newTestClientsWithConfig(t, AuthConfig{})
+}
+
+// newTestQueue creates a [mail.Queue] running until the test finished.
+func newTestQueue(t *testing.T) *mail.Queue {
+	t.Helper()
+
+	q, err := mail.NewQueue(mail.QueueConfig{Workers: 2, Size: 100, Timeout: time.Second, DrainTimeout: time.Second})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+
+	go func() { done <- q.Run(ctx) }()
+
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	return q
+}
+
+func newTestClientsWithConfig(t *testing.T, config AuthConfig) testClients {
+	t.Helper()
+
+	f := newFakeStores()
+	users := fakeUserStore{f}
+	sessions := fakeSessionStore{f}
+	refresh := fakeRefreshTokenStore{f}
+	tokens := fakeAccessTokenIssuer{f}
+	totp := fakeTOTPStore{f}
+	recovery := fakeRecoveryCodeStore{f}
+	passkeys := fakePasskeyStore{f}
+	passwordless := fakePasswordlessStore{f}
+	verifications := fakeEmailVerificationStore{f}
+	resets := fakePasswordResetStore{f}
+	rbac := fakeRBACStore{f}
+	mailer := fakeMailer{f, newTestQueue(t)}
+	audit := fakeAuditLog{f}
+	decisions := fakeDecisionLog{f}
+
+	mux := http.NewServeMux()
+	mux.Handle(guardianv1connect.NewAuthServiceHandler(NewAuthService(
+		config, users, fakePasswordStore{f}, fakePolicy{}, sessions, refresh, tokens, totp, recovery, fakeMFAChallengeStore{f},
+		passkeys, passwordless, verifications, resets, mailer, mailer.queue, audit,
+	)))
+	mux.Handle(guardianv1connect.NewUserServiceHandler(NewUserService(users, fakeUserProfileStore{f}, sessions, refresh, tokens, verifications, mailer, rbac)))
+	mux.Handle(guardianv1connect.NewMFAServiceHandler(NewMFAService(users, totp, recovery, audit, sessions, tokens)))
+	mux.Handle(guardianv1connect.NewPasskeyServiceHandler(NewPasskeyService(users, passkeys, audit, sessions, tokens)))
+	mux.Handle(guardianv1connect.NewAuthzServiceHandler(NewAuthzService(rbac, fakeConditionStore{f}, decisions, sessions, tokens)))
+	mux.Handle(guardianv1connect.NewRelationServiceHandler(NewRelationService(fakeReBACStore{f}, rbac, decisions, sessions, tokens)))
+	mux.Handle(guardianv1connect.NewOrganizationServiceHandler(NewOrganizationService(fakeOrganizationStore{f}, users, mailer, sessions, tokens)))
+	mux.Handle(guardianv1connect.NewAPIKeyServiceHandler(NewAPIKeyService(fakeAPIKeyStore{f}, fakeOrganizationStore{f}, rbac, sessions, tokens)))
+	mux.Handle(guardianv1connect.NewOAuthServiceHandler(NewOAuthService(fakeOAuthClientStore{f}, fakeOAuthAuthorizationStore{f}, fakeOAuthDeviceAuthorizationStore{f}, rbac, sessions, tokens)))
+	mux.Handle(guardianv1connect.NewServiceAccountServiceHandler(NewServiceAccountService(fakeServiceAccountStore{f}, rbac, sessions, tokens)))
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return testClients{
+		auth:          guardianv1connect.NewAuthServiceClient(srv.Client(), srv.URL),
+		users:         guardianv1connect.NewUserServiceClient(srv.Client(), srv.URL),
+		mfa:           guardianv1connect.NewMFAServiceClient(srv.Client(), srv.URL),
+		passkeys:      guardianv1connect.NewPasskeyServiceClient(srv.Client(), srv.URL),
+		authz:         guardianv1connect.NewAuthzServiceClient(srv.Client(), srv.URL),
+		relation:      guardianv1connect.NewRelationServiceClient(srv.Client(), srv.URL),
+		orgs:          guardianv1connect.NewOrganizationServiceClient(srv.Client(), srv.URL),
+		apiKeys:       guardianv1connect.NewAPIKeyServiceClient(srv.Client(), srv.URL),
+		oauth:         guardianv1connect.NewOAuthServiceClient(srv.Client(), srv.URL),
+		accounts:      guardianv1connect.NewServiceAccountServiceClient(srv.Client(), srv.URL),
+		totp:          totp,
+		passwordless:  passwordless,
+		verifications: verifications,
+		resets:        resets,
+		rbac:          rbac,
+		mailer:        mailer,
+		audit:         audit,
+		decisions:     decisions,
+		tokens:        tokens,
+		requests:      fakeOAuthAuthorizationStore{f},
+		devices:       fakeOAuthDeviceAuthorizationStore{f},
+	}
+}
+
+func withBearer[T any](msg *T, token string) *connect.Request[T] {
+	req := connect.NewRequest(msg)
+	req.Header().Set("Authorization", "Bearer "+token)
+	return req
+}
+
+func requireCode(t *testing.T, code connect.Code, err error) {
+	t.Helper()
+
+	var connectErr *connect.Error
+	require.True(t, errors.As(err, &connectErr), "expected connect error, got %v", err)
+	require.Equal(t, code, connectErr.Code())
+}
+
+func signUp(t *testing.T, c testClients, email, username string) *guardianv1.SignUpResponse {
+	t.Helper()
+
+	res, err := c.auth.SignUp(context.Background(), connect.NewRequest(guardianv1.SignUpRequest_builder{
+		Email:    email,
+		Username: username,
+		Password: "correct horse battery staple",
+		Device:   "test",
+	}.Build()))
+	require.NoError(t, err)
+
+	return res.Msg
+}
+
+func TestAuthService(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClients(t)
+
+	signedUp := signUp(t, c, "ada@example.com", "ada")
+	require.Equal(t, "ada@example.com", signedUp.GetUser().GetEmail())
+	require.Equal(t, "test", signedUp.GetSession().GetDevice())
+
+	t.Run("password policy", func(t *testing.T) {
+		_, err := c.auth.SignUp(ctx, connect.NewRequest(guardianv1.SignUpRequest_builder{
+			Email:    "bob@example.com",
+			Username: "bob",
+			Password: "short",
+		}.Build()))
+		requireCode(t, connect.CodeInvalidArgument, err)
+
+		var connectErr *connect.Error
+		require.ErrorAs(t, err, &connectErr)
+		require.Len(t, connectErr.Details(), 1)
+
+		detail, err := connectErr.Details()[0].Value()
+		require.NoError(t, err)
+		require.Equal(t, "password_too_short", detail.(*guardianv1.PasswordPolicyError).GetViolations()[0].GetCode())
+
+		// The user must not have been created.
+		_, err = c.auth.SignIn(ctx, connect.NewRequest(guardianv1.SignInRequest_builder{
+			Identifier: "bob",
+			Password:   "short",
+		}.Build()))
+		requireCode(t, connect.CodeUnauthenticated, err)
+	})
+
+	t.Run("sign in", func(t *testing.T) {
+		for _, identifier := range []string{"ada", "ADA@example.com"} {
+			res, err := c.auth.SignIn(ctx, connect.NewRequest(guardianv1.SignInRequest_builder{
+				Identifier: identifier,
+				Password:   "correct horse battery staple",
+			}.Build()))
+			require.NoError(t, err)
+			require.Equal(t, signedUp.GetUser().GetId(), res.Msg.GetUser().GetId())
+		}
+
+		_, err := c.auth.SignIn(ctx, connect.NewRequest(guardianv1.SignInRequest_builder{
+			Identifier: "ada",
+			Password:   "wrong",
+		}.Build()))
+		requireCode(t, connect.CodeUnauthenticated, err)
+	})
+
+	t.Run("get session", func(t *testing.T) {
+		res, err := c.auth.GetSession(ctx, withBearer(&guardianv1.GetSessionRequest{}, signedUp.GetTokens().GetAccessToken()))
+		require.NoError(t, err)
+		require.Equal(t, signedUp.GetSession().GetId(), res.Msg.GetSession().GetId())
+
+		_, err = c.auth.GetSession(ctx, connect.NewRequest(&guardianv1.GetSessionRequest{}))
+		requireCode(t, connect.CodeUnauthenticated, err)
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		first := signedUp.GetTokens().GetRefreshToken()
+
+		res, err := c.auth.Refresh(ctx, connect.NewRequest(guardianv1.RefreshRequest_builder{RefreshToken: first}.Build()))
+		require.NoError(t, err)
+
+		second := res.Msg.GetTokens().GetRefreshToken()
+		require.NotEqual(t, first, second)
+
+		// Reusing the first token revokes the family including the second token.
+		_, err = c.auth.Refresh(ctx, connect.NewRequest(guardianv1.RefreshRequest_builder{RefreshToken: first}.Build()))
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		_, err = c.auth.Refresh(ctx, connect.NewRequest(guardianv1.RefreshRequest_builder{RefreshToken: second}.Build()))
+		requireCode(t, connect.CodeUnauthenticated, err)
+	})
+
+	t.Run("sign out", func(t *testing.T) {
+		res, err := c.auth.SignIn(ctx, connect.NewRequest(guardianv1.SignInRequest_builder{
+			Identifier: "ada",
+			Password:   "correct horse battery staple",
+		}.Build()))
+		require.NoError(t, err)
+
+		token := res.Msg.GetTokens().GetAccessToken()
+
+		_, err = c.auth.SignOut(ctx, withBearer(&guardianv1.SignOutRequest{}, token))
+		require.NoError(t, err)
+
+		_, err = c.auth.GetSession(ctx, withBearer(&guardianv1.GetSessionRequest{}, token))
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		_, err = c.auth.Refresh(ctx, connect.NewRequest(guardianv1.RefreshRequest_builder{
+			RefreshToken: res.Msg.GetTokens().GetRefreshToken(),
+		}.Build()))
+		requireCode(t, connect.CodeUnauthenticated, err)
+	})
+}
+
+func TestPasswordlessSignIn(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClients(t)
+
+	signedUp := signUp(t, c, "ada@example.com", "ada")
+
+	// Bob enrolled a second factor.
+	bob := signUp(t, c, "bob@example.com", "bob")
+	bobToken := bob.GetTokens().GetAccessToken()
+	_, err := c.mfa.EnrollTOTP(ctx, withBearer(&guardianv1.EnrollTOTPRequest{}, bobToken))
+	require.NoError(t, err)
+	_, err = c.mfa.ConfirmTOTP(ctx, withBearer(guardianv1.ConfirmTOTPRequest_builder{
+		Code: c.totp.currentCode(uuid.MustParse(bob.GetUser().GetId())),
+	}.Build(), bobToken))
+	require.NoError(t, err)
+
+	// Drop the verification emails of the sign ups.
+	c.mailer.sent()
+
+	sendMagicLink := func(t *testing.T, email string) string {
+		t.Helper()
+
+		// Challenges of earlier subtests are not throttling this one.
+		c.passwordless.expireThrottle()
+
+		_, err := c.auth.SendMagicLink(ctx, connect.NewRequest(guardianv1.SendMagicLinkRequest_builder{
+			Email:  email,
+			Device: "phone",
+		}.Build()))
+		require.NoError(t, err)
+
+		sent := c.mailer.sent()
+		if len(sent) == 0 {
+			return ""
+		}
+
+		require.Len(t, sent, 1)
+		require.Equal(t, email, sent[0].To)
+
+		_, token, ok := strings.Cut(sent[0].Text, "https://example.com/magic-link?token=")
+		require.True(t, ok)
+		token, _, _ = strings.Cut(token, "\n")
+
+		return token
+	}
+
+	sendEmailOTP := func(t *testing.T, email string) (string, string) {
+		t.Helper()
+
+		c.passwordless.expireThrottle()
+
+		res, err := c.auth.SendEmailOTP(ctx, connect.NewRequest(guardianv1.SendEmailOTPRequest_builder{
+			Email:  email,
+			Device: "phone",
+		}.Build()))
+		require.NoError(t, err)
+		require.NotEmpty(t, res.Msg.GetChallengeToken())
+		require.True(t, res.Msg.HasExpiresAt())
+
+		sent := c.mailer.sent()
+		if len(sent) == 0 {
+			return res.Msg.GetChallengeToken(), ""
+		}
+
+		require.Len(t, sent, 1)
+		code, ok := strings.CutPrefix(sent[0].Subject, "Your sign in code is ")
+		require.True(t, ok)
+
+		return res.Msg.GetChallengeToken(), code
+	}
+
+	verifyEmailOTP := func(token, code string) (*guardianv1.VerifyEmailOTPResponse, error) {
+		res, err := c.auth.VerifyEmailOTP(ctx, connect.NewRequest(guardianv1.VerifyEmailOTPRequest_builder{
+			ChallengeToken: token,
+			Code:           code,
+		}.Build()))
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Msg, nil
+	}
+
+	t.Run("magic link", func(t *testing.T) {
+		token := sendMagicLink(t, "ada@example.com")
+		require.NotEmpty(t, token)
+
+		res, err := c.auth.VerifyMagicLink(ctx, connect.NewRequest(guardianv1.VerifyMagicLinkRequest_builder{Token: token}.Build()))
+		require.NoError(t, err)
+		require.Equal(t, signedUp.GetUser().GetId(), res.Msg.GetUser().GetId())
+		require.Equal(t, "phone", res.Msg.GetSession().GetDevice())
+		require.NotEmpty(t, res.Msg.GetTokens().GetAccessToken())
+
+		// Magic links are single-use.
+		_, err = c.auth.VerifyMagicLink(ctx, connect.NewRequest(guardianv1.VerifyMagicLinkRequest_builder{Token: token}.Build()))
+		requireCode(t, connect.CodeUnauthenticated, err)
+	})
+
+	t.Run("magic link with mfa", func(t *testing.T) {
+		token := sendMagicLink(t, "bob@example.com")
+		require.NotEmpty(t, token)
+
+		res, err := c.auth.VerifyMagicLink(ctx, connect.NewRequest(guardianv1.VerifyMagicLinkRequest_builder{Token: token}.Build()))
+		require.NoError(t, err)
+		require.False(t, res.Msg.HasTokens())
+		require.NotEmpty(t, res.Msg.GetMfaChallenge().GetToken())
+	})
+
+	t.Run("email otp", func(t *testing.T) {
+		token, code := sendEmailOTP(t, "ada@example.com")
+		require.Len(t, code, 6)
+
+		_, err := verifyEmailOTP(token, "999999")
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		res, err := verifyEmailOTP(token, code)
+		require.NoError(t, err)
+		require.Equal(t, signedUp.GetUser().GetId(), res.GetUser().GetId())
+		require.Equal(t, "phone", res.GetSession().GetDevice())
+
+		_, err = verifyEmailOTP(token, code)
+		requireCode(t, connect.CodeUnauthenticated, err)
+	})
+
+	t.Run("email otp attempts", func(t *testing.T) {
+		token, code := sendEmailOTP(t, "ada@example.com")
+		require.Len(t, code, 6)
+
+		for range 3 {
+			_, err := verifyEmailOTP(token, "999999")
+			requireCode(t, connect.CodeUnauthenticated, err)
+		}
+
+		_, err := verifyEmailOTP(token, code)
+		requireCode(t, connect.CodeUnauthenticated, err)
+	})
+
+	t.Run("email otp with mfa", func(t *testing.T) {
+		token, code := sendEmailOTP(t, "bob@example.com")
+		require.Len(t, code, 6)
+
+		res, err := verifyEmailOTP(token, code)
+		require.NoError(t, err)
+		require.False(t, res.HasTokens())
+		require.NotEmpty(t, res.GetMfaChallenge().GetToken())
+	})
+
+	t.Run("throttled", func(t *testing.T) {
+		link := sendMagicLink(t, "ada@example.com")
+		require.NotEmpty(t, link)
+
+		// Repeated requests are answered alike, but send nothing and keep the previous challenge valid.
+		_, err := c.auth.SendMagicLink(ctx, connect.NewRequest(guardianv1.SendMagicLinkRequest_builder{
+			Email: "ada@example.com",
+		}.Build()))
+		require.NoError(t, err)
+		require.Empty(t, c.mailer.sent())
+
+		_, err = c.auth.VerifyMagicLink(ctx, connect.NewRequest(guardianv1.VerifyMagicLinkRequest_builder{Token: link}.Build()))
+		require.NoError(t, err)
+
+		token, code := sendEmailOTP(t, "ada@example.com")
+		require.Len(t, code, 6)
+
+		res, err := c.auth.SendEmailOTP(ctx, connect.NewRequest(guardianv1.SendEmailOTPRequest_builder{
+			Email: "ada@example.com",
+		}.Build()))
+		require.NoError(t, err)
+		require.NotEmpty(t, res.Msg.GetChallengeToken())
+		require.True(t, res.Msg.HasExpiresAt())
+		require.Empty(t, c.mailer.sent())
+
+		_, err = verifyEmailOTP(res.Msg.GetChallengeToken(), code)
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		_, err = verifyEmailOTP(token, code)
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown email", func(t *testing.T) {
+		// Unknown emails are answered like known ones without sending an email.
+		require.Empty(t, sendMagicLink(t, "nobody@example.com"))
+
+		token, code := sendEmailOTP(t, "nobody@example.com")
+		require.Empty(t, code)
+
+		_, err := verifyEmailOTP(token, "000000")
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		_, err = c.auth.SendMagicLink(ctx, connect.NewRequest(&guardianv1.SendMagicLinkRequest{}))
+		requireCode(t, connect.CodeInvalidArgument, err)
+	})
+}
+
+func TestUserService(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClients(t)
+
+	ada := signUp(t, c, "ada@example.com", "ada")
+	bob := signUp(t, c, "bob@example.com", "bob")
+	token := ada.GetTokens().GetAccessToken()
+
+	admin := signUp(t, c, "carol@example.com", "carol")
+	adminToken := admin.GetTokens().GetAccessToken()
+
+	_, err := c.rbac.CreatePermission(ctx, core.PermissionUsersManage, "")
+	require.NoError(t, err)
+
+	role, err := c.rbac.CreateRole(ctx, core.CreateRoleParams{Name: "guardian.admin"})
+	require.NoError(t, err)
+	require.NoError(t, c.rbac.GrantPermission(ctx, role.ID, core.PermissionUsersManage))
+
+	_, err = c.rbac.Assign(ctx, uuid.MustParse(admin.GetUser().GetId()), role.ID, uuid.Nil, "")
+	require.NoError(t, err)
+
+	t.Run("get", func(t *testing.T) {
+		res, err := c.users.GetUser(ctx, withBearer(guardianv1.GetUserRequest_builder{Id: ada.GetUser().GetId()}.Build(), token))
+		require.NoError(t, err)
+		require.Equal(t, "ada", res.Msg.GetUser().GetUsername())
+
+		_, err = c.users.GetUser(ctx, withBearer(guardianv1.GetUserRequest_builder{Id: bob.GetUser().GetId()}.Build(), token))
+		requireCode(t, connect.CodePermissionDenied, err)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		username := "ada.lovelace"
+		res, err := c.users.UpdateUser(ctx, withBearer(guardianv1.UpdateUserRequest_builder{
+			Id:       ada.GetUser().GetId(),
+			Username: &username,
+		}.Build(), token))
+		require.NoError(t, err)
+		require.Equal(t, username, res.Msg.GetUser().GetUsername())
+		require.Equal(t, "ada@example.com", res.Msg.GetUser().GetEmail())
+
+		status := guardianv1.UserStatus_USER_STATUS_DISABLED
+		_, err = c.users.UpdateUser(ctx, withBearer(guardianv1.UpdateUserRequest_builder{
+			Id:     ada.GetUser().GetId(),
+			Status: &status,
+		}.Build(), token))
+		requireCode(t, connect.CodePermissionDenied, err)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		_, err := c.users.ListUsers(ctx, withBearer(&guardianv1.ListUsersRequest{}, token))
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		res, err := c.users.ListUsers(ctx, withBearer(&guardianv1.ListUsersRequest{}, adminToken))
+		require.NoError(t, err)
+		require.Len(t, res.Msg.GetUsers(), 3)
+	})
+
+	t.Run("manage other users", func(t *testing.T) {
+		res, err := c.users.GetUser(ctx, withBearer(guardianv1.GetUserRequest_builder{Id: bob.GetUser().GetId()}.Build(), adminToken))
+		require.NoError(t, err)
+		require.Equal(t, "bob", res.Msg.GetUser().GetUsername())
+
+		status := guardianv1.UserStatus_USER_STATUS_SUSPENDED
+		updated, err := c.users.UpdateUser(ctx, withBearer(guardianv1.UpdateUserRequest_builder{
+			Id:     bob.GetUser().GetId(),
+			Status: &status,
+		}.Build(), adminToken))
+		require.NoError(t, err)
+		require.Equal(t, status, updated.Msg.GetUser().GetStatus())
+
+		email := "robert@example.com"
+		updated, err = c.users.UpdateUser(ctx, withBearer(guardianv1.UpdateUserRequest_builder{
+			Id:    bob.GetUser().GetId(),
+			Email: &email,
+		}.Build(), adminToken))
+		require.NoError(t, err)
+		require.Equal(t, email, updated.Msg.GetUser().GetEmail())
+
+		// The permission does not extend to users without it.
+		_, err = c.users.GetUser(ctx, withBearer(guardianv1.GetUserRequest_builder{Id: admin.GetUser().GetId()}.Build(), token))
+		requireCode(t, connect.CodePermissionDenied, err)
+	})
+
+	t.Run("suspend revokes sessions", func(t *testing.T) {
+		dave := signUp(t, c, "dave@example.com", "dave")
+		daveToken := dave.GetTokens().GetAccessToken()
+
+		updateStatus := func(t *testing.T, status guardianv1.UserStatus) {
+			t.Helper()
+
+			_, err := c.users.UpdateUser(ctx, withBearer(guardianv1.UpdateUserRequest_builder{
+				Id:     dave.GetUser().GetId(),
+				Status: &status,
+			}.Build(), adminToken))
+			require.NoError(t, err)
+		}
+
+		updateStatus(t, guardianv1.UserStatus_USER_STATUS_SUSPENDED)
+
+		_, err := c.auth.GetSession(ctx, withBearer(&guardianv1.GetSessionRequest{}, daveToken))
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		// Reactivating the user does not bring the sessions and refresh tokens back.
+		updateStatus(t, guardianv1.UserStatus_USER_STATUS_ACTIVE)
+
+		_, err = c.auth.GetSession(ctx, withBearer(&guardianv1.GetSessionRequest{}, daveToken))
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		_, err = c.auth.Refresh(ctx, connect.NewRequest(guardianv1.RefreshRequest_builder{
+			RefreshToken: dave.GetTokens().GetRefreshToken(),
+		}.Build()))
+		requireCode(t, connect.CodeUnauthenticated, err)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		_, err := c.users.DeleteUser(ctx, withBearer(guardianv1.DeleteUserRequest_builder{Id: ada.GetUser().GetId()}.Build(), token))
+		require.NoError(t, err)
+
+		// All sessions of the user were revoked.
+		_, err = c.auth.GetSession(ctx, withBearer(&guardianv1.GetSessionRequest{}, token))
+		requireCode(t, connect.CodeUnauthenticated, err)
+	})
+}
+
+// verificationToken returns the token of the verification link in the only email sent to to.
+func verificationToken(t *testing.T, c testClients, to string) string {
+	t.Helper()
+
+	sent := c.mailer.sent()
+	require.Len(t, sent, 1)
+	require.Equal(t, to, sent[0].To)
+
+	_, token, ok := strings.Cut(sent[0].Text, "https://example.com/verify-email?token=")
+	require.True(t, ok)
+	token, _, _ = strings.Cut(token, "\n")
+
+	return token
+}
+
+func TestEmailVerification(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClients(t)
+
+	ada := signUp(t, c, "ada@example.com", "ada")
+	require.False(t, ada.GetUser().HasEmailVerifiedAt())
+	adaToken := verificationToken(t, c, "ada@example.com")
+
+	signUp(t, c, "bob@example.com", "bob")
+	bobToken := verificationToken(t, c, "bob@example.com")
+
+	verifyEmail := func(token string) (*guardianv1.User, error) {
+		res, err := c.auth.VerifyEmail(ctx, connect.NewRequest(guardianv1.VerifyEmailRequest_builder{Token: token}.Build()))
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Msg.GetUser(), nil
+	}
+
+	sendEmailVerification := func(t *testing.T, email string) {
+		t.Helper()
+
+		_, err := c.auth.SendEmailVerification(ctx, connect.NewRequest(guardianv1.SendEmailVerificationRequest_builder{
+			Email: email,
+		}.Build()))
+		require.NoError(t, err)
+	}
+
+	t.Run("verify", func(t *testing.T) {
+		user, err := verifyEmail(adaToken)
+		require.NoError(t, err)
+		require.True(t, user.HasEmailVerifiedAt())
+		require.Contains(t, c.audit.auditTypes(), core.AuditEmailVerified)
+
+		// Verification links are single-use.
+		_, err = verifyEmail(adaToken)
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		// Verified users are not sent another link.
+		sendEmailVerification(t, "ada@example.com")
+		require.Empty(t, c.mailer.sent())
+	})
+
+	t.Run("resend", func(t *testing.T) {
+		// Resending right after the sign up is ignored.
+		sendEmailVerification(t, "bob@example.com")
+		require.Empty(t, c.mailer.sent())
+
+		c.verifications.expireThrottle()
+		sendEmailVerification(t, "bob@example.com")
+		token := verificationToken(t, c, "bob@example.com")
+
+		// The new link replaced the previous one.
+		_, err := verifyEmail(bobToken)
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		user, err := verifyEmail(token)
+		require.NoError(t, err)
+		require.True(t, user.HasEmailVerifiedAt())
+	})
+
+	t.Run("unknown email", func(t *testing.T) {
+		sendEmailVerification(t, "nobody@example.com")
+		require.Empty(t, c.mailer.sent())
+
+		_, err := c.auth.SendEmailVerification(ctx, connect.NewRequest(&guardianv1.SendEmailVerificationRequest{}))
+		requireCode(t, connect.CodeInvalidArgument, err)
+	})
+
+	t.Run("change", func(t *testing.T) {
+		accessToken := ada.GetTokens().GetAccessToken()
+		requestEmailChange := func(email string) error {
+			_, err := c.users.RequestEmailChange(ctx, withBearer(guardianv1.RequestEmailChangeRequest_builder{
+				Id:    ada.GetUser().GetId(),
+				Email: email,
+			}.Build(), accessToken))
+			return err
+		}
+
+		// Users cannot change their email without confirming it.
+		email := "lovelace@example.com"
+		_, err := c.users.UpdateUser(ctx, withBearer(guardianv1.UpdateUserRequest_builder{
+			Id:    ada.GetUser().GetId(),
+			Email: &email,
+		}.Build(), accessToken))
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		requireCode(t, connect.CodeInvalidArgument, requestEmailChange("ADA@example.com"))
+
+		require.NoError(t, requestEmailChange(email))
+		token := verificationToken(t, c, email)
+
+		// Changes are throttled.
+		requireCode(t, connect.CodeResourceExhausted, requestEmailChange("other@example.com"))
+
+		user, err := verifyEmail(token)
+		require.NoError(t, err)
+		require.Equal(t, email, user.GetEmail())
+		require.True(t, user.HasEmailVerifiedAt())
+		require.Contains(t, c.audit.auditTypes(), core.AuditEmailChanged)
+
+		// The previous address is notified.
+		sent := c.mailer.sent()
+		require.Len(t, sent, 1)
+		require.Equal(t, "ada@example.com", sent[0].To)
+		require.Contains(t, sent[0].Text, email)
+	})
+
+	t.Run("change to taken email", func(t *testing.T) {
+		c.verifications.expireThrottle()
+
+		_, err := c.users.RequestEmailChange(ctx, withBearer(guardianv1.RequestEmailChangeRequest_builder{
+			Id:    ada.GetUser().GetId(),
+			Email: "bob@example.com",
+		}.Build(), ada.GetTokens().GetAccessToken()))
+		require.NoError(t, err)
+
+		_, err = verifyEmail(verificationToken(t, c, "bob@example.com"))
+		requireCode(t, connect.CodeAlreadyExists, err)
+	})
+}
+
+func TestRequireVerifiedEmail(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClientsWithConfig(t, AuthConfig{RequireVerifiedEmail: true})
+
+	signIn := func(identifier string) error {
+		_, err := c.auth.SignIn(ctx, connect.NewRequest(guardianv1.SignInRequest_builder{
+			Identifier: identifier,
+			Password:   "correct horse battery staple",
+		}.Build()))
+		return err
+	}
+
+	// Unverified users are not signed in.
+	ada := signUp(t, c, "ada@example.com", "ada")
+	require.NotEmpty(t, ada.GetUser().GetId())
+	require.False(t, ada.HasSession())
+	require.False(t, ada.HasTokens())
+	requireCode(t, connect.CodeFailedPrecondition, signIn("ada"))
+
+	_, err := c.auth.VerifyEmail(ctx, connect.NewRequest(guardianv1.VerifyEmailRequest_builder{
+		Token: verificationToken(t, c, "ada@example.com"),
+	}.Build()))
+	require.NoError(t, err)
+	require.NoError(t, signIn("ada"))
+
+	// A magic link proves control of the email address as well.
+	signUp(t, c, "bob@example.com", "bob")
+	c.mailer.sent()
+
+	_, err = c.auth.SendMagicLink(ctx, connect.NewRequest(guardianv1.SendMagicLinkRequest_builder{
+		Email: "bob@example.com",
+	}.Build()))
+	require.NoError(t, err)
+
+	sent := c.mailer.sent()
+	require.Len(t, sent, 1)
+	_, token, ok := strings.Cut(sent[0].Text, "https://example.com/magic-link?token=")
+	require.True(t, ok)
+	token, _, _ = strings.Cut(token, "\n")
+
+	res, err := c.auth.VerifyMagicLink(ctx, connect.NewRequest(guardianv1.VerifyMagicLinkRequest_builder{Token: token}.Build()))
+	require.NoError(t, err)
+	require.True(t, res.Msg.GetUser().HasEmailVerifiedAt())
+	require.NotEmpty(t, res.Msg.GetTokens().GetAccessToken())
+}
+
+func TestPasswordReset(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClients(t)
+
+	ada := signUp(t, c, "ada@example.com", "ada")
+	c.mailer.sent()
+
+	requestPasswordReset := func(t *testing.T, email string) string {
+		t.Helper()
+
+		// Resets requested before are not throttling this one.
+		c.resets.expireThrottle()
+
+		_, err := c.auth.RequestPasswordReset(ctx, connect.NewRequest(guardianv1.RequestPasswordResetRequest_builder{
+			Email: email,
+		}.Build()))
+		require.NoError(t, err)
+
+		sent := c.mailer.sent()
+		require.Len(t, sent, 1)
+		require.Equal(t, email, sent[0].To)
+
+		_, token, ok := strings.Cut(sent[0].Text, "https://example.com/reset-password?token=")
+		require.True(t, ok)
+		token, _, _ = strings.Cut(token, "\n")
+
+		return token
+	}
+
+	resetPassword := func(token, password string) error {
+		_, err := c.auth.ResetPassword(ctx, connect.NewRequest(guardianv1.ResetPasswordRequest_builder{
+			Token:    token,
+			Password: password,
+		}.Build()))
+		return err
+	}
+
+	signIn := func(password string) error {
+		_, err := c.auth.SignIn(ctx, connect.NewRequest(guardianv1.SignInRequest_builder{
+			Identifier: "ada",
+			Password:   password,
+		}.Build()))
+		return err
+	}
+
+	t.Run("reset", func(t *testing.T) {
+		token := requestPasswordReset(t, "ada@example.com")
+		require.NotEmpty(t, token)
+
+		// A rejected or reused password does not use up the token.
+		requireCode(t, connect.CodeInvalidArgument, resetPassword(token, "short"))
+		requireCode(t, connect.CodeInvalidArgument, resetPassword(token, "correct horse battery staple"))
+
+		require.NoError(t, resetPassword(token, "a new correct horse"))
+		require.NoError(t, signIn("a new correct horse"))
+		requireCode(t, connect.CodeUnauthenticated, signIn("correct horse battery staple"))
+
+		// Reset tokens are single-use.
+		requireCode(t, connect.CodeUnauthenticated, resetPassword(token, "another correct horse"))
+
+		// All sessions of the user were revoked.
+		_, err := c.auth.GetSession(ctx, withBearer(&guardianv1.GetSessionRequest{}, ada.GetTokens().GetAccessToken()))
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		_, err = c.auth.Refresh(ctx, connect.NewRequest(guardianv1.RefreshRequest_builder{
+			RefreshToken: ada.GetTokens().GetRefreshToken(),
+		}.Build()))
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		require.Subset(t, c.audit.auditTypes(), []core.AuditEventType{
+			core.AuditPasswordResetRequested,
+			core.AuditPasswordReset,
+			core.AuditPasswordResetFailed,
+		})
+	})
+
+	t.Run("replaced token", func(t *testing.T) {
+		first := requestPasswordReset(t, "ada@example.com")
+		second := requestPasswordReset(t, "ada@example.com")
+
+		requireCode(t, connect.CodeUnauthenticated, resetPassword(first, "a replaced correct horse"))
+		require.NoError(t, resetPassword(second, "the latest correct horse"))
+	})
+
+	t.Run("throttled", func(t *testing.T) {
+		token := requestPasswordReset(t, "ada@example.com")
+
+		// A repeated request is answered alike, but sends no email and keeps the previous token valid.
+		_, err := c.auth.RequestPasswordReset(ctx, connect.NewRequest(guardianv1.RequestPasswordResetRequest_builder{
+			Email: "ada@example.com",
+		}.Build()))
+		require.NoError(t, err)
+		require.Empty(t, c.mailer.sent())
+
+		require.NoError(t, resetPassword(token, "a throttled correct horse"))
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		token := requestPasswordReset(t, "ada@example.com")
+		c.resets.expire()
+
+		requireCode(t, connect.CodeUnauthenticated, resetPassword(token, "an expired correct horse"))
+	})
+
+	t.Run("unknown email", func(t *testing.T) {
+		// Unknown emails are answered like known ones without sending an email.
+		_, err := c.auth.RequestPasswordReset(ctx, connect.NewRequest(guardianv1.RequestPasswordResetRequest_builder{
+			Email: "nobody@example.com",
+		}.Build()))
+		require.NoError(t, err)
+
+		require.Empty(t, c.mailer.sent())
+
+		_, err = c.auth.RequestPasswordReset(ctx, connect.NewRequest(&guardianv1.RequestPasswordResetRequest{}))
+		requireCode(t, connect.CodeInvalidArgument, err)
+	})
+}
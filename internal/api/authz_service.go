@@ -0,0 +1,664 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+	"github.com/gophero/guardian/core/proto/guardian/v1/guardianv1connect"
+)
+
+// AuthzService implements [guardianv1connect.AuthzServiceHandler].
+type AuthzService struct {
+	rbac       core.RBACStore
+	conditions core.ConditionStore
+	decisions  core.DecisionLog
+	auth       *authenticator
+}
+
+var _ guardianv1connect.AuthzServiceHandler = (*AuthzService)(nil)
+
+// NewAuthzService constructs new [AuthzService].
+func NewAuthzService(
+	rbac core.RBACStore,
+	conditions core.ConditionStore,
+	decisions core.DecisionLog,
+	sessions core.SessionStore,
+	tokens core.AccessTokenIssuer,
+) *AuthzService {
+	return &AuthzService{
+		rbac:       rbac,
+		conditions: conditions,
+		decisions:  decisions,
+		auth:       &authenticator{tokens: tokens, sessions: sessions, now: time.Now},
+	}
+}
+
+// authorize authenticates the caller and requires it to have permission in scope.
+func (s *AuthzService) authorize(ctx context.Context, req connect.AnyRequest, permission string, scope uuid.UUID) (principal, error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return principal{}, err
+	}
+
+	if err := s.require(ctx, req, p, permission, scope); err != nil {
+		return principal{}, err
+	}
+
+	return p, nil
+}
+
+func (s *AuthzService) require(ctx context.Context, req connect.AnyRequest, p principal, permission string, scope uuid.UUID) error {
+	return requirePermission(ctx, s.rbac, p, permission, scope, callerAttributes(req, s.auth.now()))
+}
+
+// requirePermission returns a [connect.CodePermissionDenied] error unless the caller has permission in scope. Conditions
+// of the caller's role assignments are evaluated against attrs.
+func requirePermission(
+	ctx context.Context,
+	rbac core.RBACStore,
+	p principal,
+	permission string,
+	scope uuid.UUID,
+	attrs core.AttributeContext,
+) error {
+	d, err := rbac.Check(ctx, p.UserID, permission, scope, attrs)
+	if err != nil {
+		return toConnectError(ctx, err)
+	}
+
+	if !d.Allowed {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("api: missing permission `%s`", permission))
+	}
+
+	return nil
+}
+
+// subject returns the user a check is for and whether it is the caller. Callers may check themselves unless
+// privileged is set, checking other users requires the [core.PermissionAuthzCheck] permission in scope.
+func (s *AuthzService) subject(ctx context.Context, req connect.AnyRequest, subject string, scope uuid.UUID, privileged bool) (uuid.UUID, bool, error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+
+	id := p.UserID
+	if subject != "" {
+		if id, err = parseID("subject", subject); err != nil {
+			return uuid.Nil, false, err
+		}
+	}
+
+	if id != p.UserID || privileged {
+		if err := s.require(ctx, req, p, core.PermissionAuthzCheck, scope); err != nil {
+			return uuid.Nil, false, err
+		}
+	}
+
+	return id, id == p.UserID, nil
+}
+
+// callerAttributes returns the attributes of the caller of req, which its own permissions are checked against.
+func callerAttributes(req connect.AnyRequest, now time.Time) core.AttributeContext {
+	return core.AttributeContext{Time: now, IP: clientAddr(req)}
+}
+
+// attributeContext converts the attributes of a check. The time defaults to now, and the address to the address of
+// the caller if it checks itself.
+func attributeContext(msg *guardianv1.AttributeContext, req connect.AnyRequest, self bool, now time.Time) (core.AttributeContext, error) {
+	attrs := core.AttributeContext{
+		Time:     now,
+		User:     msg.GetUser().AsMap(),
+		Resource: msg.GetResource().AsMap(),
+	}
+
+	if msg.HasTime() {
+		attrs.Time = msg.GetTime().AsTime()
+	}
+
+	switch {
+	case msg.GetIp() != "":
+		ip, err := netip.ParseAddr(msg.GetIp())
+		if err != nil {
+			return core.AttributeContext{}, connect.NewError(connect.CodeInvalidArgument, errors.New("api: context.ip is not a valid ip address"))
+		}
+
+		attrs.IP = ip.Unmap()
+	case self:
+		attrs.IP = clientAddr(req)
+	}
+
+	return attrs, nil
+}
+
+// CreatePermission implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) CreatePermission(ctx context.Context, req *connect.Request[guardianv1.CreatePermissionRequest]) (*connect.Response[guardianv1.CreatePermissionResponse], error) {
+	if _, err := s.authorize(ctx, req, core.PermissionAuthzManage, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	p, err := s.rbac.CreatePermission(ctx, req.Msg.GetName(), req.Msg.GetDescription())
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.CreatePermissionResponse_builder{Permission: toPermission(p)}.Build()), nil
+}
+
+// ListPermissions implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) ListPermissions(ctx context.Context, req *connect.Request[guardianv1.ListPermissionsRequest]) (*connect.Response[guardianv1.ListPermissionsResponse], error) {
+	if _, err := s.authorize(ctx, req, core.PermissionAuthzManage, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	permissions, err := s.rbac.ListPermissions(ctx)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	res := make([]*guardianv1.Permission, 0, len(permissions))
+	for _, p := range permissions {
+		res = append(res, toPermission(p))
+	}
+
+	return connect.NewResponse(guardianv1.ListPermissionsResponse_builder{Permissions: res}.Build()), nil
+}
+
+// DeletePermission implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) DeletePermission(ctx context.Context, req *connect.Request[guardianv1.DeletePermissionRequest]) (*connect.Response[guardianv1.DeletePermissionResponse], error) {
+	if _, err := s.authorize(ctx, req, core.PermissionAuthzManage, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	if err := s.rbac.DeletePermission(ctx, req.Msg.GetName()); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.DeletePermissionResponse{}), nil
+}
+
+// CreateRole implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) CreateRole(ctx context.Context, req *connect.Request[guardianv1.CreateRoleRequest]) (*connect.Response[guardianv1.CreateRoleResponse], error) {
+	if _, err := s.authorize(ctx, req, core.PermissionAuthzManage, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	r, err := s.rbac.CreateRole(ctx, core.CreateRoleParams{Name: req.Msg.GetName(), Description: req.Msg.GetDescription()})
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.CreateRoleResponse_builder{Role: toRole(r)}.Build()), nil
+}
+
+// GetRole implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) GetRole(ctx context.Context, req *connect.Request[guardianv1.GetRoleRequest]) (*connect.Response[guardianv1.GetRoleResponse], error) {
+	if _, err := s.authorize(ctx, req, core.PermissionAuthzManage, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	id, err := parseID("id", req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := s.rbac.GetRole(ctx, id)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.GetRoleResponse_builder{Role: toRole(r)}.Build()), nil
+}
+
+// ListRoles implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) ListRoles(ctx context.Context, req *connect.Request[guardianv1.ListRolesRequest]) (*connect.Response[guardianv1.ListRolesResponse], error) {
+	if _, err := s.authorize(ctx, req, core.PermissionAuthzManage, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	roles, err := s.rbac.ListRoles(ctx)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	res := make([]*guardianv1.Role, 0, len(roles))
+	for _, r := range roles {
+		res = append(res, toRole(r))
+	}
+
+	return connect.NewResponse(guardianv1.ListRolesResponse_builder{Roles: res}.Build()), nil
+}
+
+// UpdateRole implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) UpdateRole(ctx context.Context, req *connect.Request[guardianv1.UpdateRoleRequest]) (*connect.Response[guardianv1.UpdateRoleResponse], error) {
+	if _, err := s.authorize(ctx, req, core.PermissionAuthzManage, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	msg := req.Msg
+
+	id, err := parseID("id", msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	var params core.UpdateRoleParams
+
+	if msg.HasName() {
+		name := msg.GetName()
+		params.Name = &name
+	}
+
+	if msg.HasDescription() {
+		description := msg.GetDescription()
+		params.Description = &description
+	}
+
+	r, err := s.rbac.UpdateRole(ctx, id, params)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.UpdateRoleResponse_builder{Role: toRole(r)}.Build()), nil
+}
+
+// DeleteRole implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) DeleteRole(ctx context.Context, req *connect.Request[guardianv1.DeleteRoleRequest]) (*connect.Response[guardianv1.DeleteRoleResponse], error) {
+	if _, err := s.authorize(ctx, req, core.PermissionAuthzManage, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	id, err := parseID("id", req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.rbac.DeleteRole(ctx, id); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.DeleteRoleResponse{}), nil
+}
+
+// GrantPermission implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) GrantPermission(ctx context.Context, req *connect.Request[guardianv1.GrantPermissionRequest]) (*connect.Response[guardianv1.GrantPermissionResponse], error) {
+	if _, err := s.authorize(ctx, req, core.PermissionAuthzManage, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	roleID, err := parseID("role_id", req.Msg.GetRoleId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.rbac.GrantPermission(ctx, roleID, req.Msg.GetPermission()); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.GrantPermissionResponse{}), nil
+}
+
+// RevokePermission implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) RevokePermission(ctx context.Context, req *connect.Request[guardianv1.RevokePermissionRequest]) (*connect.Response[guardianv1.RevokePermissionResponse], error) {
+	if _, err := s.authorize(ctx, req, core.PermissionAuthzManage, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	roleID, err := parseID("role_id", req.Msg.GetRoleId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.rbac.RevokePermission(ctx, roleID, req.Msg.GetPermission()); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.RevokePermissionResponse{}), nil
+}
+
+// AddRoleParent implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) AddRoleParent(ctx context.Context, req *connect.Request[guardianv1.AddRoleParentRequest]) (*connect.Response[guardianv1.AddRoleParentResponse], error) {
+	if _, err := s.authorize(ctx, req, core.PermissionAuthzManage, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	roleID, err := parseID("role_id", req.Msg.GetRoleId())
+	if err != nil {
+		return nil, err
+	}
+
+	parentID, err := parseID("parent_id", req.Msg.GetParentId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.rbac.AddParent(ctx, roleID, parentID); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.AddRoleParentResponse{}), nil
+}
+
+// RemoveRoleParent implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) RemoveRoleParent(ctx context.Context, req *connect.Request[guardianv1.RemoveRoleParentRequest]) (*connect.Response[guardianv1.RemoveRoleParentResponse], error) {
+	if _, err := s.authorize(ctx, req, core.PermissionAuthzManage, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	roleID, err := parseID("role_id", req.Msg.GetRoleId())
+	if err != nil {
+		return nil, err
+	}
+
+	parentID, err := parseID("parent_id", req.Msg.GetParentId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.rbac.RemoveParent(ctx, roleID, parentID); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.RemoveRoleParentResponse{}), nil
+}
+
+// CreateCondition implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) CreateCondition(ctx context.Context, req *connect.Request[guardianv1.CreateConditionRequest]) (*connect.Response[guardianv1.CreateConditionResponse], error) {
+	if _, err := s.authorize(ctx, req, core.PermissionAuthzManage, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	msg := req.Msg
+
+	c, err := s.conditions.CreateCondition(ctx, core.CreateConditionParams{
+		Name:        msg.GetName(),
+		Expression:  msg.GetExpression(),
+		Description: msg.GetDescription(),
+	})
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.CreateConditionResponse_builder{Condition: toCondition(c)}.Build()), nil
+}
+
+// GetCondition implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) GetCondition(ctx context.Context, req *connect.Request[guardianv1.GetConditionRequest]) (*connect.Response[guardianv1.GetConditionResponse], error) {
+	if _, err := s.authorize(ctx, req, core.PermissionAuthzManage, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	c, err := s.conditions.GetCondition(ctx, req.Msg.GetName())
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.GetConditionResponse_builder{Condition: toCondition(c)}.Build()), nil
+}
+
+// ListConditions implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) ListConditions(ctx context.Context, req *connect.Request[guardianv1.ListConditionsRequest]) (*connect.Response[guardianv1.ListConditionsResponse], error) {
+	if _, err := s.authorize(ctx, req, core.PermissionAuthzManage, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	conditions, err := s.conditions.ListConditions(ctx)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	res := make([]*guardianv1.Condition, 0, len(conditions))
+	for _, c := range conditions {
+		res = append(res, toCondition(c))
+	}
+
+	return connect.NewResponse(guardianv1.ListConditionsResponse_builder{Conditions: res}.Build()), nil
+}
+
+// UpdateCondition implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) UpdateCondition(ctx context.Context, req *connect.Request[guardianv1.UpdateConditionRequest]) (*connect.Response[guardianv1.UpdateConditionResponse], error) {
+	if _, err := s.authorize(ctx, req, core.PermissionAuthzManage, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	msg := req.Msg
+
+	var params core.UpdateConditionParams
+
+	if msg.HasExpression() {
+		expression := msg.GetExpression()
+		params.Expression = &expression
+	}
+
+	if msg.HasDescription() {
+		description := msg.GetDescription()
+		params.Description = &description
+	}
+
+	c, err := s.conditions.UpdateCondition(ctx, msg.GetName(), params)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.UpdateConditionResponse_builder{Condition: toCondition(c)}.Build()), nil
+}
+
+// DeleteCondition implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) DeleteCondition(ctx context.Context, req *connect.Request[guardianv1.DeleteConditionRequest]) (*connect.Response[guardianv1.DeleteConditionResponse], error) {
+	if _, err := s.authorize(ctx, req, core.PermissionAuthzManage, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	if err := s.conditions.DeleteCondition(ctx, req.Msg.GetName()); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.DeleteConditionResponse{}), nil
+}
+
+// AssignRole implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) AssignRole(ctx context.Context, req *connect.Request[guardianv1.AssignRoleRequest]) (*connect.Response[guardianv1.AssignRoleResponse], error) {
+	if _, err := s.authorize(ctx, req, core.PermissionAuthzManage, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	msg := req.Msg
+
+	subject, roleID, scope, err := parseAssignment(msg.GetUserId(), msg.GetServiceAccountId(), msg.GetRoleId(), msg.GetScope())
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.rbac.Assign(ctx, subject, roleID, scope, msg.GetCondition())
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.AssignRoleResponse_builder{Assignment: toRoleAssignment(a)}.Build()), nil
+}
+
+// UnassignRole implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) UnassignRole(ctx context.Context, req *connect.Request[guardianv1.UnassignRoleRequest]) (*connect.Response[guardianv1.UnassignRoleResponse], error) {
+	if _, err := s.authorize(ctx, req, core.PermissionAuthzManage, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	msg := req.Msg
+
+	subject, roleID, scope, err := parseAssignment(msg.GetUserId(), msg.GetServiceAccountId(), msg.GetRoleId(), msg.GetScope())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.rbac.Unassign(ctx, subject, roleID, scope); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.UnassignRoleResponse{}), nil
+}
+
+// ListRoleAssignments implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) ListRoleAssignments(ctx context.Context, req *connect.Request[guardianv1.ListRoleAssignmentsRequest]) (*connect.Response[guardianv1.ListRoleAssignmentsResponse], error) {
+	if _, err := s.authorize(ctx, req, core.PermissionAuthzManage, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	subject, err := parseAssignee(req.Msg.GetUserId(), req.Msg.GetServiceAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := s.rbac.ListAssignments(ctx, subject)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	res := make([]*guardianv1.RoleAssignment, 0, len(assignments))
+	for _, a := range assignments {
+		res = append(res, toRoleAssignment(a))
+	}
+
+	return connect.NewResponse(guardianv1.ListRoleAssignmentsResponse_builder{Assignments: res}.Build()), nil
+}
+
+// Check implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) Check(ctx context.Context, req *connect.Request[guardianv1.CheckRequest]) (*connect.Response[guardianv1.CheckResponse], error) {
+	msg := req.Msg
+
+	scope, err := parseScope(msg.GetScope())
+	if err != nil {
+		return nil, err
+	}
+
+	subject, self, err := s.subject(ctx, req, msg.GetSubject(), scope, msg.GetExplain())
+	if err != nil {
+		return nil, err
+	}
+
+	attrs, err := attributeContext(msg.GetContext(), req, self, s.auth.now())
+	if err != nil {
+		return nil, err
+	}
+
+	var e core.Explanation
+	if msg.GetExplain() {
+		e, err = s.rbac.Explain(ctx, subject, msg.GetPermission(), scope, attrs)
+	} else {
+		e.Decision, err = s.rbac.Check(ctx, subject, msg.GetPermission(), scope, attrs)
+	}
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	var resource string
+	if scope != uuid.Nil {
+		resource = scope.String()
+	}
+
+	recordDecision(ctx, s.decisions, core.DecisionRecord{
+		Type:     core.DecisionPermission,
+		Subject:  subject.String(),
+		Action:   msg.GetPermission(),
+		Resource: resource,
+		Decision: e.Decision,
+	})
+
+	res := guardianv1.CheckResponse_builder{
+		Allowed:    e.Decision.Allowed,
+		Policy:     e.Decision.Policy,
+		Conditions: e.Decision.Conditions,
+	}
+	if msg.GetExplain() {
+		res.Explanation = toExplainNode(e.Tree)
+	}
+
+	return connect.NewResponse(res.Build()), nil
+}
+
+// ListEffectivePermissions implements [guardianv1connect.AuthzServiceHandler].
+func (s *AuthzService) ListEffectivePermissions(ctx context.Context, req *connect.Request[guardianv1.ListEffectivePermissionsRequest]) (*connect.Response[guardianv1.ListEffectivePermissionsResponse], error) {
+	msg := req.Msg
+
+	scope, err := parseScope(msg.GetScope())
+	if err != nil {
+		return nil, err
+	}
+
+	subject, self, err := s.subject(ctx, req, msg.GetSubject(), scope, false)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs, err := attributeContext(msg.GetContext(), req, self, s.auth.now())
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := s.rbac.EffectivePermissions(ctx, subject, scope, attrs)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.ListEffectivePermissionsResponse_builder{Permissions: permissions}.Build()), nil
+}
+
+func parseID(field, value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("api: %s is not a valid uuid", field))
+	}
+
+	return id, nil
+}
+
+// parseScope returns [uuid.Nil] for the empty global scope.
+func parseScope(value string) (uuid.UUID, error) {
+	if value == "" {
+		return uuid.Nil, nil
+	}
+
+	scope, err := uuid.Parse(value)
+	if err != nil || scope == uuid.Nil {
+		return uuid.Nil, connect.NewError(connect.CodeInvalidArgument, errors.New("api: scope is not a valid organization id"))
+	}
+
+	return scope, nil
+}
+
+// parseAssignee returns the user or service account a role is assigned to. Exactly one of them has to be set.
+func parseAssignee(userID, serviceAccountID string) (uuid.UUID, error) {
+	switch {
+	case userID != "" && serviceAccountID != "":
+		return uuid.Nil, connect.NewError(connect.CodeInvalidArgument, errors.New("api: only one of user_id and service_account_id may be set"))
+	case serviceAccountID != "":
+		return parseID("service_account_id", serviceAccountID)
+	default:
+		return parseID("user_id", userID)
+	}
+}
+
+func parseAssignment(userID, serviceAccountID, roleID, scope string) (uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	subject, err := parseAssignee(userID, serviceAccountID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+
+	role, err := parseID("role_id", roleID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+
+	org, err := parseScope(scope)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+
+	return subject, role, org, nil
+}
@@ -0,0 +1,306 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+)
+
+// grantAdmin assigns the user a role like the guardian.admin role seeded by the migrations.
+func grantAdmin(t *testing.T, c testClients, userID string) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	for _, p := range []string{core.PermissionAuthzManage, core.PermissionAuthzCheck} {
+		_, err := c.rbac.CreatePermission(ctx, p, "")
+		require.NoError(t, err)
+	}
+
+	role, err := c.rbac.CreateRole(ctx, core.CreateRoleParams{Name: "guardian.admin"})
+	require.NoError(t, err)
+	require.NoError(t, c.rbac.GrantPermission(ctx, role.ID, core.PermissionAuthzManage))
+	require.NoError(t, c.rbac.GrantPermission(ctx, role.ID, core.PermissionAuthzCheck))
+
+	_, err = c.rbac.Assign(ctx, uuid.MustParse(userID), role.ID, uuid.Nil, "")
+	require.NoError(t, err)
+}
+
+func TestAuthzService(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClients(t)
+
+	admin := signUp(t, c, "ada@example.com", "ada")
+	adminToken := admin.GetTokens().GetAccessToken()
+	user := signUp(t, c, "bob@example.com", "bob")
+	userToken := user.GetTokens().GetAccessToken()
+
+	grantAdmin(t, c, admin.GetUser().GetId())
+
+	createRole := func(t *testing.T, name string, permissions ...string) *guardianv1.Role {
+		t.Helper()
+
+		res, err := c.authz.CreateRole(ctx, withBearer(guardianv1.CreateRoleRequest_builder{Name: name}.Build(), adminToken))
+		require.NoError(t, err)
+
+		for _, p := range permissions {
+			_, err := c.authz.GrantPermission(ctx, withBearer(guardianv1.GrantPermissionRequest_builder{
+				RoleId:     res.Msg.GetRole().GetId(),
+				Permission: p,
+			}.Build(), adminToken))
+			require.NoError(t, err)
+		}
+
+		return res.Msg.GetRole()
+	}
+
+	check := func(t *testing.T, token, subject, permission, scope string) (bool, error) {
+		t.Helper()
+
+		res, err := c.authz.Check(ctx, withBearer(guardianv1.CheckRequest_builder{
+			Subject:    subject,
+			Permission: permission,
+			Scope:      scope,
+		}.Build(), token))
+		if err != nil {
+			return false, err
+		}
+
+		return res.Msg.GetAllowed(), nil
+	}
+
+	for _, p := range []string{"docs.read", "docs.write"} {
+		_, err := c.authz.CreatePermission(ctx, withBearer(guardianv1.CreatePermissionRequest_builder{Name: p}.Build(), adminToken))
+		require.NoError(t, err)
+	}
+
+	viewer := createRole(t, "viewer", "docs.read")
+	editor := createRole(t, "editor", "docs.write")
+
+	_, err := c.authz.AddRoleParent(ctx, withBearer(guardianv1.AddRoleParentRequest_builder{
+		RoleId:   editor.GetId(),
+		ParentId: viewer.GetId(),
+	}.Build(), adminToken))
+	require.NoError(t, err)
+
+	org := uuid.NewString()
+
+	t.Run("requires manage permission", func(t *testing.T) {
+		_, err := c.authz.CreateRole(ctx, withBearer(guardianv1.CreateRoleRequest_builder{Name: "owner"}.Build(), userToken))
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		_, err = c.authz.ListRoles(ctx, connect.NewRequest(&guardianv1.ListRolesRequest{}))
+		requireCode(t, connect.CodeUnauthenticated, err)
+	})
+
+	t.Run("rejects cycles", func(t *testing.T) {
+		_, err := c.authz.AddRoleParent(ctx, withBearer(guardianv1.AddRoleParentRequest_builder{
+			RoleId:   viewer.GetId(),
+			ParentId: editor.GetId(),
+		}.Build(), adminToken))
+		requireCode(t, connect.CodeInvalidArgument, err)
+
+		_, err = c.authz.AddRoleParent(ctx, withBearer(guardianv1.AddRoleParentRequest_builder{
+			RoleId:   viewer.GetId(),
+			ParentId: viewer.GetId(),
+		}.Build(), adminToken))
+		requireCode(t, connect.CodeInvalidArgument, err)
+	})
+
+	t.Run("scoped assignments", func(t *testing.T) {
+		_, err := c.authz.AssignRole(ctx, withBearer(guardianv1.AssignRoleRequest_builder{
+			UserId: user.GetUser().GetId(),
+			RoleId: editor.GetId(),
+			Scope:  org,
+		}.Build(), adminToken))
+		require.NoError(t, err)
+
+		// Inherited from viewer.
+		allowed, err := check(t, userToken, "", "docs.read", org)
+		require.NoError(t, err)
+		require.True(t, allowed)
+
+		allowed, err = check(t, userToken, "", "docs.write", uuid.NewString())
+		require.NoError(t, err)
+		require.False(t, allowed)
+
+		allowed, err = check(t, userToken, "", "docs.write", "")
+		require.NoError(t, err)
+		require.False(t, allowed)
+
+		res, err := c.authz.ListEffectivePermissions(ctx, withBearer(guardianv1.ListEffectivePermissionsRequest_builder{
+			Scope: org,
+		}.Build(), userToken))
+		require.NoError(t, err)
+		require.Equal(t, []string{"docs.read", "docs.write"}, res.Msg.GetPermissions())
+
+		assignments, err := c.authz.ListRoleAssignments(ctx, withBearer(guardianv1.ListRoleAssignmentsRequest_builder{
+			UserId: user.GetUser().GetId(),
+		}.Build(), adminToken))
+		require.NoError(t, err)
+		require.Len(t, assignments.Msg.GetAssignments(), 1)
+		require.Equal(t, org, assignments.Msg.GetAssignments()[0].GetScope())
+	})
+
+	t.Run("checking other users", func(t *testing.T) {
+		_, err := check(t, userToken, admin.GetUser().GetId(), "docs.read", org)
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		allowed, err := check(t, adminToken, user.GetUser().GetId(), "docs.write", org)
+		require.NoError(t, err)
+		require.True(t, allowed)
+
+		_, err = check(t, adminToken, "bob", "docs.write", org)
+		requireCode(t, connect.CodeInvalidArgument, err)
+	})
+
+	t.Run("unassign", func(t *testing.T) {
+		_, err := c.authz.UnassignRole(ctx, withBearer(guardianv1.UnassignRoleRequest_builder{
+			UserId: user.GetUser().GetId(),
+			RoleId: editor.GetId(),
+		}.Build(), adminToken))
+		requireCode(t, connect.CodeNotFound, err)
+
+		_, err = c.authz.UnassignRole(ctx, withBearer(guardianv1.UnassignRoleRequest_builder{
+			UserId: user.GetUser().GetId(),
+			RoleId: editor.GetId(),
+			Scope:  org,
+		}.Build(), adminToken))
+		require.NoError(t, err)
+
+		allowed, err := check(t, userToken, "", "docs.read", org)
+		require.NoError(t, err)
+		require.False(t, allowed)
+	})
+	t.Run("conditions", func(t *testing.T) {
+		createCondition := func(t *testing.T, name, expression string) error {
+			t.Helper()
+
+			_, err := c.authz.CreateCondition(ctx, withBearer(guardianv1.CreateConditionRequest_builder{
+				Name:       name,
+				Expression: expression,
+			}.Build(), adminToken))
+			return err
+		}
+
+		requireCode(t, connect.CodeInvalidArgument, createCondition(t, "broken", "ip.inCidr("))
+		requireCode(t, connect.CodeInvalidArgument, createCondition(t, "not_bool", "ip"))
+		require.NoError(t, createCondition(t, "loopback", "ip.inCidr('127.0.0.0/8')"))
+		require.NoError(t, createCondition(t, "clearance", "user.clearance >= resource.level"))
+
+		_, err := c.authz.AssignRole(ctx, withBearer(guardianv1.AssignRoleRequest_builder{
+			UserId:    user.GetUser().GetId(),
+			RoleId:    viewer.GetId(),
+			Condition: "missing",
+		}.Build(), adminToken))
+		requireCode(t, connect.CodeNotFound, err)
+
+		_, err = c.authz.AssignRole(ctx, withBearer(guardianv1.AssignRoleRequest_builder{
+			UserId:    user.GetUser().GetId(),
+			RoleId:    viewer.GetId(),
+			Condition: "loopback",
+		}.Build(), adminToken))
+		require.NoError(t, err)
+
+		// Callers checking themselves are evaluated with their own address.
+		res, err := c.authz.Check(ctx, withBearer(guardianv1.CheckRequest_builder{Permission: "docs.read"}.Build(), userToken))
+		require.NoError(t, err)
+		require.True(t, res.Msg.GetAllowed())
+		require.Equal(t, "role:viewer", res.Msg.GetPolicy())
+		require.Equal(t, []string{"loopback"}, res.Msg.GetConditions())
+
+		allowed, err := check(t, adminToken, user.GetUser().GetId(), "docs.read", "")
+		require.NoError(t, err)
+		require.False(t, allowed)
+
+		_, err = c.authz.AssignRole(ctx, withBearer(guardianv1.AssignRoleRequest_builder{
+			UserId:    user.GetUser().GetId(),
+			RoleId:    editor.GetId(),
+			Scope:     org,
+			Condition: "clearance",
+		}.Build(), adminToken))
+		require.NoError(t, err)
+
+		checkClearance := func(t *testing.T, clearance, level float64) bool {
+			t.Helper()
+
+			userAttrs, err := structpb.NewStruct(map[string]any{"clearance": clearance})
+			require.NoError(t, err)
+			resourceAttrs, err := structpb.NewStruct(map[string]any{"level": level})
+			require.NoError(t, err)
+
+			res, err := c.authz.Check(ctx, withBearer(guardianv1.CheckRequest_builder{
+				Subject:    user.GetUser().GetId(),
+				Permission: "docs.write",
+				Scope:      org,
+				Context: guardianv1.AttributeContext_builder{
+					User:     userAttrs,
+					Resource: resourceAttrs,
+				}.Build(),
+			}.Build(), adminToken))
+			require.NoError(t, err)
+
+			return res.Msg.GetAllowed()
+		}
+
+		require.True(t, checkClearance(t, 3, 2))
+		require.False(t, checkClearance(t, 1, 2))
+
+		_, err = c.authz.DeleteCondition(ctx, withBearer(guardianv1.DeleteConditionRequest_builder{Name: "loopback"}.Build(), adminToken))
+		requireCode(t, connect.CodeInvalidArgument, err)
+
+		// Updated expressions apply to existing assignments.
+		_, err = c.authz.UpdateCondition(ctx, withBearer(guardianv1.UpdateConditionRequest_builder{
+			Name:       "clearance",
+			Expression: proto.String("user.clearance > resource.level"),
+		}.Build(), adminToken))
+		require.NoError(t, err)
+		require.False(t, checkClearance(t, 2, 2))
+
+		conditions, err := c.authz.ListConditions(ctx, withBearer(&guardianv1.ListConditionsRequest{}, adminToken))
+		require.NoError(t, err)
+		require.Len(t, conditions.Msg.GetConditions(), 2)
+		require.Equal(t, "clearance", conditions.Msg.GetConditions()[0].GetName())
+	})
+	t.Run("explain", func(t *testing.T) {
+		// Explanations reveal grants, so callers need the check permission to explain even their own checks.
+		_, err := c.authz.Check(ctx, withBearer(guardianv1.CheckRequest_builder{
+			Permission: "docs.read",
+			Explain:    true,
+		}.Build(), userToken))
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		res, err := c.authz.Check(ctx, withBearer(guardianv1.CheckRequest_builder{
+			Permission: core.PermissionAuthzManage,
+			Explain:    true,
+		}.Build(), adminToken))
+		require.NoError(t, err)
+		require.True(t, res.Msg.GetAllowed())
+
+		tree := res.Msg.GetExplanation()
+		require.Equal(t, guardianv1.ExplainNodeKind_EXPLAIN_NODE_KIND_PERMISSION, tree.GetKind())
+		require.Equal(t, core.PermissionAuthzManage, tree.GetName())
+		require.Len(t, tree.GetChildren(), 1)
+		require.Equal(t, "role:guardian.admin", tree.GetChildren()[0].GetName())
+
+		res, err = c.authz.Check(ctx, withBearer(guardianv1.CheckRequest_builder{Permission: "docs.read"}.Build(), adminToken))
+		require.NoError(t, err)
+		require.False(t, res.Msg.HasExplanation())
+
+		decisions := c.decisions.recorded()
+		require.Equal(t, core.DecisionRecord{
+			Type:     core.DecisionPermission,
+			Subject:  admin.GetUser().GetId(),
+			Action:   "docs.read",
+			Decision: core.Decision{},
+		}, decisions[len(decisions)-1])
+	})
+}
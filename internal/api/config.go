@@ -0,0 +1,5 @@
+package api
+
+type AuthConfig struct {
+	RequireVerifiedEmail bool `help:"Deny sign ins of users who did not verify their email address." name:"require_verified_email" env:"REQUIRE_VERIFIED_EMAIL"`
+}
@@ -0,0 +1,503 @@
+package api
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+	"github.com/gophero/guardian/internal/oauth"
+)
+
+var userStatuses = map[core.UserStatus]guardianv1.UserStatus{
+	core.UserStatusActive:    guardianv1.UserStatus_USER_STATUS_ACTIVE,
+	core.UserStatusSuspended: guardianv1.UserStatus_USER_STATUS_SUSPENDED,
+	core.UserStatusDisabled:  guardianv1.UserStatus_USER_STATUS_DISABLED,
+}
+
+func toUserStatus(s core.UserStatus) guardianv1.UserStatus {
+	return userStatuses[s]
+}
+
+// fromUserStatus returns an empty status for [guardianv1.UserStatus_USER_STATUS_UNSPECIFIED] and unknown values.
+func fromUserStatus(s guardianv1.UserStatus) core.UserStatus {
+	for k, v := range userStatuses {
+		if v == s {
+			return k
+		}
+	}
+
+	return ""
+}
+
+func toUser(u core.User) *guardianv1.User {
+	b := guardianv1.User_builder{
+		Id:        u.ID.String(),
+		Email:     u.Email,
+		Username:  u.Username,
+		Status:    toUserStatus(u.Status),
+		CreatedAt: timestamppb.New(u.CreatedAt),
+		UpdatedAt: timestamppb.New(u.UpdatedAt),
+	}
+
+	if u.EmailVerifiedAt != nil {
+		b.EmailVerifiedAt = timestamppb.New(*u.EmailVerifiedAt)
+	}
+
+	return b.Build()
+}
+
+func toSession(s core.Session) *guardianv1.Session {
+	var ip string
+	if s.IPAddress.IsValid() {
+		ip = s.IPAddress.String()
+	}
+
+	// The earlier of both expirations is the one the client has to care about.
+	expiresAt := s.ExpiresAt
+	if s.IdleExpiresAt.Before(expiresAt) {
+		expiresAt = s.IdleExpiresAt
+	}
+
+	return guardianv1.Session_builder{
+		Id:          s.ID.String(),
+		UserId:      s.UserID.String(),
+		UserAgent:   s.UserAgent,
+		IpAddress:   ip,
+		Device:      s.Device,
+		CreatedAt:   timestamppb.New(s.CreatedAt),
+		LastSeenAt:  timestamppb.New(s.LastSeenAt),
+		ExpiresAt:   timestamppb.New(expiresAt),
+		AuthMethods: s.AuthMethods,
+	}.Build()
+}
+
+func toTokens(accessToken string, accessTokenExpiresAt time.Time, refreshToken string, refreshTokenExpiresAt time.Time) *guardianv1.Tokens {
+	return guardianv1.Tokens_builder{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  timestamppb.New(accessTokenExpiresAt),
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(refreshTokenExpiresAt),
+	}.Build()
+}
+
+func toPasskey(p core.Passkey) *guardianv1.Passkey {
+	b := guardianv1.Passkey_builder{
+		Id:                p.ID.String(),
+		Name:              p.Name,
+		AttestationFormat: p.AttestationFormat,
+		Aaguid:            p.AAGUID.String(),
+		Transports:        p.Transports,
+		BackedUp:          p.BackupState,
+		CreatedAt:         timestamppb.New(p.CreatedAt),
+	}
+
+	if p.LastUsedAt != nil {
+		b.LastUsedAt = timestamppb.New(*p.LastUsedAt)
+	}
+
+	return b.Build()
+}
+
+func toPermission(p core.Permission) *guardianv1.Permission {
+	return guardianv1.Permission_builder{
+		Name:        p.Name,
+		Description: p.Description,
+		CreatedAt:   timestamppb.New(p.CreatedAt),
+	}.Build()
+}
+
+func toRole(r core.Role) *guardianv1.Role {
+	parentIDs := make([]string, 0, len(r.ParentIDs))
+	for _, id := range r.ParentIDs {
+		parentIDs = append(parentIDs, id.String())
+	}
+
+	return guardianv1.Role_builder{
+		Id:          r.ID.String(),
+		Name:        r.Name,
+		Description: r.Description,
+		Permissions: r.Permissions,
+		ParentIds:   parentIDs,
+		CreatedAt:   timestamppb.New(r.CreatedAt),
+		UpdatedAt:   timestamppb.New(r.UpdatedAt),
+	}.Build()
+}
+
+func toRoleAssignment(a core.RoleAssignment) *guardianv1.RoleAssignment {
+	b := guardianv1.RoleAssignment_builder{
+		Id:        a.ID.String(),
+		RoleId:    a.RoleID.String(),
+		CreatedAt: timestamppb.New(a.CreatedAt),
+		Condition: a.Condition,
+	}
+
+	if a.UserID != uuid.Nil {
+		b.UserId = a.UserID.String()
+	}
+
+	if a.ServiceAccountID != uuid.Nil {
+		b.ServiceAccountId = a.ServiceAccountID.String()
+	}
+
+	if a.OrgID != uuid.Nil {
+		b.Scope = a.OrgID.String()
+	}
+
+	return b.Build()
+}
+
+func toCondition(c core.Condition) *guardianv1.Condition {
+	return guardianv1.Condition_builder{
+		Name:        c.Name,
+		Expression:  c.Expression,
+		Description: c.Description,
+		CreatedAt:   timestamppb.New(c.CreatedAt),
+		UpdatedAt:   timestamppb.New(c.UpdatedAt),
+	}.Build()
+}
+
+func toObjectRef(o core.ObjectRef) *guardianv1.ObjectRef {
+	return guardianv1.ObjectRef_builder{Namespace: o.Namespace, Id: o.ID}.Build()
+}
+
+func fromObjectRef(o *guardianv1.ObjectRef) core.ObjectRef {
+	return core.ObjectRef{Namespace: o.GetNamespace(), ID: o.GetId()}
+}
+
+func toSubjectRef(s core.SubjectRef) *guardianv1.SubjectRef {
+	return guardianv1.SubjectRef_builder{Namespace: s.Namespace, Id: s.ID, Relation: s.Relation}.Build()
+}
+
+func fromSubjectRef(s *guardianv1.SubjectRef) core.SubjectRef {
+	return core.SubjectRef{Namespace: s.GetNamespace(), ID: s.GetId(), Relation: s.GetRelation()}
+}
+
+func toRelationTuple(t core.RelationTuple) *guardianv1.RelationTuple {
+	return guardianv1.RelationTuple_builder{
+		Object:    toObjectRef(t.Object),
+		Relation:  t.Relation,
+		Subject:   toSubjectRef(t.Subject),
+		Condition: t.Condition,
+	}.Build()
+}
+
+func fromRelationTuples(tuples []*guardianv1.RelationTuple) []core.RelationTuple {
+	res := make([]core.RelationTuple, 0, len(tuples))
+	for _, t := range tuples {
+		res = append(res, core.RelationTuple{
+			Object:    fromObjectRef(t.GetObject()),
+			Relation:  t.GetRelation(),
+			Subject:   fromSubjectRef(t.GetSubject()),
+			Condition: t.GetCondition(),
+		})
+	}
+
+	return res
+}
+
+var usersetOperations = map[core.UsersetOperation]guardianv1.UsersetOperation{
+	core.UsersetLeaf:         guardianv1.UsersetOperation_USERSET_OPERATION_LEAF,
+	core.UsersetUnion:        guardianv1.UsersetOperation_USERSET_OPERATION_UNION,
+	core.UsersetIntersection: guardianv1.UsersetOperation_USERSET_OPERATION_INTERSECTION,
+	core.UsersetExclusion:    guardianv1.UsersetOperation_USERSET_OPERATION_EXCLUSION,
+}
+
+func toUsersetTree(t core.UsersetTree) *guardianv1.UsersetTree {
+	subjects := make([]*guardianv1.SubjectRef, 0, len(t.Subjects))
+	for _, s := range t.Subjects {
+		subjects = append(subjects, toSubjectRef(s))
+	}
+
+	children := make([]*guardianv1.UsersetTree, 0, len(t.Children))
+	for _, c := range t.Children {
+		children = append(children, toUsersetTree(c))
+	}
+
+	return guardianv1.UsersetTree_builder{
+		Operation: usersetOperations[t.Operation],
+		Object:    toObjectRef(t.Object),
+		Relation:  t.Relation,
+		Subjects:  subjects,
+		Children:  children,
+	}.Build()
+}
+
+var explainKinds = map[core.ExplainKind]guardianv1.ExplainNodeKind{
+	core.ExplainPermission:   guardianv1.ExplainNodeKind_EXPLAIN_NODE_KIND_PERMISSION,
+	core.ExplainAssignment:   guardianv1.ExplainNodeKind_EXPLAIN_NODE_KIND_ASSIGNMENT,
+	core.ExplainRole:         guardianv1.ExplainNodeKind_EXPLAIN_NODE_KIND_ROLE,
+	core.ExplainRelation:     guardianv1.ExplainNodeKind_EXPLAIN_NODE_KIND_RELATION,
+	core.ExplainUnion:        guardianv1.ExplainNodeKind_EXPLAIN_NODE_KIND_UNION,
+	core.ExplainIntersection: guardianv1.ExplainNodeKind_EXPLAIN_NODE_KIND_INTERSECTION,
+	core.ExplainExclusion:    guardianv1.ExplainNodeKind_EXPLAIN_NODE_KIND_EXCLUSION,
+	core.ExplainTuple:        guardianv1.ExplainNodeKind_EXPLAIN_NODE_KIND_TUPLE,
+	core.ExplainCondition:    guardianv1.ExplainNodeKind_EXPLAIN_NODE_KIND_CONDITION,
+}
+
+func toExplainNode(n core.ExplainNode) *guardianv1.ExplainNode {
+	children := make([]*guardianv1.ExplainNode, 0, len(n.Children))
+	for _, c := range n.Children {
+		children = append(children, toExplainNode(c))
+	}
+
+	return guardianv1.ExplainNode_builder{
+		Kind:     explainKinds[n.Kind],
+		Name:     n.Name,
+		Allowed:  n.Allowed,
+		Detail:   n.Detail,
+		Children: children,
+	}.Build()
+}
+
+func toOrganization(o core.Organization) *guardianv1.Organization {
+	return guardianv1.Organization_builder{
+		Id:        o.ID.String(),
+		Slug:      o.Slug,
+		Name:      o.Name,
+		CreatedAt: timestamppb.New(o.CreatedAt),
+		UpdatedAt: timestamppb.New(o.UpdatedAt),
+	}.Build()
+}
+
+var organizationRoles = map[core.OrganizationRole]guardianv1.OrganizationRole{
+	core.OrganizationRoleOwner:  guardianv1.OrganizationRole_ORGANIZATION_ROLE_OWNER,
+	core.OrganizationRoleAdmin:  guardianv1.OrganizationRole_ORGANIZATION_ROLE_ADMIN,
+	core.OrganizationRoleMember: guardianv1.OrganizationRole_ORGANIZATION_ROLE_MEMBER,
+}
+
+// fromOrganizationRole returns an empty role for [guardianv1.OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED] and
+// unknown values.
+func fromOrganizationRole(r guardianv1.OrganizationRole) core.OrganizationRole {
+	for k, v := range organizationRoles {
+		if v == r {
+			return k
+		}
+	}
+
+	return ""
+}
+
+func toOrganizationMembership(m core.OrganizationMembership) *guardianv1.OrganizationMembership {
+	return guardianv1.OrganizationMembership_builder{
+		OrgId:     m.OrgID.String(),
+		UserId:    m.UserID.String(),
+		Role:      organizationRoles[m.Role],
+		CreatedAt: timestamppb.New(m.CreatedAt),
+		UpdatedAt: timestamppb.New(m.UpdatedAt),
+	}.Build()
+}
+
+func toOrganizationInvitation(i core.OrganizationInvitation) *guardianv1.OrganizationInvitation {
+	b := guardianv1.OrganizationInvitation_builder{
+		Id:        i.ID.String(),
+		OrgId:     i.OrgID.String(),
+		Email:     i.Email,
+		Role:      organizationRoles[i.Role],
+		CreatedAt: timestamppb.New(i.CreatedAt),
+		ExpiresAt: timestamppb.New(i.ExpiresAt),
+	}
+
+	if i.InviterID != uuid.Nil {
+		b.InviterId = i.InviterID.String()
+	}
+
+	return b.Build()
+}
+
+var apiKeyOwnerTypes = map[core.APIKeyOwnerType]guardianv1.APIKeyOwnerType{
+	core.APIKeyOwnerUser:           guardianv1.APIKeyOwnerType_API_KEY_OWNER_TYPE_USER,
+	core.APIKeyOwnerOrganization:   guardianv1.APIKeyOwnerType_API_KEY_OWNER_TYPE_ORGANIZATION,
+	core.APIKeyOwnerServiceAccount: guardianv1.APIKeyOwnerType_API_KEY_OWNER_TYPE_SERVICE_ACCOUNT,
+}
+
+// fromAPIKeyOwnerType returns [core.APIKeyOwnerUser] for [guardianv1.APIKeyOwnerType_API_KEY_OWNER_TYPE_UNSPECIFIED]
+// and an empty type for unknown values.
+func fromAPIKeyOwnerType(t guardianv1.APIKeyOwnerType) core.APIKeyOwnerType {
+	if t == guardianv1.APIKeyOwnerType_API_KEY_OWNER_TYPE_UNSPECIFIED {
+		return core.APIKeyOwnerUser
+	}
+
+	for k, v := range apiKeyOwnerTypes {
+		if v == t {
+			return k
+		}
+	}
+
+	return ""
+}
+
+func toAPIKey(k core.APIKey) *guardianv1.APIKey {
+	b := guardianv1.APIKey_builder{
+		Id:        k.ID.String(),
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		OwnerType: apiKeyOwnerTypes[k.Owner.Type],
+		OwnerId:   k.Owner.ID.String(),
+		Scopes:    k.Scopes,
+		CreatedAt: timestamppb.New(k.CreatedAt),
+	}
+
+	if k.ExpiresAt != nil {
+		b.ExpiresAt = timestamppb.New(*k.ExpiresAt)
+	}
+
+	if k.LastUsedAt != nil {
+		b.LastUsedAt = timestamppb.New(*k.LastUsedAt)
+	}
+
+	if k.RotatedAt != nil {
+		b.RotatedAt = timestamppb.New(*k.RotatedAt)
+	}
+
+	if k.PreviousExpiresAt != nil {
+		b.PreviousExpiresAt = timestamppb.New(*k.PreviousExpiresAt)
+	}
+
+	return b.Build()
+}
+
+func toServiceAccount(a core.ServiceAccount) *guardianv1.ServiceAccount {
+	b := guardianv1.ServiceAccount_builder{
+		Id:          a.ID.String(),
+		Name:        a.Name,
+		Description: a.Description,
+		Scopes:      a.Scopes,
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+		UpdatedAt:   timestamppb.New(a.UpdatedAt),
+	}
+
+	if a.RotatedAt != nil {
+		b.RotatedAt = timestamppb.New(*a.RotatedAt)
+	}
+
+	if a.PreviousExpiresAt != nil {
+		b.PreviousExpiresAt = timestamppb.New(*a.PreviousExpiresAt)
+	}
+
+	return b.Build()
+}
+
+func toServiceAccountKey(k core.ServiceAccountKey) *guardianv1.ServiceAccountKey {
+	return guardianv1.ServiceAccountKey_builder{
+		Id:               k.ID.String(),
+		ServiceAccountId: k.ServiceAccountID.String(),
+		KeyId:            k.KeyID,
+		Algorithm:        k.Algorithm,
+		CreatedAt:        timestamppb.New(k.CreatedAt),
+	}.Build()
+}
+
+var oauthClientTypes = map[core.OAuthClientType]guardianv1.OAuthClientType{
+	core.OAuthClientPublic:       guardianv1.OAuthClientType_O_AUTH_CLIENT_TYPE_PUBLIC,
+	core.OAuthClientConfidential: guardianv1.OAuthClientType_O_AUTH_CLIENT_TYPE_CONFIDENTIAL,
+}
+
+// fromOAuthClientType returns an empty type for [guardianv1.OAuthClientType_O_AUTH_CLIENT_TYPE_UNSPECIFIED] and
+// unknown values.
+func fromOAuthClientType(t guardianv1.OAuthClientType) core.OAuthClientType {
+	for k, v := range oauthClientTypes {
+		if v == t {
+			return k
+		}
+	}
+
+	return ""
+}
+
+func toOAuthClient(c core.OAuthClient) *guardianv1.OAuthClient {
+	return guardianv1.OAuthClient_builder{
+		Id:           c.ID.String(),
+		Name:         c.Name,
+		Type:         oauthClientTypes[c.Type],
+		RedirectUris: c.RedirectURIs,
+		GrantTypes:   c.GrantTypes,
+		Scopes:       c.Scopes,
+		Audiences:    c.Audiences,
+		CreatedAt:    timestamppb.New(c.CreatedAt),
+		UpdatedAt:    timestamppb.New(c.UpdatedAt),
+	}.Build()
+}
+
+func toPendingAuthorization(r core.OAuthAuthorizationRequest, c core.OAuthClient) *guardianv1.PendingAuthorization {
+	b := guardianv1.PendingAuthorization_builder{
+		Id:          r.ID.String(),
+		ClientId:    c.ID.String(),
+		ClientName:  c.Name,
+		RedirectUri: r.RedirectURI,
+		Scopes:      r.Scope,
+		ExpiresAt:   timestamppb.New(r.ExpiresAt),
+		Prompt:      r.Prompt,
+	}
+	if r.MaxAge != nil {
+		b.MaxAge = durationpb.New(*r.MaxAge)
+	}
+
+	return b.Build()
+}
+
+func toPendingDeviceAuthorization(d core.OAuthDeviceAuthorization, c core.OAuthClient) *guardianv1.PendingDeviceAuthorization {
+	return guardianv1.PendingDeviceAuthorization_builder{
+		UserCode:   oauth.FormatUserCode(d.UserCode),
+		ClientId:   c.ID.String(),
+		ClientName: c.Name,
+		Scopes:     d.Scope,
+		ExpiresAt:  timestamppb.New(d.ExpiresAt),
+	}.Build()
+}
+
+func toUserProfile(p core.UserProfile) *guardianv1.UserProfile {
+	b := guardianv1.UserProfile_builder{
+		Name:        p.Name,
+		GivenName:   p.GivenName,
+		FamilyName:  p.FamilyName,
+		Nickname:    p.Nickname,
+		Picture:     p.Picture,
+		Website:     p.Website,
+		Birthdate:   p.Birthdate,
+		Zoneinfo:    p.Zoneinfo,
+		Locale:      p.Locale,
+		PhoneNumber: p.PhoneNumber,
+		Address: guardianv1.Address_builder{
+			StreetAddress: p.Address.StreetAddress,
+			Locality:      p.Address.Locality,
+			Region:        p.Address.Region,
+			PostalCode:    p.Address.PostalCode,
+			Country:       p.Address.Country,
+		}.Build(),
+	}
+
+	if !p.UpdatedAt.IsZero() {
+		b.UpdatedAt = timestamppb.New(p.UpdatedAt)
+	}
+
+	return b.Build()
+}
+
+// fromUserProfile leaves [core.UserProfile.UserID] unset.
+func fromUserProfile(p *guardianv1.UserProfile) core.UserProfile {
+	a := p.GetAddress()
+
+	return core.UserProfile{
+		Name:        p.GetName(),
+		GivenName:   p.GetGivenName(),
+		FamilyName:  p.GetFamilyName(),
+		Nickname:    p.GetNickname(),
+		Picture:     p.GetPicture(),
+		Website:     p.GetWebsite(),
+		Birthdate:   p.GetBirthdate(),
+		Zoneinfo:    p.GetZoneinfo(),
+		Locale:      p.GetLocale(),
+		PhoneNumber: p.GetPhoneNumber(),
+		Address: core.UserAddress{
+			StreetAddress: a.GetStreetAddress(),
+			Locality:      a.GetLocality(),
+			Region:        a.GetRegion(),
+			PostalCode:    a.GetPostalCode(),
+			Country:       a.GetCountry(),
+		},
+	}
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/rs/zerolog"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+)
+
+// toConnectError maps errors of core stores to [connect.Error]s. Unexpected errors are logged and hidden from clients.
+func toConnectError(ctx context.Context, err error) error {
+	var (
+		connectErr *connect.Error
+		policyErr  *core.PasswordPolicyError
+	)
+
+	switch {
+	case errors.As(err, &connectErr):
+		return err
+	case errors.As(err, &policyErr):
+		return passwordPolicyError(policyErr)
+	case errors.Is(err, core.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, core.ErrAlreadyExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, core.ErrInvalidArgument):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, core.ErrInvalidCredentials), errors.Is(err, core.ErrInvalidToken):
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	case errors.Is(err, core.ErrRateLimited):
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	case errors.Is(err, context.Canceled):
+		return connect.NewError(connect.CodeCanceled, err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.NewError(connect.CodeDeadlineExceeded, err)
+	default:
+		zerolog.Ctx(ctx).Err(err).Msg("internal error")
+		return connect.NewError(connect.CodeInternal, errors.New("api: internal error"))
+	}
+}
+
+// passwordPolicyError attaches the violations of err as [guardianv1.PasswordPolicyError] detail.
+func passwordPolicyError(err *core.PasswordPolicyError) error {
+	violations := make([]*guardianv1.PasswordPolicyError_Violation, 0, len(err.Violations))
+	for _, v := range err.Violations {
+		violations = append(violations, guardianv1.PasswordPolicyError_Violation_builder{
+			Code:    string(v.Code),
+			Message: v.Message,
+		}.Build())
+	}
+
+	connectErr := connect.NewError(connect.CodeInvalidArgument, err)
+
+	detail, detailErr := connect.NewErrorDetail(guardianv1.PasswordPolicyError_builder{Violations: violations}.Build())
+	if detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+
+	return connectErr
+}
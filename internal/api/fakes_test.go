@@ -0,0 +1,2785 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/abac"
+	"github.com/gophero/guardian/internal/mail"
+	"github.com/gophero/guardian/internal/oauth"
+	"github.com/gophero/guardian/internal/token"
+)
+
+// fakeStores is an in-memory implementation of the stores used by the API services.
+type fakeStores struct {
+	mu        sync.Mutex
+	users     map[uuid.UUID]core.User
+	profiles  map[uuid.UUID]core.UserProfile
+	passwords map[uuid.UUID]string
+	sessions  map[uuid.UUID]core.Session
+	refresh   map[string]*fakeRefreshToken
+	access    map[string]core.AccessTokenClaims
+	totp      map[uuid.UUID]*fakeTOTP
+	recovery  map[uuid.UUID]map[string]bool // Code to whether it was used.
+	mfa       map[string]*fakeChallenge
+	passkeys  map[uuid.UUID]core.Passkey
+	ceremony  map[uuid.UUID]fakeCeremony
+	email     map[string]*fakePasswordless // Token to challenge.
+	verify    map[string]*fakeVerification // Token to verification.
+	resets    map[string]*fakeReset        // Token to reset.
+	perms     map[string]core.Permission
+	roles     map[uuid.UUID]*core.Role
+	assigned  []core.RoleAssignment
+	conds     map[string]core.Condition
+	engine    *abac.Engine
+	schema    string
+	tuples    []core.RelationTuple
+	revision  int64
+	orgs      map[uuid.UUID]core.Organization
+	members   map[uuid.UUID]map[uuid.UUID]core.OrganizationMembership // Organization to user to membership.
+	invites   map[string]core.OrganizationInvitation                  // Token to invitation.
+	apiKeys   map[uuid.UUID]*fakeAPIKey
+	accounts  map[uuid.UUID]*fakeServiceAccount
+	saKeys    map[uuid.UUID]core.ServiceAccountKey
+	clients   map[uuid.UUID]core.OAuthClient
+	requests  map[uuid.UUID]core.OAuthAuthorizationRequest
+	codes     map[string]core.OAuthAuthorizationCode   // Code to authorization code.
+	devices   map[string]core.OAuthDeviceAuthorization // User code to authorization.
+	outbox    []core.Email
+	audit     []core.AuditEvent
+	decisions []core.DecisionRecord
+}
+
+// fakeTOTP accepts the code of its current step only once.
+type fakeTOTP struct {
+	confirmed bool
+	code      string
+	used      map[string]bool
+}
+
+type fakeChallenge struct {
+	core.MFAChallenge
+	completed bool
+}
+
+// fakeCeremony is a pending passkey ceremony. userID is [uuid.Nil] for discoverable sign ins.
+type fakeCeremony struct {
+	userID       uuid.UUID
+	registration bool
+}
+
+// fakePasswordless is a pending passwordless challenge. code is empty for magic links.
+type fakePasswordless struct {
+	core.PasswordlessChallenge
+	code      string
+	completed bool
+}
+
+// fakeVerification is a pending email verification.
+type fakeVerification struct {
+	core.EmailVerification
+	completed bool
+}
+
+// fakeReset is a pending password reset.
+type fakeReset struct {
+	core.PasswordReset
+	used bool
+}
+
+// fakeAPIKey is an API key with its tokens. previous is empty unless it is valid during a rotation overlap.
+type fakeAPIKey struct {
+	core.APIKey
+	token    string
+	previous string
+}
+
+// fakeServiceAccount is a service account with its secrets. previous is empty unless it is valid during a rotation
+// overlap.
+type fakeServiceAccount struct {
+	core.ServiceAccount
+	secret   string
+	previous string
+}
+
+type fakeRefreshToken struct {
+	core.RefreshToken
+	used    bool
+	revoked *bool
+}
+
+func newFakeStores() *fakeStores {
+	engine, err := abac.NewEngine(abac.Config{CostLimit: 10000, MaxExpressionLength: 4096, CacheSize: 100})
+	if err != nil {
+		panic(err)
+	}
+
+	return &fakeStores{
+		users:     map[uuid.UUID]core.User{},
+		profiles:  map[uuid.UUID]core.UserProfile{},
+		passwords: map[uuid.UUID]string{},
+		sessions:  map[uuid.UUID]core.Session{},
+		refresh:   map[string]*fakeRefreshToken{},
+		access:    map[string]core.AccessTokenClaims{},
+		totp:      map[uuid.UUID]*fakeTOTP{},
+		recovery:  map[uuid.UUID]map[string]bool{},
+		mfa:       map[string]*fakeChallenge{},
+		passkeys:  map[uuid.UUID]core.Passkey{},
+		ceremony:  map[uuid.UUID]fakeCeremony{},
+		email:     map[string]*fakePasswordless{},
+		verify:    map[string]*fakeVerification{},
+		resets:    map[string]*fakeReset{},
+		perms:     map[string]core.Permission{},
+		roles:     map[uuid.UUID]*core.Role{},
+		conds:     map[string]core.Condition{},
+		engine:    engine,
+		orgs:      map[uuid.UUID]core.Organization{},
+		members:   map[uuid.UUID]map[uuid.UUID]core.OrganizationMembership{},
+		invites:   map[string]core.OrganizationInvitation{},
+		apiKeys:   map[uuid.UUID]*fakeAPIKey{},
+		accounts:  map[uuid.UUID]*fakeServiceAccount{},
+		saKeys:    map[uuid.UUID]core.ServiceAccountKey{},
+		clients:   map[uuid.UUID]core.OAuthClient{},
+		requests:  map[uuid.UUID]core.OAuthAuthorizationRequest{},
+		codes:     map[string]core.OAuthAuthorizationCode{},
+		devices:   map[string]core.OAuthDeviceAuthorization{},
+	}
+}
+
+// holds evaluates the condition of a grant like the stores do. The lock must be held.
+func (f *fakeStores) holds(ctx context.Context, condition string, attrs core.AttributeContext) bool {
+	return condition == "" || f.engine.Holds(ctx, condition, f.conds[condition].Expression, attrs)
+}
+
+type fakeUserStore struct{ *fakeStores }
+
+func (f fakeUserStore) Create(_ context.Context, params core.CreateUserParams) (core.User, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, u := range f.users {
+		if strings.EqualFold(u.Email, params.Email) || strings.EqualFold(u.Username, params.Username) {
+			return core.User{}, core.ErrAlreadyExists
+		}
+	}
+
+	u := core.User{ID: uuid.New(), Email: params.Email, Username: params.Username, Status: core.UserStatusActive}
+	f.users[u.ID] = u
+
+	return u, nil
+}
+
+func (f fakeUserStore) Get(_ context.Context, id uuid.UUID) (core.User, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	u, ok := f.users[id]
+	if !ok {
+		return core.User{}, core.ErrNotFound
+	}
+
+	return u, nil
+}
+
+func (f fakeUserStore) find(match func(core.User) bool) (core.User, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, u := range f.users {
+		if match(u) {
+			return u, nil
+		}
+	}
+
+	return core.User{}, core.ErrNotFound
+}
+
+func (f fakeUserStore) GetByEmail(_ context.Context, email string) (core.User, error) {
+	return f.find(func(u core.User) bool { return strings.EqualFold(u.Email, email) })
+}
+
+func (f fakeUserStore) GetByUsername(_ context.Context, username string) (core.User, error) {
+	return f.find(func(u core.User) bool { return strings.EqualFold(u.Username, username) })
+}
+
+func (f fakeUserStore) Update(_ context.Context, id uuid.UUID, params core.UpdateUserParams) (core.User, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	u, ok := f.users[id]
+	if !ok {
+		return core.User{}, core.ErrNotFound
+	}
+
+	if params.Email != nil {
+		if !strings.EqualFold(u.Email, *params.Email) {
+			u.EmailVerifiedAt = nil
+		}
+		u.Email = *params.Email
+	}
+	if params.Username != nil {
+		u.Username = *params.Username
+	}
+	if params.Status != nil {
+		u.Status = *params.Status
+	}
+	f.users[id] = u
+
+	return u, nil
+}
+
+func (f fakeUserStore) MarkEmailVerified(_ context.Context, id uuid.UUID, email string) (core.User, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	u, ok := f.users[id]
+	if !ok || !strings.EqualFold(u.Email, email) {
+		return core.User{}, core.ErrNotFound
+	}
+
+	if u.EmailVerifiedAt == nil {
+		now := time.Now()
+		u.EmailVerifiedAt = &now
+	}
+	f.users[id] = u
+
+	return u, nil
+}
+
+func (f fakeUserStore) ChangeEmail(_ context.Context, id uuid.UUID, email string) (core.User, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	u, ok := f.users[id]
+	if !ok {
+		return core.User{}, core.ErrNotFound
+	}
+
+	for _, other := range f.users {
+		if other.ID != id && strings.EqualFold(other.Email, email) {
+			return core.User{}, core.ErrAlreadyExists
+		}
+	}
+
+	now := time.Now()
+	u.Email, u.EmailVerifiedAt = email, &now
+	f.users[id] = u
+
+	return u, nil
+}
+
+func (f fakeUserStore) Delete(_ context.Context, id uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.users[id]; !ok {
+		return core.ErrNotFound
+	}
+	delete(f.users, id)
+
+	return nil
+}
+
+func (f fakeUserStore) List(context.Context, core.ListUsersParams) (core.UserPage, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var page core.UserPage
+	for _, u := range f.users {
+		page.Users = append(page.Users, u)
+	}
+
+	return page, nil
+}
+
+func (f fakeUserStore) Search(context.Context, core.SearchUsersParams) (core.UserPage, error) {
+	return core.UserPage{}, nil
+}
+
+type fakeUserProfileStore struct{ *fakeStores }
+
+func (f fakeUserProfileStore) Get(_ context.Context, userID uuid.UUID) (core.UserProfile, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	p, ok := f.profiles[userID]
+	if !ok {
+		return core.UserProfile{UserID: userID}, nil
+	}
+
+	return p, nil
+}
+
+func (f fakeUserProfileStore) Update(_ context.Context, profile core.UserProfile) (core.UserProfile, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.users[profile.UserID]; !ok {
+		return core.UserProfile{}, core.ErrNotFound
+	}
+
+	if profile.PhoneNumber != "" && !strings.HasPrefix(profile.PhoneNumber, "+") {
+		return core.UserProfile{}, fmt.Errorf("fake: invalid phone number: %w", core.ErrInvalidArgument)
+	}
+
+	profile.UpdatedAt = time.Now()
+	f.profiles[profile.UserID] = profile
+
+	return profile, nil
+}
+
+// fakePasswordStore validates passwords against [fakePolicy] and a password history of the current password.
+type fakePasswordStore struct{ *fakeStores }
+
+func (f fakePasswordStore) Set(ctx context.Context, userID uuid.UUID, password string) error {
+	if err := f.Check(ctx, userID, password); err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.passwords[userID] = password
+	return nil
+}
+
+func (f fakePasswordStore) Check(ctx context.Context, userID uuid.UUID, password string) error {
+	if err := (fakePolicy{}).Validate(ctx, password, core.User{}); err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.passwords[userID] == password {
+		return &core.PasswordPolicyError{Violations: []core.PasswordViolation{{
+			Code:    core.PasswordReused,
+			Message: "reused",
+		}}}
+	}
+
+	return nil
+}
+
+func (f fakePasswordStore) Verify(_ context.Context, userID uuid.UUID, password string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if p, ok := f.passwords[userID]; !ok || p != password {
+		return core.ErrInvalidCredentials
+	}
+
+	return nil
+}
+
+func (f fakePasswordStore) Delete(_ context.Context, userID uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.passwords, userID)
+	return nil
+}
+
+// fakePolicy rejects passwords shorter than 8 characters.
+type fakePolicy struct{}
+
+func (fakePolicy) Validate(_ context.Context, password string, _ core.User) error {
+	if len(password) < 8 {
+		return &core.PasswordPolicyError{Violations: []core.PasswordViolation{{
+			Code:    core.PasswordTooShort,
+			Message: "too short",
+		}}}
+	}
+
+	return nil
+}
+
+type fakeSessionStore struct{ *fakeStores }
+
+func (f fakeSessionStore) Create(_ context.Context, userID uuid.UUID, meta core.SessionMetadata) (core.Session, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+	sess := core.Session{
+		ID:            uuid.New(),
+		UserID:        userID,
+		UserAgent:     meta.UserAgent,
+		IPAddress:     meta.IPAddress,
+		Device:        meta.Device,
+		CreatedAt:     now,
+		LastSeenAt:    now,
+		IdleExpiresAt: now.Add(time.Hour),
+		ExpiresAt:     now.Add(time.Hour),
+		AuthMethods:   meta.AuthMethods,
+	}
+	f.sessions[sess.ID] = sess
+
+	return sess, uuid.NewString(), nil
+}
+
+func (f fakeSessionStore) Authenticate(context.Context, string) (core.Session, error) {
+	return core.Session{}, core.ErrInvalidCredentials
+}
+
+func (f fakeSessionStore) Get(_ context.Context, id uuid.UUID) (core.Session, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	sess, ok := f.sessions[id]
+	if !ok {
+		return core.Session{}, core.ErrNotFound
+	}
+
+	return sess, nil
+}
+
+func (f fakeSessionStore) List(_ context.Context, userID uuid.UUID) ([]core.Session, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var out []core.Session
+	for _, sess := range f.sessions {
+		if sess.UserID == userID && sess.RevokedAt == nil {
+			out = append(out, sess)
+		}
+	}
+
+	return out, nil
+}
+
+func (f fakeSessionStore) Revoke(_ context.Context, id uuid.UUID, reason core.SessionRevokeReason) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	sess, ok := f.sessions[id]
+	if !ok || sess.RevokedAt != nil {
+		return core.ErrNotFound
+	}
+
+	now := time.Now()
+	sess.RevokedAt, sess.RevokedReason = &now, reason
+	f.sessions[id] = sess
+
+	return nil
+}
+
+func (f fakeSessionStore) RevokeAll(_ context.Context, userID uuid.UUID, except uuid.UUID, reason core.SessionRevokeReason) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var n int64
+	now := time.Now()
+	for id, sess := range f.sessions {
+		if sess.UserID == userID && id != except && sess.RevokedAt == nil {
+			sess.RevokedAt, sess.RevokedReason = &now, reason
+			f.sessions[id] = sess
+			n++
+		}
+	}
+
+	return n, nil
+}
+
+type fakeRefreshTokenStore struct{ *fakeStores }
+
+func (f fakeRefreshTokenStore) Issue(_ context.Context, params core.IssueRefreshTokenParams) (core.RefreshToken, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	rt := core.RefreshToken{
+		ID:        uuid.New(),
+		FamilyID:  uuid.New(),
+		UserID:    params.UserID,
+		SessionID: params.SessionID,
+		ClientID:  params.ClientID,
+		Scope:     params.Scope,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	token := uuid.NewString()
+	f.refresh[token] = &fakeRefreshToken{RefreshToken: rt, revoked: new(bool)}
+
+	return rt, token, nil
+}
+
+func (f fakeRefreshTokenStore) Rotate(_ context.Context, token string, _ core.SessionMetadata) (core.RefreshToken, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	rt, ok := f.refresh[token]
+	if !ok || *rt.revoked {
+		return core.RefreshToken{}, "", core.ErrInvalidToken
+	}
+
+	if rt.used {
+		*rt.revoked = true
+		return core.RefreshToken{}, "", core.ErrInvalidToken
+	}
+
+	rt.used = true
+	next := &fakeRefreshToken{RefreshToken: rt.RefreshToken, revoked: rt.revoked}
+	next.ID = uuid.New()
+	nextToken := uuid.NewString()
+	f.refresh[nextToken] = next
+
+	return next.RefreshToken, nextToken, nil
+}
+
+func (f fakeRefreshTokenStore) Get(_ context.Context, token string) (core.RefreshToken, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	rt, ok := f.refresh[token]
+	if !ok || rt.used || *rt.revoked {
+		return core.RefreshToken{}, core.ErrInvalidToken
+	}
+
+	return rt.RefreshToken, nil
+}
+
+func (f fakeRefreshTokenStore) FamilyActive(_ context.Context, familyID uuid.UUID) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, rt := range f.refresh {
+		if rt.FamilyID == familyID {
+			return !*rt.revoked, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (f fakeRefreshTokenStore) revokeWhere(match func(*fakeRefreshToken) bool) int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var n int64
+	for _, rt := range f.refresh {
+		if match(rt) && !*rt.revoked {
+			*rt.revoked = true
+			n++
+		}
+	}
+
+	return n
+}
+
+func (f fakeRefreshTokenStore) RevokeFamily(_ context.Context, familyID uuid.UUID, _ core.RefreshTokenRevokeReason) error {
+	f.revokeWhere(func(rt *fakeRefreshToken) bool { return rt.FamilyID == familyID })
+	return nil
+}
+
+func (f fakeRefreshTokenStore) RevokeSession(_ context.Context, sessionID uuid.UUID, _ core.RefreshTokenRevokeReason) (int64, error) {
+	return f.revokeWhere(func(rt *fakeRefreshToken) bool { return rt.SessionID == sessionID }), nil
+}
+
+func (f fakeRefreshTokenStore) RevokeUser(_ context.Context, userID uuid.UUID, _ core.RefreshTokenRevokeReason) (int64, error) {
+	return f.revokeWhere(func(rt *fakeRefreshToken) bool { return rt.UserID == userID }), nil
+}
+
+type fakeAccessTokenIssuer struct{ *fakeStores }
+
+func (f fakeAccessTokenIssuer) Issue(_ context.Context, params core.AccessTokenParams) (string, core.AccessTokenClaims, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+	claims := core.AccessTokenClaims{
+		ID:        uuid.NewString(),
+		Subject:   params.Subject,
+		SessionID: params.SessionID,
+		ClientID:  params.ClientID,
+		Scope:     params.Scope,
+		Audience:  params.Audience,
+		IssuedAt:  now,
+		NotBefore: now,
+		ExpiresAt: now.Add(time.Minute),
+	}
+	f.access[claims.ID] = claims
+
+	return claims.ID, claims, nil
+}
+
+func (f fakeAccessTokenIssuer) Verify(_ context.Context, token string) (core.AccessTokenClaims, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	claims, ok := f.access[token]
+	if !ok {
+		return core.AccessTokenClaims{}, core.ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+type fakeTOTPStore struct{ *fakeStores }
+
+// currentCode returns the code the authenticator of the user would show now.
+func (f fakeTOTPStore) currentCode(userID uuid.UUID) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.totp[userID].code
+}
+
+// nextStep simulates time passing by changing the current code of the user.
+func (f fakeTOTPStore) nextStep(userID uuid.UUID) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.totp[userID].code = fmt.Sprintf("%06d", len(f.totp[userID].used))
+}
+
+func (f fakeTOTPStore) Enroll(_ context.Context, userID uuid.UUID, account string) (core.TOTPEnrollment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if t, ok := f.totp[userID]; ok && t.confirmed {
+		return core.TOTPEnrollment{}, core.ErrAlreadyExists
+	}
+
+	f.totp[userID] = &fakeTOTP{code: "123456", used: map[string]bool{}}
+
+	return core.TOTPEnrollment{Secret: "SECRET", URI: "otpauth://totp/Guardian:" + account, QRCode: []byte("png")}, nil
+}
+
+func (f fakeTOTPStore) match(userID uuid.UUID, code string) bool {
+	t := f.totp[userID]
+	if t.code != code || t.used[code] {
+		return false
+	}
+
+	t.used[code] = true
+	return true
+}
+
+func (f fakeTOTPStore) Confirm(_ context.Context, userID uuid.UUID, code string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	t, ok := f.totp[userID]
+	if !ok || t.confirmed {
+		return core.ErrNotFound
+	}
+
+	if !f.match(userID, code) {
+		return core.ErrInvalidCredentials
+	}
+
+	t.confirmed = true
+	return nil
+}
+
+func (f fakeTOTPStore) Verify(_ context.Context, userID uuid.UUID, code string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if t, ok := f.totp[userID]; !ok || !t.confirmed || !f.match(userID, code) {
+		return core.ErrInvalidCredentials
+	}
+
+	return nil
+}
+
+func (f fakeTOTPStore) Enabled(_ context.Context, userID uuid.UUID) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	t, ok := f.totp[userID]
+	return ok && t.confirmed, nil
+}
+
+func (f fakeTOTPStore) Delete(_ context.Context, userID uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.totp[userID]; !ok {
+		return core.ErrNotFound
+	}
+
+	delete(f.totp, userID)
+	return nil
+}
+
+type fakeRecoveryCodeStore struct{ *fakeStores }
+
+func (f fakeRecoveryCodeStore) Generate(_ context.Context, userID uuid.UUID) ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	codes := []string{uuid.NewString(), uuid.NewString(), uuid.NewString()}
+
+	f.recovery[userID] = map[string]bool{}
+	for _, c := range codes {
+		f.recovery[userID][c] = false
+	}
+
+	return codes, nil
+}
+
+func (f fakeRecoveryCodeStore) Use(_ context.Context, userID uuid.UUID, code string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	used, ok := f.recovery[userID][code]
+	if !ok || used {
+		return core.ErrInvalidCredentials
+	}
+
+	f.recovery[userID][code] = true
+	return nil
+}
+
+func (f fakeRecoveryCodeStore) Remaining(_ context.Context, userID uuid.UUID) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var n int
+	for _, used := range f.recovery[userID] {
+		if !used {
+			n++
+		}
+	}
+
+	return n, nil
+}
+
+func (f fakeRecoveryCodeStore) Delete(_ context.Context, userID uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.recovery, userID)
+	return nil
+}
+
+// fakeMFAChallengeStore allows 3 attempts per challenge.
+type fakeMFAChallengeStore struct{ *fakeStores }
+
+func (f fakeMFAChallengeStore) Create(_ context.Context, userID uuid.UUID, device string) (core.MFAChallenge, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+	c := core.MFAChallenge{ID: uuid.New(), UserID: userID, Device: device, CreatedAt: now, ExpiresAt: now.Add(time.Minute)}
+	token := uuid.NewString()
+	f.mfa[token] = &fakeChallenge{MFAChallenge: c}
+
+	return c, token, nil
+}
+
+func (f fakeMFAChallengeStore) Attempt(_ context.Context, token string) (core.MFAChallenge, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, ok := f.mfa[token]
+	if !ok || c.completed || c.Attempts >= 3 {
+		return core.MFAChallenge{}, core.ErrInvalidToken
+	}
+
+	c.Attempts++
+	return c.MFAChallenge, nil
+}
+
+func (f fakeMFAChallengeStore) find(id uuid.UUID) *fakeChallenge {
+	for _, c := range f.mfa {
+		if c.ID == id {
+			return c
+		}
+	}
+
+	return nil
+}
+
+func (f fakeMFAChallengeStore) Complete(_ context.Context, id uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c := f.find(id)
+	if c.completed {
+		return core.ErrInvalidToken
+	}
+
+	c.completed = true
+	return nil
+}
+
+// fakePasskeyStore treats the response of a ceremony as the credential ID of the passkey, "invalid" fails
+// verification.
+type fakePasskeyStore struct{ *fakeStores }
+
+func (f fakePasskeyStore) begin(c fakeCeremony) core.PasskeyCeremony {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	id := uuid.New()
+	f.ceremony[id] = c
+
+	return core.PasskeyCeremony{ID: id, Options: []byte(`{"publicKey":{}}`), ExpiresAt: time.Now().Add(time.Minute)}
+}
+
+func (f fakePasskeyStore) consume(id uuid.UUID, registration bool) (fakeCeremony, error) {
+	c, ok := f.ceremony[id]
+	if !ok || c.registration != registration {
+		return fakeCeremony{}, core.ErrInvalidToken
+	}
+
+	delete(f.ceremony, id)
+	return c, nil
+}
+
+func (f fakePasskeyStore) BeginRegistration(_ context.Context, user core.User) (core.PasskeyCeremony, error) {
+	return f.begin(fakeCeremony{userID: user.ID, registration: true}), nil
+}
+
+func (f fakePasskeyStore) FinishRegistration(_ context.Context, userID, ceremonyID uuid.UUID, response []byte, name string) (core.Passkey, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, err := f.consume(ceremonyID, true)
+	if err != nil || c.userID != userID {
+		return core.Passkey{}, core.ErrInvalidToken
+	}
+
+	if string(response) == "invalid" {
+		return core.Passkey{}, core.ErrInvalidCredentials
+	}
+
+	for _, p := range f.passkeys {
+		if string(p.CredentialID) == string(response) {
+			return core.Passkey{}, core.ErrAlreadyExists
+		}
+	}
+
+	p := core.Passkey{ID: uuid.New(), UserID: userID, CredentialID: response, Name: name, AttestationFormat: "none", CreatedAt: time.Now()}
+	f.passkeys[p.ID] = p
+
+	return p, nil
+}
+
+func (f fakePasskeyStore) BeginLogin(_ context.Context, userID uuid.UUID) (core.PasskeyCeremony, error) {
+	return f.begin(fakeCeremony{userID: userID}), nil
+}
+
+func (f fakePasskeyStore) FinishLogin(_ context.Context, ceremonyID uuid.UUID, response []byte) (core.Passkey, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, err := f.consume(ceremonyID, false)
+	if err != nil {
+		return core.Passkey{}, err
+	}
+
+	for id, p := range f.passkeys {
+		if string(p.CredentialID) == string(response) && (c.userID == uuid.Nil || c.userID == p.UserID) {
+			now := time.Now()
+			p.SignCount++
+			p.LastUsedAt = &now
+			f.passkeys[id] = p
+			return p, nil
+		}
+	}
+
+	return core.Passkey{}, core.ErrInvalidCredentials
+}
+
+func (f fakePasskeyStore) List(_ context.Context, userID uuid.UUID) ([]core.Passkey, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var res []core.Passkey
+	for _, p := range f.passkeys {
+		if p.UserID == userID {
+			res = append(res, p)
+		}
+	}
+
+	return res, nil
+}
+
+func (f fakePasskeyStore) Delete(_ context.Context, userID, id uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if p, ok := f.passkeys[id]; !ok || p.UserID != userID {
+		return core.ErrNotFound
+	}
+
+	delete(f.passkeys, id)
+	return nil
+}
+
+// fakePasswordlessStore allows 3 attempts per challenge and one challenge per user, method and minute. Magic links
+// point to https://example.com/magic-link.
+type fakePasswordlessStore struct{ *fakeStores }
+
+func (f fakePasswordlessStore) challenge(userID uuid.UUID, method core.PasswordlessMethod, device string) core.PasswordlessChallenge {
+	now := time.Now()
+
+	return core.PasswordlessChallenge{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Method:    method,
+		Device:    device,
+		CreatedAt: now,
+		ExpiresAt: now.Add(10 * time.Minute),
+	}
+}
+
+// create stores the challenge unless one of the same method was created for the user within the last minute.
+func (f fakePasswordlessStore) create(c core.PasswordlessChallenge, token, code string) error {
+	for _, other := range f.email {
+		if other.UserID == c.UserID && other.Method == c.Method && other.CreatedAt.After(time.Now().Add(-time.Minute)) {
+			return core.ErrRateLimited
+		}
+	}
+
+	f.email[token] = &fakePasswordless{PasswordlessChallenge: c, code: code}
+	return nil
+}
+
+func (f fakePasswordlessStore) CreateMagicLink(_ context.Context, userID uuid.UUID, device string) (core.PasswordlessChallenge, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, token := f.challenge(userID, core.PasswordlessMagicLink, device), uuid.NewString()
+	if err := f.create(c, token, ""); err != nil {
+		return core.PasswordlessChallenge{}, "", err
+	}
+
+	return c, "https://example.com/magic-link?token=" + token, nil
+}
+
+func (f fakePasswordlessStore) NewEmailOTP(userID uuid.UUID, device string) (core.PasswordlessChallenge, string, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.challenge(userID, core.PasswordlessEmailOTP, device), uuid.NewString(), fmt.Sprintf("%06d", len(f.email))
+}
+
+func (f fakePasswordlessStore) CreateEmailOTP(_ context.Context, c core.PasswordlessChallenge, token, code string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.create(c, token, code)
+}
+
+// expireThrottle moves the creation of all challenges back, so new ones are no longer throttled.
+func (f fakePasswordlessStore) expireThrottle() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, c := range f.email {
+		c.CreatedAt = c.CreatedAt.Add(-time.Hour)
+	}
+}
+
+func (f fakePasswordlessStore) verify(token string, method core.PasswordlessMethod, code string) (core.PasswordlessChallenge, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, ok := f.email[token]
+	if !ok || c.Method != method || c.completed || c.Attempts >= 3 {
+		return core.PasswordlessChallenge{}, core.ErrInvalidToken
+	}
+
+	c.Attempts++
+	if c.code != code {
+		return core.PasswordlessChallenge{}, core.ErrInvalidCredentials
+	}
+
+	c.completed = true
+	return c.PasswordlessChallenge, nil
+}
+
+func (f fakePasswordlessStore) VerifyMagicLink(_ context.Context, token string) (core.PasswordlessChallenge, error) {
+	return f.verify(token, core.PasswordlessMagicLink, "")
+}
+
+func (f fakePasswordlessStore) VerifyEmailOTP(_ context.Context, token, code string) (core.PasswordlessChallenge, error) {
+	return f.verify(token, core.PasswordlessEmailOTP, code)
+}
+
+// fakeEmailVerificationStore throttles verifications of the same purpose to one per minute. Links point to
+// https://example.com/verify-email.
+type fakeEmailVerificationStore struct{ *fakeStores }
+
+func (f fakeEmailVerificationStore) Create(_ context.Context, userID uuid.UUID, email string, purpose core.EmailVerificationPurpose) (core.EmailVerification, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+
+	for token, v := range f.verify {
+		if v.UserID != userID || v.Purpose != purpose {
+			continue
+		}
+
+		if v.CreatedAt.After(now.Add(-time.Minute)) {
+			return core.EmailVerification{}, "", core.ErrRateLimited
+		}
+
+		if !v.completed {
+			delete(f.verify, token)
+		}
+	}
+
+	v := core.EmailVerification{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Purpose:   purpose,
+		Email:     email,
+		CreatedAt: now,
+		ExpiresAt: now.Add(24 * time.Hour),
+	}
+	token := uuid.NewString()
+	f.verify[token] = &fakeVerification{EmailVerification: v}
+
+	return v, "https://example.com/verify-email?token=" + token, nil
+}
+
+func (f fakeEmailVerificationStore) Complete(_ context.Context, token string) (core.EmailVerification, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	v, ok := f.verify[token]
+	if !ok || v.completed {
+		return core.EmailVerification{}, core.ErrInvalidToken
+	}
+
+	v.completed = true
+	return v.EmailVerification, nil
+}
+
+// expireThrottle moves the creation of all verifications back, so new ones are no longer throttled.
+func (f fakeEmailVerificationStore) expireThrottle() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, v := range f.verify {
+		v.CreatedAt = v.CreatedAt.Add(-time.Hour)
+	}
+}
+
+// fakePasswordResetStore creates resets valid for 15 minutes, at most one per user and minute. Links point to
+// https://example.com/reset-password.
+type fakePasswordResetStore struct{ *fakeStores }
+
+func (f fakePasswordResetStore) Create(_ context.Context, userID uuid.UUID) (core.PasswordReset, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+
+	for token, r := range f.resets {
+		if r.UserID != userID {
+			continue
+		}
+
+		if r.CreatedAt.After(now.Add(-time.Minute)) {
+			return core.PasswordReset{}, "", core.ErrRateLimited
+		}
+
+		if !r.used {
+			delete(f.resets, token)
+		}
+	}
+
+	r := core.PasswordReset{ID: uuid.New(), UserID: userID, CreatedAt: now, ExpiresAt: now.Add(15 * time.Minute)}
+	token := uuid.NewString()
+	f.resets[token] = &fakeReset{PasswordReset: r}
+
+	return r, "https://example.com/reset-password?token=" + token, nil
+}
+
+func (f fakePasswordResetStore) Get(_ context.Context, token string) (core.PasswordReset, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	r, ok := f.resets[token]
+	if !ok || r.used || !r.ExpiresAt.After(time.Now()) {
+		return core.PasswordReset{}, core.ErrInvalidToken
+	}
+
+	return r.PasswordReset, nil
+}
+
+func (f fakePasswordResetStore) Complete(_ context.Context, id uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, r := range f.resets {
+		if r.ID == id && !r.used {
+			r.used = true
+			return nil
+		}
+	}
+
+	return core.ErrInvalidToken
+}
+
+// expire moves the expiry of all resets into the past.
+func (f fakePasswordResetStore) expire() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, r := range f.resets {
+		r.ExpiresAt = time.Now().Add(-time.Second)
+	}
+}
+
+// expireThrottle moves the creation of all resets back, so new ones are no longer throttled.
+func (f fakePasswordResetStore) expireThrottle() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, r := range f.resets {
+		r.CreatedAt = r.CreatedAt.Add(-time.Hour)
+	}
+}
+
+// fakeRBACStore resolves inherited permissions without caching.
+type fakeRBACStore struct{ *fakeStores }
+
+func (f fakeRBACStore) CreatePermission(_ context.Context, name, description string) (core.Permission, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.perms[name]; ok {
+		return core.Permission{}, core.ErrAlreadyExists
+	}
+
+	p := core.Permission{Name: name, Description: description, CreatedAt: time.Now()}
+	f.perms[name] = p
+
+	return p, nil
+}
+
+func (f fakeRBACStore) ListPermissions(_ context.Context) ([]core.Permission, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	res := make([]core.Permission, 0, len(f.perms))
+	for _, p := range f.perms {
+		res = append(res, p)
+	}
+	slices.SortFunc(res, func(a, b core.Permission) int { return strings.Compare(a.Name, b.Name) })
+
+	return res, nil
+}
+
+func (f fakeRBACStore) DeletePermission(_ context.Context, name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.perms[name]; !ok {
+		return core.ErrNotFound
+	}
+
+	delete(f.perms, name)
+	for _, r := range f.roles {
+		r.Permissions = slices.DeleteFunc(r.Permissions, func(p string) bool { return p == name })
+	}
+
+	return nil
+}
+
+func (f fakeRBACStore) CreateRole(_ context.Context, params core.CreateRoleParams) (core.Role, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, r := range f.roles {
+		if strings.EqualFold(r.Name, params.Name) {
+			return core.Role{}, core.ErrAlreadyExists
+		}
+	}
+
+	now := time.Now()
+	r := &core.Role{ID: uuid.New(), Name: params.Name, Description: params.Description, CreatedAt: now, UpdatedAt: now}
+	f.roles[r.ID] = r
+
+	return *r, nil
+}
+
+func (f fakeRBACStore) GetRole(_ context.Context, id uuid.UUID) (core.Role, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	r, ok := f.roles[id]
+	if !ok {
+		return core.Role{}, core.ErrNotFound
+	}
+
+	return *r, nil
+}
+
+func (f fakeRBACStore) ListRoles(_ context.Context) ([]core.Role, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	res := make([]core.Role, 0, len(f.roles))
+	for _, r := range f.roles {
+		res = append(res, *r)
+	}
+	slices.SortFunc(res, func(a, b core.Role) int { return strings.Compare(a.Name, b.Name) })
+
+	return res, nil
+}
+
+func (f fakeRBACStore) UpdateRole(_ context.Context, id uuid.UUID, params core.UpdateRoleParams) (core.Role, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	r, ok := f.roles[id]
+	if !ok {
+		return core.Role{}, core.ErrNotFound
+	}
+
+	if params.Name != nil {
+		r.Name = *params.Name
+	}
+
+	if params.Description != nil {
+		r.Description = *params.Description
+	}
+
+	r.UpdatedAt = time.Now()
+
+	return *r, nil
+}
+
+func (f fakeRBACStore) DeleteRole(_ context.Context, id uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.roles[id]; !ok {
+		return core.ErrNotFound
+	}
+
+	delete(f.roles, id)
+	for _, r := range f.roles {
+		r.ParentIDs = slices.DeleteFunc(r.ParentIDs, func(p uuid.UUID) bool { return p == id })
+	}
+	f.assigned = slices.DeleteFunc(f.assigned, func(a core.RoleAssignment) bool { return a.RoleID == id })
+
+	return nil
+}
+
+func (f fakeRBACStore) GrantPermission(_ context.Context, roleID uuid.UUID, permission string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	r, ok := f.roles[roleID]
+	if _, exists := f.perms[permission]; !ok || !exists {
+		return core.ErrNotFound
+	}
+
+	if !slices.Contains(r.Permissions, permission) {
+		r.Permissions = append(r.Permissions, permission)
+		slices.Sort(r.Permissions)
+	}
+
+	return nil
+}
+
+func (f fakeRBACStore) RevokePermission(_ context.Context, roleID uuid.UUID, permission string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	r, ok := f.roles[roleID]
+	if !ok || !slices.Contains(r.Permissions, permission) {
+		return core.ErrNotFound
+	}
+
+	r.Permissions = slices.DeleteFunc(r.Permissions, func(p string) bool { return p == permission })
+	return nil
+}
+
+// ancestors returns the role and all roles it inherits from.
+func (f fakeRBACStore) ancestors(roleID uuid.UUID) map[uuid.UUID]bool {
+	seen := map[uuid.UUID]bool{}
+
+	queue := []uuid.UUID{roleID}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		r, ok := f.roles[id]
+		if !ok || seen[id] {
+			continue
+		}
+
+		seen[id] = true
+		queue = append(queue, r.ParentIDs...)
+	}
+
+	return seen
+}
+
+func (f fakeRBACStore) AddParent(_ context.Context, roleID, parentID uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	r, ok := f.roles[roleID]
+	if _, exists := f.roles[parentID]; !ok || !exists {
+		return core.ErrNotFound
+	}
+
+	if f.ancestors(parentID)[roleID] {
+		return fmt.Errorf("fake: role inheritance cycle: %w", core.ErrInvalidArgument)
+	}
+
+	if !slices.Contains(r.ParentIDs, parentID) {
+		r.ParentIDs = append(r.ParentIDs, parentID)
+	}
+
+	return nil
+}
+
+func (f fakeRBACStore) RemoveParent(_ context.Context, roleID, parentID uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	r, ok := f.roles[roleID]
+	if !ok || !slices.Contains(r.ParentIDs, parentID) {
+		return core.ErrNotFound
+	}
+
+	r.ParentIDs = slices.DeleteFunc(r.ParentIDs, func(p uuid.UUID) bool { return p == parentID })
+	return nil
+}
+
+// assignee returns the user or service account a role is assigned to.
+func assignee(a core.RoleAssignment) uuid.UUID {
+	if a.ServiceAccountID != uuid.Nil {
+		return a.ServiceAccountID
+	}
+
+	return a.UserID
+}
+
+func (f fakeRBACStore) Assign(_ context.Context, subject, roleID, scope uuid.UUID, condition string) (core.RoleAssignment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.roles[roleID]; !ok {
+		return core.RoleAssignment{}, core.ErrNotFound
+	}
+
+	if _, ok := f.conds[condition]; condition != "" && !ok {
+		return core.RoleAssignment{}, core.ErrNotFound
+	}
+
+	for _, a := range f.assigned {
+		if assignee(a) == subject && a.RoleID == roleID && a.OrgID == scope {
+			return core.RoleAssignment{}, core.ErrAlreadyExists
+		}
+	}
+
+	a := core.RoleAssignment{
+		ID:        uuid.New(),
+		RoleID:    roleID,
+		OrgID:     scope,
+		Condition: condition,
+		CreatedAt: time.Now(),
+	}
+	if _, ok := f.accounts[subject]; ok {
+		a.ServiceAccountID = subject
+	} else {
+		a.UserID = subject
+	}
+	f.assigned = append(f.assigned, a)
+
+	return a, nil
+}
+
+func (f fakeRBACStore) Unassign(_ context.Context, subject, roleID, scope uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	n := len(f.assigned)
+	f.assigned = slices.DeleteFunc(f.assigned, func(a core.RoleAssignment) bool {
+		return assignee(a) == subject && a.RoleID == roleID && a.OrgID == scope
+	})
+
+	if len(f.assigned) == n {
+		return core.ErrNotFound
+	}
+
+	return nil
+}
+
+func (f fakeRBACStore) ListAssignments(_ context.Context, subject uuid.UUID) ([]core.RoleAssignment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var res []core.RoleAssignment
+	for _, a := range f.assigned {
+		if assignee(a) == subject {
+			res = append(res, a)
+		}
+	}
+
+	return res, nil
+}
+
+func (f fakeRBACStore) Check(ctx context.Context, subject uuid.UUID, permission string, scope uuid.UUID, attrs core.AttributeContext) (core.Decision, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, a := range f.assignments(ctx, subject, scope, attrs) {
+		for id := range f.ancestors(a.RoleID) {
+			if slices.Contains(f.roles[id].Permissions, permission) {
+				d := core.Decision{Allowed: true, Policy: "role:" + f.roles[a.RoleID].Name}
+				if a.OrgID != uuid.Nil {
+					d.Policy += "@" + a.OrgID.String()
+				}
+				if a.Condition != "" {
+					d.Conditions = []string{a.Condition}
+				}
+
+				return d, nil
+			}
+		}
+	}
+
+	return core.Decision{}, nil
+}
+
+// Explain explains allowed checks with the deciding assignment only.
+func (f fakeRBACStore) Explain(ctx context.Context, subject uuid.UUID, permission string, scope uuid.UUID, attrs core.AttributeContext) (core.Explanation, error) {
+	d, err := f.Check(ctx, subject, permission, scope, attrs)
+	if err != nil {
+		return core.Explanation{}, err
+	}
+
+	tree := core.ExplainNode{Kind: core.ExplainPermission, Name: permission, Allowed: d.Allowed}
+	if d.Allowed {
+		tree.Children = []core.ExplainNode{{Kind: core.ExplainAssignment, Name: d.Policy, Allowed: true}}
+	}
+
+	return core.Explanation{Decision: d, Tree: tree}, nil
+}
+
+func (f fakeRBACStore) EffectivePermissions(ctx context.Context, subject, scope uuid.UUID, attrs core.AttributeContext) ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var res []string
+	for _, a := range f.assignments(ctx, subject, scope, attrs) {
+		for id := range f.ancestors(a.RoleID) {
+			for _, p := range f.roles[id].Permissions {
+				if !slices.Contains(res, p) {
+					res = append(res, p)
+				}
+			}
+		}
+	}
+	slices.Sort(res)
+
+	return res, nil
+}
+
+// assignments returns the assignments of subject which apply in scope and whose condition holds.
+func (f fakeRBACStore) assignments(ctx context.Context, subject, scope uuid.UUID, attrs core.AttributeContext) []core.RoleAssignment {
+	var res []core.RoleAssignment
+	for _, a := range f.assigned {
+		if assignee(a) == subject && (a.OrgID == uuid.Nil || a.OrgID == scope) && f.holds(ctx, a.Condition, attrs) {
+			res = append(res, a)
+		}
+	}
+
+	return res
+}
+
+type fakeConditionStore struct{ *fakeStores }
+
+func (f fakeConditionStore) CreateCondition(_ context.Context, params core.CreateConditionParams) (core.Condition, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.engine.Compile(params.Expression); err != nil {
+		return core.Condition{}, err
+	}
+
+	if _, ok := f.conds[params.Name]; ok {
+		return core.Condition{}, core.ErrAlreadyExists
+	}
+
+	now := time.Now()
+	c := core.Condition{
+		Name:        params.Name,
+		Expression:  params.Expression,
+		Description: params.Description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	f.conds[c.Name] = c
+
+	return c, nil
+}
+
+func (f fakeConditionStore) GetCondition(_ context.Context, name string) (core.Condition, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, ok := f.conds[name]
+	if !ok {
+		return core.Condition{}, core.ErrNotFound
+	}
+
+	return c, nil
+}
+
+func (f fakeConditionStore) ListConditions(_ context.Context) ([]core.Condition, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	res := slices.Collect(maps.Values(f.conds))
+	slices.SortFunc(res, func(a, b core.Condition) int { return strings.Compare(a.Name, b.Name) })
+
+	return res, nil
+}
+
+func (f fakeConditionStore) UpdateCondition(_ context.Context, name string, params core.UpdateConditionParams) (core.Condition, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, ok := f.conds[name]
+	if !ok {
+		return core.Condition{}, core.ErrNotFound
+	}
+
+	if params.Expression != nil {
+		if err := f.engine.Compile(*params.Expression); err != nil {
+			return core.Condition{}, err
+		}
+		c.Expression = *params.Expression
+	}
+
+	if params.Description != nil {
+		c.Description = *params.Description
+	}
+
+	c.UpdatedAt = time.Now()
+	f.conds[name] = c
+
+	return c, nil
+}
+
+func (f fakeConditionStore) DeleteCondition(_ context.Context, name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.conds[name]; !ok {
+		return core.ErrNotFound
+	}
+
+	inUse := slices.ContainsFunc(f.assigned, func(a core.RoleAssignment) bool { return a.Condition == name }) ||
+		slices.ContainsFunc(f.tuples, func(t core.RelationTuple) bool { return t.Condition == name })
+	if inUse {
+		return fmt.Errorf("fake: condition in use: %w", core.ErrInvalidArgument)
+	}
+
+	delete(f.conds, name)
+	return nil
+}
+
+// fakeReBACStore only knows direct tuples and does not validate them against the schema. Zookies are revisions.
+type fakeReBACStore struct{ *fakeStores }
+
+func (f fakeReBACStore) zookie() core.Zookie {
+	return core.Zookie(strconv.FormatInt(f.revision, 10))
+}
+
+// at returns an error if zookie is ahead of the current revision.
+func (f fakeReBACStore) at(zookie core.Zookie) error {
+	if zookie == "" {
+		return nil
+	}
+
+	revision, err := strconv.ParseInt(string(zookie), 10, 64)
+	if err != nil || revision > f.revision {
+		return fmt.Errorf("fake: invalid zookie: %w", core.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+func (f fakeReBACStore) WriteSchema(_ context.Context, definition string) (core.Zookie, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.schema = definition
+	f.revision++
+
+	return f.zookie(), nil
+}
+
+func (f fakeReBACStore) ReadSchema(_ context.Context) (string, core.Zookie, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.schema == "" {
+		return "", "", core.ErrNotFound
+	}
+
+	return f.schema, f.zookie(), nil
+}
+
+func (f fakeReBACStore) WriteTuples(_ context.Context, writes, deletes []core.RelationTuple) (core.Zookie, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, t := range writes {
+		if _, ok := f.conds[t.Condition]; t.Condition != "" && !ok {
+			return "", fmt.Errorf("fake: unknown condition: %w", core.ErrNotFound)
+		}
+	}
+
+	same := func(a core.RelationTuple) func(core.RelationTuple) bool {
+		return func(b core.RelationTuple) bool { return a.String() == b.String() }
+	}
+
+	for _, t := range slices.Concat(deletes, writes) {
+		f.tuples = slices.DeleteFunc(f.tuples, same(t))
+	}
+	f.tuples = append(f.tuples, writes...)
+	f.revision++
+
+	return f.zookie(), nil
+}
+
+func (f fakeReBACStore) ReadTuples(_ context.Context, params core.ReadTuplesParams) (core.TuplePage, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.at(params.Zookie); err != nil {
+		return core.TuplePage{}, err
+	}
+
+	page := core.TuplePage{Zookie: f.zookie()}
+	for _, t := range f.tuples {
+		filter := params.Filter
+		if t.Object.Namespace == filter.Namespace &&
+			(filter.ObjectID == "" || t.Object.ID == filter.ObjectID) &&
+			(filter.Relation == "" || t.Relation == filter.Relation) &&
+			(filter.Subject == nil || t.Subject == *filter.Subject) {
+			page.Tuples = append(page.Tuples, t)
+		}
+	}
+
+	return page, nil
+}
+
+func (f fakeReBACStore) Check(ctx context.Context, params core.CheckParams) (core.Decision, core.Zookie, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.at(params.Zookie); err != nil {
+		return core.Decision{}, "", err
+	}
+
+	for _, t := range f.tuples {
+		if t.Object == params.Object && t.Relation == params.Relation && t.Subject == params.Subject &&
+			f.holds(ctx, t.Condition, params.Context) {
+			d := core.Decision{Allowed: true, Policy: t.String()}
+			if t.Condition != "" {
+				d.Conditions = []string{t.Condition}
+			}
+
+			return d, f.zookie(), nil
+		}
+	}
+
+	return core.Decision{}, f.zookie(), nil
+}
+
+// Explain explains checks with the tuples of the relation which were looked at.
+func (f fakeReBACStore) Explain(ctx context.Context, params core.CheckParams) (core.Explanation, core.Zookie, error) {
+	d, zookie, err := f.Check(ctx, params)
+	if err != nil {
+		return core.Explanation{}, "", err
+	}
+
+	tree := core.ExplainNode{Kind: core.ExplainRelation, Name: params.Object.String() + "#" + params.Relation, Allowed: d.Allowed}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, t := range f.tuples {
+		if t.Object == params.Object && t.Relation == params.Relation && t.Subject == params.Subject {
+			tree.Children = append(tree.Children, core.ExplainNode{
+				Kind:    core.ExplainTuple,
+				Name:    t.String(),
+				Allowed: f.holds(ctx, t.Condition, params.Context),
+			})
+		}
+	}
+
+	return core.Explanation{Decision: d, Tree: tree}, zookie, nil
+}
+
+func (f fakeReBACStore) Expand(ctx context.Context, params core.ExpandParams) (core.UsersetTree, core.Zookie, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.at(params.Zookie); err != nil {
+		return core.UsersetTree{}, "", err
+	}
+
+	tree := core.UsersetTree{Operation: core.UsersetLeaf, Object: params.Object, Relation: params.Relation}
+	for _, t := range f.tuples {
+		if t.Object == params.Object && t.Relation == params.Relation && f.holds(ctx, t.Condition, params.Context) {
+			tree.Subjects = append(tree.Subjects, t.Subject)
+		}
+	}
+
+	return tree, f.zookie(), nil
+}
+
+func (f fakeReBACStore) ListObjects(ctx context.Context, params core.ListObjectsParams) (core.ObjectPage, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.at(params.Zookie); err != nil {
+		return core.ObjectPage{}, err
+	}
+
+	page := core.ObjectPage{Zookie: f.zookie()}
+	for _, t := range f.tuples {
+		if t.Object.Namespace == params.Namespace && t.Relation == params.Relation && t.Subject == params.Subject &&
+			f.holds(ctx, t.Condition, params.Context) {
+			page.ObjectIDs = append(page.ObjectIDs, t.Object.ID)
+		}
+	}
+	slices.Sort(page.ObjectIDs)
+
+	return page, nil
+}
+
+func (f fakeReBACStore) ListSubjects(ctx context.Context, params core.ListSubjectsParams) (core.SubjectPage, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.at(params.Zookie); err != nil {
+		return core.SubjectPage{}, err
+	}
+
+	page := core.SubjectPage{Zookie: f.zookie()}
+	for _, t := range f.tuples {
+		if t.Object == params.Object && t.Relation == params.Relation &&
+			t.Subject.Namespace == params.SubjectNamespace && t.Subject.Relation == "" && f.holds(ctx, t.Condition, params.Context) {
+			page.SubjectIDs = append(page.SubjectIDs, t.Subject.ID)
+		}
+	}
+	slices.Sort(page.SubjectIDs)
+
+	return page, nil
+}
+
+// fakeOrganizationStore creates invitations valid for a week. Links point to https://example.com/invitation.
+type fakeOrganizationStore struct{ *fakeStores }
+
+func (f fakeOrganizationStore) Create(_ context.Context, params core.CreateOrganizationParams, owner uuid.UUID) (core.Organization, core.OrganizationMembership, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if params.Slug == "" || params.Name == "" {
+		return core.Organization{}, core.OrganizationMembership{}, core.ErrInvalidArgument
+	}
+
+	if _, err := f.bySlug(params.Slug); err == nil {
+		return core.Organization{}, core.OrganizationMembership{}, core.ErrAlreadyExists
+	}
+
+	now := time.Now()
+	org := core.Organization{ID: uuid.New(), Slug: params.Slug, Name: params.Name, CreatedAt: now, UpdatedAt: now}
+	m := core.OrganizationMembership{OrgID: org.ID, UserID: owner, Role: core.OrganizationRoleOwner, CreatedAt: now, UpdatedAt: now}
+	f.orgs[org.ID] = org
+	f.members[org.ID] = map[uuid.UUID]core.OrganizationMembership{owner: m}
+
+	return org, m, nil
+}
+
+func (f fakeOrganizationStore) Get(_ context.Context, id uuid.UUID) (core.Organization, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	org, ok := f.orgs[id]
+	if !ok {
+		return core.Organization{}, core.ErrNotFound
+	}
+
+	return org, nil
+}
+
+// bySlug finds an organization by slug. The lock must be held.
+func (f fakeOrganizationStore) bySlug(slug string) (core.Organization, error) {
+	for _, org := range f.orgs {
+		if strings.EqualFold(org.Slug, slug) {
+			return org, nil
+		}
+	}
+
+	return core.Organization{}, core.ErrNotFound
+}
+
+func (f fakeOrganizationStore) GetBySlug(_ context.Context, slug string) (core.Organization, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.bySlug(slug)
+}
+
+func (f fakeOrganizationStore) ListByUser(_ context.Context, userID uuid.UUID) ([]core.Organization, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var orgs []core.Organization
+	for id, members := range f.members {
+		if _, ok := members[userID]; ok {
+			orgs = append(orgs, f.orgs[id])
+		}
+	}
+
+	slices.SortFunc(orgs, func(a, b core.Organization) int { return strings.Compare(a.ID.String(), b.ID.String()) })
+
+	return orgs, nil
+}
+
+func (f fakeOrganizationStore) Update(_ context.Context, id uuid.UUID, params core.UpdateOrganizationParams) (core.Organization, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	org, ok := f.orgs[id]
+	if !ok {
+		return core.Organization{}, core.ErrNotFound
+	}
+
+	if params.Slug != nil {
+		if other, err := f.bySlug(*params.Slug); err == nil && other.ID != id {
+			return core.Organization{}, core.ErrAlreadyExists
+		}
+		org.Slug = *params.Slug
+	}
+	if params.Name != nil {
+		org.Name = *params.Name
+	}
+	org.UpdatedAt = time.Now()
+	f.orgs[id] = org
+
+	return org, nil
+}
+
+func (f fakeOrganizationStore) Delete(_ context.Context, id uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.orgs[id]; !ok {
+		return core.ErrNotFound
+	}
+
+	delete(f.orgs, id)
+	delete(f.members, id)
+	maps.DeleteFunc(f.invites, func(_ string, inv core.OrganizationInvitation) bool { return inv.OrgID == id })
+
+	return nil
+}
+
+func (f fakeOrganizationStore) GetMembership(_ context.Context, orgID, userID uuid.UUID) (core.OrganizationMembership, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	m, ok := f.members[orgID][userID]
+	if !ok {
+		return core.OrganizationMembership{}, core.ErrNotFound
+	}
+
+	return m, nil
+}
+
+func (f fakeOrganizationStore) ListMemberships(_ context.Context, params core.ListMembershipsParams) (core.MembershipPage, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var page core.MembershipPage
+	for _, m := range f.members[params.OrgID] {
+		page.Memberships = append(page.Memberships, m)
+	}
+
+	slices.SortFunc(page.Memberships, func(a, b core.OrganizationMembership) int {
+		return strings.Compare(a.UserID.String(), b.UserID.String())
+	})
+
+	return page, nil
+}
+
+// owners counts the owners of the organization. The lock must be held.
+func (f fakeOrganizationStore) owners(orgID uuid.UUID) int {
+	n := 0
+	for _, m := range f.members[orgID] {
+		if m.Role == core.OrganizationRoleOwner {
+			n++
+		}
+	}
+
+	return n
+}
+
+// demote returns [core.ErrInvalidArgument] if m is the last owner. The lock must be held.
+func (f fakeOrganizationStore) demote(m core.OrganizationMembership) error {
+	if m.Role == core.OrganizationRoleOwner && f.owners(m.OrgID) == 1 {
+		return fmt.Errorf("fake: cannot remove the last owner: %w", core.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+func (f fakeOrganizationStore) UpdateMembershipRole(_ context.Context, orgID, userID uuid.UUID, role core.OrganizationRole) (core.OrganizationMembership, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	m, ok := f.members[orgID][userID]
+	if !ok {
+		return core.OrganizationMembership{}, core.ErrNotFound
+	}
+
+	if role != core.OrganizationRoleOwner {
+		if err := f.demote(m); err != nil {
+			return core.OrganizationMembership{}, err
+		}
+	}
+
+	m.Role = role
+	m.UpdatedAt = time.Now()
+	f.members[orgID][userID] = m
+
+	return m, nil
+}
+
+func (f fakeOrganizationStore) RemoveMembership(_ context.Context, orgID, userID uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	m, ok := f.members[orgID][userID]
+	if !ok {
+		return core.ErrNotFound
+	}
+
+	if err := f.demote(m); err != nil {
+		return err
+	}
+
+	delete(f.members[orgID], userID)
+
+	return nil
+}
+
+func (f fakeOrganizationStore) TransferOwnership(_ context.Context, orgID, from, to uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	owner, ok := f.members[orgID][from]
+	if !ok || owner.Role != core.OrganizationRoleOwner || from == to {
+		return core.ErrInvalidArgument
+	}
+
+	m, ok := f.members[orgID][to]
+	if !ok {
+		return core.ErrNotFound
+	}
+
+	now := time.Now()
+	m.Role, m.UpdatedAt = core.OrganizationRoleOwner, now
+	owner.Role, owner.UpdatedAt = core.OrganizationRoleAdmin, now
+	f.members[orgID][to] = m
+	f.members[orgID][from] = owner
+
+	return nil
+}
+
+func (f fakeOrganizationStore) Invite(_ context.Context, params core.InviteParams) (core.OrganizationInvitation, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	maps.DeleteFunc(f.invites, func(_ string, inv core.OrganizationInvitation) bool {
+		return inv.OrgID == params.OrgID && strings.EqualFold(inv.Email, params.Email)
+	})
+
+	now := time.Now()
+	inv := core.OrganizationInvitation{
+		ID:        uuid.New(),
+		OrgID:     params.OrgID,
+		Email:     params.Email,
+		Role:      params.Role,
+		InviterID: params.InviterID,
+		CreatedAt: now,
+		ExpiresAt: now.Add(7 * 24 * time.Hour),
+	}
+	token := uuid.NewString()
+	f.invites[token] = inv
+
+	return inv, "https://example.com/invitation?token=" + token, nil
+}
+
+func (f fakeOrganizationStore) GetInvitation(_ context.Context, token string) (core.OrganizationInvitation, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	inv, ok := f.invites[token]
+	if !ok || !inv.ExpiresAt.After(time.Now()) {
+		return core.OrganizationInvitation{}, core.ErrInvalidToken
+	}
+
+	return inv, nil
+}
+
+func (f fakeOrganizationStore) ListInvitations(_ context.Context, orgID uuid.UUID) ([]core.OrganizationInvitation, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var invs []core.OrganizationInvitation
+	for _, inv := range f.invites {
+		if inv.OrgID == orgID {
+			invs = append(invs, inv)
+		}
+	}
+
+	slices.SortFunc(invs, func(a, b core.OrganizationInvitation) int { return strings.Compare(a.ID.String(), b.ID.String()) })
+
+	return invs, nil
+}
+
+func (f fakeOrganizationStore) RevokeInvitation(_ context.Context, orgID, id uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for token, inv := range f.invites {
+		if inv.OrgID == orgID && inv.ID == id {
+			delete(f.invites, token)
+			return nil
+		}
+	}
+
+	return core.ErrNotFound
+}
+
+// consume returns the pending invitation of token for email. The lock must be held.
+func (f fakeOrganizationStore) consume(token, email string) (core.OrganizationInvitation, error) {
+	inv, ok := f.invites[token]
+	if !ok || !strings.EqualFold(inv.Email, email) || !inv.ExpiresAt.After(time.Now()) {
+		return core.OrganizationInvitation{}, core.ErrInvalidToken
+	}
+
+	return inv, nil
+}
+
+func (f fakeOrganizationStore) AcceptInvitation(_ context.Context, token string, userID uuid.UUID, email string) (core.OrganizationMembership, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	inv, err := f.consume(token, email)
+	if err != nil {
+		return core.OrganizationMembership{}, err
+	}
+
+	if _, ok := f.members[inv.OrgID][userID]; ok {
+		return core.OrganizationMembership{}, core.ErrAlreadyExists
+	}
+
+	now := time.Now()
+	m := core.OrganizationMembership{OrgID: inv.OrgID, UserID: userID, Role: inv.Role, CreatedAt: now, UpdatedAt: now}
+	f.members[inv.OrgID][userID] = m
+	delete(f.invites, token)
+
+	return m, nil
+}
+
+func (f fakeOrganizationStore) DeclineInvitation(_ context.Context, token, email string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, err := f.consume(token, email); err != nil {
+		return err
+	}
+
+	delete(f.invites, token)
+
+	return nil
+}
+
+// fakeMailer records sent emails. Emails sent through queue are recorded once the queue ran them.
+type fakeMailer struct {
+	*fakeStores
+	queue *mail.Queue
+}
+
+func (f fakeMailer) Send(_ context.Context, email core.Email) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.outbox = append(f.outbox, email)
+	return nil
+}
+
+// sent waits for the queued emails, returns the emails sent so far and clears the outbox.
+func (f fakeMailer) sent() []core.Email {
+	f.queue.Wait()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	sent := f.outbox
+	f.outbox = nil
+
+	return sent
+}
+
+type fakeAuditLog struct{ *fakeStores }
+
+func (f fakeAuditLog) Record(_ context.Context, event core.AuditEvent) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.audit = append(f.audit, event)
+	return nil
+}
+
+// auditTypes returns the types of recorded audit events in order.
+func (f fakeAuditLog) auditTypes() []core.AuditEventType {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	types := make([]core.AuditEventType, 0, len(f.audit))
+	for _, e := range f.audit {
+		types = append(types, e.Type)
+	}
+
+	return types
+}
+
+type fakeDecisionLog struct{ *fakeStores }
+
+func (f fakeDecisionLog) Record(_ context.Context, record core.DecisionRecord) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.decisions = append(f.decisions, record)
+	return nil
+}
+
+// recorded returns the recorded decisions in order.
+func (f fakeDecisionLog) recorded() []core.DecisionRecord {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Clone(f.decisions)
+}
+
+type fakeAPIKeyStore struct{ *fakeStores }
+
+func (f fakeAPIKeyStore) Create(_ context.Context, params core.CreateAPIKeyParams) (core.APIKey, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	k := &fakeAPIKey{
+		APIKey: core.APIKey{
+			ID:        uuid.New(),
+			Name:      params.Name,
+			Prefix:    "gdn_" + uuid.NewString()[:12],
+			Owner:     params.Owner,
+			Scopes:    slices.Sorted(slices.Values(params.Scopes)),
+			CreatedAt: time.Now(),
+			ExpiresAt: params.ExpiresAt,
+		},
+	}
+	k.token = k.Prefix + "_" + uuid.NewString()
+	f.apiKeys[k.ID] = k
+
+	return k.APIKey, k.token, nil
+}
+
+func (f fakeAPIKeyStore) Verify(_ context.Context, token string) (core.APIKey, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+	for _, k := range f.apiKeys {
+		if token != k.token && (k.previous == "" || token != k.previous || !now.Before(*k.PreviousExpiresAt)) {
+			continue
+		}
+
+		if k.ExpiresAt != nil && !now.Before(*k.ExpiresAt) {
+			break
+		}
+
+		k.LastUsedAt = &now
+		return k.APIKey, nil
+	}
+
+	return core.APIKey{}, core.ErrInvalidCredentials
+}
+
+func (f fakeAPIKeyStore) Get(_ context.Context, id uuid.UUID) (core.APIKey, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	k, ok := f.apiKeys[id]
+	if !ok {
+		return core.APIKey{}, core.ErrNotFound
+	}
+
+	return k.APIKey, nil
+}
+
+func (f fakeAPIKeyStore) List(_ context.Context, owner core.APIKeyOwner) ([]core.APIKey, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var keys []core.APIKey
+	for _, k := range f.apiKeys {
+		if k.Owner == owner {
+			keys = append(keys, k.APIKey)
+		}
+	}
+
+	slices.SortFunc(keys, func(a, b core.APIKey) int { return strings.Compare(a.ID.String(), b.ID.String()) })
+
+	return keys, nil
+}
+
+func (f fakeAPIKeyStore) Rotate(_ context.Context, id uuid.UUID, overlap *time.Duration) (core.APIKey, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	k, ok := f.apiKeys[id]
+	if !ok {
+		return core.APIKey{}, "", core.ErrNotFound
+	}
+
+	now := time.Now()
+	d := 24 * time.Hour
+	if overlap != nil {
+		d = *overlap
+	}
+
+	k.previous, k.PreviousExpiresAt = "", nil
+	if d > 0 {
+		expiresAt := now.Add(d)
+		k.previous, k.PreviousExpiresAt = k.token, &expiresAt
+	}
+
+	k.token = k.Prefix + "_" + uuid.NewString()
+	k.RotatedAt = &now
+
+	return k.APIKey, k.token, nil
+}
+
+func (f fakeAPIKeyStore) Revoke(_ context.Context, id uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.apiKeys[id]; !ok {
+		return core.ErrNotFound
+	}
+
+	delete(f.apiKeys, id)
+	return nil
+}
+
+// fakeServiceAccountStore does not verify client assertions, as the API does not accept them.
+type fakeServiceAccountStore struct{ *fakeStores }
+
+func (f fakeServiceAccountStore) Create(_ context.Context, params core.CreateServiceAccountParams) (core.ServiceAccount, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if strings.TrimSpace(params.Name) == "" {
+		return core.ServiceAccount{}, "", fmt.Errorf("fake: name is required: %w", core.ErrInvalidArgument)
+	}
+
+	for _, a := range f.accounts {
+		if a.Name == params.Name {
+			return core.ServiceAccount{}, "", core.ErrAlreadyExists
+		}
+	}
+
+	now := time.Now()
+	a := &fakeServiceAccount{
+		ServiceAccount: core.ServiceAccount{
+			ID:          uuid.New(),
+			Name:        params.Name,
+			Description: params.Description,
+			Scopes:      slices.Sorted(slices.Values(params.Scopes)),
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+		secret: uuid.NewString(),
+	}
+	f.accounts[a.ID] = a
+
+	return a.ServiceAccount, a.secret, nil
+}
+
+func (f fakeServiceAccountStore) Get(_ context.Context, id uuid.UUID) (core.ServiceAccount, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	a, ok := f.accounts[id]
+	if !ok {
+		return core.ServiceAccount{}, core.ErrNotFound
+	}
+
+	return a.ServiceAccount, nil
+}
+
+func (f fakeServiceAccountStore) List(_ context.Context) ([]core.ServiceAccount, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	res := make([]core.ServiceAccount, 0, len(f.accounts))
+	for _, a := range f.accounts {
+		res = append(res, a.ServiceAccount)
+	}
+
+	slices.SortFunc(res, func(a, b core.ServiceAccount) int { return strings.Compare(a.ID.String(), b.ID.String()) })
+
+	return res, nil
+}
+
+func (f fakeServiceAccountStore) Update(_ context.Context, params core.UpdateServiceAccountParams) (core.ServiceAccount, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	a, ok := f.accounts[params.ID]
+	if !ok {
+		return core.ServiceAccount{}, core.ErrNotFound
+	}
+
+	a.Name = params.Name
+	a.Description = params.Description
+	a.Scopes = slices.Sorted(slices.Values(params.Scopes))
+	a.UpdatedAt = time.Now()
+
+	return a.ServiceAccount, nil
+}
+
+func (f fakeServiceAccountStore) Delete(_ context.Context, id uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.accounts[id]; !ok {
+		return core.ErrNotFound
+	}
+
+	delete(f.accounts, id)
+	maps.DeleteFunc(f.saKeys, func(_ uuid.UUID, k core.ServiceAccountKey) bool { return k.ServiceAccountID == id })
+	f.assigned = slices.DeleteFunc(f.assigned, func(a core.RoleAssignment) bool { return a.ServiceAccountID == id })
+
+	return nil
+}
+
+func (f fakeServiceAccountStore) RotateSecret(_ context.Context, id uuid.UUID, overlap *time.Duration) (core.ServiceAccount, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	a, ok := f.accounts[id]
+	if !ok {
+		return core.ServiceAccount{}, "", core.ErrNotFound
+	}
+
+	now := time.Now()
+	d := 24 * time.Hour
+	if overlap != nil {
+		d = *overlap
+	}
+
+	a.previous, a.PreviousExpiresAt = "", nil
+	if d > 0 {
+		expiresAt := now.Add(d)
+		a.previous, a.PreviousExpiresAt = a.secret, &expiresAt
+	}
+
+	a.secret = uuid.NewString()
+	a.RotatedAt = &now
+
+	return a.ServiceAccount, a.secret, nil
+}
+
+func (f fakeServiceAccountStore) AddKey(_ context.Context, id uuid.UUID, jwk []byte) (core.ServiceAccountKey, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.accounts[id]; !ok {
+		return core.ServiceAccountKey{}, core.ErrNotFound
+	}
+
+	pub, err := token.ParseJWK(jwk)
+	if err != nil {
+		return core.ServiceAccountKey{}, err
+	}
+
+	for _, k := range f.saKeys {
+		if k.ServiceAccountID == id && k.KeyID == pub.ID {
+			return core.ServiceAccountKey{}, core.ErrAlreadyExists
+		}
+	}
+
+	k := core.ServiceAccountKey{
+		ID:               uuid.New(),
+		ServiceAccountID: id,
+		KeyID:            pub.ID,
+		Algorithm:        string(pub.Algorithm),
+		CreatedAt:        time.Now(),
+	}
+	f.saKeys[k.ID] = k
+
+	return k, nil
+}
+
+func (f fakeServiceAccountStore) ListKeys(_ context.Context, id uuid.UUID) ([]core.ServiceAccountKey, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.accounts[id]; !ok {
+		return nil, core.ErrNotFound
+	}
+
+	var keys []core.ServiceAccountKey
+	for _, k := range f.saKeys {
+		if k.ServiceAccountID == id {
+			keys = append(keys, k)
+		}
+	}
+
+	slices.SortFunc(keys, func(a, b core.ServiceAccountKey) int { return strings.Compare(a.ID.String(), b.ID.String()) })
+
+	return keys, nil
+}
+
+func (f fakeServiceAccountStore) DeleteKey(_ context.Context, id, keyID uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if k, ok := f.saKeys[keyID]; !ok || k.ServiceAccountID != id {
+		return core.ErrNotFound
+	}
+
+	delete(f.saKeys, keyID)
+	return nil
+}
+
+func (f fakeServiceAccountStore) Authenticate(_ context.Context, id uuid.UUID, secret string) (core.ServiceAccount, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	a, ok := f.accounts[id]
+	if !ok || secret != a.secret && (a.previous == "" || secret != a.previous || !time.Now().Before(*a.PreviousExpiresAt)) {
+		return core.ServiceAccount{}, core.ErrInvalidCredentials
+	}
+
+	return a.ServiceAccount, nil
+}
+
+func (f fakeServiceAccountStore) AuthenticateAssertion(context.Context, string, []string) (core.ServiceAccount, error) {
+	return core.ServiceAccount{}, core.ErrInvalidCredentials
+}
+
+type fakeOAuthClientStore struct{ *fakeStores }
+
+func (f fakeOAuthClientStore) Create(_ context.Context, params core.CreateOAuthClientParams) (core.OAuthClient, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if params.Type != core.OAuthClientPublic && params.Type != core.OAuthClientConfidential {
+		return core.OAuthClient{}, "", fmt.Errorf("fake: invalid type: %w", core.ErrInvalidArgument)
+	}
+
+	now := time.Now()
+	c := core.OAuthClient{
+		ID:           uuid.New(),
+		Name:         params.Name,
+		Type:         params.Type,
+		RedirectURIs: params.RedirectURIs,
+		GrantTypes:   params.GrantTypes,
+		Scopes:       slices.Sorted(slices.Values(params.Scopes)),
+		Audiences:    slices.Sorted(slices.Values(params.Audiences)),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	f.clients[c.ID] = c
+
+	var secret string
+	if c.Type == core.OAuthClientConfidential {
+		secret = uuid.NewString()
+	}
+
+	return c, secret, nil
+}
+
+func (f fakeOAuthClientStore) Get(_ context.Context, id uuid.UUID) (core.OAuthClient, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, ok := f.clients[id]
+	if !ok {
+		return core.OAuthClient{}, core.ErrNotFound
+	}
+
+	return c, nil
+}
+
+func (f fakeOAuthClientStore) List(context.Context) ([]core.OAuthClient, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	clients := slices.Collect(maps.Values(f.clients))
+	slices.SortFunc(clients, func(a, b core.OAuthClient) int { return strings.Compare(a.ID.String(), b.ID.String()) })
+
+	return clients, nil
+}
+
+func (f fakeOAuthClientStore) Update(_ context.Context, params core.UpdateOAuthClientParams) (core.OAuthClient, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, ok := f.clients[params.ID]
+	if !ok {
+		return core.OAuthClient{}, core.ErrNotFound
+	}
+
+	c.Name, c.RedirectURIs, c.GrantTypes = params.Name, params.RedirectURIs, params.GrantTypes
+	c.Scopes = slices.Sorted(slices.Values(params.Scopes))
+	c.Audiences = slices.Sorted(slices.Values(params.Audiences))
+	c.UpdatedAt = time.Now()
+	f.clients[c.ID] = c
+
+	return c, nil
+}
+
+func (f fakeOAuthClientStore) Delete(_ context.Context, id uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.clients[id]; !ok {
+		return core.ErrNotFound
+	}
+
+	delete(f.clients, id)
+	maps.DeleteFunc(f.requests, func(_ uuid.UUID, r core.OAuthAuthorizationRequest) bool { return r.ClientID == id })
+
+	return nil
+}
+
+func (f fakeOAuthClientStore) Authenticate(context.Context, uuid.UUID, string) (core.OAuthClient, error) {
+	return core.OAuthClient{}, core.ErrInvalidCredentials
+}
+
+type fakeOAuthAuthorizationStore struct{ *fakeStores }
+
+func (f fakeOAuthAuthorizationStore) CreateRequest(_ context.Context, params core.CreateOAuthAuthorizationRequestParams) (core.OAuthAuthorizationRequest, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+	r := core.OAuthAuthorizationRequest{
+		ID:            uuid.New(),
+		ClientID:      params.ClientID,
+		RedirectURI:   params.RedirectURI,
+		Scope:         params.Scope,
+		State:         params.State,
+		CodeChallenge: params.CodeChallenge,
+		Nonce:         params.Nonce,
+		Prompt:        params.Prompt,
+		MaxAge:        params.MaxAge,
+		CreatedAt:     now,
+		ExpiresAt:     now.Add(10 * time.Minute),
+	}
+	f.requests[r.ID] = r
+
+	return r, nil
+}
+
+func (f fakeOAuthAuthorizationStore) GetRequest(_ context.Context, id uuid.UUID) (core.OAuthAuthorizationRequest, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	r, ok := f.requests[id]
+	if !ok {
+		return core.OAuthAuthorizationRequest{}, core.ErrNotFound
+	}
+
+	return r, nil
+}
+
+func (f fakeOAuthAuthorizationStore) Approve(_ context.Context, requestID, userID, sessionID uuid.UUID) (core.OAuthAuthorizationRequest, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	r, ok := f.requests[requestID]
+	if !ok {
+		return core.OAuthAuthorizationRequest{}, "", core.ErrNotFound
+	}
+
+	delete(f.requests, requestID)
+
+	code := uuid.NewString()
+	f.codes[code] = core.OAuthAuthorizationCode{
+		ID:            uuid.New(),
+		ClientID:      r.ClientID,
+		UserID:        userID,
+		SessionID:     sessionID,
+		RedirectURI:   r.RedirectURI,
+		Scope:         r.Scope,
+		CodeChallenge: r.CodeChallenge,
+		Nonce:         r.Nonce,
+		ExpiresAt:     time.Now().Add(time.Minute),
+	}
+
+	return r, code, nil
+}
+
+func (f fakeOAuthAuthorizationStore) Deny(_ context.Context, requestID uuid.UUID) (core.OAuthAuthorizationRequest, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	r, ok := f.requests[requestID]
+	if !ok {
+		return core.OAuthAuthorizationRequest{}, core.ErrNotFound
+	}
+
+	delete(f.requests, requestID)
+
+	return r, nil
+}
+
+func (f fakeOAuthAuthorizationStore) Exchange(_ context.Context, clientID uuid.UUID, code string) (core.OAuthAuthorizationCode, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, ok := f.codes[code]
+	if !ok || c.ClientID != clientID {
+		return core.OAuthAuthorizationCode{}, core.ErrInvalidToken
+	}
+
+	delete(f.codes, code)
+
+	return c, nil
+}
+
+func (f fakeOAuthAuthorizationStore) BindRefreshTokenFamily(context.Context, uuid.UUID, uuid.UUID) error {
+	return nil
+}
+
+type fakeOAuthDeviceAuthorizationStore struct{ *fakeStores }
+
+func (f fakeOAuthDeviceAuthorizationStore) Create(_ context.Context, params core.CreateOAuthDeviceAuthorizationParams) (core.OAuthDeviceAuthorization, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	id := uuid.New()
+
+	// User codes are derived from the id, which is also the device code.
+	userCode := make([]byte, 8)
+	for i := range userCode {
+		userCode[i] = "BCDFGHJKLMNPQRSTVWXZ"[id[i]%20]
+	}
+
+	now := time.Now()
+	d := core.OAuthDeviceAuthorization{
+		ID:        id,
+		ClientID:  params.ClientID,
+		UserCode:  string(userCode),
+		Scope:     params.Scope,
+		Status:    core.OAuthDeviceAuthorizationPending,
+		Interval:  5 * time.Second,
+		CreatedAt: now,
+		ExpiresAt: now.Add(10 * time.Minute),
+	}
+	f.devices[d.UserCode] = d
+
+	return d, d.ID.String(), nil
+}
+
+// pending returns the pending authorization of the user code, f.mu must be held.
+func (f fakeOAuthDeviceAuthorizationStore) pending(userCode string) (core.OAuthDeviceAuthorization, error) {
+	code, _ := oauth.NormalizeUserCode(userCode)
+
+	d, ok := f.devices[code]
+	if !ok || d.Status != core.OAuthDeviceAuthorizationPending {
+		return core.OAuthDeviceAuthorization{}, core.ErrNotFound
+	}
+
+	return d, nil
+}
+
+func (f fakeOAuthDeviceAuthorizationStore) Get(_ context.Context, userCode string) (core.OAuthDeviceAuthorization, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.pending(userCode)
+}
+
+func (f fakeOAuthDeviceAuthorizationStore) Approve(_ context.Context, userCode string, userID, sessionID uuid.UUID) (core.OAuthDeviceAuthorization, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	d, err := f.pending(userCode)
+	if err != nil {
+		return core.OAuthDeviceAuthorization{}, err
+	}
+
+	d.Status, d.UserID, d.SessionID = core.OAuthDeviceAuthorizationApproved, userID, sessionID
+	f.devices[d.UserCode] = d
+
+	return d, nil
+}
+
+func (f fakeOAuthDeviceAuthorizationStore) Deny(_ context.Context, userCode string) (core.OAuthDeviceAuthorization, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	d, err := f.pending(userCode)
+	if err != nil {
+		return core.OAuthDeviceAuthorization{}, err
+	}
+
+	d.Status = core.OAuthDeviceAuthorizationDenied
+	f.devices[d.UserCode] = d
+
+	return d, nil
+}
+
+func (f fakeOAuthDeviceAuthorizationStore) Poll(_ context.Context, clientID uuid.UUID, deviceCode string) (core.OAuthDeviceAuthorization, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for code, d := range f.devices {
+		if d.ID.String() != deviceCode || d.ClientID != clientID {
+			continue
+		}
+
+		switch d.Status {
+		case core.OAuthDeviceAuthorizationPending:
+			return core.OAuthDeviceAuthorization{}, core.ErrAuthorizationPending
+		case core.OAuthDeviceAuthorizationDenied:
+			delete(f.devices, code)
+			return core.OAuthDeviceAuthorization{}, core.ErrAuthorizationDenied
+		default:
+			delete(f.devices, code)
+			return d, nil
+		}
+	}
+
+	return core.OAuthDeviceAuthorization{}, core.ErrInvalidToken
+}
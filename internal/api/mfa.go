@@ -0,0 +1,65 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+)
+
+// mfaCode is a request carrying either a TOTP code or a recovery code.
+type mfaCode interface {
+	HasTotpCode() bool
+	GetTotpCode() string
+	HasRecoveryCode() bool
+	GetRecoveryCode() string
+}
+
+// secondFactors verifies second factors of users.
+type secondFactors struct {
+	totp     core.TOTPStore
+	recovery core.RecoveryCodeStore
+	audit    core.AuditLog
+}
+
+// verify returns [core.ErrInvalidCredentials] if code does not match a second factor of the user. Used recovery codes
+// are recorded to audit.
+func (f *secondFactors) verify(ctx context.Context, userID uuid.UUID, code mfaCode, meta core.SessionMetadata) error {
+	switch {
+	case code.HasTotpCode():
+		return f.totp.Verify(ctx, userID, code.GetTotpCode())
+	case code.HasRecoveryCode():
+		if err := f.recovery.Use(ctx, userID, code.GetRecoveryCode()); err != nil {
+			return err
+		}
+
+		f.record(ctx, core.AuditRecoveryCodeUsed, userID, meta)
+		return nil
+	default:
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("api: totp_code or recovery_code is required"))
+	}
+}
+
+// factors returns the factors the user can answer an MFA challenge with.
+func (f *secondFactors) factors(ctx context.Context, userID uuid.UUID) ([]guardianv1.MFAFactor, error) {
+	remaining, err := f.recovery.Remaining(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	factors := []guardianv1.MFAFactor{guardianv1.MFAFactor_MFA_FACTOR_TOTP}
+	if remaining > 0 {
+		factors = append(factors, guardianv1.MFAFactor_MFA_FACTOR_RECOVERY_CODE)
+	}
+
+	return factors, nil
+}
+
+// record records an audit event of the user.
+func (f *secondFactors) record(ctx context.Context, typ core.AuditEventType, userID uuid.UUID, meta core.SessionMetadata) {
+	record(ctx, f.audit, typ, userID, meta)
+}
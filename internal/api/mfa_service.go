@@ -0,0 +1,195 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+	"github.com/gophero/guardian/core/proto/guardian/v1/guardianv1connect"
+)
+
+// MFAService implements [guardianv1connect.MFAServiceHandler].
+type MFAService struct {
+	users   core.UserStore
+	factors *secondFactors
+	auth    *authenticator
+}
+
+var _ guardianv1connect.MFAServiceHandler = (*MFAService)(nil)
+
+// NewMFAService constructs new [MFAService].
+func NewMFAService(
+	users core.UserStore,
+	totp core.TOTPStore,
+	recovery core.RecoveryCodeStore,
+	audit core.AuditLog,
+	sessions core.SessionStore,
+	tokens core.AccessTokenIssuer,
+) *MFAService {
+	return &MFAService{
+		users:   users,
+		factors: &secondFactors{totp: totp, recovery: recovery, audit: audit},
+		auth:    &authenticator{tokens: tokens, sessions: sessions, now: time.Now},
+	}
+}
+
+// GetMFAStatus implements [guardianv1connect.MFAServiceHandler].
+func (s *MFAService) GetMFAStatus(ctx context.Context, req *connect.Request[guardianv1.GetMFAStatusRequest]) (*connect.Response[guardianv1.GetMFAStatusResponse], error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, err := s.factors.totp.Enabled(ctx, p.UserID)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	remaining, err := s.factors.recovery.Remaining(ctx, p.UserID)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.GetMFAStatusResponse_builder{
+		TotpEnabled:            enabled,
+		RecoveryCodesRemaining: int32(remaining),
+	}.Build()), nil
+}
+
+// EnrollTOTP implements [guardianv1connect.MFAServiceHandler].
+func (s *MFAService) EnrollTOTP(ctx context.Context, req *connect.Request[guardianv1.EnrollTOTPRequest]) (*connect.Response[guardianv1.EnrollTOTPResponse], error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.users.Get(ctx, p.UserID)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	account := user.Email
+	if account == "" {
+		account = user.Username
+	}
+
+	enrollment, err := s.factors.totp.Enroll(ctx, user.ID, account)
+	if errors.Is(err, core.ErrAlreadyExists) {
+		return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("api: totp is enabled already"))
+	}
+
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.EnrollTOTPResponse_builder{
+		Secret: enrollment.Secret,
+		Uri:    enrollment.URI,
+		QrCode: enrollment.QRCode,
+	}.Build()), nil
+}
+
+// ConfirmTOTP implements [guardianv1connect.MFAServiceHandler].
+func (s *MFAService) ConfirmTOTP(ctx context.Context, req *connect.Request[guardianv1.ConfirmTOTPRequest]) (*connect.Response[guardianv1.ConfirmTOTPResponse], error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.factors.totp.Confirm(ctx, p.UserID, req.Msg.GetCode())
+	if errors.Is(err, core.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("api: no pending totp enrollment"))
+	}
+
+	if err != nil {
+		return nil, invalidCode(ctx, err)
+	}
+
+	codes, err := s.factors.recovery.Generate(ctx, p.UserID)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	s.factors.record(ctx, core.AuditTOTPEnabled, p.UserID, clientMetadata(req, p.Session.Device))
+
+	return connect.NewResponse(guardianv1.ConfirmTOTPResponse_builder{RecoveryCodes: codes}.Build()), nil
+}
+
+// DisableTOTP implements [guardianv1connect.MFAServiceHandler].
+func (s *MFAService) DisableTOTP(ctx context.Context, req *connect.Request[guardianv1.DisableTOTPRequest]) (*connect.Response[guardianv1.DisableTOTPResponse], error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	meta := clientMetadata(req, p.Session.Device)
+	if err := s.verifyEnabled(ctx, p, req.Msg, meta); err != nil {
+		return nil, err
+	}
+
+	if err := s.factors.totp.Delete(ctx, p.UserID); err != nil && !errors.Is(err, core.ErrNotFound) {
+		return nil, toConnectError(ctx, err)
+	}
+
+	if err := s.factors.recovery.Delete(ctx, p.UserID); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	s.factors.record(ctx, core.AuditTOTPDisabled, p.UserID, meta)
+
+	return connect.NewResponse(&guardianv1.DisableTOTPResponse{}), nil
+}
+
+// RegenerateRecoveryCodes implements [guardianv1connect.MFAServiceHandler].
+func (s *MFAService) RegenerateRecoveryCodes(ctx context.Context, req *connect.Request[guardianv1.RegenerateRecoveryCodesRequest]) (*connect.Response[guardianv1.RegenerateRecoveryCodesResponse], error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	meta := clientMetadata(req, p.Session.Device)
+	if err := s.verifyEnabled(ctx, p, req.Msg, meta); err != nil {
+		return nil, err
+	}
+
+	codes, err := s.factors.recovery.Generate(ctx, p.UserID)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	s.factors.record(ctx, core.AuditRecoveryCodesRegenerated, p.UserID, meta)
+
+	return connect.NewResponse(guardianv1.RegenerateRecoveryCodesResponse_builder{RecoveryCodes: codes}.Build()), nil
+}
+
+// verifyEnabled verifies code of the caller, who has to have TOTP enabled, before a change to their factors.
+func (s *MFAService) verifyEnabled(ctx context.Context, p principal, code mfaCode, meta core.SessionMetadata) error {
+	enabled, err := s.factors.totp.Enabled(ctx, p.UserID)
+	if err != nil {
+		return toConnectError(ctx, err)
+	}
+
+	if !enabled {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("api: totp is not enabled"))
+	}
+
+	if err := s.factors.verify(ctx, p.UserID, code, meta); err != nil {
+		return invalidCode(ctx, err)
+	}
+
+	return nil
+}
+
+// invalidCode maps [core.ErrInvalidCredentials] of a second factor to [connect.CodePermissionDenied], as the caller
+// itself is authenticated.
+func invalidCode(ctx context.Context, err error) error {
+	if errors.Is(err, core.ErrInvalidCredentials) {
+		return connect.NewError(connect.CodePermissionDenied, errors.New("api: invalid code"))
+	}
+
+	return toConnectError(ctx, err)
+}
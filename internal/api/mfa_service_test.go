@@ -0,0 +1,187 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+)
+
+func TestMFAService(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClients(t)
+
+	signedUp := signUp(t, c, "ada@example.com", "ada")
+	token := signedUp.GetTokens().GetAccessToken()
+	userID := uuid.MustParse(signedUp.GetUser().GetId())
+
+	signIn := func(t *testing.T) *guardianv1.SignInResponse {
+		t.Helper()
+
+		res, err := c.auth.SignIn(ctx, connect.NewRequest(guardianv1.SignInRequest_builder{
+			Identifier: "ada",
+			Password:   "correct horse battery staple",
+			Device:     "laptop",
+		}.Build()))
+		require.NoError(t, err)
+
+		return res.Msg
+	}
+
+	var recoveryCodes []string
+
+	t.Run("enroll", func(t *testing.T) {
+		res, err := c.mfa.EnrollTOTP(ctx, withBearer(&guardianv1.EnrollTOTPRequest{}, token))
+		require.NoError(t, err)
+		require.Equal(t, "otpauth://totp/Guardian:ada@example.com", res.Msg.GetUri())
+		require.NotEmpty(t, res.Msg.GetQrCode())
+
+		// Pending enrollments are not used for sign in.
+		require.True(t, signIn(t).HasTokens())
+
+		_, err = c.mfa.ConfirmTOTP(ctx, withBearer(guardianv1.ConfirmTOTPRequest_builder{Code: "000000"}.Build(), token))
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		confirmed, err := c.mfa.ConfirmTOTP(ctx, withBearer(guardianv1.ConfirmTOTPRequest_builder{Code: c.totp.currentCode(userID)}.Build(), token))
+		require.NoError(t, err)
+		recoveryCodes = confirmed.Msg.GetRecoveryCodes()
+		require.Len(t, recoveryCodes, 3)
+
+		status, err := c.mfa.GetMFAStatus(ctx, withBearer(&guardianv1.GetMFAStatusRequest{}, token))
+		require.NoError(t, err)
+		require.True(t, status.Msg.GetTotpEnabled())
+		require.EqualValues(t, 3, status.Msg.GetRecoveryCodesRemaining())
+
+		_, err = c.mfa.EnrollTOTP(ctx, withBearer(&guardianv1.EnrollTOTPRequest{}, token))
+		requireCode(t, connect.CodeAlreadyExists, err)
+	})
+
+	t.Run("sign in with totp", func(t *testing.T) {
+		res := signIn(t)
+		require.False(t, res.HasUser())
+		require.False(t, res.HasSession())
+		require.False(t, res.HasTokens())
+		require.Equal(t, []guardianv1.MFAFactor{
+			guardianv1.MFAFactor_MFA_FACTOR_TOTP,
+			guardianv1.MFAFactor_MFA_FACTOR_RECOVERY_CODE,
+		}, res.GetMfaChallenge().GetFactors())
+
+		challenge := res.GetMfaChallenge().GetToken()
+
+		// The code used to confirm the enrollment can not be replayed.
+		_, err := c.auth.VerifyMFA(ctx, connect.NewRequest(guardianv1.VerifyMFARequest_builder{
+			ChallengeToken: challenge,
+			TotpCode:       proto.String(c.totp.currentCode(userID)),
+		}.Build()))
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		c.totp.nextStep(userID)
+
+		verified, err := c.auth.VerifyMFA(ctx, connect.NewRequest(guardianv1.VerifyMFARequest_builder{
+			ChallengeToken: challenge,
+			TotpCode:       proto.String(c.totp.currentCode(userID)),
+		}.Build()))
+		require.NoError(t, err)
+		require.Equal(t, signedUp.GetUser().GetId(), verified.Msg.GetUser().GetId())
+		require.Equal(t, "laptop", verified.Msg.GetSession().GetDevice())
+		require.NotEmpty(t, verified.Msg.GetTokens().GetAccessToken())
+
+		// A challenge completes only once.
+		c.totp.nextStep(userID)
+
+		_, err = c.auth.VerifyMFA(ctx, connect.NewRequest(guardianv1.VerifyMFARequest_builder{
+			ChallengeToken: challenge,
+			TotpCode:       proto.String(c.totp.currentCode(userID)),
+		}.Build()))
+		requireCode(t, connect.CodeUnauthenticated, err)
+	})
+
+	t.Run("sign in with recovery code", func(t *testing.T) {
+		verify := func() error {
+			_, err := c.auth.VerifyMFA(ctx, connect.NewRequest(guardianv1.VerifyMFARequest_builder{
+				ChallengeToken: signIn(t).GetMfaChallenge().GetToken(),
+				RecoveryCode:   proto.String(recoveryCodes[0]),
+			}.Build()))
+			return err
+		}
+
+		require.NoError(t, verify())
+		requireCode(t, connect.CodeUnauthenticated, verify())
+	})
+
+	t.Run("attempt limit", func(t *testing.T) {
+		challenge := signIn(t).GetMfaChallenge().GetToken()
+
+		for range 3 {
+			_, err := c.auth.VerifyMFA(ctx, connect.NewRequest(guardianv1.VerifyMFARequest_builder{
+				ChallengeToken: challenge,
+				TotpCode:       proto.String("999999"),
+			}.Build()))
+			requireCode(t, connect.CodeUnauthenticated, err)
+		}
+
+		c.totp.nextStep(userID)
+
+		_, err := c.auth.VerifyMFA(ctx, connect.NewRequest(guardianv1.VerifyMFARequest_builder{
+			ChallengeToken: challenge,
+			TotpCode:       proto.String(c.totp.currentCode(userID)),
+		}.Build()))
+		requireCode(t, connect.CodeUnauthenticated, err)
+	})
+
+	t.Run("missing code", func(t *testing.T) {
+		_, err := c.auth.VerifyMFA(ctx, connect.NewRequest(guardianv1.VerifyMFARequest_builder{
+			ChallengeToken: signIn(t).GetMfaChallenge().GetToken(),
+		}.Build()))
+		requireCode(t, connect.CodeInvalidArgument, err)
+	})
+
+	t.Run("regenerate recovery codes", func(t *testing.T) {
+		c.totp.nextStep(userID)
+
+		res, err := c.mfa.RegenerateRecoveryCodes(ctx, withBearer(guardianv1.RegenerateRecoveryCodesRequest_builder{
+			TotpCode: proto.String(c.totp.currentCode(userID)),
+		}.Build(), token))
+		require.NoError(t, err)
+		require.NotEqual(t, recoveryCodes, res.Msg.GetRecoveryCodes())
+		recoveryCodes = res.Msg.GetRecoveryCodes()
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		_, err := c.mfa.DisableTOTP(ctx, withBearer(guardianv1.DisableTOTPRequest_builder{
+			RecoveryCode: proto.String("wrong"),
+		}.Build(), token))
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		_, err = c.mfa.DisableTOTP(ctx, withBearer(guardianv1.DisableTOTPRequest_builder{
+			RecoveryCode: proto.String(recoveryCodes[0]),
+		}.Build(), token))
+		require.NoError(t, err)
+
+		status, err := c.mfa.GetMFAStatus(ctx, withBearer(&guardianv1.GetMFAStatusRequest{}, token))
+		require.NoError(t, err)
+		require.False(t, status.Msg.GetTotpEnabled())
+		require.Zero(t, status.Msg.GetRecoveryCodesRemaining())
+
+		require.True(t, signIn(t).HasTokens())
+
+		_, err = c.mfa.DisableTOTP(ctx, withBearer(guardianv1.DisableTOTPRequest_builder{
+			RecoveryCode: proto.String(recoveryCodes[1]),
+		}.Build(), token))
+		requireCode(t, connect.CodeFailedPrecondition, err)
+	})
+
+	require.Equal(t, []core.AuditEventType{
+		core.AuditTOTPEnabled,
+		core.AuditRecoveryCodeUsed,
+		core.AuditRecoveryCodesRegenerated,
+		core.AuditRecoveryCodeUsed,
+		core.AuditTOTPDisabled,
+	}, c.audit.auditTypes())
+}
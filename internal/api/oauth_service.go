@@ -0,0 +1,296 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+	"github.com/gophero/guardian/core/proto/guardian/v1/guardianv1connect"
+	"github.com/gophero/guardian/internal/oauth"
+)
+
+// OAuthService implements [guardianv1connect.OAuthServiceHandler].
+type OAuthService struct {
+	clients        core.OAuthClientStore
+	authorizations core.OAuthAuthorizationStore
+	devices        core.OAuthDeviceAuthorizationStore
+	rbac           core.RBACStore
+	auth           *authenticator
+}
+
+var _ guardianv1connect.OAuthServiceHandler = (*OAuthService)(nil)
+
+// NewOAuthService constructs new [OAuthService].
+func NewOAuthService(
+	clients core.OAuthClientStore,
+	authorizations core.OAuthAuthorizationStore,
+	devices core.OAuthDeviceAuthorizationStore,
+	rbac core.RBACStore,
+	sessions core.SessionStore,
+	tokens core.AccessTokenIssuer,
+) *OAuthService {
+	return &OAuthService{
+		clients:        clients,
+		authorizations: authorizations,
+		devices:        devices,
+		rbac:           rbac,
+		auth:           &authenticator{tokens: tokens, sessions: sessions, now: time.Now},
+	}
+}
+
+// requireManage authenticates the caller and requires the [core.PermissionOAuthClientsManage] permission.
+func (s *OAuthService) requireManage(ctx context.Context, req connect.AnyRequest) error {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return err
+	}
+
+	return requirePermission(ctx, s.rbac, p, core.PermissionOAuthClientsManage, uuid.Nil, callerAttributes(req, s.auth.now()))
+}
+
+// CreateOAuthClient implements [guardianv1connect.OAuthServiceHandler].
+func (s *OAuthService) CreateOAuthClient(ctx context.Context, req *connect.Request[guardianv1.CreateOAuthClientRequest]) (*connect.Response[guardianv1.CreateOAuthClientResponse], error) {
+	if err := s.requireManage(ctx, req); err != nil {
+		return nil, err
+	}
+
+	client, secret, err := s.clients.Create(ctx, core.CreateOAuthClientParams{
+		Name:         req.Msg.GetName(),
+		Type:         fromOAuthClientType(req.Msg.GetType()),
+		RedirectURIs: req.Msg.GetRedirectUris(),
+		GrantTypes:   req.Msg.GetGrantTypes(),
+		Scopes:       req.Msg.GetScopes(),
+		Audiences:    req.Msg.GetAudiences(),
+	})
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.CreateOAuthClientResponse_builder{Client: toOAuthClient(client), ClientSecret: secret}.Build()), nil
+}
+
+// GetOAuthClient implements [guardianv1connect.OAuthServiceHandler].
+func (s *OAuthService) GetOAuthClient(ctx context.Context, req *connect.Request[guardianv1.GetOAuthClientRequest]) (*connect.Response[guardianv1.GetOAuthClientResponse], error) {
+	if err := s.requireManage(ctx, req); err != nil {
+		return nil, err
+	}
+
+	id, err := parseID("id", req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := s.clients.Get(ctx, id)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.GetOAuthClientResponse_builder{Client: toOAuthClient(client)}.Build()), nil
+}
+
+// ListOAuthClients implements [guardianv1connect.OAuthServiceHandler].
+func (s *OAuthService) ListOAuthClients(ctx context.Context, req *connect.Request[guardianv1.ListOAuthClientsRequest]) (*connect.Response[guardianv1.ListOAuthClientsResponse], error) {
+	if err := s.requireManage(ctx, req); err != nil {
+		return nil, err
+	}
+
+	clients, err := s.clients.List(ctx)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	res := make([]*guardianv1.OAuthClient, 0, len(clients))
+	for _, c := range clients {
+		res = append(res, toOAuthClient(c))
+	}
+
+	return connect.NewResponse(guardianv1.ListOAuthClientsResponse_builder{Clients: res}.Build()), nil
+}
+
+// UpdateOAuthClient implements [guardianv1connect.OAuthServiceHandler].
+func (s *OAuthService) UpdateOAuthClient(ctx context.Context, req *connect.Request[guardianv1.UpdateOAuthClientRequest]) (*connect.Response[guardianv1.UpdateOAuthClientResponse], error) {
+	if err := s.requireManage(ctx, req); err != nil {
+		return nil, err
+	}
+
+	id, err := parseID("id", req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := s.clients.Update(ctx, core.UpdateOAuthClientParams{
+		ID:           id,
+		Name:         req.Msg.GetName(),
+		RedirectURIs: req.Msg.GetRedirectUris(),
+		GrantTypes:   req.Msg.GetGrantTypes(),
+		Scopes:       req.Msg.GetScopes(),
+		Audiences:    req.Msg.GetAudiences(),
+	})
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.UpdateOAuthClientResponse_builder{Client: toOAuthClient(client)}.Build()), nil
+}
+
+// DeleteOAuthClient implements [guardianv1connect.OAuthServiceHandler].
+func (s *OAuthService) DeleteOAuthClient(ctx context.Context, req *connect.Request[guardianv1.DeleteOAuthClientRequest]) (*connect.Response[guardianv1.DeleteOAuthClientResponse], error) {
+	if err := s.requireManage(ctx, req); err != nil {
+		return nil, err
+	}
+
+	id, err := parseID("id", req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.clients.Delete(ctx, id); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.DeleteOAuthClientResponse{}), nil
+}
+
+// GetAuthorization implements [guardianv1connect.OAuthServiceHandler].
+func (s *OAuthService) GetAuthorization(ctx context.Context, req *connect.Request[guardianv1.GetAuthorizationRequest]) (*connect.Response[guardianv1.GetAuthorizationResponse], error) {
+	if _, err := s.auth.authenticate(ctx, req.Header()); err != nil {
+		return nil, err
+	}
+
+	id, err := parseID("id", req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := s.authorizations.GetRequest(ctx, id)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	client, err := s.clients.Get(ctx, r.ClientID)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.GetAuthorizationResponse_builder{Authorization: toPendingAuthorization(r, client)}.Build()), nil
+}
+
+// ApproveAuthorization implements [guardianv1connect.OAuthServiceHandler].
+func (s *OAuthService) ApproveAuthorization(ctx context.Context, req *connect.Request[guardianv1.ApproveAuthorizationRequest]) (*connect.Response[guardianv1.ApproveAuthorizationResponse], error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := parseID("id", req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := s.authorizations.GetRequest(ctx, id)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	// The session authenticated the caller when it was created.
+	if pending.RequiresLogin(p.Session.CreatedAt, s.auth.now()) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("api: the request requires to sign in again"))
+	}
+
+	r, code, err := s.authorizations.Approve(ctx, id, p.UserID, p.Session.ID)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	u, err := oauth.CodeRedirect(r, code)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("api: approve authorization: %w", err))
+	}
+
+	return connect.NewResponse(guardianv1.ApproveAuthorizationResponse_builder{RedirectUrl: u}.Build()), nil
+}
+
+// DenyAuthorization implements [guardianv1connect.OAuthServiceHandler].
+func (s *OAuthService) DenyAuthorization(ctx context.Context, req *connect.Request[guardianv1.DenyAuthorizationRequest]) (*connect.Response[guardianv1.DenyAuthorizationResponse], error) {
+	if _, err := s.auth.authenticate(ctx, req.Header()); err != nil {
+		return nil, err
+	}
+
+	id, err := parseID("id", req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	code := req.Msg.GetError()
+	if code == "" {
+		code = "access_denied"
+	}
+
+	if !oauth.ValidDenialError(code) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("api: unsupported error %s", code))
+	}
+
+	r, err := s.authorizations.Deny(ctx, id)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	u, err := oauth.DeniedRedirect(r, code)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("api: deny authorization: %w", err))
+	}
+
+	return connect.NewResponse(guardianv1.DenyAuthorizationResponse_builder{RedirectUrl: u}.Build()), nil
+}
+
+// GetDeviceAuthorization implements [guardianv1connect.OAuthServiceHandler].
+func (s *OAuthService) GetDeviceAuthorization(ctx context.Context, req *connect.Request[guardianv1.GetDeviceAuthorizationRequest]) (*connect.Response[guardianv1.GetDeviceAuthorizationResponse], error) {
+	if _, err := s.auth.authenticate(ctx, req.Header()); err != nil {
+		return nil, err
+	}
+
+	d, err := s.devices.Get(ctx, req.Msg.GetUserCode())
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	client, err := s.clients.Get(ctx, d.ClientID)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.GetDeviceAuthorizationResponse_builder{Authorization: toPendingDeviceAuthorization(d, client)}.Build()), nil
+}
+
+// ApproveDeviceAuthorization implements [guardianv1connect.OAuthServiceHandler].
+func (s *OAuthService) ApproveDeviceAuthorization(ctx context.Context, req *connect.Request[guardianv1.ApproveDeviceAuthorizationRequest]) (*connect.Response[guardianv1.ApproveDeviceAuthorizationResponse], error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.devices.Approve(ctx, req.Msg.GetUserCode(), p.UserID, p.Session.ID); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.ApproveDeviceAuthorizationResponse{}), nil
+}
+
+// DenyDeviceAuthorization implements [guardianv1connect.OAuthServiceHandler].
+func (s *OAuthService) DenyDeviceAuthorization(ctx context.Context, req *connect.Request[guardianv1.DenyDeviceAuthorizationRequest]) (*connect.Response[guardianv1.DenyDeviceAuthorizationResponse], error) {
+	if _, err := s.auth.authenticate(ctx, req.Header()); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.devices.Deny(ctx, req.Msg.GetUserCode()); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.DenyDeviceAuthorizationResponse{}), nil
+}
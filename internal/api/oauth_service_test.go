@@ -0,0 +1,254 @@
+package api
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+)
+
+func TestOAuthService(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClients(t)
+
+	admin := signUp(t, c, "ada@example.com", "ada")
+	adminToken := admin.GetTokens().GetAccessToken()
+	user := signUp(t, c, "bob@example.com", "bob")
+	userToken := user.GetTokens().GetAccessToken()
+
+	_, err := c.rbac.CreatePermission(ctx, core.PermissionOAuthClientsManage, "")
+	require.NoError(t, err)
+
+	role, err := c.rbac.CreateRole(ctx, core.CreateRoleParams{Name: "guardian.admin"})
+	require.NoError(t, err)
+	require.NoError(t, c.rbac.GrantPermission(ctx, role.ID, core.PermissionOAuthClientsManage))
+
+	_, err = c.rbac.Assign(ctx, uuid.MustParse(admin.GetUser().GetId()), role.ID, uuid.Nil, "")
+	require.NoError(t, err)
+
+	create := func(t *testing.T, typ guardianv1.OAuthClientType) (*guardianv1.OAuthClient, string) {
+		t.Helper()
+
+		res, err := c.oauth.CreateOAuthClient(ctx, withBearer(guardianv1.CreateOAuthClientRequest_builder{
+			Name:         "web",
+			Type:         typ,
+			RedirectUris: []string{"https://app.example.com/callback"},
+			GrantTypes:   []string{core.OAuthGrantAuthorizationCode},
+			Scopes:       []string{"profile", "documents.read"},
+		}.Build(), adminToken))
+		require.NoError(t, err)
+
+		return res.Msg.GetClient(), res.Msg.GetClientSecret()
+	}
+
+	t.Run("manages clients", func(t *testing.T) {
+		_, err := c.oauth.ListOAuthClients(ctx, connect.NewRequest(&guardianv1.ListOAuthClientsRequest{}))
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		_, err = c.oauth.ListOAuthClients(ctx, withBearer(&guardianv1.ListOAuthClientsRequest{}, userToken))
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		_, err = c.oauth.CreateOAuthClient(ctx, withBearer(guardianv1.CreateOAuthClientRequest_builder{Name: "web"}.Build(), adminToken))
+		requireCode(t, connect.CodeInvalidArgument, err)
+
+		client, secret := create(t, guardianv1.OAuthClientType_O_AUTH_CLIENT_TYPE_CONFIDENTIAL)
+		require.NotEmpty(t, secret)
+		require.Equal(t, []string{"documents.read", "profile"}, client.GetScopes())
+
+		public, secret := create(t, guardianv1.OAuthClientType_O_AUTH_CLIENT_TYPE_PUBLIC)
+		require.Empty(t, secret)
+		require.Equal(t, guardianv1.OAuthClientType_O_AUTH_CLIENT_TYPE_PUBLIC, public.GetType())
+
+		updated, err := c.oauth.UpdateOAuthClient(ctx, withBearer(guardianv1.UpdateOAuthClientRequest_builder{
+			Id:           client.GetId(),
+			Name:         "portal",
+			RedirectUris: client.GetRedirectUris(),
+			GrantTypes:   []string{core.OAuthGrantAuthorizationCode, core.OAuthGrantRefreshToken},
+			Audiences:    []string{"https://api.example.com"},
+		}.Build(), adminToken))
+		require.NoError(t, err)
+		require.Equal(t, "portal", updated.Msg.GetClient().GetName())
+		require.Empty(t, updated.Msg.GetClient().GetScopes())
+		require.Equal(t, []string{"https://api.example.com"}, updated.Msg.GetClient().GetAudiences())
+
+		got, err := c.oauth.GetOAuthClient(ctx, withBearer(guardianv1.GetOAuthClientRequest_builder{Id: client.GetId()}.Build(), adminToken))
+		require.NoError(t, err)
+		require.Equal(t, "portal", got.Msg.GetClient().GetName())
+
+		_, err = c.oauth.DeleteOAuthClient(ctx, withBearer(guardianv1.DeleteOAuthClientRequest_builder{Id: client.GetId()}.Build(), adminToken))
+		require.NoError(t, err)
+
+		_, err = c.oauth.GetOAuthClient(ctx, withBearer(guardianv1.GetOAuthClientRequest_builder{Id: client.GetId()}.Build(), adminToken))
+		requireCode(t, connect.CodeNotFound, err)
+	})
+
+	t.Run("answers authorizations", func(t *testing.T) {
+		client, _ := create(t, guardianv1.OAuthClientType_O_AUTH_CLIENT_TYPE_PUBLIC)
+
+		pending := func(t *testing.T) string {
+			t.Helper()
+
+			r, err := c.requests.CreateRequest(ctx, core.CreateOAuthAuthorizationRequestParams{
+				ClientID:      uuid.MustParse(client.GetId()),
+				RedirectURI:   "https://app.example.com/callback",
+				Scope:         []string{"profile"},
+				State:         "xyz",
+				CodeChallenge: "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+			})
+			require.NoError(t, err)
+
+			return r.ID.String()
+		}
+
+		id := pending(t)
+
+		_, err := c.oauth.GetAuthorization(ctx, connect.NewRequest(guardianv1.GetAuthorizationRequest_builder{Id: id}.Build()))
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		got, err := c.oauth.GetAuthorization(ctx, withBearer(guardianv1.GetAuthorizationRequest_builder{Id: id}.Build(), userToken))
+		require.NoError(t, err)
+		require.Equal(t, "web", got.Msg.GetAuthorization().GetClientName())
+		require.Equal(t, []string{"profile"}, got.Msg.GetAuthorization().GetScopes())
+
+		approved, err := c.oauth.ApproveAuthorization(ctx, withBearer(guardianv1.ApproveAuthorizationRequest_builder{Id: id}.Build(), userToken))
+		require.NoError(t, err)
+
+		u, err := url.Parse(approved.Msg.GetRedirectUrl())
+		require.NoError(t, err)
+		require.Equal(t, "app.example.com", u.Host)
+		require.Equal(t, "xyz", u.Query().Get("state"))
+
+		code, err := c.requests.Exchange(ctx, uuid.MustParse(client.GetId()), u.Query().Get("code"))
+		require.NoError(t, err)
+		require.Equal(t, user.GetUser().GetId(), code.UserID.String())
+		require.Equal(t, user.GetSession().GetId(), code.SessionID.String())
+
+		// Requests can be answered once.
+		_, err = c.oauth.DenyAuthorization(ctx, withBearer(guardianv1.DenyAuthorizationRequest_builder{Id: id}.Build(), userToken))
+		requireCode(t, connect.CodeNotFound, err)
+
+		denied, err := c.oauth.DenyAuthorization(ctx, withBearer(guardianv1.DenyAuthorizationRequest_builder{Id: pending(t)}.Build(), userToken))
+		require.NoError(t, err)
+
+		u, err = url.Parse(denied.Msg.GetRedirectUrl())
+		require.NoError(t, err)
+		require.Equal(t, "access_denied", u.Query().Get("error"))
+		require.Equal(t, "xyz", u.Query().Get("state"))
+	})
+
+	t.Run("enforces openid connect prompts", func(t *testing.T) {
+		client, _ := create(t, guardianv1.OAuthClientType_O_AUTH_CLIENT_TYPE_PUBLIC)
+
+		maxAge := time.Hour
+		r, err := c.requests.CreateRequest(ctx, core.CreateOAuthAuthorizationRequestParams{
+			ClientID:      uuid.MustParse(client.GetId()),
+			RedirectURI:   "https://app.example.com/callback",
+			Scope:         []string{"profile"},
+			CodeChallenge: "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+			Prompt:        []string{core.PromptLogin},
+			MaxAge:        &maxAge,
+		})
+		require.NoError(t, err)
+
+		id := r.ID.String()
+
+		got, err := c.oauth.GetAuthorization(ctx, withBearer(guardianv1.GetAuthorizationRequest_builder{Id: id}.Build(), userToken))
+		require.NoError(t, err)
+		require.Equal(t, []string{core.PromptLogin}, got.Msg.GetAuthorization().GetPrompt())
+		require.Equal(t, time.Hour, got.Msg.GetAuthorization().GetMaxAge().AsDuration())
+
+		// The session of the caller was created before the request.
+		_, err = c.oauth.ApproveAuthorization(ctx, withBearer(guardianv1.ApproveAuthorizationRequest_builder{Id: id}.Build(), userToken))
+		requireCode(t, connect.CodeFailedPrecondition, err)
+
+		signedIn, err := c.auth.SignIn(ctx, connect.NewRequest(guardianv1.SignInRequest_builder{
+			Identifier: "bob",
+			Password:   "correct horse battery staple",
+		}.Build()))
+		require.NoError(t, err)
+
+		_, err = c.oauth.ApproveAuthorization(ctx, withBearer(guardianv1.ApproveAuthorizationRequest_builder{Id: id}.Build(), signedIn.Msg.GetTokens().GetAccessToken()))
+		require.NoError(t, err)
+
+		r, err = c.requests.CreateRequest(ctx, core.CreateOAuthAuthorizationRequestParams{
+			ClientID:      uuid.MustParse(client.GetId()),
+			RedirectURI:   "https://app.example.com/callback",
+			Scope:         []string{"profile"},
+			CodeChallenge: "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+			Prompt:        []string{core.PromptNone},
+		})
+		require.NoError(t, err)
+
+		_, err = c.oauth.DenyAuthorization(ctx, withBearer(guardianv1.DenyAuthorizationRequest_builder{Id: r.ID.String(), Error: "server_error"}.Build(), userToken))
+		requireCode(t, connect.CodeInvalidArgument, err)
+
+		denied, err := c.oauth.DenyAuthorization(ctx, withBearer(guardianv1.DenyAuthorizationRequest_builder{Id: r.ID.String(), Error: "login_required"}.Build(), userToken))
+		require.NoError(t, err)
+
+		u, err := url.Parse(denied.Msg.GetRedirectUrl())
+		require.NoError(t, err)
+		require.Equal(t, "login_required", u.Query().Get("error"))
+	})
+
+	t.Run("answers device authorizations", func(t *testing.T) {
+		client, _ := create(t, guardianv1.OAuthClientType_O_AUTH_CLIENT_TYPE_PUBLIC)
+		clientID := uuid.MustParse(client.GetId())
+
+		d, deviceCode, err := c.devices.Create(ctx, core.CreateOAuthDeviceAuthorizationParams{ClientID: clientID, Scope: []string{"profile"}})
+		require.NoError(t, err)
+
+		// Users may type the code in lower case and without dash.
+		userCode := strings.ToLower(d.UserCode)
+
+		_, err = c.oauth.GetDeviceAuthorization(ctx, connect.NewRequest(guardianv1.GetDeviceAuthorizationRequest_builder{UserCode: userCode}.Build()))
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		got, err := c.oauth.GetDeviceAuthorization(ctx, withBearer(guardianv1.GetDeviceAuthorizationRequest_builder{UserCode: userCode}.Build(), userToken))
+		require.NoError(t, err)
+		require.Equal(t, d.UserCode[:4]+"-"+d.UserCode[4:], got.Msg.GetAuthorization().GetUserCode())
+		require.Equal(t, "web", got.Msg.GetAuthorization().GetClientName())
+		require.Equal(t, []string{"profile"}, got.Msg.GetAuthorization().GetScopes())
+
+		_, err = c.oauth.ApproveDeviceAuthorization(ctx, withBearer(guardianv1.ApproveDeviceAuthorizationRequest_builder{UserCode: userCode}.Build(), userToken))
+		require.NoError(t, err)
+
+		approved, err := c.devices.Poll(ctx, clientID, deviceCode)
+		require.NoError(t, err)
+		require.Equal(t, user.GetUser().GetId(), approved.UserID.String())
+		require.Equal(t, user.GetSession().GetId(), approved.SessionID.String())
+
+		// Authorizations can be answered once.
+		_, err = c.oauth.DenyDeviceAuthorization(ctx, withBearer(guardianv1.DenyDeviceAuthorizationRequest_builder{UserCode: userCode}.Build(), userToken))
+		requireCode(t, connect.CodeNotFound, err)
+
+		d, deviceCode, err = c.devices.Create(ctx, core.CreateOAuthDeviceAuthorizationParams{ClientID: clientID, Scope: []string{"profile"}})
+		require.NoError(t, err)
+
+		_, err = c.oauth.DenyDeviceAuthorization(ctx, withBearer(guardianv1.DenyDeviceAuthorizationRequest_builder{UserCode: d.UserCode}.Build(), userToken))
+		require.NoError(t, err)
+
+		_, err = c.devices.Poll(ctx, clientID, deviceCode)
+		require.ErrorIs(t, err, core.ErrAuthorizationDenied)
+	})
+
+	t.Run("rejects tokens of clients", func(t *testing.T) {
+		token, _, err := c.tokens.Issue(ctx, core.AccessTokenParams{
+			Subject:   admin.GetUser().GetId(),
+			SessionID: uuid.MustParse(admin.GetSession().GetId()),
+			ClientID:  uuid.NewString(),
+			Scope:     []string{"profile"},
+		})
+		require.NoError(t, err)
+
+		_, err = c.oauth.ListOAuthClients(ctx, withBearer(&guardianv1.ListOAuthClientsRequest{}, token))
+		requireCode(t, connect.CodeUnauthenticated, err)
+	})
+}
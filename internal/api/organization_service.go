@@ -0,0 +1,565 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+	"github.com/gophero/guardian/core/proto/guardian/v1/guardianv1connect"
+	"github.com/gophero/guardian/internal/mail"
+)
+
+// organizationRanks orders the organization roles by the privileges they grant.
+var organizationRanks = map[core.OrganizationRole]int{
+	core.OrganizationRoleMember: 1,
+	core.OrganizationRoleAdmin:  2,
+	core.OrganizationRoleOwner:  3,
+}
+
+// OrganizationService implements [guardianv1connect.OrganizationServiceHandler].
+type OrganizationService struct {
+	orgs   core.OrganizationStore
+	users  core.UserStore
+	mailer core.Mailer
+	auth   *authenticator
+}
+
+var _ guardianv1connect.OrganizationServiceHandler = (*OrganizationService)(nil)
+
+// NewOrganizationService constructs new [OrganizationService]. Callers are authorized by their role in the
+// organization.
+func NewOrganizationService(
+	orgs core.OrganizationStore,
+	users core.UserStore,
+	mailer core.Mailer,
+	sessions core.SessionStore,
+	tokens core.AccessTokenIssuer,
+) *OrganizationService {
+	return &OrganizationService{
+		orgs:   orgs,
+		users:  users,
+		mailer: mailer,
+		auth:   &authenticator{tokens: tokens, sessions: sessions, now: time.Now},
+	}
+}
+
+// authorize authenticates the caller and returns its membership of the organization if its role is at least role.
+func (s *OrganizationService) authorize(ctx context.Context, req connect.AnyRequest, orgID uuid.UUID, role core.OrganizationRole) (core.OrganizationMembership, error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return core.OrganizationMembership{}, err
+	}
+
+	return s.requireRole(ctx, orgID, p.UserID, role)
+}
+
+// requireRole returns the membership of the user if its role is at least role. Users which are not members are denied
+// like members lacking the role, so organizations are not revealed.
+func (s *OrganizationService) requireRole(ctx context.Context, orgID, userID uuid.UUID, role core.OrganizationRole) (core.OrganizationMembership, error) {
+	m, err := s.orgs.GetMembership(ctx, orgID, userID)
+	if errors.Is(err, core.ErrNotFound) || err == nil && organizationRanks[m.Role] < organizationRanks[role] {
+		return core.OrganizationMembership{}, roleRequired(role)
+	}
+
+	if err != nil {
+		return core.OrganizationMembership{}, toConnectError(ctx, err)
+	}
+
+	return m, nil
+}
+
+func roleRequired(role core.OrganizationRole) error {
+	return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("api: organization role %s is required", role))
+}
+
+// caller authenticates the caller and returns it as user.
+func (s *OrganizationService) caller(ctx context.Context, req connect.AnyRequest) (core.User, error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return core.User{}, err
+	}
+
+	user, err := s.users.Get(ctx, p.UserID)
+	if err != nil {
+		return core.User{}, toConnectError(ctx, err)
+	}
+
+	return user, nil
+}
+
+// invitee returns the authenticated user answering an invitation. Invitations are bound to email addresses, so only
+// users who verified theirs can answer them.
+func (s *OrganizationService) invitee(ctx context.Context, req connect.AnyRequest) (core.User, error) {
+	user, err := s.caller(ctx, req)
+	if err != nil {
+		return core.User{}, err
+	}
+
+	if user.EmailVerifiedAt == nil {
+		return core.User{}, connect.NewError(connect.CodeFailedPrecondition, errors.New("api: email is not verified"))
+	}
+
+	return user, nil
+}
+
+// CreateOrganization implements [guardianv1connect.OrganizationServiceHandler].
+func (s *OrganizationService) CreateOrganization(ctx context.Context, req *connect.Request[guardianv1.CreateOrganizationRequest]) (*connect.Response[guardianv1.CreateOrganizationResponse], error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	org, _, err := s.orgs.Create(ctx, core.CreateOrganizationParams{
+		Slug: req.Msg.GetSlug(),
+		Name: req.Msg.GetName(),
+	}, p.UserID)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.CreateOrganizationResponse_builder{Organization: toOrganization(org)}.Build()), nil
+}
+
+// GetOrganization implements [guardianv1connect.OrganizationServiceHandler].
+func (s *OrganizationService) GetOrganization(ctx context.Context, req *connect.Request[guardianv1.GetOrganizationRequest]) (*connect.Response[guardianv1.GetOrganizationResponse], error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	msg := req.Msg
+
+	var org core.Organization
+	switch {
+	case msg.GetId() != "" && msg.GetSlug() != "":
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("api: id and slug cannot be combined"))
+	case msg.GetSlug() != "":
+		org, err = s.orgs.GetBySlug(ctx, msg.GetSlug())
+	default:
+		var id uuid.UUID
+		if id, err = parseOrgID(msg.GetId()); err != nil {
+			return nil, err
+		}
+
+		org, err = s.orgs.Get(ctx, id)
+	}
+	if errors.Is(err, core.ErrNotFound) {
+		return nil, roleRequired(core.OrganizationRoleMember)
+	}
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	if _, err := s.requireRole(ctx, org.ID, p.UserID, core.OrganizationRoleMember); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(guardianv1.GetOrganizationResponse_builder{Organization: toOrganization(org)}.Build()), nil
+}
+
+// ListOrganizations implements [guardianv1connect.OrganizationServiceHandler].
+func (s *OrganizationService) ListOrganizations(ctx context.Context, req *connect.Request[guardianv1.ListOrganizationsRequest]) (*connect.Response[guardianv1.ListOrganizationsResponse], error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	orgs, err := s.orgs.ListByUser(ctx, p.UserID)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	res := make([]*guardianv1.Organization, 0, len(orgs))
+	for _, o := range orgs {
+		res = append(res, toOrganization(o))
+	}
+
+	return connect.NewResponse(guardianv1.ListOrganizationsResponse_builder{Organizations: res}.Build()), nil
+}
+
+// UpdateOrganization implements [guardianv1connect.OrganizationServiceHandler].
+func (s *OrganizationService) UpdateOrganization(ctx context.Context, req *connect.Request[guardianv1.UpdateOrganizationRequest]) (*connect.Response[guardianv1.UpdateOrganizationResponse], error) {
+	msg := req.Msg
+
+	orgID, err := parseOrgID(msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.authorize(ctx, req, orgID, core.OrganizationRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	var params core.UpdateOrganizationParams
+	if msg.HasSlug() {
+		slug := msg.GetSlug()
+		params.Slug = &slug
+	}
+	if msg.HasName() {
+		name := msg.GetName()
+		params.Name = &name
+	}
+
+	org, err := s.orgs.Update(ctx, orgID, params)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.UpdateOrganizationResponse_builder{Organization: toOrganization(org)}.Build()), nil
+}
+
+// DeleteOrganization implements [guardianv1connect.OrganizationServiceHandler].
+func (s *OrganizationService) DeleteOrganization(ctx context.Context, req *connect.Request[guardianv1.DeleteOrganizationRequest]) (*connect.Response[guardianv1.DeleteOrganizationResponse], error) {
+	orgID, err := parseOrgID(req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.authorize(ctx, req, orgID, core.OrganizationRoleOwner); err != nil {
+		return nil, err
+	}
+
+	if err := s.orgs.Delete(ctx, orgID); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.DeleteOrganizationResponse{}), nil
+}
+
+// ListMembers implements [guardianv1connect.OrganizationServiceHandler].
+func (s *OrganizationService) ListMembers(ctx context.Context, req *connect.Request[guardianv1.ListMembersRequest]) (*connect.Response[guardianv1.ListMembersResponse], error) {
+	msg := req.Msg
+
+	orgID, err := parseOrgID(msg.GetOrgId())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.authorize(ctx, req, orgID, core.OrganizationRoleMember); err != nil {
+		return nil, err
+	}
+
+	page, err := s.orgs.ListMemberships(ctx, core.ListMembershipsParams{
+		OrgID:  orgID,
+		Cursor: msg.GetPageToken(),
+		Limit:  int(msg.GetPageSize()),
+	})
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	memberships := make([]*guardianv1.OrganizationMembership, 0, len(page.Memberships))
+	for _, m := range page.Memberships {
+		memberships = append(memberships, toOrganizationMembership(m))
+	}
+
+	return connect.NewResponse(guardianv1.ListMembersResponse_builder{
+		Memberships:   memberships,
+		NextPageToken: page.NextCursor,
+	}.Build()), nil
+}
+
+// UpdateMemberRole implements [guardianv1connect.OrganizationServiceHandler].
+func (s *OrganizationService) UpdateMemberRole(ctx context.Context, req *connect.Request[guardianv1.UpdateMemberRoleRequest]) (*connect.Response[guardianv1.UpdateMemberRoleResponse], error) {
+	msg := req.Msg
+
+	orgID, userID, err := parseMember(msg.GetOrgId(), msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	role := fromOrganizationRole(msg.GetRole())
+	if role == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("api: role is not valid"))
+	}
+
+	caller, err := s.authorize(ctx, req, orgID, core.OrganizationRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeMember(ctx, caller, userID); err != nil {
+		return nil, err
+	}
+
+	if role == core.OrganizationRoleOwner && caller.Role != core.OrganizationRoleOwner {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("api: only owners can grant the owner role"))
+	}
+
+	m, err := s.orgs.UpdateMembershipRole(ctx, orgID, userID, role)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.UpdateMemberRoleResponse_builder{Membership: toOrganizationMembership(m)}.Build()), nil
+}
+
+// RemoveMember implements [guardianv1connect.OrganizationServiceHandler].
+func (s *OrganizationService) RemoveMember(ctx context.Context, req *connect.Request[guardianv1.RemoveMemberRequest]) (*connect.Response[guardianv1.RemoveMemberResponse], error) {
+	msg := req.Msg
+
+	orgID, userID, err := parseMember(msg.GetOrgId(), msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	caller, err := s.authorize(ctx, req, orgID, core.OrganizationRoleMember)
+	if err != nil {
+		return nil, err
+	}
+
+	// Members can leave on their own, the store keeps the last owner.
+	if caller.UserID != userID {
+		if organizationRanks[caller.Role] < organizationRanks[core.OrganizationRoleAdmin] {
+			return nil, roleRequired(core.OrganizationRoleAdmin)
+		}
+
+		if err := s.authorizeMember(ctx, caller, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.orgs.RemoveMembership(ctx, orgID, userID); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.RemoveMemberResponse{}), nil
+}
+
+// authorizeMember requires callers other than owners to not manage owners.
+func (s *OrganizationService) authorizeMember(ctx context.Context, caller core.OrganizationMembership, userID uuid.UUID) error {
+	if caller.Role == core.OrganizationRoleOwner {
+		return nil
+	}
+
+	m, err := s.orgs.GetMembership(ctx, caller.OrgID, userID)
+	if err != nil {
+		return toConnectError(ctx, err)
+	}
+
+	if m.Role == core.OrganizationRoleOwner {
+		return connect.NewError(connect.CodePermissionDenied, errors.New("api: only owners can manage owners"))
+	}
+
+	return nil
+}
+
+// TransferOwnership implements [guardianv1connect.OrganizationServiceHandler].
+func (s *OrganizationService) TransferOwnership(ctx context.Context, req *connect.Request[guardianv1.TransferOwnershipRequest]) (*connect.Response[guardianv1.TransferOwnershipResponse], error) {
+	msg := req.Msg
+
+	orgID, userID, err := parseMember(msg.GetOrgId(), msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	caller, err := s.authorize(ctx, req, orgID, core.OrganizationRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.orgs.TransferOwnership(ctx, orgID, caller.UserID, userID); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.TransferOwnershipResponse{}), nil
+}
+
+// InviteMember implements [guardianv1connect.OrganizationServiceHandler].
+func (s *OrganizationService) InviteMember(ctx context.Context, req *connect.Request[guardianv1.InviteMemberRequest]) (*connect.Response[guardianv1.InviteMemberResponse], error) {
+	msg := req.Msg
+
+	orgID, err := parseOrgID(msg.GetOrgId())
+	if err != nil {
+		return nil, err
+	}
+
+	role := core.OrganizationRoleMember
+	if msg.GetRole() != guardianv1.OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED {
+		if role = fromOrganizationRole(msg.GetRole()); role == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("api: role is not valid"))
+		}
+	}
+
+	caller, err := s.authorize(ctx, req, orgID, core.OrganizationRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if role == core.OrganizationRoleOwner && caller.Role != core.OrganizationRoleOwner {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("api: only owners can invite owners"))
+	}
+
+	inviter, err := s.users.Get(ctx, caller.UserID)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	org, err := s.orgs.Get(ctx, orgID)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	inv, link, err := s.orgs.Invite(ctx, core.InviteParams{
+		OrgID:     orgID,
+		Email:     msg.GetEmail(),
+		Role:      role,
+		InviterID: caller.UserID,
+	})
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	email, err := mail.OrganizationInvitation(inv.Email, mail.OrganizationInvitationData{
+		Inviter:      inviter.Username,
+		Organization: org.Name,
+		Role:         string(inv.Role),
+		Link:         link,
+		ExpiresIn:    inv.ExpiresAt.Sub(inv.CreatedAt),
+	})
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	if err := s.mailer.Send(ctx, email); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.InviteMemberResponse_builder{Invitation: toOrganizationInvitation(inv)}.Build()), nil
+}
+
+// ListInvitations implements [guardianv1connect.OrganizationServiceHandler].
+func (s *OrganizationService) ListInvitations(ctx context.Context, req *connect.Request[guardianv1.ListInvitationsRequest]) (*connect.Response[guardianv1.ListInvitationsResponse], error) {
+	orgID, err := parseOrgID(req.Msg.GetOrgId())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.authorize(ctx, req, orgID, core.OrganizationRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	invs, err := s.orgs.ListInvitations(ctx, orgID)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	res := make([]*guardianv1.OrganizationInvitation, 0, len(invs))
+	for _, inv := range invs {
+		res = append(res, toOrganizationInvitation(inv))
+	}
+
+	return connect.NewResponse(guardianv1.ListInvitationsResponse_builder{Invitations: res}.Build()), nil
+}
+
+// RevokeInvitation implements [guardianv1connect.OrganizationServiceHandler].
+func (s *OrganizationService) RevokeInvitation(ctx context.Context, req *connect.Request[guardianv1.RevokeInvitationRequest]) (*connect.Response[guardianv1.RevokeInvitationResponse], error) {
+	msg := req.Msg
+
+	orgID, err := parseOrgID(msg.GetOrgId())
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(msg.GetInvitationId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("api: invitation_id is not a valid uuid"))
+	}
+
+	if _, err := s.authorize(ctx, req, orgID, core.OrganizationRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if err := s.orgs.RevokeInvitation(ctx, orgID, id); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.RevokeInvitationResponse{}), nil
+}
+
+// GetInvitation implements [guardianv1connect.OrganizationServiceHandler].
+func (s *OrganizationService) GetInvitation(ctx context.Context, req *connect.Request[guardianv1.GetInvitationRequest]) (*connect.Response[guardianv1.GetInvitationResponse], error) {
+	user, err := s.caller(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err := s.orgs.GetInvitation(ctx, req.Msg.GetToken())
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	// Invitations for other addresses are not revealed.
+	if !strings.EqualFold(inv.Email, user.Email) {
+		return nil, toConnectError(ctx, core.ErrInvalidToken)
+	}
+
+	org, err := s.orgs.Get(ctx, inv.OrgID)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.GetInvitationResponse_builder{
+		Invitation:   toOrganizationInvitation(inv),
+		Organization: toOrganization(org),
+	}.Build()), nil
+}
+
+// AcceptInvitation implements [guardianv1connect.OrganizationServiceHandler].
+func (s *OrganizationService) AcceptInvitation(ctx context.Context, req *connect.Request[guardianv1.AcceptInvitationRequest]) (*connect.Response[guardianv1.AcceptInvitationResponse], error) {
+	user, err := s.invitee(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := s.orgs.AcceptInvitation(ctx, req.Msg.GetToken(), user.ID, user.Email)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.AcceptInvitationResponse_builder{Membership: toOrganizationMembership(m)}.Build()), nil
+}
+
+// DeclineInvitation implements [guardianv1connect.OrganizationServiceHandler].
+func (s *OrganizationService) DeclineInvitation(ctx context.Context, req *connect.Request[guardianv1.DeclineInvitationRequest]) (*connect.Response[guardianv1.DeclineInvitationResponse], error) {
+	user, err := s.invitee(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.orgs.DeclineInvitation(ctx, req.Msg.GetToken(), user.Email); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.DeclineInvitationResponse{}), nil
+}
+
+func parseOrgID(id string) (uuid.UUID, error) {
+	orgID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, connect.NewError(connect.CodeInvalidArgument, errors.New("api: organization id is not a valid uuid"))
+	}
+
+	return orgID, nil
+}
+
+func parseMember(orgID, userID string) (uuid.UUID, uuid.UUID, error) {
+	org, err := parseOrgID(orgID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	user, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, connect.NewError(connect.CodeInvalidArgument, errors.New("api: user_id is not a valid uuid"))
+	}
+
+	return org, user, nil
+}
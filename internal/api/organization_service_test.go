@@ -0,0 +1,257 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+)
+
+func TestOrganizationService(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClients(t)
+
+	owner := signUp(t, c, "ada@example.com", "ada")
+	ownerToken := owner.GetTokens().GetAccessToken()
+	c.mailer.sent()
+	member := signUp(t, c, "bob@example.com", "bob")
+	memberToken := member.GetTokens().GetAccessToken()
+	memberVerification := verificationToken(t, c, "bob@example.com")
+	outsider := signUp(t, c, "eve@example.com", "eve")
+	outsiderToken := outsider.GetTokens().GetAccessToken()
+	outsiderVerification := verificationToken(t, c, "eve@example.com")
+
+	verifyEmail := func(t *testing.T, token string) {
+		t.Helper()
+
+		_, err := c.auth.VerifyEmail(ctx, connect.NewRequest(guardianv1.VerifyEmailRequest_builder{Token: token}.Build()))
+		require.NoError(t, err)
+	}
+
+	created, err := c.orgs.CreateOrganization(ctx, withBearer(guardianv1.CreateOrganizationRequest_builder{
+		Slug: "acme",
+		Name: "Acme",
+	}.Build(), ownerToken))
+	require.NoError(t, err)
+	org := created.Msg.GetOrganization()
+
+	// invite invites the email and returns the token of the invitation link.
+	invite := func(t *testing.T, email string, role guardianv1.OrganizationRole, token string) string {
+		t.Helper()
+
+		res, err := c.orgs.InviteMember(ctx, withBearer(guardianv1.InviteMemberRequest_builder{
+			OrgId: org.GetId(),
+			Email: email,
+			Role:  role,
+		}.Build(), token))
+		require.NoError(t, err)
+		require.Equal(t, email, res.Msg.GetInvitation().GetEmail())
+
+		sent := c.mailer.sent()
+		require.Len(t, sent, 1)
+		require.Equal(t, email, sent[0].To)
+
+		_, link, ok := strings.Cut(sent[0].Text, "https://example.com/invitation?token=")
+		require.True(t, ok)
+		link, _, _ = strings.Cut(link, "\n")
+
+		return link
+	}
+
+	t.Run("creates organizations", func(t *testing.T) {
+		_, err := c.orgs.CreateOrganization(ctx, connect.NewRequest(guardianv1.CreateOrganizationRequest_builder{
+			Slug: "other",
+			Name: "Other",
+		}.Build()))
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		_, err = c.orgs.CreateOrganization(ctx, withBearer(guardianv1.CreateOrganizationRequest_builder{
+			Slug: "ACME",
+			Name: "Acme again",
+		}.Build(), outsiderToken))
+		requireCode(t, connect.CodeAlreadyExists, err)
+
+		got, err := c.orgs.GetOrganization(ctx, withBearer(guardianv1.GetOrganizationRequest_builder{Slug: "acme"}.Build(), ownerToken))
+		require.NoError(t, err)
+		require.Equal(t, org.GetId(), got.Msg.GetOrganization().GetId())
+
+		list, err := c.orgs.ListOrganizations(ctx, withBearer(&guardianv1.ListOrganizationsRequest{}, ownerToken))
+		require.NoError(t, err)
+		require.Len(t, list.Msg.GetOrganizations(), 1)
+
+		members, err := c.orgs.ListMembers(ctx, withBearer(guardianv1.ListMembersRequest_builder{OrgId: org.GetId()}.Build(), ownerToken))
+		require.NoError(t, err)
+		require.Len(t, members.Msg.GetMemberships(), 1)
+		require.Equal(t, guardianv1.OrganizationRole_ORGANIZATION_ROLE_OWNER, members.Msg.GetMemberships()[0].GetRole())
+	})
+
+	t.Run("hides organizations from outsiders", func(t *testing.T) {
+		_, err := c.orgs.GetOrganization(ctx, withBearer(guardianv1.GetOrganizationRequest_builder{Id: org.GetId()}.Build(), outsiderToken))
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		_, err = c.orgs.GetOrganization(ctx, withBearer(guardianv1.GetOrganizationRequest_builder{Slug: "missing"}.Build(), outsiderToken))
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		_, err = c.orgs.ListMembers(ctx, withBearer(guardianv1.ListMembersRequest_builder{OrgId: org.GetId()}.Build(), outsiderToken))
+		requireCode(t, connect.CodePermissionDenied, err)
+	})
+
+	t.Run("answers invitations", func(t *testing.T) {
+		token := invite(t, "bob@example.com", guardianv1.OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED, ownerToken)
+
+		// Invitations are bound to email addresses, so they are only answered with verified ones.
+		_, err := c.orgs.AcceptInvitation(ctx, withBearer(guardianv1.AcceptInvitationRequest_builder{Token: token}.Build(), memberToken))
+		requireCode(t, connect.CodeFailedPrecondition, err)
+
+		_, err = c.orgs.DeclineInvitation(ctx, withBearer(guardianv1.DeclineInvitationRequest_builder{Token: token}.Build(), memberToken))
+		requireCode(t, connect.CodeFailedPrecondition, err)
+
+		verifyEmail(t, memberVerification)
+		verifyEmail(t, outsiderVerification)
+
+		// Invitations are only answered by the invited address.
+		_, err = c.orgs.GetInvitation(ctx, withBearer(guardianv1.GetInvitationRequest_builder{Token: token}.Build(), outsiderToken))
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		_, err = c.orgs.AcceptInvitation(ctx, withBearer(guardianv1.AcceptInvitationRequest_builder{Token: token}.Build(), outsiderToken))
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		got, err := c.orgs.GetInvitation(ctx, withBearer(guardianv1.GetInvitationRequest_builder{Token: token}.Build(), memberToken))
+		require.NoError(t, err)
+		require.Equal(t, "Acme", got.Msg.GetOrganization().GetName())
+		require.Equal(t, guardianv1.OrganizationRole_ORGANIZATION_ROLE_MEMBER, got.Msg.GetInvitation().GetRole())
+		require.Equal(t, owner.GetUser().GetId(), got.Msg.GetInvitation().GetInviterId())
+
+		accepted, err := c.orgs.AcceptInvitation(ctx, withBearer(guardianv1.AcceptInvitationRequest_builder{Token: token}.Build(), memberToken))
+		require.NoError(t, err)
+		require.Equal(t, guardianv1.OrganizationRole_ORGANIZATION_ROLE_MEMBER, accepted.Msg.GetMembership().GetRole())
+
+		_, err = c.orgs.AcceptInvitation(ctx, withBearer(guardianv1.AcceptInvitationRequest_builder{Token: token}.Build(), memberToken))
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		token = invite(t, "eve@example.com", guardianv1.OrganizationRole_ORGANIZATION_ROLE_ADMIN, ownerToken)
+
+		invitations, err := c.orgs.ListInvitations(ctx, withBearer(guardianv1.ListInvitationsRequest_builder{OrgId: org.GetId()}.Build(), ownerToken))
+		require.NoError(t, err)
+		require.Len(t, invitations.Msg.GetInvitations(), 1)
+
+		_, err = c.orgs.DeclineInvitation(ctx, withBearer(guardianv1.DeclineInvitationRequest_builder{Token: token}.Build(), outsiderToken))
+		require.NoError(t, err)
+
+		_, err = c.orgs.AcceptInvitation(ctx, withBearer(guardianv1.AcceptInvitationRequest_builder{Token: token}.Build(), outsiderToken))
+		requireCode(t, connect.CodeUnauthenticated, err)
+	})
+
+	t.Run("requires roles", func(t *testing.T) {
+		_, err := c.orgs.UpdateOrganization(ctx, withBearer(guardianv1.UpdateOrganizationRequest_builder{
+			Id:   org.GetId(),
+			Name: proto.String("Renamed"),
+		}.Build(), memberToken))
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		_, err = c.orgs.InviteMember(ctx, withBearer(guardianv1.InviteMemberRequest_builder{
+			OrgId: org.GetId(),
+			Email: "carol@example.com",
+		}.Build(), memberToken))
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		_, err = c.orgs.RemoveMember(ctx, withBearer(guardianv1.RemoveMemberRequest_builder{
+			OrgId:  org.GetId(),
+			UserId: owner.GetUser().GetId(),
+		}.Build(), memberToken))
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		_, err = c.orgs.DeleteOrganization(ctx, withBearer(guardianv1.DeleteOrganizationRequest_builder{Id: org.GetId()}.Build(), memberToken))
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		// Admins cannot make themselves or others owners.
+		_, err = c.orgs.UpdateMemberRole(ctx, withBearer(guardianv1.UpdateMemberRoleRequest_builder{
+			OrgId:  org.GetId(),
+			UserId: member.GetUser().GetId(),
+			Role:   guardianv1.OrganizationRole_ORGANIZATION_ROLE_ADMIN,
+		}.Build(), ownerToken))
+		require.NoError(t, err)
+
+		_, err = c.orgs.UpdateMemberRole(ctx, withBearer(guardianv1.UpdateMemberRoleRequest_builder{
+			OrgId:  org.GetId(),
+			UserId: member.GetUser().GetId(),
+			Role:   guardianv1.OrganizationRole_ORGANIZATION_ROLE_OWNER,
+		}.Build(), memberToken))
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		_, err = c.orgs.UpdateMemberRole(ctx, withBearer(guardianv1.UpdateMemberRoleRequest_builder{
+			OrgId:  org.GetId(),
+			UserId: owner.GetUser().GetId(),
+			Role:   guardianv1.OrganizationRole_ORGANIZATION_ROLE_MEMBER,
+		}.Build(), memberToken))
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		updated, err := c.orgs.UpdateOrganization(ctx, withBearer(guardianv1.UpdateOrganizationRequest_builder{
+			Id:   org.GetId(),
+			Name: proto.String("Acme Inc"),
+		}.Build(), memberToken))
+		require.NoError(t, err)
+		require.Equal(t, "Acme Inc", updated.Msg.GetOrganization().GetName())
+		require.Equal(t, "acme", updated.Msg.GetOrganization().GetSlug())
+	})
+
+	t.Run("keeps the last owner", func(t *testing.T) {
+		_, err := c.orgs.RemoveMember(ctx, withBearer(guardianv1.RemoveMemberRequest_builder{
+			OrgId:  org.GetId(),
+			UserId: owner.GetUser().GetId(),
+		}.Build(), ownerToken))
+		requireCode(t, connect.CodeInvalidArgument, err)
+
+		_, err = c.orgs.UpdateMemberRole(ctx, withBearer(guardianv1.UpdateMemberRoleRequest_builder{
+			OrgId:  org.GetId(),
+			UserId: owner.GetUser().GetId(),
+			Role:   guardianv1.OrganizationRole_ORGANIZATION_ROLE_ADMIN,
+		}.Build(), ownerToken))
+		requireCode(t, connect.CodeInvalidArgument, err)
+	})
+
+	t.Run("transfers ownership", func(t *testing.T) {
+		_, err := c.orgs.TransferOwnership(ctx, withBearer(guardianv1.TransferOwnershipRequest_builder{
+			OrgId:  org.GetId(),
+			UserId: outsider.GetUser().GetId(),
+		}.Build(), ownerToken))
+		requireCode(t, connect.CodeNotFound, err)
+
+		_, err = c.orgs.TransferOwnership(ctx, withBearer(guardianv1.TransferOwnershipRequest_builder{
+			OrgId:  org.GetId(),
+			UserId: member.GetUser().GetId(),
+		}.Build(), ownerToken))
+		require.NoError(t, err)
+
+		members, err := c.orgs.ListMembers(ctx, withBearer(guardianv1.ListMembersRequest_builder{OrgId: org.GetId()}.Build(), ownerToken))
+		require.NoError(t, err)
+
+		roles := map[string]guardianv1.OrganizationRole{}
+		for _, m := range members.Msg.GetMemberships() {
+			roles[m.GetUserId()] = m.GetRole()
+		}
+		require.Equal(t, map[string]guardianv1.OrganizationRole{
+			owner.GetUser().GetId():  guardianv1.OrganizationRole_ORGANIZATION_ROLE_ADMIN,
+			member.GetUser().GetId(): guardianv1.OrganizationRole_ORGANIZATION_ROLE_OWNER,
+		}, roles)
+
+		// The former owner can leave now.
+		_, err = c.orgs.RemoveMember(ctx, withBearer(guardianv1.RemoveMemberRequest_builder{
+			OrgId:  org.GetId(),
+			UserId: owner.GetUser().GetId(),
+		}.Build(), ownerToken))
+		require.NoError(t, err)
+
+		_, err = c.orgs.DeleteOrganization(ctx, withBearer(guardianv1.DeleteOrganizationRequest_builder{Id: org.GetId()}.Build(), memberToken))
+		require.NoError(t, err)
+
+		list, err := c.orgs.ListOrganizations(ctx, withBearer(&guardianv1.ListOrganizationsRequest{}, memberToken))
+		require.NoError(t, err)
+		require.Empty(t, list.Msg.GetOrganizations())
+	})
+}
@@ -0,0 +1,138 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+	"github.com/gophero/guardian/core/proto/guardian/v1/guardianv1connect"
+)
+
+// PasskeyService implements [guardianv1connect.PasskeyServiceHandler].
+type PasskeyService struct {
+	users    core.UserStore
+	passkeys core.PasskeyStore
+	audit    core.AuditLog
+	auth     *authenticator
+}
+
+var _ guardianv1connect.PasskeyServiceHandler = (*PasskeyService)(nil)
+
+// NewPasskeyService constructs new [PasskeyService].
+func NewPasskeyService(
+	users core.UserStore,
+	passkeys core.PasskeyStore,
+	audit core.AuditLog,
+	sessions core.SessionStore,
+	tokens core.AccessTokenIssuer,
+) *PasskeyService {
+	return &PasskeyService{
+		users:    users,
+		passkeys: passkeys,
+		audit:    audit,
+		auth:     &authenticator{tokens: tokens, sessions: sessions, now: time.Now},
+	}
+}
+
+// BeginPasskeyRegistration implements [guardianv1connect.PasskeyServiceHandler].
+func (s *PasskeyService) BeginPasskeyRegistration(ctx context.Context, req *connect.Request[guardianv1.BeginPasskeyRegistrationRequest]) (*connect.Response[guardianv1.BeginPasskeyRegistrationResponse], error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.users.Get(ctx, p.UserID)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	c, err := s.passkeys.BeginRegistration(ctx, user)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.BeginPasskeyRegistrationResponse_builder{
+		CeremonyId: c.ID.String(),
+		Options:    string(c.Options),
+		ExpiresAt:  timestamppb.New(c.ExpiresAt),
+	}.Build()), nil
+}
+
+// FinishPasskeyRegistration implements [guardianv1connect.PasskeyServiceHandler].
+func (s *PasskeyService) FinishPasskeyRegistration(ctx context.Context, req *connect.Request[guardianv1.FinishPasskeyRegistrationRequest]) (*connect.Response[guardianv1.FinishPasskeyRegistrationResponse], error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	msg := req.Msg
+
+	ceremonyID, err := uuid.Parse(msg.GetCeremonyId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("api: ceremony_id is not a valid uuid"))
+	}
+
+	passkey, err := s.passkeys.FinishRegistration(ctx, p.UserID, ceremonyID, []byte(msg.GetCredential()), msg.GetName())
+	switch {
+	case errors.Is(err, core.ErrInvalidToken):
+		// The caller itself is authenticated, only the ceremony is gone.
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("api: ceremony does not exist or expired"))
+	case errors.Is(err, core.ErrInvalidCredentials):
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, core.ErrAlreadyExists):
+		return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("api: passkey is registered already"))
+	case err != nil:
+		return nil, toConnectError(ctx, err)
+	}
+
+	record(ctx, s.audit, core.AuditPasskeyRegistered, p.UserID, clientMetadata(req, p.Session.Device))
+
+	return connect.NewResponse(guardianv1.FinishPasskeyRegistrationResponse_builder{Passkey: toPasskey(passkey)}.Build()), nil
+}
+
+// ListPasskeys implements [guardianv1connect.PasskeyServiceHandler].
+func (s *PasskeyService) ListPasskeys(ctx context.Context, req *connect.Request[guardianv1.ListPasskeysRequest]) (*connect.Response[guardianv1.ListPasskeysResponse], error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	passkeys, err := s.passkeys.List(ctx, p.UserID)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	res := make([]*guardianv1.Passkey, 0, len(passkeys))
+	for _, pk := range passkeys {
+		res = append(res, toPasskey(pk))
+	}
+
+	return connect.NewResponse(guardianv1.ListPasskeysResponse_builder{Passkeys: res}.Build()), nil
+}
+
+// DeletePasskey implements [guardianv1connect.PasskeyServiceHandler].
+func (s *PasskeyService) DeletePasskey(ctx context.Context, req *connect.Request[guardianv1.DeletePasskeyRequest]) (*connect.Response[guardianv1.DeletePasskeyResponse], error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.Msg.GetId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("api: id is not a valid uuid"))
+	}
+
+	if err := s.passkeys.Delete(ctx, p.UserID, id); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	record(ctx, s.audit, core.AuditPasskeyDeleted, p.UserID, clientMetadata(req, p.Session.Device))
+
+	return connect.NewResponse(&guardianv1.DeletePasskeyResponse{}), nil
+}
@@ -0,0 +1,121 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+)
+
+func TestPasskeyService(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClients(t)
+
+	signedUp := signUp(t, c, "ada@example.com", "ada")
+	token := signedUp.GetTokens().GetAccessToken()
+
+	register := func(t *testing.T, credential string) (*guardianv1.Passkey, error) {
+		t.Helper()
+
+		begin, err := c.passkeys.BeginPasskeyRegistration(ctx, withBearer(&guardianv1.BeginPasskeyRegistrationRequest{}, token))
+		require.NoError(t, err)
+		require.NotEmpty(t, begin.Msg.GetOptions())
+
+		res, err := c.passkeys.FinishPasskeyRegistration(ctx, withBearer(guardianv1.FinishPasskeyRegistrationRequest_builder{
+			CeremonyId: begin.Msg.GetCeremonyId(),
+			Credential: credential,
+			Name:       "laptop",
+		}.Build(), token))
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Msg.GetPasskey(), nil
+	}
+
+	signIn := func(t *testing.T, identifier, credential string) (*guardianv1.FinishPasskeySignInResponse, error) {
+		t.Helper()
+
+		begin, err := c.auth.BeginPasskeySignIn(ctx, connect.NewRequest(guardianv1.BeginPasskeySignInRequest_builder{
+			Identifier: identifier,
+		}.Build()))
+		require.NoError(t, err)
+
+		res, err := c.auth.FinishPasskeySignIn(ctx, connect.NewRequest(guardianv1.FinishPasskeySignInRequest_builder{
+			CeremonyId: begin.Msg.GetCeremonyId(),
+			Credential: credential,
+			Device:     "phone",
+		}.Build()))
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Msg, nil
+	}
+
+	var passkey *guardianv1.Passkey
+
+	t.Run("register", func(t *testing.T) {
+		_, err := c.passkeys.BeginPasskeyRegistration(ctx, connect.NewRequest(&guardianv1.BeginPasskeyRegistrationRequest{}))
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		_, err = register(t, "invalid")
+		requireCode(t, connect.CodeInvalidArgument, err)
+
+		passkey, err = register(t, "credential-1")
+		require.NoError(t, err)
+		require.Equal(t, "laptop", passkey.GetName())
+		require.False(t, passkey.HasLastUsedAt())
+
+		_, err = register(t, "credential-1")
+		requireCode(t, connect.CodeAlreadyExists, err)
+
+		_, err = c.passkeys.FinishPasskeyRegistration(ctx, withBearer(guardianv1.FinishPasskeyRegistrationRequest_builder{
+			CeremonyId: passkey.GetId(),
+			Credential: "credential-2",
+		}.Build(), token))
+		requireCode(t, connect.CodeFailedPrecondition, err)
+
+		res, err := c.passkeys.ListPasskeys(ctx, withBearer(&guardianv1.ListPasskeysRequest{}, token))
+		require.NoError(t, err)
+		require.Len(t, res.Msg.GetPasskeys(), 1)
+		require.Equal(t, passkey.GetId(), res.Msg.GetPasskeys()[0].GetId())
+	})
+
+	t.Run("sign in", func(t *testing.T) {
+		// Unknown identifiers get a discoverable ceremony, so they cannot be told apart from users without passkeys.
+		for _, identifier := range []string{"", "ada", "ada@example.com", "nobody"} {
+			res, err := signIn(t, identifier, "credential-1")
+			require.NoError(t, err)
+			require.Equal(t, signedUp.GetUser().GetId(), res.GetUser().GetId())
+			require.Equal(t, "phone", res.GetSession().GetDevice())
+			require.NotEmpty(t, res.GetTokens().GetAccessToken())
+		}
+
+		_, err := signIn(t, "", "unknown")
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		_, err = c.auth.FinishPasskeySignIn(ctx, connect.NewRequest(guardianv1.FinishPasskeySignInRequest_builder{
+			CeremonyId: "not-a-uuid",
+			Credential: "credential-1",
+		}.Build()))
+		requireCode(t, connect.CodeInvalidArgument, err)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		_, err := c.passkeys.DeletePasskey(ctx, withBearer(guardianv1.DeletePasskeyRequest_builder{Id: passkey.GetId()}.Build(), token))
+		require.NoError(t, err)
+
+		_, err = c.passkeys.DeletePasskey(ctx, withBearer(guardianv1.DeletePasskeyRequest_builder{Id: passkey.GetId()}.Build(), token))
+		requireCode(t, connect.CodeNotFound, err)
+
+		_, err = signIn(t, "", "credential-1")
+		requireCode(t, connect.CodeUnauthenticated, err)
+	})
+
+	require.Equal(t, []core.AuditEventType{core.AuditPasskeyRegistered, core.AuditPasskeyDeleted}, c.audit.auditTypes())
+}
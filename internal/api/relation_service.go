@@ -0,0 +1,289 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+	"github.com/gophero/guardian/core/proto/guardian/v1/guardianv1connect"
+)
+
+// RelationService implements [guardianv1connect.RelationServiceHandler].
+type RelationService struct {
+	rebac     core.ReBACStore
+	rbac      core.RBACStore
+	decisions core.DecisionLog
+	auth      *authenticator
+}
+
+var _ guardianv1connect.RelationServiceHandler = (*RelationService)(nil)
+
+// NewRelationService constructs new [RelationService]. Callers are authorized by their global permissions in rbac.
+func NewRelationService(
+	rebac core.ReBACStore,
+	rbac core.RBACStore,
+	decisions core.DecisionLog,
+	sessions core.SessionStore,
+	tokens core.AccessTokenIssuer,
+) *RelationService {
+	return &RelationService{
+		rebac:     rebac,
+		rbac:      rbac,
+		decisions: decisions,
+		auth:      &authenticator{tokens: tokens, sessions: sessions, now: time.Now},
+	}
+}
+
+// authorize authenticates the caller and requires it to have the global permission.
+func (s *RelationService) authorize(ctx context.Context, req connect.AnyRequest, permission string) error {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return err
+	}
+
+	return requirePermission(ctx, s.rbac, p, permission, uuid.Nil, callerAttributes(req, s.auth.now()))
+}
+
+// attributeContext converts the attributes conditions of tuples are evaluated against. Unlike permission checks,
+// relation checks are made for other subjects, so the address is not taken from the caller.
+func (s *RelationService) attributeContext(msg *guardianv1.AttributeContext, req connect.AnyRequest) (core.AttributeContext, error) {
+	return attributeContext(msg, req, false, s.auth.now())
+}
+
+// WriteSchema implements [guardianv1connect.RelationServiceHandler].
+func (s *RelationService) WriteSchema(ctx context.Context, req *connect.Request[guardianv1.WriteSchemaRequest]) (*connect.Response[guardianv1.WriteSchemaResponse], error) {
+	if err := s.authorize(ctx, req, core.PermissionAuthzManage); err != nil {
+		return nil, err
+	}
+
+	zookie, err := s.rebac.WriteSchema(ctx, req.Msg.GetDefinition())
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.WriteSchemaResponse_builder{Zookie: string(zookie)}.Build()), nil
+}
+
+// ReadSchema implements [guardianv1connect.RelationServiceHandler].
+func (s *RelationService) ReadSchema(ctx context.Context, req *connect.Request[guardianv1.ReadSchemaRequest]) (*connect.Response[guardianv1.ReadSchemaResponse], error) {
+	if err := s.authorize(ctx, req, core.PermissionAuthzCheck); err != nil {
+		return nil, err
+	}
+
+	definition, zookie, err := s.rebac.ReadSchema(ctx)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.ReadSchemaResponse_builder{
+		Definition: definition,
+		Zookie:     string(zookie),
+	}.Build()), nil
+}
+
+// WriteTuples implements [guardianv1connect.RelationServiceHandler].
+func (s *RelationService) WriteTuples(ctx context.Context, req *connect.Request[guardianv1.WriteTuplesRequest]) (*connect.Response[guardianv1.WriteTuplesResponse], error) {
+	if err := s.authorize(ctx, req, core.PermissionAuthzManage); err != nil {
+		return nil, err
+	}
+
+	zookie, err := s.rebac.WriteTuples(ctx, fromRelationTuples(req.Msg.GetWrites()), fromRelationTuples(req.Msg.GetDeletes()))
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.WriteTuplesResponse_builder{Zookie: string(zookie)}.Build()), nil
+}
+
+// ReadTuples implements [guardianv1connect.RelationServiceHandler].
+func (s *RelationService) ReadTuples(ctx context.Context, req *connect.Request[guardianv1.ReadTuplesRequest]) (*connect.Response[guardianv1.ReadTuplesResponse], error) {
+	if err := s.authorize(ctx, req, core.PermissionAuthzCheck); err != nil {
+		return nil, err
+	}
+
+	msg := req.Msg
+
+	params := core.ReadTuplesParams{
+		Filter: core.TupleFilter{
+			Namespace: msg.GetNamespace(),
+			ObjectID:  msg.GetObjectId(),
+			Relation:  msg.GetRelation(),
+		},
+		Zookie: core.Zookie(msg.GetZookie()),
+		Cursor: msg.GetPageToken(),
+		Limit:  int(msg.GetPageSize()),
+	}
+
+	if msg.HasSubject() {
+		subject := fromSubjectRef(msg.GetSubject())
+		params.Filter.Subject = &subject
+	}
+
+	page, err := s.rebac.ReadTuples(ctx, params)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	tuples := make([]*guardianv1.RelationTuple, 0, len(page.Tuples))
+	for _, t := range page.Tuples {
+		tuples = append(tuples, toRelationTuple(t))
+	}
+
+	return connect.NewResponse(guardianv1.ReadTuplesResponse_builder{
+		Tuples:        tuples,
+		NextPageToken: page.NextCursor,
+		Zookie:        string(page.Zookie),
+	}.Build()), nil
+}
+
+// CheckRelation implements [guardianv1connect.RelationServiceHandler].
+func (s *RelationService) CheckRelation(ctx context.Context, req *connect.Request[guardianv1.CheckRelationRequest]) (*connect.Response[guardianv1.CheckRelationResponse], error) {
+	if err := s.authorize(ctx, req, core.PermissionAuthzCheck); err != nil {
+		return nil, err
+	}
+
+	msg := req.Msg
+
+	attrs, err := s.attributeContext(msg.GetContext(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	params := core.CheckParams{
+		Object:   fromObjectRef(msg.GetObject()),
+		Relation: msg.GetRelation(),
+		Subject:  fromSubjectRef(msg.GetSubject()),
+		Zookie:   core.Zookie(msg.GetZookie()),
+		Context:  attrs,
+	}
+
+	var e core.Explanation
+	var zookie core.Zookie
+	if msg.GetExplain() {
+		e, zookie, err = s.rebac.Explain(ctx, params)
+	} else {
+		e.Decision, zookie, err = s.rebac.Check(ctx, params)
+	}
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	recordDecision(ctx, s.decisions, core.DecisionRecord{
+		Type:     core.DecisionRelation,
+		Subject:  params.Subject.String(),
+		Action:   params.Relation,
+		Resource: params.Object.String(),
+		Decision: e.Decision,
+	})
+
+	res := guardianv1.CheckRelationResponse_builder{
+		Allowed:    e.Decision.Allowed,
+		Zookie:     string(zookie),
+		Policy:     e.Decision.Policy,
+		Conditions: e.Decision.Conditions,
+	}
+	if msg.GetExplain() {
+		res.Explanation = toExplainNode(e.Tree)
+	}
+
+	return connect.NewResponse(res.Build()), nil
+}
+
+// ExpandRelation implements [guardianv1connect.RelationServiceHandler].
+func (s *RelationService) ExpandRelation(ctx context.Context, req *connect.Request[guardianv1.ExpandRelationRequest]) (*connect.Response[guardianv1.ExpandRelationResponse], error) {
+	if err := s.authorize(ctx, req, core.PermissionAuthzCheck); err != nil {
+		return nil, err
+	}
+
+	msg := req.Msg
+
+	attrs, err := s.attributeContext(msg.GetContext(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, zookie, err := s.rebac.Expand(ctx, core.ExpandParams{
+		Object:   fromObjectRef(msg.GetObject()),
+		Relation: msg.GetRelation(),
+		Zookie:   core.Zookie(msg.GetZookie()),
+		Context:  attrs,
+	})
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.ExpandRelationResponse_builder{
+		Tree:   toUsersetTree(tree),
+		Zookie: string(zookie),
+	}.Build()), nil
+}
+
+// ListObjects implements [guardianv1connect.RelationServiceHandler].
+func (s *RelationService) ListObjects(ctx context.Context, req *connect.Request[guardianv1.ListObjectsRequest]) (*connect.Response[guardianv1.ListObjectsResponse], error) {
+	if err := s.authorize(ctx, req, core.PermissionAuthzCheck); err != nil {
+		return nil, err
+	}
+
+	msg := req.Msg
+
+	attrs, err := s.attributeContext(msg.GetContext(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.rebac.ListObjects(ctx, core.ListObjectsParams{
+		Namespace: msg.GetNamespace(),
+		Relation:  msg.GetRelation(),
+		Subject:   fromSubjectRef(msg.GetSubject()),
+		Zookie:    core.Zookie(msg.GetZookie()),
+		Context:   attrs,
+		Cursor:    msg.GetPageToken(),
+		Limit:     int(msg.GetPageSize()),
+	})
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.ListObjectsResponse_builder{
+		ObjectIds:     page.ObjectIDs,
+		NextPageToken: page.NextCursor,
+		Zookie:        string(page.Zookie),
+	}.Build()), nil
+}
+
+// ListSubjects implements [guardianv1connect.RelationServiceHandler].
+func (s *RelationService) ListSubjects(ctx context.Context, req *connect.Request[guardianv1.ListSubjectsRequest]) (*connect.Response[guardianv1.ListSubjectsResponse], error) {
+	if err := s.authorize(ctx, req, core.PermissionAuthzCheck); err != nil {
+		return nil, err
+	}
+
+	msg := req.Msg
+
+	attrs, err := s.attributeContext(msg.GetContext(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.rebac.ListSubjects(ctx, core.ListSubjectsParams{
+		Object:           fromObjectRef(msg.GetObject()),
+		Relation:         msg.GetRelation(),
+		SubjectNamespace: msg.GetSubjectNamespace(),
+		Zookie:           core.Zookie(msg.GetZookie()),
+		Context:          attrs,
+		Cursor:           msg.GetPageToken(),
+		Limit:            int(msg.GetPageSize()),
+	})
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.ListSubjectsResponse_builder{
+		SubjectIds:    page.SubjectIDs,
+		NextPageToken: page.NextCursor,
+		Zookie:        string(page.Zookie),
+	}.Build()), nil
+}
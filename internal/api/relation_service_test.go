@@ -0,0 +1,197 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+)
+
+func TestRelationService(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClients(t)
+
+	admin := signUp(t, c, "ada@example.com", "ada")
+	adminToken := admin.GetTokens().GetAccessToken()
+	user := signUp(t, c, "bob@example.com", "bob")
+	userToken := user.GetTokens().GetAccessToken()
+
+	grantAdmin(t, c, admin.GetUser().GetId())
+
+	readme := guardianv1.ObjectRef_builder{Namespace: "document", Id: "readme"}.Build()
+	bob := guardianv1.SubjectRef_builder{Namespace: "user", Id: "bob"}.Build()
+	tuple := guardianv1.RelationTuple_builder{Object: readme, Relation: "viewer", Subject: bob}.Build()
+
+	t.Run("requires permissions", func(t *testing.T) {
+		_, err := c.relation.WriteSchema(ctx, connect.NewRequest(guardianv1.WriteSchemaRequest_builder{Definition: "namespace user {}"}.Build()))
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		_, err = c.relation.WriteSchema(ctx, withBearer(guardianv1.WriteSchemaRequest_builder{Definition: "namespace user {}"}.Build(), userToken))
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		_, err = c.relation.CheckRelation(ctx, withBearer(guardianv1.CheckRelationRequest_builder{
+			Object:   readme,
+			Relation: "viewer",
+			Subject:  bob,
+		}.Build(), userToken))
+		requireCode(t, connect.CodePermissionDenied, err)
+	})
+
+	t.Run("writes and checks tuples", func(t *testing.T) {
+		_, err := c.relation.ReadSchema(ctx, withBearer(&guardianv1.ReadSchemaRequest{}, adminToken))
+		requireCode(t, connect.CodeNotFound, err)
+
+		schema, err := c.relation.WriteSchema(ctx, withBearer(guardianv1.WriteSchemaRequest_builder{
+			Definition: "namespace user {}\nnamespace document { relation viewer: user }",
+		}.Build(), adminToken))
+		require.NoError(t, err)
+		require.NotEmpty(t, schema.Msg.GetZookie())
+
+		written, err := c.relation.WriteTuples(ctx, withBearer(guardianv1.WriteTuplesRequest_builder{
+			Writes: []*guardianv1.RelationTuple{tuple},
+		}.Build(), adminToken))
+		require.NoError(t, err)
+
+		check, err := c.relation.CheckRelation(ctx, withBearer(guardianv1.CheckRelationRequest_builder{
+			Object:   readme,
+			Relation: "viewer",
+			Subject:  bob,
+			Zookie:   written.Msg.GetZookie(),
+		}.Build(), adminToken))
+		require.NoError(t, err)
+		require.True(t, check.Msg.GetAllowed())
+
+		_, err = c.relation.CheckRelation(ctx, withBearer(guardianv1.CheckRelationRequest_builder{
+			Object:   readme,
+			Relation: "viewer",
+			Subject:  bob,
+			Zookie:   "ahead",
+		}.Build(), adminToken))
+		requireCode(t, connect.CodeInvalidArgument, err)
+
+		tuples, err := c.relation.ReadTuples(ctx, withBearer(guardianv1.ReadTuplesRequest_builder{Namespace: "document"}.Build(), adminToken))
+		require.NoError(t, err)
+		require.Len(t, tuples.Msg.GetTuples(), 1)
+		require.Equal(t, "bob", tuples.Msg.GetTuples()[0].GetSubject().GetId())
+
+		objects, err := c.relation.ListObjects(ctx, withBearer(guardianv1.ListObjectsRequest_builder{
+			Namespace: "document",
+			Relation:  "viewer",
+			Subject:   bob,
+		}.Build(), adminToken))
+		require.NoError(t, err)
+		require.Equal(t, []string{"readme"}, objects.Msg.GetObjectIds())
+
+		tree, err := c.relation.ExpandRelation(ctx, withBearer(guardianv1.ExpandRelationRequest_builder{
+			Object:   readme,
+			Relation: "viewer",
+		}.Build(), adminToken))
+		require.NoError(t, err)
+		require.Equal(t, guardianv1.UsersetOperation_USERSET_OPERATION_LEAF, tree.Msg.GetTree().GetOperation())
+		require.Len(t, tree.Msg.GetTree().GetSubjects(), 1)
+
+		_, err = c.relation.WriteTuples(ctx, withBearer(guardianv1.WriteTuplesRequest_builder{
+			Deletes: []*guardianv1.RelationTuple{tuple},
+		}.Build(), adminToken))
+		require.NoError(t, err)
+
+		subjects, err := c.relation.ListSubjects(ctx, withBearer(guardianv1.ListSubjectsRequest_builder{
+			Object:           readme,
+			Relation:         "viewer",
+			SubjectNamespace: "user",
+		}.Build(), adminToken))
+		require.NoError(t, err)
+		require.Empty(t, subjects.Msg.GetSubjectIds())
+	})
+
+	t.Run("conditional tuples", func(t *testing.T) {
+		conditional := guardianv1.RelationTuple_builder{
+			Object:    readme,
+			Relation:  "viewer",
+			Subject:   bob,
+			Condition: "office",
+		}.Build()
+
+		_, err := c.relation.WriteTuples(ctx, withBearer(guardianv1.WriteTuplesRequest_builder{
+			Writes: []*guardianv1.RelationTuple{conditional},
+		}.Build(), adminToken))
+		requireCode(t, connect.CodeNotFound, err)
+
+		_, err = c.authz.CreateCondition(ctx, withBearer(guardianv1.CreateConditionRequest_builder{
+			Name:       "office",
+			Expression: "ip.inCidr('10.0.0.0/8')",
+		}.Build(), adminToken))
+		require.NoError(t, err)
+
+		_, err = c.relation.WriteTuples(ctx, withBearer(guardianv1.WriteTuplesRequest_builder{
+			Writes: []*guardianv1.RelationTuple{conditional},
+		}.Build(), adminToken))
+		require.NoError(t, err)
+
+		check := func(t *testing.T, ip string) *guardianv1.CheckRelationResponse {
+			t.Helper()
+
+			res, err := c.relation.CheckRelation(ctx, withBearer(guardianv1.CheckRelationRequest_builder{
+				Object:   readme,
+				Relation: "viewer",
+				Subject:  bob,
+				Context:  guardianv1.AttributeContext_builder{Ip: ip}.Build(),
+			}.Build(), adminToken))
+			require.NoError(t, err)
+
+			return res.Msg
+		}
+
+		res := check(t, "10.1.2.3")
+		require.True(t, res.GetAllowed())
+		require.Equal(t, "document:readme#viewer@user:bob", res.GetPolicy())
+		require.Equal(t, []string{"office"}, res.GetConditions())
+
+		// Relation checks are made for other subjects, so the address of the caller does not apply.
+		require.False(t, check(t, "").GetAllowed())
+		require.False(t, check(t, "192.168.0.1").GetAllowed())
+
+		_, err = c.relation.CheckRelation(ctx, withBearer(guardianv1.CheckRelationRequest_builder{
+			Object:   readme,
+			Relation: "viewer",
+			Subject:  bob,
+			Context:  guardianv1.AttributeContext_builder{Ip: "office"}.Build(),
+		}.Build(), adminToken))
+		requireCode(t, connect.CodeInvalidArgument, err)
+
+		tuples, err := c.relation.ReadTuples(ctx, withBearer(guardianv1.ReadTuplesRequest_builder{Namespace: "document"}.Build(), adminToken))
+		require.NoError(t, err)
+		require.Len(t, tuples.Msg.GetTuples(), 1)
+		require.Equal(t, "office", tuples.Msg.GetTuples()[0].GetCondition())
+	})
+	t.Run("explain", func(t *testing.T) {
+		res, err := c.relation.CheckRelation(ctx, withBearer(guardianv1.CheckRelationRequest_builder{
+			Object:   readme,
+			Relation: "viewer",
+			Subject:  bob,
+			Context:  guardianv1.AttributeContext_builder{Ip: "192.168.0.1"}.Build(),
+			Explain:  true,
+		}.Build(), adminToken))
+		require.NoError(t, err)
+		require.False(t, res.Msg.GetAllowed())
+
+		tree := res.Msg.GetExplanation()
+		require.Equal(t, guardianv1.ExplainNodeKind_EXPLAIN_NODE_KIND_RELATION, tree.GetKind())
+		require.Equal(t, "document:readme#viewer", tree.GetName())
+		require.Len(t, tree.GetChildren(), 1)
+		require.Equal(t, "document:readme#viewer@user:bob", tree.GetChildren()[0].GetName())
+		require.False(t, tree.GetChildren()[0].GetAllowed())
+
+		decisions := c.decisions.recorded()
+		require.Equal(t, core.DecisionRecord{
+			Type:     core.DecisionRelation,
+			Subject:  "user:bob",
+			Action:   "viewer",
+			Resource: "document:readme",
+		}, decisions[len(decisions)-1])
+	})
+}
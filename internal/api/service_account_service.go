@@ -0,0 +1,240 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+	"github.com/gophero/guardian/core/proto/guardian/v1/guardianv1connect"
+)
+
+// ServiceAccountService implements [guardianv1connect.ServiceAccountServiceHandler].
+type ServiceAccountService struct {
+	accounts core.ServiceAccountStore
+	rbac     core.RBACStore
+	auth     *authenticator
+}
+
+var _ guardianv1connect.ServiceAccountServiceHandler = (*ServiceAccountService)(nil)
+
+// NewServiceAccountService constructs new [ServiceAccountService].
+func NewServiceAccountService(
+	accounts core.ServiceAccountStore,
+	rbac core.RBACStore,
+	sessions core.SessionStore,
+	tokens core.AccessTokenIssuer,
+) *ServiceAccountService {
+	return &ServiceAccountService{
+		accounts: accounts,
+		rbac:     rbac,
+		auth:     &authenticator{tokens: tokens, sessions: sessions, now: time.Now},
+	}
+}
+
+// requireManage authenticates the caller and requires the [core.PermissionServiceAccountsManage] permission.
+func (s *ServiceAccountService) requireManage(ctx context.Context, req connect.AnyRequest) error {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return err
+	}
+
+	return requirePermission(ctx, s.rbac, p, core.PermissionServiceAccountsManage, uuid.Nil, callerAttributes(req, s.auth.now()))
+}
+
+// CreateServiceAccount implements [guardianv1connect.ServiceAccountServiceHandler].
+func (s *ServiceAccountService) CreateServiceAccount(ctx context.Context, req *connect.Request[guardianv1.CreateServiceAccountRequest]) (*connect.Response[guardianv1.CreateServiceAccountResponse], error) {
+	if err := s.requireManage(ctx, req); err != nil {
+		return nil, err
+	}
+
+	a, secret, err := s.accounts.Create(ctx, core.CreateServiceAccountParams{
+		Name:        req.Msg.GetName(),
+		Description: req.Msg.GetDescription(),
+		Scopes:      req.Msg.GetScopes(),
+	})
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.CreateServiceAccountResponse_builder{ServiceAccount: toServiceAccount(a), ClientSecret: secret}.Build()), nil
+}
+
+// GetServiceAccount implements [guardianv1connect.ServiceAccountServiceHandler].
+func (s *ServiceAccountService) GetServiceAccount(ctx context.Context, req *connect.Request[guardianv1.GetServiceAccountRequest]) (*connect.Response[guardianv1.GetServiceAccountResponse], error) {
+	if err := s.requireManage(ctx, req); err != nil {
+		return nil, err
+	}
+
+	id, err := parseID("id", req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.accounts.Get(ctx, id)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.GetServiceAccountResponse_builder{ServiceAccount: toServiceAccount(a)}.Build()), nil
+}
+
+// ListServiceAccounts implements [guardianv1connect.ServiceAccountServiceHandler].
+func (s *ServiceAccountService) ListServiceAccounts(ctx context.Context, req *connect.Request[guardianv1.ListServiceAccountsRequest]) (*connect.Response[guardianv1.ListServiceAccountsResponse], error) {
+	if err := s.requireManage(ctx, req); err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.accounts.List(ctx)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	res := make([]*guardianv1.ServiceAccount, 0, len(accounts))
+	for _, a := range accounts {
+		res = append(res, toServiceAccount(a))
+	}
+
+	return connect.NewResponse(guardianv1.ListServiceAccountsResponse_builder{ServiceAccounts: res}.Build()), nil
+}
+
+// UpdateServiceAccount implements [guardianv1connect.ServiceAccountServiceHandler].
+func (s *ServiceAccountService) UpdateServiceAccount(ctx context.Context, req *connect.Request[guardianv1.UpdateServiceAccountRequest]) (*connect.Response[guardianv1.UpdateServiceAccountResponse], error) {
+	if err := s.requireManage(ctx, req); err != nil {
+		return nil, err
+	}
+
+	id, err := parseID("id", req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.accounts.Update(ctx, core.UpdateServiceAccountParams{
+		ID:          id,
+		Name:        req.Msg.GetName(),
+		Description: req.Msg.GetDescription(),
+		Scopes:      req.Msg.GetScopes(),
+	})
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.UpdateServiceAccountResponse_builder{ServiceAccount: toServiceAccount(a)}.Build()), nil
+}
+
+// DeleteServiceAccount implements [guardianv1connect.ServiceAccountServiceHandler].
+func (s *ServiceAccountService) DeleteServiceAccount(ctx context.Context, req *connect.Request[guardianv1.DeleteServiceAccountRequest]) (*connect.Response[guardianv1.DeleteServiceAccountResponse], error) {
+	if err := s.requireManage(ctx, req); err != nil {
+		return nil, err
+	}
+
+	id, err := parseID("id", req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.accounts.Delete(ctx, id); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.DeleteServiceAccountResponse{}), nil
+}
+
+// RotateServiceAccountSecret implements [guardianv1connect.ServiceAccountServiceHandler].
+func (s *ServiceAccountService) RotateServiceAccountSecret(ctx context.Context, req *connect.Request[guardianv1.RotateServiceAccountSecretRequest]) (*connect.Response[guardianv1.RotateServiceAccountSecretResponse], error) {
+	if err := s.requireManage(ctx, req); err != nil {
+		return nil, err
+	}
+
+	id, err := parseID("id", req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	var overlap *time.Duration
+	if req.Msg.HasOverlap() {
+		if err := req.Msg.GetOverlap().CheckValid(); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("api: invalid overlap: %w", err))
+		}
+
+		d := req.Msg.GetOverlap().AsDuration()
+		overlap = &d
+	}
+
+	a, secret, err := s.accounts.RotateSecret(ctx, id, overlap)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.RotateServiceAccountSecretResponse_builder{ServiceAccount: toServiceAccount(a), ClientSecret: secret}.Build()), nil
+}
+
+// AddServiceAccountKey implements [guardianv1connect.ServiceAccountServiceHandler].
+func (s *ServiceAccountService) AddServiceAccountKey(ctx context.Context, req *connect.Request[guardianv1.AddServiceAccountKeyRequest]) (*connect.Response[guardianv1.AddServiceAccountKeyResponse], error) {
+	if err := s.requireManage(ctx, req); err != nil {
+		return nil, err
+	}
+
+	id, err := parseID("service_account_id", req.Msg.GetServiceAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := s.accounts.AddKey(ctx, id, []byte(req.Msg.GetJwk()))
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.AddServiceAccountKeyResponse_builder{Key: toServiceAccountKey(k)}.Build()), nil
+}
+
+// ListServiceAccountKeys implements [guardianv1connect.ServiceAccountServiceHandler].
+func (s *ServiceAccountService) ListServiceAccountKeys(ctx context.Context, req *connect.Request[guardianv1.ListServiceAccountKeysRequest]) (*connect.Response[guardianv1.ListServiceAccountKeysResponse], error) {
+	if err := s.requireManage(ctx, req); err != nil {
+		return nil, err
+	}
+
+	id, err := parseID("service_account_id", req.Msg.GetServiceAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := s.accounts.ListKeys(ctx, id)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	res := make([]*guardianv1.ServiceAccountKey, 0, len(keys))
+	for _, k := range keys {
+		res = append(res, toServiceAccountKey(k))
+	}
+
+	return connect.NewResponse(guardianv1.ListServiceAccountKeysResponse_builder{Keys: res}.Build()), nil
+}
+
+// DeleteServiceAccountKey implements [guardianv1connect.ServiceAccountServiceHandler].
+func (s *ServiceAccountService) DeleteServiceAccountKey(ctx context.Context, req *connect.Request[guardianv1.DeleteServiceAccountKeyRequest]) (*connect.Response[guardianv1.DeleteServiceAccountKeyResponse], error) {
+	if err := s.requireManage(ctx, req); err != nil {
+		return nil, err
+	}
+
+	accountID, err := parseID("service_account_id", req.Msg.GetServiceAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := parseID("id", req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.accounts.DeleteKey(ctx, accountID, id); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.DeleteServiceAccountKeyResponse{}), nil
+}
@@ -0,0 +1,186 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+)
+
+func TestServiceAccountService(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClients(t)
+
+	admin := signUp(t, c, "ada@example.com", "ada")
+	adminToken := admin.GetTokens().GetAccessToken()
+	user := signUp(t, c, "bob@example.com", "bob")
+	userToken := user.GetTokens().GetAccessToken()
+
+	admins := []string{core.PermissionServiceAccountsManage, core.PermissionAuthzManage, core.PermissionAuthzCheck}
+	for _, p := range append(admins, "invoices.write") {
+		_, err := c.rbac.CreatePermission(ctx, p, "")
+		require.NoError(t, err)
+	}
+
+	role, err := c.rbac.CreateRole(ctx, core.CreateRoleParams{Name: "guardian.admin"})
+	require.NoError(t, err)
+	for _, p := range admins {
+		require.NoError(t, c.rbac.GrantPermission(ctx, role.ID, p))
+	}
+
+	_, err = c.rbac.Assign(ctx, uuid.MustParse(admin.GetUser().GetId()), role.ID, uuid.Nil, "")
+	require.NoError(t, err)
+
+	create := func(t *testing.T, name string) (*guardianv1.ServiceAccount, string) {
+		t.Helper()
+
+		res, err := c.accounts.CreateServiceAccount(ctx, withBearer(guardianv1.CreateServiceAccountRequest_builder{
+			Name:   name,
+			Scopes: []string{"invoices.write", "invoices.read"},
+		}.Build(), adminToken))
+		require.NoError(t, err)
+
+		return res.Msg.GetServiceAccount(), res.Msg.GetClientSecret()
+	}
+
+	t.Run("manages accounts", func(t *testing.T) {
+		_, err := c.accounts.ListServiceAccounts(ctx, connect.NewRequest(&guardianv1.ListServiceAccountsRequest{}))
+		requireCode(t, connect.CodeUnauthenticated, err)
+
+		_, err = c.accounts.ListServiceAccounts(ctx, withBearer(&guardianv1.ListServiceAccountsRequest{}, userToken))
+		requireCode(t, connect.CodePermissionDenied, err)
+
+		_, err = c.accounts.CreateServiceAccount(ctx, withBearer(&guardianv1.CreateServiceAccountRequest{}, adminToken))
+		requireCode(t, connect.CodeInvalidArgument, err)
+
+		a, secret := create(t, "billing")
+		require.NotEmpty(t, secret)
+		require.Equal(t, []string{"invoices.read", "invoices.write"}, a.GetScopes())
+
+		_, err = c.accounts.CreateServiceAccount(ctx, withBearer(guardianv1.CreateServiceAccountRequest_builder{Name: "billing"}.Build(), adminToken))
+		requireCode(t, connect.CodeAlreadyExists, err)
+
+		updated, err := c.accounts.UpdateServiceAccount(ctx, withBearer(guardianv1.UpdateServiceAccountRequest_builder{
+			Id:          a.GetId(),
+			Name:        "billing",
+			Description: "Sends invoices",
+		}.Build(), adminToken))
+		require.NoError(t, err)
+		require.Equal(t, "Sends invoices", updated.Msg.GetServiceAccount().GetDescription())
+		require.Empty(t, updated.Msg.GetServiceAccount().GetScopes())
+
+		got, err := c.accounts.GetServiceAccount(ctx, withBearer(guardianv1.GetServiceAccountRequest_builder{Id: a.GetId()}.Build(), adminToken))
+		require.NoError(t, err)
+		require.Equal(t, "Sends invoices", got.Msg.GetServiceAccount().GetDescription())
+
+		_, err = c.accounts.DeleteServiceAccount(ctx, withBearer(guardianv1.DeleteServiceAccountRequest_builder{Id: a.GetId()}.Build(), adminToken))
+		require.NoError(t, err)
+
+		_, err = c.accounts.GetServiceAccount(ctx, withBearer(guardianv1.GetServiceAccountRequest_builder{Id: a.GetId()}.Build(), adminToken))
+		requireCode(t, connect.CodeNotFound, err)
+	})
+
+	t.Run("rotates secrets", func(t *testing.T) {
+		a, _ := create(t, "reports")
+
+		rotated, err := c.accounts.RotateServiceAccountSecret(ctx, withBearer(guardianv1.RotateServiceAccountSecretRequest_builder{
+			Id:      a.GetId(),
+			Overlap: durationpb.New(0),
+		}.Build(), adminToken))
+		require.NoError(t, err)
+		require.NotEmpty(t, rotated.Msg.GetClientSecret())
+		require.True(t, rotated.Msg.GetServiceAccount().HasRotatedAt())
+		require.False(t, rotated.Msg.GetServiceAccount().HasPreviousExpiresAt())
+
+		rotated, err = c.accounts.RotateServiceAccountSecret(ctx, withBearer(guardianv1.RotateServiceAccountSecretRequest_builder{Id: a.GetId()}.Build(), adminToken))
+		require.NoError(t, err)
+		require.True(t, rotated.Msg.GetServiceAccount().HasPreviousExpiresAt())
+
+		_, err = c.accounts.RotateServiceAccountSecret(ctx, withBearer(guardianv1.RotateServiceAccountSecretRequest_builder{
+			Id:      a.GetId(),
+			Overlap: &durationpb.Duration{Seconds: 1, Nanos: -1},
+		}.Build(), adminToken))
+		requireCode(t, connect.CodeInvalidArgument, err)
+	})
+
+	t.Run("manages keys", func(t *testing.T) {
+		a, _ := create(t, "exports")
+
+		_, err := c.accounts.AddServiceAccountKey(ctx, withBearer(guardianv1.AddServiceAccountKeyRequest_builder{
+			ServiceAccountId: a.GetId(),
+			Jwk:              `{"kty":"oct","k":"c2VjcmV0"}`,
+		}.Build(), adminToken))
+		requireCode(t, connect.CodeInvalidArgument, err)
+
+		added, err := c.accounts.AddServiceAccountKey(ctx, withBearer(guardianv1.AddServiceAccountKeyRequest_builder{
+			ServiceAccountId: a.GetId(),
+			Jwk:              `{"kty":"OKP","crv":"Ed25519","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo","kid":"k1"}`,
+		}.Build(), adminToken))
+		require.NoError(t, err)
+		key := added.Msg.GetKey()
+		require.Equal(t, "k1", key.GetKeyId())
+		require.Equal(t, "EdDSA", key.GetAlgorithm())
+
+		list, err := c.accounts.ListServiceAccountKeys(ctx, withBearer(guardianv1.ListServiceAccountKeysRequest_builder{ServiceAccountId: a.GetId()}.Build(), adminToken))
+		require.NoError(t, err)
+		require.Len(t, list.Msg.GetKeys(), 1)
+
+		_, err = c.accounts.DeleteServiceAccountKey(ctx, withBearer(guardianv1.DeleteServiceAccountKeyRequest_builder{
+			ServiceAccountId: uuid.NewString(),
+			Id:               key.GetId(),
+		}.Build(), adminToken))
+		requireCode(t, connect.CodeNotFound, err)
+
+		_, err = c.accounts.DeleteServiceAccountKey(ctx, withBearer(guardianv1.DeleteServiceAccountKeyRequest_builder{
+			ServiceAccountId: a.GetId(),
+			Id:               key.GetId(),
+		}.Build(), adminToken))
+		require.NoError(t, err)
+	})
+
+	t.Run("assigns roles to accounts", func(t *testing.T) {
+		a, _ := create(t, "invoicing")
+
+		writer, err := c.rbac.CreateRole(ctx, core.CreateRoleParams{Name: "invoice-writer"})
+		require.NoError(t, err)
+		require.NoError(t, c.rbac.GrantPermission(ctx, writer.ID, "invoices.write"))
+
+		_, err = c.authz.AssignRole(ctx, withBearer(guardianv1.AssignRoleRequest_builder{
+			UserId:           user.GetUser().GetId(),
+			ServiceAccountId: a.GetId(),
+			RoleId:           writer.ID.String(),
+		}.Build(), adminToken))
+		requireCode(t, connect.CodeInvalidArgument, err)
+
+		assigned, err := c.authz.AssignRole(ctx, withBearer(guardianv1.AssignRoleRequest_builder{
+			ServiceAccountId: a.GetId(),
+			RoleId:           writer.ID.String(),
+		}.Build(), adminToken))
+		require.NoError(t, err)
+		require.Equal(t, a.GetId(), assigned.Msg.GetAssignment().GetServiceAccountId())
+		require.Empty(t, assigned.Msg.GetAssignment().GetUserId())
+
+		list, err := c.authz.ListRoleAssignments(ctx, withBearer(guardianv1.ListRoleAssignmentsRequest_builder{ServiceAccountId: a.GetId()}.Build(), adminToken))
+		require.NoError(t, err)
+		require.Len(t, list.Msg.GetAssignments(), 1)
+
+		check, err := c.authz.Check(ctx, withBearer(guardianv1.CheckRequest_builder{
+			Subject:    a.GetId(),
+			Permission: "invoices.write",
+		}.Build(), adminToken))
+		require.NoError(t, err)
+		require.True(t, check.Msg.GetAllowed())
+
+		_, err = c.authz.UnassignRole(ctx, withBearer(guardianv1.UnassignRoleRequest_builder{
+			ServiceAccountId: a.GetId(),
+			RoleId:           writer.ID.String(),
+		}.Build(), adminToken))
+		require.NoError(t, err)
+	})
+}
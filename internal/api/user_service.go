@@ -0,0 +1,329 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+	"github.com/gophero/guardian/core/proto/guardian/v1/guardianv1connect"
+)
+
+type userAction string
+
+const (
+	userActionGet          userAction = "get"
+	userActionUpdate       userAction = "update"
+	userActionUpdateStatus userAction = "update_status"
+	userActionUpdateEmail  userAction = "update_email"
+	userActionList         userAction = "list"
+	userActionDelete       userAction = "delete"
+)
+
+// selfAccess returns a [connect.CodePermissionDenied] error unless users may perform action on target by themselves,
+// which is [uuid.Nil] for [userActionList]. Users may get, update and delete only themselves. They cannot change their
+// own status or list users, and have to confirm changes of their own email.
+func selfAccess(caller uuid.UUID, action userAction, target uuid.UUID) error {
+	switch {
+	case action == userActionList:
+		return connect.NewError(connect.CodePermissionDenied, errors.New("api: listing users is not permitted"))
+	case action == userActionUpdateStatus:
+		return connect.NewError(connect.CodePermissionDenied, errors.New("api: users cannot change their own status"))
+	case action == userActionUpdateEmail:
+		return connect.NewError(connect.CodePermissionDenied, errors.New("api: users have to confirm a new email with RequestEmailChange"))
+	case caller != target:
+		return connect.NewError(connect.CodePermissionDenied, errors.New("api: users can only access themselves"))
+	default:
+		return nil
+	}
+}
+
+// UserService implements [guardianv1connect.UserServiceHandler].
+type UserService struct {
+	users    core.UserStore
+	profiles core.UserProfileStore
+	sessions core.SessionStore
+	refresh  core.RefreshTokenStore
+	rbac     core.RBACStore
+	verifier *emailVerifier
+	auth     *authenticator
+}
+
+var _ guardianv1connect.UserServiceHandler = (*UserService)(nil)
+
+// NewUserService constructs new [UserService].
+func NewUserService(
+	users core.UserStore,
+	profiles core.UserProfileStore,
+	sessions core.SessionStore,
+	refresh core.RefreshTokenStore,
+	tokens core.AccessTokenIssuer,
+	verifications core.EmailVerificationStore,
+	mailer core.Mailer,
+	rbac core.RBACStore,
+) *UserService {
+	return &UserService{
+		users:    users,
+		profiles: profiles,
+		sessions: sessions,
+		refresh:  refresh,
+		rbac:     rbac,
+		verifier: &emailVerifier{verifications: verifications, mailer: mailer},
+		auth:     &authenticator{tokens: tokens, sessions: sessions, now: time.Now},
+	}
+}
+
+// authorize authenticates the caller and returns the id of the target user if the caller may perform action on it.
+func (s *UserService) authorize(ctx context.Context, req connect.AnyRequest, action userAction, id string) (uuid.UUID, error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	userID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, connect.NewError(connect.CodeInvalidArgument, errors.New("api: id is not a valid uuid"))
+	}
+
+	if err := s.access(ctx, req, p, action, userID); err != nil {
+		return uuid.Nil, err
+	}
+
+	return userID, nil
+}
+
+// access returns a [connect.CodePermissionDenied] error if the caller may not perform action on target, which is
+// [uuid.Nil] for [userActionList]. Callers with the [core.PermissionUsersManage] permission may perform every action on
+// every user, others only what [selfAccess] permits.
+func (s *UserService) access(ctx context.Context, req connect.AnyRequest, p principal, action userAction, target uuid.UUID) error {
+	denied := selfAccess(p.UserID, action, target)
+	if denied == nil {
+		return nil
+	}
+
+	d, err := s.rbac.Check(ctx, p.UserID, core.PermissionUsersManage, uuid.Nil, callerAttributes(req, s.auth.now()))
+	if err != nil {
+		return toConnectError(ctx, err)
+	}
+
+	if !d.Allowed {
+		return denied
+	}
+
+	return nil
+}
+
+// GetUser implements [guardianv1connect.UserServiceHandler].
+func (s *UserService) GetUser(ctx context.Context, req *connect.Request[guardianv1.GetUserRequest]) (*connect.Response[guardianv1.GetUserResponse], error) {
+	id, err := s.authorize(ctx, req, userActionGet, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.users.Get(ctx, id)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.GetUserResponse_builder{User: toUser(user)}.Build()), nil
+}
+
+// UpdateUser implements [guardianv1connect.UserServiceHandler].
+func (s *UserService) UpdateUser(ctx context.Context, req *connect.Request[guardianv1.UpdateUserRequest]) (*connect.Response[guardianv1.UpdateUserResponse], error) {
+	msg := req.Msg
+
+	action := userActionUpdate
+	switch {
+	case msg.HasStatus():
+		action = userActionUpdateStatus
+	case msg.HasEmail():
+		action = userActionUpdateEmail
+	}
+
+	id, err := s.authorize(ctx, req, action, msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	var params core.UpdateUserParams
+	if msg.HasEmail() {
+		email := msg.GetEmail()
+		params.Email = &email
+	}
+	if msg.HasUsername() {
+		username := msg.GetUsername()
+		params.Username = &username
+	}
+	if msg.HasStatus() {
+		status := fromUserStatus(msg.GetStatus())
+		if status == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("api: status is not valid"))
+		}
+		params.Status = &status
+	}
+
+	user, err := s.users.Update(ctx, id, params)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	// Access tokens are only accepted while their session is active, so suspending or disabling a user ends their
+	// access like deleting them does.
+	if params.Status != nil && *params.Status != core.UserStatusActive {
+		if _, err := s.sessions.RevokeAll(ctx, id, uuid.Nil, core.SessionRevokeUser); err != nil {
+			return nil, toConnectError(ctx, err)
+		}
+
+		if _, err := s.refresh.RevokeUser(ctx, id, core.RefreshTokenRevokeUser); err != nil {
+			return nil, toConnectError(ctx, err)
+		}
+	}
+
+	return connect.NewResponse(guardianv1.UpdateUserResponse_builder{User: toUser(user)}.Build()), nil
+}
+
+// RequestEmailChange implements [guardianv1connect.UserServiceHandler]. The email is changed by
+// [AuthService.VerifyEmail].
+func (s *UserService) RequestEmailChange(ctx context.Context, req *connect.Request[guardianv1.RequestEmailChangeRequest]) (*connect.Response[guardianv1.RequestEmailChangeResponse], error) {
+	msg := req.Msg
+
+	id, err := s.authorize(ctx, req, userActionUpdate, msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.GetEmail() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("api: email cannot be empty"))
+	}
+
+	user, err := s.users.Get(ctx, id)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	if strings.EqualFold(user.Email, msg.GetEmail()) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("api: email is unchanged"))
+	}
+
+	if err := s.verifier.send(ctx, user, msg.GetEmail(), core.EmailVerificationChange); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.RequestEmailChangeResponse{}), nil
+}
+
+// ListUsers implements [guardianv1connect.UserServiceHandler].
+func (s *UserService) ListUsers(ctx context.Context, req *connect.Request[guardianv1.ListUsersRequest]) (*connect.Response[guardianv1.ListUsersResponse], error) {
+	p, err := s.auth.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.access(ctx, req, p, userActionList, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	msg := req.Msg
+	status := fromUserStatus(msg.GetStatus())
+
+	var page core.UserPage
+	switch {
+	case msg.GetQuery() != "" && status != "":
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("api: status and query cannot be combined"))
+	case msg.GetQuery() != "":
+		page, err = s.users.Search(ctx, core.SearchUsersParams{
+			Query:  msg.GetQuery(),
+			Cursor: msg.GetPageToken(),
+			Limit:  int(msg.GetPageSize()),
+		})
+	default:
+		page, err = s.users.List(ctx, core.ListUsersParams{
+			Status: status,
+			Cursor: msg.GetPageToken(),
+			Limit:  int(msg.GetPageSize()),
+		})
+	}
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	users := make([]*guardianv1.User, 0, len(page.Users))
+	for _, u := range page.Users {
+		users = append(users, toUser(u))
+	}
+
+	return connect.NewResponse(guardianv1.ListUsersResponse_builder{
+		Users:         users,
+		NextPageToken: page.NextCursor,
+	}.Build()), nil
+}
+
+// DeleteUser implements [guardianv1connect.UserServiceHandler]. All sessions and refresh tokens of the user are
+// revoked.
+func (s *UserService) DeleteUser(ctx context.Context, req *connect.Request[guardianv1.DeleteUserRequest]) (*connect.Response[guardianv1.DeleteUserResponse], error) {
+	id, err := s.authorize(ctx, req, userActionDelete, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.users.Delete(ctx, id); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	if _, err := s.sessions.RevokeAll(ctx, id, uuid.Nil, core.SessionRevokeUser); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	if _, err := s.refresh.RevokeUser(ctx, id, core.RefreshTokenRevokeUser); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&guardianv1.DeleteUserResponse{}), nil
+}
+
+// GetUserProfile implements [guardianv1connect.UserServiceHandler].
+func (s *UserService) GetUserProfile(ctx context.Context, req *connect.Request[guardianv1.GetUserProfileRequest]) (*connect.Response[guardianv1.GetUserProfileResponse], error) {
+	id, err := s.authorize(ctx, req, userActionGet, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	// Profiles of users which do not exist are not reported as empty.
+	if _, err := s.users.Get(ctx, id); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	profile, err := s.profiles.Get(ctx, id)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.GetUserProfileResponse_builder{Profile: toUserProfile(profile)}.Build()), nil
+}
+
+// UpdateUserProfile implements [guardianv1connect.UserServiceHandler].
+func (s *UserService) UpdateUserProfile(ctx context.Context, req *connect.Request[guardianv1.UpdateUserProfileRequest]) (*connect.Response[guardianv1.UpdateUserProfileResponse], error) {
+	id, err := s.authorize(ctx, req, userActionUpdate, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.users.Get(ctx, id); err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	profile := fromUserProfile(req.Msg.GetProfile())
+	profile.UserID = id
+
+	profile, err = s.profiles.Update(ctx, profile)
+	if err != nil {
+		return nil, toConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(guardianv1.UpdateUserProfileResponse_builder{Profile: toUserProfile(profile)}.Build()), nil
+}
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"context"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/mail"
+)
+
+// emailVerifier emails verification links to users.
+type emailVerifier struct {
+	verifications core.EmailVerificationStore
+	mailer        core.Mailer
+}
+
+// send creates a verification of email for the user and emails its link to email. It returns [core.ErrRateLimited] if
+// the user was sent a verification of the same purpose recently.
+func (v *emailVerifier) send(ctx context.Context, user core.User, email string, purpose core.EmailVerificationPurpose) error {
+	verification, link, err := v.verifications.Create(ctx, user.ID, email, purpose)
+	if err != nil {
+		return err
+	}
+
+	expiresIn := verification.ExpiresAt.Sub(verification.CreatedAt)
+
+	var msg core.Email
+
+	switch purpose {
+	case core.EmailVerificationChange:
+		msg, err = mail.ConfirmEmailChange(email, mail.ConfirmEmailChangeData{
+			Username:  user.Username,
+			Email:     email,
+			Link:      link,
+			ExpiresIn: expiresIn,
+		})
+	default:
+		msg, err = mail.VerifyEmail(email, mail.VerifyEmailData{Username: user.Username, Link: link, ExpiresIn: expiresIn})
+	}
+	if err != nil {
+		return err
+	}
+
+	return v.mailer.Send(ctx, msg)
+}
@@ -0,0 +1,89 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/gophero/guardian/core"
+	guardianv1 "github.com/gophero/guardian/core/proto/guardian/v1"
+	"github.com/gophero/guardian/core/proto/guardian/v1/guardianv1connect"
+)
+
+// Client is a [core.APIKeyVerifier] verifying keys with the APIKeyService of a remote guardian, for services which do
+// not access its database.
+type Client struct {
+	client guardianv1connect.APIKeyServiceClient
+}
+
+var _ core.APIKeyVerifier = (*Client)(nil)
+
+// NewClient constructs new [Client] calling the guardian at baseURL.
+func NewClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) *Client {
+	return &Client{client: guardianv1connect.NewAPIKeyServiceClient(httpClient, baseURL, opts...)}
+}
+
+// Verify implements [core.APIKeyVerifier].
+func (c *Client) Verify(ctx context.Context, token string) (core.APIKey, error) {
+	res, err := c.client.VerifyAPIKey(ctx, connect.NewRequest(guardianv1.VerifyAPIKeyRequest_builder{Token: token}.Build()))
+	if connect.CodeOf(err) == connect.CodeUnauthenticated {
+		return core.APIKey{}, core.ErrInvalidCredentials
+	}
+
+	if err != nil {
+		return core.APIKey{}, fmt.Errorf("apikey: verify api key: %w", err)
+	}
+
+	return fromAPIKey(res.Msg.GetApiKey())
+}
+
+var ownerTypes = map[guardianv1.APIKeyOwnerType]core.APIKeyOwnerType{
+	guardianv1.APIKeyOwnerType_API_KEY_OWNER_TYPE_USER:            core.APIKeyOwnerUser,
+	guardianv1.APIKeyOwnerType_API_KEY_OWNER_TYPE_ORGANIZATION:    core.APIKeyOwnerOrganization,
+	guardianv1.APIKeyOwnerType_API_KEY_OWNER_TYPE_SERVICE_ACCOUNT: core.APIKeyOwnerServiceAccount,
+}
+
+func fromAPIKey(k *guardianv1.APIKey) (core.APIKey, error) {
+	id, err := uuid.Parse(k.GetId())
+	if err != nil {
+		return core.APIKey{}, fmt.Errorf("apikey: parse id: %w", err)
+	}
+
+	ownerID, err := uuid.Parse(k.GetOwnerId())
+	if err != nil {
+		return core.APIKey{}, fmt.Errorf("apikey: parse owner id: %w", err)
+	}
+
+	ownerType, ok := ownerTypes[k.GetOwnerType()]
+	if !ok {
+		return core.APIKey{}, errors.New("apikey: unknown owner type")
+	}
+
+	return core.APIKey{
+		ID:                id,
+		Name:              k.GetName(),
+		Prefix:            k.GetPrefix(),
+		Owner:             core.APIKeyOwner{Type: ownerType, ID: ownerID},
+		Scopes:            k.GetScopes(),
+		CreatedAt:         k.GetCreatedAt().AsTime(),
+		ExpiresAt:         optionalTime(k.GetExpiresAt()),
+		LastUsedAt:        optionalTime(k.GetLastUsedAt()),
+		RotatedAt:         optionalTime(k.GetRotatedAt()),
+		PreviousExpiresAt: optionalTime(k.GetPreviousExpiresAt()),
+	}, nil
+}
+
+// optionalTime returns nil for an unset timestamp.
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	t := ts.AsTime()
+	return &t
+}
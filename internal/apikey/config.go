@@ -0,0 +1,28 @@
+package apikey
+
+import (
+	"errors"
+	"time"
+)
+
+type Config struct {
+	RotationOverlap    time.Duration `help:"Default duration for which the previous secret of a rotated API key stays valid." name:"rotation_overlap" env:"ROTATION_OVERLAP" default:"24h"`
+	MaxRotationOverlap time.Duration `help:"Maximum duration for which the previous secret of a rotated API key stays valid." name:"max_rotation_overlap" env:"MAX_ROTATION_OVERLAP" default:"168h"`
+	UsageFlushInterval time.Duration `help:"Interval in which the last used times of API keys are written in a batch." name:"usage_flush_interval" env:"USAGE_FLUSH_INTERVAL" default:"1m"`
+}
+
+func (c Config) validate() error {
+	if c.MaxRotationOverlap < 0 {
+		return errors.New("apikey: MaxRotationOverlap cannot be negative")
+	}
+
+	if c.RotationOverlap < 0 || c.RotationOverlap > c.MaxRotationOverlap {
+		return errors.New("apikey: RotationOverlap cannot be negative or greater than MaxRotationOverlap")
+	}
+
+	if c.UsageFlushInterval <= 0 {
+		return errors.New("apikey: UsageFlushInterval cannot be zero or negative")
+	}
+
+	return nil
+}
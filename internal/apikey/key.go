@@ -0,0 +1,39 @@
+package apikey
+
+import (
+	"strings"
+
+	"github.com/gophero/guardian/internal/secret"
+)
+
+const (
+	// keyPrefix marks guardian API keys, so secret scanners can recognize leaked keys.
+	keyPrefix = "gdn_"
+
+	// prefixSize is the number of random bytes of the visible prefix. Prefixes only need to be unique, the secret
+	// provides the entropy.
+	prefixSize = 9
+)
+
+var (
+	// prefixLength is the length of the visible prefix including keyPrefix.
+	prefixLength = len(keyPrefix) + len(secret.New(prefixSize))
+	// keyLength is the length of a whole key.
+	keyLength = prefixLength + 1 + len(secret.New(secret.DefaultSize))
+)
+
+// newKey returns a new key along with its visible prefix.
+func newKey() (key, prefix string) {
+	prefix = keyPrefix + secret.New(prefixSize)
+	return prefix + "_" + secret.New(secret.DefaultSize), prefix
+}
+
+// parseKey returns the visible prefix of key. Base64 encoded parts may contain underscores themselves, so the key is
+// split by length.
+func parseKey(key string) (string, bool) {
+	if len(key) != keyLength || !strings.HasPrefix(key, keyPrefix) || key[prefixLength] != '_' {
+		return "", false
+	}
+
+	return key[:prefixLength], true
+}
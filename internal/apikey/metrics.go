@@ -0,0 +1,46 @@
+package apikey
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Results of verifications, the values of the result label.
+const (
+	resultValid   = "valid"
+	resultInvalid = "invalid"
+	resultExpired = "expired"
+)
+
+type metrics struct {
+	verified *prometheus.CounterVec
+	pending  prometheus.GaugeFunc
+}
+
+func newMetrics(pending func() float64) *metrics {
+	return &metrics{
+		verified: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "guardian",
+			Subsystem: "api_key",
+			Name:      "verified_total",
+			Help:      "The cumulative count of API key verifications labeled by result.",
+		}, []string{"result"}),
+		pending: prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: "guardian",
+			Subsystem: "api_key",
+			Name:      "pending_usage",
+			Help:      "The number of API keys whose last used time is not written yet.",
+		}, pending),
+	}
+}
+
+// Describe implements [prometheus.Collector].
+func (m *metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.verified.Describe(ch)
+	m.pending.Describe(ch)
+}
+
+// Collect implements [prometheus.Collector].
+func (m *metrics) Collect(ch chan<- prometheus.Metric) {
+	m.verified.Collect(ch)
+	m.pending.Collect(ch)
+}
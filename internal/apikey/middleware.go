@@ -0,0 +1,73 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/gophero/guardian/core"
+)
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying key.
+func NewContext(ctx context.Context, key core.APIKey) context.Context {
+	return context.WithValue(ctx, contextKey{}, key)
+}
+
+// FromContext returns the API key the request of ctx was authenticated with by [Middleware].
+func FromContext(ctx context.Context) (core.APIKey, bool) {
+	key, ok := ctx.Value(contextKey{}).(core.APIKey)
+	return key, ok
+}
+
+// Middleware authenticates requests by the API key in the `Authorization: Bearer` header and requires it to have all
+// scopes. Requests without a valid key are answered with 401 Unauthorized and requests with a key lacking scopes with
+// 403 Forbidden, with a `WWW-Authenticate` header like RFC 6750 describes. The key is available to next through
+// [FromContext].
+func Middleware(verifier core.APIKeyVerifier, scopes ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := bearerToken(r.Header)
+			if !ok {
+				w.Header().Set("WWW-Authenticate", `Bearer`)
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+
+			key, err := verifier.Verify(r.Context(), token)
+			if errors.Is(err, core.ErrInvalidCredentials) {
+				w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+
+			if err != nil {
+				zerolog.Ctx(r.Context()).Err(err).Msg("failed to verify api key")
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+
+			if !key.HasScopes(scopes...) {
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer error="insufficient_scope", scope="%s"`, strings.Join(scopes, " ")))
+				http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), key)))
+		})
+	}
+}
+
+func bearerToken(h http.Header) (string, bool) {
+	scheme, token, ok := strings.Cut(h.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+
+	return token, true
+}
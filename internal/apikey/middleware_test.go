@@ -0,0 +1,88 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+)
+
+// fakeVerifier accepts a single token.
+type fakeVerifier struct {
+	token string
+	key   core.APIKey
+	err   error
+}
+
+func (f fakeVerifier) Verify(_ context.Context, token string) (core.APIKey, error) {
+	if f.err != nil {
+		return core.APIKey{}, f.err
+	}
+
+	if token != f.token {
+		return core.APIKey{}, core.ErrInvalidCredentials
+	}
+
+	return f.key, nil
+}
+
+func TestMiddleware(t *testing.T) {
+	key := core.APIKey{ID: uuid.New(), Scopes: []string{"documents.read"}}
+	verifier := fakeVerifier{token: "gdn_test", key: key}
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, ok := FromContext(r.Context())
+		require.True(t, ok)
+		require.Equal(t, key.ID, got.ID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	serve := func(verifier core.APIKeyVerifier, authorization string, scopes ...string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		if authorization != "" {
+			r.Header.Set("Authorization", authorization)
+		}
+
+		w := httptest.NewRecorder()
+		Middleware(verifier, scopes...)(next).ServeHTTP(w, r)
+
+		return w
+	}
+
+	tests := []struct {
+		name          string
+		verifier      core.APIKeyVerifier
+		auth          string
+		scopes        []string
+		wantStatus    int
+		wantChallenge string
+	}{
+		{name: "valid", verifier: verifier, auth: "Bearer gdn_test", scopes: []string{"documents.read"}, wantStatus: http.StatusNoContent},
+		{name: "missing", verifier: verifier, wantStatus: http.StatusUnauthorized, wantChallenge: `Bearer`},
+		{name: "other scheme", verifier: verifier, auth: "Basic gdn_test", wantStatus: http.StatusUnauthorized, wantChallenge: `Bearer`},
+		{name: "invalid", verifier: verifier, auth: "Bearer gdn_other", wantStatus: http.StatusUnauthorized, wantChallenge: `Bearer error="invalid_token"`},
+		{
+			name:          "insufficient scope",
+			verifier:      verifier,
+			auth:          "bearer gdn_test",
+			scopes:        []string{"documents.read", "documents.write"},
+			wantStatus:    http.StatusForbidden,
+			wantChallenge: `Bearer error="insufficient_scope", scope="documents.read documents.write"`,
+		},
+		{name: "failing verifier", verifier: fakeVerifier{err: errors.New("connection refused")}, auth: "Bearer gdn_test", wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serve(tt.verifier, tt.auth, tt.scopes...)
+			require.Equal(t, tt.wantStatus, w.Code)
+			require.Equal(t, tt.wantChallenge, w.Header().Get("WWW-Authenticate"))
+		})
+	}
+}
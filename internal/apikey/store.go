@@ -0,0 +1,336 @@
+// Package apikey manages API keys authenticating scripts and CI jobs, and verifies them for HTTP services.
+package apikey
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/internal/db/queries"
+	"github.com/gophero/guardian/internal/secret"
+)
+
+// Store is a postgres backed [core.APIKeyStore]. Verifications record the use of keys in memory, which
+// [Store.FlushUsage] writes in a single batch. It is also a [prometheus.Collector] exporting verification metrics.
+type Store struct {
+	config Config
+	q      queries.Querier
+	now    func() time.Time
+
+	mu    sync.Mutex
+	usage map[uuid.UUID]time.Time // Last use of keys since the last flush.
+
+	*metrics
+}
+
+var (
+	_ core.APIKeyStore     = (*Store)(nil)
+	_ prometheus.Collector = (*Store)(nil)
+)
+
+// NewStore constructs new [Store].
+func NewStore(pool *pgxpool.Pool, config Config) (*Store, error) {
+	return newStore(queries.New(pool), config)
+}
+
+func newStore(q queries.Querier, config Config) (*Store, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	s := &Store{config: config, q: q, now: time.Now, usage: make(map[uuid.UUID]time.Time)}
+	s.metrics = newMetrics(func() float64 {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		return float64(len(s.usage))
+	})
+
+	return s, nil
+}
+
+// Create implements [core.APIKeyStore].
+func (s *Store) Create(ctx context.Context, params core.CreateAPIKeyParams) (core.APIKey, string, error) {
+	if err := validateName(params.Name); err != nil {
+		return core.APIKey{}, "", err
+	}
+
+	if err := validateOwner(params.Owner); err != nil {
+		return core.APIKey{}, "", err
+	}
+
+	scopes := slices.Compact(slices.Sorted(slices.Values(params.Scopes)))
+	if err := validateScopes(scopes); err != nil {
+		return core.APIKey{}, "", err
+	}
+
+	// A nil slice would be stored as NULL.
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	if params.ExpiresAt != nil && !params.ExpiresAt.After(s.now()) {
+		return core.APIKey{}, "", fmt.Errorf("apikey: expiration must be in the future: %w", core.ErrInvalidArgument)
+	}
+
+	key, prefix := newKey()
+
+	arg := queries.CreateAPIKeyParams{
+		Name:       params.Name,
+		Prefix:     prefix,
+		SecretHash: secret.Hash(key),
+		Scopes:     scopes,
+		ExpiresAt:  params.ExpiresAt,
+	}
+
+	switch params.Owner.Type {
+	case core.APIKeyOwnerUser:
+		arg.UserID = &params.Owner.ID
+	case core.APIKeyOwnerOrganization:
+		arg.OrgID = &params.Owner.ID
+	case core.APIKeyOwnerServiceAccount:
+		arg.ServiceAccountID = &params.Owner.ID
+	}
+
+	k, err := s.q.CreateAPIKey(ctx, arg)
+	if err != nil {
+		return core.APIKey{}, "", fmt.Errorf("apikey: create api key: %w", mapError(err))
+	}
+
+	return toAPIKey(k, s.now()), key, nil
+}
+
+// Verify implements [core.APIKeyVerifier].
+func (s *Store) Verify(ctx context.Context, token string) (core.APIKey, error) {
+	prefix, ok := parseKey(token)
+	if !ok {
+		s.verified.WithLabelValues(resultInvalid).Inc()
+		return core.APIKey{}, core.ErrInvalidCredentials
+	}
+
+	k, err := s.q.GetAPIKeyByPrefix(ctx, prefix)
+	if errors.Is(err, pgx.ErrNoRows) {
+		s.verified.WithLabelValues(resultInvalid).Inc()
+		return core.APIKey{}, core.ErrInvalidCredentials
+	}
+
+	if err != nil {
+		return core.APIKey{}, fmt.Errorf("apikey: get api key by prefix: %w", err)
+	}
+
+	now := s.now()
+	hash := secret.Hash(token)
+
+	current := subtle.ConstantTimeCompare(hash, k.SecretHash) == 1
+	previous := k.PreviousExpiresAt != nil && now.Before(*k.PreviousExpiresAt) &&
+		subtle.ConstantTimeCompare(hash, k.PreviousSecretHash) == 1
+
+	if !current && !previous {
+		s.verified.WithLabelValues(resultInvalid).Inc()
+		return core.APIKey{}, core.ErrInvalidCredentials
+	}
+
+	if k.ExpiresAt != nil && !now.Before(*k.ExpiresAt) {
+		s.verified.WithLabelValues(resultExpired).Inc()
+		return core.APIKey{}, core.ErrInvalidCredentials
+	}
+
+	s.verified.WithLabelValues(resultValid).Inc()
+
+	s.mu.Lock()
+	s.usage[k.ID] = now
+	s.mu.Unlock()
+
+	return toAPIKey(k, now), nil
+}
+
+// Get implements [core.APIKeyStore].
+func (s *Store) Get(ctx context.Context, id uuid.UUID) (core.APIKey, error) {
+	k, err := s.q.GetAPIKeyByID(ctx, id)
+	if err != nil {
+		return core.APIKey{}, fmt.Errorf("apikey: get api key by id: %w", mapError(err))
+	}
+
+	return toAPIKey(k, s.now()), nil
+}
+
+// List implements [core.APIKeyStore].
+func (s *Store) List(ctx context.Context, owner core.APIKeyOwner) ([]core.APIKey, error) {
+	if err := validateOwner(owner); err != nil {
+		return nil, err
+	}
+
+	var arg queries.ListAPIKeysByOwnerParams
+
+	switch owner.Type {
+	case core.APIKeyOwnerUser:
+		arg.UserID = &owner.ID
+	case core.APIKeyOwnerOrganization:
+		arg.OrgID = &owner.ID
+	case core.APIKeyOwnerServiceAccount:
+		arg.ServiceAccountID = &owner.ID
+	}
+
+	ks, err := s.q.ListAPIKeysByOwner(ctx, arg)
+	if err != nil {
+		return nil, fmt.Errorf("apikey: list api keys by owner: %w", err)
+	}
+
+	now := s.now()
+
+	res := make([]core.APIKey, 0, len(ks))
+	for _, k := range ks {
+		res = append(res, toAPIKey(k, now))
+	}
+
+	return res, nil
+}
+
+// Rotate implements [core.APIKeyStore].
+func (s *Store) Rotate(ctx context.Context, id uuid.UUID, overlap *time.Duration) (core.APIKey, string, error) {
+	d := s.config.RotationOverlap
+	if overlap != nil {
+		d = *overlap
+	}
+
+	if d < 0 || d > s.config.MaxRotationOverlap {
+		return core.APIKey{}, "", fmt.Errorf("apikey: overlap must be between 0 and %s: %w", s.config.MaxRotationOverlap, core.ErrInvalidArgument)
+	}
+
+	k, err := s.q.GetAPIKeyByID(ctx, id)
+	if err != nil {
+		return core.APIKey{}, "", fmt.Errorf("apikey: get api key by id: %w", mapError(err))
+	}
+
+	// The prefix identifies the key, so it is kept and only the secret part is replaced.
+	key := k.Prefix + "_" + secret.New(secret.DefaultSize)
+
+	var previousExpiresAt *time.Time
+	if d > 0 {
+		t := s.now().Add(d)
+		previousExpiresAt = &t
+	}
+
+	k, err = s.q.RotateAPIKey(ctx, queries.RotateAPIKeyParams{
+		PreviousExpiresAt: previousExpiresAt,
+		SecretHash:        secret.Hash(key),
+		ID:                id,
+	})
+	if err != nil {
+		return core.APIKey{}, "", fmt.Errorf("apikey: rotate api key: %w", mapError(err))
+	}
+
+	return toAPIKey(k, s.now()), key, nil
+}
+
+// Revoke implements [core.APIKeyStore].
+func (s *Store) Revoke(ctx context.Context, id uuid.UUID) error {
+	n, err := s.q.DeleteAPIKey(ctx, id)
+	if err != nil {
+		return fmt.Errorf("apikey: delete api key: %w", err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("apikey: delete api key: %w", core.ErrNotFound)
+	}
+
+	return nil
+}
+
+// FlushUsage writes the last used times recorded since the last flush and returns the number of updated keys. Times
+// which fail to be written are kept for the next flush.
+func (s *Store) FlushUsage(ctx context.Context) (int64, error) {
+	s.mu.Lock()
+	usage := s.usage
+	s.usage = make(map[uuid.UUID]time.Time, len(usage))
+	s.mu.Unlock()
+
+	if len(usage) == 0 {
+		return 0, nil
+	}
+
+	ids := slices.Collect(maps.Keys(usage))
+	times := make([]time.Time, 0, len(ids))
+	for _, id := range ids {
+		times = append(times, usage[id])
+	}
+
+	n, err := s.q.UpdateAPIKeysLastUsed(ctx, queries.UpdateAPIKeysLastUsedParams{Ids: ids, LastUsedAts: times})
+	if err != nil {
+		s.mu.Lock()
+		for id, t := range usage {
+			if u, ok := s.usage[id]; !ok || u.Before(t) {
+				s.usage[id] = t
+			}
+		}
+		s.mu.Unlock()
+
+		return 0, fmt.Errorf("apikey: update api keys last used: %w", err)
+	}
+
+	return n, nil
+}
+
+// DeleteExpired deletes expired keys.
+func (s *Store) DeleteExpired(ctx context.Context) (int64, error) {
+	n, err := s.q.DeleteExpiredAPIKeys(ctx, s.now())
+	if err != nil {
+		return 0, fmt.Errorf("apikey: delete expired api keys: %w", err)
+	}
+
+	return n, nil
+}
+
+func mapError(err error) error {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return core.ErrNotFound
+	case db.IsUniqueViolation(err):
+		return core.ErrAlreadyExists
+	case db.IsForeignKeyViolation(err):
+		return core.ErrNotFound
+	default:
+		return err
+	}
+}
+
+// toAPIKey converts k, hiding the previous secret once it expired at now.
+func toAPIKey(k queries.ApiKey, now time.Time) core.APIKey {
+	key := core.APIKey{
+		ID:         k.ID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		CreatedAt:  k.CreatedAt,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		RotatedAt:  k.RotatedAt,
+	}
+
+	if k.PreviousExpiresAt != nil && now.Before(*k.PreviousExpiresAt) {
+		key.PreviousExpiresAt = k.PreviousExpiresAt
+	}
+
+	switch {
+	case k.UserID != nil:
+		key.Owner = core.APIKeyOwner{Type: core.APIKeyOwnerUser, ID: *k.UserID}
+	case k.OrgID != nil:
+		key.Owner = core.APIKeyOwner{Type: core.APIKeyOwnerOrganization, ID: *k.OrgID}
+	case k.ServiceAccountID != nil:
+		key.Owner = core.APIKeyOwner{Type: core.APIKeyOwnerServiceAccount, ID: *k.ServiceAccountID}
+	}
+
+	return key
+}
@@ -0,0 +1,354 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db/dbtest"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+// fakeQuerier keeps keys in memory. Queries not used by [Store] panic.
+type fakeQuerier struct {
+	queries.Querier
+
+	keys    map[uuid.UUID]queries.ApiKey
+	flushes []queries.UpdateAPIKeysLastUsedParams
+	failing bool // Fails writes of last used times.
+}
+
+func newFakeQuerier() *fakeQuerier {
+	return &fakeQuerier{keys: map[uuid.UUID]queries.ApiKey{}}
+}
+
+func (f *fakeQuerier) CreateAPIKey(_ context.Context, arg queries.CreateAPIKeyParams) (queries.ApiKey, error) {
+	k := queries.ApiKey{
+		ID:               uuid.New(),
+		Name:             arg.Name,
+		Prefix:           arg.Prefix,
+		SecretHash:       arg.SecretHash,
+		UserID:           arg.UserID,
+		OrgID:            arg.OrgID,
+		ServiceAccountID: arg.ServiceAccountID,
+		Scopes:           arg.Scopes,
+		CreatedAt:        time.Now(),
+		ExpiresAt:        arg.ExpiresAt,
+	}
+	f.keys[k.ID] = k
+
+	return k, nil
+}
+
+func (f *fakeQuerier) GetAPIKeyByID(_ context.Context, id uuid.UUID) (queries.ApiKey, error) {
+	k, ok := f.keys[id]
+	if !ok {
+		return queries.ApiKey{}, pgx.ErrNoRows
+	}
+
+	return k, nil
+}
+
+func (f *fakeQuerier) GetAPIKeyByPrefix(_ context.Context, prefix string) (queries.ApiKey, error) {
+	for _, k := range f.keys {
+		if k.Prefix == prefix {
+			return k, nil
+		}
+	}
+
+	return queries.ApiKey{}, pgx.ErrNoRows
+}
+
+func (f *fakeQuerier) RotateAPIKey(_ context.Context, arg queries.RotateAPIKeyParams) (queries.ApiKey, error) {
+	k, ok := f.keys[arg.ID]
+	if !ok {
+		return queries.ApiKey{}, pgx.ErrNoRows
+	}
+
+	k.PreviousSecretHash = nil
+	if arg.PreviousExpiresAt != nil {
+		k.PreviousSecretHash = k.SecretHash
+	}
+
+	now := time.Now()
+	k.PreviousExpiresAt = arg.PreviousExpiresAt
+	k.SecretHash = arg.SecretHash
+	k.RotatedAt = &now
+	f.keys[k.ID] = k
+
+	return k, nil
+}
+
+func (f *fakeQuerier) UpdateAPIKeysLastUsed(_ context.Context, arg queries.UpdateAPIKeysLastUsedParams) (int64, error) {
+	if f.failing {
+		return 0, errors.New("connection refused")
+	}
+
+	f.flushes = append(f.flushes, arg)
+	return int64(len(arg.Ids)), nil
+}
+
+func TestParseKey(t *testing.T) {
+	key, prefix := newKey()
+
+	got, ok := parseKey(key)
+	require.True(t, ok)
+	require.Equal(t, prefix, got)
+	require.Len(t, prefix, prefixLength)
+
+	for _, invalid := range []string{"", prefix, key + "x", key[1:], "abcd" + key[4:], prefix + "-" + key[prefixLength+1:]} {
+		_, ok := parseKey(invalid)
+		require.False(t, ok, invalid)
+	}
+}
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	config := Config{RotationOverlap: time.Hour, MaxRotationOverlap: 24 * time.Hour, UsageFlushInterval: time.Minute}
+	owner := core.APIKeyOwner{Type: core.APIKeyOwnerUser, ID: uuid.New()}
+
+	newTestStore := func(t *testing.T) (*Store, *fakeQuerier, *time.Time) {
+		t.Helper()
+
+		q := newFakeQuerier()
+		s, err := newStore(q, config)
+		require.NoError(t, err)
+
+		now := time.Now()
+		s.now = func() time.Time { return now }
+
+		return s, q, &now
+	}
+
+	t.Run("creates and verifies keys", func(t *testing.T) {
+		s, _, _ := newTestStore(t)
+
+		key, token, err := s.Create(ctx, core.CreateAPIKeyParams{
+			Name:   "ci",
+			Owner:  owner,
+			Scopes: []string{"documents.write", "documents.read", "documents.read"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, owner, key.Owner)
+		require.Equal(t, []string{"documents.read", "documents.write"}, key.Scopes)
+
+		got, err := s.Verify(ctx, token)
+		require.NoError(t, err)
+		require.Equal(t, key.ID, got.ID)
+		require.True(t, got.HasScopes("documents.read", "documents.write"))
+		require.False(t, got.HasScopes("documents.delete"))
+
+		// A key with the same prefix but another secret is rejected.
+		forged, _ := newKey()
+		_, err = s.Verify(ctx, key.Prefix+forged[prefixLength:])
+		require.ErrorIs(t, err, core.ErrInvalidCredentials)
+
+		_, err = s.Verify(ctx, "not a key")
+		require.ErrorIs(t, err, core.ErrInvalidCredentials)
+
+		// Keys without scopes store an empty array rather than NULL.
+		key, _, err = s.Create(ctx, core.CreateAPIKeyParams{Name: "ci", Owner: owner})
+		require.NoError(t, err)
+		require.NotNil(t, key.Scopes)
+		require.Empty(t, key.Scopes)
+	})
+
+	t.Run("validates keys", func(t *testing.T) {
+		s, _, now := newTestStore(t)
+
+		past := now.Add(-time.Minute)
+
+		for _, params := range []core.CreateAPIKeyParams{
+			{Name: "", Owner: owner},
+			{Name: "ci", Owner: core.APIKeyOwner{Type: core.APIKeyOwnerUser}},
+			{Name: "ci", Owner: core.APIKeyOwner{Type: "robot", ID: owner.ID}},
+			{Name: "ci", Owner: owner, Scopes: []string{"Documents Read"}},
+			{Name: "ci", Owner: owner, ExpiresAt: &past},
+		} {
+			_, _, err := s.Create(ctx, params)
+			require.ErrorIs(t, err, core.ErrInvalidArgument)
+		}
+	})
+
+	t.Run("expires keys", func(t *testing.T) {
+		s, _, now := newTestStore(t)
+
+		expiresAt := now.Add(time.Hour)
+		_, token, err := s.Create(ctx, core.CreateAPIKeyParams{Name: "ci", Owner: owner, ExpiresAt: &expiresAt})
+		require.NoError(t, err)
+
+		_, err = s.Verify(ctx, token)
+		require.NoError(t, err)
+
+		*now = expiresAt
+		_, err = s.Verify(ctx, token)
+		require.ErrorIs(t, err, core.ErrInvalidCredentials)
+	})
+
+	t.Run("rotates keys with overlap", func(t *testing.T) {
+		s, _, now := newTestStore(t)
+
+		key, before, err := s.Create(ctx, core.CreateAPIKeyParams{Name: "ci", Owner: owner})
+		require.NoError(t, err)
+
+		// The configured overlap applies by default.
+		rotated, after, err := s.Rotate(ctx, key.ID, nil)
+		require.NoError(t, err)
+		require.Equal(t, key.Prefix, rotated.Prefix)
+		require.NotNil(t, rotated.PreviousExpiresAt)
+		require.Equal(t, now.Add(config.RotationOverlap), *rotated.PreviousExpiresAt)
+
+		for _, token := range []string{before, after} {
+			_, err := s.Verify(ctx, token)
+			require.NoError(t, err)
+		}
+
+		*now = now.Add(config.RotationOverlap)
+		_, err = s.Verify(ctx, before)
+		require.ErrorIs(t, err, core.ErrInvalidCredentials)
+		_, err = s.Verify(ctx, after)
+		require.NoError(t, err)
+
+		// A zero overlap invalidates the replaced key immediately.
+		var zero time.Duration
+		rotated, last, err := s.Rotate(ctx, key.ID, &zero)
+		require.NoError(t, err)
+		require.Nil(t, rotated.PreviousExpiresAt)
+
+		_, err = s.Verify(ctx, after)
+		require.ErrorIs(t, err, core.ErrInvalidCredentials)
+		_, err = s.Verify(ctx, last)
+		require.NoError(t, err)
+
+		tooLong := config.MaxRotationOverlap + time.Second
+		_, _, err = s.Rotate(ctx, key.ID, &tooLong)
+		require.ErrorIs(t, err, core.ErrInvalidArgument)
+
+		_, _, err = s.Rotate(ctx, uuid.New(), nil)
+		require.ErrorIs(t, err, core.ErrNotFound)
+	})
+
+	t.Run("flushes usage in batches", func(t *testing.T) {
+		s, q, now := newTestStore(t)
+
+		tokens := make(map[uuid.UUID]string)
+		for range 3 {
+			key, token, err := s.Create(ctx, core.CreateAPIKeyParams{Name: "ci", Owner: owner})
+			require.NoError(t, err)
+			tokens[key.ID] = token
+		}
+
+		n, err := s.FlushUsage(ctx)
+		require.NoError(t, err)
+		require.Zero(t, n)
+		require.Empty(t, q.flushes)
+
+		for _, token := range tokens {
+			_, err := s.Verify(ctx, token)
+			require.NoError(t, err)
+		}
+
+		// Failed writes are kept for the next flush, along with later uses.
+		q.failing = true
+		_, err = s.FlushUsage(ctx)
+		require.Error(t, err)
+
+		*now = now.Add(time.Minute)
+		for id, token := range tokens {
+			_, err := s.Verify(ctx, token)
+			require.NoError(t, err)
+			delete(tokens, id)
+			break
+		}
+
+		q.failing = false
+		n, err = s.FlushUsage(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 3, n)
+		require.Len(t, q.flushes, 1)
+
+		latest := 0
+		for _, at := range q.flushes[0].LastUsedAts {
+			if at.Equal(*now) {
+				latest++
+			}
+		}
+		require.Equal(t, 1, latest)
+
+		n, err = s.FlushUsage(ctx)
+		require.NoError(t, err)
+		require.Zero(t, n)
+	})
+}
+
+func TestStoreOwnerStatus(t *testing.T) {
+	ctx := context.Background()
+	pool := dbtest.Pool(t)
+	q := queries.New(pool)
+
+	s, err := NewStore(pool, Config{RotationOverlap: time.Hour, MaxRotationOverlap: 24 * time.Hour, UsageFlushInterval: time.Minute})
+	require.NoError(t, err)
+
+	// newKey creates a user and a key of the user.
+	newKey := func(t *testing.T) (queries.User, string) {
+		t.Helper()
+
+		suffix := uuid.NewString()[:8]
+
+		user, err := q.CreateUser(ctx, queries.CreateUserParams{
+			Email:    "apikey-" + suffix + "@example.com",
+			Username: "apikey-" + suffix,
+			Status:   queries.UserStatusActive,
+		})
+		require.NoError(t, err)
+
+		_, token, err := s.Create(ctx, core.CreateAPIKeyParams{
+			Name:  "ci",
+			Owner: core.APIKeyOwner{Type: core.APIKeyOwnerUser, ID: user.ID},
+		})
+		require.NoError(t, err)
+
+		_, err = s.Verify(ctx, token)
+		require.NoError(t, err)
+
+		return user, token
+	}
+
+	t.Run("suspended user", func(t *testing.T) {
+		user, token := newKey(t)
+
+		setStatus := func(status queries.UserStatus) {
+			_, err := q.UpdateUser(ctx, queries.UpdateUserParams{
+				ID:     user.ID,
+				Status: queries.NullUserStatus{UserStatus: status, Valid: true},
+			})
+			require.NoError(t, err)
+		}
+
+		setStatus(queries.UserStatusSuspended)
+		_, err := s.Verify(ctx, token)
+		require.ErrorIs(t, err, core.ErrInvalidCredentials)
+
+		// The key works again once the user is reactivated.
+		setStatus(queries.UserStatusActive)
+		_, err = s.Verify(ctx, token)
+		require.NoError(t, err)
+	})
+
+	t.Run("deleted user", func(t *testing.T) {
+		user, token := newKey(t)
+
+		n, err := q.SoftDeleteUser(ctx, user.ID)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, n)
+
+		_, err = s.Verify(ctx, token)
+		require.ErrorIs(t, err, core.ErrInvalidCredentials)
+	})
+}
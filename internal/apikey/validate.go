@@ -0,0 +1,53 @@
+package apikey
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/gophero/guardian/core"
+)
+
+const (
+	maxNameLength = 128
+	maxScopes     = 64
+)
+
+// Scopes are permissions, so they are validated like them.
+var scopeRe = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*(\.[a-z0-9][a-z0-9_-]*)*$`)
+
+func validateName(name string) error {
+	if strings.TrimSpace(name) == "" || utf8.RuneCountInString(name) > maxNameLength {
+		return fmt.Errorf("apikey: name must have 1 to %d characters: %w", maxNameLength, core.ErrInvalidArgument)
+	}
+	return nil
+}
+
+func validateScopes(scopes []string) error {
+	if len(scopes) > maxScopes {
+		return fmt.Errorf("apikey: keys cannot have more than %d scopes: %w", maxScopes, core.ErrInvalidArgument)
+	}
+
+	for _, s := range scopes {
+		if len(s) > 128 || !scopeRe.MatchString(s) {
+			return fmt.Errorf("apikey: invalid scope `%s`: %w", s, core.ErrInvalidArgument)
+		}
+	}
+	return nil
+}
+
+func validateOwner(owner core.APIKeyOwner) error {
+	switch owner.Type {
+	case core.APIKeyOwnerUser, core.APIKeyOwnerOrganization, core.APIKeyOwnerServiceAccount:
+	default:
+		return fmt.Errorf("apikey: invalid owner type `%s`: %w", owner.Type, core.ErrInvalidArgument)
+	}
+
+	if owner.ID == uuid.Nil {
+		return fmt.Errorf("apikey: owner id cannot be nil: %w", core.ErrInvalidArgument)
+	}
+	return nil
+}
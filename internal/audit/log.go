@@ -0,0 +1,61 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+// Log is a postgres backed [core.AuditLog].
+type Log struct {
+	q *queries.Queries
+}
+
+var _ core.AuditLog = (*Log)(nil)
+
+// NewLog constructs new [Log].
+func NewLog(pool *pgxpool.Pool) *Log {
+	return &Log{q: queries.New(pool)}
+}
+
+// Record implements [core.AuditLog].
+func (l *Log) Record(ctx context.Context, event core.AuditEvent) error {
+	metadata := event.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+
+	b, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("audit: marshal metadata: %w", err)
+	}
+
+	var userID *uuid.UUID
+	if event.UserID != uuid.Nil {
+		userID = &event.UserID
+	}
+
+	var ip *netip.Addr
+	if event.IPAddress.IsValid() {
+		ip = &event.IPAddress
+	}
+
+	if err := l.q.CreateAuditEvent(ctx, queries.CreateAuditEventParams{
+		Type:      string(event.Type),
+		UserID:    userID,
+		IpAddress: ip,
+		UserAgent: event.UserAgent,
+		Metadata:  b,
+	}); err != nil {
+		return fmt.Errorf("audit: create audit event: %w", err)
+	}
+
+	return nil
+}
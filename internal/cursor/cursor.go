@@ -0,0 +1,49 @@
+// Package cursor implements opaque cursors for keyset pagination over UUID keys.
+package cursor
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/gophero/guardian/core"
+)
+
+const (
+	DefaultLimit = 50
+	MaxLimit     = 500
+)
+
+// Encode encodes id as an opaque cursor.
+func Encode(id uuid.UUID) string {
+	return base64.RawURLEncoding.EncodeToString(id[:])
+}
+
+// Decode decodes a cursor created by [Encode]. An empty cursor decodes to nil.
+func Decode(c string) (*uuid.UUID, error) {
+	if c == "" {
+		return nil, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(c)
+	if err != nil {
+		return nil, fmt.Errorf("cursor: invalid cursor: %w", core.ErrInvalidArgument)
+	}
+
+	id, err := uuid.FromBytes(b)
+	if err != nil {
+		return nil, fmt.Errorf("cursor: invalid cursor: %w", core.ErrInvalidArgument)
+	}
+
+	return &id, nil
+}
+
+// Limit clamps limit to (0, [MaxLimit]] using [DefaultLimit] for non-positive values.
+func Limit(limit int) int32 {
+	if limit <= 0 {
+		return DefaultLimit
+	}
+
+	return int32(min(limit, MaxLimit))
+}
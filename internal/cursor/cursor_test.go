@@ -0,0 +1,44 @@
+package cursor
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+)
+
+func TestCursor(t *testing.T) {
+	t.Run("round-trip", func(t *testing.T) {
+		id := uuid.Must(uuid.NewV7())
+
+		got, err := Decode(Encode(id))
+
+		require.NoError(t, err)
+		require.Equal(t, id, *got)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		got, err := Decode("")
+
+		require.NoError(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, c := range []string{"!!", "AAAA"} {
+			_, err := Decode(c)
+
+			require.True(t, errors.Is(err, core.ErrInvalidArgument), "cursor %q should be invalid", c)
+		}
+	})
+}
+
+func TestLimit(t *testing.T) {
+	require.Equal(t, int32(DefaultLimit), Limit(0))
+	require.Equal(t, int32(DefaultLimit), Limit(-1))
+	require.Equal(t, int32(10), Limit(10))
+	require.Equal(t, int32(MaxLimit), Limit(MaxLimit+1))
+}
@@ -0,0 +1,115 @@
+// Package dbtest connects store tests to a postgres database.
+package dbtest
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4"
+	pgxmigrate "github.com/golang-migrate/migrate/v4/database/pgx/v5"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/internal/db"
+)
+
+// URIEnv is the environment variable holding the URI of the test database.
+const URIEnv = "GUARDIAN_TEST_POSTGRES_URI"
+
+// migrationFactory migrates with a database connection of the test.
+type migrationFactory struct{ db *sql.DB }
+
+func (f migrationFactory) NewMigrate(sourceDriver string, src source.Driver, table string) (*migrate.Migrate, error) {
+	driver, err := pgxmigrate.WithInstance(f.db, &pgxmigrate.Config{MigrationsTable: table})
+	if err != nil {
+		return nil, err
+	}
+
+	return migrate.NewWithInstance(sourceDriver, src, "pgx5", driver)
+}
+
+// Pool connects to the database of [URIEnv] and migrates it. The test is skipped if it is not set. The database
+// should be a throwaway one, tests leave their rows behind and must not rely on the absence of rows of other tests.
+func Pool(t testing.TB) *pgxpool.Pool {
+	t.Helper()
+
+	return connect(t, nil)
+}
+
+// RestrictedRole is the role connections of [RestrictedPool] switch to if the test connects as a role bypassing
+// row-level security, like the superuser of a throwaway database.
+const RestrictedRole = "guardian_restricted_test"
+
+// RestrictedPool is [Pool] with connections subject to row-level security, so tenant scoped tables only contain the
+// rows [db.BeginTenantFunc] and [db.BeginBypassFunc] let through.
+func RestrictedPool(t testing.TB) *pgxpool.Pool {
+	t.Helper()
+
+	pool := connect(t, nil)
+	ctx := context.Background()
+
+	var bypass bool
+	err := pool.QueryRow(ctx, "SELECT rolsuper OR rolbypassrls FROM pg_roles WHERE rolname = CURRENT_USER").Scan(&bypass)
+	require.NoError(t, err)
+
+	if !bypass {
+		return pool
+	}
+
+	_, err = pool.Exec(ctx, `DO $$ BEGIN
+		IF NOT EXISTS (SELECT FROM pg_roles WHERE rolname = '`+RestrictedRole+`') THEN
+			CREATE ROLE `+RestrictedRole+` NOLOGIN;
+		END IF;
+	END $$`)
+	require.NoError(t, err)
+
+	_, err = pool.Exec(ctx, "GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA public TO "+RestrictedRole)
+	require.NoError(t, err)
+
+	return connect(t, func(ctx context.Context, c *pgx.Conn) error {
+		_, err := c.Exec(ctx, "SET ROLE "+RestrictedRole)
+		return err
+	})
+}
+
+// connect migrates the database of [URIEnv] and connects to it, running afterConnect on new connections if it is not
+// nil.
+func connect(t testing.TB, afterConnect func(ctx context.Context, c *pgx.Conn) error) *pgxpool.Pool {
+	t.Helper()
+
+	uri := os.Getenv(URIEnv)
+	if uri == "" {
+		t.Skip(URIEnv + " is not set")
+	}
+
+	config, err := pgxpool.ParseConfig(uri)
+	require.NoError(t, err)
+
+	sqlDB := stdlib.OpenDB(*config.ConnConfig)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	require.NoError(t, db.RunMigrations(migrationFactory{db: sqlDB}))
+
+	config.AfterConnect = func(ctx context.Context, c *pgx.Conn) error {
+		types, err := c.LoadTypes(ctx, []string{"citext"})
+		if err != nil {
+			return err
+		}
+		c.TypeMap().RegisterTypes(types)
+
+		if afterConnect != nil {
+			return afterConnect(ctx, c)
+		}
+		return nil
+	}
+
+	pool, err := pgxpool.NewWithConfig(context.Background(), config)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	return pool
+}
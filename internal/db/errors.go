@@ -0,0 +1,26 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// IsUniqueViolation reports whether err is caused by a unique constraint violation.
+func IsUniqueViolation(err error) bool {
+	return hasCode(err, pgerrcode.UniqueViolation)
+}
+
+// IsForeignKeyViolation reports whether err is caused by a foreign key constraint violation.
+func IsForeignKeyViolation(err error) bool {
+	return hasCode(err, pgerrcode.ForeignKeyViolation)
+}
+
+func hasCode(err error, code string) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == code
+	}
+	return false
+}
@@ -3,3 +3,893 @@
 //   sqlc v1.30.0
 
 package queries
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type EmailVerificationPurpose string
+
+const (
+	EmailVerificationPurposeVerify EmailVerificationPurpose = "verify"
+	EmailVerificationPurposeChange EmailVerificationPurpose = "change"
+)
+
+func (e *EmailVerificationPurpose) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EmailVerificationPurpose(s)
+	case string:
+		*e = EmailVerificationPurpose(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EmailVerificationPurpose: %T", src)
+	}
+	return nil
+}
+
+type NullEmailVerificationPurpose struct {
+	EmailVerificationPurpose EmailVerificationPurpose `json:"email_verification_purpose"`
+	Valid                    bool                     `json:"valid"` // Valid is true if EmailVerificationPurpose is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEmailVerificationPurpose) Scan(value interface{}) error {
+	if value == nil {
+		ns.EmailVerificationPurpose, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EmailVerificationPurpose.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEmailVerificationPurpose) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EmailVerificationPurpose), nil
+}
+
+func (e EmailVerificationPurpose) Valid() bool {
+	switch e {
+	case EmailVerificationPurposeVerify,
+		EmailVerificationPurposeChange:
+		return true
+	}
+	return false
+}
+
+func AllEmailVerificationPurposeValues() []EmailVerificationPurpose {
+	return []EmailVerificationPurpose{
+		EmailVerificationPurposeVerify,
+		EmailVerificationPurposeChange,
+	}
+}
+
+type OauthClientType string
+
+const (
+	OauthClientTypePublic       OauthClientType = "public"
+	OauthClientTypeConfidential OauthClientType = "confidential"
+)
+
+func (e *OauthClientType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OauthClientType(s)
+	case string:
+		*e = OauthClientType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OauthClientType: %T", src)
+	}
+	return nil
+}
+
+type NullOauthClientType struct {
+	OauthClientType OauthClientType `json:"oauth_client_type"`
+	Valid           bool            `json:"valid"` // Valid is true if OauthClientType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOauthClientType) Scan(value interface{}) error {
+	if value == nil {
+		ns.OauthClientType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OauthClientType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOauthClientType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OauthClientType), nil
+}
+
+func (e OauthClientType) Valid() bool {
+	switch e {
+	case OauthClientTypePublic,
+		OauthClientTypeConfidential:
+		return true
+	}
+	return false
+}
+
+func AllOauthClientTypeValues() []OauthClientType {
+	return []OauthClientType{
+		OauthClientTypePublic,
+		OauthClientTypeConfidential,
+	}
+}
+
+type OauthDeviceAuthorizationStatus string
+
+const (
+	OauthDeviceAuthorizationStatusPending  OauthDeviceAuthorizationStatus = "pending"
+	OauthDeviceAuthorizationStatusApproved OauthDeviceAuthorizationStatus = "approved"
+	OauthDeviceAuthorizationStatusDenied   OauthDeviceAuthorizationStatus = "denied"
+)
+
+func (e *OauthDeviceAuthorizationStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OauthDeviceAuthorizationStatus(s)
+	case string:
+		*e = OauthDeviceAuthorizationStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OauthDeviceAuthorizationStatus: %T", src)
+	}
+	return nil
+}
+
+type NullOauthDeviceAuthorizationStatus struct {
+	OauthDeviceAuthorizationStatus OauthDeviceAuthorizationStatus `json:"oauth_device_authorization_status"`
+	Valid                          bool                           `json:"valid"` // Valid is true if OauthDeviceAuthorizationStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOauthDeviceAuthorizationStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.OauthDeviceAuthorizationStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OauthDeviceAuthorizationStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOauthDeviceAuthorizationStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OauthDeviceAuthorizationStatus), nil
+}
+
+func (e OauthDeviceAuthorizationStatus) Valid() bool {
+	switch e {
+	case OauthDeviceAuthorizationStatusPending,
+		OauthDeviceAuthorizationStatusApproved,
+		OauthDeviceAuthorizationStatusDenied:
+		return true
+	}
+	return false
+}
+
+func AllOauthDeviceAuthorizationStatusValues() []OauthDeviceAuthorizationStatus {
+	return []OauthDeviceAuthorizationStatus{
+		OauthDeviceAuthorizationStatusPending,
+		OauthDeviceAuthorizationStatusApproved,
+		OauthDeviceAuthorizationStatusDenied,
+	}
+}
+
+type OrganizationRole string
+
+const (
+	OrganizationRoleOwner  OrganizationRole = "owner"
+	OrganizationRoleAdmin  OrganizationRole = "admin"
+	OrganizationRoleMember OrganizationRole = "member"
+)
+
+func (e *OrganizationRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrganizationRole(s)
+	case string:
+		*e = OrganizationRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrganizationRole: %T", src)
+	}
+	return nil
+}
+
+type NullOrganizationRole struct {
+	OrganizationRole OrganizationRole `json:"organization_role"`
+	Valid            bool             `json:"valid"` // Valid is true if OrganizationRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOrganizationRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrganizationRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrganizationRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrganizationRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrganizationRole), nil
+}
+
+func (e OrganizationRole) Valid() bool {
+	switch e {
+	case OrganizationRoleOwner,
+		OrganizationRoleAdmin,
+		OrganizationRoleMember:
+		return true
+	}
+	return false
+}
+
+func AllOrganizationRoleValues() []OrganizationRole {
+	return []OrganizationRole{
+		OrganizationRoleOwner,
+		OrganizationRoleAdmin,
+		OrganizationRoleMember,
+	}
+}
+
+type PasswordlessMethod string
+
+const (
+	PasswordlessMethodMagicLink PasswordlessMethod = "magic_link"
+	PasswordlessMethodEmailOtp  PasswordlessMethod = "email_otp"
+)
+
+func (e *PasswordlessMethod) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PasswordlessMethod(s)
+	case string:
+		*e = PasswordlessMethod(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PasswordlessMethod: %T", src)
+	}
+	return nil
+}
+
+type NullPasswordlessMethod struct {
+	PasswordlessMethod PasswordlessMethod `json:"passwordless_method"`
+	Valid              bool               `json:"valid"` // Valid is true if PasswordlessMethod is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPasswordlessMethod) Scan(value interface{}) error {
+	if value == nil {
+		ns.PasswordlessMethod, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PasswordlessMethod.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPasswordlessMethod) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PasswordlessMethod), nil
+}
+
+func (e PasswordlessMethod) Valid() bool {
+	switch e {
+	case PasswordlessMethodMagicLink,
+		PasswordlessMethodEmailOtp:
+		return true
+	}
+	return false
+}
+
+func AllPasswordlessMethodValues() []PasswordlessMethod {
+	return []PasswordlessMethod{
+		PasswordlessMethodMagicLink,
+		PasswordlessMethodEmailOtp,
+	}
+}
+
+type SigningKeyAlgorithm string
+
+const (
+	SigningKeyAlgorithmEdDSA SigningKeyAlgorithm = "EdDSA"
+	SigningKeyAlgorithmES256 SigningKeyAlgorithm = "ES256"
+	SigningKeyAlgorithmRS256 SigningKeyAlgorithm = "RS256"
+)
+
+func (e *SigningKeyAlgorithm) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SigningKeyAlgorithm(s)
+	case string:
+		*e = SigningKeyAlgorithm(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SigningKeyAlgorithm: %T", src)
+	}
+	return nil
+}
+
+type NullSigningKeyAlgorithm struct {
+	SigningKeyAlgorithm SigningKeyAlgorithm `json:"signing_key_algorithm"`
+	Valid               bool                `json:"valid"` // Valid is true if SigningKeyAlgorithm is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSigningKeyAlgorithm) Scan(value interface{}) error {
+	if value == nil {
+		ns.SigningKeyAlgorithm, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SigningKeyAlgorithm.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSigningKeyAlgorithm) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SigningKeyAlgorithm), nil
+}
+
+func (e SigningKeyAlgorithm) Valid() bool {
+	switch e {
+	case SigningKeyAlgorithmEdDSA,
+		SigningKeyAlgorithmES256,
+		SigningKeyAlgorithmRS256:
+		return true
+	}
+	return false
+}
+
+func AllSigningKeyAlgorithmValues() []SigningKeyAlgorithm {
+	return []SigningKeyAlgorithm{
+		SigningKeyAlgorithmEdDSA,
+		SigningKeyAlgorithmES256,
+		SigningKeyAlgorithmRS256,
+	}
+}
+
+type UserStatus string
+
+const (
+	UserStatusActive    UserStatus = "active"
+	UserStatusSuspended UserStatus = "suspended"
+	UserStatusDisabled  UserStatus = "disabled"
+)
+
+func (e *UserStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UserStatus(s)
+	case string:
+		*e = UserStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for UserStatus: %T", src)
+	}
+	return nil
+}
+
+type NullUserStatus struct {
+	UserStatus UserStatus `json:"user_status"`
+	Valid      bool       `json:"valid"` // Valid is true if UserStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullUserStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.UserStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.UserStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullUserStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.UserStatus), nil
+}
+
+func (e UserStatus) Valid() bool {
+	switch e {
+	case UserStatusActive,
+		UserStatusSuspended,
+		UserStatusDisabled:
+		return true
+	}
+	return false
+}
+
+func AllUserStatusValues() []UserStatus {
+	return []UserStatus{
+		UserStatusActive,
+		UserStatusSuspended,
+		UserStatusDisabled,
+	}
+}
+
+type WebauthnCeremony string
+
+const (
+	WebauthnCeremonyRegistration WebauthnCeremony = "registration"
+	WebauthnCeremonyLogin        WebauthnCeremony = "login"
+)
+
+func (e *WebauthnCeremony) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WebauthnCeremony(s)
+	case string:
+		*e = WebauthnCeremony(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WebauthnCeremony: %T", src)
+	}
+	return nil
+}
+
+type NullWebauthnCeremony struct {
+	WebauthnCeremony WebauthnCeremony `json:"webauthn_ceremony"`
+	Valid            bool             `json:"valid"` // Valid is true if WebauthnCeremony is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWebauthnCeremony) Scan(value interface{}) error {
+	if value == nil {
+		ns.WebauthnCeremony, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WebauthnCeremony.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWebauthnCeremony) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WebauthnCeremony), nil
+}
+
+func (e WebauthnCeremony) Valid() bool {
+	switch e {
+	case WebauthnCeremonyRegistration,
+		WebauthnCeremonyLogin:
+		return true
+	}
+	return false
+}
+
+func AllWebauthnCeremonyValues() []WebauthnCeremony {
+	return []WebauthnCeremony{
+		WebauthnCeremonyRegistration,
+		WebauthnCeremonyLogin,
+	}
+}
+
+type ApiKey struct {
+	ID                 uuid.UUID
+	Name               string
+	Prefix             string
+	SecretHash         []byte
+	PreviousSecretHash []byte
+	PreviousExpiresAt  *time.Time
+	UserID             *uuid.UUID
+	OrgID              *uuid.UUID
+	ServiceAccountID   *uuid.UUID
+	Scopes             []string
+	CreatedAt          time.Time
+	ExpiresAt          *time.Time
+	LastUsedAt         *time.Time
+	RotatedAt          *time.Time
+}
+
+type AuditEvent struct {
+	ID        uuid.UUID
+	Type      string
+	UserID    *uuid.UUID
+	IpAddress *netip.Addr
+	UserAgent string
+	Metadata  []byte
+	CreatedAt time.Time
+}
+
+type AuthzDecision struct {
+	ID         uuid.UUID
+	Type       string
+	Subject    string
+	Action     string
+	Resource   string
+	Allowed    bool
+	Policy     string
+	Conditions []string
+	TraceID    string
+	CreatedAt  time.Time
+}
+
+type Condition struct {
+	Name        string
+	Expression  string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type EmailVerification struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	Purpose     EmailVerificationPurpose
+	Email       string
+	TokenHash   []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	CompletedAt *time.Time
+}
+
+type MfaChallenge struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	TokenHash   []byte
+	Device      string
+	Attempts    int32
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	CompletedAt *time.Time
+}
+
+type OauthAuthorizationCode struct {
+	ID                   uuid.UUID
+	CodeHash             []byte
+	ClientID             uuid.UUID
+	UserID               uuid.UUID
+	SessionID            *uuid.UUID
+	RedirectUri          string
+	Scope                []string
+	CodeChallenge        string
+	RefreshTokenFamilyID *uuid.UUID
+	CreatedAt            time.Time
+	ExpiresAt            time.Time
+	UsedAt               *time.Time
+	Nonce                string
+}
+
+type OauthAuthorizationRequest struct {
+	ID            uuid.UUID
+	ClientID      uuid.UUID
+	RedirectUri   string
+	Scope         []string
+	State         string
+	CodeChallenge string
+	CreatedAt     time.Time
+	ExpiresAt     time.Time
+	Nonce         string
+	Prompt        []string
+	MaxAge        pgtype.Int4
+}
+
+type OauthClient struct {
+	ID           uuid.UUID
+	Name         string
+	Type         OauthClientType
+	SecretHash   []byte
+	RedirectUris []string
+	GrantTypes   []string
+	Scopes       []string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	Audiences    []string
+}
+
+type OauthDeviceAuthorization struct {
+	ID             uuid.UUID
+	DeviceCodeHash []byte
+	UserCode       string
+	ClientID       uuid.UUID
+	Scope          []string
+	Status         OauthDeviceAuthorizationStatus
+	UserID         *uuid.UUID
+	SessionID      *uuid.UUID
+	PollInterval   int32
+	PolledAt       *time.Time
+	CreatedAt      time.Time
+	ExpiresAt      time.Time
+}
+
+type OauthRevokedAccessToken struct {
+	TokenID   string
+	ExpiresAt time.Time
+	RevokedAt time.Time
+}
+
+type Organization struct {
+	ID        uuid.UUID
+	Slug      string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type OrganizationInvitation struct {
+	ID        uuid.UUID
+	OrgID     uuid.UUID
+	Email     string
+	Role      OrganizationRole
+	InviterID *uuid.UUID
+	TokenHash []byte
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+type OrganizationMembership struct {
+	OrgID     uuid.UUID
+	UserID    uuid.UUID
+	Role      OrganizationRole
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type Passkey struct {
+	ID                uuid.UUID
+	UserID            uuid.UUID
+	CredentialID      []byte
+	PublicKey         []byte
+	AttestationFormat string
+	Aaguid            uuid.UUID
+	SignCount         int64
+	Transports        []string
+	UserVerified      bool
+	BackupEligible    bool
+	BackupState       bool
+	Name              string
+	CreatedAt         time.Time
+	LastUsedAt        *time.Time
+}
+
+type PasswordCredential struct {
+	UserID    uuid.UUID
+	Hash      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type PasswordHistory struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Hash      string
+	CreatedAt time.Time
+}
+
+type PasswordReset struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	TokenHash []byte
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+}
+
+type PasswordlessChallenge struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	Method      PasswordlessMethod
+	TokenHash   []byte
+	CodeHash    []byte
+	Device      string
+	Attempts    int32
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	CompletedAt *time.Time
+}
+
+type Permission struct {
+	Name        string
+	Description string
+	CreatedAt   time.Time
+}
+
+type RecoveryCode struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	CodeHash  []byte
+	CreatedAt time.Time
+	UsedAt    *time.Time
+}
+
+type RefreshToken struct {
+	ID        uuid.UUID
+	FamilyID  uuid.UUID
+	ParentID  *uuid.UUID
+	TokenHash []byte
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+}
+
+type RefreshTokenFamily struct {
+	ID            uuid.UUID
+	UserID        uuid.UUID
+	SessionID     *uuid.UUID
+	ClientID      string
+	Scope         []string
+	CreatedAt     time.Time
+	ExpiresAt     time.Time
+	RevokedAt     *time.Time
+	RevokedReason string
+}
+
+type RelationRevision struct {
+	ID       bool
+	Revision int64
+}
+
+type RelationSchema struct {
+	Revision   int64
+	Definition string
+	CreatedAt  time.Time
+}
+
+type RelationTuple struct {
+	ID               uuid.UUID
+	Namespace        string
+	ObjectID         string
+	Relation         string
+	SubjectNamespace string
+	SubjectID        string
+	SubjectRelation  string
+	CreatedRevision  int64
+	Condition        pgtype.Text
+}
+
+type Role struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type RoleAssignment struct {
+	ID               uuid.UUID
+	UserID           *uuid.UUID
+	RoleID           uuid.UUID
+	OrgID            *uuid.UUID
+	CreatedAt        time.Time
+	Condition        pgtype.Text
+	ServiceAccountID *uuid.UUID
+}
+
+type RoleParent struct {
+	RoleID   uuid.UUID
+	ParentID uuid.UUID
+}
+
+type RolePermission struct {
+	RoleID     uuid.UUID
+	Permission string
+}
+
+type ServiceAccount struct {
+	ID                 uuid.UUID
+	Name               string
+	Description        string
+	Scopes             []string
+	SecretHash         []byte
+	PreviousSecretHash []byte
+	PreviousExpiresAt  *time.Time
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	RotatedAt          *time.Time
+}
+
+type ServiceAccountAssertion struct {
+	ServiceAccountID uuid.UUID
+	Jti              string
+	ExpiresAt        time.Time
+}
+
+type ServiceAccountKey struct {
+	ID               uuid.UUID
+	ServiceAccountID uuid.UUID
+	KeyID            string
+	Algorithm        string
+	PublicKey        []byte
+	CreatedAt        time.Time
+}
+
+type Session struct {
+	ID            uuid.UUID
+	UserID        uuid.UUID
+	TokenHash     []byte
+	UserAgent     string
+	IpAddress     *netip.Addr
+	Device        string
+	CreatedAt     time.Time
+	LastSeenAt    time.Time
+	IdleExpiresAt time.Time
+	ExpiresAt     time.Time
+	RevokedAt     *time.Time
+	RevokedReason string
+	AuthMethods   []string
+}
+
+type SigningKey struct {
+	ID          string
+	Algorithm   SigningKeyAlgorithm
+	PrivateKey  []byte
+	PublicKey   []byte
+	CreatedAt   time.Time
+	ActivatesAt time.Time
+	ExpiresAt   *time.Time
+}
+
+type TotpFactor struct {
+	UserID       uuid.UUID
+	Secret       []byte
+	ConfirmedAt  *time.Time
+	LastUsedStep int64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type User struct {
+	ID              uuid.UUID
+	Email           string
+	Username        string
+	Status          UserStatus
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       *time.Time
+	EmailVerifiedAt *time.Time
+}
+
+type UserProfile struct {
+	UserID        uuid.UUID
+	Name          string
+	GivenName     string
+	FamilyName    string
+	Nickname      string
+	Picture       string
+	Website       string
+	Birthdate     string
+	Zoneinfo      string
+	Locale        string
+	PhoneNumber   string
+	StreetAddress string
+	Locality      string
+	Region        string
+	PostalCode    string
+	Country       string
+	UpdatedAt     time.Time
+}
+
+type WebauthnChallenge struct {
+	ID          uuid.UUID
+	UserID      *uuid.UUID
+	Ceremony    WebauthnCeremony
+	SessionData []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
@@ -6,10 +6,285 @@ package queries
 
 import (
 	"context"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 type Querier interface {
-	Example(ctx context.Context) (int32, error)
+	AddRoleParent(ctx context.Context, arg AddRoleParentParams) error
+	// Answers the pending authorization of the user code. No row is returned if it was answered already or expired.
+	AnswerOAuthDeviceAuthorization(ctx context.Context, arg AnswerOAuthDeviceAuthorizationParams) (OauthDeviceAuthorization, error)
+	// Counts an attempt to answer the challenge. Returns no rows if the challenge is not pending or ran out of attempts,
+	// so concurrent attempts cannot exceed the limit.
+	AttemptMFAChallenge(ctx context.Context, arg AttemptMFAChallengeParams) (MfaChallenge, error)
+	// Counts an attempt to complete the challenge. Returns no rows if the challenge is not pending or ran out of attempts,
+	// so concurrent attempts cannot exceed the limit.
+	AttemptPasswordlessChallenge(ctx context.Context, arg AttemptPasswordlessChallengeParams) (PasswordlessChallenge, error)
+	// Sets a new email which was verified by the user.
+	ChangeUserEmail(ctx context.Context, arg ChangeUserEmailParams) (User, error)
+	// Completes and returns the pending verification, so that each token can be used only once.
+	CompleteEmailVerification(ctx context.Context, tokenHash []byte) (EmailVerification, error)
+	// Completes the challenge. Returns no rows if it was completed concurrently or counted more attempts than allowed.
+	CompleteMFAChallenge(ctx context.Context, arg CompleteMFAChallengeParams) (int64, error)
+	// Completes the challenge. Returns no rows if it was completed concurrently.
+	CompletePasswordlessChallenge(ctx context.Context, id uuid.UUID) (int64, error)
+	ConfirmTOTPFactor(ctx context.Context, arg ConfirmTOTPFactorParams) (int64, error)
+	// Deletes and returns the invitation of the token hash if it is for the email and did not expire, so each invitation
+	// is answered only once.
+	ConsumeOrganizationInvitation(ctx context.Context, arg ConsumeOrganizationInvitationParams) (OrganizationInvitation, error)
+	// Deletes and returns an unexpired challenge, so that each challenge can be answered only once.
+	ConsumeWebAuthnChallenge(ctx context.Context, arg ConsumeWebAuthnChallengeParams) (WebauthnChallenge, error)
+	CountActiveSessions(ctx context.Context) (int64, error)
+	CountEmailVerificationsSince(ctx context.Context, arg CountEmailVerificationsSinceParams) (int64, error)
+	CountOrganizationOwners(ctx context.Context, orgID uuid.UUID) (int64, error)
+	CountPasswordResetsSince(ctx context.Context, arg CountPasswordResetsSinceParams) (int64, error)
+	CountPasswordlessChallengesSince(ctx context.Context, arg CountPasswordlessChallengesSinceParams) (int64, error)
+	CountUnusedRecoveryCodes(ctx context.Context, userID uuid.UUID) (int64, error)
+	CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (ApiKey, error)
+	CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) error
+	CreateAuthzDecision(ctx context.Context, arg CreateAuthzDecisionParams) error
+	CreateCondition(ctx context.Context, arg CreateConditionParams) (Condition, error)
+	CreateEmailVerification(ctx context.Context, arg CreateEmailVerificationParams) (EmailVerification, error)
+	CreateMFAChallenge(ctx context.Context, arg CreateMFAChallengeParams) (MfaChallenge, error)
+	CreateOAuthAuthorizationCode(ctx context.Context, arg CreateOAuthAuthorizationCodeParams) (OauthAuthorizationCode, error)
+	CreateOAuthAuthorizationRequest(ctx context.Context, arg CreateOAuthAuthorizationRequestParams) (OauthAuthorizationRequest, error)
+	CreateOAuthClient(ctx context.Context, arg CreateOAuthClientParams) (OauthClient, error)
+	CreateOAuthDeviceAuthorization(ctx context.Context, arg CreateOAuthDeviceAuthorizationParams) (OauthDeviceAuthorization, error)
+	CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (Organization, error)
+	CreateOrganizationMembership(ctx context.Context, arg CreateOrganizationMembershipParams) (OrganizationMembership, error)
+	CreatePasskey(ctx context.Context, arg CreatePasskeyParams) (Passkey, error)
+	CreatePasswordReset(ctx context.Context, arg CreatePasswordResetParams) (PasswordReset, error)
+	CreatePasswordlessChallenge(ctx context.Context, arg CreatePasswordlessChallengeParams) (PasswordlessChallenge, error)
+	CreatePermission(ctx context.Context, arg CreatePermissionParams) (Permission, error)
+	CreateRecoveryCodes(ctx context.Context, arg CreateRecoveryCodesParams) error
+	CreateRefreshToken(ctx context.Context, arg CreateRefreshTokenParams) (RefreshToken, error)
+	CreateRefreshTokenFamily(ctx context.Context, arg CreateRefreshTokenFamilyParams) (RefreshTokenFamily, error)
+	CreateRelationSchema(ctx context.Context, arg CreateRelationSchemaParams) error
+	// Creates the tuple or replaces the condition of an existing tuple, which counts as writing it again.
+	CreateRelationTuple(ctx context.Context, arg CreateRelationTupleParams) error
+	CreateRole(ctx context.Context, arg CreateRoleParams) (Role, error)
+	// Assigns the role to the subject, which is the id of a user or a service account. Nothing is inserted if neither
+	// exists.
+	CreateRoleAssignment(ctx context.Context, arg CreateRoleAssignmentParams) (RoleAssignment, error)
+	CreateServiceAccount(ctx context.Context, arg CreateServiceAccountParams) (ServiceAccount, error)
+	// Records the identifier of an accepted client assertion. No row is inserted if it was used before.
+	CreateServiceAccountAssertion(ctx context.Context, arg CreateServiceAccountAssertionParams) (int64, error)
+	CreateServiceAccountKey(ctx context.Context, arg CreateServiceAccountKeyParams) (ServiceAccountKey, error)
+	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
+	CreateSigningKey(ctx context.Context, arg CreateSigningKeyParams) error
+	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateWebAuthnChallenge(ctx context.Context, arg CreateWebAuthnChallengeParams) (WebauthnChallenge, error)
+	DeleteAPIKey(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteCondition(ctx context.Context, name string) (int64, error)
+	// Deletes keys which expired before the given time.
+	DeleteExpiredAPIKeys(ctx context.Context, before time.Time) (int64, error)
+	// Deletes decisions recorded before the given time.
+	DeleteExpiredAuthzDecisions(ctx context.Context, before time.Time) (int64, error)
+	// Deletes verifications which expired before the given time.
+	DeleteExpiredEmailVerifications(ctx context.Context, before time.Time) (int64, error)
+	// Deletes challenges which expired before the given time.
+	DeleteExpiredMFAChallenges(ctx context.Context, before time.Time) (int64, error)
+	// Deletes codes which expired before the given time, used or not.
+	DeleteExpiredOAuthAuthorizationCodes(ctx context.Context, before time.Time) (int64, error)
+	// Deletes requests which expired before the given time.
+	DeleteExpiredOAuthAuthorizationRequests(ctx context.Context, before time.Time) (int64, error)
+	// Deletes authorizations which expired before the given time, answered or not.
+	DeleteExpiredOAuthDeviceAuthorizations(ctx context.Context, before time.Time) (int64, error)
+	// Deletes revoked token identifiers which expired before the given time, as their tokens are rejected anyway.
+	DeleteExpiredOAuthRevokedAccessTokens(ctx context.Context, before time.Time) (int64, error)
+	// Deletes invitations which expired before the given time.
+	DeleteExpiredOrganizationInvitations(ctx context.Context, before time.Time) (int64, error)
+	// Deletes resets which expired before the given time.
+	DeleteExpiredPasswordResets(ctx context.Context, before time.Time) (int64, error)
+	// Deletes challenges which expired before the given time.
+	DeleteExpiredPasswordlessChallenges(ctx context.Context, before time.Time) (int64, error)
+	// Deletes token families which expired or were revoked before the given time along with their tokens.
+	DeleteExpiredRefreshTokenFamilies(ctx context.Context, before time.Time) (int64, error)
+	// Deletes assertion identifiers which expired before the given time, as their assertions are rejected anyway.
+	DeleteExpiredServiceAccountAssertions(ctx context.Context, before time.Time) (int64, error)
+	// Deletes sessions which expired or were revoked before the given time.
+	DeleteExpiredSessions(ctx context.Context, before time.Time) (int64, error)
+	DeleteExpiredSigningKeys(ctx context.Context, before *time.Time) (int64, error)
+	// Deletes challenges which expired before the given time.
+	DeleteExpiredWebAuthnChallenges(ctx context.Context, before time.Time) (int64, error)
+	// Deletes the request and returns it, so a request is answered at most once.
+	DeleteOAuthAuthorizationRequest(ctx context.Context, id uuid.UUID) (OauthAuthorizationRequest, error)
+	DeleteOAuthClient(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteOAuthDeviceAuthorization(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteOrganization(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteOrganizationInvitation(ctx context.Context, arg DeleteOrganizationInvitationParams) (int64, error)
+	DeleteOrganizationMembership(ctx context.Context, arg DeleteOrganizationMembershipParams) (int64, error)
+	DeletePasskey(ctx context.Context, arg DeletePasskeyParams) (int64, error)
+	DeletePasswordCredential(ctx context.Context, userID uuid.UUID) (int64, error)
+	// Deletes verifications of the user which were not completed, so only the latest one can be completed.
+	DeletePendingEmailVerifications(ctx context.Context, arg DeletePendingEmailVerificationsParams) (int64, error)
+	// Deletes resets of the user which were not used, so only the latest one can be used.
+	DeletePendingPasswordResets(ctx context.Context, userID uuid.UUID) (int64, error)
+	// Deletes challenges of the user which were not completed, so only the latest one can be completed.
+	DeletePendingPasswordlessChallenges(ctx context.Context, arg DeletePendingPasswordlessChallengesParams) (int64, error)
+	DeletePermission(ctx context.Context, name string) (int64, error)
+	DeleteRecoveryCodes(ctx context.Context, userID uuid.UUID) (int64, error)
+	DeleteRelationTuple(ctx context.Context, arg DeleteRelationTupleParams) (int64, error)
+	DeleteRole(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteRoleAssignment(ctx context.Context, arg DeleteRoleAssignmentParams) (int64, error)
+	DeleteServiceAccount(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteServiceAccountKey(ctx context.Context, arg DeleteServiceAccountKeyParams) (int64, error)
+	DeleteTOTPFactor(ctx context.Context, userID uuid.UUID) (int64, error)
+	// Deletes enrollments which were not confirmed before the given time.
+	DeleteUnconfirmedTOTPFactors(ctx context.Context, before time.Time) (int64, error)
+	// Schedules expiry of all keys except the one with `except_id` which do not expire already.
+	ExpireSigningKeys(ctx context.Context, arg ExpireSigningKeysParams) error
+	// Lists the roles reached from each assignment of the subject which applies in the organization, along with the path of
+	// role ids leading to them and whether they grant the permission directly. Without organization only global
+	// assignments apply. Assignments are ordered like the grants of ListEffectivePermissions, and each path follows the
+	// path of its parent.
+	ExplainPermission(ctx context.Context, arg ExplainPermissionParams) ([]ExplainPermissionRow, error)
+	GetAPIKeyByID(ctx context.Context, id uuid.UUID) (ApiKey, error)
+	// Returns the key of the prefix, including expired ones. Keys of users who are deleted or not active are not returned,
+	// so they stop working while the user is suspended.
+	GetAPIKeyByPrefix(ctx context.Context, prefix string) (ApiKey, error)
+	GetActiveSessionByTokenHash(ctx context.Context, tokenHash []byte) (Session, error)
+	GetCondition(ctx context.Context, name string) (Condition, error)
+	GetLatestRelationSchema(ctx context.Context) (RelationSchema, error)
+	GetLatestRelationSchemaRevision(ctx context.Context) (int64, error)
+	GetLatestSigningKey(ctx context.Context) (SigningKey, error)
+	GetOAuthAuthorizationCodeByHash(ctx context.Context, codeHash []byte) (OauthAuthorizationCode, error)
+	GetOAuthAuthorizationRequest(ctx context.Context, id uuid.UUID) (OauthAuthorizationRequest, error)
+	GetOAuthClientByID(ctx context.Context, id uuid.UUID) (OauthClient, error)
+	GetOAuthDeviceAuthorizationByDeviceCodeHash(ctx context.Context, deviceCodeHash []byte) (OauthDeviceAuthorization, error)
+	GetOAuthDeviceAuthorizationByUserCode(ctx context.Context, userCode string) (OauthDeviceAuthorization, error)
+	GetOrganizationByID(ctx context.Context, id uuid.UUID) (Organization, error)
+	GetOrganizationBySlug(ctx context.Context, slug string) (Organization, error)
+	// Returns the invitation of the token hash, including expired ones.
+	GetOrganizationInvitationByTokenHash(ctx context.Context, tokenHash []byte) (OrganizationInvitation, error)
+	GetOrganizationMembership(ctx context.Context, arg GetOrganizationMembershipParams) (OrganizationMembership, error)
+	GetPasskeyByCredentialID(ctx context.Context, credentialID []byte) (Passkey, error)
+	GetPasswordCredential(ctx context.Context, userID uuid.UUID) (PasswordCredential, error)
+	// Returns the reset of the token hash, including used and expired ones.
+	GetPasswordResetByTokenHash(ctx context.Context, tokenHash []byte) (PasswordReset, error)
+	GetRefreshTokenByHash(ctx context.Context, tokenHash []byte) (GetRefreshTokenByHashRow, error)
+	GetRefreshTokenFamily(ctx context.Context, id uuid.UUID) (RefreshTokenFamily, error)
+	GetRelationRevision(ctx context.Context) (int64, error)
+	// Returns the condition of the tuple and its expression, which are NULL for unconditional tuples.
+	GetRelationTupleCondition(ctx context.Context, arg GetRelationTupleConditionParams) (GetRelationTupleConditionRow, error)
+	GetRoleByID(ctx context.Context, id uuid.UUID) (Role, error)
+	GetServiceAccountByID(ctx context.Context, id uuid.UUID) (ServiceAccount, error)
+	GetServiceAccountKey(ctx context.Context, arg GetServiceAccountKeyParams) (ServiceAccountKey, error)
+	GetSessionByID(ctx context.Context, id uuid.UUID) (Session, error)
+	GetTOTPFactor(ctx context.Context, userID uuid.UUID) (TotpFactor, error)
+	GetUserByEmail(ctx context.Context, email string) (User, error)
+	GetUserByID(ctx context.Context, id uuid.UUID) (User, error)
+	GetUserByUsername(ctx context.Context, username string) (User, error)
+	GetUserProfile(ctx context.Context, userID uuid.UUID) (UserProfile, error)
+	GrantRolePermission(ctx context.Context, arg GrantRolePermissionParams) error
+	// Increments the revision and locks it until the transaction ends, which serializes writes.
+	IncrementRelationRevision(ctx context.Context) (int64, error)
+	InsertPasswordHistory(ctx context.Context, arg InsertPasswordHistoryParams) error
+	IsOAuthAccessTokenRevoked(ctx context.Context, tokenID string) (bool, error)
+	// Reports whether the role inherits from the ancestor, directly or through other roles.
+	IsRoleAncestor(ctx context.Context, arg IsRoleAncestorParams) (bool, error)
+	// Lists the keys of the owner, of which exactly one column is set, ordered by id.
+	ListAPIKeysByOwner(ctx context.Context, arg ListAPIKeysByOwnerParams) ([]ApiKey, error)
+	ListActiveSessionsByUser(ctx context.Context, userID uuid.UUID) ([]Session, error)
+	ListConditions(ctx context.Context) ([]Condition, error)
+	// Lists the permissions the subject has in the organization through its global and organization assignments and all
+	// roles they inherit from, along with the assignment granting them and its condition. Without organization only global
+	// assignments count. UNION stops at roles already visited through the same assignment. Unconditional grants of a
+	// permission come first.
+	ListEffectivePermissions(ctx context.Context, arg ListEffectivePermissionsParams) ([]ListEffectivePermissionsRow, error)
+	ListOAuthClients(ctx context.Context) ([]OauthClient, error)
+	// Lists the invitations to the organization which did not expire before the given time, ordered by id.
+	ListOrganizationInvitations(ctx context.Context, arg ListOrganizationInvitationsParams) ([]OrganizationInvitation, error)
+	// Lists the memberships of the organization ordered by user id.
+	ListOrganizationMemberships(ctx context.Context, arg ListOrganizationMembershipsParams) ([]OrganizationMembership, error)
+	// Lists the organizations the user is a member of, ordered by id.
+	ListOrganizationsByUser(ctx context.Context, userID uuid.UUID) ([]Organization, error)
+	ListPasskeysByUserID(ctx context.Context, userID uuid.UUID) ([]Passkey, error)
+	ListPasswordHistory(ctx context.Context, arg ListPasswordHistoryParams) ([]string, error)
+	ListPermissions(ctx context.Context) ([]Permission, error)
+	// Lists the ids of all objects of the namespace which appear in tuples, as only those can have relations.
+	ListRelationObjectIDs(ctx context.Context, arg ListRelationObjectIDsParams) ([]string, error)
+	// Lists the ids of all objects of the namespace which are subjects of tuples, as only those can have relations.
+	ListRelationSubjectIDs(ctx context.Context, arg ListRelationSubjectIDsParams) ([]string, error)
+	// Lists the subjects related to the object with the conditions of their tuples. With only_usersets, subjects which are
+	// objects are skipped.
+	ListRelationTupleSubjects(ctx context.Context, arg ListRelationTupleSubjectsParams) ([]ListRelationTupleSubjectsRow, error)
+	// Lists the distinct relations and subject types in use, to validate them against a new schema.
+	ListRelationTupleTypes(ctx context.Context) ([]ListRelationTupleTypesRow, error)
+	ListRelationTuples(ctx context.Context, arg ListRelationTuplesParams) ([]RelationTuple, error)
+	// Lists the assignments of the subject, which is the id of a user or a service account.
+	ListRoleAssignmentsBySubject(ctx context.Context, subject uuid.UUID) ([]RoleAssignment, error)
+	// Lists the direct parents of roles, ordered by role and parent.
+	ListRoleParents(ctx context.Context, roleIds []uuid.UUID) ([]RoleParent, error)
+	// Lists the permissions granted directly to roles, ordered by role and permission.
+	ListRolePermissions(ctx context.Context, roleIds []uuid.UUID) ([]RolePermission, error)
+	ListRoles(ctx context.Context) ([]Role, error)
+	ListServiceAccountKeys(ctx context.Context, serviceAccountID uuid.UUID) ([]ServiceAccountKey, error)
+	ListServiceAccounts(ctx context.Context) ([]ServiceAccount, error)
+	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	ListValidSigningKeys(ctx context.Context) ([]SigningKey, error)
+	// Locks the organization until the end of the transaction, serializing changes of its owners.
+	LockOrganization(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	// Serializes changes of the role inheritance graph, so concurrent changes cannot create a cycle together.
+	LockRoleParents(ctx context.Context) error
+	// Serializes key rotation across instances for the duration of the transaction.
+	LockSigningKeys(ctx context.Context) error
+	// Marks the email of the user verified. Returns no rows if the email of the user changed in the meantime.
+	MarkUserEmailVerified(ctx context.Context, arg MarkUserEmailVerifiedParams) (User, error)
+	// Records a poll of the device. No rows are affected if the device polled concurrently since the given previous poll.
+	PollOAuthDeviceAuthorization(ctx context.Context, arg PollOAuthDeviceAuthorizationParams) (int64, error)
+	// Deletes all but the latest `keep` entries of the user.
+	PrunePasswordHistory(ctx context.Context, arg PrunePasswordHistoryParams) error
+	// Replaces the hash only if it was not changed concurrently.
+	RehashPasswordCredential(ctx context.Context, arg RehashPasswordCredentialParams) (int64, error)
+	RemoveRoleParent(ctx context.Context, arg RemoveRoleParentParams) (int64, error)
+	RevokeOAuthAccessToken(ctx context.Context, arg RevokeOAuthAccessTokenParams) error
+	RevokeRefreshTokenFamily(ctx context.Context, arg RevokeRefreshTokenFamilyParams) (int64, error)
+	RevokeRolePermission(ctx context.Context, arg RevokeRolePermissionParams) (int64, error)
+	RevokeSession(ctx context.Context, arg RevokeSessionParams) (int64, error)
+	RevokeSessionRefreshTokenFamilies(ctx context.Context, arg RevokeSessionRefreshTokenFamiliesParams) (int64, error)
+	RevokeUserRefreshTokenFamilies(ctx context.Context, arg RevokeUserRefreshTokenFamiliesParams) (int64, error)
+	// Revokes all sessions of the user except the one with `except_id`.
+	RevokeUserSessions(ctx context.Context, arg RevokeUserSessionsParams) (int64, error)
+	// Replaces the secret hash of the key. The replaced hash stays valid until previous_expires_at, or not at all if it is
+	// null.
+	RotateAPIKey(ctx context.Context, arg RotateAPIKeyParams) (ApiKey, error)
+	// Replaces the secret hash of the account. The replaced hash stays valid until previous_expires_at, or not at all if it
+	// is null.
+	RotateServiceAccountSecret(ctx context.Context, arg RotateServiceAccountSecretParams) (ServiceAccount, error)
+	SearchUsers(ctx context.Context, arg SearchUsersParams) ([]User, error)
+	SetOAuthAuthorizationCodeRefreshTokenFamily(ctx context.Context, arg SetOAuthAuthorizationCodeRefreshTokenFamilyParams) (int64, error)
+	SoftDeleteUser(ctx context.Context, id uuid.UUID) (int64, error)
+	TouchSession(ctx context.Context, arg TouchSessionParams) (Session, error)
+	// Sets the last used times of the keys in a single statement. Times never move backwards, so batches written out of
+	// order do not lose the latest use.
+	UpdateAPIKeysLastUsed(ctx context.Context, arg UpdateAPIKeysLastUsedParams) (int64, error)
+	UpdateCondition(ctx context.Context, arg UpdateConditionParams) (Condition, error)
+	UpdateOAuthClient(ctx context.Context, arg UpdateOAuthClientParams) (OauthClient, error)
+	UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) (Organization, error)
+	UpdateOrganizationMembershipRole(ctx context.Context, arg UpdateOrganizationMembershipRoleParams) (OrganizationMembership, error)
+	// Records a successful assertion. Returns no rows if the sign count changed concurrently.
+	UpdatePasskeyUsage(ctx context.Context, arg UpdatePasskeyUsageParams) (int64, error)
+	UpdateRole(ctx context.Context, arg UpdateRoleParams) (Role, error)
+	UpdateServiceAccount(ctx context.Context, arg UpdateServiceAccountParams) (ServiceAccount, error)
+	// Updates the given fields of the user. Changing the email resets its verification.
+	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	// Creates an invitation, replacing the pending invitation of the email to the organization.
+	UpsertOrganizationInvitation(ctx context.Context, arg UpsertOrganizationInvitationParams) (OrganizationInvitation, error)
+	UpsertPasswordCredential(ctx context.Context, arg UpsertPasswordCredentialParams) error
+	// Starts enrollment of a new TOTP factor, replacing an unconfirmed one. Returns no rows if a confirmed factor exists.
+	UpsertTOTPFactor(ctx context.Context, arg UpsertTOTPFactorParams) (TotpFactor, error)
+	UpsertUserProfile(ctx context.Context, arg UpsertUserProfileParams) (UserProfile, error)
+	// Marks the code as used. No rows are affected if it was already used.
+	UseOAuthAuthorizationCode(ctx context.Context, id uuid.UUID) (int64, error)
+	// Marks the reset used. Affects no rows if the reset was used or expired, so each reset can be used only once.
+	UsePasswordReset(ctx context.Context, id uuid.UUID) (int64, error)
+	UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (int64, error)
+	// Marks the refresh token as used. No rows are affected if it was already used.
+	UseRefreshToken(ctx context.Context, id uuid.UUID) (int64, error)
+	// Records the time step of an accepted code. Returns no rows if the step, or a later one, was already used.
+	UseTOTPStep(ctx context.Context, arg UseTOTPStepParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
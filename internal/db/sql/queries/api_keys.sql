@@ -0,0 +1,93 @@
+-- name: CreateAPIKey :one
+INSERT INTO
+	api_keys (name, prefix, secret_hash, user_id, org_id, service_account_id, scopes, expires_at)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING
+	*;
+
+-- name: GetAPIKeyByID :one
+SELECT
+	*
+FROM
+	api_keys
+WHERE
+	id = $1;
+
+-- name: GetAPIKeyByPrefix :one
+-- Returns the key of the prefix, including expired ones. Keys of users who are deleted or not active are not returned,
+-- so they stop working while the user is suspended.
+SELECT
+	*
+FROM
+	api_keys
+WHERE
+	prefix = $1
+	AND (
+		user_id IS NULL
+		OR EXISTS (
+			SELECT
+			FROM
+				users
+			WHERE
+				users.id = api_keys.user_id
+				AND users.status = 'active'
+				AND users.deleted_at IS NULL
+		)
+	);
+
+-- name: ListAPIKeysByOwner :many
+-- Lists the keys of the owner, of which exactly one column is set, ordered by id.
+SELECT
+	*
+FROM
+	api_keys
+WHERE
+	user_id = sqlc.narg('user_id')
+	OR org_id = sqlc.narg('org_id')
+	OR service_account_id = sqlc.narg('service_account_id')
+ORDER BY
+	id;
+
+-- name: RotateAPIKey :one
+-- Replaces the secret hash of the key. The replaced hash stays valid until previous_expires_at, or not at all if it is
+-- null.
+UPDATE api_keys
+SET
+	previous_secret_hash = CASE
+		WHEN sqlc.narg('previous_expires_at')::TIMESTAMPTZ IS NULL THEN NULL
+		ELSE secret_hash
+	END,
+	previous_expires_at = sqlc.narg('previous_expires_at'),
+	secret_hash = sqlc.arg('secret_hash'),
+	rotated_at = NOW()
+WHERE
+	id = sqlc.arg('id')
+RETURNING
+	*;
+
+-- name: DeleteAPIKey :execrows
+DELETE FROM api_keys
+WHERE
+	id = $1;
+
+-- name: UpdateAPIKeysLastUsed :execrows
+-- Sets the last used times of the keys in a single statement. Times never move backwards, so batches written out of
+-- order do not lose the latest use.
+UPDATE api_keys k
+SET
+	last_used_at = u.last_used_at
+FROM
+	UNNEST(sqlc.arg('ids')::UUID[], sqlc.arg('last_used_ats')::TIMESTAMPTZ[]) AS u (id, last_used_at)
+WHERE
+	k.id = u.id
+	AND (
+		k.last_used_at IS NULL
+		OR k.last_used_at < u.last_used_at
+	);
+
+-- name: DeleteExpiredAPIKeys :execrows
+-- Deletes keys which expired before the given time.
+DELETE FROM api_keys
+WHERE
+	expires_at < sqlc.arg('before');
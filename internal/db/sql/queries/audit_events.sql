@@ -0,0 +1,5 @@
+-- name: CreateAuditEvent :exec
+INSERT INTO
+	audit_events (type, user_id, ip_address, user_agent, metadata)
+VALUES
+	($1, $2, $3, $4, $5);
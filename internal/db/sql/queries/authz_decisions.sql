@@ -0,0 +1,20 @@
+-- name: CreateAuthzDecision :exec
+INSERT INTO
+	authz_decisions (
+		type,
+		subject,
+		action,
+		resource,
+		allowed,
+		policy,
+		conditions,
+		trace_id
+	)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: DeleteExpiredAuthzDecisions :execrows
+-- Deletes decisions recorded before the given time.
+DELETE FROM authz_decisions
+WHERE
+	created_at < sqlc.arg('before');
@@ -0,0 +1,39 @@
+-- name: CreateCondition :one
+INSERT INTO
+	conditions (name, expression, description)
+VALUES
+	($1, $2, $3)
+RETURNING
+	*;
+
+-- name: GetCondition :one
+SELECT
+	*
+FROM
+	conditions
+WHERE
+	name = $1;
+
+-- name: ListConditions :many
+SELECT
+	*
+FROM
+	conditions
+ORDER BY
+	name;
+
+-- name: UpdateCondition :one
+UPDATE conditions
+SET
+	expression = COALESCE(sqlc.narg('expression'), expression),
+	description = COALESCE(sqlc.narg('description'), description),
+	updated_at = NOW()
+WHERE
+	name = sqlc.arg('name')
+RETURNING
+	*;
+
+-- name: DeleteCondition :execrows
+DELETE FROM conditions
+WHERE
+	name = $1;
@@ -0,0 +1,43 @@
+-- name: CountEmailVerificationsSince :one
+SELECT
+	COUNT(*)
+FROM
+	email_verifications
+WHERE
+	user_id = $1
+	AND purpose = $2
+	AND created_at > sqlc.arg('since');
+
+-- name: DeletePendingEmailVerifications :execrows
+-- Deletes verifications of the user which were not completed, so only the latest one can be completed.
+DELETE FROM email_verifications
+WHERE
+	user_id = $1
+	AND purpose = $2
+	AND completed_at IS NULL;
+
+-- name: CreateEmailVerification :one
+INSERT INTO
+	email_verifications (user_id, purpose, email, token_hash, expires_at)
+VALUES
+	($1, $2, $3, $4, $5)
+RETURNING
+	*;
+
+-- name: CompleteEmailVerification :one
+-- Completes and returns the pending verification, so that each token can be used only once.
+UPDATE email_verifications
+SET
+	completed_at = NOW()
+WHERE
+	token_hash = $1
+	AND completed_at IS NULL
+	AND expires_at > NOW()
+RETURNING
+	*;
+
+-- name: DeleteExpiredEmailVerifications :execrows
+-- Deletes verifications which expired before the given time.
+DELETE FROM email_verifications
+WHERE
+	expires_at < sqlc.arg('before');
@@ -1,3 +0,0 @@
--- name: Example :one
-SELECT
-	1;
@@ -0,0 +1,37 @@
+-- name: CreateMFAChallenge :one
+INSERT INTO
+	mfa_challenges (user_id, token_hash, device, expires_at)
+VALUES
+	($1, $2, $3, $4)
+RETURNING
+	*;
+
+-- name: AttemptMFAChallenge :one
+-- Counts an attempt to answer the challenge. Returns no rows if the challenge is not pending or ran out of attempts,
+-- so concurrent attempts cannot exceed the limit.
+UPDATE mfa_challenges
+SET
+	attempts = attempts + 1
+WHERE
+	token_hash = sqlc.arg('token_hash')
+	AND completed_at IS NULL
+	AND expires_at > NOW()
+	AND attempts < sqlc.arg('max_attempts')::INT
+RETURNING
+	*;
+
+-- name: CompleteMFAChallenge :execrows
+-- Completes the challenge. Returns no rows if it was completed concurrently or counted more attempts than allowed.
+UPDATE mfa_challenges
+SET
+	completed_at = NOW()
+WHERE
+	id = sqlc.arg('id')
+	AND completed_at IS NULL
+	AND attempts <= sqlc.arg('max_attempts')::INT;
+
+-- name: DeleteExpiredMFAChallenges :execrows
+-- Deletes challenges which expired before the given time.
+DELETE FROM mfa_challenges
+WHERE
+	expires_at < sqlc.arg('before');
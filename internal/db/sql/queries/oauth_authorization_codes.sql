@@ -0,0 +1,47 @@
+-- name: CreateOAuthAuthorizationCode :one
+INSERT INTO
+	oauth_authorization_codes (
+		code_hash,
+		client_id,
+		user_id,
+		session_id,
+		redirect_uri,
+		scope,
+		code_challenge,
+		nonce,
+		expires_at
+	)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING
+	*;
+
+-- name: GetOAuthAuthorizationCodeByHash :one
+SELECT
+	*
+FROM
+	oauth_authorization_codes
+WHERE
+	code_hash = $1;
+
+-- name: UseOAuthAuthorizationCode :execrows
+-- Marks the code as used. No rows are affected if it was already used.
+UPDATE oauth_authorization_codes
+SET
+	used_at = NOW()
+WHERE
+	id = $1
+	AND used_at IS NULL;
+
+-- name: SetOAuthAuthorizationCodeRefreshTokenFamily :execrows
+UPDATE oauth_authorization_codes
+SET
+	refresh_token_family_id = $2
+WHERE
+	id = $1;
+
+-- name: DeleteExpiredOAuthAuthorizationCodes :execrows
+-- Deletes codes which expired before the given time, used or not.
+DELETE FROM oauth_authorization_codes
+WHERE
+	expires_at < sqlc.arg('before');
@@ -0,0 +1,39 @@
+-- name: CreateOAuthAuthorizationRequest :one
+INSERT INTO
+	oauth_authorization_requests (
+		client_id,
+		redirect_uri,
+		scope,
+		state,
+		code_challenge,
+		nonce,
+		prompt,
+		max_age,
+		expires_at
+	)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING
+	*;
+
+-- name: GetOAuthAuthorizationRequest :one
+SELECT
+	*
+FROM
+	oauth_authorization_requests
+WHERE
+	id = $1;
+
+-- name: DeleteOAuthAuthorizationRequest :one
+-- Deletes the request and returns it, so a request is answered at most once.
+DELETE FROM oauth_authorization_requests
+WHERE
+	id = $1
+RETURNING
+	*;
+
+-- name: DeleteExpiredOAuthAuthorizationRequests :execrows
+-- Deletes requests which expired before the given time.
+DELETE FROM oauth_authorization_requests
+WHERE
+	expires_at < sqlc.arg('before');
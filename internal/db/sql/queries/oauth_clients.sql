@@ -0,0 +1,42 @@
+-- name: CreateOAuthClient :one
+INSERT INTO
+	oauth_clients (name, type, secret_hash, redirect_uris, grant_types, scopes, audiences)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7)
+RETURNING
+	*;
+
+-- name: GetOAuthClientByID :one
+SELECT
+	*
+FROM
+	oauth_clients
+WHERE
+	id = $1;
+
+-- name: ListOAuthClients :many
+SELECT
+	*
+FROM
+	oauth_clients
+ORDER BY
+	id;
+
+-- name: UpdateOAuthClient :one
+UPDATE oauth_clients
+SET
+	name = $2,
+	redirect_uris = $3,
+	grant_types = $4,
+	scopes = $5,
+	audiences = $6,
+	updated_at = NOW()
+WHERE
+	id = $1
+RETURNING
+	*;
+
+-- name: DeleteOAuthClient :execrows
+DELETE FROM oauth_clients
+WHERE
+	id = $1;
@@ -0,0 +1,65 @@
+-- name: CreateOAuthDeviceAuthorization :one
+INSERT INTO
+	oauth_device_authorizations (
+		device_code_hash,
+		user_code,
+		client_id,
+		scope,
+		poll_interval,
+		expires_at
+	)
+VALUES
+	($1, $2, $3, $4, $5, $6)
+RETURNING
+	*;
+
+-- name: GetOAuthDeviceAuthorizationByUserCode :one
+SELECT
+	*
+FROM
+	oauth_device_authorizations
+WHERE
+	user_code = $1;
+
+-- name: GetOAuthDeviceAuthorizationByDeviceCodeHash :one
+SELECT
+	*
+FROM
+	oauth_device_authorizations
+WHERE
+	device_code_hash = $1;
+
+-- name: AnswerOAuthDeviceAuthorization :one
+-- Answers the pending authorization of the user code. No row is returned if it was answered already or expired.
+UPDATE oauth_device_authorizations
+SET
+	status = sqlc.arg('status'),
+	user_id = sqlc.narg('user_id'),
+	session_id = sqlc.narg('session_id')
+WHERE
+	user_code = sqlc.arg('user_code')
+	AND status = 'pending'
+	AND expires_at > sqlc.arg('now')
+RETURNING
+	*;
+
+-- name: PollOAuthDeviceAuthorization :execrows
+-- Records a poll of the device. No rows are affected if the device polled concurrently since the given previous poll.
+UPDATE oauth_device_authorizations
+SET
+	polled_at = sqlc.arg('polled_at'),
+	poll_interval = sqlc.arg('poll_interval')
+WHERE
+	id = sqlc.arg('id')
+	AND polled_at IS NOT DISTINCT FROM sqlc.narg('previous_polled_at');
+
+-- name: DeleteOAuthDeviceAuthorization :execrows
+DELETE FROM oauth_device_authorizations
+WHERE
+	id = $1;
+
+-- name: DeleteExpiredOAuthDeviceAuthorizations :execrows
+-- Deletes authorizations which expired before the given time, answered or not.
+DELETE FROM oauth_device_authorizations
+WHERE
+	expires_at < sqlc.arg('before');
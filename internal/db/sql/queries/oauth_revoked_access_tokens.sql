@@ -0,0 +1,23 @@
+-- name: RevokeOAuthAccessToken :exec
+INSERT INTO
+	oauth_revoked_access_tokens (token_id, expires_at)
+VALUES
+	($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: IsOAuthAccessTokenRevoked :one
+SELECT
+	EXISTS (
+		SELECT
+			1
+		FROM
+			oauth_revoked_access_tokens
+		WHERE
+			token_id = $1
+	)::BOOL AS revoked;
+
+-- name: DeleteExpiredOAuthRevokedAccessTokens :execrows
+-- Deletes revoked token identifiers which expired before the given time, as their tokens are rejected anyway.
+DELETE FROM oauth_revoked_access_tokens
+WHERE
+	expires_at < sqlc.arg('before');
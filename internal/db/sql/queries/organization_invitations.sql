@@ -0,0 +1,59 @@
+-- name: UpsertOrganizationInvitation :one
+-- Creates an invitation, replacing the pending invitation of the email to the organization.
+INSERT INTO
+	organization_invitations (org_id, email, role, inviter_id, token_hash, expires_at)
+VALUES
+	($1, $2, $3, $4, $5, $6)
+ON CONFLICT (org_id, email) DO UPDATE
+SET
+	role = EXCLUDED.role,
+	inviter_id = EXCLUDED.inviter_id,
+	token_hash = EXCLUDED.token_hash,
+	created_at = NOW(),
+	expires_at = EXCLUDED.expires_at
+RETURNING
+	*;
+
+-- name: GetOrganizationInvitationByTokenHash :one
+-- Returns the invitation of the token hash, including expired ones.
+SELECT
+	*
+FROM
+	organization_invitations
+WHERE
+	token_hash = $1;
+
+-- name: ListOrganizationInvitations :many
+-- Lists the invitations to the organization which did not expire before the given time, ordered by id.
+SELECT
+	*
+FROM
+	organization_invitations
+WHERE
+	org_id = sqlc.arg('org_id')
+	AND expires_at > sqlc.arg('now')
+ORDER BY
+	id;
+
+-- name: DeleteOrganizationInvitation :execrows
+DELETE FROM organization_invitations
+WHERE
+	id = $1
+	AND org_id = $2;
+
+-- name: ConsumeOrganizationInvitation :one
+-- Deletes and returns the invitation of the token hash if it is for the email and did not expire, so each invitation
+-- is answered only once.
+DELETE FROM organization_invitations
+WHERE
+	token_hash = $1
+	AND email = $2
+	AND expires_at > NOW()
+RETURNING
+	*;
+
+-- name: DeleteExpiredOrganizationInvitations :execrows
+-- Deletes invitations which expired before the given time.
+DELETE FROM organization_invitations
+WHERE
+	expires_at < sqlc.arg('before');
@@ -0,0 +1,59 @@
+-- name: CreateOrganizationMembership :one
+INSERT INTO
+	organization_memberships (org_id, user_id, role)
+VALUES
+	($1, $2, $3)
+RETURNING
+	*;
+
+-- name: GetOrganizationMembership :one
+SELECT
+	*
+FROM
+	organization_memberships
+WHERE
+	org_id = $1
+	AND user_id = $2;
+
+-- name: ListOrganizationMemberships :many
+-- Lists the memberships of the organization ordered by user id.
+SELECT
+	*
+FROM
+	organization_memberships
+WHERE
+	org_id = sqlc.arg('org_id')
+	AND (
+		sqlc.narg('after_user_id')::UUID IS NULL
+		OR user_id > sqlc.narg('after_user_id')
+	)
+ORDER BY
+	user_id
+LIMIT
+	sqlc.arg('limit');
+
+-- name: UpdateOrganizationMembershipRole :one
+UPDATE organization_memberships
+SET
+	role = sqlc.arg('role'),
+	updated_at = NOW()
+WHERE
+	org_id = sqlc.arg('org_id')
+	AND user_id = sqlc.arg('user_id')
+RETURNING
+	*;
+
+-- name: DeleteOrganizationMembership :execrows
+DELETE FROM organization_memberships
+WHERE
+	org_id = $1
+	AND user_id = $2;
+
+-- name: CountOrganizationOwners :one
+SELECT
+	COUNT(*)
+FROM
+	organization_memberships
+WHERE
+	org_id = $1
+	AND role = 'owner';
@@ -0,0 +1,61 @@
+-- name: CreateOrganization :one
+INSERT INTO
+	organizations (slug, name)
+VALUES
+	($1, $2)
+RETURNING
+	*;
+
+-- name: GetOrganizationByID :one
+SELECT
+	*
+FROM
+	organizations
+WHERE
+	id = $1;
+
+-- name: GetOrganizationBySlug :one
+SELECT
+	*
+FROM
+	organizations
+WHERE
+	slug = $1;
+
+-- name: LockOrganization :one
+-- Locks the organization until the end of the transaction, serializing changes of its owners.
+SELECT
+	id
+FROM
+	organizations
+WHERE
+	id = $1
+FOR UPDATE;
+
+-- name: ListOrganizationsByUser :many
+-- Lists the organizations the user is a member of, ordered by id.
+SELECT
+	organizations.*
+FROM
+	organizations
+	JOIN organization_memberships ON organization_memberships.org_id = organizations.id
+WHERE
+	organization_memberships.user_id = $1
+ORDER BY
+	organizations.id;
+
+-- name: UpdateOrganization :one
+UPDATE organizations
+SET
+	slug = COALESCE(sqlc.narg('slug'), slug),
+	name = COALESCE(sqlc.narg('name'), name),
+	updated_at = NOW()
+WHERE
+	id = sqlc.arg('id')
+RETURNING
+	*;
+
+-- name: DeleteOrganization :execrows
+DELETE FROM organizations
+WHERE
+	id = $1;
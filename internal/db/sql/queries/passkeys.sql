@@ -0,0 +1,55 @@
+-- name: CreatePasskey :one
+INSERT INTO
+	passkeys (
+		user_id,
+		credential_id,
+		public_key,
+		attestation_format,
+		aaguid,
+		sign_count,
+		transports,
+		user_verified,
+		backup_eligible,
+		backup_state,
+		name
+	)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING
+	*;
+
+-- name: GetPasskeyByCredentialID :one
+SELECT
+	*
+FROM
+	passkeys
+WHERE
+	credential_id = $1;
+
+-- name: ListPasskeysByUserID :many
+SELECT
+	*
+FROM
+	passkeys
+WHERE
+	user_id = $1
+ORDER BY
+	id;
+
+-- name: UpdatePasskeyUsage :execrows
+-- Records a successful assertion. Returns no rows if the sign count changed concurrently.
+UPDATE passkeys
+SET
+	sign_count = sqlc.arg('sign_count'),
+	user_verified = sqlc.arg('user_verified'),
+	backup_state = sqlc.arg('backup_state'),
+	last_used_at = NOW()
+WHERE
+	id = sqlc.arg('id')
+	AND sign_count = sqlc.arg('previous_sign_count');
+
+-- name: DeletePasskey :execrows
+DELETE FROM passkeys
+WHERE
+	id = $1
+	AND user_id = $2;
@@ -0,0 +1,31 @@
+-- name: GetPasswordCredential :one
+SELECT
+	*
+FROM
+	password_credentials
+WHERE
+	user_id = $1;
+
+-- name: UpsertPasswordCredential :exec
+INSERT INTO
+	password_credentials (user_id, hash)
+VALUES
+	($1, $2)
+ON CONFLICT (user_id) DO UPDATE
+SET
+	hash = EXCLUDED.hash,
+	updated_at = NOW();
+
+-- name: RehashPasswordCredential :execrows
+-- Replaces the hash only if it was not changed concurrently.
+UPDATE password_credentials
+SET
+	hash = sqlc.arg('new_hash')
+WHERE
+	user_id = sqlc.arg('user_id')
+	AND hash = sqlc.arg('old_hash');
+
+-- name: DeletePasswordCredential :execrows
+DELETE FROM password_credentials
+WHERE
+	user_id = $1;
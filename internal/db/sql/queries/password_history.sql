@@ -0,0 +1,35 @@
+-- name: ListPasswordHistory :many
+SELECT
+	hash
+FROM
+	password_history
+WHERE
+	user_id = $1
+ORDER BY
+	id DESC
+LIMIT
+	$2;
+
+-- name: InsertPasswordHistory :exec
+INSERT INTO
+	password_history (user_id, hash)
+VALUES
+	($1, $2);
+
+-- name: PrunePasswordHistory :exec
+-- Deletes all but the latest `keep` entries of the user.
+DELETE FROM password_history
+WHERE
+	user_id = sqlc.arg('user_id')
+	AND id NOT IN (
+		SELECT
+			id
+		FROM
+			password_history
+		WHERE
+			user_id = sqlc.arg('user_id')
+		ORDER BY
+			id DESC
+		LIMIT
+			sqlc.arg('keep')
+	);
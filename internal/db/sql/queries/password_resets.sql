@@ -0,0 +1,48 @@
+-- name: CountPasswordResetsSince :one
+SELECT
+	COUNT(*)
+FROM
+	password_resets
+WHERE
+	user_id = $1
+	AND created_at > sqlc.arg('since');
+
+-- name: DeletePendingPasswordResets :execrows
+-- Deletes resets of the user which were not used, so only the latest one can be used.
+DELETE FROM password_resets
+WHERE
+	user_id = $1
+	AND used_at IS NULL;
+
+-- name: CreatePasswordReset :one
+INSERT INTO
+	password_resets (user_id, token_hash, expires_at)
+VALUES
+	($1, $2, $3)
+RETURNING
+	*;
+
+-- name: GetPasswordResetByTokenHash :one
+-- Returns the reset of the token hash, including used and expired ones.
+SELECT
+	*
+FROM
+	password_resets
+WHERE
+	token_hash = $1;
+
+-- name: UsePasswordReset :execrows
+-- Marks the reset used. Affects no rows if the reset was used or expired, so each reset can be used only once.
+UPDATE password_resets
+SET
+	used_at = NOW()
+WHERE
+	id = $1
+	AND used_at IS NULL
+	AND expires_at > NOW();
+
+-- name: DeleteExpiredPasswordResets :execrows
+-- Deletes resets which expired before the given time.
+DELETE FROM password_resets
+WHERE
+	expires_at < sqlc.arg('before');
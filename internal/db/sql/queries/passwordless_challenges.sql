@@ -0,0 +1,55 @@
+-- name: CountPasswordlessChallengesSince :one
+SELECT
+	COUNT(*)
+FROM
+	passwordless_challenges
+WHERE
+	user_id = $1
+	AND method = $2
+	AND created_at > sqlc.arg('since');
+
+-- name: CreatePasswordlessChallenge :one
+INSERT INTO
+	passwordless_challenges (user_id, method, token_hash, code_hash, device, expires_at)
+VALUES
+	($1, $2, $3, $4, $5, $6)
+RETURNING
+	*;
+
+-- name: DeletePendingPasswordlessChallenges :execrows
+-- Deletes challenges of the user which were not completed, so only the latest one can be completed.
+DELETE FROM passwordless_challenges
+WHERE
+	user_id = $1
+	AND method = $2
+	AND completed_at IS NULL;
+
+-- name: AttemptPasswordlessChallenge :one
+-- Counts an attempt to complete the challenge. Returns no rows if the challenge is not pending or ran out of attempts,
+-- so concurrent attempts cannot exceed the limit.
+UPDATE passwordless_challenges
+SET
+	attempts = attempts + 1
+WHERE
+	token_hash = sqlc.arg('token_hash')
+	AND method = sqlc.arg('method')
+	AND completed_at IS NULL
+	AND expires_at > NOW()
+	AND attempts < sqlc.arg('max_attempts')::INT
+RETURNING
+	*;
+
+-- name: CompletePasswordlessChallenge :execrows
+-- Completes the challenge. Returns no rows if it was completed concurrently.
+UPDATE passwordless_challenges
+SET
+	completed_at = NOW()
+WHERE
+	id = $1
+	AND completed_at IS NULL;
+
+-- name: DeleteExpiredPasswordlessChallenges :execrows
+-- Deletes challenges which expired before the given time.
+DELETE FROM passwordless_challenges
+WHERE
+	expires_at < sqlc.arg('before');
@@ -0,0 +1,20 @@
+-- name: CreatePermission :one
+INSERT INTO
+	permissions (name, description)
+VALUES
+	($1, $2)
+RETURNING
+	*;
+
+-- name: ListPermissions :many
+SELECT
+	*
+FROM
+	permissions
+ORDER BY
+	name;
+
+-- name: DeletePermission :execrows
+DELETE FROM permissions
+WHERE
+	name = $1;
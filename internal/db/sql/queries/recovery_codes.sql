@@ -0,0 +1,29 @@
+-- name: CreateRecoveryCodes :exec
+INSERT INTO
+	recovery_codes (user_id, code_hash)
+SELECT
+	sqlc.arg('user_id')::UUID,
+	UNNEST(sqlc.arg('code_hashes')::BYTEA[]);
+
+-- name: UseRecoveryCode :execrows
+UPDATE recovery_codes
+SET
+	used_at = NOW()
+WHERE
+	user_id = $1
+	AND code_hash = $2
+	AND used_at IS NULL;
+
+-- name: CountUnusedRecoveryCodes :one
+SELECT
+	COUNT(*)
+FROM
+	recovery_codes
+WHERE
+	user_id = $1
+	AND used_at IS NULL;
+
+-- name: DeleteRecoveryCodes :execrows
+DELETE FROM recovery_codes
+WHERE
+	user_id = $1;
@@ -0,0 +1,83 @@
+-- name: CreateRefreshTokenFamily :one
+INSERT INTO
+	refresh_token_families (user_id, session_id, client_id, scope, expires_at)
+VALUES
+	($1, $2, $3, $4, $5)
+RETURNING
+	*;
+
+-- name: CreateRefreshToken :one
+INSERT INTO
+	refresh_tokens (family_id, parent_id, token_hash, expires_at)
+VALUES
+	($1, $2, $3, $4)
+RETURNING
+	*;
+
+-- name: GetRefreshTokenByHash :one
+SELECT
+	t.id,
+	t.family_id,
+	t.expires_at,
+	t.used_at,
+	f.user_id,
+	f.session_id,
+	f.client_id,
+	f.scope,
+	f.expires_at AS family_expires_at,
+	f.revoked_at AS family_revoked_at
+FROM
+	refresh_tokens t
+	JOIN refresh_token_families f ON f.id = t.family_id
+WHERE
+	t.token_hash = $1;
+
+-- name: GetRefreshTokenFamily :one
+SELECT
+	*
+FROM
+	refresh_token_families
+WHERE
+	id = $1;
+
+-- name: UseRefreshToken :execrows
+-- Marks the refresh token as used. No rows are affected if it was already used.
+UPDATE refresh_tokens
+SET
+	used_at = NOW()
+WHERE
+	id = $1
+	AND used_at IS NULL;
+
+-- name: RevokeRefreshTokenFamily :execrows
+UPDATE refresh_token_families
+SET
+	revoked_at = NOW(),
+	revoked_reason = $2
+WHERE
+	id = $1
+	AND revoked_at IS NULL;
+
+-- name: RevokeSessionRefreshTokenFamilies :execrows
+UPDATE refresh_token_families
+SET
+	revoked_at = NOW(),
+	revoked_reason = sqlc.arg('revoked_reason')
+WHERE
+	session_id = sqlc.arg('session_id')::UUID
+	AND revoked_at IS NULL;
+
+-- name: RevokeUserRefreshTokenFamilies :execrows
+UPDATE refresh_token_families
+SET
+	revoked_at = NOW(),
+	revoked_reason = $2
+WHERE
+	user_id = $1
+	AND revoked_at IS NULL;
+
+-- name: DeleteExpiredRefreshTokenFamilies :execrows
+-- Deletes token families which expired or were revoked before the given time along with their tokens.
+DELETE FROM refresh_token_families
+WHERE
+	LEAST(expires_at, revoked_at) < sqlc.arg('before');
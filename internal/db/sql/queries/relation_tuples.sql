@@ -0,0 +1,190 @@
+-- name: GetRelationRevision :one
+SELECT
+	revision
+FROM
+	relation_revision;
+
+-- name: IncrementRelationRevision :one
+-- Increments the revision and locks it until the transaction ends, which serializes writes.
+UPDATE relation_revision
+SET
+	revision = revision + 1
+RETURNING
+	revision;
+
+-- name: CreateRelationSchema :exec
+INSERT INTO
+	relation_schemas (revision, definition)
+VALUES
+	($1, $2);
+
+-- name: GetLatestRelationSchemaRevision :one
+SELECT
+	COALESCE(MAX(revision), 0)::BIGINT AS revision
+FROM
+	relation_schemas;
+
+-- name: GetLatestRelationSchema :one
+SELECT
+	*
+FROM
+	relation_schemas
+ORDER BY
+	revision DESC
+LIMIT
+	1;
+
+-- name: CreateRelationTuple :exec
+-- Creates the tuple or replaces the condition of an existing tuple, which counts as writing it again.
+INSERT INTO
+	relation_tuples (
+		namespace,
+		object_id,
+		relation,
+		subject_namespace,
+		subject_id,
+		subject_relation,
+		condition,
+		created_revision
+	)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (
+	namespace,
+	object_id,
+	relation,
+	subject_namespace,
+	subject_id,
+	subject_relation
+) DO UPDATE
+SET
+	condition = EXCLUDED.condition,
+	created_revision = EXCLUDED.created_revision
+WHERE
+	relation_tuples.condition IS DISTINCT FROM EXCLUDED.condition;
+
+-- name: DeleteRelationTuple :execrows
+DELETE FROM relation_tuples
+WHERE
+	namespace = $1
+	AND object_id = $2
+	AND relation = $3
+	AND subject_namespace = $4
+	AND subject_id = $5
+	AND subject_relation = $6;
+
+-- name: GetRelationTupleCondition :one
+-- Returns the condition of the tuple and its expression, which are NULL for unconditional tuples.
+SELECT
+	relation_tuples.condition,
+	conditions.expression
+FROM
+	relation_tuples
+	LEFT JOIN conditions ON conditions.name = relation_tuples.condition
+WHERE
+	relation_tuples.namespace = $1
+	AND relation_tuples.object_id = $2
+	AND relation_tuples.relation = $3
+	AND relation_tuples.subject_namespace = $4
+	AND relation_tuples.subject_id = $5
+	AND relation_tuples.subject_relation = $6;
+
+-- name: ListRelationTupleSubjects :many
+-- Lists the subjects related to the object with the conditions of their tuples. With only_usersets, subjects which are
+-- objects are skipped.
+SELECT
+	relation_tuples.subject_namespace,
+	relation_tuples.subject_id,
+	relation_tuples.subject_relation,
+	relation_tuples.condition,
+	conditions.expression
+FROM
+	relation_tuples
+	LEFT JOIN conditions ON conditions.name = relation_tuples.condition
+WHERE
+	relation_tuples.namespace = sqlc.arg('namespace')
+	AND relation_tuples.object_id = sqlc.arg('object_id')
+	AND relation_tuples.relation = sqlc.arg('relation')
+	AND (
+		NOT sqlc.arg('only_usersets')::BOOLEAN
+		OR relation_tuples.subject_relation <> ''
+	)
+ORDER BY
+	relation_tuples.subject_namespace,
+	relation_tuples.subject_id,
+	relation_tuples.subject_relation;
+
+-- name: ListRelationTuples :many
+SELECT
+	*
+FROM
+	relation_tuples
+WHERE
+	namespace = sqlc.arg('namespace')
+	AND (
+		sqlc.narg('object_id')::TEXT IS NULL
+		OR object_id = sqlc.narg('object_id')
+	)
+	AND (
+		sqlc.narg('relation')::TEXT IS NULL
+		OR relation = sqlc.narg('relation')
+	)
+	AND (
+		sqlc.narg('subject_namespace')::TEXT IS NULL
+		OR subject_namespace = sqlc.narg('subject_namespace')
+	)
+	AND (
+		sqlc.narg('subject_id')::TEXT IS NULL
+		OR subject_id = sqlc.narg('subject_id')
+	)
+	AND (
+		sqlc.narg('subject_relation')::TEXT IS NULL
+		OR subject_relation = sqlc.narg('subject_relation')
+	)
+	AND (
+		sqlc.narg('after_id')::UUID IS NULL
+		OR id > sqlc.narg('after_id')
+	)
+ORDER BY
+	id
+LIMIT
+	sqlc.arg('limit');
+
+-- name: ListRelationObjectIDs :many
+-- Lists the ids of all objects of the namespace which appear in tuples, as only those can have relations.
+SELECT DISTINCT
+	object_id
+FROM
+	relation_tuples
+WHERE
+	namespace = sqlc.arg('namespace')
+	AND object_id > sqlc.arg('after')
+ORDER BY
+	object_id
+LIMIT
+	sqlc.arg('limit');
+
+-- name: ListRelationSubjectIDs :many
+-- Lists the ids of all objects of the namespace which are subjects of tuples, as only those can have relations.
+SELECT DISTINCT
+	subject_id
+FROM
+	relation_tuples
+WHERE
+	subject_namespace = sqlc.arg('subject_namespace')
+	AND subject_relation = ''
+	AND subject_id > sqlc.arg('after')
+ORDER BY
+	subject_id
+LIMIT
+	sqlc.arg('limit');
+
+-- name: ListRelationTupleTypes :many
+-- Lists the distinct relations and subject types in use, to validate them against a new schema.
+SELECT DISTINCT
+	namespace,
+	relation,
+	subject_namespace,
+	subject_relation
+FROM
+	relation_tuples;
@@ -0,0 +1,151 @@
+-- name: CreateRoleAssignment :one
+-- Assigns the role to the subject, which is the id of a user or a service account. Nothing is inserted if neither
+-- exists.
+INSERT INTO
+	role_assignments (user_id, service_account_id, role_id, org_id, condition)
+SELECT
+	users.id,
+	service_accounts.id,
+	sqlc.arg('role_id')::UUID,
+	sqlc.narg('org_id')::UUID,
+	sqlc.narg('condition')::TEXT
+FROM
+	(
+		SELECT
+			sqlc.arg('subject')::UUID AS id
+	) AS subject
+	LEFT JOIN users ON users.id = subject.id
+	LEFT JOIN service_accounts ON service_accounts.id = subject.id
+WHERE
+	users.id IS NOT NULL
+	OR service_accounts.id IS NOT NULL
+RETURNING
+	*;
+
+-- name: DeleteRoleAssignment :execrows
+DELETE FROM role_assignments
+WHERE
+	(
+		user_id = sqlc.arg('subject')
+		OR service_account_id = sqlc.arg('subject')
+	)
+	AND role_id = sqlc.arg('role_id')
+	AND org_id IS NOT DISTINCT FROM sqlc.narg('org_id');
+
+-- name: ListRoleAssignmentsBySubject :many
+-- Lists the assignments of the subject, which is the id of a user or a service account.
+SELECT
+	*
+FROM
+	role_assignments
+WHERE
+	user_id = sqlc.arg('subject')
+	OR service_account_id = sqlc.arg('subject')
+ORDER BY
+	id;
+
+-- name: ListEffectivePermissions :many
+-- Lists the permissions the subject has in the organization through its global and organization assignments and all
+-- roles they inherit from, along with the assignment granting them and its condition. Without organization only global
+-- assignments count. UNION stops at roles already visited through the same assignment. Unconditional grants of a
+-- permission come first.
+WITH RECURSIVE
+	effective_roles (id, assignment_id) AS (
+		SELECT
+			role_id,
+			id
+		FROM
+			role_assignments
+		WHERE
+			(
+				role_assignments.user_id = sqlc.arg('subject')
+				OR role_assignments.service_account_id = sqlc.arg('subject')
+			)
+			AND (
+				role_assignments.org_id IS NULL
+				OR role_assignments.org_id = sqlc.narg('org_id')
+			)
+		UNION
+		SELECT
+			role_parents.parent_id,
+			effective_roles.assignment_id
+		FROM
+			role_parents
+			JOIN effective_roles ON role_parents.role_id = effective_roles.id
+	)
+SELECT DISTINCT
+	role_permissions.permission,
+	role_assignments.id AS assignment_id,
+	roles.name AS role_name,
+	role_assignments.org_id,
+	role_assignments.condition,
+	conditions.expression
+FROM
+	role_permissions
+	JOIN effective_roles ON role_permissions.role_id = effective_roles.id
+	JOIN role_assignments ON role_assignments.id = effective_roles.assignment_id
+	JOIN roles ON roles.id = role_assignments.role_id
+	LEFT JOIN conditions ON conditions.name = role_assignments.condition
+ORDER BY
+	role_permissions.permission,
+	role_assignments.condition NULLS FIRST,
+	role_assignments.id;
+
+-- name: ExplainPermission :many
+-- Lists the roles reached from each assignment of the subject which applies in the organization, along with the path of
+-- role ids leading to them and whether they grant the permission directly. Without organization only global
+-- assignments apply. Assignments are ordered like the grants of ListEffectivePermissions, and each path follows the
+-- path of its parent.
+WITH RECURSIVE
+	traversed (assignment_id, role_id, path) AS (
+		SELECT
+			id,
+			role_id,
+			ARRAY[role_id]
+		FROM
+			role_assignments
+		WHERE
+			(
+				role_assignments.user_id = sqlc.arg('subject')
+				OR role_assignments.service_account_id = sqlc.arg('subject')
+			)
+			AND (
+				role_assignments.org_id IS NULL
+				OR role_assignments.org_id = sqlc.narg('org_id')
+			)
+		UNION ALL
+		SELECT
+			traversed.assignment_id,
+			role_parents.parent_id,
+			traversed.path || role_parents.parent_id
+		FROM
+			role_parents
+			JOIN traversed ON role_parents.role_id = traversed.role_id
+		WHERE
+			NOT role_parents.parent_id = ANY (traversed.path)
+	)
+SELECT
+	role_assignments.id AS assignment_id,
+	role_assignments.org_id,
+	role_assignments.condition,
+	conditions.expression,
+	traversed.path::UUID[] AS path,
+	roles.name AS role_name,
+	EXISTS (
+		SELECT
+			1
+		FROM
+			role_permissions
+		WHERE
+			role_permissions.role_id = traversed.role_id
+			AND role_permissions.permission = sqlc.arg('permission')
+	) AS grants
+FROM
+	traversed
+	JOIN role_assignments ON role_assignments.id = traversed.assignment_id
+	JOIN roles ON roles.id = traversed.role_id
+	LEFT JOIN conditions ON conditions.name = role_assignments.condition
+ORDER BY
+	role_assignments.condition NULLS FIRST,
+	role_assignments.id,
+	traversed.path;
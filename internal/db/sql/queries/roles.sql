@@ -0,0 +1,120 @@
+-- name: CreateRole :one
+INSERT INTO
+	roles (name, description)
+VALUES
+	($1, $2)
+RETURNING
+	*;
+
+-- name: GetRoleByID :one
+SELECT
+	*
+FROM
+	roles
+WHERE
+	id = $1;
+
+-- name: ListRoles :many
+SELECT
+	*
+FROM
+	roles
+ORDER BY
+	name;
+
+-- name: UpdateRole :one
+UPDATE roles
+SET
+	name = COALESCE(sqlc.narg('name'), name),
+	description = COALESCE(sqlc.narg('description'), description),
+	updated_at = NOW()
+WHERE
+	id = sqlc.arg('id')
+RETURNING
+	*;
+
+-- name: DeleteRole :execrows
+DELETE FROM roles
+WHERE
+	id = $1;
+
+-- name: GrantRolePermission :exec
+INSERT INTO
+	role_permissions (role_id, permission)
+VALUES
+	($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: RevokeRolePermission :execrows
+DELETE FROM role_permissions
+WHERE
+	role_id = $1
+	AND permission = $2;
+
+-- name: ListRolePermissions :many
+-- Lists the permissions granted directly to roles, ordered by role and permission.
+SELECT
+	*
+FROM
+	role_permissions
+WHERE
+	role_id = ANY (sqlc.arg('role_ids')::UUID[])
+ORDER BY
+	role_id,
+	permission;
+
+-- name: LockRoleParents :exec
+-- Serializes changes of the role inheritance graph, so concurrent changes cannot create a cycle together.
+LOCK TABLE role_parents IN SHARE ROW EXCLUSIVE MODE;
+
+-- name: AddRoleParent :exec
+INSERT INTO
+	role_parents (role_id, parent_id)
+VALUES
+	($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveRoleParent :execrows
+DELETE FROM role_parents
+WHERE
+	role_id = $1
+	AND parent_id = $2;
+
+-- name: ListRoleParents :many
+-- Lists the direct parents of roles, ordered by role and parent.
+SELECT
+	*
+FROM
+	role_parents
+WHERE
+	role_id = ANY (sqlc.arg('role_ids')::UUID[])
+ORDER BY
+	role_id,
+	parent_id;
+
+-- name: IsRoleAncestor :one
+-- Reports whether the role inherits from the ancestor, directly or through other roles.
+WITH RECURSIVE
+	ancestors (id) AS (
+		SELECT
+			parent_id
+		FROM
+			role_parents
+		WHERE
+			role_parents.role_id = sqlc.arg('role_id')
+		UNION
+		SELECT
+			role_parents.parent_id
+		FROM
+			role_parents
+			JOIN ancestors ON role_parents.role_id = ancestors.id
+	)
+SELECT
+	EXISTS (
+		SELECT
+			1
+		FROM
+			ancestors
+		WHERE
+			id = sqlc.arg('ancestor_id')
+	)::BOOL AS is_ancestor;
@@ -0,0 +1,13 @@
+-- name: CreateServiceAccountAssertion :execrows
+-- Records the identifier of an accepted client assertion. No row is inserted if it was used before.
+INSERT INTO
+	service_account_assertions (service_account_id, jti, expires_at)
+VALUES
+	($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteExpiredServiceAccountAssertions :execrows
+-- Deletes assertion identifiers which expired before the given time, as their assertions are rejected anyway.
+DELETE FROM service_account_assertions
+WHERE
+	expires_at < sqlc.arg('before');
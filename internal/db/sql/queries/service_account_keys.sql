@@ -0,0 +1,32 @@
+-- name: CreateServiceAccountKey :one
+INSERT INTO
+	service_account_keys (service_account_id, key_id, algorithm, public_key)
+VALUES
+	($1, $2, $3, $4)
+RETURNING
+	*;
+
+-- name: GetServiceAccountKey :one
+SELECT
+	*
+FROM
+	service_account_keys
+WHERE
+	service_account_id = $1
+	AND key_id = $2;
+
+-- name: ListServiceAccountKeys :many
+SELECT
+	*
+FROM
+	service_account_keys
+WHERE
+	service_account_id = $1
+ORDER BY
+	id;
+
+-- name: DeleteServiceAccountKey :execrows
+DELETE FROM service_account_keys
+WHERE
+	service_account_id = $1
+	AND id = $2;
@@ -0,0 +1,57 @@
+-- name: CreateServiceAccount :one
+INSERT INTO
+	service_accounts (name, description, scopes, secret_hash)
+VALUES
+	($1, $2, $3, $4)
+RETURNING
+	*;
+
+-- name: GetServiceAccountByID :one
+SELECT
+	*
+FROM
+	service_accounts
+WHERE
+	id = $1;
+
+-- name: ListServiceAccounts :many
+SELECT
+	*
+FROM
+	service_accounts
+ORDER BY
+	id;
+
+-- name: UpdateServiceAccount :one
+UPDATE service_accounts
+SET
+	name = $2,
+	description = $3,
+	scopes = $4,
+	updated_at = NOW()
+WHERE
+	id = $1
+RETURNING
+	*;
+
+-- name: RotateServiceAccountSecret :one
+-- Replaces the secret hash of the account. The replaced hash stays valid until previous_expires_at, or not at all if it
+-- is null.
+UPDATE service_accounts
+SET
+	previous_secret_hash = CASE
+		WHEN sqlc.narg('previous_expires_at')::TIMESTAMPTZ IS NULL THEN NULL
+		ELSE secret_hash
+	END,
+	previous_expires_at = sqlc.narg('previous_expires_at'),
+	secret_hash = sqlc.arg('secret_hash'),
+	rotated_at = NOW()
+WHERE
+	id = sqlc.arg('id')
+RETURNING
+	*;
+
+-- name: DeleteServiceAccount :execrows
+DELETE FROM service_accounts
+WHERE
+	id = $1;
@@ -0,0 +1,96 @@
+-- name: CreateSession :one
+INSERT INTO
+	sessions (
+		user_id,
+		token_hash,
+		user_agent,
+		ip_address,
+		device,
+		idle_expires_at,
+		expires_at,
+		auth_methods
+	)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING
+	*;
+
+-- name: GetSessionByID :one
+SELECT
+	*
+FROM
+	sessions
+WHERE
+	id = $1;
+
+-- name: GetActiveSessionByTokenHash :one
+SELECT
+	*
+FROM
+	sessions
+WHERE
+	token_hash = $1
+	AND revoked_at IS NULL
+	AND expires_at > NOW()
+	AND idle_expires_at > NOW();
+
+-- name: TouchSession :one
+UPDATE sessions
+SET
+	last_seen_at = NOW(),
+	idle_expires_at = LEAST(sqlc.arg('idle_expires_at'), expires_at)
+WHERE
+	id = sqlc.arg('id')
+	AND revoked_at IS NULL
+RETURNING
+	*;
+
+-- name: ListActiveSessionsByUser :many
+SELECT
+	*
+FROM
+	sessions
+WHERE
+	user_id = $1
+	AND revoked_at IS NULL
+	AND expires_at > NOW()
+	AND idle_expires_at > NOW()
+ORDER BY
+	id DESC;
+
+-- name: RevokeSession :execrows
+UPDATE sessions
+SET
+	revoked_at = NOW(),
+	revoked_reason = $2
+WHERE
+	id = $1
+	AND revoked_at IS NULL;
+
+-- name: RevokeUserSessions :execrows
+-- Revokes all sessions of the user except the one with `except_id`.
+UPDATE sessions
+SET
+	revoked_at = NOW(),
+	revoked_reason = sqlc.arg('reason')
+WHERE
+	user_id = sqlc.arg('user_id')
+	AND id <> sqlc.arg('except_id')
+	AND revoked_at IS NULL
+	AND expires_at > NOW();
+
+-- name: CountActiveSessions :one
+SELECT
+	COUNT(*)
+FROM
+	sessions
+WHERE
+	revoked_at IS NULL
+	AND expires_at > NOW()
+	AND idle_expires_at > NOW();
+
+-- name: DeleteExpiredSessions :execrows
+-- Deletes sessions which expired or were revoked before the given time.
+DELETE FROM sessions
+WHERE
+	LEAST(expires_at, idle_expires_at, revoked_at) < sqlc.arg('before');
@@ -0,0 +1,51 @@
+-- name: ListValidSigningKeys :many
+SELECT
+	*
+FROM
+	signing_keys
+WHERE
+	expires_at IS NULL
+	OR expires_at > NOW()
+ORDER BY
+	activates_at DESC;
+
+-- name: GetLatestSigningKey :one
+SELECT
+	*
+FROM
+	signing_keys
+ORDER BY
+	activates_at DESC
+LIMIT
+	1;
+
+-- name: CreateSigningKey :exec
+INSERT INTO
+	signing_keys (
+		id,
+		algorithm,
+		private_key,
+		public_key,
+		activates_at
+	)
+VALUES
+	($1, $2, $3, $4, $5);
+
+-- name: ExpireSigningKeys :exec
+-- Schedules expiry of all keys except the one with `except_id` which do not expire already.
+UPDATE signing_keys
+SET
+	expires_at = sqlc.arg('expires_at')
+WHERE
+	id <> sqlc.arg('except_id')
+	AND expires_at IS NULL;
+
+-- name: DeleteExpiredSigningKeys :execrows
+DELETE FROM signing_keys
+WHERE
+	expires_at < sqlc.arg('before');
+
+-- name: LockSigningKeys :exec
+-- Serializes key rotation across instances for the duration of the transaction.
+SELECT
+	PG_ADVISORY_XACT_LOCK(HASHTEXT('guardian.signing_keys'));
@@ -0,0 +1,58 @@
+-- name: UpsertTOTPFactor :one
+-- Starts enrollment of a new TOTP factor, replacing an unconfirmed one. Returns no rows if a confirmed factor exists.
+INSERT INTO
+	totp_factors (user_id, secret)
+VALUES
+	($1, $2)
+ON CONFLICT (user_id) DO UPDATE
+SET
+	secret = EXCLUDED.secret,
+	confirmed_at = NULL,
+	last_used_step = 0,
+	created_at = NOW(),
+	updated_at = NOW()
+WHERE
+	totp_factors.confirmed_at IS NULL
+RETURNING
+	*;
+
+-- name: GetTOTPFactor :one
+SELECT
+	*
+FROM
+	totp_factors
+WHERE
+	user_id = $1;
+
+-- name: ConfirmTOTPFactor :execrows
+UPDATE totp_factors
+SET
+	confirmed_at = NOW(),
+	last_used_step = $2,
+	updated_at = NOW()
+WHERE
+	user_id = $1
+	AND confirmed_at IS NULL;
+
+-- name: UseTOTPStep :execrows
+-- Records the time step of an accepted code. Returns no rows if the step, or a later one, was already used.
+UPDATE totp_factors
+SET
+	last_used_step = $2,
+	updated_at = NOW()
+WHERE
+	user_id = $1
+	AND confirmed_at IS NOT NULL
+	AND last_used_step < $2;
+
+-- name: DeleteTOTPFactor :execrows
+DELETE FROM totp_factors
+WHERE
+	user_id = $1;
+
+-- name: DeleteUnconfirmedTOTPFactors :execrows
+-- Deletes enrollments which were not confirmed before the given time.
+DELETE FROM totp_factors
+WHERE
+	confirmed_at IS NULL
+	AND created_at < sqlc.arg('before');
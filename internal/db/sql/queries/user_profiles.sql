@@ -0,0 +1,50 @@
+-- name: GetUserProfile :one
+SELECT
+	*
+FROM
+	user_profiles
+WHERE
+	user_id = $1;
+
+-- name: UpsertUserProfile :one
+INSERT INTO
+	user_profiles (
+		user_id,
+		name,
+		given_name,
+		family_name,
+		nickname,
+		picture,
+		website,
+		birthdate,
+		zoneinfo,
+		locale,
+		phone_number,
+		street_address,
+		locality,
+		region,
+		postal_code,
+		country
+	)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+ON CONFLICT (user_id) DO UPDATE
+SET
+	name = EXCLUDED.name,
+	given_name = EXCLUDED.given_name,
+	family_name = EXCLUDED.family_name,
+	nickname = EXCLUDED.nickname,
+	picture = EXCLUDED.picture,
+	website = EXCLUDED.website,
+	birthdate = EXCLUDED.birthdate,
+	zoneinfo = EXCLUDED.zoneinfo,
+	locale = EXCLUDED.locale,
+	phone_number = EXCLUDED.phone_number,
+	street_address = EXCLUDED.street_address,
+	locality = EXCLUDED.locality,
+	region = EXCLUDED.region,
+	postal_code = EXCLUDED.postal_code,
+	country = EXCLUDED.country,
+	updated_at = NOW()
+RETURNING
+	*;
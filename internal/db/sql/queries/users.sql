@@ -0,0 +1,127 @@
+-- name: CreateUser :one
+INSERT INTO
+	users (email, username, status)
+VALUES
+	($1, $2, $3)
+RETURNING
+	*;
+
+-- name: GetUserByID :one
+SELECT
+	*
+FROM
+	users
+WHERE
+	id = $1
+	AND deleted_at IS NULL;
+
+-- name: GetUserByEmail :one
+SELECT
+	*
+FROM
+	users
+WHERE
+	email = $1
+	AND deleted_at IS NULL;
+
+-- name: GetUserByUsername :one
+SELECT
+	*
+FROM
+	users
+WHERE
+	username = $1
+	AND deleted_at IS NULL;
+
+-- name: UpdateUser :one
+-- Updates the given fields of the user. Changing the email resets its verification.
+UPDATE users
+SET
+	email = COALESCE(sqlc.narg('email'), email),
+	username = COALESCE(sqlc.narg('username'), username),
+	status = COALESCE(sqlc.narg('status'), status),
+	email_verified_at = CASE
+		WHEN sqlc.narg('email') IS NULL
+		OR sqlc.narg('email') = email THEN email_verified_at
+	END,
+	updated_at = NOW()
+WHERE
+	id = sqlc.arg('id')
+	AND deleted_at IS NULL
+RETURNING
+	*;
+
+-- name: MarkUserEmailVerified :one
+-- Marks the email of the user verified. Returns no rows if the email of the user changed in the meantime.
+UPDATE users
+SET
+	email_verified_at = COALESCE(email_verified_at, NOW()),
+	updated_at = NOW()
+WHERE
+	id = $1
+	AND email = $2
+	AND deleted_at IS NULL
+RETURNING
+	*;
+
+-- name: ChangeUserEmail :one
+-- Sets a new email which was verified by the user.
+UPDATE users
+SET
+	email = $2,
+	email_verified_at = NOW(),
+	updated_at = NOW()
+WHERE
+	id = $1
+	AND deleted_at IS NULL
+RETURNING
+	*;
+
+-- name: SoftDeleteUser :execrows
+UPDATE users
+SET
+	deleted_at = NOW(),
+	updated_at = NOW()
+WHERE
+	id = $1
+	AND deleted_at IS NULL;
+
+-- name: ListUsers :many
+SELECT
+	*
+FROM
+	users
+WHERE
+	deleted_at IS NULL
+	AND (
+		sqlc.narg('status')::user_status IS NULL
+		OR status = sqlc.narg('status')
+	)
+	AND (
+		sqlc.narg('after_id')::UUID IS NULL
+		OR id > sqlc.narg('after_id')
+	)
+ORDER BY
+	id
+LIMIT
+	sqlc.arg('limit');
+
+-- name: SearchUsers :many
+SELECT
+	*
+FROM
+	users
+WHERE
+	deleted_at IS NULL
+	AND (
+		email ILIKE sqlc.arg('pattern')::TEXT
+		OR username ILIKE sqlc.arg('pattern')::TEXT
+	)
+	AND (
+		sqlc.narg('after_id')::UUID IS NULL
+		OR id > sqlc.narg('after_id')
+	)
+ORDER BY
+	id
+LIMIT
+	sqlc.arg('limit');
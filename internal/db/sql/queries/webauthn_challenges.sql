@@ -0,0 +1,23 @@
+-- name: CreateWebAuthnChallenge :one
+INSERT INTO
+	webauthn_challenges (user_id, ceremony, session_data, expires_at)
+VALUES
+	($1, $2, $3, $4)
+RETURNING
+	*;
+
+-- name: ConsumeWebAuthnChallenge :one
+-- Deletes and returns an unexpired challenge, so that each challenge can be answered only once.
+DELETE FROM webauthn_challenges
+WHERE
+	id = $1
+	AND ceremony = $2
+	AND expires_at > NOW()
+RETURNING
+	*;
+
+-- name: DeleteExpiredWebAuthnChallenges :execrows
+-- Deletes challenges which expired before the given time.
+DELETE FROM webauthn_challenges
+WHERE
+	expires_at < sqlc.arg('before');
@@ -0,0 +1,20 @@
+CREATE TYPE user_status AS ENUM('active', 'suspended', 'disabled');
+
+CREATE TABLE users (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	email CITEXT NOT NULL,
+	username CITEXT NOT NULL,
+	status user_status NOT NULL DEFAULT 'active',
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	deleted_at TIMESTAMPTZ
+);
+
+-- Soft deleted users should not block reuse of their email or username.
+CREATE UNIQUE INDEX users_email_key ON users (email)
+WHERE
+	deleted_at IS NULL;
+
+CREATE UNIQUE INDEX users_username_key ON users (username)
+WHERE
+	deleted_at IS NULL;
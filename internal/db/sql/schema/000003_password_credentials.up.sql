@@ -0,0 +1,6 @@
+CREATE TABLE password_credentials (
+	user_id UUID PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+	hash TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
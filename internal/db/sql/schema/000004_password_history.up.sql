@@ -0,0 +1,8 @@
+CREATE TABLE password_history (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	hash TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX password_history_user_id_idx ON password_history (user_id, id DESC);
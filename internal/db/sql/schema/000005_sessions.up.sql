@@ -0,0 +1,20 @@
+CREATE TABLE sessions (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	token_hash BYTEA NOT NULL UNIQUE,
+	user_agent TEXT NOT NULL DEFAULT '',
+	ip_address INET,
+	device TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	last_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	idle_expires_at TIMESTAMPTZ NOT NULL,
+	expires_at TIMESTAMPTZ NOT NULL,
+	revoked_at TIMESTAMPTZ,
+	revoked_reason TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX sessions_user_id_idx ON sessions (user_id, id);
+
+CREATE INDEX sessions_active_idx ON sessions (idle_expires_at)
+WHERE
+	revoked_at IS NULL;
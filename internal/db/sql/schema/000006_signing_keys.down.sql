@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS signing_keys;
+
+DROP TYPE IF EXISTS signing_key_algorithm;
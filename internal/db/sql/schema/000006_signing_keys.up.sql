@@ -0,0 +1,18 @@
+CREATE TYPE signing_key_algorithm AS ENUM('EdDSA', 'ES256', 'RS256');
+
+CREATE TABLE signing_keys (
+	id TEXT PRIMARY KEY,
+	algorithm signing_key_algorithm NOT NULL,
+	-- PKCS #8 DER encoded private key, encrypted with the configured encryption keys and bound to the id.
+	private_key BYTEA NOT NULL,
+	-- PKIX DER encoded public key.
+	public_key BYTEA NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	-- Time from which the key is used for signing. Keys are published before activation so verifiers can fetch them
+	-- ahead of use.
+	activates_at TIMESTAMPTZ NOT NULL,
+	-- Time after which the key is neither published nor accepted. Set once a newer key replaces it.
+	expires_at TIMESTAMPTZ
+);
+
+CREATE INDEX signing_keys_activates_at_idx ON signing_keys (activates_at DESC);
@@ -0,0 +1,15 @@
+CREATE TABLE audit_events (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	type TEXT NOT NULL,
+	user_id UUID,
+	ip_address INET,
+	user_agent TEXT NOT NULL DEFAULT '',
+	metadata JSONB NOT NULL DEFAULT '{}',
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX audit_events_user_id_idx ON audit_events (user_id, id)
+WHERE
+	user_id IS NOT NULL;
+
+CREATE INDEX audit_events_type_idx ON audit_events (type, id);
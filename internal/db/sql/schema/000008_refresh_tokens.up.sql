@@ -0,0 +1,27 @@
+CREATE TABLE refresh_token_families (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	session_id UUID REFERENCES sessions (id) ON DELETE CASCADE,
+	client_id TEXT NOT NULL DEFAULT '',
+	scope TEXT[] NOT NULL DEFAULT '{}',
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	expires_at TIMESTAMPTZ NOT NULL,
+	revoked_at TIMESTAMPTZ,
+	revoked_reason TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX refresh_token_families_user_id_idx ON refresh_token_families (user_id);
+
+CREATE INDEX refresh_token_families_session_id_idx ON refresh_token_families (session_id);
+
+CREATE TABLE refresh_tokens (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	family_id UUID NOT NULL REFERENCES refresh_token_families (id) ON DELETE CASCADE,
+	parent_id UUID REFERENCES refresh_tokens (id) ON DELETE CASCADE,
+	token_hash BYTEA NOT NULL UNIQUE,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	expires_at TIMESTAMPTZ NOT NULL,
+	used_at TIMESTAMPTZ
+);
+
+CREATE INDEX refresh_tokens_family_id_idx ON refresh_tokens (family_id, id);
@@ -0,0 +1,30 @@
+CREATE TABLE totp_factors (
+	user_id UUID PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+	secret BYTEA NOT NULL,
+	confirmed_at TIMESTAMPTZ,
+	last_used_step BIGINT NOT NULL DEFAULT 0,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE recovery_codes (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	code_hash BYTEA NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	used_at TIMESTAMPTZ,
+	UNIQUE (user_id, code_hash)
+);
+
+CREATE TABLE mfa_challenges (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	token_hash BYTEA NOT NULL UNIQUE,
+	device TEXT NOT NULL DEFAULT '',
+	attempts INT NOT NULL DEFAULT 0,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	expires_at TIMESTAMPTZ NOT NULL,
+	completed_at TIMESTAMPTZ
+);
+
+CREATE INDEX mfa_challenges_expires_at_idx ON mfa_challenges (expires_at);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS webauthn_challenges;
+
+DROP TYPE IF EXISTS webauthn_ceremony;
+
+DROP TABLE IF EXISTS passkeys;
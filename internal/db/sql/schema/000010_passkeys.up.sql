@@ -0,0 +1,32 @@
+CREATE TABLE passkeys (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	credential_id BYTEA NOT NULL UNIQUE,
+	public_key BYTEA NOT NULL,
+	attestation_format TEXT NOT NULL,
+	aaguid UUID NOT NULL,
+	sign_count BIGINT NOT NULL DEFAULT 0,
+	transports TEXT[] NOT NULL DEFAULT '{}',
+	user_verified BOOLEAN NOT NULL DEFAULT FALSE,
+	backup_eligible BOOLEAN NOT NULL DEFAULT FALSE,
+	backup_state BOOLEAN NOT NULL DEFAULT FALSE,
+	name TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	last_used_at TIMESTAMPTZ
+);
+
+CREATE INDEX passkeys_user_id_idx ON passkeys (user_id, id);
+
+CREATE TYPE webauthn_ceremony AS ENUM('registration', 'login');
+
+CREATE TABLE webauthn_challenges (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	-- NULL for usernameless login with a discoverable credential.
+	user_id UUID REFERENCES users (id) ON DELETE CASCADE,
+	ceremony webauthn_ceremony NOT NULL,
+	session_data JSONB NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX webauthn_challenges_expires_at_idx ON webauthn_challenges (expires_at);
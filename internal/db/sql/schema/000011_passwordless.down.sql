@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS passwordless_challenges;
+
+DROP TYPE IF EXISTS passwordless_method;
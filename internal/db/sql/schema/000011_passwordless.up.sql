@@ -0,0 +1,19 @@
+CREATE TYPE passwordless_method AS ENUM('magic_link', 'email_otp');
+
+CREATE TABLE passwordless_challenges (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	method passwordless_method NOT NULL,
+	token_hash BYTEA NOT NULL UNIQUE,
+	-- Hash of the one-time code keyed with the token. NULL for magic links.
+	code_hash BYTEA,
+	device TEXT NOT NULL DEFAULT '',
+	attempts INT NOT NULL DEFAULT 0,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	expires_at TIMESTAMPTZ NOT NULL,
+	completed_at TIMESTAMPTZ
+);
+
+CREATE INDEX passwordless_challenges_user_id_idx ON passwordless_challenges (user_id, method);
+
+CREATE INDEX passwordless_challenges_expires_at_idx ON passwordless_challenges (expires_at);
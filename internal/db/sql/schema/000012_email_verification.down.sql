@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS email_verifications;
+
+DROP TYPE IF EXISTS email_verification_purpose;
+
+ALTER TABLE users
+DROP COLUMN IF EXISTS email_verified_at;
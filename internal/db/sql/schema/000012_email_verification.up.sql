@@ -0,0 +1,20 @@
+ALTER TABLE users
+ADD COLUMN email_verified_at TIMESTAMPTZ;
+
+CREATE TYPE email_verification_purpose AS ENUM('verify', 'change');
+
+CREATE TABLE email_verifications (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	purpose email_verification_purpose NOT NULL,
+	-- The address to verify. For changes this is the new address of the user.
+	email CITEXT NOT NULL,
+	token_hash BYTEA NOT NULL UNIQUE,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	expires_at TIMESTAMPTZ NOT NULL,
+	completed_at TIMESTAMPTZ
+);
+
+CREATE INDEX email_verifications_user_id_idx ON email_verifications (user_id, purpose, created_at);
+
+CREATE INDEX email_verifications_expires_at_idx ON email_verifications (expires_at);
@@ -0,0 +1,66 @@
+CREATE TABLE permissions (
+	name TEXT PRIMARY KEY,
+	description TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE roles (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	name CITEXT NOT NULL UNIQUE,
+	description TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE role_permissions (
+	role_id UUID NOT NULL REFERENCES roles (id) ON DELETE CASCADE,
+	permission TEXT NOT NULL REFERENCES permissions (name) ON DELETE CASCADE,
+	PRIMARY KEY (role_id, permission)
+);
+
+CREATE INDEX role_permissions_permission_idx ON role_permissions (permission);
+
+-- A role inherits all permissions of its parents. The application keeps the graph acyclic.
+CREATE TABLE role_parents (
+	role_id UUID NOT NULL REFERENCES roles (id) ON DELETE CASCADE,
+	parent_id UUID NOT NULL REFERENCES roles (id) ON DELETE CASCADE,
+	PRIMARY KEY (role_id, parent_id),
+	CHECK (role_id <> parent_id)
+);
+
+CREATE INDEX role_parents_parent_id_idx ON role_parents (parent_id);
+
+CREATE TABLE role_assignments (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	role_id UUID NOT NULL REFERENCES roles (id) ON DELETE CASCADE,
+	-- The organization the assignment is scoped to. NULL for global assignments, which apply in every organization.
+	org_id UUID,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	UNIQUE NULLS NOT DISTINCT (user_id, role_id, org_id)
+);
+
+CREATE INDEX role_assignments_role_id_idx ON role_assignments (role_id);
+
+INSERT INTO
+	permissions (name, description)
+VALUES
+	('guardian.authz.manage', 'Manage permissions, roles and role assignments.'),
+	('guardian.authz.check', 'Check the permissions of other users.');
+
+INSERT INTO
+	roles (name, description)
+VALUES
+	('guardian.admin', 'Administrates guardian.');
+
+INSERT INTO
+	role_permissions (role_id, permission)
+SELECT
+	roles.id,
+	permissions.name
+FROM
+	roles,
+	permissions
+WHERE
+	roles.name = 'guardian.admin'
+	AND permissions.name LIKE 'guardian.%';
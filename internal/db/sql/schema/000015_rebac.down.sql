@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS relation_tuples;
+
+DROP TABLE IF EXISTS relation_schemas;
+
+DROP TABLE IF EXISTS relation_revision;
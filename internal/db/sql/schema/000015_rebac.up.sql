@@ -0,0 +1,33 @@
+-- Single row holding the revision of relation tuples and schemas. Every write increments it while holding the row
+-- lock, so revisions are ordered like the commits of the writes.
+CREATE TABLE relation_revision (
+	id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+	revision BIGINT NOT NULL
+);
+
+INSERT INTO
+	relation_revision (revision)
+VALUES
+	(0);
+
+CREATE TABLE relation_schemas (
+	revision BIGINT PRIMARY KEY,
+	definition TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- A tuple relates an object to a subject, which is either an object itself or the set of subjects having a relation
+-- to an object when subject_relation is not empty.
+CREATE TABLE relation_tuples (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	namespace TEXT NOT NULL,
+	object_id TEXT NOT NULL,
+	relation TEXT NOT NULL,
+	subject_namespace TEXT NOT NULL,
+	subject_id TEXT NOT NULL,
+	subject_relation TEXT NOT NULL DEFAULT '',
+	created_revision BIGINT NOT NULL,
+	UNIQUE (namespace, object_id, relation, subject_namespace, subject_id, subject_relation)
+);
+
+CREATE INDEX relation_tuples_subject_idx ON relation_tuples (subject_namespace, subject_id, subject_relation);
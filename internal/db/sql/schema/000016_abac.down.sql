@@ -0,0 +1,7 @@
+ALTER TABLE relation_tuples
+DROP COLUMN IF EXISTS condition;
+
+ALTER TABLE role_assignments
+DROP COLUMN IF EXISTS condition;
+
+DROP TABLE IF EXISTS conditions;
@@ -0,0 +1,25 @@
+-- A condition is a CEL expression evaluated against the attributes of a check. The application compiles and type
+-- checks expressions before storing them.
+CREATE TABLE conditions (
+	name TEXT PRIMARY KEY,
+	expression TEXT NOT NULL,
+	description TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Role assignments and relation tuples with a condition only apply while it holds. Conditions cannot be deleted while
+-- they are required, as dropping them would widen access.
+ALTER TABLE role_assignments
+ADD COLUMN condition TEXT REFERENCES conditions (name);
+
+CREATE INDEX role_assignments_condition_idx ON role_assignments (condition)
+WHERE
+	condition IS NOT NULL;
+
+ALTER TABLE relation_tuples
+ADD COLUMN condition TEXT REFERENCES conditions (name);
+
+CREATE INDEX relation_tuples_condition_idx ON relation_tuples (condition)
+WHERE
+	condition IS NOT NULL;
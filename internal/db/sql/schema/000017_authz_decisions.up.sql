@@ -0,0 +1,17 @@
+-- Sampled authorization decisions, kept for a retention period to audit why checks were allowed or denied.
+CREATE TABLE authz_decisions (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	type TEXT NOT NULL,
+	subject TEXT NOT NULL,
+	action TEXT NOT NULL,
+	resource TEXT NOT NULL DEFAULT '',
+	allowed BOOLEAN NOT NULL,
+	policy TEXT NOT NULL DEFAULT '',
+	conditions TEXT[] NOT NULL DEFAULT '{}',
+	trace_id TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX authz_decisions_created_at_idx ON authz_decisions (created_at);
+
+CREATE INDEX authz_decisions_subject_idx ON authz_decisions (subject, id);
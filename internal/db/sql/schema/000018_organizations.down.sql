@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS organization_invitations;
+
+DROP TABLE IF EXISTS organization_memberships;
+
+DROP TYPE IF EXISTS organization_role;
+
+DROP TABLE IF EXISTS organizations;
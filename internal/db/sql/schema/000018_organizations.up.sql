@@ -0,0 +1,37 @@
+CREATE TABLE organizations (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	slug CITEXT NOT NULL UNIQUE,
+	name TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Owners manage the organization and its owners, admins manage members and invitations.
+CREATE TYPE organization_role AS ENUM('owner', 'admin', 'member');
+
+-- The application keeps at least one owner in every organization.
+CREATE TABLE organization_memberships (
+	org_id UUID NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	role organization_role NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (org_id, user_id)
+);
+
+CREATE INDEX organization_memberships_user_id_idx ON organization_memberships (user_id);
+
+-- Invitations are deleted once accepted or declined. An email has at most one pending invitation per organization.
+CREATE TABLE organization_invitations (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	org_id UUID NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+	email CITEXT NOT NULL,
+	role organization_role NOT NULL,
+	inviter_id UUID REFERENCES users (id) ON DELETE SET NULL,
+	token_hash BYTEA NOT NULL UNIQUE,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	expires_at TIMESTAMPTZ NOT NULL,
+	UNIQUE (org_id, email)
+);
+
+CREATE INDEX organization_invitations_expires_at_idx ON organization_invitations (expires_at);
@@ -0,0 +1,17 @@
+DROP POLICY tenant_isolation ON role_assignments;
+ALTER TABLE role_assignments NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE role_assignments DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY tenant_isolation ON organization_invitations;
+ALTER TABLE organization_invitations NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE organization_invitations DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY tenant_isolation ON organization_memberships;
+ALTER TABLE organization_memberships NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE organization_memberships DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY tenant_isolation ON organizations;
+ALTER TABLE organizations NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE organizations DISABLE ROW LEVEL SECURITY;
+
+DROP FUNCTION guardian_tenant_id;
@@ -0,0 +1,32 @@
+-- Row-level security isolates tenant scoped tables by the guardian.tenant_id setting. Transactions which set it only
+-- see and write rows of that organization. Without the setting, as for lookups across organizations and background
+-- tasks, all rows are visible. FORCE applies the policies to the owner of the tables as well, which the application
+-- connects as. Superusers and roles with BYPASSRLS are never restricted.
+CREATE FUNCTION guardian_tenant_id() RETURNS UUID LANGUAGE SQL STABLE AS $$
+	SELECT NULLIF(CURRENT_SETTING('guardian.tenant_id', TRUE), '')::UUID
+$$;
+
+ALTER TABLE organizations ENABLE ROW LEVEL SECURITY;
+ALTER TABLE organizations FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY tenant_isolation ON organizations
+	USING (guardian_tenant_id() IS NULL OR id = guardian_tenant_id());
+
+ALTER TABLE organization_memberships ENABLE ROW LEVEL SECURITY;
+ALTER TABLE organization_memberships FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY tenant_isolation ON organization_memberships
+	USING (guardian_tenant_id() IS NULL OR org_id = guardian_tenant_id());
+
+ALTER TABLE organization_invitations ENABLE ROW LEVEL SECURITY;
+ALTER TABLE organization_invitations FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY tenant_isolation ON organization_invitations
+	USING (guardian_tenant_id() IS NULL OR org_id = guardian_tenant_id());
+
+-- Global assignments apply in every organization, so they are visible to all tenants.
+ALTER TABLE role_assignments ENABLE ROW LEVEL SECURITY;
+ALTER TABLE role_assignments FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY tenant_isolation ON role_assignments
+	USING (guardian_tenant_id() IS NULL OR org_id IS NULL OR org_id = guardian_tenant_id());
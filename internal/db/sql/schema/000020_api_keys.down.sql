@@ -0,0 +1,5 @@
+DELETE FROM permissions
+WHERE
+	name = 'guardian.api_keys.manage';
+
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,50 @@
+-- API keys authenticate scripts and CI jobs on behalf of exactly one owner. The prefix is the visible part of the key
+-- which identifies it, the secret part is only stored hashed. Rotating a key keeps the hash of its previous secret
+-- valid until previous_expires_at, so deployments can switch keys without downtime.
+CREATE TABLE api_keys (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	name TEXT NOT NULL,
+	prefix TEXT NOT NULL UNIQUE,
+	secret_hash BYTEA NOT NULL,
+	previous_secret_hash BYTEA,
+	previous_expires_at TIMESTAMPTZ,
+	user_id UUID REFERENCES users (id) ON DELETE CASCADE,
+	org_id UUID REFERENCES organizations (id) ON DELETE CASCADE,
+	service_account_id UUID,
+	scopes TEXT[] NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	expires_at TIMESTAMPTZ,
+	last_used_at TIMESTAMPTZ,
+	rotated_at TIMESTAMPTZ,
+	CHECK (NUM_NONNULLS(user_id, org_id, service_account_id) = 1)
+);
+
+CREATE INDEX api_keys_user_id_idx ON api_keys (user_id);
+
+CREATE INDEX api_keys_org_id_idx ON api_keys (org_id);
+
+CREATE INDEX api_keys_service_account_id_idx ON api_keys (service_account_id);
+
+CREATE INDEX api_keys_expires_at_idx ON api_keys (expires_at);
+
+-- Keys of organizations are tenant scoped like the organizations themselves, see 000019_tenant_isolation.
+ALTER TABLE api_keys ENABLE ROW LEVEL SECURITY;
+ALTER TABLE api_keys FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY tenant_isolation ON api_keys
+	USING (guardian_tenant_id() IS NULL OR org_id IS NULL OR org_id = guardian_tenant_id());
+
+INSERT INTO
+	permissions (name, description)
+VALUES
+	('guardian.api_keys.manage', 'Manage API keys of service accounts.');
+
+INSERT INTO
+	role_permissions (role_id, permission)
+SELECT
+	id,
+	'guardian.api_keys.manage'
+FROM
+	roles
+WHERE
+	name = 'guardian.admin';
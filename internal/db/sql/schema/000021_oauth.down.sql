@@ -0,0 +1,11 @@
+DELETE FROM permissions
+WHERE
+	name = 'guardian.oauth_clients.manage';
+
+DROP TABLE IF EXISTS oauth_authorization_codes;
+
+DROP TABLE IF EXISTS oauth_authorization_requests;
+
+DROP TABLE IF EXISTS oauth_clients;
+
+DROP TYPE IF EXISTS oauth_client_type;
@@ -0,0 +1,64 @@
+CREATE TYPE oauth_client_type AS ENUM('public', 'confidential');
+
+-- OAuth clients are registered applications. Only confidential clients have a secret, which is only stored hashed.
+CREATE TABLE oauth_clients (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	name TEXT NOT NULL,
+	type oauth_client_type NOT NULL,
+	secret_hash BYTEA,
+	redirect_uris TEXT[] NOT NULL,
+	grant_types TEXT[] NOT NULL,
+	scopes TEXT[] NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	CHECK ((type = 'confidential') = (secret_hash IS NOT NULL))
+);
+
+-- Authorization requests are validated requests of the authorization endpoint waiting for the user to sign in and
+-- consent.
+CREATE TABLE oauth_authorization_requests (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	client_id UUID NOT NULL REFERENCES oauth_clients (id) ON DELETE CASCADE,
+	redirect_uri TEXT NOT NULL,
+	scope TEXT[] NOT NULL,
+	state TEXT NOT NULL DEFAULT '',
+	code_challenge TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX oauth_authorization_requests_expires_at_idx ON oauth_authorization_requests (expires_at);
+
+-- Authorization codes are single use. Used codes are kept until they expire, so a replayed code can revoke the
+-- refresh token family it was exchanged for.
+CREATE TABLE oauth_authorization_codes (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	code_hash BYTEA NOT NULL UNIQUE,
+	client_id UUID NOT NULL REFERENCES oauth_clients (id) ON DELETE CASCADE,
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	session_id UUID REFERENCES sessions (id) ON DELETE CASCADE,
+	redirect_uri TEXT NOT NULL,
+	scope TEXT[] NOT NULL,
+	code_challenge TEXT NOT NULL,
+	refresh_token_family_id UUID REFERENCES refresh_token_families (id) ON DELETE SET NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	expires_at TIMESTAMPTZ NOT NULL,
+	used_at TIMESTAMPTZ
+);
+
+CREATE INDEX oauth_authorization_codes_expires_at_idx ON oauth_authorization_codes (expires_at);
+
+INSERT INTO
+	permissions (name, description)
+VALUES
+	('guardian.oauth_clients.manage', 'Register and manage OAuth clients.');
+
+INSERT INTO
+	role_permissions (role_id, permission)
+SELECT
+	id,
+	'guardian.oauth_clients.manage'
+FROM
+	roles
+WHERE
+	name = 'guardian.admin';
@@ -0,0 +1,12 @@
+ALTER TABLE oauth_authorization_codes
+DROP COLUMN IF EXISTS nonce;
+
+ALTER TABLE oauth_authorization_requests
+DROP COLUMN IF EXISTS max_age,
+DROP COLUMN IF EXISTS prompt,
+DROP COLUMN IF EXISTS nonce;
+
+DROP TABLE IF EXISTS user_profiles;
+
+ALTER TABLE sessions
+DROP COLUMN IF EXISTS auth_methods;
@@ -0,0 +1,35 @@
+-- Authentication methods of RFC 8176 the session was created with, reported in the amr claim of ID tokens.
+ALTER TABLE sessions
+ADD COLUMN auth_methods TEXT[] NOT NULL DEFAULT '{}';
+
+-- User profiles hold the standard claims of OpenID Connect released by the profile, phone and address scopes. Empty
+-- strings are not released.
+CREATE TABLE user_profiles (
+	user_id UUID PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+	name TEXT NOT NULL DEFAULT '',
+	given_name TEXT NOT NULL DEFAULT '',
+	family_name TEXT NOT NULL DEFAULT '',
+	nickname TEXT NOT NULL DEFAULT '',
+	picture TEXT NOT NULL DEFAULT '',
+	website TEXT NOT NULL DEFAULT '',
+	birthdate TEXT NOT NULL DEFAULT '',
+	zoneinfo TEXT NOT NULL DEFAULT '',
+	locale TEXT NOT NULL DEFAULT '',
+	phone_number TEXT NOT NULL DEFAULT '',
+	street_address TEXT NOT NULL DEFAULT '',
+	locality TEXT NOT NULL DEFAULT '',
+	region TEXT NOT NULL DEFAULT '',
+	postal_code TEXT NOT NULL DEFAULT '',
+	country TEXT NOT NULL DEFAULT '',
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- The nonce is passed through to the ID token. Prompt and the maximum authentication age in seconds are enforced
+-- when the user answers the request.
+ALTER TABLE oauth_authorization_requests
+ADD COLUMN nonce TEXT NOT NULL DEFAULT '',
+ADD COLUMN prompt TEXT[] NOT NULL DEFAULT '{}',
+ADD COLUMN max_age INTEGER;
+
+ALTER TABLE oauth_authorization_codes
+ADD COLUMN nonce TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,25 @@
+DELETE FROM permissions
+WHERE
+	name = 'guardian.service_accounts.manage';
+
+DELETE FROM role_assignments
+WHERE
+	service_account_id IS NOT NULL;
+
+DROP INDEX IF EXISTS role_assignments_service_account_id_role_id_org_id_key;
+
+DROP INDEX IF EXISTS role_assignments_user_id_role_id_org_id_key;
+
+ALTER TABLE role_assignments
+DROP COLUMN IF EXISTS service_account_id,
+ALTER COLUMN user_id SET NOT NULL,
+ADD UNIQUE NULLS NOT DISTINCT (user_id, role_id, org_id);
+
+ALTER TABLE api_keys
+DROP CONSTRAINT IF EXISTS api_keys_service_account_id_fkey;
+
+DROP TABLE IF EXISTS service_account_assertions;
+
+DROP TABLE IF EXISTS service_account_keys;
+
+DROP TABLE IF EXISTS service_accounts;
@@ -0,0 +1,85 @@
+-- Service accounts are principals of backend jobs. They obtain access tokens with the client credentials grant, using
+-- their id as client_id and authenticating with a secret or with a JWT signed by one of their keys. The secret is only
+-- stored hashed. Rotating it keeps the hash of the previous secret valid until previous_expires_at.
+CREATE TABLE service_accounts (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	name TEXT NOT NULL UNIQUE,
+	description TEXT NOT NULL DEFAULT '',
+	scopes TEXT[] NOT NULL,
+	secret_hash BYTEA NOT NULL,
+	previous_secret_hash BYTEA,
+	previous_expires_at TIMESTAMPTZ,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	rotated_at TIMESTAMPTZ
+);
+
+-- Public keys verifying the client assertions of private_key_jwt authentication, stored as DER encoded
+-- SubjectPublicKeyInfo.
+CREATE TABLE service_account_keys (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	service_account_id UUID NOT NULL REFERENCES service_accounts (id) ON DELETE CASCADE,
+	key_id TEXT NOT NULL,
+	algorithm TEXT NOT NULL,
+	public_key BYTEA NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	UNIQUE (service_account_id, key_id)
+);
+
+-- Identifiers of accepted client assertions are kept until the assertions expire, so they cannot be replayed.
+CREATE TABLE service_account_assertions (
+	service_account_id UUID NOT NULL REFERENCES service_accounts (id) ON DELETE CASCADE,
+	jti TEXT NOT NULL,
+	expires_at TIMESTAMPTZ NOT NULL,
+	PRIMARY KEY (service_account_id, jti)
+);
+
+CREATE INDEX service_account_assertions_expires_at_idx ON service_account_assertions (expires_at);
+
+-- API keys could be issued to service accounts before they existed, so an account is created for each of them. Their
+-- secret is unknown until it is rotated.
+INSERT INTO
+	service_accounts (id, name, scopes, secret_hash)
+SELECT DISTINCT
+	service_account_id,
+	'service-account-' || service_account_id,
+	'{}',
+	SHA256(GEN_RANDOM_UUID()::TEXT::BYTEA)
+FROM
+	api_keys
+WHERE
+	service_account_id IS NOT NULL;
+
+ALTER TABLE api_keys
+ADD FOREIGN KEY (service_account_id) REFERENCES service_accounts (id) ON DELETE CASCADE;
+
+-- Roles are assigned to users or to service accounts. Each kind of principal has its own uniqueness, as the other
+-- column is null.
+ALTER TABLE role_assignments
+DROP CONSTRAINT role_assignments_user_id_role_id_org_id_key,
+ALTER COLUMN user_id DROP NOT NULL,
+ADD COLUMN service_account_id UUID REFERENCES service_accounts (id) ON DELETE CASCADE,
+ADD CHECK (NUM_NONNULLS(user_id, service_account_id) = 1);
+
+CREATE UNIQUE INDEX role_assignments_user_id_role_id_org_id_key ON role_assignments (user_id, role_id, org_id) NULLS NOT DISTINCT
+WHERE
+	user_id IS NOT NULL;
+
+CREATE UNIQUE INDEX role_assignments_service_account_id_role_id_org_id_key ON role_assignments (service_account_id, role_id, org_id) NULLS NOT DISTINCT
+WHERE
+	service_account_id IS NOT NULL;
+
+INSERT INTO
+	permissions (name, description)
+VALUES
+	('guardian.service_accounts.manage', 'Manage service accounts and their credentials.');
+
+INSERT INTO
+	role_permissions (role_id, permission)
+SELECT
+	id,
+	'guardian.service_accounts.manage'
+FROM
+	roles
+WHERE
+	name = 'guardian.admin';
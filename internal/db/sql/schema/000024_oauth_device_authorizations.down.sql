@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS oauth_device_authorizations;
+
+DROP TYPE IF EXISTS oauth_device_authorization_status;
@@ -0,0 +1,22 @@
+CREATE TYPE oauth_device_authorization_status AS ENUM('pending', 'approved', 'denied');
+
+-- Device authorizations are requests of the device authorization grant (RFC 8628). The device polls the token endpoint
+-- with its device code, which is only stored hashed, while the user enters the user code on the verification page.
+-- Answered authorizations are deleted when the device redeems them.
+CREATE TABLE oauth_device_authorizations (
+	id UUID PRIMARY KEY DEFAULT UUIDV7(),
+	device_code_hash BYTEA NOT NULL UNIQUE,
+	user_code TEXT NOT NULL UNIQUE,
+	client_id UUID NOT NULL REFERENCES oauth_clients (id) ON DELETE CASCADE,
+	scope TEXT[] NOT NULL,
+	status oauth_device_authorization_status NOT NULL DEFAULT 'pending',
+	user_id UUID REFERENCES users (id) ON DELETE CASCADE,
+	session_id UUID REFERENCES sessions (id) ON DELETE CASCADE,
+	poll_interval INTEGER NOT NULL,
+	polled_at TIMESTAMPTZ,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	expires_at TIMESTAMPTZ NOT NULL,
+	CHECK ((status = 'approved') = (user_id IS NOT NULL))
+);
+
+CREATE INDEX oauth_device_authorizations_expires_at_idx ON oauth_device_authorizations (expires_at);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS oauth_revoked_access_tokens;
+
+ALTER TABLE oauth_clients
+DROP COLUMN IF EXISTS audiences;
@@ -0,0 +1,12 @@
+-- Audiences of the resource servers a client acts as. The client may introspect access tokens issued for them.
+ALTER TABLE oauth_clients
+ADD COLUMN audiences TEXT[] NOT NULL DEFAULT '{}';
+
+-- Access tokens are signed and stay valid until they expire, so the identifiers of revoked ones are kept until then.
+CREATE TABLE oauth_revoked_access_tokens (
+	token_id TEXT PRIMARY KEY,
+	expires_at TIMESTAMPTZ NOT NULL,
+	revoked_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX oauth_revoked_access_tokens_expires_at_idx ON oauth_revoked_access_tokens (expires_at);
@@ -0,0 +1,3 @@
+DELETE FROM permissions
+WHERE
+	name = 'guardian.users.manage';
@@ -0,0 +1,14 @@
+INSERT INTO
+	permissions (name, description)
+VALUES
+	('guardian.users.manage', 'List users and manage all users, including their status and email.');
+
+INSERT INTO
+	role_permissions (role_id, permission)
+SELECT
+	id,
+	'guardian.users.manage'
+FROM
+	roles
+WHERE
+	name = 'guardian.admin';
@@ -0,0 +1,21 @@
+DROP POLICY tenant_isolation ON organizations;
+
+CREATE POLICY tenant_isolation ON organizations
+	USING (guardian_tenant_id() IS NULL OR id = guardian_tenant_id());
+
+DROP POLICY tenant_isolation ON organization_memberships;
+
+CREATE POLICY tenant_isolation ON organization_memberships
+	USING (guardian_tenant_id() IS NULL OR org_id = guardian_tenant_id());
+
+DROP POLICY tenant_isolation ON organization_invitations;
+
+CREATE POLICY tenant_isolation ON organization_invitations
+	USING (guardian_tenant_id() IS NULL OR org_id = guardian_tenant_id());
+
+DROP POLICY tenant_isolation ON role_assignments;
+
+CREATE POLICY tenant_isolation ON role_assignments
+	USING (guardian_tenant_id() IS NULL OR org_id IS NULL OR org_id = guardian_tenant_id());
+
+DROP FUNCTION guardian_bypass_rls();
@@ -0,0 +1,27 @@
+-- Tenant scoped tables fail closed: without the guardian.tenant_id setting they contain no rows, except for global
+-- role assignments. Work across organizations, like lookups by user and cleanups, has to lift the policies explicitly
+-- with the guardian.bypass_rls setting. Superusers and roles with BYPASSRLS are never restricted.
+CREATE FUNCTION guardian_bypass_rls() RETURNS BOOLEAN LANGUAGE SQL STABLE AS $$
+	SELECT COALESCE(NULLIF(CURRENT_SETTING('guardian.bypass_rls', TRUE), '')::BOOLEAN, FALSE)
+$$;
+
+DROP POLICY tenant_isolation ON organizations;
+
+CREATE POLICY tenant_isolation ON organizations
+	USING (guardian_bypass_rls() OR id = guardian_tenant_id());
+
+DROP POLICY tenant_isolation ON organization_memberships;
+
+CREATE POLICY tenant_isolation ON organization_memberships
+	USING (guardian_bypass_rls() OR org_id = guardian_tenant_id());
+
+DROP POLICY tenant_isolation ON organization_invitations;
+
+CREATE POLICY tenant_isolation ON organization_invitations
+	USING (guardian_bypass_rls() OR org_id = guardian_tenant_id());
+
+-- Global assignments apply in every organization, so they are visible to all tenants and without a tenant.
+DROP POLICY tenant_isolation ON role_assignments;
+
+CREATE POLICY tenant_isolation ON role_assignments
+	USING (guardian_bypass_rls() OR org_id IS NULL OR org_id = guardian_tenant_id());
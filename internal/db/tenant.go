@@ -0,0 +1,54 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// TenantSetting is the setting the row-level security policies of tenant scoped tables restrict rows by.
+const TenantSetting = "guardian.tenant_id"
+
+// BypassSetting is the setting which lifts the row-level security policies of tenant scoped tables. Without it or
+// [TenantSetting], tenant scoped tables contain no rows but global role assignments.
+const BypassSetting = "guardian.bypass_rls"
+
+// BeginTenantFunc runs fn in a transaction in which tenant scoped tables only contain the rows of the tenant
+// organization, like [pgx.BeginFunc]. The setting is local to the transaction, so it does not leak to other users of
+// the connection.
+func BeginTenantFunc(ctx context.Context, pool *pgxpool.Pool, tenant uuid.UUID, fn func(pgx.Tx) error) error {
+	return BeginTenantTxFunc(ctx, pool, pgx.TxOptions{}, tenant, fn)
+}
+
+// BeginTenantTxFunc is [BeginTenantFunc] with transaction options, like [pgx.BeginTxFunc].
+func BeginTenantTxFunc(ctx context.Context, pool *pgxpool.Pool, opts pgx.TxOptions, tenant uuid.UUID, fn func(pgx.Tx) error) error {
+	// A nil tenant would be an empty setting, which matches no organization.
+	if tenant == uuid.Nil {
+		return errors.New("db: tenant cannot be nil")
+	}
+
+	return pgx.BeginTxFunc(ctx, pool, opts, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, "SELECT SET_CONFIG($1, $2, TRUE)", TenantSetting, tenant.String()); err != nil {
+			return fmt.Errorf("db: set tenant: %w", err)
+		}
+
+		return fn(tx)
+	})
+}
+
+// BeginBypassFunc runs fn in a transaction in which tenant scoped tables contain the rows of all organizations, like
+// [pgx.BeginFunc]. It is meant for work across organizations, like lookups by user or token and cleanups. The setting
+// is local to the transaction, so it does not leak to other users of the connection.
+func BeginBypassFunc(ctx context.Context, pool *pgxpool.Pool, fn func(pgx.Tx) error) error {
+	return pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, "SELECT SET_CONFIG($1, 'on', TRUE)", BypassSetting); err != nil {
+			return fmt.Errorf("db: bypass row-level security: %w", err)
+		}
+
+		return fn(tx)
+	})
+}
@@ -0,0 +1,346 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	pgxmigrate "github.com/golang-migrate/migrate/v4/database/pgx/v5"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+// restrictedRole is the role tenant transactions switch to if the test connects as a role bypassing row-level
+// security, like the superuser of a throwaway database.
+const restrictedRole = "guardian_tenant_test"
+
+// migrationFactory migrates with a database connection of the test.
+type migrationFactory struct{ db *sql.DB }
+
+func (f migrationFactory) NewMigrate(sourceDriver string, src source.Driver, table string) (*migrate.Migrate, error) {
+	driver, err := pgxmigrate.WithInstance(f.db, &pgxmigrate.Config{MigrationsTable: table})
+	if err != nil {
+		return nil, err
+	}
+
+	return migrate.NewWithInstance(sourceDriver, src, "pgx5", driver)
+}
+
+// testPool connects to the database of GUARDIAN_TEST_POSTGRES_URI and migrates it. The test is skipped if it is not
+// set. The database should be a throwaway one, tests leave their rows behind. It reports whether the connection
+// bypasses row-level security.
+func testPool(t *testing.T) (*pgxpool.Pool, bool) {
+	t.Helper()
+
+	uri := os.Getenv("GUARDIAN_TEST_POSTGRES_URI")
+	if uri == "" {
+		t.Skip("GUARDIAN_TEST_POSTGRES_URI is not set")
+	}
+
+	ctx := context.Background()
+
+	config, err := pgxpool.ParseConfig(uri)
+	require.NoError(t, err)
+
+	sqlDB := stdlib.OpenDB(*config.ConnConfig)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	require.NoError(t, RunMigrations(migrationFactory{db: sqlDB}))
+
+	// A single connection makes sure settings of tenant transactions would be seen by later queries.
+	config.MaxConns = 1
+	config.AfterConnect = func(ctx context.Context, c *pgx.Conn) error {
+		types, err := c.LoadTypes(ctx, []string{"citext"})
+		if err != nil {
+			return err
+		}
+		c.TypeMap().RegisterTypes(types)
+		return nil
+	}
+
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	var bypass bool
+	err = pool.QueryRow(ctx, "SELECT rolsuper OR rolbypassrls FROM pg_roles WHERE rolname = CURRENT_USER").Scan(&bypass)
+	require.NoError(t, err)
+
+	if bypass {
+		_, err = pool.Exec(ctx, `DO $$ BEGIN
+			IF NOT EXISTS (SELECT FROM pg_roles WHERE rolname = '`+restrictedRole+`') THEN
+				CREATE ROLE `+restrictedRole+` NOLOGIN;
+			END IF;
+		END $$`)
+		require.NoError(t, err)
+
+		_, err = pool.Exec(ctx, "GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA public TO "+restrictedRole)
+		require.NoError(t, err)
+	}
+
+	return pool, bypass
+}
+
+func TestBeginTenantFuncNilTenant(t *testing.T) {
+	// The nil tenant is rejected before a transaction is begun.
+	err := BeginTenantFunc(context.Background(), nil, uuid.Nil, func(pgx.Tx) error {
+		t.Fatal("fn must not be called")
+		return nil
+	})
+	require.Error(t, err)
+}
+
+func TestBeginTenantFunc(t *testing.T) {
+	ctx := context.Background()
+	pool, bypass := testPool(t)
+	q := queries.New(pool)
+
+	// restricted runs fn with the queries of tx as a role subject to row-level security.
+	restricted := func(tx pgx.Tx, fn func(q *queries.Queries) error) error {
+		if bypass {
+			if _, err := tx.Exec(ctx, "SET LOCAL ROLE "+restrictedRole); err != nil {
+				return err
+			}
+		}
+
+		return fn(q.WithTx(tx))
+	}
+
+	inTenant := func(t *testing.T, tenant uuid.UUID, fn func(q *queries.Queries) error) error {
+		t.Helper()
+
+		return BeginTenantFunc(ctx, pool, tenant, func(tx pgx.Tx) error { return restricted(tx, fn) })
+	}
+
+	withoutTenant := func(t *testing.T, fn func(q *queries.Queries) error) error {
+		t.Helper()
+
+		return pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error { return restricted(tx, fn) })
+	}
+
+	acrossTenants := func(t *testing.T, fn func(q *queries.Queries) error) error {
+		t.Helper()
+
+		return BeginBypassFunc(ctx, pool, func(tx pgx.Tx) error { return restricted(tx, fn) })
+	}
+
+	suffix := uuid.NewString()[:8]
+
+	user, err := q.CreateUser(ctx, queries.CreateUserParams{
+		Email:    "tenant-" + suffix + "@example.com",
+		Username: "tenant-" + suffix,
+		Status:   queries.UserStatusActive,
+	})
+	require.NoError(t, err)
+
+	role, err := q.CreateRole(ctx, queries.CreateRoleParams{Name: "tenant-" + suffix})
+	require.NoError(t, err)
+
+	// Organizations are created across tenants, like the store does. The user is assigned the role globally and in
+	// both organizations.
+	orgs := make([]queries.Organization, 2)
+	err = acrossTenants(t, func(q *queries.Queries) error {
+		_, err := q.CreateRoleAssignment(ctx, queries.CreateRoleAssignmentParams{RoleID: role.ID, Subject: user.ID})
+		require.NoError(t, err)
+
+		for i := range orgs {
+			orgs[i], err = q.CreateOrganization(ctx, queries.CreateOrganizationParams{
+				Slug: "tenant-" + suffix + "-" + string(rune('a'+i)),
+				Name: "Tenant",
+			})
+			require.NoError(t, err)
+
+			_, err = q.CreateOrganizationMembership(ctx, queries.CreateOrganizationMembershipParams{
+				OrgID:  orgs[i].ID,
+				UserID: user.ID,
+				Role:   queries.OrganizationRoleOwner,
+			})
+			require.NoError(t, err)
+
+			_, err = q.UpsertOrganizationInvitation(ctx, queries.UpsertOrganizationInvitationParams{
+				OrgID:     orgs[i].ID,
+				Email:     "invitee-" + suffix + "@example.com",
+				Role:      queries.OrganizationRoleMember,
+				TokenHash: []byte(suffix + string(rune('a'+i))),
+				ExpiresAt: time.Now().Add(time.Hour),
+			})
+			require.NoError(t, err)
+
+			_, err = q.CreateRoleAssignment(ctx, queries.CreateRoleAssignmentParams{
+				RoleID:  role.ID,
+				OrgID:   &orgs[i].ID,
+				Subject: user.ID,
+			})
+			require.NoError(t, err)
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	own, other := orgs[0], orgs[1]
+
+	t.Run("reads rows of the tenant only", func(t *testing.T) {
+		err := inTenant(t, own.ID, func(q *queries.Queries) error {
+			got, err := q.GetOrganizationByID(ctx, own.ID)
+			require.NoError(t, err)
+			require.Equal(t, own.ID, got.ID)
+
+			_, err = q.GetOrganizationByID(ctx, other.ID)
+			require.ErrorIs(t, err, pgx.ErrNoRows)
+
+			_, err = q.GetOrganizationBySlug(ctx, other.Slug)
+			require.ErrorIs(t, err, pgx.ErrNoRows)
+
+			listed, err := q.ListOrganizationsByUser(ctx, user.ID)
+			require.NoError(t, err)
+			require.Len(t, listed, 1)
+			require.Equal(t, own.ID, listed[0].ID)
+
+			_, err = q.GetOrganizationMembership(ctx, queries.GetOrganizationMembershipParams{OrgID: other.ID, UserID: user.ID})
+			require.ErrorIs(t, err, pgx.ErrNoRows)
+
+			invitations, err := q.ListOrganizationInvitations(ctx, queries.ListOrganizationInvitationsParams{
+				OrgID: other.ID,
+				Now:   time.Now(),
+			})
+			require.NoError(t, err)
+			require.Empty(t, invitations)
+
+			assignments, err := q.ListRoleAssignmentsBySubject(ctx, user.ID)
+			require.NoError(t, err)
+			require.Len(t, assignments, 2)
+			for _, a := range assignments {
+				require.True(t, a.OrgID == nil || *a.OrgID == own.ID, "assignment of organization %v", a.OrgID)
+			}
+
+			return nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("reads no rows without a tenant", func(t *testing.T) {
+		err := withoutTenant(t, func(q *queries.Queries) error {
+			_, err := q.GetOrganizationByID(ctx, own.ID)
+			require.ErrorIs(t, err, pgx.ErrNoRows)
+
+			_, err = q.GetOrganizationBySlug(ctx, own.Slug)
+			require.ErrorIs(t, err, pgx.ErrNoRows)
+
+			listed, err := q.ListOrganizationsByUser(ctx, user.ID)
+			require.NoError(t, err)
+			require.Empty(t, listed)
+
+			_, err = q.GetOrganizationMembership(ctx, queries.GetOrganizationMembershipParams{OrgID: own.ID, UserID: user.ID})
+			require.ErrorIs(t, err, pgx.ErrNoRows)
+
+			invitations, err := q.ListOrganizationInvitations(ctx, queries.ListOrganizationInvitationsParams{
+				OrgID: own.ID,
+				Now:   time.Now(),
+			})
+			require.NoError(t, err)
+			require.Empty(t, invitations)
+
+			// Global assignments apply without an organization.
+			assignments, err := q.ListRoleAssignmentsBySubject(ctx, user.ID)
+			require.NoError(t, err)
+			require.Len(t, assignments, 1)
+			require.Nil(t, assignments[0].OrgID)
+
+			return nil
+		})
+		require.NoError(t, err)
+
+		err = withoutTenant(t, func(q *queries.Queries) error {
+			_, err := q.CreateOrganization(ctx, queries.CreateOrganizationParams{Slug: "tenant-" + suffix + "-c", Name: "Tenant"})
+			return err
+		})
+		require.True(t, hasCode(err, pgerrcode.InsufficientPrivilege), "expected a policy violation, got %v", err)
+	})
+
+	t.Run("reads rows of all tenants with the bypass", func(t *testing.T) {
+		err := acrossTenants(t, func(q *queries.Queries) error {
+			listed, err := q.ListOrganizationsByUser(ctx, user.ID)
+			require.NoError(t, err)
+			require.Len(t, listed, 2)
+
+			assignments, err := q.ListRoleAssignmentsBySubject(ctx, user.ID)
+			require.NoError(t, err)
+			require.Len(t, assignments, 3)
+
+			return nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("changes rows of the tenant only", func(t *testing.T) {
+		err := inTenant(t, own.ID, func(q *queries.Queries) error {
+			n, err := q.DeleteOrganization(ctx, other.ID)
+			require.NoError(t, err)
+			require.Zero(t, n)
+
+			n, err = q.DeleteOrganizationMembership(ctx, queries.DeleteOrganizationMembershipParams{OrgID: other.ID, UserID: user.ID})
+			require.NoError(t, err)
+			require.Zero(t, n)
+
+			return nil
+		})
+		require.NoError(t, err)
+
+		err = inTenant(t, own.ID, func(q *queries.Queries) error {
+			_, err := q.UpsertOrganizationInvitation(ctx, queries.UpsertOrganizationInvitationParams{
+				OrgID:     other.ID,
+				Email:     "intruder-" + suffix + "@example.com",
+				Role:      queries.OrganizationRoleOwner,
+				TokenHash: []byte(suffix + "intruder"),
+				ExpiresAt: time.Now().Add(time.Hour),
+			})
+			return err
+		})
+		require.True(t, hasCode(err, pgerrcode.InsufficientPrivilege), "expected a policy violation, got %v", err)
+	})
+
+	t.Run("does not leak the tenant", func(t *testing.T) {
+		err := inTenant(t, own.ID, func(*queries.Queries) error { return nil })
+		require.NoError(t, err)
+
+		err = acrossTenants(t, func(*queries.Queries) error { return nil })
+		require.NoError(t, err)
+
+		for _, name := range []string{TenantSetting, BypassSetting} {
+			var setting string
+			err = pool.QueryRow(ctx, "SELECT COALESCE(CURRENT_SETTING($1, TRUE), '')", name).Scan(&setting)
+			require.NoError(t, err)
+			require.Empty(t, setting, name)
+		}
+
+		// The connection would still see the organization of the tenant or all of them otherwise.
+		notFound := func(q *queries.Queries) error {
+			_, err := q.GetOrganizationByID(ctx, own.ID)
+			require.ErrorIs(t, err, pgx.ErrNoRows)
+			return nil
+		}
+
+		require.NoError(t, withoutTenant(t, notFound))
+
+		// Failed transactions roll the setting back as well.
+		failed := errors.New("failed")
+		err = inTenant(t, own.ID, func(*queries.Queries) error { return failed })
+		require.ErrorIs(t, err, failed)
+
+		err = acrossTenants(t, func(*queries.Queries) error { return failed })
+		require.ErrorIs(t, err, failed)
+
+		require.NoError(t, withoutTenant(t, notFound))
+	})
+}
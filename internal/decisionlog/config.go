@@ -0,0 +1,36 @@
+package decisionlog
+
+import (
+	"errors"
+	"time"
+)
+
+// Sinks decisions can be recorded to.
+const (
+	SinkNone     = "none"
+	SinkPostgres = "postgres"
+	SinkLog      = "log"
+)
+
+type Config struct {
+	Sink             string        `help:"Where sampled authorization decisions are recorded. postgres stores them in the authz_decisions table and log writes them to the application log." name:"sink" env:"SINK" enum:"none,postgres,log" default:"none"`
+	SampleRate       float64       `help:"Fraction of allowed decisions which are recorded." name:"sample_rate" env:"SAMPLE_RATE" default:"0.01"`
+	DeniedSampleRate float64       `help:"Fraction of denied decisions which are recorded." name:"denied_sample_rate" env:"DENIED_SAMPLE_RATE" default:"1"`
+	Retention        time.Duration `help:"Duration decisions recorded to postgres are kept." name:"retention" env:"RETENTION" default:"720h"`
+}
+
+func (c Config) validate() error {
+	if c.SampleRate < 0 || c.SampleRate > 1 {
+		return errors.New("decisionlog: SampleRate must be between 0 and 1")
+	}
+
+	if c.DeniedSampleRate < 0 || c.DeniedSampleRate > 1 {
+		return errors.New("decisionlog: DeniedSampleRate must be between 0 and 1")
+	}
+
+	if c.Retention <= 0 {
+		return errors.New("decisionlog: Retention cannot be zero or negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+// Package decisionlog records sampled authorization decisions.
+package decisionlog
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+// Log is a [core.DecisionLog] recording a sample of decisions to the configured sink. Denied decisions are sampled
+// separately, as they are usually the ones worth investigating.
+type Log struct {
+	config Config
+	q      queries.Querier
+	logger zerolog.Logger
+	sample func() float64
+	now    func() time.Time
+}
+
+var _ core.DecisionLog = (*Log)(nil)
+
+// NewLog constructs new [Log]. Decisions are written to logger if the sink is [SinkLog].
+func NewLog(pool *pgxpool.Pool, config Config, logger zerolog.Logger) (*Log, error) {
+	return newLog(queries.New(pool), config, logger)
+}
+
+func newLog(q queries.Querier, config Config, logger zerolog.Logger) (*Log, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return &Log{config: config, q: q, logger: logger, sample: rand.Float64, now: time.Now}, nil
+}
+
+// Record implements [core.DecisionLog].
+func (l *Log) Record(ctx context.Context, record core.DecisionRecord) error {
+	if !l.sampled(record.Decision.Allowed) {
+		return nil
+	}
+
+	switch l.config.Sink {
+	case SinkPostgres:
+		var traceID string
+		if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+			traceID = sc.TraceID().String()
+		}
+
+		conditions := record.Decision.Conditions
+		if conditions == nil {
+			conditions = []string{}
+		}
+
+		if err := l.q.CreateAuthzDecision(ctx, queries.CreateAuthzDecisionParams{
+			Type:       string(record.Type),
+			Subject:    record.Subject,
+			Action:     record.Action,
+			Resource:   record.Resource,
+			Allowed:    record.Decision.Allowed,
+			Policy:     record.Decision.Policy,
+			Conditions: conditions,
+			TraceID:    traceID,
+		}); err != nil {
+			return fmt.Errorf("decisionlog: create authz decision: %w", err)
+		}
+	case SinkLog:
+		// The context carries the span, so the tracing hook of the logger adds its ids.
+		l.logger.Info().
+			Ctx(ctx).
+			Str("type", string(record.Type)).
+			Str("subject", record.Subject).
+			Str("action", record.Action).
+			Str("resource", record.Resource).
+			Bool("allowed", record.Decision.Allowed).
+			Str("policy", record.Decision.Policy).
+			Strs("conditions", record.Decision.Conditions).
+			Msg("authorization decision")
+	}
+
+	return nil
+}
+
+func (l *Log) sampled(allowed bool) bool {
+	if l.config.Sink == SinkNone {
+		return false
+	}
+
+	rate := l.config.SampleRate
+	if !allowed {
+		rate = l.config.DeniedSampleRate
+	}
+
+	return l.sample() < rate
+}
+
+// DeleteExpired deletes decisions recorded to postgres before the retention period.
+func (l *Log) DeleteExpired(ctx context.Context) (int64, error) {
+	if l.config.Sink != SinkPostgres {
+		return 0, nil
+	}
+
+	n, err := l.q.DeleteExpiredAuthzDecisions(ctx, l.now().Add(-l.config.Retention))
+	if err != nil {
+		return 0, fmt.Errorf("decisionlog: delete expired authz decisions: %w", err)
+	}
+
+	return n, nil
+}
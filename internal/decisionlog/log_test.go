@@ -0,0 +1,136 @@
+package decisionlog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+// fakeQuerier records decisions in memory. Queries not used by [Log] panic.
+type fakeQuerier struct {
+	queries.Querier
+
+	decisions []queries.CreateAuthzDecisionParams
+	before    time.Time
+}
+
+func (f *fakeQuerier) CreateAuthzDecision(_ context.Context, arg queries.CreateAuthzDecisionParams) error {
+	f.decisions = append(f.decisions, arg)
+	return nil
+}
+
+func (f *fakeQuerier) DeleteExpiredAuthzDecisions(_ context.Context, before time.Time) (int64, error) {
+	f.before = before
+	return int64(len(f.decisions)), nil
+}
+
+var (
+	allowed = core.DecisionRecord{
+		Type:     core.DecisionPermission,
+		Subject:  "0199a2a4-6c1e-7d4e-8f00-000000000001",
+		Action:   "documents.read",
+		Decision: core.Decision{Allowed: true, Policy: "role:reader", Conditions: []string{"office_hours"}},
+	}
+	denied = core.DecisionRecord{
+		Type:     core.DecisionRelation,
+		Subject:  "user:ada",
+		Action:   "viewer",
+		Resource: "document:readme",
+	}
+)
+
+func TestLog(t *testing.T) {
+	ctx := context.Background()
+
+	config := Config{Sink: SinkPostgres, SampleRate: 0.5, DeniedSampleRate: 1, Retention: time.Hour}
+
+	t.Run("sampling", func(t *testing.T) {
+		q := &fakeQuerier{}
+
+		l, err := newLog(q, config, zerolog.Nop())
+		require.NoError(t, err)
+
+		for _, sample := range []float64{0.2, 0.7} {
+			l.sample = func() float64 { return sample }
+
+			require.NoError(t, l.Record(ctx, allowed))
+			require.NoError(t, l.Record(ctx, denied))
+		}
+
+		// Allowed decisions are only recorded below the sample rate, denied ones always.
+		require.Len(t, q.decisions, 3)
+		require.Equal(t, queries.CreateAuthzDecisionParams{
+			Type:       "permission",
+			Subject:    allowed.Subject,
+			Action:     "documents.read",
+			Allowed:    true,
+			Policy:     "role:reader",
+			Conditions: []string{"office_hours"},
+		}, q.decisions[0])
+		require.Equal(t, []string{}, q.decisions[1].Conditions)
+		require.False(t, q.decisions[2].Allowed)
+	})
+
+	t.Run("trace id", func(t *testing.T) {
+		q := &fakeQuerier{}
+
+		l, err := newLog(q, config, zerolog.Nop())
+		require.NoError(t, err)
+
+		traceID := trace.TraceID{1, 2, 3}
+		ctx := trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: traceID,
+			SpanID:  trace.SpanID{4},
+		}))
+
+		require.NoError(t, l.Record(ctx, denied))
+		require.Equal(t, traceID.String(), q.decisions[0].TraceID)
+	})
+
+	t.Run("log sink", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		l, err := newLog(&fakeQuerier{}, Config{Sink: SinkLog, SampleRate: 1, DeniedSampleRate: 1, Retention: time.Hour}, zerolog.New(&buf))
+		require.NoError(t, err)
+
+		require.NoError(t, l.Record(ctx, allowed))
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+		require.Equal(t, "authorization decision", entry["message"])
+		require.Equal(t, "documents.read", entry["action"])
+		require.Equal(t, true, entry["allowed"])
+		require.Equal(t, []any{"office_hours"}, entry["conditions"])
+
+		n, err := l.DeleteExpired(ctx)
+		require.NoError(t, err)
+		require.Zero(t, n)
+	})
+
+	t.Run("delete expired", func(t *testing.T) {
+		q := &fakeQuerier{}
+		now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+		l, err := newLog(q, config, zerolog.Nop())
+		require.NoError(t, err)
+		l.now = func() time.Time { return now }
+
+		_, err = l.DeleteExpired(ctx)
+		require.NoError(t, err)
+		require.Equal(t, now.Add(-time.Hour), q.before)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := newLog(&fakeQuerier{}, Config{Sink: SinkLog, SampleRate: 2, Retention: time.Hour}, zerolog.Nop())
+		require.Error(t, err)
+	})
+}
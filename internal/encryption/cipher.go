@@ -0,0 +1,116 @@
+// Package encryption encrypts small secrets, such as TOTP secrets and signing keys, which have to be stored
+// recoverably.
+//
+// Ciphertexts are AES-256-GCM sealed and prefixed with a version and the id of the key, so keys can be rotated by
+// prepending a new key to the configuration while older keys keep decrypting existing data.
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+)
+
+const (
+	keySize   = 32
+	keyIDSize = 8
+	version   = 1
+)
+
+// ErrDecrypt is returned when a ciphertext is malformed, was encrypted with an unknown key or fails authentication.
+var ErrDecrypt = errors.New("encryption: decrypt failed")
+
+type keyID [keyIDSize]byte
+
+// Cipher encrypts and authenticates data with the configured keys.
+type Cipher struct {
+	primary keyID
+	aeads   map[keyID]cipher.AEAD
+}
+
+// NewCipher constructs new [Cipher].
+func NewCipher(config Config) (*Cipher, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	c := &Cipher{aeads: make(map[keyID]cipher.AEAD, len(config.Keys))}
+
+	for i := range config.Keys {
+		k, err := config.key(i)
+		if err != nil {
+			return nil, err
+		}
+
+		block, err := aes.NewCipher(k)
+		if err != nil {
+			return nil, fmt.Errorf("encryption: new aes cipher: %w", err)
+		}
+
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("encryption: new gcm: %w", err)
+		}
+
+		id := idOf(k)
+		if i == 0 {
+			c.primary = id
+		}
+
+		c.aeads[id] = aead
+	}
+
+	return c, nil
+}
+
+// Encrypt encrypts plaintext with the primary key. additionalData is authenticated but not encrypted, it binds the
+// ciphertext to its context, e.g. the row it is stored in, and must be passed to [Cipher.Decrypt] as well.
+func (c *Cipher) Encrypt(plaintext, additionalData []byte) []byte {
+	aead := c.aeads[c.primary]
+
+	out := make([]byte, 1+keyIDSize+aead.NonceSize(), 1+keyIDSize+aead.NonceSize()+len(plaintext)+aead.Overhead())
+	out[0] = version
+	copy(out[1:], c.primary[:])
+
+	nonce := out[1+keyIDSize:]
+	rand.Read(nonce)
+
+	return aead.Seal(out, nonce, plaintext, additionalData)
+}
+
+// Decrypt decrypts ciphertext produced by [Cipher.Encrypt] with any configured key. It returns [ErrDecrypt] on
+// failure.
+func (c *Cipher) Decrypt(ciphertext, additionalData []byte) ([]byte, error) {
+	if len(ciphertext) < 1+keyIDSize || ciphertext[0] != version {
+		return nil, ErrDecrypt
+	}
+
+	var id keyID
+	copy(id[:], ciphertext[1:])
+
+	aead, ok := c.aeads[id]
+	if !ok {
+		return nil, ErrDecrypt
+	}
+
+	rest := ciphertext[1+keyIDSize:]
+	if len(rest) < aead.NonceSize()+aead.Overhead() {
+		return nil, ErrDecrypt
+	}
+
+	plaintext, err := aead.Open(nil, rest[:aead.NonceSize()], rest[aead.NonceSize():], additionalData)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+
+	return plaintext, nil
+}
+
+// idOf derives the id of key. It does not reveal anything useful about key.
+func idOf(key []byte) keyID {
+	h := sha256.Sum256(key)
+	return keyID(h[:keyIDSize])
+}
@@ -0,0 +1,74 @@
+package encryption
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newKey() string {
+	b := make([]byte, keySize)
+	rand.Read(b)
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func TestCipher(t *testing.T) {
+	oldKey, newKeyB64 := newKey(), newKey()
+
+	old, err := NewCipher(Config{Keys: []string{oldKey}})
+	require.NoError(t, err)
+
+	ciphertext := old.Encrypt([]byte("secret"), []byte("user-1"))
+
+	t.Run("round-trip", func(t *testing.T) {
+		plaintext, err := old.Decrypt(ciphertext, []byte("user-1"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("secret"), plaintext)
+	})
+
+	t.Run("random-nonce", func(t *testing.T) {
+		require.NotEqual(t, ciphertext, old.Encrypt([]byte("secret"), []byte("user-1")))
+	})
+
+	t.Run("wrong-additional-data", func(t *testing.T) {
+		_, err := old.Decrypt(ciphertext, []byte("user-2"))
+		require.ErrorIs(t, err, ErrDecrypt)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		tampered := append([]byte(nil), ciphertext...)
+		tampered[len(tampered)-1] ^= 1
+
+		_, err := old.Decrypt(tampered, []byte("user-1"))
+		require.ErrorIs(t, err, ErrDecrypt)
+
+		_, err = old.Decrypt(ciphertext[:5], []byte("user-1"))
+		require.ErrorIs(t, err, ErrDecrypt)
+	})
+
+	t.Run("rotation", func(t *testing.T) {
+		rotated, err := NewCipher(Config{Keys: []string{newKeyB64, oldKey}})
+		require.NoError(t, err)
+
+		plaintext, err := rotated.Decrypt(ciphertext, []byte("user-1"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("secret"), plaintext)
+
+		// New data is encrypted with the new key only.
+		_, err = old.Decrypt(rotated.Encrypt([]byte("secret"), nil), nil)
+		require.ErrorIs(t, err, ErrDecrypt)
+	})
+}
+
+func TestConfigValidate(t *testing.T) {
+	_, err := NewCipher(Config{})
+	require.Error(t, err)
+
+	_, err = NewCipher(Config{Keys: []string{"not base64!"}})
+	require.Error(t, err)
+
+	_, err = NewCipher(Config{Keys: []string{base64.StdEncoding.EncodeToString([]byte("short"))}})
+	require.Error(t, err)
+}
@@ -0,0 +1,39 @@
+package encryption
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+type Config struct {
+	Keys []string `help:"Base64 encoded 32 byte AES-256 keys. The first key encrypts new data, every key decrypts, which allows rotating keys." name:"keys" env:"KEYS"`
+}
+
+func (c Config) validate() error {
+	if len(c.Keys) == 0 {
+		return errors.New("encryption: Keys cannot be empty")
+	}
+
+	for i := range c.Keys {
+		if _, err := c.key(i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// key decodes the i-th key.
+func (c Config) key(i int) ([]byte, error) {
+	b, err := base64.StdEncoding.DecodeString(c.Keys[i])
+	if err != nil {
+		return nil, fmt.Errorf("encryption: decode key %d: %w", i, err)
+	}
+
+	if len(b) != keySize {
+		return nil, fmt.Errorf("encryption: key %d must be %d bytes, got %d", i, keySize, len(b))
+	}
+
+	return b, nil
+}
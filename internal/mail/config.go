@@ -0,0 +1,81 @@
+package mail
+
+import (
+	"errors"
+	"net/mail"
+	"time"
+)
+
+type Config struct {
+	Host               string        `help:"Host of the SMTP server." name:"host" env:"HOST" default:"localhost"`
+	Port               int           `help:"Port of the SMTP server." name:"port" env:"PORT" default:"587"`
+	Username           string        `help:"Username to authenticate to the SMTP server with. Authentication is skipped if empty." name:"username" env:"USERNAME"`
+	Password           string        `help:"Password to authenticate to the SMTP server with." name:"password" env:"PASSWORD"`
+	TLS                string        `help:"How connections are secured. starttls requires the server to support STARTTLS, tls connects with implicit TLS and none sends in plain text." name:"tls" env:"TLS" enum:"starttls,tls,none" default:"starttls"`
+	InsecureSkipVerify bool          `help:"Skip verification of the certificate of the SMTP server." name:"insecure_skip_verify" env:"INSECURE_SKIP_VERIFY" default:"false"`
+	From               string        `help:"Sender address of emails, optionally with a display name." name:"from" env:"FROM" default:"Guardian <no-reply@localhost>"`
+	Timeout            time.Duration `help:"Timeout for connecting to the SMTP server and sending an email." name:"timeout" env:"TIMEOUT" default:"10s"`
+	MaxIdleConns       int           `help:"Maximum number of idle connections kept open for reuse." name:"max_idle_conns" env:"MAX_IDLE_CONNS" default:"2"`
+	IdleTimeout        time.Duration `help:"Duration after which idle connections are closed." name:"idle_timeout" env:"IDLE_TIMEOUT" default:"30s"`
+}
+
+func (c Config) validate() error {
+	if c.Host == "" {
+		return errors.New("mail: Host cannot be empty")
+	}
+
+	if c.Port <= 0 || c.Port > 65535 {
+		return errors.New("mail: Port must be between 1 and 65535")
+	}
+
+	switch c.TLS {
+	case tlsStartTLS, tlsImplicit, tlsNone:
+	default:
+		return errors.New("mail: TLS must be one of starttls, tls or none")
+	}
+
+	if _, err := mail.ParseAddress(c.From); err != nil {
+		return errors.New("mail: From must be a valid address")
+	}
+
+	if c.Timeout <= 0 {
+		return errors.New("mail: Timeout cannot be zero or negative")
+	}
+
+	if c.MaxIdleConns < 0 {
+		return errors.New("mail: MaxIdleConns cannot be negative")
+	}
+
+	if c.IdleTimeout <= 0 {
+		return errors.New("mail: IdleTimeout cannot be zero or negative")
+	}
+
+	return nil
+}
+
+type QueueConfig struct {
+	Workers      int           `help:"Number of emails sent concurrently." name:"workers" env:"WORKERS" default:"4"`
+	Size         int           `help:"Maximum number of emails waiting to be sent. Further emails are dropped." name:"size" env:"SIZE" default:"1000"`
+	Timeout      time.Duration `help:"Timeout for preparing and sending a single email." name:"timeout" env:"TIMEOUT" default:"1m"`
+	DrainTimeout time.Duration `help:"Maximum duration to send waiting emails on shutdown, after which their sending is canceled." name:"drain_timeout" env:"DRAIN_TIMEOUT" default:"30s"`
+}
+
+func (c QueueConfig) validate() error {
+	if c.Workers <= 0 {
+		return errors.New("mail: Workers cannot be zero or negative")
+	}
+
+	if c.Size < 0 {
+		return errors.New("mail: Size cannot be negative")
+	}
+
+	if c.Timeout <= 0 {
+		return errors.New("mail: Timeout cannot be zero or negative")
+	}
+
+	if c.DrainTimeout <= 0 {
+		return errors.New("mail: DrainTimeout cannot be zero or negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package mail
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/secret"
+)
+
+// message encodes email as RFC 5322 message. Bodies are quoted-printable encoded, an HTML body is sent as
+// multipart/alternative with the text body as fallback.
+func message(from, to *mail.Address, email core.Email, now time.Time) []byte {
+	var b bytes.Buffer
+
+	header := func(key, value string) {
+		fmt.Fprintf(&b, "%s: %s\r\n", key, value)
+	}
+
+	header("From", from.String())
+	header("To", to.String())
+	header("Subject", mime.QEncoding.Encode("utf-8", email.Subject))
+	header("Date", now.Format(time.RFC1123Z))
+	header("Message-ID", "<"+secret.New(16)+"@"+domain(from.Address)+">")
+	header("MIME-Version", "1.0")
+
+	if email.HTML == "" {
+		header("Content-Type", "text/plain; charset=utf-8")
+		header("Content-Transfer-Encoding", "quoted-printable")
+		b.WriteString("\r\n")
+		writeQuotedPrintable(&b, email.Text)
+
+		return b.Bytes()
+	}
+
+	w := multipart.NewWriter(&b)
+	header("Content-Type", mime.FormatMediaType("multipart/alternative", map[string]string{"boundary": w.Boundary()}))
+	b.WriteString("\r\n")
+
+	for _, part := range []struct{ contentType, body string }{
+		{"text/plain; charset=utf-8", email.Text},
+		{"text/html; charset=utf-8", email.HTML},
+	} {
+		//nolint:errcheck // Writes to a bytes.Buffer do not fail.
+		pw, _ := w.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		writeQuotedPrintable(pw, part.body)
+	}
+
+	//nolint:errcheck // Writes to a bytes.Buffer do not fail.
+	w.Close()
+
+	return b.Bytes()
+}
+
+func writeQuotedPrintable(w io.Writer, s string) {
+	qw := quotedprintable.NewWriter(w)
+	//nolint:errcheck // Writes to a bytes.Buffer do not fail.
+	qw.Write([]byte(s))
+	//nolint:errcheck // Writes to a bytes.Buffer do not fail.
+	qw.Close()
+}
+
+// domain returns the domain of address, which is used for message IDs.
+func domain(address string) string {
+	if i := strings.LastIndexByte(address, '@'); i >= 0 {
+		return address[i+1:]
+	}
+
+	return "localhost"
+}
@@ -0,0 +1,124 @@
+package mail
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Queue sends emails after the request causing them was answered, so neither the response nor its timing depends on
+// whether an email is sent. A fixed number of workers runs the queued jobs. Jobs are dropped while the queue is full,
+// which bounds the work requests can cause.
+type Queue struct {
+	config QueueConfig
+	jobs   chan job
+
+	mu      sync.RWMutex
+	stopped bool
+	pending sync.WaitGroup
+}
+
+// job is a queued function along with the context of the request which queued it.
+type job struct {
+	ctx context.Context
+	fn  func(ctx context.Context)
+}
+
+// NewQueue constructs new [Queue]. Jobs are run once [Queue.Run] is called.
+func NewQueue(config QueueConfig) (*Queue, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return &Queue{config: config, jobs: make(chan job, config.Size)}, nil
+}
+
+// Enqueue queues fn to run after the request. fn is called with a context which keeps the values of ctx but not its
+// cancellation, bounded by the configured timeout. fn is expected to log its failures. Enqueue reports whether fn was
+// queued, which is not the case once the queue is full or stopped.
+func (q *Queue) Enqueue(ctx context.Context, fn func(ctx context.Context)) bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if q.stopped {
+		zerolog.Ctx(ctx).Error().Msg("mail queue stopped, dropping email")
+		return false
+	}
+
+	q.pending.Add(1)
+
+	select {
+	case q.jobs <- job{ctx: context.WithoutCancel(ctx), fn: fn}:
+		return true
+	default:
+		q.pending.Done()
+		zerolog.Ctx(ctx).Error().Msg("mail queue full, dropping email")
+		return false
+	}
+}
+
+// Run runs the queued jobs until ctx is done. It then stops accepting jobs and runs the ones still queued. Jobs still
+// running after the drain timeout are canceled. Run must be called only once.
+func (q *Queue) Run(ctx context.Context) error {
+	drain, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var workers sync.WaitGroup
+
+	for range q.config.Workers {
+		workers.Add(1)
+
+		go func() {
+			defer workers.Done()
+
+			for j := range q.jobs {
+				q.run(drain, j)
+			}
+		}()
+	}
+
+	<-ctx.Done()
+
+	q.mu.Lock()
+	q.stopped = true
+	close(q.jobs)
+	q.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(done)
+	}()
+
+	timer := time.NewTimer(q.config.DrainTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+	case <-timer.C:
+		// The remaining jobs run with canceled contexts, so they fail fast instead of being lost silently.
+		cancel()
+		<-done
+	}
+
+	return nil
+}
+
+// Wait blocks until all queued jobs ran.
+func (q *Queue) Wait() {
+	q.pending.Wait()
+}
+
+func (q *Queue) run(drain context.Context, j job) {
+	defer q.pending.Done()
+
+	ctx, cancel := context.WithTimeout(j.ctx, q.config.Timeout)
+	defer cancel()
+
+	stop := context.AfterFunc(drain, cancel)
+	defer stop()
+
+	j.fn(ctx)
+}
@@ -0,0 +1,107 @@
+package mail
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueue(t *testing.T) {
+	ctx := context.Background()
+
+	config := QueueConfig{Workers: 2, Size: 4, Timeout: time.Second, DrainTimeout: time.Second}
+
+	// start runs the queue until the returned function is called, which returns once the queue drained.
+	start := func(t *testing.T, q *Queue) func() {
+		t.Helper()
+
+		ctx, cancel := context.WithCancel(ctx)
+		done := make(chan error)
+
+		go func() { done <- q.Run(ctx) }()
+
+		return func() {
+			cancel()
+			require.NoError(t, <-done)
+		}
+	}
+
+	t.Run("runs jobs after the request", func(t *testing.T) {
+		q, err := NewQueue(config)
+		require.NoError(t, err)
+
+		stop := start(t, q)
+		defer stop()
+
+		reqCtx, cancel := context.WithCancel(ctx)
+
+		// The job is not canceled with the request, but bound by the timeout.
+		cancel()
+
+		var jobErr error
+		var deadline bool
+		require.True(t, q.Enqueue(reqCtx, func(ctx context.Context) {
+			jobErr = ctx.Err()
+			_, deadline = ctx.Deadline()
+		}))
+
+		q.Wait()
+		require.NoError(t, jobErr)
+		require.True(t, deadline)
+	})
+
+	t.Run("drops jobs while full", func(t *testing.T) {
+		q, err := NewQueue(config)
+		require.NoError(t, err)
+
+		// Without running the queue, jobs are kept until it is full.
+		for range config.Size {
+			require.True(t, q.Enqueue(ctx, func(context.Context) {}))
+		}
+
+		require.False(t, q.Enqueue(ctx, func(context.Context) {}))
+
+		stop := start(t, q)
+		q.Wait()
+		stop()
+	})
+
+	t.Run("drains jobs when stopped", func(t *testing.T) {
+		q, err := NewQueue(config)
+		require.NoError(t, err)
+
+		var ran atomic.Int32
+		for range config.Size {
+			require.True(t, q.Enqueue(ctx, func(context.Context) { ran.Add(1) }))
+		}
+
+		stop := start(t, q)
+		stop()
+
+		require.EqualValues(t, config.Size, ran.Load())
+		require.False(t, q.Enqueue(ctx, func(context.Context) {}))
+	})
+
+	t.Run("cancels jobs after the drain timeout", func(t *testing.T) {
+		q, err := NewQueue(QueueConfig{Workers: 1, Size: 1, Timeout: time.Minute, DrainTimeout: 10 * time.Millisecond})
+		require.NoError(t, err)
+
+		started := make(chan struct{})
+		var canceled atomic.Bool
+
+		require.True(t, q.Enqueue(ctx, func(ctx context.Context) {
+			close(started)
+			<-ctx.Done()
+			canceled.Store(true)
+		}))
+
+		stop := start(t, q)
+		<-started
+		stop()
+
+		require.True(t, canceled.Load())
+	})
+}
@@ -0,0 +1,313 @@
+package mail
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"math/big"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// received is an email accepted by [fakeSMTPServer].
+type received struct {
+	From string
+	To   []string
+	Data string
+}
+
+// fakeSMTPServer is an in-process SMTP server speaking just enough of RFC 5321 for [SMTPMailer]. It supports
+// STARTTLS, implicit TLS and AUTH PLAIN.
+type fakeSMTPServer struct {
+	ln       net.Listener
+	port     int
+	tls      *tls.Config
+	startTLS bool   // Advertise STARTTLS.
+	username string // Require AUTH PLAIN if not empty.
+	password string
+
+	mu       sync.Mutex
+	conns    []net.Conn
+	dials    int
+	received []received
+}
+
+type fakeSMTPServerOptions struct {
+	implicitTLS bool
+	startTLS    bool
+	username    string
+	password    string
+}
+
+func newFakeSMTPServer(t *testing.T, opts fakeSMTPServerOptions) (*fakeSMTPServer, *x509.CertPool) {
+	t.Helper()
+
+	cert, pool := selfSignedCert(t)
+	tlsConfig := &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	addr, ok := ln.Addr().(*net.TCPAddr)
+	require.True(t, ok)
+
+	if opts.implicitTLS {
+		ln = tls.NewListener(ln, tlsConfig)
+	}
+
+	s := &fakeSMTPServer{
+		ln:       ln,
+		port:     addr.Port,
+		tls:      tlsConfig,
+		startTLS: opts.startTLS,
+		username: opts.username,
+		password: opts.password,
+	}
+
+	go s.serve()
+
+	t.Cleanup(func() {
+		//nolint:errcheck // Closing the listener stops serve.
+		ln.Close()
+		s.dropConnections()
+	})
+
+	return s, pool
+}
+
+// config returns a mailer config for the server.
+func (s *fakeSMTPServer) config(tlsMode string) Config {
+	return Config{
+		Host:         "127.0.0.1",
+		Port:         s.port,
+		Username:     s.username,
+		Password:     s.password,
+		TLS:          tlsMode,
+		From:         "Guardian <no-reply@example.com>",
+		Timeout:      5 * time.Second,
+		MaxIdleConns: 2,
+		IdleTimeout:  time.Minute,
+	}
+}
+
+func (s *fakeSMTPServer) serve() {
+	for {
+		c, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+
+		s.mu.Lock()
+		s.conns = append(s.conns, c)
+		s.dials++
+		s.mu.Unlock()
+
+		go s.handle(c)
+	}
+}
+
+// dropConnections closes all open connections like a server closing idle connections.
+func (s *fakeSMTPServer) dropConnections() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, c := range s.conns {
+		//nolint:errcheck // The connection is dropped on purpose.
+		c.Close()
+	}
+
+	s.conns = nil
+}
+
+func (s *fakeSMTPServer) dialCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.dials
+}
+
+func (s *fakeSMTPServer) emails() []received {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]received(nil), s.received...)
+}
+
+func (s *fakeSMTPServer) handle(c net.Conn) {
+	//nolint:errcheck // The client may have closed the connection already.
+	defer c.Close()
+
+	_, secure := c.(*tls.Conn)
+	tp := textproto.NewConn(c)
+	authenticated := s.username == ""
+
+	var current *received
+
+	reply := func(format string, args ...any) bool {
+		return tp.PrintfLine(format, args...) == nil
+	}
+
+	if !reply("220 fake ESMTP") {
+		return
+	}
+
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+
+		verb, arg, _ := strings.Cut(line, " ")
+
+		var ok bool
+
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			lines := []string{"fake"}
+			if s.startTLS && !secure {
+				lines = append(lines, "STARTTLS")
+			}
+			if s.username != "" {
+				lines = append(lines, "AUTH PLAIN")
+			}
+			lines = append(lines, "8BITMIME")
+
+			ok = true
+			for i, l := range lines {
+				sep := "-"
+				if i == len(lines)-1 {
+					sep = " "
+				}
+				ok = ok && reply("250%s%s", sep, l)
+			}
+		case "STARTTLS":
+			if !s.startTLS || secure {
+				ok = reply("502 not supported")
+				break
+			}
+
+			if !reply("220 ready to start TLS") {
+				return
+			}
+
+			tc := tls.Server(c, s.tls)
+			if tc.Handshake() != nil {
+				return
+			}
+
+			c, secure, tp = tc, true, textproto.NewConn(tc)
+			ok = true
+		case "AUTH":
+			mech, initial, _ := strings.Cut(arg, " ")
+			if mech != "PLAIN" {
+				ok = reply("504 unsupported mechanism")
+				break
+			}
+
+			b, err := base64.StdEncoding.DecodeString(initial)
+			if err == nil && string(b) == "\x00"+s.username+"\x00"+s.password {
+				authenticated = true
+				ok = reply("235 authenticated")
+			} else {
+				ok = reply("535 authentication failed")
+			}
+		case "MAIL":
+			if !authenticated {
+				ok = reply("530 authentication required")
+				break
+			}
+
+			current = &received{From: address(arg)}
+			ok = reply("250 ok")
+		case "RCPT":
+			if current == nil {
+				ok = reply("503 need MAIL first")
+				break
+			}
+
+			current.To = append(current.To, address(arg))
+			ok = reply("250 ok")
+		case "DATA":
+			if current == nil || len(current.To) == 0 {
+				ok = reply("503 need RCPT first")
+				break
+			}
+
+			if !reply("354 go ahead") {
+				return
+			}
+
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+
+			current.Data = string(data)
+
+			s.mu.Lock()
+			s.received = append(s.received, *current)
+			s.mu.Unlock()
+
+			current = nil
+			ok = reply("250 queued")
+		case "RSET":
+			current = nil
+			ok = reply("250 ok")
+		case "NOOP":
+			ok = reply("250 ok")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			ok = reply("502 unknown command")
+		}
+
+		if !ok {
+			return
+		}
+	}
+}
+
+// address returns the address of a MAIL FROM:<address> or RCPT TO:<address> argument.
+func address(arg string) string {
+	_, a, _ := strings.Cut(arg, "<")
+	a, _, _ = strings.Cut(a, ">")
+	return a
+}
+
+// selfSignedCert returns a certificate for 127.0.0.1 and a pool trusting it.
+func selfSignedCert(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	leaf, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(leaf)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}, pool
+}
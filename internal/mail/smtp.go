@@ -0,0 +1,284 @@
+// Package mail delivers emails over SMTP and renders the emails sent to users.
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gophero/guardian/core"
+)
+
+const (
+	tlsStartTLS = "starttls"
+	tlsImplicit = "tls"
+	tlsNone     = "none"
+)
+
+// SMTPMailer is a [core.Mailer] which delivers emails through an SMTP server. Connections are kept open after an
+// email was sent and reused for subsequent emails.
+type SMTPMailer struct {
+	config Config
+	from   *mail.Address
+	tls    *tls.Config
+	now    func() time.Time
+
+	mu     sync.Mutex
+	idle   []*conn // Most recently used last.
+	closed bool
+}
+
+var _ core.Mailer = (*SMTPMailer)(nil)
+
+// conn is an authenticated connection to the SMTP server.
+type conn struct {
+	net       net.Conn
+	client    *smtp.Client
+	idleSince time.Time
+}
+
+// NewSMTPMailer constructs new [SMTPMailer].
+func NewSMTPMailer(config Config) (*SMTPMailer, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	from, err := mail.ParseAddress(config.From)
+	if err != nil {
+		return nil, fmt.Errorf("mail: parse from address: %w", err)
+	}
+
+	return &SMTPMailer{
+		config: config,
+		from:   from,
+		tls: &tls.Config{
+			ServerName:         config.Host,
+			InsecureSkipVerify: config.InsecureSkipVerify,
+			MinVersion:         tls.VersionTLS12,
+		},
+		now: time.Now,
+	}, nil
+}
+
+// Send implements [core.Mailer].
+func (m *SMTPMailer) Send(ctx context.Context, email core.Email) error {
+	to, err := mail.ParseAddress(email.To)
+	if err != nil {
+		return fmt.Errorf("mail: parse recipient address: %w: %w", core.ErrInvalidArgument, err)
+	}
+
+	deadline := m.now().Add(m.config.Timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+
+	c, err := m.conn(ctx, deadline)
+	if err != nil {
+		return err
+	}
+
+	// Abort blocking reads and writes once ctx is done.
+	stop := context.AfterFunc(ctx, func() {
+		//nolint:errcheck // The connection is discarded after a failed send anyway.
+		c.net.SetDeadline(time.Unix(1, 0))
+	})
+
+	err = send(c.client, m.from.Address, to.Address, message(m.from, to, email, m.now()))
+
+	if !stop() {
+		// The deadline of the connection expired, so it cannot be reused.
+		c.close()
+
+		if err != nil {
+			return fmt.Errorf("mail: send: %w", ctx.Err())
+		}
+		return nil
+	}
+
+	if err != nil {
+		c.close()
+		return fmt.Errorf("mail: send: %w", err)
+	}
+
+	m.release(c)
+
+	return nil
+}
+
+func send(c *smtp.Client, from, to string, msg []byte) error {
+	if err := c.Mail(from); err != nil {
+		return err
+	}
+
+	if err := c.Rcpt(to); err != nil {
+		return err
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+
+	return w.Close()
+}
+
+// conn returns an idle connection which is still alive or dials a new one. The deadline of the returned connection is
+// set to deadline.
+func (m *SMTPMailer) conn(ctx context.Context, deadline time.Time) (*conn, error) {
+	for {
+		c := m.acquire()
+		if c == nil {
+			break
+		}
+
+		// The server may have closed the connection while it was idle.
+		if err := c.net.SetDeadline(deadline); err == nil {
+			if err := c.client.Reset(); err == nil {
+				return c, nil
+			}
+		}
+
+		c.close()
+	}
+
+	return m.dial(ctx, deadline)
+}
+
+// acquire removes the most recently used idle connection from the pool. Connections which were idle for longer than
+// the idle timeout are closed. It returns nil if there is none left.
+func (m *SMTPMailer) acquire() *conn {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+
+	for len(m.idle) > 0 {
+		c := m.idle[len(m.idle)-1]
+		m.idle = m.idle[:len(m.idle)-1]
+
+		if now.Sub(c.idleSince) < m.config.IdleTimeout {
+			return c
+		}
+
+		go c.quit()
+	}
+
+	return nil
+}
+
+// release returns c to the pool or closes it if the pool is full or closed.
+func (m *SMTPMailer) release(c *conn) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed || len(m.idle) >= m.config.MaxIdleConns {
+		go c.quit()
+		return
+	}
+
+	c.idleSince = m.now()
+	m.idle = append(m.idle, c)
+}
+
+func (m *SMTPMailer) dial(ctx context.Context, deadline time.Time) (*conn, error) {
+	addr := net.JoinHostPort(m.config.Host, strconv.Itoa(m.config.Port))
+	dialer := &net.Dialer{Deadline: deadline}
+
+	var (
+		nc  net.Conn
+		err error
+	)
+
+	if m.config.TLS == tlsImplicit {
+		nc, err = (&tls.Dialer{NetDialer: dialer, Config: m.tls}).DialContext(ctx, "tcp", addr)
+	} else {
+		nc, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("mail: dial: %w", err)
+	}
+
+	c, err := m.handshake(nc, deadline)
+	if err != nil {
+		//nolint:errcheck // The handshake error is more relevant.
+		nc.Close()
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// handshake greets the server on nc, upgrades the connection with STARTTLS if configured and authenticates.
+func (m *SMTPMailer) handshake(nc net.Conn, deadline time.Time) (*conn, error) {
+	if err := nc.SetDeadline(deadline); err != nil {
+		return nil, fmt.Errorf("mail: set deadline: %w", err)
+	}
+
+	client, err := smtp.NewClient(nc, m.config.Host)
+	if err != nil {
+		return nil, fmt.Errorf("mail: new client: %w", err)
+	}
+
+	if m.config.TLS == tlsStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			return nil, errors.New("mail: server does not support STARTTLS")
+		}
+
+		if err := client.StartTLS(m.tls); err != nil {
+			return nil, fmt.Errorf("mail: start tls: %w", err)
+		}
+	}
+
+	if m.config.Username != "" {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return nil, errors.New("mail: server does not support AUTH")
+		}
+
+		// PLAIN authentication refuses to send credentials over unencrypted connections to hosts other than localhost.
+		if err := client.Auth(smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)); err != nil {
+			return nil, fmt.Errorf("mail: auth: %w", err)
+		}
+	}
+
+	return &conn{net: nc, client: client}, nil
+}
+
+// Close closes idle connections. Connections in use are closed once their email is sent.
+func (m *SMTPMailer) Close() {
+	m.mu.Lock()
+	idle := m.idle
+	m.idle = nil
+	m.closed = true
+	m.mu.Unlock()
+
+	for _, c := range idle {
+		c.quit()
+	}
+}
+
+// quit ends the session gracefully and closes the connection.
+func (c *conn) quit() {
+	//nolint:errcheck // A failed QUIT falls back to closing the connection.
+	c.net.SetDeadline(time.Now().Add(time.Second))
+
+	if err := c.client.Quit(); err != nil {
+		c.close()
+	}
+}
+
+func (c *conn) close() {
+	//nolint:errcheck // The connection is discarded, there is nothing to do about a failure.
+	c.client.Close()
+}
@@ -0,0 +1,181 @@
+package mail
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+)
+
+func newTestMailer(t *testing.T, config Config, opts ...func(*SMTPMailer)) *SMTPMailer {
+	t.Helper()
+
+	m, err := NewSMTPMailer(config)
+	require.NoError(t, err)
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	t.Cleanup(m.Close)
+
+	return m
+}
+
+func TestSMTPMailer(t *testing.T) {
+	ctx := context.Background()
+
+	email := core.Email{
+		To:      "Ada Lovelace <ada@example.com>",
+		Subject: "Grüße",
+		Text:    "Hello Ada,\n\n. a line starting with a dot\n" + strings.Repeat("long ", 30),
+		HTML:    "<p>Hello Ada</p>",
+	}
+
+	tests := []struct {
+		name string
+		tls  string
+		opts fakeSMTPServerOptions
+	}{
+		{"starttls", tlsStartTLS, fakeSMTPServerOptions{startTLS: true, username: "admin", password: "secret"}},
+		{"implicit tls", tlsImplicit, fakeSMTPServerOptions{implicitTLS: true, username: "admin", password: "secret"}},
+		{"plain text", tlsNone, fakeSMTPServerOptions{username: "admin", password: "secret"}},
+		{"no auth", tlsNone, fakeSMTPServerOptions{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, pool := newFakeSMTPServer(t, tt.opts)
+			m := newTestMailer(t, srv.config(tt.tls), func(m *SMTPMailer) { m.tls.RootCAs = pool })
+
+			require.NoError(t, m.Send(ctx, email))
+
+			emails := srv.emails()
+			require.Len(t, emails, 1)
+			require.Equal(t, "no-reply@example.com", emails[0].From)
+			require.Equal(t, []string{"ada@example.com"}, emails[0].To)
+
+			msg, err := mail.ReadMessage(strings.NewReader(emails[0].Data))
+			require.NoError(t, err)
+
+			subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+			require.NoError(t, err)
+			require.Equal(t, email.Subject, subject)
+			require.Equal(t, `"Guardian" <no-reply@example.com>`, msg.Header.Get("From"))
+			require.Equal(t, `"Ada Lovelace" <ada@example.com>`, msg.Header.Get("To"))
+			require.NotEmpty(t, msg.Header.Get("Message-Id"))
+
+			mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+			require.NoError(t, err)
+			require.Equal(t, "multipart/alternative", mediaType)
+
+			r := multipart.NewReader(msg.Body, params["boundary"])
+
+			for _, want := range []struct{ contentType, body string }{
+				{"text/plain; charset=utf-8", email.Text},
+				{"text/html; charset=utf-8", email.HTML},
+			} {
+				part, err := r.NextPart()
+				require.NoError(t, err)
+				require.Equal(t, want.contentType, part.Header.Get("Content-Type"))
+
+				body, err := io.ReadAll(part)
+				require.NoError(t, err)
+				require.Equal(t, want.body, string(body))
+			}
+
+			_, err = r.NextPart()
+			require.ErrorIs(t, err, io.EOF)
+		})
+	}
+}
+
+func TestSMTPMailerReusesConnections(t *testing.T) {
+	ctx := context.Background()
+	email := core.Email{To: "ada@example.com", Subject: "Hello", Text: "Hello Ada"}
+
+	srv, pool := newFakeSMTPServer(t, fakeSMTPServerOptions{startTLS: true, username: "admin", password: "secret"})
+
+	now := time.Now()
+	m := newTestMailer(t, srv.config(tlsStartTLS), func(m *SMTPMailer) {
+		m.tls.RootCAs = pool
+		m.now = func() time.Time { return now }
+	})
+
+	for range 3 {
+		require.NoError(t, m.Send(ctx, email))
+	}
+	require.Equal(t, 1, srv.dialCount())
+
+	// A connection closed by the server while idle is replaced.
+	srv.dropConnections()
+	require.NoError(t, m.Send(ctx, email))
+	require.Equal(t, 2, srv.dialCount())
+
+	// A connection idle for longer than the idle timeout is replaced.
+	now = now.Add(time.Minute)
+	require.NoError(t, m.Send(ctx, email))
+	require.Equal(t, 3, srv.dialCount())
+
+	require.Len(t, srv.emails(), 5)
+}
+
+func TestSMTPMailerErrors(t *testing.T) {
+	ctx := context.Background()
+	email := core.Email{To: "ada@example.com", Subject: "Hello", Text: "Hello Ada"}
+
+	t.Run("invalid recipient", func(t *testing.T) {
+		srv, _ := newFakeSMTPServer(t, fakeSMTPServerOptions{})
+		m := newTestMailer(t, srv.config(tlsNone))
+
+		err := m.Send(ctx, core.Email{To: "not an address", Subject: "Hello", Text: "Hello"})
+		require.ErrorIs(t, err, core.ErrInvalidArgument)
+		require.Zero(t, srv.dialCount())
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		srv, pool := newFakeSMTPServer(t, fakeSMTPServerOptions{startTLS: true, username: "admin", password: "secret"})
+
+		config := srv.config(tlsStartTLS)
+		config.Password = "wrong"
+		m := newTestMailer(t, config, func(m *SMTPMailer) { m.tls.RootCAs = pool })
+
+		require.ErrorContains(t, m.Send(ctx, email), "mail: auth")
+		require.Empty(t, srv.emails())
+	})
+
+	t.Run("starttls not supported", func(t *testing.T) {
+		srv, _ := newFakeSMTPServer(t, fakeSMTPServerOptions{username: "admin", password: "secret"})
+		m := newTestMailer(t, srv.config(tlsStartTLS))
+
+		require.ErrorContains(t, m.Send(ctx, email), "does not support STARTTLS")
+		require.Empty(t, srv.emails())
+	})
+
+	t.Run("untrusted certificate", func(t *testing.T) {
+		srv, _ := newFakeSMTPServer(t, fakeSMTPServerOptions{startTLS: true})
+		m := newTestMailer(t, srv.config(tlsStartTLS))
+
+		require.ErrorContains(t, m.Send(ctx, email), "mail: start tls")
+		require.Empty(t, srv.emails())
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		srv, _ := newFakeSMTPServer(t, fakeSMTPServerOptions{})
+		m := newTestMailer(t, srv.config(tlsNone))
+
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		require.ErrorIs(t, m.Send(ctx, email), context.Canceled)
+		require.Empty(t, srv.emails())
+	})
+}
@@ -0,0 +1,139 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/gophero/guardian/core"
+)
+
+// Templates are stored per email as name.txt, which defines name.subject and holds the text body, and name.html,
+// which holds the HTML body.
+//
+//go:embed templates
+var templateFS embed.FS
+
+var funcs = map[string]any{
+	"minutes": func(d time.Duration) int { return int(d.Round(time.Minute) / time.Minute) },
+	"hours":   func(d time.Duration) int { return int(d.Round(time.Hour) / time.Hour) },
+	"days":    func(d time.Duration) int { return int(d.Round(24*time.Hour) / (24 * time.Hour)) },
+}
+
+var (
+	textTemplates = texttemplate.Must(texttemplate.New("").Funcs(funcs).ParseFS(templateFS, "templates/*.txt"))
+	htmlTemplates = htmltemplate.Must(htmltemplate.New("").Funcs(funcs).ParseFS(templateFS, "templates/*.html"))
+)
+
+// MagicLinkData is the data of magic link emails.
+type MagicLinkData struct {
+	Username  string
+	Link      string
+	ExpiresIn time.Duration
+}
+
+// MagicLink renders the email with a magic link to sign in.
+func MagicLink(to string, data MagicLinkData) (core.Email, error) {
+	return render(to, "magic_link", data)
+}
+
+// EmailOTPData is the data of email OTP emails.
+type EmailOTPData struct {
+	Username  string
+	Code      string
+	ExpiresIn time.Duration
+}
+
+// EmailOTP renders the email with a one-time code to sign in.
+func EmailOTP(to string, data EmailOTPData) (core.Email, error) {
+	return render(to, "email_otp", data)
+}
+
+// VerifyEmailData is the data of email verification emails.
+type VerifyEmailData struct {
+	Username  string
+	Link      string
+	ExpiresIn time.Duration
+}
+
+// VerifyEmail renders the email with a link to verify the email address of an account.
+func VerifyEmail(to string, data VerifyEmailData) (core.Email, error) {
+	return render(to, "verify_email", data)
+}
+
+// ConfirmEmailChangeData is the data of email change confirmation emails.
+type ConfirmEmailChangeData struct {
+	Username  string
+	Email     string // The new email address.
+	Link      string
+	ExpiresIn time.Duration
+}
+
+// ConfirmEmailChange renders the email sent to a new email address with a link to confirm the change.
+func ConfirmEmailChange(to string, data ConfirmEmailChangeData) (core.Email, error) {
+	return render(to, "confirm_email_change", data)
+}
+
+// EmailChangedData is the data of email changed notices.
+type EmailChangedData struct {
+	Username string
+	Email    string // The new email address.
+}
+
+// EmailChanged renders the notice sent to the old email address after a change.
+func EmailChanged(to string, data EmailChangedData) (core.Email, error) {
+	return render(to, "email_changed", data)
+}
+
+// PasswordResetData is the data of password reset emails.
+type PasswordResetData struct {
+	Username  string
+	Link      string
+	ExpiresIn time.Duration
+}
+
+// PasswordReset renders the email with a link to reset a forgotten password.
+func PasswordReset(to string, data PasswordResetData) (core.Email, error) {
+	return render(to, "password_reset", data)
+}
+
+// OrganizationInvitationData is the data of organization invitation emails.
+type OrganizationInvitationData struct {
+	Inviter      string // Username of the inviting user.
+	Organization string // Name of the organization.
+	Role         string
+	Link         string
+	ExpiresIn    time.Duration
+}
+
+// OrganizationInvitation renders the email with a link to answer an invitation to an organization.
+func OrganizationInvitation(to string, data OrganizationInvitationData) (core.Email, error) {
+	return render(to, "organization_invitation", data)
+}
+
+func render(to, name string, data any) (core.Email, error) {
+	var subject, text, html bytes.Buffer
+
+	if err := textTemplates.ExecuteTemplate(&subject, name+".subject", data); err != nil {
+		return core.Email{}, fmt.Errorf("mail: render %s subject: %w", name, err)
+	}
+
+	if err := textTemplates.ExecuteTemplate(&text, name+".txt", data); err != nil {
+		return core.Email{}, fmt.Errorf("mail: render %s text: %w", name, err)
+	}
+
+	if err := htmlTemplates.ExecuteTemplate(&html, name+".html", data); err != nil {
+		return core.Email{}, fmt.Errorf("mail: render %s html: %w", name, err)
+	}
+
+	return core.Email{
+		To:      to,
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
@@ -0,0 +1,78 @@
+package mail
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplates(t *testing.T) {
+	link, err := MagicLink("ada@example.com", MagicLinkData{
+		Username:  "ada",
+		Link:      "https://example.com/sign-in?token=abc&x=<y>",
+		ExpiresIn: 15 * time.Minute,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ada@example.com", link.To)
+	require.Equal(t, "Your sign in link", link.Subject)
+	require.Contains(t, link.Text, "Hi ada,\n")
+	require.Contains(t, link.Text, "expires in 15 minutes")
+	require.Contains(t, link.Text, "https://example.com/sign-in?token=abc&x=<y>")
+	require.Contains(t, link.HTML, `href="https://example.com/sign-in?token=abc&amp;x=%3cy%3e"`)
+
+	otp, err := EmailOTP("ada@example.com", EmailOTPData{Username: "ada", Code: "012345", ExpiresIn: 10 * time.Minute})
+	require.NoError(t, err)
+	require.Equal(t, "Your sign in code is 012345", otp.Subject)
+	require.Contains(t, otp.Text, "\n012345\n")
+	require.Contains(t, otp.HTML, "<strong>012345</strong>")
+
+	verify, err := VerifyEmail("ada@example.com", VerifyEmailData{
+		Username:  "ada",
+		Link:      "https://example.com/verify-email?token=abc",
+		ExpiresIn: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Verify your email address", verify.Subject)
+	require.Contains(t, verify.Text, "expires in 24 hours")
+	require.Contains(t, verify.HTML, `href="https://example.com/verify-email?token=abc"`)
+
+	confirm, err := ConfirmEmailChange("lovelace@example.com", ConfirmEmailChangeData{
+		Username:  "ada",
+		Email:     "lovelace@example.com",
+		Link:      "https://example.com/verify-email?token=abc",
+		ExpiresIn: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Confirm your new email address", confirm.Subject)
+	require.Contains(t, confirm.Text, "to lovelace@example.com.")
+
+	changed, err := EmailChanged("ada@example.com", EmailChangedData{Username: "ada", Email: "lovelace@example.com"})
+	require.NoError(t, err)
+	require.Equal(t, "Your email address was changed", changed.Subject)
+	require.Contains(t, changed.HTML, "changed to lovelace@example.com.")
+
+	reset, err := PasswordReset("ada@example.com", PasswordResetData{
+		Username:  "ada",
+		Link:      "https://example.com/reset-password?token=abc",
+		ExpiresIn: 15 * time.Minute,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Reset your password", reset.Subject)
+	require.Contains(t, reset.Text, "expires in 15 minutes")
+	require.Contains(t, reset.HTML, `href="https://example.com/reset-password?token=abc"`)
+
+	invitation, err := OrganizationInvitation("bob@example.com", OrganizationInvitationData{
+		Inviter:      "ada",
+		Organization: "Acme <Corp>",
+		Role:         "admin",
+		Link:         "https://example.com/invitations?token=abc",
+		ExpiresIn:    7 * 24 * time.Hour,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ada invited you to join Acme <Corp>", invitation.Subject)
+	require.Contains(t, invitation.Text, "join Acme <Corp> as admin.")
+	require.Contains(t, invitation.Text, "expires in 7 days")
+	require.Contains(t, invitation.HTML, "join Acme &lt;Corp&gt; as admin.")
+	require.Contains(t, invitation.HTML, `href="https://example.com/invitations?token=abc"`)
+}
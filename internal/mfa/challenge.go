@@ -0,0 +1,110 @@
+package mfa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/internal/db/queries"
+	"github.com/gophero/guardian/internal/secret"
+)
+
+// ChallengeStore is a postgres backed [core.MFAChallengeStore].
+type ChallengeStore struct {
+	config Config
+	q      *queries.Queries
+	now    func() time.Time
+}
+
+var _ core.MFAChallengeStore = (*ChallengeStore)(nil)
+
+// NewChallengeStore constructs new [ChallengeStore].
+func NewChallengeStore(pool *pgxpool.Pool, config Config) (*ChallengeStore, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return &ChallengeStore{config: config, q: queries.New(pool), now: time.Now}, nil
+}
+
+// Create implements [core.MFAChallengeStore].
+func (s *ChallengeStore) Create(ctx context.Context, userID uuid.UUID, device string) (core.MFAChallenge, string, error) {
+	token := secret.New(secret.DefaultSize)
+
+	c, err := s.q.CreateMFAChallenge(ctx, queries.CreateMFAChallengeParams{
+		UserID:    userID,
+		TokenHash: secret.Hash(token),
+		Device:    device,
+		ExpiresAt: s.now().Add(s.config.ChallengeTTL),
+	})
+	if err != nil {
+		if db.IsForeignKeyViolation(err) {
+			return core.MFAChallenge{}, "", fmt.Errorf("mfa: create mfa challenge: %w", core.ErrNotFound)
+		}
+		return core.MFAChallenge{}, "", fmt.Errorf("mfa: create mfa challenge: %w", err)
+	}
+
+	return toChallenge(c), token, nil
+}
+
+// Attempt implements [core.MFAChallengeStore].
+func (s *ChallengeStore) Attempt(ctx context.Context, token string) (core.MFAChallenge, error) {
+	c, err := s.q.AttemptMFAChallenge(ctx, queries.AttemptMFAChallengeParams{
+		TokenHash:   secret.Hash(token),
+		MaxAttempts: int32(s.config.MaxAttempts),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return core.MFAChallenge{}, core.ErrInvalidToken
+	}
+
+	if err != nil {
+		return core.MFAChallenge{}, fmt.Errorf("mfa: attempt mfa challenge: %w", err)
+	}
+
+	return toChallenge(c), nil
+}
+
+// Complete implements [core.MFAChallengeStore].
+func (s *ChallengeStore) Complete(ctx context.Context, id uuid.UUID) error {
+	n, err := s.q.CompleteMFAChallenge(ctx, queries.CompleteMFAChallengeParams{
+		ID:          id,
+		MaxAttempts: int32(s.config.MaxAttempts),
+	})
+	if err != nil {
+		return fmt.Errorf("mfa: complete mfa challenge: %w", err)
+	}
+
+	if n == 0 {
+		return core.ErrInvalidToken
+	}
+
+	return nil
+}
+
+// DeleteExpired deletes expired challenges.
+func (s *ChallengeStore) DeleteExpired(ctx context.Context) (int64, error) {
+	n, err := s.q.DeleteExpiredMFAChallenges(ctx, s.now())
+	if err != nil {
+		return 0, fmt.Errorf("mfa: delete expired mfa challenges: %w", err)
+	}
+
+	return n, nil
+}
+
+func toChallenge(c queries.MfaChallenge) core.MFAChallenge {
+	return core.MFAChallenge{
+		ID:        c.ID,
+		UserID:    c.UserID,
+		Device:    c.Device,
+		Attempts:  int(c.Attempts),
+		CreatedAt: c.CreatedAt,
+		ExpiresAt: c.ExpiresAt,
+	}
+}
@@ -0,0 +1,48 @@
+package mfa
+
+import (
+	"errors"
+	"time"
+)
+
+type Config struct {
+	Issuer        string        `help:"Issuer shown for TOTP factors in authenticator apps." name:"issuer" env:"ISSUER" default:"Guardian"`
+	Skew          int           `help:"Number of 30 second time steps before and after the current one in which TOTP codes are accepted." name:"skew" env:"SKEW" default:"1"`
+	QRCodeSize    int           `help:"Width and height of TOTP QR codes in pixels." name:"qr_code_size" env:"QR_CODE_SIZE" default:"256"`
+	EnrollmentTTL time.Duration `help:"Duration in which a TOTP enrollment has to be confirmed." name:"enrollment_ttl" env:"ENROLLMENT_TTL" default:"15m"`
+	RecoveryCodes int           `help:"Number of recovery codes generated for a user." name:"recovery_codes" env:"RECOVERY_CODES" default:"10"`
+	ChallengeTTL  time.Duration `help:"Duration in which a sign in has to be completed with a second factor." name:"challenge_ttl" env:"CHALLENGE_TTL" default:"5m"`
+	MaxAttempts   int           `help:"Maximum number of failed attempts to answer a sign in challenge." name:"max_attempts" env:"MAX_ATTEMPTS" default:"5"`
+}
+
+func (c Config) validate() error {
+	if c.Issuer == "" {
+		return errors.New("mfa: Issuer cannot be empty")
+	}
+
+	if c.Skew < 0 || c.Skew > 10 {
+		return errors.New("mfa: Skew cannot be negative or greater than 10")
+	}
+
+	if c.QRCodeSize < 64 {
+		return errors.New("mfa: QRCodeSize cannot be less than 64")
+	}
+
+	if c.EnrollmentTTL <= 0 {
+		return errors.New("mfa: EnrollmentTTL cannot be zero or negative")
+	}
+
+	if c.RecoveryCodes <= 0 {
+		return errors.New("mfa: RecoveryCodes cannot be zero or negative")
+	}
+
+	if c.ChallengeTTL <= 0 {
+		return errors.New("mfa: ChallengeTTL cannot be zero or negative")
+	}
+
+	if c.MaxAttempts <= 0 {
+		return errors.New("mfa: MaxAttempts cannot be zero or negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package mfa
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/internal/db/queries"
+	"github.com/gophero/guardian/internal/secret"
+)
+
+const (
+	recoveryCodeGroups    = 4
+	recoveryCodeGroupSize = 4 // 4 groups of 4 base32 characters give 80 bits of entropy.
+)
+
+// RecoveryCodeStore is a postgres backed [core.RecoveryCodeStore].
+type RecoveryCodeStore struct {
+	config Config
+	pool   *pgxpool.Pool
+	q      *queries.Queries
+}
+
+var _ core.RecoveryCodeStore = (*RecoveryCodeStore)(nil)
+
+// NewRecoveryCodeStore constructs new [RecoveryCodeStore].
+func NewRecoveryCodeStore(pool *pgxpool.Pool, config Config) (*RecoveryCodeStore, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return &RecoveryCodeStore{config: config, pool: pool, q: queries.New(pool)}, nil
+}
+
+// Generate implements [core.RecoveryCodeStore].
+func (s *RecoveryCodeStore) Generate(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	codes := make([]string, 0, s.config.RecoveryCodes)
+	hashes := make([][]byte, 0, s.config.RecoveryCodes)
+
+	for range s.config.RecoveryCodes {
+		code := newRecoveryCode()
+		codes = append(codes, code)
+		hashes = append(hashes, secret.Hash(normalizeRecoveryCode(code)))
+	}
+
+	err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		q := s.q.WithTx(tx)
+
+		if _, err := q.DeleteRecoveryCodes(ctx, userID); err != nil {
+			return fmt.Errorf("mfa: delete recovery codes: %w", err)
+		}
+
+		if err := q.CreateRecoveryCodes(ctx, queries.CreateRecoveryCodesParams{UserID: userID, CodeHashes: hashes}); err != nil {
+			if db.IsForeignKeyViolation(err) {
+				return fmt.Errorf("mfa: create recovery codes: %w", core.ErrNotFound)
+			}
+			return fmt.Errorf("mfa: create recovery codes: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// Use implements [core.RecoveryCodeStore].
+func (s *RecoveryCodeStore) Use(ctx context.Context, userID uuid.UUID, code string) error {
+	n, err := s.q.UseRecoveryCode(ctx, queries.UseRecoveryCodeParams{
+		UserID:   userID,
+		CodeHash: secret.Hash(normalizeRecoveryCode(code)),
+	})
+	if err != nil {
+		return fmt.Errorf("mfa: use recovery code: %w", err)
+	}
+
+	if n == 0 {
+		return core.ErrInvalidCredentials
+	}
+
+	return nil
+}
+
+// Remaining implements [core.RecoveryCodeStore].
+func (s *RecoveryCodeStore) Remaining(ctx context.Context, userID uuid.UUID) (int, error) {
+	n, err := s.q.CountUnusedRecoveryCodes(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("mfa: count unused recovery codes: %w", err)
+	}
+
+	return int(n), nil
+}
+
+// Delete implements [core.RecoveryCodeStore].
+func (s *RecoveryCodeStore) Delete(ctx context.Context, userID uuid.UUID) error {
+	if _, err := s.q.DeleteRecoveryCodes(ctx, userID); err != nil {
+		return fmt.Errorf("mfa: delete recovery codes: %w", err)
+	}
+
+	return nil
+}
+
+// newRecoveryCode generates a random code formatted as `xxxx-xxxx-xxxx-xxxx`.
+func newRecoveryCode() string {
+	text := strings.ToLower(rand.Text())
+
+	groups := make([]string, 0, recoveryCodeGroups)
+	for i := range recoveryCodeGroups {
+		groups = append(groups, text[i*recoveryCodeGroupSize:(i+1)*recoveryCodeGroupSize])
+	}
+
+	return strings.Join(groups, "-")
+}
+
+// normalizeRecoveryCode removes formatting users may or may not type, so it does not affect the hash of a code.
+func normalizeRecoveryCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '-', ' ':
+			return -1
+		default:
+			return r
+		}
+	}, strings.ToLower(strings.TrimSpace(code)))
+}
@@ -0,0 +1,19 @@
+package mfa
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecoveryCode(t *testing.T) {
+	code := newRecoveryCode()
+	require.Regexp(t, `^[a-z2-7]{4}-[a-z2-7]{4}-[a-z2-7]{4}-[a-z2-7]{4}$`, code)
+	require.NotEqual(t, code, newRecoveryCode())
+
+	normalized := normalizeRecoveryCode(code)
+	require.Len(t, normalized, 16)
+	require.Equal(t, normalized, normalizeRecoveryCode(" "+strings.ToUpper(code)+" "))
+	require.Equal(t, normalized, normalizeRecoveryCode(normalized[:8]+" "+normalized[8:]))
+}
@@ -0,0 +1,185 @@
+package mfa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/internal/db/queries"
+	"github.com/gophero/guardian/internal/encryption"
+)
+
+// TOTPStore is a postgres backed [core.TOTPStore]. Secrets are encrypted with a [encryption.Cipher] bound to the
+// user.
+type TOTPStore struct {
+	config Config
+	q      *queries.Queries
+	cipher *encryption.Cipher
+	now    func() time.Time
+}
+
+var _ core.TOTPStore = (*TOTPStore)(nil)
+
+// NewTOTPStore constructs new [TOTPStore].
+func NewTOTPStore(pool *pgxpool.Pool, config Config, cipher *encryption.Cipher) (*TOTPStore, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return &TOTPStore{config: config, q: queries.New(pool), cipher: cipher, now: time.Now}, nil
+}
+
+// Enroll implements [core.TOTPStore].
+func (s *TOTPStore) Enroll(ctx context.Context, userID uuid.UUID, account string) (core.TOTPEnrollment, error) {
+	secret := newTOTPSecret()
+
+	_, err := s.q.UpsertTOTPFactor(ctx, queries.UpsertTOTPFactorParams{
+		UserID: userID,
+		Secret: s.cipher.Encrypt(secret, userID[:]),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return core.TOTPEnrollment{}, fmt.Errorf("mfa: upsert totp factor: %w", core.ErrAlreadyExists)
+	}
+
+	if err != nil {
+		if db.IsForeignKeyViolation(err) {
+			return core.TOTPEnrollment{}, fmt.Errorf("mfa: upsert totp factor: %w", core.ErrNotFound)
+		}
+		return core.TOTPEnrollment{}, fmt.Errorf("mfa: upsert totp factor: %w", err)
+	}
+
+	uri := totpURI(s.config.Issuer, account, secret)
+
+	png, err := qrCode(uri, s.config.QRCodeSize)
+	if err != nil {
+		return core.TOTPEnrollment{}, err
+	}
+
+	return core.TOTPEnrollment{
+		Secret: base32NoPadding.EncodeToString(secret),
+		URI:    uri,
+		QRCode: png,
+	}, nil
+}
+
+// Confirm implements [core.TOTPStore].
+func (s *TOTPStore) Confirm(ctx context.Context, userID uuid.UUID, code string) error {
+	factor, secret, err := s.factor(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	now := s.now()
+	if factor.ConfirmedAt != nil || now.Sub(factor.CreatedAt) > s.config.EnrollmentTTL {
+		return fmt.Errorf("mfa: confirm totp factor: %w", core.ErrNotFound)
+	}
+
+	step, ok := matchTOTP(secret, code, now, s.config.Skew, factor.LastUsedStep)
+	if !ok {
+		return core.ErrInvalidCredentials
+	}
+
+	// The confirming code counts as used.
+	n, err := s.q.ConfirmTOTPFactor(ctx, queries.ConfirmTOTPFactorParams{UserID: userID, LastUsedStep: step})
+	if err != nil {
+		return fmt.Errorf("mfa: confirm totp factor: %w", err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("mfa: confirm totp factor: %w", core.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Verify implements [core.TOTPStore].
+func (s *TOTPStore) Verify(ctx context.Context, userID uuid.UUID, code string) error {
+	factor, secret, err := s.factor(ctx, userID)
+	if errors.Is(err, core.ErrNotFound) || err == nil && factor.ConfirmedAt == nil {
+		return core.ErrInvalidCredentials
+	}
+
+	if err != nil {
+		return err
+	}
+
+	step, ok := matchTOTP(secret, code, s.now(), s.config.Skew, factor.LastUsedStep)
+	if !ok {
+		return core.ErrInvalidCredentials
+	}
+
+	n, err := s.q.UseTOTPStep(ctx, queries.UseTOTPStepParams{UserID: userID, LastUsedStep: step})
+	if err != nil {
+		return fmt.Errorf("mfa: use totp step: %w", err)
+	}
+
+	if n == 0 { // Used concurrently.
+		return core.ErrInvalidCredentials
+	}
+
+	return nil
+}
+
+// Enabled implements [core.TOTPStore].
+func (s *TOTPStore) Enabled(ctx context.Context, userID uuid.UUID) (bool, error) {
+	factor, err := s.q.GetTOTPFactor(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("mfa: get totp factor: %w", err)
+	}
+
+	return factor.ConfirmedAt != nil, nil
+}
+
+// Delete implements [core.TOTPStore].
+func (s *TOTPStore) Delete(ctx context.Context, userID uuid.UUID) error {
+	n, err := s.q.DeleteTOTPFactor(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("mfa: delete totp factor: %w", err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("mfa: delete totp factor: %w", core.ErrNotFound)
+	}
+
+	return nil
+}
+
+// DeleteExpired deletes enrollments which were not confirmed in time.
+func (s *TOTPStore) DeleteExpired(ctx context.Context) (int64, error) {
+	n, err := s.q.DeleteUnconfirmedTOTPFactors(ctx, s.now().Add(-s.config.EnrollmentTTL))
+	if err != nil {
+		return 0, fmt.Errorf("mfa: delete unconfirmed totp factors: %w", err)
+	}
+
+	return n, nil
+}
+
+// factor returns the factor of the user with its decrypted secret.
+func (s *TOTPStore) factor(ctx context.Context, userID uuid.UUID) (queries.TotpFactor, []byte, error) {
+	factor, err := s.q.GetTOTPFactor(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return queries.TotpFactor{}, nil, fmt.Errorf("mfa: get totp factor: %w", core.ErrNotFound)
+	}
+
+	if err != nil {
+		return queries.TotpFactor{}, nil, fmt.Errorf("mfa: get totp factor: %w", err)
+	}
+
+	secret, err := s.cipher.Decrypt(factor.Secret, userID[:])
+	if err != nil {
+		return queries.TotpFactor{}, nil, fmt.Errorf("mfa: decrypt totp secret: %w", err)
+	}
+
+	return factor, secret, nil
+}
@@ -0,0 +1,120 @@
+package mfa
+
+import (
+	"bytes"
+	"image/png"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret is the SHA-1 secret of the RFC 4226 and RFC 6238 test vectors.
+var rfcSecret = []byte("12345678901234567890")
+
+func TestHOTP(t *testing.T) {
+	// RFC 4226 Appendix D.
+	expected := []string{"755224", "287082", "359152", "969429", "338314", "254676", "287922", "162583", "399871", "520489"}
+
+	for counter, code := range expected {
+		require.Equal(t, code, hotp(rfcSecret, uint64(counter), 6))
+	}
+}
+
+func TestTOTP(t *testing.T) {
+	// RFC 6238 Appendix B, SHA-1 only.
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.code, hotp(rfcSecret, uint64(totpStep(time.Unix(tt.unix, 0))), 8))
+	}
+}
+
+func TestMatchTOTP(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	step := totpStep(now)
+	code := func(step int64) string { return hotp(rfcSecret, uint64(step), totpDigits) }
+
+	t.Run("current", func(t *testing.T) {
+		matched, ok := matchTOTP(rfcSecret, code(step), now, 1, 0)
+		require.True(t, ok)
+		require.Equal(t, step, matched)
+	})
+
+	t.Run("spaces", func(t *testing.T) {
+		c := code(step)
+		_, ok := matchTOTP(rfcSecret, c[:3]+" "+c[3:], now, 1, 0)
+		require.True(t, ok)
+	})
+
+	t.Run("skew", func(t *testing.T) {
+		matched, ok := matchTOTP(rfcSecret, code(step-1), now, 1, 0)
+		require.True(t, ok)
+		require.Equal(t, step-1, matched)
+
+		_, ok = matchTOTP(rfcSecret, code(step+1), now, 1, 0)
+		require.True(t, ok)
+
+		_, ok = matchTOTP(rfcSecret, code(step-2), now, 1, 0)
+		require.False(t, ok)
+
+		_, ok = matchTOTP(rfcSecret, code(step-1), now, 0, 0)
+		require.False(t, ok)
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		_, ok := matchTOTP(rfcSecret, code(step), now, 1, step)
+		require.False(t, ok)
+
+		// Codes of earlier steps are rejected once a later step was used.
+		_, ok = matchTOTP(rfcSecret, code(step-1), now, 1, step)
+		require.False(t, ok)
+
+		_, ok = matchTOTP(rfcSecret, code(step+1), now, 1, step)
+		require.True(t, ok)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, c := range []string{"", "12345", "1234567", "abcdef"} {
+			_, ok := matchTOTP(rfcSecret, c, now, 1, 0)
+			require.False(t, ok, c)
+		}
+	})
+}
+
+func TestTOTPURI(t *testing.T) {
+	u, err := url.Parse(totpURI("Guardian", "john@example.com", rfcSecret))
+	require.NoError(t, err)
+
+	require.Equal(t, "otpauth", u.Scheme)
+	require.Equal(t, "totp", u.Host)
+	require.Equal(t, "/Guardian:john@example.com", u.Path)
+	require.Equal(t, url.Values{
+		"secret":    {"GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"},
+		"issuer":    {"Guardian"},
+		"algorithm": {"SHA1"},
+		"digits":    {"6"},
+		"period":    {"30"},
+	}, u.Query())
+}
+
+func TestQRCode(t *testing.T) {
+	b, err := qrCode(totpURI("Guardian", "john@example.com", newTOTPSecret()), 256)
+	require.NoError(t, err)
+
+	img, err := png.Decode(bytes.NewReader(b))
+	require.NoError(t, err)
+	require.Equal(t, 256, img.Bounds().Dx())
+	require.Equal(t, 256, img.Bounds().Dy())
+}
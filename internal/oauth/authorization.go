@@ -0,0 +1,269 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db/queries"
+	"github.com/gophero/guardian/internal/secret"
+)
+
+// AuthorizationStore is a postgres backed [core.OAuthAuthorizationStore]. Replayed authorization codes revoke the
+// refresh token family issued for them in refreshTokens.
+type AuthorizationStore struct {
+	config        Config
+	q             queries.Querier
+	refreshTokens core.RefreshTokenStore
+	now           func() time.Time
+}
+
+var _ core.OAuthAuthorizationStore = (*AuthorizationStore)(nil)
+
+// NewAuthorizationStore constructs new [AuthorizationStore].
+func NewAuthorizationStore(pool *pgxpool.Pool, config Config, refreshTokens core.RefreshTokenStore) (*AuthorizationStore, error) {
+	return newAuthorizationStore(queries.New(pool), config, refreshTokens)
+}
+
+func newAuthorizationStore(q queries.Querier, config Config, refreshTokens core.RefreshTokenStore) (*AuthorizationStore, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return &AuthorizationStore{config: config, q: q, refreshTokens: refreshTokens, now: time.Now}, nil
+}
+
+// CreateRequest implements [core.OAuthAuthorizationStore].
+func (s *AuthorizationStore) CreateRequest(ctx context.Context, params core.CreateOAuthAuthorizationRequestParams) (core.OAuthAuthorizationRequest, error) {
+	r, err := s.q.CreateOAuthAuthorizationRequest(ctx, queries.CreateOAuthAuthorizationRequestParams{
+		ClientID:      params.ClientID,
+		RedirectUri:   params.RedirectURI,
+		Scope:         normalize(params.Scope),
+		State:         params.State,
+		CodeChallenge: params.CodeChallenge,
+		Nonce:         params.Nonce,
+		Prompt:        normalize(params.Prompt),
+		MaxAge:        maxAge(params.MaxAge),
+		ExpiresAt:     s.now().Add(s.config.RequestTTL),
+	})
+	if err != nil {
+		return core.OAuthAuthorizationRequest{}, fmt.Errorf("oauth: create oauth authorization request: %w", mapError(err))
+	}
+
+	return toRequest(r), nil
+}
+
+// GetRequest implements [core.OAuthAuthorizationStore].
+func (s *AuthorizationStore) GetRequest(ctx context.Context, id uuid.UUID) (core.OAuthAuthorizationRequest, error) {
+	r, err := s.q.GetOAuthAuthorizationRequest(ctx, id)
+	if err != nil {
+		return core.OAuthAuthorizationRequest{}, fmt.Errorf("oauth: get oauth authorization request: %w", mapError(err))
+	}
+
+	if !s.now().Before(r.ExpiresAt) {
+		return core.OAuthAuthorizationRequest{}, fmt.Errorf("oauth: get oauth authorization request: %w", core.ErrNotFound)
+	}
+
+	return toRequest(r), nil
+}
+
+// Approve implements [core.OAuthAuthorizationStore].
+func (s *AuthorizationStore) Approve(ctx context.Context, requestID, userID, sessionID uuid.UUID) (core.OAuthAuthorizationRequest, string, error) {
+	r, err := s.answer(ctx, requestID)
+	if err != nil {
+		return core.OAuthAuthorizationRequest{}, "", err
+	}
+
+	var session *uuid.UUID
+	if sessionID != uuid.Nil {
+		session = &sessionID
+	}
+
+	code := secret.New(secret.DefaultSize)
+
+	if _, err := s.q.CreateOAuthAuthorizationCode(ctx, queries.CreateOAuthAuthorizationCodeParams{
+		CodeHash:      secret.Hash(code),
+		ClientID:      r.ClientID,
+		UserID:        userID,
+		SessionID:     session,
+		RedirectUri:   r.RedirectURI,
+		Scope:         r.Scope,
+		CodeChallenge: r.CodeChallenge,
+		Nonce:         r.Nonce,
+		ExpiresAt:     s.now().Add(s.config.CodeTTL),
+	}); err != nil {
+		return core.OAuthAuthorizationRequest{}, "", fmt.Errorf("oauth: create oauth authorization code: %w", mapError(err))
+	}
+
+	return r, code, nil
+}
+
+// Deny implements [core.OAuthAuthorizationStore].
+func (s *AuthorizationStore) Deny(ctx context.Context, requestID uuid.UUID) (core.OAuthAuthorizationRequest, error) {
+	return s.answer(ctx, requestID)
+}
+
+// answer deletes the pending request of id and returns it, so each request is answered once.
+func (s *AuthorizationStore) answer(ctx context.Context, id uuid.UUID) (core.OAuthAuthorizationRequest, error) {
+	r, err := s.q.DeleteOAuthAuthorizationRequest(ctx, id)
+	if err != nil {
+		return core.OAuthAuthorizationRequest{}, fmt.Errorf("oauth: delete oauth authorization request: %w", mapError(err))
+	}
+
+	if !s.now().Before(r.ExpiresAt) {
+		return core.OAuthAuthorizationRequest{}, fmt.Errorf("oauth: delete oauth authorization request: %w", core.ErrNotFound)
+	}
+
+	return toRequest(r), nil
+}
+
+// Exchange implements [core.OAuthAuthorizationStore].
+func (s *AuthorizationStore) Exchange(ctx context.Context, clientID uuid.UUID, code string) (core.OAuthAuthorizationCode, error) {
+	c, err := s.q.GetOAuthAuthorizationCodeByHash(ctx, secret.Hash(code))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return core.OAuthAuthorizationCode{}, core.ErrInvalidToken
+	}
+
+	if err != nil {
+		return core.OAuthAuthorizationCode{}, fmt.Errorf("oauth: get oauth authorization code by hash: %w", err)
+	}
+
+	if c.ClientID != clientID {
+		return core.OAuthAuthorizationCode{}, core.ErrInvalidToken
+	}
+
+	if c.UsedAt != nil {
+		return core.OAuthAuthorizationCode{}, s.handleReuse(ctx, c)
+	}
+
+	if !s.now().Before(c.ExpiresAt) {
+		return core.OAuthAuthorizationCode{}, core.ErrInvalidToken
+	}
+
+	n, err := s.q.UseOAuthAuthorizationCode(ctx, c.ID)
+	if err != nil {
+		return core.OAuthAuthorizationCode{}, fmt.Errorf("oauth: use oauth authorization code: %w", err)
+	}
+
+	// The code was redeemed concurrently.
+	if n == 0 {
+		return core.OAuthAuthorizationCode{}, s.handleReuse(ctx, c)
+	}
+
+	return toCode(c), nil
+}
+
+// handleReuse revokes the refresh token family issued for a replayed code, as the code may have been stolen (RFC 6749,
+// section 4.1.2). It returns [core.ErrInvalidToken] unless revoking the family fails.
+func (s *AuthorizationStore) handleReuse(ctx context.Context, c queries.OauthAuthorizationCode) error {
+	logger := zerolog.Ctx(ctx).With().
+		Stringer("code_id", c.ID).
+		Stringer("client_id", c.ClientID).
+		Stringer("user_id", c.UserID).
+		Logger()
+
+	logger.Warn().Msg("authorization code reuse detected")
+
+	if c.RefreshTokenFamilyID == nil {
+		return core.ErrInvalidToken
+	}
+
+	err := s.refreshTokens.RevokeFamily(ctx, *c.RefreshTokenFamilyID, core.RefreshTokenRevokeCodeReused)
+	if err != nil && !errors.Is(err, core.ErrNotFound) {
+		return err
+	}
+
+	return core.ErrInvalidToken
+}
+
+// BindRefreshTokenFamily implements [core.OAuthAuthorizationStore].
+func (s *AuthorizationStore) BindRefreshTokenFamily(ctx context.Context, codeID, familyID uuid.UUID) error {
+	n, err := s.q.SetOAuthAuthorizationCodeRefreshTokenFamily(ctx, queries.SetOAuthAuthorizationCodeRefreshTokenFamilyParams{
+		ID:                   codeID,
+		RefreshTokenFamilyID: &familyID,
+	})
+	if err != nil {
+		return fmt.Errorf("oauth: set oauth authorization code refresh token family: %w", mapError(err))
+	}
+
+	if n == 0 {
+		return fmt.Errorf("oauth: set oauth authorization code refresh token family: %w", core.ErrNotFound)
+	}
+
+	return nil
+}
+
+// DeleteExpired deletes expired authorization requests and codes and returns the number of deleted rows.
+func (s *AuthorizationStore) DeleteExpired(ctx context.Context) (int64, error) {
+	now := s.now()
+
+	requests, err := s.q.DeleteExpiredOAuthAuthorizationRequests(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("oauth: delete expired oauth authorization requests: %w", err)
+	}
+
+	codes, err := s.q.DeleteExpiredOAuthAuthorizationCodes(ctx, now)
+	if err != nil {
+		return requests, fmt.Errorf("oauth: delete expired oauth authorization codes: %w", err)
+	}
+
+	return requests + codes, nil
+}
+
+// maxAge stores d in whole seconds, rounding up so the limit is never relaxed.
+func maxAge(d *time.Duration) pgtype.Int4 {
+	if d == nil {
+		return pgtype.Int4{}
+	}
+
+	return pgtype.Int4{Int32: int32((*d + time.Second - 1) / time.Second), Valid: true}
+}
+
+func toRequest(r queries.OauthAuthorizationRequest) core.OAuthAuthorizationRequest {
+	req := core.OAuthAuthorizationRequest{
+		ID:            r.ID,
+		ClientID:      r.ClientID,
+		RedirectURI:   r.RedirectUri,
+		Scope:         r.Scope,
+		State:         r.State,
+		CodeChallenge: r.CodeChallenge,
+		Nonce:         r.Nonce,
+		Prompt:        r.Prompt,
+		CreatedAt:     r.CreatedAt,
+		ExpiresAt:     r.ExpiresAt,
+	}
+
+	if r.MaxAge.Valid {
+		d := time.Duration(r.MaxAge.Int32) * time.Second
+		req.MaxAge = &d
+	}
+
+	return req
+}
+
+func toCode(c queries.OauthAuthorizationCode) core.OAuthAuthorizationCode {
+	var sessionID uuid.UUID
+	if c.SessionID != nil {
+		sessionID = *c.SessionID
+	}
+
+	return core.OAuthAuthorizationCode{
+		ID:            c.ID,
+		ClientID:      c.ClientID,
+		UserID:        c.UserID,
+		SessionID:     sessionID,
+		RedirectURI:   c.RedirectUri,
+		Scope:         c.Scope,
+		CodeChallenge: c.CodeChallenge,
+		Nonce:         c.Nonce,
+		ExpiresAt:     c.ExpiresAt,
+	}
+}
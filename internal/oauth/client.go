@@ -0,0 +1,193 @@
+package oauth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/internal/db/queries"
+	"github.com/gophero/guardian/internal/secret"
+)
+
+// ClientStore is a postgres backed [core.OAuthClientStore].
+type ClientStore struct {
+	q queries.Querier
+}
+
+var _ core.OAuthClientStore = (*ClientStore)(nil)
+
+// NewClientStore constructs new [ClientStore].
+func NewClientStore(pool *pgxpool.Pool) *ClientStore {
+	return newClientStore(queries.New(pool))
+}
+
+func newClientStore(q queries.Querier) *ClientStore {
+	return &ClientStore{q: q}
+}
+
+// Create implements [core.OAuthClientStore].
+func (s *ClientStore) Create(ctx context.Context, params core.CreateOAuthClientParams) (core.OAuthClient, string, error) {
+	if err := validateType(params.Type); err != nil {
+		return core.OAuthClient{}, "", err
+	}
+
+	arg := queries.CreateOAuthClientParams{
+		Name:         params.Name,
+		Type:         queries.OauthClientType(params.Type),
+		RedirectUris: normalize(params.RedirectURIs),
+		GrantTypes:   normalize(params.GrantTypes),
+		Scopes:       normalize(params.Scopes),
+		Audiences:    normalize(params.Audiences),
+	}
+
+	if err := validateClient(arg.Name, arg.RedirectUris, arg.GrantTypes, arg.Scopes, arg.Audiences); err != nil {
+		return core.OAuthClient{}, "", err
+	}
+
+	var clientSecret string
+	if params.Type == core.OAuthClientConfidential {
+		clientSecret = secret.New(secret.DefaultSize)
+		arg.SecretHash = secret.Hash(clientSecret)
+	}
+
+	c, err := s.q.CreateOAuthClient(ctx, arg)
+	if err != nil {
+		return core.OAuthClient{}, "", fmt.Errorf("oauth: create oauth client: %w", mapError(err))
+	}
+
+	return toClient(c), clientSecret, nil
+}
+
+// Get implements [core.OAuthClientStore].
+func (s *ClientStore) Get(ctx context.Context, id uuid.UUID) (core.OAuthClient, error) {
+	c, err := s.q.GetOAuthClientByID(ctx, id)
+	if err != nil {
+		return core.OAuthClient{}, fmt.Errorf("oauth: get oauth client by id: %w", mapError(err))
+	}
+
+	return toClient(c), nil
+}
+
+// List implements [core.OAuthClientStore].
+func (s *ClientStore) List(ctx context.Context) ([]core.OAuthClient, error) {
+	cs, err := s.q.ListOAuthClients(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("oauth: list oauth clients: %w", err)
+	}
+
+	res := make([]core.OAuthClient, 0, len(cs))
+	for _, c := range cs {
+		res = append(res, toClient(c))
+	}
+
+	return res, nil
+}
+
+// Update implements [core.OAuthClientStore].
+func (s *ClientStore) Update(ctx context.Context, params core.UpdateOAuthClientParams) (core.OAuthClient, error) {
+	arg := queries.UpdateOAuthClientParams{
+		ID:           params.ID,
+		Name:         params.Name,
+		RedirectUris: normalize(params.RedirectURIs),
+		GrantTypes:   normalize(params.GrantTypes),
+		Scopes:       normalize(params.Scopes),
+		Audiences:    normalize(params.Audiences),
+	}
+
+	if err := validateClient(arg.Name, arg.RedirectUris, arg.GrantTypes, arg.Scopes, arg.Audiences); err != nil {
+		return core.OAuthClient{}, err
+	}
+
+	c, err := s.q.UpdateOAuthClient(ctx, arg)
+	if err != nil {
+		return core.OAuthClient{}, fmt.Errorf("oauth: update oauth client: %w", mapError(err))
+	}
+
+	return toClient(c), nil
+}
+
+// Delete implements [core.OAuthClientStore].
+func (s *ClientStore) Delete(ctx context.Context, id uuid.UUID) error {
+	n, err := s.q.DeleteOAuthClient(ctx, id)
+	if err != nil {
+		return fmt.Errorf("oauth: delete oauth client: %w", err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("oauth: delete oauth client: %w", core.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Authenticate implements [core.OAuthClientStore].
+func (s *ClientStore) Authenticate(ctx context.Context, id uuid.UUID, clientSecret string) (core.OAuthClient, error) {
+	c, err := s.q.GetOAuthClientByID(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return core.OAuthClient{}, core.ErrInvalidCredentials
+	}
+
+	if err != nil {
+		return core.OAuthClient{}, fmt.Errorf("oauth: get oauth client by id: %w", err)
+	}
+
+	if c.Type != queries.OauthClientTypeConfidential || subtle.ConstantTimeCompare(secret.Hash(clientSecret), c.SecretHash) != 1 {
+		return core.OAuthClient{}, core.ErrInvalidCredentials
+	}
+
+	return toClient(c), nil
+}
+
+func validateClient(name string, redirectURIs, grants, scopes, audiences []string) error {
+	if err := validateName(name); err != nil {
+		return err
+	}
+
+	if err := validateRedirectURIs(redirectURIs, grants); err != nil {
+		return err
+	}
+
+	if err := validateGrantTypes(grants); err != nil {
+		return err
+	}
+
+	if err := validateScopes(scopes); err != nil {
+		return err
+	}
+
+	return validateAudiences(audiences)
+}
+
+func mapError(err error) error {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return core.ErrNotFound
+	case db.IsUniqueViolation(err):
+		return core.ErrAlreadyExists
+	case db.IsForeignKeyViolation(err):
+		return core.ErrNotFound
+	default:
+		return err
+	}
+}
+
+func toClient(c queries.OauthClient) core.OAuthClient {
+	return core.OAuthClient{
+		ID:           c.ID,
+		Name:         c.Name,
+		Type:         core.OAuthClientType(c.Type),
+		RedirectURIs: c.RedirectUris,
+		GrantTypes:   c.GrantTypes,
+		Scopes:       c.Scopes,
+		Audiences:    c.Audiences,
+		CreatedAt:    c.CreatedAt,
+		UpdatedAt:    c.UpdatedAt,
+	}
+}
@@ -0,0 +1,95 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gophero/guardian/core"
+)
+
+// clientAssertionType is the client_assertion_type of JWT client assertions, RFC 7523, section 2.2.
+const clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// serviceAccountToken implements the client credentials grant of RFC 6749, section 4.4 for service accounts. The
+// requested scope may only narrow the scopes of the account. No refresh token is issued, as the account can request
+// another access token at any time.
+func (h *Handler) serviceAccountToken(ctx context.Context, r *http.Request, form url.Values) (tokenResponse, error) {
+	account, err := h.authenticateServiceAccount(ctx, r)
+	if err != nil {
+		return tokenResponse{}, err
+	}
+
+	scope, err := requestScope(account.Scopes, form.Get("scope"))
+	if err != nil {
+		return tokenResponse{}, err
+	}
+
+	return h.issue(ctx, core.AccessTokenParams{
+		Subject:  account.ID.String(),
+		ClientID: account.ID.String(),
+		Scope:    scope,
+	})
+}
+
+// authenticateServiceAccount authenticates the service account of a token request with client_secret_basic,
+// client_secret_post or private_key_jwt, RFC 7523, section 2.2.
+func (h *Handler) authenticateServiceAccount(ctx context.Context, r *http.Request) (core.ServiceAccount, error) {
+	form := r.PostForm
+	if !form.Has("client_assertion") && !form.Has("client_assertion_type") {
+		id, accountSecret, err := clientCredentials(r)
+		if err != nil {
+			return core.ServiceAccount{}, err
+		}
+
+		if accountSecret == "" {
+			return core.ServiceAccount{}, newError(errInvalidClient, "client authentication failed")
+		}
+
+		account, err := h.serviceAccounts.Authenticate(ctx, id, accountSecret)
+		if errors.Is(err, core.ErrInvalidCredentials) {
+			return core.ServiceAccount{}, newError(errInvalidClient, "client authentication failed")
+		}
+
+		return account, err
+	}
+
+	if _, _, basic := r.BasicAuth(); basic || form.Has("client_secret") {
+		return core.ServiceAccount{}, newError(errInvalidRequest, "multiple client authentication methods")
+	}
+
+	if form.Get("client_assertion_type") != clientAssertionType {
+		return core.ServiceAccount{}, newError(errInvalidRequest, "unsupported client_assertion_type")
+	}
+
+	assertion := form.Get("client_assertion")
+	if assertion == "" {
+		return core.ServiceAccount{}, newError(errInvalidRequest, "client_assertion is required")
+	}
+
+	account, err := h.serviceAccounts.AuthenticateAssertion(ctx, assertion, h.assertionAudiences())
+	if errors.Is(err, core.ErrInvalidCredentials) {
+		return core.ServiceAccount{}, newError(errInvalidClient, "client authentication failed")
+	}
+
+	if err != nil {
+		return core.ServiceAccount{}, err
+	}
+
+	// The client_id is optional, but has to identify the account if it is sent, RFC 7521, section 4.2.
+	if id := form.Get("client_id"); id != "" && id != account.ID.String() {
+		return core.ServiceAccount{}, newError(errInvalidClient, "client_id does not match the client assertion")
+	}
+
+	return account, nil
+}
+
+// assertionAudiences are the values identifying the authorization server in the audience of client assertions, its
+// issuer identifier and its token endpoint, RFC 7523, section 3.
+func (h *Handler) assertionAudiences() []string {
+	issuer := h.idTokens.Issuer()
+
+	return []string{issuer, strings.TrimSuffix(issuer, "/") + "/token"}
+}
@@ -0,0 +1,380 @@
+package oauth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+// fakeQuerier keeps clients, authorization requests, codes, device authorizations and revoked tokens in memory. Queries not used by the stores panic.
+type fakeQuerier struct {
+	queries.Querier
+
+	clients  map[uuid.UUID]queries.OauthClient
+	requests map[uuid.UUID]queries.OauthAuthorizationRequest
+	codes    map[uuid.UUID]queries.OauthAuthorizationCode
+	devices  map[uuid.UUID]queries.OauthDeviceAuthorization
+	revoked  map[string]queries.OauthRevokedAccessToken
+}
+
+func newFakeQuerier() *fakeQuerier {
+	return &fakeQuerier{
+		clients:  map[uuid.UUID]queries.OauthClient{},
+		requests: map[uuid.UUID]queries.OauthAuthorizationRequest{},
+		codes:    map[uuid.UUID]queries.OauthAuthorizationCode{},
+		devices:  map[uuid.UUID]queries.OauthDeviceAuthorization{},
+		revoked:  map[string]queries.OauthRevokedAccessToken{},
+	}
+}
+
+func (f *fakeQuerier) CreateOAuthClient(_ context.Context, arg queries.CreateOAuthClientParams) (queries.OauthClient, error) {
+	c := queries.OauthClient{
+		ID:           uuid.New(),
+		Name:         arg.Name,
+		Type:         arg.Type,
+		SecretHash:   arg.SecretHash,
+		RedirectUris: arg.RedirectUris,
+		GrantTypes:   arg.GrantTypes,
+		Scopes:       arg.Scopes,
+		Audiences:    arg.Audiences,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	f.clients[c.ID] = c
+
+	return c, nil
+}
+
+func (f *fakeQuerier) GetOAuthClientByID(_ context.Context, id uuid.UUID) (queries.OauthClient, error) {
+	c, ok := f.clients[id]
+	if !ok {
+		return queries.OauthClient{}, pgx.ErrNoRows
+	}
+
+	return c, nil
+}
+
+func (f *fakeQuerier) UpdateOAuthClient(_ context.Context, arg queries.UpdateOAuthClientParams) (queries.OauthClient, error) {
+	c, ok := f.clients[arg.ID]
+	if !ok {
+		return queries.OauthClient{}, pgx.ErrNoRows
+	}
+
+	c.Name, c.RedirectUris, c.GrantTypes, c.Scopes, c.Audiences = arg.Name, arg.RedirectUris, arg.GrantTypes, arg.Scopes, arg.Audiences
+	f.clients[c.ID] = c
+
+	return c, nil
+}
+
+func (f *fakeQuerier) CreateOAuthAuthorizationRequest(_ context.Context, arg queries.CreateOAuthAuthorizationRequestParams) (queries.OauthAuthorizationRequest, error) {
+	r := queries.OauthAuthorizationRequest{
+		ID:            uuid.New(),
+		ClientID:      arg.ClientID,
+		RedirectUri:   arg.RedirectUri,
+		Scope:         arg.Scope,
+		State:         arg.State,
+		CodeChallenge: arg.CodeChallenge,
+		Nonce:         arg.Nonce,
+		Prompt:        arg.Prompt,
+		MaxAge:        arg.MaxAge,
+		CreatedAt:     time.Now(),
+		ExpiresAt:     arg.ExpiresAt,
+	}
+	f.requests[r.ID] = r
+
+	return r, nil
+}
+
+func (f *fakeQuerier) GetOAuthAuthorizationRequest(_ context.Context, id uuid.UUID) (queries.OauthAuthorizationRequest, error) {
+	r, ok := f.requests[id]
+	if !ok {
+		return queries.OauthAuthorizationRequest{}, pgx.ErrNoRows
+	}
+
+	return r, nil
+}
+
+func (f *fakeQuerier) DeleteOAuthAuthorizationRequest(_ context.Context, id uuid.UUID) (queries.OauthAuthorizationRequest, error) {
+	r, ok := f.requests[id]
+	if !ok {
+		return queries.OauthAuthorizationRequest{}, pgx.ErrNoRows
+	}
+
+	delete(f.requests, id)
+
+	return r, nil
+}
+
+func (f *fakeQuerier) CreateOAuthAuthorizationCode(_ context.Context, arg queries.CreateOAuthAuthorizationCodeParams) (queries.OauthAuthorizationCode, error) {
+	c := queries.OauthAuthorizationCode{
+		ID:            uuid.New(),
+		CodeHash:      arg.CodeHash,
+		ClientID:      arg.ClientID,
+		UserID:        arg.UserID,
+		SessionID:     arg.SessionID,
+		RedirectUri:   arg.RedirectUri,
+		Scope:         arg.Scope,
+		CodeChallenge: arg.CodeChallenge,
+		Nonce:         arg.Nonce,
+		CreatedAt:     time.Now(),
+		ExpiresAt:     arg.ExpiresAt,
+	}
+	f.codes[c.ID] = c
+
+	return c, nil
+}
+
+func (f *fakeQuerier) GetOAuthAuthorizationCodeByHash(_ context.Context, hash []byte) (queries.OauthAuthorizationCode, error) {
+	for _, c := range f.codes {
+		if string(c.CodeHash) == string(hash) {
+			return c, nil
+		}
+	}
+
+	return queries.OauthAuthorizationCode{}, pgx.ErrNoRows
+}
+
+func (f *fakeQuerier) UseOAuthAuthorizationCode(_ context.Context, id uuid.UUID) (int64, error) {
+	c, ok := f.codes[id]
+	if !ok || c.UsedAt != nil {
+		return 0, nil
+	}
+
+	now := time.Now()
+	c.UsedAt = &now
+	f.codes[id] = c
+
+	return 1, nil
+}
+
+func (f *fakeQuerier) SetOAuthAuthorizationCodeRefreshTokenFamily(_ context.Context, arg queries.SetOAuthAuthorizationCodeRefreshTokenFamilyParams) (int64, error) {
+	c, ok := f.codes[arg.ID]
+	if !ok {
+		return 0, nil
+	}
+
+	c.RefreshTokenFamilyID = arg.RefreshTokenFamilyID
+	f.codes[c.ID] = c
+
+	return 1, nil
+}
+
+func (f *fakeQuerier) CreateOAuthDeviceAuthorization(_ context.Context, arg queries.CreateOAuthDeviceAuthorizationParams) (queries.OauthDeviceAuthorization, error) {
+	d := queries.OauthDeviceAuthorization{
+		ID:             uuid.New(),
+		DeviceCodeHash: arg.DeviceCodeHash,
+		UserCode:       arg.UserCode,
+		ClientID:       arg.ClientID,
+		Scope:          arg.Scope,
+		Status:         queries.OauthDeviceAuthorizationStatusPending,
+		PollInterval:   arg.PollInterval,
+		CreatedAt:      time.Now(),
+		ExpiresAt:      arg.ExpiresAt,
+	}
+	f.devices[d.ID] = d
+
+	return d, nil
+}
+
+func (f *fakeQuerier) GetOAuthDeviceAuthorizationByUserCode(_ context.Context, userCode string) (queries.OauthDeviceAuthorization, error) {
+	for _, d := range f.devices {
+		if d.UserCode == userCode {
+			return d, nil
+		}
+	}
+
+	return queries.OauthDeviceAuthorization{}, pgx.ErrNoRows
+}
+
+func (f *fakeQuerier) GetOAuthDeviceAuthorizationByDeviceCodeHash(_ context.Context, hash []byte) (queries.OauthDeviceAuthorization, error) {
+	for _, d := range f.devices {
+		if string(d.DeviceCodeHash) == string(hash) {
+			return d, nil
+		}
+	}
+
+	return queries.OauthDeviceAuthorization{}, pgx.ErrNoRows
+}
+
+func (f *fakeQuerier) AnswerOAuthDeviceAuthorization(ctx context.Context, arg queries.AnswerOAuthDeviceAuthorizationParams) (queries.OauthDeviceAuthorization, error) {
+	d, err := f.GetOAuthDeviceAuthorizationByUserCode(ctx, arg.UserCode)
+	if err != nil || d.Status != queries.OauthDeviceAuthorizationStatusPending || !arg.Now.Before(d.ExpiresAt) {
+		return queries.OauthDeviceAuthorization{}, pgx.ErrNoRows
+	}
+
+	d.Status, d.UserID, d.SessionID = arg.Status, arg.UserID, arg.SessionID
+	f.devices[d.ID] = d
+
+	return d, nil
+}
+
+func (f *fakeQuerier) PollOAuthDeviceAuthorization(_ context.Context, arg queries.PollOAuthDeviceAuthorizationParams) (int64, error) {
+	d, ok := f.devices[arg.ID]
+	if !ok || (d.PolledAt == nil) != (arg.PreviousPolledAt == nil) || d.PolledAt != nil && !d.PolledAt.Equal(*arg.PreviousPolledAt) {
+		return 0, nil
+	}
+
+	d.PolledAt, d.PollInterval = arg.PolledAt, arg.PollInterval
+	f.devices[d.ID] = d
+
+	return 1, nil
+}
+
+func (f *fakeQuerier) DeleteOAuthDeviceAuthorization(_ context.Context, id uuid.UUID) (int64, error) {
+	if _, ok := f.devices[id]; !ok {
+		return 0, nil
+	}
+
+	delete(f.devices, id)
+
+	return 1, nil
+}
+
+func (f *fakeQuerier) DeleteExpiredOAuthDeviceAuthorizations(_ context.Context, before time.Time) (int64, error) {
+	var n int64
+	for id, d := range f.devices {
+		if d.ExpiresAt.Before(before) {
+			delete(f.devices, id)
+			n++
+		}
+	}
+
+	return n, nil
+}
+
+func (f *fakeQuerier) RevokeOAuthAccessToken(_ context.Context, arg queries.RevokeOAuthAccessTokenParams) error {
+	if _, ok := f.revoked[arg.TokenID]; !ok {
+		f.revoked[arg.TokenID] = queries.OauthRevokedAccessToken{TokenID: arg.TokenID, ExpiresAt: arg.ExpiresAt, RevokedAt: time.Now()}
+	}
+
+	return nil
+}
+
+func (f *fakeQuerier) IsOAuthAccessTokenRevoked(_ context.Context, tokenID string) (bool, error) {
+	_, ok := f.revoked[tokenID]
+	return ok, nil
+}
+
+func (f *fakeQuerier) DeleteExpiredOAuthRevokedAccessTokens(_ context.Context, before time.Time) (int64, error) {
+	var n int64
+	for id, t := range f.revoked {
+		if t.ExpiresAt.Before(before) {
+			delete(f.revoked, id)
+			n++
+		}
+	}
+
+	return n, nil
+}
+
+func TestClientStore(t *testing.T) {
+	ctx := context.Background()
+	s := newClientStore(newFakeQuerier())
+
+	valid := core.CreateOAuthClientParams{
+		Name:         "web",
+		Type:         core.OAuthClientConfidential,
+		RedirectURIs: []string{"https://app.example.com/callback"},
+		GrantTypes:   []string{core.OAuthGrantRefreshToken, core.OAuthGrantAuthorizationCode},
+		Scopes:       []string{"profile", "documents.read", "profile"},
+		Audiences:    []string{"https://api.example.com", "documents"},
+	}
+
+	t.Run("creates clients", func(t *testing.T) {
+		client, clientSecret, err := s.Create(ctx, valid)
+		require.NoError(t, err)
+		require.NotEmpty(t, clientSecret)
+		require.Equal(t, []string{"documents.read", "profile"}, client.Scopes)
+		require.Equal(t, []string{core.OAuthGrantAuthorizationCode, core.OAuthGrantRefreshToken}, client.GrantTypes)
+		require.Equal(t, []string{"documents", "https://api.example.com"}, client.Audiences)
+
+		got, err := s.Authenticate(ctx, client.ID, clientSecret)
+		require.NoError(t, err)
+		require.Equal(t, client.ID, got.ID)
+
+		_, err = s.Authenticate(ctx, client.ID, clientSecret+"x")
+		require.ErrorIs(t, err, core.ErrInvalidCredentials)
+
+		_, err = s.Authenticate(ctx, uuid.New(), clientSecret)
+		require.ErrorIs(t, err, core.ErrInvalidCredentials)
+	})
+
+	t.Run("public clients have no secret", func(t *testing.T) {
+		params := valid
+		params.Type = core.OAuthClientPublic
+		params.RedirectURIs = []string{"com.example.app:/callback", "http://127.0.0.1/callback"}
+
+		client, clientSecret, err := s.Create(ctx, params)
+		require.NoError(t, err)
+		require.Empty(t, clientSecret)
+
+		_, err = s.Authenticate(ctx, client.ID, "")
+		require.ErrorIs(t, err, core.ErrInvalidCredentials)
+	})
+
+	t.Run("validates clients", func(t *testing.T) {
+		for name, modify := range map[string]func(p *core.CreateOAuthClientParams){
+			"empty name":           func(p *core.CreateOAuthClientParams) { p.Name = " " },
+			"unknown type":         func(p *core.CreateOAuthClientParams) { p.Type = "trusted" },
+			"no redirect uris":     func(p *core.CreateOAuthClientParams) { p.RedirectURIs = nil },
+			"relative redirect":    func(p *core.CreateOAuthClientParams) { p.RedirectURIs = []string{"/callback"} },
+			"redirect fragment":    func(p *core.CreateOAuthClientParams) { p.RedirectURIs = []string{"https://app.example.com/#callback"} },
+			"plain http":           func(p *core.CreateOAuthClientParams) { p.RedirectURIs = []string{"http://app.example.com/callback"} },
+			"custom scheme":        func(p *core.CreateOAuthClientParams) { p.RedirectURIs = []string{"myapp:/callback"} },
+			"no grant types":       func(p *core.CreateOAuthClientParams) { p.GrantTypes = nil },
+			"implicit grant":       func(p *core.CreateOAuthClientParams) { p.GrantTypes = []string{"implicit"} },
+			"scope with space":     func(p *core.CreateOAuthClientParams) { p.Scopes = []string{"documents read"} },
+			"scope with backslash": func(p *core.CreateOAuthClientParams) { p.Scopes = []string{`documents\read`} },
+			"empty audience":       func(p *core.CreateOAuthClientParams) { p.Audiences = []string{""} },
+			"audience with space":  func(p *core.CreateOAuthClientParams) { p.Audiences = []string{"documents api"} },
+		} {
+			t.Run(name, func(t *testing.T) {
+				params := valid
+				modify(&params)
+
+				_, _, err := s.Create(ctx, params)
+				require.ErrorIs(t, err, core.ErrInvalidArgument)
+			})
+		}
+	})
+
+	t.Run("devices need no redirect uris", func(t *testing.T) {
+		params := valid
+		params.Type = core.OAuthClientPublic
+		params.RedirectURIs = nil
+		params.GrantTypes = []string{core.OAuthGrantDeviceCode, core.OAuthGrantRefreshToken}
+
+		client, _, err := s.Create(ctx, params)
+		require.NoError(t, err)
+		require.NotNil(t, client.RedirectURIs)
+		require.Empty(t, client.RedirectURIs)
+	})
+
+	t.Run("updates clients", func(t *testing.T) {
+		client, _, err := s.Create(ctx, valid)
+		require.NoError(t, err)
+
+		updated, err := s.Update(ctx, core.UpdateOAuthClientParams{
+			ID:           client.ID,
+			Name:         "web",
+			RedirectURIs: []string{"https://app.example.com/callback", "https://app.example.com/callback"},
+			GrantTypes:   []string{core.OAuthGrantAuthorizationCode},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"https://app.example.com/callback"}, updated.RedirectURIs)
+		require.NotNil(t, updated.Scopes)
+		require.Empty(t, updated.Scopes)
+		require.NotNil(t, updated.Audiences)
+		require.Empty(t, updated.Audiences)
+
+		_, err = s.Update(ctx, core.UpdateOAuthClientParams{ID: uuid.New(), Name: "web", RedirectURIs: valid.RedirectURIs, GrantTypes: valid.GrantTypes})
+		require.ErrorIs(t, err, core.ErrNotFound)
+	})
+}
@@ -0,0 +1,54 @@
+package oauth
+
+import (
+	"errors"
+	"net/url"
+	"time"
+)
+
+type Config struct {
+	LoginURL   string        `help:"URL of the login page to which the authorization endpoint redirects users with the id of their authorization request in the request_id query parameter." name:"login_url" env:"LOGIN_URL" default:"http://localhost:3000/oauth/login"`
+	RequestTTL time.Duration `help:"Duration for which users can sign in and consent to an authorization request." name:"request_ttl" env:"REQUEST_TTL" default:"10m"`
+	CodeTTL    time.Duration `help:"Duration for which issued authorization codes can be exchanged for tokens." name:"code_ttl" env:"CODE_TTL" default:"1m"`
+
+	DeviceVerificationURL string        `help:"URL of the verification page on which users enter the user code of a device authorization, which is passed in the user_code query parameter of the complete verification URL." name:"device_verification_url" env:"DEVICE_VERIFICATION_URL" default:"http://localhost:3000/oauth/device"`
+	DeviceCodeTTL         time.Duration `help:"Duration for which users can answer a device authorization and the device can redeem it." name:"device_code_ttl" env:"DEVICE_CODE_TTL" default:"10m"`
+	DevicePollInterval    time.Duration `help:"Minimum duration between two polls of a device, increased by 5s whenever the device polls faster." name:"device_poll_interval" env:"DEVICE_POLL_INTERVAL" default:"5s"`
+
+	IntrospectionCacheTTL time.Duration `help:"Duration for which resource servers may cache introspection responses of active tokens, never beyond the expiry of the token. Revoked tokens may be accepted for as long. Zero disables caching." name:"introspection_cache_ttl" env:"INTROSPECTION_CACHE_TTL" default:"30s"`
+}
+
+func (c Config) validate() error {
+	if u, err := url.Parse(c.LoginURL); err != nil || !u.IsAbs() {
+		return errors.New("oauth: LoginURL must be an absolute URL")
+	}
+
+	if c.RequestTTL <= 0 {
+		return errors.New("oauth: RequestTTL cannot be zero or negative")
+	}
+
+	// RFC 6749, section 4.1.2 recommends a maximum lifetime of 10 minutes.
+	if c.CodeTTL <= 0 || c.CodeTTL > 10*time.Minute {
+		return errors.New("oauth: CodeTTL must be between zero and 10m")
+	}
+
+	if u, err := url.Parse(c.DeviceVerificationURL); err != nil || !u.IsAbs() {
+		return errors.New("oauth: DeviceVerificationURL must be an absolute URL")
+	}
+
+	if c.DeviceCodeTTL <= 0 || c.DeviceCodeTTL > time.Hour {
+		return errors.New("oauth: DeviceCodeTTL must be between zero and 1h")
+	}
+
+	// Intervals are sent to devices in whole seconds, RFC 8628, section 3.2.
+	if c.DevicePollInterval < time.Second || c.DevicePollInterval%time.Second != 0 {
+		return errors.New("oauth: DevicePollInterval must be a whole number of seconds of at least 1s")
+	}
+
+	// The max-age directive is sent in whole seconds.
+	if c.IntrospectionCacheTTL < 0 || c.IntrospectionCacheTTL > 5*time.Minute || c.IntrospectionCacheTTL%time.Second != 0 {
+		return errors.New("oauth: IntrospectionCacheTTL must be a whole number of seconds between 0s and 5m")
+	}
+
+	return nil
+}
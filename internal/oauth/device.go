@@ -0,0 +1,291 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/internal/db/queries"
+	"github.com/gophero/guardian/internal/secret"
+)
+
+// userCodeAlphabet has no vowels, so user codes do not spell words, and no digits or letters which are easily confused,
+// as recommended by RFC 8628, section 6.1.
+const userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+
+// userCodeLength gives about 34 bits of entropy, which is enough as user codes expire after minutes and have to be
+// entered by signed in users.
+const userCodeLength = 8
+
+// slowDownIncrement is added to the interval of devices polling too fast, RFC 8628, section 3.5.
+const slowDownIncrement = 5 * time.Second
+
+// maxUserCodeAttempts bounds the retries of user codes colliding with the code of another authorization.
+const maxUserCodeAttempts = 3
+
+// DeviceStore is a postgres backed [core.OAuthDeviceAuthorizationStore].
+type DeviceStore struct {
+	config Config
+	q      queries.Querier
+	now    func() time.Time
+}
+
+var _ core.OAuthDeviceAuthorizationStore = (*DeviceStore)(nil)
+
+// NewDeviceStore constructs new [DeviceStore].
+func NewDeviceStore(pool *pgxpool.Pool, config Config) (*DeviceStore, error) {
+	return newDeviceStore(queries.New(pool), config)
+}
+
+func newDeviceStore(q queries.Querier, config Config) (*DeviceStore, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return &DeviceStore{config: config, q: q, now: time.Now}, nil
+}
+
+// Create implements [core.OAuthDeviceAuthorizationStore].
+func (s *DeviceStore) Create(ctx context.Context, params core.CreateOAuthDeviceAuthorizationParams) (core.OAuthDeviceAuthorization, string, error) {
+	deviceCode := secret.New(secret.DefaultSize)
+
+	for attempt := 1; ; attempt++ {
+		d, err := s.q.CreateOAuthDeviceAuthorization(ctx, queries.CreateOAuthDeviceAuthorizationParams{
+			DeviceCodeHash: secret.Hash(deviceCode),
+			UserCode:       newUserCode(),
+			ClientID:       params.ClientID,
+			Scope:          normalize(params.Scope),
+			PollInterval:   int32(s.config.DevicePollInterval / time.Second),
+			ExpiresAt:      s.now().Add(s.config.DeviceCodeTTL),
+		})
+		if db.IsUniqueViolation(err) && attempt < maxUserCodeAttempts {
+			continue
+		}
+
+		if err != nil {
+			return core.OAuthDeviceAuthorization{}, "", fmt.Errorf("oauth: create oauth device authorization: %w", mapError(err))
+		}
+
+		return toDeviceAuthorization(d), deviceCode, nil
+	}
+}
+
+// Get implements [core.OAuthDeviceAuthorizationStore].
+func (s *DeviceStore) Get(ctx context.Context, userCode string) (core.OAuthDeviceAuthorization, error) {
+	code, ok := NormalizeUserCode(userCode)
+	if !ok {
+		return core.OAuthDeviceAuthorization{}, fmt.Errorf("oauth: get oauth device authorization: %w", core.ErrNotFound)
+	}
+
+	d, err := s.q.GetOAuthDeviceAuthorizationByUserCode(ctx, code)
+	if err != nil {
+		return core.OAuthDeviceAuthorization{}, fmt.Errorf("oauth: get oauth device authorization by user code: %w", mapError(err))
+	}
+
+	if d.Status != queries.OauthDeviceAuthorizationStatusPending || !s.now().Before(d.ExpiresAt) {
+		return core.OAuthDeviceAuthorization{}, fmt.Errorf("oauth: get oauth device authorization by user code: %w", core.ErrNotFound)
+	}
+
+	return toDeviceAuthorization(d), nil
+}
+
+// Approve implements [core.OAuthDeviceAuthorizationStore].
+func (s *DeviceStore) Approve(ctx context.Context, userCode string, userID, sessionID uuid.UUID) (core.OAuthDeviceAuthorization, error) {
+	var session *uuid.UUID
+	if sessionID != uuid.Nil {
+		session = &sessionID
+	}
+
+	return s.answer(ctx, userCode, queries.OauthDeviceAuthorizationStatusApproved, &userID, session)
+}
+
+// Deny implements [core.OAuthDeviceAuthorizationStore].
+func (s *DeviceStore) Deny(ctx context.Context, userCode string) (core.OAuthDeviceAuthorization, error) {
+	return s.answer(ctx, userCode, queries.OauthDeviceAuthorizationStatusDenied, nil, nil)
+}
+
+// answer sets the status of the pending authorization of userCode, so each authorization is answered once.
+func (s *DeviceStore) answer(
+	ctx context.Context,
+	userCode string,
+	status queries.OauthDeviceAuthorizationStatus,
+	userID, sessionID *uuid.UUID,
+) (core.OAuthDeviceAuthorization, error) {
+	code, ok := NormalizeUserCode(userCode)
+	if !ok {
+		return core.OAuthDeviceAuthorization{}, fmt.Errorf("oauth: answer oauth device authorization: %w", core.ErrNotFound)
+	}
+
+	d, err := s.q.AnswerOAuthDeviceAuthorization(ctx, queries.AnswerOAuthDeviceAuthorizationParams{
+		Status:    status,
+		UserID:    userID,
+		SessionID: sessionID,
+		UserCode:  code,
+		Now:       s.now(),
+	})
+	if err != nil {
+		return core.OAuthDeviceAuthorization{}, fmt.Errorf("oauth: answer oauth device authorization: %w", mapError(err))
+	}
+
+	return toDeviceAuthorization(d), nil
+}
+
+// Poll implements [core.OAuthDeviceAuthorizationStore].
+func (s *DeviceStore) Poll(ctx context.Context, clientID uuid.UUID, deviceCode string) (core.OAuthDeviceAuthorization, error) {
+	d, err := s.q.GetOAuthDeviceAuthorizationByDeviceCodeHash(ctx, secret.Hash(deviceCode))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return core.OAuthDeviceAuthorization{}, core.ErrInvalidToken
+	}
+
+	if err != nil {
+		return core.OAuthDeviceAuthorization{}, fmt.Errorf("oauth: get oauth device authorization by device code hash: %w", err)
+	}
+
+	if d.ClientID != clientID {
+		return core.OAuthDeviceAuthorization{}, core.ErrInvalidToken
+	}
+
+	now := s.now()
+	if !now.Before(d.ExpiresAt) {
+		return core.OAuthDeviceAuthorization{}, core.ErrAuthorizationExpired
+	}
+
+	if d.Status == queries.OauthDeviceAuthorizationStatusPending {
+		return core.OAuthDeviceAuthorization{}, s.poll(ctx, d, now)
+	}
+
+	// Answered authorizations are redeemed once.
+	n, err := s.q.DeleteOAuthDeviceAuthorization(ctx, d.ID)
+	if err != nil {
+		return core.OAuthDeviceAuthorization{}, fmt.Errorf("oauth: delete oauth device authorization: %w", err)
+	}
+
+	if n == 0 {
+		return core.OAuthDeviceAuthorization{}, core.ErrInvalidToken
+	}
+
+	if d.Status == queries.OauthDeviceAuthorizationStatusDenied {
+		return core.OAuthDeviceAuthorization{}, core.ErrAuthorizationDenied
+	}
+
+	return toDeviceAuthorization(d), nil
+}
+
+// poll records a poll of the pending authorization d at now and returns [core.ErrSlowDown] if the device polled before
+// its interval passed, [core.ErrAuthorizationPending] otherwise.
+func (s *DeviceStore) poll(ctx context.Context, d queries.OauthDeviceAuthorization, now time.Time) error {
+	interval := time.Duration(d.PollInterval) * time.Second
+
+	slowDown := d.PolledAt != nil && now.Before(d.PolledAt.Add(interval))
+	if slowDown {
+		interval += slowDownIncrement
+	}
+
+	n, err := s.q.PollOAuthDeviceAuthorization(ctx, queries.PollOAuthDeviceAuthorizationParams{
+		PolledAt:         &now,
+		PollInterval:     int32(interval / time.Second),
+		ID:               d.ID,
+		PreviousPolledAt: d.PolledAt,
+	})
+	if err != nil {
+		return fmt.Errorf("oauth: poll oauth device authorization: %w", err)
+	}
+
+	// Another poll was recorded concurrently, so the device polls too fast.
+	if slowDown || n == 0 {
+		return core.ErrSlowDown
+	}
+
+	return core.ErrAuthorizationPending
+}
+
+// DeleteExpired deletes expired device authorizations and returns the number of deleted rows.
+func (s *DeviceStore) DeleteExpired(ctx context.Context) (int64, error) {
+	n, err := s.q.DeleteExpiredOAuthDeviceAuthorizations(ctx, s.now())
+	if err != nil {
+		return 0, fmt.Errorf("oauth: delete expired oauth device authorizations: %w", err)
+	}
+
+	return n, nil
+}
+
+// newUserCode returns a random user code of [userCodeLength] characters of [userCodeAlphabet].
+func newUserCode() string {
+	// Bytes at or above the largest multiple of the alphabet size are rejected, so each character is uniform.
+	limit := byte(256 - 256%len(userCodeAlphabet))
+
+	code := make([]byte, 0, userCodeLength)
+	buf := make([]byte, 2*userCodeLength)
+	for len(code) < userCodeLength {
+		_, _ = rand.Read(buf)
+		for _, b := range buf {
+			if b < limit && len(code) < userCodeLength {
+				code = append(code, userCodeAlphabet[int(b)%len(userCodeAlphabet)])
+			}
+		}
+	}
+
+	return string(code)
+}
+
+// NormalizeUserCode returns the user code as stored, in upper case without separators or whitespace, and whether it
+// is a well-formed user code at all.
+func NormalizeUserCode(userCode string) (string, bool) {
+	code := strings.Map(func(r rune) rune {
+		switch {
+		case r == '-' || r == ' ' || r == '\t':
+			return -1
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		default:
+			return r
+		}
+	}, userCode)
+
+	if len(code) != userCodeLength || strings.Trim(code, userCodeAlphabet) != "" {
+		return "", false
+	}
+
+	return code, true
+}
+
+// FormatUserCode formats a normalized user code for display in two groups separated by a dash, like `BCDF-GHJK`.
+func FormatUserCode(userCode string) string {
+	if len(userCode) != userCodeLength {
+		return userCode
+	}
+
+	return userCode[:userCodeLength/2] + "-" + userCode[userCodeLength/2:]
+}
+
+func toDeviceAuthorization(d queries.OauthDeviceAuthorization) core.OAuthDeviceAuthorization {
+	res := core.OAuthDeviceAuthorization{
+		ID:        d.ID,
+		ClientID:  d.ClientID,
+		UserCode:  d.UserCode,
+		Scope:     d.Scope,
+		Status:    core.OAuthDeviceAuthorizationStatus(d.Status),
+		Interval:  time.Duration(d.PollInterval) * time.Second,
+		CreatedAt: d.CreatedAt,
+		ExpiresAt: d.ExpiresAt,
+	}
+
+	if d.UserID != nil {
+		res.UserID = *d.UserID
+	}
+
+	if d.SessionID != nil {
+		res.SessionID = *d.SessionID
+	}
+
+	return res
+}
@@ -0,0 +1,150 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/gophero/guardian/core"
+)
+
+// deviceAuthorizationResponse is the successful response of RFC 8628, section 3.2.
+type deviceAuthorizationResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval"`
+}
+
+// deviceAuthorization implements the device authorization endpoint of RFC 8628, section 3.1. Clients authenticate as
+// at the token endpoint.
+func (h *Handler) deviceAuthorization(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		h.writeError(w, r, "device_authorization", newError(errInvalidRequest, "malformed request"))
+		return
+	}
+
+	res, err := h.authorizeDevice(r.Context(), r)
+	if err != nil {
+		h.writeError(w, r, "device_authorization", err)
+		return
+	}
+
+	writeJSON(w, r, http.StatusOK, res)
+}
+
+func (h *Handler) authorizeDevice(ctx context.Context, r *http.Request) (deviceAuthorizationResponse, error) {
+	if err := singleValued(r.PostForm, "client_id", "client_secret", "scope"); err != nil {
+		return deviceAuthorizationResponse{}, err
+	}
+
+	client, err := h.authenticateClient(ctx, r)
+	if err != nil {
+		return deviceAuthorizationResponse{}, err
+	}
+
+	if !client.AllowsGrant(core.OAuthGrantDeviceCode) {
+		return deviceAuthorizationResponse{}, newError(errUnauthorizedClient, "client may not use the device authorization grant")
+	}
+
+	scope, err := requestScope(client.Scopes, r.PostForm.Get("scope"))
+	if err != nil {
+		return deviceAuthorizationResponse{}, err
+	}
+
+	d, deviceCode, err := h.devices.Create(ctx, core.CreateOAuthDeviceAuthorizationParams{ClientID: client.ID, Scope: scope})
+	if err != nil {
+		return deviceAuthorizationResponse{}, err
+	}
+
+	userCode := FormatUserCode(d.UserCode)
+
+	complete := *h.verificationURL
+	q := complete.Query()
+	q.Set("user_code", userCode)
+	complete.RawQuery = q.Encode()
+
+	return deviceAuthorizationResponse{
+		DeviceCode:              deviceCode,
+		UserCode:                userCode,
+		VerificationURI:         h.verificationURL.String(),
+		VerificationURIComplete: complete.String(),
+		ExpiresIn:               int64(d.ExpiresAt.Sub(h.now()).Seconds()),
+		Interval:                int64(d.Interval.Seconds()),
+	}, nil
+}
+
+// deviceToken implements the device access token request of RFC 8628, section 3.4. Devices poll until the user
+// answered the authorization, the tokens are then bound to the session the user approved it in.
+func (h *Handler) deviceToken(ctx context.Context, client core.OAuthClient, form url.Values) (tokenResponse, error) {
+	if !client.AllowsGrant(core.OAuthGrantDeviceCode) {
+		return tokenResponse{}, newError(errUnauthorizedClient, "client may not use the device authorization grant")
+	}
+
+	deviceCode := form.Get("device_code")
+	if deviceCode == "" {
+		return tokenResponse{}, newError(errInvalidRequest, "device_code is required")
+	}
+
+	d, err := h.devices.Poll(ctx, client.ID, deviceCode)
+	switch {
+	case errors.Is(err, core.ErrAuthorizationPending):
+		return tokenResponse{}, newError(errAuthorizationPending, "the user has not answered the authorization yet")
+	case errors.Is(err, core.ErrSlowDown):
+		return tokenResponse{}, newError(errSlowDown, "polling too fast, the interval was increased by 5 seconds")
+	case errors.Is(err, core.ErrAuthorizationDenied):
+		return tokenResponse{}, newError(errAccessDenied, "the user denied the authorization")
+	case errors.Is(err, core.ErrAuthorizationExpired):
+		return tokenResponse{}, newError(errExpiredToken, "the device code expired")
+	case errors.Is(err, core.ErrInvalidToken):
+		return tokenResponse{}, newError(errInvalidGrant, "invalid device code")
+	case err != nil:
+		return tokenResponse{}, err
+	}
+
+	_, sess, err := h.checkActive(ctx, d.UserID, d.SessionID)
+	if err != nil {
+		return tokenResponse{}, err
+	}
+
+	params := core.AccessTokenParams{
+		Subject:   d.UserID.String(),
+		SessionID: d.SessionID,
+		ClientID:  client.ID.String(),
+		Scope:     d.Scope,
+	}
+
+	var refreshToken string
+	if client.AllowsGrant(core.OAuthGrantRefreshToken) {
+		var rt core.RefreshToken
+		rt, refreshToken, err = h.refreshTokens.Issue(ctx, core.IssueRefreshTokenParams{
+			UserID:    d.UserID,
+			SessionID: d.SessionID,
+			ClientID:  client.ID.String(),
+			Scope:     d.Scope,
+		})
+		if err != nil {
+			return tokenResponse{}, err
+		}
+
+		params.FamilyID = rt.FamilyID
+	}
+
+	res, err := h.issue(ctx, params)
+	if err != nil {
+		return tokenResponse{}, err
+	}
+
+	// Device authorizations carry no nonce, the device code already binds the response to the request.
+	res.IDToken, err = h.idToken(ctx, client, d.UserID, sess, d.Scope, "")
+	if err != nil {
+		return tokenResponse{}, err
+	}
+
+	res.RefreshToken = refreshToken
+
+	return res, nil
+}
@@ -0,0 +1,168 @@
+package oauth
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+)
+
+func TestUserCodes(t *testing.T) {
+	for range 100 {
+		code := newUserCode()
+		require.Len(t, code, userCodeLength)
+		require.Empty(t, strings.Trim(code, userCodeAlphabet))
+
+		normalized, ok := NormalizeUserCode(strings.ToLower(FormatUserCode(code)))
+		require.True(t, ok)
+		require.Equal(t, code, normalized)
+	}
+
+	for _, code := range []string{"", "BCDF-GHJ", "BCDF-GHJKL", "BCDF-GHJ0", "BCDA-GHJK", "BCDF_GHJK"} {
+		_, ok := NormalizeUserCode(code)
+		require.False(t, ok, code)
+	}
+
+	normalized, ok := NormalizeUserCode(" bcdf ghjk ")
+	require.True(t, ok)
+	require.Equal(t, "BCDFGHJK", normalized)
+}
+
+func TestDeviceStore(t *testing.T) {
+	ctx := context.Background()
+	config := Config{
+		LoginURL:              "https://id.example.com/login",
+		RequestTTL:            10 * time.Minute,
+		CodeTTL:               time.Minute,
+		DeviceVerificationURL: "https://id.example.com/device",
+		DeviceCodeTTL:         10 * time.Minute,
+		DevicePollInterval:    5 * time.Second,
+	}
+
+	q := newFakeQuerier()
+	s, err := newDeviceStore(q, config)
+	require.NoError(t, err)
+
+	now := time.Now()
+	s.now = func() time.Time { return now }
+
+	clientID := uuid.New()
+
+	create := func(t *testing.T) (core.OAuthDeviceAuthorization, string) {
+		t.Helper()
+
+		d, deviceCode, err := s.Create(ctx, core.CreateOAuthDeviceAuthorizationParams{ClientID: clientID, Scope: []string{"profile", "openid"}})
+		require.NoError(t, err)
+		require.NotEmpty(t, deviceCode)
+
+		return d, deviceCode
+	}
+
+	t.Run("redeems approved authorizations once", func(t *testing.T) {
+		d, deviceCode := create(t)
+		require.Equal(t, core.OAuthDeviceAuthorizationPending, d.Status)
+		require.Equal(t, []string{"openid", "profile"}, d.Scope)
+		require.Equal(t, 5*time.Second, d.Interval)
+
+		got, err := s.Get(ctx, strings.ToLower(FormatUserCode(d.UserCode)))
+		require.NoError(t, err)
+		require.Equal(t, d.ID, got.ID)
+
+		_, err = s.Poll(ctx, clientID, deviceCode)
+		require.ErrorIs(t, err, core.ErrAuthorizationPending)
+
+		userID, sessionID := uuid.New(), uuid.New()
+		approved, err := s.Approve(ctx, d.UserCode, userID, sessionID)
+		require.NoError(t, err)
+		require.Equal(t, core.OAuthDeviceAuthorizationApproved, approved.Status)
+
+		_, err = s.Deny(ctx, d.UserCode)
+		require.ErrorIs(t, err, core.ErrNotFound)
+
+		_, err = s.Get(ctx, d.UserCode)
+		require.ErrorIs(t, err, core.ErrNotFound)
+
+		_, err = s.Poll(ctx, uuid.New(), deviceCode)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+
+		redeemed, err := s.Poll(ctx, clientID, deviceCode)
+		require.NoError(t, err)
+		require.Equal(t, userID, redeemed.UserID)
+		require.Equal(t, sessionID, redeemed.SessionID)
+
+		_, err = s.Poll(ctx, clientID, deviceCode)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+	})
+
+	t.Run("slows down fast devices", func(t *testing.T) {
+		d, deviceCode := create(t)
+
+		_, err := s.Poll(ctx, clientID, deviceCode)
+		require.ErrorIs(t, err, core.ErrAuthorizationPending)
+
+		now = now.Add(time.Second)
+		_, err = s.Poll(ctx, clientID, deviceCode)
+		require.ErrorIs(t, err, core.ErrSlowDown)
+		require.EqualValues(t, 10, q.devices[d.ID].PollInterval)
+
+		now = now.Add(5 * time.Second)
+		_, err = s.Poll(ctx, clientID, deviceCode)
+		require.ErrorIs(t, err, core.ErrSlowDown)
+		require.EqualValues(t, 15, q.devices[d.ID].PollInterval)
+
+		now = now.Add(15 * time.Second)
+		_, err = s.Poll(ctx, clientID, deviceCode)
+		require.ErrorIs(t, err, core.ErrAuthorizationPending)
+	})
+
+	t.Run("reports denials once", func(t *testing.T) {
+		d, deviceCode := create(t)
+
+		_, err := s.Deny(ctx, d.UserCode)
+		require.NoError(t, err)
+
+		_, err = s.Poll(ctx, clientID, deviceCode)
+		require.ErrorIs(t, err, core.ErrAuthorizationDenied)
+
+		_, err = s.Poll(ctx, clientID, deviceCode)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+	})
+
+	t.Run("expires authorizations", func(t *testing.T) {
+		d, deviceCode := create(t)
+
+		now = now.Add(config.DeviceCodeTTL)
+
+		_, err := s.Get(ctx, d.UserCode)
+		require.ErrorIs(t, err, core.ErrNotFound)
+
+		_, err = s.Approve(ctx, d.UserCode, uuid.New(), uuid.Nil)
+		require.ErrorIs(t, err, core.ErrNotFound)
+
+		_, err = s.Poll(ctx, clientID, deviceCode)
+		require.ErrorIs(t, err, core.ErrAuthorizationExpired)
+
+		now = now.Add(time.Second)
+
+		n, err := s.DeleteExpired(ctx)
+		require.NoError(t, err)
+		require.Positive(t, n)
+		require.NotContains(t, q.devices, d.ID)
+	})
+
+	t.Run("rejects unknown codes", func(t *testing.T) {
+		_, err := s.Get(ctx, "AAAA-AAAA")
+		require.ErrorIs(t, err, core.ErrNotFound)
+
+		_, err = s.Approve(ctx, "BCDF-GHJK", uuid.New(), uuid.Nil)
+		require.ErrorIs(t, err, core.ErrNotFound)
+
+		_, err = s.Poll(ctx, clientID, "unknown")
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+	})
+}
@@ -0,0 +1,77 @@
+package oauth
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Error codes of RFC 6749, sections 4.1.2.1 and 5.2.
+const (
+	errInvalidRequest          = "invalid_request"
+	errInvalidClient           = "invalid_client"
+	errInvalidGrant            = "invalid_grant"
+	errUnauthorizedClient      = "unauthorized_client"
+	errUnsupportedGrantType    = "unsupported_grant_type"
+	errUnsupportedResponseType = "unsupported_response_type"
+	errInvalidScope            = "invalid_scope"
+	errAccessDenied            = "access_denied"
+	errServerError             = "server_error"
+)
+
+// Error codes of bearer token requests, RFC 6750, section 3.1.
+const (
+	errInvalidToken      = "invalid_token"
+	errInsufficientScope = "insufficient_scope"
+)
+
+// Error codes of the device access token response, RFC 8628, section 3.5.
+const (
+	errAuthorizationPending = "authorization_pending"
+	errSlowDown             = "slow_down"
+	errExpiredToken         = "expired_token"
+)
+
+// Error codes the login page may deny a request with, OpenID Connect Core 1.0, section 3.1.2.6.
+const (
+	errLoginRequired            = "login_required"
+	errConsentRequired          = "consent_required"
+	errInteractionRequired      = "interaction_required"
+	errAccountSelectionRequired = "account_selection_required"
+)
+
+// ValidDenialError reports whether a request may be denied with the error code, which is access_denied if the user
+// refused the request or one of the OpenID Connect errors if the request cannot be answered without interacting with
+// the user, as for prompt=none.
+func ValidDenialError(code string) bool {
+	switch code {
+	case errAccessDenied, errLoginRequired, errConsentRequired, errInteractionRequired, errAccountSelectionRequired:
+		return true
+	default:
+		return false
+	}
+}
+
+// errorResponse is an OAuth error response. Its description is shown to developers of clients, so it must not reveal
+// anything about users.
+type errorResponse struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+
+	status int
+}
+
+func (e *errorResponse) Error() string {
+	return fmt.Sprintf("oauth: %s: %s", e.Code, e.Description)
+}
+
+func newError(code string, format string, args ...any) *errorResponse {
+	status := http.StatusBadRequest
+	switch code {
+	case errInvalidClient, errInvalidToken:
+		status = http.StatusUnauthorized
+	case errInsufficientScope:
+		status = http.StatusForbidden
+	}
+
+	return &errorResponse{Code: code, Description: fmt.Sprintf(format, args...), status: status}
+}
@@ -0,0 +1,655 @@
+// Package oauth implements an OAuth 2.1 authorization server, issuing access and refresh tokens to registered clients
+// with the authorization code grant and mandatory PKCE, and access tokens to service accounts with the client
+// credentials grant. Devices without a browser obtain tokens with the device authorization grant. Resource servers
+// introspect tokens and clients revoke them at the endpoints of RFC 7662 and RFC 7009. It is also an OpenID Connect
+// provider issuing ID tokens to clients requesting the openid scope.
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog"
+
+	"github.com/gophero/guardian/core"
+)
+
+// Handler is a [http.Handler] serving the authorization endpoint at `/authorize`, the token endpoint at `/token`, the
+// device authorization endpoint at `/device_authorization`, the introspection endpoint at `/introspect`, the
+// revocation endpoint at `/revoke`, the userinfo endpoint at `/userinfo` and the OpenID Provider metadata at
+// `/.well-known/openid-configuration`. The metadata advertises the endpoints relative to the
+// issuer of ID tokens, so the handler has to be served at the issuer URL.
+//
+// Valid authorization requests are stored and the user agent is redirected to the configured login URL, which signs
+// the user in and answers the request with [core.OAuthAuthorizationStore.Approve] or
+// [core.OAuthAuthorizationStore.Deny]. Device authorizations are answered on the verification page in the same way
+// with [core.OAuthDeviceAuthorizationStore]. Access tokens issued along with a refresh token are revoked with its
+// family. It is also a [prometheus.Collector] exporting token and error metrics.
+type Handler struct {
+	clients         core.OAuthClientStore
+	serviceAccounts core.ServiceAccountStore
+	authorizations  core.OAuthAuthorizationStore
+	devices         core.OAuthDeviceAuthorizationStore
+	users           core.UserStore
+	profiles        core.UserProfileStore
+	sessions        core.SessionStore
+	refreshTokens   core.RefreshTokenStore
+	revokedTokens   core.AccessTokenRevocationStore
+	accessTokens    core.AccessTokenIssuer
+	idTokens        core.IDTokenIssuer
+	loginURL        *url.URL
+	verificationURL *url.URL
+	cacheTTL        time.Duration
+	now             func() time.Time
+	mux             *http.ServeMux
+
+	*metrics
+}
+
+var (
+	_ http.Handler         = (*Handler)(nil)
+	_ prometheus.Collector = (*Handler)(nil)
+)
+
+// NewHandler constructs new [Handler]. Tokens are only issued while the user, and the session the authorization was
+// approved in, are active.
+func NewHandler(
+	config Config,
+	clients core.OAuthClientStore,
+	serviceAccounts core.ServiceAccountStore,
+	authorizations core.OAuthAuthorizationStore,
+	devices core.OAuthDeviceAuthorizationStore,
+	users core.UserStore,
+	profiles core.UserProfileStore,
+	sessions core.SessionStore,
+	refreshTokens core.RefreshTokenStore,
+	revokedTokens core.AccessTokenRevocationStore,
+	accessTokens core.AccessTokenIssuer,
+	idTokens core.IDTokenIssuer,
+) (*Handler, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	loginURL, err := url.Parse(config.LoginURL)
+	if err != nil {
+		return nil, err
+	}
+
+	verificationURL, err := url.Parse(config.DeviceVerificationURL)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &Handler{
+		clients:         clients,
+		serviceAccounts: serviceAccounts,
+		authorizations:  authorizations,
+		devices:         devices,
+		users:           users,
+		profiles:        profiles,
+		sessions:        sessions,
+		refreshTokens:   refreshTokens,
+		revokedTokens:   revokedTokens,
+		accessTokens:    accessTokens,
+		idTokens:        idTokens,
+		loginURL:        loginURL,
+		verificationURL: verificationURL,
+		cacheTTL:        config.IntrospectionCacheTTL,
+		now:             time.Now,
+		mux:             http.NewServeMux(),
+		metrics:         newMetrics(),
+	}
+
+	h.mux.HandleFunc("GET /authorize", h.authorize)
+	h.mux.HandleFunc("POST /authorize", h.authorize)
+	h.mux.HandleFunc("POST /token", h.token)
+	h.mux.HandleFunc("POST /device_authorization", h.deviceAuthorization)
+	h.mux.HandleFunc("POST /introspect", h.introspect)
+	h.mux.HandleFunc("POST /revoke", h.revoke)
+	h.mux.HandleFunc("GET /userinfo", h.userinfo)
+	h.mux.HandleFunc("POST /userinfo", h.userinfo)
+	h.mux.HandleFunc("GET /.well-known/openid-configuration", h.discovery)
+
+	return h, nil
+}
+
+// ServeHTTP implements [http.Handler].
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *Handler) authorize(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		h.writeError(w, r, "authorize", newError(errInvalidRequest, "malformed request"))
+		return
+	}
+
+	client, redirectURI, err := h.authorizeClient(r.Context(), r.Form)
+	if err != nil {
+		// Without a registered redirect uri the error cannot be sent to the client, RFC 6749, section 4.1.2.1.
+		h.writeError(w, r, "authorize", err)
+		return
+	}
+
+	loginURL, err := h.authorizeRequest(r.Context(), client, redirectURI, r.Form)
+	if err != nil {
+		h.redirectError(w, r, redirectURI, r.Form.Get("state"), err)
+		return
+	}
+
+	http.Redirect(w, r, loginURL, http.StatusFound)
+}
+
+// authorizeClient returns the client of an authorization request along with its redirect uri, which may only be
+// omitted if the client has a single one.
+func (h *Handler) authorizeClient(ctx context.Context, form url.Values) (core.OAuthClient, string, error) {
+	if err := singleValued(form, "client_id", "redirect_uri"); err != nil {
+		return core.OAuthClient{}, "", err
+	}
+
+	id, err := uuid.Parse(form.Get("client_id"))
+	if err != nil {
+		return core.OAuthClient{}, "", newError(errInvalidRequest, "unknown client")
+	}
+
+	client, err := h.clients.Get(ctx, id)
+	if errors.Is(err, core.ErrNotFound) {
+		return core.OAuthClient{}, "", newError(errInvalidRequest, "unknown client")
+	}
+
+	if err != nil {
+		return core.OAuthClient{}, "", err
+	}
+
+	redirectURI := form.Get("redirect_uri")
+	if redirectURI == "" && len(client.RedirectURIs) == 1 {
+		redirectURI = client.RedirectURIs[0]
+	}
+
+	if !client.AllowsRedirectURI(redirectURI) {
+		return core.OAuthClient{}, "", newError(errInvalidRequest, "redirect_uri is not registered for the client")
+	}
+
+	return client, redirectURI, nil
+}
+
+// authorizeRequest validates and stores an authorization request of client and returns the login URL to redirect the
+// user agent to.
+func (h *Handler) authorizeRequest(ctx context.Context, client core.OAuthClient, redirectURI string, form url.Values) (string, error) {
+	if err := singleValued(form, "response_type", "scope", "state", "code_challenge", "code_challenge_method", "nonce", "prompt", "max_age"); err != nil {
+		return "", err
+	}
+
+	if form.Get("response_type") != "code" {
+		return "", newError(errUnsupportedResponseType, "response_type must be code")
+	}
+
+	if !client.AllowsGrant(core.OAuthGrantAuthorizationCode) {
+		return "", newError(errUnauthorizedClient, "client may not use the authorization code grant")
+	}
+
+	challenge := form.Get("code_challenge")
+	if challenge == "" {
+		return "", newError(errInvalidRequest, "code_challenge is required")
+	}
+
+	if form.Get("code_challenge_method") != codeChallengeMethod {
+		return "", newError(errInvalidRequest, "code_challenge_method must be %s", codeChallengeMethod)
+	}
+
+	if !validChallenge(challenge) {
+		return "", newError(errInvalidRequest, "malformed code_challenge")
+	}
+
+	scope, err := requestScope(client.Scopes, form.Get("scope"))
+	if err != nil {
+		return "", err
+	}
+
+	nonce := form.Get("nonce")
+	if len(nonce) > maxNonceLength {
+		return "", newError(errInvalidRequest, "nonce is longer than %d bytes", maxNonceLength)
+	}
+
+	prompt, err := parsePrompt(form.Get("prompt"))
+	if err != nil {
+		return "", err
+	}
+
+	maxAge, err := parseMaxAge(form.Get("max_age"))
+	if err != nil {
+		return "", err
+	}
+
+	req, err := h.authorizations.CreateRequest(ctx, core.CreateOAuthAuthorizationRequestParams{
+		ClientID:      client.ID,
+		RedirectURI:   redirectURI,
+		Scope:         scope,
+		State:         form.Get("state"),
+		CodeChallenge: challenge,
+		Nonce:         nonce,
+		Prompt:        prompt,
+		MaxAge:        maxAge,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	u := *h.loginURL
+	q := u.Query()
+	q.Set("request_id", req.ID.String())
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// tokenResponse is the successful response of RFC 6749, section 5.1.
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+}
+
+func (h *Handler) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		h.writeError(w, r, "token", newError(errInvalidRequest, "malformed request"))
+		return
+	}
+
+	// Parameters of the token endpoint are only accepted in the body.
+	form := r.PostForm
+	if err := singleValued(form, "grant_type", "client_id", "client_secret", "client_assertion", "client_assertion_type", "code", "redirect_uri", "code_verifier", "refresh_token", "device_code", "scope"); err != nil {
+		h.writeError(w, r, "token", err)
+		return
+	}
+
+	var (
+		res tokenResponse
+		err error
+	)
+
+	grantType := form.Get("grant_type")
+	if grantType == core.OAuthGrantClientCredentials {
+		// Service accounts are no registered clients, they authenticate with credentials of their own.
+		res, err = h.serviceAccountToken(r.Context(), r, form)
+	} else {
+		res, err = h.grant(r.Context(), r, grantType, form)
+	}
+
+	if err != nil {
+		h.writeError(w, r, "token", err)
+		return
+	}
+
+	h.issued.WithLabelValues(grantType).Inc()
+
+	writeJSON(w, r, http.StatusOK, res)
+}
+
+// grant authenticates a registered client and answers its token request with the grant of grantType.
+func (h *Handler) grant(ctx context.Context, r *http.Request, grantType string, form url.Values) (tokenResponse, error) {
+	client, err := h.authenticateClient(ctx, r)
+	if err != nil {
+		return tokenResponse{}, err
+	}
+
+	switch grantType {
+	case core.OAuthGrantAuthorizationCode:
+		return h.exchangeCode(ctx, client, form)
+	case core.OAuthGrantRefreshToken:
+		return h.refresh(ctx, r, client, form)
+	case core.OAuthGrantDeviceCode:
+		return h.deviceToken(ctx, client, form)
+	case "":
+		return tokenResponse{}, newError(errInvalidRequest, "grant_type is required")
+	default:
+		return tokenResponse{}, newError(errUnsupportedGrantType, "unsupported grant_type")
+	}
+}
+
+// authenticateClient authenticates the client of a token request. Confidential clients authenticate with
+// client_secret_basic or client_secret_post, public clients only send their client_id.
+func (h *Handler) authenticateClient(ctx context.Context, r *http.Request) (core.OAuthClient, error) {
+	clientID, clientSecret, err := clientCredentials(r)
+	if err != nil {
+		return core.OAuthClient{}, err
+	}
+
+	if clientSecret != "" {
+		client, err := h.clients.Authenticate(ctx, clientID, clientSecret)
+		if errors.Is(err, core.ErrInvalidCredentials) {
+			return core.OAuthClient{}, newError(errInvalidClient, "client authentication failed")
+		}
+
+		return client, err
+	}
+
+	client, err := h.clients.Get(ctx, clientID)
+	if errors.Is(err, core.ErrNotFound) || err == nil && client.Type != core.OAuthClientPublic {
+		return core.OAuthClient{}, newError(errInvalidClient, "client authentication failed")
+	}
+
+	return client, err
+}
+
+// clientCredentials returns the client id of a token request along with the secret sent with client_secret_basic or
+// client_secret_post, which is empty if the client did not send one.
+func clientCredentials(r *http.Request) (uuid.UUID, string, error) {
+	id, clientSecret, basic := r.BasicAuth()
+	if basic {
+		if r.PostForm.Has("client_secret") {
+			return uuid.Nil, "", newError(errInvalidRequest, "multiple client authentication methods")
+		}
+
+		// Credentials are form encoded before they are basic encoded, RFC 6749, section 2.3.1.
+		var idErr, secretErr error
+		id, idErr = url.QueryUnescape(id)
+		clientSecret, secretErr = url.QueryUnescape(clientSecret)
+		if idErr != nil || secretErr != nil {
+			return uuid.Nil, "", newError(errInvalidClient, "malformed client credentials")
+		}
+
+		if r.PostForm.Has("client_id") && r.PostForm.Get("client_id") != id {
+			return uuid.Nil, "", newError(errInvalidRequest, "client_id does not match the authenticated client")
+		}
+	} else {
+		id, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+
+	clientID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, "", newError(errInvalidClient, "client authentication failed")
+	}
+
+	return clientID, clientSecret, nil
+}
+
+// exchangeCode implements the authorization code grant of RFC 6749, section 4.1.3 with the PKCE verification of RFC
+// 7636, section 4.6.
+func (h *Handler) exchangeCode(ctx context.Context, client core.OAuthClient, form url.Values) (tokenResponse, error) {
+	if !client.AllowsGrant(core.OAuthGrantAuthorizationCode) {
+		return tokenResponse{}, newError(errUnauthorizedClient, "client may not use the authorization code grant")
+	}
+
+	code, verifier := form.Get("code"), form.Get("code_verifier")
+	if code == "" {
+		return tokenResponse{}, newError(errInvalidRequest, "code is required")
+	}
+
+	if verifier == "" {
+		return tokenResponse{}, newError(errInvalidRequest, "code_verifier is required")
+	}
+
+	c, err := h.authorizations.Exchange(ctx, client.ID, code)
+	if errors.Is(err, core.ErrInvalidToken) {
+		return tokenResponse{}, newError(errInvalidGrant, "invalid authorization code")
+	}
+
+	if err != nil {
+		return tokenResponse{}, err
+	}
+
+	if uri := form.Get("redirect_uri"); uri != "" && uri != c.RedirectURI {
+		return tokenResponse{}, newError(errInvalidGrant, "redirect_uri does not match the authorization request")
+	}
+
+	if !verifyChallenge(verifier, c.CodeChallenge) {
+		return tokenResponse{}, newError(errInvalidGrant, "code_verifier does not match the code_challenge")
+	}
+
+	_, sess, err := h.checkActive(ctx, c.UserID, c.SessionID)
+	if err != nil {
+		return tokenResponse{}, err
+	}
+
+	params := core.AccessTokenParams{
+		Subject:   c.UserID.String(),
+		SessionID: c.SessionID,
+		ClientID:  client.ID.String(),
+		Scope:     c.Scope,
+	}
+
+	// The refresh token is issued first, so the access token is revoked along with its family.
+	var refreshToken string
+	if client.AllowsGrant(core.OAuthGrantRefreshToken) {
+		var rt core.RefreshToken
+		rt, refreshToken, err = h.refreshTokens.Issue(ctx, core.IssueRefreshTokenParams{
+			UserID:    c.UserID,
+			SessionID: c.SessionID,
+			ClientID:  client.ID.String(),
+			Scope:     c.Scope,
+		})
+		if err != nil {
+			return tokenResponse{}, err
+		}
+
+		if err := h.authorizations.BindRefreshTokenFamily(ctx, c.ID, rt.FamilyID); err != nil {
+			return tokenResponse{}, err
+		}
+
+		params.FamilyID = rt.FamilyID
+	}
+
+	res, err := h.issue(ctx, params)
+	if err != nil {
+		return tokenResponse{}, err
+	}
+
+	res.IDToken, err = h.idToken(ctx, client, c.UserID, sess, c.Scope, c.Nonce)
+	if err != nil {
+		return tokenResponse{}, err
+	}
+
+	res.RefreshToken = refreshToken
+
+	return res, nil
+}
+
+// refresh implements the refresh token grant of RFC 6749, section 6. Refresh tokens rotate on every use and the
+// requested scope may only narrow the scope of the access token.
+func (h *Handler) refresh(ctx context.Context, r *http.Request, client core.OAuthClient, form url.Values) (tokenResponse, error) {
+	if !client.AllowsGrant(core.OAuthGrantRefreshToken) {
+		return tokenResponse{}, newError(errUnauthorizedClient, "client may not use the refresh token grant")
+	}
+
+	token := form.Get("refresh_token")
+	if token == "" {
+		return tokenResponse{}, newError(errInvalidRequest, "refresh_token is required")
+	}
+
+	rt, refreshToken, err := h.refreshTokens.Rotate(ctx, token, metadata(r))
+	if errors.Is(err, core.ErrInvalidToken) {
+		return tokenResponse{}, newError(errInvalidGrant, "invalid refresh token")
+	}
+
+	if err != nil {
+		return tokenResponse{}, err
+	}
+
+	// A token presented by another client leaked, so its family cannot be trusted anymore.
+	if rt.ClientID != client.ID.String() {
+		h.revokeFamily(ctx, rt.FamilyID)
+		return tokenResponse{}, newError(errInvalidGrant, "invalid refresh token")
+	}
+
+	_, sess, err := h.checkActive(ctx, rt.UserID, rt.SessionID)
+	if err != nil {
+		if res := (*errorResponse)(nil); errors.As(err, &res) {
+			h.revokeFamily(ctx, rt.FamilyID)
+		}
+		return tokenResponse{}, err
+	}
+
+	scope, err := requestScope(rt.Scope, form.Get("scope"))
+	if err != nil {
+		return tokenResponse{}, err
+	}
+
+	res, err := h.issue(ctx, core.AccessTokenParams{
+		Subject:   rt.UserID.String(),
+		SessionID: rt.SessionID,
+		ClientID:  client.ID.String(),
+		Scope:     scope,
+		FamilyID:  rt.FamilyID,
+	})
+	if err != nil {
+		return tokenResponse{}, err
+	}
+
+	// Refreshed ID tokens carry no nonce, OpenID Connect Core 1.0, section 12.2.
+	res.IDToken, err = h.idToken(ctx, client, rt.UserID, sess, scope, "")
+	if err != nil {
+		return tokenResponse{}, err
+	}
+
+	res.RefreshToken = refreshToken
+
+	return res, nil
+}
+
+// checkActive returns the user and the session, which may be [uuid.Nil] and is then returned as zero value. It returns
+// an invalid_grant error if the user is no longer active or the session ended.
+func (h *Handler) checkActive(ctx context.Context, userID, sessionID uuid.UUID) (core.User, core.Session, error) {
+	user, err := h.users.Get(ctx, userID)
+	if errors.Is(err, core.ErrNotFound) || err == nil && user.Status != core.UserStatusActive {
+		return core.User{}, core.Session{}, newError(errInvalidGrant, "the user is not active")
+	}
+
+	if err != nil || sessionID == uuid.Nil {
+		return user, core.Session{}, err
+	}
+
+	now := h.now()
+
+	sess, err := h.sessions.Get(ctx, sessionID)
+	if errors.Is(err, core.ErrNotFound) || err == nil && (sess.RevokedAt != nil || !now.Before(sess.ExpiresAt) || !now.Before(sess.IdleExpiresAt)) {
+		return core.User{}, core.Session{}, newError(errInvalidGrant, "the session ended")
+	}
+
+	return user, sess, err
+}
+
+func (h *Handler) revokeFamily(ctx context.Context, familyID uuid.UUID) {
+	if err := h.refreshTokens.RevokeFamily(ctx, familyID, core.RefreshTokenRevokeSecurity); err != nil && !errors.Is(err, core.ErrNotFound) {
+		zerolog.Ctx(ctx).Err(err).Stringer("family_id", familyID).Msg("failed to revoke refresh token family")
+	}
+}
+
+func (h *Handler) issue(ctx context.Context, params core.AccessTokenParams) (tokenResponse, error) {
+	token, claims, err := h.accessTokens.Issue(ctx, params)
+	if err != nil {
+		return tokenResponse{}, err
+	}
+
+	return tokenResponse{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(claims.ExpiresAt.Sub(claims.IssuedAt).Seconds()),
+		Scope:       strings.Join(params.Scope, " "),
+	}, nil
+}
+
+// redirectError sends err to the client by redirecting the user agent to redirectURI.
+func (h *Handler) redirectError(w http.ResponseWriter, r *http.Request, redirectURI, state string, err error) {
+	var res *errorResponse
+	if !errors.As(err, &res) {
+		zerolog.Ctx(r.Context()).Err(err).Msg("failed to authorize")
+		res = newError(errServerError, "internal error")
+	}
+
+	u, err := redirect(redirectURI, state, url.Values{"error": {res.Code}, "error_description": {res.Description}})
+	if err != nil {
+		h.writeError(w, r, "authorize", err)
+		return
+	}
+
+	h.errors.WithLabelValues("authorize", res.Code).Inc()
+
+	http.Redirect(w, r, u, http.StatusFound)
+}
+
+// writeError writes err as JSON error response. Errors which are no [errorResponse] are logged and hidden.
+func (h *Handler) writeError(w http.ResponseWriter, r *http.Request, endpoint string, err error) {
+	var res *errorResponse
+	if !errors.As(err, &res) {
+		zerolog.Ctx(r.Context()).Err(err).Str("endpoint", endpoint).Msg("oauth request failed")
+		res = newError(errServerError, "internal error")
+		res.status = http.StatusInternalServerError
+	}
+
+	h.errors.WithLabelValues(endpoint, res.Code).Inc()
+
+	switch res.Code {
+	case errInvalidClient:
+		w.Header().Set("WWW-Authenticate", `Basic realm="guardian"`)
+	case errInvalidToken, errInsufficientScope:
+		// Errors of protected resources are sent in the challenge, RFC 6750, section 3.
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="guardian", error=%q, error_description=%q`, res.Code, res.Description))
+	}
+
+	writeJSON(w, r, res.status, res)
+}
+
+// writeJSON writes v as JSON. Responses carry tokens or are specific to a request, so they must not be cached.
+func writeJSON(w http.ResponseWriter, r *http.Request, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		zerolog.Ctx(r.Context()).Err(err).Msg("failed to write oauth response")
+	}
+}
+
+// singleValued returns an invalid_request error if any of the parameters is repeated, RFC 6749, section 3.1.
+func singleValued(form url.Values, names ...string) error {
+	for _, name := range names {
+		if len(form[name]) > 1 {
+			return newError(errInvalidRequest, "parameter %s is repeated", name)
+		}
+	}
+
+	return nil
+}
+
+// requestScope parses the space delimited scope parameter, defaulting to all allowed scopes if it is empty. It returns
+// an invalid_scope error if any of the requested scopes is not allowed.
+func requestScope(allowed []string, scope string) ([]string, error) {
+	requested := strings.Fields(scope)
+	if len(requested) == 0 {
+		return allowed, nil
+	}
+
+	for _, s := range requested {
+		if !slices.Contains(allowed, s) {
+			return nil, newError(errInvalidScope, "scope %s is not allowed", s)
+		}
+	}
+
+	return normalize(requested), nil
+}
+
+// metadata describes the client of r.
+func metadata(r *http.Request) core.SessionMetadata {
+	meta := core.SessionMetadata{UserAgent: r.UserAgent()}
+
+	if addrPort, err := netip.ParseAddrPort(r.RemoteAddr); err == nil {
+		meta.IPAddress = addrPort.Addr().Unmap()
+	}
+
+	return meta
+}
@@ -0,0 +1,1385 @@
+package oauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+)
+
+// fakeRefreshTokens rotates refresh tokens in memory, revoking the family of reused tokens like the real store.
+type fakeRefreshTokens struct {
+	core.RefreshTokenStore
+
+	tokens  map[string]core.RefreshToken
+	used    map[string]bool
+	revoked map[uuid.UUID]core.RefreshTokenRevokeReason
+}
+
+func (f *fakeRefreshTokens) Issue(_ context.Context, params core.IssueRefreshTokenParams) (core.RefreshToken, string, error) {
+	rt := core.RefreshToken{
+		ID:        uuid.New(),
+		FamilyID:  uuid.New(),
+		UserID:    params.UserID,
+		SessionID: params.SessionID,
+		ClientID:  params.ClientID,
+		Scope:     params.Scope,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	token := "rt_" + rt.ID.String()
+	f.tokens[token] = rt
+
+	return rt, token, nil
+}
+
+func (f *fakeRefreshTokens) Rotate(_ context.Context, token string, _ core.SessionMetadata) (core.RefreshToken, string, error) {
+	rt, ok := f.tokens[token]
+	if !ok {
+		return core.RefreshToken{}, "", core.ErrInvalidToken
+	}
+
+	if _, revoked := f.revoked[rt.FamilyID]; revoked {
+		return core.RefreshToken{}, "", core.ErrInvalidToken
+	}
+
+	if f.used[token] {
+		f.revoked[rt.FamilyID] = core.RefreshTokenRevokeReused
+		return core.RefreshToken{}, "", core.ErrInvalidToken
+	}
+
+	f.used[token] = true
+
+	rt.ID = uuid.New()
+	next := "rt_" + rt.ID.String()
+	f.tokens[next] = rt
+
+	return rt, next, nil
+}
+
+func (f *fakeRefreshTokens) Get(_ context.Context, token string) (core.RefreshToken, error) {
+	rt, ok := f.tokens[token]
+	if _, revoked := f.revoked[rt.FamilyID]; !ok || revoked || f.used[token] {
+		return core.RefreshToken{}, core.ErrInvalidToken
+	}
+
+	return rt, nil
+}
+
+func (f *fakeRefreshTokens) FamilyActive(_ context.Context, familyID uuid.UUID) (bool, error) {
+	_, revoked := f.revoked[familyID]
+	return !revoked, nil
+}
+
+func (f *fakeRefreshTokens) RevokeFamily(_ context.Context, familyID uuid.UUID, reason core.RefreshTokenRevokeReason) error {
+	if _, ok := f.revoked[familyID]; ok {
+		return core.ErrNotFound
+	}
+
+	f.revoked[familyID] = reason
+	return nil
+}
+
+// fakeIssuer issues opaque access tokens.
+type fakeIssuer struct {
+	issued []core.AccessTokenParams
+	claims map[string]core.AccessTokenClaims
+}
+
+func (f *fakeIssuer) Issue(_ context.Context, params core.AccessTokenParams) (string, core.AccessTokenClaims, error) {
+	f.issued = append(f.issued, params)
+
+	aud := params.Audience
+	if len(aud) == 0 {
+		aud = []string{"api"}
+	}
+
+	now := time.Now().Truncate(time.Second)
+	token, claims := fmt.Sprintf("at_%d", len(f.issued)), core.AccessTokenClaims{
+		ID:        uuid.NewString(),
+		Issuer:    "https://id.example.com/",
+		Subject:   params.Subject,
+		Audience:  aud,
+		IssuedAt:  now,
+		NotBefore: now,
+		ExpiresAt: now.Add(15 * time.Minute),
+		SessionID: params.SessionID,
+		ClientID:  params.ClientID,
+		Scope:     params.Scope,
+		FamilyID:  params.FamilyID,
+	}
+	f.claims[token] = claims
+
+	return token, claims, nil
+}
+
+func (f *fakeIssuer) Verify(_ context.Context, token string) (core.AccessTokenClaims, error) {
+	claims, ok := f.claims[token]
+	if !ok {
+		return core.AccessTokenClaims{}, core.ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+// fakeIDTokens issues opaque ID tokens.
+type fakeIDTokens struct {
+	issued []core.IDTokenParams
+}
+
+func (f *fakeIDTokens) Issue(_ context.Context, params core.IDTokenParams) (string, error) {
+	f.issued = append(f.issued, params)
+	return fmt.Sprintf("id_%d", len(f.issued)), nil
+}
+
+func (f *fakeIDTokens) Issuer() string {
+	return "https://id.example.com/"
+}
+
+func (f *fakeIDTokens) Algorithm() string {
+	return "ES256"
+}
+
+type fakeProfiles struct {
+	core.UserProfileStore
+
+	profiles map[uuid.UUID]core.UserProfile
+}
+
+func (f fakeProfiles) Get(_ context.Context, userID uuid.UUID) (core.UserProfile, error) {
+	p, ok := f.profiles[userID]
+	if !ok {
+		return core.UserProfile{UserID: userID}, nil
+	}
+
+	return p, nil
+}
+
+// fakeServiceAccounts authenticates service accounts by their secret or by assertions registered in advance.
+type fakeServiceAccounts struct {
+	core.ServiceAccountStore
+
+	accounts   map[uuid.UUID]core.ServiceAccount
+	secrets    map[uuid.UUID]string
+	assertions map[string]uuid.UUID
+}
+
+func (f fakeServiceAccounts) Authenticate(_ context.Context, id uuid.UUID, secret string) (core.ServiceAccount, error) {
+	if secret == "" || f.secrets[id] != secret {
+		return core.ServiceAccount{}, core.ErrInvalidCredentials
+	}
+
+	return f.accounts[id], nil
+}
+
+func (f fakeServiceAccounts) Get(_ context.Context, id uuid.UUID) (core.ServiceAccount, error) {
+	account, ok := f.accounts[id]
+	if !ok {
+		return core.ServiceAccount{}, core.ErrNotFound
+	}
+
+	return account, nil
+}
+
+func (f fakeServiceAccounts) AuthenticateAssertion(_ context.Context, assertion string, audiences []string) (core.ServiceAccount, error) {
+	id, ok := f.assertions[assertion]
+	if !ok || !slices.Contains(audiences, "https://id.example.com/token") {
+		return core.ServiceAccount{}, core.ErrInvalidCredentials
+	}
+
+	// Assertions are accepted once.
+	delete(f.assertions, assertion)
+
+	return f.accounts[id], nil
+}
+
+type fakeUsers struct {
+	core.UserStore
+
+	users map[uuid.UUID]core.User
+}
+
+func (f fakeUsers) Get(_ context.Context, id uuid.UUID) (core.User, error) {
+	u, ok := f.users[id]
+	if !ok {
+		return core.User{}, core.ErrNotFound
+	}
+
+	return u, nil
+}
+
+type fakeSessions struct {
+	core.SessionStore
+
+	sessions map[uuid.UUID]core.Session
+}
+
+func (f fakeSessions) Get(_ context.Context, id uuid.UUID) (core.Session, error) {
+	s, ok := f.sessions[id]
+	if !ok {
+		return core.Session{}, core.ErrNotFound
+	}
+
+	return s, nil
+}
+
+// testServer is a [Handler] with in-memory stores and a clock.
+type testServer struct {
+	handler         *Handler
+	clients         *ClientStore
+	serviceAccounts fakeServiceAccounts
+	authorizations  *AuthorizationStore
+	devices         *DeviceStore
+	refreshTokens   *fakeRefreshTokens
+	revokedTokens   *RevocationStore
+	accessTokens    *fakeIssuer
+	idTokens        *fakeIDTokens
+	users           fakeUsers
+	profiles        fakeProfiles
+	sessions        fakeSessions
+	now             time.Time
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+
+	config := Config{
+		LoginURL:              "https://id.example.com/login?theme=dark",
+		RequestTTL:            10 * time.Minute,
+		CodeTTL:               time.Minute,
+		DeviceVerificationURL: "https://id.example.com/device",
+		DeviceCodeTTL:         10 * time.Minute,
+		DevicePollInterval:    5 * time.Second,
+		IntrospectionCacheTTL: 30 * time.Second,
+	}
+
+	q := newFakeQuerier()
+	s := &testServer{
+		clients:       newClientStore(q),
+		revokedTokens: newRevocationStore(q),
+		serviceAccounts: fakeServiceAccounts{
+			accounts:   map[uuid.UUID]core.ServiceAccount{},
+			secrets:    map[uuid.UUID]string{},
+			assertions: map[string]uuid.UUID{},
+		},
+		refreshTokens: &fakeRefreshTokens{tokens: map[string]core.RefreshToken{}, used: map[string]bool{}, revoked: map[uuid.UUID]core.RefreshTokenRevokeReason{}},
+		accessTokens:  &fakeIssuer{claims: map[string]core.AccessTokenClaims{}},
+		idTokens:      &fakeIDTokens{},
+		users:         fakeUsers{users: map[uuid.UUID]core.User{}},
+		profiles:      fakeProfiles{profiles: map[uuid.UUID]core.UserProfile{}},
+		sessions:      fakeSessions{sessions: map[uuid.UUID]core.Session{}},
+		now:           time.Now(),
+	}
+
+	var err error
+	s.authorizations, err = newAuthorizationStore(q, config, s.refreshTokens)
+	require.NoError(t, err)
+	s.authorizations.now = func() time.Time { return s.now }
+
+	s.devices, err = newDeviceStore(q, config)
+	require.NoError(t, err)
+	s.devices.now = func() time.Time { return s.now }
+
+	s.handler, err = NewHandler(config, s.clients, s.serviceAccounts, s.authorizations, s.devices, s.users, s.profiles, s.sessions, s.refreshTokens, s.revokedTokens, s.accessTokens, s.idTokens)
+	require.NoError(t, err)
+	s.handler.now = func() time.Time { return s.now }
+
+	return s
+}
+
+// signIn creates an active user with a session.
+func (s *testServer) signIn() (core.User, core.Session) {
+	user := core.User{ID: uuid.New(), Status: core.UserStatusActive}
+	s.users.users[user.ID] = user
+
+	sess := core.Session{ID: uuid.New(), UserID: user.ID, IdleExpiresAt: s.now.Add(time.Hour), ExpiresAt: s.now.Add(time.Hour)}
+	s.sessions.sessions[sess.ID] = sess
+
+	return user, sess
+}
+
+func (s *testServer) do(t *testing.T, r *http.Request) *httptest.ResponseRecorder {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	s.handler.ServeHTTP(w, r)
+
+	return w
+}
+
+func (s *testServer) authorize(t *testing.T, params url.Values) *httptest.ResponseRecorder {
+	t.Helper()
+	return s.do(t, httptest.NewRequest(http.MethodGet, "/authorize?"+params.Encode(), nil))
+}
+
+func (s *testServer) token(t *testing.T, params url.Values, modify ...func(r *http.Request)) (*httptest.ResponseRecorder, map[string]any) {
+	t.Helper()
+
+	r := httptest.NewRequest(http.MethodPost, "/token", strings.NewReader(params.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	for _, m := range modify {
+		m(r)
+	}
+
+	w := s.do(t, r)
+	require.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+
+	return w, body
+}
+
+// pkce returns a code verifier along with its S256 challenge.
+func pkce() (string, string) {
+	verifier := strings.Repeat("v", 43) + uuid.NewString()
+	h := sha256.Sum256([]byte(verifier))
+
+	return verifier, base64.RawURLEncoding.EncodeToString(h[:])
+}
+
+// requireRedirectError requires w to redirect to the redirect uri with the error code and state.
+func requireRedirectError(t *testing.T, w *httptest.ResponseRecorder, redirectURI, code, state string) {
+	t.Helper()
+
+	require.Equal(t, http.StatusFound, w.Code)
+
+	u, err := url.Parse(w.Header().Get("Location"))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(u.String(), redirectURI+"?"), u.String())
+	require.Equal(t, code, u.Query().Get("error"))
+	require.Equal(t, state, u.Query().Get("state"))
+}
+
+func TestHandler(t *testing.T) {
+	ctx := context.Background()
+	redirectURI := "https://app.example.com/callback"
+
+	newClient := func(t *testing.T, s *testServer, clientType core.OAuthClientType, grants ...string) (core.OAuthClient, string) {
+		t.Helper()
+
+		client, clientSecret, err := s.clients.Create(ctx, core.CreateOAuthClientParams{
+			Name:         "app",
+			Type:         clientType,
+			RedirectURIs: []string{redirectURI},
+			GrantTypes:   grants,
+			Scopes:       []string{"documents.read", "documents.write", "profile"},
+		})
+		require.NoError(t, err)
+
+		return client, clientSecret
+	}
+
+	authorizeParams := func(client core.OAuthClient, challenge string) url.Values {
+		return url.Values{
+			"response_type":         {"code"},
+			"client_id":             {client.ID.String()},
+			"redirect_uri":          {redirectURI},
+			"scope":                 {"documents.read profile"},
+			"state":                 {"xyz"},
+			"code_challenge":        {challenge},
+			"code_challenge_method": {"S256"},
+		}
+	}
+
+	// approve runs the authorization request of params and approves it for a new user, returning the code.
+	approve := func(t *testing.T, s *testServer, params url.Values) (string, core.User, core.Session) {
+		t.Helper()
+
+		w := s.authorize(t, params)
+		require.Equal(t, http.StatusFound, w.Code)
+
+		login, err := url.Parse(w.Header().Get("Location"))
+		require.NoError(t, err)
+		require.Equal(t, "id.example.com", login.Host)
+		require.Equal(t, "dark", login.Query().Get("theme"))
+
+		requestID, err := uuid.Parse(login.Query().Get("request_id"))
+		require.NoError(t, err)
+
+		user, sess := s.signIn()
+
+		req, code, err := s.authorizations.Approve(ctx, requestID, user.ID, sess.ID)
+		require.NoError(t, err)
+
+		callback, err := CodeRedirect(req, code)
+		require.NoError(t, err)
+		require.Equal(t, redirectURI+"?code="+url.QueryEscape(code)+"&state=xyz", callback)
+
+		return code, user, sess
+	}
+
+	codeParams := func(client core.OAuthClient, code, verifier string) url.Values {
+		return url.Values{
+			"grant_type":    {core.OAuthGrantAuthorizationCode},
+			"client_id":     {client.ID.String()},
+			"code":          {code},
+			"redirect_uri":  {redirectURI},
+			"code_verifier": {verifier},
+		}
+	}
+
+	t.Run("authorization endpoint", func(t *testing.T) {
+		s := newTestServer(t)
+		client, _ := newClient(t, s, core.OAuthClientPublic, core.OAuthGrantAuthorizationCode)
+		_, challenge := pkce()
+
+		t.Run("stores valid requests", func(t *testing.T) {
+			params := authorizeParams(client, challenge)
+			params.Del("redirect_uri") // The only registered one is used.
+			params.Del("scope")        // All scopes of the client are requested.
+
+			w := s.authorize(t, params)
+			require.Equal(t, http.StatusFound, w.Code)
+
+			login, err := url.Parse(w.Header().Get("Location"))
+			require.NoError(t, err)
+
+			req, err := s.authorizations.GetRequest(ctx, uuid.MustParse(login.Query().Get("request_id")))
+			require.NoError(t, err)
+			require.Equal(t, redirectURI, req.RedirectURI)
+			require.Equal(t, client.Scopes, req.Scope)
+			require.Equal(t, challenge, req.CodeChallenge)
+
+			// POST requests are accepted as well.
+			r := httptest.NewRequest(http.MethodPost, "/authorize", strings.NewReader(authorizeParams(client, challenge).Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			require.Equal(t, http.StatusFound, s.do(t, r).Code)
+		})
+
+		t.Run("does not redirect to untrusted uris", func(t *testing.T) {
+			for name, modify := range map[string]func(v url.Values){
+				"unknown client":     func(v url.Values) { v.Set("client_id", uuid.NewString()) },
+				"malformed client":   func(v url.Values) { v.Set("client_id", "app") },
+				"unregistered uri":   func(v url.Values) { v.Set("redirect_uri", "https://evil.example.com/callback") },
+				"uri prefix":         func(v url.Values) { v.Set("redirect_uri", redirectURI+"/more") },
+				"repeated client id": func(v url.Values) { v.Add("client_id", client.ID.String()) },
+				"repeated redirect":  func(v url.Values) { v.Add("redirect_uri", redirectURI) },
+			} {
+				t.Run(name, func(t *testing.T) {
+					params := authorizeParams(client, challenge)
+					modify(params)
+
+					w := s.authorize(t, params)
+					require.Equal(t, http.StatusBadRequest, w.Code)
+					require.Empty(t, w.Header().Get("Location"))
+					require.Contains(t, w.Body.String(), `"error":"invalid_request"`)
+				})
+			}
+		})
+
+		t.Run("redirects errors to the client", func(t *testing.T) {
+			for name, tt := range map[string]struct {
+				modify func(v url.Values)
+				code   string
+			}{
+				"token response type": {func(v url.Values) { v.Set("response_type", "token") }, errUnsupportedResponseType},
+				"missing challenge":   {func(v url.Values) { v.Del("code_challenge") }, errInvalidRequest},
+				"plain challenge":     {func(v url.Values) { v.Set("code_challenge_method", "plain") }, errInvalidRequest},
+				"missing method":      {func(v url.Values) { v.Del("code_challenge_method") }, errInvalidRequest},
+				"short challenge":     {func(v url.Values) { v.Set("code_challenge", "abc") }, errInvalidRequest},
+				"unknown scope":       {func(v url.Values) { v.Set("scope", "documents.read admin") }, errInvalidScope},
+				"repeated scope":      {func(v url.Values) { v.Add("scope", "profile") }, errInvalidRequest},
+			} {
+				t.Run(name, func(t *testing.T) {
+					params := authorizeParams(client, challenge)
+					tt.modify(params)
+
+					requireRedirectError(t, s.authorize(t, params), redirectURI, tt.code, "xyz")
+				})
+			}
+		})
+
+		t.Run("requires the authorization code grant", func(t *testing.T) {
+			other, _ := newClient(t, s, core.OAuthClientConfidential, core.OAuthGrantRefreshToken)
+			requireRedirectError(t, s.authorize(t, authorizeParams(other, challenge)), redirectURI, errUnauthorizedClient, "xyz")
+		})
+
+		t.Run("answers requests once", func(t *testing.T) {
+			w := s.authorize(t, authorizeParams(client, challenge))
+			login, err := url.Parse(w.Header().Get("Location"))
+			require.NoError(t, err)
+
+			requestID := uuid.MustParse(login.Query().Get("request_id"))
+
+			req, err := s.authorizations.Deny(ctx, requestID)
+			require.NoError(t, err)
+
+			denied, err := DeniedRedirect(req, "access_denied")
+			require.NoError(t, err)
+			require.Contains(t, denied, "error=access_denied")
+			require.Contains(t, denied, "state=xyz")
+
+			user, sess := s.signIn()
+			_, _, err = s.authorizations.Approve(ctx, requestID, user.ID, sess.ID)
+			require.ErrorIs(t, err, core.ErrNotFound)
+		})
+
+		t.Run("expires requests", func(t *testing.T) {
+			w := s.authorize(t, authorizeParams(client, challenge))
+			login, err := url.Parse(w.Header().Get("Location"))
+			require.NoError(t, err)
+
+			requestID := uuid.MustParse(login.Query().Get("request_id"))
+
+			s.now = s.now.Add(10 * time.Minute)
+			defer func() { s.now = s.now.Add(-10 * time.Minute) }()
+
+			_, err = s.authorizations.GetRequest(ctx, requestID)
+			require.ErrorIs(t, err, core.ErrNotFound)
+		})
+	})
+
+	t.Run("exchanges codes of public clients", func(t *testing.T) {
+		s := newTestServer(t)
+		client, _ := newClient(t, s, core.OAuthClientPublic, core.OAuthGrantAuthorizationCode, core.OAuthGrantRefreshToken)
+		verifier, challenge := pkce()
+
+		code, user, sess := approve(t, s, authorizeParams(client, challenge))
+
+		w, body := s.token(t, codeParams(client, code, verifier))
+		require.Equal(t, http.StatusOK, w.Code, body)
+		require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		require.Equal(t, "Bearer", body["token_type"])
+		require.EqualValues(t, 900, body["expires_in"])
+		require.Equal(t, "documents.read profile", body["scope"])
+		require.NotEmpty(t, body["access_token"])
+		require.NotEmpty(t, body["refresh_token"])
+
+		refreshToken := body["refresh_token"].(string)
+
+		issued := s.accessTokens.issued[len(s.accessTokens.issued)-1]
+		require.Equal(t, user.ID.String(), issued.Subject)
+		require.Equal(t, sess.ID, issued.SessionID)
+		require.Equal(t, client.ID.String(), issued.ClientID)
+
+		// Codes are single use, and replaying one revokes the tokens issued for it.
+		w, body = s.token(t, codeParams(client, code, verifier))
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Equal(t, errInvalidGrant, body["error"])
+
+		w, body = s.token(t, url.Values{
+			"grant_type":    {core.OAuthGrantRefreshToken},
+			"client_id":     {client.ID.String()},
+			"refresh_token": {refreshToken},
+		})
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Len(t, s.refreshTokens.revoked, 1)
+		for _, reason := range s.refreshTokens.revoked {
+			require.Equal(t, core.RefreshTokenRevokeCodeReused, reason)
+		}
+	})
+
+	t.Run("rejects invalid code exchanges", func(t *testing.T) {
+		s := newTestServer(t)
+		client, _ := newClient(t, s, core.OAuthClientPublic, core.OAuthGrantAuthorizationCode)
+		other, _ := newClient(t, s, core.OAuthClientPublic, core.OAuthGrantAuthorizationCode)
+
+		for name, tt := range map[string]struct {
+			modify func(v url.Values, verifier string)
+			code   string
+		}{
+			"wrong verifier":    {func(v url.Values, _ string) { v.Set("code_verifier", strings.Repeat("x", 43)) }, errInvalidGrant},
+			"short verifier":    {func(v url.Values, verifier string) { v.Set("code_verifier", verifier[:42]) }, errInvalidGrant},
+			"missing verifier":  {func(v url.Values, _ string) { v.Del("code_verifier") }, errInvalidRequest},
+			"missing code":      {func(v url.Values, _ string) { v.Del("code") }, errInvalidRequest},
+			"unknown code":      {func(v url.Values, _ string) { v.Set("code", "abc") }, errInvalidGrant},
+			"other client":      {func(v url.Values, _ string) { v.Set("client_id", other.ID.String()) }, errInvalidGrant},
+			"other redirect":    {func(v url.Values, _ string) { v.Set("redirect_uri", "https://app.example.com/other") }, errInvalidGrant},
+			"repeated verifier": {func(v url.Values, verifier string) { v.Add("code_verifier", verifier) }, errInvalidRequest},
+			"missing grant":     {func(v url.Values, _ string) { v.Del("grant_type") }, errInvalidRequest},
+			"password grant":    {func(v url.Values, _ string) { v.Set("grant_type", "password") }, errUnsupportedGrantType},
+			"refresh grant":     {func(v url.Values, _ string) { v.Set("grant_type", core.OAuthGrantRefreshToken) }, errUnauthorizedClient},
+		} {
+			t.Run(name, func(t *testing.T) {
+				verifier, challenge := pkce()
+				code, _, _ := approve(t, s, authorizeParams(client, challenge))
+
+				params := codeParams(client, code, verifier)
+				tt.modify(params, verifier)
+
+				w, body := s.token(t, params)
+				require.Equal(t, http.StatusBadRequest, w.Code)
+				require.Equal(t, tt.code, body["error"], body)
+			})
+		}
+
+		t.Run("expired code", func(t *testing.T) {
+			verifier, challenge := pkce()
+			code, _, _ := approve(t, s, authorizeParams(client, challenge))
+
+			s.now = s.now.Add(time.Minute)
+			defer func() { s.now = s.now.Add(-time.Minute) }()
+
+			_, body := s.token(t, codeParams(client, code, verifier))
+			require.Equal(t, errInvalidGrant, body["error"])
+		})
+
+		t.Run("suspended user", func(t *testing.T) {
+			verifier, challenge := pkce()
+			code, user, _ := approve(t, s, authorizeParams(client, challenge))
+
+			user.Status = core.UserStatusSuspended
+			s.users.users[user.ID] = user
+
+			_, body := s.token(t, codeParams(client, code, verifier))
+			require.Equal(t, errInvalidGrant, body["error"])
+		})
+
+		t.Run("only accepts POST", func(t *testing.T) {
+			w := s.do(t, httptest.NewRequest(http.MethodGet, "/token", nil))
+			require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+		})
+	})
+
+	t.Run("authenticates confidential clients", func(t *testing.T) {
+		s := newTestServer(t)
+		client, clientSecret := newClient(t, s, core.OAuthClientConfidential, core.OAuthGrantAuthorizationCode)
+
+		basic := func(id, secret string) func(r *http.Request) {
+			return func(r *http.Request) {
+				r.SetBasicAuth(url.QueryEscape(id), url.QueryEscape(secret))
+			}
+		}
+
+		for name, tt := range map[string]struct {
+			modify     func(v url.Values)
+			auth       func(r *http.Request)
+			wantStatus int
+			wantError  string
+		}{
+			"client_secret_basic": {auth: basic(client.ID.String(), clientSecret), wantStatus: http.StatusOK},
+			"client_secret_post":  {modify: func(v url.Values) { v.Set("client_secret", clientSecret) }, wantStatus: http.StatusOK},
+			"no secret":           {wantStatus: http.StatusUnauthorized, wantError: errInvalidClient},
+			"wrong secret":        {auth: basic(client.ID.String(), "secret"), wantStatus: http.StatusUnauthorized, wantError: errInvalidClient},
+			"unknown client":      {auth: basic(uuid.NewString(), clientSecret), wantStatus: http.StatusUnauthorized, wantError: errInvalidClient},
+			"both methods": {
+				modify:     func(v url.Values) { v.Set("client_secret", clientSecret) },
+				auth:       basic(client.ID.String(), clientSecret),
+				wantStatus: http.StatusBadRequest,
+				wantError:  errInvalidRequest,
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				verifier, challenge := pkce()
+				code, _, _ := approve(t, s, authorizeParams(client, challenge))
+
+				params := codeParams(client, code, verifier)
+				if tt.modify != nil {
+					tt.modify(params)
+				}
+
+				// Clients authenticating with basic need not repeat their client_id.
+				var modify []func(r *http.Request)
+				if tt.auth != nil {
+					params.Del("client_id")
+					modify = append(modify, tt.auth)
+				}
+
+				w, body := s.token(t, params, modify...)
+				require.Equal(t, tt.wantStatus, w.Code, body)
+				require.Nil(t, body["refresh_token"])
+
+				if tt.wantError != "" {
+					require.Equal(t, tt.wantError, body["error"])
+				}
+
+				if tt.wantStatus == http.StatusUnauthorized {
+					require.Equal(t, `Basic realm="guardian"`, w.Header().Get("WWW-Authenticate"))
+				}
+			})
+		}
+	})
+
+	t.Run("rotates refresh tokens", func(t *testing.T) {
+		s := newTestServer(t)
+		client, clientSecret := newClient(t, s, core.OAuthClientConfidential, core.OAuthGrantAuthorizationCode, core.OAuthGrantRefreshToken)
+		other, otherSecret := newClient(t, s, core.OAuthClientConfidential, core.OAuthGrantAuthorizationCode, core.OAuthGrantRefreshToken)
+
+		exchange := func(t *testing.T) (string, core.Session) {
+			t.Helper()
+
+			verifier, challenge := pkce()
+			code, _, sess := approve(t, s, authorizeParams(client, challenge))
+
+			params := codeParams(client, code, verifier)
+			params.Set("client_secret", clientSecret)
+
+			w, body := s.token(t, params)
+			require.Equal(t, http.StatusOK, w.Code, body)
+
+			return body["refresh_token"].(string), sess
+		}
+
+		refresh := func(t *testing.T, id uuid.UUID, secret, token, scope string) (*httptest.ResponseRecorder, map[string]any) {
+			t.Helper()
+
+			return s.token(t, url.Values{
+				"grant_type":    {core.OAuthGrantRefreshToken},
+				"client_id":     {id.String()},
+				"client_secret": {secret},
+				"refresh_token": {token},
+				"scope":         {scope},
+			})
+		}
+
+		t.Run("narrows scope", func(t *testing.T) {
+			token, _ := exchange(t)
+
+			w, body := refresh(t, client.ID, clientSecret, token, "profile")
+			require.Equal(t, http.StatusOK, w.Code, body)
+			require.Equal(t, "profile", body["scope"])
+			require.NotEqual(t, token, body["refresh_token"])
+
+			// The family keeps its scope, but cannot be widened.
+			next := body["refresh_token"].(string)
+			w, body = refresh(t, client.ID, clientSecret, next, "")
+			require.Equal(t, http.StatusOK, w.Code, body)
+			require.Equal(t, "documents.read profile", body["scope"])
+
+			_, body = refresh(t, client.ID, clientSecret, body["refresh_token"].(string), "documents.write")
+			require.Equal(t, errInvalidScope, body["error"])
+		})
+
+		t.Run("revokes tokens presented by other clients", func(t *testing.T) {
+			token, _ := exchange(t)
+
+			w, body := refresh(t, other.ID, otherSecret, token, "")
+			require.Equal(t, http.StatusBadRequest, w.Code)
+			require.Equal(t, errInvalidGrant, body["error"])
+
+			_, body = refresh(t, client.ID, clientSecret, token, "")
+			require.Equal(t, errInvalidGrant, body["error"])
+		})
+
+		t.Run("requires an active session", func(t *testing.T) {
+			token, sess := exchange(t)
+
+			revokedAt := s.now
+			sess.RevokedAt = &revokedAt
+			s.sessions.sessions[sess.ID] = sess
+
+			_, body := refresh(t, client.ID, clientSecret, token, "")
+			require.Equal(t, errInvalidGrant, body["error"])
+		})
+	})
+
+	t.Run("openid connect", func(t *testing.T) {
+		s := newTestServer(t)
+
+		client, clientSecret, err := s.clients.Create(ctx, core.CreateOAuthClientParams{
+			Name:         "app",
+			Type:         core.OAuthClientConfidential,
+			RedirectURIs: []string{redirectURI},
+			GrantTypes:   []string{core.OAuthGrantAuthorizationCode, core.OAuthGrantRefreshToken},
+			Scopes:       []string{"openid", "profile", "email", "phone", "address", "documents.read"},
+		})
+		require.NoError(t, err)
+
+		oidcParams := func(challenge string) url.Values {
+			params := authorizeParams(client, challenge)
+			params.Set("scope", "openid profile email address")
+			params.Set("nonce", "n-0S6_WzA2Mj")
+			params.Set("prompt", "login consent")
+			params.Set("max_age", "300")
+			return params
+		}
+
+		userinfo := func(t *testing.T, token string) (*httptest.ResponseRecorder, map[string]any) {
+			t.Helper()
+
+			r := httptest.NewRequest(http.MethodGet, "/userinfo", nil)
+			r.Header.Set("Authorization", "Bearer "+token)
+
+			w := s.do(t, r)
+
+			var body map[string]any
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+
+			return w, body
+		}
+
+		t.Run("stores authentication requirements", func(t *testing.T) {
+			_, challenge := pkce()
+
+			w := s.authorize(t, oidcParams(challenge))
+			login, err := url.Parse(w.Header().Get("Location"))
+			require.NoError(t, err)
+
+			req, err := s.authorizations.GetRequest(ctx, uuid.MustParse(login.Query().Get("request_id")))
+			require.NoError(t, err)
+			require.Equal(t, "n-0S6_WzA2Mj", req.Nonce)
+			require.Equal(t, []string{"consent", "login"}, req.Prompt)
+			require.Equal(t, 5*time.Minute, *req.MaxAge)
+
+			// Users have to authenticate after the request was created and within max_age.
+			require.True(t, req.RequiresLogin(req.CreatedAt.Add(-time.Second), req.CreatedAt))
+			require.False(t, req.RequiresLogin(req.CreatedAt, req.CreatedAt.Add(5*time.Minute)))
+			require.True(t, req.RequiresLogin(req.CreatedAt, req.CreatedAt.Add(5*time.Minute+time.Second)))
+		})
+
+		t.Run("rejects invalid parameters", func(t *testing.T) {
+			_, challenge := pkce()
+
+			for name, modify := range map[string]func(v url.Values){
+				"combined none":    func(v url.Values) { v.Set("prompt", "none login") },
+				"unknown prompt":   func(v url.Values) { v.Set("prompt", "create") },
+				"negative max_age": func(v url.Values) { v.Set("max_age", "-1") },
+				"long nonce":       func(v url.Values) { v.Set("nonce", strings.Repeat("n", maxNonceLength+1)) },
+				"repeated nonce":   func(v url.Values) { v.Add("nonce", "abc") },
+			} {
+				t.Run(name, func(t *testing.T) {
+					params := oidcParams(challenge)
+					modify(params)
+
+					requireRedirectError(t, s.authorize(t, params), redirectURI, errInvalidRequest, "xyz")
+				})
+			}
+		})
+
+		t.Run("issues id tokens", func(t *testing.T) {
+			verifier, challenge := pkce()
+			code, user, sess := approve(t, s, oidcParams(challenge))
+
+			sess.CreatedAt = s.now.Add(-time.Minute)
+			sess.AuthMethods = []string{core.AuthMethodMultiFactor, core.AuthMethodOTP}
+			s.sessions.sessions[sess.ID] = sess
+
+			params := codeParams(client, code, verifier)
+			params.Set("client_secret", clientSecret)
+
+			w, body := s.token(t, params)
+			require.Equal(t, http.StatusOK, w.Code, body)
+			require.Equal(t, "id_1", body["id_token"])
+			require.Equal(t, core.IDTokenParams{
+				Subject:   user.ID.String(),
+				ClientID:  client.ID.String(),
+				SessionID: sess.ID,
+				Nonce:     "n-0S6_WzA2Mj",
+				AuthTime:  sess.CreatedAt,
+				ACR:       core.ACRMultiFactor,
+				AMR:       sess.AuthMethods,
+			}, s.idTokens.issued[0])
+
+			// Refreshed ID tokens carry no nonce, and none are issued without the openid scope.
+			w, body = s.token(t, url.Values{
+				"grant_type":    {core.OAuthGrantRefreshToken},
+				"client_id":     {client.ID.String()},
+				"client_secret": {clientSecret},
+				"refresh_token": {body["refresh_token"].(string)},
+			})
+			require.Equal(t, http.StatusOK, w.Code, body)
+			require.Equal(t, "id_2", body["id_token"])
+			require.Empty(t, s.idTokens.issued[1].Nonce)
+
+			w, body = s.token(t, url.Values{
+				"grant_type":    {core.OAuthGrantRefreshToken},
+				"client_id":     {client.ID.String()},
+				"client_secret": {clientSecret},
+				"refresh_token": {body["refresh_token"].(string)},
+				"scope":         {"profile"},
+			})
+			require.Equal(t, http.StatusOK, w.Code, body)
+			require.Nil(t, body["id_token"])
+		})
+
+		t.Run("returns claims by scope", func(t *testing.T) {
+			verifier, challenge := pkce()
+			code, user, _ := approve(t, s, oidcParams(challenge))
+
+			verifiedAt := s.now
+			user.Email, user.Username, user.EmailVerifiedAt = "ada@example.com", "ada", &verifiedAt
+			s.users.users[user.ID] = user
+
+			s.profiles.profiles[user.ID] = core.UserProfile{
+				UserID:      user.ID,
+				Name:        "Ada Lovelace",
+				PhoneNumber: "+14155550100",
+				Address:     core.UserAddress{StreetAddress: "12 St James's Square", Locality: "London", Country: "UK"},
+				UpdatedAt:   s.now,
+			}
+
+			params := codeParams(client, code, verifier)
+			params.Set("client_secret", clientSecret)
+
+			_, body := s.token(t, params)
+			token := body["access_token"].(string)
+
+			w, body := userinfo(t, token)
+			require.Equal(t, http.StatusOK, w.Code, body)
+			require.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+			require.Equal(t, user.ID.String(), body["sub"])
+			require.Equal(t, "Ada Lovelace", body["name"])
+			require.Equal(t, "ada", body["preferred_username"])
+			require.Equal(t, "ada@example.com", body["email"])
+			require.Equal(t, true, body["email_verified"])
+			require.EqualValues(t, s.now.Unix(), body["updated_at"])
+			require.Equal(t, map[string]any{
+				"formatted":      "12 St James's Square\nLondon\nUK",
+				"street_address": "12 St James's Square",
+				"locality":       "London",
+				"country":        "UK",
+			}, body["address"])
+
+			// The phone scope was not granted.
+			require.Nil(t, body["phone_number"])
+
+			user.Status = core.UserStatusSuspended
+			s.users.users[user.ID] = user
+
+			w, body = userinfo(t, token)
+			require.Equal(t, http.StatusUnauthorized, w.Code)
+			require.Equal(t, errInvalidToken, body["error"])
+		})
+
+		t.Run("requires openid access tokens", func(t *testing.T) {
+			user, sess := s.signIn()
+
+			w, body := userinfo(t, "at_unknown")
+			require.Equal(t, http.StatusUnauthorized, w.Code)
+			require.Equal(t, errInvalidToken, body["error"])
+			require.Contains(t, w.Header().Get("WWW-Authenticate"), `Bearer realm="guardian", error="invalid_token"`)
+
+			token, _, err := s.accessTokens.Issue(ctx, core.AccessTokenParams{Subject: user.ID.String(), SessionID: sess.ID, Scope: []string{"openid"}})
+			require.NoError(t, err)
+
+			w, body = userinfo(t, token)
+			require.Equal(t, http.StatusUnauthorized, w.Code)
+			require.Equal(t, errInvalidToken, body["error"])
+
+			token, _, err = s.accessTokens.Issue(ctx, core.AccessTokenParams{Subject: user.ID.String(), SessionID: sess.ID, ClientID: client.ID.String(), Scope: []string{"profile"}})
+			require.NoError(t, err)
+
+			w, body = userinfo(t, token)
+			require.Equal(t, http.StatusForbidden, w.Code)
+			require.Equal(t, errInsufficientScope, body["error"])
+		})
+
+		t.Run("publishes provider metadata", func(t *testing.T) {
+			w := s.do(t, httptest.NewRequest(http.MethodGet, "/.well-known/openid-configuration", nil))
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Equal(t, "public, max-age=3600", w.Header().Get("Cache-Control"))
+
+			var body map[string]any
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			require.Equal(t, "https://id.example.com/", body["issuer"])
+			require.Equal(t, "https://id.example.com/authorize", body["authorization_endpoint"])
+			require.Equal(t, "https://id.example.com/userinfo", body["userinfo_endpoint"])
+			require.Equal(t, "https://id.example.com/.well-known/jwks.json", body["jwks_uri"])
+			require.Equal(t, []any{"ES256"}, body["id_token_signing_alg_values_supported"])
+			require.Equal(t, "https://id.example.com/device_authorization", body["device_authorization_endpoint"])
+			require.Contains(t, body["grant_types_supported"], core.OAuthGrantClientCredentials)
+			require.Contains(t, body["grant_types_supported"], core.OAuthGrantDeviceCode)
+			require.Contains(t, body["token_endpoint_auth_methods_supported"], "private_key_jwt")
+			require.Equal(t, "https://id.example.com/introspect", body["introspection_endpoint"])
+			require.Equal(t, "https://id.example.com/revoke", body["revocation_endpoint"])
+		})
+	})
+
+	t.Run("client credentials grant", func(t *testing.T) {
+		s := newTestServer(t)
+
+		account := core.ServiceAccount{ID: uuid.New(), Name: "billing", Scopes: []string{"invoices.read", "invoices.write"}}
+		s.serviceAccounts.accounts[account.ID] = account
+		s.serviceAccounts.secrets[account.ID] = "s3cret"
+
+		params := func(extra ...string) url.Values {
+			v := url.Values{"grant_type": {core.OAuthGrantClientCredentials}}
+			for i := 0; i < len(extra); i += 2 {
+				v.Set(extra[i], extra[i+1])
+			}
+			return v
+		}
+
+		basic := func(r *http.Request) { r.SetBasicAuth(account.ID.String(), "s3cret") }
+
+		w, body := s.token(t, params(), basic)
+		require.Equal(t, http.StatusOK, w.Code, body)
+		require.Equal(t, "invoices.read invoices.write", body["scope"])
+		require.Nil(t, body["refresh_token"])
+		require.Nil(t, body["id_token"])
+
+		issued := s.accessTokens.issued[len(s.accessTokens.issued)-1]
+		require.Equal(t, account.ID.String(), issued.Subject)
+		require.Equal(t, account.ID.String(), issued.ClientID)
+		require.Equal(t, uuid.Nil, issued.SessionID)
+
+		w, body = s.token(t, params("client_id", account.ID.String(), "client_secret", "s3cret", "scope", "invoices.read"))
+		require.Equal(t, http.StatusOK, w.Code, body)
+		require.Equal(t, "invoices.read", body["scope"])
+
+		s.serviceAccounts.assertions["signed"] = account.ID
+		assertion := params("client_assertion_type", clientAssertionType, "client_assertion", "signed")
+
+		w, body = s.token(t, assertion)
+		require.Equal(t, http.StatusOK, w.Code, body)
+
+		for name, tt := range map[string]struct {
+			params    url.Values
+			auth      func(r *http.Request)
+			wantError string
+		}{
+			"replayed assertion":      {params: assertion, wantError: errInvalidClient},
+			"wrong secret":            {params: params("client_id", account.ID.String(), "client_secret", "wrong"), wantError: errInvalidClient},
+			"no secret":               {params: params("client_id", account.ID.String()), wantError: errInvalidClient},
+			"registered client":       {params: params("client_id", uuid.NewString(), "client_secret", "s3cret"), wantError: errInvalidClient},
+			"scope":                   {params: params("scope", "invoices.delete"), auth: basic, wantError: errInvalidScope},
+			"assertion type":          {params: params("client_assertion_type", "urn:example", "client_assertion", "signed"), wantError: errInvalidRequest},
+			"assertion and secret":    {params: params("client_assertion_type", clientAssertionType, "client_assertion", "signed"), auth: basic, wantError: errInvalidRequest},
+			"assertion without token": {params: params("client_assertion_type", clientAssertionType), wantError: errInvalidRequest},
+		} {
+			t.Run(name, func(t *testing.T) {
+				var modify []func(r *http.Request)
+				if tt.auth != nil {
+					modify = append(modify, tt.auth)
+				}
+
+				_, body := s.token(t, tt.params, modify...)
+				require.Equal(t, tt.wantError, body["error"])
+			})
+		}
+
+		// Service accounts cannot use the grants of registered clients.
+		w, body = s.token(t, url.Values{"grant_type": {core.OAuthGrantRefreshToken}}, basic)
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+		require.Equal(t, errInvalidClient, body["error"])
+	})
+
+	t.Run("device authorization grant", func(t *testing.T) {
+		s := newTestServer(t)
+		client, _ := newClient(t, s, core.OAuthClientPublic, core.OAuthGrantDeviceCode, core.OAuthGrantRefreshToken)
+
+		authorizeDevice := func(t *testing.T, params url.Values) (*httptest.ResponseRecorder, map[string]any) {
+			t.Helper()
+
+			r := httptest.NewRequest(http.MethodPost, "/device_authorization", strings.NewReader(params.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			w := s.do(t, r)
+			require.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+
+			var body map[string]any
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+
+			return w, body
+		}
+
+		w, body := authorizeDevice(t, url.Values{"client_id": {client.ID.String()}, "scope": {"profile documents.read"}})
+		require.Equal(t, http.StatusOK, w.Code, body)
+		require.Equal(t, "https://id.example.com/device", body["verification_uri"])
+		require.Equal(t, "https://id.example.com/device?user_code="+body["user_code"].(string), body["verification_uri_complete"])
+		require.EqualValues(t, 600, body["expires_in"])
+		require.EqualValues(t, 5, body["interval"])
+
+		userCode, deviceCode := body["user_code"].(string), body["device_code"].(string)
+		params := url.Values{"grant_type": {core.OAuthGrantDeviceCode}, "client_id": {client.ID.String()}, "device_code": {deviceCode}}
+
+		_, body = s.token(t, params)
+		require.Equal(t, errAuthorizationPending, body["error"])
+
+		_, body = s.token(t, params)
+		require.Equal(t, errSlowDown, body["error"])
+
+		d, err := s.devices.Get(ctx, strings.ToLower(userCode))
+		require.NoError(t, err)
+		require.Equal(t, []string{"documents.read", "profile"}, d.Scope)
+
+		user, sess := s.signIn()
+		_, err = s.devices.Approve(ctx, userCode, user.ID, sess.ID)
+		require.NoError(t, err)
+
+		w, body = s.token(t, params)
+		require.Equal(t, http.StatusOK, w.Code, body)
+		require.Equal(t, "documents.read profile", body["scope"])
+		require.NotEmpty(t, body["refresh_token"])
+
+		issued := s.accessTokens.issued[len(s.accessTokens.issued)-1]
+		require.Equal(t, user.ID.String(), issued.Subject)
+		require.Equal(t, sess.ID, issued.SessionID)
+
+		_, body = s.token(t, params)
+		require.Equal(t, errInvalidGrant, body["error"])
+
+		t.Run("reports denials and expiry", func(t *testing.T) {
+			_, body := authorizeDevice(t, url.Values{"client_id": {client.ID.String()}})
+			denied := url.Values{"grant_type": {core.OAuthGrantDeviceCode}, "client_id": {client.ID.String()}, "device_code": {body["device_code"].(string)}}
+
+			_, err := s.devices.Deny(ctx, body["user_code"].(string))
+			require.NoError(t, err)
+
+			_, body = s.token(t, denied)
+			require.Equal(t, errAccessDenied, body["error"])
+
+			_, body = authorizeDevice(t, url.Values{"client_id": {client.ID.String()}})
+			expired := url.Values{"grant_type": {core.OAuthGrantDeviceCode}, "client_id": {client.ID.String()}, "device_code": {body["device_code"].(string)}}
+
+			s.now = s.now.Add(10 * time.Minute)
+
+			_, body = s.token(t, expired)
+			require.Equal(t, errExpiredToken, body["error"])
+		})
+
+		t.Run("rejects invalid requests", func(t *testing.T) {
+			other, _ := newClient(t, s, core.OAuthClientPublic, core.OAuthGrantAuthorizationCode)
+
+			_, body := authorizeDevice(t, url.Values{"client_id": {other.ID.String()}})
+			require.Equal(t, errUnauthorizedClient, body["error"])
+
+			_, body = authorizeDevice(t, url.Values{"client_id": {client.ID.String()}, "scope": {"documents.delete"}})
+			require.Equal(t, errInvalidScope, body["error"])
+
+			w, body := authorizeDevice(t, url.Values{"client_id": {uuid.NewString()}})
+			require.Equal(t, http.StatusUnauthorized, w.Code)
+			require.Equal(t, errInvalidClient, body["error"])
+
+			_, body = s.token(t, url.Values{"grant_type": {core.OAuthGrantDeviceCode}, "client_id": {client.ID.String()}})
+			require.Equal(t, errInvalidRequest, body["error"])
+
+			_, body = s.token(t, url.Values{"grant_type": {core.OAuthGrantDeviceCode}, "client_id": {client.ID.String()}, "device_code": {"unknown"}})
+			require.Equal(t, errInvalidGrant, body["error"])
+		})
+	})
+
+	// post sends a form authenticated with client_secret_basic to path, expecting a JSON response.
+	post := func(t *testing.T, s *testServer, path string, id uuid.UUID, secret string, params url.Values) (*httptest.ResponseRecorder, map[string]any) {
+		t.Helper()
+
+		r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(params.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if secret != "" {
+			r.SetBasicAuth(id.String(), secret)
+		}
+
+		w := s.do(t, r)
+
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+
+		return w, body
+	}
+
+	// exchange runs the authorization code grant for client and returns the tokens along with the session.
+	exchange := func(t *testing.T, s *testServer, client core.OAuthClient, clientSecret string) (string, string, core.Session) {
+		t.Helper()
+
+		verifier, challenge := pkce()
+		code, _, sess := approve(t, s, authorizeParams(client, challenge))
+
+		params := codeParams(client, code, verifier)
+		params.Set("client_secret", clientSecret)
+
+		w, body := s.token(t, params)
+		require.Equal(t, http.StatusOK, w.Code, body)
+
+		return body["access_token"].(string), body["refresh_token"].(string), sess
+	}
+
+	t.Run("introspection", func(t *testing.T) {
+		s := newTestServer(t)
+		client, clientSecret := newClient(t, s, core.OAuthClientConfidential, core.OAuthGrantAuthorizationCode, core.OAuthGrantRefreshToken)
+		public, _ := newClient(t, s, core.OAuthClientPublic, core.OAuthGrantAuthorizationCode)
+		other, otherSecret := newClient(t, s, core.OAuthClientConfidential, core.OAuthGrantAuthorizationCode)
+
+		api, apiSecret, err := s.clients.Create(ctx, core.CreateOAuthClientParams{
+			Name:       "documents api",
+			Type:       core.OAuthClientConfidential,
+			GrantTypes: []string{core.OAuthGrantRefreshToken},
+			Audiences:  []string{"api"},
+		})
+		require.NoError(t, err)
+
+		introspect := func(t *testing.T, id uuid.UUID, secret, token string) (*httptest.ResponseRecorder, map[string]any) {
+			t.Helper()
+			return post(t, s, "/introspect", id, secret, url.Values{"token": {token}})
+		}
+
+		t.Run("describes active access tokens", func(t *testing.T) {
+			accessToken, _, sess := exchange(t, s, client, clientSecret)
+			claims := s.accessTokens.claims[accessToken]
+
+			for _, c := range []struct {
+				id     uuid.UUID
+				secret string
+			}{{client.ID, clientSecret}, {api.ID, apiSecret}} {
+				w, body := introspect(t, c.id, c.secret, accessToken)
+				require.Equal(t, http.StatusOK, w.Code, body)
+				require.Equal(t, "private, max-age=30", w.Header().Get("Cache-Control"))
+				require.Equal(t, true, body["active"])
+				require.Equal(t, "documents.read profile", body["scope"])
+				require.Equal(t, client.ID.String(), body["client_id"])
+				require.Equal(t, sess.UserID.String(), body["sub"])
+				require.Equal(t, []any{"api"}, body["aud"])
+				require.Equal(t, "Bearer", body["token_type"])
+				require.Equal(t, claims.ID, body["jti"])
+				require.EqualValues(t, claims.ExpiresAt.Unix(), body["exp"])
+			}
+
+			// Clients of other audiences do not learn about the token.
+			w, body := introspect(t, other.ID, otherSecret, accessToken)
+			require.Equal(t, http.StatusOK, w.Code, body)
+			require.Equal(t, map[string]any{"active": false}, body)
+			require.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+
+			// Responses are not cached beyond the expiry of the token.
+			s.now = claims.ExpiresAt.Add(-10 * time.Second)
+			w, _ = introspect(t, api.ID, apiSecret, accessToken)
+			require.Equal(t, "private, max-age=10", w.Header().Get("Cache-Control"))
+			s.now = time.Now()
+		})
+
+		t.Run("describes refresh tokens to their client", func(t *testing.T) {
+			_, refreshToken, sess := exchange(t, s, client, clientSecret)
+
+			w, body := introspect(t, client.ID, clientSecret, refreshToken)
+			require.Equal(t, http.StatusOK, w.Code, body)
+			require.Equal(t, true, body["active"])
+			require.Equal(t, sess.UserID.String(), body["sub"])
+			require.Nil(t, body["token_type"])
+
+			_, body = introspect(t, api.ID, apiSecret, refreshToken)
+			require.Equal(t, false, body["active"])
+		})
+
+		t.Run("reports ended tokens inactive", func(t *testing.T) {
+			accessToken, refreshToken, sess := exchange(t, s, client, clientSecret)
+
+			revokedAt := s.now
+			sess.RevokedAt = &revokedAt
+			s.sessions.sessions[sess.ID] = sess
+
+			for _, token := range []string{accessToken, refreshToken, "unknown"} {
+				w, body := introspect(t, api.ID, apiSecret, token)
+				require.Equal(t, http.StatusOK, w.Code, body)
+				require.Equal(t, false, body["active"])
+			}
+		})
+
+		t.Run("reports tokens of deleted service accounts inactive", func(t *testing.T) {
+			account := core.ServiceAccount{ID: uuid.New(), Name: "billing", Scopes: []string{"invoices.read"}}
+			s.serviceAccounts.accounts[account.ID] = account
+			s.serviceAccounts.secrets[account.ID] = "s3cret"
+
+			w, body := s.token(t, url.Values{"grant_type": {core.OAuthGrantClientCredentials}}, func(r *http.Request) {
+				r.SetBasicAuth(account.ID.String(), "s3cret")
+			})
+			require.Equal(t, http.StatusOK, w.Code, body)
+			accessToken := body["access_token"].(string)
+
+			_, body = introspect(t, api.ID, apiSecret, accessToken)
+			require.Equal(t, true, body["active"])
+			require.Equal(t, account.ID.String(), body["sub"])
+
+			delete(s.serviceAccounts.accounts, account.ID)
+			delete(s.serviceAccounts.secrets, account.ID)
+
+			w, body = introspect(t, api.ID, apiSecret, accessToken)
+			require.Equal(t, http.StatusOK, w.Code, body)
+			require.Equal(t, map[string]any{"active": false}, body)
+		})
+
+		t.Run("requires confidential clients", func(t *testing.T) {
+			accessToken, _, _ := exchange(t, s, client, clientSecret)
+
+			w, body := post(t, s, "/introspect", uuid.Nil, "", url.Values{"client_id": {public.ID.String()}, "token": {accessToken}})
+			require.Equal(t, http.StatusUnauthorized, w.Code)
+			require.Equal(t, errInvalidClient, body["error"])
+
+			w, body = introspect(t, api.ID, "wrong", accessToken)
+			require.Equal(t, http.StatusUnauthorized, w.Code)
+			require.Equal(t, errInvalidClient, body["error"])
+
+			_, body = introspect(t, api.ID, apiSecret, "")
+			require.Equal(t, errInvalidRequest, body["error"])
+		})
+	})
+
+	t.Run("revocation", func(t *testing.T) {
+		s := newTestServer(t)
+		client, clientSecret := newClient(t, s, core.OAuthClientConfidential, core.OAuthGrantAuthorizationCode, core.OAuthGrantRefreshToken)
+		other, otherSecret := newClient(t, s, core.OAuthClientConfidential, core.OAuthGrantAuthorizationCode)
+
+		revoke := func(t *testing.T, id uuid.UUID, secret, token string) (*httptest.ResponseRecorder, map[string]any) {
+			t.Helper()
+
+			w, body := post(t, s, "/revoke", id, secret, url.Values{"token": {token}})
+			require.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+
+			return w, body
+		}
+
+		userinfo := func(t *testing.T, token string) int {
+			t.Helper()
+
+			r := httptest.NewRequest(http.MethodGet, "/userinfo", nil)
+			r.Header.Set("Authorization", "Bearer "+token)
+
+			return s.do(t, r).Code
+		}
+
+		t.Run("revokes access tokens with their family", func(t *testing.T) {
+			accessToken, refreshToken, _ := exchange(t, s, client, clientSecret)
+			claims := s.accessTokens.claims[accessToken]
+			require.NotEqual(t, uuid.Nil, claims.FamilyID)
+
+			w, body := revoke(t, client.ID, clientSecret, accessToken)
+			require.Equal(t, http.StatusOK, w.Code, body)
+			require.Equal(t, core.RefreshTokenRevokeClient, s.refreshTokens.revoked[claims.FamilyID])
+			require.Equal(t, http.StatusUnauthorized, userinfo(t, accessToken))
+
+			_, body = s.token(t, url.Values{
+				"grant_type":    {core.OAuthGrantRefreshToken},
+				"client_id":     {client.ID.String()},
+				"client_secret": {clientSecret},
+				"refresh_token": {refreshToken},
+			})
+			require.Equal(t, errInvalidGrant, body["error"])
+
+			// Revoking twice is no error.
+			w, _ = revoke(t, client.ID, clientSecret, accessToken)
+			require.Equal(t, http.StatusOK, w.Code)
+		})
+
+		t.Run("revokes refresh tokens with the access tokens of their family", func(t *testing.T) {
+			accessToken, refreshToken, _ := exchange(t, s, client, clientSecret)
+
+			w, body := revoke(t, client.ID, clientSecret, refreshToken)
+			require.Equal(t, http.StatusOK, w.Code, body)
+			require.Equal(t, http.StatusUnauthorized, userinfo(t, accessToken))
+
+			w, _ = revoke(t, client.ID, clientSecret, refreshToken)
+			require.Equal(t, http.StatusOK, w.Code)
+		})
+
+		t.Run("refuses tokens of other clients", func(t *testing.T) {
+			accessToken, refreshToken, _ := exchange(t, s, client, clientSecret)
+
+			for _, token := range []string{accessToken, refreshToken} {
+				w, body := revoke(t, other.ID, otherSecret, token)
+				require.Equal(t, http.StatusBadRequest, w.Code)
+				require.Equal(t, errUnauthorizedClient, body["error"])
+			}
+
+			require.Empty(t, s.refreshTokens.revoked[s.accessTokens.claims[accessToken].FamilyID])
+
+			w, _ := revoke(t, other.ID, otherSecret, "unknown")
+			require.Equal(t, http.StatusOK, w.Code)
+
+			w, body := revoke(t, client.ID, "wrong", accessToken)
+			require.Equal(t, http.StatusUnauthorized, w.Code)
+			require.Equal(t, errInvalidClient, body["error"])
+		})
+	})
+}
@@ -0,0 +1,330 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/gophero/guardian/core"
+)
+
+// Token types of the token_type_hint parameter of RFC 7009, section 2.1, which also label the metrics.
+const (
+	tokenTypeAccessToken  = "access_token"
+	tokenTypeRefreshToken = "refresh_token"
+	tokenTypeUnknown      = "unknown"
+)
+
+// introspectionResponse is the introspection response of RFC 7662, section 2.2. Inactive tokens are only described by
+// the active member.
+type introspectionResponse struct {
+	Active    bool     `json:"active"`
+	Scope     string   `json:"scope,omitempty"`
+	ClientID  string   `json:"client_id,omitempty"`
+	TokenType string   `json:"token_type,omitempty"`
+	ExpiresAt int64    `json:"exp,omitempty"`
+	IssuedAt  int64    `json:"iat,omitempty"`
+	NotBefore int64    `json:"nbf,omitempty"`
+	Subject   string   `json:"sub,omitempty"`
+	Audience  []string `json:"aud,omitempty"`
+	Issuer    string   `json:"iss,omitempty"`
+	ID        string   `json:"jti,omitempty"`
+}
+
+// introspect implements the introspection endpoint of RFC 7662. Only confidential clients may introspect tokens, which
+// are reported active to the client they were issued to and to clients acting as one of their audiences. Responses on
+// active tokens may be cached for the configured duration, but never beyond the expiry of the token.
+func (h *Handler) introspect(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		h.writeError(w, r, "introspect", newError(errInvalidRequest, "malformed request"))
+		return
+	}
+
+	res, tokenType, err := h.introspection(r.Context(), r)
+	if err != nil {
+		h.writeError(w, r, "introspect", err)
+		return
+	}
+
+	h.introspections.WithLabelValues(tokenType, strconv.FormatBool(res.Active)).Inc()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", h.introspectionCacheControl(res))
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		zerolog.Ctx(r.Context()).Err(err).Msg("failed to write oauth response")
+	}
+}
+
+// introspectionCacheControl returns the Cache-Control header of an introspection response. Responses are specific to
+// the authenticated client, so they may only be cached privately.
+func (h *Handler) introspectionCacheControl(res introspectionResponse) string {
+	if !res.Active {
+		return "no-store"
+	}
+
+	maxAge := min(h.cacheTTL, time.Unix(res.ExpiresAt, 0).Sub(h.now()))
+	if maxAge < time.Second {
+		return "no-store"
+	}
+
+	return "private, max-age=" + strconv.Itoa(int(maxAge.Seconds()))
+}
+
+// introspection answers the introspection request r and returns the type of the token for metrics. The token_type_hint
+// parameter is accepted but not needed, as access tokens are recognized by their signature.
+func (h *Handler) introspection(ctx context.Context, r *http.Request) (introspectionResponse, string, error) {
+	if err := singleValued(r.PostForm, "client_id", "client_secret", "token", "token_type_hint"); err != nil {
+		return introspectionResponse{}, "", err
+	}
+
+	client, err := h.authenticateClient(ctx, r)
+	if err != nil {
+		return introspectionResponse{}, "", err
+	}
+
+	// Public clients only send their id, so anybody could introspect tokens in their name.
+	if client.Type != core.OAuthClientConfidential {
+		return introspectionResponse{}, "", newError(errInvalidClient, "client authentication failed")
+	}
+
+	token := r.PostForm.Get("token")
+	if token == "" {
+		return introspectionResponse{}, "", newError(errInvalidRequest, "token is required")
+	}
+
+	claims, err := h.accessTokens.Verify(ctx, token)
+	if err == nil {
+		res, err := h.introspectAccessToken(ctx, client, claims)
+		return res, tokenTypeAccessToken, err
+	}
+
+	if !errors.Is(err, core.ErrInvalidToken) {
+		return introspectionResponse{}, "", err
+	}
+
+	rt, err := h.refreshTokens.Get(ctx, token)
+	if errors.Is(err, core.ErrInvalidToken) {
+		return introspectionResponse{}, tokenTypeUnknown, nil
+	}
+
+	if err != nil {
+		return introspectionResponse{}, "", err
+	}
+
+	res, err := h.introspectRefreshToken(ctx, client, rt)
+	return res, tokenTypeRefreshToken, err
+}
+
+// introspectAccessToken describes the verified access token to client. It is inactive if it was revoked, the client
+// may not learn about it, the service account it was issued to was deleted or, for tokens of users, the user or the
+// session is no longer active.
+func (h *Handler) introspectAccessToken(ctx context.Context, client core.OAuthClient, claims core.AccessTokenClaims) (introspectionResponse, error) {
+	if !client.AllowsIntrospection(claims) {
+		return introspectionResponse{}, nil
+	}
+
+	err := h.checkRevoked(ctx, claims)
+	if errors.Is(err, core.ErrInvalidToken) {
+		return introspectionResponse{}, nil
+	}
+
+	if err != nil {
+		return introspectionResponse{}, err
+	}
+
+	id, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return introspectionResponse{}, nil
+	}
+
+	// Service accounts are their own clients, all other tokens are issued for users.
+	if claims.Subject == claims.ClientID {
+		_, err := h.serviceAccounts.Get(ctx, id)
+		if errors.Is(err, core.ErrNotFound) {
+			return introspectionResponse{}, nil
+		}
+
+		if err != nil {
+			return introspectionResponse{}, err
+		}
+	} else if active, err := h.active(ctx, id, claims.SessionID); err != nil || !active {
+		return introspectionResponse{}, err
+	}
+
+	return introspectionResponse{
+		Active:    true,
+		Scope:     strings.Join(claims.Scope, " "),
+		ClientID:  claims.ClientID,
+		TokenType: "Bearer",
+		ExpiresAt: claims.ExpiresAt.Unix(),
+		IssuedAt:  claims.IssuedAt.Unix(),
+		NotBefore: claims.NotBefore.Unix(),
+		Subject:   claims.Subject,
+		Audience:  claims.Audience,
+		Issuer:    claims.Issuer,
+		ID:        claims.ID,
+	}, nil
+}
+
+// introspectRefreshToken describes the refresh token to client. Refresh tokens are only presented to the
+// authorization server, so only the client they were issued to may learn about them.
+func (h *Handler) introspectRefreshToken(ctx context.Context, client core.OAuthClient, rt core.RefreshToken) (introspectionResponse, error) {
+	if rt.ClientID != client.ID.String() {
+		return introspectionResponse{}, nil
+	}
+
+	if active, err := h.active(ctx, rt.UserID, rt.SessionID); err != nil || !active {
+		return introspectionResponse{}, err
+	}
+
+	return introspectionResponse{
+		Active:    true,
+		Scope:     strings.Join(rt.Scope, " "),
+		ClientID:  rt.ClientID,
+		ExpiresAt: rt.ExpiresAt.Unix(),
+		Subject:   rt.UserID.String(),
+	}, nil
+}
+
+// active reports whether the user and the session, which may be [uuid.Nil], are still active.
+func (h *Handler) active(ctx context.Context, userID, sessionID uuid.UUID) (bool, error) {
+	_, _, err := h.checkActive(ctx, userID, sessionID)
+	if res := (*errorResponse)(nil); errors.As(err, &res) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// revoke implements the revocation endpoint of RFC 7009. Clients may only revoke their own tokens. Revoking a refresh
+// token revokes its whole family, revoking an access token also revokes the refresh token family it was issued with.
+// Unknown tokens are ignored as section 2.2 requires, tokens of other clients are refused.
+func (h *Handler) revoke(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		h.writeError(w, r, "revoke", newError(errInvalidRequest, "malformed request"))
+		return
+	}
+
+	tokenType, err := h.revokeToken(r.Context(), r)
+	if err != nil {
+		h.writeError(w, r, "revoke", err)
+		return
+	}
+
+	if tokenType != tokenTypeUnknown {
+		h.revocations.WithLabelValues(tokenType).Inc()
+	}
+
+	writeJSON(w, r, http.StatusOK, struct{}{})
+}
+
+// revokeToken revokes the token of the revocation request r and returns its type, which is [tokenTypeUnknown] if
+// nothing was revoked.
+func (h *Handler) revokeToken(ctx context.Context, r *http.Request) (string, error) {
+	if err := singleValued(r.PostForm, "client_id", "client_secret", "token", "token_type_hint"); err != nil {
+		return "", err
+	}
+
+	client, err := h.authenticateClient(ctx, r)
+	if err != nil {
+		return "", err
+	}
+
+	token := r.PostForm.Get("token")
+	if token == "" {
+		return "", newError(errInvalidRequest, "token is required")
+	}
+
+	claims, err := h.accessTokens.Verify(ctx, token)
+	if err == nil {
+		if claims.ClientID != client.ID.String() {
+			return "", newError(errUnauthorizedClient, "token was not issued to the client")
+		}
+
+		if err := h.revokedTokens.Revoke(ctx, claims); err != nil {
+			return "", err
+		}
+
+		if claims.FamilyID != uuid.Nil {
+			if err := h.refreshTokens.RevokeFamily(ctx, claims.FamilyID, core.RefreshTokenRevokeClient); err != nil && !errors.Is(err, core.ErrNotFound) {
+				return "", err
+			}
+		}
+
+		return tokenTypeAccessToken, nil
+	}
+
+	if !errors.Is(err, core.ErrInvalidToken) {
+		return "", err
+	}
+
+	rt, err := h.refreshTokens.Get(ctx, token)
+	if errors.Is(err, core.ErrInvalidToken) {
+		return tokenTypeUnknown, nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if rt.ClientID != client.ID.String() {
+		return "", newError(errUnauthorizedClient, "token was not issued to the client")
+	}
+
+	if err := h.refreshTokens.RevokeFamily(ctx, rt.FamilyID, core.RefreshTokenRevokeClient); err != nil && !errors.Is(err, core.ErrNotFound) {
+		return "", err
+	}
+
+	return tokenTypeRefreshToken, nil
+}
+
+// verifyAccessToken verifies token like [core.AccessTokenIssuer.Verify], also returning [core.ErrInvalidToken] if the
+// token was revoked.
+func (h *Handler) verifyAccessToken(ctx context.Context, token string) (core.AccessTokenClaims, error) {
+	claims, err := h.accessTokens.Verify(ctx, token)
+	if err != nil {
+		return core.AccessTokenClaims{}, err
+	}
+
+	if err := h.checkRevoked(ctx, claims); err != nil {
+		return core.AccessTokenClaims{}, err
+	}
+
+	return claims, nil
+}
+
+// checkRevoked returns [core.ErrInvalidToken] if the access token was revoked, either by itself or along with the
+// refresh token family it was issued with.
+func (h *Handler) checkRevoked(ctx context.Context, claims core.AccessTokenClaims) error {
+	revoked, err := h.revokedTokens.Revoked(ctx, claims.ID)
+	if err != nil {
+		return err
+	}
+
+	if revoked {
+		return core.ErrInvalidToken
+	}
+
+	if claims.FamilyID == uuid.Nil {
+		return nil
+	}
+
+	active, err := h.refreshTokens.FamilyActive(ctx, claims.FamilyID)
+	if err != nil {
+		return err
+	}
+
+	if !active {
+		return core.ErrInvalidToken
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package oauth
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type metrics struct {
+	issued         *prometheus.CounterVec
+	introspections *prometheus.CounterVec
+	revocations    *prometheus.CounterVec
+	errors         *prometheus.CounterVec
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		issued: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "guardian",
+			Subsystem: "oauth",
+			Name:      "tokens_issued_total",
+			Help:      "The cumulative count of access tokens issued by the token endpoint labeled by grant type.",
+		}, []string{"grant_type"}),
+		introspections: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "guardian",
+			Subsystem: "oauth",
+			Name:      "introspections_total",
+			Help:      "The cumulative count of answered introspection requests labeled by token type and whether the token was active.",
+		}, []string{"token_type", "active"}),
+		revocations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "guardian",
+			Subsystem: "oauth",
+			Name:      "revocations_total",
+			Help:      "The cumulative count of tokens revoked by clients labeled by token type.",
+		}, []string{"token_type"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "guardian",
+			Subsystem: "oauth",
+			Name:      "errors_total",
+			Help:      "The cumulative count of OAuth error responses labeled by endpoint and error code.",
+		}, []string{"endpoint", "error"}),
+	}
+}
+
+// Describe implements [prometheus.Collector].
+func (m *metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.issued.Describe(ch)
+	m.introspections.Describe(ch)
+	m.revocations.Describe(ch)
+	m.errors.Describe(ch)
+}
+
+// Collect implements [prometheus.Collector].
+func (m *metrics) Collect(ch chan<- prometheus.Metric) {
+	m.issued.Collect(ch)
+	m.introspections.Collect(ch)
+	m.revocations.Collect(ch)
+	m.errors.Collect(ch)
+}
@@ -0,0 +1,301 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/gophero/guardian/core"
+)
+
+// maxNonceLength limits the nonce, which is stored with the request and echoed in the ID token.
+const maxNonceLength = 512
+
+// discoveryMaxAge is the duration for which relying parties may cache the OpenID Provider metadata.
+const discoveryMaxAge = time.Hour
+
+// assertionAlgorithms are the algorithms service accounts may sign client assertions with.
+var assertionAlgorithms = []string{"EdDSA", "ES256", "RS256"}
+
+// providerMetadata is the OpenID Provider metadata of OpenID Connect Discovery 1.0, section 3.
+type providerMetadata struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	DeviceAuthorizationEndpoint       string   `json:"device_authorization_endpoint"`
+	IntrospectionEndpoint             string   `json:"introspection_endpoint"`
+	RevocationEndpoint                string   `json:"revocation_endpoint"`
+	UserinfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	ResponseModesSupported            []string `json:"response_modes_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	TokenEndpointAuthSigningAlgValues []string `json:"token_endpoint_auth_signing_alg_values_supported"`
+	IntrospectionEndpointAuthMethods  []string `json:"introspection_endpoint_auth_methods_supported"`
+	RevocationEndpointAuthMethods     []string `json:"revocation_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	PromptValuesSupported             []string `json:"prompt_values_supported"`
+	ACRValuesSupported                []string `json:"acr_values_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+	RequestURIParameterSupported      bool     `json:"request_uri_parameter_supported"`
+}
+
+func (h *Handler) discovery(w http.ResponseWriter, r *http.Request) {
+	issuer := h.idTokens.Issuer()
+	base := strings.TrimSuffix(issuer, "/")
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(int(discoveryMaxAge.Seconds())))
+
+	if err := json.NewEncoder(w).Encode(providerMetadata{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             base + "/authorize",
+		TokenEndpoint:                     base + "/token",
+		DeviceAuthorizationEndpoint:       base + "/device_authorization",
+		IntrospectionEndpoint:             base + "/introspect",
+		RevocationEndpoint:                base + "/revoke",
+		UserinfoEndpoint:                  base + "/userinfo",
+		JWKSURI:                           base + "/.well-known/jwks.json",
+		ScopesSupported:                   []string{core.ScopeOpenID, core.ScopeProfile, core.ScopeEmail, core.ScopePhone, core.ScopeAddress},
+		ResponseTypesSupported:            []string{"code"},
+		ResponseModesSupported:            []string{"query"},
+		GrantTypesSupported:               []string{core.OAuthGrantAuthorizationCode, core.OAuthGrantRefreshToken, core.OAuthGrantDeviceCode, core.OAuthGrantClientCredentials},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{h.idTokens.Algorithm()},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "private_key_jwt", "none"},
+		TokenEndpointAuthSigningAlgValues: assertionAlgorithms,
+		IntrospectionEndpointAuthMethods:  []string{"client_secret_basic", "client_secret_post"},
+		RevocationEndpointAuthMethods:     []string{"client_secret_basic", "client_secret_post", "none"},
+		CodeChallengeMethodsSupported:     []string{codeChallengeMethod},
+		PromptValuesSupported:             []string{core.PromptNone, core.PromptLogin, core.PromptConsent, core.PromptSelectAccount},
+		ACRValuesSupported:                []string{core.ACRSingleFactor, core.ACRMultiFactor},
+		ClaimsSupported: []string{
+			"iss", "sub", "aud", "azp", "exp", "iat", "auth_time", "nonce", "acr", "amr", "sid",
+			"name", "given_name", "family_name", "nickname", "preferred_username", "picture", "website", "birthdate",
+			"zoneinfo", "locale", "updated_at", "email", "email_verified", "phone_number", "address",
+		},
+	}); err != nil {
+		zerolog.Ctx(r.Context()).Err(err).Msg("failed to write openid configuration")
+	}
+}
+
+// idToken issues an ID token to the client if scope contains the openid scope. The authentication of the user is
+// described by the session, which is the zero value if the tokens are not bound to one.
+func (h *Handler) idToken(ctx context.Context, client core.OAuthClient, userID uuid.UUID, sess core.Session, scope []string, nonce string) (string, error) {
+	if !slices.Contains(scope, core.ScopeOpenID) {
+		return "", nil
+	}
+
+	params := core.IDTokenParams{
+		Subject:  userID.String(),
+		ClientID: client.ID.String(),
+		Nonce:    nonce,
+	}
+
+	if sess.ID != uuid.Nil {
+		params.SessionID = sess.ID
+		params.AuthTime = sess.CreatedAt
+		params.AMR = sess.AuthMethods
+		params.ACR = core.ACRSingleFactor
+		if slices.Contains(sess.AuthMethods, core.AuthMethodMultiFactor) {
+			params.ACR = core.ACRMultiFactor
+		}
+	}
+
+	return h.idTokens.Issue(ctx, params)
+}
+
+// userInfo holds the standard claims of OpenID Connect Core 1.0, section 5.1.
+type userInfo struct {
+	Subject           string       `json:"sub"`
+	Name              string       `json:"name,omitempty"`
+	GivenName         string       `json:"given_name,omitempty"`
+	FamilyName        string       `json:"family_name,omitempty"`
+	Nickname          string       `json:"nickname,omitempty"`
+	PreferredUsername string       `json:"preferred_username,omitempty"`
+	Picture           string       `json:"picture,omitempty"`
+	Website           string       `json:"website,omitempty"`
+	Birthdate         string       `json:"birthdate,omitempty"`
+	Zoneinfo          string       `json:"zoneinfo,omitempty"`
+	Locale            string       `json:"locale,omitempty"`
+	UpdatedAt         int64        `json:"updated_at,omitempty"`
+	Email             string       `json:"email,omitempty"`
+	EmailVerified     *bool        `json:"email_verified,omitempty"`
+	PhoneNumber       string       `json:"phone_number,omitempty"`
+	Address           *userAddress `json:"address,omitempty"`
+}
+
+// userAddress is the address claim of OpenID Connect Core 1.0, section 5.1.1.
+type userAddress struct {
+	Formatted     string `json:"formatted,omitempty"`
+	StreetAddress string `json:"street_address,omitempty"`
+	Locality      string `json:"locality,omitempty"`
+	Region        string `json:"region,omitempty"`
+	PostalCode    string `json:"postal_code,omitempty"`
+	Country       string `json:"country,omitempty"`
+}
+
+// userinfo implements the userinfo endpoint of OpenID Connect Core 1.0, section 5.3. The access token is only accepted
+// in the `Authorization: Bearer` header.
+func (h *Handler) userinfo(w http.ResponseWriter, r *http.Request) {
+	info, err := h.userInfo(r.Context(), r)
+	if err != nil {
+		h.writeError(w, r, "userinfo", err)
+		return
+	}
+
+	writeJSON(w, r, http.StatusOK, info)
+}
+
+// userInfo returns the claims about the user the access token of r was issued for, selected by the scope of the token
+// as described in OpenID Connect Core 1.0, section 5.4.
+func (h *Handler) userInfo(ctx context.Context, r *http.Request) (userInfo, error) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return userInfo{}, newError(errInvalidToken, "missing bearer token")
+	}
+
+	claims, err := h.verifyAccessToken(ctx, token)
+	if errors.Is(err, core.ErrInvalidToken) {
+		return userInfo{}, newError(errInvalidToken, "invalid access token")
+	}
+
+	if err != nil {
+		return userInfo{}, err
+	}
+
+	// Tokens issued to users directly are not limited to a scope and are not accepted.
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil || claims.ClientID == "" {
+		return userInfo{}, newError(errInvalidToken, "access token is not issued to a client")
+	}
+
+	if !slices.Contains(claims.Scope, core.ScopeOpenID) {
+		return userInfo{}, newError(errInsufficientScope, "scope %s is required", core.ScopeOpenID)
+	}
+
+	user, _, err := h.checkActive(ctx, userID, claims.SessionID)
+	if res := (*errorResponse)(nil); errors.As(err, &res) {
+		return userInfo{}, newError(errInvalidToken, "%s", res.Description)
+	}
+
+	if err != nil {
+		return userInfo{}, err
+	}
+
+	info := userInfo{Subject: user.ID.String()}
+
+	if slices.Contains(claims.Scope, core.ScopeEmail) {
+		verified := user.EmailVerifiedAt != nil
+		info.Email, info.EmailVerified = user.Email, &verified
+	}
+
+	profileScope := slices.Contains(claims.Scope, core.ScopeProfile)
+	phoneScope := slices.Contains(claims.Scope, core.ScopePhone)
+	addressScope := slices.Contains(claims.Scope, core.ScopeAddress)
+
+	if !profileScope && !phoneScope && !addressScope {
+		return info, nil
+	}
+
+	profile, err := h.profiles.Get(ctx, user.ID)
+	if err != nil {
+		return userInfo{}, err
+	}
+
+	if profileScope {
+		info.Name = profile.Name
+		info.GivenName = profile.GivenName
+		info.FamilyName = profile.FamilyName
+		info.Nickname = profile.Nickname
+		info.PreferredUsername = user.Username
+		info.Picture = profile.Picture
+		info.Website = profile.Website
+		info.Birthdate = profile.Birthdate
+		info.Zoneinfo = profile.Zoneinfo
+		info.Locale = profile.Locale
+
+		updatedAt := user.UpdatedAt
+		if profile.UpdatedAt.After(updatedAt) {
+			updatedAt = profile.UpdatedAt
+		}
+		if !updatedAt.IsZero() {
+			info.UpdatedAt = updatedAt.Unix()
+		}
+	}
+
+	if phoneScope {
+		info.PhoneNumber = profile.PhoneNumber
+	}
+
+	if addressScope && !profile.Address.IsZero() {
+		info.Address = toUserAddress(profile.Address)
+	}
+
+	return info, nil
+}
+
+// toUserAddress converts a, formatting it with one line per known field.
+func toUserAddress(a core.UserAddress) *userAddress {
+	var lines []string
+	for _, l := range []string{a.StreetAddress, a.Locality, a.Region, a.PostalCode, a.Country} {
+		if l != "" {
+			lines = append(lines, l)
+		}
+	}
+
+	return &userAddress{
+		Formatted:     strings.Join(lines, "\n"),
+		StreetAddress: a.StreetAddress,
+		Locality:      a.Locality,
+		Region:        a.Region,
+		PostalCode:    a.PostalCode,
+		Country:       a.Country,
+	}
+}
+
+// parsePrompt parses the space delimited prompt parameter of OpenID Connect Core 1.0, section 3.1.2.1.
+func parsePrompt(prompt string) ([]string, error) {
+	values := normalize(strings.Fields(prompt))
+	for _, v := range values {
+		switch v {
+		case core.PromptNone, core.PromptLogin, core.PromptConsent, core.PromptSelectAccount:
+		default:
+			return nil, newError(errInvalidRequest, "unsupported prompt %s", v)
+		}
+	}
+
+	if len(values) > 1 && slices.Contains(values, core.PromptNone) {
+		return nil, newError(errInvalidRequest, "prompt %s cannot be combined with other values", core.PromptNone)
+	}
+
+	return values, nil
+}
+
+// parseMaxAge parses the max_age parameter of OpenID Connect Core 1.0, section 3.1.2.1 in seconds. It returns nil if
+// the parameter is empty.
+func parseMaxAge(maxAge string) (*time.Duration, error) {
+	if maxAge == "" {
+		return nil, nil
+	}
+
+	seconds, err := strconv.ParseUint(maxAge, 10, 31)
+	if err != nil {
+		return nil, newError(errInvalidRequest, "malformed max_age")
+	}
+
+	d := time.Duration(seconds) * time.Second
+	return &d, nil
+}
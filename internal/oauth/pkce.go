@@ -0,0 +1,30 @@
+package oauth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"regexp"
+)
+
+// codeChallengeMethod is the only PKCE transformation supported. The plain method is not allowed by OAuth 2.1.
+const codeChallengeMethod = "S256"
+
+// verifierRe matches a code verifier of RFC 7636, section 4.1.
+var verifierRe = regexp.MustCompile(`^[A-Za-z0-9\-._~]{43,128}$`)
+
+// validChallenge reports whether challenge is an unpadded base64url encoded SHA-256 hash.
+func validChallenge(challenge string) bool {
+	b, err := base64.RawURLEncoding.DecodeString(challenge)
+	return err == nil && len(b) == sha256.Size
+}
+
+// verifyChallenge reports whether verifier is the code verifier of the S256 challenge.
+func verifyChallenge(verifier, challenge string) bool {
+	if !verifierRe.MatchString(verifier) {
+		return false
+	}
+
+	h := sha256.Sum256([]byte(verifier))
+	return subtle.ConstantTimeCompare([]byte(base64.RawURLEncoding.EncodeToString(h[:])), []byte(challenge)) == 1
+}
@@ -0,0 +1,53 @@
+package oauth
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/gophero/guardian/core"
+)
+
+// CodeRedirect returns the URL redirecting the user agent back to the client of r with the authorization code issued
+// for it.
+func CodeRedirect(r core.OAuthAuthorizationRequest, code string) (string, error) {
+	return redirect(r.RedirectURI, r.State, url.Values{"code": {code}})
+}
+
+// DeniedRedirect returns the URL redirecting the user agent back to the client of r with the error code, which has to
+// be accepted by [ValidDenialError].
+func DeniedRedirect(r core.OAuthAuthorizationRequest, code string) (string, error) {
+	if !ValidDenialError(code) {
+		return "", fmt.Errorf("oauth: invalid denial error %s: %w", code, core.ErrInvalidArgument)
+	}
+
+	description := "the user denied the request"
+	if code != errAccessDenied {
+		description = "the request cannot be answered without interacting with the user"
+	}
+
+	return redirect(r.RedirectURI, r.State, url.Values{
+		"error":             {code},
+		"error_description": {description},
+	})
+}
+
+// redirect adds params and state to the query of redirectURI, keeping its own query parameters.
+func redirect(redirectURI, state string, params url.Values) (string, error) {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		return "", fmt.Errorf("oauth: parse redirect uri: %w", err)
+	}
+
+	q := u.Query()
+	for k, v := range params {
+		q[k] = v
+	}
+
+	if state != "" {
+		q.Set("state", state)
+	}
+
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
@@ -0,0 +1,69 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+// revocationRetention keeps revoked tokens beyond their expiry, as verifiers accept expired tokens for a short leeway.
+const revocationRetention = 5 * time.Minute
+
+// RevocationStore is a postgres backed [core.AccessTokenRevocationStore].
+type RevocationStore struct {
+	q   queries.Querier
+	now func() time.Time
+}
+
+var _ core.AccessTokenRevocationStore = (*RevocationStore)(nil)
+
+// NewRevocationStore constructs new [RevocationStore].
+func NewRevocationStore(pool *pgxpool.Pool) *RevocationStore {
+	return newRevocationStore(queries.New(pool))
+}
+
+func newRevocationStore(q queries.Querier) *RevocationStore {
+	return &RevocationStore{q: q, now: time.Now}
+}
+
+// Revoke implements [core.AccessTokenRevocationStore]. Revoking a token twice is no error.
+func (s *RevocationStore) Revoke(ctx context.Context, claims core.AccessTokenClaims) error {
+	if claims.ID == "" {
+		return fmt.Errorf("oauth: access token has no id: %w", core.ErrInvalidArgument)
+	}
+
+	if err := s.q.RevokeOAuthAccessToken(ctx, queries.RevokeOAuthAccessTokenParams{
+		TokenID:   claims.ID,
+		ExpiresAt: claims.ExpiresAt,
+	}); err != nil {
+		return fmt.Errorf("oauth: revoke oauth access token: %w", err)
+	}
+
+	return nil
+}
+
+// Revoked implements [core.AccessTokenRevocationStore].
+func (s *RevocationStore) Revoked(ctx context.Context, id string) (bool, error) {
+	revoked, err := s.q.IsOAuthAccessTokenRevoked(ctx, id)
+	if err != nil {
+		return false, fmt.Errorf("oauth: is oauth access token revoked: %w", err)
+	}
+
+	return revoked, nil
+}
+
+// DeleteExpired deletes revoked tokens which expired longer than [revocationRetention] ago and returns the number of
+// deleted rows.
+func (s *RevocationStore) DeleteExpired(ctx context.Context) (int64, error) {
+	n, err := s.q.DeleteExpiredOAuthRevokedAccessTokens(ctx, s.now().Add(-revocationRetention))
+	if err != nil {
+		return 0, fmt.Errorf("oauth: delete expired oauth revoked access tokens: %w", err)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,49 @@
+package oauth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+)
+
+func TestRevocationStore(t *testing.T) {
+	ctx := context.Background()
+
+	q := newFakeQuerier()
+	s := newRevocationStore(q)
+
+	now := time.Now()
+	s.now = func() time.Time { return now }
+
+	claims := core.AccessTokenClaims{ID: uuid.NewString(), ExpiresAt: now.Add(15 * time.Minute)}
+
+	revoked, err := s.Revoked(ctx, claims.ID)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	require.NoError(t, s.Revoke(ctx, claims))
+	require.NoError(t, s.Revoke(ctx, claims))
+
+	revoked, err = s.Revoked(ctx, claims.ID)
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	require.ErrorIs(t, s.Revoke(ctx, core.AccessTokenClaims{ExpiresAt: claims.ExpiresAt}), core.ErrInvalidArgument)
+
+	// Revoked tokens are kept for a while after they expired.
+	now = claims.ExpiresAt.Add(time.Minute)
+	n, err := s.DeleteExpired(ctx)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	now = claims.ExpiresAt.Add(revocationRetention + time.Second)
+	n, err = s.DeleteExpired(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, n)
+	require.NotContains(t, q.revoked, claims.ID)
+}
@@ -0,0 +1,133 @@
+package oauth
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gophero/guardian/core"
+)
+
+const (
+	maxNameLength   = 128
+	maxRedirectURIs = 16
+	maxScopes       = 64
+	maxAudiences    = 16
+)
+
+// grantTypes are the grant types clients can be allowed to use.
+var grantTypes = []string{core.OAuthGrantAuthorizationCode, core.OAuthGrantRefreshToken, core.OAuthGrantDeviceCode}
+
+// scopeRe matches a scope token of RFC 6749, section 3.3.
+var scopeRe = regexp.MustCompile(`^[\x21\x23-\x5B\x5D-\x7E]+$`)
+
+// audienceRe matches audiences, which are usually URIs or plain names of resource servers.
+var audienceRe = regexp.MustCompile(`^[\x21-\x7E]+$`)
+
+func validateName(name string) error {
+	if strings.TrimSpace(name) == "" || utf8.RuneCountInString(name) > maxNameLength {
+		return fmt.Errorf("oauth: name must have 1 to %d characters: %w", maxNameLength, core.ErrInvalidArgument)
+	}
+	return nil
+}
+
+func validateType(t core.OAuthClientType) error {
+	switch t {
+	case core.OAuthClientPublic, core.OAuthClientConfidential:
+		return nil
+	default:
+		return fmt.Errorf("oauth: invalid client type `%s`: %w", t, core.ErrInvalidArgument)
+	}
+}
+
+// validateRedirectURIs requires absolute URIs without fragment. Plain http is only allowed on loopback interfaces and
+// custom schemes have to be reverse domain names, as native apps use them (RFC 8252, section 7). Clients of the
+// authorization code grant need at least one, devices never redirect.
+func validateRedirectURIs(uris, grants []string) error {
+	if len(uris) > maxRedirectURIs {
+		return fmt.Errorf("oauth: clients cannot have more than %d redirect uris: %w", maxRedirectURIs, core.ErrInvalidArgument)
+	}
+
+	if len(uris) == 0 && slices.Contains(grants, core.OAuthGrantAuthorizationCode) {
+		return fmt.Errorf("oauth: clients of the authorization code grant must have a redirect uri: %w", core.ErrInvalidArgument)
+	}
+
+	for _, uri := range uris {
+		u, err := url.Parse(uri)
+		if err != nil || !u.IsAbs() || u.Fragment != "" || strings.Contains(uri, "#") {
+			return fmt.Errorf("oauth: redirect uri `%s` must be absolute without fragment: %w", uri, core.ErrInvalidArgument)
+		}
+
+		switch {
+		case u.Scheme == "https":
+		case u.Scheme == "http":
+			if !loopback(u.Hostname()) {
+				return fmt.Errorf("oauth: redirect uri `%s` must use https: %w", uri, core.ErrInvalidArgument)
+			}
+		case !strings.Contains(u.Scheme, "."):
+			return fmt.Errorf("oauth: custom scheme of redirect uri `%s` must be a reverse domain name: %w", uri, core.ErrInvalidArgument)
+		}
+	}
+	return nil
+}
+
+func loopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func validateGrantTypes(grants []string) error {
+	if len(grants) == 0 {
+		return fmt.Errorf("oauth: clients must have at least one grant type: %w", core.ErrInvalidArgument)
+	}
+
+	for _, g := range grants {
+		if !slices.Contains(grantTypes, g) {
+			return fmt.Errorf("oauth: unsupported grant type `%s`: %w", g, core.ErrInvalidArgument)
+		}
+	}
+	return nil
+}
+
+func validateScopes(scopes []string) error {
+	if len(scopes) > maxScopes {
+		return fmt.Errorf("oauth: clients cannot have more than %d scopes: %w", maxScopes, core.ErrInvalidArgument)
+	}
+
+	for _, s := range scopes {
+		if len(s) > 128 || !scopeRe.MatchString(s) {
+			return fmt.Errorf("oauth: invalid scope `%s`: %w", s, core.ErrInvalidArgument)
+		}
+	}
+	return nil
+}
+
+func validateAudiences(audiences []string) error {
+	if len(audiences) > maxAudiences {
+		return fmt.Errorf("oauth: clients cannot have more than %d audiences: %w", maxAudiences, core.ErrInvalidArgument)
+	}
+
+	for _, a := range audiences {
+		if len(a) > 256 || !audienceRe.MatchString(a) {
+			return fmt.Errorf("oauth: invalid audience `%s`: %w", a, core.ErrInvalidArgument)
+		}
+	}
+	return nil
+}
+
+// normalize returns values sorted without duplicates. It never returns nil, which would be stored as NULL.
+func normalize(values []string) []string {
+	res := slices.Compact(slices.Sorted(slices.Values(values)))
+	if res == nil {
+		return []string{}
+	}
+	return res
+}
@@ -0,0 +1,25 @@
+package organization
+
+import (
+	"errors"
+	"net/url"
+	"time"
+)
+
+type Config struct {
+	InvitationURL string        `help:"URL of the page which answers organization invitations. The token is added as token query parameter." name:"invitation_url" env:"INVITATION_URL"`
+	InvitationTTL time.Duration `help:"Duration in which an organization invitation has to be answered." name:"invitation_ttl" env:"INVITATION_TTL" default:"168h"`
+}
+
+func (c Config) validate() error {
+	u, err := url.Parse(c.InvitationURL)
+	if err != nil || !u.IsAbs() {
+		return errors.New("organization: InvitationURL must be an absolute URL")
+	}
+
+	if c.InvitationTTL <= 0 {
+		return errors.New("organization: InvitationTTL cannot be zero or negative")
+	}
+
+	return nil
+}
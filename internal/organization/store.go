@@ -0,0 +1,640 @@
+// Package organization manages multi-tenant organizations, their members and invitations to join them.
+package organization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/cursor"
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/internal/db/queries"
+	"github.com/gophero/guardian/internal/secret"
+)
+
+// Store is a postgres backed [core.OrganizationStore]. Changes which can remove owners lock the organization, so
+// concurrent changes cannot remove the last owner together. Methods scoped to an organization run in transactions
+// restricted to it by row-level security, see [db.BeginTenantFunc]. Methods across organizations, like lookups by user
+// or token, lift the restriction explicitly, see [db.BeginBypassFunc].
+type Store struct {
+	config Config
+	link   *url.URL
+	pool   *pgxpool.Pool
+	q      *queries.Queries
+	now    func() time.Time
+}
+
+var _ core.OrganizationStore = (*Store)(nil)
+
+// NewStore constructs new [Store].
+func NewStore(pool *pgxpool.Pool, config Config) (*Store, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	link, err := url.Parse(config.InvitationURL)
+	if err != nil {
+		return nil, fmt.Errorf("organization: parse invitation url: %w", err)
+	}
+
+	return &Store{config: config, link: link, pool: pool, q: queries.New(pool), now: time.Now}, nil
+}
+
+// Create implements [core.OrganizationStore].
+func (s *Store) Create(ctx context.Context, params core.CreateOrganizationParams, owner uuid.UUID) (core.Organization, core.OrganizationMembership, error) {
+	if err := validateSlug(params.Slug); err != nil {
+		return core.Organization{}, core.OrganizationMembership{}, err
+	}
+
+	if err := validateName(params.Name); err != nil {
+		return core.Organization{}, core.OrganizationMembership{}, err
+	}
+
+	var (
+		o queries.Organization
+		m queries.OrganizationMembership
+	)
+
+	err := s.acrossTenants(ctx, func(q *queries.Queries) error {
+		var err error
+
+		o, err = q.CreateOrganization(ctx, queries.CreateOrganizationParams{Slug: params.Slug, Name: params.Name})
+		if err != nil {
+			return fmt.Errorf("organization: create organization: %w", mapError(err))
+		}
+
+		m, err = q.CreateOrganizationMembership(ctx, queries.CreateOrganizationMembershipParams{
+			OrgID:  o.ID,
+			UserID: owner,
+			Role:   queries.OrganizationRoleOwner,
+		})
+		if err != nil {
+			return fmt.Errorf("organization: create organization membership: %w", mapError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return core.Organization{}, core.OrganizationMembership{}, err
+	}
+
+	return toOrganization(o), toMembership(m), nil
+}
+
+// Get implements [core.OrganizationStore].
+func (s *Store) Get(ctx context.Context, id uuid.UUID) (core.Organization, error) {
+	var o queries.Organization
+
+	err := s.inTenant(ctx, id, func(q *queries.Queries) error {
+		var err error
+
+		o, err = q.GetOrganizationByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("organization: get organization by id: %w", mapError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return core.Organization{}, err
+	}
+
+	return toOrganization(o), nil
+}
+
+// GetBySlug implements [core.OrganizationStore].
+func (s *Store) GetBySlug(ctx context.Context, slug string) (core.Organization, error) {
+	var o queries.Organization
+
+	err := s.acrossTenants(ctx, func(q *queries.Queries) error {
+		var err error
+
+		o, err = q.GetOrganizationBySlug(ctx, slug)
+		if err != nil {
+			return fmt.Errorf("organization: get organization by slug: %w", mapError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return core.Organization{}, err
+	}
+
+	return toOrganization(o), nil
+}
+
+// ListByUser implements [core.OrganizationStore].
+func (s *Store) ListByUser(ctx context.Context, userID uuid.UUID) ([]core.Organization, error) {
+	var os []queries.Organization
+
+	err := s.acrossTenants(ctx, func(q *queries.Queries) error {
+		var err error
+
+		os, err = q.ListOrganizationsByUser(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("organization: list organizations by user: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]core.Organization, 0, len(os))
+	for _, o := range os {
+		res = append(res, toOrganization(o))
+	}
+
+	return res, nil
+}
+
+// Update implements [core.OrganizationStore].
+func (s *Store) Update(ctx context.Context, id uuid.UUID, params core.UpdateOrganizationParams) (core.Organization, error) {
+	arg := queries.UpdateOrganizationParams{ID: id}
+
+	if params.Slug != nil {
+		if err := validateSlug(*params.Slug); err != nil {
+			return core.Organization{}, err
+		}
+		arg.Slug = pgtype.Text{String: *params.Slug, Valid: true}
+	}
+
+	if params.Name != nil {
+		if err := validateName(*params.Name); err != nil {
+			return core.Organization{}, err
+		}
+		arg.Name = pgtype.Text{String: *params.Name, Valid: true}
+	}
+
+	var o queries.Organization
+
+	err := s.inTenant(ctx, id, func(q *queries.Queries) error {
+		var err error
+
+		o, err = q.UpdateOrganization(ctx, arg)
+		if err != nil {
+			return fmt.Errorf("organization: update organization: %w", mapError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return core.Organization{}, err
+	}
+
+	return toOrganization(o), nil
+}
+
+// Delete implements [core.OrganizationStore].
+func (s *Store) Delete(ctx context.Context, id uuid.UUID) error {
+	return s.inTenant(ctx, id, func(q *queries.Queries) error {
+		n, err := q.DeleteOrganization(ctx, id)
+		if err != nil {
+			return fmt.Errorf("organization: delete organization: %w", err)
+		}
+
+		if n == 0 {
+			return fmt.Errorf("organization: delete organization: %w", core.ErrNotFound)
+		}
+
+		return nil
+	})
+}
+
+// GetMembership implements [core.OrganizationStore].
+func (s *Store) GetMembership(ctx context.Context, orgID, userID uuid.UUID) (core.OrganizationMembership, error) {
+	var m queries.OrganizationMembership
+
+	err := s.inTenant(ctx, orgID, func(q *queries.Queries) error {
+		var err error
+
+		m, err = q.GetOrganizationMembership(ctx, queries.GetOrganizationMembershipParams{OrgID: orgID, UserID: userID})
+		if err != nil {
+			return fmt.Errorf("organization: get organization membership: %w", mapError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return core.OrganizationMembership{}, err
+	}
+
+	return toMembership(m), nil
+}
+
+// ListMemberships implements [core.OrganizationStore].
+func (s *Store) ListMemberships(ctx context.Context, params core.ListMembershipsParams) (core.MembershipPage, error) {
+	after, err := cursor.Decode(params.Cursor)
+	if err != nil {
+		return core.MembershipPage{}, err
+	}
+
+	limit := cursor.Limit(params.Limit)
+
+	var ms []queries.OrganizationMembership
+
+	err = s.inTenant(ctx, params.OrgID, func(q *queries.Queries) error {
+		ms, err = q.ListOrganizationMemberships(ctx, queries.ListOrganizationMembershipsParams{
+			OrgID:       params.OrgID,
+			AfterUserID: after,
+			Limit:       limit + 1,
+		})
+		if err != nil {
+			return fmt.Errorf("organization: list organization memberships: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return core.MembershipPage{}, err
+	}
+
+	var page core.MembershipPage
+
+	if len(ms) > int(limit) {
+		ms = ms[:limit]
+		page.NextCursor = cursor.Encode(ms[len(ms)-1].UserID)
+	}
+
+	page.Memberships = make([]core.OrganizationMembership, 0, len(ms))
+	for _, m := range ms {
+		page.Memberships = append(page.Memberships, toMembership(m))
+	}
+
+	return page, nil
+}
+
+// UpdateMembershipRole implements [core.OrganizationStore].
+func (s *Store) UpdateMembershipRole(ctx context.Context, orgID, userID uuid.UUID, role core.OrganizationRole) (core.OrganizationMembership, error) {
+	if err := validateRole(role); err != nil {
+		return core.OrganizationMembership{}, err
+	}
+
+	var m queries.OrganizationMembership
+
+	err := s.changeOwners(ctx, orgID, func(q *queries.Queries) error {
+		var err error
+
+		m, err = q.UpdateOrganizationMembershipRole(ctx, queries.UpdateOrganizationMembershipRoleParams{
+			Role:   queries.OrganizationRole(role),
+			OrgID:  orgID,
+			UserID: userID,
+		})
+		if err != nil {
+			return fmt.Errorf("organization: update organization membership role: %w", mapError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return core.OrganizationMembership{}, err
+	}
+
+	return toMembership(m), nil
+}
+
+// RemoveMembership implements [core.OrganizationStore].
+func (s *Store) RemoveMembership(ctx context.Context, orgID, userID uuid.UUID) error {
+	return s.changeOwners(ctx, orgID, func(q *queries.Queries) error {
+		n, err := q.DeleteOrganizationMembership(ctx, queries.DeleteOrganizationMembershipParams{OrgID: orgID, UserID: userID})
+		if err != nil {
+			return fmt.Errorf("organization: delete organization membership: %w", err)
+		}
+
+		if n == 0 {
+			return fmt.Errorf("organization: delete organization membership: %w", core.ErrNotFound)
+		}
+
+		return nil
+	})
+}
+
+// TransferOwnership implements [core.OrganizationStore].
+func (s *Store) TransferOwnership(ctx context.Context, orgID, from, to uuid.UUID) error {
+	if from == to {
+		return fmt.Errorf("organization: cannot transfer ownership to the same member: %w", core.ErrInvalidArgument)
+	}
+
+	return s.changeOwners(ctx, orgID, func(q *queries.Queries) error {
+		owner, err := q.GetOrganizationMembership(ctx, queries.GetOrganizationMembershipParams{OrgID: orgID, UserID: from})
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("organization: get organization membership: %w", err)
+		}
+
+		if owner.Role != queries.OrganizationRoleOwner {
+			return fmt.Errorf("organization: only owners can transfer ownership: %w", core.ErrInvalidArgument)
+		}
+
+		if _, err := q.UpdateOrganizationMembershipRole(ctx, queries.UpdateOrganizationMembershipRoleParams{
+			Role:   queries.OrganizationRoleOwner,
+			OrgID:  orgID,
+			UserID: to,
+		}); err != nil {
+			return fmt.Errorf("organization: update organization membership role: %w", mapError(err))
+		}
+
+		if _, err := q.UpdateOrganizationMembershipRole(ctx, queries.UpdateOrganizationMembershipRoleParams{
+			Role:   queries.OrganizationRoleAdmin,
+			OrgID:  orgID,
+			UserID: from,
+		}); err != nil {
+			return fmt.Errorf("organization: update organization membership role: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// changeOwners runs fn in a transaction holding the lock of the organization and rolls it back if the organization
+// has no owner afterwards.
+func (s *Store) changeOwners(ctx context.Context, orgID uuid.UUID, fn func(q *queries.Queries) error) error {
+	return s.inTenant(ctx, orgID, func(q *queries.Queries) error {
+		if _, err := q.LockOrganization(ctx, orgID); err != nil {
+			return fmt.Errorf("organization: lock organization: %w", mapError(err))
+		}
+
+		if err := fn(q); err != nil {
+			return err
+		}
+
+		owners, err := q.CountOrganizationOwners(ctx, orgID)
+		if err != nil {
+			return fmt.Errorf("organization: count organization owners: %w", err)
+		}
+
+		if owners == 0 {
+			return fmt.Errorf("organization: cannot remove the last owner: %w", core.ErrInvalidArgument)
+		}
+
+		return nil
+	})
+}
+
+// inTenant runs fn in a transaction restricted to the organization. The nil id never refers to an organization.
+func (s *Store) inTenant(ctx context.Context, orgID uuid.UUID, fn func(q *queries.Queries) error) error {
+	if orgID == uuid.Nil {
+		return fmt.Errorf("organization: nil organization id: %w", core.ErrNotFound)
+	}
+
+	return db.BeginTenantFunc(ctx, s.pool, orgID, func(tx pgx.Tx) error {
+		return fn(s.q.WithTx(tx))
+	})
+}
+
+// acrossTenants runs fn in a transaction which is not restricted to an organization.
+func (s *Store) acrossTenants(ctx context.Context, fn func(q *queries.Queries) error) error {
+	return db.BeginBypassFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		return fn(s.q.WithTx(tx))
+	})
+}
+
+// Invite implements [core.OrganizationStore].
+func (s *Store) Invite(ctx context.Context, params core.InviteParams) (core.OrganizationInvitation, string, error) {
+	if err := validateEmail(params.Email); err != nil {
+		return core.OrganizationInvitation{}, "", err
+	}
+
+	if err := validateRole(params.Role); err != nil {
+		return core.OrganizationInvitation{}, "", err
+	}
+
+	var inviterID *uuid.UUID
+	if params.InviterID != uuid.Nil {
+		inviterID = &params.InviterID
+	}
+
+	token := secret.New(secret.DefaultSize)
+
+	var i queries.OrganizationInvitation
+
+	err := s.inTenant(ctx, params.OrgID, func(q *queries.Queries) error {
+		var err error
+
+		i, err = q.UpsertOrganizationInvitation(ctx, queries.UpsertOrganizationInvitationParams{
+			OrgID:     params.OrgID,
+			Email:     params.Email,
+			Role:      queries.OrganizationRole(params.Role),
+			InviterID: inviterID,
+			TokenHash: secret.Hash(token),
+			ExpiresAt: s.now().Add(s.config.InvitationTTL),
+		})
+		if err != nil {
+			return fmt.Errorf("organization: upsert organization invitation: %w", mapError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return core.OrganizationInvitation{}, "", err
+	}
+
+	link := *s.link
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	return toInvitation(i), link.String(), nil
+}
+
+// GetInvitation implements [core.OrganizationStore].
+func (s *Store) GetInvitation(ctx context.Context, token string) (core.OrganizationInvitation, error) {
+	var i queries.OrganizationInvitation
+
+	err := s.acrossTenants(ctx, func(q *queries.Queries) error {
+		var err error
+
+		i, err = q.GetOrganizationInvitationByTokenHash(ctx, secret.Hash(token))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return core.ErrInvalidToken
+		}
+
+		if err != nil {
+			return fmt.Errorf("organization: get organization invitation by token hash: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return core.OrganizationInvitation{}, err
+	}
+
+	if !i.ExpiresAt.After(s.now()) {
+		return core.OrganizationInvitation{}, core.ErrInvalidToken
+	}
+
+	return toInvitation(i), nil
+}
+
+// ListInvitations implements [core.OrganizationStore].
+func (s *Store) ListInvitations(ctx context.Context, orgID uuid.UUID) ([]core.OrganizationInvitation, error) {
+	var is []queries.OrganizationInvitation
+
+	err := s.inTenant(ctx, orgID, func(q *queries.Queries) error {
+		var err error
+
+		is, err = q.ListOrganizationInvitations(ctx, queries.ListOrganizationInvitationsParams{OrgID: orgID, Now: s.now()})
+		if err != nil {
+			return fmt.Errorf("organization: list organization invitations: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]core.OrganizationInvitation, 0, len(is))
+	for _, i := range is {
+		res = append(res, toInvitation(i))
+	}
+
+	return res, nil
+}
+
+// RevokeInvitation implements [core.OrganizationStore].
+func (s *Store) RevokeInvitation(ctx context.Context, orgID, id uuid.UUID) error {
+	return s.inTenant(ctx, orgID, func(q *queries.Queries) error {
+		n, err := q.DeleteOrganizationInvitation(ctx, queries.DeleteOrganizationInvitationParams{ID: id, OrgID: orgID})
+		if err != nil {
+			return fmt.Errorf("organization: delete organization invitation: %w", err)
+		}
+
+		if n == 0 {
+			return fmt.Errorf("organization: delete organization invitation: %w", core.ErrNotFound)
+		}
+
+		return nil
+	})
+}
+
+// AcceptInvitation implements [core.OrganizationStore]. The invitation is kept if the user is a member already.
+func (s *Store) AcceptInvitation(ctx context.Context, token string, userID uuid.UUID, email string) (core.OrganizationMembership, error) {
+	var m queries.OrganizationMembership
+
+	err := s.acrossTenants(ctx, func(q *queries.Queries) error {
+		i, err := q.ConsumeOrganizationInvitation(ctx, queries.ConsumeOrganizationInvitationParams{
+			TokenHash: secret.Hash(token),
+			Email:     email,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return core.ErrInvalidToken
+		}
+		if err != nil {
+			return fmt.Errorf("organization: consume organization invitation: %w", err)
+		}
+
+		m, err = q.CreateOrganizationMembership(ctx, queries.CreateOrganizationMembershipParams{
+			OrgID:  i.OrgID,
+			UserID: userID,
+			Role:   i.Role,
+		})
+		if err != nil {
+			return fmt.Errorf("organization: create organization membership: %w", mapError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return core.OrganizationMembership{}, err
+	}
+
+	return toMembership(m), nil
+}
+
+// DeclineInvitation implements [core.OrganizationStore].
+func (s *Store) DeclineInvitation(ctx context.Context, token, email string) error {
+	return s.acrossTenants(ctx, func(q *queries.Queries) error {
+		_, err := q.ConsumeOrganizationInvitation(ctx, queries.ConsumeOrganizationInvitationParams{
+			TokenHash: secret.Hash(token),
+			Email:     email,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return core.ErrInvalidToken
+		}
+		if err != nil {
+			return fmt.Errorf("organization: consume organization invitation: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// DeleteExpired deletes expired invitations.
+func (s *Store) DeleteExpired(ctx context.Context) (int64, error) {
+	var n int64
+
+	err := s.acrossTenants(ctx, func(q *queries.Queries) error {
+		var err error
+
+		n, err = q.DeleteExpiredOrganizationInvitations(ctx, s.now())
+		if err != nil {
+			return fmt.Errorf("organization: delete expired organization invitations: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+func mapError(err error) error {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return core.ErrNotFound
+	case db.IsUniqueViolation(err):
+		return core.ErrAlreadyExists
+	case db.IsForeignKeyViolation(err):
+		return core.ErrNotFound
+	default:
+		return err
+	}
+}
+
+func toOrganization(o queries.Organization) core.Organization {
+	return core.Organization{
+		ID:        o.ID,
+		Slug:      o.Slug,
+		Name:      o.Name,
+		CreatedAt: o.CreatedAt,
+		UpdatedAt: o.UpdatedAt,
+	}
+}
+
+func toMembership(m queries.OrganizationMembership) core.OrganizationMembership {
+	return core.OrganizationMembership{
+		OrgID:     m.OrgID,
+		UserID:    m.UserID,
+		Role:      core.OrganizationRole(m.Role),
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func toInvitation(i queries.OrganizationInvitation) core.OrganizationInvitation {
+	var inviterID uuid.UUID
+	if i.InviterID != nil {
+		inviterID = *i.InviterID
+	}
+
+	return core.OrganizationInvitation{
+		ID:        i.ID,
+		OrgID:     i.OrgID,
+		Email:     i.Email,
+		Role:      core.OrganizationRole(i.Role),
+		InviterID: inviterID,
+		CreatedAt: i.CreatedAt,
+		ExpiresAt: i.ExpiresAt,
+	}
+}
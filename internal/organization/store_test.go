@@ -0,0 +1,288 @@
+package organization
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db/dbtest"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+
+	// The store runs subject to row-level security, so its tenant and bypass transactions have to let its rows
+	// through.
+	pool := dbtest.RestrictedPool(t)
+	q := queries.New(dbtest.Pool(t))
+
+	s, err := NewStore(pool, Config{InvitationURL: "https://guardian.test/invitation", InvitationTTL: time.Hour})
+	require.NoError(t, err)
+
+	newUser := func(t *testing.T) queries.User {
+		t.Helper()
+
+		suffix := uuid.NewString()[:8]
+
+		user, err := q.CreateUser(ctx, queries.CreateUserParams{
+			Email:    "organization-" + suffix + "@example.com",
+			Username: "organization-" + suffix,
+			Status:   queries.UserStatusActive,
+		})
+		require.NoError(t, err)
+
+		return user
+	}
+
+	newOrg := func(t *testing.T, owner uuid.UUID) core.Organization {
+		t.Helper()
+
+		org, m, err := s.Create(ctx, core.CreateOrganizationParams{
+			Slug: "organization-" + uuid.NewString()[:8],
+			Name: "Organization",
+		}, owner)
+		require.NoError(t, err)
+		require.Equal(t, core.OrganizationRoleOwner, m.Role)
+
+		return org
+	}
+
+	// invite invites the email and returns the token of the link.
+	invite := func(t *testing.T, orgID uuid.UUID, email string, role core.OrganizationRole) string {
+		t.Helper()
+
+		_, link, err := s.Invite(ctx, core.InviteParams{OrgID: orgID, Email: email, Role: role})
+		require.NoError(t, err)
+
+		u, err := url.Parse(link)
+		require.NoError(t, err)
+
+		return u.Query().Get("token")
+	}
+
+	join := func(t *testing.T, orgID uuid.UUID, role core.OrganizationRole) queries.User {
+		t.Helper()
+
+		user := newUser(t)
+
+		m, err := s.AcceptInvitation(ctx, invite(t, orgID, user.Email, role), user.ID, user.Email)
+		require.NoError(t, err)
+		require.Equal(t, role, m.Role)
+
+		return user
+	}
+
+	role := func(t *testing.T, orgID, userID uuid.UUID) core.OrganizationRole {
+		t.Helper()
+
+		m, err := s.GetMembership(ctx, orgID, userID)
+		require.NoError(t, err)
+
+		return m.Role
+	}
+
+	t.Run("keeps the last owner", func(t *testing.T) {
+		owner := newUser(t)
+		org := newOrg(t, owner.ID)
+		member := join(t, org.ID, core.OrganizationRoleMember)
+
+		_, err := s.UpdateMembershipRole(ctx, org.ID, owner.ID, core.OrganizationRoleAdmin)
+		require.ErrorIs(t, err, core.ErrInvalidArgument)
+		require.ErrorIs(t, s.RemoveMembership(ctx, org.ID, owner.ID), core.ErrInvalidArgument)
+		require.Equal(t, core.OrganizationRoleOwner, role(t, org.ID, owner.ID))
+
+		// With a second owner either may leave.
+		_, err = s.UpdateMembershipRole(ctx, org.ID, member.ID, core.OrganizationRoleOwner)
+		require.NoError(t, err)
+
+		require.NoError(t, s.RemoveMembership(ctx, org.ID, owner.ID))
+
+		_, err = s.GetMembership(ctx, org.ID, owner.ID)
+		require.ErrorIs(t, err, core.ErrNotFound)
+
+		require.ErrorIs(t, s.RemoveMembership(ctx, org.ID, owner.ID), core.ErrNotFound)
+		require.ErrorIs(t, s.RemoveMembership(ctx, uuid.New(), member.ID), core.ErrNotFound)
+	})
+
+	t.Run("keeps the last owner of concurrent changes", func(t *testing.T) {
+		owner := newUser(t)
+		org := newOrg(t, owner.ID)
+		other := join(t, org.ID, core.OrganizationRoleOwner)
+
+		// Without the lock of the organization, both removals could count the other owner and succeed.
+		errs := make([]error, 2)
+
+		var wg sync.WaitGroup
+		for i, id := range []uuid.UUID{owner.ID, other.ID} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[i] = s.RemoveMembership(ctx, org.ID, id)
+			}()
+		}
+		wg.Wait()
+
+		failed := 0
+		for _, err := range errs {
+			if err != nil {
+				require.ErrorIs(t, err, core.ErrInvalidArgument)
+				failed++
+			}
+		}
+		require.Equal(t, 1, failed)
+
+		page, err := s.ListMemberships(ctx, core.ListMembershipsParams{OrgID: org.ID, Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, page.Memberships, 1)
+		require.Equal(t, core.OrganizationRoleOwner, page.Memberships[0].Role)
+	})
+
+	t.Run("transfers ownership", func(t *testing.T) {
+		owner := newUser(t)
+		org := newOrg(t, owner.ID)
+		member := join(t, org.ID, core.OrganizationRoleMember)
+		outsider := newUser(t)
+
+		require.ErrorIs(t, s.TransferOwnership(ctx, org.ID, owner.ID, owner.ID), core.ErrInvalidArgument)
+		require.ErrorIs(t, s.TransferOwnership(ctx, org.ID, member.ID, owner.ID), core.ErrInvalidArgument)
+		require.ErrorIs(t, s.TransferOwnership(ctx, org.ID, outsider.ID, member.ID), core.ErrInvalidArgument)
+		require.ErrorIs(t, s.TransferOwnership(ctx, org.ID, owner.ID, outsider.ID), core.ErrNotFound)
+
+		require.Equal(t, core.OrganizationRoleOwner, role(t, org.ID, owner.ID))
+		require.Equal(t, core.OrganizationRoleMember, role(t, org.ID, member.ID))
+
+		require.NoError(t, s.TransferOwnership(ctx, org.ID, owner.ID, member.ID))
+
+		require.Equal(t, core.OrganizationRoleAdmin, role(t, org.ID, owner.ID))
+		require.Equal(t, core.OrganizationRoleOwner, role(t, org.ID, member.ID))
+
+		// The former owner cannot transfer it back.
+		require.ErrorIs(t, s.TransferOwnership(ctx, org.ID, owner.ID, member.ID), core.ErrInvalidArgument)
+	})
+
+	t.Run("accepts invitations once", func(t *testing.T) {
+		owner := newUser(t)
+		org := newOrg(t, owner.ID)
+		user := newUser(t)
+		token := invite(t, org.ID, user.Email, core.OrganizationRoleAdmin)
+
+		invitation, err := s.GetInvitation(ctx, token)
+		require.NoError(t, err)
+		require.Equal(t, org.ID, invitation.OrgID)
+		require.Equal(t, user.Email, invitation.Email)
+
+		// The invitation is only for its email.
+		_, err = s.AcceptInvitation(ctx, token, user.ID, "other-"+user.Email)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+		require.ErrorIs(t, s.DeclineInvitation(ctx, token, "other-"+user.Email), core.ErrInvalidToken)
+
+		m, err := s.AcceptInvitation(ctx, token, user.ID, user.Email)
+		require.NoError(t, err)
+		require.Equal(t, org.ID, m.OrgID)
+		require.Equal(t, core.OrganizationRoleAdmin, m.Role)
+
+		_, err = s.AcceptInvitation(ctx, token, user.ID, user.Email)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+
+		_, err = s.GetInvitation(ctx, token)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+	})
+
+	t.Run("keeps invitations of members", func(t *testing.T) {
+		owner := newUser(t)
+		org := newOrg(t, owner.ID)
+		member := join(t, org.ID, core.OrganizationRoleMember)
+		token := invite(t, org.ID, member.Email, core.OrganizationRoleAdmin)
+
+		_, err := s.AcceptInvitation(ctx, token, member.ID, member.Email)
+		require.ErrorIs(t, err, core.ErrAlreadyExists)
+		require.Equal(t, core.OrganizationRoleMember, role(t, org.ID, member.ID))
+
+		_, err = s.GetInvitation(ctx, token)
+		require.NoError(t, err)
+	})
+
+	t.Run("declines invitations", func(t *testing.T) {
+		owner := newUser(t)
+		org := newOrg(t, owner.ID)
+		user := newUser(t)
+		token := invite(t, org.ID, user.Email, core.OrganizationRoleMember)
+
+		require.NoError(t, s.DeclineInvitation(ctx, token, user.Email))
+		require.ErrorIs(t, s.DeclineInvitation(ctx, token, user.Email), core.ErrInvalidToken)
+
+		_, err := s.AcceptInvitation(ctx, token, user.ID, user.Email)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+
+		_, err = s.GetMembership(ctx, org.ID, user.ID)
+		require.ErrorIs(t, err, core.ErrNotFound)
+	})
+
+	t.Run("rejects expired invitations", func(t *testing.T) {
+		owner := newUser(t)
+		org := newOrg(t, owner.ID)
+		user := newUser(t)
+
+		s.now = func() time.Time { return time.Now().Add(-2 * time.Hour) }
+		token := invite(t, org.ID, user.Email, core.OrganizationRoleMember)
+		s.now = time.Now
+
+		_, err := s.GetInvitation(ctx, token)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+
+		_, err = s.AcceptInvitation(ctx, token, user.ID, user.Email)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+		require.ErrorIs(t, s.DeclineInvitation(ctx, token, user.Email), core.ErrInvalidToken)
+
+		invitations, err := s.ListInvitations(ctx, org.ID)
+		require.NoError(t, err)
+		require.Empty(t, invitations)
+	})
+
+	t.Run("scopes transactions to organizations", func(t *testing.T) {
+		owner := newUser(t)
+		orgs := []core.Organization{newOrg(t, owner.ID), newOrg(t, owner.ID)}
+		user := newUser(t)
+		invite(t, orgs[0].ID, user.Email, core.OrganizationRoleMember)
+
+		// Lookups across organizations see the rows of all of them.
+		listed, err := s.ListByUser(ctx, owner.ID)
+		require.NoError(t, err)
+		require.Len(t, listed, 2)
+		require.ElementsMatch(t, []uuid.UUID{orgs[0].ID, orgs[1].ID}, []uuid.UUID{listed[0].ID, listed[1].ID})
+
+		for _, org := range orgs {
+			got, err := s.GetBySlug(ctx, org.Slug)
+			require.NoError(t, err)
+			require.Equal(t, org.ID, got.ID)
+
+			got, err = s.Get(ctx, org.ID)
+			require.NoError(t, err)
+			require.Equal(t, org.Slug, got.Slug)
+		}
+
+		// Changes scoped to an organization do not reach rows of others.
+		invitations, err := s.ListInvitations(ctx, orgs[1].ID)
+		require.NoError(t, err)
+		require.Empty(t, invitations)
+
+		invitations, err = s.ListInvitations(ctx, orgs[0].ID)
+		require.NoError(t, err)
+		require.Len(t, invitations, 1)
+
+		require.ErrorIs(t, s.RevokeInvitation(ctx, orgs[1].ID, invitations[0].ID), core.ErrNotFound)
+		require.NoError(t, s.RevokeInvitation(ctx, orgs[0].ID, invitations[0].ID))
+
+		// The nil id never refers to an organization.
+		_, err = s.Get(ctx, uuid.Nil)
+		require.ErrorIs(t, err, core.ErrNotFound)
+		require.ErrorIs(t, s.RemoveMembership(ctx, uuid.Nil, owner.ID), core.ErrNotFound)
+	})
+}
@@ -0,0 +1,47 @@
+package organization
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gophero/guardian/core"
+)
+
+// Slugs are hyphen separated alphanumeric segments like `acme-corp`, usable in URLs and DNS labels.
+var slugRe = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+const maxNameLength = 128
+
+func validateSlug(slug string) error {
+	if !slugRe.MatchString(slug) || strings.Contains(slug, "--") {
+		return fmt.Errorf("organization: invalid slug `%s`: %w", slug, core.ErrInvalidArgument)
+	}
+	return nil
+}
+
+func validateName(name string) error {
+	if strings.TrimSpace(name) == "" || utf8.RuneCountInString(name) > maxNameLength {
+		return fmt.Errorf("organization: name must have 1 to %d characters: %w", maxNameLength, core.ErrInvalidArgument)
+	}
+	return nil
+}
+
+func validateRole(role core.OrganizationRole) error {
+	switch role {
+	case core.OrganizationRoleOwner, core.OrganizationRoleAdmin, core.OrganizationRoleMember:
+		return nil
+	default:
+		return fmt.Errorf("organization: invalid role `%s`: %w", role, core.ErrInvalidArgument)
+	}
+}
+
+func validateEmail(email string) error {
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return fmt.Errorf("organization: invalid email `%s`: %w", email, core.ErrInvalidArgument)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package organization
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+)
+
+func TestValidateSlug(t *testing.T) {
+	for _, slug := range []string{"a", "acme", "Acme-Corp", "acme-2", "42", strings.Repeat("a", 63)} {
+		require.NoError(t, validateSlug(slug), slug)
+	}
+
+	for _, slug := range []string{"", "-acme", "acme-", "acme--corp", "acme corp", "acme_corp", "acme.corp", strings.Repeat("a", 64)} {
+		require.Error(t, validateSlug(slug), slug)
+	}
+}
+
+func TestValidateName(t *testing.T) {
+	require.NoError(t, validateName("Acme Corp"))
+	require.NoError(t, validateName(strings.Repeat("ä", maxNameLength)))
+	require.Error(t, validateName(" "))
+	require.Error(t, validateName(strings.Repeat("a", maxNameLength+1)))
+}
+
+func TestValidateRole(t *testing.T) {
+	for _, role := range []core.OrganizationRole{core.OrganizationRoleOwner, core.OrganizationRoleAdmin, core.OrganizationRoleMember} {
+		require.NoError(t, validateRole(role))
+	}
+
+	require.ErrorIs(t, validateRole("guest"), core.ErrInvalidArgument)
+	require.ErrorIs(t, validateRole(""), core.ErrInvalidArgument)
+}
@@ -0,0 +1,199 @@
+package passkey
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// WebAuthn authenticator data flags.
+const (
+	flagUserPresent    = 0x01
+	flagUserVerified   = 0x04
+	flagBackupEligible = 0x08
+	flagBackupState    = 0x10
+	flagAttestedData   = 0x40
+)
+
+var b64 = base64.RawURLEncoding
+
+// softAuthenticator is an authenticator holding a single ES256 discoverable credential in memory, which answers
+// ceremonies like a browser would return them from navigator.credentials.
+type softAuthenticator struct {
+	origin       string
+	format       string // Attestation statement format, "none" or "packed" self attestation.
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	userHandle   []byte
+	signCount    uint32
+	flags        byte
+}
+
+func newSoftAuthenticator(t *testing.T, origin, format string) *softAuthenticator {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	id := make([]byte, 32)
+	_, err = rand.Read(id)
+	require.NoError(t, err)
+
+	return &softAuthenticator{
+		origin:       origin,
+		format:       format,
+		key:          key,
+		credentialID: id,
+		flags:        flagUserPresent | flagUserVerified | flagBackupEligible | flagBackupState,
+	}
+}
+
+// creationOptions are the parts of PublicKeyCredentialCreationOptions used by the authenticator.
+type creationOptions struct {
+	PublicKey struct {
+		Challenge string `json:"challenge"`
+		RP        struct {
+			ID string `json:"id"`
+		} `json:"rp"`
+		User struct {
+			ID string `json:"id"`
+		} `json:"user"`
+		ExcludeCredentials []struct {
+			ID string `json:"id"`
+		} `json:"excludeCredentials"`
+	} `json:"publicKey"`
+}
+
+// requestOptions are the parts of PublicKeyCredentialRequestOptions used by the authenticator.
+type requestOptions struct {
+	PublicKey struct {
+		Challenge        string `json:"challenge"`
+		RPID             string `json:"rpId"`
+		AllowCredentials []struct {
+			ID string `json:"id"`
+		} `json:"allowCredentials"`
+	} `json:"publicKey"`
+}
+
+// create answers a registration ceremony with a new credential.
+func (a *softAuthenticator) create(t *testing.T, options []byte) []byte {
+	t.Helper()
+
+	var opts creationOptions
+	require.NoError(t, json.Unmarshal(options, &opts))
+
+	userHandle, err := b64.DecodeString(opts.PublicKey.User.ID)
+	require.NoError(t, err)
+	a.userHandle = userHandle
+
+	clientData := a.clientData(t, "webauthn.create", opts.PublicKey.Challenge)
+
+	// Attested credential data: AAGUID, credential ID length, credential ID and COSE public key.
+	attested := make([]byte, 16, 16+2+len(a.credentialID))
+	attested = binary.BigEndian.AppendUint16(attested, uint16(len(a.credentialID)))
+	attested = append(attested, a.credentialID...)
+	attested = append(attested, a.coseKey(t)...)
+
+	authData := a.authData(opts.PublicKey.RP.ID, a.flags|flagAttestedData, attested)
+
+	attStmt := map[string]any{}
+	if a.format == "packed" {
+		// Self attestation is signed with the credential key itself.
+		attStmt = map[string]any{"alg": -7, "sig": a.sign(t, authData, clientData)}
+	}
+
+	attestationObject, err := cbor.Marshal(map[string]any{"fmt": a.format, "attStmt": attStmt, "authData": authData})
+	require.NoError(t, err)
+
+	return a.credential(t, map[string]any{
+		"clientDataJSON":    b64.EncodeToString(clientData),
+		"attestationObject": b64.EncodeToString(attestationObject),
+		"transports":        []string{"internal", "hybrid"},
+	})
+}
+
+// get answers an authentication ceremony with an assertion of the credential.
+func (a *softAuthenticator) get(t *testing.T, options []byte) []byte {
+	t.Helper()
+
+	var opts requestOptions
+	require.NoError(t, json.Unmarshal(options, &opts))
+
+	a.signCount++
+
+	clientData := a.clientData(t, "webauthn.get", opts.PublicKey.Challenge)
+	authData := a.authData(opts.PublicKey.RPID, a.flags, nil)
+
+	return a.credential(t, map[string]any{
+		"clientDataJSON":    b64.EncodeToString(clientData),
+		"authenticatorData": b64.EncodeToString(authData),
+		"signature":         b64.EncodeToString(a.sign(t, authData, clientData)),
+		"userHandle":        b64.EncodeToString(a.userHandle),
+	})
+}
+
+func (a *softAuthenticator) clientData(t *testing.T, typ, challenge string) []byte {
+	t.Helper()
+
+	b, err := json.Marshal(map[string]any{"type": typ, "challenge": challenge, "origin": a.origin, "crossOrigin": false})
+	require.NoError(t, err)
+
+	return b
+}
+
+func (a *softAuthenticator) authData(rpID string, flags byte, attested []byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+
+	b := append(rpIDHash[:], flags)
+	b = binary.BigEndian.AppendUint32(b, a.signCount)
+
+	return append(b, attested...)
+}
+
+func (a *softAuthenticator) coseKey(t *testing.T) []byte {
+	t.Helper()
+
+	pub, err := a.key.PublicKey.Bytes()
+	require.NoError(t, err)
+
+	// Uncompressed point: 0x04 || x || y.
+	b, err := cbor.Marshal(map[int]any{1: 2, 3: -7, -1: 1, -2: pub[1:33], -3: pub[33:]})
+	require.NoError(t, err)
+
+	return b
+}
+
+func (a *softAuthenticator) sign(t *testing.T, authData, clientData []byte) []byte {
+	t.Helper()
+
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	require.NoError(t, err)
+
+	return sig
+}
+
+func (a *softAuthenticator) credential(t *testing.T, response map[string]any) []byte {
+	t.Helper()
+
+	b, err := json.Marshal(map[string]any{
+		"id":                      b64.EncodeToString(a.credentialID),
+		"rawId":                   b64.EncodeToString(a.credentialID),
+		"type":                    "public-key",
+		"authenticatorAttachment": "platform",
+		"response":                response,
+	})
+	require.NoError(t, err)
+
+	return b
+}
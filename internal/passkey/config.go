@@ -0,0 +1,47 @@
+package passkey
+
+import (
+	"errors"
+	"time"
+)
+
+type Config struct {
+	RPID             string        `help:"WebAuthn relying party ID, the domain passkeys are scoped to." name:"rp_id" env:"RP_ID"`
+	RPDisplayName    string        `help:"Relying party name shown by authenticators." name:"rp_display_name" env:"RP_DISPLAY_NAME" default:"Guardian"`
+	RPOrigins        []string      `help:"Origins of the web apps allowed to run WebAuthn ceremonies." name:"rp_origins" env:"RP_ORIGINS"`
+	UserVerification string        `help:"Whether authenticators have to verify the user, for example with a PIN or biometrics." name:"user_verification" env:"USER_VERIFICATION" enum:"required,preferred,discouraged" default:"preferred"`
+	Attestation      string        `help:"Attestation conveyance preference for new passkeys. Only none and packed attestation statements are accepted." name:"attestation" env:"ATTESTATION" enum:"none,indirect,direct" default:"none"`
+	ChallengeTTL     time.Duration `help:"Duration in which a registration or sign in ceremony has to be finished." name:"challenge_ttl" env:"CHALLENGE_TTL" default:"5m"`
+}
+
+func (c Config) validate() error {
+	if c.RPID == "" {
+		return errors.New("passkey: RPID cannot be empty")
+	}
+
+	if c.RPDisplayName == "" {
+		return errors.New("passkey: RPDisplayName cannot be empty")
+	}
+
+	if len(c.RPOrigins) == 0 {
+		return errors.New("passkey: RPOrigins cannot be empty")
+	}
+
+	switch c.UserVerification {
+	case "required", "preferred", "discouraged":
+	default:
+		return errors.New("passkey: UserVerification must be one of required, preferred or discouraged")
+	}
+
+	switch c.Attestation {
+	case "none", "indirect", "direct":
+	default:
+		return errors.New("passkey: Attestation must be one of none, indirect or direct")
+	}
+
+	if c.ChallengeTTL <= 0 {
+		return errors.New("passkey: ChallengeTTL cannot be zero or negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,390 @@
+package passkey
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+// formats are the accepted attestation statement formats.
+var formats = []protocol.AttestationFormat{protocol.AttestationFormatNone, protocol.AttestationFormatPacked}
+
+// Store is a postgres backed [core.PasskeyStore]. Ceremonies are stored with the session data of the relying party
+// and deleted when they are finished, so each challenge is answered at most once.
+type Store struct {
+	config Config
+	rp     *webauthn.WebAuthn
+	q      queries.Querier
+	now    func() time.Time
+}
+
+var _ core.PasskeyStore = (*Store)(nil)
+
+// NewStore constructs new [Store].
+func NewStore(pool *pgxpool.Pool, config Config) (*Store, error) {
+	return newStore(queries.New(pool), config)
+}
+
+func newStore(q queries.Querier, config Config) (*Store, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	// Expiry of ceremonies is enforced by the store, timeouts only tell clients how long to wait for the user.
+	timeout := webauthn.TimeoutConfig{Timeout: config.ChallengeTTL, TimeoutUVD: config.ChallengeTTL}
+
+	rp, err := webauthn.New(&webauthn.Config{
+		RPID:                  config.RPID,
+		RPDisplayName:         config.RPDisplayName,
+		RPOrigins:             config.RPOrigins,
+		AttestationPreference: protocol.ConveyancePreference(config.Attestation),
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			ResidentKey:        protocol.ResidentKeyRequirementRequired,
+			RequireResidentKey: protocol.ResidentKeyRequired(),
+			UserVerification:   protocol.UserVerificationRequirement(config.UserVerification),
+		},
+		Timeouts: webauthn.TimeoutsConfig{Login: timeout, Registration: timeout},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("passkey: new relying party: %w", err)
+	}
+
+	return &Store{config: config, rp: rp, q: q, now: time.Now}, nil
+}
+
+// BeginRegistration implements [core.PasskeyStore].
+func (s *Store) BeginRegistration(ctx context.Context, u core.User) (core.PasskeyCeremony, error) {
+	passkeys, err := s.q.ListPasskeysByUserID(ctx, u.ID)
+	if err != nil {
+		return core.PasskeyCeremony{}, fmt.Errorf("passkey: list passkeys by user id: %w", err)
+	}
+
+	name := u.Email
+	if name == "" {
+		name = u.Username
+	}
+
+	wu := &user{id: u.ID, name: name, displayName: name, passkeys: passkeys}
+
+	exclusions := make([]protocol.CredentialDescriptor, 0, len(passkeys))
+	for _, c := range wu.WebAuthnCredentials() {
+		exclusions = append(exclusions, c.Descriptor())
+	}
+
+	creation, session, err := s.rp.BeginRegistration(wu,
+		webauthn.WithExclusions(exclusions),
+		webauthn.WithAttestationFormats(formats),
+	)
+	if err != nil {
+		return core.PasskeyCeremony{}, fmt.Errorf("passkey: begin registration: %w", err)
+	}
+
+	return s.createCeremony(ctx, &u.ID, queries.WebauthnCeremonyRegistration, creation, session)
+}
+
+// FinishRegistration implements [core.PasskeyStore].
+func (s *Store) FinishRegistration(ctx context.Context, userID, ceremonyID uuid.UUID, response []byte, name string) (core.Passkey, error) {
+	session, ceremonyUserID, err := s.consumeCeremony(ctx, ceremonyID, queries.WebauthnCeremonyRegistration)
+	if err != nil {
+		return core.Passkey{}, err
+	}
+
+	if ceremonyUserID == nil || *ceremonyUserID != userID {
+		return core.Passkey{}, fmt.Errorf("passkey: ceremony of another user: %w", core.ErrInvalidToken)
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(response)
+	if err != nil {
+		return core.Passkey{}, fmt.Errorf("passkey: parse credential creation response: %w: %w", core.ErrInvalidArgument, err)
+	}
+
+	if format := parsed.Response.AttestationObject.Format; !slices.Contains(formats, protocol.AttestationFormat(format)) {
+		return core.Passkey{}, fmt.Errorf("passkey: attestation format `%s` is not supported: %w", format, core.ErrInvalidCredentials)
+	}
+
+	credential, err := s.rp.CreateCredential(&user{id: userID}, session, parsed)
+	if err != nil {
+		return core.Passkey{}, fmt.Errorf("passkey: create credential: %w: %w", core.ErrInvalidCredentials, err)
+	}
+
+	aaguid, err := uuid.FromBytes(credential.Authenticator.AAGUID)
+	if err != nil {
+		return core.Passkey{}, fmt.Errorf("passkey: invalid aaguid: %w", core.ErrInvalidCredentials)
+	}
+
+	transports := make([]string, 0, len(credential.Transport))
+	for _, t := range credential.Transport {
+		transports = append(transports, string(t))
+	}
+
+	p, err := s.q.CreatePasskey(ctx, queries.CreatePasskeyParams{
+		UserID:            userID,
+		CredentialID:      credential.ID,
+		PublicKey:         credential.PublicKey,
+		AttestationFormat: credential.AttestationType,
+		Aaguid:            aaguid,
+		SignCount:         int64(credential.Authenticator.SignCount),
+		Transports:        transports,
+		UserVerified:      credential.Flags.UserVerified,
+		BackupEligible:    credential.Flags.BackupEligible,
+		BackupState:       credential.Flags.BackupState,
+		Name:              name,
+	})
+	if err != nil {
+		switch {
+		case db.IsUniqueViolation(err):
+			return core.Passkey{}, fmt.Errorf("passkey: create passkey: %w", core.ErrAlreadyExists)
+		case db.IsForeignKeyViolation(err):
+			return core.Passkey{}, fmt.Errorf("passkey: create passkey: %w", core.ErrNotFound)
+		}
+		return core.Passkey{}, fmt.Errorf("passkey: create passkey: %w", err)
+	}
+
+	return toPasskey(p), nil
+}
+
+// BeginLogin implements [core.PasskeyStore].
+func (s *Store) BeginLogin(ctx context.Context, userID uuid.UUID) (core.PasskeyCeremony, error) {
+	var passkeys []queries.Passkey
+
+	if userID != uuid.Nil {
+		var err error
+		if passkeys, err = s.q.ListPasskeysByUserID(ctx, userID); err != nil {
+			return core.PasskeyCeremony{}, fmt.Errorf("passkey: list passkeys by user id: %w", err)
+		}
+	}
+
+	// Without passkeys of the user any discoverable one is accepted, so callers do not reveal whether the user exists.
+	if len(passkeys) == 0 {
+		assertion, session, err := s.rp.BeginDiscoverableLogin()
+		if err != nil {
+			return core.PasskeyCeremony{}, fmt.Errorf("passkey: begin discoverable login: %w", err)
+		}
+
+		return s.createCeremony(ctx, nil, queries.WebauthnCeremonyLogin, assertion, session)
+	}
+
+	assertion, session, err := s.rp.BeginLogin(&user{id: userID, passkeys: passkeys})
+	if err != nil {
+		return core.PasskeyCeremony{}, fmt.Errorf("passkey: begin login: %w", err)
+	}
+
+	return s.createCeremony(ctx, &userID, queries.WebauthnCeremonyLogin, assertion, session)
+}
+
+// FinishLogin implements [core.PasskeyStore].
+func (s *Store) FinishLogin(ctx context.Context, ceremonyID uuid.UUID, response []byte) (core.Passkey, error) {
+	session, userID, err := s.consumeCeremony(ctx, ceremonyID, queries.WebauthnCeremonyLogin)
+	if err != nil {
+		return core.Passkey{}, err
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(response)
+	if err != nil {
+		return core.Passkey{}, fmt.Errorf("passkey: parse credential request response: %w: %w", core.ErrInvalidArgument, err)
+	}
+
+	var (
+		wu         *user
+		credential *webauthn.Credential
+	)
+
+	if userID != nil {
+		passkeys, err := s.q.ListPasskeysByUserID(ctx, *userID)
+		if err != nil {
+			return core.Passkey{}, fmt.Errorf("passkey: list passkeys by user id: %w", err)
+		}
+
+		wu = &user{id: *userID, passkeys: passkeys}
+		credential, err = s.rp.ValidateLogin(wu, session, parsed)
+		if err != nil {
+			return core.Passkey{}, fmt.Errorf("passkey: validate login: %w: %w", core.ErrInvalidCredentials, err)
+		}
+	} else {
+		var lookupErr error
+
+		handler := func(rawID, userHandle []byte) (webauthn.User, error) {
+			wu, lookupErr = s.discover(ctx, rawID, userHandle)
+			return wu, lookupErr
+		}
+
+		_, credential, err = s.rp.ValidatePasskeyLogin(handler, session, parsed)
+		if lookupErr != nil && !errors.Is(lookupErr, core.ErrInvalidCredentials) {
+			return core.Passkey{}, lookupErr
+		}
+
+		if err != nil {
+			return core.Passkey{}, fmt.Errorf("passkey: validate passkey login: %w: %w", core.ErrInvalidCredentials, err)
+		}
+	}
+
+	p, ok := wu.passkey(credential.ID)
+	if !ok {
+		return core.Passkey{}, fmt.Errorf("passkey: unknown credential: %w", core.ErrInvalidCredentials)
+	}
+
+	if credential.Authenticator.CloneWarning {
+		zerolog.Ctx(ctx).Warn().Stringer("passkey_id", p.ID).Stringer("user_id", p.UserID).Msg("passkey sign count did not increase, authenticator may be cloned")
+		return core.Passkey{}, fmt.Errorf("passkey: sign count did not increase: %w", core.ErrInvalidCredentials)
+	}
+
+	// The update only succeeds if the sign count was not changed by a concurrent assertion.
+	n, err := s.q.UpdatePasskeyUsage(ctx, queries.UpdatePasskeyUsageParams{
+		SignCount:         int64(credential.Authenticator.SignCount),
+		UserVerified:      credential.Flags.UserVerified,
+		BackupState:       credential.Flags.BackupState,
+		ID:                p.ID,
+		PreviousSignCount: p.SignCount,
+	})
+	if err != nil {
+		return core.Passkey{}, fmt.Errorf("passkey: update passkey usage: %w", err)
+	}
+
+	if n == 0 {
+		return core.Passkey{}, fmt.Errorf("passkey: concurrent use: %w", core.ErrInvalidCredentials)
+	}
+
+	now := s.now()
+	p.SignCount = int64(credential.Authenticator.SignCount)
+	p.UserVerified = credential.Flags.UserVerified
+	p.BackupState = credential.Flags.BackupState
+	p.LastUsedAt = &now
+
+	return toPasskey(p), nil
+}
+
+// discover returns the owner of the discoverable credential rawID. userHandle has to match the owner.
+func (s *Store) discover(ctx context.Context, rawID, userHandle []byte) (*user, error) {
+	p, err := s.q.GetPasskeyByCredentialID(ctx, rawID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("passkey: unknown credential: %w", core.ErrInvalidCredentials)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("passkey: get passkey by credential id: %w", err)
+	}
+
+	if !bytes.Equal(userHandle, p.UserID[:]) {
+		return nil, fmt.Errorf("passkey: user handle does not match: %w", core.ErrInvalidCredentials)
+	}
+
+	return &user{id: p.UserID, passkeys: []queries.Passkey{p}}, nil
+}
+
+// List implements [core.PasskeyStore].
+func (s *Store) List(ctx context.Context, userID uuid.UUID) ([]core.Passkey, error) {
+	passkeys, err := s.q.ListPasskeysByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("passkey: list passkeys by user id: %w", err)
+	}
+
+	res := make([]core.Passkey, 0, len(passkeys))
+	for _, p := range passkeys {
+		res = append(res, toPasskey(p))
+	}
+
+	return res, nil
+}
+
+// Delete implements [core.PasskeyStore].
+func (s *Store) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	n, err := s.q.DeletePasskey(ctx, queries.DeletePasskeyParams{ID: id, UserID: userID})
+	if err != nil {
+		return fmt.Errorf("passkey: delete passkey: %w", err)
+	}
+
+	if n == 0 {
+		return core.ErrNotFound
+	}
+
+	return nil
+}
+
+// DeleteExpired deletes expired ceremonies.
+func (s *Store) DeleteExpired(ctx context.Context) (int64, error) {
+	n, err := s.q.DeleteExpiredWebAuthnChallenges(ctx, s.now())
+	if err != nil {
+		return 0, fmt.Errorf("passkey: delete expired webauthn challenges: %w", err)
+	}
+
+	return n, nil
+}
+
+// createCeremony stores session and returns options as a ceremony.
+func (s *Store) createCeremony(ctx context.Context, userID *uuid.UUID, ceremony queries.WebauthnCeremony, options any, session *webauthn.SessionData) (core.PasskeyCeremony, error) {
+	opts, err := json.Marshal(options)
+	if err != nil {
+		return core.PasskeyCeremony{}, fmt.Errorf("passkey: marshal options: %w", err)
+	}
+
+	data, err := json.Marshal(session)
+	if err != nil {
+		return core.PasskeyCeremony{}, fmt.Errorf("passkey: marshal session data: %w", err)
+	}
+
+	c, err := s.q.CreateWebAuthnChallenge(ctx, queries.CreateWebAuthnChallengeParams{
+		UserID:      userID,
+		Ceremony:    ceremony,
+		SessionData: data,
+		ExpiresAt:   s.now().Add(s.config.ChallengeTTL),
+	})
+	if err != nil {
+		if db.IsForeignKeyViolation(err) {
+			return core.PasskeyCeremony{}, fmt.Errorf("passkey: create webauthn challenge: %w", core.ErrNotFound)
+		}
+		return core.PasskeyCeremony{}, fmt.Errorf("passkey: create webauthn challenge: %w", err)
+	}
+
+	return core.PasskeyCeremony{ID: c.ID, Options: opts, ExpiresAt: c.ExpiresAt}, nil
+}
+
+// consumeCeremony deletes the ceremony and returns its session data and user.
+func (s *Store) consumeCeremony(ctx context.Context, id uuid.UUID, ceremony queries.WebauthnCeremony) (webauthn.SessionData, *uuid.UUID, error) {
+	c, err := s.q.ConsumeWebAuthnChallenge(ctx, queries.ConsumeWebAuthnChallengeParams{ID: id, Ceremony: ceremony})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return webauthn.SessionData{}, nil, core.ErrInvalidToken
+	}
+
+	if err != nil {
+		return webauthn.SessionData{}, nil, fmt.Errorf("passkey: consume webauthn challenge: %w", err)
+	}
+
+	var session webauthn.SessionData
+	if err := json.Unmarshal(c.SessionData, &session); err != nil {
+		return webauthn.SessionData{}, nil, fmt.Errorf("passkey: unmarshal session data: %w", err)
+	}
+
+	return session, c.UserID, nil
+}
+
+func toPasskey(p queries.Passkey) core.Passkey {
+	return core.Passkey{
+		ID:                p.ID,
+		UserID:            p.UserID,
+		CredentialID:      p.CredentialID,
+		Name:              p.Name,
+		AttestationFormat: p.AttestationFormat,
+		AAGUID:            p.Aaguid,
+		SignCount:         uint32(p.SignCount),
+		Transports:        p.Transports,
+		BackupEligible:    p.BackupEligible,
+		BackupState:       p.BackupState,
+		CreatedAt:         p.CreatedAt,
+		LastUsedAt:        p.LastUsedAt,
+	}
+}
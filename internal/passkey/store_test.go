@@ -0,0 +1,521 @@
+package passkey
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+const testOrigin = "https://guardian.test"
+
+// fakeQuerier keeps passkeys and challenges in memory. Queries not used by [Store] panic.
+type fakeQuerier struct {
+	queries.Querier
+
+	mu         sync.Mutex
+	now        time.Time
+	passkeys   []queries.Passkey
+	challenges map[uuid.UUID]queries.WebauthnChallenge
+}
+
+func (f *fakeQuerier) CreatePasskey(_ context.Context, arg queries.CreatePasskeyParams) (queries.Passkey, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, p := range f.passkeys {
+		if string(p.CredentialID) == string(arg.CredentialID) {
+			return queries.Passkey{}, &pgconn.PgError{Code: pgerrcode.UniqueViolation}
+		}
+	}
+
+	p := queries.Passkey{
+		ID:                uuid.Must(uuid.NewV7()),
+		UserID:            arg.UserID,
+		CredentialID:      arg.CredentialID,
+		PublicKey:         arg.PublicKey,
+		AttestationFormat: arg.AttestationFormat,
+		Aaguid:            arg.Aaguid,
+		SignCount:         arg.SignCount,
+		Transports:        arg.Transports,
+		UserVerified:      arg.UserVerified,
+		BackupEligible:    arg.BackupEligible,
+		BackupState:       arg.BackupState,
+		Name:              arg.Name,
+		CreatedAt:         f.now,
+	}
+	f.passkeys = append(f.passkeys, p)
+
+	return p, nil
+}
+
+func (f *fakeQuerier) GetPasskeyByCredentialID(_ context.Context, credentialID []byte) (queries.Passkey, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, p := range f.passkeys {
+		if string(p.CredentialID) == string(credentialID) {
+			return p, nil
+		}
+	}
+
+	return queries.Passkey{}, pgx.ErrNoRows
+}
+
+func (f *fakeQuerier) ListPasskeysByUserID(_ context.Context, userID uuid.UUID) ([]queries.Passkey, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var res []queries.Passkey
+	for _, p := range f.passkeys {
+		if p.UserID == userID {
+			res = append(res, p)
+		}
+	}
+
+	return res, nil
+}
+
+func (f *fakeQuerier) UpdatePasskeyUsage(_ context.Context, arg queries.UpdatePasskeyUsageParams) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, p := range f.passkeys {
+		if p.ID == arg.ID && p.SignCount == arg.PreviousSignCount {
+			now := f.now
+			f.passkeys[i].SignCount = arg.SignCount
+			f.passkeys[i].UserVerified = arg.UserVerified
+			f.passkeys[i].BackupState = arg.BackupState
+			f.passkeys[i].LastUsedAt = &now
+			return 1, nil
+		}
+	}
+
+	return 0, nil
+}
+
+func (f *fakeQuerier) DeletePasskey(_ context.Context, arg queries.DeletePasskeyParams) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	n := len(f.passkeys)
+	f.passkeys = slices.DeleteFunc(f.passkeys, func(p queries.Passkey) bool {
+		return p.ID == arg.ID && p.UserID == arg.UserID
+	})
+
+	return int64(n - len(f.passkeys)), nil
+}
+
+func (f *fakeQuerier) CreateWebAuthnChallenge(_ context.Context, arg queries.CreateWebAuthnChallengeParams) (queries.WebauthnChallenge, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c := queries.WebauthnChallenge{
+		ID:          uuid.Must(uuid.NewV7()),
+		UserID:      arg.UserID,
+		Ceremony:    arg.Ceremony,
+		SessionData: arg.SessionData,
+		CreatedAt:   f.now,
+		ExpiresAt:   arg.ExpiresAt,
+	}
+	f.challenges[c.ID] = c
+
+	return c, nil
+}
+
+func (f *fakeQuerier) ConsumeWebAuthnChallenge(_ context.Context, arg queries.ConsumeWebAuthnChallengeParams) (queries.WebauthnChallenge, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, ok := f.challenges[arg.ID]
+	if !ok || c.Ceremony != arg.Ceremony || !c.ExpiresAt.After(f.now) {
+		return queries.WebauthnChallenge{}, pgx.ErrNoRows
+	}
+	delete(f.challenges, arg.ID)
+
+	return c, nil
+}
+
+func (f *fakeQuerier) DeleteExpiredWebAuthnChallenges(_ context.Context, before time.Time) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var n int64
+	for id, c := range f.challenges {
+		if c.ExpiresAt.Before(before) {
+			delete(f.challenges, id)
+			n++
+		}
+	}
+
+	return n, nil
+}
+
+func (f *fakeQuerier) advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.now = f.now.Add(d)
+}
+
+func newTestStore(t *testing.T) (*Store, *fakeQuerier) {
+	t.Helper()
+
+	q := &fakeQuerier{now: time.Now(), challenges: map[uuid.UUID]queries.WebauthnChallenge{}}
+
+	s, err := newStore(q, Config{
+		RPID:             "guardian.test",
+		RPDisplayName:    "Guardian",
+		RPOrigins:        []string{testOrigin},
+		UserVerification: "preferred",
+		Attestation:      "none",
+		ChallengeTTL:     5 * time.Minute,
+	})
+	require.NoError(t, err)
+
+	s.now = func() time.Time {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		return q.now
+	}
+
+	return s, q
+}
+
+func testUser() core.User {
+	return core.User{ID: uuid.Must(uuid.NewV7()), Email: "ada@example.com", Username: "ada"}
+}
+
+// register registers the credential of a for user.
+func register(t *testing.T, s *Store, a *softAuthenticator, user core.User) core.Passkey {
+	t.Helper()
+
+	ctx := context.Background()
+
+	ceremony, err := s.BeginRegistration(ctx, user)
+	require.NoError(t, err)
+
+	p, err := s.FinishRegistration(ctx, user.ID, ceremony.ID, a.create(t, ceremony.Options), "laptop")
+	require.NoError(t, err)
+
+	return p
+}
+
+func TestRegistration(t *testing.T) {
+	for _, format := range []string{"none", "packed"} {
+		t.Run(format, func(t *testing.T) {
+			s, _ := newTestStore(t)
+			ctx := context.Background()
+			user := testUser()
+			a := newSoftAuthenticator(t, testOrigin, format)
+
+			ceremony, err := s.BeginRegistration(ctx, user)
+			require.NoError(t, err)
+
+			var opts map[string]map[string]any
+			require.NoError(t, json.Unmarshal(ceremony.Options, &opts))
+			require.Equal(t, "required", opts["publicKey"]["authenticatorSelection"].(map[string]any)["residentKey"])
+
+			p, err := s.FinishRegistration(ctx, user.ID, ceremony.ID, a.create(t, ceremony.Options), "laptop")
+			require.NoError(t, err)
+			require.Equal(t, user.ID, p.UserID)
+			require.Equal(t, a.credentialID, p.CredentialID)
+			require.Equal(t, format, p.AttestationFormat)
+			require.Equal(t, "laptop", p.Name)
+			require.Equal(t, []string{"internal", "hybrid"}, p.Transports)
+			require.True(t, p.BackupEligible)
+			require.True(t, p.BackupState)
+
+			passkeys, err := s.List(ctx, user.ID)
+			require.NoError(t, err)
+			require.Len(t, passkeys, 1)
+
+			// Registered credentials are excluded from further registrations.
+			ceremony, err = s.BeginRegistration(ctx, user)
+			require.NoError(t, err)
+
+			var creation creationOptions
+			require.NoError(t, json.Unmarshal(ceremony.Options, &creation))
+			require.Len(t, creation.PublicKey.ExcludeCredentials, 1)
+			require.Equal(t, b64.EncodeToString(a.credentialID), creation.PublicKey.ExcludeCredentials[0].ID)
+
+			_, err = s.FinishRegistration(ctx, user.ID, ceremony.ID, a.create(t, ceremony.Options), "again")
+			require.ErrorIs(t, err, core.ErrAlreadyExists)
+		})
+	}
+}
+
+func TestRegistrationRejected(t *testing.T) {
+	tests := map[string]struct {
+		authenticator func(t *testing.T) *softAuthenticator
+		response      func(t *testing.T, a *softAuthenticator, options []byte) []byte
+		user          func(user core.User) uuid.UUID
+		want          error
+	}{
+		"unsupported attestation format": {
+			authenticator: func(t *testing.T) *softAuthenticator { return newSoftAuthenticator(t, testOrigin, "fido-u2f") },
+			want:          core.ErrInvalidCredentials,
+		},
+		"foreign origin": {
+			authenticator: func(t *testing.T) *softAuthenticator {
+				return newSoftAuthenticator(t, "https://evil.test", "none")
+			},
+			want: core.ErrInvalidCredentials,
+		},
+		"malformed response": {
+			response: func(*testing.T, *softAuthenticator, []byte) []byte { return []byte(`{"id":`) },
+			want:     core.ErrInvalidArgument,
+		},
+		"other user": {
+			user: func(core.User) uuid.UUID { return uuid.Must(uuid.NewV7()) },
+			want: core.ErrInvalidToken,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			s, q := newTestStore(t)
+			ctx := context.Background()
+			user := testUser()
+
+			a := newSoftAuthenticator(t, testOrigin, "none")
+			if tt.authenticator != nil {
+				a = tt.authenticator(t)
+			}
+
+			ceremony, err := s.BeginRegistration(ctx, user)
+			require.NoError(t, err)
+
+			response := a.create(t, ceremony.Options)
+			if tt.response != nil {
+				response = tt.response(t, a, ceremony.Options)
+			}
+
+			userID := user.ID
+			if tt.user != nil {
+				userID = tt.user(user)
+			}
+
+			_, err = s.FinishRegistration(ctx, userID, ceremony.ID, response, "laptop")
+			require.ErrorIs(t, err, tt.want)
+			require.Empty(t, q.passkeys)
+		})
+	}
+}
+
+func TestLogin(t *testing.T) {
+	tests := map[string]struct {
+		format string
+		// discoverable starts a usernameless ceremony.
+		discoverable bool
+	}{
+		"discoverable":        {format: "none", discoverable: true},
+		"user":                {format: "none"},
+		"packed user":         {format: "packed"},
+		"packed discoverable": {format: "packed", discoverable: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			s, _ := newTestStore(t)
+			ctx := context.Background()
+			user := testUser()
+			a := newSoftAuthenticator(t, testOrigin, tt.format)
+			registered := register(t, s, a, user)
+
+			userID := user.ID
+			if tt.discoverable {
+				userID = uuid.Nil
+			}
+
+			for i := range 3 {
+				ceremony, err := s.BeginLogin(ctx, userID)
+				require.NoError(t, err)
+
+				var opts requestOptions
+				require.NoError(t, json.Unmarshal(ceremony.Options, &opts))
+				if tt.discoverable {
+					require.Empty(t, opts.PublicKey.AllowCredentials)
+				} else {
+					require.Len(t, opts.PublicKey.AllowCredentials, 1)
+				}
+
+				p, err := s.FinishLogin(ctx, ceremony.ID, a.get(t, ceremony.Options))
+				require.NoError(t, err)
+				require.Equal(t, registered.ID, p.ID)
+				require.Equal(t, user.ID, p.UserID)
+				require.Equal(t, uint32(i+1), p.SignCount)
+				require.NotNil(t, p.LastUsedAt)
+			}
+		})
+	}
+}
+
+func TestLoginWithoutPasskeysIsDiscoverable(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	user := testUser()
+	a := newSoftAuthenticator(t, testOrigin, "none")
+	register(t, s, a, user)
+
+	ceremony, err := s.BeginLogin(ctx, uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+
+	var opts requestOptions
+	require.NoError(t, json.Unmarshal(ceremony.Options, &opts))
+	require.Empty(t, opts.PublicKey.AllowCredentials)
+
+	p, err := s.FinishLogin(ctx, ceremony.ID, a.get(t, ceremony.Options))
+	require.NoError(t, err)
+	require.Equal(t, user.ID, p.UserID)
+}
+
+func TestLoginRejected(t *testing.T) {
+	tests := map[string]struct {
+		// tamper prepares the authenticator or store before the assertion.
+		tamper       func(t *testing.T, s *Store, q *fakeQuerier, a *softAuthenticator)
+		discoverable bool
+		want         error
+	}{
+		"cloned authenticator": {
+			tamper: func(_ *testing.T, _ *Store, _ *fakeQuerier, a *softAuthenticator) { a.signCount-- },
+			want:   core.ErrInvalidCredentials,
+		},
+		"unknown credential": {
+			tamper: func(t *testing.T, _ *Store, _ *fakeQuerier, a *softAuthenticator) {
+				userHandle := a.userHandle
+				*a = *newSoftAuthenticator(t, testOrigin, "none")
+				a.userHandle = userHandle
+			},
+			discoverable: true,
+			want:         core.ErrInvalidCredentials,
+		},
+		"wrong key": {
+			tamper: func(t *testing.T, _ *Store, _ *fakeQuerier, a *softAuthenticator) {
+				a.key = newSoftAuthenticator(t, testOrigin, "none").key
+			},
+			want: core.ErrInvalidCredentials,
+		},
+		"foreign user handle": {
+			tamper: func(_ *testing.T, _ *Store, _ *fakeQuerier, a *softAuthenticator) {
+				id := uuid.Must(uuid.NewV7())
+				a.userHandle = id[:]
+			},
+			discoverable: true,
+			want:         core.ErrInvalidCredentials,
+		},
+		"backup eligibility changed": {
+			tamper: func(_ *testing.T, _ *Store, _ *fakeQuerier, a *softAuthenticator) {
+				a.flags = flagUserPresent | flagUserVerified
+			},
+			want: core.ErrInvalidCredentials,
+		},
+		"expired ceremony": {
+			tamper: func(_ *testing.T, s *Store, q *fakeQuerier, _ *softAuthenticator) {
+				q.advance(s.config.ChallengeTTL)
+			},
+			want: core.ErrInvalidToken,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			s, q := newTestStore(t)
+			ctx := context.Background()
+			user := testUser()
+			a := newSoftAuthenticator(t, testOrigin, "none")
+			register(t, s, a, user)
+
+			// A successful sign in so the sign count is not zero anymore.
+			ceremony, err := s.BeginLogin(ctx, user.ID)
+			require.NoError(t, err)
+			_, err = s.FinishLogin(ctx, ceremony.ID, a.get(t, ceremony.Options))
+			require.NoError(t, err)
+
+			userID := user.ID
+			if tt.discoverable {
+				userID = uuid.Nil
+			}
+
+			ceremony, err = s.BeginLogin(ctx, userID)
+			require.NoError(t, err)
+
+			tt.tamper(t, s, q, a)
+
+			_, err = s.FinishLogin(ctx, ceremony.ID, a.get(t, ceremony.Options))
+			require.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestCeremonyIsSingleUse(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	user := testUser()
+	a := newSoftAuthenticator(t, testOrigin, "none")
+	register(t, s, a, user)
+
+	ceremony, err := s.BeginLogin(ctx, user.ID)
+	require.NoError(t, err)
+
+	response := a.get(t, ceremony.Options)
+	_, err = s.FinishLogin(ctx, ceremony.ID, response)
+	require.NoError(t, err)
+
+	_, err = s.FinishLogin(ctx, ceremony.ID, response)
+	require.ErrorIs(t, err, core.ErrInvalidToken)
+
+	// Registration ceremonies cannot answer sign ins.
+	registration, err := s.BeginRegistration(ctx, user)
+	require.NoError(t, err)
+
+	_, err = s.FinishLogin(ctx, registration.ID, a.get(t, registration.Options))
+	require.ErrorIs(t, err, core.ErrInvalidToken)
+}
+
+func TestDelete(t *testing.T) {
+	s, _ := newTestStore(t)
+	ctx := context.Background()
+	user := testUser()
+	a := newSoftAuthenticator(t, testOrigin, "none")
+	p := register(t, s, a, user)
+
+	require.ErrorIs(t, s.Delete(ctx, uuid.Must(uuid.NewV7()), p.ID), core.ErrNotFound)
+	require.NoError(t, s.Delete(ctx, user.ID, p.ID))
+
+	ceremony, err := s.BeginLogin(ctx, uuid.Nil)
+	require.NoError(t, err)
+
+	_, err = s.FinishLogin(ctx, ceremony.ID, a.get(t, ceremony.Options))
+	require.True(t, errors.Is(err, core.ErrInvalidCredentials), "deleted passkey should not sign in")
+}
+
+func TestDeleteExpired(t *testing.T) {
+	s, q := newTestStore(t)
+	ctx := context.Background()
+
+	_, err := s.BeginLogin(ctx, uuid.Nil)
+	require.NoError(t, err)
+
+	n, err := s.DeleteExpired(ctx)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	q.advance(s.config.ChallengeTTL + time.Second)
+
+	n, err = s.DeleteExpired(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), n)
+}
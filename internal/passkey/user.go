@@ -0,0 +1,77 @@
+package passkey
+
+import (
+	"bytes"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+// user adapts a user and its passkeys to [webauthn.User]. The user handle is the user ID, so discoverable credentials
+// identify their user without revealing its email or username.
+type user struct {
+	id          uuid.UUID
+	name        string
+	displayName string
+	passkeys    []queries.Passkey
+}
+
+var _ webauthn.User = (*user)(nil)
+
+func (u *user) WebAuthnID() []byte {
+	return u.id[:]
+}
+
+func (u *user) WebAuthnName() string {
+	return u.name
+}
+
+func (u *user) WebAuthnDisplayName() string {
+	return u.displayName
+}
+
+func (u *user) WebAuthnCredentials() []webauthn.Credential {
+	credentials := make([]webauthn.Credential, 0, len(u.passkeys))
+	for _, p := range u.passkeys {
+		credentials = append(credentials, toCredential(p))
+	}
+
+	return credentials
+}
+
+// passkey returns the passkey with credentialID.
+func (u *user) passkey(credentialID []byte) (queries.Passkey, bool) {
+	for _, p := range u.passkeys {
+		if bytes.Equal(p.CredentialID, credentialID) {
+			return p, true
+		}
+	}
+
+	return queries.Passkey{}, false
+}
+
+func toCredential(p queries.Passkey) webauthn.Credential {
+	transports := make([]protocol.AuthenticatorTransport, 0, len(p.Transports))
+	for _, t := range p.Transports {
+		transports = append(transports, protocol.AuthenticatorTransport(t))
+	}
+
+	return webauthn.Credential{
+		ID:              p.CredentialID,
+		PublicKey:       p.PublicKey,
+		AttestationType: p.AttestationFormat,
+		Transport:       transports,
+		Flags: webauthn.CredentialFlags{
+			UserVerified:   p.UserVerified,
+			BackupEligible: p.BackupEligible,
+			BackupState:    p.BackupState,
+		},
+		Authenticator: webauthn.Authenticator{
+			AAGUID:    p.Aaguid[:],
+			SignCount: uint32(p.SignCount),
+		},
+	}
+}
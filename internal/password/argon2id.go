@@ -0,0 +1,75 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"strconv"
+
+	"golang.org/x/crypto/argon2"
+)
+
+type argon2idAlgorithm struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	saltLen     uint32
+	keyLen      uint32
+}
+
+var _ algorithm = argon2idAlgorithm{}
+
+// name implements algorithm.
+func (a argon2idAlgorithm) name() string {
+	return "argon2id"
+}
+
+// hash implements algorithm.
+func (a argon2idAlgorithm) hash(password []byte) (string, error) {
+	salt := make([]byte, a.saltLen)
+	rand.Read(salt)
+
+	key := argon2.IDKey(password, salt, a.iterations, a.memory, a.parallelism, a.keyLen)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, a.memory, a.iterations, a.parallelism, b64.EncodeToString(salt), b64.EncodeToString(key),
+	), nil
+}
+
+// verify implements algorithm.
+func (a argon2idAlgorithm) verify(password []byte, encoded string) (bool, bool, error) {
+	p, err := parsePHC(encoded)
+	if err != nil {
+		return false, false, err
+	}
+
+	if p.version != strconv.Itoa(argon2.Version) {
+		return false, false, fmt.Errorf("password: unsupported argon2id version `%s`", p.version)
+	}
+
+	m, err := p.uint("m", 32)
+	if err != nil {
+		return false, false, err
+	}
+
+	t, err := p.uint("t", 32)
+	if err != nil {
+		return false, false, err
+	}
+
+	par, err := p.uint("p", 8)
+	if err != nil {
+		return false, false, err
+	}
+
+	key := argon2.IDKey(password, p.salt, uint32(t), uint32(m), uint8(par), uint32(len(p.hash)))
+	ok := subtle.ConstantTimeCompare(key, p.hash) == 1
+
+	current := uint32(m) == a.memory &&
+		uint32(t) == a.iterations &&
+		uint8(par) == a.parallelism &&
+		uint32(len(p.salt)) == a.saltLen &&
+		uint32(len(p.hash)) == a.keyLen
+
+	return ok, current, nil
+}
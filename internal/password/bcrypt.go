@@ -0,0 +1,48 @@
+package password
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+type bcryptAlgorithm struct {
+	cost int
+}
+
+var _ algorithm = bcryptAlgorithm{}
+
+// name implements algorithm.
+func (a bcryptAlgorithm) name() string {
+	return "bcrypt"
+}
+
+// hash implements algorithm.
+func (a bcryptAlgorithm) hash(password []byte) (string, error) {
+	h, err := bcrypt.GenerateFromPassword(password, a.cost)
+	if err != nil {
+		return "", fmt.Errorf("password: bcrypt generate: %w", err)
+	}
+
+	return string(h), nil
+}
+
+// verify implements algorithm.
+func (a bcryptAlgorithm) verify(password []byte, encoded string) (bool, bool, error) {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	if err != nil {
+		return false, false, fmt.Errorf("password: bcrypt cost: %w", err)
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(encoded), password)
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, false, nil
+	}
+
+	if err != nil {
+		return false, false, fmt.Errorf("password: bcrypt compare: %w", err)
+	}
+
+	return true, cost == a.cost, nil
+}
@@ -0,0 +1,123 @@
+package password
+
+import (
+	"bytes"
+	"crypto/sha1" //nolint:gosec // SHA-1 is mandated by the HIBP format and not used for security.
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// maxLineLen is the maximum length of a line in a breached list. A SHA-1 hex is 40 characters followed by an
+// optional `:<count>`.
+const maxLineLen = 64
+
+// breachedList looks up passwords in a local file of breached password SHA-1 hashes in the Have I Been Pwned format,
+// one `<SHA-1 hex>:<count>` per line sorted by hash. The `:<count>` suffix is optional.
+//
+// The file is binary searched in place, so even the full multi gigabyte HIBP corpus is never loaded into memory.
+type breachedList struct {
+	r        io.ReaderAt
+	size     int64
+	minCount int
+}
+
+func openBreachedList(path string, minCount int) (*breachedList, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("password: open breached list: %w", err)
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("password: stat breached list: %w", err)
+	}
+
+	return &breachedList{r: f, size: fi.Size(), minCount: minCount}, nil
+}
+
+// contains reports whether password is in the list with at least minCount occurrences.
+func (l *breachedList) contains(password string) (bool, error) {
+	sum := sha1.Sum([]byte(password)) //nolint:gosec
+	target := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	// Find the first line at or after an offset whose hash is not less than target. Lines starting after an offset
+	// are non decreasing as the offset grows, which makes the predicate monotonic.
+	lo, hi := int64(0), l.size
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+
+		line, err := l.lineAfter(mid)
+		if err != nil {
+			return false, err
+		}
+
+		if line == nil || lineHash(line) >= target {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	line, err := l.lineAfter(lo)
+	if err != nil || line == nil || lineHash(line) != target {
+		return false, err
+	}
+
+	return lineCount(line) >= l.minCount, nil
+}
+
+// lineAfter returns the first complete line starting at or after off. It returns nil at the end of the file.
+func (l *breachedList) lineAfter(off int64) ([]byte, error) {
+	start := max(off-1, 0)
+
+	buf := make([]byte, 2*maxLineLen+1)
+	n, err := l.r.ReadAt(buf, start)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("password: read breached list: %w", err)
+	}
+	buf = buf[:n]
+
+	if off > 0 {
+		// Skip the remainder of the line containing off-1.
+		i := bytes.IndexByte(buf, '\n')
+		if i < 0 {
+			return nil, nil
+		}
+		buf = buf[i+1:]
+	}
+
+	if i := bytes.IndexByte(buf, '\n'); i >= 0 {
+		buf = buf[:i]
+	}
+
+	buf = bytes.TrimRight(buf, "\r")
+	if len(buf) == 0 {
+		return nil, nil
+	}
+
+	return buf, nil
+}
+
+func lineHash(line []byte) string {
+	h, _, _ := bytes.Cut(line, []byte(":"))
+	return strings.ToUpper(string(h))
+}
+
+func lineCount(line []byte) int {
+	_, c, ok := bytes.Cut(line, []byte(":"))
+	if !ok {
+		return 1
+	}
+
+	n, err := strconv.Atoi(string(c))
+	if err != nil {
+		return 1
+	}
+
+	return n
+}
@@ -0,0 +1,71 @@
+package password
+
+import (
+	"errors"
+	"fmt"
+)
+
+type HasherConfig struct {
+	Algorithm string `help:"Algorithm used to hash new passwords. Hashes of other algorithms are upgraded on successful verification." name:"algorithm" env:"ALGORITHM" enum:"argon2id,bcrypt,scrypt" default:"argon2id"`
+
+	Argon2id struct {
+		Memory      uint32 `help:"Memory in KiB." name:"memory" env:"MEMORY" default:"19456"`
+		Iterations  uint32 `help:"Number of passes over the memory." name:"iterations" env:"ITERATIONS" default:"2"`
+		Parallelism uint8  `help:"Number of threads." name:"parallelism" env:"PARALLELISM" default:"1"`
+		SaltLength  uint32 `help:"Length of random salt in bytes." name:"salt_length" env:"SALT_LENGTH" default:"16"`
+		KeyLength   uint32 `help:"Length of generated key in bytes." name:"key_length" env:"KEY_LENGTH" default:"32"`
+	} `prefix:"argon2id." envprefix:"ARGON2ID_" embed:""`
+
+	Bcrypt struct {
+		Cost int `help:"Cost factor." name:"cost" env:"COST" default:"12"`
+	} `prefix:"bcrypt." envprefix:"BCRYPT_" embed:""`
+
+	Scrypt struct {
+		LogN        uint8  `help:"Log2 of the CPU/memory cost parameter N." name:"log_n" env:"LOG_N" default:"17"`
+		BlockSize   uint32 `help:"Block size parameter r." name:"block_size" env:"BLOCK_SIZE" default:"8"`
+		Parallelism uint32 `help:"Parallelization parameter p." name:"parallelism" env:"PARALLELISM" default:"1"`
+		SaltLength  uint32 `help:"Length of random salt in bytes." name:"salt_length" env:"SALT_LENGTH" default:"16"`
+		KeyLength   uint32 `help:"Length of generated key in bytes." name:"key_length" env:"KEY_LENGTH" default:"32"`
+	} `prefix:"scrypt." envprefix:"SCRYPT_" embed:""`
+}
+
+func (c HasherConfig) parse() ([]algorithm, error) {
+	a := c.Argon2id
+	if a.Memory < 8*uint32(a.Parallelism) || a.Iterations == 0 || a.Parallelism == 0 {
+		return nil, errors.New("password: Argon2id Memory must be at least 8*Parallelism and Iterations, Parallelism cannot be zero")
+	}
+
+	if a.SaltLength < 8 || a.KeyLength < 16 {
+		return nil, errors.New("password: Argon2id SaltLength cannot be less than 8 and KeyLength cannot be less than 16")
+	}
+
+	b := c.Bcrypt
+	if b.Cost < 4 || b.Cost > 31 {
+		return nil, errors.New("password: Bcrypt Cost must be between 4 and 31")
+	}
+
+	s := c.Scrypt
+	if s.LogN == 0 || s.LogN > 31 || s.BlockSize == 0 || s.Parallelism == 0 {
+		return nil, errors.New("password: Scrypt LogN must be between 1 and 31 and BlockSize, Parallelism cannot be zero")
+	}
+
+	if s.SaltLength < 8 || s.KeyLength < 16 {
+		return nil, errors.New("password: Scrypt SaltLength cannot be less than 8 and KeyLength cannot be less than 16")
+	}
+
+	algos := []algorithm{
+		argon2idAlgorithm{memory: a.Memory, iterations: a.Iterations, parallelism: a.Parallelism, saltLen: a.SaltLength, keyLen: a.KeyLength},
+		bcryptAlgorithm{cost: b.Cost},
+		scryptAlgorithm{logN: s.LogN, r: s.BlockSize, p: s.Parallelism, saltLen: s.SaltLength, keyLen: s.KeyLength},
+	}
+
+	// Move the preferred algorithm to the front.
+	for i, algo := range algos {
+		if algo.name() == c.Algorithm {
+			algos[0], algos[i] = algos[i], algos[0]
+			return algos, nil
+		}
+	}
+
+	return nil, fmt.Errorf("password: `%s` is not a valid algorithm", c.Algorithm)
+}
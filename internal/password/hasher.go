@@ -0,0 +1,60 @@
+package password
+
+import (
+	"fmt"
+
+	"github.com/gophero/guardian/core"
+)
+
+type algorithm interface {
+	// name returns the identifier used in encoded hashes.
+	name() string
+	// hash hashes password with configured parameters.
+	hash(password []byte) (string, error)
+	// verify compares password with encoded. current reports whether encoded uses configured parameters.
+	verify(password []byte, encoded string) (ok bool, current bool, err error)
+}
+
+// Hasher is a [core.PasswordHasher] supporting argon2id, bcrypt and scrypt.
+//
+// New hashes are always produced by the configured algorithm while hashes of all supported algorithms can be verified.
+type Hasher struct {
+	algos []algorithm // First one is the preferred algorithm.
+}
+
+var _ core.PasswordHasher = (*Hasher)(nil)
+
+// NewHasher constructs new [Hasher].
+func NewHasher(config HasherConfig) (*Hasher, error) {
+	algos, err := config.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Hasher{algos: algos}, nil
+}
+
+// Hash implements [core.PasswordHasher].
+func (h *Hasher) Hash(password string) (string, error) {
+	return h.algos[0].hash([]byte(password))
+}
+
+// Verify implements [core.PasswordHasher].
+func (h *Hasher) Verify(password string, encoded string) (bool, bool, error) {
+	id := identify(encoded)
+
+	for i, algo := range h.algos {
+		if algo.name() != id {
+			continue
+		}
+
+		ok, current, err := algo.verify([]byte(password), encoded)
+		if err != nil {
+			return false, false, err
+		}
+
+		return ok, ok && (i != 0 || !current), nil
+	}
+
+	return false, false, fmt.Errorf("password: unsupported algorithm `%s`", id)
+}
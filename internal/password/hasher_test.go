@@ -0,0 +1,117 @@
+package password
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testConfig returns a config with cheap parameters to keep tests fast.
+func testConfig(algorithm string) HasherConfig {
+	var c HasherConfig
+	c.Algorithm = algorithm
+	c.Argon2id.Memory = 64
+	c.Argon2id.Iterations = 1
+	c.Argon2id.Parallelism = 1
+	c.Argon2id.SaltLength = 16
+	c.Argon2id.KeyLength = 32
+	c.Bcrypt.Cost = 4
+	c.Scrypt.LogN = 4
+	c.Scrypt.BlockSize = 8
+	c.Scrypt.Parallelism = 1
+	c.Scrypt.SaltLength = 16
+	c.Scrypt.KeyLength = 32
+	return c
+}
+
+func TestHasher(t *testing.T) {
+	for _, algo := range []string{"argon2id", "bcrypt", "scrypt"} {
+		t.Run(algo, func(t *testing.T) {
+			h, err := NewHasher(testConfig(algo))
+			require.NoError(t, err)
+
+			encoded, err := h.Hash("correct horse battery staple")
+			require.NoError(t, err)
+			require.Equal(t, algo, identify(encoded))
+
+			ok, needsRehash, err := h.Verify("correct horse battery staple", encoded)
+			require.NoError(t, err)
+			require.True(t, ok, "password should match")
+			require.False(t, needsRehash, "hash with current parameters should not need rehash")
+
+			ok, needsRehash, err = h.Verify("wrong", encoded)
+			require.NoError(t, err)
+			require.False(t, ok, "wrong password should not match")
+			require.False(t, needsRehash, "mismatch should never need rehash")
+
+			other, err := h.Hash("correct horse battery staple")
+			require.NoError(t, err)
+			require.NotEqual(t, encoded, other, "hashes should be salted")
+		})
+	}
+}
+
+func TestHasherRehash(t *testing.T) {
+	t.Run("outdated-parameters", func(t *testing.T) {
+		old, err := NewHasher(testConfig("argon2id"))
+		require.NoError(t, err)
+
+		encoded, err := old.Hash("password")
+		require.NoError(t, err)
+
+		c := testConfig("argon2id")
+		c.Argon2id.Iterations = 2
+		h, err := NewHasher(c)
+		require.NoError(t, err)
+
+		ok, needsRehash, err := h.Verify("password", encoded)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.True(t, needsRehash, "hash with outdated parameters should need rehash")
+	})
+
+	t.Run("outdated-algorithm", func(t *testing.T) {
+		for _, algo := range []string{"bcrypt", "scrypt"} {
+			old, err := NewHasher(testConfig(algo))
+			require.NoError(t, err)
+
+			encoded, err := old.Hash("password")
+			require.NoError(t, err)
+
+			h, err := NewHasher(testConfig("argon2id"))
+			require.NoError(t, err)
+
+			ok, needsRehash, err := h.Verify("password", encoded)
+			require.NoError(t, err)
+			require.True(t, ok, "%s hash should be verifiable", algo)
+			require.True(t, needsRehash, "%s hash should need rehash", algo)
+		}
+	})
+}
+
+func TestHasherMalformed(t *testing.T) {
+	h, err := NewHasher(testConfig("argon2id"))
+	require.NoError(t, err)
+
+	for _, encoded := range []string{
+		"",
+		"plaintext",
+		"$md5$abc",
+		"$argon2id$v=19$m=64,t=1$c2FsdA$aGFzaA",
+		"$argon2id$v=18$m=64,t=1,p=1$c2FsdA$aGFzaA",
+		"$scrypt$ln=0,r=8,p=1$c2FsdA$aGFzaA",
+	} {
+		_, _, err := h.Verify("password", encoded)
+		require.Error(t, err, "encoded %q should be rejected", encoded)
+	}
+}
+
+func TestArgon2idEncoding(t *testing.T) {
+	h, err := NewHasher(testConfig("argon2id"))
+	require.NoError(t, err)
+
+	encoded, err := h.Hash("password")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(encoded, "$argon2id$v=19$m=64,t=1,p=1$"), "unexpected encoding %q", encoded)
+}
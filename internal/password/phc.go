@@ -0,0 +1,89 @@
+package password
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var b64 = base64.RawStdEncoding
+
+// phc is a parsed PHC string format hash `$<id>[$v=<version>]$<param>=<value>(,<param>=<value>)*$<salt>$<hash>`.
+//
+// See https://github.com/P-H-C/phc-string-format/blob/master/phc-sf-spec.md.
+type phc struct {
+	id      string
+	version string
+	params  map[string]string
+	salt    []byte
+	hash    []byte
+}
+
+func parsePHC(s string) (phc, error) {
+	fields := strings.Split(s, "$")
+	if len(fields) < 5 || fields[0] != "" {
+		return phc{}, errors.New("password: malformed PHC string")
+	}
+
+	p := phc{id: fields[1], params: make(map[string]string)}
+	fields = fields[2:]
+
+	if v, ok := strings.CutPrefix(fields[0], "v="); ok {
+		p.version = v
+		fields = fields[1:]
+	}
+
+	if len(fields) != 3 {
+		return phc{}, errors.New("password: malformed PHC string")
+	}
+
+	for kv := range strings.SplitSeq(fields[0], ",") {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok {
+			return phc{}, fmt.Errorf("password: malformed PHC parameter `%s`", kv)
+		}
+		p.params[k] = v
+	}
+
+	var err error
+
+	if p.salt, err = b64.DecodeString(fields[1]); err != nil {
+		return phc{}, fmt.Errorf("password: decode PHC salt: %w", err)
+	}
+
+	if p.hash, err = b64.DecodeString(fields[2]); err != nil {
+		return phc{}, fmt.Errorf("password: decode PHC hash: %w", err)
+	}
+
+	return p, nil
+}
+
+// uint parses the named parameter as an unsigned integer which fits in bitSize.
+func (p phc) uint(name string, bitSize int) (uint64, error) {
+	v, ok := p.params[name]
+	if !ok {
+		return 0, fmt.Errorf("password: missing PHC parameter `%s`", name)
+	}
+
+	n, err := strconv.ParseUint(v, 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("password: parse PHC parameter `%s`: %w", name, err)
+	}
+
+	return n, nil
+}
+
+// identify returns the algorithm identifier of an encoded hash.
+func identify(encoded string) string {
+	// bcrypt predates PHC and uses the modular crypt format `$2b$<cost>$<salt+hash>`.
+	for _, prefix := range []string{"$2a$", "$2b$", "$2y$"} {
+		if strings.HasPrefix(encoded, prefix) {
+			return "bcrypt"
+		}
+	}
+
+	id, _, _ := strings.Cut(strings.TrimPrefix(encoded, "$"), "$")
+	return id
+}
@@ -0,0 +1,207 @@
+package password
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gophero/guardian/core"
+)
+
+// PolicyConfig configures the password [Policy]. Defaults follow NIST SP 800-63B, which favours length and screening
+// against breached passwords over composition rules.
+type PolicyConfig struct {
+	MinLength          int    `help:"Minimum number of characters." name:"min_length" env:"MIN_LENGTH" default:"8"`
+	MaxLength          int    `help:"Maximum number of characters." name:"max_length" env:"MAX_LENGTH" default:"64"`
+	RequireUppercase   bool   `help:"Require at least one uppercase letter." name:"require_uppercase" env:"REQUIRE_UPPERCASE" default:"false"`
+	RequireLowercase   bool   `help:"Require at least one lowercase letter." name:"require_lowercase" env:"REQUIRE_LOWERCASE" default:"false"`
+	RequireDigit       bool   `help:"Require at least one digit." name:"require_digit" env:"REQUIRE_DIGIT" default:"false"`
+	RequireSymbol      bool   `help:"Require at least one symbol." name:"require_symbol" env:"REQUIRE_SYMBOL" default:"false"`
+	MaxRepeatedChars   int    `help:"Maximum run of the same character such as 'aaa'. Zero disables the check." name:"max_repeated_chars" env:"MAX_REPEATED_CHARS" default:"3"`
+	MaxSequentialChars int    `help:"Maximum run of sequential characters such as 'abc' or '321'. Zero disables the check." name:"max_sequential_chars" env:"MAX_SEQUENTIAL_CHARS" default:"3"`
+	DisallowUserInfo   bool   `help:"Reject passwords containing the username or email of the user." name:"disallow_user_info" env:"DISALLOW_USER_INFO" default:"true"`
+	HistorySize        int    `help:"Number of previous passwords which cannot be reused. Zero disables the check." name:"history_size" env:"HISTORY_SIZE" default:"5"`
+	BreachedFile       string `help:"Path to a file of breached password SHA-1 hashes in HIBP format sorted by hash. Empty disables the check." name:"breached_file" env:"BREACHED_FILE" default:""`
+	BreachedMinCount   int    `help:"Minimum number of times a password must appear in the breached file to be rejected." name:"breached_min_count" env:"BREACHED_MIN_COUNT" default:"1"`
+}
+
+// Policy is a [core.PasswordPolicy].
+type Policy struct {
+	config   PolicyConfig
+	breached *breachedList
+}
+
+var _ core.PasswordPolicy = (*Policy)(nil)
+
+// NewPolicy constructs new [Policy].
+func NewPolicy(config PolicyConfig) (*Policy, error) {
+	if config.MinLength < 1 {
+		return nil, errors.New("password: MinLength cannot be zero or negative")
+	}
+
+	if config.MaxLength < config.MinLength {
+		return nil, errors.New("password: MaxLength cannot be less than MinLength")
+	}
+
+	if config.MaxRepeatedChars < 0 || config.MaxSequentialChars < 0 || config.HistorySize < 0 {
+		return nil, errors.New("password: MaxRepeatedChars, MaxSequentialChars and HistorySize cannot be negative")
+	}
+
+	p := &Policy{config: config}
+
+	if config.BreachedFile != "" {
+		if config.BreachedMinCount < 1 {
+			return nil, errors.New("password: BreachedMinCount cannot be zero or negative")
+		}
+
+		l, err := openBreachedList(config.BreachedFile, config.BreachedMinCount)
+		if err != nil {
+			return nil, err
+		}
+		p.breached = l
+	}
+
+	return p, nil
+}
+
+// Validate implements [core.PasswordPolicy].
+func (p *Policy) Validate(ctx context.Context, password string, user core.User) error {
+	var violations []core.PasswordViolation
+
+	add := func(code core.PasswordViolationCode, format string, args ...any) {
+		violations = append(violations, core.PasswordViolation{Code: code, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if !utf8.ValidString(password) {
+		add(core.PasswordInvalidCharacters, "password must be valid UTF-8")
+		return &core.PasswordPolicyError{Violations: violations}
+	}
+
+	c := p.config
+	n := utf8.RuneCountInString(password)
+
+	if n < c.MinLength {
+		add(core.PasswordTooShort, "password must be at least %d characters", c.MinLength)
+	}
+
+	if n > c.MaxLength {
+		add(core.PasswordTooLong, "password must be at most %d characters", c.MaxLength)
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+
+	if c.RequireUppercase && !upper {
+		add(core.PasswordMissingUppercase, "password must contain an uppercase letter")
+	}
+
+	if c.RequireLowercase && !lower {
+		add(core.PasswordMissingLowercase, "password must contain a lowercase letter")
+	}
+
+	if c.RequireDigit && !digit {
+		add(core.PasswordMissingDigit, "password must contain a digit")
+	}
+
+	if c.RequireSymbol && !symbol {
+		add(core.PasswordMissingSymbol, "password must contain a symbol")
+	}
+
+	if c.MaxRepeatedChars > 0 && longestRepeatedRun(password) > c.MaxRepeatedChars {
+		add(core.PasswordRepeatedChars, "password must not repeat a character more than %d times in a row", c.MaxRepeatedChars)
+	}
+
+	if c.MaxSequentialChars > 0 && longestSequentialRun(password) > c.MaxSequentialChars {
+		add(core.PasswordSequentialChars, "password must not contain more than %d sequential characters", c.MaxSequentialChars)
+	}
+
+	if c.DisallowUserInfo && containsUserInfo(password, user) {
+		add(core.PasswordContainsUserInfo, "password must not contain the username or email")
+	}
+
+	if p.breached != nil {
+		found, err := p.breached.contains(password)
+		if err != nil {
+			return err
+		}
+
+		if found {
+			add(core.PasswordBreached, "password has appeared in a data breach")
+		}
+	}
+
+	if len(violations) > 0 {
+		return &core.PasswordPolicyError{Violations: violations}
+	}
+
+	return nil
+}
+
+func longestRepeatedRun(s string) int {
+	longest, run := 0, 0
+	var prev rune = -1
+
+	for _, r := range s {
+		if r == prev {
+			run++
+		} else {
+			run = 1
+		}
+		prev = r
+		longest = max(longest, run)
+	}
+
+	return longest
+}
+
+// longestSequentialRun returns the longest run of ascending or descending consecutive characters, e.g. `abcd` or `4321`.
+func longestSequentialRun(s string) int {
+	longest, run := 0, 0
+	var prev, step rune = -1, 0
+
+	for _, r := range strings.ToLower(s) {
+		d := r - prev
+		switch {
+		case prev < 0 || (d != 1 && d != -1):
+			run = 1
+		case run >= 2 && d == step:
+			run++
+		default:
+			run = 2
+		}
+		prev, step = r, d
+		longest = max(longest, run)
+	}
+
+	return longest
+}
+
+// containsUserInfo reports whether password contains the username, email or local part of the email of user.
+// Values shorter than 3 characters are ignored to avoid false positives.
+func containsUserInfo(password string, user core.User) bool {
+	password = strings.ToLower(password)
+
+	local, _, _ := strings.Cut(user.Email, "@")
+
+	for _, v := range []string{user.Username, user.Email, local} {
+		if utf8.RuneCountInString(v) >= 3 && strings.Contains(password, strings.ToLower(v)) {
+			return true
+		}
+	}
+
+	return false
+}
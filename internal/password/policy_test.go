@@ -0,0 +1,161 @@
+package password
+
+import (
+	"context"
+	"crypto/sha1" //nolint:gosec
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+)
+
+func defaultPolicyConfig() PolicyConfig {
+	return PolicyConfig{
+		MinLength:          8,
+		MaxLength:          64,
+		MaxRepeatedChars:   3,
+		MaxSequentialChars: 3,
+		DisallowUserInfo:   true,
+		HistorySize:        5,
+		BreachedMinCount:   1,
+	}
+}
+
+func violationCodes(t *testing.T, err error) []core.PasswordViolationCode {
+	t.Helper()
+
+	if err == nil {
+		return nil
+	}
+
+	var perr *core.PasswordPolicyError
+	require.True(t, errors.As(err, &perr), "error should be *core.PasswordPolicyError: %v", err)
+	require.True(t, errors.Is(err, core.ErrInvalidArgument), "error should match core.ErrInvalidArgument")
+
+	codes := make([]core.PasswordViolationCode, 0, len(perr.Violations))
+	for _, v := range perr.Violations {
+		codes = append(codes, v.Code)
+	}
+	return codes
+}
+
+func TestPolicy(t *testing.T) {
+	user := core.User{Email: "jane.doe@example.com", Username: "jdoe"}
+
+	strict := defaultPolicyConfig()
+	strict.RequireUppercase = true
+	strict.RequireLowercase = true
+	strict.RequireDigit = true
+	strict.RequireSymbol = true
+
+	tests := []struct {
+		name     string
+		config   PolicyConfig
+		password string
+		want     []core.PasswordViolationCode
+	}{
+		{"valid", defaultPolicyConfig(), "purple monkey dishwasher", nil},
+		{"too-short", defaultPolicyConfig(), "pa55", []core.PasswordViolationCode{core.PasswordTooShort}},
+		{"too-long", defaultPolicyConfig(), strings.Repeat("ab", 33), []core.PasswordViolationCode{core.PasswordTooLong}},
+		{"length-counts-characters", defaultPolicyConfig(), "ééééééé", []core.PasswordViolationCode{core.PasswordTooShort, core.PasswordRepeatedChars}},
+		{"repeated", defaultPolicyConfig(), "passwooooord", []core.PasswordViolationCode{core.PasswordRepeatedChars}},
+		{"sequential-ascending", defaultPolicyConfig(), "xyz1234yyz", []core.PasswordViolationCode{core.PasswordSequentialChars}},
+		{"sequential-descending", defaultPolicyConfig(), "fedcbxxyy", []core.PasswordViolationCode{core.PasswordSequentialChars}},
+		{"sequential-allowed", defaultPolicyConfig(), "abc-zyx-123", nil},
+		{"username", defaultPolicyConfig(), "my-JDOE-password", []core.PasswordViolationCode{core.PasswordContainsUserInfo}},
+		{"email-local-part", defaultPolicyConfig(), "jane.doe-rocks", []core.PasswordViolationCode{core.PasswordContainsUserInfo}},
+		{"invalid-utf8", defaultPolicyConfig(), "password\xff", []core.PasswordViolationCode{core.PasswordInvalidCharacters}},
+		{"composition", strict, "purple monkey", []core.PasswordViolationCode{
+			core.PasswordMissingUppercase,
+			core.PasswordMissingDigit,
+		}},
+		{"composition-valid", strict, "Purple monkey 9", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewPolicy(tt.config)
+			require.NoError(t, err)
+
+			got := violationCodes(t, p.Validate(context.Background(), tt.password, user))
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPolicyBreached(t *testing.T) {
+	counts := map[string]string{
+		"password":  "9545824",
+		"123456789": "7",
+		"rare":      "1",
+	}
+
+	lines := make([]string, 0, len(counts)+2)
+	for pw, c := range counts {
+		sum := sha1.Sum([]byte(pw)) //nolint:gosec
+		lines = append(lines, strings.ToUpper(hex.EncodeToString(sum[:]))+":"+c)
+	}
+	lines = append(lines, strings.Repeat("0", 40)+":3", strings.Repeat("F", 40)+":3")
+	slices.Sort(lines)
+
+	path := filepath.Join(t.TempDir(), "pwned.txt")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\r\n")+"\r\n"), 0o600))
+
+	c := defaultPolicyConfig()
+	c.MaxSequentialChars = 0
+	c.BreachedFile = path
+	c.BreachedMinCount = 2
+
+	p, err := NewPolicy(c)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		password string
+		breached bool
+	}{
+		{"password", true},
+		{"123456789", true},
+		{"rare", false}, // Below BreachedMinCount.
+		{"not breached at all", false},
+	} {
+		l, err := p.breached.contains(tt.password)
+		require.NoError(t, err)
+		require.Equal(t, tt.breached, l, "password %q", tt.password)
+	}
+
+	codes := violationCodes(t, p.Validate(context.Background(), "password", core.User{}))
+	require.Equal(t, []core.PasswordViolationCode{core.PasswordBreached}, codes)
+}
+
+func TestBreachedListSearch(t *testing.T) {
+	// Every line in a large list must be found regardless of its position.
+	lines := make([]string, 0, 500)
+	for i := range 500 {
+		sum := sha1.Sum([]byte{byte(i), byte(i >> 8)}) //nolint:gosec
+		lines = append(lines, strings.ToUpper(hex.EncodeToString(sum[:]))+":"+strings.Repeat("9", i%7+1))
+	}
+	slices.Sort(lines)
+
+	path := filepath.Join(t.TempDir(), "pwned.txt")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o600))
+
+	l, err := openBreachedList(path, 1)
+	require.NoError(t, err)
+
+	for i := range 500 {
+		found, err := l.contains(string([]byte{byte(i), byte(i >> 8)}))
+		require.NoError(t, err)
+		require.True(t, found, "entry %d should be found", i)
+	}
+
+	found, err := l.contains("missing")
+	require.NoError(t, err)
+	require.False(t, found)
+}
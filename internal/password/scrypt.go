@@ -0,0 +1,81 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+type scryptAlgorithm struct {
+	logN    uint8
+	r       uint32
+	p       uint32
+	saltLen uint32
+	keyLen  uint32
+}
+
+var _ algorithm = scryptAlgorithm{}
+
+// name implements algorithm.
+func (a scryptAlgorithm) name() string {
+	return "scrypt"
+}
+
+// hash implements algorithm.
+func (a scryptAlgorithm) hash(password []byte) (string, error) {
+	salt := make([]byte, a.saltLen)
+	rand.Read(salt)
+
+	key, err := scrypt.Key(password, salt, 1<<a.logN, int(a.r), int(a.p), int(a.keyLen))
+	if err != nil {
+		return "", fmt.Errorf("password: scrypt key: %w", err)
+	}
+
+	return fmt.Sprintf("$scrypt$ln=%d,r=%d,p=%d$%s$%s",
+		a.logN, a.r, a.p, b64.EncodeToString(salt), b64.EncodeToString(key),
+	), nil
+}
+
+// verify implements algorithm.
+func (a scryptAlgorithm) verify(password []byte, encoded string) (bool, bool, error) {
+	p, err := parsePHC(encoded)
+	if err != nil {
+		return false, false, err
+	}
+
+	logN, err := p.uint("ln", 8)
+	if err != nil {
+		return false, false, err
+	}
+
+	r, err := p.uint("r", 32)
+	if err != nil {
+		return false, false, err
+	}
+
+	par, err := p.uint("p", 32)
+	if err != nil {
+		return false, false, err
+	}
+
+	if logN == 0 || logN > 31 {
+		return false, false, fmt.Errorf("password: invalid scrypt parameter ln=%d", logN)
+	}
+
+	key, err := scrypt.Key(password, p.salt, 1<<logN, int(r), int(par), len(p.hash))
+	if err != nil {
+		return false, false, fmt.Errorf("password: scrypt key: %w", err)
+	}
+
+	ok := subtle.ConstantTimeCompare(key, p.hash) == 1
+
+	current := uint8(logN) == a.logN &&
+		uint32(r) == a.r &&
+		uint32(par) == a.p &&
+		uint32(len(p.salt)) == a.saltLen &&
+		uint32(len(p.hash)) == a.keyLen
+
+	return ok, current, nil
+}
@@ -0,0 +1,203 @@
+package password
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+// Store is a postgres backed [core.PasswordStore].
+type Store struct {
+	pool   *pgxpool.Pool
+	q      *queries.Queries
+	hasher core.PasswordHasher
+	policy *Policy
+
+	// dummyHash is verified against when the user has no password so that response time does not reveal whether a
+	// credential exists.
+	dummyHash string
+}
+
+var _ core.PasswordStore = (*Store)(nil)
+
+// NewStore constructs new [Store].
+func NewStore(pool *pgxpool.Pool, hasher core.PasswordHasher, policy *Policy) (*Store, error) {
+	dummyHash, err := hasher.Hash("guardian-dummy-password")
+	if err != nil {
+		return nil, fmt.Errorf("password: hash dummy password: %w", err)
+	}
+
+	return &Store{
+		pool:      pool,
+		q:         queries.New(pool),
+		hasher:    hasher,
+		policy:    policy,
+		dummyHash: dummyHash,
+	}, nil
+}
+
+// Check implements [core.PasswordStore].
+func (s *Store) Check(ctx context.Context, userID uuid.UUID, password string) error {
+	if err := s.validate(ctx, userID, password); err != nil {
+		return err
+	}
+
+	return s.checkHistory(ctx, s.q, userID, password)
+}
+
+// Set implements [core.PasswordStore].
+func (s *Store) Set(ctx context.Context, userID uuid.UUID, password string) error {
+	if err := s.validate(ctx, userID, password); err != nil {
+		return err
+	}
+
+	hash, err := s.hasher.Hash(password)
+	if err != nil {
+		return err
+	}
+
+	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		q := s.q.WithTx(tx)
+
+		if err := s.checkHistory(ctx, q, userID, password); err != nil {
+			return err
+		}
+
+		if err := q.UpsertPasswordCredential(ctx, queries.UpsertPasswordCredentialParams{UserID: userID, Hash: hash}); err != nil {
+			if db.IsForeignKeyViolation(err) {
+				return fmt.Errorf("password: upsert password credential: %w", core.ErrNotFound)
+			}
+			return fmt.Errorf("password: upsert password credential: %w", err)
+		}
+
+		size := int32(s.policy.config.HistorySize)
+		if size == 0 {
+			return nil
+		}
+
+		if err := q.InsertPasswordHistory(ctx, queries.InsertPasswordHistoryParams{UserID: userID, Hash: hash}); err != nil {
+			return fmt.Errorf("password: insert password history: %w", err)
+		}
+
+		if err := q.PrunePasswordHistory(ctx, queries.PrunePasswordHistoryParams{UserID: userID, Keep: size}); err != nil {
+			return fmt.Errorf("password: prune password history: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// validate validates password against the policy in the context of the user.
+func (s *Store) validate(ctx context.Context, userID uuid.UUID, password string) error {
+	u, err := s.q.GetUserByID(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("password: get user by id: %w", core.ErrNotFound)
+	}
+
+	if err != nil {
+		return fmt.Errorf("password: get user by id: %w", err)
+	}
+
+	return s.policy.Validate(ctx, password, core.User{ID: u.ID, Email: u.Email, Username: u.Username})
+}
+
+// checkHistory returns a [*core.PasswordPolicyError] if password matches one of the last HistorySize passwords.
+func (s *Store) checkHistory(ctx context.Context, q *queries.Queries, userID uuid.UUID, password string) error {
+	size := int32(s.policy.config.HistorySize)
+	if size == 0 {
+		return nil
+	}
+
+	hashes, err := q.ListPasswordHistory(ctx, queries.ListPasswordHistoryParams{UserID: userID, Limit: size})
+	if err != nil {
+		return fmt.Errorf("password: list password history: %w", err)
+	}
+
+	for _, h := range hashes {
+		ok, _, err := s.hasher.Verify(password, h)
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			return &core.PasswordPolicyError{Violations: []core.PasswordViolation{{
+				Code:    core.PasswordReused,
+				Message: fmt.Sprintf("password must not match any of the last %d passwords", size),
+			}}}
+		}
+	}
+
+	return nil
+}
+
+// Verify implements [core.PasswordStore].
+func (s *Store) Verify(ctx context.Context, userID uuid.UUID, password string) error {
+	cred, err := s.q.GetPasswordCredential(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		//nolint:errcheck // Only spends the time of a verification so response times do not reveal missing credentials.
+		s.hasher.Verify(password, s.dummyHash)
+		return core.ErrInvalidCredentials
+	}
+
+	if err != nil {
+		return fmt.Errorf("password: get password credential: %w", err)
+	}
+
+	ok, needsRehash, err := s.hasher.Verify(password, cred.Hash)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return core.ErrInvalidCredentials
+	}
+
+	if needsRehash {
+		s.rehash(ctx, userID, password, cred.Hash)
+	}
+
+	return nil
+}
+
+// rehash replaces oldHash with a hash of the preferred algorithm and parameters. Failures are only logged since the
+// password was already verified and the upgrade will be retried on the next successful verification.
+func (s *Store) rehash(ctx context.Context, userID uuid.UUID, password string, oldHash string) {
+	logger := zerolog.Ctx(ctx)
+
+	newHash, err := s.hasher.Hash(password)
+	if err != nil {
+		logger.Warn().Err(err).Stringer("user_id", userID).Msg("failed to rehash password")
+		return
+	}
+
+	if _, err := s.q.RehashPasswordCredential(ctx, queries.RehashPasswordCredentialParams{
+		NewHash: newHash,
+		UserID:  userID,
+		OldHash: oldHash,
+	}); err != nil {
+		logger.Warn().Err(err).Stringer("user_id", userID).Msg("failed to update rehashed password")
+	}
+}
+
+// Delete implements [core.PasswordStore].
+func (s *Store) Delete(ctx context.Context, userID uuid.UUID) error {
+	n, err := s.q.DeletePasswordCredential(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("password: delete password credential: %w", err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("password: delete password credential: %w", core.ErrNotFound)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package passwordless
+
+import (
+	"errors"
+	"net/url"
+	"time"
+)
+
+type Config struct {
+	MagicLinkURL   string        `help:"URL of the page which completes magic link sign ins. The token is added as token query parameter." name:"magic_link_url" env:"MAGIC_LINK_URL"`
+	MagicLinkTTL   time.Duration `help:"Duration in which a magic link has to be used." name:"magic_link_ttl" env:"MAGIC_LINK_TTL" default:"15m"`
+	EmailOTPTTL    time.Duration `help:"Duration in which an email one-time code has to be entered." name:"email_otp_ttl" env:"EMAIL_OTP_TTL" default:"10m"`
+	MaxAttempts    int           `help:"Maximum number of attempts to enter an email one-time code." name:"max_attempts" env:"MAX_ATTEMPTS" default:"5"`
+	ResendInterval time.Duration `help:"Minimum duration between two passwordless emails of the same kind to a user." name:"resend_interval" env:"RESEND_INTERVAL" default:"1m"`
+}
+
+func (c Config) validate() error {
+	u, err := url.Parse(c.MagicLinkURL)
+	if err != nil || !u.IsAbs() {
+		return errors.New("passwordless: MagicLinkURL must be an absolute URL")
+	}
+
+	if c.MagicLinkTTL <= 0 {
+		return errors.New("passwordless: MagicLinkTTL cannot be zero or negative")
+	}
+
+	if c.EmailOTPTTL <= 0 {
+		return errors.New("passwordless: EmailOTPTTL cannot be zero or negative")
+	}
+
+	if c.MaxAttempts <= 0 {
+		return errors.New("passwordless: MaxAttempts cannot be zero or negative")
+	}
+
+	if c.ResendInterval < 0 {
+		return errors.New("passwordless: ResendInterval cannot be negative")
+	}
+
+	return nil
+}
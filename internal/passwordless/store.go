@@ -0,0 +1,237 @@
+// Package passwordless signs in users with magic links and one-time codes sent to their email address.
+package passwordless
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/internal/db/queries"
+	"github.com/gophero/guardian/internal/secret"
+)
+
+// codeDigits is the number of digits of email one-time codes.
+const codeDigits = 6
+
+// Store is a postgres backed [core.PasswordlessStore].
+type Store struct {
+	config Config
+	link   *url.URL
+	pool   *pgxpool.Pool
+	q      *queries.Queries
+	now    func() time.Time
+}
+
+var _ core.PasswordlessStore = (*Store)(nil)
+
+// NewStore constructs new [Store].
+func NewStore(pool *pgxpool.Pool, config Config) (*Store, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	link, err := url.Parse(config.MagicLinkURL)
+	if err != nil {
+		return nil, fmt.Errorf("passwordless: parse magic link url: %w", err)
+	}
+
+	return &Store{config: config, link: link, pool: pool, q: queries.New(pool), now: time.Now}, nil
+}
+
+// CreateMagicLink implements [core.PasswordlessStore].
+func (s *Store) CreateMagicLink(ctx context.Context, userID uuid.UUID, device string) (core.PasswordlessChallenge, string, error) {
+	token := secret.New(secret.DefaultSize)
+
+	c, err := s.create(ctx, queries.CreatePasswordlessChallengeParams{
+		UserID:    userID,
+		Method:    queries.PasswordlessMethodMagicLink,
+		TokenHash: secret.Hash(token),
+		Device:    device,
+		ExpiresAt: s.now().Add(s.config.MagicLinkTTL),
+	})
+	if err != nil {
+		return core.PasswordlessChallenge{}, "", err
+	}
+
+	link := *s.link
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	return c, link.String(), nil
+}
+
+// NewEmailOTP implements [core.PasswordlessStore].
+func (s *Store) NewEmailOTP(userID uuid.UUID, device string) (core.PasswordlessChallenge, string, string) {
+	now := s.now()
+
+	return core.PasswordlessChallenge{
+		UserID:    userID,
+		Method:    core.PasswordlessEmailOTP,
+		Device:    device,
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.config.EmailOTPTTL),
+	}, secret.New(secret.DefaultSize), newCode()
+}
+
+// CreateEmailOTP implements [core.PasswordlessStore].
+func (s *Store) CreateEmailOTP(ctx context.Context, c core.PasswordlessChallenge, token, code string) error {
+	_, err := s.create(ctx, queries.CreatePasswordlessChallengeParams{
+		UserID:    c.UserID,
+		Method:    queries.PasswordlessMethodEmailOtp,
+		TokenHash: secret.Hash(token),
+		CodeHash:  codeHash(token, code),
+		Device:    c.Device,
+		ExpiresAt: c.ExpiresAt,
+	})
+
+	return err
+}
+
+// create replaces the pending challenges of the user with a new one, unless one was created within the resend
+// interval.
+func (s *Store) create(ctx context.Context, params queries.CreatePasswordlessChallengeParams) (core.PasswordlessChallenge, error) {
+	var c queries.PasswordlessChallenge
+
+	err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		q := s.q.WithTx(tx)
+
+		n, err := q.CountPasswordlessChallengesSince(ctx, queries.CountPasswordlessChallengesSinceParams{
+			UserID: params.UserID,
+			Method: params.Method,
+			Since:  s.now().Add(-s.config.ResendInterval),
+		})
+		if err != nil {
+			return fmt.Errorf("passwordless: count passwordless challenges: %w", err)
+		}
+
+		if n > 0 {
+			return core.ErrRateLimited
+		}
+
+		if _, err := q.DeletePendingPasswordlessChallenges(ctx, queries.DeletePendingPasswordlessChallengesParams{
+			UserID: params.UserID,
+			Method: params.Method,
+		}); err != nil {
+			return fmt.Errorf("passwordless: delete pending passwordless challenges: %w", err)
+		}
+
+		c, err = q.CreatePasswordlessChallenge(ctx, params)
+		if err != nil {
+			if db.IsForeignKeyViolation(err) {
+				return fmt.Errorf("passwordless: create passwordless challenge: %w", core.ErrNotFound)
+			}
+			return fmt.Errorf("passwordless: create passwordless challenge: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return core.PasswordlessChallenge{}, err
+	}
+
+	return toChallenge(c), nil
+}
+
+// VerifyMagicLink implements [core.PasswordlessStore].
+func (s *Store) VerifyMagicLink(ctx context.Context, token string) (core.PasswordlessChallenge, error) {
+	c, err := s.attempt(ctx, token, queries.PasswordlessMethodMagicLink)
+	if err != nil {
+		return core.PasswordlessChallenge{}, err
+	}
+
+	return s.complete(ctx, c)
+}
+
+// VerifyEmailOTP implements [core.PasswordlessStore].
+func (s *Store) VerifyEmailOTP(ctx context.Context, token, code string) (core.PasswordlessChallenge, error) {
+	c, err := s.attempt(ctx, token, queries.PasswordlessMethodEmailOtp)
+	if err != nil {
+		return core.PasswordlessChallenge{}, err
+	}
+
+	if subtle.ConstantTimeCompare(c.CodeHash, codeHash(token, code)) != 1 {
+		return core.PasswordlessChallenge{}, core.ErrInvalidCredentials
+	}
+
+	return s.complete(ctx, c)
+}
+
+// attempt counts an attempt to complete the pending challenge of token.
+func (s *Store) attempt(ctx context.Context, token string, method queries.PasswordlessMethod) (queries.PasswordlessChallenge, error) {
+	c, err := s.q.AttemptPasswordlessChallenge(ctx, queries.AttemptPasswordlessChallengeParams{
+		TokenHash:   secret.Hash(token),
+		Method:      method,
+		MaxAttempts: int32(s.config.MaxAttempts),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return queries.PasswordlessChallenge{}, core.ErrInvalidToken
+	}
+
+	if err != nil {
+		return queries.PasswordlessChallenge{}, fmt.Errorf("passwordless: attempt passwordless challenge: %w", err)
+	}
+
+	return c, nil
+}
+
+func (s *Store) complete(ctx context.Context, c queries.PasswordlessChallenge) (core.PasswordlessChallenge, error) {
+	n, err := s.q.CompletePasswordlessChallenge(ctx, c.ID)
+	if err != nil {
+		return core.PasswordlessChallenge{}, fmt.Errorf("passwordless: complete passwordless challenge: %w", err)
+	}
+
+	// The challenge was completed concurrently.
+	if n == 0 {
+		return core.PasswordlessChallenge{}, core.ErrInvalidToken
+	}
+
+	return toChallenge(c), nil
+}
+
+// DeleteExpired deletes expired challenges.
+func (s *Store) DeleteExpired(ctx context.Context) (int64, error) {
+	n, err := s.q.DeleteExpiredPasswordlessChallenges(ctx, s.now())
+	if err != nil {
+		return 0, fmt.Errorf("passwordless: delete expired passwordless challenges: %w", err)
+	}
+
+	return n, nil
+}
+
+// newCode returns a random code of [codeDigits] digits. The modulo bias of 64 random bits is negligible.
+func newCode() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+
+	return fmt.Sprintf("%0*d", codeDigits, binary.BigEndian.Uint64(b)%1_000_000)
+}
+
+// codeHash hashes code keyed with the token of its challenge. Codes have little entropy, so a leaked hash alone must
+// not allow to recover them.
+func codeHash(token, code string) []byte {
+	return secret.Hash(token + ":" + code)
+}
+
+func toChallenge(c queries.PasswordlessChallenge) core.PasswordlessChallenge {
+	return core.PasswordlessChallenge{
+		ID:        c.ID,
+		UserID:    c.UserID,
+		Method:    core.PasswordlessMethod(c.Method),
+		Device:    c.Device,
+		Attempts:  int(c.Attempts),
+		CreatedAt: c.CreatedAt,
+		ExpiresAt: c.ExpiresAt,
+	}
+}
@@ -0,0 +1,132 @@
+package passwordless
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db/dbtest"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	pool := dbtest.Pool(t)
+	q := queries.New(pool)
+
+	s, err := NewStore(pool, Config{
+		MagicLinkURL:   "https://example.com/magic-link",
+		MagicLinkTTL:   15 * time.Minute,
+		EmailOTPTTL:    10 * time.Minute,
+		MaxAttempts:    3,
+		ResendInterval: time.Minute,
+	})
+	require.NoError(t, err)
+
+	setNow := func(t time.Time) { s.now = func() time.Time { return t } }
+
+	newUser := func(t *testing.T) uuid.UUID {
+		t.Helper()
+
+		suffix := uuid.NewString()[:8]
+
+		user, err := q.CreateUser(ctx, queries.CreateUserParams{
+			Email:    "passwordless-" + suffix + "@example.com",
+			Username: "passwordless-" + suffix,
+			Status:   queries.UserStatusActive,
+		})
+		require.NoError(t, err)
+
+		return user.ID
+	}
+
+	createMagicLink := func(t *testing.T, userID uuid.UUID) string {
+		t.Helper()
+
+		_, link, err := s.CreateMagicLink(ctx, userID, "phone")
+		require.NoError(t, err)
+
+		u, err := url.Parse(link)
+		require.NoError(t, err)
+
+		return u.Query().Get("token")
+	}
+
+	t.Run("completes email one-time codes once stored", func(t *testing.T) {
+		userID := newUser(t)
+		setNow(time.Now())
+
+		c, token, code := s.NewEmailOTP(userID, "phone")
+		require.Equal(t, userID, c.UserID)
+		require.Equal(t, core.PasswordlessEmailOTP, c.Method)
+
+		// The challenge cannot be completed before it was stored.
+		_, err := s.VerifyEmailOTP(ctx, token, code)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+
+		require.NoError(t, s.CreateEmailOTP(ctx, c, token, code))
+
+		_, err = s.VerifyEmailOTP(ctx, token, "x"+code)
+		require.ErrorIs(t, err, core.ErrInvalidCredentials)
+
+		got, err := s.VerifyEmailOTP(ctx, token, code)
+		require.NoError(t, err)
+		require.Equal(t, userID, got.UserID)
+		require.Equal(t, "phone", got.Device)
+
+		_, err = s.VerifyEmailOTP(ctx, token, code)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+	})
+
+	t.Run("throttles challenges of a user", func(t *testing.T) {
+		userID := newUser(t)
+		now := time.Now()
+		setNow(now)
+
+		first := createMagicLink(t, userID)
+
+		_, _, err := s.CreateMagicLink(ctx, userID, "phone")
+		require.ErrorIs(t, err, core.ErrRateLimited)
+
+		// Other methods are throttled separately.
+		c, token, code := s.NewEmailOTP(userID, "phone")
+		require.NoError(t, s.CreateEmailOTP(ctx, c, token, code))
+
+		c, token, code = s.NewEmailOTP(userID, "phone")
+		require.ErrorIs(t, s.CreateEmailOTP(ctx, c, token, code), core.ErrRateLimited)
+
+		// Once the resend interval passed, a new challenge replaces the pending one.
+		setNow(now.Add(2 * time.Minute))
+		second := createMagicLink(t, userID)
+
+		_, err = s.VerifyMagicLink(ctx, first)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+
+		_, err = s.VerifyMagicLink(ctx, second)
+		require.NoError(t, err)
+	})
+}
+
+func TestNewCode(t *testing.T) {
+	seen := make(map[string]bool)
+
+	for range 100 {
+		code := newCode()
+		require.Regexp(t, regexp.MustCompile(`^[0-9]{6}$`), code)
+		seen[code] = true
+	}
+
+	require.Greater(t, len(seen), 90)
+}
+
+func TestCodeHash(t *testing.T) {
+	require.Equal(t, codeHash("token", "123456"), codeHash("token", "123456"))
+	require.NotEqual(t, codeHash("token", "123456"), codeHash("token", "123457"))
+	require.NotEqual(t, codeHash("token", "123456"), codeHash("other", "123456"))
+}
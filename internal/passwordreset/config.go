@@ -0,0 +1,30 @@
+package passwordreset
+
+import (
+	"errors"
+	"net/url"
+	"time"
+)
+
+type Config struct {
+	URL            string        `help:"URL of the page which completes password resets. The token is added as token query parameter." name:"url" env:"URL"`
+	TTL            time.Duration `help:"Duration in which a password reset has to be completed." name:"ttl" env:"TTL" default:"15m"`
+	ResendInterval time.Duration `help:"Minimum duration between two password reset emails to a user." name:"resend_interval" env:"RESEND_INTERVAL" default:"1m"`
+}
+
+func (c Config) validate() error {
+	u, err := url.Parse(c.URL)
+	if err != nil || !u.IsAbs() {
+		return errors.New("passwordreset: URL must be an absolute URL")
+	}
+
+	if c.TTL <= 0 {
+		return errors.New("passwordreset: TTL cannot be zero or negative")
+	}
+
+	if c.ResendInterval < 0 {
+		return errors.New("passwordreset: ResendInterval cannot be negative")
+	}
+
+	return nil
+}
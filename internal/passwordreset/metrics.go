@@ -0,0 +1,55 @@
+package passwordreset
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Reasons of failed password resets.
+const (
+	failedInvalid = "invalid"
+	failedExpired = "expired"
+	failedUsed    = "used"
+)
+
+type metrics struct {
+	requested prometheus.Counter
+	completed prometheus.Counter
+	failed    *prometheus.CounterVec
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		requested: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "guardian",
+			Subsystem: "password_reset",
+			Name:      "requested_total",
+			Help:      "The cumulative count of password resets created for users.",
+		}),
+		completed: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "guardian",
+			Subsystem: "password_reset",
+			Name:      "completed_total",
+			Help:      "The cumulative count of completed password resets.",
+		}),
+		failed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "guardian",
+			Subsystem: "password_reset",
+			Name:      "failed_total",
+			Help:      "The cumulative count of password reset attempts with unusable tokens labeled by reason.",
+		}, []string{"reason"}),
+	}
+}
+
+// Describe implements [prometheus.Collector].
+func (m *metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.requested.Describe(ch)
+	m.completed.Describe(ch)
+	m.failed.Describe(ch)
+}
+
+// Collect implements [prometheus.Collector].
+func (m *metrics) Collect(ch chan<- prometheus.Metric) {
+	m.requested.Collect(ch)
+	m.completed.Collect(ch)
+	m.failed.Collect(ch)
+}
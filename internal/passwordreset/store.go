@@ -0,0 +1,173 @@
+// Package passwordreset resets forgotten passwords with single-use tokens sent to the email address of users.
+package passwordreset
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/internal/db/queries"
+	"github.com/gophero/guardian/internal/secret"
+)
+
+// Store is a postgres backed [core.PasswordResetStore]. It is also a [prometheus.Collector] exporting password reset
+// metrics.
+type Store struct {
+	config Config
+	link   *url.URL
+	pool   *pgxpool.Pool
+	q      *queries.Queries
+	now    func() time.Time
+
+	*metrics
+}
+
+var (
+	_ core.PasswordResetStore = (*Store)(nil)
+	_ prometheus.Collector    = (*Store)(nil)
+)
+
+// NewStore constructs new [Store].
+func NewStore(pool *pgxpool.Pool, config Config) (*Store, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	link, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("passwordreset: parse url: %w", err)
+	}
+
+	return &Store{
+		config:  config,
+		link:    link,
+		pool:    pool,
+		q:       queries.New(pool),
+		now:     time.Now,
+		metrics: newMetrics(),
+	}, nil
+}
+
+// Create implements [core.PasswordResetStore].
+func (s *Store) Create(ctx context.Context, userID uuid.UUID) (core.PasswordReset, string, error) {
+	token := secret.New(secret.DefaultSize)
+	now := s.now()
+
+	var r queries.PasswordReset
+
+	err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		q := s.q.WithTx(tx)
+
+		n, err := q.CountPasswordResetsSince(ctx, queries.CountPasswordResetsSinceParams{
+			UserID: userID,
+			Since:  now.Add(-s.config.ResendInterval),
+		})
+		if err != nil {
+			return fmt.Errorf("passwordreset: count password resets: %w", err)
+		}
+
+		if n > 0 {
+			return core.ErrRateLimited
+		}
+
+		if _, err := q.DeletePendingPasswordResets(ctx, userID); err != nil {
+			return fmt.Errorf("passwordreset: delete pending password resets: %w", err)
+		}
+
+		r, err = q.CreatePasswordReset(ctx, queries.CreatePasswordResetParams{
+			UserID:    userID,
+			TokenHash: secret.Hash(token),
+			ExpiresAt: now.Add(s.config.TTL),
+		})
+		if err != nil {
+			if db.IsForeignKeyViolation(err) {
+				return fmt.Errorf("passwordreset: create password reset: %w", core.ErrNotFound)
+			}
+			return fmt.Errorf("passwordreset: create password reset: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return core.PasswordReset{}, "", err
+	}
+
+	s.requested.Inc()
+
+	link := *s.link
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	return toReset(r), link.String(), nil
+}
+
+// Get implements [core.PasswordResetStore].
+func (s *Store) Get(ctx context.Context, token string) (core.PasswordReset, error) {
+	r, err := s.q.GetPasswordResetByTokenHash(ctx, secret.Hash(token))
+	if errors.Is(err, pgx.ErrNoRows) {
+		s.failed.WithLabelValues(failedInvalid).Inc()
+		return core.PasswordReset{}, core.ErrInvalidToken
+	}
+
+	if err != nil {
+		return core.PasswordReset{}, fmt.Errorf("passwordreset: get password reset by token hash: %w", err)
+	}
+
+	switch {
+	case r.UsedAt != nil:
+		s.failed.WithLabelValues(failedUsed).Inc()
+		return core.PasswordReset{}, core.ErrInvalidToken
+	case !r.ExpiresAt.After(s.now()):
+		s.failed.WithLabelValues(failedExpired).Inc()
+		return core.PasswordReset{}, core.ErrInvalidToken
+	}
+
+	return toReset(r), nil
+}
+
+// Complete implements [core.PasswordResetStore].
+func (s *Store) Complete(ctx context.Context, id uuid.UUID) error {
+	n, err := s.q.UsePasswordReset(ctx, id)
+	if err != nil {
+		return fmt.Errorf("passwordreset: use password reset: %w", err)
+	}
+
+	// The reset was used concurrently or expired in the meantime.
+	if n == 0 {
+		s.failed.WithLabelValues(failedUsed).Inc()
+		return core.ErrInvalidToken
+	}
+
+	s.completed.Inc()
+
+	return nil
+}
+
+// DeleteExpired deletes expired resets.
+func (s *Store) DeleteExpired(ctx context.Context) (int64, error) {
+	n, err := s.q.DeleteExpiredPasswordResets(ctx, s.now())
+	if err != nil {
+		return 0, fmt.Errorf("passwordreset: delete expired password resets: %w", err)
+	}
+
+	return n, nil
+}
+
+func toReset(r queries.PasswordReset) core.PasswordReset {
+	return core.PasswordReset{
+		ID:        r.ID,
+		UserID:    r.UserID,
+		CreatedAt: r.CreatedAt,
+		ExpiresAt: r.ExpiresAt,
+	}
+}
@@ -0,0 +1,216 @@
+package passwordreset
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db/dbtest"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	pool := dbtest.Pool(t)
+	q := queries.New(pool)
+
+	// Completing checks expiry against the clock of the database, the clock of the store sets deadlines and throttles.
+	s, err := NewStore(pool, Config{
+		URL:            "https://example.com/reset-password?source=email",
+		TTL:            15 * time.Minute,
+		ResendInterval: time.Minute,
+	})
+	require.NoError(t, err)
+
+	setNow := func(t time.Time) { s.now = func() time.Time { return t } }
+
+	newUser := func(t *testing.T) uuid.UUID {
+		t.Helper()
+
+		suffix := uuid.NewString()[:8]
+
+		user, err := q.CreateUser(ctx, queries.CreateUserParams{
+			Email:    "passwordreset-" + suffix + "@example.com",
+			Username: "passwordreset-" + suffix,
+			Status:   queries.UserStatusActive,
+		})
+		require.NoError(t, err)
+
+		return user.ID
+	}
+
+	// create creates a reset for the user and returns it with the token embedded in its link.
+	create := func(t *testing.T, userID uuid.UUID) (core.PasswordReset, string) {
+		t.Helper()
+
+		r, link, err := s.Create(ctx, userID)
+		require.NoError(t, err)
+
+		u, err := url.Parse(link)
+		require.NoError(t, err)
+		require.Equal(t, "email", u.Query().Get("source"))
+
+		token := u.Query().Get("token")
+		require.NotEmpty(t, token)
+
+		return r, token
+	}
+
+	// collect returns the value of the counter described by desc with the label values, zero if it was not collected.
+	collect := func(t *testing.T, desc string, labelValues ...string) float64 {
+		t.Helper()
+
+		ch := make(chan prometheus.Metric, 16)
+		s.Collect(ch)
+		close(ch)
+
+	metrics:
+		for m := range ch {
+			var out dto.Metric
+			require.NoError(t, m.Write(&out))
+
+			if m.Desc().String() != desc || len(out.GetLabel()) != len(labelValues) {
+				continue
+			}
+
+			for i, l := range out.GetLabel() {
+				if l.GetValue() != labelValues[i] {
+					continue metrics
+				}
+			}
+
+			return out.GetCounter().GetValue()
+		}
+
+		return 0
+	}
+
+	requestedDesc := s.requested.Desc().String()
+	completedDesc := s.completed.Desc().String()
+	failedDesc := s.failed.WithLabelValues(failedInvalid).Desc().String()
+
+	t.Run("completes resets once", func(t *testing.T) {
+		userID := newUser(t)
+		setNow(time.Now())
+
+		r, token := create(t, userID)
+		require.Equal(t, userID, r.UserID)
+
+		got, err := s.Get(ctx, token)
+		require.NoError(t, err)
+		require.Equal(t, r.ID, got.ID)
+		require.Equal(t, userID, got.UserID)
+
+		require.NoError(t, s.Complete(ctx, r.ID))
+
+		_, err = s.Get(ctx, token)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+
+		require.ErrorIs(t, s.Complete(ctx, r.ID), core.ErrInvalidToken)
+	})
+
+	t.Run("throttles resets of a user", func(t *testing.T) {
+		userID := newUser(t)
+		now := time.Now()
+		setNow(now)
+
+		_, first := create(t, userID)
+
+		_, _, err := s.Create(ctx, userID)
+		require.ErrorIs(t, err, core.ErrRateLimited)
+
+		// The throttled request keeps the pending reset.
+		_, err = s.Get(ctx, first)
+		require.NoError(t, err)
+
+		// Other users are not throttled.
+		create(t, newUser(t))
+
+		// Once the resend interval passed, a new reset replaces the pending one.
+		setNow(now.Add(2 * time.Minute))
+		_, second := create(t, userID)
+
+		_, err = s.Get(ctx, first)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+
+		_, err = s.Get(ctx, second)
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects expired and unknown tokens", func(t *testing.T) {
+		userID := newUser(t)
+
+		setNow(time.Now().Add(-15*time.Minute - time.Minute))
+		r, token := create(t, userID)
+
+		setNow(time.Now())
+		_, err := s.Get(ctx, token)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+
+		require.ErrorIs(t, s.Complete(ctx, r.ID), core.ErrInvalidToken)
+
+		_, err = s.Get(ctx, "unknown")
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+
+		n, err := s.DeleteExpired(ctx)
+		require.NoError(t, err)
+		require.Positive(t, n)
+	})
+
+	t.Run("rejects unknown users", func(t *testing.T) {
+		setNow(time.Now())
+
+		_, _, err := s.Create(ctx, uuid.New())
+		require.ErrorIs(t, err, core.ErrNotFound)
+	})
+
+	t.Run("exports metrics", func(t *testing.T) {
+		userID := newUser(t)
+		now := time.Now()
+		setNow(now)
+
+		requested := collect(t, requestedDesc)
+		completed := collect(t, completedDesc)
+		invalid := collect(t, failedDesc, failedInvalid)
+		used := collect(t, failedDesc, failedUsed)
+		expired := collect(t, failedDesc, failedExpired)
+
+		r, token := create(t, userID)
+		require.Equal(t, requested+1, collect(t, requestedDesc))
+
+		// Throttled requests are not counted.
+		_, _, err := s.Create(ctx, userID)
+		require.ErrorIs(t, err, core.ErrRateLimited)
+		require.Equal(t, requested+1, collect(t, requestedDesc))
+
+		require.NoError(t, s.Complete(ctx, r.ID))
+		require.Equal(t, completed+1, collect(t, completedDesc))
+
+		_, err = s.Get(ctx, token)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+		require.Equal(t, used+1, collect(t, failedDesc, failedUsed))
+
+		require.ErrorIs(t, s.Complete(ctx, r.ID), core.ErrInvalidToken)
+		require.Equal(t, used+2, collect(t, failedDesc, failedUsed))
+
+		_, err = s.Get(ctx, "unknown")
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+		require.Equal(t, invalid+1, collect(t, failedDesc, failedInvalid))
+
+		setNow(now.Add(-15*time.Minute - time.Minute))
+		_, token = create(t, newUser(t))
+
+		setNow(now)
+		_, err = s.Get(ctx, token)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+		require.Equal(t, expired+1, collect(t, failedDesc, failedExpired))
+		require.Equal(t, completed+1, collect(t, completedDesc))
+	})
+}
@@ -0,0 +1,108 @@
+package rbac
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type cacheKey struct {
+	subject uuid.UUID
+	scope   uuid.UUID
+}
+
+// grant is an assignment granting a permission. Grants with a condition only apply while it holds.
+type grant struct {
+	policy     string
+	condition  string
+	expression string
+}
+
+// grants maps permissions to the assignments granting them, unconditional grants first.
+type grants map[string][]grant
+
+type cacheEntry struct {
+	grants    grants
+	expiresAt time.Time
+}
+
+// cache holds the grants of effective permissions per subject and scope. Every invalidation starts a new generation, and entries
+// loaded in an older generation are not stored, so a load racing with a change cannot cache outdated permissions.
+type cache struct {
+	ttl  time.Duration
+	size int
+	now  func() time.Time
+
+	mu         sync.Mutex
+	generation uint64
+	entries    map[cacheKey]cacheEntry
+}
+
+func newCache(ttl time.Duration, size int) *cache {
+	return &cache{ttl: ttl, size: size, now: time.Now, entries: map[cacheKey]cacheEntry{}}
+}
+
+// get returns the cached grants of key and the current generation to pass to [cache.put].
+func (c *cache) get(key cacheKey) (grants, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if ok && !e.expiresAt.After(c.now()) {
+		delete(c.entries, key)
+		ok = false
+	}
+
+	return e.grants, c.generation, ok
+}
+
+// put caches grants of key unless the cache was invalidated since generation.
+func (c *cache) put(key cacheKey, g grants, generation uint64) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+
+	now := c.now()
+
+	if len(c.entries) >= c.size {
+		for k, e := range c.entries {
+			if !e.expiresAt.After(now) {
+				delete(c.entries, k)
+			}
+		}
+
+		// Start over rather than tracking usage, permissions are cheap to reload.
+		if len(c.entries) >= c.size {
+			clear(c.entries)
+		}
+	}
+
+	c.entries[key] = cacheEntry{grants: g, expiresAt: now.Add(c.ttl)}
+}
+
+// invalidate drops the cached grants of subject, or of all subjects if subject is [uuid.Nil].
+func (c *cache) invalidate(subject uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+
+	if subject == uuid.Nil {
+		clear(c.entries)
+		return
+	}
+
+	for k := range c.entries {
+		if k.subject == subject {
+			delete(c.entries, k)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package rbac
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	now := time.Now()
+	c := newCache(time.Minute, 2)
+	c.now = func() time.Time { return now }
+
+	alice := cacheKey{subject: uuid.New()}
+	bob := cacheKey{subject: uuid.New(), scope: uuid.New()}
+	permissions := grants{"docs.read": {{policy: "role:viewer"}}}
+
+	_, generation, ok := c.get(alice)
+	require.False(t, ok)
+
+	c.put(alice, permissions, generation)
+	got, _, ok := c.get(alice)
+	require.True(t, ok)
+	require.Equal(t, permissions, got)
+
+	// A load which started before an invalidation must not be cached.
+	_, generation, _ = c.get(bob)
+	c.invalidate(alice.subject)
+	c.put(bob, permissions, generation)
+	_, _, ok = c.get(bob)
+	require.False(t, ok)
+
+	_, _, ok = c.get(alice)
+	require.False(t, ok)
+
+	// Invalidating a subject keeps the entries of other subjects.
+	_, generation, _ = c.get(alice)
+	c.put(alice, permissions, generation)
+	c.put(bob, permissions, generation)
+	c.invalidate(uuid.New())
+	_, _, ok = c.get(alice)
+	require.True(t, ok)
+
+	c.invalidate(uuid.Nil)
+	_, _, ok = c.get(bob)
+	require.False(t, ok)
+
+	_, generation, _ = c.get(alice)
+	c.put(alice, permissions, generation)
+	now = now.Add(time.Minute)
+	_, _, ok = c.get(alice)
+	require.False(t, ok)
+}
+
+func TestCacheSize(t *testing.T) {
+	c := newCache(time.Minute, 2)
+
+	for range 5 {
+		_, generation, _ := c.get(cacheKey{})
+		c.put(cacheKey{subject: uuid.New()}, nil, generation)
+		require.LessOrEqual(t, len(c.entries), 2)
+	}
+}
+
+func TestCacheDisabled(t *testing.T) {
+	c := newCache(0, 0)
+
+	_, generation, _ := c.get(cacheKey{})
+	c.put(cacheKey{}, nil, generation)
+	require.Empty(t, c.entries)
+}
@@ -0,0 +1,23 @@
+package rbac
+
+import (
+	"errors"
+	"time"
+)
+
+type Config struct {
+	CacheTTL  time.Duration `help:"Duration for which effective permissions are cached. Changes made through other instances are seen after at most this duration. Zero disables the cache." name:"cache_ttl" env:"CACHE_TTL" default:"30s"`
+	CacheSize int           `help:"Maximum number of cached effective permission sets." name:"cache_size" env:"CACHE_SIZE" default:"10000"`
+}
+
+func (c Config) validate() error {
+	if c.CacheTTL < 0 {
+		return errors.New("rbac: CacheTTL cannot be negative")
+	}
+
+	if c.CacheTTL > 0 && c.CacheSize <= 0 {
+		return errors.New("rbac: CacheSize cannot be zero or negative")
+	}
+
+	return nil
+}
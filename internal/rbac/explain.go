@@ -0,0 +1,97 @@
+package rbac
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+// explain builds the explanation of a permission check from the roles reached by the assignments of the subject,
+// ordered like [queries.Queries.ExplainPermission] returns them. evaluate explains the condition of an assignment.
+// Like [Store.Check], the first unconditional assignment granting the permission decides, or else the first
+// conditional one whose condition holds.
+func explain(permission string, rows []queries.ExplainPermissionRow, evaluate func(condition, expression string) core.ExplainNode) core.Explanation {
+	e := core.Explanation{Tree: core.ExplainNode{Kind: core.ExplainPermission, Name: permission}}
+
+	for i := 0; i < len(rows); {
+		n := 1
+		for i+n < len(rows) && rows[i+n].AssignmentID == rows[i].AssignmentID {
+			n++
+		}
+
+		node, d := explainAssignment(permission, rows[i:i+n], evaluate)
+		e.Tree.Children = append(e.Tree.Children, node)
+
+		if d.Allowed && !e.Decision.Allowed {
+			e.Decision = d
+		}
+
+		i += n
+	}
+
+	e.Tree.Allowed = e.Decision.Allowed
+
+	return e
+}
+
+// explainAssignment explains the rows of a single assignment.
+func explainAssignment(permission string, rows []queries.ExplainPermissionRow, evaluate func(condition, expression string) core.ExplainNode) (core.ExplainNode, core.Decision) {
+	a := rows[0]
+
+	roles, _ := explainRole(permission, rows)
+	node := core.ExplainNode{
+		Kind:     core.ExplainAssignment,
+		Name:     policy(a.RoleName, a.OrgID),
+		Allowed:  roles.Allowed,
+		Children: []core.ExplainNode{roles},
+	}
+
+	// Conditions are only evaluated for assignments granting the permission, like in checks.
+	if roles.Allowed && a.Condition.Valid {
+		condition := evaluate(a.Condition.String, a.Expression.String)
+		node.Children = append(node.Children, condition)
+		node.Allowed = condition.Allowed
+	}
+
+	if !node.Allowed {
+		return node, core.Decision{}
+	}
+
+	d := core.Decision{Allowed: true, Policy: node.Name}
+	if a.Condition.Valid {
+		d.Conditions = []string{a.Condition.String}
+	}
+
+	return node, d
+}
+
+// explainRole explains the role at the path of rows[0], whose inherited roles are the following rows with longer
+// paths. It returns the number of rows it explained.
+func explainRole(permission string, rows []queries.ExplainPermissionRow) (core.ExplainNode, int) {
+	r := rows[0]
+
+	node := core.ExplainNode{Kind: core.ExplainRole, Name: r.RoleName, Allowed: r.Grants}
+	if r.Grants {
+		node.Detail = "grants " + permission
+	}
+
+	n := 1
+	for n < len(rows) && len(rows[n].Path) > len(r.Path) {
+		parent, used := explainRole(permission, rows[n:])
+		node.Children = append(node.Children, parent)
+		node.Allowed = node.Allowed || parent.Allowed
+		n += used
+	}
+
+	return node, n
+}
+
+// policy names the assignment of a role, e.g. `role:editor@<org id>`.
+func policy(roleName string, orgID *uuid.UUID) string {
+	if orgID == nil {
+		return "role:" + roleName
+	}
+
+	return "role:" + roleName + "@" + orgID.String()
+}
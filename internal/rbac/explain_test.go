@@ -0,0 +1,88 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+func TestExplain(t *testing.T) {
+	editor, viewer, reader := uuid.New(), uuid.New(), uuid.New()
+	global, scoped := uuid.New(), uuid.New()
+	org := uuid.New()
+	office := pgtype.Text{String: "office", Valid: true}
+	inOffice := pgtype.Text{String: "ip.inCidr('10.0.0.0/8')", Valid: true}
+
+	// editor inherits from viewer, which inherits from reader granting the permission.
+	rows := []queries.ExplainPermissionRow{
+		{AssignmentID: global, Path: []uuid.UUID{viewer}, RoleName: "viewer"},
+		{AssignmentID: global, Path: []uuid.UUID{viewer, reader}, RoleName: "reader", Grants: true},
+		{AssignmentID: scoped, OrgID: &org, Condition: office, Expression: inOffice, Path: []uuid.UUID{editor}, RoleName: "editor"},
+		{AssignmentID: scoped, OrgID: &org, Condition: office, Expression: inOffice, Path: []uuid.UUID{editor, viewer}, RoleName: "viewer"},
+		{
+			AssignmentID: scoped,
+			OrgID:        &org,
+			Condition:    office,
+			Expression:   inOffice,
+			Path:         []uuid.UUID{editor, viewer, reader},
+			RoleName:     "reader",
+			Grants:       true,
+		},
+	}
+
+	holds := func(result bool) func(condition, expression string) core.ExplainNode {
+		return func(condition, expression string) core.ExplainNode {
+			return core.ExplainNode{Kind: core.ExplainCondition, Name: condition, Allowed: result, Detail: expression}
+		}
+	}
+
+	reading := core.ExplainNode{
+		Kind:     core.ExplainRole,
+		Name:     "viewer",
+		Allowed:  true,
+		Children: []core.ExplainNode{{Kind: core.ExplainRole, Name: "reader", Allowed: true, Detail: "grants docs.read"}},
+	}
+
+	t.Run("unconditional assignment decides", func(t *testing.T) {
+		e := explain("docs.read", rows, holds(true))
+
+		require.Equal(t, core.Decision{Allowed: true, Policy: "role:viewer"}, e.Decision)
+		require.Equal(t, core.ExplainNode{
+			Kind:    core.ExplainPermission,
+			Name:    "docs.read",
+			Allowed: true,
+			Children: []core.ExplainNode{
+				{Kind: core.ExplainAssignment, Name: "role:viewer", Allowed: true, Children: []core.ExplainNode{reading}},
+				{
+					Kind:    core.ExplainAssignment,
+					Name:    "role:editor@" + org.String(),
+					Allowed: true,
+					Children: []core.ExplainNode{
+						{Kind: core.ExplainRole, Name: "editor", Allowed: true, Children: []core.ExplainNode{reading}},
+						{Kind: core.ExplainCondition, Name: "office", Allowed: true, Detail: "ip.inCidr('10.0.0.0/8')"},
+					},
+				},
+			},
+		}, e.Tree)
+	})
+
+	t.Run("conditional assignment decides", func(t *testing.T) {
+		e := explain("docs.read", rows[2:], holds(true))
+		require.Equal(t, core.Decision{Allowed: true, Policy: "role:editor@" + org.String(), Conditions: []string{"office"}}, e.Decision)
+
+		e = explain("docs.read", rows[2:], holds(false))
+		require.Equal(t, core.Decision{}, e.Decision)
+		require.False(t, e.Tree.Allowed)
+		require.True(t, e.Tree.Children[0].Children[0].Allowed) // The roles grant the permission.
+	})
+
+	t.Run("no assignments", func(t *testing.T) {
+		e := explain("docs.read", nil, holds(true))
+		require.Equal(t, core.Explanation{Tree: core.ExplainNode{Kind: core.ExplainPermission, Name: "docs.read"}}, e)
+	})
+}
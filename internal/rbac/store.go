@@ -0,0 +1,553 @@
+// Package rbac implements role-based access control with hierarchical roles and organization scoped assignments.
+package rbac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/abac"
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+// Store is a postgres backed [core.RBACStore]. Effective permissions are cached in memory and invalidated by changes
+// made through the store. Conditions of assignments are evaluated by engine on every check. Assignments scoped to an
+// organization are read and written in transactions restricted to it by row-level security, see [db.BeginTenantFunc].
+type Store struct {
+	config Config
+	pool   *pgxpool.Pool
+	q      *queries.Queries
+	cache  *cache
+	engine *abac.Engine
+}
+
+var _ core.RBACStore = (*Store)(nil)
+
+// NewStore constructs new [Store].
+func NewStore(pool *pgxpool.Pool, config Config, engine *abac.Engine) (*Store, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		config: config,
+		pool:   pool,
+		q:      queries.New(pool),
+		cache:  newCache(config.CacheTTL, config.CacheSize),
+		engine: engine,
+	}, nil
+}
+
+// CreatePermission implements [core.RBACStore].
+func (s *Store) CreatePermission(ctx context.Context, name, description string) (core.Permission, error) {
+	if err := validatePermission(name); err != nil {
+		return core.Permission{}, err
+	}
+
+	p, err := s.q.CreatePermission(ctx, queries.CreatePermissionParams{Name: name, Description: description})
+	if err != nil {
+		return core.Permission{}, fmt.Errorf("rbac: create permission: %w", mapError(err))
+	}
+
+	return toPermission(p), nil
+}
+
+// ListPermissions implements [core.RBACStore].
+func (s *Store) ListPermissions(ctx context.Context) ([]core.Permission, error) {
+	ps, err := s.q.ListPermissions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("rbac: list permissions: %w", err)
+	}
+
+	permissions := make([]core.Permission, 0, len(ps))
+	for _, p := range ps {
+		permissions = append(permissions, toPermission(p))
+	}
+
+	return permissions, nil
+}
+
+// DeletePermission implements [core.RBACStore].
+func (s *Store) DeletePermission(ctx context.Context, name string) error {
+	n, err := s.q.DeletePermission(ctx, name)
+	if err != nil {
+		return fmt.Errorf("rbac: delete permission: %w", err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("rbac: delete permission: %w", core.ErrNotFound)
+	}
+
+	s.cache.invalidate(uuid.Nil)
+
+	return nil
+}
+
+// CreateRole implements [core.RBACStore].
+func (s *Store) CreateRole(ctx context.Context, params core.CreateRoleParams) (core.Role, error) {
+	if err := validateRoleName(params.Name); err != nil {
+		return core.Role{}, err
+	}
+
+	r, err := s.q.CreateRole(ctx, queries.CreateRoleParams{Name: params.Name, Description: params.Description})
+	if err != nil {
+		return core.Role{}, fmt.Errorf("rbac: create role: %w", mapError(err))
+	}
+
+	return toRole(r, nil, nil), nil
+}
+
+// GetRole implements [core.RBACStore].
+func (s *Store) GetRole(ctx context.Context, id uuid.UUID) (core.Role, error) {
+	r, err := s.q.GetRoleByID(ctx, id)
+	if err != nil {
+		return core.Role{}, fmt.Errorf("rbac: get role by id: %w", mapError(err))
+	}
+
+	roles, err := s.withGrants(ctx, []queries.Role{r})
+	if err != nil {
+		return core.Role{}, err
+	}
+
+	return roles[0], nil
+}
+
+// ListRoles implements [core.RBACStore].
+func (s *Store) ListRoles(ctx context.Context) ([]core.Role, error) {
+	rs, err := s.q.ListRoles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("rbac: list roles: %w", err)
+	}
+
+	return s.withGrants(ctx, rs)
+}
+
+// withGrants converts roles to [core.Role]s with their permissions and parents.
+func (s *Store) withGrants(ctx context.Context, rs []queries.Role) ([]core.Role, error) {
+	ids := make([]uuid.UUID, 0, len(rs))
+	for _, r := range rs {
+		ids = append(ids, r.ID)
+	}
+
+	grants, err := s.q.ListRolePermissions(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("rbac: list role permissions: %w", err)
+	}
+
+	parents, err := s.q.ListRoleParents(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("rbac: list role parents: %w", err)
+	}
+
+	permissionsByRole := map[uuid.UUID][]string{}
+	for _, g := range grants {
+		permissionsByRole[g.RoleID] = append(permissionsByRole[g.RoleID], g.Permission)
+	}
+
+	parentsByRole := map[uuid.UUID][]uuid.UUID{}
+	for _, p := range parents {
+		parentsByRole[p.RoleID] = append(parentsByRole[p.RoleID], p.ParentID)
+	}
+
+	roles := make([]core.Role, 0, len(rs))
+	for _, r := range rs {
+		roles = append(roles, toRole(r, permissionsByRole[r.ID], parentsByRole[r.ID]))
+	}
+
+	return roles, nil
+}
+
+// UpdateRole implements [core.RBACStore].
+func (s *Store) UpdateRole(ctx context.Context, id uuid.UUID, params core.UpdateRoleParams) (core.Role, error) {
+	arg := queries.UpdateRoleParams{ID: id}
+
+	if params.Name != nil {
+		if err := validateRoleName(*params.Name); err != nil {
+			return core.Role{}, err
+		}
+		arg.Name = pgtype.Text{String: *params.Name, Valid: true}
+	}
+
+	if params.Description != nil {
+		arg.Description = pgtype.Text{String: *params.Description, Valid: true}
+	}
+
+	r, err := s.q.UpdateRole(ctx, arg)
+	if err != nil {
+		return core.Role{}, fmt.Errorf("rbac: update role: %w", mapError(err))
+	}
+
+	roles, err := s.withGrants(ctx, []queries.Role{r})
+	if err != nil {
+		return core.Role{}, err
+	}
+
+	return roles[0], nil
+}
+
+// DeleteRole implements [core.RBACStore].
+func (s *Store) DeleteRole(ctx context.Context, id uuid.UUID) error {
+	n, err := s.q.DeleteRole(ctx, id)
+	if err != nil {
+		return fmt.Errorf("rbac: delete role: %w", err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("rbac: delete role: %w", core.ErrNotFound)
+	}
+
+	s.cache.invalidate(uuid.Nil)
+
+	return nil
+}
+
+// GrantPermission implements [core.RBACStore].
+func (s *Store) GrantPermission(ctx context.Context, roleID uuid.UUID, permission string) error {
+	if err := s.q.GrantRolePermission(ctx, queries.GrantRolePermissionParams{RoleID: roleID, Permission: permission}); err != nil {
+		return fmt.Errorf("rbac: grant role permission: %w", mapError(err))
+	}
+
+	s.cache.invalidate(uuid.Nil)
+
+	return nil
+}
+
+// RevokePermission implements [core.RBACStore].
+func (s *Store) RevokePermission(ctx context.Context, roleID uuid.UUID, permission string) error {
+	n, err := s.q.RevokeRolePermission(ctx, queries.RevokeRolePermissionParams{RoleID: roleID, Permission: permission})
+	if err != nil {
+		return fmt.Errorf("rbac: revoke role permission: %w", err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("rbac: revoke role permission: %w", core.ErrNotFound)
+	}
+
+	s.cache.invalidate(uuid.Nil)
+
+	return nil
+}
+
+// AddParent implements [core.RBACStore].
+func (s *Store) AddParent(ctx context.Context, roleID, parentID uuid.UUID) error {
+	if roleID == parentID {
+		return fmt.Errorf("rbac: role cannot inherit from itself: %w", core.ErrInvalidArgument)
+	}
+
+	err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		q := s.q.WithTx(tx)
+
+		if err := q.LockRoleParents(ctx); err != nil {
+			return fmt.Errorf("rbac: lock role parents: %w", err)
+		}
+
+		cycle, err := q.IsRoleAncestor(ctx, queries.IsRoleAncestorParams{RoleID: parentID, AncestorID: roleID})
+		if err != nil {
+			return fmt.Errorf("rbac: is role ancestor: %w", err)
+		}
+
+		if cycle {
+			return fmt.Errorf("rbac: role inheritance cycle: %w", core.ErrInvalidArgument)
+		}
+
+		if err := q.AddRoleParent(ctx, queries.AddRoleParentParams{RoleID: roleID, ParentID: parentID}); err != nil {
+			return fmt.Errorf("rbac: add role parent: %w", mapError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.cache.invalidate(uuid.Nil)
+
+	return nil
+}
+
+// RemoveParent implements [core.RBACStore].
+func (s *Store) RemoveParent(ctx context.Context, roleID, parentID uuid.UUID) error {
+	n, err := s.q.RemoveRoleParent(ctx, queries.RemoveRoleParentParams{RoleID: roleID, ParentID: parentID})
+	if err != nil {
+		return fmt.Errorf("rbac: remove role parent: %w", err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("rbac: remove role parent: %w", core.ErrNotFound)
+	}
+
+	s.cache.invalidate(uuid.Nil)
+
+	return nil
+}
+
+// Assign implements [core.RBACStore].
+func (s *Store) Assign(ctx context.Context, subject, roleID, scope uuid.UUID, condition string) (core.RoleAssignment, error) {
+	var a queries.RoleAssignment
+
+	err := s.inScope(ctx, scope, func(q *queries.Queries) error {
+		var err error
+
+		a, err = q.CreateRoleAssignment(ctx, queries.CreateRoleAssignmentParams{
+			RoleID:    roleID,
+			OrgID:     orgID(scope),
+			Condition: pgtype.Text{String: condition, Valid: condition != ""},
+			Subject:   subject,
+		})
+		if err != nil {
+			return fmt.Errorf("rbac: create role assignment: %w", mapError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return core.RoleAssignment{}, err
+	}
+
+	s.cache.invalidate(subject)
+
+	return toAssignment(a), nil
+}
+
+// Unassign implements [core.RBACStore].
+func (s *Store) Unassign(ctx context.Context, subject, roleID, scope uuid.UUID) error {
+	err := s.inScope(ctx, scope, func(q *queries.Queries) error {
+		n, err := q.DeleteRoleAssignment(ctx, queries.DeleteRoleAssignmentParams{
+			Subject: subject,
+			RoleID:  roleID,
+			OrgID:   orgID(scope),
+		})
+		if err != nil {
+			return fmt.Errorf("rbac: delete role assignment: %w", err)
+		}
+
+		if n == 0 {
+			return fmt.Errorf("rbac: delete role assignment: %w", core.ErrNotFound)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.cache.invalidate(subject)
+
+	return nil
+}
+
+// ListAssignments implements [core.RBACStore]. The assignments of all organizations are listed.
+func (s *Store) ListAssignments(ctx context.Context, subject uuid.UUID) ([]core.RoleAssignment, error) {
+	var as []queries.RoleAssignment
+
+	err := db.BeginBypassFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		var err error
+
+		as, err = s.q.WithTx(tx).ListRoleAssignmentsBySubject(ctx, subject)
+		if err != nil {
+			return fmt.Errorf("rbac: list role assignments by subject: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := make([]core.RoleAssignment, 0, len(as))
+	for _, a := range as {
+		assignments = append(assignments, toAssignment(a))
+	}
+
+	return assignments, nil
+}
+
+// Check implements [core.RBACStore].
+func (s *Store) Check(ctx context.Context, subject uuid.UUID, permission string, scope uuid.UUID, attrs core.AttributeContext) (core.Decision, error) {
+	g, err := s.grants(ctx, subject, scope)
+	if err != nil {
+		return core.Decision{}, err
+	}
+
+	return s.decide(ctx, g[permission], attrs), nil
+}
+
+// Explain implements [core.RBACStore].
+func (s *Store) Explain(ctx context.Context, subject uuid.UUID, permission string, scope uuid.UUID, attrs core.AttributeContext) (core.Explanation, error) {
+	var rows []queries.ExplainPermissionRow
+
+	err := s.inScope(ctx, scope, func(q *queries.Queries) error {
+		var err error
+
+		rows, err = q.ExplainPermission(ctx, queries.ExplainPermissionParams{
+			Subject:    subject,
+			OrgID:      orgID(scope),
+			Permission: permission,
+		})
+		if err != nil {
+			return fmt.Errorf("rbac: explain permission: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return core.Explanation{}, err
+	}
+
+	return explain(permission, rows, func(condition, expression string) core.ExplainNode {
+		return s.engine.Explain(ctx, condition, expression, attrs)
+	}), nil
+}
+
+// EffectivePermissions implements [core.RBACStore].
+func (s *Store) EffectivePermissions(ctx context.Context, subject, scope uuid.UUID, attrs core.AttributeContext) ([]string, error) {
+	g, err := s.grants(ctx, subject, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(g))
+	for p, pg := range g {
+		if s.decide(ctx, pg, attrs).Allowed {
+			names = append(names, p)
+		}
+	}
+	slices.Sort(names)
+
+	return names, nil
+}
+
+// decide returns the decision of the first grant which applies.
+func (s *Store) decide(ctx context.Context, pg []grant, attrs core.AttributeContext) core.Decision {
+	for _, g := range pg {
+		if g.condition == "" {
+			return core.Decision{Allowed: true, Policy: g.policy}
+		}
+
+		if s.engine.Holds(ctx, g.condition, g.expression, attrs) {
+			return core.Decision{Allowed: true, Policy: g.policy, Conditions: []string{g.condition}}
+		}
+	}
+
+	return core.Decision{}
+}
+
+// grants returns the grants of the effective permissions of subject in scope from the cache or resolves them.
+func (s *Store) grants(ctx context.Context, subject, scope uuid.UUID) (grants, error) {
+	key := cacheKey{subject: subject, scope: scope}
+
+	g, generation, ok := s.cache.get(key)
+	if ok {
+		return g, nil
+	}
+
+	var rows []queries.ListEffectivePermissionsRow
+
+	err := s.inScope(ctx, scope, func(q *queries.Queries) error {
+		var err error
+
+		rows, err = q.ListEffectivePermissions(ctx, queries.ListEffectivePermissionsParams{
+			Subject: subject,
+			OrgID:   orgID(scope),
+		})
+		if err != nil {
+			return fmt.Errorf("rbac: list effective permissions: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	g = grants{}
+	for _, r := range rows {
+		g[r.Permission] = append(g[r.Permission], grant{
+			policy:     policy(r.RoleName, r.OrgID),
+			condition:  r.Condition.String,
+			expression: r.Expression.String,
+		})
+	}
+
+	s.cache.put(key, g, generation)
+
+	return g, nil
+}
+
+// inScope runs fn in a transaction restricted to the organization of scope. Global assignments are visible without
+// one, so the global scope needs no transaction.
+func (s *Store) inScope(ctx context.Context, scope uuid.UUID, fn func(q *queries.Queries) error) error {
+	if scope == uuid.Nil {
+		return fn(s.q)
+	}
+
+	return db.BeginTenantFunc(ctx, s.pool, scope, func(tx pgx.Tx) error {
+		return fn(s.q.WithTx(tx))
+	})
+}
+
+// orgID returns the organization of scope, which is nil for the global scope.
+func orgID(scope uuid.UUID) *uuid.UUID {
+	if scope == uuid.Nil {
+		return nil
+	}
+
+	return &scope
+}
+
+func toPermission(p queries.Permission) core.Permission {
+	return core.Permission{Name: p.Name, Description: p.Description, CreatedAt: p.CreatedAt}
+}
+
+func toRole(r queries.Role, permissions []string, parentIDs []uuid.UUID) core.Role {
+	return core.Role{
+		ID:          r.ID,
+		Name:        r.Name,
+		Description: r.Description,
+		Permissions: permissions,
+		ParentIDs:   parentIDs,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
+
+func toAssignment(a queries.RoleAssignment) core.RoleAssignment {
+	assignment := core.RoleAssignment{
+		ID:        a.ID,
+		RoleID:    a.RoleID,
+		Condition: a.Condition.String,
+		CreatedAt: a.CreatedAt,
+	}
+	if a.UserID != nil {
+		assignment.UserID = *a.UserID
+	}
+	if a.ServiceAccountID != nil {
+		assignment.ServiceAccountID = *a.ServiceAccountID
+	}
+	if a.OrgID != nil {
+		assignment.OrgID = *a.OrgID
+	}
+
+	return assignment
+}
+
+func mapError(err error) error {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return core.ErrNotFound
+	case db.IsUniqueViolation(err):
+		return core.ErrAlreadyExists
+	case db.IsForeignKeyViolation(err):
+		return core.ErrNotFound
+	default:
+		return err
+	}
+}
@@ -0,0 +1,196 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/abac"
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/internal/db/dbtest"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	pool := dbtest.Pool(t)
+	q := queries.New(pool)
+
+	engine, err := abac.NewEngine(abac.Config{CostLimit: 10000, MaxExpressionLength: 4096, CacheSize: 100})
+	require.NoError(t, err)
+
+	// Effective permissions are cached longer than the test runs, so changes are only seen through invalidations.
+	s, err := NewStore(pool, Config{CacheTTL: time.Hour, CacheSize: 100}, engine)
+	require.NoError(t, err)
+
+	// newName returns a name no other test uses, as tests may share the database.
+	newName := func(name string) string {
+		return "rbac-" + uuid.NewString()[:8] + "." + name
+	}
+
+	newUser := func(t *testing.T) uuid.UUID {
+		t.Helper()
+
+		suffix := uuid.NewString()[:8]
+
+		user, err := q.CreateUser(ctx, queries.CreateUserParams{
+			Email:    "rbac-" + suffix + "@example.com",
+			Username: "rbac-" + suffix,
+			Status:   queries.UserStatusActive,
+		})
+		require.NoError(t, err)
+
+		return user.ID
+	}
+
+	// newRole creates a role granted a new permission, which is returned with it.
+	newRole := func(t *testing.T) (core.Role, string) {
+		t.Helper()
+
+		permission, err := s.CreatePermission(ctx, newName("use"), "")
+		require.NoError(t, err)
+
+		role, err := s.CreateRole(ctx, core.CreateRoleParams{Name: newName("role")})
+		require.NoError(t, err)
+
+		require.NoError(t, s.GrantPermission(ctx, role.ID, permission.Name))
+
+		return role, permission.Name
+	}
+
+	effective := func(t *testing.T, subject, scope uuid.UUID) []string {
+		t.Helper()
+
+		permissions, err := s.EffectivePermissions(ctx, subject, scope, core.AttributeContext{})
+		require.NoError(t, err)
+
+		return permissions
+	}
+
+	t.Run("inherits permissions over several levels", func(t *testing.T) {
+		user := newUser(t)
+		viewer, view := newRole(t)
+		editor, edit := newRole(t)
+		admin, administer := newRole(t)
+
+		require.NoError(t, s.AddParent(ctx, editor.ID, viewer.ID))
+		require.NoError(t, s.AddParent(ctx, admin.ID, editor.ID))
+
+		_, err := s.Assign(ctx, user, admin.ID, uuid.Nil, "")
+		require.NoError(t, err)
+
+		require.ElementsMatch(t, []string{view, edit, administer}, effective(t, user, uuid.Nil))
+
+		decision, err := s.Check(ctx, user, view, uuid.Nil, core.AttributeContext{})
+		require.NoError(t, err)
+		require.True(t, decision.Allowed)
+
+		explanation, err := s.Explain(ctx, user, view, uuid.Nil, core.AttributeContext{})
+		require.NoError(t, err)
+		require.True(t, explanation.Decision.Allowed)
+	})
+
+	t.Run("rejects inheritance cycles", func(t *testing.T) {
+		a, _ := newRole(t)
+		b, _ := newRole(t)
+		c, _ := newRole(t)
+
+		require.ErrorIs(t, s.AddParent(ctx, a.ID, a.ID), core.ErrInvalidArgument)
+
+		require.NoError(t, s.AddParent(ctx, b.ID, a.ID))
+		require.NoError(t, s.AddParent(ctx, c.ID, b.ID))
+
+		ancestor, err := q.IsRoleAncestor(ctx, queries.IsRoleAncestorParams{RoleID: c.ID, AncestorID: a.ID})
+		require.NoError(t, err)
+		require.True(t, ancestor)
+
+		ancestor, err = q.IsRoleAncestor(ctx, queries.IsRoleAncestorParams{RoleID: a.ID, AncestorID: c.ID})
+		require.NoError(t, err)
+		require.False(t, ancestor)
+
+		// a would inherit from c, which inherits from a through b.
+		require.ErrorIs(t, s.AddParent(ctx, a.ID, c.ID), core.ErrInvalidArgument)
+		require.ErrorIs(t, s.AddParent(ctx, a.ID, b.ID), core.ErrInvalidArgument)
+
+		role, err := s.GetRole(ctx, a.ID)
+		require.NoError(t, err)
+		require.Empty(t, role.ParentIDs)
+	})
+
+	t.Run("revokes inherited permissions with the parent", func(t *testing.T) {
+		user := newUser(t)
+		viewer, view := newRole(t)
+		editor, edit := newRole(t)
+		admin, administer := newRole(t)
+
+		require.NoError(t, s.AddParent(ctx, editor.ID, viewer.ID))
+		require.NoError(t, s.AddParent(ctx, admin.ID, editor.ID))
+
+		_, err := s.Assign(ctx, user, admin.ID, uuid.Nil, "")
+		require.NoError(t, err)
+
+		require.ElementsMatch(t, []string{view, edit, administer}, effective(t, user, uuid.Nil))
+
+		// Removing the parent in the middle of the chain also drops what it inherits.
+		require.NoError(t, s.RemoveParent(ctx, admin.ID, editor.ID))
+		require.Equal(t, []string{administer}, effective(t, user, uuid.Nil))
+
+		require.ErrorIs(t, s.RemoveParent(ctx, admin.ID, editor.ID), core.ErrNotFound)
+
+		// The parent keeps its own parents.
+		role, err := s.GetRole(ctx, editor.ID)
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{viewer.ID}, role.ParentIDs)
+
+		// Revoking a permission of a parent is seen by the roles inheriting it.
+		require.NoError(t, s.AddParent(ctx, admin.ID, editor.ID))
+		require.NoError(t, s.RevokePermission(ctx, viewer.ID, view))
+		require.ElementsMatch(t, []string{edit, administer}, effective(t, user, uuid.Nil))
+
+		// Deleting a parent removes it from the inheritance graph.
+		require.NoError(t, s.DeleteRole(ctx, editor.ID))
+		require.Equal(t, []string{administer}, effective(t, user, uuid.Nil))
+	})
+
+	t.Run("scopes assignments to organizations", func(t *testing.T) {
+		user := newUser(t)
+		role, permission := newRole(t)
+
+		orgs := make([]queries.Organization, 2)
+		err := db.BeginBypassFunc(ctx, pool, func(tx pgx.Tx) error {
+			for i := range orgs {
+				var err error
+
+				orgs[i], err = q.WithTx(tx).CreateOrganization(ctx, queries.CreateOrganizationParams{
+					Slug: "rbac-" + uuid.NewString()[:8],
+					Name: "RBAC",
+				})
+				require.NoError(t, err)
+			}
+
+			return nil
+		})
+		require.NoError(t, err)
+
+		_, err = s.Assign(ctx, user, role.ID, orgs[0].ID, "")
+		require.NoError(t, err)
+
+		require.Equal(t, []string{permission}, effective(t, user, orgs[0].ID))
+		require.Empty(t, effective(t, user, orgs[1].ID))
+		require.Empty(t, effective(t, user, uuid.Nil))
+
+		assignments, err := s.ListAssignments(ctx, user)
+		require.NoError(t, err)
+		require.Len(t, assignments, 1)
+		require.Equal(t, orgs[0].ID, assignments[0].OrgID)
+
+		require.NoError(t, s.Unassign(ctx, user, role.ID, orgs[0].ID))
+		require.Empty(t, effective(t, user, orgs[0].ID))
+		require.ErrorIs(t, s.Unassign(ctx, user, role.ID, orgs[0].ID), core.ErrNotFound)
+	})
+}
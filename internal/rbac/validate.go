@@ -0,0 +1,28 @@
+package rbac
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/gophero/guardian/core"
+)
+
+var (
+	// Permissions are dot separated segments like `documents.read`.
+	permissionRe = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*(\.[a-z0-9][a-z0-9_-]*)*$`)
+	roleNameRe   = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,63}$`)
+)
+
+func validatePermission(name string) error {
+	if len(name) > 128 || !permissionRe.MatchString(name) {
+		return fmt.Errorf("rbac: invalid permission `%s`: %w", name, core.ErrInvalidArgument)
+	}
+	return nil
+}
+
+func validateRoleName(name string) error {
+	if !roleNameRe.MatchString(name) {
+		return fmt.Errorf("rbac: invalid role name `%s`: %w", name, core.ErrInvalidArgument)
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePermission(t *testing.T) {
+	for _, name := range []string{"docs", "docs.read", "guardian.authz.manage", "billing-v2.invoice_export"} {
+		require.NoError(t, validatePermission(name), name)
+	}
+
+	for _, name := range []string{"", "Docs.read", "docs.", ".docs", "docs..read", "docs read", "-docs"} {
+		require.Error(t, validatePermission(name), name)
+	}
+}
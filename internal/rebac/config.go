@@ -0,0 +1,20 @@
+package rebac
+
+import "errors"
+
+type Config struct {
+	MaxDepth          int `help:"Maximum number of relations followed to check or expand a relation." name:"max_depth" env:"MAX_DEPTH" default:"25"`
+	MaxListCandidates int `help:"Maximum number of objects checked to list objects or subjects. Pages end early once reached and continue with their cursor." name:"max_list_candidates" env:"MAX_LIST_CANDIDATES" default:"1000"`
+}
+
+func (c Config) validate() error {
+	if c.MaxDepth <= 0 {
+		return errors.New("rebac: MaxDepth cannot be zero or negative")
+	}
+
+	if c.MaxListCandidates <= 0 {
+		return errors.New("rebac: MaxListCandidates cannot be zero or negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,381 @@
+package rebac
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gophero/guardian/core"
+)
+
+// tupleReader reads relation tuples from a consistent snapshot and evaluates their conditions for a request.
+type tupleReader interface {
+	// tuple looks up a tuple by its identity and returns it with its condition.
+	tuple(ctx context.Context, t core.RelationTuple) (core.RelationTuple, bool, error)
+	// subjects lists the tuples relating object by relation, only those with userset subjects if onlyUsersets is set.
+	subjects(ctx context.Context, object core.ObjectRef, relation string, onlyUsersets bool) ([]core.RelationTuple, error)
+	// condition evaluates the condition of a tuple returned by the reader.
+	condition(ctx context.Context, t core.RelationTuple) core.ExplainNode
+}
+
+type checkKey struct {
+	object   core.ObjectRef
+	relation string
+	subject  core.SubjectRef
+}
+
+// evaluator evaluates the rewrites of a schema on the tuples of a reader. It is not safe for concurrent use, and
+// memoizes results so it should only be used for a single request. Checks are explained if tracer is set.
+type evaluator struct {
+	schema   *schema
+	reader   tupleReader
+	maxDepth int
+	tracer   *tracer
+
+	visiting map[checkKey]bool
+	memo     map[checkKey]core.Decision
+	cycles   int // Number of cycles hit, results depending on a cycle are not memoized.
+}
+
+func newEvaluator(s *schema, r tupleReader, maxDepth int) *evaluator {
+	return &evaluator{
+		schema:   s,
+		reader:   r,
+		maxDepth: maxDepth,
+		visiting: map[checkKey]bool{},
+		memo:     map[checkKey]core.Decision{},
+	}
+}
+
+// check decides whether subject has relation to object.
+func (e *evaluator) check(ctx context.Context, object core.ObjectRef, relation string, subject core.SubjectRef, depth int) (d core.Decision, err error) {
+	var detail string
+
+	e.tracer.push(core.ExplainRelation, object.String()+"#"+relation)
+	defer func() { e.tracer.pop(d.Allowed, detail) }()
+
+	if depth > e.maxDepth {
+		return core.Decision{}, fmt.Errorf("rebac: check exceeded the maximum depth of %d: %w", e.maxDepth, core.ErrInvalidArgument)
+	}
+
+	rel, err := e.schema.lookup(object.Namespace, relation)
+	if err != nil {
+		return core.Decision{}, err
+	}
+
+	// A userset contains itself.
+	if subject.Relation == relation && subject.Object() == object {
+		detail = "the subject is the userset"
+		return core.Decision{Allowed: true, Policy: subject.String()}, nil
+	}
+
+	key := checkKey{object: object, relation: relation, subject: subject}
+
+	if d, found := e.memo[key]; found {
+		detail = "evaluated before"
+		return d, nil
+	}
+
+	// Subjects do not have a relation through a path which leads back to the same question, e.g. nested groups
+	// containing each other.
+	if e.visiting[key] {
+		e.cycles++
+		detail = "cycle"
+		return core.Decision{}, nil
+	}
+
+	e.visiting[key] = true
+	cycles := e.cycles
+
+	d, err = e.rewrite(ctx, object, rel, rel.rewrite, subject, depth)
+
+	delete(e.visiting, key)
+
+	if err != nil {
+		return core.Decision{}, err
+	}
+
+	if e.cycles == cycles {
+		e.memo[key] = d
+	}
+
+	return d, nil
+}
+
+var explainKinds = map[rewriteKind]core.ExplainKind{
+	rewriteUnion:        core.ExplainUnion,
+	rewriteIntersection: core.ExplainIntersection,
+	rewriteExclusion:    core.ExplainExclusion,
+}
+
+func (e *evaluator) rewrite(ctx context.Context, object core.ObjectRef, rel *relationDef, r *rewrite, subject core.SubjectRef, depth int) (d core.Decision, err error) {
+	if r == nil {
+		return e.this(ctx, object, rel, subject, depth)
+	}
+
+	if kind, ok := explainKinds[r.kind]; ok {
+		e.tracer.push(kind, object.String()+"#"+rel.name)
+		defer func() { e.tracer.pop(d.Allowed, "") }()
+	}
+
+	switch r.kind {
+	case rewriteThis:
+		return e.this(ctx, object, rel, subject, depth)
+	case rewriteComputed:
+		return e.check(ctx, object, r.relation, subject, depth+1)
+	case rewriteTupleToUserset:
+		return e.follow(ctx, object, r.tupleset, false, r.relation, subject, depth)
+	case rewriteUnion:
+		for _, c := range r.children {
+			d, err := e.rewrite(ctx, object, rel, c, subject, depth)
+			if d.Allowed || err != nil {
+				return d, err
+			}
+		}
+
+		return core.Decision{}, nil
+	case rewriteIntersection:
+		var policies []string
+		var conditions []string
+
+		for _, c := range r.children {
+			d, err := e.rewrite(ctx, object, rel, c, subject, depth)
+			if !d.Allowed || err != nil {
+				return core.Decision{}, err
+			}
+
+			policies = append(policies, d.Policy)
+			conditions = append(conditions, d.Conditions...)
+		}
+
+		return core.Decision{Allowed: true, Policy: strings.Join(policies, " & "), Conditions: conditions}, nil
+	case rewriteExclusion:
+		d, err := e.rewrite(ctx, object, rel, r.children[0], subject, depth)
+		if !d.Allowed || err != nil {
+			return core.Decision{}, err
+		}
+
+		excluded, err := e.rewrite(ctx, object, rel, r.children[1], subject, depth)
+		if excluded.Allowed || err != nil {
+			return core.Decision{}, err
+		}
+
+		return d, nil
+	default:
+		return core.Decision{}, fmt.Errorf("rebac: unknown rewrite %d", r.kind)
+	}
+}
+
+// this checks the tuples written for the relation, following userset subjects.
+func (e *evaluator) this(ctx context.Context, object core.ObjectRef, rel *relationDef, subject core.SubjectRef, depth int) (core.Decision, error) {
+	d, err := e.direct(ctx, core.RelationTuple{Object: object, Relation: rel.name, Subject: subject})
+	if d.Allowed || err != nil {
+		return d, err
+	}
+
+	return e.follow(ctx, object, rel.name, true, "", subject, depth)
+}
+
+// direct looks up the tuple and decides whether it applies.
+func (e *evaluator) direct(ctx context.Context, t core.RelationTuple) (d core.Decision, err error) {
+	e.tracer.push(core.ExplainTuple, t.String())
+	defer func() { e.tracer.pop(d.Allowed, "") }()
+
+	t, ok, err := e.reader.tuple(ctx, t)
+	if err != nil || !ok || !e.holds(ctx, t) {
+		return core.Decision{}, err
+	}
+
+	return withCondition(core.Decision{Allowed: true, Policy: t.String()}, t.Condition), nil
+}
+
+// follow checks whether subject has relation to the subjects of the tuples of tupleset, or to the usersets which are
+// subjects if relation is empty. Conditions of the followed tuples are added to the decision.
+func (e *evaluator) follow(ctx context.Context, object core.ObjectRef, tupleset string, onlyUsersets bool, relation string, subject core.SubjectRef, depth int) (core.Decision, error) {
+	tuples, err := e.reader.subjects(ctx, object, tupleset, onlyUsersets)
+	if err != nil {
+		return core.Decision{}, err
+	}
+
+	for _, t := range tuples {
+		d, err := e.followTuple(ctx, t, relation, subject, depth)
+		if d.Allowed || err != nil {
+			return d, err
+		}
+	}
+
+	return core.Decision{}, nil
+}
+
+func (e *evaluator) followTuple(ctx context.Context, t core.RelationTuple, relation string, subject core.SubjectRef, depth int) (d core.Decision, err error) {
+	e.tracer.push(core.ExplainTuple, t.String())
+	defer func() { e.tracer.pop(d.Allowed, "") }()
+
+	if !e.holds(ctx, t) {
+		return core.Decision{}, nil
+	}
+
+	if relation == "" {
+		relation = t.Subject.Relation
+	}
+
+	d, err = e.check(ctx, t.Subject.Object(), relation, subject, depth+1)
+	if !d.Allowed || err != nil {
+		return core.Decision{}, err
+	}
+
+	return withCondition(d, t.Condition), nil
+}
+
+// holds reports whether the condition of t holds, explaining it when tracing.
+func (e *evaluator) holds(ctx context.Context, t core.RelationTuple) bool {
+	if t.Condition == "" {
+		return true
+	}
+
+	node := e.reader.condition(ctx, t)
+	e.tracer.add(node)
+
+	return node.Allowed
+}
+
+// withCondition adds condition to the conditions of d unless it is empty. d may be memoized, so its conditions are
+// not modified.
+func withCondition(d core.Decision, condition string) core.Decision {
+	if condition != "" {
+		d.Conditions = append(slices.Clip(d.Conditions), condition)
+	}
+
+	return d
+}
+
+// applying returns the tuples whose condition holds.
+func (e *evaluator) applying(ctx context.Context, tuples []core.RelationTuple) []core.RelationTuple {
+	return slices.DeleteFunc(tuples, func(t core.RelationTuple) bool { return !e.holds(ctx, t) })
+}
+
+// expand returns the tree of subjects having relation to object.
+func (e *evaluator) expand(ctx context.Context, object core.ObjectRef, relation string, depth int) (core.UsersetTree, error) {
+	if depth > e.maxDepth {
+		return core.UsersetTree{}, fmt.Errorf("rebac: expand exceeded the maximum depth of %d: %w", e.maxDepth, core.ErrInvalidArgument)
+	}
+
+	rel, err := e.schema.lookup(object.Namespace, relation)
+	if err != nil {
+		return core.UsersetTree{}, err
+	}
+
+	tree, err := e.expandRewrite(ctx, object, rel, rel.rewrite, depth)
+	if err != nil {
+		return core.UsersetTree{}, err
+	}
+
+	// Wrap computed usersets, so the root always describes the expanded relation.
+	if tree.Object != object || tree.Relation != relation {
+		tree = core.UsersetTree{Operation: core.UsersetUnion, Object: object, Relation: relation, Children: []core.UsersetTree{tree}}
+	}
+
+	return tree, nil
+}
+
+func (e *evaluator) expandRewrite(ctx context.Context, object core.ObjectRef, rel *relationDef, r *rewrite, depth int) (core.UsersetTree, error) {
+	node := core.UsersetTree{Object: object, Relation: rel.name}
+
+	if r == nil || r.kind == rewriteThis {
+		tuples, err := e.reader.subjects(ctx, object, rel.name, false)
+		if err != nil {
+			return core.UsersetTree{}, err
+		}
+
+		node.Operation = core.UsersetLeaf
+		for _, t := range e.applying(ctx, tuples) {
+			node.Subjects = append(node.Subjects, t.Subject)
+		}
+
+		return node, nil
+	}
+
+	switch r.kind {
+	case rewriteComputed:
+		return e.expand(ctx, object, r.relation, depth+1)
+	case rewriteTupleToUserset:
+		tupleset, err := e.reader.subjects(ctx, object, r.tupleset, false)
+		if err != nil {
+			return core.UsersetTree{}, err
+		}
+
+		node.Operation = core.UsersetUnion
+		for _, t := range e.applying(ctx, tupleset) {
+			child, err := e.expand(ctx, t.Subject.Object(), r.relation, depth+1)
+			if err != nil {
+				return core.UsersetTree{}, err
+			}
+			node.Children = append(node.Children, child)
+		}
+
+		return node, nil
+	case rewriteUnion:
+		node.Operation = core.UsersetUnion
+	case rewriteIntersection:
+		node.Operation = core.UsersetIntersection
+	case rewriteExclusion:
+		node.Operation = core.UsersetExclusion
+	default:
+		return core.UsersetTree{}, fmt.Errorf("rebac: unknown rewrite %d", r.kind)
+	}
+
+	for _, c := range r.children {
+		child, err := e.expandRewrite(ctx, object, rel, c, depth)
+		if err != nil {
+			return core.UsersetTree{}, err
+		}
+		node.Children = append(node.Children, child)
+	}
+
+	return node, nil
+}
+
+// tracer records the evaluation of an explained check. Nodes are pushed when their evaluation starts and popped into
+// their parent when it ends. Its methods do nothing on a nil tracer, so evaluations which are not explained need no
+// checks.
+type tracer struct {
+	stack []core.ExplainNode
+	root  core.ExplainNode
+}
+
+func (t *tracer) push(kind core.ExplainKind, name string) {
+	if t == nil {
+		return
+	}
+
+	t.stack = append(t.stack, core.ExplainNode{Kind: kind, Name: name})
+}
+
+func (t *tracer) pop(allowed bool, detail string) {
+	if t == nil {
+		return
+	}
+
+	node := t.stack[len(t.stack)-1]
+	node.Allowed = allowed
+	node.Detail = detail
+	t.stack = t.stack[:len(t.stack)-1]
+
+	t.add(node)
+}
+
+// add adds a complete node to the current node, or makes it the root.
+func (t *tracer) add(node core.ExplainNode) {
+	if t == nil {
+		return
+	}
+
+	if len(t.stack) == 0 {
+		t.root = node
+		return
+	}
+
+	parent := &t.stack[len(t.stack)-1]
+	parent.Children = append(parent.Children, node)
+}
@@ -0,0 +1,250 @@
+package rebac
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+)
+
+// memReader holds tuples in memory. All conditions hold except `never`.
+type memReader []core.RelationTuple
+
+func (m memReader) tuple(_ context.Context, t core.RelationTuple) (core.RelationTuple, bool, error) {
+	i := slices.IndexFunc(m, func(s core.RelationTuple) bool { return s.String() == t.String() })
+	if i < 0 {
+		return core.RelationTuple{}, false, nil
+	}
+	return m[i], true, nil
+}
+
+func (m memReader) subjects(_ context.Context, object core.ObjectRef, relation string, onlyUsersets bool) ([]core.RelationTuple, error) {
+	var tuples []core.RelationTuple
+	for _, t := range m {
+		if t.Object == object && t.Relation == relation && (!onlyUsersets || t.Subject.Relation != "") {
+			tuples = append(tuples, t)
+		}
+	}
+	return tuples, nil
+}
+
+func (m memReader) condition(_ context.Context, t core.RelationTuple) core.ExplainNode {
+	return core.ExplainNode{Kind: core.ExplainCondition, Name: t.Condition, Allowed: t.Condition != "never"}
+}
+
+// tuple parses `namespace:id#relation@namespace:id[#relation][ if condition]`.
+func tuple(s string) core.RelationTuple {
+	s, condition, _ := strings.Cut(s, " if ")
+	object, subject, _ := strings.Cut(s, "@")
+	object, relation, _ := strings.Cut(object, "#")
+	return core.RelationTuple{Object: objectRef(object), Relation: relation, Subject: subjectRef(subject), Condition: condition}
+}
+
+func objectRef(s string) core.ObjectRef {
+	namespace, id, _ := strings.Cut(s, ":")
+	return core.ObjectRef{Namespace: namespace, ID: id}
+}
+
+func subjectRef(s string) core.SubjectRef {
+	object, relation, _ := strings.Cut(s, "#")
+	o := objectRef(object)
+	return core.SubjectRef{Namespace: o.Namespace, ID: o.ID, Relation: relation}
+}
+
+func TestEvaluatorCheck(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := parseSchema(testSchema, 1)
+	require.NoError(t, err)
+
+	tuples := memReader{
+		tuple("team:eng#member@user:ada"),
+		tuple("team:eng#member@team:infra#member"),
+		tuple("team:infra#member@user:bob"),
+		// Teams containing each other must not loop.
+		tuple("team:infra#member@team:eng#member"),
+		tuple("folder:root#viewer@user:carol"),
+		tuple("folder:docs#parent@folder:root"),
+		tuple("document:readme#parent@folder:docs"),
+		tuple("document:readme#editor@team:eng#member"),
+		tuple("document:readme#banned@user:bob"),
+		tuple("document:readme#owner@user:dave"),
+		tuple("document:readme#viewer@user:erin"),
+		tuple("document:readme#viewer@user:frank if never"),
+		tuple("document:readme#viewer@team:ops#member if office_hours"),
+		tuple("team:ops#member@user:grace if on_call"),
+		tuple("team:ops#member@user:heidi if never"),
+	}
+
+	for _, tc := range []struct {
+		check string
+		want  bool
+	}{
+		{"document:readme#edit@user:ada", true},
+		{"document:readme#edit@user:bob", false}, // Banned.
+		{"document:readme#edit@user:dave", true},
+		{"document:readme#viewer@user:bob", false},
+		{"document:readme#viewer@user:ada", true},
+		{"document:readme#viewer@user:carol", true}, // Through the folder hierarchy.
+		{"document:readme#viewer@user:erin", true},
+		{"document:readme#edit@user:erin", false},
+		{"document:readme#review@user:ada", true},
+		{"document:readme#review@user:dave", false}, // Owners are no editors.
+		{"document:readme#editor@team:infra#member", true},
+		{"document:readme#viewer@team:eng#member", true},
+		{"team:eng#member@team:eng#member", true},
+		{"team:eng#member@user:frank", false},
+		{"folder:docs#view@user:carol", true},
+		{"folder:root#view@user:ada", false},
+		{"document:readme#viewer@user:frank", false}, // The condition does not hold.
+		{"document:readme#viewer@user:grace", true},
+		{"document:readme#viewer@user:heidi", false},
+	} {
+		t.Run(tc.check, func(t *testing.T) {
+			c := tuple(tc.check)
+
+			d, err := newEvaluator(s, tuples, 25).check(ctx, c.Object, c.Relation, c.Subject, 0)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, d.Allowed)
+		})
+	}
+
+	t.Run("policy", func(t *testing.T) {
+		for _, tc := range []struct {
+			check string
+			want  core.Decision
+		}{
+			{"document:readme#edit@user:ada", core.Decision{Allowed: true, Policy: "team:eng#member@user:ada"}},
+			{"document:readme#viewer@user:carol", core.Decision{Allowed: true, Policy: "folder:root#viewer@user:carol"}},
+			{"document:readme#viewer@user:grace", core.Decision{
+				Allowed:    true,
+				Policy:     "team:ops#member@user:grace",
+				Conditions: []string{"on_call", "office_hours"},
+			}},
+			{"document:readme#review@user:ada", core.Decision{
+				Allowed: true,
+				Policy:  "team:eng#member@user:ada & team:eng#member@user:ada",
+			}},
+		} {
+			c := tuple(tc.check)
+
+			d, err := newEvaluator(s, tuples, 25).check(ctx, c.Object, c.Relation, c.Subject, 0)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, d, tc.check)
+		}
+	})
+
+	t.Run("unknown relation", func(t *testing.T) {
+		_, err := newEvaluator(s, tuples, 25).check(ctx, objectRef("document:readme"), "delete", subjectRef("user:ada"), 0)
+		require.ErrorIs(t, err, core.ErrInvalidArgument)
+	})
+
+	t.Run("max depth", func(t *testing.T) {
+		var chain memReader
+		for i := range 10 {
+			chain = append(chain, tuple("folder:"+strings.Repeat("f", i+1)+"#parent@folder:"+strings.Repeat("f", i+2)))
+		}
+		chain = append(chain, tuple("folder:fffffffffff#viewer@user:ada"))
+
+		d, err := newEvaluator(s, chain, 25).check(ctx, objectRef("folder:f"), "view", subjectRef("user:ada"), 0)
+		require.NoError(t, err)
+		require.True(t, d.Allowed)
+
+		_, err = newEvaluator(s, chain, 5).check(ctx, objectRef("folder:f"), "view", subjectRef("user:ada"), 0)
+		require.ErrorIs(t, err, core.ErrInvalidArgument)
+	})
+}
+
+func TestEvaluatorExpand(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := parseSchema(testSchema, 1)
+	require.NoError(t, err)
+
+	tuples := memReader{
+		tuple("folder:root#viewer@user:carol"),
+		tuple("folder:docs#parent@folder:root"),
+		tuple("folder:docs#viewer@team:eng#member"),
+		tuple("folder:docs#viewer@user:frank if never"),
+	}
+
+	tree, err := newEvaluator(s, tuples, 25).expand(ctx, objectRef("folder:docs"), "view", 0)
+	require.NoError(t, err)
+
+	require.Equal(t, core.UsersetTree{
+		Operation: core.UsersetUnion,
+		Object:    objectRef("folder:docs"),
+		Relation:  "view",
+		Children: []core.UsersetTree{
+			{
+				Operation: core.UsersetLeaf,
+				Object:    objectRef("folder:docs"),
+				Relation:  "viewer",
+				Subjects:  []core.SubjectRef{subjectRef("team:eng#member")},
+			},
+			{
+				Operation: core.UsersetUnion,
+				Object:    objectRef("folder:docs"),
+				Relation:  "view",
+				Children: []core.UsersetTree{{
+					Operation: core.UsersetUnion,
+					Object:    objectRef("folder:root"),
+					Relation:  "view",
+					Children: []core.UsersetTree{
+						{
+							Operation: core.UsersetLeaf,
+							Object:    objectRef("folder:root"),
+							Relation:  "viewer",
+							Subjects:  []core.SubjectRef{subjectRef("user:carol")},
+						},
+						{Operation: core.UsersetUnion, Object: objectRef("folder:root"), Relation: "view"},
+					},
+				}},
+			},
+		},
+	}, tree)
+}
+
+func TestEvaluatorExplain(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := parseSchema(testSchema, 1)
+	require.NoError(t, err)
+
+	tuples := memReader{
+		tuple("folder:root#viewer@user:carol if office_hours"),
+		tuple("folder:docs#parent@folder:root"),
+	}
+
+	ev := newEvaluator(s, tuples, 25)
+	ev.tracer = &tracer{}
+
+	d, err := ev.check(ctx, objectRef("folder:docs"), "view", subjectRef("user:carol"), 0)
+	require.NoError(t, err)
+	require.True(t, d.Allowed)
+
+	viewer := func(folder string, allowed bool, children ...core.ExplainNode) core.ExplainNode {
+		return core.ExplainNode{Kind: core.ExplainRelation, Name: folder + "#viewer", Allowed: allowed, Children: []core.ExplainNode{
+			{Kind: core.ExplainTuple, Name: folder + "#viewer@user:carol", Allowed: allowed, Children: children},
+		}}
+	}
+
+	require.Equal(t, core.ExplainNode{
+		Kind: core.ExplainRelation, Name: "folder:docs#view", Allowed: true, Children: []core.ExplainNode{{
+			Kind: core.ExplainUnion, Name: "folder:docs#view", Allowed: true, Children: []core.ExplainNode{
+				viewer("folder:docs", false),
+				{Kind: core.ExplainTuple, Name: "folder:docs#parent@folder:root", Allowed: true, Children: []core.ExplainNode{{
+					Kind: core.ExplainRelation, Name: "folder:root#view", Allowed: true, Children: []core.ExplainNode{{
+						Kind: core.ExplainUnion, Name: "folder:root#view", Allowed: true, Children: []core.ExplainNode{
+							viewer("folder:root", true, core.ExplainNode{Kind: core.ExplainCondition, Name: "office_hours", Allowed: true}),
+						},
+					}},
+				}}},
+			},
+		}},
+	}, ev.tracer.root)
+}
@@ -0,0 +1,343 @@
+package rebac
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophero/guardian/core"
+)
+
+// The schema language defines namespaces and their relations:
+//
+//	// Comments start with two slashes.
+//	namespace user {}
+//
+//	namespace team {
+//		relation member: user | team#member
+//	}
+//
+//	namespace document {
+//		relation parent: folder
+//		relation owner: user
+//		relation editor: user | team#member
+//		relation banned: user
+//		relation edit = (owner | editor) - banned
+//		relation view = edit | parent->view
+//	}
+//
+// Relations listing subject types after a colon can be written as tuples with subjects of these types: objects of a
+// namespace or usersets of a namespace and relation. Relations with an expression after an equals sign are rewritten:
+// `this` are the tuples written for the relation, a relation name are the subjects of that relation to the same
+// object (computed userset) and `tupleset->relation` are the subjects having the relation to the objects related by
+// tupleset (tuple to userset). Expressions combine these with `|` (union), `&` (intersection) and `-` (exclusion),
+// which cannot be mixed without parentheses.
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenPunct
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	line int
+	col  int
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of schema"
+	}
+
+	return "`" + t.text + "`"
+}
+
+// lex splits src into identifiers and punctuation, skipping whitespace and comments.
+func lex(src string) ([]token, error) {
+	var tokens []token
+
+	line, col := 1, 1
+	advance := func(n int) {
+		for _, r := range src[:n] {
+			if r == '\n' {
+				line++
+				col = 1
+			} else {
+				col++
+			}
+		}
+		src = src[n:]
+	}
+
+	for len(src) > 0 {
+		c := src[0]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			advance(1)
+		case strings.HasPrefix(src, "//"):
+			n := strings.IndexByte(src, '\n')
+			if n < 0 {
+				n = len(src)
+			}
+			advance(n)
+		case strings.HasPrefix(src, "->"):
+			tokens = append(tokens, token{kind: tokenPunct, text: "->", line: line, col: col})
+			advance(2)
+		case strings.IndexByte("{}:|&-()=#", c) >= 0:
+			tokens = append(tokens, token{kind: tokenPunct, text: string(c), line: line, col: col})
+			advance(1)
+		case isIdentChar(c):
+			n := 1
+			for n < len(src) && isIdentChar(src[n]) {
+				n++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: src[:n], line: line, col: col})
+			advance(n)
+		default:
+			return nil, fmt.Errorf("rebac: schema %d:%d: unexpected character %q: %w", line, col, c, core.ErrInvalidArgument)
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, line: line, col: col}), nil
+}
+
+func isIdentChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_'
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) errorf(t token, format string, args ...any) error {
+	return fmt.Errorf("rebac: schema %d:%d: %s: %w", t.line, t.col, fmt.Sprintf(format, args...), core.ErrInvalidArgument)
+}
+
+// accept consumes the next token if it is the punctuation or keyword text.
+func (p *parser) accept(text string) bool {
+	if p.peek().text == text && p.peek().kind != tokenEOF {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(text string) error {
+	if t := p.next(); t.text != text || t.kind == tokenEOF {
+		return p.errorf(t, "expected `%s`, got %s", text, t)
+	}
+	return nil
+}
+
+// name consumes an identifier which is a valid namespace or relation name.
+func (p *parser) name() (token, error) {
+	t := p.next()
+	if t.kind != tokenIdent {
+		return t, p.errorf(t, "expected name, got %s", t)
+	}
+
+	if !nameRe.MatchString(t.text) || t.text == "this" {
+		return t, p.errorf(t, "invalid name %s", t)
+	}
+
+	return t, nil
+}
+
+func (p *parser) schema() ([]*namespaceDef, error) {
+	var namespaces []*namespaceDef
+
+	for p.peek().kind != tokenEOF {
+		if err := p.expect("namespace"); err != nil {
+			return nil, err
+		}
+
+		ns, err := p.namespace()
+		if err != nil {
+			return nil, err
+		}
+
+		namespaces = append(namespaces, ns)
+	}
+
+	return namespaces, nil
+}
+
+func (p *parser) namespace() (*namespaceDef, error) {
+	name, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+
+	ns := &namespaceDef{name: name.text, line: name.line, col: name.col}
+
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	for !p.accept("}") {
+		if err := p.expect("relation"); err != nil {
+			return nil, err
+		}
+
+		rel, err := p.relation()
+		if err != nil {
+			return nil, err
+		}
+
+		ns.relations = append(ns.relations, rel)
+	}
+
+	return ns, nil
+}
+
+func (p *parser) relation() (*relationDef, error) {
+	name, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+
+	rel := &relationDef{name: name.text, line: name.line, col: name.col}
+
+	if p.accept(":") {
+		for {
+			typ, err := p.subjectType()
+			if err != nil {
+				return nil, err
+			}
+
+			rel.types = append(rel.types, typ)
+
+			if !p.accept("|") {
+				break
+			}
+		}
+	}
+
+	if p.accept("=") {
+		if rel.rewrite, err = p.expr(); err != nil {
+			return nil, err
+		}
+	} else if rel.types == nil {
+		return nil, p.errorf(p.peek(), "relation `%s` needs subject types or a rewrite", rel.name)
+	}
+
+	return rel, nil
+}
+
+func (p *parser) subjectType() (subjectType, error) {
+	ns, err := p.name()
+	if err != nil {
+		return subjectType{}, err
+	}
+
+	typ := subjectType{namespace: ns.text}
+
+	if p.accept("#") {
+		rel, err := p.name()
+		if err != nil {
+			return subjectType{}, err
+		}
+		typ.relation = rel.text
+	}
+
+	return typ, nil
+}
+
+var operators = map[string]rewriteKind{
+	"|": rewriteUnion,
+	"&": rewriteIntersection,
+	"-": rewriteExclusion,
+}
+
+// expr parses terms joined by a single kind of operator. Exclusions are left associative.
+func (p *parser) expr() (*rewrite, error) {
+	left, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+
+	kind, ok := operators[p.peek().text]
+	if !ok || p.peek().kind != tokenPunct {
+		return left, nil
+	}
+
+	node := &rewrite{kind: kind, children: []*rewrite{left}}
+
+	for {
+		t := p.peek()
+		k, ok := operators[t.text]
+		if !ok || t.kind != tokenPunct {
+			return node, nil
+		}
+
+		if k != kind {
+			return nil, p.errorf(t, "operators `|`, `&` and `-` cannot be mixed without parentheses")
+		}
+		p.next()
+
+		right, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+
+		if kind == rewriteExclusion && len(node.children) == 2 {
+			node = &rewrite{kind: kind, children: []*rewrite{node, right}}
+		} else {
+			node.children = append(node.children, right)
+		}
+	}
+}
+
+func (p *parser) term() (*rewrite, error) {
+	if p.accept("(") {
+		r, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		return r, p.expect(")")
+	}
+
+	if t := p.peek(); t.kind == tokenIdent && t.text == "this" {
+		p.next()
+		return &rewrite{kind: rewriteThis, line: t.line, col: t.col}, nil
+	}
+
+	name, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.accept("->") {
+		return &rewrite{kind: rewriteComputed, relation: name.text, line: name.line, col: name.col}, nil
+	}
+
+	computed, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+
+	return &rewrite{
+		kind:     rewriteTupleToUserset,
+		tupleset: name.text,
+		relation: computed.text,
+		line:     name.line,
+		col:      name.col,
+	}, nil
+}
@@ -0,0 +1,337 @@
+package rebac
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/gophero/guardian/core"
+)
+
+var (
+	nameRe     = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+	objectIDRe = regexp.MustCompile(`^[a-zA-Z0-9_\-./|=+@]{1,256}$`)
+)
+
+type rewriteKind int
+
+const (
+	rewriteThis rewriteKind = iota
+	rewriteComputed
+	rewriteTupleToUserset
+	rewriteUnion
+	rewriteIntersection
+	rewriteExclusion
+)
+
+// rewrite computes the subjects of a relation. Computed usersets use relation, tuple to usersets follow the tuples
+// of tupleset and compute relation on their subjects. Set operations combine their children.
+type rewrite struct {
+	kind     rewriteKind
+	relation string
+	tupleset string
+	children []*rewrite
+	line     int
+	col      int
+}
+
+type subjectType struct {
+	namespace string
+	relation  string
+}
+
+type relationDef struct {
+	name    string
+	types   []subjectType
+	rewrite *rewrite // Nil for relations which are only written as tuples.
+	line    int
+	col     int
+}
+
+// direct reports whether tuples can be written for the relation.
+func (r *relationDef) direct() bool {
+	return len(r.types) > 0
+}
+
+type namespaceDef struct {
+	name      string
+	relations []*relationDef
+	line      int
+	col       int
+}
+
+// schema is a parsed and validated schema definition.
+type schema struct {
+	revision   int64
+	definition string
+	relations  map[string]map[string]*relationDef // Namespace to relation name to definition.
+}
+
+// parseSchema parses and validates a schema definition.
+func parseSchema(definition string, revision int64) (*schema, error) {
+	tokens, err := lex(definition)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+
+	namespaces, err := p.schema()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &schema{revision: revision, definition: definition, relations: map[string]map[string]*relationDef{}}
+
+	for _, ns := range namespaces {
+		if _, ok := s.relations[ns.name]; ok {
+			return nil, schemaErrorf(ns.line, ns.col, "namespace `%s` is defined twice", ns.name)
+		}
+
+		relations := map[string]*relationDef{}
+		for _, rel := range ns.relations {
+			if _, ok := relations[rel.name]; ok {
+				return nil, schemaErrorf(rel.line, rel.col, "relation `%s#%s` is defined twice", ns.name, rel.name)
+			}
+			relations[rel.name] = rel
+		}
+
+		s.relations[ns.name] = relations
+	}
+
+	for _, ns := range namespaces {
+		for _, rel := range ns.relations {
+			if err := s.validateRelation(ns.name, rel); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := s.validateAcyclic(ns); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+func schemaErrorf(line, col int, format string, args ...any) error {
+	return fmt.Errorf("rebac: schema %d:%d: %s: %w", line, col, fmt.Sprintf(format, args...), core.ErrInvalidArgument)
+}
+
+func (s *schema) relation(namespace, name string) (*relationDef, bool) {
+	rel, ok := s.relations[namespace][name]
+	return rel, ok
+}
+
+func (s *schema) validateRelation(namespace string, rel *relationDef) error {
+	for _, typ := range rel.types {
+		if _, ok := s.relations[typ.namespace]; !ok {
+			return schemaErrorf(rel.line, rel.col, "relation `%s#%s` references unknown namespace `%s`", namespace, rel.name, typ.namespace)
+		}
+
+		if _, ok := s.relation(typ.namespace, typ.relation); typ.relation != "" && !ok {
+			return schemaErrorf(rel.line, rel.col, "relation `%s#%s` references unknown relation `%s#%s`", namespace, rel.name, typ.namespace, typ.relation)
+		}
+	}
+
+	if rel.rewrite == nil {
+		return nil
+	}
+
+	usesThis := false
+
+	var validate func(r *rewrite) error
+	validate = func(r *rewrite) error {
+		switch r.kind {
+		case rewriteThis:
+			if !rel.direct() {
+				return schemaErrorf(r.line, r.col, "relation `%s#%s` uses `this` without subject types", namespace, rel.name)
+			}
+			usesThis = true
+		case rewriteComputed:
+			if _, ok := s.relation(namespace, r.relation); !ok {
+				return schemaErrorf(r.line, r.col, "unknown relation `%s#%s`", namespace, r.relation)
+			}
+		case rewriteTupleToUserset:
+			return s.validateTupleToUserset(namespace, r)
+		default:
+			for _, c := range r.children {
+				if err := validate(c); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	if err := validate(rel.rewrite); err != nil {
+		return err
+	}
+
+	if rel.direct() && !usesThis {
+		return schemaErrorf(rel.line, rel.col, "relation `%s#%s` has subject types but its rewrite does not use `this`", namespace, rel.name)
+	}
+
+	return nil
+}
+
+// validateTupleToUserset requires the tupleset to relate objects directly, so each of its subjects has the computed
+// relation.
+func (s *schema) validateTupleToUserset(namespace string, r *rewrite) error {
+	tupleset, ok := s.relation(namespace, r.tupleset)
+	if !ok {
+		return schemaErrorf(r.line, r.col, "unknown relation `%s#%s`", namespace, r.tupleset)
+	}
+
+	if !tupleset.direct() || tupleset.rewrite != nil {
+		return schemaErrorf(r.line, r.col, "tupleset `%s#%s` must only have subject types", namespace, r.tupleset)
+	}
+
+	for _, typ := range tupleset.types {
+		if typ.relation != "" {
+			return schemaErrorf(r.line, r.col, "tupleset `%s#%s` cannot have userset subjects", namespace, r.tupleset)
+		}
+
+		if _, ok := s.relation(typ.namespace, r.relation); !ok {
+			return schemaErrorf(r.line, r.col, "unknown relation `%s#%s`", typ.namespace, r.relation)
+		}
+	}
+
+	return nil
+}
+
+// validateAcyclic rejects relations which compute themselves from the same object. Cycles through tuples depend on
+// the data and are handled during evaluation.
+func (s *schema) validateAcyclic(ns *namespaceDef) error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := map[string]int{}
+
+	var visit func(rel *relationDef) error
+	visit = func(rel *relationDef) error {
+		switch state[rel.name] {
+		case visiting:
+			return schemaErrorf(rel.line, rel.col, "relation `%s#%s` is computed from itself", ns.name, rel.name)
+		case visited:
+			return nil
+		}
+
+		state[rel.name] = visiting
+
+		var walk func(r *rewrite) error
+		walk = func(r *rewrite) error {
+			if r == nil {
+				return nil
+			}
+
+			if r.kind == rewriteComputed {
+				computed, _ := s.relation(ns.name, r.relation)
+				return visit(computed)
+			}
+
+			for _, c := range r.children {
+				if err := walk(c); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		if err := walk(rel.rewrite); err != nil {
+			return err
+		}
+
+		state[rel.name] = visited
+		return nil
+	}
+
+	for _, rel := range ns.relations {
+		if err := visit(rel); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lookup returns the definition of a relation which is checked or expanded.
+func (s *schema) lookup(namespace, relation string) (*relationDef, error) {
+	if _, ok := s.relations[namespace]; !ok {
+		return nil, fmt.Errorf("rebac: unknown namespace `%s`: %w", namespace, core.ErrInvalidArgument)
+	}
+
+	rel, ok := s.relation(namespace, relation)
+	if !ok {
+		return nil, fmt.Errorf("rebac: unknown relation `%s#%s`: %w", namespace, relation, core.ErrInvalidArgument)
+	}
+
+	return rel, nil
+}
+
+// validateTupleType returns an error unless tuples of the relation may have subjects of the namespace and relation.
+func (s *schema) validateTupleType(namespace, relation, subjectNamespace, subjectRelation string) error {
+	rel, err := s.lookup(namespace, relation)
+	if err != nil {
+		return err
+	}
+
+	for _, typ := range rel.types {
+		if typ.namespace == subjectNamespace && typ.relation == subjectRelation {
+			return nil
+		}
+	}
+
+	subject := subjectNamespace
+	if subjectRelation != "" {
+		subject += "#" + subjectRelation
+	}
+
+	return fmt.Errorf("rebac: relation `%s#%s` does not allow subjects of type `%s`: %w", namespace, relation, subject, core.ErrInvalidArgument)
+}
+
+// validateTuple validates the format of a tuple. It does not require the schema to allow it.
+func validateTuple(t core.RelationTuple) error {
+	if err := validateObject(t.Object); err != nil {
+		return err
+	}
+
+	if err := validateSubject(t.Subject); err != nil {
+		return err
+	}
+
+	return validateName("relation", t.Relation)
+}
+
+func validateObject(o core.ObjectRef) error {
+	if err := validateName("namespace", o.Namespace); err != nil {
+		return err
+	}
+
+	if !objectIDRe.MatchString(o.ID) {
+		return fmt.Errorf("rebac: invalid object id `%s`: %w", o.ID, core.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+func validateSubject(s core.SubjectRef) error {
+	if err := validateObject(s.Object()); err != nil {
+		return err
+	}
+
+	if s.Relation != "" {
+		return validateName("relation", s.Relation)
+	}
+
+	return nil
+}
+
+func validateName(kind, name string) error {
+	if !nameRe.MatchString(name) {
+		return fmt.Errorf("rebac: invalid %s `%s`: %w", kind, name, core.ErrInvalidArgument)
+	}
+	return nil
+}
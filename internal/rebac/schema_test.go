@@ -0,0 +1,110 @@
+package rebac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+)
+
+const testSchema = `
+// Users are only subjects.
+namespace user {}
+
+namespace team {
+	relation member: user | team#member
+}
+
+namespace folder {
+	relation parent: folder
+	relation viewer: user | team#member
+	relation view = viewer | parent->view
+}
+
+namespace document {
+	relation parent: folder
+	relation owner: user
+	relation editor: user | team#member
+	relation banned: user
+	relation edit = (owner | editor) - banned
+	relation viewer: user | team#member = this | edit | parent->view
+	relation review = editor & viewer
+}
+`
+
+func TestParseSchema(t *testing.T) {
+	s, err := parseSchema(testSchema, 1)
+	require.NoError(t, err)
+	require.Len(t, s.relations, 4)
+
+	edit, err := s.lookup("document", "edit")
+	require.NoError(t, err)
+	require.False(t, edit.direct())
+	require.Equal(t, rewriteExclusion, edit.rewrite.kind)
+	require.Equal(t, rewriteUnion, edit.rewrite.children[0].kind)
+	require.Equal(t, "banned", edit.rewrite.children[1].relation)
+
+	viewer, err := s.lookup("document", "viewer")
+	require.NoError(t, err)
+	require.True(t, viewer.direct())
+	require.Len(t, viewer.rewrite.children, 3)
+	require.Equal(t, rewriteTupleToUserset, viewer.rewrite.children[2].kind)
+
+	require.NoError(t, s.validateTupleType("document", "editor", "team", "member"))
+	require.ErrorIs(t, s.validateTupleType("document", "editor", "team", ""), core.ErrInvalidArgument)
+	require.ErrorIs(t, s.validateTupleType("document", "edit", "user", ""), core.ErrInvalidArgument)
+	require.ErrorIs(t, s.validateTupleType("document", "unknown", "user", ""), core.ErrInvalidArgument)
+
+	// Exclusions are left associative.
+	s, err = parseSchema(`namespace user {} namespace doc { relation a: user relation b: user relation c: user relation d = a - b - c }`, 1)
+	require.NoError(t, err)
+	d, _ := s.relation("doc", "d")
+	require.Equal(t, "c", d.rewrite.children[1].relation)
+	require.Equal(t, rewriteExclusion, d.rewrite.children[0].kind)
+}
+
+func TestParseSchemaErrors(t *testing.T) {
+	for name, definition := range map[string]string{
+		"unexpected character":  `namespace user { relation a: user! }`,
+		"missing brace":         `namespace user { relation a: user`,
+		"invalid name":          `namespace User {}`,
+		"this as name":          `namespace user { relation this: user }`,
+		"duplicate namespace":   `namespace user {} namespace user {}`,
+		"duplicate relation":    `namespace user { relation a: user relation a: user }`,
+		"unknown namespace":     `namespace doc { relation a: user }`,
+		"unknown type relation": `namespace user {} namespace doc { relation a: user#member }`,
+		"empty relation":        `namespace user { relation a }`,
+		"mixed operators":       `namespace user { relation a: user relation b: user relation c = a | b & a }`,
+		"this without types":    `namespace user { relation a: user relation b = this | a }`,
+		"types without this":    `namespace user { relation a: user relation b: user = a }`,
+		"unknown computed":      `namespace user { relation a = b }`,
+		"computed cycle":        `namespace user { relation a: user relation b = a | c relation c = b }`,
+		"unknown tupleset":      `namespace user { relation a: user relation b = parent->a }`,
+		"rewritten tupleset":    `namespace user { relation a: user relation p = a relation b = p->a }`,
+		"userset tupleset":      `namespace user { relation a: user#a relation b = a->a }`,
+		"tupleset missing rel":  `namespace user {} namespace doc { relation p: user relation b = p->view }`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseSchema(definition, 1)
+			require.ErrorIs(t, err, core.ErrInvalidArgument)
+		})
+	}
+}
+
+func TestZookie(t *testing.T) {
+	for _, revision := range []int64{0, 1, 300, 1 << 40} {
+		got, err := decodeZookie(encodeZookie(revision))
+		require.NoError(t, err)
+		require.Equal(t, revision, got)
+	}
+
+	got, err := decodeZookie("")
+	require.NoError(t, err)
+	require.Zero(t, got)
+
+	for _, z := range []core.Zookie{"!", "AA", encodeZookie(1)[:1], encodeZookie(1) + "AA"} {
+		_, err := decodeZookie(z)
+		require.ErrorIs(t, err, core.ErrInvalidArgument, z)
+	}
+}
@@ -0,0 +1,621 @@
+// Package rebac implements relationship-based access control in the style of Zanzibar on relation tuples stored in
+// postgres.
+package rebac
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/abac"
+	"github.com/gophero/guardian/internal/cursor"
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+// candidateBatch is the number of candidates loaded at once by [Store.ListObjects] and [Store.ListSubjects].
+const candidateBatch = 100
+
+// Store is a postgres backed [core.ReBACStore]. Reads run in a repeatable read transaction, so a request observes a
+// single revision. Conditions of tuples are evaluated by engine.
+type Store struct {
+	config Config
+	pool   *pgxpool.Pool
+	q      *queries.Queries
+	engine *abac.Engine
+
+	mu     sync.Mutex
+	schema *schema // Latest parsed schema.
+}
+
+var _ core.ReBACStore = (*Store)(nil)
+
+// NewStore constructs new [Store].
+func NewStore(pool *pgxpool.Pool, config Config, engine *abac.Engine) (*Store, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		config: config,
+		pool:   pool,
+		q:      queries.New(pool),
+		engine: engine,
+	}, nil
+}
+
+// WriteSchema implements [core.ReBACStore].
+func (s *Store) WriteSchema(ctx context.Context, definition string) (core.Zookie, error) {
+	sch, err := parseSchema(definition, 0)
+	if err != nil {
+		return "", err
+	}
+
+	var revision int64
+
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		q := s.q.WithTx(tx)
+
+		var err error
+		if revision, err = q.IncrementRelationRevision(ctx); err != nil {
+			return fmt.Errorf("rebac: increment relation revision: %w", err)
+		}
+
+		// Tuples cannot be written concurrently while the revision is locked.
+		types, err := q.ListRelationTupleTypes(ctx)
+		if err != nil {
+			return fmt.Errorf("rebac: list relation tuple types: %w", err)
+		}
+
+		for _, t := range types {
+			if err := sch.validateTupleType(t.Namespace, t.Relation, t.SubjectNamespace, t.SubjectRelation); err != nil {
+				return fmt.Errorf("rebac: schema does not allow stored tuples: %w", err)
+			}
+		}
+
+		if err := q.CreateRelationSchema(ctx, queries.CreateRelationSchemaParams{Revision: revision, Definition: definition}); err != nil {
+			return fmt.Errorf("rebac: create relation schema: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return encodeZookie(revision), nil
+}
+
+// ReadSchema implements [core.ReBACStore].
+func (s *Store) ReadSchema(ctx context.Context) (string, core.Zookie, error) {
+	var definition string
+
+	zookie, err := s.read(ctx, "", func(q *queries.Queries, _ *schema) error {
+		sch, err := q.GetLatestRelationSchema(ctx)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("rebac: get latest relation schema: %w", core.ErrNotFound)
+		}
+		if err != nil {
+			return fmt.Errorf("rebac: get latest relation schema: %w", err)
+		}
+
+		definition = sch.Definition
+		return nil
+	})
+
+	return definition, zookie, err
+}
+
+// WriteTuples implements [core.ReBACStore].
+func (s *Store) WriteTuples(ctx context.Context, writes, deletes []core.RelationTuple) (core.Zookie, error) {
+	if len(writes) == 0 && len(deletes) == 0 {
+		return "", fmt.Errorf("rebac: no tuples to write or delete: %w", core.ErrInvalidArgument)
+	}
+
+	for _, t := range slices.Concat(writes, deletes) {
+		if err := validateTuple(t); err != nil {
+			return "", err
+		}
+	}
+
+	var revision int64
+
+	err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		q := s.q.WithTx(tx)
+
+		var err error
+		if revision, err = q.IncrementRelationRevision(ctx); err != nil {
+			return fmt.Errorf("rebac: increment relation revision: %w", err)
+		}
+
+		sch, err := s.loadSchema(ctx, q)
+		if err != nil {
+			return err
+		}
+
+		for _, t := range deletes {
+			if _, err := q.DeleteRelationTuple(ctx, queries.DeleteRelationTupleParams{
+				Namespace:        t.Object.Namespace,
+				ObjectID:         t.Object.ID,
+				Relation:         t.Relation,
+				SubjectNamespace: t.Subject.Namespace,
+				SubjectID:        t.Subject.ID,
+				SubjectRelation:  t.Subject.Relation,
+			}); err != nil {
+				return fmt.Errorf("rebac: delete relation tuple: %w", err)
+			}
+		}
+
+		for _, t := range writes {
+			if err := sch.validateTupleType(t.Object.Namespace, t.Relation, t.Subject.Namespace, t.Subject.Relation); err != nil {
+				return err
+			}
+
+			if err := q.CreateRelationTuple(ctx, queries.CreateRelationTupleParams{
+				Namespace:        t.Object.Namespace,
+				ObjectID:         t.Object.ID,
+				Relation:         t.Relation,
+				SubjectNamespace: t.Subject.Namespace,
+				SubjectID:        t.Subject.ID,
+				SubjectRelation:  t.Subject.Relation,
+				Condition:        optionalText(t.Condition),
+				CreatedRevision:  revision,
+			}); db.IsForeignKeyViolation(err) {
+				return fmt.Errorf("rebac: create relation tuple: unknown condition `%s`: %w", t.Condition, core.ErrNotFound)
+			} else if err != nil {
+				return fmt.Errorf("rebac: create relation tuple: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return encodeZookie(revision), nil
+}
+
+// ReadTuples implements [core.ReBACStore].
+func (s *Store) ReadTuples(ctx context.Context, params core.ReadTuplesParams) (core.TuplePage, error) {
+	f := params.Filter
+
+	if err := validateName("namespace", f.Namespace); err != nil {
+		return core.TuplePage{}, err
+	}
+
+	afterID, err := cursor.Decode(params.Cursor)
+	if err != nil {
+		return core.TuplePage{}, err
+	}
+
+	arg := queries.ListRelationTuplesParams{
+		Namespace: f.Namespace,
+		ObjectID:  optionalText(f.ObjectID),
+		Relation:  optionalText(f.Relation),
+		AfterID:   afterID,
+		Limit:     cursor.Limit(params.Limit) + 1,
+	}
+
+	if f.Subject != nil {
+		if err := validateSubject(*f.Subject); err != nil {
+			return core.TuplePage{}, err
+		}
+
+		arg.SubjectNamespace = pgtype.Text{String: f.Subject.Namespace, Valid: true}
+		arg.SubjectID = pgtype.Text{String: f.Subject.ID, Valid: true}
+		arg.SubjectRelation = pgtype.Text{String: f.Subject.Relation, Valid: true}
+	}
+
+	var page core.TuplePage
+
+	page.Zookie, err = s.read(ctx, params.Zookie, func(q *queries.Queries, _ *schema) error {
+		tuples, err := q.ListRelationTuples(ctx, arg)
+		if err != nil {
+			return fmt.Errorf("rebac: list relation tuples: %w", err)
+		}
+
+		limit := int(arg.Limit - 1)
+		if len(tuples) > limit {
+			tuples = tuples[:limit]
+			page.NextCursor = cursor.Encode(tuples[limit-1].ID)
+		}
+
+		page.Tuples = make([]core.RelationTuple, 0, len(tuples))
+		for _, t := range tuples {
+			page.Tuples = append(page.Tuples, toTuple(t))
+		}
+
+		return nil
+	})
+
+	return page, err
+}
+
+// Check implements [core.ReBACStore].
+func (s *Store) Check(ctx context.Context, params core.CheckParams) (core.Decision, core.Zookie, error) {
+	if err := validateObject(params.Object); err != nil {
+		return core.Decision{}, "", err
+	}
+
+	if err := validateSubject(params.Subject); err != nil {
+		return core.Decision{}, "", err
+	}
+
+	var d core.Decision
+
+	zookie, err := s.read(ctx, params.Zookie, func(q *queries.Queries, sch *schema) error {
+		var err error
+		d, err = s.evaluator(q, sch, params.Context).check(ctx, params.Object, params.Relation, params.Subject, 0)
+		return err
+	})
+
+	return d, zookie, err
+}
+
+// Explain implements [core.ReBACStore].
+func (s *Store) Explain(ctx context.Context, params core.CheckParams) (core.Explanation, core.Zookie, error) {
+	if err := validateObject(params.Object); err != nil {
+		return core.Explanation{}, "", err
+	}
+
+	if err := validateSubject(params.Subject); err != nil {
+		return core.Explanation{}, "", err
+	}
+
+	var e core.Explanation
+
+	zookie, err := s.read(ctx, params.Zookie, func(q *queries.Queries, sch *schema) error {
+		ev := s.evaluator(q, sch, params.Context)
+		ev.tracer = &tracer{}
+
+		var err error
+		e.Decision, err = ev.check(ctx, params.Object, params.Relation, params.Subject, 0)
+		e.Tree = ev.tracer.root
+		return err
+	})
+
+	return e, zookie, err
+}
+
+// Expand implements [core.ReBACStore].
+func (s *Store) Expand(ctx context.Context, params core.ExpandParams) (core.UsersetTree, core.Zookie, error) {
+	if err := validateObject(params.Object); err != nil {
+		return core.UsersetTree{}, "", err
+	}
+
+	var tree core.UsersetTree
+
+	zookie, err := s.read(ctx, params.Zookie, func(q *queries.Queries, sch *schema) error {
+		var err error
+		tree, err = s.evaluator(q, sch, params.Context).expand(ctx, params.Object, params.Relation, 0)
+		return err
+	})
+
+	return tree, zookie, err
+}
+
+// ListObjects implements [core.ReBACStore]. It checks the objects of the namespace which appear in a tuple, as objects
+// without tuples cannot have relations. At most [Config.MaxListCandidates] objects are checked per page.
+func (s *Store) ListObjects(ctx context.Context, params core.ListObjectsParams) (core.ObjectPage, error) {
+	if err := validateName("namespace", params.Namespace); err != nil {
+		return core.ObjectPage{}, err
+	}
+
+	if err := validateSubject(params.Subject); err != nil {
+		return core.ObjectPage{}, err
+	}
+
+	after, err := decodeIDCursor(params.Cursor)
+	if err != nil {
+		return core.ObjectPage{}, err
+	}
+
+	var page core.ObjectPage
+
+	page.Zookie, err = s.read(ctx, params.Zookie, func(q *queries.Queries, sch *schema) error {
+		if _, err := sch.lookup(params.Namespace, params.Relation); err != nil {
+			return err
+		}
+
+		ev := s.evaluator(q, sch, params.Context)
+
+		var err error
+		page.ObjectIDs, page.NextCursor, err = collect(after, int(cursor.Limit(params.Limit)), s.config.MaxListCandidates,
+			func(after string) ([]string, error) {
+				ids, err := q.ListRelationObjectIDs(ctx, queries.ListRelationObjectIDsParams{
+					Namespace: params.Namespace,
+					After:     after,
+					Limit:     candidateBatch,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("rebac: list relation object ids: %w", err)
+				}
+				return ids, nil
+			},
+			func(id string) (bool, error) {
+				d, err := ev.check(ctx, core.ObjectRef{Namespace: params.Namespace, ID: id}, params.Relation, params.Subject, 0)
+				return d.Allowed, err
+			},
+		)
+		return err
+	})
+
+	return page, err
+}
+
+// ListSubjects implements [core.ReBACStore]. It checks the objects of the subject namespace which are the subject of a
+// tuple, as objects which are not cannot have relations. At most [Config.MaxListCandidates] objects are checked per
+// page.
+func (s *Store) ListSubjects(ctx context.Context, params core.ListSubjectsParams) (core.SubjectPage, error) {
+	if err := validateObject(params.Object); err != nil {
+		return core.SubjectPage{}, err
+	}
+
+	if err := validateName("namespace", params.SubjectNamespace); err != nil {
+		return core.SubjectPage{}, err
+	}
+
+	after, err := decodeIDCursor(params.Cursor)
+	if err != nil {
+		return core.SubjectPage{}, err
+	}
+
+	var page core.SubjectPage
+
+	page.Zookie, err = s.read(ctx, params.Zookie, func(q *queries.Queries, sch *schema) error {
+		if _, err := sch.lookup(params.Object.Namespace, params.Relation); err != nil {
+			return err
+		}
+
+		ev := s.evaluator(q, sch, params.Context)
+
+		var err error
+		page.SubjectIDs, page.NextCursor, err = collect(after, int(cursor.Limit(params.Limit)), s.config.MaxListCandidates,
+			func(after string) ([]string, error) {
+				ids, err := q.ListRelationSubjectIDs(ctx, queries.ListRelationSubjectIDsParams{
+					SubjectNamespace: params.SubjectNamespace,
+					After:            after,
+					Limit:            candidateBatch,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("rebac: list relation subject ids: %w", err)
+				}
+				return ids, nil
+			},
+			func(id string) (bool, error) {
+				d, err := ev.check(ctx, params.Object, params.Relation, core.SubjectRef{Namespace: params.SubjectNamespace, ID: id}, 0)
+				return d.Allowed, err
+			},
+		)
+		return err
+	})
+
+	return page, err
+}
+
+// collect loads candidates ordered by id after the cursor and returns up to limit ids which pass check, along with
+// the cursor of the next page. At most maxCandidates are checked, so a page can hold fewer ids, even none, and still
+// be followed by another one.
+func collect(after string, limit, maxCandidates int, load func(after string) ([]string, error), check func(id string) (bool, error)) ([]string, string, error) {
+	ids := make([]string, 0)
+	checked := 0
+
+	for {
+		candidates, err := load(after)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, id := range candidates {
+			if checked == maxCandidates {
+				return ids, encodeIDCursor(after), nil
+			}
+
+			after = id
+			checked++
+
+			ok, err := check(id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if !ok {
+				continue
+			}
+
+			ids = append(ids, id)
+			if len(ids) == limit {
+				return ids, encodeIDCursor(id), nil
+			}
+		}
+
+		if len(candidates) < candidateBatch {
+			return ids, "", nil
+		}
+	}
+}
+
+// read runs fn in a read only snapshot which is at least as fresh as zookie and returns the zookie of the snapshot.
+func (s *Store) read(ctx context.Context, zookie core.Zookie, fn func(q *queries.Queries, sch *schema) error) (core.Zookie, error) {
+	want, err := decodeZookie(zookie)
+	if err != nil {
+		return "", err
+	}
+
+	var revision int64
+
+	err = pgx.BeginTxFunc(ctx, s.pool, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}, func(tx pgx.Tx) error {
+		q := s.q.WithTx(tx)
+
+		var err error
+		if revision, err = q.GetRelationRevision(ctx); err != nil {
+			return fmt.Errorf("rebac: get relation revision: %w", err)
+		}
+
+		// All writes go to the same database, so only zookies which were not issued by it can be ahead.
+		if revision < want {
+			return fmt.Errorf("rebac: zookie is ahead of the latest revision: %w", core.ErrInvalidArgument)
+		}
+
+		sch, err := s.loadSchema(ctx, q)
+		if err != nil {
+			return err
+		}
+
+		return fn(q, sch)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return encodeZookie(revision), nil
+}
+
+// loadSchema returns the latest schema visible to q. Parsed schemas are cached until a new schema is written.
+func (s *Store) loadSchema(ctx context.Context, q *queries.Queries) (*schema, error) {
+	revision, err := q.GetLatestRelationSchemaRevision(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("rebac: get latest relation schema revision: %w", err)
+	}
+
+	s.mu.Lock()
+	cached := s.schema
+	s.mu.Unlock()
+
+	if cached != nil && cached.revision == revision {
+		return cached, nil
+	}
+
+	// Nothing is defined until the first schema is written.
+	if revision == 0 {
+		return &schema{relations: map[string]map[string]*relationDef{}}, nil
+	}
+
+	row, err := q.GetLatestRelationSchema(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("rebac: get latest relation schema: %w", err)
+	}
+
+	sch, err := parseSchema(row.Definition, row.Revision)
+	if err != nil {
+		return nil, fmt.Errorf("rebac: parse stored schema: %w", err)
+	}
+
+	s.mu.Lock()
+	if s.schema == nil || s.schema.revision < sch.revision {
+		s.schema = sch
+	}
+	s.mu.Unlock()
+
+	return sch, nil
+}
+
+// evaluator creates an evaluator for a single request reading with q.
+func (s *Store) evaluator(q *queries.Queries, sch *schema, attrs core.AttributeContext) *evaluator {
+	r := reader{q: q, engine: s.engine, attrs: attrs, expressions: make(map[string]string)}
+	return newEvaluator(sch, r, s.config.MaxDepth)
+}
+
+// reader reads tuples with queries bound to a transaction and evaluates their conditions for attrs. The expressions
+// of conditions are read along with the tuples.
+type reader struct {
+	q           *queries.Queries
+	engine      *abac.Engine
+	attrs       core.AttributeContext
+	expressions map[string]string // Expressions of the conditions of read tuples by name.
+}
+
+func (r reader) tuple(ctx context.Context, t core.RelationTuple) (core.RelationTuple, bool, error) {
+	row, err := r.q.GetRelationTupleCondition(ctx, queries.GetRelationTupleConditionParams{
+		Namespace:        t.Object.Namespace,
+		ObjectID:         t.Object.ID,
+		Relation:         t.Relation,
+		SubjectNamespace: t.Subject.Namespace,
+		SubjectID:        t.Subject.ID,
+		SubjectRelation:  t.Subject.Relation,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return core.RelationTuple{}, false, nil
+	}
+	if err != nil {
+		return core.RelationTuple{}, false, fmt.Errorf("rebac: get relation tuple condition: %w", err)
+	}
+
+	t.Condition = r.read(row.Condition, row.Expression)
+
+	return t, true, nil
+}
+
+func (r reader) subjects(ctx context.Context, object core.ObjectRef, relation string, onlyUsersets bool) ([]core.RelationTuple, error) {
+	rows, err := r.q.ListRelationTupleSubjects(ctx, queries.ListRelationTupleSubjectsParams{
+		Namespace:    object.Namespace,
+		ObjectID:     object.ID,
+		Relation:     relation,
+		OnlyUsersets: onlyUsersets,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("rebac: list relation tuple subjects: %w", err)
+	}
+
+	tuples := make([]core.RelationTuple, 0, len(rows))
+	for _, row := range rows {
+		tuples = append(tuples, core.RelationTuple{
+			Object:    object,
+			Relation:  relation,
+			Subject:   core.SubjectRef{Namespace: row.SubjectNamespace, ID: row.SubjectID, Relation: row.SubjectRelation},
+			Condition: r.read(row.Condition, row.Expression),
+		})
+	}
+
+	return tuples, nil
+}
+
+func (r reader) condition(ctx context.Context, t core.RelationTuple) core.ExplainNode {
+	return r.engine.Explain(ctx, t.Condition, r.expressions[t.Condition], r.attrs)
+}
+
+// read remembers the expression of a condition read with a tuple and returns the name of the condition.
+func (r reader) read(condition, expression pgtype.Text) string {
+	if condition.Valid {
+		r.expressions[condition.String] = expression.String
+	}
+
+	return condition.String
+}
+
+func encodeIDCursor(id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(id))
+}
+
+// decodeIDCursor decodes a cursor created by [encodeIDCursor]. An empty cursor decodes to an empty id, which sorts
+// before all ids.
+func decodeIDCursor(c string) (string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(c)
+	if err != nil {
+		return "", fmt.Errorf("rebac: invalid cursor: %w", core.ErrInvalidArgument)
+	}
+
+	return string(b), nil
+}
+
+func optionalText(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: s != ""}
+}
+
+func toTuple(t queries.RelationTuple) core.RelationTuple {
+	return core.RelationTuple{
+		Object:    core.ObjectRef{Namespace: t.Namespace, ID: t.ObjectID},
+		Relation:  t.Relation,
+		Subject:   core.SubjectRef{Namespace: t.SubjectNamespace, ID: t.SubjectID, Relation: t.SubjectRelation},
+		Condition: t.Condition.String,
+	}
+}
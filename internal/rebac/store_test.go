@@ -0,0 +1,264 @@
+package rebac
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/abac"
+	"github.com/gophero/guardian/internal/db/dbtest"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	pool := dbtest.Pool(t)
+
+	engine, err := abac.NewEngine(abac.Config{CostLimit: 10000, MaxExpressionLength: 4096, CacheSize: 100})
+	require.NoError(t, err)
+
+	// Few candidates are checked per page, so listing spans several pages.
+	s, err := NewStore(pool, Config{MaxDepth: 25, MaxListCandidates: 2}, engine)
+	require.NoError(t, err)
+
+	// Tests may share the database, which has a single schema. Tuples stored by earlier runs only use its namespaces.
+	_, err = s.WriteSchema(ctx, testSchema)
+	require.NoError(t, err)
+
+	// newID returns an object id no other test uses.
+	newID := func(name string) string {
+		return name + "-" + uuid.NewString()[:8]
+	}
+
+	revision := func(t *testing.T, z core.Zookie) int64 {
+		t.Helper()
+
+		r, err := decodeZookie(z)
+		require.NoError(t, err)
+
+		return r
+	}
+
+	check := func(t *testing.T, z core.Zookie, c string) bool {
+		t.Helper()
+
+		tc := tuple(c)
+
+		d, read, err := s.Check(ctx, core.CheckParams{Object: tc.Object, Relation: tc.Relation, Subject: tc.Subject, Zookie: z})
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, revision(t, read), revision(t, z))
+
+		return d.Allowed
+	}
+
+	t.Run("writes and deletes tuples", func(t *testing.T) {
+		team, doc, user := newID("team"), newID("doc"), newID("user")
+		member := tuple("team:" + team + "#member@user:" + user)
+		editor := tuple("document:" + doc + "#editor@team:" + team + "#member")
+
+		written, err := s.WriteTuples(ctx, []core.RelationTuple{member, editor}, nil)
+		require.NoError(t, err)
+		require.True(t, check(t, written, "document:"+doc+"#edit@user:"+user))
+
+		page, err := s.ReadTuples(ctx, core.ReadTuplesParams{
+			Filter: core.TupleFilter{Namespace: "document", ObjectID: doc},
+			Zookie: written,
+		})
+		require.NoError(t, err)
+		require.Len(t, page.Tuples, 1)
+		require.Equal(t, editor.String(), page.Tuples[0].String())
+
+		deleted, err := s.WriteTuples(ctx, nil, []core.RelationTuple{member})
+		require.NoError(t, err)
+		require.False(t, check(t, deleted, "document:"+doc+"#edit@user:"+user))
+
+		// Deleting a missing tuple is no error.
+		_, err = s.WriteTuples(ctx, nil, []core.RelationTuple{member})
+		require.NoError(t, err)
+
+		_, err = s.WriteTuples(ctx, nil, nil)
+		require.ErrorIs(t, err, core.ErrInvalidArgument)
+	})
+
+	t.Run("writes tuples atomically", func(t *testing.T) {
+		doc, user := newID("doc"), newID("user")
+
+		// Only users can own documents, so the second tuple is rejected and neither is written.
+		_, err := s.WriteTuples(ctx, []core.RelationTuple{
+			tuple("document:" + doc + "#owner@user:" + user),
+			tuple("document:" + doc + "#owner@document:" + doc),
+		}, nil)
+		require.ErrorIs(t, err, core.ErrInvalidArgument)
+
+		page, err := s.ReadTuples(ctx, core.ReadTuplesParams{Filter: core.TupleFilter{Namespace: "document", ObjectID: doc}})
+		require.NoError(t, err)
+		require.Empty(t, page.Tuples)
+	})
+
+	t.Run("orders revisions", func(t *testing.T) {
+		doc, user := newID("doc"), newID("user")
+
+		first, err := s.WriteTuples(ctx, []core.RelationTuple{tuple("document:" + doc + "#viewer@user:" + user)}, nil)
+		require.NoError(t, err)
+
+		second, err := s.WriteTuples(ctx, nil, []core.RelationTuple{tuple("document:" + doc + "#viewer@user:" + user)})
+		require.NoError(t, err)
+		require.Greater(t, revision(t, second), revision(t, first))
+
+		// Reads are at least as fresh as the zookie, so they observe the later write.
+		require.False(t, check(t, first, "document:"+doc+"#viewer@user:"+user))
+
+		// Zookies ahead of the latest revision were not issued by the store.
+		_, _, err = s.Check(ctx, core.CheckParams{
+			Object:   core.ObjectRef{Namespace: "document", ID: doc},
+			Relation: "viewer",
+			Subject:  core.SubjectRef{Namespace: "user", ID: user},
+			Zookie:   encodeZookie(revision(t, second) + 1<<40),
+		})
+		require.ErrorIs(t, err, core.ErrInvalidArgument)
+	})
+
+	t.Run("lists objects and subjects over pages", func(t *testing.T) {
+		user, other := newID("user"), newID("user")
+		docs := []string{newID("doc"), newID("doc"), newID("doc")}
+
+		var writes []core.RelationTuple
+		for _, doc := range docs {
+			writes = append(writes, tuple("document:"+doc+"#owner@user:"+user))
+		}
+		writes = append(writes, tuple("document:"+docs[0]+"#viewer@user:"+other))
+
+		z, err := s.WriteTuples(ctx, writes, nil)
+		require.NoError(t, err)
+
+		var objects []string
+		for c := ""; ; {
+			page, err := s.ListObjects(ctx, core.ListObjectsParams{
+				Namespace: "document",
+				Relation:  "edit",
+				Subject:   core.SubjectRef{Namespace: "user", ID: user},
+				Zookie:    z,
+				Cursor:    c,
+				Limit:     2,
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(page.ObjectIDs), 2)
+
+			objects = append(objects, page.ObjectIDs...)
+			if c = page.NextCursor; c == "" {
+				break
+			}
+		}
+
+		require.ElementsMatch(t, docs, objects)
+
+		var subjects []string
+		for c := ""; ; {
+			page, err := s.ListSubjects(ctx, core.ListSubjectsParams{
+				Object:           core.ObjectRef{Namespace: "document", ID: docs[0]},
+				Relation:         "viewer",
+				SubjectNamespace: "user",
+				Zookie:           z,
+				Cursor:           c,
+				Limit:            2,
+			})
+			require.NoError(t, err)
+
+			subjects = append(subjects, page.SubjectIDs...)
+			if c = page.NextCursor; c == "" {
+				break
+			}
+		}
+
+		require.ElementsMatch(t, []string{user, other}, subjects)
+	})
+}
+
+func TestCollect(t *testing.T) {
+	var candidates []string
+	for i := range 250 {
+		candidates = append(candidates, fmt.Sprintf("%03d", i))
+	}
+
+	load := func(after string) ([]string, error) {
+		i, _ := slices.BinarySearch(candidates, after)
+		if i < len(candidates) && candidates[i] == after {
+			i++
+		}
+		return candidates[i:min(i+candidateBatch, len(candidates))], nil
+	}
+	even := func(id string) (bool, error) {
+		return id[2]%2 == 0, nil
+	}
+
+	var (
+		all    []string
+		cursor string
+	)
+
+	for range 10 {
+		after, err := decodeIDCursor(cursor)
+		require.NoError(t, err)
+
+		ids, next, err := collect(after, 50, 1000, load, even)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(ids), 50)
+
+		all = append(all, ids...)
+		if cursor = next; cursor == "" {
+			break
+		}
+	}
+
+	require.Len(t, all, 125)
+	require.True(t, slices.IsSorted(all))
+	require.Empty(t, cursor)
+}
+
+func TestCollectMaxCandidates(t *testing.T) {
+	var candidates []string
+	for i := range 250 {
+		candidates = append(candidates, fmt.Sprintf("%03d", i))
+	}
+
+	load := func(after string) ([]string, error) {
+		i, _ := slices.BinarySearch(candidates, after)
+		if i < len(candidates) && candidates[i] == after {
+			i++
+		}
+		return candidates[i:min(i+candidateBatch, len(candidates))], nil
+	}
+
+	checked := 0
+	none := func(string) (bool, error) {
+		checked++
+		return false, nil
+	}
+
+	var (
+		pages  int
+		cursor string
+	)
+
+	for {
+		after, err := decodeIDCursor(cursor)
+		require.NoError(t, err)
+
+		// Pages without ids continue after the last checked candidate.
+		ids, next, err := collect(after, 50, 60, load, none)
+		require.NoError(t, err)
+		require.Empty(t, ids)
+
+		pages++
+		if cursor = next; cursor == "" {
+			break
+		}
+	}
+
+	require.Equal(t, 5, pages)
+	require.Equal(t, 250, checked)
+}
@@ -0,0 +1,36 @@
+package rebac
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/gophero/guardian/core"
+)
+
+// zookieVersion prefixes encoded zookies, so their format can change.
+const zookieVersion = 1
+
+func encodeZookie(revision int64) core.Zookie {
+	b := binary.AppendUvarint([]byte{zookieVersion}, uint64(revision))
+	return core.Zookie(base64.RawURLEncoding.EncodeToString(b))
+}
+
+// decodeZookie returns the revision of z. An empty zookie decodes to revision zero.
+func decodeZookie(z core.Zookie) (int64, error) {
+	if z == "" {
+		return 0, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(string(z))
+	if err != nil || len(b) < 2 || b[0] != zookieVersion {
+		return 0, fmt.Errorf("rebac: invalid zookie: %w", core.ErrInvalidArgument)
+	}
+
+	revision, n := binary.Uvarint(b[1:])
+	if n != len(b)-1 || revision > 1<<62 {
+		return 0, fmt.Errorf("rebac: invalid zookie: %w", core.ErrInvalidArgument)
+	}
+
+	return int64(revision), nil
+}
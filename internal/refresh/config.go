@@ -0,0 +1,28 @@
+package refresh
+
+import (
+	"errors"
+	"time"
+)
+
+type Config struct {
+	TTL            time.Duration `help:"Duration for which a refresh token can be used. Each rotation issues a token valid for this duration." name:"ttl" env:"TTL" default:"720h"`
+	FamilyTTL      time.Duration `help:"Duration after which a token family expires regardless of rotations." name:"family_ttl" env:"FAMILY_TTL" default:"2160h"`
+	RetentionAfter time.Duration `help:"Duration for which expired and revoked token families are kept before deletion." name:"retention_after" env:"RETENTION_AFTER" default:"720h"`
+}
+
+func (c Config) validate() error {
+	if c.TTL <= 0 {
+		return errors.New("refresh: TTL cannot be zero or negative")
+	}
+
+	if c.FamilyTTL < c.TTL {
+		return errors.New("refresh: FamilyTTL cannot be less than TTL")
+	}
+
+	if c.RetentionAfter < 0 {
+		return errors.New("refresh: RetentionAfter cannot be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package refresh
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type metrics struct {
+	issued  prometheus.Counter
+	rotated prometheus.Counter
+	reused  prometheus.Counter
+	revoked *prometheus.CounterVec
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		issued: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "guardian",
+			Subsystem: "refresh_token",
+			Name:      "issued_total",
+			Help:      "The cumulative count of started refresh token families.",
+		}),
+		rotated: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "guardian",
+			Subsystem: "refresh_token",
+			Name:      "rotated_total",
+			Help:      "The cumulative count of refresh token rotations.",
+		}),
+		reused: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "guardian",
+			Subsystem: "refresh_token",
+			Name:      "reused_total",
+			Help:      "The cumulative count of detected reuses of already rotated refresh tokens.",
+		}),
+		revoked: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "guardian",
+			Subsystem: "refresh_token",
+			Name:      "families_revoked_total",
+			Help:      "The cumulative count of revoked refresh token families labeled by reason.",
+		}, []string{"reason"}),
+	}
+}
+
+// Describe implements [prometheus.Collector].
+func (m *metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.issued.Describe(ch)
+	m.rotated.Describe(ch)
+	m.reused.Describe(ch)
+	m.revoked.Describe(ch)
+}
+
+// Collect implements [prometheus.Collector].
+func (m *metrics) Collect(ch chan<- prometheus.Metric) {
+	m.issued.Collect(ch)
+	m.rotated.Collect(ch)
+	m.reused.Collect(ch)
+	m.revoked.Collect(ch)
+}
@@ -0,0 +1,334 @@
+package refresh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/internal/db/queries"
+	"github.com/gophero/guardian/internal/secret"
+)
+
+// errReused is returned from the rotation transaction if the token was used concurrently.
+var errReused = errors.New("refresh: token reused")
+
+// Store is a postgres backed [core.RefreshTokenStore]. It is also a [prometheus.Collector] exporting refresh token
+// metrics.
+type Store struct {
+	config Config
+	pool   *pgxpool.Pool
+	q      *queries.Queries
+	audit  core.AuditLog
+	now    func() time.Time
+
+	*metrics
+}
+
+var (
+	_ core.RefreshTokenStore = (*Store)(nil)
+	_ prometheus.Collector   = (*Store)(nil)
+)
+
+// NewStore constructs new [Store]. Reuse of refresh tokens is recorded to audit.
+func NewStore(pool *pgxpool.Pool, config Config, audit core.AuditLog) (*Store, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		config:  config,
+		pool:    pool,
+		q:       queries.New(pool),
+		audit:   audit,
+		now:     time.Now,
+		metrics: newMetrics(),
+	}, nil
+}
+
+// Issue implements [core.RefreshTokenStore].
+func (s *Store) Issue(ctx context.Context, params core.IssueRefreshTokenParams) (core.RefreshToken, string, error) {
+	var sessionID *uuid.UUID
+	if params.SessionID != uuid.Nil {
+		sessionID = &params.SessionID
+	}
+
+	scope := params.Scope
+	if scope == nil {
+		scope = []string{}
+	}
+
+	token := secret.New(secret.DefaultSize)
+	now := s.now()
+
+	var rt core.RefreshToken
+	err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		q := s.q.WithTx(tx)
+
+		family, err := q.CreateRefreshTokenFamily(ctx, queries.CreateRefreshTokenFamilyParams{
+			UserID:    params.UserID,
+			SessionID: sessionID,
+			ClientID:  params.ClientID,
+			Scope:     scope,
+			ExpiresAt: now.Add(s.config.FamilyTTL),
+		})
+		if err != nil {
+			if db.IsForeignKeyViolation(err) {
+				return fmt.Errorf("refresh: create refresh token family: %w", core.ErrNotFound)
+			}
+			return fmt.Errorf("refresh: create refresh token family: %w", err)
+		}
+
+		t, err := q.CreateRefreshToken(ctx, queries.CreateRefreshTokenParams{
+			FamilyID:  family.ID,
+			TokenHash: secret.Hash(token),
+			ExpiresAt: now.Add(s.config.TTL),
+		})
+		if err != nil {
+			return fmt.Errorf("refresh: create refresh token: %w", err)
+		}
+
+		rt = core.RefreshToken{
+			ID:        t.ID,
+			FamilyID:  family.ID,
+			UserID:    family.UserID,
+			SessionID: params.SessionID,
+			ClientID:  family.ClientID,
+			Scope:     family.Scope,
+			ExpiresAt: t.ExpiresAt,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return core.RefreshToken{}, "", err
+	}
+
+	s.issued.Inc()
+
+	return rt, token, nil
+}
+
+// Rotate implements [core.RefreshTokenStore].
+func (s *Store) Rotate(ctx context.Context, token string, meta core.SessionMetadata) (core.RefreshToken, string, error) {
+	row, err := s.q.GetRefreshTokenByHash(ctx, secret.Hash(token))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return core.RefreshToken{}, "", core.ErrInvalidToken
+	}
+
+	if err != nil {
+		return core.RefreshToken{}, "", fmt.Errorf("refresh: get refresh token by hash: %w", err)
+	}
+
+	if row.FamilyRevokedAt != nil {
+		return core.RefreshToken{}, "", core.ErrInvalidToken
+	}
+
+	if row.UsedAt != nil {
+		return core.RefreshToken{}, "", s.handleReuse(ctx, row, meta)
+	}
+
+	now := s.now()
+	if !now.Before(row.ExpiresAt) || !now.Before(row.FamilyExpiresAt) {
+		return core.RefreshToken{}, "", core.ErrInvalidToken
+	}
+
+	next := secret.New(secret.DefaultSize)
+
+	var rt core.RefreshToken
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		q := s.q.WithTx(tx)
+
+		n, err := q.UseRefreshToken(ctx, row.ID)
+		if err != nil {
+			return fmt.Errorf("refresh: use refresh token: %w", err)
+		}
+
+		if n == 0 {
+			return errReused
+		}
+
+		// Rotated tokens never outlive their family.
+		expiresAt := now.Add(s.config.TTL)
+		if expiresAt.After(row.FamilyExpiresAt) {
+			expiresAt = row.FamilyExpiresAt
+		}
+
+		t, err := q.CreateRefreshToken(ctx, queries.CreateRefreshTokenParams{
+			FamilyID:  row.FamilyID,
+			ParentID:  &row.ID,
+			TokenHash: secret.Hash(next),
+			ExpiresAt: expiresAt,
+		})
+		if err != nil {
+			return fmt.Errorf("refresh: create refresh token: %w", err)
+		}
+
+		rt = toRefreshToken(row)
+		rt.ID, rt.ExpiresAt = t.ID, t.ExpiresAt
+
+		return nil
+	})
+	if errors.Is(err, errReused) {
+		return core.RefreshToken{}, "", s.handleReuse(ctx, row, meta)
+	}
+
+	if err != nil {
+		return core.RefreshToken{}, "", err
+	}
+
+	s.rotated.Inc()
+
+	return rt, next, nil
+}
+
+// Get implements [core.RefreshTokenStore].
+func (s *Store) Get(ctx context.Context, token string) (core.RefreshToken, error) {
+	row, err := s.q.GetRefreshTokenByHash(ctx, secret.Hash(token))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return core.RefreshToken{}, core.ErrInvalidToken
+	}
+
+	if err != nil {
+		return core.RefreshToken{}, fmt.Errorf("refresh: get refresh token by hash: %w", err)
+	}
+
+	now := s.now()
+	if row.FamilyRevokedAt != nil || row.UsedAt != nil || !now.Before(row.ExpiresAt) || !now.Before(row.FamilyExpiresAt) {
+		return core.RefreshToken{}, core.ErrInvalidToken
+	}
+
+	return toRefreshToken(row), nil
+}
+
+// FamilyActive implements [core.RefreshTokenStore].
+func (s *Store) FamilyActive(ctx context.Context, familyID uuid.UUID) (bool, error) {
+	family, err := s.q.GetRefreshTokenFamily(ctx, familyID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("refresh: get refresh token family: %w", err)
+	}
+
+	return family.RevokedAt == nil && s.now().Before(family.ExpiresAt), nil
+}
+
+// handleReuse revokes the family of a reused token and records an audit event. It returns [core.ErrInvalidToken] unless
+// revoking the family fails.
+func (s *Store) handleReuse(ctx context.Context, row queries.GetRefreshTokenByHashRow, meta core.SessionMetadata) error {
+	s.reused.Inc()
+
+	logger := zerolog.Ctx(ctx).With().
+		Stringer("family_id", row.FamilyID).
+		Stringer("user_id", row.UserID).
+		Logger()
+
+	logger.Warn().Stringer("token_id", row.ID).Msg("refresh token reuse detected, revoking family")
+
+	if err := s.RevokeFamily(ctx, row.FamilyID, core.RefreshTokenRevokeReused); err != nil && !errors.Is(err, core.ErrNotFound) {
+		return err
+	}
+
+	if err := s.audit.Record(ctx, core.AuditEvent{
+		Type:      core.AuditRefreshTokenReused,
+		UserID:    row.UserID,
+		IPAddress: meta.IPAddress,
+		UserAgent: meta.UserAgent,
+		Metadata: map[string]string{
+			"family_id": row.FamilyID.String(),
+			"token_id":  row.ID.String(),
+			"client_id": row.ClientID,
+		},
+	}); err != nil {
+		// The family is revoked already, failing the request would not make it any safer.
+		logger.Err(err).Msg("failed to record refresh token reuse")
+	}
+
+	return core.ErrInvalidToken
+}
+
+// RevokeFamily implements [core.RefreshTokenStore].
+func (s *Store) RevokeFamily(ctx context.Context, familyID uuid.UUID, reason core.RefreshTokenRevokeReason) error {
+	n, err := s.q.RevokeRefreshTokenFamily(ctx, queries.RevokeRefreshTokenFamilyParams{
+		ID:            familyID,
+		RevokedReason: string(reason),
+	})
+	if err != nil {
+		return fmt.Errorf("refresh: revoke refresh token family: %w", err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("refresh: revoke refresh token family: %w", core.ErrNotFound)
+	}
+
+	s.revoked.WithLabelValues(string(reason)).Inc()
+
+	return nil
+}
+
+// RevokeSession implements [core.RefreshTokenStore].
+func (s *Store) RevokeSession(ctx context.Context, sessionID uuid.UUID, reason core.RefreshTokenRevokeReason) (int64, error) {
+	n, err := s.q.RevokeSessionRefreshTokenFamilies(ctx, queries.RevokeSessionRefreshTokenFamiliesParams{
+		RevokedReason: string(reason),
+		SessionID:     sessionID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("refresh: revoke session refresh token families: %w", err)
+	}
+
+	s.revoked.WithLabelValues(string(reason)).Add(float64(n))
+
+	return n, nil
+}
+
+// RevokeUser implements [core.RefreshTokenStore].
+func (s *Store) RevokeUser(ctx context.Context, userID uuid.UUID, reason core.RefreshTokenRevokeReason) (int64, error) {
+	n, err := s.q.RevokeUserRefreshTokenFamilies(ctx, queries.RevokeUserRefreshTokenFamiliesParams{
+		UserID:        userID,
+		RevokedReason: string(reason),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("refresh: revoke user refresh token families: %w", err)
+	}
+
+	s.revoked.WithLabelValues(string(reason)).Add(float64(n))
+
+	return n, nil
+}
+
+// DeleteExpired deletes token families which expired or were revoked longer than the configured retention ago.
+func (s *Store) DeleteExpired(ctx context.Context) (int64, error) {
+	n, err := s.q.DeleteExpiredRefreshTokenFamilies(ctx, s.now().Add(-s.config.RetentionAfter))
+	if err != nil {
+		return 0, fmt.Errorf("refresh: delete expired refresh token families: %w", err)
+	}
+
+	return n, nil
+}
+
+func toRefreshToken(row queries.GetRefreshTokenByHashRow) core.RefreshToken {
+	var sessionID uuid.UUID
+	if row.SessionID != nil {
+		sessionID = *row.SessionID
+	}
+
+	return core.RefreshToken{
+		ID:        row.ID,
+		FamilyID:  row.FamilyID,
+		UserID:    row.UserID,
+		SessionID: sessionID,
+		ClientID:  row.ClientID,
+		Scope:     row.Scope,
+		ExpiresAt: row.ExpiresAt,
+	}
+}
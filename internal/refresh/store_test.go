@@ -0,0 +1,160 @@
+package refresh
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db/dbtest"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+// fakeAuditLog keeps recorded events in memory.
+type fakeAuditLog struct {
+	mu     sync.Mutex
+	events []core.AuditEvent
+}
+
+func (f *fakeAuditLog) Record(_ context.Context, event core.AuditEvent) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.events = append(f.events, event)
+	return nil
+}
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	pool := dbtest.Pool(t)
+	q := queries.New(pool)
+
+	newTestStore := func(t *testing.T) (*Store, *fakeAuditLog, *time.Time) {
+		t.Helper()
+
+		audit := &fakeAuditLog{}
+		s, err := NewStore(pool, Config{TTL: time.Hour, FamilyTTL: 90 * time.Minute}, audit)
+		require.NoError(t, err)
+
+		// Postgres stores microseconds.
+		now := time.Now().Truncate(time.Microsecond)
+		s.now = func() time.Time { return now }
+
+		return s, audit, &now
+	}
+
+	issue := func(t *testing.T, s *Store) (core.RefreshToken, string) {
+		t.Helper()
+
+		suffix := uuid.NewString()[:8]
+
+		user, err := q.CreateUser(ctx, queries.CreateUserParams{
+			Email:    "refresh-" + suffix + "@example.com",
+			Username: "refresh-" + suffix,
+			Status:   queries.UserStatusActive,
+		})
+		require.NoError(t, err)
+
+		rt, token, err := s.Issue(ctx, core.IssueRefreshTokenParams{UserID: user.ID, Scope: []string{"openid"}})
+		require.NoError(t, err)
+
+		return rt, token
+	}
+
+	t.Run("revokes the family on reuse", func(t *testing.T) {
+		s, audit, _ := newTestStore(t)
+		first, token := issue(t, s)
+
+		rotated, next, err := s.Rotate(ctx, token, core.SessionMetadata{})
+		require.NoError(t, err)
+		require.Equal(t, first.FamilyID, rotated.FamilyID)
+		require.NotEqual(t, first.ID, rotated.ID)
+		require.Equal(t, []string{"openid"}, rotated.Scope)
+
+		// The rotated token is no longer valid, presenting it again revokes the whole family.
+		_, err = s.Get(ctx, token)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+
+		_, _, err = s.Rotate(ctx, token, core.SessionMetadata{})
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+
+		_, _, err = s.Rotate(ctx, next, core.SessionMetadata{})
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+
+		active, err := s.FamilyActive(ctx, first.FamilyID)
+		require.NoError(t, err)
+		require.False(t, active)
+
+		require.Len(t, audit.events, 1)
+		require.Equal(t, core.AuditRefreshTokenReused, audit.events[0].Type)
+		require.Equal(t, first.FamilyID.String(), audit.events[0].Metadata["family_id"])
+	})
+
+	t.Run("rotates a token once under concurrency", func(t *testing.T) {
+		s, _, _ := newTestStore(t)
+		first, token := issue(t, s)
+
+		const n = 8
+
+		var wg sync.WaitGroup
+		errs := make([]error, n)
+
+		for i := range n {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _, errs[i] = s.Rotate(ctx, token, core.SessionMetadata{})
+			}()
+		}
+
+		wg.Wait()
+
+		var rotated int
+		for _, err := range errs {
+			if err == nil {
+				rotated++
+				continue
+			}
+
+			require.ErrorIs(t, err, core.ErrInvalidToken)
+		}
+
+		require.Equal(t, 1, rotated)
+
+		// The losing rotations were reuses of the token, so the family is revoked.
+		active, err := s.FamilyActive(ctx, first.FamilyID)
+		require.NoError(t, err)
+		require.False(t, active)
+	})
+
+	t.Run("caps expiry at the family lifetime", func(t *testing.T) {
+		s, _, now := newTestStore(t)
+		issuedAt := *now
+
+		first, token := issue(t, s)
+		require.True(t, first.ExpiresAt.Equal(issuedAt.Add(time.Hour)))
+
+		// A rotation after 50 minutes would be valid for another hour, past the family lifetime of 90 minutes.
+		*now = issuedAt.Add(50 * time.Minute)
+
+		rotated, next, err := s.Rotate(ctx, token, core.SessionMetadata{})
+		require.NoError(t, err)
+		require.True(t, rotated.ExpiresAt.Equal(issuedAt.Add(90*time.Minute)), "expires at %s", rotated.ExpiresAt)
+
+		*now = issuedAt.Add(90 * time.Minute)
+
+		_, err = s.Get(ctx, next)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+
+		_, _, err = s.Rotate(ctx, next, core.SessionMetadata{})
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+
+		active, err := s.FamilyActive(ctx, first.FamilyID)
+		require.NoError(t, err)
+		require.False(t, active)
+	})
+}
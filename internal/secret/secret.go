@@ -0,0 +1,27 @@
+// Package secret generates high entropy opaque tokens and hashes them for storage.
+//
+// Tokens are random, so a single round of SHA-256 is sufficient for storage. Unlike passwords they do not need a slow
+// hash function to resist brute force.
+package secret
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// DefaultSize is the default number of random bytes in a token, giving 256 bits of entropy.
+const DefaultSize = 32
+
+// New returns a URL safe token of size random bytes.
+func New(size int) string {
+	b := make([]byte, size)
+	rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Hash returns the SHA-256 hash of token.
+func Hash(token string) []byte {
+	h := sha256.Sum256([]byte(token))
+	return h[:]
+}
@@ -0,0 +1,33 @@
+package serviceaccount
+
+import (
+	"errors"
+	"time"
+)
+
+type Config struct {
+	RotationOverlap    time.Duration `help:"Default duration for which the previous secret of a rotated service account stays valid." name:"rotation_overlap" env:"ROTATION_OVERLAP" default:"24h"`
+	MaxRotationOverlap time.Duration `help:"Maximum duration for which the previous secret of a rotated service account stays valid." name:"max_rotation_overlap" env:"MAX_ROTATION_OVERLAP" default:"168h"`
+	MaxAssertionTTL    time.Duration `help:"Maximum lifetime of JWT client assertions. Identifiers of used assertions are kept until they expire to prevent replays." name:"max_assertion_ttl" env:"MAX_ASSERTION_TTL" default:"5m"`
+	Leeway             time.Duration `help:"Tolerated clock skew when validating the expiration of JWT client assertions." name:"leeway" env:"LEEWAY" default:"30s"`
+}
+
+func (c Config) validate() error {
+	if c.MaxRotationOverlap < 0 {
+		return errors.New("serviceaccount: MaxRotationOverlap cannot be negative")
+	}
+
+	if c.RotationOverlap < 0 || c.RotationOverlap > c.MaxRotationOverlap {
+		return errors.New("serviceaccount: RotationOverlap cannot be negative or greater than MaxRotationOverlap")
+	}
+
+	if c.MaxAssertionTTL <= 0 || c.MaxAssertionTTL > time.Hour {
+		return errors.New("serviceaccount: MaxAssertionTTL must be between zero and 1h")
+	}
+
+	if c.Leeway < 0 {
+		return errors.New("serviceaccount: Leeway cannot be negative")
+	}
+
+	return nil
+}
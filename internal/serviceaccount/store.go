@@ -0,0 +1,392 @@
+// Package serviceaccount manages service accounts, the non-human principals authenticating with the OAuth client
+// credentials grant.
+package serviceaccount
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/internal/db/queries"
+	"github.com/gophero/guardian/internal/secret"
+	"github.com/gophero/guardian/internal/token"
+)
+
+// Store is a postgres backed [core.ServiceAccountStore]. Identifiers of accepted client assertions are stored until
+// the assertions expire, see [Store.DeleteExpired].
+type Store struct {
+	config Config
+	q      queries.Querier
+	now    func() time.Time
+}
+
+var _ core.ServiceAccountStore = (*Store)(nil)
+
+// NewStore constructs new [Store].
+func NewStore(pool *pgxpool.Pool, config Config) (*Store, error) {
+	return newStore(queries.New(pool), config)
+}
+
+func newStore(q queries.Querier, config Config) (*Store, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return &Store{config: config, q: q, now: time.Now}, nil
+}
+
+// Create implements [core.ServiceAccountStore].
+func (s *Store) Create(ctx context.Context, params core.CreateServiceAccountParams) (core.ServiceAccount, string, error) {
+	scopes := normalize(params.Scopes)
+	if err := validateAccount(params.Name, params.Description, scopes); err != nil {
+		return core.ServiceAccount{}, "", err
+	}
+
+	accountSecret := secret.New(secret.DefaultSize)
+
+	a, err := s.q.CreateServiceAccount(ctx, queries.CreateServiceAccountParams{
+		Name:        params.Name,
+		Description: params.Description,
+		Scopes:      scopes,
+		SecretHash:  secret.Hash(accountSecret),
+	})
+	if err != nil {
+		return core.ServiceAccount{}, "", fmt.Errorf("serviceaccount: create service account: %w", mapError(err))
+	}
+
+	return toServiceAccount(a, s.now()), accountSecret, nil
+}
+
+// Get implements [core.ServiceAccountStore].
+func (s *Store) Get(ctx context.Context, id uuid.UUID) (core.ServiceAccount, error) {
+	a, err := s.q.GetServiceAccountByID(ctx, id)
+	if err != nil {
+		return core.ServiceAccount{}, fmt.Errorf("serviceaccount: get service account by id: %w", mapError(err))
+	}
+
+	return toServiceAccount(a, s.now()), nil
+}
+
+// List implements [core.ServiceAccountStore].
+func (s *Store) List(ctx context.Context) ([]core.ServiceAccount, error) {
+	as, err := s.q.ListServiceAccounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("serviceaccount: list service accounts: %w", err)
+	}
+
+	now := s.now()
+	res := make([]core.ServiceAccount, 0, len(as))
+	for _, a := range as {
+		res = append(res, toServiceAccount(a, now))
+	}
+
+	return res, nil
+}
+
+// Update implements [core.ServiceAccountStore].
+func (s *Store) Update(ctx context.Context, params core.UpdateServiceAccountParams) (core.ServiceAccount, error) {
+	scopes := normalize(params.Scopes)
+	if err := validateAccount(params.Name, params.Description, scopes); err != nil {
+		return core.ServiceAccount{}, err
+	}
+
+	a, err := s.q.UpdateServiceAccount(ctx, queries.UpdateServiceAccountParams{
+		ID:          params.ID,
+		Name:        params.Name,
+		Description: params.Description,
+		Scopes:      scopes,
+	})
+	if err != nil {
+		return core.ServiceAccount{}, fmt.Errorf("serviceaccount: update service account: %w", mapError(err))
+	}
+
+	return toServiceAccount(a, s.now()), nil
+}
+
+// Delete implements [core.ServiceAccountStore].
+func (s *Store) Delete(ctx context.Context, id uuid.UUID) error {
+	n, err := s.q.DeleteServiceAccount(ctx, id)
+	if err != nil {
+		return fmt.Errorf("serviceaccount: delete service account: %w", err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("serviceaccount: delete service account: %w", core.ErrNotFound)
+	}
+
+	return nil
+}
+
+// RotateSecret implements [core.ServiceAccountStore].
+func (s *Store) RotateSecret(ctx context.Context, id uuid.UUID, overlap *time.Duration) (core.ServiceAccount, string, error) {
+	d := s.config.RotationOverlap
+	if overlap != nil {
+		d = *overlap
+	}
+
+	if d < 0 || d > s.config.MaxRotationOverlap {
+		return core.ServiceAccount{}, "", fmt.Errorf("serviceaccount: overlap must be between 0 and %s: %w", s.config.MaxRotationOverlap, core.ErrInvalidArgument)
+	}
+
+	var previousExpiresAt *time.Time
+	if d > 0 {
+		t := s.now().Add(d)
+		previousExpiresAt = &t
+	}
+
+	accountSecret := secret.New(secret.DefaultSize)
+
+	a, err := s.q.RotateServiceAccountSecret(ctx, queries.RotateServiceAccountSecretParams{
+		PreviousExpiresAt: previousExpiresAt,
+		SecretHash:        secret.Hash(accountSecret),
+		ID:                id,
+	})
+	if err != nil {
+		return core.ServiceAccount{}, "", fmt.Errorf("serviceaccount: rotate service account secret: %w", mapError(err))
+	}
+
+	return toServiceAccount(a, s.now()), accountSecret, nil
+}
+
+// AddKey implements [core.ServiceAccountStore].
+func (s *Store) AddKey(ctx context.Context, id uuid.UUID, jwk []byte) (core.ServiceAccountKey, error) {
+	pub, err := token.ParseJWK(jwk)
+	if err != nil {
+		return core.ServiceAccountKey{}, fmt.Errorf("serviceaccount: %w", err)
+	}
+
+	der, err := pub.Marshal()
+	if err != nil {
+		return core.ServiceAccountKey{}, fmt.Errorf("serviceaccount: %w", err)
+	}
+
+	k, err := s.q.CreateServiceAccountKey(ctx, queries.CreateServiceAccountKeyParams{
+		ServiceAccountID: id,
+		KeyID:            pub.ID,
+		Algorithm:        string(pub.Algorithm),
+		PublicKey:        der,
+	})
+	if err != nil {
+		return core.ServiceAccountKey{}, fmt.Errorf("serviceaccount: create service account key: %w", mapError(err))
+	}
+
+	return toKey(k), nil
+}
+
+// ListKeys implements [core.ServiceAccountStore].
+func (s *Store) ListKeys(ctx context.Context, id uuid.UUID) ([]core.ServiceAccountKey, error) {
+	// Accounts without keys are told apart from unknown accounts.
+	if _, err := s.q.GetServiceAccountByID(ctx, id); err != nil {
+		return nil, fmt.Errorf("serviceaccount: get service account by id: %w", mapError(err))
+	}
+
+	ks, err := s.q.ListServiceAccountKeys(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("serviceaccount: list service account keys: %w", err)
+	}
+
+	res := make([]core.ServiceAccountKey, 0, len(ks))
+	for _, k := range ks {
+		res = append(res, toKey(k))
+	}
+
+	return res, nil
+}
+
+// DeleteKey implements [core.ServiceAccountStore].
+func (s *Store) DeleteKey(ctx context.Context, id, keyID uuid.UUID) error {
+	n, err := s.q.DeleteServiceAccountKey(ctx, queries.DeleteServiceAccountKeyParams{ServiceAccountID: id, ID: keyID})
+	if err != nil {
+		return fmt.Errorf("serviceaccount: delete service account key: %w", err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("serviceaccount: delete service account key: %w", core.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Authenticate implements [core.ServiceAccountStore].
+func (s *Store) Authenticate(ctx context.Context, id uuid.UUID, accountSecret string) (core.ServiceAccount, error) {
+	a, err := s.q.GetServiceAccountByID(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return core.ServiceAccount{}, core.ErrInvalidCredentials
+	}
+
+	if err != nil {
+		return core.ServiceAccount{}, fmt.Errorf("serviceaccount: get service account by id: %w", err)
+	}
+
+	now := s.now()
+	hash := secret.Hash(accountSecret)
+
+	current := subtle.ConstantTimeCompare(hash, a.SecretHash) == 1
+	previous := a.PreviousExpiresAt != nil && now.Before(*a.PreviousExpiresAt) &&
+		subtle.ConstantTimeCompare(hash, a.PreviousSecretHash) == 1
+
+	if !current && !previous {
+		return core.ServiceAccount{}, core.ErrInvalidCredentials
+	}
+
+	return toServiceAccount(a, now), nil
+}
+
+// AuthenticateAssertion implements [core.ServiceAccountStore]. The assertion has to be signed by the key of its kid
+// header, or by any key of the account if it has none, and expire within the configured maximum lifetime.
+func (s *Store) AuthenticateAssertion(ctx context.Context, assertion string, audiences []string) (core.ServiceAccount, error) {
+	a, err := token.ParseAssertion(assertion)
+	if err != nil {
+		return core.ServiceAccount{}, core.ErrInvalidCredentials
+	}
+
+	id, err := uuid.Parse(a.Subject)
+	if err != nil || a.Issuer != a.Subject {
+		return core.ServiceAccount{}, core.ErrInvalidCredentials
+	}
+
+	if !slices.ContainsFunc(a.Audience, func(aud string) bool { return slices.Contains(audiences, aud) }) {
+		return core.ServiceAccount{}, core.ErrInvalidCredentials
+	}
+
+	now := s.now()
+	leeway := s.config.Leeway
+
+	if !now.Before(a.ExpiresAt.Add(leeway)) || a.ExpiresAt.After(now.Add(s.config.MaxAssertionTTL+leeway)) {
+		return core.ServiceAccount{}, core.ErrInvalidCredentials
+	}
+
+	if now.Add(leeway).Before(a.NotBefore) {
+		return core.ServiceAccount{}, core.ErrInvalidCredentials
+	}
+
+	if a.ID == "" || len(a.ID) > maxAssertionIDLength {
+		return core.ServiceAccount{}, core.ErrInvalidCredentials
+	}
+
+	if err := s.verifyAssertion(ctx, id, a); err != nil {
+		return core.ServiceAccount{}, err
+	}
+
+	account, err := s.q.GetServiceAccountByID(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return core.ServiceAccount{}, core.ErrInvalidCredentials
+	}
+
+	if err != nil {
+		return core.ServiceAccount{}, fmt.Errorf("serviceaccount: get service account by id: %w", err)
+	}
+
+	// The identifier is recorded last, so assertions rejected for other reasons do not use it up.
+	n, err := s.q.CreateServiceAccountAssertion(ctx, queries.CreateServiceAccountAssertionParams{
+		ServiceAccountID: id,
+		Jti:              a.ID,
+		ExpiresAt:        a.ExpiresAt.Add(leeway),
+	})
+	if err != nil {
+		return core.ServiceAccount{}, fmt.Errorf("serviceaccount: create service account assertion: %w", mapError(err))
+	}
+
+	if n == 0 {
+		return core.ServiceAccount{}, core.ErrInvalidCredentials
+	}
+
+	return toServiceAccount(account, now), nil
+}
+
+// verifyAssertion verifies the signature of a with the keys of the account.
+func (s *Store) verifyAssertion(ctx context.Context, id uuid.UUID, a token.Assertion) error {
+	var ks []queries.ServiceAccountKey
+
+	if a.KeyID != "" {
+		k, err := s.q.GetServiceAccountKey(ctx, queries.GetServiceAccountKeyParams{ServiceAccountID: id, KeyID: a.KeyID})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return core.ErrInvalidCredentials
+		}
+
+		if err != nil {
+			return fmt.Errorf("serviceaccount: get service account key: %w", err)
+		}
+
+		ks = append(ks, k)
+	} else {
+		var err error
+		if ks, err = s.q.ListServiceAccountKeys(ctx, id); err != nil {
+			return fmt.Errorf("serviceaccount: list service account keys: %w", err)
+		}
+	}
+
+	for _, k := range ks {
+		pub, err := token.ParsePublicKey(k.KeyID, token.Algorithm(k.Algorithm), k.PublicKey)
+		if err != nil {
+			return fmt.Errorf("serviceaccount: %w", err)
+		}
+
+		if a.Verify(pub) == nil {
+			return nil
+		}
+	}
+
+	return core.ErrInvalidCredentials
+}
+
+// DeleteExpired deletes the identifiers of expired client assertions and returns the number of deleted rows.
+func (s *Store) DeleteExpired(ctx context.Context) (int64, error) {
+	n, err := s.q.DeleteExpiredServiceAccountAssertions(ctx, s.now())
+	if err != nil {
+		return 0, fmt.Errorf("serviceaccount: delete expired service account assertions: %w", err)
+	}
+
+	return n, nil
+}
+
+func mapError(err error) error {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return core.ErrNotFound
+	case db.IsUniqueViolation(err):
+		return core.ErrAlreadyExists
+	case db.IsForeignKeyViolation(err):
+		return core.ErrNotFound
+	default:
+		return err
+	}
+}
+
+// toServiceAccount converts a, hiding the previous secret once it expired at now.
+func toServiceAccount(a queries.ServiceAccount, now time.Time) core.ServiceAccount {
+	account := core.ServiceAccount{
+		ID:          a.ID,
+		Name:        a.Name,
+		Description: a.Description,
+		Scopes:      a.Scopes,
+		CreatedAt:   a.CreatedAt,
+		UpdatedAt:   a.UpdatedAt,
+		RotatedAt:   a.RotatedAt,
+	}
+
+	if a.PreviousExpiresAt != nil && now.Before(*a.PreviousExpiresAt) {
+		account.PreviousExpiresAt = a.PreviousExpiresAt
+	}
+
+	return account
+}
+
+func toKey(k queries.ServiceAccountKey) core.ServiceAccountKey {
+	return core.ServiceAccountKey{
+		ID:               k.ID,
+		ServiceAccountID: k.ServiceAccountID,
+		KeyID:            k.KeyID,
+		Algorithm:        k.Algorithm,
+		CreatedAt:        k.CreatedAt,
+	}
+}
@@ -0,0 +1,295 @@
+package serviceaccount
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+// fakeQuerier keeps accounts, keys and assertions in memory. Queries not used by [Store] panic.
+type fakeQuerier struct {
+	queries.Querier
+
+	accounts   map[uuid.UUID]queries.ServiceAccount
+	keys       map[uuid.UUID]queries.ServiceAccountKey
+	assertions map[string]time.Time
+}
+
+func newFakeQuerier() *fakeQuerier {
+	return &fakeQuerier{
+		accounts:   map[uuid.UUID]queries.ServiceAccount{},
+		keys:       map[uuid.UUID]queries.ServiceAccountKey{},
+		assertions: map[string]time.Time{},
+	}
+}
+
+func (f *fakeQuerier) CreateServiceAccount(_ context.Context, arg queries.CreateServiceAccountParams) (queries.ServiceAccount, error) {
+	a := queries.ServiceAccount{
+		ID:          uuid.New(),
+		Name:        arg.Name,
+		Description: arg.Description,
+		Scopes:      arg.Scopes,
+		SecretHash:  arg.SecretHash,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	f.accounts[a.ID] = a
+
+	return a, nil
+}
+
+func (f *fakeQuerier) GetServiceAccountByID(_ context.Context, id uuid.UUID) (queries.ServiceAccount, error) {
+	a, ok := f.accounts[id]
+	if !ok {
+		return queries.ServiceAccount{}, pgx.ErrNoRows
+	}
+
+	return a, nil
+}
+
+func (f *fakeQuerier) RotateServiceAccountSecret(_ context.Context, arg queries.RotateServiceAccountSecretParams) (queries.ServiceAccount, error) {
+	a, ok := f.accounts[arg.ID]
+	if !ok {
+		return queries.ServiceAccount{}, pgx.ErrNoRows
+	}
+
+	a.PreviousSecretHash = nil
+	if arg.PreviousExpiresAt != nil {
+		a.PreviousSecretHash = a.SecretHash
+	}
+
+	now := time.Now()
+	a.PreviousExpiresAt = arg.PreviousExpiresAt
+	a.SecretHash = arg.SecretHash
+	a.RotatedAt = &now
+	f.accounts[a.ID] = a
+
+	return a, nil
+}
+
+func (f *fakeQuerier) CreateServiceAccountKey(_ context.Context, arg queries.CreateServiceAccountKeyParams) (queries.ServiceAccountKey, error) {
+	k := queries.ServiceAccountKey{
+		ID:               uuid.New(),
+		ServiceAccountID: arg.ServiceAccountID,
+		KeyID:            arg.KeyID,
+		Algorithm:        arg.Algorithm,
+		PublicKey:        arg.PublicKey,
+		CreatedAt:        time.Now(),
+	}
+	f.keys[k.ID] = k
+
+	return k, nil
+}
+
+func (f *fakeQuerier) GetServiceAccountKey(_ context.Context, arg queries.GetServiceAccountKeyParams) (queries.ServiceAccountKey, error) {
+	for _, k := range f.keys {
+		if k.ServiceAccountID == arg.ServiceAccountID && k.KeyID == arg.KeyID {
+			return k, nil
+		}
+	}
+
+	return queries.ServiceAccountKey{}, pgx.ErrNoRows
+}
+
+func (f *fakeQuerier) ListServiceAccountKeys(_ context.Context, id uuid.UUID) ([]queries.ServiceAccountKey, error) {
+	var ks []queries.ServiceAccountKey
+	for _, k := range f.keys {
+		if k.ServiceAccountID == id {
+			ks = append(ks, k)
+		}
+	}
+
+	return ks, nil
+}
+
+func (f *fakeQuerier) CreateServiceAccountAssertion(_ context.Context, arg queries.CreateServiceAccountAssertionParams) (int64, error) {
+	key := arg.ServiceAccountID.String() + "/" + arg.Jti
+	if _, ok := f.assertions[key]; ok {
+		return 0, nil
+	}
+
+	f.assertions[key] = arg.ExpiresAt
+	return 1, nil
+}
+
+// signAssertion signs claims as a compact JWS with the EdDSA key of kid.
+func signAssertion(t *testing.T, private ed25519.PrivateKey, kid string, claims map[string]any) string {
+	t.Helper()
+
+	h, err := json.Marshal(map[string]string{"alg": "EdDSA", "kid": kid, "typ": "JWT"})
+	require.NoError(t, err)
+
+	c, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	b64 := base64.RawURLEncoding
+	input := b64.EncodeToString(h) + "." + b64.EncodeToString(c)
+
+	return input + "." + b64.EncodeToString(ed25519.Sign(private, []byte(input)))
+}
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	config := Config{RotationOverlap: time.Hour, MaxRotationOverlap: 24 * time.Hour, MaxAssertionTTL: 5 * time.Minute, Leeway: 30 * time.Second}
+
+	newTestStore := func(t *testing.T) (*Store, *fakeQuerier, *time.Time) {
+		t.Helper()
+
+		q := newFakeQuerier()
+		s, err := newStore(q, config)
+		require.NoError(t, err)
+
+		now := time.Now()
+		s.now = func() time.Time { return now }
+
+		return s, q, &now
+	}
+
+	t.Run("creates and authenticates accounts", func(t *testing.T) {
+		s, _, _ := newTestStore(t)
+
+		a, secret, err := s.Create(ctx, core.CreateServiceAccountParams{Name: "billing", Scopes: []string{"invoices.write", "invoices.read", "invoices.read"}})
+		require.NoError(t, err)
+		require.NotEmpty(t, secret)
+		require.Equal(t, []string{"invoices.read", "invoices.write"}, a.Scopes)
+
+		got, err := s.Authenticate(ctx, a.ID, secret)
+		require.NoError(t, err)
+		require.Equal(t, a.ID, got.ID)
+
+		_, err = s.Authenticate(ctx, a.ID, "wrong")
+		require.ErrorIs(t, err, core.ErrInvalidCredentials)
+
+		_, err = s.Authenticate(ctx, uuid.New(), secret)
+		require.ErrorIs(t, err, core.ErrInvalidCredentials)
+
+		// Accounts without scopes store an empty array rather than NULL.
+		a, _, err = s.Create(ctx, core.CreateServiceAccountParams{Name: "reports"})
+		require.NoError(t, err)
+		require.NotNil(t, a.Scopes)
+
+		for _, params := range []core.CreateServiceAccountParams{
+			{Name: " "},
+			{Name: "billing", Scopes: []string{"invoices read"}},
+		} {
+			_, _, err := s.Create(ctx, params)
+			require.ErrorIs(t, err, core.ErrInvalidArgument)
+		}
+	})
+
+	t.Run("rotates secrets with overlap", func(t *testing.T) {
+		s, _, now := newTestStore(t)
+
+		a, before, err := s.Create(ctx, core.CreateServiceAccountParams{Name: "billing"})
+		require.NoError(t, err)
+
+		rotated, after, err := s.RotateSecret(ctx, a.ID, nil)
+		require.NoError(t, err)
+		require.NotNil(t, rotated.PreviousExpiresAt)
+		require.Equal(t, now.Add(config.RotationOverlap), *rotated.PreviousExpiresAt)
+
+		for _, secret := range []string{before, after} {
+			_, err := s.Authenticate(ctx, a.ID, secret)
+			require.NoError(t, err)
+		}
+
+		*now = now.Add(config.RotationOverlap)
+		_, err = s.Authenticate(ctx, a.ID, before)
+		require.ErrorIs(t, err, core.ErrInvalidCredentials)
+
+		var zero time.Duration
+		rotated, last, err := s.RotateSecret(ctx, a.ID, &zero)
+		require.NoError(t, err)
+		require.Nil(t, rotated.PreviousExpiresAt)
+
+		_, err = s.Authenticate(ctx, a.ID, after)
+		require.ErrorIs(t, err, core.ErrInvalidCredentials)
+		_, err = s.Authenticate(ctx, a.ID, last)
+		require.NoError(t, err)
+
+		tooLong := config.MaxRotationOverlap + time.Second
+		_, _, err = s.RotateSecret(ctx, a.ID, &tooLong)
+		require.ErrorIs(t, err, core.ErrInvalidArgument)
+
+		_, _, err = s.RotateSecret(ctx, uuid.New(), nil)
+		require.ErrorIs(t, err, core.ErrNotFound)
+	})
+
+	t.Run("authenticates assertions", func(t *testing.T) {
+		s, _, now := newTestStore(t)
+
+		a, _, err := s.Create(ctx, core.CreateServiceAccountParams{Name: "billing"})
+		require.NoError(t, err)
+
+		public, private, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		jwk := `{"kty":"OKP","crv":"Ed25519","x":"` + base64.RawURLEncoding.EncodeToString(public) + `"}`
+		key, err := s.AddKey(ctx, a.ID, []byte(jwk))
+		require.NoError(t, err)
+		require.Equal(t, "EdDSA", key.Algorithm)
+		require.NotEmpty(t, key.KeyID)
+
+		_, err = s.AddKey(ctx, a.ID, []byte(`{"kty":"OKP"}`))
+		require.ErrorIs(t, err, core.ErrInvalidArgument)
+
+		keys, err := s.ListKeys(ctx, a.ID)
+		require.NoError(t, err)
+		require.Equal(t, []core.ServiceAccountKey{key}, keys)
+
+		_, err = s.ListKeys(ctx, uuid.New())
+		require.ErrorIs(t, err, core.ErrNotFound)
+
+		audiences := []string{"https://id.example.com", "https://id.example.com/token"}
+		claims := func(jti string, exp time.Time) map[string]any {
+			return map[string]any{"iss": a.ID.String(), "sub": a.ID.String(), "aud": "https://id.example.com/token", "jti": jti, "exp": exp.Unix()}
+		}
+
+		assertion := signAssertion(t, private, key.KeyID, claims("1", now.Add(time.Minute)))
+		got, err := s.AuthenticateAssertion(ctx, assertion, audiences)
+		require.NoError(t, err)
+		require.Equal(t, a.ID, got.ID)
+
+		// Assertions cannot be replayed.
+		_, err = s.AuthenticateAssertion(ctx, assertion, audiences)
+		require.ErrorIs(t, err, core.ErrInvalidCredentials)
+
+		// Without kid, all keys of the account are tried.
+		_, err = s.AuthenticateAssertion(ctx, signAssertion(t, private, "", claims("2", now.Add(time.Minute))), audiences)
+		require.NoError(t, err)
+
+		_, other, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		wrongAudience := claims("3", now.Add(time.Minute))
+		wrongAudience["aud"] = "https://api.example.com"
+		wrongIssuer := claims("3", now.Add(time.Minute))
+		wrongIssuer["iss"] = uuid.NewString()
+		noID := claims("", now.Add(time.Minute))
+
+		for name, assertion := range map[string]string{
+			"malformed":      "not a jwt",
+			"wrong key":      signAssertion(t, other, key.KeyID, claims("3", now.Add(time.Minute))),
+			"unknown kid":    signAssertion(t, private, "other", claims("3", now.Add(time.Minute))),
+			"audience":       signAssertion(t, private, key.KeyID, wrongAudience),
+			"issuer":         signAssertion(t, private, key.KeyID, wrongIssuer),
+			"expired":        signAssertion(t, private, key.KeyID, claims("3", now.Add(-time.Minute))),
+			"too long-lived": signAssertion(t, private, key.KeyID, claims("3", now.Add(time.Hour))),
+			"without jti":    signAssertion(t, private, key.KeyID, noID),
+		} {
+			_, err := s.AuthenticateAssertion(ctx, assertion, audiences)
+			require.ErrorIs(t, err, core.ErrInvalidCredentials, name)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package serviceaccount
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gophero/guardian/core"
+)
+
+const (
+	maxNameLength        = 128
+	maxDescriptionLength = 1024
+	maxScopes            = 64
+	maxAssertionIDLength = 256
+)
+
+// scopeRe matches a scope token of RFC 6749, section 3.3.
+var scopeRe = regexp.MustCompile(`^[\x21\x23-\x5B\x5D-\x7E]+$`)
+
+func validateAccount(name, description string, scopes []string) error {
+	if strings.TrimSpace(name) == "" || utf8.RuneCountInString(name) > maxNameLength {
+		return fmt.Errorf("serviceaccount: name must have 1 to %d characters: %w", maxNameLength, core.ErrInvalidArgument)
+	}
+
+	if utf8.RuneCountInString(description) > maxDescriptionLength {
+		return fmt.Errorf("serviceaccount: description cannot have more than %d characters: %w", maxDescriptionLength, core.ErrInvalidArgument)
+	}
+
+	if len(scopes) > maxScopes {
+		return fmt.Errorf("serviceaccount: accounts cannot have more than %d scopes: %w", maxScopes, core.ErrInvalidArgument)
+	}
+
+	for _, s := range scopes {
+		if len(s) > 128 || !scopeRe.MatchString(s) {
+			return fmt.Errorf("serviceaccount: invalid scope `%s`: %w", s, core.ErrInvalidArgument)
+		}
+	}
+	return nil
+}
+
+// normalize returns values sorted without duplicates. It never returns nil, which would be stored as NULL.
+func normalize(values []string) []string {
+	res := slices.Compact(slices.Sorted(slices.Values(values)))
+	if res == nil {
+		return []string{}
+	}
+	return res
+}
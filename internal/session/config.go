@@ -0,0 +1,33 @@
+package session
+
+import (
+	"errors"
+	"time"
+)
+
+type Config struct {
+	AbsoluteTTL    time.Duration `help:"Duration after which a session expires regardless of activity." name:"absolute_ttl" env:"ABSOLUTE_TTL" default:"720h"`
+	IdleTTL        time.Duration `help:"Duration of inactivity after which a session expires." name:"idle_ttl" env:"IDLE_TTL" default:"168h"`
+	TouchInterval  time.Duration `help:"Minimum duration between updates of the last seen time of a session." name:"touch_interval" env:"TOUCH_INTERVAL" default:"1m"`
+	RetentionAfter time.Duration `help:"Duration for which expired and revoked sessions are kept before deletion." name:"retention_after" env:"RETENTION_AFTER" default:"720h"`
+}
+
+func (c Config) validate() error {
+	if c.AbsoluteTTL <= 0 {
+		return errors.New("session: AbsoluteTTL cannot be zero or negative")
+	}
+
+	if c.IdleTTL <= 0 || c.IdleTTL > c.AbsoluteTTL {
+		return errors.New("session: IdleTTL cannot be zero, negative or greater than AbsoluteTTL")
+	}
+
+	if c.TouchInterval < 0 || c.TouchInterval >= c.IdleTTL {
+		return errors.New("session: TouchInterval cannot be negative or, greater than or equal to IdleTTL")
+	}
+
+	if c.RetentionAfter < 0 {
+		return errors.New("session: RetentionAfter cannot be negative")
+	}
+
+	return nil
+}
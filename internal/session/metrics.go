@@ -0,0 +1,66 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+// collectTimeout bounds the query counting active sessions on each scrape.
+const collectTimeout = 5 * time.Second
+
+type metrics struct {
+	q *queries.Queries
+
+	active  *prometheus.Desc
+	created prometheus.Counter
+	revoked *prometheus.CounterVec
+}
+
+func newMetrics(q *queries.Queries) *metrics {
+	return &metrics{
+		q: q,
+		active: prometheus.NewDesc(
+			prometheus.BuildFQName("guardian", "session", "active"),
+			"The number of sessions which are neither expired nor revoked.",
+			nil, nil,
+		),
+		created: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "guardian",
+			Subsystem: "session",
+			Name:      "created_total",
+			Help:      "The cumulative count of created sessions.",
+		}),
+		revoked: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "guardian",
+			Subsystem: "session",
+			Name:      "revoked_total",
+			Help:      "The cumulative count of revoked sessions labeled by reason.",
+		}, []string{"reason"}),
+	}
+}
+
+// Describe implements [prometheus.Collector].
+func (m *metrics) Describe(ch chan<- *prometheus.Desc) {
+	ch <- m.active
+	m.created.Describe(ch)
+	m.revoked.Describe(ch)
+}
+
+// Collect implements [prometheus.Collector].
+func (m *metrics) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	if n, err := m.q.CountActiveSessions(ctx); err != nil {
+		ch <- prometheus.NewInvalidMetric(m.active, err)
+	} else {
+		ch <- prometheus.MustNewConstMetric(m.active, prometheus.GaugeValue, float64(n))
+	}
+
+	m.created.Collect(ch)
+	m.revoked.Collect(ch)
+}
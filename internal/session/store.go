@@ -0,0 +1,214 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/internal/db/queries"
+	"github.com/gophero/guardian/internal/secret"
+)
+
+// Store is a postgres backed [core.SessionStore]. It is also a [prometheus.Collector] exporting session metrics.
+type Store struct {
+	config Config
+	q      *queries.Queries
+	now    func() time.Time
+
+	*metrics
+}
+
+var (
+	_ core.SessionStore    = (*Store)(nil)
+	_ prometheus.Collector = (*Store)(nil)
+)
+
+// NewStore constructs new [Store].
+func NewStore(pool *pgxpool.Pool, config Config) (*Store, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	s := &Store{config: config, q: queries.New(pool), now: time.Now}
+	s.metrics = newMetrics(s.q)
+
+	return s, nil
+}
+
+// Create implements [core.SessionStore].
+func (s *Store) Create(ctx context.Context, userID uuid.UUID, meta core.SessionMetadata) (core.Session, string, error) {
+	token := secret.New(secret.DefaultSize)
+	now := s.now()
+
+	var ip *netip.Addr
+	if meta.IPAddress.IsValid() {
+		ip = &meta.IPAddress
+	}
+
+	sess, err := s.q.CreateSession(ctx, queries.CreateSessionParams{
+		UserID:        userID,
+		TokenHash:     secret.Hash(token),
+		UserAgent:     meta.UserAgent,
+		IpAddress:     ip,
+		Device:        meta.Device,
+		IdleExpiresAt: now.Add(s.config.IdleTTL),
+		ExpiresAt:     now.Add(s.config.AbsoluteTTL),
+		AuthMethods:   authMethods(meta.AuthMethods),
+	})
+	if err != nil {
+		if db.IsForeignKeyViolation(err) {
+			return core.Session{}, "", fmt.Errorf("session: create session: %w", core.ErrNotFound)
+		}
+		return core.Session{}, "", fmt.Errorf("session: create session: %w", err)
+	}
+
+	s.created.Inc()
+
+	return toSession(sess), token, nil
+}
+
+// Authenticate implements [core.SessionStore].
+func (s *Store) Authenticate(ctx context.Context, token string) (core.Session, error) {
+	sess, err := s.q.GetActiveSessionByTokenHash(ctx, secret.Hash(token))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return core.Session{}, core.ErrInvalidCredentials
+	}
+
+	if err != nil {
+		return core.Session{}, fmt.Errorf("session: get active session by token hash: %w", err)
+	}
+
+	now := s.now()
+	if now.Sub(sess.LastSeenAt) < s.config.TouchInterval {
+		return toSession(sess), nil
+	}
+
+	touched, err := s.q.TouchSession(ctx, queries.TouchSessionParams{
+		IdleExpiresAt: now.Add(s.config.IdleTTL),
+		ID:            sess.ID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) { // Revoked concurrently.
+		return core.Session{}, core.ErrInvalidCredentials
+	}
+
+	if err != nil {
+		return core.Session{}, fmt.Errorf("session: touch session: %w", err)
+	}
+
+	return toSession(touched), nil
+}
+
+// Get implements [core.SessionStore].
+func (s *Store) Get(ctx context.Context, id uuid.UUID) (core.Session, error) {
+	sess, err := s.q.GetSessionByID(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return core.Session{}, fmt.Errorf("session: get session by id: %w", core.ErrNotFound)
+	}
+
+	if err != nil {
+		return core.Session{}, fmt.Errorf("session: get session by id: %w", err)
+	}
+
+	return toSession(sess), nil
+}
+
+// List implements [core.SessionStore].
+func (s *Store) List(ctx context.Context, userID uuid.UUID) ([]core.Session, error) {
+	sessions, err := s.q.ListActiveSessionsByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("session: list active sessions by user: %w", err)
+	}
+
+	out := make([]core.Session, 0, len(sessions))
+	for _, sess := range sessions {
+		out = append(out, toSession(sess))
+	}
+
+	return out, nil
+}
+
+// Revoke implements [core.SessionStore].
+func (s *Store) Revoke(ctx context.Context, id uuid.UUID, reason core.SessionRevokeReason) error {
+	n, err := s.q.RevokeSession(ctx, queries.RevokeSessionParams{ID: id, RevokedReason: string(reason)})
+	if err != nil {
+		return fmt.Errorf("session: revoke session: %w", err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("session: revoke session: %w", core.ErrNotFound)
+	}
+
+	s.revoked.WithLabelValues(string(reason)).Inc()
+
+	return nil
+}
+
+// RevokeAll implements [core.SessionStore].
+func (s *Store) RevokeAll(ctx context.Context, userID uuid.UUID, except uuid.UUID, reason core.SessionRevokeReason) (int64, error) {
+	n, err := s.q.RevokeUserSessions(ctx, queries.RevokeUserSessionsParams{
+		Reason:   string(reason),
+		UserID:   userID,
+		ExceptID: except,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("session: revoke user sessions: %w", err)
+	}
+
+	s.revoked.WithLabelValues(string(reason)).Add(float64(n))
+
+	return n, nil
+}
+
+// DeleteExpired deletes sessions which expired or were revoked longer than the configured retention ago.
+func (s *Store) DeleteExpired(ctx context.Context) (int64, error) {
+	n, err := s.q.DeleteExpiredSessions(ctx, s.now().Add(-s.config.RetentionAfter))
+	if err != nil {
+		return 0, fmt.Errorf("session: delete expired sessions: %w", err)
+	}
+
+	return n, nil
+}
+
+func toSession(s queries.Session) core.Session {
+	var ip netip.Addr
+	if s.IpAddress != nil {
+		ip = *s.IpAddress
+	}
+
+	return core.Session{
+		ID:            s.ID,
+		UserID:        s.UserID,
+		UserAgent:     s.UserAgent,
+		IPAddress:     ip,
+		Device:        s.Device,
+		CreatedAt:     s.CreatedAt,
+		LastSeenAt:    s.LastSeenAt,
+		IdleExpiresAt: s.IdleExpiresAt,
+		ExpiresAt:     s.ExpiresAt,
+		RevokedAt:     s.RevokedAt,
+		RevokedReason: core.SessionRevokeReason(s.RevokedReason),
+		AuthMethods:   s.AuthMethods,
+	}
+}
+
+// authMethods sorts and dedupes methods. A nil slice would be stored as NULL.
+func authMethods(methods []string) []string {
+	if len(methods) == 0 {
+		return []string{}
+	}
+
+	methods = slices.Clone(methods)
+	slices.Sort(methods)
+
+	return slices.Compact(methods)
+}
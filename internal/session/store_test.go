@@ -0,0 +1,196 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db/dbtest"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	pool := dbtest.Pool(t)
+	q := queries.New(pool)
+
+	// Expiry is checked against the clock of the database, so sessions are created in the past to expire them. The
+	// clock of the store only sets deadlines.
+	s, err := NewStore(pool, Config{AbsoluteTTL: 90 * time.Minute, IdleTTL: time.Hour, TouchInterval: time.Minute})
+	require.NoError(t, err)
+
+	setNow := func(t time.Time) { s.now = func() time.Time { return t } }
+
+	newUser := func(t *testing.T) uuid.UUID {
+		t.Helper()
+
+		suffix := uuid.NewString()[:8]
+
+		user, err := q.CreateUser(ctx, queries.CreateUserParams{
+			Email:    "session-" + suffix + "@example.com",
+			Username: "session-" + suffix,
+			Status:   queries.UserStatusActive,
+		})
+		require.NoError(t, err)
+
+		return user.ID
+	}
+
+	create := func(t *testing.T, userID uuid.UUID) (core.Session, string) {
+		t.Helper()
+
+		sess, token, err := s.Create(ctx, userID, core.SessionMetadata{Device: "test"})
+		require.NoError(t, err)
+
+		return sess, token
+	}
+
+	// collect returns the value of the metric described by desc with the label values, zero if it was not collected.
+	collect := func(t *testing.T, desc string, labelValues ...string) float64 {
+		t.Helper()
+
+		ch := make(chan prometheus.Metric, 16)
+		s.Collect(ch)
+		close(ch)
+
+	metrics:
+		for m := range ch {
+			var out dto.Metric
+			require.NoError(t, m.Write(&out))
+
+			if m.Desc().String() != desc || len(out.GetLabel()) != len(labelValues) {
+				continue
+			}
+
+			for i, l := range out.GetLabel() {
+				if l.GetValue() != labelValues[i] {
+					continue metrics
+				}
+			}
+
+			if out.GetGauge() != nil {
+				return out.GetGauge().GetValue()
+			}
+
+			return out.GetCounter().GetValue()
+		}
+
+		return 0
+	}
+
+	activeDesc := s.active.String()
+	revokedDesc := s.revoked.WithLabelValues(string(core.SessionRevokeSignOut)).Desc().String()
+
+	t.Run("expires idle sessions", func(t *testing.T) {
+		userID := newUser(t)
+
+		setNow(time.Now().Add(-time.Hour - time.Minute))
+		_, token := create(t, userID)
+
+		_, err := s.Authenticate(ctx, token)
+		require.ErrorIs(t, err, core.ErrInvalidCredentials)
+
+		sessions, err := s.List(ctx, userID)
+		require.NoError(t, err)
+		require.Empty(t, sessions)
+	})
+
+	t.Run("expires sessions absolutely", func(t *testing.T) {
+		userID := newUser(t)
+
+		setNow(time.Now().Add(-90*time.Minute - time.Minute))
+		_, token := create(t, userID)
+
+		_, err := s.Authenticate(ctx, token)
+		require.ErrorIs(t, err, core.ErrInvalidCredentials)
+
+		// Expired sessions are not revoked anymore.
+		n, err := s.RevokeAll(ctx, userID, uuid.Nil, core.SessionRevokeSignOut)
+		require.NoError(t, err)
+		require.Zero(t, n)
+	})
+
+	t.Run("extends the idle expiry up to the absolute expiry", func(t *testing.T) {
+		userID := newUser(t)
+		now := time.Now().Truncate(time.Microsecond)
+
+		setNow(now)
+		sess, token := create(t, userID)
+		require.True(t, sess.IdleExpiresAt.Equal(now.Add(time.Hour)))
+		require.True(t, sess.ExpiresAt.Equal(now.Add(90*time.Minute)))
+
+		// Sessions are not touched more often than the touch interval.
+		setNow(now.Add(30 * time.Second))
+		got, err := s.Authenticate(ctx, token)
+		require.NoError(t, err)
+		require.True(t, got.IdleExpiresAt.Equal(sess.IdleExpiresAt))
+
+		setNow(now.Add(10 * time.Minute))
+		got, err = s.Authenticate(ctx, token)
+		require.NoError(t, err)
+		require.True(t, got.IdleExpiresAt.Equal(now.Add(70*time.Minute)), "idle expires at %s", got.IdleExpiresAt)
+
+		// The last touch would extend the idle expiry past the absolute one.
+		setNow(now.Add(40 * time.Minute))
+		got, err = s.Authenticate(ctx, token)
+		require.NoError(t, err)
+		require.True(t, got.IdleExpiresAt.Equal(sess.ExpiresAt), "idle expires at %s", got.IdleExpiresAt)
+		require.True(t, got.ExpiresAt.Equal(sess.ExpiresAt))
+	})
+
+	t.Run("revokes all sessions except one", func(t *testing.T) {
+		userID := newUser(t)
+		setNow(time.Now())
+
+		current, currentToken := create(t, userID)
+		_, otherToken := create(t, userID)
+		_, thirdToken := create(t, userID)
+
+		n, err := s.RevokeAll(ctx, userID, current.ID, core.SessionRevokeSignOut)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, n)
+
+		_, err = s.Authenticate(ctx, currentToken)
+		require.NoError(t, err)
+
+		for _, token := range []string{otherToken, thirdToken} {
+			_, err = s.Authenticate(ctx, token)
+			require.ErrorIs(t, err, core.ErrInvalidCredentials)
+		}
+
+		sessions, err := s.List(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+		require.Equal(t, current.ID, sessions[0].ID)
+
+		// Revoked sessions are not revoked again.
+		n, err = s.RevokeAll(ctx, userID, uuid.Nil, core.SessionRevokeSignOut)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, n)
+	})
+
+	t.Run("exports metrics", func(t *testing.T) {
+		// Other tests may share the database, so only changes of the metrics are compared.
+		userID := newUser(t)
+		setNow(time.Now())
+
+		active := collect(t, activeDesc)
+		revoked := collect(t, revokedDesc, string(core.SessionRevokeSignOut))
+
+		sess, _ := create(t, userID)
+		require.Equal(t, active+1, collect(t, activeDesc))
+
+		require.NoError(t, s.Revoke(ctx, sess.ID, core.SessionRevokeSignOut))
+		require.Equal(t, active, collect(t, activeDesc))
+		require.Equal(t, revoked+1, collect(t, revokedDesc, string(core.SessionRevokeSignOut)))
+
+		require.ErrorIs(t, s.Revoke(ctx, sess.ID, core.SessionRevokeSignOut), core.ErrNotFound)
+		require.Equal(t, revoked+1, collect(t, revokedDesc, string(core.SessionRevokeSignOut)))
+	})
+}
@@ -0,0 +1,221 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/gophero/guardian/core"
+)
+
+// minRSAKeySize is the minimum size of accepted RSA public keys in bits.
+const minRSAKeySize = 2048
+
+// PublicKey is a public key of a client, verifying the signatures of its JWT client assertions.
+type PublicKey struct {
+	ID        string
+	Algorithm Algorithm
+	public    crypto.PublicKey
+}
+
+// ParseJWK parses a public JSON Web Key. The algorithm defaults to the one of the key type and the key ID to the
+// RFC 7638 thumbprint of the key. It returns [core.ErrInvalidArgument] for malformed, private or unsupported keys.
+func ParseJWK(b []byte) (PublicKey, error) {
+	var jwk struct {
+		JWK
+		D string `json:"d"`
+	}
+	if err := json.Unmarshal(b, &jwk); err != nil {
+		return PublicKey{}, fmt.Errorf("token: malformed jwk: %w", core.ErrInvalidArgument)
+	}
+
+	if jwk.D != "" {
+		return PublicKey{}, fmt.Errorf("token: jwk must be a public key: %w", core.ErrInvalidArgument)
+	}
+
+	if jwk.Use != "" && jwk.Use != "sig" {
+		return PublicKey{}, fmt.Errorf("token: jwk must be a signing key: %w", core.ErrInvalidArgument)
+	}
+
+	k, err := publicKeyOf(jwk.JWK)
+	if err != nil {
+		return PublicKey{}, fmt.Errorf("%w: %w", err, core.ErrInvalidArgument)
+	}
+
+	if jwk.Algorithm != "" && Algorithm(jwk.Algorithm) != k.Algorithm {
+		return PublicKey{}, fmt.Errorf("token: key does not match algorithm `%s`: %w", jwk.Algorithm, core.ErrInvalidArgument)
+	}
+
+	k.ID = jwk.KeyID
+	if k.ID == "" {
+		// The thumbprint is computed over the parsed key, so differently padded encodings result in the same ID.
+		normalized, err := publicJWK(k.public)
+		if err != nil {
+			return PublicKey{}, err
+		}
+		k.ID = thumbprintOf(normalized)
+	}
+
+	return k, nil
+}
+
+// publicKeyOf decodes the key parameters of jwk.
+func publicKeyOf(jwk JWK) (PublicKey, error) {
+	b64 := base64.RawURLEncoding
+
+	switch jwk.KeyType {
+	case "OKP":
+		x, err := b64.DecodeString(jwk.X)
+		if jwk.Curve != "Ed25519" || err != nil || len(x) != ed25519.PublicKeySize {
+			return PublicKey{}, errors.New("token: invalid Ed25519 key")
+		}
+		return PublicKey{Algorithm: EdDSA, public: ed25519.PublicKey(x)}, nil
+	case "EC":
+		x, errX := b64.DecodeString(jwk.X)
+		y, errY := b64.DecodeString(jwk.Y)
+		if jwk.Curve != "P-256" || errX != nil || errY != nil || len(x) != es256Size || len(y) != es256Size {
+			return PublicKey{}, errors.New("token: invalid P-256 key")
+		}
+		pub, err := ecdsa.ParseUncompressedPublicKey(elliptic.P256(), append(append([]byte{4}, x...), y...))
+		if err != nil {
+			return PublicKey{}, errors.New("token: invalid P-256 key")
+		}
+		return PublicKey{Algorithm: ES256, public: pub}, nil
+	case "RSA":
+		n, errN := b64.DecodeString(jwk.N)
+		e, errE := b64.DecodeString(jwk.E)
+		if errN != nil || errE != nil || len(e) == 0 || len(e) > 4 {
+			return PublicKey{}, errors.New("token: invalid RSA key")
+		}
+		pub := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		if err := checkRSAKey(pub); err != nil {
+			return PublicKey{}, err
+		}
+		return PublicKey{Algorithm: RS256, public: pub}, nil
+	default:
+		return PublicKey{}, fmt.Errorf("token: unsupported key type `%s`", jwk.KeyType)
+	}
+}
+
+func checkRSAKey(pub *rsa.PublicKey) error {
+	if pub.N.BitLen() < minRSAKeySize || pub.E < 3 || pub.E%2 == 0 {
+		return fmt.Errorf("token: RSA keys must have at least %d bits and an odd exponent", minRSAKeySize)
+	}
+
+	return nil
+}
+
+// ParsePublicKey parses a DER encoded SubjectPublicKeyInfo as returned by [PublicKey.Marshal].
+func ParsePublicKey(id string, alg Algorithm, der []byte) (PublicKey, error) {
+	pub, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return PublicKey{}, fmt.Errorf("token: parse public key `%s`: %w", id, err)
+	}
+
+	var ok bool
+	switch alg {
+	case EdDSA:
+		_, ok = pub.(ed25519.PublicKey)
+	case ES256:
+		var ec *ecdsa.PublicKey
+		ec, ok = pub.(*ecdsa.PublicKey)
+		ok = ok && ec.Curve == elliptic.P256()
+	case RS256:
+		_, ok = pub.(*rsa.PublicKey)
+	}
+
+	if !ok {
+		return PublicKey{}, fmt.Errorf("token: public key `%s` does not match algorithm `%s`", id, alg)
+	}
+
+	return PublicKey{ID: id, Algorithm: alg, public: pub}, nil
+}
+
+// Marshal encodes the key as DER encoded SubjectPublicKeyInfo.
+func (k PublicKey) Marshal() ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(k.public)
+	if err != nil {
+		return nil, fmt.Errorf("token: marshal public key: %w", err)
+	}
+
+	return der, nil
+}
+
+// Assertion is a JWT presented by a client to authenticate itself, as described by RFC 7523, section 3. Its signature
+// is not verified until [Assertion.Verify] is called.
+type Assertion struct {
+	KeyID     string
+	Algorithm Algorithm
+	ID        string
+	Issuer    string
+	Subject   string
+	Audience  []string
+	ExpiresAt time.Time
+	NotBefore time.Time // Zero if the assertion has no nbf claim.
+	input     []byte
+	sig       []byte
+}
+
+type assertionClaims struct {
+	ID        string   `json:"jti"`
+	Issuer    string   `json:"iss"`
+	Subject   string   `json:"sub"`
+	Audience  audience `json:"aud"`
+	ExpiresAt int64    `json:"exp"`
+	NotBefore int64    `json:"nbf"`
+}
+
+// ParseAssertion decodes a client assertion without verifying it. It returns [core.ErrInvalidToken] if the assertion is
+// malformed.
+func ParseAssertion(token string) (Assertion, error) {
+	h, payload, input, sig, err := parse(token)
+	if err != nil {
+		return Assertion{}, invalid(err)
+	}
+
+	var c assertionClaims
+	if err := json.Unmarshal(payload, &c); err != nil || c.ExpiresAt == 0 {
+		return Assertion{}, invalid(errMalformed)
+	}
+
+	a := Assertion{
+		KeyID:     h.KeyID,
+		Algorithm: h.Algorithm,
+		ID:        c.ID,
+		Issuer:    c.Issuer,
+		Subject:   c.Subject,
+		Audience:  c.Audience,
+		ExpiresAt: time.Unix(c.ExpiresAt, 0),
+		input:     input,
+		sig:       sig,
+	}
+
+	if c.NotBefore != 0 {
+		a.NotBefore = time.Unix(c.NotBefore, 0)
+	}
+
+	return a, nil
+}
+
+// Verify verifies the signature of the assertion with k. It returns [core.ErrInvalidToken] if the assertion was not
+// signed by k.
+func (a Assertion) Verify(k PublicKey) error {
+	if a.Algorithm != k.Algorithm {
+		return invalid(fmt.Errorf("token: algorithm `%s` does not match key", a.Algorithm))
+	}
+
+	if !verifySignature(k.public, a.input, a.sig) {
+		return invalid(errors.New("token: invalid signature"))
+	}
+
+	return nil
+}
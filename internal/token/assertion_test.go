@@ -0,0 +1,96 @@
+package token
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+)
+
+func TestAssertion(t *testing.T) {
+	for _, alg := range []Algorithm{EdDSA, ES256, RS256} {
+		t.Run(string(alg), func(t *testing.T) {
+			k, err := generateKey(alg)
+			require.NoError(t, err)
+
+			jwk, err := k.jwk()
+			require.NoError(t, err)
+			jwk.KeyID, jwk.Algorithm, jwk.Use = "", "", ""
+
+			b, err := json.Marshal(jwk)
+			require.NoError(t, err)
+
+			pub, err := ParseJWK(b)
+			require.NoError(t, err)
+			require.Equal(t, k.id, pub.ID)
+			require.Equal(t, alg, pub.Algorithm)
+
+			der, err := pub.Marshal()
+			require.NoError(t, err)
+
+			pub, err = ParsePublicKey(pub.ID, alg, der)
+			require.NoError(t, err)
+
+			exp := time.Now().Add(time.Minute).Truncate(time.Second)
+			token, err := sign(k, "JWT", map[string]any{
+				"jti": "a1", "iss": "client", "sub": "client", "aud": "https://guardian.test/token", "exp": exp.Unix(),
+			})
+			require.NoError(t, err)
+
+			a, err := ParseAssertion(token)
+			require.NoError(t, err)
+			require.Equal(t, k.id, a.KeyID)
+			require.Equal(t, "a1", a.ID)
+			require.Equal(t, "client", a.Subject)
+			require.Equal(t, []string{"https://guardian.test/token"}, a.Audience)
+			require.True(t, exp.Equal(a.ExpiresAt))
+			require.True(t, a.NotBefore.IsZero())
+			require.NoError(t, a.Verify(pub))
+
+			other, err := generateKey(alg)
+			require.NoError(t, err)
+			token, err = sign(other, "JWT", map[string]any{"exp": exp.Unix()})
+			require.NoError(t, err)
+
+			a, err = ParseAssertion(token)
+			require.NoError(t, err)
+			require.ErrorIs(t, a.Verify(pub), core.ErrInvalidToken)
+		})
+	}
+}
+
+func TestParseJWKRejects(t *testing.T) {
+	for name, jwk := range map[string]string{
+		"malformed":      `{`,
+		"private key":    `{"kty":"OKP","crv":"Ed25519","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo","d":"nWGxne_9WmC6hEr0kuwsxERJxWl7MmkZcDusAxyuf2A"}`,
+		"encryption key": `{"kty":"OKP","crv":"Ed25519","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo","use":"enc"}`,
+		"algorithm":      `{"kty":"OKP","crv":"Ed25519","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo","alg":"ES256"}`,
+		"curve":          `{"kty":"OKP","crv":"X25519","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"}`,
+		"coordinates":    `{"kty":"EC","crv":"P-256","x":"AQ","y":"AQ"}`,
+		"short rsa key":  `{"kty":"RSA","n":"AQAB","e":"AQAB"}`,
+		"key type":       `{"kty":"oct","k":"c2VjcmV0"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseJWK([]byte(jwk))
+			require.ErrorIs(t, err, core.ErrInvalidArgument)
+		})
+	}
+}
+
+func TestParseAssertionRejects(t *testing.T) {
+	k, err := generateKey(EdDSA)
+	require.NoError(t, err)
+
+	_, err = ParseAssertion("a.b")
+	require.ErrorIs(t, err, core.ErrInvalidToken)
+
+	// Assertions have to expire.
+	token, err := sign(k, "JWT", map[string]any{"iss": "client"})
+	require.NoError(t, err)
+
+	_, err = ParseAssertion(token)
+	require.ErrorIs(t, err, core.ErrInvalidToken)
+}
@@ -0,0 +1,62 @@
+package token
+
+import (
+	"errors"
+	"time"
+)
+
+type Config struct {
+	Issuer           string        `help:"Issuer identifier placed in the iss claim of issued tokens. OpenID Connect requires it to be the URL the oauth server is reachable at." name:"issuer" env:"ISSUER" default:"http://localhost:9004"`
+	Audience         []string      `help:"Default audience placed in the aud claim of issued tokens and required when verifying tokens." name:"audience" env:"AUDIENCE"`
+	AccessTokenTTL   time.Duration `help:"Duration for which issued access tokens are valid." name:"access_token_ttl" env:"ACCESS_TOKEN_TTL" default:"15m"`
+	IDTokenTTL       time.Duration `help:"Duration for which issued OpenID Connect ID tokens are valid." name:"id_token_ttl" env:"ID_TOKEN_TTL" default:"1h"`
+	Algorithm        Algorithm     `help:"Algorithm of newly generated signing keys." name:"algorithm" env:"ALGORITHM" enum:"EdDSA,ES256,RS256" default:"EdDSA"`
+	RotationInterval time.Duration `help:"Duration after which the active signing key is rotated." name:"rotation_interval" env:"ROTATION_INTERVAL" default:"720h"`
+	PrePublish       time.Duration `help:"Duration for which a new signing key is published in the JWKS before it is used for signing." name:"pre_publish" env:"PRE_PUBLISH" default:"1h"`
+	Grace            time.Duration `help:"Duration for which a retired signing key is still published and accepted for verification." name:"grace" env:"GRACE" default:"24h"`
+	RefreshInterval  time.Duration `help:"Interval at which the key ring is reloaded from postgres. Should be less than PrePublish." name:"refresh_interval" env:"REFRESH_INTERVAL" default:"1m"`
+	Leeway           time.Duration `help:"Allowed clock skew when validating time based claims." name:"leeway" env:"LEEWAY" default:"30s"`
+}
+
+func (c Config) validate() error {
+	if c.Issuer == "" {
+		return errors.New("token: Issuer cannot be empty")
+	}
+
+	if c.AccessTokenTTL <= 0 {
+		return errors.New("token: AccessTokenTTL cannot be zero or negative")
+	}
+
+	if c.IDTokenTTL <= 0 {
+		return errors.New("token: IDTokenTTL cannot be zero or negative")
+	}
+
+	switch c.Algorithm {
+	case EdDSA, ES256, RS256:
+	default:
+		return errors.New("token: Algorithm must be one of EdDSA, ES256 or RS256")
+	}
+
+	if c.PrePublish < 0 {
+		return errors.New("token: PrePublish cannot be negative")
+	}
+
+	if c.RotationInterval <= c.PrePublish {
+		return errors.New("token: RotationInterval cannot be less than or equal to PrePublish")
+	}
+
+	// Tokens signed just before a key is retired must remain verifiable till they expire.
+	if c.Grace < max(c.AccessTokenTTL, c.IDTokenTTL)+c.Leeway {
+		return errors.New("token: Grace cannot be less than AccessTokenTTL or IDTokenTTL plus Leeway")
+	}
+
+	if c.RefreshInterval <= 0 {
+		return errors.New("token: RefreshInterval cannot be zero or negative")
+	}
+
+	if c.Leeway < 0 {
+		return errors.New("token: Leeway cannot be negative")
+	}
+
+	return nil
+}
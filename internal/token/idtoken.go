@@ -0,0 +1,86 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gophero/guardian/core"
+)
+
+// idTokenType is the JWT type of ID tokens. OpenID Connect does not define a dedicated type.
+const idTokenType = "JWT"
+
+type idTokenClaims struct {
+	Issuer          string   `json:"iss"`
+	Subject         string   `json:"sub"`
+	Audience        string   `json:"aud"`
+	AuthorizedParty string   `json:"azp"`
+	ExpiresAt       int64    `json:"exp"`
+	IssuedAt        int64    `json:"iat"`
+	AuthTime        int64    `json:"auth_time,omitempty"`
+	Nonce           string   `json:"nonce,omitempty"`
+	ACR             string   `json:"acr,omitempty"`
+	AMR             []string `json:"amr,omitempty"`
+	SessionID       string   `json:"sid,omitempty"`
+}
+
+// IDTokenIssuer is a [core.IDTokenIssuer] which signs ID tokens with the keys of a [KeyRing].
+type IDTokenIssuer struct {
+	config Config
+	keys   *KeyRing
+	now    func() time.Time
+}
+
+var _ core.IDTokenIssuer = (*IDTokenIssuer)(nil)
+
+// NewIDTokenIssuer constructs new [IDTokenIssuer] using the config of keys.
+func NewIDTokenIssuer(keys *KeyRing) *IDTokenIssuer {
+	return &IDTokenIssuer{config: keys.config, keys: keys, now: time.Now}
+}
+
+// Issue implements [core.IDTokenIssuer].
+func (i *IDTokenIssuer) Issue(ctx context.Context, params core.IDTokenParams) (string, error) {
+	if params.Subject == "" || params.ClientID == "" {
+		return "", fmt.Errorf("token: subject and client id cannot be empty: %w", core.ErrInvalidArgument)
+	}
+
+	k, err := i.keys.signingKey(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	now := i.now().Truncate(time.Second)
+	c := idTokenClaims{
+		Issuer:          i.config.Issuer,
+		Subject:         params.Subject,
+		Audience:        params.ClientID,
+		AuthorizedParty: params.ClientID,
+		ExpiresAt:       now.Add(i.config.IDTokenTTL).Unix(),
+		IssuedAt:        now.Unix(),
+		Nonce:           params.Nonce,
+		ACR:             params.ACR,
+		AMR:             slices.Clone(params.AMR),
+	}
+	if !params.AuthTime.IsZero() {
+		c.AuthTime = params.AuthTime.Unix()
+	}
+	if params.SessionID != uuid.Nil {
+		c.SessionID = params.SessionID.String()
+	}
+
+	return sign(k, idTokenType, c)
+}
+
+// Issuer implements [core.IDTokenIssuer].
+func (i *IDTokenIssuer) Issuer() string {
+	return i.config.Issuer
+}
+
+// Algorithm implements [core.IDTokenIssuer].
+func (i *IDTokenIssuer) Algorithm() string {
+	return string(i.config.Algorithm)
+}
@@ -0,0 +1,62 @@
+package token
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+)
+
+func TestIDTokenIssuer(t *testing.T) {
+	now := time.Now()
+	_, ring := newTestIssuer(t, ES256, now)
+	issuer := &IDTokenIssuer{config: ring.config, keys: ring, now: func() time.Time { return now }}
+
+	require.Equal(t, "https://guardian.test", issuer.Issuer())
+	require.Equal(t, "ES256", issuer.Algorithm())
+
+	params := core.IDTokenParams{
+		Subject:   uuid.NewString(),
+		ClientID:  uuid.NewString(),
+		SessionID: uuid.New(),
+		Nonce:     "n-0S6_WzA2Mj",
+		AuthTime:  now.Add(-time.Minute),
+		ACR:       core.ACRMultiFactor,
+		AMR:       []string{core.AuthMethodMultiFactor, core.AuthMethodOTP},
+	}
+
+	token, err := issuer.Issue(context.Background(), params)
+	require.NoError(t, err)
+
+	h, payload, input, sig, err := parse(token)
+	require.NoError(t, err)
+	require.Equal(t, "JWT", h.Type)
+
+	k, err := ring.verificationKey(context.Background(), h.KeyID)
+	require.NoError(t, err)
+	require.True(t, verifySignature(k.private.Public(), input, sig))
+
+	var c idTokenClaims
+	require.NoError(t, json.Unmarshal(payload, &c))
+	require.Equal(t, idTokenClaims{
+		Issuer:          "https://guardian.test",
+		Subject:         params.Subject,
+		Audience:        params.ClientID,
+		AuthorizedParty: params.ClientID,
+		ExpiresAt:       now.Truncate(time.Second).Add(time.Hour).Unix(),
+		IssuedAt:        now.Unix(),
+		AuthTime:        params.AuthTime.Unix(),
+		Nonce:           params.Nonce,
+		ACR:             core.ACRMultiFactor,
+		AMR:             params.AMR,
+		SessionID:       params.SessionID.String(),
+	}, c)
+
+	_, err = issuer.Issue(context.Background(), core.IDTokenParams{Subject: params.Subject})
+	require.ErrorIs(t, err, core.ErrInvalidArgument)
+}
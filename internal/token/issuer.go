@@ -0,0 +1,203 @@
+package token
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gophero/guardian/core"
+)
+
+// accessTokenType is the JWT type of access tokens as defined by RFC 9068.
+const accessTokenType = "at+jwt"
+
+// audience is the aud claim which may be encoded either as a string or an array of strings.
+type audience []string
+
+func (a *audience) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*a = audience{s}
+		return nil
+	}
+
+	return json.Unmarshal(b, (*[]string)(a))
+}
+
+type accessTokenClaims struct {
+	Issuer    string   `json:"iss"`
+	Subject   string   `json:"sub"`
+	Audience  audience `json:"aud,omitempty"`
+	ExpiresAt int64    `json:"exp"`
+	NotBefore int64    `json:"nbf"`
+	IssuedAt  int64    `json:"iat"`
+	ID        string   `json:"jti"`
+	SessionID string   `json:"sid,omitempty"`
+	ClientID  string   `json:"client_id,omitempty"`
+	Scope     string   `json:"scope,omitempty"`
+	FamilyID  string   `json:"family_id,omitempty"`
+}
+
+// Issuer is a [core.AccessTokenIssuer] which signs JWT access tokens with the keys of a [KeyRing].
+type Issuer struct {
+	config Config
+	keys   *KeyRing
+	now    func() time.Time
+}
+
+var _ core.AccessTokenIssuer = (*Issuer)(nil)
+
+// NewIssuer constructs new [Issuer] using the config of keys.
+func NewIssuer(keys *KeyRing) *Issuer {
+	return &Issuer{config: keys.config, keys: keys, now: time.Now}
+}
+
+// Issue implements [core.AccessTokenIssuer].
+func (i *Issuer) Issue(ctx context.Context, params core.AccessTokenParams) (string, core.AccessTokenClaims, error) {
+	if params.Subject == "" {
+		return "", core.AccessTokenClaims{}, fmt.Errorf("token: subject cannot be empty: %w", core.ErrInvalidArgument)
+	}
+
+	k, err := i.keys.signingKey(ctx)
+	if err != nil {
+		return "", core.AccessTokenClaims{}, err
+	}
+
+	aud := params.Audience
+	if len(aud) == 0 {
+		aud = i.config.Audience
+	}
+
+	now := i.now().Truncate(time.Second)
+	claims := core.AccessTokenClaims{
+		ID:        uuid.NewString(),
+		Issuer:    i.config.Issuer,
+		Subject:   params.Subject,
+		Audience:  slices.Clone(aud),
+		IssuedAt:  now,
+		NotBefore: now,
+		ExpiresAt: now.Add(i.config.AccessTokenTTL),
+		SessionID: params.SessionID,
+		ClientID:  params.ClientID,
+		Scope:     slices.Clone(params.Scope),
+		FamilyID:  params.FamilyID,
+	}
+
+	c := accessTokenClaims{
+		Issuer:    claims.Issuer,
+		Subject:   claims.Subject,
+		Audience:  claims.Audience,
+		ExpiresAt: claims.ExpiresAt.Unix(),
+		NotBefore: claims.NotBefore.Unix(),
+		IssuedAt:  claims.IssuedAt.Unix(),
+		ID:        claims.ID,
+		ClientID:  claims.ClientID,
+		Scope:     strings.Join(claims.Scope, " "),
+	}
+	if claims.SessionID != uuid.Nil {
+		c.SessionID = claims.SessionID.String()
+	}
+	if claims.FamilyID != uuid.Nil {
+		c.FamilyID = claims.FamilyID.String()
+	}
+
+	token, err := sign(k, accessTokenType, c)
+	if err != nil {
+		return "", core.AccessTokenClaims{}, err
+	}
+
+	return token, claims, nil
+}
+
+// Verify implements [core.AccessTokenIssuer].
+func (i *Issuer) Verify(ctx context.Context, token string) (core.AccessTokenClaims, error) {
+	h, payload, input, sig, err := parse(token)
+	if err != nil {
+		return core.AccessTokenClaims{}, invalid(err)
+	}
+
+	if h.Type != accessTokenType {
+		return core.AccessTokenClaims{}, invalid(fmt.Errorf("token: unexpected type `%s`", h.Type))
+	}
+
+	k, err := i.keys.verificationKey(ctx, h.KeyID)
+	if err != nil {
+		return core.AccessTokenClaims{}, err
+	}
+
+	if k == nil {
+		return core.AccessTokenClaims{}, invalid(fmt.Errorf("token: unknown key `%s`", h.KeyID))
+	}
+
+	if h.Algorithm != k.alg {
+		return core.AccessTokenClaims{}, invalid(fmt.Errorf("token: algorithm `%s` does not match key", h.Algorithm))
+	}
+
+	if !verifySignature(k.private.Public(), input, sig) {
+		return core.AccessTokenClaims{}, invalid(errors.New("token: invalid signature"))
+	}
+
+	var c accessTokenClaims
+	if err := json.Unmarshal(payload, &c); err != nil {
+		return core.AccessTokenClaims{}, invalid(errMalformed)
+	}
+
+	if c.Issuer != i.config.Issuer {
+		return core.AccessTokenClaims{}, invalid(fmt.Errorf("token: unexpected issuer `%s`", c.Issuer))
+	}
+
+	now := i.now()
+	if !now.Before(time.Unix(c.ExpiresAt, 0).Add(i.config.Leeway)) {
+		return core.AccessTokenClaims{}, invalid(errors.New("token: expired"))
+	}
+
+	if now.Add(i.config.Leeway).Before(time.Unix(c.NotBefore, 0)) {
+		return core.AccessTokenClaims{}, invalid(errors.New("token: not yet valid"))
+	}
+
+	if len(i.config.Audience) > 0 && !slices.ContainsFunc(c.Audience, func(aud string) bool {
+		return slices.Contains(i.config.Audience, aud)
+	}) {
+		return core.AccessTokenClaims{}, invalid(errors.New("token: unexpected audience"))
+	}
+
+	claims := core.AccessTokenClaims{
+		ID:        c.ID,
+		Issuer:    c.Issuer,
+		Subject:   c.Subject,
+		Audience:  c.Audience,
+		IssuedAt:  time.Unix(c.IssuedAt, 0),
+		NotBefore: time.Unix(c.NotBefore, 0),
+		ExpiresAt: time.Unix(c.ExpiresAt, 0),
+		ClientID:  c.ClientID,
+	}
+
+	if c.Scope != "" {
+		claims.Scope = strings.Fields(c.Scope)
+	}
+
+	if c.SessionID != "" {
+		if claims.SessionID, err = uuid.Parse(c.SessionID); err != nil {
+			return core.AccessTokenClaims{}, invalid(errMalformed)
+		}
+	}
+
+	if c.FamilyID != "" {
+		if claims.FamilyID, err = uuid.Parse(c.FamilyID); err != nil {
+			return core.AccessTokenClaims{}, invalid(errMalformed)
+		}
+	}
+
+	return claims, nil
+}
+
+// invalid wraps err with [core.ErrInvalidToken].
+func invalid(err error) error {
+	return fmt.Errorf("%w: %w", core.ErrInvalidToken, err)
+}
@@ -0,0 +1,131 @@
+package token
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+)
+
+func newTestIssuer(t *testing.T, alg Algorithm, now time.Time) (*Issuer, *KeyRing) {
+	t.Helper()
+
+	k, err := generateKey(alg)
+	require.NoError(t, err)
+	k.activatesAt = now.Add(-time.Hour)
+
+	config := Config{
+		Issuer:          "https://guardian.test",
+		Audience:        []string{"api"},
+		AccessTokenTTL:  15 * time.Minute,
+		IDTokenTTL:      time.Hour,
+		Algorithm:       alg,
+		RefreshInterval: time.Hour,
+		Leeway:          30 * time.Second,
+	}
+
+	clock := func() time.Time { return now }
+	ring := &KeyRing{config: config, now: clock, keys: []*key{k}, loadedAt: now}
+
+	return &Issuer{config: config, keys: ring, now: clock}, ring
+}
+
+func TestIssuer(t *testing.T) {
+	now := time.Now()
+
+	for _, alg := range []Algorithm{EdDSA, ES256, RS256} {
+		t.Run(string(alg), func(t *testing.T) {
+			issuer, _ := newTestIssuer(t, alg, now)
+
+			params := core.AccessTokenParams{
+				Subject:   uuid.NewString(),
+				SessionID: uuid.New(),
+				ClientID:  "cli",
+				Scope:     []string{"openid", "profile"},
+				FamilyID:  uuid.New(),
+			}
+
+			token, issued, err := issuer.Issue(context.Background(), params)
+			require.NoError(t, err)
+			require.Equal(t, []string{"api"}, issued.Audience)
+			require.Equal(t, now.Truncate(time.Second).Add(15*time.Minute), issued.ExpiresAt)
+
+			verified, err := issuer.Verify(context.Background(), token)
+			require.NoError(t, err)
+			require.Equal(t, issued.ID, verified.ID)
+			require.Equal(t, params.Subject, verified.Subject)
+			require.Equal(t, params.SessionID, verified.SessionID)
+			require.Equal(t, params.ClientID, verified.ClientID)
+			require.Equal(t, params.Scope, verified.Scope)
+			require.Equal(t, params.FamilyID, verified.FamilyID)
+			require.True(t, issued.ExpiresAt.Equal(verified.ExpiresAt))
+		})
+	}
+}
+
+func TestIssuerVerifyRejects(t *testing.T) {
+	now := time.Now()
+	issuer, ring := newTestIssuer(t, EdDSA, now)
+
+	token, _, err := issuer.Issue(context.Background(), core.AccessTokenParams{Subject: "user"})
+	require.NoError(t, err)
+
+	parts := strings.Split(token, ".")
+
+	t.Run("malformed", func(t *testing.T) {
+		_, err := issuer.Verify(context.Background(), "not.a-token")
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		payload := base64.RawURLEncoding.EncodeToString([]byte(`{"iss":"https://guardian.test","sub":"admin","aud":"api","exp":9999999999}`))
+		_, err := issuer.Verify(context.Background(), parts[0]+"."+payload+"."+parts[2])
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+	})
+
+	t.Run("algorithm mismatch", func(t *testing.T) {
+		h := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","kid":"` + ring.keys[0].id + `","typ":"at+jwt"}`))
+		_, err := issuer.Verify(context.Background(), h+"."+parts[1]+"."+parts[2])
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		other, _ := newTestIssuer(t, EdDSA, now)
+		token, _, err := other.Issue(context.Background(), core.AccessTokenParams{Subject: "user"})
+		require.NoError(t, err)
+
+		_, err = issuer.Verify(context.Background(), token)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		issuer.now = func() time.Time { return now.Add(16 * time.Minute) }
+		defer func() { issuer.now = func() time.Time { return now } }()
+
+		_, err := issuer.Verify(context.Background(), token)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+	})
+
+	t.Run("audience", func(t *testing.T) {
+		token, _, err := issuer.Issue(context.Background(), core.AccessTokenParams{Subject: "user", Audience: []string{"other"}})
+		require.NoError(t, err)
+
+		_, err = issuer.Verify(context.Background(), token)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+	})
+
+	t.Run("retired key", func(t *testing.T) {
+		expiresAt := now.Add(-time.Second)
+		ring.keys[0].expiresAt = &expiresAt
+		defer func() { ring.keys[0].expiresAt = nil }()
+
+		_, err := issuer.Verify(context.Background(), token)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+	})
+}
@@ -0,0 +1,33 @@
+package token
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/rs/zerolog"
+)
+
+// NewJWKSHandler creates a [http.Handler] serving the public keys of keys as a JWK set.
+func NewJWKSHandler(keys *KeyRing) http.Handler {
+	maxAge := int(keys.config.RefreshInterval.Seconds())
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jwks, err := keys.JWKS(r.Context())
+		if err != nil {
+			zerolog.Ctx(r.Context()).Err(err).Msg("failed to get jwks")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/jwk-set+json")
+		// Verifiers may cache keys for as long as the key ring itself does since new keys are pre-published.
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", maxAge))
+
+		if err := json.NewEncoder(w).Encode(struct {
+			Keys []JWK `json:"keys"`
+		}{Keys: jwks}); err != nil {
+			zerolog.Ctx(r.Context()).Err(err).Msg("failed to write jwks")
+		}
+	})
+}
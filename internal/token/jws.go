@@ -0,0 +1,127 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// es256Size is the size of each of the r and s values of an ES256 signature.
+const es256Size = 32
+
+var errMalformed = errors.New("token: malformed token")
+
+type header struct {
+	Algorithm Algorithm `json:"alg"`
+	KeyID     string    `json:"kid"`
+	Type      string    `json:"typ,omitempty"`
+}
+
+// sign creates a compact JWS over the JSON encoding of claims.
+func sign(k *key, typ string, claims any) (string, error) {
+	h, err := json.Marshal(header{Algorithm: k.alg, KeyID: k.id, Type: typ})
+	if err != nil {
+		return "", fmt.Errorf("token: marshal header: %w", err)
+	}
+
+	c, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("token: marshal claims: %w", err)
+	}
+
+	b64 := base64.RawURLEncoding
+	input := b64.EncodeToString(h) + "." + b64.EncodeToString(c)
+
+	sig, err := signInput(k, []byte(input))
+	if err != nil {
+		return "", err
+	}
+
+	return input + "." + b64.EncodeToString(sig), nil
+}
+
+func signInput(k *key, input []byte) ([]byte, error) {
+	switch k.alg {
+	case EdDSA:
+		return k.private.Sign(rand.Reader, input, crypto.Hash(0))
+	case ES256:
+		digest := sha256.Sum256(input)
+		r, s, err := ecdsa.Sign(rand.Reader, k.private.(*ecdsa.PrivateKey), digest[:])
+		if err != nil {
+			return nil, fmt.Errorf("token: sign: %w", err)
+		}
+		// JWS uses the fixed-size R || S encoding rather than ASN.1.
+		sig := make([]byte, 2*es256Size)
+		r.FillBytes(sig[:es256Size])
+		s.FillBytes(sig[es256Size:])
+		return sig, nil
+	case RS256:
+		digest := sha256.Sum256(input)
+		return rsa.SignPKCS1v15(rand.Reader, k.private.(*rsa.PrivateKey), crypto.SHA256, digest[:])
+	default:
+		return nil, fmt.Errorf("token: `%s` is not a valid algorithm", k.alg)
+	}
+}
+
+// parse decodes a compact JWS without verifying it and returns the header, payload, signing input and signature.
+func parse(token string) (header, []byte, []byte, []byte, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return header{}, nil, nil, nil, errMalformed
+	}
+
+	b64 := base64.RawURLEncoding
+
+	rawHeader, err := b64.DecodeString(parts[0])
+	if err != nil {
+		return header{}, nil, nil, nil, errMalformed
+	}
+
+	var h header
+	if err := json.Unmarshal(rawHeader, &h); err != nil {
+		return header{}, nil, nil, nil, errMalformed
+	}
+
+	payload, err := b64.DecodeString(parts[1])
+	if err != nil {
+		return header{}, nil, nil, nil, errMalformed
+	}
+
+	sig, err := b64.DecodeString(parts[2])
+	if err != nil {
+		return header{}, nil, nil, nil, errMalformed
+	}
+
+	return h, payload, []byte(parts[0] + "." + parts[1]), sig, nil
+}
+
+// verifySignature verifies sig over input with pub. The algorithm is taken from the key and never from the token
+// header.
+func verifySignature(pub crypto.PublicKey, input, sig []byte) bool {
+	switch pub := pub.(type) {
+	case ed25519.PublicKey:
+		return ed25519.Verify(pub, input, sig)
+	case *ecdsa.PublicKey:
+		if len(sig) != 2*es256Size {
+			return false
+		}
+		digest := sha256.Sum256(input)
+		r := new(big.Int).SetBytes(sig[:es256Size])
+		s := new(big.Int).SetBytes(sig[es256Size:])
+		return ecdsa.Verify(pub, digest[:], r, s)
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(input)
+		return rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], sig) == nil
+	default:
+		return false
+	}
+}
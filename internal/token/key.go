@@ -0,0 +1,208 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// rsaKeySize is the size of generated RSA keys in bits.
+const rsaKeySize = 3072
+
+// Algorithm is a JWS signing algorithm.
+type Algorithm string
+
+const (
+	EdDSA Algorithm = "EdDSA"
+	ES256 Algorithm = "ES256"
+	RS256 Algorithm = "RS256"
+)
+
+// key is a signing key of the key ring.
+type key struct {
+	id          string
+	alg         Algorithm
+	private     crypto.Signer
+	activatesAt time.Time
+	expiresAt   *time.Time
+}
+
+func generateKey(alg Algorithm) (*key, error) {
+	var (
+		signer crypto.Signer
+		err    error
+	)
+
+	switch alg {
+	case EdDSA:
+		_, signer, err = ed25519.GenerateKey(rand.Reader)
+	case ES256:
+		signer, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case RS256:
+		signer, err = rsa.GenerateKey(rand.Reader, rsaKeySize)
+	default:
+		return nil, fmt.Errorf("token: `%s` is not a valid algorithm", alg)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("token: generate %s key: %w", alg, err)
+	}
+
+	k := &key{alg: alg, private: signer}
+
+	// Derive the key ID from the public key thumbprint so it is stable and unique.
+	thumbprint, err := k.thumbprint()
+	if err != nil {
+		return nil, err
+	}
+	k.id = thumbprint
+
+	return k, nil
+}
+
+func parseKey(id string, alg Algorithm, der []byte) (*key, error) {
+	priv, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("token: parse private key `%s`: %w", id, err)
+	}
+
+	signer, ok := priv.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("token: private key `%s` is not a signer", id)
+	}
+
+	k := &key{id: id, alg: alg, private: signer}
+
+	if err := k.checkAlgorithm(); err != nil {
+		return nil, err
+	}
+
+	return k, nil
+}
+
+// checkAlgorithm ensures the key type matches the algorithm to prevent algorithm confusion.
+func (k *key) checkAlgorithm() error {
+	var ok bool
+
+	switch k.alg {
+	case EdDSA:
+		_, ok = k.private.(ed25519.PrivateKey)
+	case ES256:
+		var ec *ecdsa.PrivateKey
+		ec, ok = k.private.(*ecdsa.PrivateKey)
+		ok = ok && ec.Curve == elliptic.P256()
+	case RS256:
+		_, ok = k.private.(*rsa.PrivateKey)
+	}
+
+	if !ok {
+		return fmt.Errorf("token: key `%s` does not match algorithm `%s`", k.id, k.alg)
+	}
+
+	return nil
+}
+
+func (k *key) marshal() (private []byte, public []byte, err error) {
+	private, err = x509.MarshalPKCS8PrivateKey(k.private)
+	if err != nil {
+		return nil, nil, fmt.Errorf("token: marshal private key: %w", err)
+	}
+
+	public, err = x509.MarshalPKIXPublicKey(k.private.Public())
+	if err != nil {
+		return nil, nil, fmt.Errorf("token: marshal public key: %w", err)
+	}
+
+	return private, public, nil
+}
+
+// JWK is a public JSON Web Key as defined by RFC 7517.
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	Y         string `json:"y,omitempty"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+}
+
+func (k *key) jwk() (JWK, error) {
+	jwk, err := publicJWK(k.private.Public())
+	if err != nil {
+		return JWK{}, err
+	}
+
+	jwk.KeyID, jwk.Algorithm, jwk.Use = k.id, string(k.alg), "sig"
+
+	return jwk, nil
+}
+
+// publicJWK returns the key type and parameters of pub as a JWK.
+func publicJWK(pub crypto.PublicKey) (JWK, error) {
+	var jwk JWK
+	b64 := base64.RawURLEncoding
+
+	switch pub := pub.(type) {
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = b64.EncodeToString(pub)
+	case *ecdsa.PublicKey:
+		ecdhPub, err := pub.ECDH()
+		if err != nil {
+			return JWK{}, fmt.Errorf("token: ecdsa public key: %w", err)
+		}
+		b := ecdhPub.Bytes() // Uncompressed point 0x04 || X || Y.
+		size := (len(b) - 1) / 2
+		jwk.KeyType = "EC"
+		jwk.Curve = "P-256"
+		jwk.X = b64.EncodeToString(b[1 : 1+size])
+		jwk.Y = b64.EncodeToString(b[1+size:])
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = b64.EncodeToString(pub.N.Bytes())
+		jwk.E = b64.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	default:
+		return JWK{}, fmt.Errorf("token: unsupported public key type %T", pub)
+	}
+
+	return jwk, nil
+}
+
+// thumbprint computes the RFC 7638 JWK thumbprint of the public key.
+func (k *key) thumbprint() (string, error) {
+	jwk, err := k.jwk()
+	if err != nil {
+		return "", err
+	}
+
+	return thumbprintOf(jwk), nil
+}
+
+func thumbprintOf(jwk JWK) string {
+	// Required members in lexicographic order as mandated by RFC 7638.
+	var s string
+	switch jwk.KeyType {
+	case "OKP":
+		s = fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q}`, jwk.Curve, jwk.KeyType, jwk.X)
+	case "EC":
+		s = fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q,"y":%q}`, jwk.Curve, jwk.KeyType, jwk.X, jwk.Y)
+	case "RSA":
+		s = fmt.Sprintf(`{"e":%q,"kty":%q,"n":%q}`, jwk.E, jwk.KeyType, jwk.N)
+	}
+
+	sum := sha256.Sum256([]byte(s))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,50 @@
+package token
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyMarshalParse(t *testing.T) {
+	for _, alg := range []Algorithm{EdDSA, ES256, RS256} {
+		t.Run(string(alg), func(t *testing.T) {
+			k, err := generateKey(alg)
+			require.NoError(t, err)
+
+			private, _, err := k.marshal()
+			require.NoError(t, err)
+
+			parsed, err := parseKey(k.id, alg, private)
+			require.NoError(t, err)
+
+			jwk, err := parsed.jwk()
+			require.NoError(t, err)
+			require.Equal(t, k.id, jwk.KeyID)
+			require.Equal(t, string(alg), jwk.Algorithm)
+			require.Equal(t, "sig", jwk.Use)
+
+			thumbprint, err := parsed.thumbprint()
+			require.NoError(t, err)
+			require.Equal(t, k.id, thumbprint)
+		})
+	}
+}
+
+func TestParseKeyAlgorithmMismatch(t *testing.T) {
+	k, err := generateKey(EdDSA)
+	require.NoError(t, err)
+
+	private, _, err := k.marshal()
+	require.NoError(t, err)
+
+	_, err = parseKey(k.id, ES256, private)
+	require.Error(t, err)
+}
+
+func TestThumbprint(t *testing.T) {
+	// Example from RFC 8037 appendix A.3.
+	jwk := JWK{KeyType: "OKP", Curve: "Ed25519", X: "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"}
+
+	require.Equal(t, "kPrK_qmxVWaYVA9wwBF6Iuo3vVzz7TxHCTwXBygrS4k", thumbprintOf(jwk))
+}
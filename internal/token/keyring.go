@@ -0,0 +1,267 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/gophero/guardian/internal/db/queries"
+	"github.com/gophero/guardian/internal/encryption"
+)
+
+// minReloadInterval limits reloads of the key ring triggered by tokens signed with unknown keys.
+const minReloadInterval = 5 * time.Second
+
+// KeyRing is a postgres backed set of signing keys. It caches valid keys in memory and reloads them every
+// RefreshInterval so that keys rotated by any instance are picked up by all instances. Private keys are encrypted with
+// cipher bound to their key ID, keys which cannot be decrypted are rejected.
+type KeyRing struct {
+	config Config
+	pool   *pgxpool.Pool
+	q      *queries.Queries
+	cipher *encryption.Cipher
+	now    func() time.Time
+
+	mu       sync.RWMutex
+	keys     []*key // Sorted by activation time, newest first.
+	loadedAt time.Time
+}
+
+// NewKeyRing constructs new [KeyRing].
+func NewKeyRing(pool *pgxpool.Pool, config Config, cipher *encryption.Cipher) (*KeyRing, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return &KeyRing{config: config, pool: pool, q: queries.New(pool), cipher: cipher, now: time.Now}, nil
+}
+
+// signingKey returns the newest active key.
+func (r *KeyRing) signingKey(ctx context.Context) (*key, error) {
+	keys, err := r.load(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	now := r.now()
+	for _, k := range keys {
+		if !k.activatesAt.After(now) && (k.expiresAt == nil || k.expiresAt.After(now)) {
+			return k, nil
+		}
+	}
+
+	return nil, errors.New("token: no active signing key")
+}
+
+// verificationKey returns the valid key with the given id or nil if there is none.
+func (r *KeyRing) verificationKey(ctx context.Context, id string) (*key, error) {
+	keys, err := r.load(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if k := findKey(keys, id, r.now()); k != nil {
+		return k, nil
+	}
+
+	// The key may have been created by another instance since the last reload.
+	r.mu.RLock()
+	stale := r.now().Sub(r.loadedAt) >= minReloadInterval
+	r.mu.RUnlock()
+
+	if !stale {
+		return nil, nil
+	}
+
+	keys, err = r.load(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return findKey(keys, id, r.now()), nil
+}
+
+func findKey(keys []*key, id string, now time.Time) *key {
+	for _, k := range keys {
+		if k.id == id && (k.expiresAt == nil || k.expiresAt.After(now)) {
+			return k
+		}
+	}
+
+	return nil
+}
+
+// JWKS returns the public keys of all valid keys, including keys which are not yet active.
+func (r *KeyRing) JWKS(ctx context.Context) ([]JWK, error) {
+	keys, err := r.load(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	now := r.now()
+	jwks := make([]JWK, 0, len(keys))
+	for _, k := range keys {
+		if k.expiresAt != nil && !k.expiresAt.After(now) {
+			continue
+		}
+
+		jwk, err := k.jwk()
+		if err != nil {
+			return nil, err
+		}
+		jwks = append(jwks, jwk)
+	}
+
+	return jwks, nil
+}
+
+func (r *KeyRing) load(ctx context.Context, force bool) ([]*key, error) {
+	r.mu.RLock()
+	keys, loadedAt := r.keys, r.loadedAt
+	r.mu.RUnlock()
+
+	if !force && !loadedAt.IsZero() && r.now().Sub(loadedAt) < r.config.RefreshInterval {
+		return keys, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Another goroutine may have reloaded while waiting for the lock.
+	if !r.loadedAt.Equal(loadedAt) {
+		return r.keys, nil
+	}
+
+	rows, err := r.q.ListValidSigningKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("token: list valid signing keys: %w", err)
+	}
+
+	keys = make([]*key, 0, len(rows))
+	for _, row := range rows {
+		private, err := r.cipher.Decrypt(row.PrivateKey, []byte(row.ID))
+		if err != nil {
+			return nil, fmt.Errorf("token: decrypt private key `%s`: %w", row.ID, err)
+		}
+
+		k, err := parseKey(row.ID, Algorithm(row.Algorithm), private)
+		if err != nil {
+			return nil, err
+		}
+		k.activatesAt = row.ActivatesAt
+		k.expiresAt = row.ExpiresAt
+		keys = append(keys, k)
+	}
+
+	r.keys, r.loadedAt = keys, r.now()
+
+	return keys, nil
+}
+
+// Rotate generates a new signing key and schedules expiry of all other keys. The new key is used for signing after
+// PrePublish, or immediately if there is no other key. Retired keys remain valid for Grace after the new key
+// activates.
+func (r *KeyRing) Rotate(ctx context.Context) error {
+	return r.rotate(ctx, true)
+}
+
+// RotateIfDue rotates the signing key if there is none, the newest key is due for rotation within PrePublish, or the
+// newest key does not use the configured algorithm. It is safe to call concurrently from multiple instances.
+func (r *KeyRing) RotateIfDue(ctx context.Context) error {
+	return r.rotate(ctx, false)
+}
+
+func (r *KeyRing) rotate(ctx context.Context, force bool) error {
+	rotated := false
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		q := r.q.WithTx(tx)
+
+		if err := q.LockSigningKeys(ctx); err != nil {
+			return fmt.Errorf("token: lock signing keys: %w", err)
+		}
+
+		now := r.now()
+		activatesAt := now
+
+		latest, err := q.GetLatestSigningKey(ctx)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+		case err != nil:
+			return fmt.Errorf("token: get latest signing key: %w", err)
+		default:
+			due := !latest.ActivatesAt.Add(r.config.RotationInterval).After(now.Add(r.config.PrePublish))
+			if !force && !due && Algorithm(latest.Algorithm) == r.config.Algorithm {
+				return nil
+			}
+
+			activatesAt = now.Add(r.config.PrePublish)
+		}
+
+		k, err := generateKey(r.config.Algorithm)
+		if err != nil {
+			return err
+		}
+
+		private, public, err := k.marshal()
+		if err != nil {
+			return err
+		}
+
+		if err := q.CreateSigningKey(ctx, queries.CreateSigningKeyParams{
+			ID:          k.id,
+			Algorithm:   queries.SigningKeyAlgorithm(k.alg),
+			PrivateKey:  r.cipher.Encrypt(private, []byte(k.id)),
+			PublicKey:   public,
+			ActivatesAt: activatesAt,
+		}); err != nil {
+			return fmt.Errorf("token: create signing key: %w", err)
+		}
+
+		expiresAt := activatesAt.Add(r.config.Grace)
+		if err := q.ExpireSigningKeys(ctx, queries.ExpireSigningKeysParams{
+			ExpiresAt: &expiresAt,
+			ExceptID:  k.id,
+		}); err != nil {
+			return fmt.Errorf("token: expire signing keys: %w", err)
+		}
+
+		zerolog.Ctx(ctx).Info().
+			Str("kid", k.id).
+			Str("alg", string(k.alg)).
+			Time("activates_at", activatesAt).
+			Msg("signing key rotated")
+
+		rotated = true
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if rotated {
+		if _, err := r.load(ctx, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteExpired deletes expired keys and returns the number of deleted keys.
+func (r *KeyRing) DeleteExpired(ctx context.Context) (int64, error) {
+	now := r.now()
+
+	n, err := r.q.DeleteExpiredSigningKeys(ctx, &now)
+	if err != nil {
+		return 0, fmt.Errorf("token: delete expired signing keys: %w", err)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,80 @@
+package token
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/internal/db/dbtest"
+	"github.com/gophero/guardian/internal/db/queries"
+	"github.com/gophero/guardian/internal/encryption"
+)
+
+func TestKeyRing(t *testing.T) {
+	ctx := context.Background()
+	pool := dbtest.Pool(t)
+	q := queries.New(pool)
+
+	secret := make([]byte, 32)
+	rand.Read(secret)
+
+	cipher, err := encryption.NewCipher(encryption.Config{Keys: []string{base64.StdEncoding.EncodeToString(secret)}})
+	require.NoError(t, err)
+
+	ring, err := NewKeyRing(pool, Config{
+		Issuer:           "https://guardian.test",
+		AccessTokenTTL:   15 * time.Minute,
+		IDTokenTTL:       time.Hour,
+		Algorithm:        EdDSA,
+		RotationInterval: 720 * time.Hour,
+		Grace:            24 * time.Hour,
+		RefreshInterval:  time.Minute,
+	}, cipher)
+	require.NoError(t, err)
+
+	t.Run("encrypts private keys", func(t *testing.T) {
+		require.NoError(t, ring.Rotate(ctx))
+
+		latest, err := q.GetLatestSigningKey(ctx)
+		require.NoError(t, err)
+
+		_, err = x509.ParsePKCS8PrivateKey(latest.PrivateKey)
+		require.Error(t, err)
+
+		// The ciphertext is bound to the key ID.
+		_, err = cipher.Decrypt(latest.PrivateKey, []byte(latest.ID+"x"))
+		require.ErrorIs(t, err, encryption.ErrDecrypt)
+
+		k, err := ring.verificationKey(ctx, latest.ID)
+		require.NoError(t, err)
+		require.NotNil(t, k)
+	})
+
+	t.Run("rejects plaintext keys", func(t *testing.T) {
+		k, err := generateKey(EdDSA)
+		require.NoError(t, err)
+
+		private, public, err := k.marshal()
+		require.NoError(t, err)
+
+		require.NoError(t, q.CreateSigningKey(ctx, queries.CreateSigningKeyParams{
+			ID:          k.id,
+			Algorithm:   queries.SigningKeyAlgorithm(k.alg),
+			PrivateKey:  private,
+			PublicKey:   public,
+			ActivatesAt: time.Now(),
+		}))
+		t.Cleanup(func() {
+			_, err := pool.Exec(ctx, "DELETE FROM signing_keys WHERE id = $1", k.id)
+			require.NoError(t, err)
+		})
+
+		_, err = ring.load(ctx, true)
+		require.ErrorIs(t, err, encryption.ErrDecrypt)
+	})
+}
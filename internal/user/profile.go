@@ -0,0 +1,100 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+// ProfileStore is a postgres backed [core.UserProfileStore].
+type ProfileStore struct {
+	q *queries.Queries
+}
+
+var _ core.UserProfileStore = (*ProfileStore)(nil)
+
+// NewProfileStore constructs new [ProfileStore].
+func NewProfileStore(pool *pgxpool.Pool) *ProfileStore {
+	return &ProfileStore{q: queries.New(pool)}
+}
+
+// Get implements [core.UserProfileStore].
+func (s *ProfileStore) Get(ctx context.Context, userID uuid.UUID) (core.UserProfile, error) {
+	p, err := s.q.GetUserProfile(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return core.UserProfile{UserID: userID}, nil
+	}
+
+	if err != nil {
+		return core.UserProfile{}, fmt.Errorf("user: get user profile: %w", err)
+	}
+
+	return toProfile(p), nil
+}
+
+// Update implements [core.UserProfileStore].
+func (s *ProfileStore) Update(ctx context.Context, profile core.UserProfile) (core.UserProfile, error) {
+	if err := validateProfile(profile); err != nil {
+		return core.UserProfile{}, err
+	}
+
+	p, err := s.q.UpsertUserProfile(ctx, queries.UpsertUserProfileParams{
+		UserID:        profile.UserID,
+		Name:          profile.Name,
+		GivenName:     profile.GivenName,
+		FamilyName:    profile.FamilyName,
+		Nickname:      profile.Nickname,
+		Picture:       profile.Picture,
+		Website:       profile.Website,
+		Birthdate:     profile.Birthdate,
+		Zoneinfo:      profile.Zoneinfo,
+		Locale:        profile.Locale,
+		PhoneNumber:   profile.PhoneNumber,
+		StreetAddress: profile.Address.StreetAddress,
+		Locality:      profile.Address.Locality,
+		Region:        profile.Address.Region,
+		PostalCode:    profile.Address.PostalCode,
+		Country:       profile.Address.Country,
+	})
+	if db.IsForeignKeyViolation(err) {
+		return core.UserProfile{}, fmt.Errorf("user: upsert user profile: %w", core.ErrNotFound)
+	}
+
+	if err != nil {
+		return core.UserProfile{}, fmt.Errorf("user: upsert user profile: %w", err)
+	}
+
+	return toProfile(p), nil
+}
+
+func toProfile(p queries.UserProfile) core.UserProfile {
+	return core.UserProfile{
+		UserID:      p.UserID,
+		Name:        p.Name,
+		GivenName:   p.GivenName,
+		FamilyName:  p.FamilyName,
+		Nickname:    p.Nickname,
+		Picture:     p.Picture,
+		Website:     p.Website,
+		Birthdate:   p.Birthdate,
+		Zoneinfo:    p.Zoneinfo,
+		Locale:      p.Locale,
+		PhoneNumber: p.PhoneNumber,
+		Address: core.UserAddress{
+			StreetAddress: p.StreetAddress,
+			Locality:      p.Locality,
+			Region:        p.Region,
+			PostalCode:    p.PostalCode,
+			Country:       p.Country,
+		},
+		UpdatedAt: p.UpdatedAt,
+	}
+}
@@ -0,0 +1,257 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/cursor"
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+// Store is a postgres backed [core.UserStore].
+type Store struct {
+	q *queries.Queries
+}
+
+var _ core.UserStore = (*Store)(nil)
+
+// NewStore constructs new [Store].
+func NewStore(pool *pgxpool.Pool) *Store {
+	return &Store{q: queries.New(pool)}
+}
+
+// Create implements [core.UserStore].
+func (s *Store) Create(ctx context.Context, params core.CreateUserParams) (core.User, error) {
+	if params.Status == "" {
+		params.Status = core.UserStatusActive
+	}
+
+	if err := validateEmail(params.Email); err != nil {
+		return core.User{}, err
+	}
+
+	if err := validateUsername(params.Username); err != nil {
+		return core.User{}, err
+	}
+
+	if err := validateStatus(params.Status); err != nil {
+		return core.User{}, err
+	}
+
+	u, err := s.q.CreateUser(ctx, queries.CreateUserParams{
+		Email:    params.Email,
+		Username: params.Username,
+		Status:   queries.UserStatus(params.Status),
+	})
+	if err != nil {
+		return core.User{}, fmt.Errorf("user: create user: %w", mapError(err))
+	}
+
+	return toUser(u), nil
+}
+
+// Get implements [core.UserStore].
+func (s *Store) Get(ctx context.Context, id uuid.UUID) (core.User, error) {
+	u, err := s.q.GetUserByID(ctx, id)
+	if err != nil {
+		return core.User{}, fmt.Errorf("user: get user by id: %w", mapError(err))
+	}
+
+	return toUser(u), nil
+}
+
+// GetByEmail implements [core.UserStore].
+func (s *Store) GetByEmail(ctx context.Context, email string) (core.User, error) {
+	u, err := s.q.GetUserByEmail(ctx, email)
+	if err != nil {
+		return core.User{}, fmt.Errorf("user: get user by email: %w", mapError(err))
+	}
+
+	return toUser(u), nil
+}
+
+// GetByUsername implements [core.UserStore].
+func (s *Store) GetByUsername(ctx context.Context, username string) (core.User, error) {
+	u, err := s.q.GetUserByUsername(ctx, username)
+	if err != nil {
+		return core.User{}, fmt.Errorf("user: get user by username: %w", mapError(err))
+	}
+
+	return toUser(u), nil
+}
+
+// Update implements [core.UserStore].
+func (s *Store) Update(ctx context.Context, id uuid.UUID, params core.UpdateUserParams) (core.User, error) {
+	arg := queries.UpdateUserParams{ID: id}
+
+	if params.Email != nil {
+		if err := validateEmail(*params.Email); err != nil {
+			return core.User{}, err
+		}
+		arg.Email = pgtype.Text{String: *params.Email, Valid: true}
+	}
+
+	if params.Username != nil {
+		if err := validateUsername(*params.Username); err != nil {
+			return core.User{}, err
+		}
+		arg.Username = pgtype.Text{String: *params.Username, Valid: true}
+	}
+
+	if params.Status != nil {
+		if err := validateStatus(*params.Status); err != nil {
+			return core.User{}, err
+		}
+		arg.Status = queries.NullUserStatus{UserStatus: queries.UserStatus(*params.Status), Valid: true}
+	}
+
+	u, err := s.q.UpdateUser(ctx, arg)
+	if err != nil {
+		return core.User{}, fmt.Errorf("user: update user: %w", mapError(err))
+	}
+
+	return toUser(u), nil
+}
+
+// MarkEmailVerified implements [core.UserStore].
+func (s *Store) MarkEmailVerified(ctx context.Context, id uuid.UUID, email string) (core.User, error) {
+	u, err := s.q.MarkUserEmailVerified(ctx, queries.MarkUserEmailVerifiedParams{ID: id, Email: email})
+	if err != nil {
+		return core.User{}, fmt.Errorf("user: mark user email verified: %w", mapError(err))
+	}
+
+	return toUser(u), nil
+}
+
+// ChangeEmail implements [core.UserStore].
+func (s *Store) ChangeEmail(ctx context.Context, id uuid.UUID, email string) (core.User, error) {
+	if err := validateEmail(email); err != nil {
+		return core.User{}, err
+	}
+
+	u, err := s.q.ChangeUserEmail(ctx, queries.ChangeUserEmailParams{ID: id, Email: email})
+	if err != nil {
+		return core.User{}, fmt.Errorf("user: change user email: %w", mapError(err))
+	}
+
+	return toUser(u), nil
+}
+
+// Delete implements [core.UserStore].
+func (s *Store) Delete(ctx context.Context, id uuid.UUID) error {
+	n, err := s.q.SoftDeleteUser(ctx, id)
+	if err != nil {
+		return fmt.Errorf("user: soft delete user: %w", err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("user: soft delete user: %w", core.ErrNotFound)
+	}
+
+	return nil
+}
+
+// List implements [core.UserStore].
+func (s *Store) List(ctx context.Context, params core.ListUsersParams) (core.UserPage, error) {
+	afterID, err := cursor.Decode(params.Cursor)
+	if err != nil {
+		return core.UserPage{}, err
+	}
+
+	arg := queries.ListUsersParams{
+		AfterID: afterID,
+		Limit:   cursor.Limit(params.Limit) + 1,
+	}
+
+	if params.Status != "" {
+		if err := validateStatus(params.Status); err != nil {
+			return core.UserPage{}, err
+		}
+		arg.Status = queries.NullUserStatus{UserStatus: queries.UserStatus(params.Status), Valid: true}
+	}
+
+	users, err := s.q.ListUsers(ctx, arg)
+	if err != nil {
+		return core.UserPage{}, fmt.Errorf("user: list users: %w", err)
+	}
+
+	return toPage(users, int(arg.Limit-1)), nil
+}
+
+// Search implements [core.UserStore].
+func (s *Store) Search(ctx context.Context, params core.SearchUsersParams) (core.UserPage, error) {
+	afterID, err := cursor.Decode(params.Cursor)
+	if err != nil {
+		return core.UserPage{}, err
+	}
+
+	arg := queries.SearchUsersParams{
+		Pattern: "%" + escapeLike(params.Query) + "%",
+		AfterID: afterID,
+		Limit:   cursor.Limit(params.Limit) + 1,
+	}
+
+	users, err := s.q.SearchUsers(ctx, arg)
+	if err != nil {
+		return core.UserPage{}, fmt.Errorf("user: search users: %w", err)
+	}
+
+	return toPage(users, int(arg.Limit-1)), nil
+}
+
+// toPage converts users fetched with one extra row than limit into [core.UserPage].
+func toPage(users []queries.User, limit int) core.UserPage {
+	var page core.UserPage
+
+	if len(users) > limit {
+		users = users[:limit]
+		page.NextCursor = cursor.Encode(users[limit-1].ID)
+	}
+
+	page.Users = make([]core.User, 0, len(users))
+	for _, u := range users {
+		page.Users = append(page.Users, toUser(u))
+	}
+
+	return page
+}
+
+func toUser(u queries.User) core.User {
+	return core.User{
+		ID:              u.ID,
+		Email:           u.Email,
+		EmailVerifiedAt: u.EmailVerifiedAt,
+		Username:        u.Username,
+		Status:          core.UserStatus(u.Status),
+		CreatedAt:       u.CreatedAt,
+		UpdatedAt:       u.UpdatedAt,
+		DeletedAt:       u.DeletedAt,
+	}
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike escapes LIKE pattern meta characters in s.
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+func mapError(err error) error {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return core.ErrNotFound
+	case db.IsUniqueViolation(err):
+		return core.ErrAlreadyExists
+	default:
+		return err
+	}
+}
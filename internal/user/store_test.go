@@ -0,0 +1,237 @@
+package user
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/cursor"
+	"github.com/gophero/guardian/internal/db/dbtest"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	s := NewStore(dbtest.Pool(t))
+
+	// newSuffix returns a suffix no other test uses, as tests may share the database.
+	newSuffix := func() string {
+		return uuid.NewString()[:8]
+	}
+
+	create := func(t *testing.T, email, username string) core.User {
+		t.Helper()
+
+		u, err := s.Create(ctx, core.CreateUserParams{Email: email, Username: username})
+		require.NoError(t, err)
+		require.Equal(t, core.UserStatusActive, u.Status)
+
+		return u
+	}
+
+	search := func(t *testing.T, query string) []uuid.UUID {
+		t.Helper()
+
+		page, err := s.Search(ctx, core.SearchUsersParams{Query: query})
+		require.NoError(t, err)
+		require.Empty(t, page.NextCursor)
+
+		ids := make([]uuid.UUID, 0, len(page.Users))
+		for _, u := range page.Users {
+			ids = append(ids, u.ID)
+		}
+
+		return ids
+	}
+
+	t.Run("searches literal substrings", func(t *testing.T) {
+		suffix := newSuffix()
+
+		underscore := create(t, "user-"+suffix+"_x@example.com", "user-"+suffix+"-1")
+		letter := create(t, "user-"+suffix+"ax@example.com", "user-"+suffix+"-2")
+		percent := create(t, "user-"+suffix+"%y@example.com", "user-"+suffix+"-3")
+		other := create(t, "user-"+suffix+"zzy@example.com", "user-"+suffix+"-4")
+
+		// Unescaped, _ would match any character and % any string.
+		require.Equal(t, []uuid.UUID{underscore.ID}, search(t, suffix+"_x"))
+		require.Equal(t, []uuid.UUID{percent.ID}, search(t, suffix+"%y"))
+
+		require.Equal(t, []uuid.UUID{letter.ID}, search(t, strings.ToUpper(suffix+"AX")))
+		require.ElementsMatch(t, []uuid.UUID{underscore.ID, letter.ID, percent.ID, other.ID}, search(t, "user-"+suffix))
+
+		// Usernames are matched too.
+		require.Equal(t, []uuid.UUID{other.ID}, search(t, suffix+"-4"))
+
+		require.NoError(t, s.Delete(ctx, other.ID))
+		require.Empty(t, search(t, suffix+"-4"))
+	})
+
+	t.Run("pages through users", func(t *testing.T) {
+		suffix := newSuffix()
+
+		users := make([]core.User, 5)
+		for i := range users {
+			users[i] = create(t, "page-"+suffix+"-"+string(rune('a'+i))+"@example.com", "page-"+suffix+"-"+string(rune('a'+i)))
+		}
+		slices.SortFunc(users, func(a, b core.User) int { return strings.Compare(a.ID.String(), b.ID.String()) })
+
+		require.NoError(t, s.Delete(ctx, users[2].ID))
+
+		_, err := s.Update(ctx, users[3].ID, core.UpdateUserParams{Status: ptr(core.UserStatusSuspended)})
+		require.NoError(t, err)
+
+		// Other tests may create users between them, so the pages are only checked to hold the users in order.
+		list := func(t *testing.T, status core.UserStatus) []uuid.UUID {
+			t.Helper()
+
+			var ids []uuid.UUID
+
+			c := cursor.Encode(users[0].ID)
+			for {
+				page, err := s.List(ctx, core.ListUsersParams{Status: status, Cursor: c, Limit: 1})
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(page.Users), 1)
+
+				for _, u := range page.Users {
+					ids = append(ids, u.ID)
+				}
+
+				if slices.Contains(ids, users[4].ID) {
+					break
+				}
+
+				require.NotEmpty(t, page.NextCursor)
+				c = page.NextCursor
+			}
+
+			return slices.DeleteFunc(ids, func(id uuid.UUID) bool {
+				return !slices.ContainsFunc(users, func(u core.User) bool { return u.ID == id })
+			})
+		}
+
+		require.Equal(t, []uuid.UUID{users[1].ID, users[3].ID, users[4].ID}, list(t, ""))
+
+		_, err = s.Update(ctx, users[4].ID, core.UpdateUserParams{Status: ptr(core.UserStatusSuspended)})
+		require.NoError(t, err)
+
+		require.Equal(t, []uuid.UUID{users[3].ID, users[4].ID}, list(t, core.UserStatusSuspended))
+
+		// Searches page the same way.
+		page, err := s.Search(ctx, core.SearchUsersParams{Query: "page-" + suffix, Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, page.Users, 2)
+		require.NotEmpty(t, page.NextCursor)
+
+		page, err = s.Search(ctx, core.SearchUsersParams{Query: "page-" + suffix, Cursor: page.NextCursor, Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, page.Users, 2)
+		require.Empty(t, page.NextCursor)
+		require.Equal(t, users[4].ID, page.Users[1].ID)
+
+		_, err = s.List(ctx, core.ListUsersParams{Cursor: "not a cursor"})
+		require.ErrorIs(t, err, core.ErrInvalidArgument)
+
+		_, err = s.List(ctx, core.ListUsersParams{Status: "unknown"})
+		require.ErrorIs(t, err, core.ErrInvalidArgument)
+	})
+
+	t.Run("reuses emails and usernames of deleted users", func(t *testing.T) {
+		suffix := newSuffix()
+		email, username := "reuse-"+suffix+"@example.com", "reuse-"+suffix
+
+		deleted := create(t, email, username)
+
+		// Both are unique ignoring case.
+		_, err := s.Create(ctx, core.CreateUserParams{Email: strings.ToUpper(email), Username: username + "-2"})
+		require.ErrorIs(t, err, core.ErrAlreadyExists)
+
+		_, err = s.Create(ctx, core.CreateUserParams{Email: "2-" + email, Username: strings.ToUpper(username)})
+		require.ErrorIs(t, err, core.ErrAlreadyExists)
+
+		require.NoError(t, s.Delete(ctx, deleted.ID))
+
+		u := create(t, email, username)
+		require.NotEqual(t, deleted.ID, u.ID)
+
+		got, err := s.GetByEmail(ctx, email)
+		require.NoError(t, err)
+		require.Equal(t, u.ID, got.ID)
+
+		got, err = s.GetByUsername(ctx, username)
+		require.NoError(t, err)
+		require.Equal(t, u.ID, got.ID)
+
+		// Only one undeleted user may hold them.
+		_, err = s.Create(ctx, core.CreateUserParams{Email: email, Username: username + "-2"})
+		require.ErrorIs(t, err, core.ErrAlreadyExists)
+	})
+
+	t.Run("updates users", func(t *testing.T) {
+		suffix := newSuffix()
+
+		u := create(t, "update-"+suffix+"@example.com", "update-"+suffix)
+		taken := create(t, "taken-"+suffix+"@example.com", "taken-"+suffix)
+
+		verified, err := s.MarkEmailVerified(ctx, u.ID, u.Email)
+		require.NoError(t, err)
+		require.NotNil(t, verified.EmailVerifiedAt)
+
+		// Fields which are not given are kept, including the verification of an unchanged email.
+		updated, err := s.Update(ctx, u.ID, core.UpdateUserParams{Username: ptr("renamed-" + suffix)})
+		require.NoError(t, err)
+		require.Equal(t, "renamed-"+suffix, updated.Username)
+		require.Equal(t, u.Email, updated.Email)
+		require.Equal(t, core.UserStatusActive, updated.Status)
+		require.NotNil(t, updated.EmailVerifiedAt)
+
+		updated, err = s.Update(ctx, u.ID, core.UpdateUserParams{Email: ptr(u.Email)})
+		require.NoError(t, err)
+		require.NotNil(t, updated.EmailVerifiedAt)
+
+		updated, err = s.Update(ctx, u.ID, core.UpdateUserParams{Email: ptr("changed-" + suffix + "@example.com")})
+		require.NoError(t, err)
+		require.Equal(t, "changed-"+suffix+"@example.com", updated.Email)
+		require.Nil(t, updated.EmailVerifiedAt)
+
+		_, err = s.Update(ctx, u.ID, core.UpdateUserParams{Email: ptr(taken.Email)})
+		require.ErrorIs(t, err, core.ErrAlreadyExists)
+
+		_, err = s.Update(ctx, u.ID, core.UpdateUserParams{Username: ptr("no")})
+		require.ErrorIs(t, err, core.ErrInvalidArgument)
+
+		_, err = s.Update(ctx, uuid.New(), core.UpdateUserParams{Username: ptr("missing-" + suffix)})
+		require.ErrorIs(t, err, core.ErrNotFound)
+	})
+
+	t.Run("deletes users", func(t *testing.T) {
+		suffix := newSuffix()
+
+		u := create(t, "delete-"+suffix+"@example.com", "delete-"+suffix)
+
+		require.NoError(t, s.Delete(ctx, u.ID))
+		require.ErrorIs(t, s.Delete(ctx, u.ID), core.ErrNotFound)
+
+		_, err := s.Get(ctx, u.ID)
+		require.ErrorIs(t, err, core.ErrNotFound)
+
+		_, err = s.GetByEmail(ctx, u.Email)
+		require.ErrorIs(t, err, core.ErrNotFound)
+
+		_, err = s.GetByUsername(ctx, u.Username)
+		require.ErrorIs(t, err, core.ErrNotFound)
+
+		_, err = s.Update(ctx, u.ID, core.UpdateUserParams{Username: ptr("undeleted-" + suffix)})
+		require.ErrorIs(t, err, core.ErrNotFound)
+
+		_, err = s.MarkEmailVerified(ctx, u.ID, u.Email)
+		require.ErrorIs(t, err, core.ErrNotFound)
+	})
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
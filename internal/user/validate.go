@@ -0,0 +1,115 @@
+package user
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gophero/guardian/core"
+)
+
+var usernameRe = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{2,31}$`)
+
+func validateEmail(email string) error {
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return fmt.Errorf("user: invalid email `%s`: %w", email, core.ErrInvalidArgument)
+	}
+	return nil
+}
+
+func validateUsername(username string) error {
+	if !usernameRe.MatchString(username) {
+		return fmt.Errorf("user: invalid username `%s`: %w", username, core.ErrInvalidArgument)
+	}
+	return nil
+}
+
+func validateStatus(status core.UserStatus) error {
+	switch status {
+	case core.UserStatusActive, core.UserStatusSuspended, core.UserStatusDisabled:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid status `%s`: %w", status, core.ErrInvalidArgument)
+	}
+}
+
+var (
+	// E.164 numbers have at most 15 digits.
+	phoneNumberRe = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+	birthdateRe   = regexp.MustCompile(`^[0-9]{4}(-[0-9]{2}-[0-9]{2})?$`)
+	zoneinfoRe    = regexp.MustCompile(`^[A-Za-z_]+(/[A-Za-z0-9_+-]+)*$`)
+	localeRe      = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]{2,8})*$`)
+)
+
+// maxProfileFieldLength limits the length of each profile field in bytes.
+const maxProfileFieldLength = 512
+
+func validateProfile(p core.UserProfile) error {
+	for name, value := range map[string]string{
+		"name":           p.Name,
+		"given_name":     p.GivenName,
+		"family_name":    p.FamilyName,
+		"nickname":       p.Nickname,
+		"picture":        p.Picture,
+		"website":        p.Website,
+		"street_address": p.Address.StreetAddress,
+		"locality":       p.Address.Locality,
+		"region":         p.Address.Region,
+		"postal_code":    p.Address.PostalCode,
+		"country":        p.Address.Country,
+	} {
+		if len(value) > maxProfileFieldLength {
+			return fmt.Errorf("user: %s exceeds %d bytes: %w", name, maxProfileFieldLength, core.ErrInvalidArgument)
+		}
+	}
+
+	for name, value := range map[string]string{"picture": p.Picture, "website": p.Website} {
+		if value == "" {
+			continue
+		}
+
+		if u, err := url.Parse(value); err != nil || u.Scheme != "https" && u.Scheme != "http" || u.Host == "" {
+			return fmt.Errorf("user: invalid %s `%s`: %w", name, value, core.ErrInvalidArgument)
+		}
+	}
+
+	if p.Birthdate != "" && !validBirthdate(p.Birthdate) {
+		return fmt.Errorf("user: invalid birthdate `%s`: %w", p.Birthdate, core.ErrInvalidArgument)
+	}
+
+	if p.Zoneinfo != "" && !zoneinfoRe.MatchString(p.Zoneinfo) {
+		return fmt.Errorf("user: invalid zoneinfo `%s`: %w", p.Zoneinfo, core.ErrInvalidArgument)
+	}
+
+	if p.Locale != "" && !localeRe.MatchString(p.Locale) {
+		return fmt.Errorf("user: invalid locale `%s`: %w", p.Locale, core.ErrInvalidArgument)
+	}
+
+	if p.PhoneNumber != "" && !phoneNumberRe.MatchString(p.PhoneNumber) {
+		return fmt.Errorf("user: invalid phone number `%s`: %w", p.PhoneNumber, core.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+func validBirthdate(birthdate string) bool {
+	if !birthdateRe.MatchString(birthdate) || birthdate == "0000" {
+		return false
+	}
+
+	if len(birthdate) == 4 {
+		return true
+	}
+
+	// 0000 withholds the year, so the month and day are validated in a leap year.
+	if date, ok := strings.CutPrefix(birthdate, "0000"); ok {
+		birthdate = "2000" + date
+	}
+
+	_, err := time.Parse(time.DateOnly, birthdate)
+	return err == nil
+}
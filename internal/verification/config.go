@@ -0,0 +1,30 @@
+package verification
+
+import (
+	"errors"
+	"net/url"
+	"time"
+)
+
+type Config struct {
+	URL            string        `help:"URL of the page which completes email verifications. The token is added as token query parameter." name:"url" env:"URL"`
+	TTL            time.Duration `help:"Duration in which an email verification has to be completed." name:"ttl" env:"TTL" default:"24h"`
+	ResendInterval time.Duration `help:"Minimum duration between two verification emails of the same kind to a user." name:"resend_interval" env:"RESEND_INTERVAL" default:"1m"`
+}
+
+func (c Config) validate() error {
+	u, err := url.Parse(c.URL)
+	if err != nil || !u.IsAbs() {
+		return errors.New("verification: URL must be an absolute URL")
+	}
+
+	if c.TTL <= 0 {
+		return errors.New("verification: TTL cannot be zero or negative")
+	}
+
+	if c.ResendInterval < 0 {
+		return errors.New("verification: ResendInterval cannot be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+// Package verification verifies that users control email addresses with tokens sent to them.
+package verification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db"
+	"github.com/gophero/guardian/internal/db/queries"
+	"github.com/gophero/guardian/internal/secret"
+)
+
+// Store is a postgres backed [core.EmailVerificationStore].
+type Store struct {
+	config Config
+	link   *url.URL
+	pool   *pgxpool.Pool
+	q      *queries.Queries
+	now    func() time.Time
+}
+
+var _ core.EmailVerificationStore = (*Store)(nil)
+
+// NewStore constructs new [Store].
+func NewStore(pool *pgxpool.Pool, config Config) (*Store, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	link, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("verification: parse url: %w", err)
+	}
+
+	return &Store{config: config, link: link, pool: pool, q: queries.New(pool), now: time.Now}, nil
+}
+
+// Create implements [core.EmailVerificationStore].
+func (s *Store) Create(ctx context.Context, userID uuid.UUID, email string, purpose core.EmailVerificationPurpose) (core.EmailVerification, string, error) {
+	token := secret.New(secret.DefaultSize)
+	now := s.now()
+
+	var v queries.EmailVerification
+
+	err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		q := s.q.WithTx(tx)
+
+		n, err := q.CountEmailVerificationsSince(ctx, queries.CountEmailVerificationsSinceParams{
+			UserID:  userID,
+			Purpose: queries.EmailVerificationPurpose(purpose),
+			Since:   now.Add(-s.config.ResendInterval),
+		})
+		if err != nil {
+			return fmt.Errorf("verification: count email verifications: %w", err)
+		}
+
+		if n > 0 {
+			return core.ErrRateLimited
+		}
+
+		if _, err := q.DeletePendingEmailVerifications(ctx, queries.DeletePendingEmailVerificationsParams{
+			UserID:  userID,
+			Purpose: queries.EmailVerificationPurpose(purpose),
+		}); err != nil {
+			return fmt.Errorf("verification: delete pending email verifications: %w", err)
+		}
+
+		v, err = q.CreateEmailVerification(ctx, queries.CreateEmailVerificationParams{
+			UserID:    userID,
+			Purpose:   queries.EmailVerificationPurpose(purpose),
+			Email:     email,
+			TokenHash: secret.Hash(token),
+			ExpiresAt: now.Add(s.config.TTL),
+		})
+		if err != nil {
+			if db.IsForeignKeyViolation(err) {
+				return fmt.Errorf("verification: create email verification: %w", core.ErrNotFound)
+			}
+			return fmt.Errorf("verification: create email verification: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return core.EmailVerification{}, "", err
+	}
+
+	link := *s.link
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	return toVerification(v), link.String(), nil
+}
+
+// Complete implements [core.EmailVerificationStore].
+func (s *Store) Complete(ctx context.Context, token string) (core.EmailVerification, error) {
+	v, err := s.q.CompleteEmailVerification(ctx, secret.Hash(token))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return core.EmailVerification{}, core.ErrInvalidToken
+	}
+
+	if err != nil {
+		return core.EmailVerification{}, fmt.Errorf("verification: complete email verification: %w", err)
+	}
+
+	return toVerification(v), nil
+}
+
+// DeleteExpired deletes expired verifications.
+func (s *Store) DeleteExpired(ctx context.Context) (int64, error) {
+	n, err := s.q.DeleteExpiredEmailVerifications(ctx, s.now())
+	if err != nil {
+		return 0, fmt.Errorf("verification: delete expired email verifications: %w", err)
+	}
+
+	return n, nil
+}
+
+func toVerification(v queries.EmailVerification) core.EmailVerification {
+	return core.EmailVerification{
+		ID:        v.ID,
+		UserID:    v.UserID,
+		Purpose:   core.EmailVerificationPurpose(v.Purpose),
+		Email:     v.Email,
+		CreatedAt: v.CreatedAt,
+		ExpiresAt: v.ExpiresAt,
+	}
+}
@@ -0,0 +1,86 @@
+package verification
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/db/dbtest"
+	"github.com/gophero/guardian/internal/db/queries"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	pool := dbtest.Pool(t)
+	q := queries.New(pool)
+
+	s, err := NewStore(pool, Config{URL: "https://example.com/verify-email", TTL: time.Hour, ResendInterval: time.Minute})
+	require.NoError(t, err)
+
+	setNow := func(t time.Time) { s.now = func() time.Time { return t } }
+
+	newUser := func(t *testing.T) (uuid.UUID, string) {
+		t.Helper()
+
+		suffix := uuid.NewString()[:8]
+
+		user, err := q.CreateUser(ctx, queries.CreateUserParams{
+			Email:    "verification-" + suffix + "@example.com",
+			Username: "verification-" + suffix,
+			Status:   queries.UserStatusActive,
+		})
+		require.NoError(t, err)
+
+		return user.ID, user.Email
+	}
+
+	create := func(t *testing.T, userID uuid.UUID, email string, purpose core.EmailVerificationPurpose) string {
+		t.Helper()
+
+		_, link, err := s.Create(ctx, userID, email, purpose)
+		require.NoError(t, err)
+
+		u, err := url.Parse(link)
+		require.NoError(t, err)
+
+		return u.Query().Get("token")
+	}
+
+	t.Run("throttles verifications of the same purpose", func(t *testing.T) {
+		userID, email := newUser(t)
+		now := time.Now()
+		setNow(now)
+
+		first := create(t, userID, email, core.EmailVerificationVerify)
+
+		_, _, err := s.Create(ctx, userID, email, core.EmailVerificationVerify)
+		require.ErrorIs(t, err, core.ErrRateLimited)
+
+		// Other purposes and users are throttled separately.
+		create(t, userID, "new-"+email, core.EmailVerificationChange)
+
+		otherID, otherEmail := newUser(t)
+		create(t, otherID, otherEmail, core.EmailVerificationVerify)
+
+		// Once the resend interval passed, a new verification replaces the pending one.
+		setNow(now.Add(2 * time.Minute))
+		second := create(t, userID, email, core.EmailVerificationVerify)
+
+		_, err = s.Complete(ctx, first)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+
+		v, err := s.Complete(ctx, second)
+		require.NoError(t, err)
+		require.Equal(t, userID, v.UserID)
+		require.Equal(t, email, v.Email)
+
+		// Verifications are single-use.
+		_, err = s.Complete(ctx, second)
+		require.ErrorIs(t, err, core.ErrInvalidToken)
+	})
+}
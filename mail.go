@@ -0,0 +1,33 @@
+package guardian
+
+import (
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/mail"
+)
+
+// MailConfig configures the SMTP server used by [NewSMTPMailer].
+type MailConfig = mail.Config
+
+// Mailer is a [core.Mailer] which holds connections until it is closed.
+type Mailer interface {
+	core.Mailer
+
+	// Close closes idle connections.
+	Close()
+}
+
+// NewSMTPMailer creates a [Mailer] which delivers emails through an SMTP server and reuses its connections.
+func NewSMTPMailer(config MailConfig) (Mailer, error) {
+	return mail.NewSMTPMailer(config)
+}
+
+// MailQueueConfig configures the [MailQueue] created by [NewMailQueue].
+type MailQueueConfig = mail.QueueConfig
+
+// MailQueue sends emails in the background after requests were answered. It has to be run for emails to be sent.
+type MailQueue = mail.Queue
+
+// NewMailQueue creates a [MailQueue] which sends emails with a bounded number of workers and drains on shutdown.
+func NewMailQueue(config MailQueueConfig) (*MailQueue, error) {
+	return mail.NewQueue(config)
+}
@@ -0,0 +1,45 @@
+package guardian
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/mfa"
+)
+
+// MFAConfig configures the stores created by [NewTOTPStore], [NewRecoveryCodeStore] and [NewMFAChallengeStore].
+type MFAConfig = mfa.Config
+
+// TOTPStore is a [core.TOTPStore] which can delete enrollments that were not confirmed in time.
+type TOTPStore interface {
+	core.TOTPStore
+
+	// DeleteExpired deletes unconfirmed enrollments older than the configured enrollment TTL and returns the number of
+	// deleted enrollments.
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// MFAChallengeStore is a [core.MFAChallengeStore] which can delete expired challenges.
+type MFAChallengeStore interface {
+	core.MFAChallengeStore
+
+	// DeleteExpired deletes expired challenges and returns the number of deleted challenges.
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// NewTOTPStore creates a postgres backed [TOTPStore] which stores secrets encrypted with cipher.
+func NewTOTPStore(pool *pgxpool.Pool, config MFAConfig, cipher *Cipher) (TOTPStore, error) {
+	return mfa.NewTOTPStore(pool, config, cipher)
+}
+
+// NewRecoveryCodeStore creates a postgres backed [core.RecoveryCodeStore].
+func NewRecoveryCodeStore(pool *pgxpool.Pool, config MFAConfig) (core.RecoveryCodeStore, error) {
+	return mfa.NewRecoveryCodeStore(pool, config)
+}
+
+// NewMFAChallengeStore creates a postgres backed [MFAChallengeStore].
+func NewMFAChallengeStore(pool *pgxpool.Pool, config MFAConfig) (MFAChallengeStore, error) {
+	return mfa.NewChallengeStore(pool, config)
+}
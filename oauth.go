@@ -0,0 +1,92 @@
+package guardian
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/oauth"
+)
+
+// OAuthConfig configures the authorization server created by [NewOAuthAuthorizationStore],
+// [NewOAuthDeviceAuthorizationStore] and [NewOAuthHandler].
+type OAuthConfig = oauth.Config
+
+// OAuthAuthorizationStore is a [core.OAuthAuthorizationStore] which can delete expired authorizations.
+type OAuthAuthorizationStore interface {
+	core.OAuthAuthorizationStore
+
+	// DeleteExpired deletes expired authorization requests and codes and returns the number of deleted rows.
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// OAuthDeviceAuthorizationStore is a [core.OAuthDeviceAuthorizationStore] which can delete expired authorizations.
+type OAuthDeviceAuthorizationStore interface {
+	core.OAuthDeviceAuthorizationStore
+
+	// DeleteExpired deletes expired device authorizations and returns the number of deleted rows.
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// OAuthRevocationStore is a [core.AccessTokenRevocationStore] which can delete revoked tokens once they expired.
+type OAuthRevocationStore interface {
+	core.AccessTokenRevocationStore
+
+	// DeleteExpired deletes revoked tokens which expired and returns the number of deleted rows.
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// OAuthHandler serves the authorization and token endpoints of the OAuth 2.1 authorization server and exports
+// prometheus metrics about issued tokens and errors.
+type OAuthHandler interface {
+	http.Handler
+	prometheus.Collector
+}
+
+// NewOAuthClientStore creates a postgres backed [core.OAuthClientStore].
+func NewOAuthClientStore(pool *pgxpool.Pool) core.OAuthClientStore {
+	return oauth.NewClientStore(pool)
+}
+
+// NewOAuthAuthorizationStore creates a postgres backed [OAuthAuthorizationStore] which revokes the refresh tokens issued
+// for a code when the code is redeemed again.
+func NewOAuthAuthorizationStore(pool *pgxpool.Pool, config OAuthConfig, refreshTokens core.RefreshTokenStore) (OAuthAuthorizationStore, error) {
+	return oauth.NewAuthorizationStore(pool, config, refreshTokens)
+}
+
+// NewOAuthDeviceAuthorizationStore creates a postgres backed [OAuthDeviceAuthorizationStore].
+func NewOAuthDeviceAuthorizationStore(pool *pgxpool.Pool, config OAuthConfig) (OAuthDeviceAuthorizationStore, error) {
+	return oauth.NewDeviceStore(pool, config)
+}
+
+// NewOAuthRevocationStore creates a postgres backed [OAuthRevocationStore].
+func NewOAuthRevocationStore(pool *pgxpool.Pool) OAuthRevocationStore {
+	return oauth.NewRevocationStore(pool)
+}
+
+// NewOAuthHandler creates an [OAuthHandler] which sends users to the configured login URL to consent to
+// authorization requests. The login page answers them with the OAuthService, see [NewOAuthServiceHandler]. It also
+// serves the OpenID Connect discovery and userinfo endpoints, so it has to be served at the issuer of idTokens. Service
+// accounts obtain tokens with the client credentials grant. Devices obtain tokens with the device authorization grant
+// once users entered their user code on the configured verification page, which answers them with the OAuthService
+// too. Resource servers introspect tokens and clients revoke them at the endpoints of RFC 7662 and RFC 7009, revoked
+// access tokens are recorded in revokedTokens.
+func NewOAuthHandler(
+	config OAuthConfig,
+	clients core.OAuthClientStore,
+	serviceAccounts core.ServiceAccountStore,
+	authorizations core.OAuthAuthorizationStore,
+	devices core.OAuthDeviceAuthorizationStore,
+	users core.UserStore,
+	profiles core.UserProfileStore,
+	sessions core.SessionStore,
+	refreshTokens core.RefreshTokenStore,
+	revokedTokens core.AccessTokenRevocationStore,
+	accessTokens core.AccessTokenIssuer,
+	idTokens core.IDTokenIssuer,
+) (OAuthHandler, error) {
+	return oauth.NewHandler(config, clients, serviceAccounts, authorizations, devices, users, profiles, sessions, refreshTokens, revokedTokens, accessTokens, idTokens)
+}
@@ -0,0 +1,26 @@
+package guardian
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/organization"
+)
+
+// OrganizationConfig configures the invitation links of [NewOrganizationStore].
+type OrganizationConfig = organization.Config
+
+// OrganizationStore is a [core.OrganizationStore] which can delete expired invitations.
+type OrganizationStore interface {
+	core.OrganizationStore
+
+	// DeleteExpired deletes expired invitations and returns the number of deleted invitations.
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// NewOrganizationStore creates a postgres backed [OrganizationStore].
+func NewOrganizationStore(pool *pgxpool.Pool, config OrganizationConfig) (OrganizationStore, error) {
+	return organization.NewStore(pool, config)
+}
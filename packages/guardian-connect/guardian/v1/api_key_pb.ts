@@ -0,0 +1,467 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts"
+// @generated from file guardian/v1/api_key.proto (package guardian.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Duration, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_duration, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file guardian/v1/api_key.proto.
+ */
+export const file_guardian_v1_api_key: GenFile = /*@__PURE__*/
+  fileDesc("ChlndWFyZGlhbi92MS9hcGlfa2V5LnByb3RvEgtndWFyZGlhbi52MSKBAwoGQVBJS2V5EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSDgoGcHJlZml4GAMgASgJEjAKCm93bmVyX3R5cGUYBCABKA4yHC5ndWFyZGlhbi52MS5BUElLZXlPd25lclR5cGUSEAoIb3duZXJfaWQYBSABKAkSDgoGc2NvcGVzGAYgAygJEi4KCmNyZWF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmV4cGlyZXNfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKDGxhc3RfdXNlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKcm90YXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNwoTcHJldmlvdXNfZXhwaXJlc19hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAipwEKE0NyZWF0ZUFQSUtleVJlcXVlc3QSDAoEbmFtZRgBIAEoCRIwCgpvd25lcl90eXBlGAIgASgOMhwuZ3VhcmRpYW4udjEuQVBJS2V5T3duZXJUeXBlEhAKCG93bmVyX2lkGAMgASgJEg4KBnNjb3BlcxgEIAMoCRIuCgpleHBpcmVzX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJLChRDcmVhdGVBUElLZXlSZXNwb25zZRIkCgdhcGlfa2V5GAEgASgLMhMuZ3VhcmRpYW4udjEuQVBJS2V5Eg0KBXRva2VuGAIgASgJIh4KEEdldEFQSUtleVJlcXVlc3QSCgoCaWQYASABKAkiOQoRR2V0QVBJS2V5UmVzcG9uc2USJAoHYXBpX2tleRgBIAEoCzITLmd1YXJkaWFuLnYxLkFQSUtleSJYChJMaXN0QVBJS2V5c1JlcXVlc3QSMAoKb3duZXJfdHlwZRgBIAEoDjIcLmd1YXJkaWFuLnYxLkFQSUtleU93bmVyVHlwZRIQCghvd25lcl9pZBgCIAEoCSI8ChNMaXN0QVBJS2V5c1Jlc3BvbnNlEiUKCGFwaV9rZXlzGAEgAygLMhMuZ3VhcmRpYW4udjEuQVBJS2V5Ik0KE1JvdGF0ZUFQSUtleVJlcXVlc3QSCgoCaWQYASABKAkSKgoHb3ZlcmxhcBgCIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbiJLChRSb3RhdGVBUElLZXlSZXNwb25zZRIkCgdhcGlfa2V5GAEgASgLMhMuZ3VhcmRpYW4udjEuQVBJS2V5Eg0KBXRva2VuGAIgASgJIiEKE1Jldm9rZUFQSUtleVJlcXVlc3QSCgoCaWQYASABKAkiFgoUUmV2b2tlQVBJS2V5UmVzcG9uc2UiNAoTVmVyaWZ5QVBJS2V5UmVxdWVzdBINCgV0b2tlbhgBIAEoCRIOCgZzY29wZXMYAiADKAkiPAoUVmVyaWZ5QVBJS2V5UmVzcG9uc2USJAoHYXBpX2tleRgBIAEoCzITLmd1YXJkaWFuLnYxLkFQSUtleSqfAQoPQVBJS2V5T3duZXJUeXBlEiIKHkFQSV9LRVlfT1dORVJfVFlQRV9VTlNQRUNJRklFRBAAEhsKF0FQSV9LRVlfT1dORVJfVFlQRV9VU0VSEAESIwofQVBJX0tFWV9PV05FUl9UWVBFX09SR0FOSVpBVElPThACEiYKIkFQSV9LRVlfT1dORVJfVFlQRV9TRVJWSUNFX0FDQ09VTlQQAzKBBAoNQVBJS2V5U2VydmljZRJTCgxDcmVhdGVBUElLZXkSIC5ndWFyZGlhbi52MS5DcmVhdGVBUElLZXlSZXF1ZXN0GiEuZ3VhcmRpYW4udjEuQ3JlYXRlQVBJS2V5UmVzcG9uc2USSgoJR2V0QVBJS2V5Eh0uZ3VhcmRpYW4udjEuR2V0QVBJS2V5UmVxdWVzdBoeLmd1YXJkaWFuLnYxLkdldEFQSUtleVJlc3BvbnNlElAKC0xpc3RBUElLZXlzEh8uZ3VhcmRpYW4udjEuTGlzdEFQSUtleXNSZXF1ZXN0GiAuZ3VhcmRpYW4udjEuTGlzdEFQSUtleXNSZXNwb25zZRJTCgxSb3RhdGVBUElLZXkSIC5ndWFyZGlhbi52MS5Sb3RhdGVBUElLZXlSZXF1ZXN0GiEuZ3VhcmRpYW4udjEuUm90YXRlQVBJS2V5UmVzcG9uc2USUwoMUmV2b2tlQVBJS2V5EiAuZ3VhcmRpYW4udjEuUmV2b2tlQVBJS2V5UmVxdWVzdBohLmd1YXJkaWFuLnYxLlJldm9rZUFQSUtleVJlc3BvbnNlElMKDFZlcmlmeUFQSUtleRIgLmd1YXJkaWFuLnYxLlZlcmlmeUFQSUtleVJlcXVlc3QaIS5ndWFyZGlhbi52MS5WZXJpZnlBUElLZXlSZXNwb25zZUKrAQoPY29tLmd1YXJkaWFuLnYxQgxBcGlfa2V5UHJvdG9QAVo9Z2l0aHViLmNvbS9nb3BoZXJvL2d1YXJkaWFuL2NvcmUvcHJvdG8vZ3VhcmRpYW4vdjE7Z3VhcmRpYW52MaICA0dWWKoCC0d1YXJkaWFuLlYxygILR3VhcmRpYW5cVjHiAhdHdWFyZGlhblxWMVxHUEJNZXRhZGF0YeoCDEd1YXJkaWFuOjpWMWIGcHJvdG8z", [file_google_protobuf_duration, file_google_protobuf_timestamp]);
+
+/**
+ * APIKey is a long-lived credential presented as `gdn_<prefix>_<secret>`.
+ *
+ * @generated from message guardian.v1.APIKey
+ */
+export type APIKey = Message<"guardian.v1.APIKey"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * Identifies the key, including its `gdn_` part. It may be shown to users.
+   *
+   * @generated from field: string prefix = 3;
+   */
+  prefix: string;
+
+  /**
+   * @generated from field: guardian.v1.APIKeyOwnerType owner_type = 4;
+   */
+  ownerType: APIKeyOwnerType;
+
+  /**
+   * @generated from field: string owner_id = 5;
+   */
+  ownerId: string;
+
+  /**
+   * Permissions granted to the key, sorted by name.
+   *
+   * @generated from field: repeated string scopes = 6;
+   */
+  scopes: string[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * Unset if the key does not expire.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 8;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * Unset if the key was never used. Uses are recorded in batches, so it lags behind.
+   *
+   * @generated from field: google.protobuf.Timestamp last_used_at = 9;
+   */
+  lastUsedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp rotated_at = 10;
+   */
+  rotatedAt?: Timestamp;
+
+  /**
+   * The time until which the token replaced by the last rotation stays valid, unset if it is not valid anymore.
+   *
+   * @generated from field: google.protobuf.Timestamp previous_expires_at = 11;
+   */
+  previousExpiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message guardian.v1.APIKey.
+ * Use `create(APIKeySchema)` to create a new message.
+ */
+export const APIKeySchema: GenMessage<APIKey> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_api_key, 0);
+
+/**
+ * @generated from message guardian.v1.CreateAPIKeyRequest
+ */
+export type CreateAPIKeyRequest = Message<"guardian.v1.CreateAPIKeyRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Defaults to API_KEY_OWNER_TYPE_USER.
+   *
+   * @generated from field: guardian.v1.APIKeyOwnerType owner_type = 2;
+   */
+  ownerType: APIKeyOwnerType;
+
+  /**
+   * Defaults to the caller for keys of users.
+   *
+   * @generated from field: string owner_id = 3;
+   */
+  ownerId: string;
+
+  /**
+   * @generated from field: repeated string scopes = 4;
+   */
+  scopes: string[];
+
+  /**
+   * Unset if the key does not expire.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 5;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message guardian.v1.CreateAPIKeyRequest.
+ * Use `create(CreateAPIKeyRequestSchema)` to create a new message.
+ */
+export const CreateAPIKeyRequestSchema: GenMessage<CreateAPIKeyRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_api_key, 1);
+
+/**
+ * @generated from message guardian.v1.CreateAPIKeyResponse
+ */
+export type CreateAPIKeyResponse = Message<"guardian.v1.CreateAPIKeyResponse"> & {
+  /**
+   * @generated from field: guardian.v1.APIKey api_key = 1;
+   */
+  apiKey?: APIKey;
+
+  /**
+   * @generated from field: string token = 2;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message guardian.v1.CreateAPIKeyResponse.
+ * Use `create(CreateAPIKeyResponseSchema)` to create a new message.
+ */
+export const CreateAPIKeyResponseSchema: GenMessage<CreateAPIKeyResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_api_key, 2);
+
+/**
+ * @generated from message guardian.v1.GetAPIKeyRequest
+ */
+export type GetAPIKeyRequest = Message<"guardian.v1.GetAPIKeyRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message guardian.v1.GetAPIKeyRequest.
+ * Use `create(GetAPIKeyRequestSchema)` to create a new message.
+ */
+export const GetAPIKeyRequestSchema: GenMessage<GetAPIKeyRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_api_key, 3);
+
+/**
+ * @generated from message guardian.v1.GetAPIKeyResponse
+ */
+export type GetAPIKeyResponse = Message<"guardian.v1.GetAPIKeyResponse"> & {
+  /**
+   * @generated from field: guardian.v1.APIKey api_key = 1;
+   */
+  apiKey?: APIKey;
+};
+
+/**
+ * Describes the message guardian.v1.GetAPIKeyResponse.
+ * Use `create(GetAPIKeyResponseSchema)` to create a new message.
+ */
+export const GetAPIKeyResponseSchema: GenMessage<GetAPIKeyResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_api_key, 4);
+
+/**
+ * @generated from message guardian.v1.ListAPIKeysRequest
+ */
+export type ListAPIKeysRequest = Message<"guardian.v1.ListAPIKeysRequest"> & {
+  /**
+   * Defaults to API_KEY_OWNER_TYPE_USER.
+   *
+   * @generated from field: guardian.v1.APIKeyOwnerType owner_type = 1;
+   */
+  ownerType: APIKeyOwnerType;
+
+  /**
+   * Defaults to the caller for keys of users.
+   *
+   * @generated from field: string owner_id = 2;
+   */
+  ownerId: string;
+};
+
+/**
+ * Describes the message guardian.v1.ListAPIKeysRequest.
+ * Use `create(ListAPIKeysRequestSchema)` to create a new message.
+ */
+export const ListAPIKeysRequestSchema: GenMessage<ListAPIKeysRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_api_key, 5);
+
+/**
+ * @generated from message guardian.v1.ListAPIKeysResponse
+ */
+export type ListAPIKeysResponse = Message<"guardian.v1.ListAPIKeysResponse"> & {
+  /**
+   * @generated from field: repeated guardian.v1.APIKey api_keys = 1;
+   */
+  apiKeys: APIKey[];
+};
+
+/**
+ * Describes the message guardian.v1.ListAPIKeysResponse.
+ * Use `create(ListAPIKeysResponseSchema)` to create a new message.
+ */
+export const ListAPIKeysResponseSchema: GenMessage<ListAPIKeysResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_api_key, 6);
+
+/**
+ * @generated from message guardian.v1.RotateAPIKeyRequest
+ */
+export type RotateAPIKeyRequest = Message<"guardian.v1.RotateAPIKeyRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * Duration for which the previous token stays valid. Zero invalidates it immediately, unset uses the configured
+   * default.
+   *
+   * @generated from field: google.protobuf.Duration overlap = 2;
+   */
+  overlap?: Duration;
+};
+
+/**
+ * Describes the message guardian.v1.RotateAPIKeyRequest.
+ * Use `create(RotateAPIKeyRequestSchema)` to create a new message.
+ */
+export const RotateAPIKeyRequestSchema: GenMessage<RotateAPIKeyRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_api_key, 7);
+
+/**
+ * @generated from message guardian.v1.RotateAPIKeyResponse
+ */
+export type RotateAPIKeyResponse = Message<"guardian.v1.RotateAPIKeyResponse"> & {
+  /**
+   * @generated from field: guardian.v1.APIKey api_key = 1;
+   */
+  apiKey?: APIKey;
+
+  /**
+   * @generated from field: string token = 2;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message guardian.v1.RotateAPIKeyResponse.
+ * Use `create(RotateAPIKeyResponseSchema)` to create a new message.
+ */
+export const RotateAPIKeyResponseSchema: GenMessage<RotateAPIKeyResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_api_key, 8);
+
+/**
+ * @generated from message guardian.v1.RevokeAPIKeyRequest
+ */
+export type RevokeAPIKeyRequest = Message<"guardian.v1.RevokeAPIKeyRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message guardian.v1.RevokeAPIKeyRequest.
+ * Use `create(RevokeAPIKeyRequestSchema)` to create a new message.
+ */
+export const RevokeAPIKeyRequestSchema: GenMessage<RevokeAPIKeyRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_api_key, 9);
+
+/**
+ * @generated from message guardian.v1.RevokeAPIKeyResponse
+ */
+export type RevokeAPIKeyResponse = Message<"guardian.v1.RevokeAPIKeyResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.RevokeAPIKeyResponse.
+ * Use `create(RevokeAPIKeyResponseSchema)` to create a new message.
+ */
+export const RevokeAPIKeyResponseSchema: GenMessage<RevokeAPIKeyResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_api_key, 10);
+
+/**
+ * @generated from message guardian.v1.VerifyAPIKeyRequest
+ */
+export type VerifyAPIKeyRequest = Message<"guardian.v1.VerifyAPIKeyRequest"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * Scopes the key is required to have.
+   *
+   * @generated from field: repeated string scopes = 2;
+   */
+  scopes: string[];
+};
+
+/**
+ * Describes the message guardian.v1.VerifyAPIKeyRequest.
+ * Use `create(VerifyAPIKeyRequestSchema)` to create a new message.
+ */
+export const VerifyAPIKeyRequestSchema: GenMessage<VerifyAPIKeyRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_api_key, 11);
+
+/**
+ * @generated from message guardian.v1.VerifyAPIKeyResponse
+ */
+export type VerifyAPIKeyResponse = Message<"guardian.v1.VerifyAPIKeyResponse"> & {
+  /**
+   * @generated from field: guardian.v1.APIKey api_key = 1;
+   */
+  apiKey?: APIKey;
+};
+
+/**
+ * Describes the message guardian.v1.VerifyAPIKeyResponse.
+ * Use `create(VerifyAPIKeyResponseSchema)` to create a new message.
+ */
+export const VerifyAPIKeyResponseSchema: GenMessage<VerifyAPIKeyResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_api_key, 12);
+
+/**
+ * APIKeyOwnerType is the kind of principal an API key acts on behalf of.
+ *
+ * @generated from enum guardian.v1.APIKeyOwnerType
+ */
+export enum APIKeyOwnerType {
+  /**
+   * @generated from enum value: API_KEY_OWNER_TYPE_UNSPECIFIED = 0;
+   */
+  API_KEY_OWNER_TYPE_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: API_KEY_OWNER_TYPE_USER = 1;
+   */
+  API_KEY_OWNER_TYPE_USER = 1,
+
+  /**
+   * @generated from enum value: API_KEY_OWNER_TYPE_ORGANIZATION = 2;
+   */
+  API_KEY_OWNER_TYPE_ORGANIZATION = 2,
+
+  /**
+   * @generated from enum value: API_KEY_OWNER_TYPE_SERVICE_ACCOUNT = 3;
+   */
+  API_KEY_OWNER_TYPE_SERVICE_ACCOUNT = 3,
+}
+
+/**
+ * Describes the enum guardian.v1.APIKeyOwnerType.
+ */
+export const APIKeyOwnerTypeSchema: GenEnum<APIKeyOwnerType> = /*@__PURE__*/
+  enumDesc(file_guardian_v1_api_key, 0);
+
+/**
+ * APIKeyService manages API keys, which authenticate scripts and CI jobs on behalf of a user, an organization or a
+ * service account. Methods managing keys expect the access token in the `Authorization: Bearer` header.
+ *
+ * Users manage their own keys, organization admins and owners the keys of their organization and callers with the
+ * `guardian.api_keys.manage` permission the keys of service accounts. Methods taking a key id report keys the caller
+ * cannot manage as NOT_FOUND.
+ *
+ * @generated from service guardian.v1.APIKeyService
+ */
+export const APIKeyService: GenService<{
+  /**
+   * CreateAPIKey creates a key and returns its token, which cannot be retrieved later. The scopes of the key have to
+   * be permissions the caller has itself, in the organization for keys of organizations. Fails with
+   * PERMISSION_DENIED otherwise.
+   *
+   * @generated from rpc guardian.v1.APIKeyService.CreateAPIKey
+   */
+  createAPIKey: {
+    methodKind: "unary";
+    input: typeof CreateAPIKeyRequestSchema;
+    output: typeof CreateAPIKeyResponseSchema;
+  },
+  /**
+   * GetAPIKey returns a key by id.
+   *
+   * @generated from rpc guardian.v1.APIKeyService.GetAPIKey
+   */
+  getAPIKey: {
+    methodKind: "unary";
+    input: typeof GetAPIKeyRequestSchema;
+    output: typeof GetAPIKeyResponseSchema;
+  },
+  /**
+   * ListAPIKeys lists the keys of an owner ordered by id, including expired ones.
+   *
+   * @generated from rpc guardian.v1.APIKeyService.ListAPIKeys
+   */
+  listAPIKeys: {
+    methodKind: "unary";
+    input: typeof ListAPIKeysRequestSchema;
+    output: typeof ListAPIKeysResponseSchema;
+  },
+  /**
+   * RotateAPIKey replaces the secret of a key and returns its new token. The previous token stays valid for the
+   * overlap, so clients can switch without downtime.
+   *
+   * @generated from rpc guardian.v1.APIKeyService.RotateAPIKey
+   */
+  rotateAPIKey: {
+    methodKind: "unary";
+    input: typeof RotateAPIKeyRequestSchema;
+    output: typeof RotateAPIKeyResponseSchema;
+  },
+  /**
+   * RevokeAPIKey deletes a key, invalidating its tokens immediately.
+   *
+   * @generated from rpc guardian.v1.APIKeyService.RevokeAPIKey
+   */
+  revokeAPIKey: {
+    methodKind: "unary";
+    input: typeof RevokeAPIKeyRequestSchema;
+    output: typeof RevokeAPIKeyResponseSchema;
+  },
+  /**
+   * VerifyAPIKey returns the key of a token for services authenticating its bearer. It needs no access token, as the
+   * token is the credential. Fails with UNAUTHENTICATED if the token is invalid or expired and with
+   * PERMISSION_DENIED if the key lacks any of the required scopes.
+   *
+   * @generated from rpc guardian.v1.APIKeyService.VerifyAPIKey
+   */
+  verifyAPIKey: {
+    methodKind: "unary";
+    input: typeof VerifyAPIKeyRequestSchema;
+    output: typeof VerifyAPIKeyResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_guardian_v1_api_key, 0);
+
@@ -0,0 +1,1102 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts"
+// @generated from file guardian/v1/auth.proto (package guardian.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { User } from "./user_pb";
+import { file_guardian_v1_user } from "./user_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file guardian/v1/auth.proto.
+ */
+export const file_guardian_v1_auth: GenFile = /*@__PURE__*/
+  fileDesc("ChZndWFyZGlhbi92MS9hdXRoLnByb3RvEgtndWFyZGlhbi52MSKGAgoHU2Vzc2lvbhIKCgJpZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEhIKCnVzZXJfYWdlbnQYAyABKAkSEgoKaXBfYWRkcmVzcxgEIAEoCRIOCgZkZXZpY2UYBSABKAkSLgoKY3JlYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMAoMbGFzdF9zZWVuX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpleHBpcmVzX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIUCgxhdXRoX21ldGhvZHMYCSADKAkisAEKBlRva2VucxIUCgxhY2Nlc3NfdG9rZW4YASABKAkSOwoXYWNjZXNzX3Rva2VuX2V4cGlyZXNfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhUKDXJlZnJlc2hfdG9rZW4YAyABKAkSPAoYcmVmcmVzaF90b2tlbl9leHBpcmVzX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJ2CgxNRkFDaGFsbGVuZ2USDQoFdG9rZW4YASABKAkSJwoHZmFjdG9ycxgCIAMoDjIWLmd1YXJkaWFuLnYxLk1GQUZhY3RvchIuCgpleHBpcmVzX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKBAQoTUGFzc3dvcmRQb2xpY3lFcnJvchI+Cgp2aW9sYXRpb25zGAEgAygLMiouZ3VhcmRpYW4udjEuUGFzc3dvcmRQb2xpY3lFcnJvci5WaW9sYXRpb24aKgoJVmlvbGF0aW9uEgwKBGNvZGUYASABKAkSDwoHbWVzc2FnZRgCIAEoCSJSCg1TaWduVXBSZXF1ZXN0Eg0KBWVtYWlsGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEhAKCHBhc3N3b3JkGAMgASgJEg4KBmRldmljZRgEIAEoCSJ9Cg5TaWduVXBSZXNwb25zZRIfCgR1c2VyGAEgASgLMhEuZ3VhcmRpYW4udjEuVXNlchIlCgdzZXNzaW9uGAIgASgLMhQuZ3VhcmRpYW4udjEuU2Vzc2lvbhIjCgZ0b2tlbnMYAyABKAsyEy5ndWFyZGlhbi52MS5Ub2tlbnMiRQoNU2lnbkluUmVxdWVzdBISCgppZGVudGlmaWVyGAEgASgJEhAKCHBhc3N3b3JkGAIgASgJEg4KBmRldmljZRgDIAEoCSKvAQoOU2lnbkluUmVzcG9uc2USHwoEdXNlchgBIAEoCzIRLmd1YXJkaWFuLnYxLlVzZXISJQoHc2Vzc2lvbhgCIAEoCzIULmd1YXJkaWFuLnYxLlNlc3Npb24SIwoGdG9rZW5zGAMgASgLMhMuZ3VhcmRpYW4udjEuVG9rZW5zEjAKDW1mYV9jaGFsbGVuZ2UYBCABKAsyGS5ndWFyZGlhbi52MS5NRkFDaGFsbGVuZ2UiYQoQVmVyaWZ5TUZBUmVxdWVzdBIXCg9jaGFsbGVuZ2VfdG9rZW4YASABKAkSEwoJdG90cF9jb2RlGAIgASgJSAASFwoNcmVjb3ZlcnlfY29kZRgDIAEoCUgAQgYKBGNvZGUigAEKEVZlcmlmeU1GQVJlc3BvbnNlEh8KBHVzZXIYASABKAsyES5ndWFyZGlhbi52MS5Vc2VyEiUKB3Nlc3Npb24YAiABKAsyFC5ndWFyZGlhbi52MS5TZXNzaW9uEiMKBnRva2VucxgDIAEoCzITLmd1YXJkaWFuLnYxLlRva2VucyIvChlCZWdpblBhc3NrZXlTaWduSW5SZXF1ZXN0EhIKCmlkZW50aWZpZXIYASABKAkicgoaQmVnaW5QYXNza2V5U2lnbkluUmVzcG9uc2USEwoLY2VyZW1vbnlfaWQYASABKAkSDwoHb3B0aW9ucxgCIAEoCRIuCgpleHBpcmVzX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJVChpGaW5pc2hQYXNza2V5U2lnbkluUmVxdWVzdBITCgtjZXJlbW9ueV9pZBgBIAEoCRISCgpjcmVkZW50aWFsGAIgASgJEg4KBmRldmljZRgDIAEoCSKKAQobRmluaXNoUGFzc2tleVNpZ25JblJlc3BvbnNlEh8KBHVzZXIYASABKAsyES5ndWFyZGlhbi52MS5Vc2VyEiUKB3Nlc3Npb24YAiABKAsyFC5ndWFyZGlhbi52MS5TZXNzaW9uEiMKBnRva2VucxgDIAEoCzITLmd1YXJkaWFuLnYxLlRva2VucyI1ChRTZW5kTWFnaWNMaW5rUmVxdWVzdBINCgVlbWFpbBgBIAEoCRIOCgZkZXZpY2UYAiABKAkiFwoVU2VuZE1hZ2ljTGlua1Jlc3BvbnNlIicKFlZlcmlmeU1hZ2ljTGlua1JlcXVlc3QSDQoFdG9rZW4YASABKAkiuAEKF1ZlcmlmeU1hZ2ljTGlua1Jlc3BvbnNlEh8KBHVzZXIYASABKAsyES5ndWFyZGlhbi52MS5Vc2VyEiUKB3Nlc3Npb24YAiABKAsyFC5ndWFyZGlhbi52MS5TZXNzaW9uEiMKBnRva2VucxgDIAEoCzITLmd1YXJkaWFuLnYxLlRva2VucxIwCg1tZmFfY2hhbGxlbmdlGAQgASgLMhkuZ3VhcmRpYW4udjEuTUZBQ2hhbGxlbmdlIjQKE1NlbmRFbWFpbE9UUFJlcXVlc3QSDQoFZW1haWwYASABKAkSDgoGZGV2aWNlGAIgASgJIl8KFFNlbmRFbWFpbE9UUFJlc3BvbnNlEhcKD2NoYWxsZW5nZV90b2tlbhgBIAEoCRIuCgpleHBpcmVzX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCI+ChVWZXJpZnlFbWFpbE9UUFJlcXVlc3QSFwoPY2hhbGxlbmdlX3Rva2VuGAEgASgJEgwKBGNvZGUYAiABKAkitwEKFlZlcmlmeUVtYWlsT1RQUmVzcG9uc2USHwoEdXNlchgBIAEoCzIRLmd1YXJkaWFuLnYxLlVzZXISJQoHc2Vzc2lvbhgCIAEoCzIULmd1YXJkaWFuLnYxLlNlc3Npb24SIwoGdG9rZW5zGAMgASgLMhMuZ3VhcmRpYW4udjEuVG9rZW5zEjAKDW1mYV9jaGFsbGVuZ2UYBCABKAsyGS5ndWFyZGlhbi52MS5NRkFDaGFsbGVuZ2UiLQocU2VuZEVtYWlsVmVyaWZpY2F0aW9uUmVxdWVzdBINCgVlbWFpbBgBIAEoCSIfCh1TZW5kRW1haWxWZXJpZmljYXRpb25SZXNwb25zZSIjChJWZXJpZnlFbWFpbFJlcXVlc3QSDQoFdG9rZW4YASABKAkiNgoTVmVyaWZ5RW1haWxSZXNwb25zZRIfCgR1c2VyGAEgASgLMhEuZ3VhcmRpYW4udjEuVXNlciIsChtSZXF1ZXN0UGFzc3dvcmRSZXNldFJlcXVlc3QSDQoFZW1haWwYASABKAkiHgocUmVxdWVzdFBhc3N3b3JkUmVzZXRSZXNwb25zZSI3ChRSZXNldFBhc3N3b3JkUmVxdWVzdBINCgV0b2tlbhgBIAEoCRIQCghwYXNzd29yZBgCIAEoCSIXChVSZXNldFBhc3N3b3JkUmVzcG9uc2UiEAoOU2lnbk91dFJlcXVlc3QiEQoPU2lnbk91dFJlc3BvbnNlIicKDlJlZnJlc2hSZXF1ZXN0EhUKDXJlZnJlc2hfdG9rZW4YASABKAkiNgoPUmVmcmVzaFJlc3BvbnNlEiMKBnRva2VucxgBIAEoCzITLmd1YXJkaWFuLnYxLlRva2VucyITChFHZXRTZXNzaW9uUmVxdWVzdCJcChJHZXRTZXNzaW9uUmVzcG9uc2USHwoEdXNlchgBIAEoCzIRLmd1YXJkaWFuLnYxLlVzZXISJQoHc2Vzc2lvbhgCIAEoCzIULmd1YXJkaWFuLnYxLlNlc3Npb24qWgoJTUZBRmFjdG9yEhoKFk1GQV9GQUNUT1JfVU5TUEVDSUZJRUQQABITCg9NRkFfRkFDVE9SX1RPVFAQARIcChhNRkFfRkFDVE9SX1JFQ09WRVJZX0NPREUQAjL4CgoLQXV0aFNlcnZpY2USQQoGU2lnblVwEhouZ3VhcmRpYW4udjEuU2lnblVwUmVxdWVzdBobLmd1YXJkaWFuLnYxLlNpZ25VcFJlc3BvbnNlEkEKBlNpZ25JbhIaLmd1YXJkaWFuLnYxLlNpZ25JblJlcXVlc3QaGy5ndWFyZGlhbi52MS5TaWduSW5SZXNwb25zZRJKCglWZXJpZnlNRkESHS5ndWFyZGlhbi52MS5WZXJpZnlNRkFSZXF1ZXN0Gh4uZ3VhcmRpYW4udjEuVmVyaWZ5TUZBUmVzcG9uc2USZQoSQmVnaW5QYXNza2V5U2lnbkluEiYuZ3VhcmRpYW4udjEuQmVnaW5QYXNza2V5U2lnbkluUmVxdWVzdBonLmd1YXJkaWFuLnYxLkJlZ2luUGFzc2tleVNpZ25JblJlc3BvbnNlEmgKE0ZpbmlzaFBhc3NrZXlTaWduSW4SJy5ndWFyZGlhbi52MS5GaW5pc2hQYXNza2V5U2lnbkluUmVxdWVzdBooLmd1YXJkaWFuLnYxLkZpbmlzaFBhc3NrZXlTaWduSW5SZXNwb25zZRJWCg1TZW5kTWFnaWNMaW5rEiEuZ3VhcmRpYW4udjEuU2VuZE1hZ2ljTGlua1JlcXVlc3QaIi5ndWFyZGlhbi52MS5TZW5kTWFnaWNMaW5rUmVzcG9uc2USXAoPVmVyaWZ5TWFnaWNMaW5rEiMuZ3VhcmRpYW4udjEuVmVyaWZ5TWFnaWNMaW5rUmVxdWVzdBokLmd1YXJkaWFuLnYxLlZlcmlmeU1hZ2ljTGlua1Jlc3BvbnNlElMKDFNlbmRFbWFpbE9UUBIgLmd1YXJkaWFuLnYxLlNlbmRFbWFpbE9UUFJlcXVlc3QaIS5ndWFyZGlhbi52MS5TZW5kRW1haWxPVFBSZXNwb25zZRJZCg5WZXJpZnlFbWFpbE9UUBIiLmd1YXJkaWFuLnYxLlZlcmlmeUVtYWlsT1RQUmVxdWVzdBojLmd1YXJkaWFuLnYxLlZlcmlmeUVtYWlsT1RQUmVzcG9uc2USbgoVU2VuZEVtYWlsVmVyaWZpY2F0aW9uEikuZ3VhcmRpYW4udjEuU2VuZEVtYWlsVmVyaWZpY2F0aW9uUmVxdWVzdBoqLmd1YXJkaWFuLnYxLlNlbmRFbWFpbFZlcmlmaWNhdGlvblJlc3BvbnNlElAKC1ZlcmlmeUVtYWlsEh8uZ3VhcmRpYW4udjEuVmVyaWZ5RW1haWxSZXF1ZXN0GiAuZ3VhcmRpYW4udjEuVmVyaWZ5RW1haWxSZXNwb25zZRJrChRSZXF1ZXN0UGFzc3dvcmRSZXNldBIoLmd1YXJkaWFuLnYxLlJlcXVlc3RQYXNzd29yZFJlc2V0UmVxdWVzdBopLmd1YXJkaWFuLnYxLlJlcXVlc3RQYXNzd29yZFJlc2V0UmVzcG9uc2USVgoNUmVzZXRQYXNzd29yZBIhLmd1YXJkaWFuLnYxLlJlc2V0UGFzc3dvcmRSZXF1ZXN0GiIuZ3VhcmRpYW4udjEuUmVzZXRQYXNzd29yZFJlc3BvbnNlEkQKB1NpZ25PdXQSGy5ndWFyZGlhbi52MS5TaWduT3V0UmVxdWVzdBocLmd1YXJkaWFuLnYxLlNpZ25PdXRSZXNwb25zZRJECgdSZWZyZXNoEhsuZ3VhcmRpYW4udjEuUmVmcmVzaFJlcXVlc3QaHC5ndWFyZGlhbi52MS5SZWZyZXNoUmVzcG9uc2USTQoKR2V0U2Vzc2lvbhIeLmd1YXJkaWFuLnYxLkdldFNlc3Npb25SZXF1ZXN0Gh8uZ3VhcmRpYW4udjEuR2V0U2Vzc2lvblJlc3BvbnNlQqgBCg9jb20uZ3VhcmRpYW4udjFCCUF1dGhQcm90b1ABWj1naXRodWIuY29tL2dvcGhlcm8vZ3VhcmRpYW4vY29yZS9wcm90by9ndWFyZGlhbi92MTtndWFyZGlhbnYxogIDR1ZYqgILR3VhcmRpYW4uVjHKAgtHdWFyZGlhblxWMeICF0d1YXJkaWFuXFYxXEdQQk1ldGFkYXRh6gIMR3VhcmRpYW46OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_guardian_v1_user]);
+
+/**
+ * Session is a signed in device of a user.
+ *
+ * @generated from message guardian.v1.Session
+ */
+export type Session = Message<"guardian.v1.Session"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string user_agent = 3;
+   */
+  userAgent: string;
+
+  /**
+   * @generated from field: string ip_address = 4;
+   */
+  ipAddress: string;
+
+  /**
+   * @generated from field: string device = 5;
+   */
+  device: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_seen_at = 7;
+   */
+  lastSeenAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 8;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * Authentication methods of RFC 8176 the user signed in with: `pwd`, `otp`, `hwk` and `mfa`.
+   *
+   * @generated from field: repeated string auth_methods = 9;
+   */
+  authMethods: string[];
+};
+
+/**
+ * Describes the message guardian.v1.Session.
+ * Use `create(SessionSchema)` to create a new message.
+ */
+export const SessionSchema: GenMessage<Session> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 0);
+
+/**
+ * Tokens are the credentials of a session.
+ *
+ * @generated from message guardian.v1.Tokens
+ */
+export type Tokens = Message<"guardian.v1.Tokens"> & {
+  /**
+   * @generated from field: string access_token = 1;
+   */
+  accessToken: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp access_token_expires_at = 2;
+   */
+  accessTokenExpiresAt?: Timestamp;
+
+  /**
+   * @generated from field: string refresh_token = 3;
+   */
+  refreshToken: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp refresh_token_expires_at = 4;
+   */
+  refreshTokenExpiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message guardian.v1.Tokens.
+ * Use `create(TokensSchema)` to create a new message.
+ */
+export const TokensSchema: GenMessage<Tokens> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 1);
+
+/**
+ * MFAChallenge is a sign in waiting for a second factor.
+ *
+ * @generated from message guardian.v1.MFAChallenge
+ */
+export type MFAChallenge = Message<"guardian.v1.MFAChallenge"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * Factors the challenge can be answered with.
+   *
+   * @generated from field: repeated guardian.v1.MFAFactor factors = 2;
+   */
+  factors: MFAFactor[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 3;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message guardian.v1.MFAChallenge.
+ * Use `create(MFAChallengeSchema)` to create a new message.
+ */
+export const MFAChallengeSchema: GenMessage<MFAChallenge> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 2);
+
+/**
+ * PasswordPolicyError is attached as error detail when a password violates the password policy.
+ *
+ * @generated from message guardian.v1.PasswordPolicyError
+ */
+export type PasswordPolicyError = Message<"guardian.v1.PasswordPolicyError"> & {
+  /**
+   * @generated from field: repeated guardian.v1.PasswordPolicyError.Violation violations = 1;
+   */
+  violations: PasswordPolicyError_Violation[];
+};
+
+/**
+ * Describes the message guardian.v1.PasswordPolicyError.
+ * Use `create(PasswordPolicyErrorSchema)` to create a new message.
+ */
+export const PasswordPolicyErrorSchema: GenMessage<PasswordPolicyError> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 3);
+
+/**
+ * @generated from message guardian.v1.PasswordPolicyError.Violation
+ */
+export type PasswordPolicyError_Violation = Message<"guardian.v1.PasswordPolicyError.Violation"> & {
+  /**
+   * Machine-readable code such as `password_too_short`.
+   *
+   * @generated from field: string code = 1;
+   */
+  code: string;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message guardian.v1.PasswordPolicyError.Violation.
+ * Use `create(PasswordPolicyError_ViolationSchema)` to create a new message.
+ */
+export const PasswordPolicyError_ViolationSchema: GenMessage<PasswordPolicyError_Violation> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 3, 0);
+
+/**
+ * @generated from message guardian.v1.SignUpRequest
+ */
+export type SignUpRequest = Message<"guardian.v1.SignUpRequest"> & {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email: string;
+
+  /**
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * @generated from field: string password = 3;
+   */
+  password: string;
+
+  /**
+   * Human readable name of the device signing up.
+   *
+   * @generated from field: string device = 4;
+   */
+  device: string;
+};
+
+/**
+ * Describes the message guardian.v1.SignUpRequest.
+ * Use `create(SignUpRequestSchema)` to create a new message.
+ */
+export const SignUpRequestSchema: GenMessage<SignUpRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 4);
+
+/**
+ * @generated from message guardian.v1.SignUpResponse
+ */
+export type SignUpResponse = Message<"guardian.v1.SignUpResponse"> & {
+  /**
+   * @generated from field: guardian.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * Not set if verified email addresses are required.
+   *
+   * @generated from field: guardian.v1.Session session = 2;
+   */
+  session?: Session;
+
+  /**
+   * Not set if verified email addresses are required.
+   *
+   * @generated from field: guardian.v1.Tokens tokens = 3;
+   */
+  tokens?: Tokens;
+};
+
+/**
+ * Describes the message guardian.v1.SignUpResponse.
+ * Use `create(SignUpResponseSchema)` to create a new message.
+ */
+export const SignUpResponseSchema: GenMessage<SignUpResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 5);
+
+/**
+ * @generated from message guardian.v1.SignInRequest
+ */
+export type SignInRequest = Message<"guardian.v1.SignInRequest"> & {
+  /**
+   * Email or username.
+   *
+   * @generated from field: string identifier = 1;
+   */
+  identifier: string;
+
+  /**
+   * @generated from field: string password = 2;
+   */
+  password: string;
+
+  /**
+   * Human readable name of the device signing in.
+   *
+   * @generated from field: string device = 3;
+   */
+  device: string;
+};
+
+/**
+ * Describes the message guardian.v1.SignInRequest.
+ * Use `create(SignInRequestSchema)` to create a new message.
+ */
+export const SignInRequestSchema: GenMessage<SignInRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 6);
+
+/**
+ * @generated from message guardian.v1.SignInResponse
+ */
+export type SignInResponse = Message<"guardian.v1.SignInResponse"> & {
+  /**
+   * @generated from field: guardian.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: guardian.v1.Session session = 2;
+   */
+  session?: Session;
+
+  /**
+   * @generated from field: guardian.v1.Tokens tokens = 3;
+   */
+  tokens?: Tokens;
+
+  /**
+   * Set instead of user, session and tokens if the user enrolled a second factor.
+   *
+   * @generated from field: guardian.v1.MFAChallenge mfa_challenge = 4;
+   */
+  mfaChallenge?: MFAChallenge;
+};
+
+/**
+ * Describes the message guardian.v1.SignInResponse.
+ * Use `create(SignInResponseSchema)` to create a new message.
+ */
+export const SignInResponseSchema: GenMessage<SignInResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 7);
+
+/**
+ * @generated from message guardian.v1.VerifyMFARequest
+ */
+export type VerifyMFARequest = Message<"guardian.v1.VerifyMFARequest"> & {
+  /**
+   * @generated from field: string challenge_token = 1;
+   */
+  challengeToken: string;
+
+  /**
+   * @generated from field: string totp_code = 2;
+   */
+  totpCode: string;
+
+  /**
+   * @generated from field: string recovery_code = 3;
+   */
+  recoveryCode: string;
+};
+
+/**
+ * Describes the message guardian.v1.VerifyMFARequest.
+ * Use `create(VerifyMFARequestSchema)` to create a new message.
+ */
+export const VerifyMFARequestSchema: GenMessage<VerifyMFARequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 8);
+
+/**
+ * @generated from message guardian.v1.VerifyMFAResponse
+ */
+export type VerifyMFAResponse = Message<"guardian.v1.VerifyMFAResponse"> & {
+  /**
+   * @generated from field: guardian.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: guardian.v1.Session session = 2;
+   */
+  session?: Session;
+
+  /**
+   * @generated from field: guardian.v1.Tokens tokens = 3;
+   */
+  tokens?: Tokens;
+};
+
+/**
+ * Describes the message guardian.v1.VerifyMFAResponse.
+ * Use `create(VerifyMFAResponseSchema)` to create a new message.
+ */
+export const VerifyMFAResponseSchema: GenMessage<VerifyMFAResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 9);
+
+/**
+ * @generated from message guardian.v1.BeginPasskeySignInRequest
+ */
+export type BeginPasskeySignInRequest = Message<"guardian.v1.BeginPasskeySignInRequest"> & {
+  /**
+   * Email or username. Optional.
+   *
+   * @generated from field: string identifier = 1;
+   */
+  identifier: string;
+};
+
+/**
+ * Describes the message guardian.v1.BeginPasskeySignInRequest.
+ * Use `create(BeginPasskeySignInRequestSchema)` to create a new message.
+ */
+export const BeginPasskeySignInRequestSchema: GenMessage<BeginPasskeySignInRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 10);
+
+/**
+ * @generated from message guardian.v1.BeginPasskeySignInResponse
+ */
+export type BeginPasskeySignInResponse = Message<"guardian.v1.BeginPasskeySignInResponse"> & {
+  /**
+   * @generated from field: string ceremony_id = 1;
+   */
+  ceremonyId: string;
+
+  /**
+   * JSON encoded options for navigator.credentials.get().
+   *
+   * @generated from field: string options = 2;
+   */
+  options: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 3;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message guardian.v1.BeginPasskeySignInResponse.
+ * Use `create(BeginPasskeySignInResponseSchema)` to create a new message.
+ */
+export const BeginPasskeySignInResponseSchema: GenMessage<BeginPasskeySignInResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 11);
+
+/**
+ * @generated from message guardian.v1.FinishPasskeySignInRequest
+ */
+export type FinishPasskeySignInRequest = Message<"guardian.v1.FinishPasskeySignInRequest"> & {
+  /**
+   * @generated from field: string ceremony_id = 1;
+   */
+  ceremonyId: string;
+
+  /**
+   * JSON encoded PublicKeyCredential returned by navigator.credentials.get().
+   *
+   * @generated from field: string credential = 2;
+   */
+  credential: string;
+
+  /**
+   * Human readable name of the device signing in.
+   *
+   * @generated from field: string device = 3;
+   */
+  device: string;
+};
+
+/**
+ * Describes the message guardian.v1.FinishPasskeySignInRequest.
+ * Use `create(FinishPasskeySignInRequestSchema)` to create a new message.
+ */
+export const FinishPasskeySignInRequestSchema: GenMessage<FinishPasskeySignInRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 12);
+
+/**
+ * @generated from message guardian.v1.FinishPasskeySignInResponse
+ */
+export type FinishPasskeySignInResponse = Message<"guardian.v1.FinishPasskeySignInResponse"> & {
+  /**
+   * @generated from field: guardian.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: guardian.v1.Session session = 2;
+   */
+  session?: Session;
+
+  /**
+   * @generated from field: guardian.v1.Tokens tokens = 3;
+   */
+  tokens?: Tokens;
+};
+
+/**
+ * Describes the message guardian.v1.FinishPasskeySignInResponse.
+ * Use `create(FinishPasskeySignInResponseSchema)` to create a new message.
+ */
+export const FinishPasskeySignInResponseSchema: GenMessage<FinishPasskeySignInResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 13);
+
+/**
+ * @generated from message guardian.v1.SendMagicLinkRequest
+ */
+export type SendMagicLinkRequest = Message<"guardian.v1.SendMagicLinkRequest"> & {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email: string;
+
+  /**
+   * Human readable name of the device signing in.
+   *
+   * @generated from field: string device = 2;
+   */
+  device: string;
+};
+
+/**
+ * Describes the message guardian.v1.SendMagicLinkRequest.
+ * Use `create(SendMagicLinkRequestSchema)` to create a new message.
+ */
+export const SendMagicLinkRequestSchema: GenMessage<SendMagicLinkRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 14);
+
+/**
+ * @generated from message guardian.v1.SendMagicLinkResponse
+ */
+export type SendMagicLinkResponse = Message<"guardian.v1.SendMagicLinkResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.SendMagicLinkResponse.
+ * Use `create(SendMagicLinkResponseSchema)` to create a new message.
+ */
+export const SendMagicLinkResponseSchema: GenMessage<SendMagicLinkResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 15);
+
+/**
+ * @generated from message guardian.v1.VerifyMagicLinkRequest
+ */
+export type VerifyMagicLinkRequest = Message<"guardian.v1.VerifyMagicLinkRequest"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message guardian.v1.VerifyMagicLinkRequest.
+ * Use `create(VerifyMagicLinkRequestSchema)` to create a new message.
+ */
+export const VerifyMagicLinkRequestSchema: GenMessage<VerifyMagicLinkRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 16);
+
+/**
+ * @generated from message guardian.v1.VerifyMagicLinkResponse
+ */
+export type VerifyMagicLinkResponse = Message<"guardian.v1.VerifyMagicLinkResponse"> & {
+  /**
+   * @generated from field: guardian.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: guardian.v1.Session session = 2;
+   */
+  session?: Session;
+
+  /**
+   * @generated from field: guardian.v1.Tokens tokens = 3;
+   */
+  tokens?: Tokens;
+
+  /**
+   * Set instead of user, session and tokens if the user enrolled a second factor.
+   *
+   * @generated from field: guardian.v1.MFAChallenge mfa_challenge = 4;
+   */
+  mfaChallenge?: MFAChallenge;
+};
+
+/**
+ * Describes the message guardian.v1.VerifyMagicLinkResponse.
+ * Use `create(VerifyMagicLinkResponseSchema)` to create a new message.
+ */
+export const VerifyMagicLinkResponseSchema: GenMessage<VerifyMagicLinkResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 17);
+
+/**
+ * @generated from message guardian.v1.SendEmailOTPRequest
+ */
+export type SendEmailOTPRequest = Message<"guardian.v1.SendEmailOTPRequest"> & {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email: string;
+
+  /**
+   * Human readable name of the device signing in.
+   *
+   * @generated from field: string device = 2;
+   */
+  device: string;
+};
+
+/**
+ * Describes the message guardian.v1.SendEmailOTPRequest.
+ * Use `create(SendEmailOTPRequestSchema)` to create a new message.
+ */
+export const SendEmailOTPRequestSchema: GenMessage<SendEmailOTPRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 18);
+
+/**
+ * @generated from message guardian.v1.SendEmailOTPResponse
+ */
+export type SendEmailOTPResponse = Message<"guardian.v1.SendEmailOTPResponse"> & {
+  /**
+   * @generated from field: string challenge_token = 1;
+   */
+  challengeToken: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 2;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message guardian.v1.SendEmailOTPResponse.
+ * Use `create(SendEmailOTPResponseSchema)` to create a new message.
+ */
+export const SendEmailOTPResponseSchema: GenMessage<SendEmailOTPResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 19);
+
+/**
+ * @generated from message guardian.v1.VerifyEmailOTPRequest
+ */
+export type VerifyEmailOTPRequest = Message<"guardian.v1.VerifyEmailOTPRequest"> & {
+  /**
+   * @generated from field: string challenge_token = 1;
+   */
+  challengeToken: string;
+
+  /**
+   * @generated from field: string code = 2;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message guardian.v1.VerifyEmailOTPRequest.
+ * Use `create(VerifyEmailOTPRequestSchema)` to create a new message.
+ */
+export const VerifyEmailOTPRequestSchema: GenMessage<VerifyEmailOTPRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 20);
+
+/**
+ * @generated from message guardian.v1.VerifyEmailOTPResponse
+ */
+export type VerifyEmailOTPResponse = Message<"guardian.v1.VerifyEmailOTPResponse"> & {
+  /**
+   * @generated from field: guardian.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: guardian.v1.Session session = 2;
+   */
+  session?: Session;
+
+  /**
+   * @generated from field: guardian.v1.Tokens tokens = 3;
+   */
+  tokens?: Tokens;
+
+  /**
+   * Set instead of user, session and tokens if the user enrolled a second factor.
+   *
+   * @generated from field: guardian.v1.MFAChallenge mfa_challenge = 4;
+   */
+  mfaChallenge?: MFAChallenge;
+};
+
+/**
+ * Describes the message guardian.v1.VerifyEmailOTPResponse.
+ * Use `create(VerifyEmailOTPResponseSchema)` to create a new message.
+ */
+export const VerifyEmailOTPResponseSchema: GenMessage<VerifyEmailOTPResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 21);
+
+/**
+ * @generated from message guardian.v1.SendEmailVerificationRequest
+ */
+export type SendEmailVerificationRequest = Message<"guardian.v1.SendEmailVerificationRequest"> & {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email: string;
+};
+
+/**
+ * Describes the message guardian.v1.SendEmailVerificationRequest.
+ * Use `create(SendEmailVerificationRequestSchema)` to create a new message.
+ */
+export const SendEmailVerificationRequestSchema: GenMessage<SendEmailVerificationRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 22);
+
+/**
+ * @generated from message guardian.v1.SendEmailVerificationResponse
+ */
+export type SendEmailVerificationResponse = Message<"guardian.v1.SendEmailVerificationResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.SendEmailVerificationResponse.
+ * Use `create(SendEmailVerificationResponseSchema)` to create a new message.
+ */
+export const SendEmailVerificationResponseSchema: GenMessage<SendEmailVerificationResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 23);
+
+/**
+ * @generated from message guardian.v1.VerifyEmailRequest
+ */
+export type VerifyEmailRequest = Message<"guardian.v1.VerifyEmailRequest"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message guardian.v1.VerifyEmailRequest.
+ * Use `create(VerifyEmailRequestSchema)` to create a new message.
+ */
+export const VerifyEmailRequestSchema: GenMessage<VerifyEmailRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 24);
+
+/**
+ * @generated from message guardian.v1.VerifyEmailResponse
+ */
+export type VerifyEmailResponse = Message<"guardian.v1.VerifyEmailResponse"> & {
+  /**
+   * @generated from field: guardian.v1.User user = 1;
+   */
+  user?: User;
+};
+
+/**
+ * Describes the message guardian.v1.VerifyEmailResponse.
+ * Use `create(VerifyEmailResponseSchema)` to create a new message.
+ */
+export const VerifyEmailResponseSchema: GenMessage<VerifyEmailResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 25);
+
+/**
+ * @generated from message guardian.v1.RequestPasswordResetRequest
+ */
+export type RequestPasswordResetRequest = Message<"guardian.v1.RequestPasswordResetRequest"> & {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email: string;
+};
+
+/**
+ * Describes the message guardian.v1.RequestPasswordResetRequest.
+ * Use `create(RequestPasswordResetRequestSchema)` to create a new message.
+ */
+export const RequestPasswordResetRequestSchema: GenMessage<RequestPasswordResetRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 26);
+
+/**
+ * @generated from message guardian.v1.RequestPasswordResetResponse
+ */
+export type RequestPasswordResetResponse = Message<"guardian.v1.RequestPasswordResetResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.RequestPasswordResetResponse.
+ * Use `create(RequestPasswordResetResponseSchema)` to create a new message.
+ */
+export const RequestPasswordResetResponseSchema: GenMessage<RequestPasswordResetResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 27);
+
+/**
+ * @generated from message guardian.v1.ResetPasswordRequest
+ */
+export type ResetPasswordRequest = Message<"guardian.v1.ResetPasswordRequest"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * @generated from field: string password = 2;
+   */
+  password: string;
+};
+
+/**
+ * Describes the message guardian.v1.ResetPasswordRequest.
+ * Use `create(ResetPasswordRequestSchema)` to create a new message.
+ */
+export const ResetPasswordRequestSchema: GenMessage<ResetPasswordRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 28);
+
+/**
+ * @generated from message guardian.v1.ResetPasswordResponse
+ */
+export type ResetPasswordResponse = Message<"guardian.v1.ResetPasswordResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.ResetPasswordResponse.
+ * Use `create(ResetPasswordResponseSchema)` to create a new message.
+ */
+export const ResetPasswordResponseSchema: GenMessage<ResetPasswordResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 29);
+
+/**
+ * @generated from message guardian.v1.SignOutRequest
+ */
+export type SignOutRequest = Message<"guardian.v1.SignOutRequest"> & {
+};
+
+/**
+ * Describes the message guardian.v1.SignOutRequest.
+ * Use `create(SignOutRequestSchema)` to create a new message.
+ */
+export const SignOutRequestSchema: GenMessage<SignOutRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 30);
+
+/**
+ * @generated from message guardian.v1.SignOutResponse
+ */
+export type SignOutResponse = Message<"guardian.v1.SignOutResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.SignOutResponse.
+ * Use `create(SignOutResponseSchema)` to create a new message.
+ */
+export const SignOutResponseSchema: GenMessage<SignOutResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 31);
+
+/**
+ * @generated from message guardian.v1.RefreshRequest
+ */
+export type RefreshRequest = Message<"guardian.v1.RefreshRequest"> & {
+  /**
+   * @generated from field: string refresh_token = 1;
+   */
+  refreshToken: string;
+};
+
+/**
+ * Describes the message guardian.v1.RefreshRequest.
+ * Use `create(RefreshRequestSchema)` to create a new message.
+ */
+export const RefreshRequestSchema: GenMessage<RefreshRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 32);
+
+/**
+ * @generated from message guardian.v1.RefreshResponse
+ */
+export type RefreshResponse = Message<"guardian.v1.RefreshResponse"> & {
+  /**
+   * @generated from field: guardian.v1.Tokens tokens = 1;
+   */
+  tokens?: Tokens;
+};
+
+/**
+ * Describes the message guardian.v1.RefreshResponse.
+ * Use `create(RefreshResponseSchema)` to create a new message.
+ */
+export const RefreshResponseSchema: GenMessage<RefreshResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 33);
+
+/**
+ * @generated from message guardian.v1.GetSessionRequest
+ */
+export type GetSessionRequest = Message<"guardian.v1.GetSessionRequest"> & {
+};
+
+/**
+ * Describes the message guardian.v1.GetSessionRequest.
+ * Use `create(GetSessionRequestSchema)` to create a new message.
+ */
+export const GetSessionRequestSchema: GenMessage<GetSessionRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 34);
+
+/**
+ * @generated from message guardian.v1.GetSessionResponse
+ */
+export type GetSessionResponse = Message<"guardian.v1.GetSessionResponse"> & {
+  /**
+   * @generated from field: guardian.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: guardian.v1.Session session = 2;
+   */
+  session?: Session;
+};
+
+/**
+ * Describes the message guardian.v1.GetSessionResponse.
+ * Use `create(GetSessionResponseSchema)` to create a new message.
+ */
+export const GetSessionResponseSchema: GenMessage<GetSessionResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_auth, 35);
+
+/**
+ * MFAFactor is a kind of second factor.
+ *
+ * @generated from enum guardian.v1.MFAFactor
+ */
+export enum MFAFactor {
+  /**
+   * @generated from enum value: MFA_FACTOR_UNSPECIFIED = 0;
+   */
+  MFA_FACTOR_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MFA_FACTOR_TOTP = 1;
+   */
+  MFA_FACTOR_TOTP = 1,
+
+  /**
+   * @generated from enum value: MFA_FACTOR_RECOVERY_CODE = 2;
+   */
+  MFA_FACTOR_RECOVERY_CODE = 2,
+}
+
+/**
+ * Describes the enum guardian.v1.MFAFactor.
+ */
+export const MFAFactorSchema: GenEnum<MFAFactor> = /*@__PURE__*/
+  enumDesc(file_guardian_v1_auth, 0);
+
+/**
+ * AuthService signs users up, in and out. Authenticated methods expect the access token in the `Authorization: Bearer`
+ * header.
+ *
+ * @generated from service guardian.v1.AuthService
+ */
+export const AuthService: GenService<{
+  /**
+   * SignUp creates a user with a password, emails a link to verify its email address and signs it in. If verified
+   * email addresses are required, the user is returned without a session instead.
+   *
+   * @generated from rpc guardian.v1.AuthService.SignUp
+   */
+  signUp: {
+    methodKind: "unary";
+    input: typeof SignUpRequestSchema;
+    output: typeof SignUpResponseSchema;
+  },
+  /**
+   * SignIn signs in a user by email or username and password. If the user enrolled a second factor, an MFA challenge
+   * is returned instead of a session, which has to be answered with VerifyMFA.
+   *
+   * @generated from rpc guardian.v1.AuthService.SignIn
+   */
+  signIn: {
+    methodKind: "unary";
+    input: typeof SignInRequestSchema;
+    output: typeof SignInResponseSchema;
+  },
+  /**
+   * VerifyMFA completes a sign in by answering its MFA challenge with a second factor.
+   *
+   * @generated from rpc guardian.v1.AuthService.VerifyMFA
+   */
+  verifyMFA: {
+    methodKind: "unary";
+    input: typeof VerifyMFARequestSchema;
+    output: typeof VerifyMFAResponseSchema;
+  },
+  /**
+   * BeginPasskeySignIn starts a WebAuthn authentication ceremony. Without identifier, or if the user has no passkeys,
+   * any discoverable passkey is accepted.
+   *
+   * @generated from rpc guardian.v1.AuthService.BeginPasskeySignIn
+   */
+  beginPasskeySignIn: {
+    methodKind: "unary";
+    input: typeof BeginPasskeySignInRequestSchema;
+    output: typeof BeginPasskeySignInResponseSchema;
+  },
+  /**
+   * FinishPasskeySignIn signs in the owner of the passkey which answered the ceremony. A passkey is not followed by an
+   * MFA challenge.
+   *
+   * @generated from rpc guardian.v1.AuthService.FinishPasskeySignIn
+   */
+  finishPasskeySignIn: {
+    methodKind: "unary";
+    input: typeof FinishPasskeySignInRequestSchema;
+    output: typeof FinishPasskeySignInResponseSchema;
+  },
+  /**
+   * SendMagicLink emails a single-use sign in link to the user. It succeeds whether or not the user exists, so it does
+   * not reveal which email addresses are registered.
+   *
+   * @generated from rpc guardian.v1.AuthService.SendMagicLink
+   */
+  sendMagicLink: {
+    methodKind: "unary";
+    input: typeof SendMagicLinkRequestSchema;
+    output: typeof SendMagicLinkResponseSchema;
+  },
+  /**
+   * VerifyMagicLink signs in with the token of a magic link. If the user enrolled a second factor, an MFA challenge is
+   * returned instead of a session.
+   *
+   * @generated from rpc guardian.v1.AuthService.VerifyMagicLink
+   */
+  verifyMagicLink: {
+    methodKind: "unary";
+    input: typeof VerifyMagicLinkRequestSchema;
+    output: typeof VerifyMagicLinkResponseSchema;
+  },
+  /**
+   * SendEmailOTP emails a 6 digit one-time code to the user and returns the challenge to answer with it. A challenge is
+   * returned whether or not the user exists.
+   *
+   * @generated from rpc guardian.v1.AuthService.SendEmailOTP
+   */
+  sendEmailOTP: {
+    methodKind: "unary";
+    input: typeof SendEmailOTPRequestSchema;
+    output: typeof SendEmailOTPResponseSchema;
+  },
+  /**
+   * VerifyEmailOTP signs in by answering an email challenge with its code. A challenge only accepts a limited number
+   * of attempts. If the user enrolled a second factor, an MFA challenge is returned instead of a session.
+   *
+   * @generated from rpc guardian.v1.AuthService.VerifyEmailOTP
+   */
+  verifyEmailOTP: {
+    methodKind: "unary";
+    input: typeof VerifyEmailOTPRequestSchema;
+    output: typeof VerifyEmailOTPResponseSchema;
+  },
+  /**
+   * SendEmailVerification emails a new verification link to the user if its email address is not verified yet.
+   * Repeated requests within a short time are ignored. It succeeds whether or not the user exists, so it does not
+   * reveal which email addresses are registered.
+   *
+   * @generated from rpc guardian.v1.AuthService.SendEmailVerification
+   */
+  sendEmailVerification: {
+    methodKind: "unary";
+    input: typeof SendEmailVerificationRequestSchema;
+    output: typeof SendEmailVerificationResponseSchema;
+  },
+  /**
+   * VerifyEmail completes a verification link sent by SignUp, SendEmailVerification or UserService.RequestEmailChange.
+   * A confirmed email change is notified to the previous email address.
+   *
+   * @generated from rpc guardian.v1.AuthService.VerifyEmail
+   */
+  verifyEmail: {
+    methodKind: "unary";
+    input: typeof VerifyEmailRequestSchema;
+    output: typeof VerifyEmailResponseSchema;
+  },
+  /**
+   * RequestPasswordReset emails a single-use link to reset the password of the user. It succeeds whether or not the
+   * user exists, so it does not reveal which email addresses are registered.
+   *
+   * @generated from rpc guardian.v1.AuthService.RequestPasswordReset
+   */
+  requestPasswordReset: {
+    methodKind: "unary";
+    input: typeof RequestPasswordResetRequestSchema;
+    output: typeof RequestPasswordResetResponseSchema;
+  },
+  /**
+   * ResetPassword sets a new password with the token of a password reset link and revokes all sessions of the user.
+   * The token is kept if the password violates the password policy, so another password can be tried.
+   *
+   * @generated from rpc guardian.v1.AuthService.ResetPassword
+   */
+  resetPassword: {
+    methodKind: "unary";
+    input: typeof ResetPasswordRequestSchema;
+    output: typeof ResetPasswordResponseSchema;
+  },
+  /**
+   * SignOut revokes the session of the caller and all of its refresh tokens.
+   *
+   * @generated from rpc guardian.v1.AuthService.SignOut
+   */
+  signOut: {
+    methodKind: "unary";
+    input: typeof SignOutRequestSchema;
+    output: typeof SignOutResponseSchema;
+  },
+  /**
+   * Refresh exchanges a refresh token for a new access and refresh token. Each refresh token can only be used once.
+   *
+   * @generated from rpc guardian.v1.AuthService.Refresh
+   */
+  refresh: {
+    methodKind: "unary";
+    input: typeof RefreshRequestSchema;
+    output: typeof RefreshResponseSchema;
+  },
+  /**
+   * GetSession returns the session and user of the caller.
+   *
+   * @generated from rpc guardian.v1.AuthService.GetSession
+   */
+  getSession: {
+    methodKind: "unary";
+    input: typeof GetSessionRequestSchema;
+    output: typeof GetSessionResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_guardian_v1_auth, 0);
+
@@ -0,0 +1,1468 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts"
+// @generated from file guardian/v1/authz.proto (package guardian.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_struct, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { JsonObject, Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file guardian/v1/authz.proto.
+ */
+export const file_guardian_v1_authz: GenFile = /*@__PURE__*/
+  fileDesc("ChdndWFyZGlhbi92MS9hdXRoei5wcm90bxILZ3VhcmRpYW4udjEiXwoKUGVybWlzc2lvbhIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEi4KCmNyZWF0ZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIr4BCgRSb2xlEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSEwoLcGVybWlzc2lvbnMYBCADKAkSEgoKcGFyZW50X2lkcxgFIAMoCRIuCgpjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKsAQoOUm9sZUFzc2lnbm1lbnQSCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIPCgdyb2xlX2lkGAMgASgJEg0KBXNjb3BlGAQgASgJEi4KCmNyZWF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhEKCWNvbmRpdGlvbhgGIAEoCRIaChJzZXJ2aWNlX2FjY291bnRfaWQYByABKAkiogEKCUNvbmRpdGlvbhIMCgRuYW1lGAEgASgJEhIKCmV4cHJlc3Npb24YAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSLgoKY3JlYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAimgEKEEF0dHJpYnV0ZUNvbnRleHQSKAoEdGltZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASCgoCaXAYAiABKAkSJQoEdXNlchgDIAEoCzIXLmdvb2dsZS5wcm90b2J1Zi5TdHJ1Y3QSKQoIcmVzb3VyY2UYBCABKAsyFy5nb29nbGUucHJvdG9idWYuU3RydWN0IpQBCgtFeHBsYWluTm9kZRIqCgRraW5kGAEgASgOMhwuZ3VhcmRpYW4udjEuRXhwbGFpbk5vZGVLaW5kEgwKBG5hbWUYAiABKAkSDwoHYWxsb3dlZBgDIAEoCBIOCgZkZXRhaWwYBCABKAkSKgoIY2hpbGRyZW4YBSADKAsyGC5ndWFyZGlhbi52MS5FeHBsYWluTm9kZSI8ChdDcmVhdGVQZXJtaXNzaW9uUmVxdWVzdBIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJIkcKGENyZWF0ZVBlcm1pc3Npb25SZXNwb25zZRIrCgpwZXJtaXNzaW9uGAEgASgLMhcuZ3VhcmRpYW4udjEuUGVybWlzc2lvbiIYChZMaXN0UGVybWlzc2lvbnNSZXF1ZXN0IkcKF0xpc3RQZXJtaXNzaW9uc1Jlc3BvbnNlEiwKC3Blcm1pc3Npb25zGAEgAygLMhcuZ3VhcmRpYW4udjEuUGVybWlzc2lvbiInChdEZWxldGVQZXJtaXNzaW9uUmVxdWVzdBIMCgRuYW1lGAEgASgJIhoKGERlbGV0ZVBlcm1pc3Npb25SZXNwb25zZSI2ChFDcmVhdGVSb2xlUmVxdWVzdBIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJIjUKEkNyZWF0ZVJvbGVSZXNwb25zZRIfCgRyb2xlGAEgASgLMhEuZ3VhcmRpYW4udjEuUm9sZSIcCg5HZXRSb2xlUmVxdWVzdBIKCgJpZBgBIAEoCSIyCg9HZXRSb2xlUmVzcG9uc2USHwoEcm9sZRgBIAEoCzIRLmd1YXJkaWFuLnYxLlJvbGUiEgoQTGlzdFJvbGVzUmVxdWVzdCI1ChFMaXN0Um9sZXNSZXNwb25zZRIgCgVyb2xlcxgBIAMoCzIRLmd1YXJkaWFuLnYxLlJvbGUiZQoRVXBkYXRlUm9sZVJlcXVlc3QSCgoCaWQYASABKAkSEQoEbmFtZRgCIAEoCUgAiAEBEhgKC2Rlc2NyaXB0aW9uGAMgASgJSAGIAQFCBwoFX25hbWVCDgoMX2Rlc2NyaXB0aW9uIjUKElVwZGF0ZVJvbGVSZXNwb25zZRIfCgRyb2xlGAEgASgLMhEuZ3VhcmRpYW4udjEuUm9sZSIfChFEZWxldGVSb2xlUmVxdWVzdBIKCgJpZBgBIAEoCSIUChJEZWxldGVSb2xlUmVzcG9uc2UiPQoWR3JhbnRQZXJtaXNzaW9uUmVxdWVzdBIPCgdyb2xlX2lkGAEgASgJEhIKCnBlcm1pc3Npb24YAiABKAkiGQoXR3JhbnRQZXJtaXNzaW9uUmVzcG9uc2UiPgoXUmV2b2tlUGVybWlzc2lvblJlcXVlc3QSDwoHcm9sZV9pZBgBIAEoCRISCgpwZXJtaXNzaW9uGAIgASgJIhoKGFJldm9rZVBlcm1pc3Npb25SZXNwb25zZSI6ChRBZGRSb2xlUGFyZW50UmVxdWVzdBIPCgdyb2xlX2lkGAEgASgJEhEKCXBhcmVudF9pZBgCIAEoCSIXChVBZGRSb2xlUGFyZW50UmVzcG9uc2UiPQoXUmVtb3ZlUm9sZVBhcmVudFJlcXVlc3QSDwoHcm9sZV9pZBgBIAEoCRIRCglwYXJlbnRfaWQYAiABKAkiGgoYUmVtb3ZlUm9sZVBhcmVudFJlc3BvbnNlIk8KFkNyZWF0ZUNvbmRpdGlvblJlcXVlc3QSDAoEbmFtZRgBIAEoCRISCgpleHByZXNzaW9uGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJIkQKF0NyZWF0ZUNvbmRpdGlvblJlc3BvbnNlEikKCWNvbmRpdGlvbhgBIAEoCzIWLmd1YXJkaWFuLnYxLkNvbmRpdGlvbiIjChNHZXRDb25kaXRpb25SZXF1ZXN0EgwKBG5hbWUYASABKAkiQQoUR2V0Q29uZGl0aW9uUmVzcG9uc2USKQoJY29uZGl0aW9uGAEgASgLMhYuZ3VhcmRpYW4udjEuQ29uZGl0aW9uIhcKFUxpc3RDb25kaXRpb25zUmVxdWVzdCJEChZMaXN0Q29uZGl0aW9uc1Jlc3BvbnNlEioKCmNvbmRpdGlvbnMYASADKAsyFi5ndWFyZGlhbi52MS5Db25kaXRpb24ieAoWVXBkYXRlQ29uZGl0aW9uUmVxdWVzdBIMCgRuYW1lGAEgASgJEhcKCmV4cHJlc3Npb24YAiABKAlIAIgBARIYCgtkZXNjcmlwdGlvbhgDIAEoCUgBiAEBQg0KC19leHByZXNzaW9uQg4KDF9kZXNjcmlwdGlvbiJEChdVcGRhdGVDb25kaXRpb25SZXNwb25zZRIpCgljb25kaXRpb24YASABKAsyFi5ndWFyZGlhbi52MS5Db25kaXRpb24iJgoWRGVsZXRlQ29uZGl0aW9uUmVxdWVzdBIMCgRuYW1lGAEgASgJIhkKF0RlbGV0ZUNvbmRpdGlvblJlc3BvbnNlInMKEUFzc2lnblJvbGVSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSDwoHcm9sZV9pZBgCIAEoCRINCgVzY29wZRgDIAEoCRIRCgljb25kaXRpb24YBCABKAkSGgoSc2VydmljZV9hY2NvdW50X2lkGAUgASgJIkUKEkFzc2lnblJvbGVSZXNwb25zZRIvCgphc3NpZ25tZW50GAEgASgLMhsuZ3VhcmRpYW4udjEuUm9sZUFzc2lnbm1lbnQiYgoTVW5hc3NpZ25Sb2xlUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEg8KB3JvbGVfaWQYAiABKAkSDQoFc2NvcGUYAyABKAkSGgoSc2VydmljZV9hY2NvdW50X2lkGAQgASgJIhYKFFVuYXNzaWduUm9sZVJlc3BvbnNlIkkKGkxpc3RSb2xlQXNzaWdubWVudHNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSGgoSc2VydmljZV9hY2NvdW50X2lkGAIgASgJIk8KG0xpc3RSb2xlQXNzaWdubWVudHNSZXNwb25zZRIwCgthc3NpZ25tZW50cxgBIAMoCzIbLmd1YXJkaWFuLnYxLlJvbGVBc3NpZ25tZW50IoMBCgxDaGVja1JlcXVlc3QSDwoHc3ViamVjdBgBIAEoCRISCgpwZXJtaXNzaW9uGAIgASgJEg0KBXNjb3BlGAMgASgJEi4KB2NvbnRleHQYBCABKAsyHS5ndWFyZGlhbi52MS5BdHRyaWJ1dGVDb250ZXh0Eg8KB2V4cGxhaW4YBSABKAgicwoNQ2hlY2tSZXNwb25zZRIPCgdhbGxvd2VkGAEgASgIEg4KBnBvbGljeRgCIAEoCRISCgpjb25kaXRpb25zGAMgAygJEi0KC2V4cGxhbmF0aW9uGAQgASgLMhguZ3VhcmRpYW4udjEuRXhwbGFpbk5vZGUicQofTGlzdEVmZmVjdGl2ZVBlcm1pc3Npb25zUmVxdWVzdBIPCgdzdWJqZWN0GAEgASgJEg0KBXNjb3BlGAIgASgJEi4KB2NvbnRleHQYAyABKAsyHS5ndWFyZGlhbi52MS5BdHRyaWJ1dGVDb250ZXh0IjcKIExpc3RFZmZlY3RpdmVQZXJtaXNzaW9uc1Jlc3BvbnNlEhMKC3Blcm1pc3Npb25zGAEgAygJKtQCCg9FeHBsYWluTm9kZUtpbmQSIQodRVhQTEFJTl9OT0RFX0tJTkRfVU5TUEVDSUZJRUQQABIgChxFWFBMQUlOX05PREVfS0lORF9QRVJNSVNTSU9OEAESIAocRVhQTEFJTl9OT0RFX0tJTkRfQVNTSUdOTUVOVBACEhoKFkVYUExBSU5fTk9ERV9LSU5EX1JPTEUQAxIeChpFWFBMQUlOX05PREVfS0lORF9SRUxBVElPThAEEhsKF0VYUExBSU5fTk9ERV9LSU5EX1VOSU9OEAUSIgoeRVhQTEFJTl9OT0RFX0tJTkRfSU5URVJTRUNUSU9OEAYSHwobRVhQTEFJTl9OT0RFX0tJTkRfRVhDTFVTSU9OEAcSGwoXRVhQTEFJTl9OT0RFX0tJTkRfVFVQTEUQCBIfChtFWFBMQUlOX05PREVfS0lORF9DT05ESVRJT04QCTK2DwoMQXV0aHpTZXJ2aWNlEl8KEENyZWF0ZVBlcm1pc3Npb24SJC5ndWFyZGlhbi52MS5DcmVhdGVQZXJtaXNzaW9uUmVxdWVzdBolLmd1YXJkaWFuLnYxLkNyZWF0ZVBlcm1pc3Npb25SZXNwb25zZRJcCg9MaXN0UGVybWlzc2lvbnMSIy5ndWFyZGlhbi52MS5MaXN0UGVybWlzc2lvbnNSZXF1ZXN0GiQuZ3VhcmRpYW4udjEuTGlzdFBlcm1pc3Npb25zUmVzcG9uc2USXwoQRGVsZXRlUGVybWlzc2lvbhIkLmd1YXJkaWFuLnYxLkRlbGV0ZVBlcm1pc3Npb25SZXF1ZXN0GiUuZ3VhcmRpYW4udjEuRGVsZXRlUGVybWlzc2lvblJlc3BvbnNlEk0KCkNyZWF0ZVJvbGUSHi5ndWFyZGlhbi52MS5DcmVhdGVSb2xlUmVxdWVzdBofLmd1YXJkaWFuLnYxLkNyZWF0ZVJvbGVSZXNwb25zZRJECgdHZXRSb2xlEhsuZ3VhcmRpYW4udjEuR2V0Um9sZVJlcXVlc3QaHC5ndWFyZGlhbi52MS5HZXRSb2xlUmVzcG9uc2USSgoJTGlzdFJvbGVzEh0uZ3VhcmRpYW4udjEuTGlzdFJvbGVzUmVxdWVzdBoeLmd1YXJkaWFuLnYxLkxpc3RSb2xlc1Jlc3BvbnNlEk0KClVwZGF0ZVJvbGUSHi5ndWFyZGlhbi52MS5VcGRhdGVSb2xlUmVxdWVzdBofLmd1YXJkaWFuLnYxLlVwZGF0ZVJvbGVSZXNwb25zZRJNCgpEZWxldGVSb2xlEh4uZ3VhcmRpYW4udjEuRGVsZXRlUm9sZVJlcXVlc3QaHy5ndWFyZGlhbi52MS5EZWxldGVSb2xlUmVzcG9uc2USXAoPR3JhbnRQZXJtaXNzaW9uEiMuZ3VhcmRpYW4udjEuR3JhbnRQZXJtaXNzaW9uUmVxdWVzdBokLmd1YXJkaWFuLnYxLkdyYW50UGVybWlzc2lvblJlc3BvbnNlEl8KEFJldm9rZVBlcm1pc3Npb24SJC5ndWFyZGlhbi52MS5SZXZva2VQZXJtaXNzaW9uUmVxdWVzdBolLmd1YXJkaWFuLnYxLlJldm9rZVBlcm1pc3Npb25SZXNwb25zZRJWCg1BZGRSb2xlUGFyZW50EiEuZ3VhcmRpYW4udjEuQWRkUm9sZVBhcmVudFJlcXVlc3QaIi5ndWFyZGlhbi52MS5BZGRSb2xlUGFyZW50UmVzcG9uc2USXwoQUmVtb3ZlUm9sZVBhcmVudBIkLmd1YXJkaWFuLnYxLlJlbW92ZVJvbGVQYXJlbnRSZXF1ZXN0GiUuZ3VhcmRpYW4udjEuUmVtb3ZlUm9sZVBhcmVudFJlc3BvbnNlElwKD0NyZWF0ZUNvbmRpdGlvbhIjLmd1YXJkaWFuLnYxLkNyZWF0ZUNvbmRpdGlvblJlcXVlc3QaJC5ndWFyZGlhbi52MS5DcmVhdGVDb25kaXRpb25SZXNwb25zZRJTCgxHZXRDb25kaXRpb24SIC5ndWFyZGlhbi52MS5HZXRDb25kaXRpb25SZXF1ZXN0GiEuZ3VhcmRpYW4udjEuR2V0Q29uZGl0aW9uUmVzcG9uc2USWQoOTGlzdENvbmRpdGlvbnMSIi5ndWFyZGlhbi52MS5MaXN0Q29uZGl0aW9uc1JlcXVlc3QaIy5ndWFyZGlhbi52MS5MaXN0Q29uZGl0aW9uc1Jlc3BvbnNlElwKD1VwZGF0ZUNvbmRpdGlvbhIjLmd1YXJkaWFuLnYxLlVwZGF0ZUNvbmRpdGlvblJlcXVlc3QaJC5ndWFyZGlhbi52MS5VcGRhdGVDb25kaXRpb25SZXNwb25zZRJcCg9EZWxldGVDb25kaXRpb24SIy5ndWFyZGlhbi52MS5EZWxldGVDb25kaXRpb25SZXF1ZXN0GiQuZ3VhcmRpYW4udjEuRGVsZXRlQ29uZGl0aW9uUmVzcG9uc2USTQoKQXNzaWduUm9sZRIeLmd1YXJkaWFuLnYxLkFzc2lnblJvbGVSZXF1ZXN0Gh8uZ3VhcmRpYW4udjEuQXNzaWduUm9sZVJlc3BvbnNlElMKDFVuYXNzaWduUm9sZRIgLmd1YXJkaWFuLnYxLlVuYXNzaWduUm9sZVJlcXVlc3QaIS5ndWFyZGlhbi52MS5VbmFzc2lnblJvbGVSZXNwb25zZRJoChNMaXN0Um9sZUFzc2lnbm1lbnRzEicuZ3VhcmRpYW4udjEuTGlzdFJvbGVBc3NpZ25tZW50c1JlcXVlc3QaKC5ndWFyZGlhbi52MS5MaXN0Um9sZUFzc2lnbm1lbnRzUmVzcG9uc2USPgoFQ2hlY2sSGS5ndWFyZGlhbi52MS5DaGVja1JlcXVlc3QaGi5ndWFyZGlhbi52MS5DaGVja1Jlc3BvbnNlEncKGExpc3RFZmZlY3RpdmVQZXJtaXNzaW9ucxIsLmd1YXJkaWFuLnYxLkxpc3RFZmZlY3RpdmVQZXJtaXNzaW9uc1JlcXVlc3QaLS5ndWFyZGlhbi52MS5MaXN0RWZmZWN0aXZlUGVybWlzc2lvbnNSZXNwb25zZUKpAQoPY29tLmd1YXJkaWFuLnYxQgpBdXRoelByb3RvUAFaPWdpdGh1Yi5jb20vZ29waGVyby9ndWFyZGlhbi9jb3JlL3Byb3RvL2d1YXJkaWFuL3YxO2d1YXJkaWFudjGiAgNHVliqAgtHdWFyZGlhbi5WMcoCC0d1YXJkaWFuXFYx4gIXR3VhcmRpYW5cVjFcR1BCTWV0YWRhdGHqAgxHdWFyZGlhbjo6VjFiBnByb3RvMw", [file_google_protobuf_struct, file_google_protobuf_timestamp]);
+
+/**
+ * Permission is a named action which can be granted to roles.
+ *
+ * @generated from message guardian.v1.Permission
+ */
+export type Permission = Message<"guardian.v1.Permission"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 3;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message guardian.v1.Permission.
+ * Use `create(PermissionSchema)` to create a new message.
+ */
+export const PermissionSchema: GenMessage<Permission> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 0);
+
+/**
+ * Role is a named set of permissions. A role has all permissions of its parents.
+ *
+ * @generated from message guardian.v1.Role
+ */
+export type Role = Message<"guardian.v1.Role"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * Permissions granted directly to the role.
+   *
+   * @generated from field: repeated string permissions = 4;
+   */
+  permissions: string[];
+
+  /**
+   * Ids of the roles the role inherits from directly.
+   *
+   * @generated from field: repeated string parent_ids = 5;
+   */
+  parentIds: string[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 7;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message guardian.v1.Role.
+ * Use `create(RoleSchema)` to create a new message.
+ */
+export const RoleSchema: GenMessage<Role> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 1);
+
+/**
+ * RoleAssignment grants the permissions of a role to a user or a service account in a scope.
+ *
+ * @generated from message guardian.v1.RoleAssignment
+ */
+export type RoleAssignment = Message<"guardian.v1.RoleAssignment"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * User the role is assigned to, empty for assignments to service accounts.
+   *
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string role_id = 3;
+   */
+  roleId: string;
+
+  /**
+   * Organization the assignment is scoped to, empty for global assignments.
+   *
+   * @generated from field: string scope = 4;
+   */
+  scope: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * Name of the condition the assignment requires, empty for unconditional assignments.
+   *
+   * @generated from field: string condition = 6;
+   */
+  condition: string;
+
+  /**
+   * Service account the role is assigned to, empty for assignments to users.
+   *
+   * @generated from field: string service_account_id = 7;
+   */
+  serviceAccountId: string;
+};
+
+/**
+ * Describes the message guardian.v1.RoleAssignment.
+ * Use `create(RoleAssignmentSchema)` to create a new message.
+ */
+export const RoleAssignmentSchema: GenMessage<RoleAssignment> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 2);
+
+/**
+ * Condition is a named CEL expression which grants can require to hold. Expressions evaluate to a bool and can use
+ * the variables `time` (timestamp), `ip` (string, empty if unknown), `user` and `resource` (maps of the attributes
+ * in AttributeContext). `ip.inCidr('10.0.0.0/8')` reports whether ip is in a network.
+ *
+ * @generated from message guardian.v1.Condition
+ */
+export type Condition = Message<"guardian.v1.Condition"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string expression = 2;
+   */
+  expression: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 5;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message guardian.v1.Condition.
+ * Use `create(ConditionSchema)` to create a new message.
+ */
+export const ConditionSchema: GenMessage<Condition> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 3);
+
+/**
+ * AttributeContext holds the attributes conditions are evaluated against.
+ *
+ * @generated from message guardian.v1.AttributeContext
+ */
+export type AttributeContext = Message<"guardian.v1.AttributeContext"> & {
+  /**
+   * Time of the request, the time the check is received if unset.
+   *
+   * @generated from field: google.protobuf.Timestamp time = 1;
+   */
+  time?: Timestamp;
+
+  /**
+   * Address of the client the check is made for. Defaults to the address of the caller when it checks itself.
+   *
+   * @generated from field: string ip = 2;
+   */
+  ip: string;
+
+  /**
+   * @generated from field: google.protobuf.Struct user = 3;
+   */
+  user?: JsonObject;
+
+  /**
+   * @generated from field: google.protobuf.Struct resource = 4;
+   */
+  resource?: JsonObject;
+};
+
+/**
+ * Describes the message guardian.v1.AttributeContext.
+ * Use `create(AttributeContextSchema)` to create a new message.
+ */
+export const AttributeContextSchema: GenMessage<AttributeContext> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 4);
+
+/**
+ * ExplainNode is a node of the evaluation tree of an explained check. Children are in the order they were evaluated.
+ *
+ * @generated from message guardian.v1.ExplainNode
+ */
+export type ExplainNode = Message<"guardian.v1.ExplainNode"> & {
+  /**
+   * @generated from field: guardian.v1.ExplainNodeKind kind = 1;
+   */
+  kind: ExplainNodeKind;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: bool allowed = 3;
+   */
+  allowed: boolean;
+
+  /**
+   * Additional information like the expression of a condition or why evaluation stopped.
+   *
+   * @generated from field: string detail = 4;
+   */
+  detail: string;
+
+  /**
+   * @generated from field: repeated guardian.v1.ExplainNode children = 5;
+   */
+  children: ExplainNode[];
+};
+
+/**
+ * Describes the message guardian.v1.ExplainNode.
+ * Use `create(ExplainNodeSchema)` to create a new message.
+ */
+export const ExplainNodeSchema: GenMessage<ExplainNode> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 5);
+
+/**
+ * @generated from message guardian.v1.CreatePermissionRequest
+ */
+export type CreatePermissionRequest = Message<"guardian.v1.CreatePermissionRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+};
+
+/**
+ * Describes the message guardian.v1.CreatePermissionRequest.
+ * Use `create(CreatePermissionRequestSchema)` to create a new message.
+ */
+export const CreatePermissionRequestSchema: GenMessage<CreatePermissionRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 6);
+
+/**
+ * @generated from message guardian.v1.CreatePermissionResponse
+ */
+export type CreatePermissionResponse = Message<"guardian.v1.CreatePermissionResponse"> & {
+  /**
+   * @generated from field: guardian.v1.Permission permission = 1;
+   */
+  permission?: Permission;
+};
+
+/**
+ * Describes the message guardian.v1.CreatePermissionResponse.
+ * Use `create(CreatePermissionResponseSchema)` to create a new message.
+ */
+export const CreatePermissionResponseSchema: GenMessage<CreatePermissionResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 7);
+
+/**
+ * @generated from message guardian.v1.ListPermissionsRequest
+ */
+export type ListPermissionsRequest = Message<"guardian.v1.ListPermissionsRequest"> & {
+};
+
+/**
+ * Describes the message guardian.v1.ListPermissionsRequest.
+ * Use `create(ListPermissionsRequestSchema)` to create a new message.
+ */
+export const ListPermissionsRequestSchema: GenMessage<ListPermissionsRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 8);
+
+/**
+ * @generated from message guardian.v1.ListPermissionsResponse
+ */
+export type ListPermissionsResponse = Message<"guardian.v1.ListPermissionsResponse"> & {
+  /**
+   * @generated from field: repeated guardian.v1.Permission permissions = 1;
+   */
+  permissions: Permission[];
+};
+
+/**
+ * Describes the message guardian.v1.ListPermissionsResponse.
+ * Use `create(ListPermissionsResponseSchema)` to create a new message.
+ */
+export const ListPermissionsResponseSchema: GenMessage<ListPermissionsResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 9);
+
+/**
+ * @generated from message guardian.v1.DeletePermissionRequest
+ */
+export type DeletePermissionRequest = Message<"guardian.v1.DeletePermissionRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message guardian.v1.DeletePermissionRequest.
+ * Use `create(DeletePermissionRequestSchema)` to create a new message.
+ */
+export const DeletePermissionRequestSchema: GenMessage<DeletePermissionRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 10);
+
+/**
+ * @generated from message guardian.v1.DeletePermissionResponse
+ */
+export type DeletePermissionResponse = Message<"guardian.v1.DeletePermissionResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.DeletePermissionResponse.
+ * Use `create(DeletePermissionResponseSchema)` to create a new message.
+ */
+export const DeletePermissionResponseSchema: GenMessage<DeletePermissionResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 11);
+
+/**
+ * @generated from message guardian.v1.CreateRoleRequest
+ */
+export type CreateRoleRequest = Message<"guardian.v1.CreateRoleRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+};
+
+/**
+ * Describes the message guardian.v1.CreateRoleRequest.
+ * Use `create(CreateRoleRequestSchema)` to create a new message.
+ */
+export const CreateRoleRequestSchema: GenMessage<CreateRoleRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 12);
+
+/**
+ * @generated from message guardian.v1.CreateRoleResponse
+ */
+export type CreateRoleResponse = Message<"guardian.v1.CreateRoleResponse"> & {
+  /**
+   * @generated from field: guardian.v1.Role role = 1;
+   */
+  role?: Role;
+};
+
+/**
+ * Describes the message guardian.v1.CreateRoleResponse.
+ * Use `create(CreateRoleResponseSchema)` to create a new message.
+ */
+export const CreateRoleResponseSchema: GenMessage<CreateRoleResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 13);
+
+/**
+ * @generated from message guardian.v1.GetRoleRequest
+ */
+export type GetRoleRequest = Message<"guardian.v1.GetRoleRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message guardian.v1.GetRoleRequest.
+ * Use `create(GetRoleRequestSchema)` to create a new message.
+ */
+export const GetRoleRequestSchema: GenMessage<GetRoleRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 14);
+
+/**
+ * @generated from message guardian.v1.GetRoleResponse
+ */
+export type GetRoleResponse = Message<"guardian.v1.GetRoleResponse"> & {
+  /**
+   * @generated from field: guardian.v1.Role role = 1;
+   */
+  role?: Role;
+};
+
+/**
+ * Describes the message guardian.v1.GetRoleResponse.
+ * Use `create(GetRoleResponseSchema)` to create a new message.
+ */
+export const GetRoleResponseSchema: GenMessage<GetRoleResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 15);
+
+/**
+ * @generated from message guardian.v1.ListRolesRequest
+ */
+export type ListRolesRequest = Message<"guardian.v1.ListRolesRequest"> & {
+};
+
+/**
+ * Describes the message guardian.v1.ListRolesRequest.
+ * Use `create(ListRolesRequestSchema)` to create a new message.
+ */
+export const ListRolesRequestSchema: GenMessage<ListRolesRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 16);
+
+/**
+ * @generated from message guardian.v1.ListRolesResponse
+ */
+export type ListRolesResponse = Message<"guardian.v1.ListRolesResponse"> & {
+  /**
+   * @generated from field: repeated guardian.v1.Role roles = 1;
+   */
+  roles: Role[];
+};
+
+/**
+ * Describes the message guardian.v1.ListRolesResponse.
+ * Use `create(ListRolesResponseSchema)` to create a new message.
+ */
+export const ListRolesResponseSchema: GenMessage<ListRolesResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 17);
+
+/**
+ * @generated from message guardian.v1.UpdateRoleRequest
+ */
+export type UpdateRoleRequest = Message<"guardian.v1.UpdateRoleRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: optional string name = 2;
+   */
+  name?: string;
+
+  /**
+   * @generated from field: optional string description = 3;
+   */
+  description?: string;
+};
+
+/**
+ * Describes the message guardian.v1.UpdateRoleRequest.
+ * Use `create(UpdateRoleRequestSchema)` to create a new message.
+ */
+export const UpdateRoleRequestSchema: GenMessage<UpdateRoleRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 18);
+
+/**
+ * @generated from message guardian.v1.UpdateRoleResponse
+ */
+export type UpdateRoleResponse = Message<"guardian.v1.UpdateRoleResponse"> & {
+  /**
+   * @generated from field: guardian.v1.Role role = 1;
+   */
+  role?: Role;
+};
+
+/**
+ * Describes the message guardian.v1.UpdateRoleResponse.
+ * Use `create(UpdateRoleResponseSchema)` to create a new message.
+ */
+export const UpdateRoleResponseSchema: GenMessage<UpdateRoleResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 19);
+
+/**
+ * @generated from message guardian.v1.DeleteRoleRequest
+ */
+export type DeleteRoleRequest = Message<"guardian.v1.DeleteRoleRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message guardian.v1.DeleteRoleRequest.
+ * Use `create(DeleteRoleRequestSchema)` to create a new message.
+ */
+export const DeleteRoleRequestSchema: GenMessage<DeleteRoleRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 20);
+
+/**
+ * @generated from message guardian.v1.DeleteRoleResponse
+ */
+export type DeleteRoleResponse = Message<"guardian.v1.DeleteRoleResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.DeleteRoleResponse.
+ * Use `create(DeleteRoleResponseSchema)` to create a new message.
+ */
+export const DeleteRoleResponseSchema: GenMessage<DeleteRoleResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 21);
+
+/**
+ * @generated from message guardian.v1.GrantPermissionRequest
+ */
+export type GrantPermissionRequest = Message<"guardian.v1.GrantPermissionRequest"> & {
+  /**
+   * @generated from field: string role_id = 1;
+   */
+  roleId: string;
+
+  /**
+   * @generated from field: string permission = 2;
+   */
+  permission: string;
+};
+
+/**
+ * Describes the message guardian.v1.GrantPermissionRequest.
+ * Use `create(GrantPermissionRequestSchema)` to create a new message.
+ */
+export const GrantPermissionRequestSchema: GenMessage<GrantPermissionRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 22);
+
+/**
+ * @generated from message guardian.v1.GrantPermissionResponse
+ */
+export type GrantPermissionResponse = Message<"guardian.v1.GrantPermissionResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.GrantPermissionResponse.
+ * Use `create(GrantPermissionResponseSchema)` to create a new message.
+ */
+export const GrantPermissionResponseSchema: GenMessage<GrantPermissionResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 23);
+
+/**
+ * @generated from message guardian.v1.RevokePermissionRequest
+ */
+export type RevokePermissionRequest = Message<"guardian.v1.RevokePermissionRequest"> & {
+  /**
+   * @generated from field: string role_id = 1;
+   */
+  roleId: string;
+
+  /**
+   * @generated from field: string permission = 2;
+   */
+  permission: string;
+};
+
+/**
+ * Describes the message guardian.v1.RevokePermissionRequest.
+ * Use `create(RevokePermissionRequestSchema)` to create a new message.
+ */
+export const RevokePermissionRequestSchema: GenMessage<RevokePermissionRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 24);
+
+/**
+ * @generated from message guardian.v1.RevokePermissionResponse
+ */
+export type RevokePermissionResponse = Message<"guardian.v1.RevokePermissionResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.RevokePermissionResponse.
+ * Use `create(RevokePermissionResponseSchema)` to create a new message.
+ */
+export const RevokePermissionResponseSchema: GenMessage<RevokePermissionResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 25);
+
+/**
+ * @generated from message guardian.v1.AddRoleParentRequest
+ */
+export type AddRoleParentRequest = Message<"guardian.v1.AddRoleParentRequest"> & {
+  /**
+   * @generated from field: string role_id = 1;
+   */
+  roleId: string;
+
+  /**
+   * @generated from field: string parent_id = 2;
+   */
+  parentId: string;
+};
+
+/**
+ * Describes the message guardian.v1.AddRoleParentRequest.
+ * Use `create(AddRoleParentRequestSchema)` to create a new message.
+ */
+export const AddRoleParentRequestSchema: GenMessage<AddRoleParentRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 26);
+
+/**
+ * @generated from message guardian.v1.AddRoleParentResponse
+ */
+export type AddRoleParentResponse = Message<"guardian.v1.AddRoleParentResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.AddRoleParentResponse.
+ * Use `create(AddRoleParentResponseSchema)` to create a new message.
+ */
+export const AddRoleParentResponseSchema: GenMessage<AddRoleParentResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 27);
+
+/**
+ * @generated from message guardian.v1.RemoveRoleParentRequest
+ */
+export type RemoveRoleParentRequest = Message<"guardian.v1.RemoveRoleParentRequest"> & {
+  /**
+   * @generated from field: string role_id = 1;
+   */
+  roleId: string;
+
+  /**
+   * @generated from field: string parent_id = 2;
+   */
+  parentId: string;
+};
+
+/**
+ * Describes the message guardian.v1.RemoveRoleParentRequest.
+ * Use `create(RemoveRoleParentRequestSchema)` to create a new message.
+ */
+export const RemoveRoleParentRequestSchema: GenMessage<RemoveRoleParentRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 28);
+
+/**
+ * @generated from message guardian.v1.RemoveRoleParentResponse
+ */
+export type RemoveRoleParentResponse = Message<"guardian.v1.RemoveRoleParentResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.RemoveRoleParentResponse.
+ * Use `create(RemoveRoleParentResponseSchema)` to create a new message.
+ */
+export const RemoveRoleParentResponseSchema: GenMessage<RemoveRoleParentResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 29);
+
+/**
+ * @generated from message guardian.v1.CreateConditionRequest
+ */
+export type CreateConditionRequest = Message<"guardian.v1.CreateConditionRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string expression = 2;
+   */
+  expression: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+};
+
+/**
+ * Describes the message guardian.v1.CreateConditionRequest.
+ * Use `create(CreateConditionRequestSchema)` to create a new message.
+ */
+export const CreateConditionRequestSchema: GenMessage<CreateConditionRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 30);
+
+/**
+ * @generated from message guardian.v1.CreateConditionResponse
+ */
+export type CreateConditionResponse = Message<"guardian.v1.CreateConditionResponse"> & {
+  /**
+   * @generated from field: guardian.v1.Condition condition = 1;
+   */
+  condition?: Condition;
+};
+
+/**
+ * Describes the message guardian.v1.CreateConditionResponse.
+ * Use `create(CreateConditionResponseSchema)` to create a new message.
+ */
+export const CreateConditionResponseSchema: GenMessage<CreateConditionResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 31);
+
+/**
+ * @generated from message guardian.v1.GetConditionRequest
+ */
+export type GetConditionRequest = Message<"guardian.v1.GetConditionRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message guardian.v1.GetConditionRequest.
+ * Use `create(GetConditionRequestSchema)` to create a new message.
+ */
+export const GetConditionRequestSchema: GenMessage<GetConditionRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 32);
+
+/**
+ * @generated from message guardian.v1.GetConditionResponse
+ */
+export type GetConditionResponse = Message<"guardian.v1.GetConditionResponse"> & {
+  /**
+   * @generated from field: guardian.v1.Condition condition = 1;
+   */
+  condition?: Condition;
+};
+
+/**
+ * Describes the message guardian.v1.GetConditionResponse.
+ * Use `create(GetConditionResponseSchema)` to create a new message.
+ */
+export const GetConditionResponseSchema: GenMessage<GetConditionResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 33);
+
+/**
+ * @generated from message guardian.v1.ListConditionsRequest
+ */
+export type ListConditionsRequest = Message<"guardian.v1.ListConditionsRequest"> & {
+};
+
+/**
+ * Describes the message guardian.v1.ListConditionsRequest.
+ * Use `create(ListConditionsRequestSchema)` to create a new message.
+ */
+export const ListConditionsRequestSchema: GenMessage<ListConditionsRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 34);
+
+/**
+ * @generated from message guardian.v1.ListConditionsResponse
+ */
+export type ListConditionsResponse = Message<"guardian.v1.ListConditionsResponse"> & {
+  /**
+   * @generated from field: repeated guardian.v1.Condition conditions = 1;
+   */
+  conditions: Condition[];
+};
+
+/**
+ * Describes the message guardian.v1.ListConditionsResponse.
+ * Use `create(ListConditionsResponseSchema)` to create a new message.
+ */
+export const ListConditionsResponseSchema: GenMessage<ListConditionsResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 35);
+
+/**
+ * @generated from message guardian.v1.UpdateConditionRequest
+ */
+export type UpdateConditionRequest = Message<"guardian.v1.UpdateConditionRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: optional string expression = 2;
+   */
+  expression?: string;
+
+  /**
+   * @generated from field: optional string description = 3;
+   */
+  description?: string;
+};
+
+/**
+ * Describes the message guardian.v1.UpdateConditionRequest.
+ * Use `create(UpdateConditionRequestSchema)` to create a new message.
+ */
+export const UpdateConditionRequestSchema: GenMessage<UpdateConditionRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 36);
+
+/**
+ * @generated from message guardian.v1.UpdateConditionResponse
+ */
+export type UpdateConditionResponse = Message<"guardian.v1.UpdateConditionResponse"> & {
+  /**
+   * @generated from field: guardian.v1.Condition condition = 1;
+   */
+  condition?: Condition;
+};
+
+/**
+ * Describes the message guardian.v1.UpdateConditionResponse.
+ * Use `create(UpdateConditionResponseSchema)` to create a new message.
+ */
+export const UpdateConditionResponseSchema: GenMessage<UpdateConditionResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 37);
+
+/**
+ * @generated from message guardian.v1.DeleteConditionRequest
+ */
+export type DeleteConditionRequest = Message<"guardian.v1.DeleteConditionRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message guardian.v1.DeleteConditionRequest.
+ * Use `create(DeleteConditionRequestSchema)` to create a new message.
+ */
+export const DeleteConditionRequestSchema: GenMessage<DeleteConditionRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 38);
+
+/**
+ * @generated from message guardian.v1.DeleteConditionResponse
+ */
+export type DeleteConditionResponse = Message<"guardian.v1.DeleteConditionResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.DeleteConditionResponse.
+ * Use `create(DeleteConditionResponseSchema)` to create a new message.
+ */
+export const DeleteConditionResponseSchema: GenMessage<DeleteConditionResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 39);
+
+/**
+ * Exactly one of user_id and service_account_id is required.
+ *
+ * @generated from message guardian.v1.AssignRoleRequest
+ */
+export type AssignRoleRequest = Message<"guardian.v1.AssignRoleRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string role_id = 2;
+   */
+  roleId: string;
+
+  /**
+   * @generated from field: string scope = 3;
+   */
+  scope: string;
+
+  /**
+   * Name of a condition the assignment requires, unconditional if empty.
+   *
+   * @generated from field: string condition = 4;
+   */
+  condition: string;
+
+  /**
+   * @generated from field: string service_account_id = 5;
+   */
+  serviceAccountId: string;
+};
+
+/**
+ * Describes the message guardian.v1.AssignRoleRequest.
+ * Use `create(AssignRoleRequestSchema)` to create a new message.
+ */
+export const AssignRoleRequestSchema: GenMessage<AssignRoleRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 40);
+
+/**
+ * @generated from message guardian.v1.AssignRoleResponse
+ */
+export type AssignRoleResponse = Message<"guardian.v1.AssignRoleResponse"> & {
+  /**
+   * @generated from field: guardian.v1.RoleAssignment assignment = 1;
+   */
+  assignment?: RoleAssignment;
+};
+
+/**
+ * Describes the message guardian.v1.AssignRoleResponse.
+ * Use `create(AssignRoleResponseSchema)` to create a new message.
+ */
+export const AssignRoleResponseSchema: GenMessage<AssignRoleResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 41);
+
+/**
+ * Exactly one of user_id and service_account_id is required.
+ *
+ * @generated from message guardian.v1.UnassignRoleRequest
+ */
+export type UnassignRoleRequest = Message<"guardian.v1.UnassignRoleRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string role_id = 2;
+   */
+  roleId: string;
+
+  /**
+   * @generated from field: string scope = 3;
+   */
+  scope: string;
+
+  /**
+   * @generated from field: string service_account_id = 4;
+   */
+  serviceAccountId: string;
+};
+
+/**
+ * Describes the message guardian.v1.UnassignRoleRequest.
+ * Use `create(UnassignRoleRequestSchema)` to create a new message.
+ */
+export const UnassignRoleRequestSchema: GenMessage<UnassignRoleRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 42);
+
+/**
+ * @generated from message guardian.v1.UnassignRoleResponse
+ */
+export type UnassignRoleResponse = Message<"guardian.v1.UnassignRoleResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.UnassignRoleResponse.
+ * Use `create(UnassignRoleResponseSchema)` to create a new message.
+ */
+export const UnassignRoleResponseSchema: GenMessage<UnassignRoleResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 43);
+
+/**
+ * Exactly one of user_id and service_account_id is required.
+ *
+ * @generated from message guardian.v1.ListRoleAssignmentsRequest
+ */
+export type ListRoleAssignmentsRequest = Message<"guardian.v1.ListRoleAssignmentsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string service_account_id = 2;
+   */
+  serviceAccountId: string;
+};
+
+/**
+ * Describes the message guardian.v1.ListRoleAssignmentsRequest.
+ * Use `create(ListRoleAssignmentsRequestSchema)` to create a new message.
+ */
+export const ListRoleAssignmentsRequestSchema: GenMessage<ListRoleAssignmentsRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 44);
+
+/**
+ * @generated from message guardian.v1.ListRoleAssignmentsResponse
+ */
+export type ListRoleAssignmentsResponse = Message<"guardian.v1.ListRoleAssignmentsResponse"> & {
+  /**
+   * @generated from field: repeated guardian.v1.RoleAssignment assignments = 1;
+   */
+  assignments: RoleAssignment[];
+};
+
+/**
+ * Describes the message guardian.v1.ListRoleAssignmentsResponse.
+ * Use `create(ListRoleAssignmentsResponseSchema)` to create a new message.
+ */
+export const ListRoleAssignmentsResponseSchema: GenMessage<ListRoleAssignmentsResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 45);
+
+/**
+ * @generated from message guardian.v1.CheckRequest
+ */
+export type CheckRequest = Message<"guardian.v1.CheckRequest"> & {
+  /**
+   * User or service account to check, the caller if empty.
+   *
+   * @generated from field: string subject = 1;
+   */
+  subject: string;
+
+  /**
+   * @generated from field: string permission = 2;
+   */
+  permission: string;
+
+  /**
+   * @generated from field: string scope = 3;
+   */
+  scope: string;
+
+  /**
+   * @generated from field: guardian.v1.AttributeContext context = 4;
+   */
+  context?: AttributeContext;
+
+  /**
+   * Returns the evaluation tree of the check. Explained checks bypass caches.
+   *
+   * @generated from field: bool explain = 5;
+   */
+  explain: boolean;
+};
+
+/**
+ * Describes the message guardian.v1.CheckRequest.
+ * Use `create(CheckRequestSchema)` to create a new message.
+ */
+export const CheckRequestSchema: GenMessage<CheckRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 46);
+
+/**
+ * @generated from message guardian.v1.CheckResponse
+ */
+export type CheckResponse = Message<"guardian.v1.CheckResponse"> & {
+  /**
+   * @generated from field: bool allowed = 1;
+   */
+  allowed: boolean;
+
+  /**
+   * The grant which allowed the check like `role:editor@<scope>`, empty if denied.
+   *
+   * @generated from field: string policy = 2;
+   */
+  policy: string;
+
+  /**
+   * Conditions which held for the policy to apply.
+   *
+   * @generated from field: repeated string conditions = 3;
+   */
+  conditions: string[];
+
+  /**
+   * The evaluation tree if the check was explained.
+   *
+   * @generated from field: guardian.v1.ExplainNode explanation = 4;
+   */
+  explanation?: ExplainNode;
+};
+
+/**
+ * Describes the message guardian.v1.CheckResponse.
+ * Use `create(CheckResponseSchema)` to create a new message.
+ */
+export const CheckResponseSchema: GenMessage<CheckResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 47);
+
+/**
+ * @generated from message guardian.v1.ListEffectivePermissionsRequest
+ */
+export type ListEffectivePermissionsRequest = Message<"guardian.v1.ListEffectivePermissionsRequest"> & {
+  /**
+   * User or service account to list the permissions of, the caller if empty.
+   *
+   * @generated from field: string subject = 1;
+   */
+  subject: string;
+
+  /**
+   * @generated from field: string scope = 2;
+   */
+  scope: string;
+
+  /**
+   * @generated from field: guardian.v1.AttributeContext context = 3;
+   */
+  context?: AttributeContext;
+};
+
+/**
+ * Describes the message guardian.v1.ListEffectivePermissionsRequest.
+ * Use `create(ListEffectivePermissionsRequestSchema)` to create a new message.
+ */
+export const ListEffectivePermissionsRequestSchema: GenMessage<ListEffectivePermissionsRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 48);
+
+/**
+ * @generated from message guardian.v1.ListEffectivePermissionsResponse
+ */
+export type ListEffectivePermissionsResponse = Message<"guardian.v1.ListEffectivePermissionsResponse"> & {
+  /**
+   * @generated from field: repeated string permissions = 1;
+   */
+  permissions: string[];
+};
+
+/**
+ * Describes the message guardian.v1.ListEffectivePermissionsResponse.
+ * Use `create(ListEffectivePermissionsResponseSchema)` to create a new message.
+ */
+export const ListEffectivePermissionsResponseSchema: GenMessage<ListEffectivePermissionsResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_authz, 49);
+
+/**
+ * ExplainNodeKind is the kind of an ExplainNode.
+ *
+ * @generated from enum guardian.v1.ExplainNodeKind
+ */
+export enum ExplainNodeKind {
+  /**
+   * @generated from enum value: EXPLAIN_NODE_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * A checked permission, the root of role-based explanations.
+   *
+   * @generated from enum value: EXPLAIN_NODE_KIND_PERMISSION = 1;
+   */
+  PERMISSION = 1,
+
+  /**
+   * A role assignment of the subject, named like a policy.
+   *
+   * @generated from enum value: EXPLAIN_NODE_KIND_ASSIGNMENT = 2;
+   */
+  ASSIGNMENT = 2,
+
+  /**
+   * A role reached through an assignment or by inheritance.
+   *
+   * @generated from enum value: EXPLAIN_NODE_KIND_ROLE = 3;
+   */
+  ROLE = 3,
+
+  /**
+   * A checked relation of an object like `document:readme#viewer`.
+   *
+   * @generated from enum value: EXPLAIN_NODE_KIND_RELATION = 4;
+   */
+  RELATION = 4,
+
+  /**
+   * Allowed if any child is.
+   *
+   * @generated from enum value: EXPLAIN_NODE_KIND_UNION = 5;
+   */
+  UNION = 5,
+
+  /**
+   * Allowed if all children are.
+   *
+   * @generated from enum value: EXPLAIN_NODE_KIND_INTERSECTION = 6;
+   */
+  INTERSECTION = 6,
+
+  /**
+   * Allowed if the first child is and the second is not.
+   *
+   * @generated from enum value: EXPLAIN_NODE_KIND_EXCLUSION = 7;
+   */
+  EXCLUSION = 7,
+
+  /**
+   * A relation tuple which was looked up or followed.
+   *
+   * @generated from enum value: EXPLAIN_NODE_KIND_TUPLE = 8;
+   */
+  TUPLE = 8,
+
+  /**
+   * A condition which was evaluated, with its expression as detail.
+   *
+   * @generated from enum value: EXPLAIN_NODE_KIND_CONDITION = 9;
+   */
+  CONDITION = 9,
+}
+
+/**
+ * Describes the enum guardian.v1.ExplainNodeKind.
+ */
+export const ExplainNodeKindSchema: GenEnum<ExplainNodeKind> = /*@__PURE__*/
+  enumDesc(file_guardian_v1_authz, 0);
+
+/**
+ * AuthzService manages role-based access control and checks permissions. Methods expect the access token in the
+ * `Authorization: Bearer` header. Managing permissions, roles and assignments requires the global
+ * `guardian.authz.manage` permission.
+ *
+ * Scopes are organization ids. An empty scope is the global scope, and global role assignments apply in every
+ * organization.
+ *
+ * Role assignments can require a condition, a CEL expression over the attributes of a check, which is evaluated on
+ * every check. Managing conditions requires the `guardian.authz.manage` permission as well.
+ *
+ * @generated from service guardian.v1.AuthzService
+ */
+export const AuthzService: GenService<{
+  /**
+   * CreatePermission creates a permission. Names are lowercase dot separated segments like `documents.read`.
+   *
+   * @generated from rpc guardian.v1.AuthzService.CreatePermission
+   */
+  createPermission: {
+    methodKind: "unary";
+    input: typeof CreatePermissionRequestSchema;
+    output: typeof CreatePermissionResponseSchema;
+  },
+  /**
+   * ListPermissions lists all permissions ordered by name.
+   *
+   * @generated from rpc guardian.v1.AuthzService.ListPermissions
+   */
+  listPermissions: {
+    methodKind: "unary";
+    input: typeof ListPermissionsRequestSchema;
+    output: typeof ListPermissionsResponseSchema;
+  },
+  /**
+   * DeletePermission deletes a permission and revokes it from all roles.
+   *
+   * @generated from rpc guardian.v1.AuthzService.DeletePermission
+   */
+  deletePermission: {
+    methodKind: "unary";
+    input: typeof DeletePermissionRequestSchema;
+    output: typeof DeletePermissionResponseSchema;
+  },
+  /**
+   * CreateRole creates a role without permissions.
+   *
+   * @generated from rpc guardian.v1.AuthzService.CreateRole
+   */
+  createRole: {
+    methodKind: "unary";
+    input: typeof CreateRoleRequestSchema;
+    output: typeof CreateRoleResponseSchema;
+  },
+  /**
+   * GetRole returns a role by id.
+   *
+   * @generated from rpc guardian.v1.AuthzService.GetRole
+   */
+  getRole: {
+    methodKind: "unary";
+    input: typeof GetRoleRequestSchema;
+    output: typeof GetRoleResponseSchema;
+  },
+  /**
+   * ListRoles lists all roles ordered by name.
+   *
+   * @generated from rpc guardian.v1.AuthzService.ListRoles
+   */
+  listRoles: {
+    methodKind: "unary";
+    input: typeof ListRolesRequestSchema;
+    output: typeof ListRolesResponseSchema;
+  },
+  /**
+   * UpdateRole updates the fields of a role which are set in the request.
+   *
+   * @generated from rpc guardian.v1.AuthzService.UpdateRole
+   */
+  updateRole: {
+    methodKind: "unary";
+    input: typeof UpdateRoleRequestSchema;
+    output: typeof UpdateRoleResponseSchema;
+  },
+  /**
+   * DeleteRole deletes a role along with its assignments.
+   *
+   * @generated from rpc guardian.v1.AuthzService.DeleteRole
+   */
+  deleteRole: {
+    methodKind: "unary";
+    input: typeof DeleteRoleRequestSchema;
+    output: typeof DeleteRoleResponseSchema;
+  },
+  /**
+   * GrantPermission grants a permission to a role.
+   *
+   * @generated from rpc guardian.v1.AuthzService.GrantPermission
+   */
+  grantPermission: {
+    methodKind: "unary";
+    input: typeof GrantPermissionRequestSchema;
+    output: typeof GrantPermissionResponseSchema;
+  },
+  /**
+   * RevokePermission revokes a permission from a role.
+   *
+   * @generated from rpc guardian.v1.AuthzService.RevokePermission
+   */
+  revokePermission: {
+    methodKind: "unary";
+    input: typeof RevokePermissionRequestSchema;
+    output: typeof RevokePermissionResponseSchema;
+  },
+  /**
+   * AddRoleParent lets a role inherit all permissions of a parent role. Fails with INVALID_ARGUMENT if the parent
+   * inherits from the role, as role inheritance must not contain cycles.
+   *
+   * @generated from rpc guardian.v1.AuthzService.AddRoleParent
+   */
+  addRoleParent: {
+    methodKind: "unary";
+    input: typeof AddRoleParentRequestSchema;
+    output: typeof AddRoleParentResponseSchema;
+  },
+  /**
+   * RemoveRoleParent removes a parent of a role.
+   *
+   * @generated from rpc guardian.v1.AuthzService.RemoveRoleParent
+   */
+  removeRoleParent: {
+    methodKind: "unary";
+    input: typeof RemoveRoleParentRequestSchema;
+    output: typeof RemoveRoleParentResponseSchema;
+  },
+  /**
+   * CreateCondition creates a condition. Fails with INVALID_ARGUMENT if the expression does not compile or does not
+   * evaluate to a bool.
+   *
+   * @generated from rpc guardian.v1.AuthzService.CreateCondition
+   */
+  createCondition: {
+    methodKind: "unary";
+    input: typeof CreateConditionRequestSchema;
+    output: typeof CreateConditionResponseSchema;
+  },
+  /**
+   * GetCondition returns a condition by name.
+   *
+   * @generated from rpc guardian.v1.AuthzService.GetCondition
+   */
+  getCondition: {
+    methodKind: "unary";
+    input: typeof GetConditionRequestSchema;
+    output: typeof GetConditionResponseSchema;
+  },
+  /**
+   * ListConditions lists all conditions ordered by name.
+   *
+   * @generated from rpc guardian.v1.AuthzService.ListConditions
+   */
+  listConditions: {
+    methodKind: "unary";
+    input: typeof ListConditionsRequestSchema;
+    output: typeof ListConditionsResponseSchema;
+  },
+  /**
+   * UpdateCondition updates the fields of a condition which are set in the request. A new expression applies to all
+   * grants requiring the condition.
+   *
+   * @generated from rpc guardian.v1.AuthzService.UpdateCondition
+   */
+  updateCondition: {
+    methodKind: "unary";
+    input: typeof UpdateConditionRequestSchema;
+    output: typeof UpdateConditionResponseSchema;
+  },
+  /**
+   * DeleteCondition deletes a condition. Fails with INVALID_ARGUMENT while grants require it.
+   *
+   * @generated from rpc guardian.v1.AuthzService.DeleteCondition
+   */
+  deleteCondition: {
+    methodKind: "unary";
+    input: typeof DeleteConditionRequestSchema;
+    output: typeof DeleteConditionResponseSchema;
+  },
+  /**
+   * AssignRole assigns a role to a user or a service account in a scope.
+   *
+   * @generated from rpc guardian.v1.AuthzService.AssignRole
+   */
+  assignRole: {
+    methodKind: "unary";
+    input: typeof AssignRoleRequestSchema;
+    output: typeof AssignRoleResponseSchema;
+  },
+  /**
+   * UnassignRole removes a role assignment.
+   *
+   * @generated from rpc guardian.v1.AuthzService.UnassignRole
+   */
+  unassignRole: {
+    methodKind: "unary";
+    input: typeof UnassignRoleRequestSchema;
+    output: typeof UnassignRoleResponseSchema;
+  },
+  /**
+   * ListRoleAssignments lists the role assignments of a user or a service account in all scopes.
+   *
+   * @generated from rpc guardian.v1.AuthzService.ListRoleAssignments
+   */
+  listRoleAssignments: {
+    methodKind: "unary";
+    input: typeof ListRoleAssignmentsRequestSchema;
+    output: typeof ListRoleAssignmentsResponseSchema;
+  },
+  /**
+   * Check reports whether a user has a permission in a scope and which role assignment granted it. Callers can check
+   * themselves, checking other users requires the `guardian.authz.check` permission in the scope. Explaining a check
+   * requires the `guardian.authz.check` permission even for the caller.
+   *
+   * @generated from rpc guardian.v1.AuthzService.Check
+   */
+  check: {
+    methodKind: "unary";
+    input: typeof CheckRequestSchema;
+    output: typeof CheckResponseSchema;
+  },
+  /**
+   * ListEffectivePermissions lists all permissions of a user in a scope, including inherited ones. It is authorized
+   * like Check.
+   *
+   * @generated from rpc guardian.v1.AuthzService.ListEffectivePermissions
+   */
+  listEffectivePermissions: {
+    methodKind: "unary";
+    input: typeof ListEffectivePermissionsRequestSchema;
+    output: typeof ListEffectivePermissionsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_guardian_v1_authz, 0);
+
@@ -0,0 +1,268 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts"
+// @generated from file guardian/v1/mfa.proto (package guardian.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file guardian/v1/mfa.proto.
+ */
+export const file_guardian_v1_mfa: GenFile = /*@__PURE__*/
+  fileDesc("ChVndWFyZGlhbi92MS9tZmEucHJvdG8SC2d1YXJkaWFuLnYxIhUKE0dldE1GQVN0YXR1c1JlcXVlc3QiTgoUR2V0TUZBU3RhdHVzUmVzcG9uc2USFAoMdG90cF9lbmFibGVkGAEgASgIEiAKGHJlY292ZXJ5X2NvZGVzX3JlbWFpbmluZxgCIAEoBSITChFFbnJvbGxUT1RQUmVxdWVzdCJCChJFbnJvbGxUT1RQUmVzcG9uc2USDgoGc2VjcmV0GAEgASgJEgsKA3VyaRgCIAEoCRIPCgdxcl9jb2RlGAMgASgMIiIKEkNvbmZpcm1UT1RQUmVxdWVzdBIMCgRjb2RlGAEgASgJIi0KE0NvbmZpcm1UT1RQUmVzcG9uc2USFgoOcmVjb3ZlcnlfY29kZXMYASADKAkiSgoSRGlzYWJsZVRPVFBSZXF1ZXN0EhMKCXRvdHBfY29kZRgBIAEoCUgAEhcKDXJlY292ZXJ5X2NvZGUYAiABKAlIAEIGCgRjb2RlIhUKE0Rpc2FibGVUT1RQUmVzcG9uc2UiVgoeUmVnZW5lcmF0ZVJlY292ZXJ5Q29kZXNSZXF1ZXN0EhMKCXRvdHBfY29kZRgBIAEoCUgAEhcKDXJlY292ZXJ5X2NvZGUYAiABKAlIAEIGCgRjb2RlIjkKH1JlZ2VuZXJhdGVSZWNvdmVyeUNvZGVzUmVzcG9uc2USFgoOcmVjb3ZlcnlfY29kZXMYASADKAkyygMKCk1GQVNlcnZpY2USUwoMR2V0TUZBU3RhdHVzEiAuZ3VhcmRpYW4udjEuR2V0TUZBU3RhdHVzUmVxdWVzdBohLmd1YXJkaWFuLnYxLkdldE1GQVN0YXR1c1Jlc3BvbnNlEk0KCkVucm9sbFRPVFASHi5ndWFyZGlhbi52MS5FbnJvbGxUT1RQUmVxdWVzdBofLmd1YXJkaWFuLnYxLkVucm9sbFRPVFBSZXNwb25zZRJQCgtDb25maXJtVE9UUBIfLmd1YXJkaWFuLnYxLkNvbmZpcm1UT1RQUmVxdWVzdBogLmd1YXJkaWFuLnYxLkNvbmZpcm1UT1RQUmVzcG9uc2USUAoLRGlzYWJsZVRPVFASHy5ndWFyZGlhbi52MS5EaXNhYmxlVE9UUFJlcXVlc3QaIC5ndWFyZGlhbi52MS5EaXNhYmxlVE9UUFJlc3BvbnNlEnQKF1JlZ2VuZXJhdGVSZWNvdmVyeUNvZGVzEisuZ3VhcmRpYW4udjEuUmVnZW5lcmF0ZVJlY292ZXJ5Q29kZXNSZXF1ZXN0GiwuZ3VhcmRpYW4udjEuUmVnZW5lcmF0ZVJlY292ZXJ5Q29kZXNSZXNwb25zZUKnAQoPY29tLmd1YXJkaWFuLnYxQghNZmFQcm90b1ABWj1naXRodWIuY29tL2dvcGhlcm8vZ3VhcmRpYW4vY29yZS9wcm90by9ndWFyZGlhbi92MTtndWFyZGlhbnYxogIDR1ZYqgILR3VhcmRpYW4uVjHKAgtHdWFyZGlhblxWMeICF0d1YXJkaWFuXFYxXEdQQk1ldGFkYXRh6gIMR3VhcmRpYW46OlYxYgZwcm90bzM");
+
+/**
+ * @generated from message guardian.v1.GetMFAStatusRequest
+ */
+export type GetMFAStatusRequest = Message<"guardian.v1.GetMFAStatusRequest"> & {
+};
+
+/**
+ * Describes the message guardian.v1.GetMFAStatusRequest.
+ * Use `create(GetMFAStatusRequestSchema)` to create a new message.
+ */
+export const GetMFAStatusRequestSchema: GenMessage<GetMFAStatusRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_mfa, 0);
+
+/**
+ * @generated from message guardian.v1.GetMFAStatusResponse
+ */
+export type GetMFAStatusResponse = Message<"guardian.v1.GetMFAStatusResponse"> & {
+  /**
+   * @generated from field: bool totp_enabled = 1;
+   */
+  totpEnabled: boolean;
+
+  /**
+   * @generated from field: int32 recovery_codes_remaining = 2;
+   */
+  recoveryCodesRemaining: number;
+};
+
+/**
+ * Describes the message guardian.v1.GetMFAStatusResponse.
+ * Use `create(GetMFAStatusResponseSchema)` to create a new message.
+ */
+export const GetMFAStatusResponseSchema: GenMessage<GetMFAStatusResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_mfa, 1);
+
+/**
+ * @generated from message guardian.v1.EnrollTOTPRequest
+ */
+export type EnrollTOTPRequest = Message<"guardian.v1.EnrollTOTPRequest"> & {
+};
+
+/**
+ * Describes the message guardian.v1.EnrollTOTPRequest.
+ * Use `create(EnrollTOTPRequestSchema)` to create a new message.
+ */
+export const EnrollTOTPRequestSchema: GenMessage<EnrollTOTPRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_mfa, 2);
+
+/**
+ * @generated from message guardian.v1.EnrollTOTPResponse
+ */
+export type EnrollTOTPResponse = Message<"guardian.v1.EnrollTOTPResponse"> & {
+  /**
+   * Base32 encoded secret for manual entry.
+   *
+   * @generated from field: string secret = 1;
+   */
+  secret: string;
+
+  /**
+   * The otpauth:// URI of the secret.
+   *
+   * @generated from field: string uri = 2;
+   */
+  uri: string;
+
+  /**
+   * PNG encoded QR code of uri.
+   *
+   * @generated from field: bytes qr_code = 3;
+   */
+  qrCode: Uint8Array;
+};
+
+/**
+ * Describes the message guardian.v1.EnrollTOTPResponse.
+ * Use `create(EnrollTOTPResponseSchema)` to create a new message.
+ */
+export const EnrollTOTPResponseSchema: GenMessage<EnrollTOTPResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_mfa, 3);
+
+/**
+ * @generated from message guardian.v1.ConfirmTOTPRequest
+ */
+export type ConfirmTOTPRequest = Message<"guardian.v1.ConfirmTOTPRequest"> & {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message guardian.v1.ConfirmTOTPRequest.
+ * Use `create(ConfirmTOTPRequestSchema)` to create a new message.
+ */
+export const ConfirmTOTPRequestSchema: GenMessage<ConfirmTOTPRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_mfa, 4);
+
+/**
+ * @generated from message guardian.v1.ConfirmTOTPResponse
+ */
+export type ConfirmTOTPResponse = Message<"guardian.v1.ConfirmTOTPResponse"> & {
+  /**
+   * Shown only once, only hashes are stored.
+   *
+   * @generated from field: repeated string recovery_codes = 1;
+   */
+  recoveryCodes: string[];
+};
+
+/**
+ * Describes the message guardian.v1.ConfirmTOTPResponse.
+ * Use `create(ConfirmTOTPResponseSchema)` to create a new message.
+ */
+export const ConfirmTOTPResponseSchema: GenMessage<ConfirmTOTPResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_mfa, 5);
+
+/**
+ * @generated from message guardian.v1.DisableTOTPRequest
+ */
+export type DisableTOTPRequest = Message<"guardian.v1.DisableTOTPRequest"> & {
+  /**
+   * @generated from field: string totp_code = 1;
+   */
+  totpCode: string;
+
+  /**
+   * @generated from field: string recovery_code = 2;
+   */
+  recoveryCode: string;
+};
+
+/**
+ * Describes the message guardian.v1.DisableTOTPRequest.
+ * Use `create(DisableTOTPRequestSchema)` to create a new message.
+ */
+export const DisableTOTPRequestSchema: GenMessage<DisableTOTPRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_mfa, 6);
+
+/**
+ * @generated from message guardian.v1.DisableTOTPResponse
+ */
+export type DisableTOTPResponse = Message<"guardian.v1.DisableTOTPResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.DisableTOTPResponse.
+ * Use `create(DisableTOTPResponseSchema)` to create a new message.
+ */
+export const DisableTOTPResponseSchema: GenMessage<DisableTOTPResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_mfa, 7);
+
+/**
+ * @generated from message guardian.v1.RegenerateRecoveryCodesRequest
+ */
+export type RegenerateRecoveryCodesRequest = Message<"guardian.v1.RegenerateRecoveryCodesRequest"> & {
+  /**
+   * @generated from field: string totp_code = 1;
+   */
+  totpCode: string;
+
+  /**
+   * @generated from field: string recovery_code = 2;
+   */
+  recoveryCode: string;
+};
+
+/**
+ * Describes the message guardian.v1.RegenerateRecoveryCodesRequest.
+ * Use `create(RegenerateRecoveryCodesRequestSchema)` to create a new message.
+ */
+export const RegenerateRecoveryCodesRequestSchema: GenMessage<RegenerateRecoveryCodesRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_mfa, 8);
+
+/**
+ * @generated from message guardian.v1.RegenerateRecoveryCodesResponse
+ */
+export type RegenerateRecoveryCodesResponse = Message<"guardian.v1.RegenerateRecoveryCodesResponse"> & {
+  /**
+   * Shown only once, only hashes are stored.
+   *
+   * @generated from field: repeated string recovery_codes = 1;
+   */
+  recoveryCodes: string[];
+};
+
+/**
+ * Describes the message guardian.v1.RegenerateRecoveryCodesResponse.
+ * Use `create(RegenerateRecoveryCodesResponseSchema)` to create a new message.
+ */
+export const RegenerateRecoveryCodesResponseSchema: GenMessage<RegenerateRecoveryCodesResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_mfa, 9);
+
+/**
+ * MFAService manages second factors of the caller. Methods expect the access token in the `Authorization: Bearer`
+ * header.
+ *
+ * @generated from service guardian.v1.MFAService
+ */
+export const MFAService: GenService<{
+  /**
+   * GetMFAStatus returns the second factors enrolled by the caller.
+   *
+   * @generated from rpc guardian.v1.MFAService.GetMFAStatus
+   */
+  getMFAStatus: {
+    methodKind: "unary";
+    input: typeof GetMFAStatusRequestSchema;
+    output: typeof GetMFAStatusResponseSchema;
+  },
+  /**
+   * EnrollTOTP starts enrollment of a TOTP factor, replacing a pending enrollment. The factor is not used until it is
+   * confirmed with ConfirmTOTP.
+   *
+   * @generated from rpc guardian.v1.MFAService.EnrollTOTP
+   */
+  enrollTOTP: {
+    methodKind: "unary";
+    input: typeof EnrollTOTPRequestSchema;
+    output: typeof EnrollTOTPResponseSchema;
+  },
+  /**
+   * ConfirmTOTP confirms the pending TOTP factor with a code of it and returns new recovery codes.
+   *
+   * @generated from rpc guardian.v1.MFAService.ConfirmTOTP
+   */
+  confirmTOTP: {
+    methodKind: "unary";
+    input: typeof ConfirmTOTPRequestSchema;
+    output: typeof ConfirmTOTPResponseSchema;
+  },
+  /**
+   * DisableTOTP removes the TOTP factor and all recovery codes.
+   *
+   * @generated from rpc guardian.v1.MFAService.DisableTOTP
+   */
+  disableTOTP: {
+    methodKind: "unary";
+    input: typeof DisableTOTPRequestSchema;
+    output: typeof DisableTOTPResponseSchema;
+  },
+  /**
+   * RegenerateRecoveryCodes replaces all recovery codes with new ones.
+   *
+   * @generated from rpc guardian.v1.MFAService.RegenerateRecoveryCodes
+   */
+  regenerateRecoveryCodes: {
+    methodKind: "unary";
+    input: typeof RegenerateRecoveryCodesRequestSchema;
+    output: typeof RegenerateRecoveryCodesResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_guardian_v1_mfa, 0);
+
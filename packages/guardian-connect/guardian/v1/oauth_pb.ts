@@ -0,0 +1,785 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts"
+// @generated from file guardian/v1/oauth.proto (package guardian.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Duration, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_duration, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file guardian/v1/oauth.proto.
+ */
+export const file_guardian_v1_oauth: GenFile = /*@__PURE__*/
+  fileDesc("ChdndWFyZGlhbi92MS9vYXV0aC5wcm90bxILZ3VhcmRpYW4udjEiggIKC09BdXRoQ2xpZW50EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSKgoEdHlwZRgDIAEoDjIcLmd1YXJkaWFuLnYxLk9BdXRoQ2xpZW50VHlwZRIVCg1yZWRpcmVjdF91cmlzGAQgAygJEhMKC2dyYW50X3R5cGVzGAUgAygJEg4KBnNjb3BlcxgGIAMoCRIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIRCglhdWRpZW5jZXMYCSADKAki3AEKFFBlbmRpbmdBdXRob3JpemF0aW9uEgoKAmlkGAEgASgJEhEKCWNsaWVudF9pZBgCIAEoCRITCgtjbGllbnRfbmFtZRgDIAEoCRIUCgxyZWRpcmVjdF91cmkYBCABKAkSDgoGc2NvcGVzGAUgAygJEi4KCmV4cGlyZXNfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg4KBnByb21wdBgHIAMoCRIqCgdtYXhfYWdlGAggASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uIpcBChpQZW5kaW5nRGV2aWNlQXV0aG9yaXphdGlvbhIRCgl1c2VyX2NvZGUYASABKAkSEQoJY2xpZW50X2lkGAIgASgJEhMKC2NsaWVudF9uYW1lGAMgASgJEg4KBnNjb3BlcxgEIAMoCRIuCgpleHBpcmVzX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKjAQoYQ3JlYXRlT0F1dGhDbGllbnRSZXF1ZXN0EgwKBG5hbWUYASABKAkSKgoEdHlwZRgCIAEoDjIcLmd1YXJkaWFuLnYxLk9BdXRoQ2xpZW50VHlwZRIVCg1yZWRpcmVjdF91cmlzGAMgAygJEhMKC2dyYW50X3R5cGVzGAQgAygJEg4KBnNjb3BlcxgFIAMoCRIRCglhdWRpZW5jZXMYBiADKAkiXAoZQ3JlYXRlT0F1dGhDbGllbnRSZXNwb25zZRIoCgZjbGllbnQYASABKAsyGC5ndWFyZGlhbi52MS5PQXV0aENsaWVudBIVCg1jbGllbnRfc2VjcmV0GAIgASgJIiMKFUdldE9BdXRoQ2xpZW50UmVxdWVzdBIKCgJpZBgBIAEoCSJCChZHZXRPQXV0aENsaWVudFJlc3BvbnNlEigKBmNsaWVudBgBIAEoCzIYLmd1YXJkaWFuLnYxLk9BdXRoQ2xpZW50IhkKF0xpc3RPQXV0aENsaWVudHNSZXF1ZXN0IkUKGExpc3RPQXV0aENsaWVudHNSZXNwb25zZRIpCgdjbGllbnRzGAEgAygLMhguZ3VhcmRpYW4udjEuT0F1dGhDbGllbnQigwEKGFVwZGF0ZU9BdXRoQ2xpZW50UmVxdWVzdBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhUKDXJlZGlyZWN0X3VyaXMYAyADKAkSEwoLZ3JhbnRfdHlwZXMYBCADKAkSDgoGc2NvcGVzGAUgAygJEhEKCWF1ZGllbmNlcxgGIAMoCSJFChlVcGRhdGVPQXV0aENsaWVudFJlc3BvbnNlEigKBmNsaWVudBgBIAEoCzIYLmd1YXJkaWFuLnYxLk9BdXRoQ2xpZW50IiYKGERlbGV0ZU9BdXRoQ2xpZW50UmVxdWVzdBIKCgJpZBgBIAEoCSIbChlEZWxldGVPQXV0aENsaWVudFJlc3BvbnNlIiUKF0dldEF1dGhvcml6YXRpb25SZXF1ZXN0EgoKAmlkGAEgASgJIlQKGEdldEF1dGhvcml6YXRpb25SZXNwb25zZRI4Cg1hdXRob3JpemF0aW9uGAEgASgLMiEuZ3VhcmRpYW4udjEuUGVuZGluZ0F1dGhvcml6YXRpb24iKQobQXBwcm92ZUF1dGhvcml6YXRpb25SZXF1ZXN0EgoKAmlkGAEgASgJIjQKHEFwcHJvdmVBdXRob3JpemF0aW9uUmVzcG9uc2USFAoMcmVkaXJlY3RfdXJsGAEgASgJIjUKGERlbnlBdXRob3JpemF0aW9uUmVxdWVzdBIKCgJpZBgBIAEoCRINCgVlcnJvchgCIAEoCSIxChlEZW55QXV0aG9yaXphdGlvblJlc3BvbnNlEhQKDHJlZGlyZWN0X3VybBgBIAEoCSIyCh1HZXREZXZpY2VBdXRob3JpemF0aW9uUmVxdWVzdBIRCgl1c2VyX2NvZGUYASABKAkiYAoeR2V0RGV2aWNlQXV0aG9yaXphdGlvblJlc3BvbnNlEj4KDWF1dGhvcml6YXRpb24YASABKAsyJy5ndWFyZGlhbi52MS5QZW5kaW5nRGV2aWNlQXV0aG9yaXphdGlvbiI2CiFBcHByb3ZlRGV2aWNlQXV0aG9yaXphdGlvblJlcXVlc3QSEQoJdXNlcl9jb2RlGAEgASgJIiQKIkFwcHJvdmVEZXZpY2VBdXRob3JpemF0aW9uUmVzcG9uc2UiMwoeRGVueURldmljZUF1dGhvcml6YXRpb25SZXF1ZXN0EhEKCXVzZXJfY29kZRgBIAEoCSIhCh9EZW55RGV2aWNlQXV0aG9yaXphdGlvblJlc3BvbnNlKnkKD09BdXRoQ2xpZW50VHlwZRIiCh5PX0FVVEhfQ0xJRU5UX1RZUEVfVU5TUEVDSUZJRUQQABIdChlPX0FVVEhfQ0xJRU5UX1RZUEVfUFVCTElDEAESIwofT19BVVRIX0NMSUVOVF9UWVBFX0NPTkZJREVOVElBTBACMpAJCgxPQXV0aFNlcnZpY2USYgoRQ3JlYXRlT0F1dGhDbGllbnQSJS5ndWFyZGlhbi52MS5DcmVhdGVPQXV0aENsaWVudFJlcXVlc3QaJi5ndWFyZGlhbi52MS5DcmVhdGVPQXV0aENsaWVudFJlc3BvbnNlElkKDkdldE9BdXRoQ2xpZW50EiIuZ3VhcmRpYW4udjEuR2V0T0F1dGhDbGllbnRSZXF1ZXN0GiMuZ3VhcmRpYW4udjEuR2V0T0F1dGhDbGllbnRSZXNwb25zZRJfChBMaXN0T0F1dGhDbGllbnRzEiQuZ3VhcmRpYW4udjEuTGlzdE9BdXRoQ2xpZW50c1JlcXVlc3QaJS5ndWFyZGlhbi52MS5MaXN0T0F1dGhDbGllbnRzUmVzcG9uc2USYgoRVXBkYXRlT0F1dGhDbGllbnQSJS5ndWFyZGlhbi52MS5VcGRhdGVPQXV0aENsaWVudFJlcXVlc3QaJi5ndWFyZGlhbi52MS5VcGRhdGVPQXV0aENsaWVudFJlc3BvbnNlEmIKEURlbGV0ZU9BdXRoQ2xpZW50EiUuZ3VhcmRpYW4udjEuRGVsZXRlT0F1dGhDbGllbnRSZXF1ZXN0GiYuZ3VhcmRpYW4udjEuRGVsZXRlT0F1dGhDbGllbnRSZXNwb25zZRJfChBHZXRBdXRob3JpemF0aW9uEiQuZ3VhcmRpYW4udjEuR2V0QXV0aG9yaXphdGlvblJlcXVlc3QaJS5ndWFyZGlhbi52MS5HZXRBdXRob3JpemF0aW9uUmVzcG9uc2USawoUQXBwcm92ZUF1dGhvcml6YXRpb24SKC5ndWFyZGlhbi52MS5BcHByb3ZlQXV0aG9yaXphdGlvblJlcXVlc3QaKS5ndWFyZGlhbi52MS5BcHByb3ZlQXV0aG9yaXphdGlvblJlc3BvbnNlEmIKEURlbnlBdXRob3JpemF0aW9uEiUuZ3VhcmRpYW4udjEuRGVueUF1dGhvcml6YXRpb25SZXF1ZXN0GiYuZ3VhcmRpYW4udjEuRGVueUF1dGhvcml6YXRpb25SZXNwb25zZRJxChZHZXREZXZpY2VBdXRob3JpemF0aW9uEiouZ3VhcmRpYW4udjEuR2V0RGV2aWNlQXV0aG9yaXphdGlvblJlcXVlc3QaKy5ndWFyZGlhbi52MS5HZXREZXZpY2VBdXRob3JpemF0aW9uUmVzcG9uc2USfQoaQXBwcm92ZURldmljZUF1dGhvcml6YXRpb24SLi5ndWFyZGlhbi52MS5BcHByb3ZlRGV2aWNlQXV0aG9yaXphdGlvblJlcXVlc3QaLy5ndWFyZGlhbi52MS5BcHByb3ZlRGV2aWNlQXV0aG9yaXphdGlvblJlc3BvbnNlEnQKF0RlbnlEZXZpY2VBdXRob3JpemF0aW9uEisuZ3VhcmRpYW4udjEuRGVueURldmljZUF1dGhvcml6YXRpb25SZXF1ZXN0GiwuZ3VhcmRpYW4udjEuRGVueURldmljZUF1dGhvcml6YXRpb25SZXNwb25zZUKpAQoPY29tLmd1YXJkaWFuLnYxQgpPYXV0aFByb3RvUAFaPWdpdGh1Yi5jb20vZ29waGVyby9ndWFyZGlhbi9jb3JlL3Byb3RvL2d1YXJkaWFuL3YxO2d1YXJkaWFudjGiAgNHVliqAgtHdWFyZGlhbi5WMcoCC0d1YXJkaWFuXFYx4gIXR3VhcmRpYW5cVjFcR1BCTWV0YWRhdGHqAgxHdWFyZGlhbjo6VjFiBnByb3RvMw", [file_google_protobuf_duration, file_google_protobuf_timestamp]);
+
+/**
+ * OAuthClient is an application registered to obtain tokens on behalf of users.
+ *
+ * @generated from message guardian.v1.OAuthClient
+ */
+export type OAuthClient = Message<"guardian.v1.OAuthClient"> & {
+  /**
+   * The client_id of the client.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: guardian.v1.OAuthClientType type = 3;
+   */
+  type: OAuthClientType;
+
+  /**
+   * Redirect URIs the client may use, compared by exact string match. Plain http is only allowed on loopback
+   * interfaces and custom schemes have to be reverse domain names.
+   *
+   * @generated from field: repeated string redirect_uris = 4;
+   */
+  redirectUris: string[];
+
+  /**
+   * Grant types the client may use, `authorization_code`, `refresh_token` and
+   * `urn:ietf:params:oauth:grant-type:device_code`. Clients of the authorization code grant need a redirect URI.
+   *
+   * @generated from field: repeated string grant_types = 5;
+   */
+  grantTypes: string[];
+
+  /**
+   * Scopes the client may request, sorted by name.
+   *
+   * @generated from field: repeated string scopes = 6;
+   */
+  scopes: string[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 8;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * Audiences of the resource servers the client acts as, sorted by name. The client may introspect access tokens
+   * issued for any of them.
+   *
+   * @generated from field: repeated string audiences = 9;
+   */
+  audiences: string[];
+};
+
+/**
+ * Describes the message guardian.v1.OAuthClient.
+ * Use `create(OAuthClientSchema)` to create a new message.
+ */
+export const OAuthClientSchema: GenMessage<OAuthClient> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 0);
+
+/**
+ * PendingAuthorization is an authorization request waiting for the user to consent.
+ *
+ * @generated from message guardian.v1.PendingAuthorization
+ */
+export type PendingAuthorization = Message<"guardian.v1.PendingAuthorization"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string client_id = 2;
+   */
+  clientId: string;
+
+  /**
+   * @generated from field: string client_name = 3;
+   */
+  clientName: string;
+
+  /**
+   * @generated from field: string redirect_uri = 4;
+   */
+  redirectUri: string;
+
+  /**
+   * Scopes requested by the client, sorted by name.
+   *
+   * @generated from field: repeated string scopes = 5;
+   */
+  scopes: string[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 6;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * OpenID Connect prompt values of the request, sorted by name. With `none` the request has to be answered without
+   * showing anything to the user, with `login` the user has to sign in again.
+   *
+   * @generated from field: repeated string prompt = 7;
+   */
+  prompt: string[];
+
+  /**
+   * Maximum time since the user last signed in, unset if the client did not limit it.
+   *
+   * @generated from field: google.protobuf.Duration max_age = 8;
+   */
+  maxAge?: Duration;
+};
+
+/**
+ * Describes the message guardian.v1.PendingAuthorization.
+ * Use `create(PendingAuthorizationSchema)` to create a new message.
+ */
+export const PendingAuthorizationSchema: GenMessage<PendingAuthorization> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 1);
+
+/**
+ * PendingDeviceAuthorization is a device authorization waiting for the user to consent.
+ *
+ * @generated from message guardian.v1.PendingDeviceAuthorization
+ */
+export type PendingDeviceAuthorization = Message<"guardian.v1.PendingDeviceAuthorization"> & {
+  /**
+   * The user code formatted for display, like `BCDF-GHJK`.
+   *
+   * @generated from field: string user_code = 1;
+   */
+  userCode: string;
+
+  /**
+   * @generated from field: string client_id = 2;
+   */
+  clientId: string;
+
+  /**
+   * @generated from field: string client_name = 3;
+   */
+  clientName: string;
+
+  /**
+   * Scopes requested by the client, sorted by name.
+   *
+   * @generated from field: repeated string scopes = 4;
+   */
+  scopes: string[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 5;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message guardian.v1.PendingDeviceAuthorization.
+ * Use `create(PendingDeviceAuthorizationSchema)` to create a new message.
+ */
+export const PendingDeviceAuthorizationSchema: GenMessage<PendingDeviceAuthorization> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 2);
+
+/**
+ * @generated from message guardian.v1.CreateOAuthClientRequest
+ */
+export type CreateOAuthClientRequest = Message<"guardian.v1.CreateOAuthClientRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: guardian.v1.OAuthClientType type = 2;
+   */
+  type: OAuthClientType;
+
+  /**
+   * @generated from field: repeated string redirect_uris = 3;
+   */
+  redirectUris: string[];
+
+  /**
+   * @generated from field: repeated string grant_types = 4;
+   */
+  grantTypes: string[];
+
+  /**
+   * @generated from field: repeated string scopes = 5;
+   */
+  scopes: string[];
+
+  /**
+   * @generated from field: repeated string audiences = 6;
+   */
+  audiences: string[];
+};
+
+/**
+ * Describes the message guardian.v1.CreateOAuthClientRequest.
+ * Use `create(CreateOAuthClientRequestSchema)` to create a new message.
+ */
+export const CreateOAuthClientRequestSchema: GenMessage<CreateOAuthClientRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 3);
+
+/**
+ * @generated from message guardian.v1.CreateOAuthClientResponse
+ */
+export type CreateOAuthClientResponse = Message<"guardian.v1.CreateOAuthClientResponse"> & {
+  /**
+   * @generated from field: guardian.v1.OAuthClient client = 1;
+   */
+  client?: OAuthClient;
+
+  /**
+   * Empty for public clients.
+   *
+   * @generated from field: string client_secret = 2;
+   */
+  clientSecret: string;
+};
+
+/**
+ * Describes the message guardian.v1.CreateOAuthClientResponse.
+ * Use `create(CreateOAuthClientResponseSchema)` to create a new message.
+ */
+export const CreateOAuthClientResponseSchema: GenMessage<CreateOAuthClientResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 4);
+
+/**
+ * @generated from message guardian.v1.GetOAuthClientRequest
+ */
+export type GetOAuthClientRequest = Message<"guardian.v1.GetOAuthClientRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message guardian.v1.GetOAuthClientRequest.
+ * Use `create(GetOAuthClientRequestSchema)` to create a new message.
+ */
+export const GetOAuthClientRequestSchema: GenMessage<GetOAuthClientRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 5);
+
+/**
+ * @generated from message guardian.v1.GetOAuthClientResponse
+ */
+export type GetOAuthClientResponse = Message<"guardian.v1.GetOAuthClientResponse"> & {
+  /**
+   * @generated from field: guardian.v1.OAuthClient client = 1;
+   */
+  client?: OAuthClient;
+};
+
+/**
+ * Describes the message guardian.v1.GetOAuthClientResponse.
+ * Use `create(GetOAuthClientResponseSchema)` to create a new message.
+ */
+export const GetOAuthClientResponseSchema: GenMessage<GetOAuthClientResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 6);
+
+/**
+ * @generated from message guardian.v1.ListOAuthClientsRequest
+ */
+export type ListOAuthClientsRequest = Message<"guardian.v1.ListOAuthClientsRequest"> & {
+};
+
+/**
+ * Describes the message guardian.v1.ListOAuthClientsRequest.
+ * Use `create(ListOAuthClientsRequestSchema)` to create a new message.
+ */
+export const ListOAuthClientsRequestSchema: GenMessage<ListOAuthClientsRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 7);
+
+/**
+ * @generated from message guardian.v1.ListOAuthClientsResponse
+ */
+export type ListOAuthClientsResponse = Message<"guardian.v1.ListOAuthClientsResponse"> & {
+  /**
+   * @generated from field: repeated guardian.v1.OAuthClient clients = 1;
+   */
+  clients: OAuthClient[];
+};
+
+/**
+ * Describes the message guardian.v1.ListOAuthClientsResponse.
+ * Use `create(ListOAuthClientsResponseSchema)` to create a new message.
+ */
+export const ListOAuthClientsResponseSchema: GenMessage<ListOAuthClientsResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 8);
+
+/**
+ * @generated from message guardian.v1.UpdateOAuthClientRequest
+ */
+export type UpdateOAuthClientRequest = Message<"guardian.v1.UpdateOAuthClientRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: repeated string redirect_uris = 3;
+   */
+  redirectUris: string[];
+
+  /**
+   * @generated from field: repeated string grant_types = 4;
+   */
+  grantTypes: string[];
+
+  /**
+   * @generated from field: repeated string scopes = 5;
+   */
+  scopes: string[];
+
+  /**
+   * @generated from field: repeated string audiences = 6;
+   */
+  audiences: string[];
+};
+
+/**
+ * Describes the message guardian.v1.UpdateOAuthClientRequest.
+ * Use `create(UpdateOAuthClientRequestSchema)` to create a new message.
+ */
+export const UpdateOAuthClientRequestSchema: GenMessage<UpdateOAuthClientRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 9);
+
+/**
+ * @generated from message guardian.v1.UpdateOAuthClientResponse
+ */
+export type UpdateOAuthClientResponse = Message<"guardian.v1.UpdateOAuthClientResponse"> & {
+  /**
+   * @generated from field: guardian.v1.OAuthClient client = 1;
+   */
+  client?: OAuthClient;
+};
+
+/**
+ * Describes the message guardian.v1.UpdateOAuthClientResponse.
+ * Use `create(UpdateOAuthClientResponseSchema)` to create a new message.
+ */
+export const UpdateOAuthClientResponseSchema: GenMessage<UpdateOAuthClientResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 10);
+
+/**
+ * @generated from message guardian.v1.DeleteOAuthClientRequest
+ */
+export type DeleteOAuthClientRequest = Message<"guardian.v1.DeleteOAuthClientRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message guardian.v1.DeleteOAuthClientRequest.
+ * Use `create(DeleteOAuthClientRequestSchema)` to create a new message.
+ */
+export const DeleteOAuthClientRequestSchema: GenMessage<DeleteOAuthClientRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 11);
+
+/**
+ * @generated from message guardian.v1.DeleteOAuthClientResponse
+ */
+export type DeleteOAuthClientResponse = Message<"guardian.v1.DeleteOAuthClientResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.DeleteOAuthClientResponse.
+ * Use `create(DeleteOAuthClientResponseSchema)` to create a new message.
+ */
+export const DeleteOAuthClientResponseSchema: GenMessage<DeleteOAuthClientResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 12);
+
+/**
+ * @generated from message guardian.v1.GetAuthorizationRequest
+ */
+export type GetAuthorizationRequest = Message<"guardian.v1.GetAuthorizationRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message guardian.v1.GetAuthorizationRequest.
+ * Use `create(GetAuthorizationRequestSchema)` to create a new message.
+ */
+export const GetAuthorizationRequestSchema: GenMessage<GetAuthorizationRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 13);
+
+/**
+ * @generated from message guardian.v1.GetAuthorizationResponse
+ */
+export type GetAuthorizationResponse = Message<"guardian.v1.GetAuthorizationResponse"> & {
+  /**
+   * @generated from field: guardian.v1.PendingAuthorization authorization = 1;
+   */
+  authorization?: PendingAuthorization;
+};
+
+/**
+ * Describes the message guardian.v1.GetAuthorizationResponse.
+ * Use `create(GetAuthorizationResponseSchema)` to create a new message.
+ */
+export const GetAuthorizationResponseSchema: GenMessage<GetAuthorizationResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 14);
+
+/**
+ * @generated from message guardian.v1.ApproveAuthorizationRequest
+ */
+export type ApproveAuthorizationRequest = Message<"guardian.v1.ApproveAuthorizationRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message guardian.v1.ApproveAuthorizationRequest.
+ * Use `create(ApproveAuthorizationRequestSchema)` to create a new message.
+ */
+export const ApproveAuthorizationRequestSchema: GenMessage<ApproveAuthorizationRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 15);
+
+/**
+ * @generated from message guardian.v1.ApproveAuthorizationResponse
+ */
+export type ApproveAuthorizationResponse = Message<"guardian.v1.ApproveAuthorizationResponse"> & {
+  /**
+   * The redirect URI of the client with the authorization code and state.
+   *
+   * @generated from field: string redirect_url = 1;
+   */
+  redirectUrl: string;
+};
+
+/**
+ * Describes the message guardian.v1.ApproveAuthorizationResponse.
+ * Use `create(ApproveAuthorizationResponseSchema)` to create a new message.
+ */
+export const ApproveAuthorizationResponseSchema: GenMessage<ApproveAuthorizationResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 16);
+
+/**
+ * @generated from message guardian.v1.DenyAuthorizationRequest
+ */
+export type DenyAuthorizationRequest = Message<"guardian.v1.DenyAuthorizationRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * Error sent to the client, `access_denied` if empty. Requests which cannot be answered without interacting with the
+   * user are denied with `login_required`, `consent_required`, `interaction_required` or `account_selection_required`.
+   *
+   * @generated from field: string error = 2;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message guardian.v1.DenyAuthorizationRequest.
+ * Use `create(DenyAuthorizationRequestSchema)` to create a new message.
+ */
+export const DenyAuthorizationRequestSchema: GenMessage<DenyAuthorizationRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 17);
+
+/**
+ * @generated from message guardian.v1.DenyAuthorizationResponse
+ */
+export type DenyAuthorizationResponse = Message<"guardian.v1.DenyAuthorizationResponse"> & {
+  /**
+   * The redirect URI of the client with an `access_denied` error and state.
+   *
+   * @generated from field: string redirect_url = 1;
+   */
+  redirectUrl: string;
+};
+
+/**
+ * Describes the message guardian.v1.DenyAuthorizationResponse.
+ * Use `create(DenyAuthorizationResponseSchema)` to create a new message.
+ */
+export const DenyAuthorizationResponseSchema: GenMessage<DenyAuthorizationResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 18);
+
+/**
+ * @generated from message guardian.v1.GetDeviceAuthorizationRequest
+ */
+export type GetDeviceAuthorizationRequest = Message<"guardian.v1.GetDeviceAuthorizationRequest"> & {
+  /**
+   * @generated from field: string user_code = 1;
+   */
+  userCode: string;
+};
+
+/**
+ * Describes the message guardian.v1.GetDeviceAuthorizationRequest.
+ * Use `create(GetDeviceAuthorizationRequestSchema)` to create a new message.
+ */
+export const GetDeviceAuthorizationRequestSchema: GenMessage<GetDeviceAuthorizationRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 19);
+
+/**
+ * @generated from message guardian.v1.GetDeviceAuthorizationResponse
+ */
+export type GetDeviceAuthorizationResponse = Message<"guardian.v1.GetDeviceAuthorizationResponse"> & {
+  /**
+   * @generated from field: guardian.v1.PendingDeviceAuthorization authorization = 1;
+   */
+  authorization?: PendingDeviceAuthorization;
+};
+
+/**
+ * Describes the message guardian.v1.GetDeviceAuthorizationResponse.
+ * Use `create(GetDeviceAuthorizationResponseSchema)` to create a new message.
+ */
+export const GetDeviceAuthorizationResponseSchema: GenMessage<GetDeviceAuthorizationResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 20);
+
+/**
+ * @generated from message guardian.v1.ApproveDeviceAuthorizationRequest
+ */
+export type ApproveDeviceAuthorizationRequest = Message<"guardian.v1.ApproveDeviceAuthorizationRequest"> & {
+  /**
+   * @generated from field: string user_code = 1;
+   */
+  userCode: string;
+};
+
+/**
+ * Describes the message guardian.v1.ApproveDeviceAuthorizationRequest.
+ * Use `create(ApproveDeviceAuthorizationRequestSchema)` to create a new message.
+ */
+export const ApproveDeviceAuthorizationRequestSchema: GenMessage<ApproveDeviceAuthorizationRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 21);
+
+/**
+ * @generated from message guardian.v1.ApproveDeviceAuthorizationResponse
+ */
+export type ApproveDeviceAuthorizationResponse = Message<"guardian.v1.ApproveDeviceAuthorizationResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.ApproveDeviceAuthorizationResponse.
+ * Use `create(ApproveDeviceAuthorizationResponseSchema)` to create a new message.
+ */
+export const ApproveDeviceAuthorizationResponseSchema: GenMessage<ApproveDeviceAuthorizationResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 22);
+
+/**
+ * @generated from message guardian.v1.DenyDeviceAuthorizationRequest
+ */
+export type DenyDeviceAuthorizationRequest = Message<"guardian.v1.DenyDeviceAuthorizationRequest"> & {
+  /**
+   * @generated from field: string user_code = 1;
+   */
+  userCode: string;
+};
+
+/**
+ * Describes the message guardian.v1.DenyDeviceAuthorizationRequest.
+ * Use `create(DenyDeviceAuthorizationRequestSchema)` to create a new message.
+ */
+export const DenyDeviceAuthorizationRequestSchema: GenMessage<DenyDeviceAuthorizationRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 23);
+
+/**
+ * @generated from message guardian.v1.DenyDeviceAuthorizationResponse
+ */
+export type DenyDeviceAuthorizationResponse = Message<"guardian.v1.DenyDeviceAuthorizationResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.DenyDeviceAuthorizationResponse.
+ * Use `create(DenyDeviceAuthorizationResponseSchema)` to create a new message.
+ */
+export const DenyDeviceAuthorizationResponseSchema: GenMessage<DenyDeviceAuthorizationResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_oauth, 24);
+
+/**
+ * @generated from enum guardian.v1.OAuthClientType
+ */
+export enum OAuthClientType {
+  /**
+   * @generated from enum value: O_AUTH_CLIENT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Clients which cannot keep a secret, like single page and native apps. They are authenticated by PKCE only.
+   *
+   * @generated from enum value: O_AUTH_CLIENT_TYPE_PUBLIC = 1;
+   */
+  PUBLIC = 1,
+
+  /**
+   * Clients which authenticate with their secret at the token endpoint.
+   *
+   * @generated from enum value: O_AUTH_CLIENT_TYPE_CONFIDENTIAL = 2;
+   */
+  CONFIDENTIAL = 2,
+}
+
+/**
+ * Describes the enum guardian.v1.OAuthClientType.
+ */
+export const OAuthClientTypeSchema: GenEnum<OAuthClientType> = /*@__PURE__*/
+  enumDesc(file_guardian_v1_oauth, 0);
+
+/**
+ * OAuthService registers the clients of the OAuth authorization server and answers the authorization requests users
+ * are sent to the login page with, as well as the device authorizations users enter on the verification page. Methods
+ * expect the access token in the `Authorization: Bearer` header.
+ *
+ * Managing clients requires the `guardian.oauth_clients.manage` permission.
+ *
+ * @generated from service guardian.v1.OAuthService
+ */
+export const OAuthService: GenService<{
+  /**
+   * CreateOAuthClient registers a client and returns its secret, which is empty for public clients and cannot be
+   * retrieved later.
+   *
+   * @generated from rpc guardian.v1.OAuthService.CreateOAuthClient
+   */
+  createOAuthClient: {
+    methodKind: "unary";
+    input: typeof CreateOAuthClientRequestSchema;
+    output: typeof CreateOAuthClientResponseSchema;
+  },
+  /**
+   * GetOAuthClient returns a client by id.
+   *
+   * @generated from rpc guardian.v1.OAuthService.GetOAuthClient
+   */
+  getOAuthClient: {
+    methodKind: "unary";
+    input: typeof GetOAuthClientRequestSchema;
+    output: typeof GetOAuthClientResponseSchema;
+  },
+  /**
+   * ListOAuthClients lists all clients ordered by id.
+   *
+   * @generated from rpc guardian.v1.OAuthService.ListOAuthClients
+   */
+  listOAuthClients: {
+    methodKind: "unary";
+    input: typeof ListOAuthClientsRequestSchema;
+    output: typeof ListOAuthClientsResponseSchema;
+  },
+  /**
+   * UpdateOAuthClient replaces the settings of a client. The type of a client cannot be changed.
+   *
+   * @generated from rpc guardian.v1.OAuthService.UpdateOAuthClient
+   */
+  updateOAuthClient: {
+    methodKind: "unary";
+    input: typeof UpdateOAuthClientRequestSchema;
+    output: typeof UpdateOAuthClientResponseSchema;
+  },
+  /**
+   * DeleteOAuthClient deletes a client along with its pending authorizations. Tokens issued to it stay valid until
+   * they expire.
+   *
+   * @generated from rpc guardian.v1.OAuthService.DeleteOAuthClient
+   */
+  deleteOAuthClient: {
+    methodKind: "unary";
+    input: typeof DeleteOAuthClientRequestSchema;
+    output: typeof DeleteOAuthClientResponseSchema;
+  },
+  /**
+   * GetAuthorization returns a pending authorization request from the `request_id` query parameter of the login page,
+   * so the login page can ask the caller for consent. Fails with NOT_FOUND if the request does not exist, expired or
+   * was answered already.
+   *
+   * @generated from rpc guardian.v1.OAuthService.GetAuthorization
+   */
+  getAuthorization: {
+    methodKind: "unary";
+    input: typeof GetAuthorizationRequestSchema;
+    output: typeof GetAuthorizationResponseSchema;
+  },
+  /**
+   * ApproveAuthorization issues an authorization code to the client on behalf of the caller and returns the URL to
+   * redirect the user agent to. It is answered like GetAuthorization. Fails with FAILED_PRECONDITION if the caller has
+   * to sign in again because of the prompt or max_age of the request.
+   *
+   * @generated from rpc guardian.v1.OAuthService.ApproveAuthorization
+   */
+  approveAuthorization: {
+    methodKind: "unary";
+    input: typeof ApproveAuthorizationRequestSchema;
+    output: typeof ApproveAuthorizationResponseSchema;
+  },
+  /**
+   * DenyAuthorization refuses an authorization request and returns the URL to redirect the user agent to. It is
+   * answered like GetAuthorization. Requests with prompt `none` are denied with an OpenID Connect error if they cannot
+   * be approved without interacting with the user.
+   *
+   * @generated from rpc guardian.v1.OAuthService.DenyAuthorization
+   */
+  denyAuthorization: {
+    methodKind: "unary";
+    input: typeof DenyAuthorizationRequestSchema;
+    output: typeof DenyAuthorizationResponseSchema;
+  },
+  /**
+   * GetDeviceAuthorization returns a pending device authorization from the user code the caller entered on the
+   * verification page, or from its `user_code` query parameter, so the page can ask the caller for consent. Case and
+   * dashes of the user code are ignored. Fails with NOT_FOUND if the authorization does not exist, expired or was
+   * answered already.
+   *
+   * @generated from rpc guardian.v1.OAuthService.GetDeviceAuthorization
+   */
+  getDeviceAuthorization: {
+    methodKind: "unary";
+    input: typeof GetDeviceAuthorizationRequestSchema;
+    output: typeof GetDeviceAuthorizationResponseSchema;
+  },
+  /**
+   * ApproveDeviceAuthorization lets the device obtain tokens on behalf of the caller, bound to the session of the
+   * caller. It is answered like GetDeviceAuthorization.
+   *
+   * @generated from rpc guardian.v1.OAuthService.ApproveDeviceAuthorization
+   */
+  approveDeviceAuthorization: {
+    methodKind: "unary";
+    input: typeof ApproveDeviceAuthorizationRequestSchema;
+    output: typeof ApproveDeviceAuthorizationResponseSchema;
+  },
+  /**
+   * DenyDeviceAuthorization refuses a device authorization, the device is sent an `access_denied` error. It is
+   * answered like GetDeviceAuthorization.
+   *
+   * @generated from rpc guardian.v1.OAuthService.DenyDeviceAuthorization
+   */
+  denyDeviceAuthorization: {
+    methodKind: "unary";
+    input: typeof DenyDeviceAuthorizationRequestSchema;
+    output: typeof DenyDeviceAuthorizationResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_guardian_v1_oauth, 0);
+
@@ -0,0 +1,922 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts"
+// @generated from file guardian/v1/organization.proto (package guardian.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file guardian/v1/organization.proto.
+ */
+export const file_guardian_v1_organization: GenFile = /*@__PURE__*/
+  fileDesc("Ch5ndWFyZGlhbi92MS9vcmdhbml6YXRpb24ucHJvdG8SC2d1YXJkaWFuLnYxIpYBCgxPcmdhbml6YXRpb24SCgoCaWQYASABKAkSDAoEc2x1ZxgCIAEoCRIMCgRuYW1lGAMgASgJEi4KCmNyZWF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIsYBChZPcmdhbml6YXRpb25NZW1iZXJzaGlwEg4KBm9yZ19pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEisKBHJvbGUYAyABKA4yHS5ndWFyZGlhbi52MS5Pcmdhbml6YXRpb25Sb2xlEi4KCmNyZWF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIuQBChZPcmdhbml6YXRpb25JbnZpdGF0aW9uEgoKAmlkGAEgASgJEg4KBm9yZ19pZBgCIAEoCRINCgVlbWFpbBgDIAEoCRIrCgRyb2xlGAQgASgOMh0uZ3VhcmRpYW4udjEuT3JnYW5pemF0aW9uUm9sZRISCgppbnZpdGVyX2lkGAUgASgJEi4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmV4cGlyZXNfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIjcKGUNyZWF0ZU9yZ2FuaXphdGlvblJlcXVlc3QSDAoEc2x1ZxgBIAEoCRIMCgRuYW1lGAIgASgJIk0KGkNyZWF0ZU9yZ2FuaXphdGlvblJlc3BvbnNlEi8KDG9yZ2FuaXphdGlvbhgBIAEoCzIZLmd1YXJkaWFuLnYxLk9yZ2FuaXphdGlvbiIyChZHZXRPcmdhbml6YXRpb25SZXF1ZXN0EgoKAmlkGAEgASgJEgwKBHNsdWcYAiABKAkiSgoXR2V0T3JnYW5pemF0aW9uUmVzcG9uc2USLwoMb3JnYW5pemF0aW9uGAEgASgLMhkuZ3VhcmRpYW4udjEuT3JnYW5pemF0aW9uIhoKGExpc3RPcmdhbml6YXRpb25zUmVxdWVzdCJNChlMaXN0T3JnYW5pemF0aW9uc1Jlc3BvbnNlEjAKDW9yZ2FuaXphdGlvbnMYASADKAsyGS5ndWFyZGlhbi52MS5Pcmdhbml6YXRpb24iXwoZVXBkYXRlT3JnYW5pemF0aW9uUmVxdWVzdBIKCgJpZBgBIAEoCRIRCgRzbHVnGAIgASgJSACIAQESEQoEbmFtZRgDIAEoCUgBiAEBQgcKBV9zbHVnQgcKBV9uYW1lIk0KGlVwZGF0ZU9yZ2FuaXphdGlvblJlc3BvbnNlEi8KDG9yZ2FuaXphdGlvbhgBIAEoCzIZLmd1YXJkaWFuLnYxLk9yZ2FuaXphdGlvbiInChlEZWxldGVPcmdhbml6YXRpb25SZXF1ZXN0EgoKAmlkGAEgASgJIhwKGkRlbGV0ZU9yZ2FuaXphdGlvblJlc3BvbnNlIksKEkxpc3RNZW1iZXJzUmVxdWVzdBIOCgZvcmdfaWQYASABKAkSEQoJcGFnZV9zaXplGAIgASgFEhIKCnBhZ2VfdG9rZW4YAyABKAkiaAoTTGlzdE1lbWJlcnNSZXNwb25zZRI4CgttZW1iZXJzaGlwcxgBIAMoCzIjLmd1YXJkaWFuLnYxLk9yZ2FuaXphdGlvbk1lbWJlcnNoaXASFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJImcKF1VwZGF0ZU1lbWJlclJvbGVSZXF1ZXN0Eg4KBm9yZ19pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEisKBHJvbGUYAyABKA4yHS5ndWFyZGlhbi52MS5Pcmdhbml6YXRpb25Sb2xlIlMKGFVwZGF0ZU1lbWJlclJvbGVSZXNwb25zZRI3CgptZW1iZXJzaGlwGAEgASgLMiMuZ3VhcmRpYW4udjEuT3JnYW5pemF0aW9uTWVtYmVyc2hpcCI2ChNSZW1vdmVNZW1iZXJSZXF1ZXN0Eg4KBm9yZ19pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJIhYKFFJlbW92ZU1lbWJlclJlc3BvbnNlIjsKGFRyYW5zZmVyT3duZXJzaGlwUmVxdWVzdBIOCgZvcmdfaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCSIbChlUcmFuc2Zlck93bmVyc2hpcFJlc3BvbnNlImEKE0ludml0ZU1lbWJlclJlcXVlc3QSDgoGb3JnX2lkGAEgASgJEg0KBWVtYWlsGAIgASgJEisKBHJvbGUYAyABKA4yHS5ndWFyZGlhbi52MS5Pcmdhbml6YXRpb25Sb2xlIk8KFEludml0ZU1lbWJlclJlc3BvbnNlEjcKCmludml0YXRpb24YASABKAsyIy5ndWFyZGlhbi52MS5Pcmdhbml6YXRpb25JbnZpdGF0aW9uIigKFkxpc3RJbnZpdGF0aW9uc1JlcXVlc3QSDgoGb3JnX2lkGAEgASgJIlMKF0xpc3RJbnZpdGF0aW9uc1Jlc3BvbnNlEjgKC2ludml0YXRpb25zGAEgAygLMiMuZ3VhcmRpYW4udjEuT3JnYW5pemF0aW9uSW52aXRhdGlvbiJAChdSZXZva2VJbnZpdGF0aW9uUmVxdWVzdBIOCgZvcmdfaWQYASABKAkSFQoNaW52aXRhdGlvbl9pZBgCIAEoCSIaChhSZXZva2VJbnZpdGF0aW9uUmVzcG9uc2UiJQoUR2V0SW52aXRhdGlvblJlcXVlc3QSDQoFdG9rZW4YASABKAkigQEKFUdldEludml0YXRpb25SZXNwb25zZRI3CgppbnZpdGF0aW9uGAEgASgLMiMuZ3VhcmRpYW4udjEuT3JnYW5pemF0aW9uSW52aXRhdGlvbhIvCgxvcmdhbml6YXRpb24YAiABKAsyGS5ndWFyZGlhbi52MS5Pcmdhbml6YXRpb24iKAoXQWNjZXB0SW52aXRhdGlvblJlcXVlc3QSDQoFdG9rZW4YASABKAkiUwoYQWNjZXB0SW52aXRhdGlvblJlc3BvbnNlEjcKCm1lbWJlcnNoaXAYASABKAsyIy5ndWFyZGlhbi52MS5Pcmdhbml6YXRpb25NZW1iZXJzaGlwIikKGERlY2xpbmVJbnZpdGF0aW9uUmVxdWVzdBINCgV0b2tlbhgBIAEoCSIbChlEZWNsaW5lSW52aXRhdGlvblJlc3BvbnNlKo0BChBPcmdhbml6YXRpb25Sb2xlEiEKHU9SR0FOSVpBVElPTl9ST0xFX1VOU1BFQ0lGSUVEEAASGwoXT1JHQU5JWkFUSU9OX1JPTEVfT1dORVIQARIbChdPUkdBTklaQVRJT05fUk9MRV9BRE1JThACEhwKGE9SR0FOSVpBVElPTl9ST0xFX01FTUJFUhADMqkLChNPcmdhbml6YXRpb25TZXJ2aWNlEmUKEkNyZWF0ZU9yZ2FuaXphdGlvbhImLmd1YXJkaWFuLnYxLkNyZWF0ZU9yZ2FuaXphdGlvblJlcXVlc3QaJy5ndWFyZGlhbi52MS5DcmVhdGVPcmdhbml6YXRpb25SZXNwb25zZRJcCg9HZXRPcmdhbml6YXRpb24SIy5ndWFyZGlhbi52MS5HZXRPcmdhbml6YXRpb25SZXF1ZXN0GiQuZ3VhcmRpYW4udjEuR2V0T3JnYW5pemF0aW9uUmVzcG9uc2USYgoRTGlzdE9yZ2FuaXphdGlvbnMSJS5ndWFyZGlhbi52MS5MaXN0T3JnYW5pemF0aW9uc1JlcXVlc3QaJi5ndWFyZGlhbi52MS5MaXN0T3JnYW5pemF0aW9uc1Jlc3BvbnNlEmUKElVwZGF0ZU9yZ2FuaXphdGlvbhImLmd1YXJkaWFuLnYxLlVwZGF0ZU9yZ2FuaXphdGlvblJlcXVlc3QaJy5ndWFyZGlhbi52MS5VcGRhdGVPcmdhbml6YXRpb25SZXNwb25zZRJlChJEZWxldGVPcmdhbml6YXRpb24SJi5ndWFyZGlhbi52MS5EZWxldGVPcmdhbml6YXRpb25SZXF1ZXN0GicuZ3VhcmRpYW4udjEuRGVsZXRlT3JnYW5pemF0aW9uUmVzcG9uc2USUAoLTGlzdE1lbWJlcnMSHy5ndWFyZGlhbi52MS5MaXN0TWVtYmVyc1JlcXVlc3QaIC5ndWFyZGlhbi52MS5MaXN0TWVtYmVyc1Jlc3BvbnNlEl8KEFVwZGF0ZU1lbWJlclJvbGUSJC5ndWFyZGlhbi52MS5VcGRhdGVNZW1iZXJSb2xlUmVxdWVzdBolLmd1YXJkaWFuLnYxLlVwZGF0ZU1lbWJlclJvbGVSZXNwb25zZRJTCgxSZW1vdmVNZW1iZXISIC5ndWFyZGlhbi52MS5SZW1vdmVNZW1iZXJSZXF1ZXN0GiEuZ3VhcmRpYW4udjEuUmVtb3ZlTWVtYmVyUmVzcG9uc2USYgoRVHJhbnNmZXJPd25lcnNoaXASJS5ndWFyZGlhbi52MS5UcmFuc2Zlck93bmVyc2hpcFJlcXVlc3QaJi5ndWFyZGlhbi52MS5UcmFuc2Zlck93bmVyc2hpcFJlc3BvbnNlElMKDEludml0ZU1lbWJlchIgLmd1YXJkaWFuLnYxLkludml0ZU1lbWJlclJlcXVlc3QaIS5ndWFyZGlhbi52MS5JbnZpdGVNZW1iZXJSZXNwb25zZRJcCg9MaXN0SW52aXRhdGlvbnMSIy5ndWFyZGlhbi52MS5MaXN0SW52aXRhdGlvbnNSZXF1ZXN0GiQuZ3VhcmRpYW4udjEuTGlzdEludml0YXRpb25zUmVzcG9uc2USXwoQUmV2b2tlSW52aXRhdGlvbhIkLmd1YXJkaWFuLnYxLlJldm9rZUludml0YXRpb25SZXF1ZXN0GiUuZ3VhcmRpYW4udjEuUmV2b2tlSW52aXRhdGlvblJlc3BvbnNlElYKDUdldEludml0YXRpb24SIS5ndWFyZGlhbi52MS5HZXRJbnZpdGF0aW9uUmVxdWVzdBoiLmd1YXJkaWFuLnYxLkdldEludml0YXRpb25SZXNwb25zZRJfChBBY2NlcHRJbnZpdGF0aW9uEiQuZ3VhcmRpYW4udjEuQWNjZXB0SW52aXRhdGlvblJlcXVlc3QaJS5ndWFyZGlhbi52MS5BY2NlcHRJbnZpdGF0aW9uUmVzcG9uc2USYgoRRGVjbGluZUludml0YXRpb24SJS5ndWFyZGlhbi52MS5EZWNsaW5lSW52aXRhdGlvblJlcXVlc3QaJi5ndWFyZGlhbi52MS5EZWNsaW5lSW52aXRhdGlvblJlc3BvbnNlQrABCg9jb20uZ3VhcmRpYW4udjFCEU9yZ2FuaXphdGlvblByb3RvUAFaPWdpdGh1Yi5jb20vZ29waGVyby9ndWFyZGlhbi9jb3JlL3Byb3RvL2d1YXJkaWFuL3YxO2d1YXJkaWFudjGiAgNHVliqAgtHdWFyZGlhbi5WMcoCC0d1YXJkaWFuXFYx4gIXR3VhcmRpYW5cVjFcR1BCTWV0YWRhdGHqAgxHdWFyZGlhbjo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
+
+/**
+ * Organization is a tenant which users are members of.
+ *
+ * @generated from message guardian.v1.Organization
+ */
+export type Organization = Message<"guardian.v1.Organization"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * Identifies the organization in URLs. Slugs are hyphen separated alphanumeric segments of up to 63 characters and
+   * unique ignoring case.
+   *
+   * @generated from field: string slug = 2;
+   */
+  slug: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 5;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message guardian.v1.Organization.
+ * Use `create(OrganizationSchema)` to create a new message.
+ */
+export const OrganizationSchema: GenMessage<Organization> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 0);
+
+/**
+ * OrganizationMembership makes a user a member of an organization.
+ *
+ * @generated from message guardian.v1.OrganizationMembership
+ */
+export type OrganizationMembership = Message<"guardian.v1.OrganizationMembership"> & {
+  /**
+   * @generated from field: string org_id = 1;
+   */
+  orgId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: guardian.v1.OrganizationRole role = 3;
+   */
+  role: OrganizationRole;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 5;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message guardian.v1.OrganizationMembership.
+ * Use `create(OrganizationMembershipSchema)` to create a new message.
+ */
+export const OrganizationMembershipSchema: GenMessage<OrganizationMembership> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 1);
+
+/**
+ * OrganizationInvitation invites the owner of an email address to join an organization.
+ *
+ * @generated from message guardian.v1.OrganizationInvitation
+ */
+export type OrganizationInvitation = Message<"guardian.v1.OrganizationInvitation"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string org_id = 2;
+   */
+  orgId: string;
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email: string;
+
+  /**
+   * @generated from field: guardian.v1.OrganizationRole role = 4;
+   */
+  role: OrganizationRole;
+
+  /**
+   * The user who invited, empty if the user was deleted.
+   *
+   * @generated from field: string inviter_id = 5;
+   */
+  inviterId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 7;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message guardian.v1.OrganizationInvitation.
+ * Use `create(OrganizationInvitationSchema)` to create a new message.
+ */
+export const OrganizationInvitationSchema: GenMessage<OrganizationInvitation> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 2);
+
+/**
+ * @generated from message guardian.v1.CreateOrganizationRequest
+ */
+export type CreateOrganizationRequest = Message<"guardian.v1.CreateOrganizationRequest"> & {
+  /**
+   * @generated from field: string slug = 1;
+   */
+  slug: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message guardian.v1.CreateOrganizationRequest.
+ * Use `create(CreateOrganizationRequestSchema)` to create a new message.
+ */
+export const CreateOrganizationRequestSchema: GenMessage<CreateOrganizationRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 3);
+
+/**
+ * @generated from message guardian.v1.CreateOrganizationResponse
+ */
+export type CreateOrganizationResponse = Message<"guardian.v1.CreateOrganizationResponse"> & {
+  /**
+   * @generated from field: guardian.v1.Organization organization = 1;
+   */
+  organization?: Organization;
+};
+
+/**
+ * Describes the message guardian.v1.CreateOrganizationResponse.
+ * Use `create(CreateOrganizationResponseSchema)` to create a new message.
+ */
+export const CreateOrganizationResponseSchema: GenMessage<CreateOrganizationResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 4);
+
+/**
+ * @generated from message guardian.v1.GetOrganizationRequest
+ */
+export type GetOrganizationRequest = Message<"guardian.v1.GetOrganizationRequest"> & {
+  /**
+   * Either id or slug has to be set.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string slug = 2;
+   */
+  slug: string;
+};
+
+/**
+ * Describes the message guardian.v1.GetOrganizationRequest.
+ * Use `create(GetOrganizationRequestSchema)` to create a new message.
+ */
+export const GetOrganizationRequestSchema: GenMessage<GetOrganizationRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 5);
+
+/**
+ * @generated from message guardian.v1.GetOrganizationResponse
+ */
+export type GetOrganizationResponse = Message<"guardian.v1.GetOrganizationResponse"> & {
+  /**
+   * @generated from field: guardian.v1.Organization organization = 1;
+   */
+  organization?: Organization;
+};
+
+/**
+ * Describes the message guardian.v1.GetOrganizationResponse.
+ * Use `create(GetOrganizationResponseSchema)` to create a new message.
+ */
+export const GetOrganizationResponseSchema: GenMessage<GetOrganizationResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 6);
+
+/**
+ * @generated from message guardian.v1.ListOrganizationsRequest
+ */
+export type ListOrganizationsRequest = Message<"guardian.v1.ListOrganizationsRequest"> & {
+};
+
+/**
+ * Describes the message guardian.v1.ListOrganizationsRequest.
+ * Use `create(ListOrganizationsRequestSchema)` to create a new message.
+ */
+export const ListOrganizationsRequestSchema: GenMessage<ListOrganizationsRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 7);
+
+/**
+ * @generated from message guardian.v1.ListOrganizationsResponse
+ */
+export type ListOrganizationsResponse = Message<"guardian.v1.ListOrganizationsResponse"> & {
+  /**
+   * @generated from field: repeated guardian.v1.Organization organizations = 1;
+   */
+  organizations: Organization[];
+};
+
+/**
+ * Describes the message guardian.v1.ListOrganizationsResponse.
+ * Use `create(ListOrganizationsResponseSchema)` to create a new message.
+ */
+export const ListOrganizationsResponseSchema: GenMessage<ListOrganizationsResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 8);
+
+/**
+ * @generated from message guardian.v1.UpdateOrganizationRequest
+ */
+export type UpdateOrganizationRequest = Message<"guardian.v1.UpdateOrganizationRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: optional string slug = 2;
+   */
+  slug?: string;
+
+  /**
+   * @generated from field: optional string name = 3;
+   */
+  name?: string;
+};
+
+/**
+ * Describes the message guardian.v1.UpdateOrganizationRequest.
+ * Use `create(UpdateOrganizationRequestSchema)` to create a new message.
+ */
+export const UpdateOrganizationRequestSchema: GenMessage<UpdateOrganizationRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 9);
+
+/**
+ * @generated from message guardian.v1.UpdateOrganizationResponse
+ */
+export type UpdateOrganizationResponse = Message<"guardian.v1.UpdateOrganizationResponse"> & {
+  /**
+   * @generated from field: guardian.v1.Organization organization = 1;
+   */
+  organization?: Organization;
+};
+
+/**
+ * Describes the message guardian.v1.UpdateOrganizationResponse.
+ * Use `create(UpdateOrganizationResponseSchema)` to create a new message.
+ */
+export const UpdateOrganizationResponseSchema: GenMessage<UpdateOrganizationResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 10);
+
+/**
+ * @generated from message guardian.v1.DeleteOrganizationRequest
+ */
+export type DeleteOrganizationRequest = Message<"guardian.v1.DeleteOrganizationRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message guardian.v1.DeleteOrganizationRequest.
+ * Use `create(DeleteOrganizationRequestSchema)` to create a new message.
+ */
+export const DeleteOrganizationRequestSchema: GenMessage<DeleteOrganizationRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 11);
+
+/**
+ * @generated from message guardian.v1.DeleteOrganizationResponse
+ */
+export type DeleteOrganizationResponse = Message<"guardian.v1.DeleteOrganizationResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.DeleteOrganizationResponse.
+ * Use `create(DeleteOrganizationResponseSchema)` to create a new message.
+ */
+export const DeleteOrganizationResponseSchema: GenMessage<DeleteOrganizationResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 12);
+
+/**
+ * @generated from message guardian.v1.ListMembersRequest
+ */
+export type ListMembersRequest = Message<"guardian.v1.ListMembersRequest"> & {
+  /**
+   * @generated from field: string org_id = 1;
+   */
+  orgId: string;
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * The next_page_token of the previous response.
+   *
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message guardian.v1.ListMembersRequest.
+ * Use `create(ListMembersRequestSchema)` to create a new message.
+ */
+export const ListMembersRequestSchema: GenMessage<ListMembersRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 13);
+
+/**
+ * @generated from message guardian.v1.ListMembersResponse
+ */
+export type ListMembersResponse = Message<"guardian.v1.ListMembersResponse"> & {
+  /**
+   * @generated from field: repeated guardian.v1.OrganizationMembership memberships = 1;
+   */
+  memberships: OrganizationMembership[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message guardian.v1.ListMembersResponse.
+ * Use `create(ListMembersResponseSchema)` to create a new message.
+ */
+export const ListMembersResponseSchema: GenMessage<ListMembersResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 14);
+
+/**
+ * @generated from message guardian.v1.UpdateMemberRoleRequest
+ */
+export type UpdateMemberRoleRequest = Message<"guardian.v1.UpdateMemberRoleRequest"> & {
+  /**
+   * @generated from field: string org_id = 1;
+   */
+  orgId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: guardian.v1.OrganizationRole role = 3;
+   */
+  role: OrganizationRole;
+};
+
+/**
+ * Describes the message guardian.v1.UpdateMemberRoleRequest.
+ * Use `create(UpdateMemberRoleRequestSchema)` to create a new message.
+ */
+export const UpdateMemberRoleRequestSchema: GenMessage<UpdateMemberRoleRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 15);
+
+/**
+ * @generated from message guardian.v1.UpdateMemberRoleResponse
+ */
+export type UpdateMemberRoleResponse = Message<"guardian.v1.UpdateMemberRoleResponse"> & {
+  /**
+   * @generated from field: guardian.v1.OrganizationMembership membership = 1;
+   */
+  membership?: OrganizationMembership;
+};
+
+/**
+ * Describes the message guardian.v1.UpdateMemberRoleResponse.
+ * Use `create(UpdateMemberRoleResponseSchema)` to create a new message.
+ */
+export const UpdateMemberRoleResponseSchema: GenMessage<UpdateMemberRoleResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 16);
+
+/**
+ * @generated from message guardian.v1.RemoveMemberRequest
+ */
+export type RemoveMemberRequest = Message<"guardian.v1.RemoveMemberRequest"> & {
+  /**
+   * @generated from field: string org_id = 1;
+   */
+  orgId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message guardian.v1.RemoveMemberRequest.
+ * Use `create(RemoveMemberRequestSchema)` to create a new message.
+ */
+export const RemoveMemberRequestSchema: GenMessage<RemoveMemberRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 17);
+
+/**
+ * @generated from message guardian.v1.RemoveMemberResponse
+ */
+export type RemoveMemberResponse = Message<"guardian.v1.RemoveMemberResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.RemoveMemberResponse.
+ * Use `create(RemoveMemberResponseSchema)` to create a new message.
+ */
+export const RemoveMemberResponseSchema: GenMessage<RemoveMemberResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 18);
+
+/**
+ * @generated from message guardian.v1.TransferOwnershipRequest
+ */
+export type TransferOwnershipRequest = Message<"guardian.v1.TransferOwnershipRequest"> & {
+  /**
+   * @generated from field: string org_id = 1;
+   */
+  orgId: string;
+
+  /**
+   * The member who becomes an owner.
+   *
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message guardian.v1.TransferOwnershipRequest.
+ * Use `create(TransferOwnershipRequestSchema)` to create a new message.
+ */
+export const TransferOwnershipRequestSchema: GenMessage<TransferOwnershipRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 19);
+
+/**
+ * @generated from message guardian.v1.TransferOwnershipResponse
+ */
+export type TransferOwnershipResponse = Message<"guardian.v1.TransferOwnershipResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.TransferOwnershipResponse.
+ * Use `create(TransferOwnershipResponseSchema)` to create a new message.
+ */
+export const TransferOwnershipResponseSchema: GenMessage<TransferOwnershipResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 20);
+
+/**
+ * @generated from message guardian.v1.InviteMemberRequest
+ */
+export type InviteMemberRequest = Message<"guardian.v1.InviteMemberRequest"> & {
+  /**
+   * @generated from field: string org_id = 1;
+   */
+  orgId: string;
+
+  /**
+   * @generated from field: string email = 2;
+   */
+  email: string;
+
+  /**
+   * Defaults to ORGANIZATION_ROLE_MEMBER.
+   *
+   * @generated from field: guardian.v1.OrganizationRole role = 3;
+   */
+  role: OrganizationRole;
+};
+
+/**
+ * Describes the message guardian.v1.InviteMemberRequest.
+ * Use `create(InviteMemberRequestSchema)` to create a new message.
+ */
+export const InviteMemberRequestSchema: GenMessage<InviteMemberRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 21);
+
+/**
+ * @generated from message guardian.v1.InviteMemberResponse
+ */
+export type InviteMemberResponse = Message<"guardian.v1.InviteMemberResponse"> & {
+  /**
+   * @generated from field: guardian.v1.OrganizationInvitation invitation = 1;
+   */
+  invitation?: OrganizationInvitation;
+};
+
+/**
+ * Describes the message guardian.v1.InviteMemberResponse.
+ * Use `create(InviteMemberResponseSchema)` to create a new message.
+ */
+export const InviteMemberResponseSchema: GenMessage<InviteMemberResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 22);
+
+/**
+ * @generated from message guardian.v1.ListInvitationsRequest
+ */
+export type ListInvitationsRequest = Message<"guardian.v1.ListInvitationsRequest"> & {
+  /**
+   * @generated from field: string org_id = 1;
+   */
+  orgId: string;
+};
+
+/**
+ * Describes the message guardian.v1.ListInvitationsRequest.
+ * Use `create(ListInvitationsRequestSchema)` to create a new message.
+ */
+export const ListInvitationsRequestSchema: GenMessage<ListInvitationsRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 23);
+
+/**
+ * @generated from message guardian.v1.ListInvitationsResponse
+ */
+export type ListInvitationsResponse = Message<"guardian.v1.ListInvitationsResponse"> & {
+  /**
+   * @generated from field: repeated guardian.v1.OrganizationInvitation invitations = 1;
+   */
+  invitations: OrganizationInvitation[];
+};
+
+/**
+ * Describes the message guardian.v1.ListInvitationsResponse.
+ * Use `create(ListInvitationsResponseSchema)` to create a new message.
+ */
+export const ListInvitationsResponseSchema: GenMessage<ListInvitationsResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 24);
+
+/**
+ * @generated from message guardian.v1.RevokeInvitationRequest
+ */
+export type RevokeInvitationRequest = Message<"guardian.v1.RevokeInvitationRequest"> & {
+  /**
+   * @generated from field: string org_id = 1;
+   */
+  orgId: string;
+
+  /**
+   * @generated from field: string invitation_id = 2;
+   */
+  invitationId: string;
+};
+
+/**
+ * Describes the message guardian.v1.RevokeInvitationRequest.
+ * Use `create(RevokeInvitationRequestSchema)` to create a new message.
+ */
+export const RevokeInvitationRequestSchema: GenMessage<RevokeInvitationRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 25);
+
+/**
+ * @generated from message guardian.v1.RevokeInvitationResponse
+ */
+export type RevokeInvitationResponse = Message<"guardian.v1.RevokeInvitationResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.RevokeInvitationResponse.
+ * Use `create(RevokeInvitationResponseSchema)` to create a new message.
+ */
+export const RevokeInvitationResponseSchema: GenMessage<RevokeInvitationResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 26);
+
+/**
+ * @generated from message guardian.v1.GetInvitationRequest
+ */
+export type GetInvitationRequest = Message<"guardian.v1.GetInvitationRequest"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message guardian.v1.GetInvitationRequest.
+ * Use `create(GetInvitationRequestSchema)` to create a new message.
+ */
+export const GetInvitationRequestSchema: GenMessage<GetInvitationRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 27);
+
+/**
+ * @generated from message guardian.v1.GetInvitationResponse
+ */
+export type GetInvitationResponse = Message<"guardian.v1.GetInvitationResponse"> & {
+  /**
+   * @generated from field: guardian.v1.OrganizationInvitation invitation = 1;
+   */
+  invitation?: OrganizationInvitation;
+
+  /**
+   * @generated from field: guardian.v1.Organization organization = 2;
+   */
+  organization?: Organization;
+};
+
+/**
+ * Describes the message guardian.v1.GetInvitationResponse.
+ * Use `create(GetInvitationResponseSchema)` to create a new message.
+ */
+export const GetInvitationResponseSchema: GenMessage<GetInvitationResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 28);
+
+/**
+ * @generated from message guardian.v1.AcceptInvitationRequest
+ */
+export type AcceptInvitationRequest = Message<"guardian.v1.AcceptInvitationRequest"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message guardian.v1.AcceptInvitationRequest.
+ * Use `create(AcceptInvitationRequestSchema)` to create a new message.
+ */
+export const AcceptInvitationRequestSchema: GenMessage<AcceptInvitationRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 29);
+
+/**
+ * @generated from message guardian.v1.AcceptInvitationResponse
+ */
+export type AcceptInvitationResponse = Message<"guardian.v1.AcceptInvitationResponse"> & {
+  /**
+   * @generated from field: guardian.v1.OrganizationMembership membership = 1;
+   */
+  membership?: OrganizationMembership;
+};
+
+/**
+ * Describes the message guardian.v1.AcceptInvitationResponse.
+ * Use `create(AcceptInvitationResponseSchema)` to create a new message.
+ */
+export const AcceptInvitationResponseSchema: GenMessage<AcceptInvitationResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 30);
+
+/**
+ * @generated from message guardian.v1.DeclineInvitationRequest
+ */
+export type DeclineInvitationRequest = Message<"guardian.v1.DeclineInvitationRequest"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message guardian.v1.DeclineInvitationRequest.
+ * Use `create(DeclineInvitationRequestSchema)` to create a new message.
+ */
+export const DeclineInvitationRequestSchema: GenMessage<DeclineInvitationRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 31);
+
+/**
+ * @generated from message guardian.v1.DeclineInvitationResponse
+ */
+export type DeclineInvitationResponse = Message<"guardian.v1.DeclineInvitationResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.DeclineInvitationResponse.
+ * Use `create(DeclineInvitationResponseSchema)` to create a new message.
+ */
+export const DeclineInvitationResponseSchema: GenMessage<DeclineInvitationResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_organization, 32);
+
+/**
+ * OrganizationRole is the role of a member in an organization.
+ *
+ * @generated from enum guardian.v1.OrganizationRole
+ */
+export enum OrganizationRole {
+  /**
+   * @generated from enum value: ORGANIZATION_ROLE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ORGANIZATION_ROLE_OWNER = 1;
+   */
+  OWNER = 1,
+
+  /**
+   * @generated from enum value: ORGANIZATION_ROLE_ADMIN = 2;
+   */
+  ADMIN = 2,
+
+  /**
+   * @generated from enum value: ORGANIZATION_ROLE_MEMBER = 3;
+   */
+  MEMBER = 3,
+}
+
+/**
+ * Describes the enum guardian.v1.OrganizationRole.
+ */
+export const OrganizationRoleSchema: GenEnum<OrganizationRole> = /*@__PURE__*/
+  enumDesc(file_guardian_v1_organization, 0);
+
+/**
+ * OrganizationService manages organizations, their members and invitations. Methods expect the access token in the
+ * `Authorization: Bearer` header.
+ *
+ * Members have one of the roles of OrganizationRole. Members can view the organization and its members, admins
+ * manage the organization, its members and invitations and owners additionally manage owners and delete the
+ * organization. Every organization keeps at least one owner, so removing or demoting the last owner fails with
+ * INVALID_ARGUMENT.
+ *
+ * @generated from service guardian.v1.OrganizationService
+ */
+export const OrganizationService: GenService<{
+  /**
+   * CreateOrganization creates an organization with the caller as its owner. Fails with ALREADY_EXISTS if the slug
+   * is taken.
+   *
+   * @generated from rpc guardian.v1.OrganizationService.CreateOrganization
+   */
+  createOrganization: {
+    methodKind: "unary";
+    input: typeof CreateOrganizationRequestSchema;
+    output: typeof CreateOrganizationResponseSchema;
+  },
+  /**
+   * GetOrganization returns an organization by id or slug.
+   *
+   * @generated from rpc guardian.v1.OrganizationService.GetOrganization
+   */
+  getOrganization: {
+    methodKind: "unary";
+    input: typeof GetOrganizationRequestSchema;
+    output: typeof GetOrganizationResponseSchema;
+  },
+  /**
+   * ListOrganizations lists the organizations the caller is a member of ordered by id.
+   *
+   * @generated from rpc guardian.v1.OrganizationService.ListOrganizations
+   */
+  listOrganizations: {
+    methodKind: "unary";
+    input: typeof ListOrganizationsRequestSchema;
+    output: typeof ListOrganizationsResponseSchema;
+  },
+  /**
+   * UpdateOrganization updates the fields of an organization which are set in the request.
+   *
+   * @generated from rpc guardian.v1.OrganizationService.UpdateOrganization
+   */
+  updateOrganization: {
+    methodKind: "unary";
+    input: typeof UpdateOrganizationRequestSchema;
+    output: typeof UpdateOrganizationResponseSchema;
+  },
+  /**
+   * DeleteOrganization deletes an organization along with its memberships and invitations.
+   *
+   * @generated from rpc guardian.v1.OrganizationService.DeleteOrganization
+   */
+  deleteOrganization: {
+    methodKind: "unary";
+    input: typeof DeleteOrganizationRequestSchema;
+    output: typeof DeleteOrganizationResponseSchema;
+  },
+  /**
+   * ListMembers lists the memberships of an organization ordered by user id.
+   *
+   * @generated from rpc guardian.v1.OrganizationService.ListMembers
+   */
+  listMembers: {
+    methodKind: "unary";
+    input: typeof ListMembersRequestSchema;
+    output: typeof ListMembersResponseSchema;
+  },
+  /**
+   * UpdateMemberRole changes the role of a member. Only owners can promote members to owners or change the role of
+   * owners.
+   *
+   * @generated from rpc guardian.v1.OrganizationService.UpdateMemberRole
+   */
+  updateMemberRole: {
+    methodKind: "unary";
+    input: typeof UpdateMemberRoleRequestSchema;
+    output: typeof UpdateMemberRoleResponseSchema;
+  },
+  /**
+   * RemoveMember removes a member from an organization. Members can remove themselves to leave.
+   *
+   * @generated from rpc guardian.v1.OrganizationService.RemoveMember
+   */
+  removeMember: {
+    methodKind: "unary";
+    input: typeof RemoveMemberRequestSchema;
+    output: typeof RemoveMemberResponseSchema;
+  },
+  /**
+   * TransferOwnership makes a member an owner and the calling owner an admin.
+   *
+   * @generated from rpc guardian.v1.OrganizationService.TransferOwnership
+   */
+  transferOwnership: {
+    methodKind: "unary";
+    input: typeof TransferOwnershipRequestSchema;
+    output: typeof TransferOwnershipResponseSchema;
+  },
+  /**
+   * InviteMember emails an invitation to join an organization with a role. Inviting an email again replaces its
+   * pending invitation. Only owners can invite owners.
+   *
+   * @generated from rpc guardian.v1.OrganizationService.InviteMember
+   */
+  inviteMember: {
+    methodKind: "unary";
+    input: typeof InviteMemberRequestSchema;
+    output: typeof InviteMemberResponseSchema;
+  },
+  /**
+   * ListInvitations lists the pending invitations of an organization ordered by id.
+   *
+   * @generated from rpc guardian.v1.OrganizationService.ListInvitations
+   */
+  listInvitations: {
+    methodKind: "unary";
+    input: typeof ListInvitationsRequestSchema;
+    output: typeof ListInvitationsResponseSchema;
+  },
+  /**
+   * RevokeInvitation deletes a pending invitation.
+   *
+   * @generated from rpc guardian.v1.OrganizationService.RevokeInvitation
+   */
+  revokeInvitation: {
+    methodKind: "unary";
+    input: typeof RevokeInvitationRequestSchema;
+    output: typeof RevokeInvitationResponseSchema;
+  },
+  /**
+   * GetInvitation returns the invitation of a token from an invitation email along with its organization. The
+   * invitation has to be for the email of the caller. Fails with UNAUTHENTICATED if the token is invalid or expired.
+   *
+   * @generated from rpc guardian.v1.OrganizationService.GetInvitation
+   */
+  getInvitation: {
+    methodKind: "unary";
+    input: typeof GetInvitationRequestSchema;
+    output: typeof GetInvitationResponseSchema;
+  },
+  /**
+   * AcceptInvitation makes the caller a member of the organization of an invitation. It is answered like
+   * GetInvitation and fails with ALREADY_EXISTS if the caller is a member already. Fails with FAILED_PRECONDITION if
+   * the email of the caller is not verified.
+   *
+   * @generated from rpc guardian.v1.OrganizationService.AcceptInvitation
+   */
+  acceptInvitation: {
+    methodKind: "unary";
+    input: typeof AcceptInvitationRequestSchema;
+    output: typeof AcceptInvitationResponseSchema;
+  },
+  /**
+   * DeclineInvitation deletes an invitation. It is answered like GetInvitation and fails with FAILED_PRECONDITION if
+   * the email of the caller is not verified.
+   *
+   * @generated from rpc guardian.v1.OrganizationService.DeclineInvitation
+   */
+  declineInvitation: {
+    methodKind: "unary";
+    input: typeof DeclineInvitationRequestSchema;
+    output: typeof DeclineInvitationResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_guardian_v1_organization, 0);
+
@@ -0,0 +1,277 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts"
+// @generated from file guardian/v1/passkey.proto (package guardian.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file guardian/v1/passkey.proto.
+ */
+export const file_guardian_v1_passkey: GenFile = /*@__PURE__*/
+  fileDesc("ChlndWFyZGlhbi92MS9wYXNza2V5LnByb3RvEgtndWFyZGlhbi52MSLYAQoHUGFzc2tleRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhoKEmF0dGVzdGF0aW9uX2Zvcm1hdBgDIAEoCRIOCgZhYWd1aWQYBCABKAkSEgoKdHJhbnNwb3J0cxgFIAMoCRIRCgliYWNrZWRfdXAYBiABKAgSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMAoMbGFzdF91c2VkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIhCh9CZWdpblBhc3NrZXlSZWdpc3RyYXRpb25SZXF1ZXN0IngKIEJlZ2luUGFzc2tleVJlZ2lzdHJhdGlvblJlc3BvbnNlEhMKC2NlcmVtb255X2lkGAEgASgJEg8KB29wdGlvbnMYAiABKAkSLgoKZXhwaXJlc19hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiWQogRmluaXNoUGFzc2tleVJlZ2lzdHJhdGlvblJlcXVlc3QSEwoLY2VyZW1vbnlfaWQYASABKAkSEgoKY3JlZGVudGlhbBgCIAEoCRIMCgRuYW1lGAMgASgJIkoKIUZpbmlzaFBhc3NrZXlSZWdpc3RyYXRpb25SZXNwb25zZRIlCgdwYXNza2V5GAEgASgLMhQuZ3VhcmRpYW4udjEuUGFzc2tleSIVChNMaXN0UGFzc2tleXNSZXF1ZXN0Ij4KFExpc3RQYXNza2V5c1Jlc3BvbnNlEiYKCHBhc3NrZXlzGAEgAygLMhQuZ3VhcmRpYW4udjEuUGFzc2tleSIiChREZWxldGVQYXNza2V5UmVxdWVzdBIKCgJpZBgBIAEoCSIXChVEZWxldGVQYXNza2V5UmVzcG9uc2UysgMKDlBhc3NrZXlTZXJ2aWNlEncKGEJlZ2luUGFzc2tleVJlZ2lzdHJhdGlvbhIsLmd1YXJkaWFuLnYxLkJlZ2luUGFzc2tleVJlZ2lzdHJhdGlvblJlcXVlc3QaLS5ndWFyZGlhbi52MS5CZWdpblBhc3NrZXlSZWdpc3RyYXRpb25SZXNwb25zZRJ6ChlGaW5pc2hQYXNza2V5UmVnaXN0cmF0aW9uEi0uZ3VhcmRpYW4udjEuRmluaXNoUGFzc2tleVJlZ2lzdHJhdGlvblJlcXVlc3QaLi5ndWFyZGlhbi52MS5GaW5pc2hQYXNza2V5UmVnaXN0cmF0aW9uUmVzcG9uc2USUwoMTGlzdFBhc3NrZXlzEiAuZ3VhcmRpYW4udjEuTGlzdFBhc3NrZXlzUmVxdWVzdBohLmd1YXJkaWFuLnYxLkxpc3RQYXNza2V5c1Jlc3BvbnNlElYKDURlbGV0ZVBhc3NrZXkSIS5ndWFyZGlhbi52MS5EZWxldGVQYXNza2V5UmVxdWVzdBoiLmd1YXJkaWFuLnYxLkRlbGV0ZVBhc3NrZXlSZXNwb25zZUKrAQoPY29tLmd1YXJkaWFuLnYxQgxQYXNza2V5UHJvdG9QAVo9Z2l0aHViLmNvbS9nb3BoZXJvL2d1YXJkaWFuL2NvcmUvcHJvdG8vZ3VhcmRpYW4vdjE7Z3VhcmRpYW52MaICA0dWWKoCC0d1YXJkaWFuLlYxygILR3VhcmRpYW5cVjHiAhdHdWFyZGlhblxWMVxHUEJNZXRhZGF0YeoCDEd1YXJkaWFuOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp]);
+
+/**
+ * Passkey is a WebAuthn credential of a user.
+ *
+ * @generated from message guardian.v1.Passkey
+ */
+export type Passkey = Message<"guardian.v1.Passkey"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * Attestation statement format the passkey was registered with.
+   *
+   * @generated from field: string attestation_format = 3;
+   */
+  attestationFormat: string;
+
+  /**
+   * Identifies the authenticator model. All zeros for "none" attestation.
+   *
+   * @generated from field: string aaguid = 4;
+   */
+  aaguid: string;
+
+  /**
+   * @generated from field: repeated string transports = 5;
+   */
+  transports: string[];
+
+  /**
+   * Whether the passkey is synced to other devices.
+   *
+   * @generated from field: bool backed_up = 6;
+   */
+  backedUp: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_used_at = 8;
+   */
+  lastUsedAt?: Timestamp;
+};
+
+/**
+ * Describes the message guardian.v1.Passkey.
+ * Use `create(PasskeySchema)` to create a new message.
+ */
+export const PasskeySchema: GenMessage<Passkey> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_passkey, 0);
+
+/**
+ * @generated from message guardian.v1.BeginPasskeyRegistrationRequest
+ */
+export type BeginPasskeyRegistrationRequest = Message<"guardian.v1.BeginPasskeyRegistrationRequest"> & {
+};
+
+/**
+ * Describes the message guardian.v1.BeginPasskeyRegistrationRequest.
+ * Use `create(BeginPasskeyRegistrationRequestSchema)` to create a new message.
+ */
+export const BeginPasskeyRegistrationRequestSchema: GenMessage<BeginPasskeyRegistrationRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_passkey, 1);
+
+/**
+ * @generated from message guardian.v1.BeginPasskeyRegistrationResponse
+ */
+export type BeginPasskeyRegistrationResponse = Message<"guardian.v1.BeginPasskeyRegistrationResponse"> & {
+  /**
+   * @generated from field: string ceremony_id = 1;
+   */
+  ceremonyId: string;
+
+  /**
+   * JSON encoded options for navigator.credentials.create().
+   *
+   * @generated from field: string options = 2;
+   */
+  options: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 3;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message guardian.v1.BeginPasskeyRegistrationResponse.
+ * Use `create(BeginPasskeyRegistrationResponseSchema)` to create a new message.
+ */
+export const BeginPasskeyRegistrationResponseSchema: GenMessage<BeginPasskeyRegistrationResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_passkey, 2);
+
+/**
+ * @generated from message guardian.v1.FinishPasskeyRegistrationRequest
+ */
+export type FinishPasskeyRegistrationRequest = Message<"guardian.v1.FinishPasskeyRegistrationRequest"> & {
+  /**
+   * @generated from field: string ceremony_id = 1;
+   */
+  ceremonyId: string;
+
+  /**
+   * JSON encoded PublicKeyCredential returned by navigator.credentials.create().
+   *
+   * @generated from field: string credential = 2;
+   */
+  credential: string;
+
+  /**
+   * Human readable name of the passkey.
+   *
+   * @generated from field: string name = 3;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message guardian.v1.FinishPasskeyRegistrationRequest.
+ * Use `create(FinishPasskeyRegistrationRequestSchema)` to create a new message.
+ */
+export const FinishPasskeyRegistrationRequestSchema: GenMessage<FinishPasskeyRegistrationRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_passkey, 3);
+
+/**
+ * @generated from message guardian.v1.FinishPasskeyRegistrationResponse
+ */
+export type FinishPasskeyRegistrationResponse = Message<"guardian.v1.FinishPasskeyRegistrationResponse"> & {
+  /**
+   * @generated from field: guardian.v1.Passkey passkey = 1;
+   */
+  passkey?: Passkey;
+};
+
+/**
+ * Describes the message guardian.v1.FinishPasskeyRegistrationResponse.
+ * Use `create(FinishPasskeyRegistrationResponseSchema)` to create a new message.
+ */
+export const FinishPasskeyRegistrationResponseSchema: GenMessage<FinishPasskeyRegistrationResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_passkey, 4);
+
+/**
+ * @generated from message guardian.v1.ListPasskeysRequest
+ */
+export type ListPasskeysRequest = Message<"guardian.v1.ListPasskeysRequest"> & {
+};
+
+/**
+ * Describes the message guardian.v1.ListPasskeysRequest.
+ * Use `create(ListPasskeysRequestSchema)` to create a new message.
+ */
+export const ListPasskeysRequestSchema: GenMessage<ListPasskeysRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_passkey, 5);
+
+/**
+ * @generated from message guardian.v1.ListPasskeysResponse
+ */
+export type ListPasskeysResponse = Message<"guardian.v1.ListPasskeysResponse"> & {
+  /**
+   * @generated from field: repeated guardian.v1.Passkey passkeys = 1;
+   */
+  passkeys: Passkey[];
+};
+
+/**
+ * Describes the message guardian.v1.ListPasskeysResponse.
+ * Use `create(ListPasskeysResponseSchema)` to create a new message.
+ */
+export const ListPasskeysResponseSchema: GenMessage<ListPasskeysResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_passkey, 6);
+
+/**
+ * @generated from message guardian.v1.DeletePasskeyRequest
+ */
+export type DeletePasskeyRequest = Message<"guardian.v1.DeletePasskeyRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message guardian.v1.DeletePasskeyRequest.
+ * Use `create(DeletePasskeyRequestSchema)` to create a new message.
+ */
+export const DeletePasskeyRequestSchema: GenMessage<DeletePasskeyRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_passkey, 7);
+
+/**
+ * @generated from message guardian.v1.DeletePasskeyResponse
+ */
+export type DeletePasskeyResponse = Message<"guardian.v1.DeletePasskeyResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.DeletePasskeyResponse.
+ * Use `create(DeletePasskeyResponseSchema)` to create a new message.
+ */
+export const DeletePasskeyResponseSchema: GenMessage<DeletePasskeyResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_passkey, 8);
+
+/**
+ * PasskeyService manages the passkeys of the caller. Methods expect the access token in the `Authorization: Bearer`
+ * header. Signing in with a passkey is part of AuthService.
+ *
+ * @generated from service guardian.v1.PasskeyService
+ */
+export const PasskeyService: GenService<{
+  /**
+   * BeginPasskeyRegistration starts a WebAuthn registration ceremony for a discoverable passkey.
+   *
+   * @generated from rpc guardian.v1.PasskeyService.BeginPasskeyRegistration
+   */
+  beginPasskeyRegistration: {
+    methodKind: "unary";
+    input: typeof BeginPasskeyRegistrationRequestSchema;
+    output: typeof BeginPasskeyRegistrationResponseSchema;
+  },
+  /**
+   * FinishPasskeyRegistration verifies the credential created by the authenticator and stores it as passkey. Only
+   * "none" and "packed" attestation statements are accepted.
+   *
+   * @generated from rpc guardian.v1.PasskeyService.FinishPasskeyRegistration
+   */
+  finishPasskeyRegistration: {
+    methodKind: "unary";
+    input: typeof FinishPasskeyRegistrationRequestSchema;
+    output: typeof FinishPasskeyRegistrationResponseSchema;
+  },
+  /**
+   * ListPasskeys returns all passkeys of the caller.
+   *
+   * @generated from rpc guardian.v1.PasskeyService.ListPasskeys
+   */
+  listPasskeys: {
+    methodKind: "unary";
+    input: typeof ListPasskeysRequestSchema;
+    output: typeof ListPasskeysResponseSchema;
+  },
+  /**
+   * DeletePasskey deletes a passkey of the caller.
+   *
+   * @generated from rpc guardian.v1.PasskeyService.DeletePasskey
+   */
+  deletePasskey: {
+    methodKind: "unary";
+    input: typeof DeletePasskeyRequestSchema;
+    output: typeof DeletePasskeyResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_guardian_v1_passkey, 0);
+
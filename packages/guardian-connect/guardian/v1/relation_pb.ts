@@ -0,0 +1,789 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts"
+// @generated from file guardian/v1/relation.proto (package guardian.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { AttributeContext, ExplainNode } from "./authz_pb";
+import { file_guardian_v1_authz } from "./authz_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file guardian/v1/relation.proto.
+ */
+export const file_guardian_v1_relation: GenFile = /*@__PURE__*/
+  fileDesc("ChpndWFyZGlhbi92MS9yZWxhdGlvbi5wcm90bxILZ3VhcmRpYW4udjEiKgoJT2JqZWN0UmVmEhEKCW5hbWVzcGFjZRgBIAEoCRIKCgJpZBgCIAEoCSI9CgpTdWJqZWN0UmVmEhEKCW5hbWVzcGFjZRgBIAEoCRIKCgJpZBgCIAEoCRIQCghyZWxhdGlvbhgDIAEoCSKGAQoNUmVsYXRpb25UdXBsZRImCgZvYmplY3QYASABKAsyFi5ndWFyZGlhbi52MS5PYmplY3RSZWYSEAoIcmVsYXRpb24YAiABKAkSKAoHc3ViamVjdBgDIAEoCzIXLmd1YXJkaWFuLnYxLlN1YmplY3RSZWYSEQoJY29uZGl0aW9uGAQgASgJItABCgtVc2Vyc2V0VHJlZRIwCglvcGVyYXRpb24YASABKA4yHS5ndWFyZGlhbi52MS5Vc2Vyc2V0T3BlcmF0aW9uEiYKBm9iamVjdBgCIAEoCzIWLmd1YXJkaWFuLnYxLk9iamVjdFJlZhIQCghyZWxhdGlvbhgDIAEoCRIpCghzdWJqZWN0cxgEIAMoCzIXLmd1YXJkaWFuLnYxLlN1YmplY3RSZWYSKgoIY2hpbGRyZW4YBSADKAsyGC5ndWFyZGlhbi52MS5Vc2Vyc2V0VHJlZSIoChJXcml0ZVNjaGVtYVJlcXVlc3QSEgoKZGVmaW5pdGlvbhgBIAEoCSIlChNXcml0ZVNjaGVtYVJlc3BvbnNlEg4KBnpvb2tpZRgBIAEoCSITChFSZWFkU2NoZW1hUmVxdWVzdCI4ChJSZWFkU2NoZW1hUmVzcG9uc2USEgoKZGVmaW5pdGlvbhgBIAEoCRIOCgZ6b29raWUYAiABKAkibQoSV3JpdGVUdXBsZXNSZXF1ZXN0EioKBndyaXRlcxgBIAMoCzIaLmd1YXJkaWFuLnYxLlJlbGF0aW9uVHVwbGUSKwoHZGVsZXRlcxgCIAMoCzIaLmd1YXJkaWFuLnYxLlJlbGF0aW9uVHVwbGUiJQoTV3JpdGVUdXBsZXNSZXNwb25zZRIOCgZ6b29raWUYASABKAkirAEKEVJlYWRUdXBsZXNSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIRCglvYmplY3RfaWQYAiABKAkSEAoIcmVsYXRpb24YAyABKAkSKAoHc3ViamVjdBgEIAEoCzIXLmd1YXJkaWFuLnYxLlN1YmplY3RSZWYSDgoGem9va2llGAUgASgJEhEKCXBhZ2Vfc2l6ZRgGIAEoBRISCgpwYWdlX3Rva2VuGAcgASgJImkKElJlYWRUdXBsZXNSZXNwb25zZRIqCgZ0dXBsZXMYASADKAsyGi5ndWFyZGlhbi52MS5SZWxhdGlvblR1cGxlEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRIOCgZ6b29raWUYAyABKAkiywEKFENoZWNrUmVsYXRpb25SZXF1ZXN0EiYKBm9iamVjdBgBIAEoCzIWLmd1YXJkaWFuLnYxLk9iamVjdFJlZhIQCghyZWxhdGlvbhgCIAEoCRIoCgdzdWJqZWN0GAMgASgLMhcuZ3VhcmRpYW4udjEuU3ViamVjdFJlZhIOCgZ6b29raWUYBCABKAkSLgoHY29udGV4dBgFIAEoCzIdLmd1YXJkaWFuLnYxLkF0dHJpYnV0ZUNvbnRleHQSDwoHZXhwbGFpbhgGIAEoCCKLAQoVQ2hlY2tSZWxhdGlvblJlc3BvbnNlEg8KB2FsbG93ZWQYASABKAgSDgoGem9va2llGAIgASgJEg4KBnBvbGljeRgDIAEoCRISCgpjb25kaXRpb25zGAQgAygJEi0KC2V4cGxhbmF0aW9uGAUgASgLMhguZ3VhcmRpYW4udjEuRXhwbGFpbk5vZGUikQEKFUV4cGFuZFJlbGF0aW9uUmVxdWVzdBImCgZvYmplY3QYASABKAsyFi5ndWFyZGlhbi52MS5PYmplY3RSZWYSEAoIcmVsYXRpb24YAiABKAkSDgoGem9va2llGAMgASgJEi4KB2NvbnRleHQYBCABKAsyHS5ndWFyZGlhbi52MS5BdHRyaWJ1dGVDb250ZXh0IlAKFkV4cGFuZFJlbGF0aW9uUmVzcG9uc2USJgoEdHJlZRgBIAEoCzIYLmd1YXJkaWFuLnYxLlVzZXJzZXRUcmVlEg4KBnpvb2tpZRgCIAEoCSLKAQoSTGlzdE9iamVjdHNSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIQCghyZWxhdGlvbhgCIAEoCRIoCgdzdWJqZWN0GAMgASgLMhcuZ3VhcmRpYW4udjEuU3ViamVjdFJlZhIOCgZ6b29raWUYBCABKAkSEQoJcGFnZV9zaXplGAUgASgFEhIKCnBhZ2VfdG9rZW4YBiABKAkSLgoHY29udGV4dBgHIAEoCzIdLmd1YXJkaWFuLnYxLkF0dHJpYnV0ZUNvbnRleHQiUgoTTGlzdE9iamVjdHNSZXNwb25zZRISCgpvYmplY3RfaWRzGAEgAygJEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRIOCgZ6b29raWUYAyABKAki0QEKE0xpc3RTdWJqZWN0c1JlcXVlc3QSJgoGb2JqZWN0GAEgASgLMhYuZ3VhcmRpYW4udjEuT2JqZWN0UmVmEhAKCHJlbGF0aW9uGAIgASgJEhkKEXN1YmplY3RfbmFtZXNwYWNlGAMgASgJEg4KBnpvb2tpZRgEIAEoCRIRCglwYWdlX3NpemUYBSABKAUSEgoKcGFnZV90b2tlbhgGIAEoCRIuCgdjb250ZXh0GAcgASgLMh0uZ3VhcmRpYW4udjEuQXR0cmlidXRlQ29udGV4dCJUChRMaXN0U3ViamVjdHNSZXNwb25zZRITCgtzdWJqZWN0X2lkcxgBIAMoCRIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkSDgoGem9va2llGAMgASgJKrMBChBVc2Vyc2V0T3BlcmF0aW9uEiEKHVVTRVJTRVRfT1BFUkFUSU9OX1VOU1BFQ0lGSUVEEAASGgoWVVNFUlNFVF9PUEVSQVRJT05fTEVBRhABEhsKF1VTRVJTRVRfT1BFUkFUSU9OX1VOSU9OEAISIgoeVVNFUlNFVF9PUEVSQVRJT05fSU5URVJTRUNUSU9OEAMSHwobVVNFUlNFVF9PUEVSQVRJT05fRVhDTFVTSU9OEAQyrQUKD1JlbGF0aW9uU2VydmljZRJQCgtXcml0ZVNjaGVtYRIfLmd1YXJkaWFuLnYxLldyaXRlU2NoZW1hUmVxdWVzdBogLmd1YXJkaWFuLnYxLldyaXRlU2NoZW1hUmVzcG9uc2USTQoKUmVhZFNjaGVtYRIeLmd1YXJkaWFuLnYxLlJlYWRTY2hlbWFSZXF1ZXN0Gh8uZ3VhcmRpYW4udjEuUmVhZFNjaGVtYVJlc3BvbnNlElAKC1dyaXRlVHVwbGVzEh8uZ3VhcmRpYW4udjEuV3JpdGVUdXBsZXNSZXF1ZXN0GiAuZ3VhcmRpYW4udjEuV3JpdGVUdXBsZXNSZXNwb25zZRJNCgpSZWFkVHVwbGVzEh4uZ3VhcmRpYW4udjEuUmVhZFR1cGxlc1JlcXVlc3QaHy5ndWFyZGlhbi52MS5SZWFkVHVwbGVzUmVzcG9uc2USVgoNQ2hlY2tSZWxhdGlvbhIhLmd1YXJkaWFuLnYxLkNoZWNrUmVsYXRpb25SZXF1ZXN0GiIuZ3VhcmRpYW4udjEuQ2hlY2tSZWxhdGlvblJlc3BvbnNlElkKDkV4cGFuZFJlbGF0aW9uEiIuZ3VhcmRpYW4udjEuRXhwYW5kUmVsYXRpb25SZXF1ZXN0GiMuZ3VhcmRpYW4udjEuRXhwYW5kUmVsYXRpb25SZXNwb25zZRJQCgtMaXN0T2JqZWN0cxIfLmd1YXJkaWFuLnYxLkxpc3RPYmplY3RzUmVxdWVzdBogLmd1YXJkaWFuLnYxLkxpc3RPYmplY3RzUmVzcG9uc2USUwoMTGlzdFN1YmplY3RzEiAuZ3VhcmRpYW4udjEuTGlzdFN1YmplY3RzUmVxdWVzdBohLmd1YXJkaWFuLnYxLkxpc3RTdWJqZWN0c1Jlc3BvbnNlQqwBCg9jb20uZ3VhcmRpYW4udjFCDVJlbGF0aW9uUHJvdG9QAVo9Z2l0aHViLmNvbS9nb3BoZXJvL2d1YXJkaWFuL2NvcmUvcHJvdG8vZ3VhcmRpYW4vdjE7Z3VhcmRpYW52MaICA0dWWKoCC0d1YXJkaWFuLlYxygILR3VhcmRpYW5cVjHiAhdHdWFyZGlhblxWMVxHUEJNZXRhZGF0YeoCDEd1YXJkaWFuOjpWMWIGcHJvdG8z", [file_guardian_v1_authz]);
+
+/**
+ * ObjectRef identifies an object like `document:readme`.
+ *
+ * @generated from message guardian.v1.ObjectRef
+ */
+export type ObjectRef = Message<"guardian.v1.ObjectRef"> & {
+  /**
+   * @generated from field: string namespace = 1;
+   */
+  namespace: string;
+
+  /**
+   * @generated from field: string id = 2;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message guardian.v1.ObjectRef.
+ * Use `create(ObjectRefSchema)` to create a new message.
+ */
+export const ObjectRefSchema: GenMessage<ObjectRef> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_relation, 0);
+
+/**
+ * SubjectRef is an object or, with a relation, the set of subjects having the relation to the object.
+ *
+ * @generated from message guardian.v1.SubjectRef
+ */
+export type SubjectRef = Message<"guardian.v1.SubjectRef"> & {
+  /**
+   * @generated from field: string namespace = 1;
+   */
+  namespace: string;
+
+  /**
+   * @generated from field: string id = 2;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string relation = 3;
+   */
+  relation: string;
+};
+
+/**
+ * Describes the message guardian.v1.SubjectRef.
+ * Use `create(SubjectRefSchema)` to create a new message.
+ */
+export const SubjectRefSchema: GenMessage<SubjectRef> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_relation, 1);
+
+/**
+ * RelationTuple relates an object to a subject.
+ *
+ * @generated from message guardian.v1.RelationTuple
+ */
+export type RelationTuple = Message<"guardian.v1.RelationTuple"> & {
+  /**
+   * @generated from field: guardian.v1.ObjectRef object = 1;
+   */
+  object?: ObjectRef;
+
+  /**
+   * @generated from field: string relation = 2;
+   */
+  relation: string;
+
+  /**
+   * @generated from field: guardian.v1.SubjectRef subject = 3;
+   */
+  subject?: SubjectRef;
+
+  /**
+   * Name of a condition the tuple requires, unconditional if empty. It is not part of the identity of a tuple.
+   *
+   * @generated from field: string condition = 4;
+   */
+  condition: string;
+};
+
+/**
+ * Describes the message guardian.v1.RelationTuple.
+ * Use `create(RelationTupleSchema)` to create a new message.
+ */
+export const RelationTupleSchema: GenMessage<RelationTuple> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_relation, 2);
+
+/**
+ * UsersetTree is the expansion of the subjects having a relation to an object.
+ *
+ * @generated from message guardian.v1.UsersetTree
+ */
+export type UsersetTree = Message<"guardian.v1.UsersetTree"> & {
+  /**
+   * @generated from field: guardian.v1.UsersetOperation operation = 1;
+   */
+  operation: UsersetOperation;
+
+  /**
+   * @generated from field: guardian.v1.ObjectRef object = 2;
+   */
+  object?: ObjectRef;
+
+  /**
+   * @generated from field: string relation = 3;
+   */
+  relation: string;
+
+  /**
+   * @generated from field: repeated guardian.v1.SubjectRef subjects = 4;
+   */
+  subjects: SubjectRef[];
+
+  /**
+   * @generated from field: repeated guardian.v1.UsersetTree children = 5;
+   */
+  children: UsersetTree[];
+};
+
+/**
+ * Describes the message guardian.v1.UsersetTree.
+ * Use `create(UsersetTreeSchema)` to create a new message.
+ */
+export const UsersetTreeSchema: GenMessage<UsersetTree> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_relation, 3);
+
+/**
+ * @generated from message guardian.v1.WriteSchemaRequest
+ */
+export type WriteSchemaRequest = Message<"guardian.v1.WriteSchemaRequest"> & {
+  /**
+   * @generated from field: string definition = 1;
+   */
+  definition: string;
+};
+
+/**
+ * Describes the message guardian.v1.WriteSchemaRequest.
+ * Use `create(WriteSchemaRequestSchema)` to create a new message.
+ */
+export const WriteSchemaRequestSchema: GenMessage<WriteSchemaRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_relation, 4);
+
+/**
+ * @generated from message guardian.v1.WriteSchemaResponse
+ */
+export type WriteSchemaResponse = Message<"guardian.v1.WriteSchemaResponse"> & {
+  /**
+   * @generated from field: string zookie = 1;
+   */
+  zookie: string;
+};
+
+/**
+ * Describes the message guardian.v1.WriteSchemaResponse.
+ * Use `create(WriteSchemaResponseSchema)` to create a new message.
+ */
+export const WriteSchemaResponseSchema: GenMessage<WriteSchemaResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_relation, 5);
+
+/**
+ * @generated from message guardian.v1.ReadSchemaRequest
+ */
+export type ReadSchemaRequest = Message<"guardian.v1.ReadSchemaRequest"> & {
+};
+
+/**
+ * Describes the message guardian.v1.ReadSchemaRequest.
+ * Use `create(ReadSchemaRequestSchema)` to create a new message.
+ */
+export const ReadSchemaRequestSchema: GenMessage<ReadSchemaRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_relation, 6);
+
+/**
+ * @generated from message guardian.v1.ReadSchemaResponse
+ */
+export type ReadSchemaResponse = Message<"guardian.v1.ReadSchemaResponse"> & {
+  /**
+   * @generated from field: string definition = 1;
+   */
+  definition: string;
+
+  /**
+   * @generated from field: string zookie = 2;
+   */
+  zookie: string;
+};
+
+/**
+ * Describes the message guardian.v1.ReadSchemaResponse.
+ * Use `create(ReadSchemaResponseSchema)` to create a new message.
+ */
+export const ReadSchemaResponseSchema: GenMessage<ReadSchemaResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_relation, 7);
+
+/**
+ * @generated from message guardian.v1.WriteTuplesRequest
+ */
+export type WriteTuplesRequest = Message<"guardian.v1.WriteTuplesRequest"> & {
+  /**
+   * @generated from field: repeated guardian.v1.RelationTuple writes = 1;
+   */
+  writes: RelationTuple[];
+
+  /**
+   * @generated from field: repeated guardian.v1.RelationTuple deletes = 2;
+   */
+  deletes: RelationTuple[];
+};
+
+/**
+ * Describes the message guardian.v1.WriteTuplesRequest.
+ * Use `create(WriteTuplesRequestSchema)` to create a new message.
+ */
+export const WriteTuplesRequestSchema: GenMessage<WriteTuplesRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_relation, 8);
+
+/**
+ * @generated from message guardian.v1.WriteTuplesResponse
+ */
+export type WriteTuplesResponse = Message<"guardian.v1.WriteTuplesResponse"> & {
+  /**
+   * @generated from field: string zookie = 1;
+   */
+  zookie: string;
+};
+
+/**
+ * Describes the message guardian.v1.WriteTuplesResponse.
+ * Use `create(WriteTuplesResponseSchema)` to create a new message.
+ */
+export const WriteTuplesResponseSchema: GenMessage<WriteTuplesResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_relation, 9);
+
+/**
+ * @generated from message guardian.v1.ReadTuplesRequest
+ */
+export type ReadTuplesRequest = Message<"guardian.v1.ReadTuplesRequest"> & {
+  /**
+   * @generated from field: string namespace = 1;
+   */
+  namespace: string;
+
+  /**
+   * Filters by object id if not empty.
+   *
+   * @generated from field: string object_id = 2;
+   */
+  objectId: string;
+
+  /**
+   * Filters by relation if not empty.
+   *
+   * @generated from field: string relation = 3;
+   */
+  relation: string;
+
+  /**
+   * Filters by subject if set, including an empty subject relation.
+   *
+   * @generated from field: guardian.v1.SubjectRef subject = 4;
+   */
+  subject?: SubjectRef;
+
+  /**
+   * @generated from field: string zookie = 5;
+   */
+  zookie: string;
+
+  /**
+   * @generated from field: int32 page_size = 6;
+   */
+  pageSize: number;
+
+  /**
+   * The next_page_token of the previous response.
+   *
+   * @generated from field: string page_token = 7;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message guardian.v1.ReadTuplesRequest.
+ * Use `create(ReadTuplesRequestSchema)` to create a new message.
+ */
+export const ReadTuplesRequestSchema: GenMessage<ReadTuplesRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_relation, 10);
+
+/**
+ * @generated from message guardian.v1.ReadTuplesResponse
+ */
+export type ReadTuplesResponse = Message<"guardian.v1.ReadTuplesResponse"> & {
+  /**
+   * @generated from field: repeated guardian.v1.RelationTuple tuples = 1;
+   */
+  tuples: RelationTuple[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  /**
+   * @generated from field: string zookie = 3;
+   */
+  zookie: string;
+};
+
+/**
+ * Describes the message guardian.v1.ReadTuplesResponse.
+ * Use `create(ReadTuplesResponseSchema)` to create a new message.
+ */
+export const ReadTuplesResponseSchema: GenMessage<ReadTuplesResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_relation, 11);
+
+/**
+ * @generated from message guardian.v1.CheckRelationRequest
+ */
+export type CheckRelationRequest = Message<"guardian.v1.CheckRelationRequest"> & {
+  /**
+   * @generated from field: guardian.v1.ObjectRef object = 1;
+   */
+  object?: ObjectRef;
+
+  /**
+   * @generated from field: string relation = 2;
+   */
+  relation: string;
+
+  /**
+   * @generated from field: guardian.v1.SubjectRef subject = 3;
+   */
+  subject?: SubjectRef;
+
+  /**
+   * @generated from field: string zookie = 4;
+   */
+  zookie: string;
+
+  /**
+   * @generated from field: guardian.v1.AttributeContext context = 5;
+   */
+  context?: AttributeContext;
+
+  /**
+   * Returns the evaluation tree of the check. Relations evaluated before in the same check are not explained again.
+   *
+   * @generated from field: bool explain = 6;
+   */
+  explain: boolean;
+};
+
+/**
+ * Describes the message guardian.v1.CheckRelationRequest.
+ * Use `create(CheckRelationRequestSchema)` to create a new message.
+ */
+export const CheckRelationRequestSchema: GenMessage<CheckRelationRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_relation, 12);
+
+/**
+ * @generated from message guardian.v1.CheckRelationResponse
+ */
+export type CheckRelationResponse = Message<"guardian.v1.CheckRelationResponse"> & {
+  /**
+   * @generated from field: bool allowed = 1;
+   */
+  allowed: boolean;
+
+  /**
+   * @generated from field: string zookie = 2;
+   */
+  zookie: string;
+
+  /**
+   * The tuple which contains the subject, empty if denied.
+   *
+   * @generated from field: string policy = 3;
+   */
+  policy: string;
+
+  /**
+   * Conditions of tuples which held for the subject to have the relation.
+   *
+   * @generated from field: repeated string conditions = 4;
+   */
+  conditions: string[];
+
+  /**
+   * The evaluation tree if the check was explained.
+   *
+   * @generated from field: guardian.v1.ExplainNode explanation = 5;
+   */
+  explanation?: ExplainNode;
+};
+
+/**
+ * Describes the message guardian.v1.CheckRelationResponse.
+ * Use `create(CheckRelationResponseSchema)` to create a new message.
+ */
+export const CheckRelationResponseSchema: GenMessage<CheckRelationResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_relation, 13);
+
+/**
+ * @generated from message guardian.v1.ExpandRelationRequest
+ */
+export type ExpandRelationRequest = Message<"guardian.v1.ExpandRelationRequest"> & {
+  /**
+   * @generated from field: guardian.v1.ObjectRef object = 1;
+   */
+  object?: ObjectRef;
+
+  /**
+   * @generated from field: string relation = 2;
+   */
+  relation: string;
+
+  /**
+   * @generated from field: string zookie = 3;
+   */
+  zookie: string;
+
+  /**
+   * @generated from field: guardian.v1.AttributeContext context = 4;
+   */
+  context?: AttributeContext;
+};
+
+/**
+ * Describes the message guardian.v1.ExpandRelationRequest.
+ * Use `create(ExpandRelationRequestSchema)` to create a new message.
+ */
+export const ExpandRelationRequestSchema: GenMessage<ExpandRelationRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_relation, 14);
+
+/**
+ * @generated from message guardian.v1.ExpandRelationResponse
+ */
+export type ExpandRelationResponse = Message<"guardian.v1.ExpandRelationResponse"> & {
+  /**
+   * @generated from field: guardian.v1.UsersetTree tree = 1;
+   */
+  tree?: UsersetTree;
+
+  /**
+   * @generated from field: string zookie = 2;
+   */
+  zookie: string;
+};
+
+/**
+ * Describes the message guardian.v1.ExpandRelationResponse.
+ * Use `create(ExpandRelationResponseSchema)` to create a new message.
+ */
+export const ExpandRelationResponseSchema: GenMessage<ExpandRelationResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_relation, 15);
+
+/**
+ * @generated from message guardian.v1.ListObjectsRequest
+ */
+export type ListObjectsRequest = Message<"guardian.v1.ListObjectsRequest"> & {
+  /**
+   * @generated from field: string namespace = 1;
+   */
+  namespace: string;
+
+  /**
+   * @generated from field: string relation = 2;
+   */
+  relation: string;
+
+  /**
+   * @generated from field: guardian.v1.SubjectRef subject = 3;
+   */
+  subject?: SubjectRef;
+
+  /**
+   * @generated from field: string zookie = 4;
+   */
+  zookie: string;
+
+  /**
+   * @generated from field: int32 page_size = 5;
+   */
+  pageSize: number;
+
+  /**
+   * The next_page_token of the previous response.
+   *
+   * @generated from field: string page_token = 6;
+   */
+  pageToken: string;
+
+  /**
+   * @generated from field: guardian.v1.AttributeContext context = 7;
+   */
+  context?: AttributeContext;
+};
+
+/**
+ * Describes the message guardian.v1.ListObjectsRequest.
+ * Use `create(ListObjectsRequestSchema)` to create a new message.
+ */
+export const ListObjectsRequestSchema: GenMessage<ListObjectsRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_relation, 16);
+
+/**
+ * @generated from message guardian.v1.ListObjectsResponse
+ */
+export type ListObjectsResponse = Message<"guardian.v1.ListObjectsResponse"> & {
+  /**
+   * @generated from field: repeated string object_ids = 1;
+   */
+  objectIds: string[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  /**
+   * @generated from field: string zookie = 3;
+   */
+  zookie: string;
+};
+
+/**
+ * Describes the message guardian.v1.ListObjectsResponse.
+ * Use `create(ListObjectsResponseSchema)` to create a new message.
+ */
+export const ListObjectsResponseSchema: GenMessage<ListObjectsResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_relation, 17);
+
+/**
+ * @generated from message guardian.v1.ListSubjectsRequest
+ */
+export type ListSubjectsRequest = Message<"guardian.v1.ListSubjectsRequest"> & {
+  /**
+   * @generated from field: guardian.v1.ObjectRef object = 1;
+   */
+  object?: ObjectRef;
+
+  /**
+   * @generated from field: string relation = 2;
+   */
+  relation: string;
+
+  /**
+   * @generated from field: string subject_namespace = 3;
+   */
+  subjectNamespace: string;
+
+  /**
+   * @generated from field: string zookie = 4;
+   */
+  zookie: string;
+
+  /**
+   * @generated from field: int32 page_size = 5;
+   */
+  pageSize: number;
+
+  /**
+   * The next_page_token of the previous response.
+   *
+   * @generated from field: string page_token = 6;
+   */
+  pageToken: string;
+
+  /**
+   * @generated from field: guardian.v1.AttributeContext context = 7;
+   */
+  context?: AttributeContext;
+};
+
+/**
+ * Describes the message guardian.v1.ListSubjectsRequest.
+ * Use `create(ListSubjectsRequestSchema)` to create a new message.
+ */
+export const ListSubjectsRequestSchema: GenMessage<ListSubjectsRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_relation, 18);
+
+/**
+ * @generated from message guardian.v1.ListSubjectsResponse
+ */
+export type ListSubjectsResponse = Message<"guardian.v1.ListSubjectsResponse"> & {
+  /**
+   * @generated from field: repeated string subject_ids = 1;
+   */
+  subjectIds: string[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  /**
+   * @generated from field: string zookie = 3;
+   */
+  zookie: string;
+};
+
+/**
+ * Describes the message guardian.v1.ListSubjectsResponse.
+ * Use `create(ListSubjectsResponseSchema)` to create a new message.
+ */
+export const ListSubjectsResponseSchema: GenMessage<ListSubjectsResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_relation, 19);
+
+/**
+ * UsersetOperation is the kind of a UsersetTree node.
+ *
+ * @generated from enum guardian.v1.UsersetOperation
+ */
+export enum UsersetOperation {
+  /**
+   * @generated from enum value: USERSET_OPERATION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Leaves hold the subjects of tuples, which may be usersets that are not expanded further.
+   *
+   * @generated from enum value: USERSET_OPERATION_LEAF = 1;
+   */
+  LEAF = 1,
+
+  /**
+   * @generated from enum value: USERSET_OPERATION_UNION = 2;
+   */
+  UNION = 2,
+
+  /**
+   * @generated from enum value: USERSET_OPERATION_INTERSECTION = 3;
+   */
+  INTERSECTION = 3,
+
+  /**
+   * The second child is subtracted from the first.
+   *
+   * @generated from enum value: USERSET_OPERATION_EXCLUSION = 4;
+   */
+  EXCLUSION = 4,
+}
+
+/**
+ * Describes the enum guardian.v1.UsersetOperation.
+ */
+export const UsersetOperationSchema: GenEnum<UsersetOperation> = /*@__PURE__*/
+  enumDesc(file_guardian_v1_relation, 0);
+
+/**
+ * RelationService stores relation tuples and answers relationship-based access control questions like "is user:ada a
+ * viewer of document:readme". Methods expect the access token in the `Authorization: Bearer` header. Writing requires
+ * the global `guardian.authz.manage` permission and reading the global `guardian.authz.check` permission.
+ *
+ * The schema defines the relations of each namespace in a small language:
+ *
+ *   namespace user {}
+ *
+ *   namespace team {
+ *     relation member: user | team#member
+ *   }
+ *
+ *   namespace document {
+ *     relation parent: folder
+ *     relation owner: user
+ *     relation editor: user | team#member
+ *     relation banned: user
+ *     relation edit = (owner | editor) - banned
+ *     relation viewer: user | team#member = this | edit | parent->view
+ *   }
+ *
+ * Relations with subject types after a colon can be written as tuples. Relations after an equals sign are computed:
+ * `this` are the written tuples, a relation name are the subjects of that relation to the same object and
+ * `tupleset->relation` are the subjects having the relation to the objects related by the tupleset. They are
+ * combined with `|` (union), `&` (intersection) and `-` (exclusion).
+ *
+ * Tuples can require a condition of the AuthzService. Evaluations ignore them while the condition does not hold for
+ * the attributes in the context of the request.
+ *
+ * Writes return a zookie. Passing it to reads guarantees that they observe the write.
+ *
+ * @generated from service guardian.v1.RelationService
+ */
+export const RelationService: GenService<{
+  /**
+   * WriteSchema replaces the schema. Fails with INVALID_ARGUMENT if the schema is invalid or does not allow tuples
+   * which are stored.
+   *
+   * @generated from rpc guardian.v1.RelationService.WriteSchema
+   */
+  writeSchema: {
+    methodKind: "unary";
+    input: typeof WriteSchemaRequestSchema;
+    output: typeof WriteSchemaResponseSchema;
+  },
+  /**
+   * ReadSchema returns the current schema.
+   *
+   * @generated from rpc guardian.v1.RelationService.ReadSchema
+   */
+  readSchema: {
+    methodKind: "unary";
+    input: typeof ReadSchemaRequestSchema;
+    output: typeof ReadSchemaResponseSchema;
+  },
+  /**
+   * WriteTuples atomically deletes and writes tuples. Writing existing tuples replaces their condition and deleting
+   * missing tuples does nothing. Fails with NOT_FOUND if a condition does not exist.
+   *
+   * @generated from rpc guardian.v1.RelationService.WriteTuples
+   */
+  writeTuples: {
+    methodKind: "unary";
+    input: typeof WriteTuplesRequestSchema;
+    output: typeof WriteTuplesResponseSchema;
+  },
+  /**
+   * ReadTuples lists the tuples of a namespace matching a filter ordered by their creation.
+   *
+   * @generated from rpc guardian.v1.RelationService.ReadTuples
+   */
+  readTuples: {
+    methodKind: "unary";
+    input: typeof ReadTuplesRequestSchema;
+    output: typeof ReadTuplesResponseSchema;
+  },
+  /**
+   * CheckRelation reports whether a subject has a relation to an object, and explains the evaluation if requested.
+   *
+   * @generated from rpc guardian.v1.RelationService.CheckRelation
+   */
+  checkRelation: {
+    methodKind: "unary";
+    input: typeof CheckRelationRequestSchema;
+    output: typeof CheckRelationResponseSchema;
+  },
+  /**
+   * ExpandRelation returns the tree of subjects having a relation to an object.
+   *
+   * @generated from rpc guardian.v1.RelationService.ExpandRelation
+   */
+  expandRelation: {
+    methodKind: "unary";
+    input: typeof ExpandRelationRequestSchema;
+    output: typeof ExpandRelationResponseSchema;
+  },
+  /**
+   * ListObjects lists the objects of a namespace a subject has a relation to, ordered by id.
+   *
+   * @generated from rpc guardian.v1.RelationService.ListObjects
+   */
+  listObjects: {
+    methodKind: "unary";
+    input: typeof ListObjectsRequestSchema;
+    output: typeof ListObjectsResponseSchema;
+  },
+  /**
+   * ListSubjects lists the objects of a namespace which have a relation to an object, ordered by id.
+   *
+   * @generated from rpc guardian.v1.RelationService.ListSubjects
+   */
+  listSubjects: {
+    methodKind: "unary";
+    input: typeof ListSubjectsRequestSchema;
+    output: typeof ListSubjectsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_guardian_v1_relation, 0);
+
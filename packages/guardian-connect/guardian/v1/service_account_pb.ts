@@ -0,0 +1,574 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts"
+// @generated from file guardian/v1/service_account.proto (package guardian.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Duration, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_duration, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file guardian/v1/service_account.proto.
+ */
+export const file_guardian_v1_service_account: GenFile = /*@__PURE__*/
+  fileDesc("CiFndWFyZGlhbi92MS9zZXJ2aWNlX2FjY291bnQucHJvdG8SC2d1YXJkaWFuLnYxIpgCCg5TZXJ2aWNlQWNjb3VudBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEg4KBnNjb3BlcxgEIAMoCRIuCgpjcmVhdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpyb3RhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI3ChNwcmV2aW91c19leHBpcmVzX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKOAQoRU2VydmljZUFjY291bnRLZXkSCgoCaWQYASABKAkSGgoSc2VydmljZV9hY2NvdW50X2lkGAIgASgJEg4KBmtleV9pZBgDIAEoCRIRCglhbGdvcml0aG0YBCABKAkSLgoKY3JlYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiUAobQ3JlYXRlU2VydmljZUFjY291bnRSZXF1ZXN0EgwKBG5hbWUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSDgoGc2NvcGVzGAMgAygJImsKHENyZWF0ZVNlcnZpY2VBY2NvdW50UmVzcG9uc2USNAoPc2VydmljZV9hY2NvdW50GAEgASgLMhsuZ3VhcmRpYW4udjEuU2VydmljZUFjY291bnQSFQoNY2xpZW50X3NlY3JldBgCIAEoCSImChhHZXRTZXJ2aWNlQWNjb3VudFJlcXVlc3QSCgoCaWQYASABKAkiUQoZR2V0U2VydmljZUFjY291bnRSZXNwb25zZRI0Cg9zZXJ2aWNlX2FjY291bnQYASABKAsyGy5ndWFyZGlhbi52MS5TZXJ2aWNlQWNjb3VudCIcChpMaXN0U2VydmljZUFjY291bnRzUmVxdWVzdCJUChtMaXN0U2VydmljZUFjY291bnRzUmVzcG9uc2USNQoQc2VydmljZV9hY2NvdW50cxgBIAMoCzIbLmd1YXJkaWFuLnYxLlNlcnZpY2VBY2NvdW50IlwKG1VwZGF0ZVNlcnZpY2VBY2NvdW50UmVxdWVzdBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEg4KBnNjb3BlcxgEIAMoCSJUChxVcGRhdGVTZXJ2aWNlQWNjb3VudFJlc3BvbnNlEjQKD3NlcnZpY2VfYWNjb3VudBgBIAEoCzIbLmd1YXJkaWFuLnYxLlNlcnZpY2VBY2NvdW50IikKG0RlbGV0ZVNlcnZpY2VBY2NvdW50UmVxdWVzdBIKCgJpZBgBIAEoCSIeChxEZWxldGVTZXJ2aWNlQWNjb3VudFJlc3BvbnNlIlsKIVJvdGF0ZVNlcnZpY2VBY2NvdW50U2VjcmV0UmVxdWVzdBIKCgJpZBgBIAEoCRIqCgdvdmVybGFwGAIgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uInEKIlJvdGF0ZVNlcnZpY2VBY2NvdW50U2VjcmV0UmVzcG9uc2USNAoPc2VydmljZV9hY2NvdW50GAEgASgLMhsuZ3VhcmRpYW4udjEuU2VydmljZUFjY291bnQSFQoNY2xpZW50X3NlY3JldBgCIAEoCSJGChtBZGRTZXJ2aWNlQWNjb3VudEtleVJlcXVlc3QSGgoSc2VydmljZV9hY2NvdW50X2lkGAEgASgJEgsKA2p3axgCIAEoCSJLChxBZGRTZXJ2aWNlQWNjb3VudEtleVJlc3BvbnNlEisKA2tleRgBIAEoCzIeLmd1YXJkaWFuLnYxLlNlcnZpY2VBY2NvdW50S2V5IjsKHUxpc3RTZXJ2aWNlQWNjb3VudEtleXNSZXF1ZXN0EhoKEnNlcnZpY2VfYWNjb3VudF9pZBgBIAEoCSJOCh5MaXN0U2VydmljZUFjY291bnRLZXlzUmVzcG9uc2USLAoEa2V5cxgBIAMoCzIeLmd1YXJkaWFuLnYxLlNlcnZpY2VBY2NvdW50S2V5IkgKHkRlbGV0ZVNlcnZpY2VBY2NvdW50S2V5UmVxdWVzdBIaChJzZXJ2aWNlX2FjY291bnRfaWQYASABKAkSCgoCaWQYAiABKAkiIQofRGVsZXRlU2VydmljZUFjY291bnRLZXlSZXNwb25zZTKBCAoVU2VydmljZUFjY291bnRTZXJ2aWNlEmsKFENyZWF0ZVNlcnZpY2VBY2NvdW50EiguZ3VhcmRpYW4udjEuQ3JlYXRlU2VydmljZUFjY291bnRSZXF1ZXN0GikuZ3VhcmRpYW4udjEuQ3JlYXRlU2VydmljZUFjY291bnRSZXNwb25zZRJiChFHZXRTZXJ2aWNlQWNjb3VudBIlLmd1YXJkaWFuLnYxLkdldFNlcnZpY2VBY2NvdW50UmVxdWVzdBomLmd1YXJkaWFuLnYxLkdldFNlcnZpY2VBY2NvdW50UmVzcG9uc2USaAoTTGlzdFNlcnZpY2VBY2NvdW50cxInLmd1YXJkaWFuLnYxLkxpc3RTZXJ2aWNlQWNjb3VudHNSZXF1ZXN0GiguZ3VhcmRpYW4udjEuTGlzdFNlcnZpY2VBY2NvdW50c1Jlc3BvbnNlEmsKFFVwZGF0ZVNlcnZpY2VBY2NvdW50EiguZ3VhcmRpYW4udjEuVXBkYXRlU2VydmljZUFjY291bnRSZXF1ZXN0GikuZ3VhcmRpYW4udjEuVXBkYXRlU2VydmljZUFjY291bnRSZXNwb25zZRJrChREZWxldGVTZXJ2aWNlQWNjb3VudBIoLmd1YXJkaWFuLnYxLkRlbGV0ZVNlcnZpY2VBY2NvdW50UmVxdWVzdBopLmd1YXJkaWFuLnYxLkRlbGV0ZVNlcnZpY2VBY2NvdW50UmVzcG9uc2USfQoaUm90YXRlU2VydmljZUFjY291bnRTZWNyZXQSLi5ndWFyZGlhbi52MS5Sb3RhdGVTZXJ2aWNlQWNjb3VudFNlY3JldFJlcXVlc3QaLy5ndWFyZGlhbi52MS5Sb3RhdGVTZXJ2aWNlQWNjb3VudFNlY3JldFJlc3BvbnNlEmsKFEFkZFNlcnZpY2VBY2NvdW50S2V5EiguZ3VhcmRpYW4udjEuQWRkU2VydmljZUFjY291bnRLZXlSZXF1ZXN0GikuZ3VhcmRpYW4udjEuQWRkU2VydmljZUFjY291bnRLZXlSZXNwb25zZRJxChZMaXN0U2VydmljZUFjY291bnRLZXlzEiouZ3VhcmRpYW4udjEuTGlzdFNlcnZpY2VBY2NvdW50S2V5c1JlcXVlc3QaKy5ndWFyZGlhbi52MS5MaXN0U2VydmljZUFjY291bnRLZXlzUmVzcG9uc2USdAoXRGVsZXRlU2VydmljZUFjY291bnRLZXkSKy5ndWFyZGlhbi52MS5EZWxldGVTZXJ2aWNlQWNjb3VudEtleVJlcXVlc3QaLC5ndWFyZGlhbi52MS5EZWxldGVTZXJ2aWNlQWNjb3VudEtleVJlc3BvbnNlQrMBCg9jb20uZ3VhcmRpYW4udjFCFFNlcnZpY2VfYWNjb3VudFByb3RvUAFaPWdpdGh1Yi5jb20vZ29waGVyby9ndWFyZGlhbi9jb3JlL3Byb3RvL2d1YXJkaWFuL3YxO2d1YXJkaWFudjGiAgNHVliqAgtHdWFyZGlhbi5WMcoCC0d1YXJkaWFuXFYx4gIXR3VhcmRpYW5cVjFcR1BCTWV0YWRhdGHqAgxHdWFyZGlhbjo6VjFiBnByb3RvMw", [file_google_protobuf_duration, file_google_protobuf_timestamp]);
+
+/**
+ * ServiceAccount is a non-human principal, like a backend job.
+ *
+ * @generated from message guardian.v1.ServiceAccount
+ */
+export type ServiceAccount = Message<"guardian.v1.ServiceAccount"> & {
+  /**
+   * The client_id of the account.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * Scopes the account may request, sorted by name.
+   *
+   * @generated from field: repeated string scopes = 4;
+   */
+  scopes: string[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 6;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp rotated_at = 7;
+   */
+  rotatedAt?: Timestamp;
+
+  /**
+   * Time until which the secret replaced by the last rotation stays valid, unset if it is not valid anymore.
+   *
+   * @generated from field: google.protobuf.Timestamp previous_expires_at = 8;
+   */
+  previousExpiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message guardian.v1.ServiceAccount.
+ * Use `create(ServiceAccountSchema)` to create a new message.
+ */
+export const ServiceAccountSchema: GenMessage<ServiceAccount> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_service_account, 0);
+
+/**
+ * ServiceAccountKey is a public key verifying the client assertions of a service account.
+ *
+ * @generated from message guardian.v1.ServiceAccountKey
+ */
+export type ServiceAccountKey = Message<"guardian.v1.ServiceAccountKey"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string service_account_id = 2;
+   */
+  serviceAccountId: string;
+
+  /**
+   * The `kid` header of assertions signed with the key.
+   *
+   * @generated from field: string key_id = 3;
+   */
+  keyId: string;
+
+  /**
+   * The JWS algorithm of the key, `EdDSA`, `ES256` or `RS256`.
+   *
+   * @generated from field: string algorithm = 4;
+   */
+  algorithm: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message guardian.v1.ServiceAccountKey.
+ * Use `create(ServiceAccountKeySchema)` to create a new message.
+ */
+export const ServiceAccountKeySchema: GenMessage<ServiceAccountKey> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_service_account, 1);
+
+/**
+ * @generated from message guardian.v1.CreateServiceAccountRequest
+ */
+export type CreateServiceAccountRequest = Message<"guardian.v1.CreateServiceAccountRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * @generated from field: repeated string scopes = 3;
+   */
+  scopes: string[];
+};
+
+/**
+ * Describes the message guardian.v1.CreateServiceAccountRequest.
+ * Use `create(CreateServiceAccountRequestSchema)` to create a new message.
+ */
+export const CreateServiceAccountRequestSchema: GenMessage<CreateServiceAccountRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_service_account, 2);
+
+/**
+ * @generated from message guardian.v1.CreateServiceAccountResponse
+ */
+export type CreateServiceAccountResponse = Message<"guardian.v1.CreateServiceAccountResponse"> & {
+  /**
+   * @generated from field: guardian.v1.ServiceAccount service_account = 1;
+   */
+  serviceAccount?: ServiceAccount;
+
+  /**
+   * @generated from field: string client_secret = 2;
+   */
+  clientSecret: string;
+};
+
+/**
+ * Describes the message guardian.v1.CreateServiceAccountResponse.
+ * Use `create(CreateServiceAccountResponseSchema)` to create a new message.
+ */
+export const CreateServiceAccountResponseSchema: GenMessage<CreateServiceAccountResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_service_account, 3);
+
+/**
+ * @generated from message guardian.v1.GetServiceAccountRequest
+ */
+export type GetServiceAccountRequest = Message<"guardian.v1.GetServiceAccountRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message guardian.v1.GetServiceAccountRequest.
+ * Use `create(GetServiceAccountRequestSchema)` to create a new message.
+ */
+export const GetServiceAccountRequestSchema: GenMessage<GetServiceAccountRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_service_account, 4);
+
+/**
+ * @generated from message guardian.v1.GetServiceAccountResponse
+ */
+export type GetServiceAccountResponse = Message<"guardian.v1.GetServiceAccountResponse"> & {
+  /**
+   * @generated from field: guardian.v1.ServiceAccount service_account = 1;
+   */
+  serviceAccount?: ServiceAccount;
+};
+
+/**
+ * Describes the message guardian.v1.GetServiceAccountResponse.
+ * Use `create(GetServiceAccountResponseSchema)` to create a new message.
+ */
+export const GetServiceAccountResponseSchema: GenMessage<GetServiceAccountResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_service_account, 5);
+
+/**
+ * @generated from message guardian.v1.ListServiceAccountsRequest
+ */
+export type ListServiceAccountsRequest = Message<"guardian.v1.ListServiceAccountsRequest"> & {
+};
+
+/**
+ * Describes the message guardian.v1.ListServiceAccountsRequest.
+ * Use `create(ListServiceAccountsRequestSchema)` to create a new message.
+ */
+export const ListServiceAccountsRequestSchema: GenMessage<ListServiceAccountsRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_service_account, 6);
+
+/**
+ * @generated from message guardian.v1.ListServiceAccountsResponse
+ */
+export type ListServiceAccountsResponse = Message<"guardian.v1.ListServiceAccountsResponse"> & {
+  /**
+   * @generated from field: repeated guardian.v1.ServiceAccount service_accounts = 1;
+   */
+  serviceAccounts: ServiceAccount[];
+};
+
+/**
+ * Describes the message guardian.v1.ListServiceAccountsResponse.
+ * Use `create(ListServiceAccountsResponseSchema)` to create a new message.
+ */
+export const ListServiceAccountsResponseSchema: GenMessage<ListServiceAccountsResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_service_account, 7);
+
+/**
+ * @generated from message guardian.v1.UpdateServiceAccountRequest
+ */
+export type UpdateServiceAccountRequest = Message<"guardian.v1.UpdateServiceAccountRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: repeated string scopes = 4;
+   */
+  scopes: string[];
+};
+
+/**
+ * Describes the message guardian.v1.UpdateServiceAccountRequest.
+ * Use `create(UpdateServiceAccountRequestSchema)` to create a new message.
+ */
+export const UpdateServiceAccountRequestSchema: GenMessage<UpdateServiceAccountRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_service_account, 8);
+
+/**
+ * @generated from message guardian.v1.UpdateServiceAccountResponse
+ */
+export type UpdateServiceAccountResponse = Message<"guardian.v1.UpdateServiceAccountResponse"> & {
+  /**
+   * @generated from field: guardian.v1.ServiceAccount service_account = 1;
+   */
+  serviceAccount?: ServiceAccount;
+};
+
+/**
+ * Describes the message guardian.v1.UpdateServiceAccountResponse.
+ * Use `create(UpdateServiceAccountResponseSchema)` to create a new message.
+ */
+export const UpdateServiceAccountResponseSchema: GenMessage<UpdateServiceAccountResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_service_account, 9);
+
+/**
+ * @generated from message guardian.v1.DeleteServiceAccountRequest
+ */
+export type DeleteServiceAccountRequest = Message<"guardian.v1.DeleteServiceAccountRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message guardian.v1.DeleteServiceAccountRequest.
+ * Use `create(DeleteServiceAccountRequestSchema)` to create a new message.
+ */
+export const DeleteServiceAccountRequestSchema: GenMessage<DeleteServiceAccountRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_service_account, 10);
+
+/**
+ * @generated from message guardian.v1.DeleteServiceAccountResponse
+ */
+export type DeleteServiceAccountResponse = Message<"guardian.v1.DeleteServiceAccountResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.DeleteServiceAccountResponse.
+ * Use `create(DeleteServiceAccountResponseSchema)` to create a new message.
+ */
+export const DeleteServiceAccountResponseSchema: GenMessage<DeleteServiceAccountResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_service_account, 11);
+
+/**
+ * @generated from message guardian.v1.RotateServiceAccountSecretRequest
+ */
+export type RotateServiceAccountSecretRequest = Message<"guardian.v1.RotateServiceAccountSecretRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * Duration for which the previous secret stays valid. Zero invalidates it immediately, unset uses the configured
+   * default.
+   *
+   * @generated from field: google.protobuf.Duration overlap = 2;
+   */
+  overlap?: Duration;
+};
+
+/**
+ * Describes the message guardian.v1.RotateServiceAccountSecretRequest.
+ * Use `create(RotateServiceAccountSecretRequestSchema)` to create a new message.
+ */
+export const RotateServiceAccountSecretRequestSchema: GenMessage<RotateServiceAccountSecretRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_service_account, 12);
+
+/**
+ * @generated from message guardian.v1.RotateServiceAccountSecretResponse
+ */
+export type RotateServiceAccountSecretResponse = Message<"guardian.v1.RotateServiceAccountSecretResponse"> & {
+  /**
+   * @generated from field: guardian.v1.ServiceAccount service_account = 1;
+   */
+  serviceAccount?: ServiceAccount;
+
+  /**
+   * @generated from field: string client_secret = 2;
+   */
+  clientSecret: string;
+};
+
+/**
+ * Describes the message guardian.v1.RotateServiceAccountSecretResponse.
+ * Use `create(RotateServiceAccountSecretResponseSchema)` to create a new message.
+ */
+export const RotateServiceAccountSecretResponseSchema: GenMessage<RotateServiceAccountSecretResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_service_account, 13);
+
+/**
+ * @generated from message guardian.v1.AddServiceAccountKeyRequest
+ */
+export type AddServiceAccountKeyRequest = Message<"guardian.v1.AddServiceAccountKeyRequest"> & {
+  /**
+   * @generated from field: string service_account_id = 1;
+   */
+  serviceAccountId: string;
+
+  /**
+   * The public key as JSON Web Key. The key id defaults to the RFC 7638 thumbprint of the key.
+   *
+   * @generated from field: string jwk = 2;
+   */
+  jwk: string;
+};
+
+/**
+ * Describes the message guardian.v1.AddServiceAccountKeyRequest.
+ * Use `create(AddServiceAccountKeyRequestSchema)` to create a new message.
+ */
+export const AddServiceAccountKeyRequestSchema: GenMessage<AddServiceAccountKeyRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_service_account, 14);
+
+/**
+ * @generated from message guardian.v1.AddServiceAccountKeyResponse
+ */
+export type AddServiceAccountKeyResponse = Message<"guardian.v1.AddServiceAccountKeyResponse"> & {
+  /**
+   * @generated from field: guardian.v1.ServiceAccountKey key = 1;
+   */
+  key?: ServiceAccountKey;
+};
+
+/**
+ * Describes the message guardian.v1.AddServiceAccountKeyResponse.
+ * Use `create(AddServiceAccountKeyResponseSchema)` to create a new message.
+ */
+export const AddServiceAccountKeyResponseSchema: GenMessage<AddServiceAccountKeyResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_service_account, 15);
+
+/**
+ * @generated from message guardian.v1.ListServiceAccountKeysRequest
+ */
+export type ListServiceAccountKeysRequest = Message<"guardian.v1.ListServiceAccountKeysRequest"> & {
+  /**
+   * @generated from field: string service_account_id = 1;
+   */
+  serviceAccountId: string;
+};
+
+/**
+ * Describes the message guardian.v1.ListServiceAccountKeysRequest.
+ * Use `create(ListServiceAccountKeysRequestSchema)` to create a new message.
+ */
+export const ListServiceAccountKeysRequestSchema: GenMessage<ListServiceAccountKeysRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_service_account, 16);
+
+/**
+ * @generated from message guardian.v1.ListServiceAccountKeysResponse
+ */
+export type ListServiceAccountKeysResponse = Message<"guardian.v1.ListServiceAccountKeysResponse"> & {
+  /**
+   * @generated from field: repeated guardian.v1.ServiceAccountKey keys = 1;
+   */
+  keys: ServiceAccountKey[];
+};
+
+/**
+ * Describes the message guardian.v1.ListServiceAccountKeysResponse.
+ * Use `create(ListServiceAccountKeysResponseSchema)` to create a new message.
+ */
+export const ListServiceAccountKeysResponseSchema: GenMessage<ListServiceAccountKeysResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_service_account, 17);
+
+/**
+ * @generated from message guardian.v1.DeleteServiceAccountKeyRequest
+ */
+export type DeleteServiceAccountKeyRequest = Message<"guardian.v1.DeleteServiceAccountKeyRequest"> & {
+  /**
+   * @generated from field: string service_account_id = 1;
+   */
+  serviceAccountId: string;
+
+  /**
+   * @generated from field: string id = 2;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message guardian.v1.DeleteServiceAccountKeyRequest.
+ * Use `create(DeleteServiceAccountKeyRequestSchema)` to create a new message.
+ */
+export const DeleteServiceAccountKeyRequestSchema: GenMessage<DeleteServiceAccountKeyRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_service_account, 18);
+
+/**
+ * @generated from message guardian.v1.DeleteServiceAccountKeyResponse
+ */
+export type DeleteServiceAccountKeyResponse = Message<"guardian.v1.DeleteServiceAccountKeyResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.DeleteServiceAccountKeyResponse.
+ * Use `create(DeleteServiceAccountKeyResponseSchema)` to create a new message.
+ */
+export const DeleteServiceAccountKeyResponseSchema: GenMessage<DeleteServiceAccountKeyResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_service_account, 19);
+
+/**
+ * ServiceAccountService manages service accounts, the principals of backend jobs. Service accounts are assigned roles
+ * like users with the AuthzService and obtain access tokens from the client credentials grant of the OAuth
+ * authorization server, using their id as client_id. They authenticate with their secret (`client_secret_basic` or
+ * `client_secret_post`) or with a JWT signed by one of their keys (`private_key_jwt`). Methods expect the access token
+ * in the `Authorization: Bearer` header and require the `guardian.service_accounts.manage` permission.
+ *
+ * @generated from service guardian.v1.ServiceAccountService
+ */
+export const ServiceAccountService: GenService<{
+  /**
+   * CreateServiceAccount creates an account and returns its secret, which cannot be retrieved later.
+   *
+   * @generated from rpc guardian.v1.ServiceAccountService.CreateServiceAccount
+   */
+  createServiceAccount: {
+    methodKind: "unary";
+    input: typeof CreateServiceAccountRequestSchema;
+    output: typeof CreateServiceAccountResponseSchema;
+  },
+  /**
+   * GetServiceAccount returns an account by id.
+   *
+   * @generated from rpc guardian.v1.ServiceAccountService.GetServiceAccount
+   */
+  getServiceAccount: {
+    methodKind: "unary";
+    input: typeof GetServiceAccountRequestSchema;
+    output: typeof GetServiceAccountResponseSchema;
+  },
+  /**
+   * ListServiceAccounts lists all accounts ordered by id.
+   *
+   * @generated from rpc guardian.v1.ServiceAccountService.ListServiceAccounts
+   */
+  listServiceAccounts: {
+    methodKind: "unary";
+    input: typeof ListServiceAccountsRequestSchema;
+    output: typeof ListServiceAccountsResponseSchema;
+  },
+  /**
+   * UpdateServiceAccount replaces the settings of an account.
+   *
+   * @generated from rpc guardian.v1.ServiceAccountService.UpdateServiceAccount
+   */
+  updateServiceAccount: {
+    methodKind: "unary";
+    input: typeof UpdateServiceAccountRequestSchema;
+    output: typeof UpdateServiceAccountResponseSchema;
+  },
+  /**
+   * DeleteServiceAccount deletes an account along with its keys, API keys and role assignments. Access tokens issued
+   * to it stay valid until they expire.
+   *
+   * @generated from rpc guardian.v1.ServiceAccountService.DeleteServiceAccount
+   */
+  deleteServiceAccount: {
+    methodKind: "unary";
+    input: typeof DeleteServiceAccountRequestSchema;
+    output: typeof DeleteServiceAccountResponseSchema;
+  },
+  /**
+   * RotateServiceAccountSecret replaces the secret of an account and returns the new secret. The previous secret stays
+   * valid for the overlap, so jobs can switch without downtime.
+   *
+   * @generated from rpc guardian.v1.ServiceAccountService.RotateServiceAccountSecret
+   */
+  rotateServiceAccountSecret: {
+    methodKind: "unary";
+    input: typeof RotateServiceAccountSecretRequestSchema;
+    output: typeof RotateServiceAccountSecretResponseSchema;
+  },
+  /**
+   * AddServiceAccountKey adds a public key verifying the client assertions of an account. Fails with ALREADY_EXISTS
+   * if the account has a key with the key id.
+   *
+   * @generated from rpc guardian.v1.ServiceAccountService.AddServiceAccountKey
+   */
+  addServiceAccountKey: {
+    methodKind: "unary";
+    input: typeof AddServiceAccountKeyRequestSchema;
+    output: typeof AddServiceAccountKeyResponseSchema;
+  },
+  /**
+   * ListServiceAccountKeys lists the keys of an account ordered by id.
+   *
+   * @generated from rpc guardian.v1.ServiceAccountService.ListServiceAccountKeys
+   */
+  listServiceAccountKeys: {
+    methodKind: "unary";
+    input: typeof ListServiceAccountKeysRequestSchema;
+    output: typeof ListServiceAccountKeysResponseSchema;
+  },
+  /**
+   * DeleteServiceAccountKey deletes a key of an account, rejecting assertions signed with it immediately.
+   *
+   * @generated from rpc guardian.v1.ServiceAccountService.DeleteServiceAccountKey
+   */
+  deleteServiceAccountKey: {
+    methodKind: "unary";
+    input: typeof DeleteServiceAccountKeyRequestSchema;
+    output: typeof DeleteServiceAccountKeyResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_guardian_v1_service_account, 0);
+
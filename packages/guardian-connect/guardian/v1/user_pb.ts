@@ -0,0 +1,598 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts"
+// @generated from file guardian/v1/user.proto (package guardian.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file guardian/v1/user.proto.
+ */
+export const file_guardian_v1_user: GenFile = /*@__PURE__*/
+  fileDesc("ChZndWFyZGlhbi92MS91c2VyLnByb3RvEgtndWFyZGlhbi52MSLzAQoEVXNlchIKCgJpZBgBIAEoCRINCgVlbWFpbBgCIAEoCRIQCgh1c2VybmFtZRgDIAEoCRInCgZzdGF0dXMYBCABKA4yFy5ndWFyZGlhbi52MS5Vc2VyU3RhdHVzEi4KCmNyZWF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjUKEWVtYWlsX3ZlcmlmaWVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKaAgoLVXNlclByb2ZpbGUSDAoEbmFtZRgBIAEoCRISCgpnaXZlbl9uYW1lGAIgASgJEhMKC2ZhbWlseV9uYW1lGAMgASgJEhAKCG5pY2tuYW1lGAQgASgJEg8KB3BpY3R1cmUYBSABKAkSDwoHd2Vic2l0ZRgGIAEoCRIRCgliaXJ0aGRhdGUYByABKAkSEAoIem9uZWluZm8YCCABKAkSDgoGbG9jYWxlGAkgASgJEhQKDHBob25lX251bWJlchgKIAEoCRIlCgdhZGRyZXNzGAsgASgLMhQuZ3VhcmRpYW4udjEuQWRkcmVzcxIuCgp1cGRhdGVkX2F0GAwgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJpCgdBZGRyZXNzEhYKDnN0cmVldF9hZGRyZXNzGAEgASgJEhAKCGxvY2FsaXR5GAIgASgJEg4KBnJlZ2lvbhgDIAEoCRITCgtwb3N0YWxfY29kZRgEIAEoCRIPCgdjb3VudHJ5GAUgASgJIhwKDkdldFVzZXJSZXF1ZXN0EgoKAmlkGAEgASgJIjIKD0dldFVzZXJSZXNwb25zZRIfCgR1c2VyGAEgASgLMhEuZ3VhcmRpYW4udjEuVXNlciKaAQoRVXBkYXRlVXNlclJlcXVlc3QSCgoCaWQYASABKAkSEgoFZW1haWwYAiABKAlIAIgBARIVCgh1c2VybmFtZRgDIAEoCUgBiAEBEiwKBnN0YXR1cxgEIAEoDjIXLmd1YXJkaWFuLnYxLlVzZXJTdGF0dXNIAogBAUIICgZfZW1haWxCCwoJX3VzZXJuYW1lQgkKB19zdGF0dXMiNQoSVXBkYXRlVXNlclJlc3BvbnNlEh8KBHVzZXIYASABKAsyES5ndWFyZGlhbi52MS5Vc2VyIjYKGVJlcXVlc3RFbWFpbENoYW5nZVJlcXVlc3QSCgoCaWQYASABKAkSDQoFZW1haWwYAiABKAkiHAoaUmVxdWVzdEVtYWlsQ2hhbmdlUmVzcG9uc2UicQoQTGlzdFVzZXJzUmVxdWVzdBInCgZzdGF0dXMYASABKA4yFy5ndWFyZGlhbi52MS5Vc2VyU3RhdHVzEg0KBXF1ZXJ5GAIgASgJEhEKCXBhZ2Vfc2l6ZRgDIAEoBRISCgpwYWdlX3Rva2VuGAQgASgJIk4KEUxpc3RVc2Vyc1Jlc3BvbnNlEiAKBXVzZXJzGAEgAygLMhEuZ3VhcmRpYW4udjEuVXNlchIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiHwoRRGVsZXRlVXNlclJlcXVlc3QSCgoCaWQYASABKAkiFAoSRGVsZXRlVXNlclJlc3BvbnNlIiMKFUdldFVzZXJQcm9maWxlUmVxdWVzdBIKCgJpZBgBIAEoCSJDChZHZXRVc2VyUHJvZmlsZVJlc3BvbnNlEikKB3Byb2ZpbGUYASABKAsyGC5ndWFyZGlhbi52MS5Vc2VyUHJvZmlsZSJRChhVcGRhdGVVc2VyUHJvZmlsZVJlcXVlc3QSCgoCaWQYASABKAkSKQoHcHJvZmlsZRgCIAEoCzIYLmd1YXJkaWFuLnYxLlVzZXJQcm9maWxlIkYKGVVwZGF0ZVVzZXJQcm9maWxlUmVzcG9uc2USKQoHcHJvZmlsZRgBIAEoCzIYLmd1YXJkaWFuLnYxLlVzZXJQcm9maWxlKnYKClVzZXJTdGF0dXMSGwoXVVNFUl9TVEFUVVNfVU5TUEVDSUZJRUQQABIWChJVU0VSX1NUQVRVU19BQ1RJVkUQARIZChVVU0VSX1NUQVRVU19TVVNQRU5ERUQQAhIYChRVU0VSX1NUQVRVU19ESVNBQkxFRBADMuMECgtVc2VyU2VydmljZRJECgdHZXRVc2VyEhsuZ3VhcmRpYW4udjEuR2V0VXNlclJlcXVlc3QaHC5ndWFyZGlhbi52MS5HZXRVc2VyUmVzcG9uc2USTQoKVXBkYXRlVXNlchIeLmd1YXJkaWFuLnYxLlVwZGF0ZVVzZXJSZXF1ZXN0Gh8uZ3VhcmRpYW4udjEuVXBkYXRlVXNlclJlc3BvbnNlEmUKElJlcXVlc3RFbWFpbENoYW5nZRImLmd1YXJkaWFuLnYxLlJlcXVlc3RFbWFpbENoYW5nZVJlcXVlc3QaJy5ndWFyZGlhbi52MS5SZXF1ZXN0RW1haWxDaGFuZ2VSZXNwb25zZRJKCglMaXN0VXNlcnMSHS5ndWFyZGlhbi52MS5MaXN0VXNlcnNSZXF1ZXN0Gh4uZ3VhcmRpYW4udjEuTGlzdFVzZXJzUmVzcG9uc2USTQoKRGVsZXRlVXNlchIeLmd1YXJkaWFuLnYxLkRlbGV0ZVVzZXJSZXF1ZXN0Gh8uZ3VhcmRpYW4udjEuRGVsZXRlVXNlclJlc3BvbnNlElkKDkdldFVzZXJQcm9maWxlEiIuZ3VhcmRpYW4udjEuR2V0VXNlclByb2ZpbGVSZXF1ZXN0GiMuZ3VhcmRpYW4udjEuR2V0VXNlclByb2ZpbGVSZXNwb25zZRJiChFVcGRhdGVVc2VyUHJvZmlsZRIlLmd1YXJkaWFuLnYxLlVwZGF0ZVVzZXJQcm9maWxlUmVxdWVzdBomLmd1YXJkaWFuLnYxLlVwZGF0ZVVzZXJQcm9maWxlUmVzcG9uc2VCqAEKD2NvbS5ndWFyZGlhbi52MUIJVXNlclByb3RvUAFaPWdpdGh1Yi5jb20vZ29waGVyby9ndWFyZGlhbi9jb3JlL3Byb3RvL2d1YXJkaWFuL3YxO2d1YXJkaWFudjGiAgNHVliqAgtHdWFyZGlhbi5WMcoCC0d1YXJkaWFuXFYx4gIXR3VhcmRpYW5cVjFcR1BCTWV0YWRhdGHqAgxHdWFyZGlhbjo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
+
+/**
+ * User is an account managed by guardian.
+ *
+ * @generated from message guardian.v1.User
+ */
+export type User = Message<"guardian.v1.User"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string email = 2;
+   */
+  email: string;
+
+  /**
+   * @generated from field: string username = 3;
+   */
+  username: string;
+
+  /**
+   * @generated from field: guardian.v1.UserStatus status = 4;
+   */
+  status: UserStatus;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 6;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * Not set until the user verified its email address.
+   *
+   * @generated from field: google.protobuf.Timestamp email_verified_at = 7;
+   */
+  emailVerifiedAt?: Timestamp;
+};
+
+/**
+ * Describes the message guardian.v1.User.
+ * Use `create(UserSchema)` to create a new message.
+ */
+export const UserSchema: GenMessage<User> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_user, 0);
+
+/**
+ * UserProfile holds the standard claims of OpenID Connect about a user. Empty fields are unknown.
+ *
+ * @generated from message guardian.v1.UserProfile
+ */
+export type UserProfile = Message<"guardian.v1.UserProfile"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string given_name = 2;
+   */
+  givenName: string;
+
+  /**
+   * @generated from field: string family_name = 3;
+   */
+  familyName: string;
+
+  /**
+   * @generated from field: string nickname = 4;
+   */
+  nickname: string;
+
+  /**
+   * URL of a profile picture.
+   *
+   * @generated from field: string picture = 5;
+   */
+  picture: string;
+
+  /**
+   * URL of a web page or blog.
+   *
+   * @generated from field: string website = 6;
+   */
+  website: string;
+
+  /**
+   * Formatted as YYYY-MM-DD, YYYY if only the year is known or 0000-MM-DD if the year is withheld.
+   *
+   * @generated from field: string birthdate = 7;
+   */
+  birthdate: string;
+
+  /**
+   * IANA time zone like `Europe/Paris`.
+   *
+   * @generated from field: string zoneinfo = 8;
+   */
+  zoneinfo: string;
+
+  /**
+   * BCP 47 language tag like `en-US`.
+   *
+   * @generated from field: string locale = 9;
+   */
+  locale: string;
+
+  /**
+   * E.164 phone number like `+14155550100`.
+   *
+   * @generated from field: string phone_number = 10;
+   */
+  phoneNumber: string;
+
+  /**
+   * @generated from field: guardian.v1.Address address = 11;
+   */
+  address?: Address;
+
+  /**
+   * Not set if the profile was never updated.
+   *
+   * @generated from field: google.protobuf.Timestamp updated_at = 12;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message guardian.v1.UserProfile.
+ * Use `create(UserProfileSchema)` to create a new message.
+ */
+export const UserProfileSchema: GenMessage<UserProfile> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_user, 1);
+
+/**
+ * Address is a postal address.
+ *
+ * @generated from message guardian.v1.Address
+ */
+export type Address = Message<"guardian.v1.Address"> & {
+  /**
+   * May span multiple lines.
+   *
+   * @generated from field: string street_address = 1;
+   */
+  streetAddress: string;
+
+  /**
+   * @generated from field: string locality = 2;
+   */
+  locality: string;
+
+  /**
+   * @generated from field: string region = 3;
+   */
+  region: string;
+
+  /**
+   * @generated from field: string postal_code = 4;
+   */
+  postalCode: string;
+
+  /**
+   * @generated from field: string country = 5;
+   */
+  country: string;
+};
+
+/**
+ * Describes the message guardian.v1.Address.
+ * Use `create(AddressSchema)` to create a new message.
+ */
+export const AddressSchema: GenMessage<Address> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_user, 2);
+
+/**
+ * @generated from message guardian.v1.GetUserRequest
+ */
+export type GetUserRequest = Message<"guardian.v1.GetUserRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message guardian.v1.GetUserRequest.
+ * Use `create(GetUserRequestSchema)` to create a new message.
+ */
+export const GetUserRequestSchema: GenMessage<GetUserRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_user, 3);
+
+/**
+ * @generated from message guardian.v1.GetUserResponse
+ */
+export type GetUserResponse = Message<"guardian.v1.GetUserResponse"> & {
+  /**
+   * @generated from field: guardian.v1.User user = 1;
+   */
+  user?: User;
+};
+
+/**
+ * Describes the message guardian.v1.GetUserResponse.
+ * Use `create(GetUserResponseSchema)` to create a new message.
+ */
+export const GetUserResponseSchema: GenMessage<GetUserResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_user, 4);
+
+/**
+ * @generated from message guardian.v1.UpdateUserRequest
+ */
+export type UpdateUserRequest = Message<"guardian.v1.UpdateUserRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: optional string email = 2;
+   */
+  email?: string;
+
+  /**
+   * @generated from field: optional string username = 3;
+   */
+  username?: string;
+
+  /**
+   * @generated from field: optional guardian.v1.UserStatus status = 4;
+   */
+  status?: UserStatus;
+};
+
+/**
+ * Describes the message guardian.v1.UpdateUserRequest.
+ * Use `create(UpdateUserRequestSchema)` to create a new message.
+ */
+export const UpdateUserRequestSchema: GenMessage<UpdateUserRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_user, 5);
+
+/**
+ * @generated from message guardian.v1.UpdateUserResponse
+ */
+export type UpdateUserResponse = Message<"guardian.v1.UpdateUserResponse"> & {
+  /**
+   * @generated from field: guardian.v1.User user = 1;
+   */
+  user?: User;
+};
+
+/**
+ * Describes the message guardian.v1.UpdateUserResponse.
+ * Use `create(UpdateUserResponseSchema)` to create a new message.
+ */
+export const UpdateUserResponseSchema: GenMessage<UpdateUserResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_user, 6);
+
+/**
+ * @generated from message guardian.v1.RequestEmailChangeRequest
+ */
+export type RequestEmailChangeRequest = Message<"guardian.v1.RequestEmailChangeRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The new email address.
+   *
+   * @generated from field: string email = 2;
+   */
+  email: string;
+};
+
+/**
+ * Describes the message guardian.v1.RequestEmailChangeRequest.
+ * Use `create(RequestEmailChangeRequestSchema)` to create a new message.
+ */
+export const RequestEmailChangeRequestSchema: GenMessage<RequestEmailChangeRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_user, 7);
+
+/**
+ * @generated from message guardian.v1.RequestEmailChangeResponse
+ */
+export type RequestEmailChangeResponse = Message<"guardian.v1.RequestEmailChangeResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.RequestEmailChangeResponse.
+ * Use `create(RequestEmailChangeResponseSchema)` to create a new message.
+ */
+export const RequestEmailChangeResponseSchema: GenMessage<RequestEmailChangeResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_user, 8);
+
+/**
+ * @generated from message guardian.v1.ListUsersRequest
+ */
+export type ListUsersRequest = Message<"guardian.v1.ListUsersRequest"> & {
+  /**
+   * Only return users with this status. Cannot be combined with query.
+   *
+   * @generated from field: guardian.v1.UserStatus status = 1;
+   */
+  status: UserStatus;
+
+  /**
+   * Only return users whose email or username contains query, ignoring case.
+   *
+   * @generated from field: string query = 2;
+   */
+  query: string;
+
+  /**
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize: number;
+
+  /**
+   * The next_page_token of the previous response.
+   *
+   * @generated from field: string page_token = 4;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message guardian.v1.ListUsersRequest.
+ * Use `create(ListUsersRequestSchema)` to create a new message.
+ */
+export const ListUsersRequestSchema: GenMessage<ListUsersRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_user, 9);
+
+/**
+ * @generated from message guardian.v1.ListUsersResponse
+ */
+export type ListUsersResponse = Message<"guardian.v1.ListUsersResponse"> & {
+  /**
+   * @generated from field: repeated guardian.v1.User users = 1;
+   */
+  users: User[];
+
+  /**
+   * Empty on the last page.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message guardian.v1.ListUsersResponse.
+ * Use `create(ListUsersResponseSchema)` to create a new message.
+ */
+export const ListUsersResponseSchema: GenMessage<ListUsersResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_user, 10);
+
+/**
+ * @generated from message guardian.v1.DeleteUserRequest
+ */
+export type DeleteUserRequest = Message<"guardian.v1.DeleteUserRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message guardian.v1.DeleteUserRequest.
+ * Use `create(DeleteUserRequestSchema)` to create a new message.
+ */
+export const DeleteUserRequestSchema: GenMessage<DeleteUserRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_user, 11);
+
+/**
+ * @generated from message guardian.v1.DeleteUserResponse
+ */
+export type DeleteUserResponse = Message<"guardian.v1.DeleteUserResponse"> & {
+};
+
+/**
+ * Describes the message guardian.v1.DeleteUserResponse.
+ * Use `create(DeleteUserResponseSchema)` to create a new message.
+ */
+export const DeleteUserResponseSchema: GenMessage<DeleteUserResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_user, 12);
+
+/**
+ * @generated from message guardian.v1.GetUserProfileRequest
+ */
+export type GetUserProfileRequest = Message<"guardian.v1.GetUserProfileRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message guardian.v1.GetUserProfileRequest.
+ * Use `create(GetUserProfileRequestSchema)` to create a new message.
+ */
+export const GetUserProfileRequestSchema: GenMessage<GetUserProfileRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_user, 13);
+
+/**
+ * @generated from message guardian.v1.GetUserProfileResponse
+ */
+export type GetUserProfileResponse = Message<"guardian.v1.GetUserProfileResponse"> & {
+  /**
+   * @generated from field: guardian.v1.UserProfile profile = 1;
+   */
+  profile?: UserProfile;
+};
+
+/**
+ * Describes the message guardian.v1.GetUserProfileResponse.
+ * Use `create(GetUserProfileResponseSchema)` to create a new message.
+ */
+export const GetUserProfileResponseSchema: GenMessage<GetUserProfileResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_user, 14);
+
+/**
+ * @generated from message guardian.v1.UpdateUserProfileRequest
+ */
+export type UpdateUserProfileRequest = Message<"guardian.v1.UpdateUserProfileRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: guardian.v1.UserProfile profile = 2;
+   */
+  profile?: UserProfile;
+};
+
+/**
+ * Describes the message guardian.v1.UpdateUserProfileRequest.
+ * Use `create(UpdateUserProfileRequestSchema)` to create a new message.
+ */
+export const UpdateUserProfileRequestSchema: GenMessage<UpdateUserProfileRequest> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_user, 15);
+
+/**
+ * @generated from message guardian.v1.UpdateUserProfileResponse
+ */
+export type UpdateUserProfileResponse = Message<"guardian.v1.UpdateUserProfileResponse"> & {
+  /**
+   * @generated from field: guardian.v1.UserProfile profile = 1;
+   */
+  profile?: UserProfile;
+};
+
+/**
+ * Describes the message guardian.v1.UpdateUserProfileResponse.
+ * Use `create(UpdateUserProfileResponseSchema)` to create a new message.
+ */
+export const UpdateUserProfileResponseSchema: GenMessage<UpdateUserProfileResponse> = /*@__PURE__*/
+  messageDesc(file_guardian_v1_user, 16);
+
+/**
+ * UserStatus is the lifecycle state of a user.
+ *
+ * @generated from enum guardian.v1.UserStatus
+ */
+export enum UserStatus {
+  /**
+   * @generated from enum value: USER_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: USER_STATUS_ACTIVE = 1;
+   */
+  ACTIVE = 1,
+
+  /**
+   * @generated from enum value: USER_STATUS_SUSPENDED = 2;
+   */
+  SUSPENDED = 2,
+
+  /**
+   * @generated from enum value: USER_STATUS_DISABLED = 3;
+   */
+  DISABLED = 3,
+}
+
+/**
+ * Describes the enum guardian.v1.UserStatus.
+ */
+export const UserStatusSchema: GenEnum<UserStatus> = /*@__PURE__*/
+  enumDesc(file_guardian_v1_user, 0);
+
+/**
+ * UserService manages users. Users may access themselves, callers with the `guardian.users.manage` permission may
+ * manage all users.
+ *
+ * @generated from service guardian.v1.UserService
+ */
+export const UserService: GenService<{
+  /**
+   * GetUser returns a user by id.
+   *
+   * @generated from rpc guardian.v1.UserService.GetUser
+   */
+  getUser: {
+    methodKind: "unary";
+    input: typeof GetUserRequestSchema;
+    output: typeof GetUserResponseSchema;
+  },
+  /**
+   * UpdateUser updates the fields of a user which are set in the request. Only callers with the `guardian.users.manage`
+   * permission may change the status or the email of a user with it, users have to confirm a new email address with
+   * RequestEmailChange.
+   *
+   * @generated from rpc guardian.v1.UserService.UpdateUser
+   */
+  updateUser: {
+    methodKind: "unary";
+    input: typeof UpdateUserRequestSchema;
+    output: typeof UpdateUserResponseSchema;
+  },
+  /**
+   * RequestEmailChange emails a link to the new email address of a user, which changes the email once it is opened.
+   *
+   * @generated from rpc guardian.v1.UserService.RequestEmailChange
+   */
+  requestEmailChange: {
+    methodKind: "unary";
+    input: typeof RequestEmailChangeRequestSchema;
+    output: typeof RequestEmailChangeResponseSchema;
+  },
+  /**
+   * ListUsers lists users ordered by id, optionally filtered by status or a search query. It requires the
+   * `guardian.users.manage` permission.
+   *
+   * @generated from rpc guardian.v1.UserService.ListUsers
+   */
+  listUsers: {
+    methodKind: "unary";
+    input: typeof ListUsersRequestSchema;
+    output: typeof ListUsersResponseSchema;
+  },
+  /**
+   * DeleteUser deletes a user.
+   *
+   * @generated from rpc guardian.v1.UserService.DeleteUser
+   */
+  deleteUser: {
+    methodKind: "unary";
+    input: typeof DeleteUserRequestSchema;
+    output: typeof DeleteUserResponseSchema;
+  },
+  /**
+   * GetUserProfile returns the profile of a user, which is empty if it was never updated.
+   *
+   * @generated from rpc guardian.v1.UserService.GetUserProfile
+   */
+  getUserProfile: {
+    methodKind: "unary";
+    input: typeof GetUserProfileRequestSchema;
+    output: typeof GetUserProfileResponseSchema;
+  },
+  /**
+   * UpdateUserProfile replaces the profile of a user. Its fields are released to OAuth clients by the `profile`,
+   * `phone` and `address` scopes of OpenID Connect.
+   *
+   * @generated from rpc guardian.v1.UserService.UpdateUserProfile
+   */
+  updateUserProfile: {
+    methodKind: "unary";
+    input: typeof UpdateUserProfileRequestSchema;
+    output: typeof UpdateUserProfileResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_guardian_v1_user, 0);
+
@@ -0,0 +1,26 @@
+package guardian
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/passkey"
+)
+
+// PasskeyConfig configures the WebAuthn relying party of [NewPasskeyStore].
+type PasskeyConfig = passkey.Config
+
+// PasskeyStore is a [core.PasskeyStore] which can delete expired ceremonies.
+type PasskeyStore interface {
+	core.PasskeyStore
+
+	// DeleteExpired deletes expired ceremonies and returns the number of deleted ceremonies.
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// NewPasskeyStore creates a postgres backed [PasskeyStore].
+func NewPasskeyStore(pool *pgxpool.Pool, config PasskeyConfig) (PasskeyStore, error) {
+	return passkey.NewStore(pool, config)
+}
@@ -0,0 +1,32 @@
+package guardian
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/password"
+)
+
+// PasswordHasherConfig configures the [core.PasswordHasher] created by [NewPasswordHasher].
+type PasswordHasherConfig = password.HasherConfig
+
+// NewPasswordHasher creates a [core.PasswordHasher] supporting argon2id, bcrypt and scrypt.
+func NewPasswordHasher(config PasswordHasherConfig) (core.PasswordHasher, error) {
+	return password.NewHasher(config)
+}
+
+// PasswordPolicyConfig configures the [PasswordPolicy] created by [NewPasswordPolicy].
+type PasswordPolicyConfig = password.PolicyConfig
+
+// PasswordPolicy is a [core.PasswordPolicy] which also configures the password history used by [NewPasswordStore].
+type PasswordPolicy = password.Policy
+
+// NewPasswordPolicy creates a [PasswordPolicy].
+func NewPasswordPolicy(config PasswordPolicyConfig) (*PasswordPolicy, error) {
+	return password.NewPolicy(config)
+}
+
+// NewPasswordStore creates a postgres backed [core.PasswordStore] which validates passwords against policy.
+func NewPasswordStore(pool *pgxpool.Pool, hasher core.PasswordHasher, policy *PasswordPolicy) (core.PasswordStore, error) {
+	return password.NewStore(pool, hasher, policy)
+}
@@ -0,0 +1,29 @@
+package guardian
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/passwordreset"
+)
+
+// PasswordResetConfig configures the reset links of [NewPasswordResetStore].
+type PasswordResetConfig = passwordreset.Config
+
+// PasswordResetStore is a [core.PasswordResetStore] which exports prometheus metrics about requested, completed and
+// failed password resets.
+type PasswordResetStore interface {
+	core.PasswordResetStore
+	prometheus.Collector
+
+	// DeleteExpired deletes expired resets and returns the number of deleted resets.
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// NewPasswordResetStore creates a postgres backed [PasswordResetStore].
+func NewPasswordResetStore(pool *pgxpool.Pool, config PasswordResetConfig) (PasswordResetStore, error) {
+	return passwordreset.NewStore(pool, config)
+}
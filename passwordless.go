@@ -0,0 +1,26 @@
+package guardian
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/passwordless"
+)
+
+// PasswordlessConfig configures the magic links and email one-time codes of [NewPasswordlessStore].
+type PasswordlessConfig = passwordless.Config
+
+// PasswordlessStore is a [core.PasswordlessStore] which can delete expired challenges.
+type PasswordlessStore interface {
+	core.PasswordlessStore
+
+	// DeleteExpired deletes expired challenges and returns the number of deleted challenges.
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// NewPasswordlessStore creates a postgres backed [PasswordlessStore].
+func NewPasswordlessStore(pool *pgxpool.Pool, config PasswordlessConfig) (PasswordlessStore, error) {
+	return passwordless.NewStore(pool, config)
+}
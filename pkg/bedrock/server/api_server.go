@@ -0,0 +1,21 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gophero/guardian/pkg/bedrock/server/middleware"
+)
+
+// NewAPIServer creates a new [Server] serving h wrapped with mws, the first middleware being the outermost one.
+func NewAPIServer(config Config, h http.Handler, mws ...middleware.Middleware) (*Server, error) {
+	if config.Network == "tcp" && config.Addr == "" {
+		config.Addr = "localhost:9001"
+	}
+
+	s, err := newServer("api", config, middleware.Chain(h, mws...))
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
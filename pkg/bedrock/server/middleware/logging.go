@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Logging logs every request once it has been served using the logger from request context.
+//
+// Server errors are logged at error level, everything else at info level.
+func Logging() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rw := newResponseWriter(w)
+
+			next.ServeHTTP(rw, r)
+
+			lvl := zerolog.InfoLevel
+			if rw.statusCode() >= http.StatusInternalServerError {
+				lvl = zerolog.ErrorLevel
+			}
+
+			ctx := r.Context()
+			zerolog.Ctx(ctx).WithLevel(lvl).
+				Ctx(ctx).
+				Str("method", r.Method).
+				Str("path", r.URL.Path).
+				Str("proto", r.Proto).
+				Int("status", rw.statusCode()).
+				Int64("size", rw.size).
+				Dur("duration", time.Since(start)).
+				Str("remote_addr", r.RemoteAddr).
+				Str("user_agent", r.UserAgent()).
+				Msg("request served")
+		})
+	}
+}
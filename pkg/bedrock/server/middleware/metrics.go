@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics records prometheus metrics about requests served by a server.
+type Metrics struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	inFlight prometheus.Gauge
+}
+
+var _ prometheus.Collector = (*Metrics)(nil)
+
+// NewMetrics creates [Metrics] labeled by given `server`.
+func NewMetrics(server string) *Metrics {
+	labels := prometheus.Labels{"server": server}
+
+	return &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        "http_server_requests_total",
+			Help:        "Total number of http requests served.",
+			ConstLabels: labels,
+		}, []string{"method", "path", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:        "http_server_request_duration_seconds",
+			Help:        "Duration of http requests in seconds.",
+			ConstLabels: labels,
+			Buckets:     prometheus.DefBuckets,
+		}, []string{"method", "path"}),
+		inFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name:        "http_server_requests_in_flight",
+			Help:        "Number of http requests currently being served.",
+			ConstLabels: labels,
+		}),
+	}
+}
+
+// Describe implements [prometheus.Collector].
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.requests.Describe(ch)
+	m.duration.Describe(ch)
+	m.inFlight.Describe(ch)
+}
+
+// Collect implements [prometheus.Collector].
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.requests.Collect(ch)
+	m.duration.Collect(ch)
+	m.inFlight.Collect(ch)
+}
+
+// Middleware returns a [Middleware] recording requests served by the handler.
+func (m *Metrics) Middleware() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rw := newResponseWriter(w)
+
+			m.inFlight.Inc()
+			defer m.inFlight.Dec()
+
+			next.ServeHTTP(rw, r)
+
+			path := r.URL.Path
+			// Only registered routes can respond with anything but 404, everything else is folded
+			// into a single label value to keep cardinality bounded.
+			if rw.statusCode() == http.StatusNotFound {
+				path = "unmatched"
+			}
+
+			m.requests.WithLabelValues(r.Method, path, strconv.Itoa(rw.statusCode())).Inc()
+			m.duration.WithLabelValues(r.Method, path).Observe(time.Since(start).Seconds())
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// Package middleware provides [http.Handler] middlewares shared by bedrock servers.
+package middleware
+
+import (
+	"net/http"
+)
+
+// Middleware wraps an [http.Handler] with additional behaviour.
+type Middleware func(http.Handler) http.Handler
+
+// Chain wraps h with mws. The first middleware is the outermost one, i.e. it sees the request first and the response last.
+func Chain(h http.Handler, mws ...Middleware) http.Handler {
+	for i := len(mws) - 1; i >= 0; i-- {
+		h = mws[i](h)
+	}
+
+	return h
+}
+
+// responseWriter records the status code and number of bytes written through the wrapped [http.ResponseWriter].
+type responseWriter struct {
+	http.ResponseWriter
+
+	status int
+	size   int64
+}
+
+func newResponseWriter(w http.ResponseWriter) *responseWriter {
+	return &responseWriter{ResponseWriter: w}
+}
+
+// WriteHeader implements [http.ResponseWriter].
+func (w *responseWriter) WriteHeader(status int) {
+	// Informational responses can be followed by the final one.
+	if w.status == 0 && status >= http.StatusOK {
+		w.status = status
+	}
+
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// Write implements [http.ResponseWriter].
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+
+	n, err := w.ResponseWriter.Write(b)
+	w.size += int64(n)
+
+	return n, err
+}
+
+// Flush implements [http.Flusher], required for streaming responses.
+func (w *responseWriter) Flush() {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+
+	//nolint:errcheck // Flush of http.Flusher has no way to report an error either.
+	http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+// Unwrap allows [http.ResponseController] to reach the underlying [http.ResponseWriter].
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// wroteHeader reports whether the response header has been sent.
+func (w *responseWriter) wroteHeader() bool {
+	return w.status != 0
+}
+
+// statusCode returns the response status, which defaults to 200 when handler wrote nothing.
+func (w *responseWriter) statusCode() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+
+	return w.status
+}
@@ -0,0 +1,170 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChain(t *testing.T) {
+	var order []string
+
+	mw := func(name string) Middleware {
+		return func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				order = append(order, name)
+				next.ServeHTTP(w, r)
+			})
+		}
+	}
+
+	h := Chain(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		order = append(order, "handler")
+	}), mw("first"), mw("second"))
+
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+	require.Equal(t, []string{"first", "second", "handler"}, order)
+}
+
+func TestRecovery(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	logger := zerolog.New(buf)
+
+	serve := func(h http.HandlerFunc) *httptest.ResponseRecorder {
+		buf.Reset()
+
+		r := httptest.NewRequest(http.MethodPost, "/guardian.v1.AuthService/SignIn", nil)
+		r = r.WithContext(logger.WithContext(r.Context()))
+
+		w := httptest.NewRecorder()
+		Recovery()(h).ServeHTTP(w, r)
+
+		return w
+	}
+
+	t.Run("panic", func(t *testing.T) {
+		w := serve(func(http.ResponseWriter, *http.Request) {
+			panic("boom")
+		})
+
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+		require.Equal(t, "error", entry["level"])
+		require.Equal(t, "panic: boom", entry["message"])
+		require.Equal(t, "/guardian.v1.AuthService/SignIn", entry["path"])
+		require.Contains(t, entry["stacktrace"], "TestRecovery")
+	})
+
+	t.Run("panic-after-write", func(t *testing.T) {
+		w := serve(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+			panic("boom")
+		})
+
+		require.Equal(t, http.StatusAccepted, w.Code)
+		require.NotEmpty(t, buf.String())
+	})
+
+	t.Run("abort-handler", func(t *testing.T) {
+		require.PanicsWithValue(t, http.ErrAbortHandler, func() {
+			serve(func(http.ResponseWriter, *http.Request) {
+				panic(http.ErrAbortHandler)
+			})
+		})
+	})
+
+	t.Run("no-panic", func(t *testing.T) {
+		w := serve(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+		require.Equal(t, http.StatusNoContent, w.Code)
+		require.Empty(t, buf.String())
+	})
+}
+
+func TestLogging(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	logger := zerolog.New(buf)
+
+	serve := func(status int) map[string]any {
+		buf.Reset()
+
+		r := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+		r = r.WithContext(logger.WithContext(r.Context()))
+
+		Logging()(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(status)
+			w.Write([]byte("hello")) //nolint:errcheck // recorder never fails.
+		})).ServeHTTP(httptest.NewRecorder(), r)
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+
+		return entry
+	}
+
+	entry := serve(http.StatusOK)
+	require.Equal(t, "info", entry["level"])
+	require.Equal(t, "GET", entry["method"])
+	require.Equal(t, "/.well-known/jwks.json", entry["path"])
+	require.EqualValues(t, http.StatusOK, entry["status"])
+	require.EqualValues(t, 5, entry["size"])
+	require.Contains(t, entry, "duration")
+
+	entry = serve(http.StatusBadGateway)
+	require.Equal(t, "error", entry["level"])
+	require.EqualValues(t, http.StatusBadGateway, entry["status"])
+}
+
+func TestMetrics(t *testing.T) {
+	m := NewMetrics("api")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /guardian.v1.AuthService/SignIn", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	h := m.Middleware()(mux)
+
+	for _, path := range []string{"/guardian.v1.AuthService/SignIn", "/guardian.v1.AuthService/SignIn", "/random", "/other"} {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, path, nil))
+	}
+
+	reg := prometheus.NewPedanticRegistry()
+	reg.MustRegister(m)
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+
+	requests := make(map[string]float64)
+	for _, f := range families {
+		if f.GetName() != "http_server_requests_total" {
+			continue
+		}
+
+		for _, metric := range f.GetMetric() {
+			labels := make(map[string]string)
+			for _, l := range metric.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+
+			require.Equal(t, "api", labels["server"])
+			requests[labels["method"]+" "+labels["path"]+" "+labels["code"]] = metric.GetCounter().GetValue()
+		}
+	}
+
+	require.Equal(t, map[string]float64{
+		"POST /guardian.v1.AuthService/SignIn 200": 2,
+		"POST unmatched 404":                       2,
+	}, requests)
+}
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/rs/zerolog"
+
+	"github.com/gophero/guardian/pkg/bedrock/log"
+)
+
+// Recovery recovers panics raised by the handler, logs them with a stacktrace using the logger from request context
+// and responds with 500 Internal Server Error when nothing has been written yet.
+//
+// [http.ErrAbortHandler] is propagated as it is used to deliberately abort a response.
+func Recovery() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rw := newResponseWriter(w)
+
+			defer func() {
+				rvr := recover()
+				if rvr == nil {
+					return
+				}
+
+				if rvr == http.ErrAbortHandler {
+					panic(rvr)
+				}
+
+				ctx := r.Context()
+				zerolog.Ctx(ctx).Error().
+					Ctx(ctx).
+					Str(log.Stack(2)).
+					Str("method", r.Method).
+					Str("path", r.URL.Path).
+					Msg(fmt.Sprintf("panic: %v", rvr))
+
+				if !rw.wroteHeader() {
+					http.Error(rw, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				}
+			}()
+
+			next.ServeHTTP(rw, r)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// Tracing starts a server span for every request using the global tracer provider and propagator.
+//
+// Spans are named after the request method and path, which is stable for Connect procedures.
+func Tracing(server string) Middleware {
+	return otelhttp.NewMiddleware(server,
+		otelhttp.WithServerName(server),
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method + " " + r.URL.Path
+		}),
+	)
+}
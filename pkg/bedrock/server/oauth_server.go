@@ -0,0 +1,22 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gophero/guardian/pkg/bedrock/server/middleware"
+)
+
+// NewOAuthServer creates a new [Server] serving the OAuth endpoints of h wrapped with mws, the first middleware being
+// the outermost one.
+func NewOAuthServer(config Config, h http.Handler, mws ...middleware.Middleware) (*Server, error) {
+	if config.Network == "tcp" && config.Addr == "" {
+		config.Addr = "localhost:9004"
+	}
+
+	s, err := newServer("oauth", config, middleware.Chain(h, mws...))
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
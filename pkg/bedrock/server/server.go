@@ -49,6 +49,10 @@ func newServer(name string, config Config, h http.Handler) (*Server, error) {
 		Handler:   h,
 		ErrorLog:  log.NewStdLog(logger, zerolog.ErrorLevel),
 		Protocols: protocols,
+		// Handlers can log through the request context with the server logger.
+		BaseContext: func(net.Listener) context.Context {
+			return logger.WithContext(context.Background())
+		},
 	}
 
 	s := &Server{config: config, logger: logger, httpSrv: httpSrv, errChan: make(chan error)}
@@ -0,0 +1,18 @@
+package guardian
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/rbac"
+)
+
+// RBACConfig configures the permission cache of [NewRBACStore].
+type RBACConfig = rbac.Config
+
+// NewRBACStore creates a postgres backed [core.RBACStore] which evaluates conditions of assignments with engine.
+// Effective permissions are cached in memory, so changes made through other instances, including updated condition
+// expressions, are seen after at most [RBACConfig.CacheTTL].
+func NewRBACStore(pool *pgxpool.Pool, config RBACConfig, engine *ConditionEngine) (core.RBACStore, error) {
+	return rbac.NewStore(pool, config, engine)
+}
@@ -0,0 +1,16 @@
+package guardian
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/rebac"
+)
+
+// ReBACConfig configures the evaluation limits of [NewReBACStore].
+type ReBACConfig = rebac.Config
+
+// NewReBACStore creates a postgres backed [core.ReBACStore] which evaluates conditions of tuples with engine.
+func NewReBACStore(pool *pgxpool.Pool, config ReBACConfig, engine *ConditionEngine) (core.ReBACStore, error) {
+	return rebac.NewStore(pool, config, engine)
+}
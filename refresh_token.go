@@ -0,0 +1,30 @@
+package guardian
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/refresh"
+)
+
+// RefreshTokenConfig configures the [RefreshTokenStore] created by [NewRefreshTokenStore].
+type RefreshTokenConfig = refresh.Config
+
+// RefreshTokenStore is a [core.RefreshTokenStore] which exports prometheus metrics about issued, rotated, reused and
+// revoked refresh tokens.
+type RefreshTokenStore interface {
+	core.RefreshTokenStore
+	prometheus.Collector
+
+	// DeleteExpired deletes token families which expired or were revoked longer than the configured retention ago and
+	// returns the number of deleted families.
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// NewRefreshTokenStore creates a postgres backed [RefreshTokenStore] which records detected token reuse to audit.
+func NewRefreshTokenStore(pool *pgxpool.Pool, config RefreshTokenConfig, audit core.AuditLog) (RefreshTokenStore, error) {
+	return refresh.NewStore(pool, config, audit)
+}
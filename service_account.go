@@ -0,0 +1,27 @@
+package guardian
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/serviceaccount"
+)
+
+// ServiceAccountConfig configures the [ServiceAccountStore] created by [NewServiceAccountStore].
+type ServiceAccountConfig = serviceaccount.Config
+
+// ServiceAccountStore is a [core.ServiceAccountStore] which can delete the expired client assertions it remembers to
+// reject replays.
+type ServiceAccountStore interface {
+	core.ServiceAccountStore
+
+	// DeleteExpired deletes the IDs of expired client assertions and returns the number of deleted IDs.
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// NewServiceAccountStore creates a postgres backed [ServiceAccountStore].
+func NewServiceAccountStore(pool *pgxpool.Pool, config ServiceAccountConfig) (ServiceAccountStore, error) {
+	return serviceaccount.NewStore(pool, config)
+}
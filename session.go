@@ -0,0 +1,29 @@
+package guardian
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/session"
+)
+
+// SessionConfig configures the [SessionStore] created by [NewSessionStore].
+type SessionConfig = session.Config
+
+// SessionStore is a [core.SessionStore] which exports prometheus metrics about active, created and revoked sessions.
+type SessionStore interface {
+	core.SessionStore
+	prometheus.Collector
+
+	// DeleteExpired deletes sessions which expired or were revoked longer than the configured retention ago and
+	// returns the number of deleted sessions.
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// NewSessionStore creates a postgres backed [SessionStore].
+func NewSessionStore(pool *pgxpool.Pool, config SessionConfig) (SessionStore, error) {
+	return session.NewStore(pool, config)
+}
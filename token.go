@@ -0,0 +1,38 @@
+package guardian
+
+import (
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/token"
+)
+
+// TokenConfig configures the [KeyRing] created by [NewKeyRing] and the access tokens issued with it.
+type TokenConfig = token.Config
+
+// KeyRing is a postgres backed set of rotating signing keys.
+type KeyRing = token.KeyRing
+
+// NewKeyRing creates a postgres backed [KeyRing] which stores private keys encrypted with cipher.
+func NewKeyRing(pool *pgxpool.Pool, config TokenConfig, cipher *Cipher) (*KeyRing, error) {
+	return token.NewKeyRing(pool, config, cipher)
+}
+
+// NewAccessTokenIssuer creates a [core.AccessTokenIssuer] which signs JWT access tokens with the keys of keys.
+func NewAccessTokenIssuer(keys *KeyRing) core.AccessTokenIssuer {
+	return token.NewIssuer(keys)
+}
+
+// NewIDTokenIssuer creates a [core.IDTokenIssuer] which signs OpenID Connect ID tokens with the keys of keys. The
+// configured issuer of keys is the issuer identifier of the OpenID Provider.
+func NewIDTokenIssuer(keys *KeyRing) core.IDTokenIssuer {
+	return token.NewIDTokenIssuer(keys)
+}
+
+// NewJWKSHandler creates a [http.Handler] serving the public keys of keys as a JWK set, typically mounted at
+// `/.well-known/jwks.json`.
+func NewJWKSHandler(keys *KeyRing) http.Handler {
+	return token.NewJWKSHandler(keys)
+}
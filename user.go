@@ -0,0 +1,18 @@
+package guardian
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/user"
+)
+
+// NewUserStore creates a postgres backed [core.UserStore].
+func NewUserStore(pool *pgxpool.Pool) core.UserStore {
+	return user.NewStore(pool)
+}
+
+// NewUserProfileStore creates a postgres backed [core.UserProfileStore].
+func NewUserProfileStore(pool *pgxpool.Pool) core.UserProfileStore {
+	return user.NewProfileStore(pool)
+}
@@ -0,0 +1,26 @@
+package guardian
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/gophero/guardian/core"
+	"github.com/gophero/guardian/internal/verification"
+)
+
+// EmailVerificationConfig configures the verification links of [NewEmailVerificationStore].
+type EmailVerificationConfig = verification.Config
+
+// EmailVerificationStore is a [core.EmailVerificationStore] which can delete expired verifications.
+type EmailVerificationStore interface {
+	core.EmailVerificationStore
+
+	// DeleteExpired deletes expired verifications and returns the number of deleted verifications.
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// NewEmailVerificationStore creates a postgres backed [EmailVerificationStore].
+func NewEmailVerificationStore(pool *pgxpool.Pool, config EmailVerificationConfig) (EmailVerificationStore, error) {
+	return verification.NewStore(pool, config)
+}